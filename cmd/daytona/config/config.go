@@ -10,6 +10,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/cmd/autocomplete"
 	"github.com/google/uuid"
@@ -17,16 +19,48 @@ import (
 
 type ServerApi struct {
 	Url string `json:"url"`
-	Key string `json:"key"`
+	// Key is kept in the credential store and is not written to the config file
+	Key string `json:"key,omitempty"`
 }
 
 type Profile struct {
 	Id   string    `json:"id"`
 	Name string    `json:"name"`
 	Api  ServerApi `json:"api"`
+	// Defaults applied when the profile is active
+	DefaultTarget       string            `json:"defaultTarget,omitempty"`
+	DefaultIdeId        string            `json:"defaultIde,omitempty"`
+	DefaultWorkspaceTtl string            `json:"defaultWorkspaceTtl,omitempty"`
+	EnvVars             map[string]string `json:"envVars,omitempty"`
+	// Organization the profile requests are scoped to
+	Organization string `json:"organization,omitempty"`
+	// Server announcements that were already shown or acknowledged
+	SeenAnnouncements  []string `json:"seenAnnouncements,omitempty"`
+	AckedAnnouncements []string `json:"ackedAnnouncements,omitempty"`
+	// Local ports allocated to the ports declared by projects, keyed by <workspace id>/<project>/<port name>
+	LocalPorts map[string]uint16 `json:"localPorts,omitempty"`
+	// Session in which the requests of the profile act on behalf of another user
+	Impersonation *ImpersonationSession `json:"impersonation,omitempty"`
+}
+
+type ImpersonationSession struct {
+	Id        string    `json:"id"`
+	User      string    `json:"user"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// GetActiveImpersonation returns the impersonation session of the profile, or nil if there is none or it expired
+func (p *Profile) GetActiveImpersonation() *ImpersonationSession {
+	if p.Impersonation == nil || !time.Now().Before(p.Impersonation.ExpiresAt) {
+		return nil
+	}
+
+	return p.Impersonation
 }
 
 type Config struct {
+	// Version is the schema version of the config file
+	Version          int       `json:"version"`
 	Id               string    `json:"id"`
 	ActiveProfileId  string    `json:"activeProfile"`
 	DefaultIdeId     string    `json:"defaultIde"`
@@ -45,50 +79,176 @@ type GitProvider struct {
 }
 
 func GetConfig() (*Config, error) {
-	configFilePath, err := getConfigPath()
+	unlock, err := lockConfig()
 	if err != nil {
 		return nil, err
 	}
+	defer unlock()
 
-	_, err = os.Stat(configFilePath)
-	if os.IsNotExist(err) {
+	c, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	if c == nil {
 		// Setup autocompletion when adding initial config
 		_ = autocomplete.DetectShellAndSetupAutocompletion(autocomplete.AutoCompleteCmd.Root())
 
-		config := &Config{
+		c = &Config{
 			Id:               uuid.NewString(),
 			DefaultIdeId:     getInitialDefaultIde(),
 			TelemetryEnabled: true,
 		}
-		return config, config.Save()
+		return c, write(c)
 	}
 
+	return c, nil
+}
+
+// load reads the config file, migrating it to the current schema version
+// if needed. It returns nil if the file does not exist. The caller must
+// hold the config lock.
+func load() (*Config, error) {
+	configFilePath, err := getConfigPath()
 	if err != nil {
 		return nil, err
 	}
 
-	var c Config
 	configContent, err := os.ReadFile(configFilePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	err = json.Unmarshal(configContent, &c)
+	migratedContent, version, err := migrate(configContent)
 	if err != nil {
 		return nil, err
 	}
 
-	if c.Id == "" {
-		c.Id = uuid.NewString()
-		err := c.Save()
+	var c Config
+	err = json.Unmarshal(migratedContent, &c)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != currentConfigVersion {
+		// Keep the original file around in case the migration needs to be undone. API keys are left out so that
+		// they do not stay on disk in plain text once they are moved to the credential store
+		backupContent, err := redactApiKeys(configContent)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.WriteFile(fmt.Sprintf("%s.v%d.bak", configFilePath, version), backupContent, 0600)
 		if err != nil {
 			return nil, err
 		}
+
+		err = write(&c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = loadApiKeys(&c)
+	if err != nil {
+		return nil, err
 	}
 
 	return &c, nil
 }
 
+// write atomically replaces the config file. The caller must hold the config lock.
+func write(c *Config) error {
+	configFilePath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(configFilePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	c.Version = currentConfigVersion
+
+	err = storeApiKeys(c)
+	if err != nil {
+		return err
+	}
+
+	// API keys are written to the credential store instead of the config file
+	fileConfig := *c
+	fileConfig.Profiles = make([]Profile, len(c.Profiles))
+	for i, profile := range c.Profiles {
+		profile.Api.Key = ""
+		fileConfig.Profiles[i] = profile
+	}
+
+	configContent, err := json.MarshalIndent(fileConfig, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(configFilePath), "config.json.tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(configContent)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	err = os.Chmod(tmpFile.Name(), 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), configFilePath)
+}
+
+// update applies fn to the latest config on disk while holding the lock so
+// that changes made by concurrent daytona processes are not lost
+func (c *Config) update(fn func(latest *Config) error) error {
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	latest, err := load()
+	if err != nil {
+		return err
+	}
+	if latest == nil {
+		latest = c
+	}
+
+	err = fn(latest)
+	if err != nil {
+		return err
+	}
+
+	err = write(latest)
+	if err != nil {
+		return err
+	}
+
+	*c = *latest
+	return nil
+}
+
 var ErrNoProfilesFound = errors.New("no profiles found. Run `daytona serve` to create a default profile or `daytona profile add` to connect to a remote server")
 
 func (c *Config) GetActiveProfile() (Profile, error) {
@@ -105,42 +265,47 @@ func (c *Config) GetActiveProfile() (Profile, error) {
 	return Profile{}, errors.New("active profile not found. Set an active profile with `daytona profile use`")
 }
 
-func (c *Config) Save() error {
-	configFilePath, err := getConfigPath()
-	if err != nil {
-		return err
+// GetDefaultIdeId returns the default IDE of the active profile or the global default IDE if the profile does not set one
+func (c *Config) GetDefaultIdeId() string {
+	activeProfile, err := c.GetActiveProfile()
+	if err == nil && activeProfile.DefaultIdeId != "" {
+		return activeProfile.DefaultIdeId
 	}
 
-	err = os.MkdirAll(filepath.Dir(configFilePath), 0755)
-	if err != nil {
-		return err
-	}
+	return c.DefaultIdeId
+}
 
-	configContent, err := json.MarshalIndent(c, "", "  ")
+// Save overwrites the config file with c. Prefer the specific setters which
+// merge with changes made by other daytona processes.
+func (c *Config) Save() error {
+	unlock, err := lockConfig()
 	if err != nil {
 		return err
 	}
+	defer unlock()
 
-	return os.WriteFile(configFilePath, configContent, 0644)
+	return write(c)
 }
 
 func (c *Config) AddProfile(profile Profile) error {
-	c.Profiles = append(c.Profiles, profile)
-	c.ActiveProfileId = profile.Id
-
-	return c.Save()
+	return c.update(func(latest *Config) error {
+		latest.Profiles = append(latest.Profiles, profile)
+		latest.ActiveProfileId = profile.Id
+		return nil
+	})
 }
 
 func (c *Config) EditProfile(profile Profile) error {
-	for i, p := range c.Profiles {
-		if p.Id == profile.Id {
-			c.Profiles[i] = profile
-
-			return c.Save()
+	return c.update(func(latest *Config) error {
+		for i, p := range latest.Profiles {
+			if p.Id == profile.Id {
+				latest.Profiles[i] = profile
+				return nil
+			}
 		}
-	}
 
-	return fmt.Errorf("profile with id %s not found", profile.Id)
+		return fmt.Errorf("profile with id %s not found", profile.Id)
+	})
 }
 
 func (c *Config) RemoveProfile(profileId string) error {
@@ -148,20 +313,88 @@ func (c *Config) RemoveProfile(profileId string) error {
 		return errors.New("can not remove default profile")
 	}
 
-	var profiles []Profile
-	for _, profile := range c.Profiles {
-		if profile.Id != profileId {
-			profiles = append(profiles, profile)
+	return c.update(func(latest *Config) error {
+		var profiles []Profile
+		for _, profile := range latest.Profiles {
+			if profile.Id != profileId {
+				profiles = append(profiles, profile)
+			}
 		}
-	}
 
-	if c.ActiveProfileId == profileId {
-		c.ActiveProfileId = "default"
-	}
+		err := deleteApiKey(latest, profileId)
+		if err != nil {
+			return err
+		}
+
+		if latest.ActiveProfileId == profileId {
+			latest.ActiveProfileId = "default"
+		}
+
+		latest.Profiles = profiles
+		return nil
+	})
+}
 
-	c.Profiles = profiles
+func (c *Config) SetActiveProfile(profileId string) error {
+	return c.update(func(latest *Config) error {
+		for _, profile := range latest.Profiles {
+			if profile.Id == profileId {
+				latest.ActiveProfileId = profileId
+				return nil
+			}
+		}
 
-	return c.Save()
+		return errors.New("profile not found")
+	})
+}
+
+func (c *Config) SetDefaultIde(ideId string) error {
+	return c.update(func(latest *Config) error {
+		latest.DefaultIdeId = ideId
+		return nil
+	})
+}
+
+// MarkAnnouncementsSeen records that the announcements were shown in the profile.
+// IDs of announcements that are no longer active are dropped.
+func (c *Config) MarkAnnouncementsSeen(profileId string, ids, activeIds []string) error {
+	return c.updateAnnouncements(profileId, func(p *Profile) {
+		p.SeenAnnouncements = mergeAnnouncementIds(p.SeenAnnouncements, ids, activeIds)
+		p.AckedAnnouncements = mergeAnnouncementIds(p.AckedAnnouncements, nil, activeIds)
+	})
+}
+
+// AckAnnouncements records that the announcements were acknowledged in the profile.
+// IDs of announcements that are no longer active are dropped.
+func (c *Config) AckAnnouncements(profileId string, ids, activeIds []string) error {
+	return c.updateAnnouncements(profileId, func(p *Profile) {
+		p.SeenAnnouncements = mergeAnnouncementIds(p.SeenAnnouncements, ids, activeIds)
+		p.AckedAnnouncements = mergeAnnouncementIds(p.AckedAnnouncements, ids, activeIds)
+	})
+}
+
+func (c *Config) updateAnnouncements(profileId string, fn func(p *Profile)) error {
+	return c.update(func(latest *Config) error {
+		for i := range latest.Profiles {
+			if latest.Profiles[i].Id == profileId {
+				fn(&latest.Profiles[i])
+				return nil
+			}
+		}
+
+		return fmt.Errorf("profile with id %s not found", profileId)
+	})
+}
+
+func mergeAnnouncementIds(existing, ids, activeIds []string) []string {
+	var merged []string
+	for _, id := range slices.Concat(existing, ids) {
+		if slices.Contains(activeIds, id) && !slices.Contains(merged, id) {
+			merged = append(merged, id)
+		}
+	}
+
+	return merged
 }
 
 func (c *Config) GetProfile(profileId string) (Profile, error) {
@@ -175,15 +408,17 @@ func (c *Config) GetProfile(profileId string) (Profile, error) {
 }
 
 func (c *Config) EnableTelemetry() error {
-	c.TelemetryEnabled = true
-
-	return c.Save()
+	return c.update(func(latest *Config) error {
+		latest.TelemetryEnabled = true
+		return nil
+	})
 }
 
 func (c *Config) DisableTelemetry() error {
-	c.TelemetryEnabled = false
-
-	return c.Save()
+	return c.update(func(latest *Config) error {
+		latest.TelemetryEnabled = false
+		return nil
+	})
 }
 
 func getConfigPath() (string, error) {
@@ -215,6 +450,20 @@ func DeleteConfigDir() error {
 		return err
 	}
 
+	c, err := load()
+	if err != nil {
+		return err
+	}
+
+	if c != nil {
+		for _, profile := range c.Profiles {
+			err = deleteApiKey(c, profile.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	err = os.RemoveAll(configDir)
 	if err != nil {
 		return err
@@ -0,0 +1,145 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const legacyConfig = `{
+  "activeProfile": "removed",
+  "defaultIde": "vscode",
+  "profiles": [
+    {"id": "remote", "name": "remote", "api": {"url": "https://daytona.example.com", "key": "key"}},
+    {"id": "default", "name": "default", "api": {"url": "http://localhost:3986", "key": "key"}}
+  ],
+  "telemetryEnabled": false
+}`
+
+func setupConfigDir(t *testing.T, content string) string {
+	dir := t.TempDir()
+	t.Setenv("DAYTONA_CONFIG_DIR", dir)
+	t.Setenv("DAYTONA_CREDENTIAL_STORE", string(CredentialStoreFile))
+
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "config.json"), []byte(content), 0644))
+	return dir
+}
+
+func TestMigrateLegacyConfig(t *testing.T) {
+	dir := setupConfigDir(t, legacyConfig)
+
+	c, err := GetConfig()
+	require.Nil(t, err)
+
+	require.Equal(t, currentConfigVersion, c.Version)
+	require.NotEmpty(t, c.Id)
+	require.Equal(t, "default", c.ActiveProfileId)
+	require.Len(t, c.Profiles, 2)
+	require.Equal(t, "vscode", c.DefaultIdeId)
+
+	backup, err := os.ReadFile(filepath.Join(dir, "config.json.v0.bak"))
+	require.Nil(t, err)
+	require.Contains(t, string(backup), `"activeProfile": "removed"`)
+	require.NotContains(t, string(backup), `"key"`)
+
+	// The migrated file is stable across reads
+	again, err := GetConfig()
+	require.Nil(t, err)
+	require.Equal(t, c.Id, again.Id)
+}
+
+func TestApiKeysMovedToCredentialStore(t *testing.T) {
+	dir := setupConfigDir(t, legacyConfig)
+
+	c, err := GetConfig()
+	require.Nil(t, err)
+
+	profile, err := c.GetProfile("remote")
+	require.Nil(t, err)
+	require.Equal(t, "key", profile.Api.Key)
+
+	content, err := os.ReadFile(filepath.Join(dir, "config.json"))
+	require.Nil(t, err)
+	require.NotContains(t, string(content), `"key"`)
+
+	store, err := GetCredentialStore()
+	require.Nil(t, err)
+
+	key, err := store.Get(apiKeyCredential(c, "remote"))
+	require.Nil(t, err)
+	require.Equal(t, "key", key)
+
+	info, err := os.Stat(filepath.Join(dir, "credentials.json"))
+	require.Nil(t, err)
+	if runtime.GOOS != "windows" {
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+
+	profile.Api.Key = "rotated"
+	require.Nil(t, c.EditProfile(profile))
+
+	again, err := GetConfig()
+	require.Nil(t, err)
+	profile, err = again.GetProfile("remote")
+	require.Nil(t, err)
+	require.Equal(t, "rotated", profile.Api.Key)
+
+	require.Nil(t, again.RemoveProfile("remote"))
+	_, err = store.Get(apiKeyCredential(c, "remote"))
+	require.ErrorIs(t, err, ErrCredentialNotFound)
+}
+
+func TestInvalidCredentialStore(t *testing.T) {
+	setupConfigDir(t, legacyConfig)
+	t.Setenv("DAYTONA_CREDENTIAL_STORE", "vault")
+
+	_, err := GetConfig()
+	require.ErrorContains(t, err, "invalid credential store")
+}
+
+func TestRejectNewerConfig(t *testing.T) {
+	setupConfigDir(t, fmt.Sprintf(`{"version": %d, "id": "id"}`, currentConfigVersion+1))
+
+	_, err := GetConfig()
+	require.ErrorContains(t, err, "newer version of Daytona")
+}
+
+func TestConcurrentProfileUpdates(t *testing.T) {
+	dir := setupConfigDir(t, legacyConfig)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			c, err := GetConfig()
+			if !assert.Nil(t, err) {
+				return
+			}
+
+			assert.Nil(t, c.AddProfile(Profile{
+				Id:   fmt.Sprintf("profile-%d", i),
+				Name: fmt.Sprintf("profile-%d", i),
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	content, err := os.ReadFile(filepath.Join(dir, "config.json"))
+	require.Nil(t, err)
+
+	var c Config
+	require.Nil(t, json.Unmarshal(content, &c))
+	require.Len(t, c.Profiles, 22)
+}
@@ -0,0 +1,283 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Service name under which the credentials are stored in the OS keychain
+const credentialService = "daytona"
+
+var ErrCredentialNotFound = errors.New("credential not found")
+
+// CredentialStore keeps the secrets of the CLI, e.g. the API keys of profiles, outside of the config file
+type CredentialStore interface {
+	Get(key string) (string, error)
+	Set(key, secret string) error
+	Delete(key string) error
+}
+
+type CredentialStoreType string
+
+const (
+	// CredentialStoreKeychain uses the OS keychain: Keychain on macOS, the Secret Service (libsecret) on Linux and
+	// the Credential Manager on Windows. Credentials are stored in the file if the keychain is not available
+	CredentialStoreKeychain CredentialStoreType = "keychain"
+	// CredentialStoreFile stores the credentials in a file in the config directory that only the user can read
+	CredentialStoreFile CredentialStoreType = "file"
+)
+
+// GetCredentialStore returns the store selected with DAYTONA_CREDENTIAL_STORE. Defaults to the keychain
+func GetCredentialStore() (CredentialStore, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	file := &fileCredentialStore{path: filepath.Join(configDir, "credentials.json")}
+
+	storeType := CredentialStoreType(os.Getenv("DAYTONA_CREDENTIAL_STORE"))
+	switch storeType {
+	case CredentialStoreFile:
+		return file, nil
+	case CredentialStoreKeychain, "":
+		if !keychainAvailable() {
+			return file, nil
+		}
+		return &fallbackCredentialStore{primary: keychainCredentialStore{}, fallback: file}, nil
+	default:
+		return nil, fmt.Errorf("invalid credential store %q: must be %s or %s", storeType, CredentialStoreKeychain, CredentialStoreFile)
+	}
+}
+
+// fallbackCredentialStore stores credentials in the fallback store if storing them in the primary store fails,
+// e.g. if the keychain is locked
+type fallbackCredentialStore struct {
+	primary  CredentialStore
+	fallback CredentialStore
+}
+
+func (s *fallbackCredentialStore) Get(key string) (string, error) {
+	secret, err := s.primary.Get(key)
+	if err == nil {
+		return secret, nil
+	}
+	if !errors.Is(err, ErrCredentialNotFound) {
+		log.Debugf("Failed to read credential from the keychain: %v", err)
+	}
+
+	return s.fallback.Get(key)
+}
+
+func (s *fallbackCredentialStore) Set(key, secret string) error {
+	err := s.primary.Set(key, secret)
+	if err != nil {
+		log.Debugf("Failed to store credential in the keychain, storing it in the credentials file: %v", err)
+		return s.fallback.Set(key, secret)
+	}
+
+	return s.fallback.Delete(key)
+}
+
+func (s *fallbackCredentialStore) Delete(key string) error {
+	return errors.Join(s.primary.Delete(key), s.fallback.Delete(key))
+}
+
+// fileCredentialStore keeps the credentials in a JSON file readable only by the user
+type fileCredentialStore struct {
+	path  string
+	mutex sync.Mutex
+}
+
+func (s *fileCredentialStore) Get(key string) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	credentials, err := s.read()
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := credentials[key]
+	if !ok {
+		return "", ErrCredentialNotFound
+	}
+
+	return secret, nil
+}
+
+func (s *fileCredentialStore) Set(key, secret string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	credentials, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if credentials[key] == secret {
+		return nil
+	}
+
+	credentials[key] = secret
+	return s.write(credentials)
+}
+
+func (s *fileCredentialStore) Delete(key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	credentials, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := credentials[key]; !ok {
+		return nil
+	}
+
+	delete(credentials, key)
+	return s.write(credentials)
+}
+
+func (s *fileCredentialStore) read() (map[string]string, error) {
+	credentials := map[string]string{}
+
+	content, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return credentials, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &credentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", s.path, err)
+	}
+
+	return credentials, nil
+}
+
+func (s *fileCredentialStore) write(credentials map[string]string) error {
+	err := os.MkdirAll(filepath.Dir(s.path), 0755)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(credentials, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(s.path), "credentials.json.tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(content)
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	// CreateTemp creates the file readable only by the user
+	return os.Rename(tmpFile.Name(), s.path)
+}
+
+// apiKeyCredential returns the key under which the API key of the profile is stored
+func apiKeyCredential(c *Config, profileId string) string {
+	return fmt.Sprintf("%s/%s", c.Id, profileId)
+}
+
+// loadApiKeys reads the API keys of the profiles from the credential store. Keys that are still in the config
+// file, e.g. because it was edited by hand, take precedence and are moved to the store on the next write
+func loadApiKeys(c *Config) error {
+	store, err := GetCredentialStore()
+	if err != nil {
+		return err
+	}
+
+	for i, profile := range c.Profiles {
+		if profile.Api.Key != "" {
+			continue
+		}
+
+		key, err := store.Get(apiKeyCredential(c, profile.Id))
+		if err != nil {
+			if errors.Is(err, ErrCredentialNotFound) {
+				continue
+			}
+			return fmt.Errorf("failed to read the API key of profile %s: %w", profile.Name, err)
+		}
+
+		c.Profiles[i].Api.Key = key
+	}
+
+	return nil
+}
+
+func storeApiKeys(c *Config) error {
+	store, err := GetCredentialStore()
+	if err != nil {
+		return err
+	}
+
+	for _, profile := range c.Profiles {
+		if profile.Api.Key == "" {
+			continue
+		}
+
+		current, err := store.Get(apiKeyCredential(c, profile.Id))
+		if err == nil && current == profile.Api.Key {
+			continue
+		}
+
+		err = store.Set(apiKeyCredential(c, profile.Id), profile.Api.Key)
+		if err != nil {
+			return fmt.Errorf("failed to store the API key of profile %s: %w", profile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteApiKey(c *Config, profileId string) error {
+	store, err := GetCredentialStore()
+	if err != nil {
+		return err
+	}
+
+	return store.Delete(apiKeyCredential(c, profileId))
+}
+
+// redactApiKeys removes the API keys of the profiles from raw config file content
+func redactApiKeys(content []byte) ([]byte, error) {
+	raw := map[string]interface{}{}
+	err := json.Unmarshal(content, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, _ := raw["profiles"].([]interface{})
+	for _, p := range profiles {
+		profile, _ := p.(map[string]interface{})
+		api, _ := profile["api"].(map[string]interface{})
+		delete(api, "key")
+	}
+
+	return json.MarshalIndent(raw, "", "  ")
+}
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// Exit code of the security tool if the item is not in the keychain
+const securityItemNotFound = 44
+
+// keychainCredentialStore uses the login keychain through the security tool
+type keychainCredentialStore struct{}
+
+func keychainAvailable() bool {
+	_, err := exec.LookPath("security")
+	return err == nil
+}
+
+func (keychainCredentialStore) Get(key string) (string, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", credentialService, "-a", key, "-w").Output()
+	if err != nil {
+		return "", securityError(err)
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func (keychainCredentialStore) Set(key, secret string) error {
+	// The command is passed on stdin so that the secret does not show up in the process list
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader(fmt.Sprintf("add-generic-password -U -s %s -a %s -X %s\n", credentialService, key, hex.EncodeToString([]byte(secret))))
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", securityError(err), strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func (keychainCredentialStore) Delete(key string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", credentialService, "-a", key).Run()
+	if err != nil && !errors.Is(securityError(err), ErrCredentialNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func securityError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == securityItemNotFound {
+		return ErrCredentialNotFound
+	}
+
+	return err
+}
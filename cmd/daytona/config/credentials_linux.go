@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// keychainCredentialStore uses the Secret Service of the desktop session through the secret-tool of libsecret
+type keychainCredentialStore struct{}
+
+func keychainAvailable() bool {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+
+	_, err := exec.LookPath("secret-tool")
+	return err == nil
+}
+
+func (keychainCredentialStore) Get(key string) (string, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("secret-tool", "lookup", "service", credentialService, "account", key)
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		// secret-tool exits with 1 without output if the secret does not exist
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(out) == 0 && stderr.Len() == 0 {
+			return "", ErrCredentialNotFound
+		}
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return string(out), nil
+}
+
+func (keychainCredentialStore) Set(key, secret string) error {
+	cmd := exec.Command("secret-tool", "store", "--label", fmt.Sprintf("Daytona %s", key), "service", credentialService, "account", key)
+	cmd.Stdin = strings.NewReader(secret)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+func (keychainCredentialStore) Delete(key string) error {
+	out, err := exec.Command("secret-tool", "clear", "service", credentialService, "account", key).CombinedOutput()
+	if err != nil && len(out) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
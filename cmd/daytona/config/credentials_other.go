@@ -0,0 +1,28 @@
+//go:build !darwin && !linux && !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "errors"
+
+var errKeychainUnsupported = errors.New("the keychain is not supported on this platform")
+
+type keychainCredentialStore struct{}
+
+func keychainAvailable() bool {
+	return false
+}
+
+func (keychainCredentialStore) Get(key string) (string, error) {
+	return "", errKeychainUnsupported
+}
+
+func (keychainCredentialStore) Set(key, secret string) error {
+	return errKeychainUnsupported
+}
+
+func (keychainCredentialStore) Delete(key string) error {
+	return errKeychainUnsupported
+}
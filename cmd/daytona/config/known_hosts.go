@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// getKnownHostsFile returns the path of the known_hosts file with the host keys of the projects, which is managed by
+// the CLI from the host keys the Daytona Server reports
+func getKnownHostsFile() string {
+	return filepath.Join(SshHomeDir, ".ssh", "daytona_known_hosts")
+}
+
+// SetKnownHostsEntry records the host key of the project with the hostname of its SSH config entry, replacing the key
+// recorded before. The entry is removed if publicKey is empty, so that the key the project presents is accepted
+func SetKnownHostsEntry(profileId, workspaceId, projectName, publicKey string) error {
+	hostname := GetProjectHostname(profileId, workspaceId, projectName)
+
+	entry := ""
+	if publicKey != "" {
+		entry = hostname + " " + publicKey
+	}
+
+	return updateKnownHosts(hostname, entry)
+}
+
+func RemoveKnownHostsEntry(profileId, workspaceId, projectName string) error {
+	return updateKnownHosts(GetProjectHostname(profileId, workspaceId, projectName), "")
+}
+
+// updateKnownHosts replaces the entries of the hostname with the entry, or removes them if entry is empty
+func updateKnownHosts(hostname, entry string) error {
+	unlock, err := lockConfig()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	knownHostsPath := getKnownHostsFile()
+
+	content, err := os.ReadFile(knownHostsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	lines := []string{}
+	replaced := entry == ""
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if fields[0] == hostname {
+			if !replaced {
+				lines = append(lines, entry)
+				replaced = true
+			}
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	if !replaced {
+		lines = append(lines, entry)
+	}
+
+	newContent := ""
+	if len(lines) > 0 {
+		newContent = strings.Join(lines, "\n") + "\n"
+	}
+
+	// Avoid rewriting the file on every connection
+	if newContent == string(content) {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(knownHostsPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	// ssh may be reading the file while it is replaced
+	tmpPath := knownHostsPath + ".tmp"
+	err = os.WriteFile(tmpPath, []byte(newContent), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, knownHostsPath)
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetKnownHostsEntry(t *testing.T) {
+	setupConfigDir(t, legacyConfig)
+
+	sshHomeDir := SshHomeDir
+	SshHomeDir = t.TempDir()
+	defer func() { SshHomeDir = sshHomeDir }()
+
+	require.Nil(t, SetKnownHostsEntry("default", "ws1", "api", "ssh-ed25519 AAAA1"))
+	require.Nil(t, SetKnownHostsEntry("default", "ws1", "web", "ssh-ed25519 AAAA1"))
+
+	// A rebuilt workspace keeps its key, a replaced key overwrites the entry in place
+	require.Nil(t, SetKnownHostsEntry("default", "ws1", "api", "ssh-ed25519 AAAA2"))
+
+	content, err := os.ReadFile(getKnownHostsFile())
+	require.Nil(t, err)
+	require.Equal(t, "default-ws1-api ssh-ed25519 AAAA2\ndefault-ws1-web ssh-ed25519 AAAA1\n", string(content))
+
+	// Projects without a managed host key are trusted on first use
+	require.Nil(t, SetKnownHostsEntry("default", "ws1", "api", ""))
+	require.Nil(t, RemoveKnownHostsEntry("default", "ws1", "missing"))
+
+	content, err = os.ReadFile(getKnownHostsFile())
+	require.Nil(t, err)
+	require.Equal(t, "default-ws1-web ssh-ed25519 AAAA1\n", string(content))
+}
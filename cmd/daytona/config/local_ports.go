@@ -0,0 +1,159 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/ports"
+)
+
+// Range of the local ports allocated when the declared port number is taken
+const (
+	firstEphemeralLocalPort uint16 = 50000
+	lastEphemeralLocalPort  uint16 = 60000
+)
+
+func localPortKey(workspaceId, projectName, portName string) string {
+	return fmt.Sprintf("%s/%s/%s", workspaceId, projectName, portName)
+}
+
+// LocalPortAllocation is a local port allocated to a port of a project
+type LocalPortAllocation struct {
+	WorkspaceId string `json:"workspaceId"`
+	ProjectName string `json:"projectName"`
+	PortName    string `json:"portName"`
+	LocalPort   uint16 `json:"localPort"`
+}
+
+// GetLocalPort returns the local port allocated to a port of a project and allocates one if there is none.
+// The port number is allocated if it is free, otherwise an alternate from the ephemeral range. Allocations are
+// persisted so that a port is always forwarded to the same local port
+func (c *Config) GetLocalPort(profileId, workspaceId, projectName, portName string, port uint16) (uint16, error) {
+	key := localPortKey(workspaceId, projectName, portName)
+
+	var localPort uint16
+
+	err := c.update(func(latest *Config) error {
+		allocated := map[uint16]bool{}
+		var profile *Profile
+
+		for i := range latest.Profiles {
+			if latest.Profiles[i].Id == profileId {
+				profile = &latest.Profiles[i]
+			}
+			for _, p := range latest.Profiles[i].LocalPorts {
+				allocated[p] = true
+			}
+		}
+
+		if profile == nil {
+			return fmt.Errorf("profile with id %s not found", profileId)
+		}
+
+		if p, ok := profile.LocalPorts[key]; ok {
+			localPort = p
+			return nil
+		}
+
+		localPort = port
+		if allocated[localPort] || !ports.IsPortAvailable(localPort) {
+			localPort = findAlternateLocalPort(key, allocated)
+		}
+
+		if localPort == 0 {
+			return fmt.Errorf("failed to allocate a local port for %s", key)
+		}
+
+		if profile.LocalPorts == nil {
+			profile.LocalPorts = map[string]uint16{}
+		}
+		profile.LocalPorts[key] = localPort
+
+		return nil
+	})
+
+	return localPort, err
+}
+
+// findAlternateLocalPort returns a free port of the ephemeral range. The search starts at an offset derived from the
+// key, so a port gets the same alternate on every machine and after its allocation is released, as long as it is free
+func findAlternateLocalPort(key string, allocated map[uint16]bool) uint16 {
+	size := uint32(lastEphemeralLocalPort - firstEphemeralLocalPort)
+
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	offset := hash.Sum32() % size
+
+	for i := uint32(0); i < size; i++ {
+		p := firstEphemeralLocalPort + uint16((offset+i)%size)
+		if !allocated[p] && ports.IsPortAvailable(p) {
+			return p
+		}
+	}
+
+	return 0
+}
+
+// FindLocalPort returns the local port allocated to a port of a project, 0 if none was allocated
+func (c *Config) FindLocalPort(profileId, workspaceId, projectName, portName string) uint16 {
+	profile, err := c.GetProfile(profileId)
+	if err != nil {
+		return 0
+	}
+
+	return profile.LocalPorts[localPortKey(workspaceId, projectName, portName)]
+}
+
+// ListLocalPorts returns the local ports allocated in the profile, sorted by workspace, project and port name
+func (c *Config) ListLocalPorts(profileId string) ([]LocalPortAllocation, error) {
+	profile, err := c.GetProfile(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	allocations := []LocalPortAllocation{}
+	for key, localPort := range profile.LocalPorts {
+		parts := strings.SplitN(key, "/", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		allocations = append(allocations, LocalPortAllocation{
+			WorkspaceId: parts[0],
+			ProjectName: parts[1],
+			PortName:    parts[2],
+			LocalPort:   localPort,
+		})
+	}
+
+	sort.Slice(allocations, func(i, j int) bool {
+		return localPortKey(allocations[i].WorkspaceId, allocations[i].ProjectName, allocations[i].PortName) <
+			localPortKey(allocations[j].WorkspaceId, allocations[j].ProjectName, allocations[j].PortName)
+	})
+
+	return allocations, nil
+}
+
+// RemoveLocalPorts releases the local ports allocated to the ports of a workspace
+func (c *Config) RemoveLocalPorts(profileId, workspaceId string) error {
+	return c.update(func(latest *Config) error {
+		for i := range latest.Profiles {
+			if latest.Profiles[i].Id != profileId {
+				continue
+			}
+
+			for key := range latest.Profiles[i].LocalPorts {
+				if strings.HasPrefix(key, workspaceId+"/") {
+					delete(latest.Profiles[i].LocalPorts, key)
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLocalPort(t *testing.T) {
+	setupConfigDir(t, legacyConfig)
+
+	// Occupy a port so that the project declaring it gets another local port
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer listener.Close()
+	takenPort := uint16(listener.Addr().(*net.TCPAddr).Port)
+
+	c, err := GetConfig()
+	require.Nil(t, err)
+
+	port, err := c.GetLocalPort("default", "ws1", "api", "web", takenPort)
+	require.Nil(t, err)
+	require.NotEqual(t, takenPort, port)
+
+	// Allocations are stable and not handed out twice, even across profiles
+	again, err := c.GetLocalPort("default", "ws1", "api", "web", takenPort)
+	require.Nil(t, err)
+	require.Equal(t, port, again)
+
+	other, err := c.GetLocalPort("remote", "ws2", "api", "web", port)
+	require.Nil(t, err)
+	require.NotEqual(t, port, other)
+
+	c, err = GetConfig()
+	require.Nil(t, err)
+	require.Equal(t, port, c.FindLocalPort("default", "ws1", "api", "web"))
+
+	allocations, err := c.ListLocalPorts("default")
+	require.Nil(t, err)
+	require.Equal(t, []LocalPortAllocation{{WorkspaceId: "ws1", ProjectName: "api", PortName: "web", LocalPort: port}}, allocations)
+
+	require.Nil(t, c.RemoveLocalPorts("default", "ws1"))
+	require.Zero(t, c.FindLocalPort("default", "ws1", "api", "web"))
+	require.Equal(t, other, c.FindLocalPort("remote", "ws2", "api", "web"))
+
+	// The alternate of a port is the same after its allocation is released
+	again, err = c.GetLocalPort("default", "ws1", "api", "web", takenPort)
+	require.Nil(t, err)
+	require.Equal(t, port, again)
+}
+
+func TestFindAlternateLocalPort(t *testing.T) {
+	port := findAlternateLocalPort("ws1/api/web", map[uint16]bool{})
+	require.GreaterOrEqual(t, port, firstEphemeralLocalPort)
+	require.Less(t, port, lastEphemeralLocalPort)
+	require.Equal(t, port, findAlternateLocalPort("ws1/api/web", map[uint16]bool{}))
+
+	// Ports allocated to other ports are skipped
+	require.NotEqual(t, port, findAlternateLocalPort("ws1/api/web", map[uint16]bool{port: true}))
+}
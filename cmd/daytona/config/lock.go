@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var ErrConfigLocked = errors.New("timed out waiting for another daytona process to release the config file")
+
+var errLockHeld = errors.New("lock held by another process")
+
+const (
+	lockTimeout       = 10 * time.Second
+	lockRetryInterval = 25 * time.Millisecond
+)
+
+// lockConfig acquires an exclusive lock shared by all daytona processes of
+// the current user. The returned function releases it.
+func lockConfig() (func(), error) {
+	configFilePath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(configFilePath), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	lockFile, err := os.OpenFile(configFilePath+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		err = tryLock(lockFile)
+		if err == nil {
+			return func() {
+				_ = unlock(lockFile)
+				lockFile.Close()
+			}, nil
+		}
+
+		if !errors.Is(err, errLockHeld) {
+			lockFile.Close()
+			return nil, err
+		}
+
+		if time.Now().After(deadline) {
+			lockFile.Close()
+			return nil, ErrConfigLocked
+		}
+
+		time.Sleep(lockRetryInterval)
+	}
+}
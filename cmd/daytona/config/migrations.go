@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// currentConfigVersion is the schema version written by this build. Bump it
+// together with a new entry in migrations when the config format changes.
+const currentConfigVersion = 2
+
+// migrations[i] upgrades a raw config from version i to version i+1. They
+// operate on the decoded JSON so fields can be renamed or restructured.
+var migrations = []func(raw map[string]interface{}) error{
+	migrateToV1,
+	migrateToV2,
+}
+
+// migrateToV1 upgrades configs written before the schema was versioned.
+// Those could be missing an id or point to a profile that no longer exists.
+func migrateToV1(raw map[string]interface{}) error {
+	if id, _ := raw["id"].(string); id == "" {
+		raw["id"] = uuid.NewString()
+	}
+
+	profiles, _ := raw["profiles"].([]interface{})
+	if profiles == nil {
+		raw["profiles"] = []interface{}{}
+		return nil
+	}
+
+	activeProfileId, _ := raw["activeProfile"].(string)
+	fallbackId := ""
+	for _, p := range profiles {
+		profile, ok := p.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid profile entry: %v", p)
+		}
+
+		id, _ := profile["id"].(string)
+		if id == activeProfileId {
+			return nil
+		}
+		if fallbackId == "" || id == "default" {
+			fallbackId = id
+		}
+	}
+
+	raw["activeProfile"] = fallbackId
+	return nil
+}
+
+// migrateToV2 moves the API keys of profiles out of the config file. The raw
+// config is left as is, the keys are written to the credential store instead
+// of the file when the migrated config is written.
+func migrateToV2(raw map[string]interface{}) error {
+	return nil
+}
+
+// migrate upgrades config file content to currentConfigVersion. It returns
+// the version the content was written with.
+func migrate(content []byte) ([]byte, int, error) {
+	raw := map[string]interface{}{}
+	err := json.Unmarshal(content, &raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	version := 0
+	if v, ok := raw["version"].(float64); ok {
+		version = int(v)
+	}
+
+	if version > currentConfigVersion {
+		return nil, version, fmt.Errorf("the config file was written by a newer version of Daytona (schema version %d), please upgrade", version)
+	}
+
+	if version == currentConfigVersion {
+		return content, version, nil
+	}
+
+	for v := version; v < currentConfigVersion; v++ {
+		err = migrations[v](raw)
+		if err != nil {
+			return nil, version, fmt.Errorf("failed to migrate config to version %d: %w", v+1, err)
+		}
+		raw["version"] = v + 1
+	}
+
+	migrated, err := json.Marshal(raw)
+	if err != nil {
+		return nil, version, err
+	}
+
+	return migrated, version, nil
+}
@@ -119,8 +119,9 @@ func generateSshConfigEntry(profileId, workspaceId, projectName, knownHostsPath
 
 	config := fmt.Sprintf("Host %s\n"+
 		tab+"User daytona\n"+
-		tab+"StrictHostKeyChecking no\n"+
-		tab+"UserKnownHostsFile %s\n"+
+		tab+"StrictHostKeyChecking accept-new\n"+
+		tab+"UserKnownHostsFile \"%s\"\n"+
+		tab+"HashKnownHosts no\n"+
 		tab+"ProxyCommand \"%s\" ssh-proxy %s %s %s\n"+
 		tab+"ForwardAgent yes\n", projectHostname, knownHostsPath, daytonaPath, profileId, workspaceId, projectName)
 
@@ -220,14 +221,13 @@ func regenerateProxyCommand(existingContent, profileId, workspaceId, projectName
 	re := regexp.MustCompile(`(?m)^\s*ProxyCommand\s+.*$`)
 	updatedContent := re.ReplaceAllString(matchedEntry, fmt.Sprintf("\tProxyCommand \"%s\" ssh-proxy %s %s %s", daytonaPath, profileId, workspaceId, projectName))
 
-	return updatedContent, nil
-}
+	// Entries generated before the host keys of projects were verified disable host key checking
+	re = regexp.MustCompile(`(?m)^\s*StrictHostKeyChecking\s+no\s*$`)
+	updatedContent = re.ReplaceAllString(updatedContent, "\tStrictHostKeyChecking accept-new")
+	re = regexp.MustCompile(`(?m)^\s*UserKnownHostsFile\s+(/dev/null|NUL)\s*$`)
+	updatedContent = re.ReplaceAllString(updatedContent, fmt.Sprintf("\tUserKnownHostsFile \"%s\"\n\tHashKnownHosts no", getKnownHostsFile()))
 
-func getKnownHostsFile() string {
-	if runtime.GOOS == "windows" {
-		return "NUL"
-	}
-	return "/dev/null"
+	return updatedContent, nil
 }
 
 func appendSshConfigEntry(configPath, profileId, workspaceId, projectName, knownHostsFile string, gpgForward bool, existingContent string) (string, error) {
@@ -331,6 +331,11 @@ func RemoveWorkspaceSshEntries(profileId, workspaceId, projectName string) error
 		return err
 	}
 
+	err = RemoveKnownHostsEntry(profileId, workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
 	hostLine := fmt.Sprintf("Host %s", GetProjectHostname(profileId, workspaceId, projectName))
 	regex := regexp.MustCompile(fmt.Sprintf(`%s\s*\n(?:\t.*\n?)*`, hostLine))
 	contentToDelete := regex.FindString(existingContent)
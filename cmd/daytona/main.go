@@ -13,6 +13,8 @@ import (
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/cmd"
 	"github.com/daytonaio/daytona/pkg/cmd/workspacemode"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/rs/zerolog"
 	zlog "github.com/rs/zerolog/log"
 	log "github.com/sirupsen/logrus"
@@ -22,17 +24,23 @@ func main() {
 	if internal.WorkspaceMode() {
 		err := workspacemode.Execute()
 		if err != nil {
-			log.Fatal(err)
+			exitWithError(err)
 		}
 		return
 	}
 
 	err := cmd.Execute()
 	if err != nil {
-		log.Fatal(err)
+		exitWithError(err)
 	}
 }
 
+// exitWithError exits with the code of the error category so that scripts can tell failures apart
+func exitWithError(err error) {
+	views.RenderError(err)
+	os.Exit(common.GetExitCode(err))
+}
+
 func init() {
 	logLevel := log.WarnLevel
 
@@ -244,6 +244,13 @@ chmod +x "$temp_file"
 echo "Installing server to $DESTINATION"
 mv "$temp_file" "$DESTINATION/daytona"
 
+# Register the daytona:// protocol handler so workspace links open in the configured IDE
+if [[ -n "$SUDO_USER" ]]; then
+  sudo -u "$SUDO_USER" "$DESTINATION/daytona" url-handler register >/dev/null 2>&1 || true
+else
+  "$DESTINATION/daytona" url-handler register >/dev/null 2>&1 || true
+fi
+
 # Check if destination is in user's PATH
 if [[ ! :"$PATH:" == *":$DESTINATION:"* ]]; then
   echo -e "\nWarning: $DESTINATION is not currently in your PATH environment variable."
@@ -4,6 +4,7 @@
 package tailscale
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -12,7 +13,6 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
-	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/tailscale"
 	"github.com/google/uuid"
 	"tailscale.com/tsnet"
@@ -31,12 +31,14 @@ func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 		return nil, err
 	}
 
-	serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+	ctx := context.Background()
+
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	networkKey, res, err := apiClient.ServerAPI.GenerateNetworkKeyExecute(apiclient.ApiGenerateNetworkKeyRequest{})
+	networkKey, res, err := apiClient.ServerAPI.GenerateNetworkKey(ctx).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -0,0 +1,244 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"tailscale.com/ipn/ipnstate"
+	"tailscale.com/tailcfg"
+	"tailscale.com/tsnet"
+)
+
+const (
+	pingTimeout  = 2 * time.Second
+	pingInterval = 200 * time.Millisecond
+
+	highLatencyThreshold   = 150 * time.Millisecond
+	lowThroughputThreshold = 1024 * 1024
+)
+
+type NetworkTestOptions struct {
+	Pings int
+	// Bytes transferred in each direction of the throughput test. The throughput test is skipped if 0
+	Size int64
+}
+
+type NetworkTestResult struct {
+	Pings      int           `json:"pings"`
+	LostPings  int           `json:"lostPings"`
+	MinLatency time.Duration `json:"minLatency"`
+	AvgLatency time.Duration `json:"avgLatency"`
+	MaxLatency time.Duration `json:"maxLatency"`
+	// Set if the last ping used a direct UDP connection
+	Endpoint string `json:"endpoint,omitempty"`
+	// Set if the last ping was relayed through a DERP server
+	DerpRegion string `json:"derpRegion,omitempty"`
+	// Throughput in bytes per second
+	Download float64  `json:"download,omitempty"`
+	Upload   float64  `json:"upload,omitempty"`
+	Hints    []string `json:"hints"`
+}
+
+func (r *NetworkTestResult) Direct() bool {
+	return r.Endpoint != ""
+}
+
+// TestNetwork measures the latency, connection path and throughput between the client and the agent of a project
+func TestNetwork(ctx context.Context, profile config.Profile, workspaceId, projectName string, opts NetworkTestOptions) (*NetworkTestResult, error) {
+	tsConn, err := GetConnection(&profile)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := apiclient_util.GetTailnetProject(workspaceId, projectName, &profile)
+	if err != nil {
+		return nil, err
+	}
+
+	peer, err := findPeer(ctx, tsConn, p.GetHostname())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := pingPeer(ctx, tsConn, peer, opts.Pings)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Size > 0 {
+		toolboxAddress, err := p.GetTailnetAddress(toolbox_config.TOOLBOX_PORT)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Download, result.Upload, err = measureThroughput(ctx, tsConn, toolboxAddress, opts.Size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result.Hints = result.GetHints()
+
+	return result, nil
+}
+
+// GetHints explains results that are likely to make port forwarding and interactive sessions slow
+func (r *NetworkTestResult) GetHints() []string {
+	hints := []string{}
+
+	if r.LostPings == r.Pings {
+		return append(hints, "The project agent did not respond to any ping. Make sure the project is running and its agent is connected to the Daytona Server")
+	}
+
+	if !r.Direct() && r.DerpRegion != "" {
+		hints = append(hints, fmt.Sprintf("Traffic is relayed through the DERP server in region %s because a direct connection could not be established. UDP traffic is likely blocked by a firewall or a restrictive NAT on the client or the workspace side", r.DerpRegion))
+	}
+
+	if r.LostPings > 0 {
+		hints = append(hints, fmt.Sprintf("%d of %d pings were lost, the connection is unstable", r.LostPings, r.Pings))
+	}
+
+	if r.AvgLatency > highLatencyThreshold {
+		hints = append(hints, fmt.Sprintf("Average latency is above %s, interactive sessions will feel slow. Consider creating workspaces on a target closer to you", highLatencyThreshold))
+	}
+
+	if (r.Download > 0 && r.Download < lowThroughputThreshold) || (r.Upload > 0 && r.Upload < lowThroughputThreshold) {
+		hints = append(hints, "Throughput is below 1 MB/s, large file transfers and forwarded web applications will be slow")
+	}
+
+	return hints
+}
+
+func findPeer(ctx context.Context, tsConn *tsnet.Server, hostname string) (*ipnstate.PeerStatus, error) {
+	localClient, err := tsConn.LocalClient()
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := localClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, peer := range status.Peer {
+		if peer.HostName == hostname && len(peer.TailscaleIPs) > 0 {
+			return peer, nil
+		}
+	}
+
+	return nil, fmt.Errorf("project %s is not connected to the Daytona network", hostname)
+}
+
+func pingPeer(ctx context.Context, tsConn *tsnet.Server, peer *ipnstate.PeerStatus, count int) (*NetworkTestResult, error) {
+	localClient, err := tsConn.LocalClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &NetworkTestResult{Pings: count}
+	var total time.Duration
+
+	for i := 0; i < count; i++ {
+		if i > 0 {
+			time.Sleep(pingInterval)
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, pingTimeout)
+		// Disco pings report whether the path is direct or relayed
+		pong, err := localClient.Ping(pingCtx, peer.TailscaleIPs[0], tailcfg.PingDisco)
+		cancel()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil || pong.Err != "" {
+			result.LostPings++
+			continue
+		}
+
+		latency := time.Duration(pong.LatencySeconds * float64(time.Second))
+		total += latency
+		if result.MinLatency == 0 || latency < result.MinLatency {
+			result.MinLatency = latency
+		}
+		result.MaxLatency = max(result.MaxLatency, latency)
+
+		// The first pings are usually relayed until a direct connection is established
+		result.Endpoint = pong.Endpoint
+		result.DerpRegion = pong.DERPRegionCode
+	}
+
+	if received := count - result.LostPings; received > 0 {
+		result.AvgLatency = total / time.Duration(received)
+	}
+
+	return result, nil
+}
+
+func measureThroughput(ctx context.Context, tsConn *tsnet.Server, toolboxAddress string, size int64) (float64, float64, error) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return tsConn.Dial(ctx, network, addr)
+			},
+		},
+	}
+
+	baseUrl := fmt.Sprintf("http://%s/network", toolboxAddress)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/download?size=%d", baseUrl, size), nil)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start := time.Now()
+	res, err := client.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to reach the project toolbox: %w", err)
+	}
+	received, err := io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+	if err != nil {
+		return 0, 0, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("download test failed with status %d", res.StatusCode)
+	}
+	download := float64(received) / time.Since(start).Seconds()
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodPost, baseUrl+"/upload", bytes.NewReader(make([]byte, size)))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start = time.Now()
+	res, err = client.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to reach the project toolbox: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("upload test failed with status %d", res.StatusCode)
+	}
+
+	var uploadResponse dto.NetworkTestUploadResponse
+	err = json.NewDecoder(res.Body).Decode(&uploadResponse)
+	if err != nil {
+		return 0, 0, err
+	}
+	upload := float64(uploadResponse.Bytes) / time.Since(start).Seconds()
+
+	return download, upload, nil
+}
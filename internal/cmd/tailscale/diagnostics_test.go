@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkTestResultHints(t *testing.T) {
+	direct := &NetworkTestResult{
+		Pings:      10,
+		AvgLatency: 20 * time.Millisecond,
+		Endpoint:   "203.0.113.10:41641",
+		Download:   50 * 1024 * 1024,
+		Upload:     20 * 1024 * 1024,
+	}
+	require.Empty(t, direct.GetHints())
+
+	relayed := &NetworkTestResult{
+		Pings:      10,
+		LostPings:  2,
+		AvgLatency: 300 * time.Millisecond,
+		DerpRegion: "fra",
+		Download:   512 * 1024,
+	}
+	hints := relayed.GetHints()
+	require.Len(t, hints, 4)
+	require.Contains(t, hints[0], "DERP server in region fra")
+	require.Contains(t, hints[0], "UDP")
+
+	unreachable := &NetworkTestResult{Pings: 5, LostPings: 5}
+	require.Len(t, unreachable.GetHints(), 1)
+}
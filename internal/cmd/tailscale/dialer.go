@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// tailnetDialTimeout bounds the attempt to reach a tunneled project over the tailnet before its tunnel is used
+const tailnetDialTimeout = 5 * time.Second
+
+// GetProjectDialer returns a function that connects to ports of the project over the tailnet or, for projects with
+// agentless networking, through a WebSocket tunnel opened by the Daytona Server. Projects whose agents report that
+// they can't reach the tailnet are only reached through the Daytona Server if the tailnet fails
+func GetProjectDialer(workspace *apiclient.WorkspaceDTO, projectName string, profile *config.Profile) (func(ctx context.Context, port uint16) (net.Conn, error), error) {
+	forward := func(ctx context.Context, port uint16) (net.Conn, error) {
+		ws, res, err := apiclient_util.GetWebsocketConn(ctx, fmt.Sprintf("/workspace/%s/%s/forward/%d", workspace.Id, projectName, port), profile, nil)
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return util.NewWebsocketNetConn(ws), nil
+	}
+
+	tailnetProject := &project.Project{Name: projectName, WorkspaceId: workspace.Id}
+	for _, p := range workspace.Projects {
+		if p.Name == projectName {
+			tailnetProject = conversion.ToProject(&p)
+		}
+	}
+
+	if tailnetProject.Networking == project.NetworkingAgentless {
+		return forward, nil
+	}
+
+	tunneled := IsProjectTunneled(workspace, projectName)
+
+	tsConn, err := GetConnection(profile)
+	if err != nil {
+		if tunneled {
+			return forward, nil
+		}
+		return nil, err
+	}
+
+	dialTailnet := func(ctx context.Context, port uint16) (net.Conn, error) {
+		address, err := tailnetProject.GetTailnetAddress(port)
+		if err != nil {
+			return nil, err
+		}
+
+		return tsConn.Dial(ctx, "tcp", address)
+	}
+
+	if !tunneled {
+		return dialTailnet, nil
+	}
+
+	return func(ctx context.Context, port uint16) (net.Conn, error) {
+		tailnetCtx, cancel := context.WithTimeout(ctx, tailnetDialTimeout)
+		defer cancel()
+
+		conn, err := dialTailnet(tailnetCtx, port)
+		if err == nil {
+			return conn, nil
+		}
+		log.Debugf("failed to reach project %s over the tailnet, using its tunnel: %v", projectName, err)
+
+		return forward(ctx, port)
+	}, nil
+}
+
+// IsProjectTunneled returns true if the project is reached through the Daytona Server instead of the tailnet, because
+// it uses agentless networking or its agent can't reach the tailnet
+func IsProjectTunneled(workspace *apiclient.WorkspaceDTO, projectName string) bool {
+	for _, p := range workspace.Projects {
+		if p.Name == projectName {
+			state := p.GetState()
+			return p.GetNetworking() == apiclient.NetworkingAgentless || state.GetTunneled()
+		}
+	}
+
+	return false
+}
@@ -10,29 +10,48 @@ import (
 	"net"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/ports"
-	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"tailscale.com/tsnet"
 )
 
 func ForwardPort(workspaceId, projectName string, targetPort uint16, profile config.Profile) (*uint16, chan error) {
 	hostPort := targetPort
-	errChan := make(chan error)
-	var err error
 	if !ports.IsPortAvailable(targetPort) {
+		var err error
 		hostPort, err = ports.GetAvailableEphemeralPort()
 		if err != nil {
+			errChan := make(chan error, 1)
 			errChan <- err
 			return nil, errChan
 		}
 	}
 
+	return ForwardPortTo(workspaceId, projectName, targetPort, hostPort, profile)
+}
+
+// ForwardPortTo forwards the port of the project to the given local port
+func ForwardPortTo(workspaceId, projectName string, targetPort, hostPort uint16, profile config.Profile) (*uint16, chan error) {
+	errChan := make(chan error, 1)
+
 	tsConn, err := GetConnection(&profile)
 	if err != nil {
 		errChan <- err
 		return nil, errChan
 	}
 
+	p, err := apiclient_util.GetTailnetProject(workspaceId, projectName, &profile)
+	if err != nil {
+		errChan <- err
+		return nil, errChan
+	}
+
+	targetUrl, err := p.GetTailnetAddress(targetPort)
+	if err != nil {
+		errChan <- err
+		return nil, errChan
+	}
+
 	netListener, err := net.Listen("tcp", fmt.Sprintf(":%d", hostPort))
 	if err != nil {
 		errChan <- err
@@ -47,8 +66,6 @@ func ForwardPort(workspaceId, projectName string, targetPort uint16, profile con
 				return
 			}
 
-			targetUrl := fmt.Sprintf("%s:%d", project.GetProjectHostname(workspaceId, projectName), targetPort)
-
 			go handlePortConnection(conn, tsConn, targetUrl, errChan)
 		}
 	}()
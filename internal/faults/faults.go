@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Fault injection is meant for testing how clients handle slow and failing servers, providers and networks.
+// It is disabled unless DAYTONA_FAULT_INJECTION is set to true. Each layer is then configured with
+// DAYTONA_FAULT_<LAYER>_DELAY (e.g. 500ms) and DAYTONA_FAULT_<LAYER>_ERROR_RATE (between 0 and 1).
+const FAULT_INJECTION_ENV_VAR = "DAYTONA_FAULT_INJECTION"
+
+type Layer string
+
+const (
+	// Requests handled by the API server fail with a 500 status code
+	LayerApi Layer = "API"
+	// Requests sent by API clients are dropped before reaching the server
+	LayerClient Layer = "CLIENT"
+	// Calls to provider plugins are dropped before reaching the provider
+	LayerProvider Layer = "PROVIDER"
+)
+
+var ErrInjectedFault = errors.New("injected fault")
+
+func IsInjectedFault(err error) bool {
+	return err.Error() == ErrInjectedFault.Error()
+}
+
+type Injector struct {
+	Delay     time.Duration
+	ErrorRate float64
+}
+
+var (
+	injectors      = map[Layer]*Injector{}
+	injectorsMutex sync.Mutex
+)
+
+// GetInjector returns the injector of the layer. It returns nil if fault injection is disabled or
+// no faults are configured for the layer.
+func GetInjector(layer Layer) *Injector {
+	injectorsMutex.Lock()
+	defer injectorsMutex.Unlock()
+
+	injector, ok := injectors[layer]
+	if ok {
+		return injector
+	}
+
+	injector, err := NewInjectorFromEnv(layer)
+	if err != nil {
+		log.Errorf("fault injection for %s disabled: %v", layer, err)
+	} else if injector != nil {
+		log.Warnf("Fault injection enabled for %s: delay %s, error rate %.2f", layer, injector.Delay, injector.ErrorRate)
+	}
+
+	injectors[layer] = injector
+	return injector
+}
+
+func NewInjectorFromEnv(layer Layer) (*Injector, error) {
+	enabled, _ := strconv.ParseBool(os.Getenv(FAULT_INJECTION_ENV_VAR))
+	if !enabled {
+		return nil, nil
+	}
+
+	injector := &Injector{}
+
+	delayEnvVar := fmt.Sprintf("DAYTONA_FAULT_%s_DELAY", layer)
+	if delay := os.Getenv(delayEnvVar); delay != "" {
+		d, err := time.ParseDuration(delay)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid %s value %q", delayEnvVar, delay)
+		}
+		injector.Delay = d
+	}
+
+	errorRateEnvVar := fmt.Sprintf("DAYTONA_FAULT_%s_ERROR_RATE", layer)
+	if errorRate := os.Getenv(errorRateEnvVar); errorRate != "" {
+		r, err := strconv.ParseFloat(errorRate, 64)
+		if err != nil || r < 0 || r > 1 {
+			return nil, fmt.Errorf("invalid %s value %q, must be between 0 and 1", errorRateEnvVar, errorRate)
+		}
+		injector.ErrorRate = r
+	}
+
+	if injector.Delay == 0 && injector.ErrorRate == 0 {
+		return nil, nil
+	}
+
+	return injector, nil
+}
+
+// Inject waits for the configured delay and returns ErrInjectedFault at the configured error rate.
+// It is safe to call on a nil injector.
+func (i *Injector) Inject(ctx context.Context) error {
+	if i == nil {
+		return nil
+	}
+
+	if i.Delay > 0 {
+		timer := time.NewTimer(i.Delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if i.ErrorRate > 0 && rand.Float64() < i.ErrorRate {
+		return ErrInjectedFault
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewInjectorFromEnv(t *testing.T) {
+	t.Setenv("DAYTONA_FAULT_API_DELAY", "10ms")
+	t.Setenv("DAYTONA_FAULT_API_ERROR_RATE", "1")
+
+	injector, err := NewInjectorFromEnv(LayerApi)
+	require.NoError(t, err)
+	require.Nil(t, injector, "fault injection must be explicitly enabled")
+
+	t.Setenv(FAULT_INJECTION_ENV_VAR, "true")
+
+	injector, err = NewInjectorFromEnv(LayerApi)
+	require.NoError(t, err)
+	require.Equal(t, &Injector{Delay: 10 * time.Millisecond, ErrorRate: 1}, injector)
+
+	injector, err = NewInjectorFromEnv(LayerProvider)
+	require.NoError(t, err)
+	require.Nil(t, injector)
+
+	t.Setenv("DAYTONA_FAULT_API_ERROR_RATE", "2")
+	_, err = NewInjectorFromEnv(LayerApi)
+	require.Error(t, err)
+}
+
+func TestInject(t *testing.T) {
+	var nilInjector *Injector
+	require.NoError(t, nilInjector.Inject(context.Background()))
+
+	require.True(t, IsInjectedFault((&Injector{ErrorRate: 1}).Inject(context.Background())))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, (&Injector{Delay: time.Hour}).Inject(ctx), context.Canceled)
+}
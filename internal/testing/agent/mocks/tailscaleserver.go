@@ -6,6 +6,7 @@
 package mocks
 
 import (
+	"context"
 	"time"
 
 	"github.com/stretchr/testify/mock"
@@ -22,6 +23,11 @@ func (m *mockTailscaleServer) Start() error {
 	return args.Error(0)
 }
 
+func (m *mockTailscaleServer) Stop(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
 func NewMockTailscaleServer() *mockTailscaleServer {
 	mockTailscaleServer := new(mockTailscaleServer)
 	mockTailscaleServer.On("Start").Return(nil)
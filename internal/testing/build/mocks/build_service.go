@@ -42,3 +42,13 @@ func (m *MockBuildService) Delete(id string) error {
 	args := m.Called(id)
 	return args.Error(0)
 }
+
+func (m *MockBuildService) SetPriority(id string, priority build.BuildPriority) error {
+	args := m.Called(id, priority)
+	return args.Error(0)
+}
+
+func (m *MockBuildService) MarkImagePrePulled(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
@@ -146,6 +146,23 @@ func (s *InMemoryBuildStore) processFilters(filter *build.Filter) ([]*build.Buil
 				}
 			}
 		}
+		if filter.Image != nil {
+			for _, b := range filteredBuilds {
+				if b.ContainerConfig.Image != *filter.Image {
+					delete(filteredBuilds, b.Id)
+				}
+			}
+		}
+		if filter.Architecture != nil {
+			for _, b := range filteredBuilds {
+				if b.Architecture == nil && *filter.Architecture == "" {
+					continue
+				}
+				if b.Architecture == nil || *b.Architecture != *filter.Architecture {
+					delete(filteredBuilds, b.Id)
+				}
+			}
+		}
 	}
 
 	for _, b := range filteredBuilds {
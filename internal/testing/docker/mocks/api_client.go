@@ -10,9 +10,12 @@ import (
 	"io"
 
 	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/checkpoint"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/api/types/system"
 	"github.com/docker/docker/client"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/mock"
@@ -52,6 +55,11 @@ func (m *MockApiClient) ImagePull(ctx context.Context, ref string, options image
 	return args.Get(0).(io.ReadCloser), args.Error(1)
 }
 
+func (m *MockApiClient) DistributionInspect(ctx context.Context, image, encodedRegistryAuth string) (registry.DistributionInspect, error) {
+	args := m.Called(ctx, image, encodedRegistryAuth)
+	return args.Get(0).(registry.DistributionInspect), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerLogs(ctx context.Context, container string, options container.LogsOptions) (io.ReadCloser, error) {
 	args := m.Called(ctx, container, options)
 	return args.Get(0).(io.ReadCloser), args.Error(1)
@@ -62,6 +70,16 @@ func (m *MockApiClient) ContainerCreate(ctx context.Context, config *container.C
 	return args.Get(0).(container.CreateResponse), args.Error(1)
 }
 
+func (m *MockApiClient) ContainerCommit(ctx context.Context, container string, options container.CommitOptions) (types.IDResponse, error) {
+	args := m.Called(ctx, container, options)
+	return args.Get(0).(types.IDResponse), args.Error(1)
+}
+
+func (m *MockApiClient) ImageRemove(ctx context.Context, imageID string, options image.RemoveOptions) ([]image.DeleteResponse, error) {
+	args := m.Called(ctx, imageID, options)
+	return args.Get(0).([]image.DeleteResponse), args.Error(1)
+}
+
 func (m *MockApiClient) ContainerRemove(ctx context.Context, container string, options container.RemoveOptions) error {
 	args := m.Called(ctx, container, options)
 	return args.Error(0)
@@ -77,6 +95,16 @@ func (m *MockApiClient) ContainerStop(ctx context.Context, container string, sto
 	return args.Error(0)
 }
 
+func (m *MockApiClient) CheckpointCreate(ctx context.Context, container string, options checkpoint.CreateOptions) error {
+	args := m.Called(ctx, container, options)
+	return args.Error(0)
+}
+
+func (m *MockApiClient) CheckpointDelete(ctx context.Context, container string, options checkpoint.DeleteOptions) error {
+	args := m.Called(ctx, container, options)
+	return args.Error(0)
+}
+
 func (m *MockApiClient) ContainerExecCreate(ctx context.Context, container string, config container.ExecOptions) (types.IDResponse, error) {
 	args := m.Called(ctx, container, config)
 	return args.Get(0).(types.IDResponse), args.Error(1)
@@ -111,3 +139,8 @@ func (m *MockApiClient) VolumeRemove(ctx context.Context, volume string, force b
 	args := m.Called(ctx, volume, force)
 	return args.Error(0)
 }
+
+func (m *MockApiClient) Info(ctx context.Context) (system.Info, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(system.Info), args.Error(1)
+}
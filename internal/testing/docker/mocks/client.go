@@ -65,6 +65,11 @@ func (c *MockClient) GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.Works
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
+func (c *MockClient) GetHostCapacity() (*project.Resources, error) {
+	args := c.Called()
+	return args.Get(0).(*project.Resources), args.Error(1)
+}
+
 func (c *MockClient) GetProjectContainerName(p *project.Project) string {
 	args := c.Called(p)
 	return args.String(0)
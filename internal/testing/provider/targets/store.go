@@ -70,6 +70,13 @@ func (s *InMemoryTargetStore) processFilters(filter *provider.TargetFilter) ([]*
 				}
 			}
 		}
+		if filter.OrganizationId != nil {
+			for _, target := range filteredTargets {
+				if target.OrganizationId != *filter.OrganizationId {
+					delete(filteredTargets, target.Name)
+				}
+			}
+		}
 	}
 
 	for _, target := range filteredTargets {
@@ -0,0 +1,82 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevents
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+)
+
+type InMemoryAgentEventStore struct {
+	mutex  sync.Mutex
+	events []*agentevent.Event
+}
+
+func NewInMemoryAgentEventStore() agentevent.Store {
+	return &InMemoryAgentEventStore{}
+}
+
+func (s *InMemoryAgentEventStore) List(filter *agentevent.Filter) ([]*agentevent.Event, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	events := []*agentevent.Event{}
+	for _, e := range s.events {
+		if matches(e, filter) {
+			events = append(events, e)
+		}
+	}
+
+	slices.SortStableFunc(events, func(a, b *agentevent.Event) int {
+		return a.OccurredAt.Compare(b.OccurredAt)
+	})
+
+	return events, nil
+}
+
+func (s *InMemoryAgentEventStore) Save(event *agentevent.Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *InMemoryAgentEventStore) Delete(filter *agentevent.Filter) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.events = slices.DeleteFunc(s.events, func(e *agentevent.Event) bool {
+		return matches(e, filter)
+	})
+	return nil
+}
+
+func matches(e *agentevent.Event, filter *agentevent.Filter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if filter.WorkspaceId != nil && e.WorkspaceId != *filter.WorkspaceId {
+		return false
+	}
+	if filter.ProjectName != nil && e.ProjectName != *filter.ProjectName {
+		return false
+	}
+	if filter.Type != nil && e.Type != *filter.Type {
+		return false
+	}
+	if filter.Since != nil && e.OccurredAt.Before(*filter.Since) {
+		return false
+	}
+	if filter.Before != nil && !e.OccurredAt.Before(*filter.Before) {
+		return false
+	}
+
+	return true
+}
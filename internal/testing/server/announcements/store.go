@@ -0,0 +1,58 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcements
+
+import (
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+)
+
+type InMemoryAnnouncementStore struct {
+	announcements map[string]*announcement.Announcement
+}
+
+func NewInMemoryAnnouncementStore() announcement.Store {
+	return &InMemoryAnnouncementStore{
+		announcements: make(map[string]*announcement.Announcement),
+	}
+}
+
+func (s *InMemoryAnnouncementStore) List() ([]*announcement.Announcement, error) {
+	announcements := []*announcement.Announcement{}
+	for _, a := range s.announcements {
+		announcements = append(announcements, a)
+	}
+
+	slices.SortFunc(announcements, func(a, b *announcement.Announcement) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return announcements, nil
+}
+
+func (s *InMemoryAnnouncementStore) Find(id string) (*announcement.Announcement, error) {
+	a, ok := s.announcements[id]
+	if !ok {
+		return nil, announcement.ErrAnnouncementNotFound
+	}
+
+	return a, nil
+}
+
+func (s *InMemoryAnnouncementStore) Save(a *announcement.Announcement) error {
+	s.announcements[a.Id] = a
+	return nil
+}
+
+func (s *InMemoryAnnouncementStore) Delete(a *announcement.Announcement) error {
+	if _, ok := s.announcements[a.Id]; !ok {
+		return announcement.ErrAnnouncementNotFound
+	}
+
+	delete(s.announcements, a.Id)
+	return nil
+}
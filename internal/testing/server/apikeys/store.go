@@ -44,7 +44,7 @@ func (s *InMemoryApiKeyStore) FindByName(name string) (*apikey.ApiKey, error) {
 		}
 	}
 
-	return nil, nil
+	return nil, apikey.ErrApiKeyNotFound
 }
 
 func (s *InMemoryApiKeyStore) Save(apiKey *apikey.ApiKey) error {
@@ -0,0 +1,56 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifacts
+
+import (
+	"github.com/daytonaio/daytona/pkg/artifact"
+)
+
+type InMemoryArtifactStore struct {
+	artifacts map[string]*artifact.Artifact
+}
+
+func NewInMemoryArtifactStore() artifact.Store {
+	return &InMemoryArtifactStore{
+		artifacts: make(map[string]*artifact.Artifact),
+	}
+}
+
+func (s *InMemoryArtifactStore) List(filter *artifact.Filter) ([]*artifact.Artifact, error) {
+	artifacts := []*artifact.Artifact{}
+	for _, a := range s.artifacts {
+		if filter != nil {
+			if filter.WorkspaceId != nil && a.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && a.ProjectName != *filter.ProjectName {
+				continue
+			}
+		}
+		artifacts = append(artifacts, a)
+	}
+
+	return artifacts, nil
+}
+
+func (s *InMemoryArtifactStore) Find(id string) (*artifact.Artifact, error) {
+	a, ok := s.artifacts[id]
+	if !ok {
+		return nil, artifact.ErrArtifactNotFound
+	}
+
+	return a, nil
+}
+
+func (s *InMemoryArtifactStore) Save(a *artifact.Artifact) error {
+	s.artifacts[a.Id] = a
+	return nil
+}
+
+func (s *InMemoryArtifactStore) Delete(a *artifact.Artifact) error {
+	delete(s.artifacts, a.Id)
+	return nil
+}
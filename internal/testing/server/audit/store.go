@@ -0,0 +1,52 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type InMemoryAuditEventStore struct {
+	mutex  sync.Mutex
+	events []*audit.Event
+}
+
+func NewInMemoryAuditEventStore() audit.Store {
+	return &InMemoryAuditEventStore{}
+}
+
+func (s *InMemoryAuditEventStore) List(filter *audit.Filter) ([]*audit.Event, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	events := []*audit.Event{}
+	for _, event := range s.events {
+		if filter != nil {
+			if filter.Action != nil && event.Action != *filter.Action {
+				continue
+			}
+			if filter.Resource != nil && event.Resource != *filter.Resource {
+				continue
+			}
+			if filter.Since != nil && event.CreatedAt.Before(*filter.Since) {
+				continue
+			}
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (s *InMemoryAuditEventStore) Save(event *audit.Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.events = append(s.events, event)
+	return nil
+}
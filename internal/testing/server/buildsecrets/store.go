@@ -0,0 +1,65 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecrets
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+)
+
+type InMemoryBuildSecretStore struct {
+	secrets map[string]*buildsecret.BuildSecret
+}
+
+func NewInMemoryBuildSecretStore() buildsecret.Store {
+	return &InMemoryBuildSecretStore{
+		secrets: make(map[string]*buildsecret.BuildSecret),
+	}
+}
+
+func (s *InMemoryBuildSecretStore) List(organizationId string) ([]*buildsecret.BuildSecret, error) {
+	secrets := []*buildsecret.BuildSecret{}
+	for _, secret := range s.secrets {
+		if secret.OrganizationId == organizationId {
+			secrets = append(secrets, secret)
+		}
+	}
+
+	slices.SortFunc(secrets, func(a, b *buildsecret.BuildSecret) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return secrets, nil
+}
+
+func (s *InMemoryBuildSecretStore) Find(organizationId, name string) (*buildsecret.BuildSecret, error) {
+	secret, ok := s.secrets[getKey(organizationId, name)]
+	if !ok {
+		return nil, buildsecret.ErrBuildSecretNotFound
+	}
+
+	return secret, nil
+}
+
+func (s *InMemoryBuildSecretStore) Save(secret *buildsecret.BuildSecret) error {
+	s.secrets[getKey(secret.OrganizationId, secret.Name)] = secret
+	return nil
+}
+
+func (s *InMemoryBuildSecretStore) Delete(secret *buildsecret.BuildSecret) error {
+	_, ok := s.secrets[getKey(secret.OrganizationId, secret.Name)]
+	if !ok {
+		return buildsecret.ErrBuildSecretNotFound
+	}
+	delete(s.secrets, getKey(secret.OrganizationId, secret.Name))
+	return nil
+}
+
+func getKey(organizationId, name string) string {
+	return organizationId + "/" + name
+}
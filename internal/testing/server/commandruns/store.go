@@ -0,0 +1,79 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandruns
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/commandrun"
+)
+
+type InMemoryCommandRunStore struct {
+	mutex sync.Mutex
+	runs  map[string]*commandrun.CommandRun
+}
+
+func NewInMemoryCommandRunStore() commandrun.Store {
+	return &InMemoryCommandRunStore{
+		runs: make(map[string]*commandrun.CommandRun),
+	}
+}
+
+func (s *InMemoryCommandRunStore) List(filter *commandrun.Filter) ([]*commandrun.CommandRun, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	runs := []*commandrun.CommandRun{}
+	for _, r := range s.runs {
+		if filter != nil {
+			if filter.WorkspaceId != nil && r.WorkspaceId != *filter.WorkspaceId {
+				continue
+			}
+			if filter.ProjectName != nil && r.ProjectName != *filter.ProjectName {
+				continue
+			}
+			if filter.CommandName != nil && r.CommandName != *filter.CommandName {
+				continue
+			}
+		}
+		runs = append(runs, r)
+	}
+
+	slices.SortFunc(runs, func(a, b *commandrun.CommandRun) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+
+	return runs, nil
+}
+
+func (s *InMemoryCommandRunStore) Find(id string) (*commandrun.CommandRun, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, ok := s.runs[id]
+	if !ok {
+		return nil, commandrun.ErrCommandRunNotFound
+	}
+
+	return r, nil
+}
+
+func (s *InMemoryCommandRunStore) Save(r *commandrun.CommandRun) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.runs[r.Id] = r
+	return nil
+}
+
+func (s *InMemoryCommandRunStore) Delete(r *commandrun.CommandRun) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.runs, r.Id)
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtimings
+
+import (
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+)
+
+type InMemoryCreationTimingStore struct {
+	mutex   sync.Mutex
+	timings []*creationtiming.PhaseTiming
+}
+
+func NewInMemoryCreationTimingStore() creationtiming.Store {
+	return &InMemoryCreationTimingStore{}
+}
+
+func (s *InMemoryCreationTimingStore) List(filter *creationtiming.Filter) ([]*creationtiming.PhaseTiming, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	timings := []*creationtiming.PhaseTiming{}
+	for _, t := range s.timings {
+		if filter != nil {
+			if filter.Target != nil && t.Target != *filter.Target {
+				continue
+			}
+			if filter.Provider != nil && t.Provider != *filter.Provider {
+				continue
+			}
+			if filter.Phase != nil && t.Phase != *filter.Phase {
+				continue
+			}
+			if filter.Since != nil && t.RecordedAt.Before(*filter.Since) {
+				continue
+			}
+		}
+		timings = append(timings, t)
+	}
+
+	return timings, nil
+}
+
+func (s *InMemoryCreationTimingStore) Save(timing *creationtiming.PhaseTiming) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.timings = append(s.timings, timing)
+	return nil
+}
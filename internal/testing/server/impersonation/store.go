@@ -0,0 +1,68 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/impersonation"
+)
+
+type InMemoryImpersonationSessionStore struct {
+	mutex    sync.Mutex
+	sessions map[string]*impersonation.Session
+}
+
+func NewInMemoryImpersonationSessionStore() impersonation.Store {
+	return &InMemoryImpersonationSessionStore{
+		sessions: make(map[string]*impersonation.Session),
+	}
+}
+
+func (s *InMemoryImpersonationSessionStore) List(filter *impersonation.Filter) ([]*impersonation.Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sessions := []*impersonation.Session{}
+	for _, session := range s.sessions {
+		if filter != nil {
+			if filter.Admin != nil && session.Admin != *filter.Admin {
+				continue
+			}
+			if filter.User != nil && session.User != *filter.User {
+				continue
+			}
+		}
+		sessions = append(sessions, session)
+	}
+
+	slices.SortFunc(sessions, func(a, b *impersonation.Session) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return sessions, nil
+}
+
+func (s *InMemoryImpersonationSessionStore) Find(id string) (*impersonation.Session, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, ok := s.sessions[id]
+	if !ok {
+		return nil, impersonation.ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *InMemoryImpersonationSessionStore) Save(session *impersonation.Session) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.sessions[session.Id] = session
+	return nil
+}
@@ -0,0 +1,83 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkeys
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+)
+
+type InMemoryNetworkKeyStore struct {
+	mutex sync.Mutex
+	keys  map[string]*networkkey.NetworkKey
+}
+
+func NewInMemoryNetworkKeyStore() networkkey.Store {
+	return &InMemoryNetworkKeyStore{
+		keys: make(map[string]*networkkey.NetworkKey),
+	}
+}
+
+func (s *InMemoryNetworkKeyStore) List(filter *networkkey.Filter) ([]*networkkey.NetworkKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := []*networkkey.NetworkKey{}
+	for _, key := range s.keys {
+		if filter != nil {
+			if filter.Scope != nil && key.Scope != *filter.Scope {
+				continue
+			}
+			if filter.ScopeName != nil && key.ScopeName != *filter.ScopeName {
+				continue
+			}
+			if filter.Revoked != nil && key.IsRevoked() != *filter.Revoked {
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b *networkkey.NetworkKey) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return keys, nil
+}
+
+func (s *InMemoryNetworkKeyStore) Find(id string) (*networkkey.NetworkKey, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, networkkey.ErrNetworkKeyNotFound
+	}
+
+	return key, nil
+}
+
+func (s *InMemoryNetworkKeyStore) Save(key *networkkey.NetworkKey) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.keys[key.Id] = key
+	return nil
+}
+
+func (s *InMemoryNetworkKeyStore) Delete(key *networkkey.NetworkKey) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.keys[key.Id]; !ok {
+		return networkkey.ErrNetworkKeyNotFound
+	}
+
+	delete(s.keys, key.Id)
+	return nil
+}
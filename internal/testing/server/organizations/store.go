@@ -0,0 +1,53 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organizations
+
+import (
+	"github.com/daytonaio/daytona/pkg/organization"
+)
+
+type InMemoryOrganizationStore struct {
+	organizations map[string]*organization.Organization
+}
+
+func NewInMemoryOrganizationStore() organization.Store {
+	return &InMemoryOrganizationStore{
+		organizations: make(map[string]*organization.Organization),
+	}
+}
+
+func (s *InMemoryOrganizationStore) List() ([]*organization.Organization, error) {
+	organizations := []*organization.Organization{}
+	for _, o := range s.organizations {
+		organizations = append(organizations, o)
+	}
+
+	return organizations, nil
+}
+
+func (s *InMemoryOrganizationStore) Find(idOrName string) (*organization.Organization, error) {
+	o, ok := s.organizations[idOrName]
+	if !ok {
+		for _, o := range s.organizations {
+			if o.Name == idOrName {
+				return o, nil
+			}
+		}
+		return nil, organization.ErrOrganizationNotFound
+	}
+
+	return o, nil
+}
+
+func (s *InMemoryOrganizationStore) Save(o *organization.Organization) error {
+	s.organizations[o.Id] = o
+	return nil
+}
+
+func (s *InMemoryOrganizationStore) Delete(o *organization.Organization) error {
+	delete(s.organizations, o.Id)
+	return nil
+}
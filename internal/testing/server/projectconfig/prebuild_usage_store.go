@@ -0,0 +1,45 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectconfig
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+)
+
+type InMemoryPrebuildUsageStore struct {
+	usages map[string]*config.PrebuildUsage
+}
+
+func NewInMemoryPrebuildUsageStore() config.PrebuildUsageStore {
+	return &InMemoryPrebuildUsageStore{
+		usages: make(map[string]*config.PrebuildUsage),
+	}
+}
+
+func (s *InMemoryPrebuildUsageStore) List(filter *config.PrebuildUsageFilter) ([]*config.PrebuildUsage, error) {
+	usages := []*config.PrebuildUsage{}
+	for _, u := range s.usages {
+		if filter != nil {
+			if filter.RepositoryUrl != nil && u.RepositoryUrl != *filter.RepositoryUrl {
+				continue
+			}
+			if filter.Branch != nil && u.Branch != *filter.Branch {
+				continue
+			}
+			if filter.PrebuildId != nil && u.PrebuildId != *filter.PrebuildId {
+				continue
+			}
+		}
+		usages = append(usages, u)
+	}
+
+	return usages, nil
+}
+
+func (s *InMemoryPrebuildUsageStore) Save(usage *config.PrebuildUsage) error {
+	s.usages[usage.Id] = usage
+	return nil
+}
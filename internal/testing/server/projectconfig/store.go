@@ -84,6 +84,13 @@ func (s *InMemoryProjectConfigStore) processFilters(filter *config.ProjectConfig
 				}
 			}
 		}
+		if filter.OrganizationId != nil {
+			for _, projectConfig := range filteredProjectConfigs {
+				if projectConfig.OrganizationId != *filter.OrganizationId {
+					delete(filteredProjectConfigs, projectConfig.Name)
+				}
+			}
+		}
 	}
 
 	for _, projectConfig := range filteredProjectConfigs {
@@ -0,0 +1,73 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package regions
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/region"
+)
+
+type InMemoryRegionStore struct {
+	mutex   sync.Mutex
+	regions map[string]*region.Region
+}
+
+func NewInMemoryRegionStore() region.Store {
+	return &InMemoryRegionStore{
+		regions: make(map[string]*region.Region),
+	}
+}
+
+func (s *InMemoryRegionStore) List() ([]*region.Region, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	regions := []*region.Region{}
+	for _, r := range s.regions {
+		regions = append(regions, r)
+	}
+
+	slices.SortFunc(regions, func(a, b *region.Region) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return regions, nil
+}
+
+func (s *InMemoryRegionStore) Find(name string) (*region.Region, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r, ok := s.regions[name]
+	if !ok {
+		return nil, region.ErrRegionNotFound
+	}
+
+	return r, nil
+}
+
+func (s *InMemoryRegionStore) Save(r *region.Region) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.regions[r.Name] = r
+	return nil
+}
+
+func (s *InMemoryRegionStore) Delete(r *region.Region) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.regions[r.Name]; !ok {
+		return region.ErrRegionNotFound
+	}
+
+	delete(s.regions, r.Name)
+	return nil
+}
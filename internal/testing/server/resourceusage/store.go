@@ -0,0 +1,79 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+)
+
+type InMemoryResourceUsageStore struct {
+	mutex   sync.Mutex
+	samples []*resourceusage.Sample
+}
+
+func NewInMemoryResourceUsageStore() resourceusage.Store {
+	return &InMemoryResourceUsageStore{}
+}
+
+func (s *InMemoryResourceUsageStore) List(filter *resourceusage.Filter) ([]*resourceusage.Sample, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	samples := []*resourceusage.Sample{}
+	for _, sample := range s.samples {
+		if matches(sample, filter) {
+			samples = append(samples, sample)
+		}
+	}
+
+	slices.SortStableFunc(samples, func(a, b *resourceusage.Sample) int {
+		return a.SampledAt.Compare(b.SampledAt)
+	})
+
+	return samples, nil
+}
+
+func (s *InMemoryResourceUsageStore) Save(sample *resourceusage.Sample) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.samples = append(s.samples, sample)
+	return nil
+}
+
+func (s *InMemoryResourceUsageStore) Delete(filter *resourceusage.Filter) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.samples = slices.DeleteFunc(s.samples, func(sample *resourceusage.Sample) bool {
+		return matches(sample, filter)
+	})
+	return nil
+}
+
+func matches(sample *resourceusage.Sample, filter *resourceusage.Filter) bool {
+	if filter == nil {
+		return true
+	}
+
+	if filter.WorkspaceId != nil && sample.WorkspaceId != *filter.WorkspaceId {
+		return false
+	}
+	if filter.ProjectName != nil && sample.ProjectName != *filter.ProjectName {
+		return false
+	}
+	if filter.Since != nil && sample.SampledAt.Before(*filter.Since) {
+		return false
+	}
+	if filter.Before != nil && !sample.SampledAt.Before(*filter.Before) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import (
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/rollout"
+)
+
+type InMemoryRolloutStore struct {
+	rollouts map[string]*rollout.Rollout
+}
+
+func NewInMemoryRolloutStore() rollout.Store {
+	return &InMemoryRolloutStore{
+		rollouts: make(map[string]*rollout.Rollout),
+	}
+}
+
+func (s *InMemoryRolloutStore) List() ([]*rollout.Rollout, error) {
+	rollouts := []*rollout.Rollout{}
+	for _, r := range s.rollouts {
+		rollouts = append(rollouts, r)
+	}
+
+	slices.SortFunc(rollouts, func(a, b *rollout.Rollout) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return rollouts, nil
+}
+
+func (s *InMemoryRolloutStore) Find(id string) (*rollout.Rollout, error) {
+	r, ok := s.rollouts[id]
+	if !ok {
+		return nil, rollout.ErrRolloutNotFound
+	}
+
+	return r, nil
+}
+
+func (s *InMemoryRolloutStore) Save(r *rollout.Rollout) error {
+	s.rollouts[r.Id] = r
+	return nil
+}
@@ -0,0 +1,76 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservices
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+)
+
+type InMemorySharedServiceStore struct {
+	mutex    sync.Mutex
+	services map[string]*sharedservice.SharedService
+}
+
+func NewInMemorySharedServiceStore() sharedservice.Store {
+	return &InMemorySharedServiceStore{
+		services: make(map[string]*sharedservice.SharedService),
+	}
+}
+
+func (s *InMemorySharedServiceStore) List(filter *sharedservice.Filter) ([]*sharedservice.SharedService, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	services := []*sharedservice.SharedService{}
+	for _, service := range s.services {
+		if filter != nil && filter.Target != nil && service.Target != *filter.Target {
+			continue
+		}
+		services = append(services, service)
+	}
+
+	slices.SortFunc(services, func(a, b *sharedservice.SharedService) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return services, nil
+}
+
+func (s *InMemorySharedServiceStore) Find(name string) (*sharedservice.SharedService, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	service, ok := s.services[name]
+	if !ok {
+		return nil, sharedservice.ErrSharedServiceNotFound
+	}
+
+	return service, nil
+}
+
+func (s *InMemorySharedServiceStore) Save(service *sharedservice.SharedService) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.services[service.Name] = service
+	return nil
+}
+
+func (s *InMemorySharedServiceStore) Delete(service *sharedservice.SharedService) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, ok := s.services[service.Name]; !ok {
+		return sharedservice.ErrSharedServiceNotFound
+	}
+
+	delete(s.services, service.Name)
+	return nil
+}
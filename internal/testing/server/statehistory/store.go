@@ -0,0 +1,68 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/statehistory"
+)
+
+type InMemoryStateSnapshotStore struct {
+	mutex     sync.Mutex
+	snapshots []*statehistory.Snapshot
+}
+
+func NewInMemoryStateSnapshotStore() statehistory.Store {
+	return &InMemoryStateSnapshotStore{}
+}
+
+func (s *InMemoryStateSnapshotStore) List(filter *statehistory.Filter) ([]*statehistory.Snapshot, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshots := []*statehistory.Snapshot{}
+	for _, snapshot := range s.snapshots {
+		if filter != nil {
+			if filter.Workspace != nil && snapshot.WorkspaceId != *filter.Workspace && snapshot.WorkspaceName != *filter.Workspace {
+				continue
+			}
+			if filter.Since != nil && snapshot.RecordedAt.Before(*filter.Since) {
+				continue
+			}
+			if filter.Until != nil && snapshot.RecordedAt.After(*filter.Until) {
+				continue
+			}
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots, nil
+}
+
+func (s *InMemoryStateSnapshotStore) Save(snapshot *statehistory.Snapshot) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+func (s *InMemoryStateSnapshotStore) DeleteBefore(before time.Time) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	snapshots := []*statehistory.Snapshot{}
+	for _, snapshot := range s.snapshots {
+		if !snapshot.RecordedAt.Before(before) {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	s.snapshots = snapshots
+
+	return nil
+}
@@ -23,6 +23,21 @@ func (s *mockApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (st
 	return args.String(0), args.Error(1)
 }
 
+func (s *mockApiKeyService) GenerateAdmin(name string) (string, error) {
+	args := s.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GetApiKeyName(apiKey string) (string, error) {
+	args := s.Called(apiKey)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) IsAdminApiKey(apiKey string) bool {
+	args := s.Called(apiKey)
+	return args.Bool(0)
+}
+
 func (s *mockApiKeyService) IsProjectApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
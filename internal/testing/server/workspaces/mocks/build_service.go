@@ -47,6 +47,16 @@ func (m *MockBuildService) Delete(id string) error {
 	return args.Error(0)
 }
 
+func (m *MockBuildService) SetPriority(id string, priority build.BuildPriority) error {
+	args := m.Called(id, priority)
+	return args.Error(0)
+}
+
+func (m *MockBuildService) MarkImagePrePulled(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
 func (m *MockBuildService) AwaitEmptyList(waitTime time.Duration) error {
 	args := m.Called(waitTime)
 	return args.Error(0)
@@ -50,8 +50,8 @@ type MockBuilder struct {
 	mock.Mock
 }
 
-func (b *MockBuilder) Build(build build.Build) (string, string, error) {
-	args := b.Called(build)
+func (b *MockBuilder) Build(build build.Build, secrets map[string]string) (string, string, error) {
+	args := b.Called(build, secrets)
 	return args.String(0), args.String(1), args.Error(2)
 }
 
@@ -74,3 +74,8 @@ func (b *MockBuilder) GetImageName(build build.Build) (string, error) {
 	args := b.Called(build)
 	return args.String(0), args.Error(1)
 }
+
+func (b *MockBuilder) Stop(build build.Build) error {
+	args := b.Called(build)
+	return args.Error(0)
+}
@@ -65,6 +65,16 @@ func (m *mockProjectConfigService) DeletePrebuild(projectConfigName string, id s
 	return args.Get(0).([]error)
 }
 
+func (m *mockProjectConfigService) RecordPrebuildUsage(usage *config.PrebuildUsage) error {
+	args := m.Called(usage)
+	return args.Error(0)
+}
+
+func (m *mockProjectConfigService) GetPrebuildStats(filter *config.PrebuildUsageFilter) (*dto.PrebuildStatsDTO, error) {
+	args := m.Called(filter)
+	return args.Get(0).(*dto.PrebuildStatsDTO), args.Error(1)
+}
+
 func (m *mockProjectConfigService) StartRetentionPoller() error {
 	args := m.Called()
 	return args.Error(0)
@@ -7,9 +7,13 @@ package mocks
 
 import (
 	"context"
+	"net"
 
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/stretchr/testify/mock"
@@ -43,11 +47,71 @@ func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, targe
 	return args.Error(0)
 }
 
+func (p *mockProvisioner) GetProjectCreationTimings(proj *project.Project, target *provider.ProviderTarget) ([]creationtiming.PhaseDuration, error) {
+	args := p.Called(proj, target)
+	return args.Get(0).([]creationtiming.PhaseDuration), args.Error(1)
+}
+
+func (p *mockProvisioner) GetProjectNetworking(proj *project.Project, target *provider.ProviderTarget) (project.Networking, error) {
+	args := p.Called(proj, target)
+	return args.Get(0).(project.Networking), args.Error(1)
+}
+
+func (p *mockProvisioner) GetTargetCapacity(target *provider.ProviderTarget) (*project.Resources, error) {
+	args := p.Called(target)
+	return args.Get(0).(*project.Resources), args.Error(1)
+}
+
+func (p *mockProvisioner) GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error) {
+	args := p.Called(target)
+	return args.Get(0).(*provider.TargetPool), args.Error(1)
+}
+
+func (p *mockProvisioner) ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error) {
+	args := p.Called(target, hosts)
+	return args.Get(0).(*provider.TargetPool), args.Error(1)
+}
+
+func (p *mockProvisioner) ForwardProjectPort(proj *project.Project, target *provider.ProviderTarget, port uint16) (net.Conn, error) {
+	args := p.Called(proj, target, port)
+	return args.Get(0).(net.Conn), args.Error(1)
+}
+
+func (p *mockProvisioner) PullImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	args := p.Called(image, target, cr)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) InspectImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	args := p.Called(image, target, cr)
+	return args.Error(0)
+}
+
 func (p *mockProvisioner) GetWorkspaceInfo(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
 	args := p.Called(ctx, w, target)
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
+func (p *mockProvisioner) CreateSharedService(service *sharedservice.SharedService, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	args := p.Called(service, target, cr)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) DestroySharedService(service *sharedservice.SharedService, target *provider.ProviderTarget) error {
+	args := p.Called(service, target)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) GetSharedServiceInfo(ctx context.Context, service *sharedservice.SharedService, target *provider.ProviderTarget) (*sharedservice.SharedServiceInfo, error) {
+	args := p.Called(ctx, service, target)
+	return args.Get(0).(*sharedservice.SharedServiceInfo), args.Error(1)
+}
+
+func (p *mockProvisioner) RebuildProject(params provisioner.ProjectParams) error {
+	args := p.Called(params)
+	return args.Error(0)
+}
+
 func (p *mockProvisioner) StartProject(params provisioner.ProjectParams) error {
 	args := p.Called(params)
 	return args.Error(0)
@@ -63,6 +127,26 @@ func (p *mockProvisioner) StopProject(proj *project.Project, target *provider.Pr
 	return args.Error(0)
 }
 
+func (p *mockProvisioner) StartProjectRecovery(params provisioner.ProjectParams) error {
+	args := p.Called(params)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) StopProjectRecovery(proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(proj, target)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) PauseProject(proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(proj, target)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) ResumeProject(proj *project.Project, target *provider.ProviderTarget) error {
+	args := p.Called(proj, target)
+	return args.Error(0)
+}
+
 func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
@@ -13,14 +13,25 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/impersonation"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 const CLIENT_VERSION_HEADER = "X-Client-Version"
 
 var apiClient *apiclient.APIClient
 
+// CorrelationId is sent with all API requests of the process so that server logs of a failed command can be traced
+var CorrelationId = correlation.NewId()
+
+// OrganizationFlag overrides the organization of the active profile for the current command
+var OrganizationFlag string
+
 func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 	if apiClient != nil {
 		return apiClient, nil
@@ -56,6 +67,19 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 
 	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
+	clientConfig.AddDefaultHeader(correlation.CORRELATION_ID_HEADER, CorrelationId)
+
+	organizationIdOrName := activeProfile.Organization
+	if OrganizationFlag != "" {
+		organizationIdOrName = OrganizationFlag
+	}
+	if organizationIdOrName != "" {
+		clientConfig.AddDefaultHeader(organization.ORGANIZATION_HEADER, organizationIdOrName)
+	}
+
+	if session := activeProfile.GetActiveImpersonation(); session != nil {
+		clientConfig.AddDefaultHeader(impersonation.IMPERSONATION_HEADER, session.Id)
+	}
 
 	if c.TelemetryEnabled {
 		clientConfig.AddDefaultHeader(telemetry.ENABLED_HEADER, "true")
@@ -71,7 +95,7 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 	newApiClient = apiclient.NewAPIClient(clientConfig)
 
 	newApiClient.GetConfig().HTTPClient = &http.Client{
-		Transport: http.DefaultTransport,
+		Transport: newCacheTransport(newTimeoutTransport()),
 	}
 
 	healthUrl, err := url.JoinPath(serverUrl, constants.HEALTH_CHECK_ROUTE)
@@ -79,7 +103,10 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		return nil, err
 	}
 
-	_, _, err = newApiClient.DefaultAPI.HealthCheck(context.Background()).Execute()
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	_, _, err = newApiClient.DefaultAPI.HealthCheck(ctx).Execute()
 	if err != nil {
 		return nil, ErrHealthCheckFailed(healthUrl)
 	}
@@ -98,6 +125,7 @@ func GetAgentApiClient(apiUrl, apiKey, clientId string, telemetryEnabled bool) (
 
 	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
+	clientConfig.AddDefaultHeader(correlation.CORRELATION_ID_HEADER, CorrelationId)
 
 	if telemetryEnabled {
 		clientConfig.AddDefaultHeader(telemetry.ENABLED_HEADER, "true")
@@ -106,13 +134,14 @@ func GetAgentApiClient(apiUrl, apiKey, clientId string, telemetryEnabled bool) (
 		clientConfig.AddDefaultHeader(telemetry.SOURCE_HEADER, string(telemetry.AGENT_SOURCE))
 	}
 
-	apiClient = apiclient.NewAPIClient(clientConfig)
+	// The client is not cached because the agent talks to several servers from concurrent goroutines
+	agentApiClient := apiclient.NewAPIClient(clientConfig)
 
-	apiClient.GetConfig().HTTPClient = &http.Client{
-		Transport: http.DefaultTransport,
+	agentApiClient.GetConfig().HTTPClient = &http.Client{
+		Transport: newTimeoutTransport(),
 	}
 
-	return apiClient, nil
+	return agentApiClient, nil
 }
 
 func GetWorkspace(workspaceNameOrId string, verbose bool) (*apiclient.WorkspaceDTO, error) {
@@ -160,3 +189,26 @@ func GetFirstWorkspaceProjectName(workspaceId string, projectName string, profil
 
 	return "", errors.New("project not found in workspace")
 }
+
+// GetTailnetProject returns the project with the hostname and route its agent is reached at on the tailnet
+func GetTailnetProject(workspaceId string, projectName string, profile *config.Profile) (*project.Project, error) {
+	ctx := context.Background()
+
+	apiClient, err := GetApiClient(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+	if err != nil {
+		return nil, HandleErrorResponse(res, err)
+	}
+
+	for _, p := range wsInfo.Projects {
+		if p.Name == projectName {
+			return conversion.ToProject(&p), nil
+		}
+	}
+
+	return nil, errors.New("project not found in workspace")
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// UploadArtifacts uploads every file found under the given paths as project artifacts.
+// Paths are resolved relative to projectDir and directories are walked recursively.
+func UploadArtifacts(ctx context.Context, apiClient *apiclient.APIClient, workspaceId, projectName, projectDir string, paths []string) ([]apiclient.Artifact, error) {
+	artifacts := []apiclient.Artifact{}
+
+	for _, p := range paths {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(projectDir, p)
+		}
+
+		err := filepath.WalkDir(p, func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(projectDir, filePath)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(filePath)
+			if err != nil {
+				return err
+			}
+
+			artifact, res, err := apiClient.ArtifactAPI.UploadArtifact(ctx, workspaceId, projectName).Path(filepath.ToSlash(relPath)).File(file).Execute()
+			if err != nil {
+				return fmt.Errorf("failed to upload %s: %w", relPath, HandleErrorResponse(res, err))
+			}
+
+			artifacts = append(artifacts, *artifact)
+			return nil
+		})
+		if err != nil {
+			return artifacts, err
+		}
+	}
+
+	return artifacts, nil
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/organization"
+	log "github.com/sirupsen/logrus"
+)
+
+const API_CACHE_TTL_ENV_VAR = "DAYTONA_API_CACHE_TTL"
+
+// DefaultCacheTTL is how long a cached response is used without revalidating it with the server,
+// e.g. for the completion requests made on each key press
+const DefaultCacheTTL = 3 * time.Second
+
+// cachedResponse is a response of a GET request the server returned an ETag for
+type cachedResponse struct {
+	ETag        string    `json:"etag"`
+	ContentType string    `json:"contentType"`
+	Body        []byte    `json:"body"`
+	StoredAt    time.Time `json:"storedAt"`
+}
+
+// cacheTransport caches responses with an ETag on disk. Cached responses are reused without a request
+// for the cache TTL and revalidated with If-None-Match afterwards. Requests other than GET clear the cache
+// since they may change any of the cached responses
+type cacheTransport struct {
+	transport http.RoundTripper
+	dir       string
+	ttl       time.Duration
+}
+
+func newCacheTransport(transport http.RoundTripper) http.RoundTripper {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		log.Debugf("API response cache disabled: %v", err)
+		return transport
+	}
+
+	return &cacheTransport{
+		transport: transport,
+		dir:       filepath.Join(configDir, "cache", "api"),
+		ttl:       getCacheTTL(),
+	}
+}
+
+func getCacheTTL() time.Duration {
+	if ttlEnv := os.Getenv(API_CACHE_TTL_ENV_VAR); ttlEnv != "" {
+		ttl, err := time.ParseDuration(ttlEnv)
+		if err == nil {
+			return ttl
+		}
+		log.Warnf("invalid %s value %q, using defaults", API_CACHE_TTL_ENV_VAR, ttlEnv)
+	}
+
+	return DefaultCacheTTL
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		t.clear()
+		return t.transport.RoundTrip(req)
+	}
+
+	path := t.getPath(req)
+	cached := t.read(path)
+
+	if cached != nil {
+		if time.Since(cached.StoredAt) < t.ttl {
+			return cached.toResponse(req), nil
+		}
+
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		res.Body.Close()
+		cached.StoredAt = time.Now()
+		t.write(path, cached)
+		return cached.toResponse(req), nil
+	}
+
+	etag := res.Header.Get("ETag")
+	if res.StatusCode != http.StatusOK || etag == "" {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.write(path, &cachedResponse{
+		ETag:        etag,
+		ContentType: res.Header.Get("Content-Type"),
+		Body:        body,
+		StoredAt:    time.Now(),
+	})
+
+	return res, nil
+}
+
+// getPath returns the cache file of the request. Responses depend on the API key and organization of the request
+func (t *cacheTransport) getPath(req *http.Request) string {
+	hash := sha256.New()
+	for _, part := range []string{req.URL.String(), req.Header.Get("Authorization"), req.Header.Get(organization.ORGANIZATION_HEADER)} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+
+	return filepath.Join(t.dir, hex.EncodeToString(hash.Sum(nil))+".json")
+}
+
+func (t *cacheTransport) read(path string) *cachedResponse {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var cached cachedResponse
+	err = json.Unmarshal(content, &cached)
+	if err != nil || cached.ETag == "" {
+		return nil
+	}
+
+	return &cached
+}
+
+func (t *cacheTransport) write(path string, cached *cachedResponse) {
+	content, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(t.dir, 0700)
+	if err == nil {
+		err = os.WriteFile(path, content, 0600)
+	}
+	if err != nil {
+		log.Debugf("failed to cache API response: %v", err)
+	}
+}
+
+func (t *cacheTransport) clear() {
+	err := os.RemoveAll(t.dir)
+	if err != nil {
+		log.Debugf("failed to clear the API response cache: %v", err)
+	}
+}
+
+func (c *cachedResponse) toResponse(req *http.Request) *http.Response {
+	header := http.Header{}
+	header.Set("ETag", c.ETag)
+	header.Set("Content-Type", c.ContentType)
+	header.Set("Content-Length", strconv.Itoa(len(c.Body)))
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTransport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	workspaces := []string{"ws1"}
+	requests := 0
+	notModified := 0
+
+	router := gin.New()
+	router.Use(func(ctx *gin.Context) {
+		requests++
+		ctx.Next()
+		if ctx.Writer.Status() == http.StatusNotModified {
+			notModified++
+		}
+	})
+	router.GET("/workspace", middlewares.ETagMiddleware(), func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, workspaces)
+	})
+	router.POST("/workspace", func(ctx *gin.Context) {
+		workspaces = append(workspaces, "ws2")
+		ctx.Status(http.StatusOK)
+	})
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	t.Setenv("DAYTONA_CONFIG_DIR", t.TempDir())
+	t.Setenv(API_CACHE_TTL_ENV_VAR, "1h")
+
+	client := &http.Client{Transport: newCacheTransport(http.DefaultTransport)}
+
+	get := func() string {
+		res, err := client.Get(server.URL + "/workspace")
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return string(body)
+	}
+
+	// Fresh responses are served from the cache
+	require.Equal(t, `["ws1"]`, get())
+	require.Equal(t, `["ws1"]`, get())
+	require.Equal(t, 1, requests)
+
+	// Stale responses are revalidated
+	client.Transport.(*cacheTransport).ttl = 0
+	require.Equal(t, `["ws1"]`, get())
+	require.Equal(t, 2, requests)
+	require.Equal(t, 1, notModified)
+
+	// Other requests clear the cache
+	client.Transport.(*cacheTransport).ttl = time.Hour
+	res, err := client.Post(server.URL+"/workspace", "application/json", nil)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	require.Equal(t, `["ws1","ws2"]`, get())
+	require.Equal(t, 4, requests)
+	require.Equal(t, 1, notModified)
+}
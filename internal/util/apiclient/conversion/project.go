@@ -37,6 +37,9 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 			Uptime:    uint64(uptime),
 			GitStatus: ToGitStatus(projectDTO.State.GitStatus),
 		}
+		if projectDTO.State.AgentVersion != nil {
+			projectState.AgentVersion = *projectDTO.State.AgentVersion
+		}
 	}
 
 	var projectBuild *buildconfig.BuildConfig
@@ -47,6 +50,21 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 				FilePath: projectDTO.BuildConfig.Devcontainer.FilePath,
 			}
 		}
+		if projectDTO.BuildConfig.CachedBuild != nil {
+			projectBuild.CachedBuild = &buildconfig.CachedBuild{
+				Image: projectDTO.BuildConfig.CachedBuild.Image,
+				User:  projectDTO.BuildConfig.CachedBuild.User,
+			}
+		}
+	}
+
+	var route *project.ProjectRoute
+	if projectDTO.Route != nil {
+		route = &project.ProjectRoute{
+			Gateway:        projectDTO.Route.Gateway,
+			PortRangeStart: uint16(projectDTO.Route.PortRangeStart),
+			PortRangeEnd:   uint16(projectDTO.Route.PortRangeEnd),
+		}
 	}
 
 	project := &project.Project{
@@ -55,10 +73,26 @@ func ToProject(projectDTO *apiclient.Project) *project.Project {
 		User:                projectDTO.User,
 		BuildConfig:         projectBuild,
 		Repository:          repository,
+		EnvVars:             projectDTO.EnvVars,
 		Target:              projectDTO.Target,
 		WorkspaceId:         projectDTO.WorkspaceId,
 		State:               projectState,
 		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Networking:          project.Networking(projectDTO.GetNetworking()),
+		Hostname:            projectDTO.GetHostname(),
+		Route:               route,
+	}
+
+	for _, mountDTO := range projectDTO.Mounts {
+		project.Mounts = append(project.Mounts, ToMount(mountDTO))
+	}
+
+	for _, commandDTO := range projectDTO.Commands {
+		project.Commands = append(project.Commands, ToCommand(commandDTO))
+	}
+
+	for _, portDTO := range projectDTO.Ports {
+		project.Ports = append(project.Ports, ToPort(portDTO))
 	}
 
 	if projectDTO.Repository.PrNumber != nil {
@@ -153,6 +187,10 @@ func ToProjectConfig(createProjectConfigDto pc_dto.CreateProjectConfigDTO) *conf
 		BuildConfig:         createProjectConfigDto.BuildConfig,
 		EnvVars:             createProjectConfigDto.EnvVars,
 		GitProviderConfigId: createProjectConfigDto.GitProviderConfigId,
+		Mounts:              createProjectConfigDto.Mounts,
+		Commands:            createProjectConfigDto.Commands,
+		Ports:               createProjectConfigDto.Ports,
+		BuildSecrets:        createProjectConfigDto.BuildSecrets,
 	}
 
 	result.RepositoryUrl = createProjectConfigDto.RepositoryUrl
@@ -175,6 +213,10 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 		Repository:          createProjectDto.Source.Repository,
 		EnvVars:             createProjectDto.EnvVars,
 		GitProviderConfigId: createProjectDto.GitProviderConfigId,
+		Mounts:              createProjectDto.Mounts,
+		Commands:            createProjectDto.Commands,
+		Ports:               createProjectDto.Ports,
+		Resources:           createProjectDto.Resources,
 	}
 
 	if createProjectDto.Image != nil {
@@ -185,6 +227,10 @@ func CreateDtoToProject(createProjectDto project_dto.CreateProjectDTO) *project.
 		p.User = *createProjectDto.User
 	}
 
+	if createProjectDto.Architecture != nil {
+		p.Architecture = *createProjectDto.Architecture
+	}
+
 	return p
 }
 
@@ -198,6 +244,90 @@ func CreateConfigDtoToProject(createProjectConfigDto pc_dto.CreateProjectConfigD
 		Repository: &gitprovider.GitRepository{
 			Url: createProjectConfigDto.RepositoryUrl,
 		},
-		EnvVars: createProjectConfigDto.EnvVars,
+		EnvVars:  createProjectConfigDto.EnvVars,
+		Mounts:   createProjectConfigDto.Mounts,
+		Commands: createProjectConfigDto.Commands,
+		Ports:    createProjectConfigDto.Ports,
+	}
+}
+
+func ToMount(mountDTO apiclient.Mount) project.Mount {
+	return project.Mount{
+		Type:     project.MountType(mountDTO.Type),
+		Source:   mountDTO.GetSource(),
+		Target:   mountDTO.Target,
+		ReadOnly: mountDTO.GetReadOnly(),
+		Size:     mountDTO.GetSize(),
+	}
+}
+
+func ToMountDTO(mount project.Mount) apiclient.Mount {
+	mountDTO := apiclient.Mount{
+		Type:   apiclient.MountType(mount.Type),
+		Target: mount.Target,
+	}
+
+	if mount.Source != "" {
+		mountDTO.Source = &mount.Source
+	}
+	if mount.ReadOnly {
+		mountDTO.ReadOnly = &mount.ReadOnly
 	}
+	if mount.Size != "" {
+		mountDTO.Size = &mount.Size
+	}
+
+	return mountDTO
+}
+
+func ToCommand(commandDTO apiclient.ProjectCommand) project.Command {
+	return project.Command{
+		Name:        commandDTO.Name,
+		Command:     commandDTO.Command,
+		Workdir:     commandDTO.GetWorkdir(),
+		Description: commandDTO.GetDescription(),
+	}
+}
+
+func ToCommandDTO(command project.Command) apiclient.ProjectCommand {
+	commandDTO := apiclient.ProjectCommand{
+		Name:    command.Name,
+		Command: command.Command,
+	}
+
+	if command.Workdir != "" {
+		commandDTO.Workdir = &command.Workdir
+	}
+	if command.Description != "" {
+		commandDTO.Description = &command.Description
+	}
+
+	return commandDTO
+}
+
+func ToPort(portDTO apiclient.ProjectPort) project.Port {
+	return project.Port{
+		Name:       portDTO.Name,
+		Port:       uint16(portDTO.Port),
+		Protocol:   project.PortProtocol(portDTO.GetProtocol()),
+		Visibility: project.PortVisibility(portDTO.GetVisibility()),
+	}
+}
+
+func ToPortDTO(port project.Port) apiclient.ProjectPort {
+	portDTO := apiclient.ProjectPort{
+		Name: port.Name,
+		Port: int32(port.Port),
+	}
+
+	if port.Protocol != "" {
+		protocol := apiclient.PortProtocol(port.Protocol)
+		portDTO.Protocol = &protocol
+	}
+	if port.Visibility != "" {
+		visibility := apiclient.PortVisibility(port.Visibility)
+		portDTO.Visibility = &visibility
+	}
+
+	return portDTO
 }
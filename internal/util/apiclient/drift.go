@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// GetProjectsDrift returns the configuration drift of the running workspace projects built from a devcontainer configuration.
+// Projects where the drift couldn't be checked are omitted.
+func GetProjectsDrift(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO) map[string]apiclient.ProjectDrift {
+	projectsDrift := map[string]apiclient.ProjectDrift{}
+
+	for _, project := range workspace.Projects {
+		if project.BuildConfig == nil || project.BuildConfig.Devcontainer == nil {
+			continue
+		}
+
+		if project.State == nil || project.State.Uptime == 0 {
+			continue
+		}
+
+		drift, res, err := apiClient.WorkspaceToolboxAPI.GetProjectDrift(ctx, workspace.Id, project.Name).Execute()
+		if err != nil {
+			log.Debugf("failed to check drift of project %s: %v", project.Name, HandleErrorResponse(res, err))
+			continue
+		}
+
+		projectsDrift[project.Name] = *drift
+	}
+
+	return projectsDrift
+}
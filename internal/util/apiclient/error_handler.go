@@ -4,10 +4,12 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -16,15 +18,34 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/correlation"
 	log "github.com/sirupsen/logrus"
 )
 
 type ApiErrorResponse struct {
-	Error string `json:"error"`
+	Error    string               `json:"error"`
+	Code     common.ErrorCode     `json:"code"`
+	Category common.ErrorCategory `json:"category"`
+	Hint     string               `json:"hint"`
 }
 
 func HandleErrorResponse(res *http.Response, requestErr error) error {
 	if res == nil {
+		if errors.Is(requestErr, context.DeadlineExceeded) {
+			return &common.CodedError{
+				Code:     common.ErrorCodeTimeout,
+				Category: common.ErrorCategoryNetwork,
+				Hint:     fmt.Sprintf("Retry or set %s to raise the timeout", API_TIMEOUT_ENV_VAR),
+				Err:      fmt.Errorf("the Daytona Server did not respond in time: %w", requestErr),
+			}
+		}
+
+		var netErr net.Error
+		if errors.As(requestErr, &netErr) && !errors.Is(requestErr, context.Canceled) {
+			return common.WithErrorCode(requestErr, common.ErrorCodeUnavailable)
+		}
+
 		return requestErr
 	}
 
@@ -62,7 +83,25 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 		checkVersionsMismatch(res)
 	}
 
-	return errors.New(errResponse.Error)
+	err = errors.New(errResponse.Error)
+
+	// The correlation ID lets server admins find the logs of the failed request
+	if correlationId := res.Header.Get(correlation.CORRELATION_ID_HEADER); correlationId != "" {
+		err = fmt.Errorf("%s (correlation ID: %s)", errResponse.Error, correlationId)
+	}
+
+	// Servers that predate error codes only return the message, the error is classified by the status code instead
+	if errResponse.Code == "" {
+		errResponse := middlewares.NewErrorResponse(err, res.StatusCode)
+		return &common.CodedError{Code: errResponse.Code, Category: errResponse.Category, Hint: errResponse.Hint, Err: err}
+	}
+
+	category := errResponse.Category
+	if category == "" {
+		category = errResponse.Code.Category()
+	}
+
+	return &common.CodedError{Code: errResponse.Code, Category: category, Hint: errResponse.Hint, Err: err}
 }
 
 func checkVersionsMismatch(res *http.Response) {
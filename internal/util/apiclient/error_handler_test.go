@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleErrorResponse(t *testing.T) {
+	newResponse := func(statusCode int, body string) *http.Response {
+		header := http.Header{}
+		header.Set("Content-Type", "application/json")
+		header.Set(correlation.CORRELATION_ID_HEADER, "abc")
+		return &http.Response{StatusCode: statusCode, Header: header, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	err := HandleErrorResponse(newResponse(http.StatusForbidden, `{"error":"organization workspace quota exceeded","code":"quota_exceeded","category":"quota","hint":"Delete unused workspaces"}`), nil)
+	require.EqualError(t, err, "organization workspace quota exceeded (correlation ID: abc)")
+
+	codedErr, ok := common.GetCodedError(err)
+	require.True(t, ok)
+	require.Equal(t, common.ErrorCodeQuotaExceeded, codedErr.Code)
+	require.Equal(t, common.ErrorCategoryQuota, codedErr.Category)
+	require.Equal(t, "Delete unused workspaces", codedErr.Hint)
+
+	// Errors of servers without error codes are classified by the status code
+	codedErr, ok = common.GetCodedError(HandleErrorResponse(newResponse(http.StatusNotFound, `{"error":"workspace not found"}`), nil))
+	require.True(t, ok)
+	require.Equal(t, common.ErrorCodeNotFound, codedErr.Code)
+	require.Equal(t, common.ErrorCategoryRequest, codedErr.Category)
+
+	codedErr, ok = common.GetCodedError(HandleErrorResponse(nil, fmt.Errorf("request failed: %w", context.DeadlineExceeded)))
+	require.True(t, ok)
+	require.Equal(t, common.ErrorCodeTimeout, codedErr.Code)
+	require.Equal(t, common.ErrorCategoryNetwork, codedErr.Category)
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/faults"
+)
+
+// faultTransport simulates a slow or unreliable network between the client and the server
+type faultTransport struct {
+	transport http.RoundTripper
+	injector  *faults.Injector
+}
+
+func withFaultInjection(transport http.RoundTripper) http.RoundTripper {
+	injector := faults.GetInjector(faults.LayerClient)
+	if injector == nil {
+		return transport
+	}
+
+	return &faultTransport{
+		transport: transport,
+		injector:  injector,
+	}
+}
+
+func (t *faultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.injector.Inject(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	return t.transport.RoundTrip(req)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/common"
+)
+
+// Interval at which requests rejected because the workspace is busy with another operation are retried
+var operationRetryInterval = 2 * time.Second
+
+// WaitForOperation makes the request and, if wait is set, repeats it for as long as the server rejects it because
+// another operation is in progress on the workspace. The request is made once if wait is not set
+func WaitForOperation(ctx context.Context, wait bool, request func() (*http.Response, error)) (*http.Response, error) {
+	for {
+		res, err := request()
+		if !wait || !isOperationInProgress(res, err) {
+			return res, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return res, err
+		case <-time.After(operationRetryInterval):
+		}
+	}
+}
+
+func isOperationInProgress(res *http.Response, err error) bool {
+	if err == nil || res == nil || res.StatusCode != http.StatusConflict {
+		return false
+	}
+
+	var openApiErr *apiclient.GenericOpenAPIError
+	if !errors.As(err, &openApiErr) {
+		return false
+	}
+
+	var errResponse ApiErrorResponse
+	if json.Unmarshal(openApiErr.Body(), &errResponse) != nil {
+		return false
+	}
+
+	return errResponse.Code == common.ErrorCodeOperationInProgress
+}
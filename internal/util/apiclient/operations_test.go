@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForOperation(t *testing.T) {
+	operationRetryInterval = 10 * time.Millisecond
+
+	busyRequests := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if busyRequests > 0 {
+			busyRequests--
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":"another operation is in progress on the workspace","code":"operation_in_progress","category":"request"}`))
+			return
+		}
+	}))
+	defer server.Close()
+
+	cfg := apiclient.NewConfiguration()
+	cfg.Servers = apiclient.ServerConfigurations{{URL: server.URL}}
+	apiClient := apiclient.NewAPIClient(cfg)
+	ctx := context.Background()
+
+	res, err := WaitForOperation(ctx, false, apiClient.WorkspaceAPI.StopWorkspace(ctx, "ws1").Execute)
+	require.Error(t, err)
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+	require.ErrorContains(t, HandleErrorResponse(res, err), "another operation is in progress")
+
+	_, err = WaitForOperation(ctx, true, apiClient.WorkspaceAPI.StopWorkspace(ctx, "ws1").Execute)
+	require.NoError(t, err)
+	require.Equal(t, 0, busyRequests)
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const API_TIMEOUT_ENV_VAR = "DAYTONA_API_TIMEOUT"
+
+// DefaultRequestTimeout bounds requests whose context has no deadline of its own
+const DefaultRequestTimeout = time.Minute
+
+// LongRequestTimeout is used for routes that block until a provider finishes
+// creating, starting or removing resources and for file transfers
+const LongRequestTimeout = 30 * time.Minute
+
+const healthCheckTimeout = 10 * time.Second
+
+var longRunningRoutes = map[string]*regexp.Regexp{
+	http.MethodGet:    regexp.MustCompile(`/artifact/[^/]+/download/?$`),
+	http.MethodPost:   regexp.MustCompile(`/(workspace(/adopt|/[^/]+(/[^/]+)?/(start|stop|rebuild)|/[^/]+/[^/]+/artifacts|/[^/]+/[^/]+/commands/[^/]+/run)?|build|provider/install|shared-service)/?$`),
+	http.MethodDelete: regexp.MustCompile(`/(workspace|shared-service)/[^/]+/?$`),
+}
+
+var toolboxRoute = regexp.MustCompile(`/workspace/[^/]+/[^/]+/toolbox/`)
+
+var interruptCtx, cancelRequests = context.WithCancel(context.Background())
+
+// InterruptContext is canceled when CancelRequests is called
+func InterruptContext() context.Context {
+	return interruptCtx
+}
+
+// CancelRequests aborts all in-flight and future API requests made by clients from this package
+func CancelRequests() {
+	cancelRequests()
+}
+
+func getRequestTimeout(req *http.Request) time.Duration {
+	if timeoutEnv := os.Getenv(API_TIMEOUT_ENV_VAR); timeoutEnv != "" {
+		timeout, err := time.ParseDuration(timeoutEnv)
+		if err == nil {
+			return timeout
+		}
+		log.Warnf("invalid %s value %q, using defaults", API_TIMEOUT_ENV_VAR, timeoutEnv)
+	}
+
+	if toolboxRoute.MatchString(req.URL.Path) {
+		return LongRequestTimeout
+	}
+
+	if route, ok := longRunningRoutes[req.Method]; ok && route.MatchString(req.URL.Path) {
+		return LongRequestTimeout
+	}
+
+	return DefaultRequestTimeout
+}
+
+// timeoutTransport ties every request to the interrupt context and applies a
+// timeout to requests that do not carry a deadline
+type timeoutTransport struct {
+	transport http.RoundTripper
+}
+
+func newTimeoutTransport() *timeoutTransport {
+	return &timeoutTransport{
+		transport: withFaultInjection(http.DefaultTransport),
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var cancel context.CancelFunc = func() {}
+	if _, ok := ctx.Deadline(); !ok {
+		timeout := getRequestTimeout(req)
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+	}
+
+	ctx, cancelWithInterrupt := context.WithCancel(ctx)
+	stop := context.AfterFunc(interruptCtx, cancelWithInterrupt)
+
+	release := func() {
+		stop()
+		cancelWithInterrupt()
+		cancel()
+	}
+
+	res, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		release()
+		if interruptCtx.Err() != nil {
+			return nil, ErrRequestInterrupted
+		}
+		return nil, err
+	}
+
+	res.Body = &releaseOnClose{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (r *releaseOnClose) Close() error {
+	defer r.release()
+	return r.ReadCloser.Close()
+}
+
+var ErrRequestInterrupted = errors.New("request interrupted")
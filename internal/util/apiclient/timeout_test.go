@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequestTimeout(t *testing.T) {
+	tests := []struct {
+		method  string
+		path    string
+		timeout time.Duration
+	}{
+		{http.MethodGet, "/workspace", DefaultRequestTimeout},
+		{http.MethodPost, "/workspace", LongRequestTimeout},
+		{http.MethodPost, "/workspace/adopt", LongRequestTimeout},
+		{http.MethodPost, "/workspace/ws1/start", LongRequestTimeout},
+		{http.MethodPost, "/workspace/ws1/project1/rebuild", LongRequestTimeout},
+		{http.MethodDelete, "/workspace/ws1", LongRequestTimeout},
+		{http.MethodPost, "/workspace/ws1/project1/toolbox/process/execute", LongRequestTimeout},
+		{http.MethodPost, "/workspace/ws1/project1/commands/test/run", LongRequestTimeout},
+		{http.MethodPost, "/provider/install", LongRequestTimeout},
+		{http.MethodPost, "/shared-service", LongRequestTimeout},
+		{http.MethodDelete, "/shared-service/postgres", LongRequestTimeout},
+		{http.MethodGet, "/shared-service", DefaultRequestTimeout},
+		{http.MethodPost, "/provider/docker-provider/uninstall", DefaultRequestTimeout},
+		{http.MethodPost, "/project-config/config1/prebuild", DefaultRequestTimeout},
+		{http.MethodGet, "/artifact/a1/download", LongRequestTimeout},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, "http://localhost:3986"+tt.path, nil)
+		require.Equal(t, tt.timeout, getRequestTimeout(req), "%s %s", tt.method, tt.path)
+	}
+
+	t.Setenv(API_TIMEOUT_ENV_VAR, "5s")
+	req := httptest.NewRequest(http.MethodPost, "http://localhost:3986/workspace", nil)
+	require.Equal(t, 5*time.Second, getRequestTimeout(req))
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv(API_TIMEOUT_ENV_VAR, "100ms")
+
+	client := &http.Client{Transport: newTimeoutTransport()}
+
+	start := time.Now()
+	_, err := client.Get(server.URL)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
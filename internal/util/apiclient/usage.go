@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// Agents report their usage every 30 seconds, older samples no longer show the live utilization
+const liveUsageWindow = "2m"
+
+// GetProjectsUsage returns the latest resource usage sample of the running workspace projects.
+// Projects without a recent sample are omitted.
+func GetProjectsUsage(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO) map[string]apiclient.ResourceUsageSample {
+	projectsUsage := map[string]apiclient.ResourceUsageSample{}
+
+	samples, res, err := apiClient.WorkspaceAPI.ListWorkspaceUsage(ctx, workspace.Id).Since(liveUsageWindow).Execute()
+	if err != nil {
+		log.Debugf("failed to get resource usage of workspace %s: %v", workspace.Name, HandleErrorResponse(res, err))
+		return projectsUsage
+	}
+
+	running := map[string]bool{}
+	for _, project := range workspace.Projects {
+		running[project.Name] = project.State != nil && project.State.Uptime > 0
+	}
+
+	// Samples are listed in the order they were taken
+	for _, sample := range samples {
+		if running[sample.ProjectName] {
+			projectsUsage[sample.ProjectName] = sample
+		}
+	}
+
+	return projectsUsage
+}
@@ -12,6 +12,7 @@ import (
 	"regexp"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/correlation"
 	"github.com/gorilla/websocket"
 )
 
@@ -53,7 +54,8 @@ func GetWebsocketConn(ctx context.Context, path string, profile *config.Profile,
 	}
 
 	return websocket.DefaultDialer.DialContext(ctx, wsUrl, http.Header{
-		"Authorization": []string{fmt.Sprintf("Bearer %s", apiKey)},
+		"Authorization":                   []string{fmt.Sprintf("Bearer %s", apiKey)},
+		correlation.CORRELATION_ID_HEADER: []string{CorrelationId},
 	})
 }
 
@@ -4,7 +4,9 @@
 package util
 
 import (
+	"encoding/base64"
 	"fmt"
+	"hash/fnv"
 )
 
 func GetFrpcApiDomain(serverId, frpsDomain string) string {
@@ -30,3 +32,11 @@ func GetFrpcRegistryDomain(serverId, frpsDomain string) string {
 func GetFrpcRegistryUrl(protocol, serverId, frpsDomain string) string {
 	return fmt.Sprintf("%s://%s", protocol, GetFrpcRegistryDomain(serverId, frpsDomain))
 }
+
+// GetPublicPortSubdomain returns the subdomain of the frps domain on which a port of a project is published
+func GetPublicPortSubdomain(serverId, workspaceId, projectName string, port uint16) string {
+	h := fnv.New64()
+	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, serverId)))
+
+	return fmt.Sprintf("%d-%s", port, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+}
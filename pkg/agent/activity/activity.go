@@ -0,0 +1,275 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Source string
+
+const (
+	// No activity was observed since the agent started
+	SourceStart Source = "start"
+	// Input or output on a terminal, e.g. an interactive SSH session
+	SourceTerminal Source = "terminal"
+	// CPU usage of an IDE backend running in the project
+	SourceIde Source = "ide"
+	// Modified files in the project directory
+	SourceFiles Source = "files"
+	// An SSH session was opened or closed, or a port was forwarded over SSH
+	SourceSsh Source = "ssh"
+	// Traffic on a port of the project forwarded from the tailnet
+	SourcePorts Source = "ports"
+)
+
+const (
+	defaultInterval = 30 * time.Second
+	// Share of a CPU an IDE backend has to use to count as activity. Idle backends only run housekeeping timers
+	ideCpuThreshold = 0.02
+	// Assumed USER_HZ, the unit of CPU times in /proc/<pid>/stat
+	clockTicks = 100
+	// Stops scanning very large projects instead of walking them every interval
+	maxScannedFiles = 50000
+)
+
+// Command line fragments of IDE backends connected to remotely
+var DefaultIdeProcesses = []string{
+	".vscode-server",
+	".vscode-server-insiders",
+	".cursor-server",
+	".windsurf-server",
+	".openvscode-server",
+	"code-server",
+	"remote-dev-server",
+	"jupyter",
+}
+
+// Directories with generated content that are skipped when looking for modified files
+var ignoredDirs = []string{".git", "node_modules", ".venv", "venv", "__pycache__", ".cache", "target", "dist", "build"}
+
+type Activity struct {
+	At     time.Time
+	Source Source
+}
+
+// Detector tracks user activity in a project beyond network connections, so long-lived sessions
+// with no new connections are not considered idle
+type Detector struct {
+	ProjectDir string
+	// Defaults to DefaultIdeProcesses
+	IdeProcesses []string
+	// Defaults to 30 seconds
+	Interval time.Duration
+	// Overridable for tests. Default to /dev/pts and /proc
+	PtsDir  string
+	ProcDir string
+
+	mutex        sync.Mutex
+	lastActivity Activity
+	lastCheck    time.Time
+	cpuTimes     map[int]uint64
+}
+
+// Start checks for activity every interval until the agent exits
+func (d *Detector) Start() {
+	d.init()
+
+	go func() {
+		for {
+			time.Sleep(d.Interval)
+			d.Check()
+		}
+	}()
+}
+
+func (d *Detector) LastActivity() Activity {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.lastActivity
+}
+
+// Record records activity the agent observed itself, e.g. SSH sessions it serves
+func (d *Detector) Record(source Source) {
+	d.init()
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.record(time.Now(), source)
+}
+
+// Check records activity observed since the previous check
+func (d *Detector) Check() {
+	d.init()
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	now := time.Now()
+	since := d.lastCheck
+
+	if at := d.lastTerminalActivity(); at.After(since) {
+		d.record(at, SourceTerminal)
+	}
+
+	if d.ideActive(now.Sub(since)) {
+		d.record(now, SourceIde)
+	}
+
+	if at := d.lastFileModification(since); at.After(since) {
+		d.record(at, SourceFiles)
+	}
+
+	d.lastCheck = now
+}
+
+func (d *Detector) init() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if !d.lastCheck.IsZero() {
+		return
+	}
+
+	if d.IdeProcesses == nil {
+		d.IdeProcesses = DefaultIdeProcesses
+	}
+	if d.Interval == 0 {
+		d.Interval = defaultInterval
+	}
+	if d.PtsDir == "" {
+		d.PtsDir = "/dev/pts"
+	}
+	if d.ProcDir == "" {
+		d.ProcDir = "/proc"
+	}
+
+	d.lastCheck = time.Now()
+	d.lastActivity = Activity{At: d.lastCheck, Source: SourceStart}
+	// Baseline CPU times so time spent before the agent started does not count
+	d.ideActive(0)
+}
+
+func (d *Detector) record(at time.Time, source Source) {
+	if at.After(d.lastActivity.At) {
+		d.lastActivity = Activity{At: at, Source: source}
+	}
+}
+
+// ideActive reports whether IDE backends used more than the threshold CPU share during the elapsed time
+func (d *Detector) ideActive(elapsed time.Duration) bool {
+	cpuTimes := map[int]uint64{}
+	var used uint64
+
+	entries, err := os.ReadDir(d.ProcDir)
+	if err != nil {
+		return false
+	}
+
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		cmdline, err := os.ReadFile(filepath.Join(d.ProcDir, entry.Name(), "cmdline"))
+		if err != nil || !slices.ContainsFunc(d.IdeProcesses, func(p string) bool { return strings.Contains(string(cmdline), p) }) {
+			continue
+		}
+
+		cpuTime, ok := readCpuTime(filepath.Join(d.ProcDir, entry.Name(), "stat"))
+		if !ok {
+			continue
+		}
+
+		cpuTimes[pid] = cpuTime
+		if previous, ok := d.cpuTimes[pid]; ok && cpuTime > previous {
+			used += cpuTime - previous
+		}
+	}
+
+	d.cpuTimes = cpuTimes
+
+	if elapsed <= 0 {
+		return false
+	}
+
+	return float64(used)/clockTicks > elapsed.Seconds()*ideCpuThreshold
+}
+
+// readCpuTime returns the user and system time of a process in clock ticks
+func readCpuTime(statPath string) (uint64, bool) {
+	content, err := os.ReadFile(statPath)
+	if err != nil {
+		return 0, false
+	}
+
+	// The command name may contain spaces, fields are counted from its closing parenthesis
+	stat := string(content)
+	fields := strings.Fields(stat[strings.LastIndex(stat, ")")+1:])
+	// utime and stime are fields 14 and 15 of the full line
+	if len(fields) < 13 {
+		return 0, false
+	}
+
+	utime, err := strconv.ParseUint(fields[11], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	stime, err := strconv.ParseUint(fields[12], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return utime + stime, true
+}
+
+// lastFileModification returns the latest modification time in the project directory if it is after since
+func (d *Detector) lastFileModification(since time.Time) time.Time {
+	var latest time.Time
+	scanned := 0
+	// Files with modification times in the future, e.g. extracted from archives, would never become idle
+	now := time.Now()
+
+	if d.ProjectDir == "" {
+		return latest
+	}
+
+	_ = filepath.WalkDir(d.ProjectDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		if entry.IsDir() && path != d.ProjectDir && slices.Contains(ignoredDirs, entry.Name()) {
+			return filepath.SkipDir
+		}
+
+		scanned++
+		if scanned > maxScannedFiles {
+			return filepath.SkipAll
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+
+		if info.ModTime().After(since) && info.ModTime().After(latest) && !info.ModTime().After(now) {
+			latest = info.ModTime()
+		}
+
+		return nil
+	})
+
+	return latest
+}
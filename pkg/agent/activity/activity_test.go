@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetector(t *testing.T) {
+	projectDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, "node_modules"), 0755))
+	ptsDir := t.TempDir()
+	procDir := t.TempDir()
+
+	old := time.Now().Add(-time.Hour)
+	pts := filepath.Join(ptsDir, "0")
+	require.NoError(t, os.WriteFile(pts, nil, 0600))
+	require.NoError(t, os.Chtimes(pts, old, old))
+
+	ideDir := filepath.Join(procDir, "42")
+	require.NoError(t, os.MkdirAll(ideDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(ideDir, "cmdline"), []byte("/home/daytona/.vscode-server/bin/node\x00server-main.js"), 0644))
+	writeStat := func(utime int) {
+		stat := "42 (node server) S 1 42 42 0 -1 4194304 100 0 0 0 " + strconv.Itoa(utime) + " 10 0 0 20 0 11 0 100 0 0"
+		require.NoError(t, os.WriteFile(filepath.Join(ideDir, "stat"), []byte(stat), 0644))
+	}
+	writeStat(100)
+
+	d := &Detector{ProjectDir: projectDir, PtsDir: ptsDir, ProcDir: procDir}
+	d.Check()
+	require.Equal(t, SourceStart, d.LastActivity().Source)
+
+	// Typing in a long-lived terminal
+	time.Sleep(10 * time.Millisecond)
+	now := time.Now()
+	require.NoError(t, os.Chtimes(pts, now, old))
+	d.Check()
+	require.Equal(t, SourceTerminal, d.LastActivity().Source)
+
+	// A busy IDE backend
+	time.Sleep(10 * time.Millisecond)
+	writeStat(10000)
+	d.Check()
+	require.Equal(t, SourceIde, d.LastActivity().Source)
+
+	// A saved file, files in ignored directories do not count
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "node_modules", "index.js"), nil, 0644))
+	d.Check()
+	require.Equal(t, SourceIde, d.LastActivity().Source)
+
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main"), 0644))
+	d.Check()
+	require.Equal(t, SourceFiles, d.LastActivity().Source)
+
+	time.Sleep(10 * time.Millisecond)
+	d.Record(SourceSsh)
+	require.Equal(t, SourceSsh, d.LastActivity().Source)
+}
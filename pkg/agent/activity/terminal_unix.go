@@ -0,0 +1,45 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// lastTerminalActivity returns the latest input or output time of the open terminals.
+// The kernel updates the access time on input and the modification time on output.
+func (d *Detector) lastTerminalActivity() time.Time {
+	var latest time.Time
+
+	entries, err := os.ReadDir(d.PtsDir)
+	if err != nil {
+		return latest
+	}
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+
+		var stat unix.Stat_t
+		if unix.Stat(filepath.Join(d.PtsDir, entry.Name()), &stat) != nil {
+			continue
+		}
+
+		for _, ts := range []unix.Timespec{stat.Atim, stat.Mtim} {
+			if t := time.Unix(ts.Unix()); t.After(latest) {
+				latest = t
+			}
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package activity
+
+import "time"
+
+// The agent does not run on Windows, terminals are never reported as active
+func (d *Detector) lastTerminalActivity() time.Time {
+	return time.Time{}
+}
@@ -10,13 +10,17 @@ import (
 	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agent/drift"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -24,6 +28,9 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// Time given to in-flight tailnet connections to finish when the agent is stopped
+const shutdownTimeout = 5 * time.Second
+
 func (a *Agent) Start() error {
 	a.initLogs()
 
@@ -31,11 +38,29 @@ func (a *Agent) Start() error {
 
 	a.startTime = time.Now()
 
-	if a.Config.Mode == agent_config.ModeProject {
+	if a.Activity != nil {
+		a.Activity.Start()
+	}
+
+	if a.Events != nil {
+		go a.Events.Run(context.Background())
+	}
+
+	go a.shutdownOnSignal()
+
+	switch a.Config.Mode {
+	case agent_config.ModeProject:
 		err := a.startProjectMode()
 		if err != nil {
 			return err
 		}
+	case agent_config.ModeVM:
+		err := a.startVMMode()
+		if err != nil {
+			return err
+		}
+	case agent_config.ModeRecovery:
+		log.Infof("Recovery mode enabled, serving SSH from %s", a.Config.ProjectDir)
 	}
 
 	go func() {
@@ -45,9 +70,52 @@ func (a *Agent) Start() error {
 		}
 	}()
 
+	if a.Toolbox != nil {
+		go func() {
+			err := a.Toolbox.Start()
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to start toolbox server: %s", err))
+			}
+		}()
+	}
+
+	// Agentless projects are reached through the API of their target and routed projects through the
+	// tailnet node of another project instead of their own node
+	if a.Tailscale == nil {
+		log.Infof("%s networking enabled, not connecting to the tailnet", a.Config.Networking)
+		select {}
+	}
+
 	return a.Tailscale.Start()
 }
 
+// shutdownOnSignal waits for the agent to be stopped, uploads registered artifacts of the project and
+// disconnects from the tailnet before exiting
+func (a *Agent) shutdownOnSignal() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, os.Interrupt)
+
+	<-sigs
+
+	if a.Config.Mode == agent_config.ModeProject || a.Config.Mode == agent_config.ModeVM {
+		err := a.uploadArtifacts()
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to upload artifacts: %s", err))
+		}
+	}
+
+	if a.Tailscale != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		err := a.Tailscale.Stop(ctx)
+		cancel()
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to stop tailscale server: %s", err))
+		}
+	}
+
+	os.Exit(0)
+}
+
 func (a *Agent) startProjectMode() error {
 	err := a.ensureDefaultProfile()
 	if err != nil {
@@ -59,6 +127,41 @@ func (a *Agent) startProjectMode() error {
 		return err
 	}
 
+	a.setupRepository(project)
+
+	if project.BuildConfig != nil && project.BuildConfig.Devcontainer != nil {
+		err = drift.RecordBaseline(context.Background(), a.Config.ProjectDir, project.BuildConfig.Devcontainer.FilePath)
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to record devcontainer baseline: %s", err))
+		}
+
+		if a.EnvironmentWatcher != nil {
+			a.EnvironmentWatcher.Start(context.Background())
+		}
+	}
+
+	go a.updateProjectStateLoop()
+
+	if a.Events != nil {
+		go a.monitorPressureLoop()
+	}
+
+	if a.Usage != nil {
+		go a.reportUsageLoop()
+	}
+
+	if a.IdleStopper != nil && a.Activity != nil {
+		go a.stopWhenIdleLoop()
+	}
+
+	if a.Updater != nil {
+		go a.updateLoop()
+	}
+
+	return nil
+}
+
+func (a *Agent) setupRepository(project *project.Project) {
 	// Ignoring error because we don't want to fail if the git provider is not found
 	gitProvider, _ := a.getGitProvider(project.Repository.Url)
 
@@ -76,7 +179,8 @@ func (a *Agent) startProjectMode() error {
 		if exists {
 			log.Info("Repository already exists. Skipping clone...")
 		} else {
-			if stat, err := os.Stat(a.Config.ProjectDir); err == nil {
+			// In VM mode, the project directory owner is set to the project user after cloning
+			if stat, err := os.Stat(a.Config.ProjectDir); err == nil && a.Config.Mode == agent_config.ModeProject {
 				ownerUid := stat.Sys().(*syscall.Stat_t).Uid
 				if ownerUid != uint32(os.Getuid()) {
 					chownCmd := exec.Command("sudo", "chown", "-R", fmt.Sprintf("%s:%s", project.User, project.User), a.Config.ProjectDir)
@@ -88,11 +192,13 @@ func (a *Agent) startProjectMode() error {
 			}
 
 			log.Info("Cloning repository...")
+			cloneStart := time.Now()
 			err = a.Git.CloneRepository(project.Repository, auth)
 			if err != nil {
 				log.Error(fmt.Sprintf("failed to clone repository: %s", err))
 			} else {
 				log.Info("Repository cloned")
+				a.reportCreationPhase(apiclient.PhaseClone, cloneStart)
 			}
 		}
 	}
@@ -123,19 +229,6 @@ func (a *Agent) startProjectMode() error {
 	if err != nil {
 		log.Error(fmt.Sprintf("failed to set git config: %s", err))
 	}
-
-	go func() {
-		for {
-			err := a.updateProjectState()
-			if err != nil {
-				log.Error(fmt.Sprintf("failed to update project state: %s", err))
-			}
-
-			time.Sleep(2 * time.Second)
-		}
-	}()
-
-	return nil
 }
 
 func (a *Agent) getProject() (*project.Project, error) {
@@ -229,6 +322,17 @@ func (a *Agent) uptime() int32 {
 	return max(int32(time.Since(a.startTime).Seconds()), 1)
 }
 
+func (a *Agent) updateProjectStateLoop() {
+	for {
+		err := a.updateProjectState()
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to update project state: %s", err))
+		}
+
+		time.Sleep(2 * time.Second)
+	}
+}
+
 func (a *Agent) updateProjectState() error {
 	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
 	if err != nil {
@@ -240,11 +344,94 @@ func (a *Agent) updateProjectState() error {
 		return err
 	}
 
+	// The state is reported every few seconds, a stalled request must not block the next one for long
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
 	uptime := a.uptime()
-	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
+	state := apiclient.SetProjectState{
 		Uptime:    uptime,
 		GitStatus: conversion.ToGitStatusDTO(gitStatus),
-	}).Execute()
+		Version:   &internal.Version,
+	}
+
+	// The gateway of the project forwards its routed ports to the container
+	if a.Config.Networking == string(project.NetworkingRouted) {
+		address, err := getContainerAddress()
+		if err != nil {
+			log.Debugf("failed to get container address: %s", err)
+		} else {
+			state.Address = &address
+		}
+	}
+
+	// The usage of the project container is accounted against the reservations of the target host
+	if a.Config.Mode == agent_config.ModeProject {
+		usage, err := a.usage.sample()
+		if err != nil {
+			log.Debugf("failed to get resource usage: %s", err)
+		} else {
+			state.Usage = &apiclient.Resources{
+				Cpus:   util.Pointer(float32(usage.Cpus)),
+				Memory: util.Pointer(int32(usage.Memory)),
+			}
+		}
+	}
+
+	for _, service := range a.Services {
+		state.Services = append(state.Services, apiclient.ProjectService{
+			Name:     service.Name,
+			Port:     int32(service.Port),
+			Protocol: apiclient.ServiceProtocol(service.Protocol),
+		})
+	}
+
+	if a.PortDetector != nil {
+		detectedPorts, err := a.PortDetector.DetectPorts()
+		if err != nil {
+			log.Debugf("failed to detect ports: %s", err)
+		} else {
+			for _, port := range detectedPorts {
+				state.DetectedPorts = append(state.DetectedPorts, int32(port))
+			}
+			a.publishPortChanges(detectedPorts)
+		}
+	}
+
+	if a.Activity != nil {
+		lastActivity := a.Activity.LastActivity()
+		idleSeconds := int32(time.Since(lastActivity.At).Seconds())
+		source := string(lastActivity.Source)
+		state.IdleSeconds = &idleSeconds
+		state.LastActivitySource = &source
+	}
+
+	if a.EnvironmentWatcher != nil {
+		if changes := a.EnvironmentWatcher.Changes(); changes != nil {
+			state.EnvironmentChanges = &apiclient.EnvironmentChanges{
+				Local:    changes.Local,
+				Upstream: changes.Upstream,
+			}
+		}
+	}
+
+	if a.NodeKeyExpiry != nil {
+		if expiry := a.NodeKeyExpiry(); expiry != nil {
+			state.NodeKeyExpiry = util.Pointer(expiry.Format(time.RFC3339))
+		}
+	}
+
+	ready := true
+	if a.ReadyCheck != nil {
+		err := a.ReadyCheck(ctx)
+		if err != nil {
+			log.Debugf("project not ready: %s", err)
+			ready = false
+		}
+	}
+	state.Ready = &ready
+
+	res, err := apiClient.WorkspaceAPI.SetProjectState(ctx, a.Config.WorkspaceId, a.Config.ProjectName).SetState(state).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/artifact"
+	log "github.com/sirupsen/logrus"
+)
+
+func (a *Agent) uploadArtifacts() error {
+	paths, err := a.getArtifactPaths()
+	if err != nil {
+		return err
+	}
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Uploading artifacts...")
+
+	artifacts, err := apiclient_util.UploadArtifacts(context.Background(), apiClient, a.Config.WorkspaceId, a.Config.ProjectName, a.Config.ProjectDir, paths)
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("Uploaded %d artifacts", len(artifacts)))
+	return nil
+}
+
+func (a *Agent) getArtifactPaths() ([]string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := artifact.NewRegistry(configDir).List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range a.Config.Artifacts {
+		if !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths, nil
+}
@@ -5,8 +5,10 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kelseyhightower/envconfig"
@@ -18,16 +20,80 @@ type DaytonaServerConfig struct {
 	Url    string `envconfig:"DAYTONA_SERVER_URL" validate:"required"`
 	ApiKey string `envconfig:"DAYTONA_SERVER_API_KEY" validate:"required"`
 	ApiUrl string `envconfig:"DAYTONA_SERVER_API_URL" validate:"required"`
+	// Reconnect controls how often the agent retries reaching the Daytona Server after losing the connection
+	Reconnect BackoffPolicy
+}
+
+// BackoffPolicy doubles the retry interval after each failed attempt. Zero durations fall back to the defaults
+type BackoffPolicy struct {
+	// Interval before the first retry. Defaults to 1s
+	InitialInterval time.Duration `envconfig:"DAYTONA_SERVER_RECONNECT_INITIAL_INTERVAL"`
+	// Upper bound of the retry interval. Defaults to 1m
+	MaxInterval time.Duration `envconfig:"DAYTONA_SERVER_RECONNECT_MAX_INTERVAL"`
+	// Fraction of the interval by which each retry is randomly shifted, between 0 and 1. Defaults to 0.2 if not set
+	Jitter *float64 `envconfig:"DAYTONA_SERVER_RECONNECT_JITTER" validate:"omitempty,gte=0,lte=1"`
+	// Time after the first failed attempt at which the agent gives up and exits. Retries forever if 0
+	MaxElapsedTime time.Duration `envconfig:"DAYTONA_SERVER_RECONNECT_MAX_ELAPSED_TIME"`
 }
 
 type Config struct {
 	ProjectDir  string
-	ClientId    string  `envconfig:"DAYTONA_CLIENT_ID" validate:"required"`
-	ProjectName string  `envconfig:"DAYTONA_WS_PROJECT_NAME"`
-	WorkspaceId string  `envconfig:"DAYTONA_WS_ID" validate:"required"`
-	LogFilePath *string `envconfig:"DAYTONA_AGENT_LOG_FILE_PATH"`
-	Server      DaytonaServerConfig
-	Mode        Mode
+	ClientId    string   `envconfig:"DAYTONA_CLIENT_ID" validate:"required"`
+	ProjectName string   `envconfig:"DAYTONA_WS_PROJECT_NAME"`
+	WorkspaceId string   `envconfig:"DAYTONA_WS_ID" validate:"required"`
+	LogFilePath *string  `envconfig:"DAYTONA_AGENT_LOG_FILE_PATH"`
+	Artifacts   []string `envconfig:"DAYTONA_ARTIFACTS"`
+	ProjectUser string   `envconfig:"DAYTONA_PROJECT_USER"`
+	Networking  string   `envconfig:"DAYTONA_AGENT_NETWORKING"`
+	// Tailnet hostname assigned by the server. Derived from the workspace ID and project name if empty
+	Hostname string `envconfig:"DAYTONA_PROJECT_HOSTNAME"`
+	// Gateway is set if other projects of the workspace are routed through the tailnet node of the agent
+	Gateway bool `envconfig:"DAYTONA_AGENT_GATEWAY"`
+	// Tailnet ports mapped to Unix sockets in the <port>:<path>[:<access>] format
+	UnixSockets []string `envconfig:"DAYTONA_AGENT_UNIX_SOCKETS"`
+	// Tailnet UDP ports forwarded to local ports in the <port>[:<target port>[:<idle timeout>]] format
+	UdpPorts []string `envconfig:"DAYTONA_AGENT_UDP_PORTS"`
+	// Tailnet port on which Prometheus metrics of the tailnet proxy are served. Metrics are disabled if 0
+	MetricsPort uint16 `envconfig:"DAYTONA_AGENT_METRICS_PORT"`
+	// Connections proxied from the tailnet are closed after this long without data in either direction. Kept open if 0
+	ConnIdleTimeout time.Duration `envconfig:"DAYTONA_AGENT_CONN_IDLE_TIMEOUT"`
+	// Maximum number of connections proxied from the tailnet at the same time. Unlimited if 0
+	MaxConnections int `envconfig:"DAYTONA_AGENT_MAX_CONNECTIONS" validate:"gte=0"`
+	// Maximum number of connections proxied to a tailnet port at the same time in the <port>:<max connections> format
+	PortConnectionLimits []string `envconfig:"DAYTONA_AGENT_PORT_CONNECTION_LIMITS"`
+	// Size in bytes of the pooled buffers proxied connections are copied through. Defaults to 32KiB if 0
+	ProxyBufferSize int `envconfig:"DAYTONA_AGENT_PROXY_BUFFER_SIZE" validate:"gte=0"`
+	// Compress text responses of the HTTP proxies with gzip for clients that accept it
+	ProxyCompression bool `envconfig:"DAYTONA_AGENT_PROXY_COMPRESSION"`
+	// Register the agent as a persistent tailnet node that keeps its node key and hostname across agent restarts
+	PersistentNode bool `envconfig:"DAYTONA_AGENT_PERSISTENT_NODE"`
+	// Services registered with the server for other workspaces to resolve by name in the <name>:<port>[/<protocol>] format
+	Services []string `envconfig:"DAYTONA_AGENT_SERVICES"`
+	// Local port of the SOCKS5 proxy through which processes reach the services of other workspaces by name. Disabled if 0
+	ServiceProxyPort uint16 `envconfig:"DAYTONA_AGENT_SERVICE_PROXY_PORT"`
+	// Tailnet port of the HTTP reverse proxy that routes requests to local ports by the Host header. Disabled if 0
+	HttpProxyPort uint16 `envconfig:"DAYTONA_AGENT_HTTP_PROXY_PORT"`
+	// Tailnet ports on which local ports are served over HTTPS in the <port>[:<target port>] format. Certificates are
+	// issued by the tailnet CA of the Daytona Server
+	TlsPorts []string `envconfig:"DAYTONA_AGENT_TLS_PORTS"`
+	// Serve SSH on port 22 of the tailnet node to clients that authenticate with an SSH token issued by the Daytona Server as the password
+	TailnetSsh bool `envconfig:"DAYTONA_AGENT_TAILNET_SSH"`
+	// Consecutive failed attempts to reach the tailnet after which connections are tunneled over a WebSocket to the
+	// Daytona Server. Defaults to 5 if 0. Connections are never tunneled if negative
+	TunnelFallbackAttempts int `envconfig:"DAYTONA_AGENT_TUNNEL_FALLBACK_ATTEMPTS"`
+	// The agent asks the server to stop the workspace after the project saw no SSH, port, terminal, IDE or file
+	// activity for this long. Never stopped if 0
+	IdleTimeout time.Duration `envconfig:"DAYTONA_AGENT_IDLE_TIMEOUT"`
+	// Maximum size in bytes of files uploaded through the file browser. Defaults to 100MiB if 0
+	MaxUploadSize int64 `envconfig:"DAYTONA_AGENT_MAX_UPLOAD_SIZE" validate:"gte=0"`
+	// Profiles of other Daytona Servers whose tailnets the agent joins at the same time, e.g. staging. The server of a
+	// profile is configured with DAYTONA_SERVER_<PROFILE>_URL, DAYTONA_SERVER_<PROFILE>_API_URL and
+	// DAYTONA_SERVER_<PROFILE>_API_KEY
+	AdditionalServerProfiles []string `envconfig:"DAYTONA_AGENT_ADDITIONAL_SERVERS"`
+	// Config of the servers of AdditionalServerProfiles, keyed by profile
+	AdditionalServers map[string]DaytonaServerConfig `ignored:"true"`
+	Server            DaytonaServerConfig
+	Mode              Mode
 }
 
 type Mode string
@@ -35,6 +101,10 @@ type Mode string
 const (
 	ModeHost    Mode = "host"
 	ModeProject Mode = "project"
+	// ModeVM runs the project directly on the host, without a container
+	ModeVM Mode = "vm"
+	// ModeRecovery only serves SSH from the recovery container of a project whose container or agent is broken
+	ModeRecovery Mode = "recovery"
 )
 
 var config *Config
@@ -63,12 +133,21 @@ func GetConfig(mode Mode) (*Config, error) {
 		return nil, err
 	}
 
-	if config.Mode == ModeProject {
+	if config.Mode == ModeProject || config.Mode == ModeVM || config.Mode == ModeRecovery {
 		if config.ProjectName == "" {
-			return nil, errors.New("DAYTONA_WS_PROJECT_NAME is required in project mode")
+			return nil, fmt.Errorf("DAYTONA_WS_PROJECT_NAME is required in %s mode", config.Mode)
 		}
 	}
 
+	if config.Mode == ModeVM && config.ProjectUser == "" {
+		return nil, errors.New("DAYTONA_PROJECT_USER is required in vm mode")
+	}
+
+	config.AdditionalServers, err = getAdditionalServers(config.AdditionalServerProfiles, config.Server.Reconnect)
+	if err != nil {
+		return nil, err
+	}
+
 	config.LogFilePath = GetLogFilePath()
 
 	return config, nil
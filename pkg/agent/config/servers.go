@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var serverProfileRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// getAdditionalServers reads the config of the servers of the profiles from the environment. The servers reconnect
+// with the policy of the own server of the agent
+func getAdditionalServers(profiles []string, reconnect BackoffPolicy) (map[string]DaytonaServerConfig, error) {
+	servers := map[string]DaytonaServerConfig{}
+
+	for _, profile := range profiles {
+		if !serverProfileRegex.MatchString(profile) {
+			return nil, fmt.Errorf("invalid server profile %q: only lowercase letters, digits and dashes are allowed", profile)
+		}
+
+		if _, ok := servers[profile]; ok {
+			return nil, fmt.Errorf("duplicate server profile %s", profile)
+		}
+
+		prefix := "DAYTONA_SERVER_" + strings.ToUpper(strings.ReplaceAll(profile, "-", "_"))
+
+		server := DaytonaServerConfig{
+			Url:       os.Getenv(prefix + "_URL"),
+			ApiUrl:    os.Getenv(prefix + "_API_URL"),
+			ApiKey:    os.Getenv(prefix + "_API_KEY"),
+			Reconnect: reconnect,
+		}
+
+		if server.Url == "" || server.ApiUrl == "" || server.ApiKey == "" {
+			return nil, fmt.Errorf("%s_URL, %s_API_URL and %s_API_KEY are required for the server profile %s", prefix, prefix, prefix, profile)
+		}
+
+		servers[profile] = server
+	}
+
+	return servers, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdditionalServers(t *testing.T) {
+	t.Setenv("DAYTONA_SERVER_EU_STAGING_URL", "https://staging.example.com")
+	t.Setenv("DAYTONA_SERVER_EU_STAGING_API_URL", "https://api.staging.example.com")
+	t.Setenv("DAYTONA_SERVER_EU_STAGING_API_KEY", "key")
+
+	reconnect := BackoffPolicy{MaxInterval: time.Minute}
+
+	servers, err := getAdditionalServers([]string{"eu-staging"}, reconnect)
+	require.NoError(t, err)
+	require.Equal(t, map[string]DaytonaServerConfig{
+		"eu-staging": {
+			Url:       "https://staging.example.com",
+			ApiUrl:    "https://api.staging.example.com",
+			ApiKey:    "key",
+			Reconnect: reconnect,
+		},
+	}, servers)
+
+	_, err = getAdditionalServers([]string{"eu-staging", "eu-staging"}, reconnect)
+	require.Error(t, err)
+
+	_, err = getAdditionalServers([]string{"production"}, reconnect)
+	require.ErrorContains(t, err, "DAYTONA_SERVER_PRODUCTION_URL")
+
+	_, err = getAdditionalServers([]string{"../production"}, reconnect)
+	require.Error(t, err)
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	log "github.com/sirupsen/logrus"
+)
+
+// reportCreationPhase sends the time since start to the server as the duration of a creation phase.
+// Failures are only logged since timings are not required for the project to work.
+func (a *Agent) reportCreationPhase(phase apiclient.CreationtimingPhase, start time.Time) {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey, a.Config.ClientId, a.TelemetryEnabled)
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to report %s timing: %s", phase, err))
+		return
+	}
+
+	durations := []apiclient.PhaseDuration{*apiclient.NewPhaseDuration(int32(time.Since(start).Milliseconds()), phase)}
+
+	res, err := apiClient.WorkspaceAPI.RecordProjectCreationTimings(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).Timings(durations).Execute()
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to report %s timing: %s", phase, apiclient_util.HandleErrorResponse(res, err)))
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+)
+
+const digestResolveTimeout = 10 * time.Second
+
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ResolveImageDigest returns the digest the image tag currently points to in its registry. Only registries that allow
+// anonymous pulls are supported
+func ResolveImageDigest(ctx context.Context, image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+
+	if digested, ok := named.(reference.Digested); ok {
+		return digested.Digest().String(), nil
+	}
+
+	tag := "latest"
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+
+	host := reference.Domain(named)
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, digestResolveTimeout)
+	defer cancel()
+
+	manifestUrl := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, reference.Path(named), tag)
+
+	res, err := headManifest(ctx, manifestUrl, "")
+	if err != nil {
+		return "", err
+	}
+	res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		token, err := getAnonymousToken(ctx, res.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return "", err
+		}
+
+		res, err = headManifest(ctx, manifestUrl, token)
+		if err != nil {
+			return "", err
+		}
+		res.Body.Close()
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the manifest of %s: status %d", image, res.StatusCode)
+	}
+
+	digest := res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("registry did not return the digest of %s", image)
+	}
+
+	return digest, nil
+}
+
+func headManifest(ctx context.Context, manifestUrl, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, manifestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return http.DefaultClient.Do(req)
+}
+
+// getAnonymousToken requests a pull token from the token service of a registry challenge
+func getAnonymousToken(ctx context.Context, challenge string) (string, error) {
+	params, err := parseBearerChallenge(challenge)
+	if err != nil {
+		return "", err
+	}
+
+	tokenUrl, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", err
+	}
+
+	query := tokenUrl.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	tokenUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenUrl.String(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get a registry token: status %d", res.StatusCode)
+	}
+
+	var response struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		return "", err
+	}
+
+	if response.Token != "" {
+		return response.Token, nil
+	}
+
+	return response.AccessToken, nil
+}
+
+// parseBearerChallenge parses a WWW-Authenticate header such as
+// Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/ubuntu:pull"
+func parseBearerChallenge(challenge string) (map[string]string, error) {
+	scheme, rest, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, errors.New("registry requires credentials")
+	}
+
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+
+	if params["realm"] == "" {
+		return nil, errors.New("registry challenge has no realm")
+	}
+
+	return params, nil
+}
@@ -0,0 +1,305 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrNoBaseline = errors.New("no devcontainer baseline recorded for the project")
+
+// Snapshot holds the parts of a devcontainer configuration that only take effect after a rebuild
+type Snapshot struct {
+	ConfigFilePath string `json:"configFilePath"`
+	Image          string `json:"image,omitempty"`
+	// ImageDigest is the digest the image tag pointed to. Empty if it could not be resolved
+	ImageDigest    string                 `json:"imageDigest,omitempty"`
+	DockerfileHash string                 `json:"dockerfileHash,omitempty"`
+	Features       map[string]interface{} `json:"features,omitempty"`
+	ContainerEnv   map[string]string      `json:"containerEnv,omitempty"`
+	// Files hashes the files of the environment definition by their path relative to the project directory. Not
+	// recorded in baselines of older agents
+	Files map[string]string `json:"files,omitempty"`
+}
+
+// GetBaselinePath returns the path of the snapshot taken when the project container first started.
+// The baseline is kept in the agent's config dir in the container's writable layer so it survives restarts, but not
+// a rebuild.
+func GetBaselinePath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "drift", "baseline.json"), nil
+}
+
+// TakeSnapshot reads the devcontainer configuration at configFilePath, relative to projectDir
+func TakeSnapshot(projectDir, configFilePath string) (*Snapshot, error) {
+	configPath := filepath.Join(projectDir, configFilePath)
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := devcontainer.ParseConfiguration(content)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{
+		ConfigFilePath: configFilePath,
+		Image:          config.Image,
+		Features:       config.Features,
+		ContainerEnv:   config.ContainerEnv,
+	}
+
+	dockerfile := config.DockerFile
+	if config.Build != nil && config.Build.Dockerfile != "" {
+		dockerfile = config.Build.Dockerfile
+	}
+
+	paths := []string{devcontainerDir, configFilePath}
+
+	if dockerfile != "" {
+		dockerfileContent, err := os.ReadFile(filepath.Join(filepath.Dir(configPath), dockerfile))
+		if err != nil {
+			return nil, err
+		}
+
+		hash := sha256.Sum256(dockerfileContent)
+		snapshot.DockerfileHash = hex.EncodeToString(hash[:])
+
+		paths = append(paths, filepath.Join(filepath.Dir(configFilePath), dockerfile))
+	}
+
+	snapshot.Files, err = hashFiles(projectDir, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// RecordBaseline saves a snapshot of the devcontainer configuration the project was built from.
+// An existing baseline is kept.
+func RecordBaseline(ctx context.Context, projectDir, configFilePath string) error {
+	baselinePath, err := GetBaselinePath()
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(baselinePath); err == nil {
+		return nil
+	}
+
+	snapshot, err := TakeSnapshot(projectDir, configFilePath)
+	if err != nil {
+		return err
+	}
+
+	snapshot.ImageDigest = resolveDigest(ctx, snapshot.Image)
+
+	content, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(baselinePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(baselinePath, content, 0644)
+}
+
+func LoadBaseline() (*Snapshot, error) {
+	baselinePath, err := GetBaselinePath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(baselinePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoBaseline
+		}
+		return nil, err
+	}
+
+	var snapshot Snapshot
+	err = json.Unmarshal(content, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// Check compares the running project with the current devcontainer configuration in projectDir
+func Check(ctx context.Context, projectDir string) (*dto.ProjectDrift, error) {
+	baseline, err := LoadBaseline()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := TakeSnapshot(projectDir, baseline.ConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tag of the image is only checked for a new digest if the digest it was built from is known
+	if baseline.ImageDigest != "" && current.Image == baseline.Image {
+		current.ImageDigest = resolveDigest(ctx, current.Image)
+	}
+
+	env := map[string]string{}
+	for _, envVar := range os.Environ() {
+		key, value, _ := strings.Cut(envVar, "=")
+		env[key] = value
+	}
+
+	differences := Compare(baseline, current, env)
+
+	return &dto.ProjectDrift{
+		Drifted:     len(differences) > 0,
+		Differences: differences,
+	}, nil
+}
+
+// Compare returns the differences between the snapshot the project was built from and the current one.
+// Container env vars are compared against env, the environment of the running project.
+func Compare(baseline, current *Snapshot, env map[string]string) []dto.DriftDifference {
+	differences := []dto.DriftDifference{}
+
+	if baseline.Image != current.Image {
+		differences = append(differences, dto.DriftDifference{
+			Kind:     dto.DriftKindImage,
+			Key:      "image",
+			Expected: current.Image,
+			Actual:   baseline.Image,
+		})
+	}
+
+	if baseline.Image == current.Image && baseline.ImageDigest != "" && current.ImageDigest != "" && baseline.ImageDigest != current.ImageDigest {
+		differences = append(differences, dto.DriftDifference{
+			Kind:     dto.DriftKindImage,
+			Key:      "image digest",
+			Expected: current.ImageDigest,
+			Actual:   baseline.ImageDigest,
+		})
+	}
+
+	if baseline.DockerfileHash != current.DockerfileHash {
+		differences = append(differences, dto.DriftDifference{
+			Kind:     dto.DriftKindDockerfile,
+			Key:      "dockerfile",
+			Expected: shortHash(current.DockerfileHash),
+			Actual:   shortHash(baseline.DockerfileHash),
+		})
+	}
+
+	for _, feature := range sortedKeys(baseline.Features, current.Features) {
+		expected := featureOptions(current.Features, feature)
+		actual := featureOptions(baseline.Features, feature)
+		if expected != actual {
+			differences = append(differences, dto.DriftDifference{
+				Kind:     dto.DriftKindFeature,
+				Key:      feature,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(baseline.ContainerEnv, current.ContainerEnv) {
+		expected, ok := current.ContainerEnv[key]
+		// Values referencing variables are resolved by the devcontainer CLI and can't be compared
+		if strings.Contains(expected, "${") {
+			continue
+		}
+
+		actual, set := env[key]
+		if !ok && !set {
+			continue
+		}
+
+		if expected != actual || ok != set {
+			differences = append(differences, dto.DriftDifference{
+				Kind:     dto.DriftKindEnv,
+				Key:      key,
+				Expected: expected,
+				Actual:   actual,
+			})
+		}
+	}
+
+	return differences
+}
+
+// resolveDigest returns the digest of the image or an empty string if it can not be resolved, e.g. because the registry
+// requires credentials
+func resolveDigest(ctx context.Context, image string) string {
+	if image == "" {
+		return ""
+	}
+
+	digest, err := ResolveImageDigest(ctx, image)
+	if err != nil {
+		log.Debugf("failed to resolve the digest of %s: %s", image, err)
+		return ""
+	}
+
+	return digest
+}
+
+func featureOptions(features map[string]interface{}, feature string) string {
+	options, ok := features[feature]
+	if !ok {
+		return ""
+	}
+
+	content, err := json.Marshal(options)
+	if err != nil {
+		return ""
+	}
+
+	return string(content)
+}
+
+func sortedKeys[T any](maps ...map[string]T) []string {
+	keys := []string{}
+	for _, m := range maps {
+		for key := range m {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/drift"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	baseline := &drift.Snapshot{
+		Image: "mcr.microsoft.com/devcontainers/go:1",
+		Features: map[string]interface{}{
+			"ghcr.io/devcontainers/features/node:1": map[string]interface{}{"version": "18"},
+			"ghcr.io/devcontainers/features/rust:1": map[string]interface{}{},
+		},
+		ContainerEnv: map[string]string{"GOFLAGS": "-mod=mod"},
+	}
+
+	require.Empty(t, drift.Compare(baseline, baseline, map[string]string{"GOFLAGS": "-mod=mod"}))
+
+	current := &drift.Snapshot{
+		Image: "mcr.microsoft.com/devcontainers/go:1.23",
+		Features: map[string]interface{}{
+			"ghcr.io/devcontainers/features/node:1": map[string]interface{}{"version": "20"},
+		},
+		ContainerEnv: map[string]string{
+			"GOFLAGS":   "-mod=mod",
+			"LOG_LEVEL": "debug",
+			"WORKSPACE": "${containerWorkspaceFolder}",
+		},
+	}
+
+	require.Equal(t, []dto.DriftDifference{
+		{Kind: dto.DriftKindImage, Key: "image", Expected: "mcr.microsoft.com/devcontainers/go:1.23", Actual: "mcr.microsoft.com/devcontainers/go:1"},
+		{Kind: dto.DriftKindFeature, Key: "ghcr.io/devcontainers/features/node:1", Expected: `{"version":"20"}`, Actual: `{"version":"18"}`},
+		{Kind: dto.DriftKindFeature, Key: "ghcr.io/devcontainers/features/rust:1", Expected: "", Actual: "{}"},
+		{Kind: dto.DriftKindEnv, Key: "LOG_LEVEL", Expected: "debug", Actual: ""},
+	}, drift.Compare(baseline, current, map[string]string{"GOFLAGS": "-mod=mod"}))
+}
+
+func TestCompareImageDigest(t *testing.T) {
+	baseline := &drift.Snapshot{
+		Image:       "mcr.microsoft.com/devcontainers/go:1",
+		ImageDigest: "sha256:aaa",
+	}
+
+	// Digests that could not be resolved are not compared
+	require.Empty(t, drift.Compare(baseline, &drift.Snapshot{Image: baseline.Image}, nil))
+
+	require.Equal(t, []dto.DriftDifference{
+		{Kind: dto.DriftKindImage, Key: "image digest", Expected: "sha256:bbb", Actual: "sha256:aaa"},
+	}, drift.Compare(baseline, &drift.Snapshot{Image: baseline.Image, ImageDigest: "sha256:bbb"}, nil))
+}
+
+func TestResolveImageDigestOfPinnedImage(t *testing.T) {
+	digest, err := drift.ResolveImageDigest(context.Background(), "ubuntu@sha256:"+strings.Repeat("a", 64))
+	require.NoError(t, err)
+	require.Equal(t, "sha256:"+strings.Repeat("a", 64), digest)
+}
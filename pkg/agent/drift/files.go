@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Directory of the environment definition of a project, watched as a whole since any file in it can be used by the build
+const devcontainerDir = ".devcontainer"
+
+// Files of the environment definition beyond this are not hashed, so that a large directory does not stall the agent
+const maxFiles = 1000
+
+// hashFiles returns the hashes of the files at the paths, relative to projectDir, by their slash separated path.
+// Directories are hashed recursively and missing paths are skipped
+func hashFiles(projectDir string, paths []string) (map[string]string, error) {
+	hashes := map[string]string{}
+
+	for _, path := range paths {
+		err := filepath.WalkDir(filepath.Join(projectDir, path), func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+				return err
+			}
+
+			if len(hashes) >= maxFiles {
+				return fs.SkipAll
+			}
+
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			relPath, err := filepath.Rel(projectDir, filePath)
+			if err != nil {
+				return err
+			}
+
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			hash := sha256.Sum256(content)
+			hashes[filepath.ToSlash(relPath)] = hex.EncodeToString(hash[:])
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return hashes, nil
+}
+
+// getPaths returns the paths of the environment definition of the snapshot
+func (s *Snapshot) getPaths() []string {
+	paths := []string{devcontainerDir, filepath.ToSlash(s.ConfigFilePath)}
+	for path := range s.Files {
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+
+	return paths
+}
+
+// changedFiles returns the files of the environment definition that were added, changed or removed since the baseline
+func changedFiles(baseline map[string]string, current map[string]string) []string {
+	changed := []string{}
+
+	for _, path := range sortedKeys(baseline, current) {
+		if baseline[path] != current[path] {
+			changed = append(changed, path)
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Interval at which the environment definition in the project directory is compared with the baseline
+	watchInterval = 30 * time.Second
+	// Interval at which the upstream branch is fetched to find changes to the environment definition that are not pulled yet
+	upstreamFetchInterval = 5 * time.Minute
+)
+
+// UpstreamGit finds the changes on the upstream branch of the project
+type UpstreamGit interface {
+	FetchUpstream(ctx context.Context) error
+	GetUpstreamChanges(ctx context.Context, paths []string) ([]string, error)
+}
+
+// Watcher watches the environment definition of the project for changes since the baseline, both in the project
+// directory and on the upstream branch, so that users can be told to rebuild the project before the environment
+// silently drifts from its definition
+type Watcher struct {
+	ProjectDir string
+	// Git finds the changes on the upstream branch. Only the project directory is watched if nil
+	Git UpstreamGit
+
+	mutex   sync.Mutex
+	changes *project.EnvironmentChanges
+}
+
+// Start watches the environment definition until the context is canceled
+func (w *Watcher) Start(ctx context.Context) {
+	go func() {
+		lastFetch := time.Time{}
+
+		for {
+			fetch := time.Since(lastFetch) >= upstreamFetchInterval
+			if fetch {
+				lastFetch = time.Now()
+			}
+
+			err := w.check(ctx, fetch)
+			if err != nil && !errors.Is(err, ErrNoBaseline) {
+				log.Debugf("failed to check the environment definition for changes: %s", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchInterval):
+			}
+		}
+	}()
+}
+
+// Changes returns the files of the environment definition that changed since the baseline. Nil if there are none
+func (w *Watcher) Changes() *project.EnvironmentChanges {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.changes
+}
+
+func (w *Watcher) check(ctx context.Context, fetch bool) error {
+	baseline, err := LoadBaseline()
+	if err != nil {
+		return err
+	}
+
+	// Baselines of older agents do not record the files
+	if baseline.Files == nil {
+		return nil
+	}
+
+	paths := baseline.getPaths()
+
+	current, err := hashFiles(w.ProjectDir, paths)
+	if err != nil {
+		return err
+	}
+
+	changes := &project.EnvironmentChanges{
+		Local: changedFiles(baseline.Files, current),
+	}
+
+	if w.Git != nil {
+		if fetch {
+			err = w.Git.FetchUpstream(ctx)
+			if err != nil && !errors.Is(err, project.ErrNoUpstreamBranch) {
+				log.Debugf("failed to fetch the upstream branch: %s", err)
+			}
+		}
+
+		changes.Upstream, err = w.Git.GetUpstreamChanges(ctx, paths)
+		if err != nil && !errors.Is(err, project.ErrNoUpstreamBranch) {
+			log.Debugf("failed to get the upstream changes of the environment definition: %s", err)
+		}
+	}
+
+	if changes.IsEmpty() {
+		changes = nil
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.changes = changes
+
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package drift_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/drift"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUpstreamGit struct {
+	changes []string
+}
+
+func (g *fakeUpstreamGit) FetchUpstream(ctx context.Context) error {
+	return nil
+}
+
+func (g *fakeUpstreamGit) GetUpstreamChanges(ctx context.Context, paths []string) ([]string, error) {
+	return g.changes, nil
+}
+
+func TestWatcher(t *testing.T) {
+	// The baseline is kept in the config dir
+	t.Setenv("DAYTONA_CONFIG_DIR", t.TempDir())
+
+	projectDir := t.TempDir()
+	writeFile(t, projectDir, ".devcontainer/devcontainer.json", `{"build": {"dockerfile": "../docker/Dockerfile"}}`)
+	writeFile(t, projectDir, ".devcontainer/setup.sh", "#!/bin/sh")
+	writeFile(t, projectDir, "docker/Dockerfile", "FROM ubuntu")
+
+	require.NoError(t, drift.RecordBaseline(context.Background(), projectDir, ".devcontainer/devcontainer.json"))
+
+	baseline, err := drift.LoadBaseline()
+	require.NoError(t, err)
+	require.Len(t, baseline.Files, 3)
+
+	writeFile(t, projectDir, ".devcontainer/setup.sh", "#!/bin/bash")
+	writeFile(t, projectDir, ".devcontainer/post-create.sh", "#!/bin/sh")
+	require.NoError(t, os.Remove(filepath.Join(projectDir, "docker/Dockerfile")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := &drift.Watcher{
+		ProjectDir: projectDir,
+		Git:        &fakeUpstreamGit{changes: []string{".devcontainer/devcontainer.json"}},
+	}
+	watcher.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return watcher.Changes() != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, &project.EnvironmentChanges{
+		Local:    []string{".devcontainer/post-create.sh", ".devcontainer/setup.sh", "docker/Dockerfile"},
+		Upstream: []string{".devcontainer/devcontainer.json"},
+	}, watcher.Changes())
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which published events are sent to the Daytona Server
+const eventFlushInterval = 5 * time.Second
+
+// Events kept while the Daytona Server can not be reached. The oldest events are dropped beyond this
+const maxPendingEvents = 500
+
+// Proxy errors of a port are published at most once per interval so that a failing port does not flood the server
+const proxyErrorInterval = time.Minute
+
+// EventPublisher batches the lifecycle and network events of the agent and sends them to the Daytona Server.
+// Batches that could not be sent are retried with the next flush. All methods do nothing on a nil receiver
+type EventPublisher struct {
+	// Send delivers a batch of events to the Daytona Server
+	Send func(ctx context.Context, reports []agentevent.Report) error
+
+	mutex           sync.Mutex
+	pending         []agentevent.Report
+	lastProxyErrors map[uint16]time.Time
+}
+
+// Publish queues the event for the next flush. Port is zero for events that are not about a port
+func (p *EventPublisher) Publish(eventType agentevent.Type, message string, port uint16) {
+	if p == nil {
+		return
+	}
+
+	now := time.Now()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if eventType == agentevent.TypeProxyError {
+		if now.Sub(p.lastProxyErrors[port]) < proxyErrorInterval {
+			return
+		}
+		if p.lastProxyErrors == nil {
+			p.lastProxyErrors = map[uint16]time.Time{}
+		}
+		p.lastProxyErrors[port] = now
+	}
+
+	p.pending = append(p.pending, agentevent.Report{
+		Type:       eventType,
+		Message:    message,
+		Port:       uint32(port),
+		OccurredAt: now,
+	})
+
+	if len(p.pending) > maxPendingEvents {
+		p.pending = p.pending[len(p.pending)-maxPendingEvents:]
+	}
+}
+
+// Run sends the queued events every few seconds until the context is canceled
+func (p *EventPublisher) Run(ctx context.Context) {
+	if p == nil {
+		return
+	}
+
+	ticker := time.NewTicker(eventFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := p.flush(ctx)
+		if err != nil {
+			log.Debugf("failed to publish agent events: %s", err)
+		}
+	}
+}
+
+// flush sends the oldest queued events, at most as many as the server accepts at once. The events are queued again
+// ahead of events published in the meantime if sending them fails
+func (p *EventPublisher) flush(ctx context.Context) error {
+	p.mutex.Lock()
+	batch := p.pending[:min(len(p.pending), agentevent.MaxBatchSize)]
+	p.pending = slices.Clone(p.pending[len(batch):])
+	p.mutex.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	// Events are published every few seconds, a stalled request must not delay the next batch for long
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err := p.Send(ctx, batch)
+	if err == nil {
+		return nil
+	}
+
+	p.mutex.Lock()
+	p.pending = append(batch, p.pending...)
+	if len(p.pending) > maxPendingEvents {
+		p.pending = p.pending[len(p.pending)-maxPendingEvents:]
+	}
+	p.mutex.Unlock()
+
+	return fmt.Errorf("%d events not sent: %w", len(batch), err)
+}
+
+// publishPortChanges publishes the ports the project started or stopped listening on since the previous detection
+func (a *Agent) publishPortChanges(detectedPorts []uint16) {
+	if a.Events == nil {
+		return
+	}
+
+	for _, port := range detectedPorts {
+		if !slices.Contains(a.openPorts, port) {
+			a.Events.Publish(agentevent.TypePortOpened, fmt.Sprintf("The project started listening on port %d", port), port)
+		}
+	}
+
+	for _, port := range a.openPorts {
+		if !slices.Contains(detectedPorts, port) {
+			a.Events.Publish(agentevent.TypePortClosed, fmt.Sprintf("The project stopped listening on port %d", port), port)
+		}
+	}
+
+	a.openPorts = detectedPorts
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventPublisher(t *testing.T) {
+	sent := [][]agentevent.Report{}
+	sendErr := errors.New("server unavailable")
+
+	publisher := &EventPublisher{
+		Send: func(ctx context.Context, reports []agentevent.Report) error {
+			if sendErr != nil {
+				return sendErr
+			}
+			sent = append(sent, reports)
+			return nil
+		},
+	}
+
+	publisher.Publish(agentevent.TypeConnected, "Connected to the tailnet", 0)
+	publisher.Publish(agentevent.TypeProxyError, "Failed to proxy tcp connection", 3000)
+	// Throttled per port
+	publisher.Publish(agentevent.TypeProxyError, "Failed to proxy tcp connection", 3000)
+	publisher.Publish(agentevent.TypeProxyError, "Failed to proxy tcp connection", 8080)
+
+	// Failed batches are kept ahead of events published in the meantime
+	require.Error(t, publisher.flush(context.Background()))
+	publisher.Publish(agentevent.TypePortOpened, "The project started listening on port 5173", 5173)
+
+	sendErr = nil
+	require.NoError(t, publisher.flush(context.Background()))
+	require.Len(t, sent, 1)
+
+	types := []agentevent.Type{}
+	for _, report := range sent[0] {
+		types = append(types, report.Type)
+	}
+	require.Equal(t, []agentevent.Type{agentevent.TypeConnected, agentevent.TypeProxyError, agentevent.TypeProxyError, agentevent.TypePortOpened}, types)
+	require.Equal(t, uint32(8080), sent[0][2].Port)
+
+	// Nothing is sent without events
+	require.NoError(t, publisher.flush(context.Background()))
+	require.Len(t, sent, 1)
+}
+
+func TestEventPublisherDropsOldestEvents(t *testing.T) {
+	publisher := &EventPublisher{}
+
+	for i := 0; i < maxPendingEvents+10; i++ {
+		publisher.Publish(agentevent.TypePortOpened, "The project started listening on a port", uint16(i))
+	}
+
+	require.Len(t, publisher.pending, maxPendingEvents)
+	require.Equal(t, uint32(10), publisher.pending[0].Port)
+}
+
+func TestEventPublisherLimitsBatchSize(t *testing.T) {
+	sent := [][]agentevent.Report{}
+	publisher := &EventPublisher{
+		Send: func(ctx context.Context, reports []agentevent.Report) error {
+			sent = append(sent, reports)
+			return nil
+		},
+	}
+
+	for i := 0; i < agentevent.MaxBatchSize+10; i++ {
+		publisher.Publish(agentevent.TypePortOpened, "The project started listening on a port", uint16(i))
+	}
+
+	require.NoError(t, publisher.flush(context.Background()))
+	require.NoError(t, publisher.flush(context.Background()))
+	require.Len(t, sent, 2)
+	require.Len(t, sent[0], agentevent.MaxBatchSize)
+	require.Len(t, sent[1], 10)
+	require.Equal(t, uint32(agentevent.MaxBatchSize), sent[1][0].Port)
+}
+
+func TestPublishPortChanges(t *testing.T) {
+	a := &Agent{
+		Events: &EventPublisher{},
+	}
+
+	a.publishPortChanges([]uint16{3000, 8080})
+	a.publishPortChanges([]uint16{3000, 5173})
+
+	reports := a.Events.pending
+	require.Len(t, reports, 4)
+	require.Equal(t, agentevent.TypePortOpened, reports[0].Type)
+	require.Equal(t, uint32(3000), reports[0].Port)
+	require.Equal(t, agentevent.TypePortOpened, reports[1].Type)
+	require.Equal(t, uint32(8080), reports[1].Port)
+	require.Equal(t, agentevent.TypePortOpened, reports[2].Type)
+	require.Equal(t, uint32(5173), reports[2].Port)
+	require.Equal(t, agentevent.TypePortClosed, reports[3].Type)
+	require.Equal(t, uint32(8080), reports[3].Port)
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/activity"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the idle time of the project is compared to the idle timeout
+const idleCheckInterval = 30 * time.Second
+
+// Longest warning given before an idle workspace is stopped. Short timeouts are warned a quarter of the timeout ahead
+const maxIdleWarning = 5 * time.Minute
+
+// Time after which the server is asked again to stop the workspace if the previous request failed or the workspace
+// kept running
+const idleStopRetryInterval = 5 * time.Minute
+
+// IdleStopper asks the Daytona Server to stop the workspace once the project has been idle for the timeout. A
+// warning event is published shortly before and again after every period of activity
+type IdleStopper struct {
+	Timeout time.Duration
+	// Stop asks the Daytona Server to stop the workspace of the project. The server decides from the activity the
+	// agents reported whether the workspace is idle
+	Stop func(ctx context.Context) error
+
+	warned  bool
+	retryAt time.Time
+}
+
+func (a *Agent) stopWhenIdleLoop() {
+	for {
+		time.Sleep(idleCheckInterval)
+
+		err := a.IdleStopper.check(context.Background(), time.Now(), a.Activity.LastActivity(), a.Events)
+		if err != nil {
+			log.Errorf("failed to stop idle workspace: %s", err)
+		}
+	}
+}
+
+func (s *IdleStopper) check(ctx context.Context, now time.Time, lastActivity activity.Activity, events *EventPublisher) error {
+	idle := now.Sub(lastActivity.At)
+	warning := min(maxIdleWarning, s.Timeout/4)
+
+	if idle < s.Timeout-warning {
+		s.warned = false
+		s.retryAt = time.Time{}
+		return nil
+	}
+
+	if idle < s.Timeout {
+		if !s.warned {
+			events.Publish(agentevent.TypeIdleWarning, fmt.Sprintf("The workspace will be stopped in %s unless the project is used", (s.Timeout-idle).Round(time.Second)), 0)
+			s.warned = true
+		}
+		return nil
+	}
+
+	if now.Before(s.retryAt) {
+		return nil
+	}
+	s.retryAt = now.Add(idleStopRetryInterval)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	log.Infof("Project idle for %s, stopping the workspace", idle.Round(time.Second))
+
+	return s.Stop(ctx)
+}
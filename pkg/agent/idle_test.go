@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/activity"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdleStopper(t *testing.T) {
+	stops := 0
+	stopErr := errors.New("server unavailable")
+
+	stopper := &IdleStopper{
+		Timeout: time.Hour,
+		Stop: func(ctx context.Context) error {
+			stops++
+			return stopErr
+		},
+	}
+	events := &EventPublisher{}
+
+	now := time.Now()
+	lastActivity := activity.Activity{At: now, Source: activity.SourceSsh}
+
+	require.NoError(t, stopper.check(context.Background(), now.Add(50*time.Minute), lastActivity, events))
+	require.Empty(t, events.pending)
+
+	// Warned once within the last 5 minutes
+	require.NoError(t, stopper.check(context.Background(), now.Add(56*time.Minute), lastActivity, events))
+	require.NoError(t, stopper.check(context.Background(), now.Add(58*time.Minute), lastActivity, events))
+	require.Len(t, events.pending, 1)
+	require.Equal(t, agentevent.TypeIdleWarning, events.pending[0].Type)
+
+	// Failed stops are retried after the retry interval
+	require.Error(t, stopper.check(context.Background(), now.Add(time.Hour), lastActivity, events))
+	require.NoError(t, stopper.check(context.Background(), now.Add(61*time.Minute), lastActivity, events))
+	stopErr = nil
+	require.NoError(t, stopper.check(context.Background(), now.Add(66*time.Minute), lastActivity, events))
+	require.Equal(t, 2, stops)
+
+	// Activity resets the warning
+	lastActivity.At = now.Add(70 * time.Minute)
+	require.NoError(t, stopper.check(context.Background(), now.Add(71*time.Minute), lastActivity, events))
+	require.NoError(t, stopper.check(context.Background(), now.Add(126*time.Minute), lastActivity, events))
+	require.Len(t, events.pending, 2)
+	require.Equal(t, 2, stops)
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// runLifecycleCommands runs the devcontainer lifecycle commands of a project running directly on the VM.
+// onCreateCommand and postCreateCommand only run the first time the project is started.
+// Commands run as the project user, never with the agent privileges.
+func (a *Agent) runLifecycleCommands(p *project.Project) error {
+	if p.BuildConfig == nil || p.BuildConfig.Devcontainer == nil {
+		return nil
+	}
+
+	content, err := os.ReadFile(filepath.Join(a.Config.ProjectDir, p.BuildConfig.Devcontainer.FilePath))
+	if err != nil {
+		return err
+	}
+
+	devcontainerConfig, err := devcontainer.ParseConfiguration(content)
+	if err != nil {
+		return err
+	}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return err
+	}
+
+	createdMarker := filepath.Join(configDir, "lifecycle", fmt.Sprintf("%s-%s.created", a.Config.WorkspaceId, p.Name))
+
+	if _, err := os.Stat(createdMarker); os.IsNotExist(err) {
+		lifecycleStart := time.Now()
+		for _, command := range []devcontainer.Command{devcontainerConfig.OnCreateCommand, devcontainerConfig.PostCreateCommand} {
+			err = a.runLifecycleCommand(command, p.EnvVars)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = os.MkdirAll(filepath.Dir(createdMarker), 0755)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(createdMarker, []byte{}, 0644)
+		if err != nil {
+			return err
+		}
+
+		a.reportCreationPhase(apiclient.PhaseLifecycleCommands, lifecycleStart)
+	}
+
+	return a.runLifecycleCommand(devcontainerConfig.PostStartCommand, p.EnvVars)
+}
+
+// runLifecycleCommand runs a devcontainer command. Object commands run their entries in parallel.
+func (a *Agent) runLifecycleCommand(command devcontainer.Command, envVars map[string]string) error {
+	commands, err := getCommandArgs(command)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(commands))
+
+	for i, args := range commands {
+		wg.Add(1)
+		go func(i int, args []string) {
+			defer wg.Done()
+			errs[i] = a.execAsProjectUser(args, envVars)
+		}(i, args)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (a *Agent) execAsProjectUser(args []string, envVars map[string]string) error {
+	log.Infof("Running %v", args)
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = a.Config.ProjectDir
+	cmd.Env = os.Environ()
+	cmd.Env = append(cmd.Env, a.ProjectUser.Env()...)
+	for key, value := range envVars {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", key, value))
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: a.ProjectUser.Credential(),
+	}
+
+	if a.LogWriter != nil {
+		cmd.Stdout = a.LogWriter
+		cmd.Stderr = a.LogWriter
+	}
+
+	err := cmd.Run()
+	if err != nil {
+		return fmt.Errorf("command %v failed: %w", args, err)
+	}
+
+	return nil
+}
+
+// getCommandArgs converts a devcontainer command to the list of commands to execute.
+// A string runs in a shell, an array runs without a shell and an object contains named commands.
+func getCommandArgs(command devcontainer.Command) ([][]string, error) {
+	switch c := command.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if c == "" {
+			return nil, nil
+		}
+		return [][]string{{"/bin/sh", "-c", c}}, nil
+	case []interface{}:
+		if len(c) == 0 {
+			return nil, nil
+		}
+		args := []string{}
+		for _, arg := range c {
+			argString, ok := arg.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid command argument: %v", arg)
+			}
+			args = append(args, argString)
+		}
+		return [][]string{args}, nil
+	case map[string]interface{}:
+		commands := [][]string{}
+		for _, namedCommand := range c {
+			args, err := getCommandArgs(namedCommand)
+			if err != nil {
+				return nil, err
+			}
+			commands = append(commands, args...)
+		}
+		return commands, nil
+	}
+
+	return nil, fmt.Errorf("invalid command: %v", command)
+}
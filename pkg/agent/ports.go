@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/ports"
+)
+
+// ListeningPortDetector detects the ports processes of the project listen on by reading the listening sockets from procfs
+type ListeningPortDetector struct {
+	// IgnoredPorts are served by the agent itself and are not reported
+	IgnoredPorts []uint16
+	// Only sockets owned by Uid are reported if set. Used in VM mode, where other users share the host with the project
+	Uid *uint32
+}
+
+func (d *ListeningPortDetector) DetectPorts() ([]uint16, error) {
+	sockets, err := ports.GetListeningSockets()
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Filter(sockets), nil
+}
+
+// Filter returns the sorted ports of the sockets that are not ignored, each port once
+func (d *ListeningPortDetector) Filter(sockets []ports.ListeningSocket) []uint16 {
+	detected := []uint16{}
+
+	for _, socket := range sockets {
+		if slices.Contains(d.IgnoredPorts, socket.Port) || slices.Contains(detected, socket.Port) {
+			continue
+		}
+
+		if d.Uid != nil && socket.Uid != *d.Uid {
+			continue
+		}
+
+		detected = append(detected, socket.Port)
+	}
+
+	slices.Sort(detected)
+
+	return detected
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the memory and disk of the project are checked for pressure
+const pressureCheckInterval = 30 * time.Second
+
+// Share of the disk of the project in use above which disk pressure is published. It is published again once the
+// usage dropped below the recovery share and rose above the threshold again
+const (
+	diskPressureThreshold = 0.9
+	diskPressureRecovery  = 0.85
+)
+
+// pressureMonitor publishes an event when the kernel kills a process of the project because the project ran out of
+// memory or when the disk of the project is almost full
+type pressureMonitor struct {
+	// Cgroup of the project container. OOM kills are not checked if empty
+	cgroupDir string
+	// Directory on the disk of the project
+	diskDir string
+
+	lastOomKills uint64
+	diskPressure bool
+}
+
+func (a *Agent) monitorPressureLoop() {
+	monitor := &pressureMonitor{
+		diskDir: a.Config.ProjectDir,
+	}
+
+	// The cgroup root only belongs to the project inside a container
+	if a.Config.Mode == agent_config.ModeProject {
+		monitor.cgroupDir = cgroupDir
+	}
+
+	// Kills before the agent started are not reported
+	if monitor.cgroupDir != "" {
+		oomKills, err := readOomKills(filepath.Join(monitor.cgroupDir, "memory.events"))
+		if err != nil {
+			log.Debugf("failed to read OOM kills: %s", err)
+		}
+		monitor.lastOomKills = oomKills
+	}
+
+	for {
+		time.Sleep(pressureCheckInterval)
+		monitor.check(a.Events)
+	}
+}
+
+func (m *pressureMonitor) check(events *EventPublisher) {
+	if m.cgroupDir != "" {
+		oomKills, err := readOomKills(filepath.Join(m.cgroupDir, "memory.events"))
+		if err != nil {
+			log.Debugf("failed to read OOM kills: %s", err)
+		} else if oomKills > m.lastOomKills {
+			events.Publish(agentevent.TypeOom, fmt.Sprintf("The kernel killed %d processes of the project because it ran out of memory", oomKills-m.lastOomKills), 0)
+			m.lastOomKills = oomKills
+		}
+	}
+
+	usage, err := getDiskUsage(m.diskDir)
+	if err != nil {
+		log.Debugf("failed to get disk usage: %s", err)
+		return
+	}
+
+	if !m.diskPressure && usage >= diskPressureThreshold {
+		events.Publish(agentevent.TypeDiskPressure, fmt.Sprintf("The disk of the project is %.0f%% full", usage*100), 0)
+		m.diskPressure = true
+	} else if m.diskPressure && usage < diskPressureRecovery {
+		m.diskPressure = false
+	}
+}
+
+// readOomKills returns the number of processes of the cgroup killed by the OOM killer
+func readOomKills(eventsPath string) (uint64, error) {
+	file, err := os.Open(eventsPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "oom_kill" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, errors.New("oom_kill not found in " + eventsPath)
+}
+
+// getDiskUsage returns the share of the disk of the directory in use, including space reserved for root
+func getDiskUsage(dir string) (float64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(dir, &stat)
+	if err != nil {
+		return 0, err
+	}
+
+	if stat.Blocks == 0 {
+		return 0, nil
+	}
+
+	return float64(stat.Blocks-stat.Bavail) / float64(stat.Blocks), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/gliderlabs/ssh"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// How long a verified SSH token is accepted without asking the Daytona Server again. Kept well below the lifetime of
+// the tokens so that expired tokens are soon rejected
+const verifiedTokenTtl = time.Minute
+
+// Timeout of the verification of an SSH token by the Daytona Server
+const verifyTokenTimeout = 10 * time.Second
+
+// tokenCache remembers the SSH tokens verified by the Daytona Server so that every new connection
+// of the same client does not hit the server. Only hashes of the tokens are kept
+type tokenCache struct {
+	mutex    sync.Mutex
+	verified map[[sha256.Size]byte]time.Time
+}
+
+func (c *tokenCache) has(token string, now time.Time) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	expiresAt, ok := c.verified[sha256.Sum256([]byte(token))]
+	return ok && now.Before(expiresAt)
+}
+
+func (c *tokenCache) add(token string, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.verified == nil {
+		c.verified = map[[sha256.Size]byte]time.Time{}
+	}
+
+	for hash, expiresAt := range c.verified {
+		if !now.Before(expiresAt) {
+			delete(c.verified, hash)
+		}
+	}
+
+	c.verified[sha256.Sum256([]byte(token))] = now.Add(verifiedTokenTtl)
+}
+
+func (s *Server) passwordHandler(ctx ssh.Context, password string) bool {
+	if password == "" {
+		return false
+	}
+
+	if s.verifiedTokens.has(password, time.Now()) {
+		return true
+	}
+
+	verifyCtx, cancel := context.WithTimeout(ctx, verifyTokenTimeout)
+	defer cancel()
+
+	err := s.VerifyToken(verifyCtx, password)
+	if err != nil {
+		log.Debugf("Rejected ssh connection of %s from %s: %v", ctx.User(), ctx.RemoteAddr(), err)
+		return false
+	}
+
+	s.verifiedTokens.add(password, time.Now())
+	return true
+}
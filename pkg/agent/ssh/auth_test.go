@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+func TestServeTailnet(t *testing.T) {
+	var verifications atomic.Int32
+
+	s := &Server{
+		ProjectDir:        t.TempDir(),
+		DefaultProjectDir: t.TempDir(),
+		VerifyToken: func(ctx context.Context, token string) error {
+			verifications.Add(1)
+			if token != "ssh-token" {
+				return errors.New("access denied")
+			}
+			return nil
+		},
+	}
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.ServeTailnet(ln)
+	}()
+
+	dial := func(password string) error {
+		client, err := gossh.Dial("tcp", ln.Addr().String(), &gossh.ClientConfig{
+			User:            "daytona",
+			Auth:            []gossh.AuthMethod{gossh.Password(password)},
+			HostKeyCallback: gossh.InsecureIgnoreHostKey(),
+			Timeout:         5 * time.Second,
+		})
+		if err != nil {
+			return err
+		}
+		return client.Close()
+	}
+
+	require.Error(t, dial("project-api-key"))
+	require.NoError(t, dial("ssh-token"))
+	require.NoError(t, dial("ssh-token"))
+	// The second connection with the same token is accepted without verifying it again
+	require.Equal(t, int32(2), verifications.Load())
+
+	ln.Close()
+	require.NoError(t, <-done)
+
+	require.Error(t, (&Server{}).ServeTailnet(ln))
+}
+
+func TestTokenCache(t *testing.T) {
+	cache := tokenCache{}
+	now := time.Now()
+
+	require.False(t, cache.has("token", now))
+
+	cache.add("token", now)
+	require.True(t, cache.has("token", now.Add(verifiedTokenTtl-time.Second)))
+	require.False(t, cache.has("token", now.Add(verifiedTokenTtl)))
+	require.False(t, cache.has("other", now))
+}
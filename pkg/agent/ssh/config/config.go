@@ -4,3 +4,9 @@
 package config
 
 const SSH_PORT = 2222
+
+// Port of the resumable session server that keeps SSH sessions open across tailnet reconnections
+const SSH_RESUME_PORT = 2223
+
+// Port on which the tailnet node of the project serves SSH to clients authenticated with an SSH token
+const TAILNET_SSH_PORT = 22
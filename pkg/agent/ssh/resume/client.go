@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resume
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type DialFunc func(ctx context.Context) (net.Conn, error)
+
+type Client struct {
+	Dial DialFunc
+	// Reconnection attempts stop after the timeout. Defaults to DefaultResumeTimeout
+	ResumeTimeout time.Duration
+
+	session *session
+}
+
+// Connect opens a new session on the server. Clients fall back to a plain connection if it fails,
+// e.g. when the agent does not support resumable sessions.
+func (c *Client) Connect(ctx context.Context, out io.Writer) error {
+	conn, err := c.Dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	id, peerReceived, err := handshake(conn, sessionId{}, 0)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	conn.SetDeadline(time.Time{})
+
+	c.session = newSession(id, out)
+
+	return c.session.attach(conn, peerReceived)
+}
+
+// Proxy copies in to the session until the session is closed by either side, reconnecting
+// whenever the transport fails. Connect must be called first.
+func (c *Client) Proxy(ctx context.Context, in io.Reader) error {
+	if c.session == nil {
+		return errors.New("session not connected")
+	}
+
+	go func() {
+		_, err := io.Copy(c.session, in)
+		if err != nil {
+			log.Tracef("failed to read input: %v", err)
+		}
+		c.session.closeLocal()
+		// Give the close frame a chance to reach the server
+		time.Sleep(time.Second)
+		c.session.finish()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.session.finish()
+			return ctx.Err()
+		case <-c.session.done:
+			return nil
+		case <-c.session.detached:
+			err := c.reconnect(ctx)
+			if err != nil {
+				c.session.finish()
+				return err
+			}
+		}
+	}
+}
+
+func (c *Client) reconnect(ctx context.Context) error {
+	timeout := c.ResumeTimeout
+	if timeout == 0 {
+		timeout = DefaultResumeTimeout
+	}
+
+	deadline := time.Now().Add(timeout)
+	backoff := 250 * time.Millisecond
+
+	for {
+		if c.session.isDone() {
+			return nil
+		}
+
+		err := c.resume(ctx)
+		if err == nil {
+			log.Debug("resumed session")
+			return nil
+		}
+		if errors.Is(err, ErrSessionNotFound) {
+			return err
+		}
+
+		if time.Now().Add(backoff).After(deadline) {
+			return errors.New("failed to resume session: " + err.Error())
+		}
+
+		log.Debugf("failed to resume session, retrying in %s: %v", backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, 5*time.Second)
+	}
+}
+
+func (c *Client) resume(ctx context.Context) error {
+	dialCtx, cancel := context.WithTimeout(ctx, handshakeTimeout)
+	defer cancel()
+
+	conn, err := c.Dial(dialCtx)
+	if err != nil {
+		return err
+	}
+
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	_, peerReceived, err := handshake(conn, c.session.id, c.session.getReceived())
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	conn.SetDeadline(time.Time{})
+
+	return c.session.attach(conn, peerReceived)
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resume
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// The stream is split into frames of a type byte, a 4 byte payload length and the payload.
+// Data frames carry the stream offset of their first byte so that frames resent after a
+// reconnection can be deduplicated by the receiver.
+const (
+	frameHello byte = iota + 1
+	frameData
+	frameAck
+	frameClose
+	frameError
+)
+
+const (
+	maxDataSize  = 32 * 1024
+	maxFrameSize = maxDataSize + 8
+	// Data not yet acknowledged by the peer is kept for resending. Writers block once the buffer is full
+	maxUnackedSize = 4 * 1024 * 1024
+	ackThreshold   = 64 * 1024
+
+	keepaliveInterval = 5 * time.Second
+	// A transport is considered dead if nothing was received for this long
+	readTimeout      = 3 * keepaliveInterval
+	handshakeTimeout = 10 * time.Second
+
+	DefaultResumeTimeout = 5 * time.Minute
+)
+
+var ErrSessionNotFound = errors.New("session not found, the agent was probably restarted")
+
+type sessionId [16]byte
+
+func newSessionId() (sessionId, error) {
+	var id sessionId
+	_, err := rand.Read(id[:])
+	return id, err
+}
+
+func (id sessionId) isZero() bool {
+	return id == sessionId{}
+}
+
+func writeFrame(w io.Writer, frameType byte, payload []byte) error {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = frameType
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(payload)))
+	copy(frame[5:], payload)
+
+	_, err := w.Write(frame)
+	return err
+}
+
+func readFrame(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, 5)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[1:5])
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("frame of %d bytes exceeds the maximum size", size)
+	}
+
+	payload := make([]byte, size)
+	_, err = io.ReadFull(r, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return header[0], payload, nil
+}
+
+// The hello frame opens or resumes a session and tells the peer how many bytes were received so far
+func encodeHello(id sessionId, received uint64) []byte {
+	payload := make([]byte, len(id)+8)
+	copy(payload, id[:])
+	binary.BigEndian.PutUint64(payload[len(id):], received)
+	return payload
+}
+
+func decodeHello(payload []byte) (sessionId, uint64, error) {
+	var id sessionId
+	if len(payload) != len(id)+8 {
+		return id, 0, errors.New("invalid hello frame")
+	}
+
+	copy(id[:], payload)
+	return id, binary.BigEndian.Uint64(payload[len(id):]), nil
+}
+
+func encodeOffset(offset uint64, data []byte) []byte {
+	payload := make([]byte, 8+len(data))
+	binary.BigEndian.PutUint64(payload, offset)
+	copy(payload[8:], data)
+	return payload
+}
+
+func decodeOffset(payload []byte) (uint64, []byte, error) {
+	if len(payload) < 8 {
+		return 0, nil, errors.New("invalid frame")
+	}
+
+	return binary.BigEndian.Uint64(payload), payload[8:], nil
+}
+
+// handshake sends the hello frame and waits for the hello of the peer
+func handshake(conn io.ReadWriter, id sessionId, received uint64) (sessionId, uint64, error) {
+	err := writeFrame(conn, frameHello, encodeHello(id, received))
+	if err != nil {
+		return id, 0, err
+	}
+
+	frameType, payload, err := readFrame(conn)
+	if err != nil {
+		return id, 0, err
+	}
+
+	switch frameType {
+	case frameHello:
+		return decodeHello(payload)
+	case frameError:
+		if string(payload) == ErrSessionNotFound.Error() {
+			return id, 0, ErrSessionNotFound
+		}
+		return id, 0, errors.New(string(payload))
+	default:
+		return id, 0, fmt.Errorf("unexpected frame type %d during handshake", frameType)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resume_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/ssh/resume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResumeAfterTransportFailure(t *testing.T) {
+	echoListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer echoListener.Close()
+
+	go func() {
+		for {
+			conn, err := echoListener.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(conn, conn)
+		}
+	}()
+
+	resumeListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer resumeListener.Close()
+
+	server := &resume.Server{TargetAddr: echoListener.Addr().String()}
+	go server.Serve(resumeListener)
+
+	var mutex sync.Mutex
+	var transport net.Conn
+	dials := 0
+
+	client := &resume.Client{
+		Dial: func(ctx context.Context) (net.Conn, error) {
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", resumeListener.Addr().String())
+			if err != nil {
+				return nil, err
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			transport = conn
+			dials++
+
+			return conn, nil
+		},
+	}
+
+	outReader, outWriter := io.Pipe()
+	inReader, inWriter := io.Pipe()
+
+	err = client.Connect(context.Background(), outWriter)
+	require.NoError(t, err)
+
+	proxyErr := make(chan error)
+	go func() {
+		proxyErr <- client.Proxy(context.Background(), inReader)
+	}()
+
+	readString := func(size int) string {
+		buf := make([]byte, size)
+		_, err := io.ReadFull(outReader, buf)
+		require.NoError(t, err)
+		return string(buf)
+	}
+
+	_, err = inWriter.Write([]byte("hello "))
+	require.NoError(t, err)
+	require.Equal(t, "hello ", readString(6))
+
+	// Simulate a tailnet drop
+	mutex.Lock()
+	transport.Close()
+	mutex.Unlock()
+
+	_, err = inWriter.Write([]byte("world"))
+	require.NoError(t, err)
+	require.Equal(t, "world", readString(5))
+
+	mutex.Lock()
+	require.Equal(t, 2, dials)
+	mutex.Unlock()
+
+	inWriter.Close()
+
+	select {
+	case err := <-proxyErr:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("session was not closed")
+	}
+}
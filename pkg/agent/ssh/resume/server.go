@@ -0,0 +1,166 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resume
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Server accepts resumable sessions and forwards each of them to a new connection to the target address.
+// The target connection is kept open while the client reconnects, so SSH sessions survive tailnet drops.
+type Server struct {
+	Port uint16
+	// Address the sessions are forwarded to, e.g. the address of the SSH server
+	TargetAddr string
+	// Sessions that are not resumed within the timeout are closed. Defaults to DefaultResumeTimeout
+	ResumeTimeout time.Duration
+
+	mutex    sync.Mutex
+	sessions map[sessionId]*session
+}
+
+func (s *Server) Start() error {
+	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Port))
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Starting resumable session server on port %d...\n", s.Port)
+	return s.Serve(ln)
+}
+
+func (s *Server) Serve(ln net.Listener) error {
+	s.mutex.Lock()
+	s.sessions = map[sessionId]*session{}
+	s.mutex.Unlock()
+
+	go s.closeExpiredSessions()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			err := s.handleConn(conn)
+			if err != nil {
+				log.Debugf("failed to open resumable session: %v", err)
+				conn.Close()
+			}
+		}()
+	}
+}
+
+func (s *Server) handleConn(conn net.Conn) error {
+	conn.SetDeadline(time.Now().Add(handshakeTimeout))
+
+	frameType, payload, err := readFrame(conn)
+	if err != nil {
+		return err
+	}
+	if frameType != frameHello {
+		return fmt.Errorf("unexpected frame type %d", frameType)
+	}
+
+	id, peerReceived, err := decodeHello(payload)
+	if err != nil {
+		return err
+	}
+
+	var sess *session
+	if id.isZero() {
+		sess, err = s.openSession()
+		if err != nil {
+			writeFrame(conn, frameError, []byte(err.Error()))
+			return err
+		}
+	} else {
+		s.mutex.Lock()
+		sess = s.sessions[id]
+		s.mutex.Unlock()
+
+		if sess == nil {
+			writeFrame(conn, frameError, []byte(ErrSessionNotFound.Error()))
+			return ErrSessionNotFound
+		}
+	}
+
+	err = writeFrame(conn, frameHello, encodeHello(sess.id, sess.getReceived()))
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Time{})
+
+	return sess.attach(conn, peerReceived)
+}
+
+func (s *Server) openSession() (*session, error) {
+	id, err := newSessionId()
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := net.Dial("tcp", s.TargetAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sess := newSession(id, target)
+
+	s.mutex.Lock()
+	s.sessions[id] = sess
+	s.mutex.Unlock()
+
+	go func() {
+		_, err := io.Copy(sess, target)
+		if err != nil {
+			log.Tracef("resumable session target closed: %v", err)
+		}
+		sess.closeLocal()
+	}()
+
+	go func() {
+		<-sess.done
+		target.Close()
+
+		s.mutex.Lock()
+		delete(s.sessions, id)
+		s.mutex.Unlock()
+	}()
+
+	return sess, nil
+}
+
+func (s *Server) closeExpiredSessions() {
+	timeout := s.ResumeTimeout
+	if timeout == 0 {
+		timeout = DefaultResumeTimeout
+	}
+
+	for {
+		time.Sleep(min(timeout/4, 30*time.Second))
+
+		s.mutex.Lock()
+		sessions := make([]*session, 0, len(s.sessions))
+		for _, sess := range s.sessions {
+			sessions = append(sessions, sess)
+		}
+		s.mutex.Unlock()
+
+		for _, sess := range sessions {
+			detachedAt, detached := sess.getDetachedAt()
+			if detached && time.Since(detachedAt) > timeout {
+				log.Debug("closing resumable session that was not resumed in time")
+				sess.finish()
+			}
+		}
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resume
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// session is one end of a byte stream that outlives the transport connections it is sent over.
+// Bytes written to the session are kept until the peer acknowledges them and are resent when
+// a new transport is attached. Bytes received from the peer are written to out in order.
+type session struct {
+	id  sessionId
+	out io.Writer
+
+	// Serializes writes to the transport so resent data is never interleaved with new data
+	writeMutex sync.Mutex
+	// Serializes writes to out so data is delivered in order while transports are replaced
+	deliverMutex sync.Mutex
+
+	mutex      sync.Mutex
+	spaceCond  *sync.Cond
+	conn       net.Conn
+	unacked    []byte
+	sent       uint64
+	received   uint64
+	acked      uint64
+	closed     bool
+	detachedAt time.Time
+
+	detached chan struct{}
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func newSession(id sessionId, out io.Writer) *session {
+	s := &session{
+		id:         id,
+		out:        out,
+		detachedAt: time.Now(),
+		detached:   make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+	s.spaceCond = sync.NewCond(&s.mutex)
+
+	return s
+}
+
+// Write queues the data for the peer and sends it if a transport is attached
+func (s *session) Write(p []byte) (int, error) {
+	written := 0
+
+	for len(p) > 0 {
+		chunk := p[:min(len(p), maxDataSize)]
+		p = p[len(chunk):]
+
+		s.mutex.Lock()
+		for len(s.unacked) >= maxUnackedSize && !s.isDone() {
+			s.spaceCond.Wait()
+		}
+		s.mutex.Unlock()
+
+		if s.isDone() {
+			return written, io.ErrClosedPipe
+		}
+
+		s.writeMutex.Lock()
+		s.mutex.Lock()
+		offset := s.sent
+		s.unacked = append(s.unacked, chunk...)
+		s.sent += uint64(len(chunk))
+		conn := s.conn
+		s.mutex.Unlock()
+
+		if conn != nil {
+			s.send(conn, frameData, encodeOffset(offset, chunk))
+		}
+		s.writeMutex.Unlock()
+
+		written += len(chunk)
+	}
+
+	return written, nil
+}
+
+// closeLocal tells the peer that the local end of the stream is closed once all queued data is sent
+func (s *session) closeLocal() {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.mutex.Lock()
+	s.closed = true
+	conn := s.conn
+	s.mutex.Unlock()
+
+	if conn != nil {
+		s.send(conn, frameClose, nil)
+	}
+}
+
+// attach replaces the transport of the session and resends the data the peer has not received.
+// Must only be called after the handshake on conn completed.
+func (s *session) attach(conn net.Conn, peerReceived uint64) error {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.mutex.Lock()
+	if s.conn != nil {
+		s.conn.Close()
+	}
+
+	base := s.sent - uint64(len(s.unacked))
+	if peerReceived < base || peerReceived > s.sent {
+		s.mutex.Unlock()
+		conn.Close()
+		return fmt.Errorf("cannot resume session, peer received %d bytes of %d", peerReceived, s.sent)
+	}
+
+	s.unacked = s.unacked[peerReceived-base:]
+	s.spaceCond.Broadcast()
+
+	pending := make([]byte, len(s.unacked))
+	copy(pending, s.unacked)
+	closed := s.closed
+	s.conn = conn
+
+	// Drop the notification of a previous transport failure
+	select {
+	case <-s.detached:
+	default:
+	}
+	s.mutex.Unlock()
+
+	for offset := peerReceived; len(pending) > 0; {
+		chunk := pending[:min(len(pending), maxDataSize)]
+		pending = pending[len(chunk):]
+
+		if !s.send(conn, frameData, encodeOffset(offset, chunk)) {
+			return nil
+		}
+		offset += uint64(len(chunk))
+	}
+
+	if closed {
+		s.send(conn, frameClose, nil)
+	}
+
+	go s.readLoop(conn)
+	go s.keepalive(conn)
+
+	return nil
+}
+
+// send writes a frame to the transport and detaches it if the write fails. The write mutex must be held.
+func (s *session) send(conn net.Conn, frameType byte, payload []byte) bool {
+	conn.SetWriteDeadline(time.Now().Add(readTimeout))
+
+	err := writeFrame(conn, frameType, payload)
+	if err != nil {
+		log.Tracef("failed to write to transport: %v", err)
+		s.detach(conn)
+		return false
+	}
+
+	return true
+}
+
+func (s *session) detach(conn net.Conn) {
+	conn.Close()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.conn != conn {
+		return
+	}
+
+	s.conn = nil
+	s.detachedAt = time.Now()
+
+	select {
+	case s.detached <- struct{}{}:
+	default:
+	}
+}
+
+func (s *session) readLoop(conn net.Conn) {
+	defer s.detach(conn)
+
+	for {
+		conn.SetReadDeadline(time.Now().Add(readTimeout))
+
+		frameType, payload, err := readFrame(conn)
+		if err != nil {
+			log.Tracef("failed to read from transport: %v", err)
+			return
+		}
+
+		switch frameType {
+		case frameData:
+			offset, data, err := decodeOffset(payload)
+			if err != nil {
+				log.Debug(err)
+				return
+			}
+
+			err = s.deliver(conn, offset, data)
+			if err != nil {
+				log.Debug(err)
+				return
+			}
+		case frameAck:
+			if len(payload) != 8 {
+				return
+			}
+			s.handleAck(binary.BigEndian.Uint64(payload))
+		case frameClose:
+			// Confirm the close so the peer can end its side of the session as well
+			s.mutex.Lock()
+			closed := s.closed
+			s.mutex.Unlock()
+
+			if !closed {
+				s.closeLocal()
+			}
+			s.finish()
+			return
+		default:
+			log.Debugf("unexpected frame type %d", frameType)
+			return
+		}
+	}
+}
+
+func (s *session) deliver(conn net.Conn, offset uint64, data []byte) error {
+	s.deliverMutex.Lock()
+	defer s.deliverMutex.Unlock()
+
+	s.mutex.Lock()
+	if offset > s.received {
+		s.mutex.Unlock()
+		return fmt.Errorf("frame starts at offset %d but only %d bytes were received", offset, s.received)
+	}
+
+	// Skip data that was received before the transport was replaced
+	data = data[min(uint64(len(data)), s.received-offset):]
+	s.received += uint64(len(data))
+	ack := s.received-s.acked >= ackThreshold
+	s.mutex.Unlock()
+
+	if len(data) > 0 {
+		_, err := s.out.Write(data)
+		if err != nil {
+			s.finish()
+			return err
+		}
+	}
+
+	if ack {
+		s.sendAck(conn)
+	}
+
+	return nil
+}
+
+func (s *session) handleAck(received uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	base := s.sent - uint64(len(s.unacked))
+	if received <= base || received > s.sent {
+		return
+	}
+
+	s.unacked = s.unacked[received-base:]
+	s.spaceCond.Broadcast()
+}
+
+func (s *session) sendAck(conn net.Conn) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.mutex.Lock()
+	received := s.received
+	s.acked = received
+	s.mutex.Unlock()
+
+	payload := make([]byte, 8)
+	binary.BigEndian.PutUint64(payload, received)
+	s.send(conn, frameAck, payload)
+}
+
+// keepalive acknowledges received data periodically, which also lets the peer detect dead transports
+func (s *session) keepalive(conn net.Conn) {
+	ticker := time.NewTicker(keepaliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.mutex.Lock()
+			attached := s.conn == conn
+			s.mutex.Unlock()
+
+			if !attached {
+				return
+			}
+
+			s.sendAck(conn)
+		}
+	}
+}
+
+func (s *session) getReceived() uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.received
+}
+
+func (s *session) getDetachedAt() (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.detachedAt, s.conn == nil
+}
+
+func (s *session) isDone() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// finish ends the session and closes the transport
+func (s *session) finish() {
+	s.doneOnce.Do(func() {
+		close(s.done)
+
+		s.mutex.Lock()
+		if s.conn != nil {
+			s.conn.Close()
+		}
+		s.spaceCond.Broadcast()
+		s.mutex.Unlock()
+	})
+}
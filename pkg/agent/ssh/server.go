@@ -4,8 +4,11 @@
 package ssh
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"os/exec"
 	"strings"
@@ -14,6 +17,7 @@ import (
 
 	"github.com/creack/pty"
 	"github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/agent/ssh/resume"
 	"github.com/gliderlabs/ssh"
 	"github.com/pkg/sftp"
 	"golang.org/x/sys/unix"
@@ -24,15 +28,69 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	// VerifyToken checks the SSH token clients on the tailnet authenticate with as the password. Required by ServeTailnet
+	VerifyToken func(ctx context.Context, token string) error
+	// PEM encoded host key of the project. A host key is generated on every start if empty
+	HostKey string
+	// RecordActivity is called when a session is opened or closed and when a port is forwarded. Optional
+	RecordActivity func()
+
+	verifiedTokens tokenCache
 }
 
 func (s *Server) Start() error {
+	sshServer, err := s.newSshServer()
+	if err != nil {
+		return err
+	}
+	sshServer.Addr = fmt.Sprintf(":%d", config.SSH_PORT)
+
+	resumeServer := &resume.Server{
+		Port:       config.SSH_RESUME_PORT,
+		TargetAddr: fmt.Sprintf("localhost:%d", config.SSH_PORT),
+	}
+
+	go func() {
+		err := resumeServer.Start()
+		if err != nil {
+			log.Errorf("failed to start resumable session server: %v", err)
+		}
+	}()
+
+	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
+	return sshServer.ListenAndServe()
+}
+
+// ServeTailnet serves SSH on a listener of the tailnet node of the project. Unlike the server started by Start, which
+// is only reached through the tunnel of the Daytona Server, clients must authenticate with an SSH token issued by the Daytona Server as the password
+func (s *Server) ServeTailnet(ln net.Listener) error {
+	if s.VerifyToken == nil {
+		return errors.New("token verification is required to serve ssh on the tailnet")
+	}
+
+	sshServer, err := s.newSshServer()
+	if err != nil {
+		return err
+	}
+	sshServer.PasswordHandler = s.passwordHandler
+
+	err = sshServer.Serve(ln)
+	if errors.Is(err, ssh.ErrServerClosed) || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *Server) newSshServer() (*ssh.Server, error) {
 	forwardedTCPHandler := &ssh.ForwardedTCPHandler{}
 	unixForwardHandler := newForwardedUnixHandler()
 
-	sshServer := ssh.Server{
-		Addr: fmt.Sprintf(":%d", config.SSH_PORT),
+	sshServer := &ssh.Server{
 		Handler: func(session ssh.Session) {
+			s.recordActivity()
+			defer s.recordActivity()
+
 			switch ss := session.Subsystem(); ss {
 			case "":
 			case "sftp":
@@ -66,9 +124,11 @@ func (s *Server) Start() error {
 			"sftp": s.sftpHandler,
 		},
 		LocalPortForwardingCallback: ssh.LocalPortForwardingCallback(func(ctx ssh.Context, dhost string, dport uint32) bool {
+			s.recordActivity()
 			return true
 		}),
 		ReversePortForwardingCallback: ssh.ReversePortForwardingCallback(func(ctx ssh.Context, host string, port uint32) bool {
+			s.recordActivity()
 			return true
 		}),
 		SessionRequestCallback: func(sess ssh.Session, requestType string) bool {
@@ -76,8 +136,20 @@ func (s *Server) Start() error {
 		},
 	}
 
-	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
-	return sshServer.ListenAndServe()
+	if s.HostKey != "" {
+		err := sshServer.SetOption(ssh.HostKeyPEM([]byte(s.HostKey)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ssh host key: %w", err)
+		}
+	}
+
+	return sshServer, nil
+}
+
+func (s *Server) recordActivity() {
+	if s.RecordActivity != nil {
+		s.RecordActivity()
+	}
 }
 
 func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh.Window) {
@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"tailscale.com/tailcfg"
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the agent refreshes the access policy of the project
+const accessPolicyRefreshInterval = 10 * time.Second
+
+// refreshAccessPolicy keeps the access policy in sync with the server. The last known policy is kept if the server is unreachable
+func (s *Server) refreshAccessPolicy(ctx context.Context) {
+	for {
+		requestCtx, cancel := context.WithTimeout(ctx, accessPolicyRefreshInterval)
+		policy, err := s.GetAccessPolicy(requestCtx)
+		cancel()
+
+		if err != nil {
+			log.Debugf("Failed to refresh access policy: %v", err)
+		} else {
+			s.accessPolicy.Store(policy)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(accessPolicyRefreshInterval):
+		}
+	}
+}
+
+// allowPeer enforces the access policy on a connection from the tailnet peer to the port.
+// Rejected attempts are logged for auditing
+func (s *Server) allowPeer(tsnetServer *tsnet.Server, src netip.AddrPort, port uint16) bool {
+	policy := s.accessPolicy.Load()
+	if policy == nil || policy.IsEmpty() {
+		return true
+	}
+
+	// Unidentified peers only match rules without peer patterns
+	peer := ""
+	node, err := getPeer(tsnetServer, src)
+	if err != nil {
+		log.Debugf("Failed to identify peer %s: %v", src, err)
+	} else {
+		peer = getPeerName(node)
+	}
+
+	action, rule := policy.Check(port, peer)
+	if action == project.PortAccessActionAllow {
+		return true
+	}
+
+	fields := log.Fields{
+		"peer":   peer,
+		"source": src.String(),
+		"port":   port,
+		"rule":   "default",
+	}
+	if rule >= 0 {
+		fields["rule"] = fmt.Sprint(rule)
+	}
+	log.WithFields(fields).Warn("Rejected tailnet connection by the access policy")
+
+	return false
+}
+
+// getPeerName returns the name the access policy matches the tailnet peer by. Peers are identified by their ACL tags
+// and the name the control server gave their node, since the hostname a node reports can be set to anything by the
+// peer. Only the Daytona Server is named server and only CLI clients have names starting with cli-. Nodes that did
+// not join with a Daytona network key are not identified
+func getPeerName(node *tailcfg.Node) string {
+	name, _, _ := strings.Cut(node.Name, ".")
+
+	switch {
+	case slices.Contains(node.Tags, serverTag):
+		return "server"
+	case hasTagPrefix(node.Tags, clientTagPrefix):
+		if !strings.HasPrefix(name, "cli-") {
+			return ""
+		}
+		return name
+	case hasTagPrefix(node.Tags, workspaceTagPrefix), hasTagPrefix(node.Tags, providerTagPrefix):
+		if project.IsReservedHostname(name) {
+			return ""
+		}
+		return name
+	default:
+		return ""
+	}
+}
+
+// getPeer returns the node of the tailnet peer with the address
+func getPeer(tsnetServer *tsnet.Server, src netip.AddrPort) (*tailcfg.Node, error) {
+	localClient, err := tsnetServer.LocalClient()
+	if err != nil {
+		return nil, err
+	}
+
+	who, err := localClient.WhoIs(context.Background(), src.String())
+	if err != nil {
+		return nil, err
+	}
+	if who.Node == nil {
+		return nil, fmt.Errorf("peer %s not found", src)
+	}
+
+	return who.Node, nil
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func TestGetPeerName(t *testing.T) {
+	clientTag := networkkey.GetScopeTag(networkkey.ScopeClient, "laptop")
+	workspaceTag := networkkey.GetScopeTag(networkkey.ScopeWorkspace, "ws")
+
+	tests := []struct {
+		name string
+		tags []string
+		peer string
+	}{
+		{"server.daytona.local.", []string{serverTag}, "server"},
+		{"cli-laptop.daytona.local.", []string{clientTag}, "cli-laptop"},
+		{"ws-api.daytona.local.", []string{workspaceTag}, "ws-api"},
+		// Workspace nodes can not pass for the server or CLI clients
+		{"server-1.daytona.local.", []string{workspaceTag}, "server-1"},
+		{"server.daytona.local.", []string{workspaceTag}, ""},
+		{"cli-laptop.daytona.local.", []string{workspaceTag}, ""},
+		{"api.daytona.local.", []string{clientTag}, ""},
+		{"cli-laptop.daytona.local.", nil, ""},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.peer, getPeerName(&tailcfg.Node{Name: test.name, Tags: test.tags}), "%s %v", test.name, test.tags)
+	}
+}
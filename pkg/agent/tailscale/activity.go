@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// Minimum time between two reports of activity on the same port. Busy connections would otherwise report on every
+// read
+const activityReportInterval = 10 * time.Second
+
+// activityRecorder reports traffic from the tailnet to the ports of the project, at most once per port per report
+// interval. A nil recorder reports nothing
+type activityRecorder struct {
+	record func(port uint16)
+
+	mutex sync.Mutex
+	last  map[uint16]time.Time
+}
+
+func newActivityRecorder(record func(port uint16)) *activityRecorder {
+	return &activityRecorder{
+		record: record,
+		last:   map[uint16]time.Time{},
+	}
+}
+
+func (r *activityRecorder) observe(port uint16) {
+	if r == nil {
+		return
+	}
+
+	now := time.Now()
+
+	r.mutex.Lock()
+	if now.Sub(r.last[port]) < activityReportInterval {
+		r.mutex.Unlock()
+		return
+	}
+	r.last[port] = now
+	r.mutex.Unlock()
+
+	r.record(port)
+}
+
+// reader wraps the reader from the peer so that data received from the peer counts as activity on the port
+func (r *activityRecorder) reader(reader io.Reader, port uint16) io.Reader {
+	if r == nil {
+		return reader
+	}
+	return &activityReader{Reader: reader, recorder: r, port: port}
+}
+
+type activityReader struct {
+	io.Reader
+	recorder *activityRecorder
+	port     uint16
+}
+
+func (r *activityReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.recorder.observe(r.port)
+	}
+	return n, err
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestActivityRecorder(t *testing.T) {
+	recorded := []uint16{}
+	r := newActivityRecorder(func(port uint16) {
+		recorded = append(recorded, port)
+	})
+
+	r.observe(3000)
+	// Throttled per port
+	r.observe(3000)
+	r.observe(8080)
+	require.Equal(t, []uint16{3000, 8080}, recorded)
+
+	data, err := io.ReadAll(r.reader(strings.NewReader("daytona"), 5173))
+	require.NoError(t, err)
+	require.Equal(t, "daytona", string(data))
+	require.Equal(t, []uint16{3000, 8080, 5173}, recorded)
+
+	var nilRecorder *activityRecorder
+	nilRecorder.observe(3000)
+	reader := strings.NewReader("daytona")
+	require.Equal(t, reader, nilRecorder.reader(reader, 3000))
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+)
+
+const (
+	defaultReconnectInitialInterval = time.Second
+	defaultReconnectMaxInterval     = time.Minute
+	defaultReconnectJitter          = 0.2
+)
+
+// ErrReconnectBudgetExhausted is returned once the agent gave up reconnecting to the Daytona Server.
+// The agent exits with it so the supervisor can restart it from a clean state
+var ErrReconnectBudgetExhausted = errors.New("gave up reconnecting to the Daytona Server")
+
+// backoff computes the delays between attempts to reach the Daytona Server. It is shared by the
+// network key requests and the tsnet reconnects and reset once the agent is connected
+type backoff struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	jitter          float64
+	maxElapsedTime  time.Duration
+
+	interval     time.Duration
+	firstFailure time.Time
+	now          func() time.Time
+}
+
+func newBackoff(policy config.BackoffPolicy) *backoff {
+	b := &backoff{
+		initialInterval: policy.InitialInterval,
+		maxInterval:     policy.MaxInterval,
+		jitter:          defaultReconnectJitter,
+		maxElapsedTime:  policy.MaxElapsedTime,
+		now:             time.Now,
+	}
+
+	if b.initialInterval <= 0 {
+		b.initialInterval = defaultReconnectInitialInterval
+	}
+	if b.maxInterval <= 0 {
+		b.maxInterval = defaultReconnectMaxInterval
+	}
+	b.maxInterval = max(b.maxInterval, b.initialInterval)
+	if policy.Jitter != nil {
+		b.jitter = *policy.Jitter
+	}
+
+	return b
+}
+
+// Next records a failed attempt and returns the delay before the next one, or ErrReconnectBudgetExhausted
+// if the next attempt would start after the max elapsed time
+func (b *backoff) Next() (time.Duration, error) {
+	now := b.now()
+
+	if b.firstFailure.IsZero() {
+		b.firstFailure = now
+		b.interval = b.initialInterval
+	} else {
+		b.interval = min(b.interval*2, b.maxInterval)
+	}
+
+	delay := b.interval
+	if b.jitter > 0 {
+		delay += time.Duration((rand.Float64()*2 - 1) * b.jitter * float64(b.interval))
+	}
+
+	if b.maxElapsedTime > 0 && now.Add(delay).Sub(b.firstFailure) > b.maxElapsedTime {
+		return 0, ErrReconnectBudgetExhausted
+	}
+
+	return delay, nil
+}
+
+// Reset is called after a successful attempt
+func (b *backoff) Reset() {
+	b.firstFailure = time.Time{}
+	b.interval = 0
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoff(t *testing.T) {
+	now := time.Now()
+
+	b := newBackoff(config.BackoffPolicy{
+		InitialInterval: time.Second,
+		MaxInterval:     4 * time.Second,
+		Jitter:          util.Pointer(0.0),
+		MaxElapsedTime:  10 * time.Second,
+	})
+	b.now = func() time.Time { return now }
+
+	for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		delay, err := b.Next()
+		require.NoError(t, err)
+		require.Equal(t, expected, delay)
+	}
+
+	now = now.Add(7 * time.Second)
+	_, err := b.Next()
+	require.ErrorIs(t, err, ErrReconnectBudgetExhausted)
+
+	b.Reset()
+	delay, err := b.Next()
+	require.NoError(t, err)
+	require.Equal(t, time.Second, delay)
+}
+
+func TestBackoffJitter(t *testing.T) {
+	b := newBackoff(config.BackoffPolicy{})
+
+	for i := 0; i < 100; i++ {
+		b.Reset()
+		delay, err := b.Next()
+		require.NoError(t, err)
+		require.InDelta(t, float64(defaultReconnectInitialInterval), float64(delay), defaultReconnectJitter*float64(defaultReconnectInitialInterval))
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"math"
+	"net"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"golang.org/x/time/rate"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the agent refreshes the bandwidth limit of the project
+const bandwidthLimitRefreshInterval = 10 * time.Second
+
+// bandwidthLimiter throttles the traffic of the connections proxied from the tailnet with token buckets shared by all
+// connections, so that a noisy project can't saturate the uplink of a shared runner. Both directions are unlimited
+// until a limit is set
+type bandwidthLimiter struct {
+	ingress *rate.Limiter
+	egress  *rate.Limiter
+}
+
+func newBandwidthLimiter() *bandwidthLimiter {
+	return &bandwidthLimiter{
+		ingress: rate.NewLimiter(rate.Inf, 0),
+		egress:  rate.NewLimiter(rate.Inf, 0),
+	}
+}
+
+// set replaces the limit of both directions. Directions without a limit are unlimited
+func (l *bandwidthLimiter) set(limit *project.BandwidthLimit) {
+	if limit == nil {
+		limit = &project.BandwidthLimit{}
+	}
+
+	setRate(l.ingress, limit.IngressBytesPerSecond)
+	setRate(l.egress, limit.EgressBytesPerSecond)
+}
+
+func setRate(limiter *rate.Limiter, bytesPerSecond uint64) {
+	if bytesPerSecond == 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+
+	// The bucket holds a second worth of traffic, which also bounds the size of a single read or write
+	limiter.SetBurst(int(min(bytesPerSecond, math.MaxInt32)))
+	limiter.SetLimit(rate.Limit(bytesPerSecond))
+}
+
+// conn limits the rate at which data is read from and written to the connection of a tailnet peer.
+// Returns the connection on a nil receiver
+func (l *bandwidthLimiter) conn(c net.Conn) net.Conn {
+	if l == nil {
+		return c
+	}
+
+	return &rateLimitedConn{Conn: c, ingress: l.ingress, egress: l.egress}
+}
+
+// listener limits the rate of the connections accepted from tailnet peers. Returns the listener on a nil receiver
+func (l *bandwidthLimiter) listener(ln net.Listener) net.Listener {
+	if l == nil {
+		return ln
+	}
+
+	return &rateLimitedListener{Listener: ln, bandwidth: l}
+}
+
+type rateLimitedListener struct {
+	net.Listener
+	bandwidth *bandwidthLimiter
+}
+
+func (ln *rateLimitedListener) Accept() (net.Conn, error) {
+	c, err := ln.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	return ln.bandwidth.conn(c), nil
+}
+
+type rateLimitedConn struct {
+	net.Conn
+	ingress *rate.Limiter
+	egress  *rate.Limiter
+}
+
+func (c *rateLimitedConn) Read(p []byte) (int, error) {
+	if c.ingress.Limit() != rate.Inf {
+		p = p[:min(len(p), max(c.ingress.Burst(), 1))]
+	}
+
+	n, err := c.Conn.Read(p)
+	waitForTokens(c.ingress, n)
+
+	return n, err
+}
+
+func (c *rateLimitedConn) Write(p []byte) (int, error) {
+	written := 0
+
+	for written < len(p) {
+		chunk := len(p) - written
+		if c.egress.Limit() != rate.Inf {
+			chunk = min(chunk, max(c.egress.Burst(), 1))
+		}
+
+		waitForTokens(c.egress, chunk)
+
+		n, err := c.Conn.Write(p[written : written+chunk])
+		written += n
+		if err != nil {
+			return written, err
+		}
+	}
+
+	return written, nil
+}
+
+// waitForTokens blocks until the limiter allows n bytes
+func waitForTokens(limiter *rate.Limiter, n int) {
+	for n > 0 {
+		chunk := n
+		if limiter.Limit() != rate.Inf {
+			chunk = min(n, max(limiter.Burst(), 1))
+		}
+
+		// Only fails if the limit is lowered concurrently, in which case the chunk is sized again
+		if limiter.WaitN(context.Background(), chunk) == nil {
+			n -= chunk
+		}
+	}
+}
+
+// refreshBandwidthLimit keeps the bandwidth limit in sync with the server. The last known limit is kept if the server is
+// unreachable
+func (s *Server) refreshBandwidthLimit(ctx context.Context) {
+	for {
+		requestCtx, cancel := context.WithTimeout(ctx, bandwidthLimitRefreshInterval)
+		limit, err := s.GetBandwidthLimit(requestCtx)
+		cancel()
+
+		if err != nil {
+			log.Debugf("Failed to refresh bandwidth limit: %v", err)
+		} else {
+			s.bandwidth.set(limit)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bandwidthLimitRefreshInterval):
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+// transfer writes size bytes through the connections of a pipe limited by the limiter and returns how long it took
+func transfer(t *testing.T, l *bandwidthLimiter, size int, ingress bool) time.Duration {
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	limited := l.conn(server)
+
+	writer, reader := io.Writer(limited), io.Reader(client)
+	if ingress {
+		writer, reader = client, limited
+	}
+
+	start := time.Now()
+
+	go func() {
+		_, _ = writer.Write(make([]byte, size))
+	}()
+
+	_, err := io.ReadFull(reader, make([]byte, size))
+	require.NoError(t, err)
+
+	return time.Since(start)
+}
+
+func TestBandwidthLimiter(t *testing.T) {
+	l := newBandwidthLimiter()
+
+	// Unlimited until a limit is set
+	require.Less(t, transfer(t, l, 64*1024, false), 200*time.Millisecond)
+
+	l.set(&project.BandwidthLimit{IngressBytesPerSecond: 10000, EgressBytesPerSecond: 20000})
+
+	// The first second worth of traffic is sent at once and the rest at the limited rate
+	require.GreaterOrEqual(t, transfer(t, l, 15000, true), 400*time.Millisecond)
+	require.GreaterOrEqual(t, transfer(t, l, 30000, false), 400*time.Millisecond)
+
+	l.set(nil)
+	require.Less(t, transfer(t, l, 64*1024, true), 200*time.Millisecond)
+}
+
+func TestNilBandwidthLimiter(t *testing.T) {
+	var l *bandwidthLimiter
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	require.Equal(t, server, l.conn(server))
+}
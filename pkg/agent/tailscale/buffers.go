@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"sync"
+)
+
+// DefaultProxyBufferSize is the size of the buffers connections are proxied through if the server does not set one
+const DefaultProxyBufferSize = 32 * 1024
+
+// bufferPool reuses the buffers connections are proxied through so that every connection does not allocate its own.
+// It implements httputil.BufferPool for the http proxies
+type bufferPool struct {
+	size int
+	pool sync.Pool
+}
+
+func newBufferPool(size int) *bufferPool {
+	if size <= 0 {
+		size = DefaultProxyBufferSize
+	}
+
+	p := &bufferPool{size: size}
+	p.pool.New = func() any {
+		buf := make([]byte, size)
+		return &buf
+	}
+
+	return p
+}
+
+// Get returns a buffer from the pool. Buffers are allocated on a nil receiver
+func (p *bufferPool) Get() []byte {
+	if p == nil {
+		return make([]byte, DefaultProxyBufferSize)
+	}
+
+	return *p.pool.Get().(*[]byte)
+}
+
+// Put returns the buffer to the pool. Buffers of another size are dropped
+func (p *bufferPool) Put(buf []byte) {
+	if p == nil || cap(buf) != p.size {
+		return
+	}
+
+	buf = buf[:p.size]
+	p.pool.Put(&buf)
+}
+
+// copy copies from src to dst through a pooled buffer
+func (p *bufferPool) copy(dst io.Writer, src io.Reader) (int64, error) {
+	buf := p.Get()
+	defer p.Put(buf)
+
+	// Hiding ReadFrom and WriteTo makes io.CopyBuffer use the pooled buffer instead of allocating one
+	return io.CopyBuffer(struct{ io.Writer }{dst}, struct{ io.Reader }{src}, buf)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferPool(t *testing.T) {
+	p := newBufferPool(0)
+	require.Len(t, p.Get(), DefaultProxyBufferSize)
+
+	p = newBufferPool(1024)
+	buf := p.Get()
+	require.Len(t, buf, 1024)
+
+	// Buffers of another size are not pooled
+	p.Put(make([]byte, 512))
+	p.Put(buf[:10])
+	require.Len(t, p.Get(), 1024)
+
+	var nilPool *bufferPool
+	require.Len(t, nilPool.Get(), DefaultProxyBufferSize)
+	nilPool.Put(buf)
+}
+
+func TestBufferPoolCopy(t *testing.T) {
+	data := strings.Repeat("daytona", 1000)
+
+	for _, p := range []*bufferPool{newBufferPool(16), nil} {
+		var dst bytes.Buffer
+		n, err := p.copy(&dst, strings.NewReader(data))
+		require.NoError(t, err)
+		require.Equal(t, int64(len(data)), n)
+		require.Equal(t, data, dst.String())
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"compress/gzip"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// compressibleContentTypes are the media types of text protocols whose responses are compressed by the http proxies.
+// Entries ending with a slash match every subtype
+var compressibleContentTypes = []string{
+	"text/",
+	"application/json",
+	"application/x-ndjson",
+	"application/javascript",
+	"application/xml",
+	"image/svg+xml",
+}
+
+// Responses are compressed with the fastest level because they are compressed while being streamed to the client
+var gzipWriters = sync.Pool{
+	New: func() any {
+		w, _ := gzip.NewWriterLevel(io.Discard, gzip.BestSpeed)
+		return w
+	},
+}
+
+// acceptsGzip checks whether the client accepts gzip encoded responses
+func acceptsGzip(r *http.Request) bool {
+	for _, value := range r.Header.Values("Accept-Encoding") {
+		for _, coding := range strings.Split(value, ",") {
+			name, params, _ := strings.Cut(coding, ";")
+			if strings.TrimSpace(name) != "gzip" {
+				continue
+			}
+
+			q, ok := strings.CutPrefix(strings.TrimSpace(params), "q=")
+			if !ok {
+				return true
+			}
+
+			// gzip;q=0 explicitly refuses the encoding
+			weight, err := strconv.ParseFloat(q, 64)
+			return err == nil && weight > 0
+		}
+	}
+
+	return false
+}
+
+// isCompressible checks whether the content type is a text protocol
+func isCompressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, compressible := range compressibleContentTypes {
+		if strings.HasSuffix(compressible, "/") && strings.HasPrefix(mediaType, compressible) || mediaType == compressible {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gzipResponseWriter compresses the body of text responses that are not already encoded by the project.
+// Close must be called once the response is written
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gzipWriter  *gzip.Writer
+	wroteHeader bool
+}
+
+func newGzipResponseWriter(w http.ResponseWriter) *gzipResponseWriter {
+	return &gzipResponseWriter{ResponseWriter: w}
+}
+
+func (w *gzipResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	header := w.Header()
+	header.Add("Vary", "Accept-Encoding")
+
+	compress := statusCode >= http.StatusOK && statusCode != http.StatusNoContent && statusCode != http.StatusNotModified &&
+		header.Get("Content-Encoding") == "" && header.Get("Content-Range") == "" && isCompressible(header.Get("Content-Type"))
+
+	if compress {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		// The strong validator of the project no longer matches the encoded body
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+
+		w.gzipWriter = gzipWriters.Get().(*gzip.Writer)
+		w.gzipWriter.Reset(w.ResponseWriter)
+	}
+
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	if w.gzipWriter == nil {
+		return w.ResponseWriter.Write(b)
+	}
+
+	return w.gzipWriter.Write(b)
+}
+
+// Flush sends the data compressed so far to the client, e.g. for every event of a log stream
+func (w *gzipResponseWriter) Flush() {
+	if w.gzipWriter != nil {
+		w.gzipWriter.Flush()
+	}
+
+	http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController, e.g. to hijack upgraded connections
+func (w *gzipResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Close writes the gzip footer and returns the gzip writer to the pool
+func (w *gzipResponseWriter) Close() error {
+	if w.gzipWriter == nil {
+		return nil
+	}
+
+	err := w.gzipWriter.Close()
+	w.gzipWriter.Reset(io.Discard)
+	gzipWriters.Put(w.gzipWriter)
+	w.gzipWriter = nil
+
+	return err
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptsGzip(t *testing.T) {
+	for value, expected := range map[string]bool{
+		"":                  false,
+		"gzip":              true,
+		"deflate, gzip;q=1": true,
+		"br, gzip; q=0.5":   true,
+		"gzip;q=0":          false,
+		"br, deflate":       false,
+	} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Encoding", value)
+		require.Equal(t, expected, acceptsGzip(r), value)
+	}
+}
+
+func TestIsCompressible(t *testing.T) {
+	for _, contentType := range []string{"text/html; charset=utf-8", "text/event-stream", "application/json", "image/svg+xml"} {
+		require.True(t, isCompressible(contentType), contentType)
+	}
+
+	for _, contentType := range []string{"", "image/png", "application/octet-stream", "application/jsonx"} {
+		require.False(t, isCompressible(contentType), contentType)
+	}
+}
+
+func TestHttpProxyCompression(t *testing.T) {
+	text := strings.Repeat("log line\n", 1000)
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/text":
+			w.Header().Set("Content-Type", "text/plain")
+			fmt.Fprint(w, text)
+		case "/binary":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			fmt.Fprint(w, text)
+		case "/stream":
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: first\n\n")
+			w.(http.Flusher).Flush()
+			// The client reads the first event before the second is sent
+			<-r.Context().Done()
+		}
+	}))
+	defer backend.Close()
+
+	backendUrl, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+	backendPort, err := strconv.Atoi(backendUrl.Port())
+	require.NoError(t, err)
+
+	s := &Server{ProxyCompression: true}
+
+	proxy := httptest.NewServer(s.httpProxyHandler(nil))
+	defer proxy.Close()
+
+	transport := &http.Transport{DisableCompression: true}
+	get := func(path string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, proxy.URL+path, nil)
+		require.NoError(t, err)
+		req.Host = fmt.Sprintf("%d-myws.tailnet.ts.net", backendPort)
+		req.Header.Set("Accept-Encoding", "gzip")
+
+		res, err := transport.RoundTrip(req)
+		require.NoError(t, err)
+		return res
+	}
+
+	res := get("/text")
+	defer res.Body.Close()
+	require.Equal(t, "gzip", res.Header.Get("Content-Encoding"))
+	gzipReader, err := gzip.NewReader(res.Body)
+	require.NoError(t, err)
+	body, err := io.ReadAll(gzipReader)
+	require.NoError(t, err)
+	require.Equal(t, text, string(body))
+
+	res = get("/binary")
+	defer res.Body.Close()
+	require.Empty(t, res.Header.Get("Content-Encoding"))
+	body, err = io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.Equal(t, text, string(body))
+
+	// Flushed events are sent to the client without waiting for the end of the response
+	res = get("/stream")
+	defer res.Body.Close()
+	require.Equal(t, "gzip", res.Header.Get("Content-Encoding"))
+	gzipReader, err = gzip.NewReader(res.Body)
+	require.NoError(t, err)
+	line, err := bufio.NewReader(gzipReader).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "data: first\n", line)
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Number of consecutive failed attempts to reach the tailnet after which the fallback is started if the server does not set one
+const defaultFallbackAttempts = 5
+
+// fallbackRunner starts the fallback transport after consecutive failed attempts to reach the tailnet and stops it
+// once the tailnet is reached again. It is only used from the connection loop of the server
+type fallbackRunner struct {
+	attempts int
+	run      func(ctx context.Context)
+	failures int
+	cancel   context.CancelFunc
+}
+
+// newFallbackRunner returns nil if there is no fallback to run
+func newFallbackRunner(attempts int, run func(ctx context.Context)) *fallbackRunner {
+	if run == nil {
+		return nil
+	}
+
+	if attempts <= 0 {
+		attempts = defaultFallbackAttempts
+	}
+
+	return &fallbackRunner{
+		attempts: attempts,
+		run:      run,
+	}
+}
+
+// report records the result of an attempt to reach the tailnet. Does nothing on a nil receiver
+func (f *fallbackRunner) report(ctx context.Context, reachable bool) {
+	if f == nil {
+		return
+	}
+
+	if reachable {
+		f.failures = 0
+		if f.cancel != nil {
+			log.Info("Reached the tailnet, closing the fallback tunnel")
+			f.cancel()
+			f.cancel = nil
+		}
+		return
+	}
+
+	f.failures++
+	if f.failures < f.attempts || f.cancel != nil {
+		return
+	}
+
+	log.Warnf("Failed to reach the tailnet %d times, tunneling connections through the Daytona Server", f.failures)
+
+	fallbackCtx, cancel := context.WithCancel(ctx)
+	f.cancel = cancel
+	go f.run(fallbackCtx)
+}
+
+// running checks whether the fallback is started
+func (f *fallbackRunner) running() bool {
+	return f != nil && f.cancel != nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFallbackRunner(t *testing.T) {
+	require.Nil(t, newFallbackRunner(3, nil))
+
+	var running atomic.Int32
+	f := newFallbackRunner(3, func(ctx context.Context) {
+		running.Add(1)
+		<-ctx.Done()
+		running.Add(-1)
+	})
+
+	ctx := context.Background()
+
+	f.report(ctx, false)
+	f.report(ctx, false)
+	f.report(ctx, true)
+	f.report(ctx, false)
+	f.report(ctx, false)
+	require.False(t, f.running())
+
+	f.report(ctx, false)
+	require.True(t, f.running())
+	require.Eventually(t, func() bool { return running.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// The fallback is started once while the tailnet stays unreachable
+	f.report(ctx, false)
+	require.Never(t, func() bool { return running.Load() > 1 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	f.report(ctx, true)
+	require.False(t, f.running())
+	require.Eventually(t, func() bool { return running.Load() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestFallbackRunnerDefaultAttempts(t *testing.T) {
+	f := newFallbackRunner(0, func(ctx context.Context) {})
+	require.Equal(t, defaultFallbackAttempts, f.attempts)
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"tailscale.com/ipn/ipnstate"
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const HEALTH_PATH = "/health"
+
+// healthHandler serves the health of the agent as JSON. The status code is 200 even if the agent is degraded,
+// so callers can tell a degraded agent from an unreachable one
+func (n *node) healthHandler(tsnetServer *tsnet.Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var status *ipnstate.Status
+
+		localClient, err := tsnetServer.LocalClient()
+		if err == nil {
+			status, err = localClient.StatusWithoutPeers(r.Context())
+		}
+		if err != nil {
+			log.Debugf("Failed to get tailnet status: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(n.getHealth(r.Context(), status, err))
+		if err != nil {
+			log.Debugf("Failed to write health response: %v", err)
+		}
+	}
+}
+
+func (n *node) getHealth(ctx context.Context, status *ipnstate.Status, statusErr error) *project.AgentHealth {
+	s := n.server
+
+	health := &project.AgentHealth{
+		Uptime:            uint64(time.Since(s.startTime).Seconds()),
+		ActiveConnections: uint32(max(s.activeConnections.Load(), 0)),
+		Processes:         []project.ProcessHealth{},
+	}
+
+	if lastContact := n.lastControlContact.Load(); lastContact != nil {
+		health.LastControlContactAt = lastContact.Format(time.RFC3339)
+	}
+
+	if statusErr != nil {
+		health.Tailnet.State = "Unknown"
+		health.Tailnet.Warnings = []string{statusErr.Error()}
+	} else if status != nil {
+		health.Tailnet.State = status.BackendState
+		health.Tailnet.Warnings = status.Health
+		if status.Self != nil {
+			health.Tailnet.Online = status.Self.Online
+			health.Tailnet.Relay = status.Self.Relay
+		}
+	}
+
+	if relays := n.relays.Load(); relays != nil {
+		health.Tailnet.Relays = *relays
+	}
+
+	names := []string{}
+	for name := range s.ProcessChecks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		processHealth := project.ProcessHealth{Name: name, Healthy: true}
+
+		err := s.ProcessChecks[name](ctx)
+		if err != nil {
+			processHealth.Healthy = false
+			processHealth.Error = err.Error()
+		}
+
+		health.Processes = append(health.Processes, processHealth)
+	}
+
+	health.Status = health.GetStatus()
+
+	return health
+}
+
+// ListenerCheck returns a process check that passes while the process accepts TCP connections on the address
+func ListenerCheck(address string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+
+		return conn.Close()
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/ipn/ipnstate"
+)
+
+func TestGetHealth(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	s := &Server{
+		ProcessChecks: map[string]func(ctx context.Context) error{
+			"ssh": ListenerCheck(ln.Addr().String()),
+		},
+		startTime: time.Now().Add(-time.Minute),
+	}
+	s.activeConnections.Add(2)
+	n := newNode(s, "", config.DaytonaServerConfig{})
+	lastContact := time.Now()
+	n.lastControlContact.Store(&lastContact)
+
+	status := &ipnstate.Status{
+		BackendState: "Running",
+		Self:         &ipnstate.PeerStatus{Online: true, Relay: "fra"},
+	}
+
+	health := n.getHealth(context.Background(), status, nil)
+	require.Equal(t, project.AgentHealthStatusHealthy, health.Status)
+	require.Equal(t, project.TailnetHealth{State: "Running", Online: true, Relay: "fra"}, health.Tailnet)
+	require.Equal(t, lastContact.Format(time.RFC3339), health.LastControlContactAt)
+	require.GreaterOrEqual(t, health.Uptime, uint64(60))
+	require.Equal(t, uint32(2), health.ActiveConnections)
+	require.Equal(t, []project.ProcessHealth{{Name: "ssh", Healthy: true}}, health.Processes)
+
+	s.ProcessChecks["toolbox"] = func(ctx context.Context) error {
+		return errors.New("connection refused")
+	}
+
+	health = n.getHealth(context.Background(), status, nil)
+	require.Equal(t, project.AgentHealthStatusDegraded, health.Status)
+	require.Equal(t, project.ProcessHealth{Name: "toolbox", Error: "connection refused"}, health.Processes[1])
+
+	delete(s.ProcessChecks, "toolbox")
+
+	health = n.getHealth(context.Background(), nil, errors.New("not connected"))
+	require.Equal(t, project.AgentHealthStatusDegraded, health.Status)
+	require.Equal(t, project.TailnetHealth{State: "Unknown", Warnings: []string{"not connected"}}, health.Tailnet)
+}
+
+func TestGetHealthRelays(t *testing.T) {
+	s := &Server{
+		startTime: time.Now(),
+	}
+	n := newNode(s, "", config.DaytonaServerConfig{})
+
+	relays := []project.RelayHealth{
+		{Region: "eu", Reachable: true, LatencyMs: 12, CheckedAt: time.Now().Format(time.RFC3339)},
+		{Region: "us", Error: "connection refused", CheckedAt: time.Now().Format(time.RFC3339)},
+	}
+	n.relays.Store(&relays)
+
+	status := &ipnstate.Status{
+		BackendState: "Running",
+		Self:         &ipnstate.PeerStatus{Online: true, Relay: "eu"},
+	}
+
+	health := n.getHealth(context.Background(), status, nil)
+	require.Equal(t, project.AgentHealthStatusHealthy, health.Status)
+	require.Equal(t, "eu", health.Tailnet.Relay)
+	require.Equal(t, relays, health.Tailnet.Relays)
+}
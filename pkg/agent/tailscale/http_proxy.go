@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// httpProxyHandler returns the handler of the HTTP reverse proxy. Requests are routed to the local port in the
+// first label of the Host header, e.g. 3000-myws.<tailnet> is routed to localhost:3000. WebSocket upgrades are
+// proxied as well
+func (s *Server) httpProxyHandler(tsnetServer *tsnet.Server) http.Handler {
+	transport := &http.Transport{}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		port, ok := getHostPort(r.Host)
+		if !ok {
+			http.Error(w, fmt.Sprintf("host %s does not start with a port, expected <port>-<name>", r.Host), http.StatusBadRequest)
+			return
+		}
+
+		s.proxyHttpRequest(w, r, tsnetServer, transport, port)
+	})
+}
+
+// proxyHttpRequest proxies the request to the local port after checking the access of the tailnet peer
+func (s *Server) proxyHttpRequest(w http.ResponseWriter, r *http.Request, tsnetServer *tsnet.Server, transport http.RoundTripper, port uint16) {
+	if src, err := netip.ParseAddrPort(r.RemoteAddr); err == nil && !s.allowPeer(tsnetServer, src, port) {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	address, ok := s.getRoute(port)
+	if !ok {
+		if s.AllowPort != nil && !s.AllowPort(port) {
+			log.Debugf("Rejected http request to port %d", port)
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		address = fmt.Sprintf("localhost:%d", port)
+	}
+
+	err := s.limiter.acquire(port)
+	if err != nil {
+		s.metrics.incRejected(metricsProtocolHttp, port)
+		log.Warnf("Rejected http request to port %d: %v", port, err)
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	defer s.limiter.release(port)
+
+	s.activity.observe(port)
+
+	// Counted so that upgraded connections, which are not tracked by the http server, are drained on stop
+	s.activeConnections.Add(1)
+	defer s.activeConnections.Add(-1)
+
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(&url.URL{Scheme: "http", Host: address})
+			pr.SetXForwarded()
+		},
+		Transport:  transport,
+		BufferPool: s.buffers,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			s.metrics.incDialFailures(metricsProtocolHttp, port)
+			log.Debugf("Failed to proxy http request to port %d: %v", port, err)
+			s.publishEvent(agentevent.TypeProxyError, fmt.Sprintf("Failed to proxy http request: %v", err), port)
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+
+	if s.ProxyCompression && acceptsGzip(r) {
+		gzipWriter := newGzipResponseWriter(w)
+		defer gzipWriter.Close()
+		w = gzipWriter
+	}
+
+	proxy.ServeHTTP(w, r)
+}
+
+// getHostPort returns the port in the first label of the host, e.g. 3000 for 3000-myws.example.ts.net:80
+func getHostPort(host string) (uint16, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	label, _, _ := strings.Cut(host, ".")
+	portSpec, _, ok := strings.Cut(label, "-")
+	if !ok {
+		return 0, false
+	}
+
+	port, err := strconv.ParseUint(portSpec, 10, 16)
+	if err != nil || port == 0 {
+		return 0, false
+	}
+
+	return uint16(port), true
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHostPort(t *testing.T) {
+	for host, expected := range map[string]uint16{
+		"3000-myws.tailnet.ts.net":    3000,
+		"3000-myws.tailnet.ts.net:80": 3000,
+		"8080-ws1-api":                8080,
+	} {
+		port, ok := getHostPort(host)
+		require.True(t, ok, host)
+		require.Equal(t, expected, port, host)
+	}
+
+	for _, host := range []string{"myws.tailnet.ts.net", "3000.tailnet.ts.net", "0-myws", "70000-myws", "web-3000"} {
+		_, ok := getHostPort(host)
+		require.False(t, ok, host)
+	}
+}
+
+func TestHttpProxyHandler(t *testing.T) {
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" {
+			fmt.Fprintf(w, "%s %s", r.Header.Get("X-Forwarded-Host"), r.URL.Path)
+			return
+		}
+
+		conn, rw, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		fmt.Fprint(rw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n")
+		rw.Flush()
+		io.Copy(conn, rw)
+	}))
+	defer backend.Close()
+
+	backendUrl, err := url.Parse(backend.URL)
+	require.NoError(t, err)
+	backendPort, err := strconv.Atoi(backendUrl.Port())
+	require.NoError(t, err)
+
+	s := &Server{
+		AllowPort: func(port uint16) bool {
+			return port == uint16(backendPort)
+		},
+	}
+
+	proxy := httptest.NewServer(s.httpProxyHandler(nil))
+	defer proxy.Close()
+
+	get := func(host string) (int, string) {
+		req, err := http.NewRequest(http.MethodGet, proxy.URL+"/preview", nil)
+		require.NoError(t, err)
+		req.Host = host
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+
+		return res.StatusCode, string(body)
+	}
+
+	host := fmt.Sprintf("%d-myws.tailnet.ts.net", backendPort)
+	status, body := get(host)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, host+" /preview", body)
+
+	status, _ = get("myws.tailnet.ts.net")
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = get(fmt.Sprintf("%d-myws.tailnet.ts.net", backendPort+1))
+	require.Equal(t, http.StatusForbidden, status)
+
+	// Upgraded connections are proxied in both directions
+	conn, err := net.Dial("tcp", proxy.Listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	fmt.Fprintf(conn, "GET /ws HTTP/1.1\r\nHost: %s\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n", host)
+	reader := bufio.NewReader(conn)
+	res, err := http.ReadResponse(reader, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusSwitchingProtocols, res.StatusCode)
+
+	fmt.Fprint(conn, "ping\n")
+	line, err := reader.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", line)
+}
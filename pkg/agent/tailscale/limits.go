@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrMaxConnections     = errors.New("maximum number of proxied connections reached")
+	ErrMaxPortConnections = errors.New("maximum number of proxied connections to the port reached")
+)
+
+// ParseConnectionLimit parses a per-port connection limit in the <port>:<max connections> format, e.g. 3000:50
+func ParseConnectionLimit(value string) (port uint16, limit int, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid connection limit %q: expected <port>:<max connections>", value)
+	}
+
+	p, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || p == 0 {
+		return 0, 0, fmt.Errorf("invalid connection limit %q: invalid port %s", value, parts[0])
+	}
+
+	limit, err = strconv.Atoi(parts[1])
+	if err != nil || limit <= 0 {
+		return 0, 0, fmt.Errorf("invalid connection limit %q: invalid max connections %s", value, parts[1])
+	}
+
+	return uint16(p), limit, nil
+}
+
+// ParseConnectionLimits parses per-port connection limits and makes sure every port is limited once
+func ParseConnectionLimits(values []string) (map[uint16]int, error) {
+	limits := map[uint16]int{}
+
+	for _, value := range values {
+		port, limit, err := ParseConnectionLimit(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := limits[port]; ok {
+			return nil, fmt.Errorf("connection limit of port %d is set more than once", port)
+		}
+
+		limits[port] = limit
+	}
+
+	return limits, nil
+}
+
+// connLimiter caps the number of concurrently proxied connections, overall and per tailnet port
+type connLimiter struct {
+	max     int
+	portMax map[uint16]int
+	mutex   sync.Mutex
+	total   int
+	perPort map[uint16]int
+}
+
+func newConnLimiter(max int, portMax map[uint16]int) *connLimiter {
+	return &connLimiter{
+		max:     max,
+		portMax: portMax,
+		perPort: map[uint16]int{},
+	}
+}
+
+// acquire reserves a connection to the port. Every successful acquire must be followed by a release.
+// Connections are not limited on a nil receiver
+func (l *connLimiter) acquire(port uint16) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.max > 0 && l.total >= l.max {
+		return ErrMaxConnections
+	}
+
+	if portMax, ok := l.portMax[port]; ok && l.perPort[port] >= portMax {
+		return ErrMaxPortConnections
+	}
+
+	l.total++
+	l.perPort[port]++
+
+	return nil
+}
+
+func (l *connLimiter) release(port uint16) {
+	if l == nil {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.total--
+	l.perPort[port]--
+	if l.perPort[port] <= 0 {
+		delete(l.perPort, port)
+	}
+}
+
+// idleTimer closes the connections once no data was read from either of them for the timeout
+type idleTimer struct {
+	timeout time.Duration
+	timer   *time.Timer
+}
+
+func newIdleTimer(timeout time.Duration, conns ...net.Conn) *idleTimer {
+	return &idleTimer{
+		timeout: timeout,
+		timer: time.AfterFunc(timeout, func() {
+			for _, conn := range conns {
+				conn.Close()
+			}
+		}),
+	}
+}
+
+// reader resets the timer on every read from r
+func (t *idleTimer) reader(r io.Reader) io.Reader {
+	return &idleReader{Reader: r, timer: t}
+}
+
+func (t *idleTimer) stop() {
+	t.timer.Stop()
+}
+
+type idleReader struct {
+	io.Reader
+	timer *idleTimer
+}
+
+func (r *idleReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.timer.timer.Reset(r.timer.timeout)
+	}
+	return n, err
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConnectionLimits(t *testing.T) {
+	limits, err := ParseConnectionLimits([]string{"3000:50", "8080:1"})
+	require.NoError(t, err)
+	require.Equal(t, map[uint16]int{3000: 50, 8080: 1}, limits)
+
+	for _, value := range []string{"3000", "3000:0", "0:10", "3000:-1", "3000:10:1", "port:10"} {
+		_, err := ParseConnectionLimits([]string{value})
+		require.Error(t, err, value)
+	}
+
+	_, err = ParseConnectionLimits([]string{"3000:1", "3000:2"})
+	require.Error(t, err)
+}
+
+func TestConnLimiter(t *testing.T) {
+	var nilLimiter *connLimiter
+	require.NoError(t, nilLimiter.acquire(3000))
+	nilLimiter.release(3000)
+
+	l := newConnLimiter(3, map[uint16]int{3000: 1})
+
+	require.NoError(t, l.acquire(3000))
+	require.ErrorIs(t, l.acquire(3000), ErrMaxPortConnections)
+
+	require.NoError(t, l.acquire(8080))
+	require.NoError(t, l.acquire(8080))
+	require.ErrorIs(t, l.acquire(8080), ErrMaxConnections)
+
+	l.release(3000)
+	require.NoError(t, l.acquire(3000))
+
+	l.release(8080)
+	require.NoError(t, l.acquire(8080))
+}
+
+func TestProxyConnIdleTimeout(t *testing.T) {
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+
+	go func() {
+		conn, err := target.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	s := &Server{IdleTimeout: 200 * time.Millisecond}
+
+	peer, src := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		s.proxyConn(src, "tcp", target.Addr().String(), metricsProtocolTcp, 3000)
+		close(done)
+	}()
+
+	// Traffic keeps the connection open past the idle timeout
+	buf := make([]byte, 5)
+	for i := 0; i < 3; i++ {
+		_, err = peer.Write([]byte("hello"))
+		require.NoError(t, err)
+		_, err = io.ReadFull(peer, buf)
+		require.NoError(t, err)
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	select {
+	case <-done:
+		t.Fatal("connection was closed while active")
+	default:
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("idle connection was not closed")
+	}
+
+	peer.Close()
+}
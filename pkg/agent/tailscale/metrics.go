@@ -0,0 +1,215 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"tailscale.com/tailcfg"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const METRICS_PATH = "/metrics"
+
+const (
+	metricsProtocolTcp  = "tcp"
+	metricsProtocolUdp  = "udp"
+	metricsProtocolUnix = "unix"
+	metricsProtocolHttp = "http"
+)
+
+// metrics of the tailnet proxy in the Prometheus format. All methods are no-ops on a nil receiver,
+// so the proxy is instrumented whether or not the metrics are served
+type metrics struct {
+	registry       *prometheus.Registry
+	receivedBytes  *prometheus.CounterVec
+	sentBytes      *prometheus.CounterVec
+	dialFailures   *prometheus.CounterVec
+	rejected       *prometheus.CounterVec
+	reconnects     prometheus.Counter
+	controlLatency prometheus.Histogram
+	relayLatency   *prometheus.GaugeVec
+	relayReachable *prometheus.GaugeVec
+}
+
+func newMetrics(activeConnections func() float64) *metrics {
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		receivedBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "daytona_agent_tailnet_received_bytes_total",
+			Help: "Bytes received from tailnet peers and forwarded to the project, by forwarded port",
+		}, []string{"protocol", "port"}),
+		sentBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "daytona_agent_tailnet_sent_bytes_total",
+			Help: "Bytes sent by the project back to tailnet peers, by forwarded port",
+		}, []string{"protocol", "port"}),
+		dialFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "daytona_agent_tailnet_dial_failures_total",
+			Help: "Connections from tailnet peers that could not be forwarded because the port of the project could not be dialed",
+		}, []string{"protocol", "port"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "daytona_agent_tailnet_rejected_connections_total",
+			Help: "Connections from tailnet peers that were rejected because a connection limit was reached",
+		}, []string{"protocol", "port"}),
+		reconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "daytona_agent_tailnet_reconnects_total",
+			Help: "Reconnects to the tailnet after the connection was lost",
+		}),
+		controlLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "daytona_agent_control_latency_seconds",
+			Help:    "Round trip time of requests to the control server of the tailnet",
+			Buckets: prometheus.DefBuckets,
+		}),
+		relayLatency: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "daytona_agent_derp_latency_seconds",
+			Help: "Round trip time of probes to the DERP relay regions of the tailnet, by region. Unset while a region is unreachable",
+		}, []string{"region"}),
+		relayReachable: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "daytona_agent_derp_reachable",
+			Help: "Whether the DERP relay region responded to the last probe, by region",
+		}, []string{"region"}),
+	}
+
+	m.registry.MustRegister(
+		m.receivedBytes,
+		m.sentBytes,
+		m.dialFailures,
+		m.rejected,
+		m.reconnects,
+		m.controlLatency,
+		m.relayLatency,
+		m.relayReachable,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "daytona_agent_tailnet_active_connections",
+			Help: "Open TCP connections and UDP flows forwarded from tailnet peers",
+		}, activeConnections),
+	)
+
+	return m
+}
+
+func (m *metrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// countReceived wraps the writer towards the project so that every byte written is counted as received from the peer
+func (m *metrics) countReceived(w io.Writer, protocol string, port uint16) io.Writer {
+	if m == nil {
+		return w
+	}
+	return &countingWriter{Writer: w, counter: m.receivedBytes.WithLabelValues(protocol, fmt.Sprint(port))}
+}
+
+// countSent wraps the writer towards the peer so that every byte written is counted as sent to the peer
+func (m *metrics) countSent(w io.Writer, protocol string, port uint16) io.Writer {
+	if m == nil {
+		return w
+	}
+	return &countingWriter{Writer: w, counter: m.sentBytes.WithLabelValues(protocol, fmt.Sprint(port))}
+}
+
+func (m *metrics) addReceived(protocol string, port uint16, n int) {
+	if m == nil {
+		return
+	}
+	m.receivedBytes.WithLabelValues(protocol, fmt.Sprint(port)).Add(float64(n))
+}
+
+func (m *metrics) addSent(protocol string, port uint16, n int) {
+	if m == nil {
+		return
+	}
+	m.sentBytes.WithLabelValues(protocol, fmt.Sprint(port)).Add(float64(n))
+}
+
+func (m *metrics) incDialFailures(protocol string, port uint16) {
+	if m == nil {
+		return
+	}
+	m.dialFailures.WithLabelValues(protocol, fmt.Sprint(port)).Inc()
+}
+
+func (m *metrics) incRejected(protocol string, port uint16) {
+	if m == nil {
+		return
+	}
+	m.rejected.WithLabelValues(protocol, fmt.Sprint(port)).Inc()
+}
+
+func (m *metrics) incReconnects() {
+	if m == nil {
+		return
+	}
+	m.reconnects.Inc()
+}
+
+// observeControlLatency measures the round trip of a request for the public key of the control server.
+// Every Tailscale control server serves the key to clients before they log in
+func (m *metrics) observeControlLatency(ctx context.Context, controlUrl string) {
+	if m == nil {
+		return
+	}
+
+	url := fmt.Sprintf("%s/key?v=%d", strings.TrimSuffix(controlUrl, "/"), tailcfg.CurrentCapabilityVersion)
+
+	ctx, cancel := context.WithTimeout(ctx, statusCheckInterval)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		log.Debugf("Failed to measure control latency: %v", err)
+		return
+	}
+
+	start := time.Now()
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Debugf("Failed to measure control latency: %v", err)
+		return
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(io.Discard, res.Body)
+	if err != nil {
+		log.Debugf("Failed to measure control latency: %v", err)
+		return
+	}
+
+	m.controlLatency.Observe(time.Since(start).Seconds())
+}
+
+func (m *metrics) observeRelay(relay project.RelayHealth) {
+	if m == nil {
+		return
+	}
+
+	if !relay.Reachable {
+		m.relayReachable.WithLabelValues(relay.Region).Set(0)
+		m.relayLatency.DeleteLabelValues(relay.Region)
+		return
+	}
+
+	m.relayReachable.WithLabelValues(relay.Region).Set(1)
+	m.relayLatency.WithLabelValues(relay.Region).Set(float64(relay.LatencyMs) / 1000)
+}
+
+type countingWriter struct {
+	io.Writer
+	counter prometheus.Counter
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.Writer.Write(p)
+	w.counter.Add(float64(n))
+	return n, err
+}
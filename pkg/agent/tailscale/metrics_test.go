@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"io"
+	"net"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyConnMetrics(t *testing.T) {
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	// Echo server
+	go func() {
+		for {
+			conn, err := target.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				_, _ = io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	s := &Server{}
+	s.metrics = newMetrics(func() float64 {
+		return float64(s.activeConnections.Load())
+	})
+
+	peer, src := net.Pipe()
+	done := make(chan struct{})
+	go func() {
+		s.proxyConn(src, "tcp", target.Addr().String(), metricsProtocolTcp, 3000)
+		close(done)
+	}()
+
+	_, err = peer.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(peer, buf)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(buf))
+
+	peer.Close()
+	<-done
+
+	// Dialing fails once the port is closed
+	target.Close()
+	_, src = net.Pipe()
+	s.proxyConn(src, "tcp", target.Addr().String(), metricsProtocolTcp, 3000)
+
+	s.metrics.incReconnects()
+
+	recorder := httptest.NewRecorder()
+	s.metrics.handler().ServeHTTP(recorder, httptest.NewRequest("GET", METRICS_PATH, nil))
+
+	body := recorder.Body.String()
+	require.Contains(t, body, `daytona_agent_tailnet_received_bytes_total{port="3000",protocol="tcp"} 5`)
+	require.Contains(t, body, `daytona_agent_tailnet_sent_bytes_total{port="3000",protocol="tcp"} 5`)
+	require.Contains(t, body, `daytona_agent_tailnet_dial_failures_total{port="3000",protocol="tcp"} 1`)
+	require.Contains(t, body, "daytona_agent_tailnet_reconnects_total 1")
+	require.Contains(t, body, "daytona_agent_tailnet_active_connections 0")
+}
+
+func TestNilMetrics(t *testing.T) {
+	var m *metrics
+
+	w := io.Discard
+	require.Equal(t, w, m.countReceived(w, metricsProtocolTcp, 3000))
+	require.Equal(t, w, m.countSent(w, metricsProtocolTcp, 3000))
+
+	m.addReceived(metricsProtocolUdp, 53, 10)
+	m.incDialFailures(metricsProtocolUdp, 53)
+	m.incReconnects()
+}
@@ -0,0 +1,150 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// A cached network key is renewed this long before it expires, or after 90% of its lifetime for shorter lived keys
+	networkKeyRenewalMargin = 10 * time.Minute
+	// Bounds of the delay between failed attempts to renew the cached network key
+	networkKeyRetryMinDelay = 5 * time.Second
+	networkKeyRetryMaxDelay = 5 * time.Minute
+)
+
+type networkKey struct {
+	key       string
+	fetchedAt time.Time
+	// Keys of servers that do not report the expiry are never renewed before they are used
+	expiresAt time.Time
+}
+
+// renewAt returns when the key should be replaced before it expires. Zero if the expiry is unknown
+func (k *networkKey) renewAt() time.Time {
+	if k.expiresAt.IsZero() {
+		return time.Time{}
+	}
+
+	margin := min(networkKeyRenewalMargin, k.expiresAt.Sub(k.fetchedAt)/10)
+
+	return k.expiresAt.Add(-margin)
+}
+
+// networkKeyCache keeps an unused network key ahead of the next connection to the tailnet, so that reconnecting does
+// not depend on the API of the Daytona Server being reachable at that moment. Network keys can only be used once, so
+// a key is removed from the cache when it is taken and a new one is fetched in the background
+type networkKeyCache struct {
+	fetch func(ctx context.Context) (*networkKey, error)
+	now   func() time.Time
+
+	mutex   sync.Mutex
+	key     *networkKey
+	refresh chan struct{}
+}
+
+func newNetworkKeyCache(fetch func(ctx context.Context) (*networkKey, error)) *networkKeyCache {
+	return &networkKeyCache{
+		fetch:   fetch,
+		now:     time.Now,
+		refresh: make(chan struct{}, 1),
+	}
+}
+
+// take returns the cached key and removes it from the cache. Returns nil if there is no key or it is about to expire.
+// Does nothing on a nil receiver
+func (c *networkKeyCache) take() *networkKey {
+	if c == nil {
+		return nil
+	}
+
+	c.mutex.Lock()
+	key := c.key
+	c.key = nil
+	c.mutex.Unlock()
+
+	c.requestRefresh()
+
+	if key == nil {
+		return nil
+	}
+
+	if renewAt := key.renewAt(); !renewAt.IsZero() && !c.now().Before(renewAt) {
+		return nil
+	}
+
+	return key
+}
+
+func (c *networkKeyCache) get() *networkKey {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.key
+}
+
+func (c *networkKeyCache) put(key *networkKey) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.key = key
+}
+
+func (c *networkKeyCache) requestRefresh() {
+	select {
+	case c.refresh <- struct{}{}:
+	default:
+	}
+}
+
+// run keeps a valid key in the cache until the context is canceled. The key is fetched when the cache is empty and
+// renewed before it expires
+func (c *networkKeyCache) run(ctx context.Context) {
+	retryDelay := networkKeyRetryMinDelay
+
+	for {
+		var wait <-chan time.Time
+
+		key := c.get()
+		if key == nil {
+			wait = time.After(0)
+		} else if renewAt := key.renewAt(); !renewAt.IsZero() {
+			wait = time.After(renewAt.Sub(c.now()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.refresh:
+			continue
+		case <-wait:
+		}
+
+		key, err := c.fetch(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			log.Tracef("Failed to renew the cached network key, retrying in %s: %v", retryDelay, err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryDelay):
+			}
+
+			retryDelay = min(retryDelay*2, networkKeyRetryMaxDelay)
+			continue
+		}
+
+		retryDelay = networkKeyRetryMinDelay
+		c.put(key)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkKeyRenewAt(t *testing.T) {
+	now := time.Now()
+
+	key := &networkKey{key: "key", fetchedAt: now}
+	require.True(t, key.renewAt().IsZero())
+
+	key.expiresAt = now.Add(24 * time.Hour)
+	require.Equal(t, key.expiresAt.Add(-networkKeyRenewalMargin), key.renewAt())
+
+	// Short lived keys are renewed after 90% of their lifetime
+	key.expiresAt = now.Add(10 * time.Minute)
+	require.Equal(t, key.expiresAt.Add(-time.Minute), key.renewAt())
+}
+
+func TestNetworkKeyCache(t *testing.T) {
+	var fetched atomic.Int32
+	var failing atomic.Bool
+
+	c := newNetworkKeyCache(func(ctx context.Context) (*networkKey, error) {
+		if failing.Load() {
+			return nil, errors.New("unavailable")
+		}
+		n := fetched.Add(1)
+		return &networkKey{key: fmt.Sprintf("key-%d", n), fetchedAt: time.Now(), expiresAt: time.Now().Add(time.Hour)}, nil
+	})
+
+	require.Nil(t, (*networkKeyCache)(nil).take())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.run(ctx)
+
+	require.Eventually(t, func() bool { return c.get() != nil }, time.Second, 10*time.Millisecond)
+
+	// Keys are used once and replaced in the background
+	key := c.take()
+	require.NotNil(t, key)
+	require.Equal(t, "key-1", key.key)
+	require.Eventually(t, func() bool { return c.get() != nil }, time.Second, 10*time.Millisecond)
+	require.Equal(t, "key-2", c.take().key)
+
+	// A cached key is still taken while the API of the server is unavailable
+	require.Eventually(t, func() bool { return c.get() != nil }, time.Second, 10*time.Millisecond)
+	failing.Store(true)
+	require.Equal(t, "key-3", c.take().key)
+	require.Nil(t, c.take())
+
+	// Keys about to expire are not taken
+	c.put(&networkKey{key: "expiring", fetchedAt: time.Now().Add(-time.Hour), expiresAt: time.Now().Add(time.Second)})
+	require.Nil(t, c.take())
+}
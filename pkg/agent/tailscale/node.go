@@ -0,0 +1,511 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// node is the member of the agent in the tailnet of a Daytona Server. The agent runs a node per server it joins, each
+// with its own connection loop and tsnet state, while the ports of the project are proxied the same way for all of them
+type node struct {
+	server *Server
+	// Profile of the Daytona Server in the AdditionalServers of the agent. Empty for the node of the own server
+	profile string
+	config  config.DaytonaServerConfig
+	logger  *log.Entry
+
+	backoff            *backoff
+	networkKeys        *networkKeyCache
+	fallback           *fallbackRunner
+	relayConnected     atomic.Bool
+	relays             atomic.Pointer[[]project.RelayHealth]
+	lastControlContact atomic.Pointer[time.Time]
+	keyExpiry          atomic.Pointer[time.Time]
+	udpMutex           sync.Mutex
+	udpForwarders      []*udpForwarder
+	// Set by the connection loop only
+	connectedAt    time.Time
+	nodeKeyRetryAt time.Time
+	// Guards the fields below, which are set while the node is connected
+	mutex       sync.Mutex
+	tsnetServer *tsnet.Server
+	httpServers []*http.Server
+}
+
+func newNode(s *Server, profile string, serverConfig config.DaytonaServerConfig) *node {
+	n := &node{
+		server:  s,
+		profile: profile,
+		config:  serverConfig,
+		logger:  log.NewEntry(log.StandardLogger()),
+		backoff: newBackoff(serverConfig.Reconnect),
+	}
+
+	if profile != "" {
+		n.logger = log.WithField("server", profile)
+	}
+
+	n.networkKeys = newNetworkKeyCache(n.fetchNetworkKey)
+
+	return n
+}
+
+// run connects to the tailnet and keeps the connection alive until the context is canceled. Returns an error if the
+// reconnect budget of the server is exhausted
+func (n *node) run(ctx context.Context) error {
+	go n.networkKeys.run(ctx)
+	go n.probeRelaysLoop(ctx)
+
+	tsnetServer, err := n.connect(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+
+	n.publishEvent(agentevent.TypeConnected, "Connected to the tailnet")
+
+	var homeRegion string
+	// Set while the node reconnects to use the network key it renewed before its node key expired
+	var renewed bool
+
+	delay := statusCheckInterval
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+		delay = statusCheckInterval
+
+		if tsnetServer != nil {
+			err := n.checkStatus(ctx, tsnetServer, &homeRegion)
+			if err == nil {
+				n.backoff.Reset()
+				n.fallback.report(ctx, n.relayConnected.Load())
+				err = n.renewNodeKey(ctx, time.Now())
+				if err == nil {
+					continue
+				}
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			renewed = errors.Is(err, errNodeKeyRenewed)
+			if renewed {
+				n.logger.Info("Registering the node again before its node key expires")
+			} else {
+				n.fallback.report(ctx, false)
+				n.logger.Errorf("%v. Reconnecting...", err)
+			}
+
+			needsLogin := renewed || errors.Is(err, errNeedsLogin)
+
+			// Close the tsnet server and reconnect
+			n.closeUdpForwarders()
+			n.setTsnetServer(nil, nil)
+			n.keyExpiry.Store(nil)
+			err = tsnetServer.Close()
+			if err != nil {
+				n.logger.Errorf("Failed to close tsnet server: %v", err)
+			}
+
+			if needsLogin {
+				err = n.resetState()
+				if err != nil {
+					n.logger.Errorf("Failed to reset tsnet state: %v", err)
+				}
+			}
+		}
+
+		tsnetServer, err = n.connect(ctx)
+		if err == nil {
+			if renewed {
+				n.logger.Info("Registered the node with a renewed node key")
+				n.publishEvent(agentevent.TypeNodeKeyRenewed, "Registered the node again with a renewed node key")
+			} else {
+				n.logger.Info("Reconnected to server")
+				n.server.metrics.incReconnects()
+				n.publishEvent(agentevent.TypeReconnected, "Reconnected to the tailnet")
+			}
+			renewed = false
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		n.fallback.report(ctx, false)
+
+		if !errors.Is(err, ErrReconnectBudgetExhausted) {
+			n.logger.Errorf("Failed to reconnect: %v", err)
+			delay, err = n.backoff.Next()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// publishEvent reports the event of the node, naming the profile of its Daytona Server unless it is the own server
+func (n *node) publishEvent(eventType agentevent.Type, message string) {
+	if n.profile != "" {
+		message = fmt.Sprintf("%s of server %s", message, n.profile)
+	}
+
+	n.server.publishEvent(eventType, message, 0)
+}
+
+// checkStatus returns an error if the tsnet server is disconnected from the tailnet
+func (n *node) checkStatus(ctx context.Context, tsnetServer *tsnet.Server, homeRegion *string) error {
+	localClient, err := tsnetServer.LocalClient()
+	if err != nil {
+		return fmt.Errorf("failed to get local client: %v, %w", err, common.ErrConnection)
+	}
+
+	status, err := localClient.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get local client status: %v, %w", err, common.ErrConnection)
+	}
+
+	if nodeNeedsLogin(status, time.Now()) {
+		return errNeedsLogin
+	}
+
+	if status.CurrentTailnet == nil {
+		return fmt.Errorf("tailscale not connected. %w", common.ErrConnection)
+	}
+
+	n.logger.Tracef("Connected to server. Status: %v", status)
+
+	// Without a home relay the node is unreachable on networks that block direct WireGuard connections
+	n.relayConnected.Store(status.Self != nil && status.Self.Relay != "")
+
+	if status.Self != nil {
+		*homeRegion = n.checkDerpRegion(status.Self.Relay, *homeRegion)
+
+		if status.Self.Online {
+			now := time.Now()
+			n.lastControlContact.Store(&now)
+		}
+
+		n.keyExpiry.Store(status.Self.KeyExpiry)
+	}
+
+	n.server.metrics.observeControlLatency(ctx, n.config.Url)
+
+	return nil
+}
+
+// checkDerpRegion logs changes of the home relay region and returns the current one
+func (n *node) checkDerpRegion(current, previous string) string {
+	if current == "" || current == previous {
+		return previous
+	}
+
+	n.logger.Debugf("Using DERP region %s", current)
+
+	return current
+}
+
+// getNetworkKey takes the network key cached ahead of the connection or fetches one if none is cached
+func (n *node) getNetworkKey(ctx context.Context) (string, error) {
+	if key := n.networkKeys.take(); key != nil {
+		return key.key, nil
+	}
+
+	// Retry with backoff until the reconnect budget is exhausted. Used to reconnect to the Daytona Server
+	for {
+		networkKey, err := n.fetchNetworkKey(ctx)
+		if err == nil {
+			return networkKey.key, nil
+		}
+
+		delay, backoffErr := n.backoff.Next()
+		if backoffErr != nil {
+			return "", fmt.Errorf("%w: %v", backoffErr, err)
+		}
+
+		n.logger.Tracef("Failed to get network key, retrying in %s: %v", delay, err)
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (n *node) fetchNetworkKey(ctx context.Context) (*networkKey, error) {
+	// A node that registers without a stored node key replaces the node the project registered before, e.g. after
+	// switching between a persistent and an ephemeral node or after its node key expired. The key is issued for the
+	// project so the server removes the previous node, which a persistent node would leave in the tailnet otherwise
+	if n.profile == "" && n.server.RenewNetworkKey != nil && !n.hasState() {
+		key, err := n.server.RenewNetworkKey(ctx, n.server.Persistent)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseNetworkKey(key, time.Now())
+	}
+
+	apiClient, err := apiclient_util.GetAgentApiClient(n.config.ApiUrl, n.config.ApiKey, n.server.ClientId, n.server.TelemetryEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := apiClient.ServerAPI.GenerateNetworkKey(ctx).Persistent(n.server.Persistent).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNetworkKey(key, time.Now())
+}
+
+func (n *node) getTsnetServer(ctx context.Context) (*tsnet.Server, error) {
+	s := n.server
+
+	stateDir, err := n.getStateDir()
+	if err != nil {
+		return nil, err
+	}
+
+	err = n.removeOtherModeState()
+	if err != nil {
+		n.logger.Warnf("Failed to remove the state of the previous node: %v", err)
+	}
+
+	tsnetServer := &tsnet.Server{
+		Hostname:   s.Hostname,
+		ControlURL: n.config.Url,
+		Ephemeral:  !s.Persistent,
+		Dir:        stateDir,
+	}
+
+	networkKey, err := n.getNetworkKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network key: %w", err)
+	}
+
+	tsnetServer.AuthKey = networkKey
+
+	tsnetServer.RegisterFallbackTCPHandler(func(src, dest netip.AddrPort) (handler func(net.Conn), intercept bool) {
+		destPort := dest.Port()
+
+		// Reject new connections while the server is stopping
+		if ctx.Err() != nil {
+			return nil, false
+		}
+
+		if !s.allowPeer(tsnetServer, src, destPort) {
+			return nil, false
+		}
+
+		if socket := s.getUnixSocket(destPort); socket != nil {
+			if !s.allowUnixSocketPeer(tsnetServer, socket, src) {
+				log.Debugf("Rejected connection from %s to unix socket %s", src, socket.Path)
+				return nil, false
+			}
+
+			if s.AllowUnixSocket != nil && !s.AllowUnixSocket(socket.Path) {
+				log.Warnf("Rejected connection to unix socket %s. The socket is not accessible to the project user", socket.Path)
+				return nil, false
+			}
+
+			return s.proxyHandler("unix", socket.Path, metricsProtocolUnix, destPort)
+		}
+
+		if address, ok := s.getRoute(destPort); ok {
+			return s.proxyHandler("tcp", address, metricsProtocolTcp, destPort)
+		}
+
+		if s.AllowPort != nil && !s.AllowPort(destPort) {
+			log.Debugf("Rejected connection to port %d", destPort)
+			return nil, false
+		}
+
+		return s.proxyHandler("tcp", fmt.Sprintf("localhost:%d", destPort), metricsProtocolTcp, destPort)
+	})
+
+	return tsnetServer, nil
+}
+
+func (n *node) connect(ctx context.Context) (*tsnet.Server, error) {
+	s := n.server
+
+	tsnetServer, err := n.getTsnetServer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := tsnetServer.Listen("tcp", ":80")
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(HEALTH_PATH, n.healthHandler(tsnetServer))
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "Ok\n")
+	})
+
+	httpServers := []*http.Server{{Handler: mux}}
+	listeners := []net.Listener{ln}
+
+	if s.metrics != nil {
+		metricsLn, err := tsnetServer.Listen("tcp", fmt.Sprintf(":%d", s.MetricsPort))
+		if err != nil {
+			ln.Close()
+			return nil, err
+		}
+
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle(METRICS_PATH, s.metrics.handler())
+
+		httpServers = append(httpServers, &http.Server{Handler: metricsMux})
+		listeners = append(listeners, metricsLn)
+	}
+
+	if s.HttpProxyPort != 0 {
+		httpProxyLn, err := tsnetServer.Listen("tcp", fmt.Sprintf(":%d", s.HttpProxyPort))
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+
+		httpServers = append(httpServers, &http.Server{Handler: s.httpProxyHandler(tsnetServer)})
+		listeners = append(listeners, s.bandwidth.listener(httpProxyLn))
+	}
+
+	// Certificates are issued for the tailnet name of the project by the own server, so TLS ports are not served in
+	// the tailnets of additional servers
+	if s.certificates != nil && n.profile == "" {
+		tlsServers, tlsListeners, err := n.listenTlsPorts(tsnetServer)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+
+		httpServers = append(httpServers, tlsServers...)
+		listeners = append(listeners, tlsListeners...)
+	}
+
+	// API keys are verified by the own server, so SSH is not served in the tailnets of additional servers
+	var sshLn net.Listener
+	if s.ServeSsh != nil && n.profile == "" {
+		sshLn, err = tsnetServer.Listen("tcp", fmt.Sprintf(":%d", ssh_config.TAILNET_SSH_PORT))
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+	}
+
+	n.setTsnetServer(tsnetServer, httpServers)
+
+	if sshLn != nil {
+		go func() {
+			err := s.ServeSsh(sshLn)
+			if err != nil {
+				log.Tracef("Failed to serve ssh on the tailnet: %v", err)
+			}
+		}()
+	}
+
+	for i, httpServer := range httpServers {
+		go func() {
+			err := httpServer.Serve(listeners[i])
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				// Trace log because this is expected to fail when disconnected from the Daytona Server
+				n.logger.Tracef("Failed to serve: %v", err)
+			}
+		}()
+	}
+
+	if len(s.UdpPorts) > 0 {
+		go n.forwardUdpPorts(ctx, tsnetServer)
+	}
+
+	n.connectedAt = time.Now()
+
+	return tsnetServer, nil
+}
+
+// setTsnetServer records the running tsnet server so that it can be closed when the server is stopped
+func (n *node) setTsnetServer(tsnetServer *tsnet.Server, httpServers []*http.Server) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	n.tsnetServer = tsnetServer
+	n.httpServers = httpServers
+}
+
+// currentTsnetServer returns the running tsnet server. Nil while the node is disconnected or on a nil receiver
+func (n *node) currentTsnetServer() *tsnet.Server {
+	if n == nil {
+		return nil
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	return n.tsnetServer
+}
+
+// shutdownHttpServers stops the http servers of the running tsnet server from accepting requests and waits for the
+// requests in flight until the context is done
+func (n *node) shutdownHttpServers(ctx context.Context) {
+	n.mutex.Lock()
+	httpServers := n.httpServers
+	n.mutex.Unlock()
+
+	for _, httpServer := range httpServers {
+		err := httpServer.Shutdown(ctx)
+		if err != nil {
+			n.logger.Debugf("Failed to shut down http server: %v", err)
+		}
+	}
+}
+
+// close closes the running tsnet server. Does nothing while the node is disconnected
+func (n *node) close() error {
+	tsnetServer := n.currentTsnetServer()
+	if tsnetServer == nil {
+		return nil
+	}
+
+	err := tsnetServer.Close()
+	if err != nil {
+		return fmt.Errorf("failed to close tsnet server: %w", err)
+	}
+
+	return nil
+}
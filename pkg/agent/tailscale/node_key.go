@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+const (
+	// The node key is renewed this long before it expires, or after 90% of the time the node has left when it
+	// connected for nodes that expire sooner
+	nodeKeyRenewalMargin = 24 * time.Hour
+	// Delay before a failed renewal of the node key is attempted again
+	nodeKeyRenewalRetryDelay = 5 * time.Minute
+)
+
+// errNodeKeyRenewed is returned once a network key to register the node again is cached. The node has to reconnect
+// with a fresh state to use it before its current node key expires
+var errNodeKeyRenewed = errors.New("the node key is about to expire and was renewed")
+
+// nodeKeyRenewAt returns when a node key that expires at expiry should be renewed by a node that connected at
+// connectedAt
+func nodeKeyRenewAt(expiry, connectedAt time.Time) time.Time {
+	margin := min(nodeKeyRenewalMargin, expiry.Sub(connectedAt)/10)
+
+	return expiry.Add(-max(margin, 0))
+}
+
+// renewNodeKey fetches a network key to register the node again if its node key is about to expire. Returns
+// errNodeKeyRenewed once the key is cached for the next connection. Failures are logged and retried later, the node
+// keeps its connection until the key expires
+func (n *node) renewNodeKey(ctx context.Context, now time.Time) error {
+	// The node key of additional servers is rotated once it expired, they do not issue keys for the project
+	if n.profile != "" || n.server.RenewNetworkKey == nil {
+		return nil
+	}
+
+	expiry := n.keyExpiry.Load()
+	if expiry == nil || now.Before(nodeKeyRenewAt(*expiry, n.connectedAt)) || now.Before(n.nodeKeyRetryAt) {
+		return nil
+	}
+
+	key, err := n.server.RenewNetworkKey(ctx, n.server.Persistent)
+	if err == nil {
+		var networkKey *networkKey
+		networkKey, err = parseNetworkKey(key, now)
+		if err == nil {
+			n.networkKeys.put(networkKey)
+			return errNodeKeyRenewed
+		}
+	}
+
+	n.nodeKeyRetryAt = now.Add(nodeKeyRenewalRetryDelay)
+	n.logger.Warnf("Failed to renew the node key that expires at %s, retrying in %s: %v", expiry.Format(time.RFC3339), nodeKeyRenewalRetryDelay, err)
+
+	return nil
+}
+
+// parseNetworkKey converts a network key issued by the server
+func parseNetworkKey(key *apiclient.NetworkKey, fetchedAt time.Time) (*networkKey, error) {
+	networkKey := &networkKey{
+		key:       key.Key,
+		fetchedAt: fetchedAt,
+	}
+
+	if key.ExpiresAt != nil {
+		var err error
+		networkKey.expiresAt, err = time.Parse(time.RFC3339, *key.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry of network key: %w", err)
+		}
+	}
+
+	return networkKey, nil
+}
+
+// NodeKeyExpiry returns when the node key of the node in the tailnet of Server expires. Nil while the node is
+// disconnected or if its key does not expire
+func (s *Server) NodeKeyExpiry() *time.Time {
+	n := s.primaryNode()
+	if n == nil {
+		return nil
+	}
+
+	return n.keyExpiry.Load()
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeKeyRenewAt(t *testing.T) {
+	now := time.Now()
+
+	expiry := now.Add(90 * 24 * time.Hour)
+	require.Equal(t, expiry.Add(-nodeKeyRenewalMargin), nodeKeyRenewAt(expiry, now))
+
+	// Nodes that expire sooner are renewed after 90% of their remaining time
+	expiry = now.Add(10 * time.Hour)
+	require.Equal(t, expiry.Add(-time.Hour), nodeKeyRenewAt(expiry, now))
+
+	// Expired keys are renewed right away
+	expiry = now.Add(-time.Hour)
+	require.Equal(t, expiry, nodeKeyRenewAt(expiry, now))
+}
+
+func TestRenewNodeKey(t *testing.T) {
+	var persistent bool
+	failing := true
+	s := &Server{
+		Persistent: true,
+		RenewNetworkKey: func(ctx context.Context, p bool) (*apiclient.NetworkKey, error) {
+			persistent = p
+			if failing {
+				return nil, errors.New("unavailable")
+			}
+			return &apiclient.NetworkKey{Key: "renewed"}, nil
+		},
+	}
+	n := newNode(s, "", config.DaytonaServerConfig{})
+
+	now := time.Now()
+	n.connectedAt = now.Add(-80 * 24 * time.Hour)
+
+	// Keys that do not expire are never renewed
+	require.NoError(t, n.renewNodeKey(context.Background(), now))
+	require.Nil(t, s.NodeKeyExpiry())
+
+	s.nodes = []*node{n}
+	expiry := now.Add(48 * time.Hour)
+	n.keyExpiry.Store(&expiry)
+	require.Equal(t, &expiry, s.NodeKeyExpiry())
+	require.NoError(t, n.renewNodeKey(context.Background(), now))
+
+	// Failures are retried after a delay without dropping the connection
+	now = expiry.Add(-time.Hour)
+	require.NoError(t, n.renewNodeKey(context.Background(), now))
+	require.True(t, persistent)
+	failing = false
+	require.NoError(t, n.renewNodeKey(context.Background(), now.Add(time.Minute)))
+	require.Nil(t, n.networkKeys.get())
+
+	require.ErrorIs(t, n.renewNodeKey(context.Background(), now.Add(nodeKeyRenewalRetryDelay)), errNodeKeyRenewed)
+	require.Equal(t, "renewed", n.networkKeys.take().key)
+
+	// Nodes in the tailnets of additional servers are not renewed through the own server
+	staging := newNode(s, "staging", config.DaytonaServerConfig{})
+	staging.keyExpiry.Store(&now)
+	require.NoError(t, staging.renewNodeKey(context.Background(), now))
+}
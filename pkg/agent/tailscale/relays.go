@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"tailscale.com/tailcfg"
+	"tailscale.com/tsnet"
+)
+
+const (
+	// Interval at which the relay regions of the tailnet are probed while the node is connected
+	relayProbeInterval = time.Minute
+	// The first probe also establishes the connection, so more than one sample is needed
+	relayProbeSamples = 3
+	relayProbeTimeout = 5 * time.Second
+)
+
+// Path answered by DERP relays, including the relay embedded in Headscale, without upgrading the connection
+const derpProbePath = "/derp/probe"
+
+// probeRelaysLoop probes the relay regions of the tailnet until the context is canceled, so that slow connections to
+// the project can be traced to the relays it is reachable through. Nothing is probed while the node is disconnected
+func (n *node) probeRelaysLoop(ctx context.Context) {
+	delay := statusCheckInterval
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = relayProbeInterval
+
+		tsnetServer := n.currentTsnetServer()
+		if tsnetServer == nil {
+			delay = statusCheckInterval
+			continue
+		}
+
+		relays, err := n.probeRelays(ctx, tsnetServer)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			n.logger.Debugf("Failed to probe DERP relays: %v", err)
+			continue
+		}
+
+		n.relays.Store(&relays)
+	}
+}
+
+func (n *node) probeRelays(ctx context.Context, tsnetServer *tsnet.Server) ([]project.RelayHealth, error) {
+	localClient, err := tsnetServer.LocalClient()
+	if err != nil {
+		return nil, err
+	}
+
+	derpMap, err := localClient.CurrentDERPMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	relays := probeDerpMap(ctx, http.DefaultTransport.(*http.Transport), derpMap)
+	for _, relay := range relays {
+		n.server.metrics.observeRelay(relay)
+	}
+
+	return relays, nil
+}
+
+// probeDerpMap probes the regions of the DERP map concurrently and returns their health sorted by region code
+func probeDerpMap(ctx context.Context, transport *http.Transport, derpMap *tailcfg.DERPMap) []project.RelayHealth {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	relays := []project.RelayHealth{}
+
+	for _, region := range derpMap.Regions {
+		wg.Add(1)
+		go func(region *tailcfg.DERPRegion) {
+			defer wg.Done()
+
+			health := probeRegion(ctx, transport, region)
+
+			mutex.Lock()
+			relays = append(relays, health)
+			mutex.Unlock()
+		}(region)
+	}
+
+	wg.Wait()
+
+	slices.SortFunc(relays, func(a, b project.RelayHealth) int {
+		return strings.Compare(a.Region, b.Region)
+	})
+
+	return relays
+}
+
+// probeRegion probes the relay nodes of the region in order until one responds
+func probeRegion(ctx context.Context, transport *http.Transport, region *tailcfg.DERPRegion) project.RelayHealth {
+	health := project.RelayHealth{
+		Region:    region.RegionCode,
+		CheckedAt: time.Now().Format(time.RFC3339),
+	}
+
+	err := errors.New("region has no relay nodes")
+	for _, node := range region.Nodes {
+		if node.STUNOnly {
+			continue
+		}
+
+		var latency time.Duration
+		latency, err = probeRelayNode(ctx, transport, node)
+		if err == nil {
+			health.Reachable = true
+			// Zero is reserved for unreachable regions
+			health.LatencyMs = max(latency.Milliseconds(), 1)
+			return health
+		}
+	}
+
+	health.Error = err.Error()
+
+	return health
+}
+
+// probeRelayNode returns the lowest round trip time of a few probe requests to the relay node
+func probeRelayNode(ctx context.Context, transport *http.Transport, node *tailcfg.DERPNode) (time.Duration, error) {
+	transport = transport.Clone()
+	defer transport.CloseIdleConnections()
+
+	port := "443"
+	if node.DERPPort != 0 {
+		port = strconv.Itoa(node.DERPPort)
+	}
+
+	// Nodes with a fixed address are dialed without resolving the host name, like the tailscale client does
+	if node.IPv4 != "" && node.IPv4 != "none" {
+		address := net.JoinHostPort(node.IPv4, port)
+		dialer := &net.Dialer{}
+		transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return dialer.DialContext(ctx, network, address)
+		}
+	}
+
+	if node.InsecureForTests {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	client := &http.Client{Transport: transport, Timeout: relayProbeTimeout}
+	url := fmt.Sprintf("https://%s%s", net.JoinHostPort(node.HostName, port), derpProbePath)
+
+	var lowest time.Duration
+
+	for i := 0; i < relayProbeSamples; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		start := time.Now()
+		res, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+		res.Body.Close()
+		if err != nil {
+			return 0, err
+		}
+
+		if res.StatusCode != http.StatusOK {
+			return 0, fmt.Errorf("relay node %s responded with status %d", node.Name, res.StatusCode)
+		}
+
+		latency := time.Since(start)
+		if lowest == 0 || latency < lowest {
+			lowest = latency
+		}
+	}
+
+	return lowest, nil
+}
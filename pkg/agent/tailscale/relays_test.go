@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func TestProbeDerpMap(t *testing.T) {
+	probes := 0
+	relay := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, derpProbePath, r.URL.Path)
+		probes++
+	}))
+	defer relay.Close()
+
+	_, port, err := net.SplitHostPort(relay.Listener.Addr().String())
+	require.NoError(t, err)
+	relayPort, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := closed.Addr().(*net.TCPAddr).Port
+	closed.Close()
+
+	derpMap := &tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{
+			900: {
+				RegionID:   900,
+				RegionCode: "eu",
+				Nodes: []*tailcfg.DERPNode{
+					{Name: "900a", HostName: "example.com", IPv4: "127.0.0.1", DERPPort: closedPort},
+					// The certificate of the test server is valid for example.com
+					{Name: "900b", HostName: "example.com", IPv4: "127.0.0.1", DERPPort: relayPort},
+				},
+			},
+			901: {
+				RegionID:   901,
+				RegionCode: "us",
+				Nodes: []*tailcfg.DERPNode{
+					{Name: "901a", HostName: "example.com", IPv4: "127.0.0.1", DERPPort: closedPort},
+				},
+			},
+			902: {
+				RegionID:   902,
+				RegionCode: "ap",
+				Nodes: []*tailcfg.DERPNode{
+					{Name: "902a", HostName: "stun.example.com", STUNOnly: true},
+				},
+			},
+		},
+	}
+
+	relays := probeDerpMap(context.Background(), relay.Client().Transport.(*http.Transport), derpMap)
+	require.Len(t, relays, 3)
+
+	require.Equal(t, "ap", relays[0].Region)
+	require.False(t, relays[0].Reachable)
+	require.Equal(t, "region has no relay nodes", relays[0].Error)
+
+	require.Equal(t, "eu", relays[1].Region)
+	require.True(t, relays[1].Reachable)
+	require.Positive(t, relays[1].LatencyMs)
+	require.Empty(t, relays[1].Error)
+	require.NotEmpty(t, relays[1].CheckedAt)
+	require.Equal(t, relayProbeSamples, probes)
+
+	require.Equal(t, "us", relays[2].Region)
+	require.False(t, relays[2].Reachable)
+	require.Zero(t, relays[2].LatencyMs)
+	require.NotEmpty(t, relays[2].Error)
+}
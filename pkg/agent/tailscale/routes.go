@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the gateway refreshes its routing table
+const routesRefreshInterval = 10 * time.Second
+
+// routingTable holds the routes of the projects that share the tailnet node of the agent
+type routingTable struct {
+	mu     sync.RWMutex
+	routes []project.RoutingEntry
+}
+
+// lookup returns the address in the project container the tailnet port is routed to
+func (t *routingTable) lookup(port uint16) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, route := range t.routes {
+		if port >= route.PortRangeStart && port <= route.PortRangeEnd {
+			return net.JoinHostPort(route.Address, strconv.Itoa(int(port-route.PortRangeStart))), true
+		}
+	}
+
+	return "", false
+}
+
+func (t *routingTable) set(routes []project.RoutingEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.routes = routes
+}
+
+// refreshRoutes keeps the routing table in sync with the server. The last known table is kept if the server is unreachable
+func (s *Server) refreshRoutes(ctx context.Context) {
+	for {
+		requestCtx, cancel := context.WithTimeout(ctx, routesRefreshInterval)
+		routes, err := s.GetRoutes(requestCtx)
+		cancel()
+
+		if err != nil {
+			log.Debugf("Failed to refresh routes: %v", err)
+		} else {
+			s.routes.set(routes)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(routesRefreshInterval):
+		}
+	}
+}
+
+func (s *Server) getRoute(port uint16) (string, bool) {
+	if s.GetRoutes == nil {
+		return "", false
+	}
+
+	return s.routes.lookup(port)
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutingTable(t *testing.T) {
+	table := routingTable{}
+	table.set([]project.RoutingEntry{
+		{Project: "web", Address: "172.17.0.3", PortRangeStart: 10000, PortRangeEnd: 19999},
+	})
+
+	address, ok := table.lookup(12222)
+	require.True(t, ok)
+	require.Equal(t, "172.17.0.3:2222", address)
+
+	_, ok = table.lookup(2222)
+	require.False(t, ok)
+	_, ok = table.lookup(20000)
+	require.False(t, ok)
+}
@@ -5,177 +5,362 @@ package tailscale
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
-	"net/http"
 	"net/netip"
-	"path/filepath"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
-	cfg "github.com/daytonaio/daytona/cmd/daytona/config"
-	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agentevent"
 	"github.com/daytonaio/daytona/pkg/apiclient"
-	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"tailscale.com/tsnet"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// Interval at which the connection to the tailnet is checked while the agent is connected
+const statusCheckInterval = 5 * time.Second
+
+// Interval at which the active connections are counted while the server is stopping
+const drainCheckInterval = 100 * time.Millisecond
+
 type Server struct {
-	Hostname         string
-	Server           config.DaytonaServerConfig
-	TelemetryEnabled bool
-	ClientId         string
+	Hostname string
+	Server   config.DaytonaServerConfig
+	// AdditionalServers are other Daytona Servers whose tailnets the agent joins at the same time, keyed by profile,
+	// e.g. to be reachable from both a staging and a production server. Routes, policies, limits and services are
+	// fetched from Server only
+	AdditionalServers map[string]config.DaytonaServerConfig
+	TelemetryEnabled  bool
+	ClientId          string
+	// AllowPort restricts the local ports reachable from the tailnet. All ports are reachable if not set
+	AllowPort func(port uint16) bool
+	// UnixSockets maps tailnet ports to Unix sockets inside the project
+	UnixSockets []UnixSocket
+	// AllowUnixSocket restricts the Unix sockets that can be mapped. All sockets can be mapped if not set
+	AllowUnixSocket func(path string) bool
+	// ProcessChecks report the health of the processes the agent runs, keyed by process name
+	ProcessChecks map[string]func(ctx context.Context) error
+	// UdpPorts forwards datagrams received on tailnet ports to local ports of the project
+	UdpPorts []UdpPort
+	// GetRoutes fetches the routing table of the node if other projects of the workspace share it
+	GetRoutes func(ctx context.Context) ([]project.RoutingEntry, error)
+	// GetAccessPolicy fetches the policy that restricts the tailnet peers that can connect to ports of the project
+	GetAccessPolicy func(ctx context.Context) (*project.PortAccessPolicy, error)
+	// GetBandwidthLimit fetches the limit of the traffic proxied from and to the tailnet. Traffic is not limited if nil
+	GetBandwidthLimit func(ctx context.Context) (*project.BandwidthLimit, error)
+	// MetricsPort serves Prometheus metrics of the proxy on the tailnet. Metrics are not collected if not set
+	MetricsPort uint16
+	// IdleTimeout closes proxied connections without data in either direction for this long. Connections are kept open if not set
+	IdleTimeout time.Duration
+	// MaxConnections caps the number of concurrently proxied connections. Unlimited if not set
+	MaxConnections int
+	// PortConnectionLimits caps the number of concurrently proxied connections per tailnet port
+	PortConnectionLimits map[uint16]int
+	// ProxyBufferSize is the size of the pooled buffers proxied connections are copied through. Defaults to 32KiB if not set
+	ProxyBufferSize int
+	// ProxyCompression compresses text responses of the http proxies with gzip for clients that accept it, which
+	// reduces the bandwidth of large log streams viewed from another region
+	ProxyCompression bool
+	// Persistent registers a node that stays in the tailnet while the agent is stopped. Its state is kept in the
+	// config dir so that the node key and MagicDNS name survive agent restarts. Ephemeral nodes are registered otherwise
+	Persistent bool
+	// ServiceProxyPort serves a local SOCKS5 proxy through which processes of the project reach the services of
+	// other workspaces by name. The proxy is not served if not set
+	ServiceProxyPort uint16
+	// ResolveService resolves the name of a service to its tailnet address. Required if ServiceProxyPort is set
+	ResolveService func(ctx context.Context, name string) (*project.ServiceEndpoint, error)
+	// HttpProxyPort serves an HTTP reverse proxy on the tailnet that routes requests to local ports by the Host header,
+	// so that previews are reachable without a listener per port. The proxy is not served if not set
+	HttpProxyPort uint16
+	// TlsPorts serve local ports of the project over HTTPS on tailnet ports, for apps that require a secure context.
+	// Not served in the tailnets of additional servers or if IssueCertificate is not set
+	TlsPorts []TlsPort
+	// IssueCertificate issues the certificate for the tailnet name of the project that TlsPorts are served with
+	IssueCertificate func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error)
+	// ServeSsh serves SSH on port 22 of the tailnet node, so that tailnet members can reach the project with plain ssh
+	// when the tunnel of the Daytona Server is unavailable. Not served if nil
+	ServeSsh func(ln net.Listener) error
+	// Fallback carries the connections to the project while no DERP relay of the tailnet can be reached, e.g. on
+	// networks that block WireGuard and DERP. It runs until its context is canceled once the tailnet is reached again.
+	// Not started if nil
+	Fallback func(ctx context.Context)
+	// FallbackAttempts is the number of consecutive failed attempts to reach the tailnet after which Fallback is
+	// started. Defaults to 5 if not set
+	FallbackAttempts int
+	// PublishEvent reports lifecycle and network events of the agent to the Daytona Server, e.g. reconnects to the
+	// tailnet or connections that could not be proxied to a port. Events are not reported if nil
+	PublishEvent func(eventType agentevent.Type, message string, port uint16)
+	// RecordActivity is called when a peer sends traffic to a port of the project, at most once every 10 seconds per
+	// port. Optional
+	RecordActivity func(port uint16)
+	// RenewNetworkKey issues a network key to register the node in the tailnet of Server again before its node key
+	// expires. Nodes that can not renew their key drop off the tailnet when it expires and register again afterwards
+	RenewNetworkKey func(ctx context.Context, persistent bool) (*apiclient.NetworkKey, error)
+
+	startTime         time.Time
+	routes            routingTable
+	accessPolicy      atomic.Pointer[project.PortAccessPolicy]
+	activeConnections atomic.Int32
+	metrics           *metrics
+	limiter           *connLimiter
+	bandwidth         *bandwidthLimiter
+	buffers           *bufferPool
+	activity          *activityRecorder
+	certificates      *certificateCache
+	// Guards the fields below, which are set while the server is running
+	mutex  sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+	// Nodes in the tailnets of the servers, starting with the node in the tailnet of Server
+	nodes []*node
 }
 
+// Start connects to the tailnets of the servers and keeps the connections alive until the server is stopped. Returns
+// once the reconnect budget of Server is exhausted. The budgets of the additional servers only stop their own nodes
 func (s *Server) Start() error {
-	errChan := make(chan error)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	defer close(done)
 
-	tsnetServer, err := s.connect()
-	if err != nil {
-		return fmt.Errorf("failed to connect to server: %w", err)
+	primary := newNode(s, "", s.Server)
+	primary.fallback = newFallbackRunner(s.FallbackAttempts, s.Fallback)
+	nodes := []*node{primary}
+
+	profiles := []string{}
+	for profile := range s.AdditionalServers {
+		profiles = append(profiles, profile)
 	}
+	slices.Sort(profiles)
 
-	go func(tsnetServer *tsnet.Server) {
-		reconnect := func() {
-			// Close the tsnet server and reconnect
-			err = tsnetServer.Close()
-			if err != nil {
-				log.Errorf("Failed to close tsnet server: %v", err)
-			}
+	for _, profile := range profiles {
+		nodes = append(nodes, newNode(s, profile, s.AdditionalServers[profile]))
+	}
 
-			tsnetServer, err = s.connect()
-			if err != nil {
-				log.Errorf("Failed to reconnect: %v", err)
-			} else {
-				log.Info("Reconnected to server")
-			}
+	s.mutex.Lock()
+	s.cancel = cancel
+	s.done = done
+	s.nodes = nodes
+	s.mutex.Unlock()
+
+	s.startTime = time.Now()
+
+	if s.MetricsPort != 0 && s.metrics == nil {
+		s.metrics = newMetrics(func() float64 {
+			return float64(max(s.activeConnections.Load(), 0))
+		})
+	}
+
+	if (s.MaxConnections > 0 || len(s.PortConnectionLimits) > 0) && s.limiter == nil {
+		s.limiter = newConnLimiter(s.MaxConnections, s.PortConnectionLimits)
+	}
+
+	if s.buffers == nil {
+		s.buffers = newBufferPool(s.ProxyBufferSize)
+	}
+
+	if s.RecordActivity != nil && s.activity == nil {
+		s.activity = newActivityRecorder(s.RecordActivity)
+	}
+
+	if len(s.TlsPorts) > 0 && s.IssueCertificate != nil && s.certificates == nil {
+		s.certificates = newCertificateCache(s.IssueCertificate)
+	}
+
+	if s.GetRoutes != nil {
+		go s.refreshRoutes(ctx)
+	}
+
+	if s.GetAccessPolicy != nil {
+		go s.refreshAccessPolicy(ctx)
+	}
+
+	if s.GetBandwidthLimit != nil {
+		if s.bandwidth == nil {
+			s.bandwidth = newBandwidthLimiter()
 		}
+		go s.refreshBandwidthLimit(ctx)
+	}
 
-		for {
-			time.Sleep(5 * time.Second)
+	if s.ServiceProxyPort != 0 && s.ResolveService != nil {
+		go s.serveServiceProxy(ctx)
+	}
 
-			localClient, err := tsnetServer.LocalClient()
+	var wg sync.WaitGroup
+	for _, n := range nodes[1:] {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := n.run(ctx)
 			if err != nil {
-				log.Errorf("Failed to get local client: %v, %v", err, common.ErrConnection)
-				reconnect()
-				continue
+				n.logger.Errorf("Left the tailnet of the server: %v", err)
 			}
+		}()
+	}
 
-			status, err := localClient.Status(context.Background())
-			if err != nil {
-				log.Errorf("Failed to get local client status: %v, %v", err, common.ErrConnection)
-				reconnect()
-				continue
-			}
+	err := primary.run(ctx)
 
-			if status.CurrentTailnet == nil {
-				log.Errorf("Tailscale not connected. %v. Reconnecting...", common.ErrConnection)
-				reconnect()
-			} else {
-				log.Tracef("Connected to server. Status: %v", status)
-			}
-		}
-	}(tsnetServer)
+	// The agent does not stay in the tailnets of the additional servers without the tailnet of its own server
+	cancel()
+	wg.Wait()
 
-	return <-errChan
+	return err
 }
 
-func (s *Server) getNetworkKey() (string, error) {
-	apiClient, err := apiclient_util.GetAgentApiClient(s.Server.ApiUrl, s.Server.ApiKey, s.ClientId, s.TelemetryEnabled)
-	if err != nil {
-		return "", err
+// Stop disconnects from the tailnets. New connections are rejected and in-flight connections are
+// drained until the context is done, after which they are closed along with the tsnet servers
+func (s *Server) Stop(ctx context.Context) error {
+	s.mutex.Lock()
+	cancel, done, nodes := s.cancel, s.done, s.nodes
+	s.mutex.Unlock()
+
+	if cancel == nil {
+		return nil
 	}
 
-	networkKey, _, err := apiClient.ServerAPI.GenerateNetworkKeyExecute(apiclient.ApiGenerateNetworkKeyRequest{})
-	// Retry indefinitely. Used to reconnect to the Daytona Server
-	if err != nil {
-		log.Tracef("Failed to get network key: %v", err)
-		time.Sleep(5 * time.Second)
-		return s.getNetworkKey()
+	cancel()
+
+	// Wait for the connection loops to exit so that no tsnet server is started after this point
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return fmt.Errorf("failed to stop the connection loop: %w", ctx.Err())
 	}
 
-	return networkKey.Key, nil
+	for _, n := range nodes {
+		n.shutdownHttpServers(ctx)
+		n.closeUdpForwarders()
+	}
+
+	errs := []error{s.drainConnections(ctx)}
+
+	for _, n := range nodes {
+		errs = append(errs, n.close())
+	}
+
+	return errors.Join(errs...)
 }
 
-func (s *Server) getTsnetServer() (*tsnet.Server, error) {
-	configDir, err := cfg.GetConfigDir()
-	if err != nil {
-		return nil, err
+// drainConnections waits for the in-flight proxied connections to finish
+func (s *Server) drainConnections(ctx context.Context) error {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		active := s.activeConnections.Load()
+		if active <= 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d connections were not drained: %w", active, ctx.Err())
+		case <-ticker.C:
+		}
 	}
+}
 
-	tsnetServer := &tsnet.Server{
-		Hostname:   s.Hostname,
-		ControlURL: s.Server.Url,
-		Ephemeral:  true,
-		Dir:        filepath.Join(configDir, "tsnet"),
+// publishEvent reports the event to the Daytona Server if events are published
+func (s *Server) publishEvent(eventType agentevent.Type, message string, port uint16) {
+	if s.PublishEvent == nil {
+		return
 	}
 
-	networkKey, err := s.getNetworkKey()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get network key: %w", err)
+	s.PublishEvent(eventType, message, port)
+}
+
+// Connected reports whether the agent joined the tailnet of Server and peers can reach the ports of the project
+func (s *Server) Connected() bool {
+	return s.primaryNode().currentTsnetServer() != nil
+}
+
+// primaryNode returns the node in the tailnet of Server. Nil if the server is not started
+func (s *Server) primaryNode() *node {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.nodes) == 0 {
+		return nil
 	}
 
-	tsnetServer.AuthKey = networkKey
+	return s.nodes[0]
+}
 
-	tsnetServer.RegisterFallbackTCPHandler(func(src, dest netip.AddrPort) (handler func(net.Conn), intercept bool) {
-		destPort := dest.Port()
+// allowUnixSocketPeer checks the access of the socket against the ACL tags of the tailnet peer
+func (s *Server) allowUnixSocketPeer(tsnetServer *tsnet.Server, socket *UnixSocket, src netip.AddrPort) bool {
+	if socket.Access == UnixSocketAccessAll {
+		return true
+	}
 
-		return func(src net.Conn) {
-			defer src.Close()
-			dst, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", destPort))
-			if err != nil {
-				log.Errorf("Dial failed: %v", err)
-				return
-			}
-			defer dst.Close()
-
-			done := make(chan struct{})
-
-			go func() {
-				defer src.Close()
-				defer dst.Close()
-				io.Copy(dst, src)
-				done <- struct{}{}
-			}()
-
-			go func() {
-				defer src.Close()
-				defer dst.Close()
-				io.Copy(src, dst)
-				done <- struct{}{}
-			}()
-
-			<-done
-			<-done
-		}, true
-	})
-
-	return tsnetServer, nil
+	peer, err := getPeer(tsnetServer, src)
+	if err != nil {
+		log.Errorf("Failed to identify peer %s: %v", src, err)
+		return false
+	}
+
+	return socket.AllowsPeer(peer.Tags)
 }
 
-func (s *Server) connect() (*tsnet.Server, error) {
-	tsnetServer, err := s.getTsnetServer()
+// proxyHandler returns the handler that proxies a connection from the tailnet port to the address. The connection
+// is rejected with a reset if the limit of concurrently proxied connections is reached
+func (s *Server) proxyHandler(network, address, protocol string, port uint16) (func(net.Conn), bool) {
+	err := s.limiter.acquire(port)
 	if err != nil {
-		return nil, err
+		s.metrics.incRejected(protocol, port)
+		log.Warnf("Rejected connection to port %d: %v", port, err)
+		return nil, false
 	}
 
-	ln, err := tsnetServer.Listen("tcp", ":80")
+	return func(src net.Conn) {
+		defer s.limiter.release(port)
+		s.activeConnections.Add(1)
+		defer s.activeConnections.Add(-1)
+		s.proxyConn(src, network, address, protocol, port)
+	}, true
+}
+
+// proxyConn forwards the connection from the tailnet port to the address and counts the bytes proxied in both directions.
+// The connection is closed once it is idle for the idle timeout of the server
+func (s *Server) proxyConn(src net.Conn, network, address, protocol string, port uint16) {
+	defer src.Close()
+	dst, err := net.Dial(network, address)
 	if err != nil {
-		return nil, err
+		s.metrics.incDialFailures(protocol, port)
+		log.Errorf("Dial failed: %v", err)
+		s.publishEvent(agentevent.TypeProxyError, fmt.Sprintf("Failed to proxy %s connection: %v", protocol, err), port)
+		return
+	}
+	defer dst.Close()
+
+	src = s.bandwidth.conn(src)
+
+	var srcReader, dstReader io.Reader = src, dst
+	if s.IdleTimeout > 0 {
+		timer := newIdleTimer(s.IdleTimeout, src, dst)
+		defer timer.stop()
+		srcReader, dstReader = timer.reader(src), timer.reader(dst)
 	}
+	srcReader = s.activity.reader(srcReader, port)
+
+	done := make(chan struct{})
 
 	go func() {
-		err := http.Serve(ln, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			fmt.Fprintf(w, "Ok\n")
-		}))
-		if err != nil {
-			// Trace log because this is expected to fail when disconnected from the Daytona Server
-			log.Tracef("Failed to serve: %v", err)
-		}
+		defer src.Close()
+		defer dst.Close()
+		s.buffers.copy(s.metrics.countReceived(dst, protocol, port), srcReader)
+		close(done)
 	}()
 
-	return tsnetServer, nil
+	// The other direction is copied on the goroutine of the handler
+	s.buffers.copy(s.metrics.countSent(src, protocol, port), dstReader)
+	src.Close()
+	dst.Close()
+
+	<-done
 }
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStop(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	s := &Server{
+		Hostname: "test",
+		Server:   config.DaytonaServerConfig{ApiUrl: "http://127.0.0.1:1"},
+	}
+
+	require.NoError(t, s.Stop(context.Background()))
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- s.Start()
+	}()
+
+	// The server keeps retrying to get a network key until it is stopped
+	require.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return s.cancel != nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, s.Stop(ctx))
+	require.NoError(t, <-errChan)
+}
+
+func TestStopAdditionalServers(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	s := &Server{
+		Hostname: "test",
+		Server:   config.DaytonaServerConfig{ApiUrl: "http://127.0.0.1:1"},
+		AdditionalServers: map[string]config.DaytonaServerConfig{
+			"staging": {ApiUrl: "http://127.0.0.1:2"},
+		},
+	}
+
+	errChan := make(chan error)
+	go func() {
+		errChan <- s.Start()
+	}()
+
+	// Every server has its own node, starting with the own server
+	require.Eventually(t, func() bool {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		return len(s.nodes) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Equal(t, "", s.primaryNode().profile)
+	require.Equal(t, "http://127.0.0.1:2", s.nodes[1].config.ApiUrl)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, s.Stop(ctx))
+	require.NoError(t, <-errChan)
+}
+
+func TestDrainConnections(t *testing.T) {
+	s := &Server{}
+	require.NoError(t, s.drainConnections(context.Background()))
+
+	s.activeConnections.Add(1)
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		s.activeConnections.Add(-1)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, s.drainConnections(ctx))
+
+	s.activeConnections.Add(1)
+
+	ctx, cancel = context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.drainConnections(ctx), context.DeadlineExceeded)
+}
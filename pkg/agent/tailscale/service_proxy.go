@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"tailscale.com/net/socks5"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var errNotConnected = errors.New("not connected to the tailnet")
+
+// serveServiceProxy serves a SOCKS5 proxy on the local port until the context is done. Service names, e.g.
+// api-proj.daytona.internal, are resolved by the server and dialed over the tailnet, other addresses directly
+func (s *Server) serveServiceProxy(ctx context.Context) {
+	ln, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", s.ServiceProxyPort))
+	if err != nil {
+		log.Errorf("Failed to start the service proxy: %v", err)
+		return
+	}
+
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	proxy := &socks5.Server{
+		Logf: log.Debugf,
+		Dialer: serviceDialer(s.ResolveService, func(ctx context.Context, network, address string) (net.Conn, error) {
+			// Services are resolved by Server, so they are dialed over its tailnet
+			tsnetServer := s.primaryNode().currentTsnetServer()
+			if tsnetServer == nil {
+				return nil, errNotConnected
+			}
+
+			return tsnetServer.Dial(ctx, network, address)
+		}),
+	}
+
+	err = proxy.Serve(ln)
+	if err != nil && ctx.Err() == nil {
+		log.Errorf("Service proxy stopped: %v", err)
+	}
+}
+
+// serviceDialer returns a dial function that dials resolved services with dialTailnet. Only the port the
+// service was registered with can be dialed
+func serviceDialer(resolve func(ctx context.Context, name string) (*project.ServiceEndpoint, error), dialTailnet func(ctx context.Context, network, address string) (net.Conn, error)) func(ctx context.Context, network, address string) (net.Conn, error) {
+	dialer := &net.Dialer{}
+
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		host, portSpec, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if !project.IsServiceName(host) {
+			return dialer.DialContext(ctx, network, address)
+		}
+
+		endpoint, err := resolve(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		if endpoint.Protocol != project.ServiceProtocolTcp {
+			return nil, fmt.Errorf("service %s uses the %s protocol, only tcp services can be proxied", host, endpoint.Protocol)
+		}
+
+		if portSpec != strconv.Itoa(int(endpoint.Port)) {
+			return nil, fmt.Errorf("service %s listens on port %d", host, endpoint.Port)
+		}
+
+		return dialTailnet(ctx, "tcp", endpoint.Address)
+	}
+}
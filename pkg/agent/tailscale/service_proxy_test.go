@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceDialer(t *testing.T) {
+	endpoints := map[string]*project.ServiceEndpoint{
+		"api-proj.daytona.internal": {Port: 8080, Address: "gateway:18080", Protocol: project.ServiceProtocolTcp},
+		"dns-proj.daytona.internal": {Port: 53, Address: "proj:53", Protocol: project.ServiceProtocolUdp},
+	}
+
+	resolve := func(ctx context.Context, name string) (*project.ServiceEndpoint, error) {
+		endpoint, ok := endpoints[name]
+		if !ok {
+			return nil, project.ErrServiceNotFound
+		}
+		return endpoint, nil
+	}
+
+	var dialed string
+	dialTailnet := func(ctx context.Context, network, address string) (net.Conn, error) {
+		dialed = address
+		conn, _ := net.Pipe()
+		return conn, nil
+	}
+
+	dial := serviceDialer(resolve, dialTailnet)
+	ctx := context.Background()
+
+	conn, err := dial(ctx, "tcp", "api-proj.daytona.internal:8080")
+	require.NoError(t, err)
+	conn.Close()
+	require.Equal(t, "gateway:18080", dialed)
+
+	_, err = dial(ctx, "tcp", "api-proj.daytona.internal:9090")
+	require.ErrorContains(t, err, "listens on port 8080")
+
+	_, err = dial(ctx, "tcp", "dns-proj.daytona.internal:53")
+	require.ErrorContains(t, err, "only tcp services")
+
+	_, err = dial(ctx, "tcp", "web-proj.daytona.internal:80")
+	require.ErrorIs(t, err, project.ErrServiceNotFound)
+
+	// Other addresses are dialed directly
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	conn, err = dial(ctx, "tcp", ln.Addr().String())
+	require.NoError(t, err)
+	conn.Close()
+}
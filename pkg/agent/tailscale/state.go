@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	cfg "github.com/daytonaio/daytona/cmd/daytona/config"
+	"tailscale.com/ipn"
+	"tailscale.com/ipn/ipnstate"
+)
+
+// errNeedsLogin is returned when the control server no longer accepts the node key, e.g. because the key expired
+// or the node was removed from the tailnet. The node has to register again with a new network key
+var errNeedsLogin = errors.New("the node key is no longer accepted by the control server")
+
+// getStateDir returns the directory of the tsnet state. Persistent and ephemeral nodes keep their state in
+// separate directories so that switching modes never reuses the node key of the other kind of node. Nodes in the
+// tailnets of additional servers keep their state in a directory per profile
+func (n *node) getStateDir() (string, error) {
+	return n.getModeStateDir(n.server.Persistent)
+}
+
+func (n *node) getModeStateDir(persistent bool) (string, error) {
+	configDir, err := cfg.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	if n.profile != "" {
+		configDir = filepath.Join(configDir, "tsnet-profiles", n.profile)
+	}
+
+	if persistent {
+		return filepath.Join(configDir, "tsnet-persistent"), nil
+	}
+
+	return filepath.Join(configDir, "tsnet"), nil
+}
+
+// hasState checks if the node registered before and stored its node key
+func (n *node) hasState() bool {
+	stateDir, err := n.getStateDir()
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(filepath.Join(stateDir, "tailscaled.state"))
+	return err == nil
+}
+
+// removeOtherModeState removes the state the node stored before it switched between a persistent and an ephemeral
+// node, so that switching back registers a new node instead of reusing the node key of a removed node
+func (n *node) removeOtherModeState() error {
+	stateDir, err := n.getModeStateDir(!n.server.Persistent)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(stateDir)
+}
+
+// resetState removes the stored node key so that the next connection registers a new node with a fresh network key
+func (n *node) resetState() error {
+	stateDir, err := n.getStateDir()
+	if err != nil {
+		return err
+	}
+
+	n.logger.Info("Removing the tsnet state to register the node again")
+
+	return os.RemoveAll(stateDir)
+}
+
+// nodeNeedsLogin returns true if the node has to register again to rotate its node key
+func nodeNeedsLogin(status *ipnstate.Status, now time.Time) bool {
+	if status.BackendState == ipn.NeedsLogin.String() {
+		return true
+	}
+
+	return status.Self != nil && status.Self.KeyExpiry != nil && !status.Self.KeyExpiry.After(now)
+}
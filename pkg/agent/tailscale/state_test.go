@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/ipn/ipnstate"
+)
+
+func TestStateDir(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DAYTONA_CONFIG_DIR", configDir)
+
+	ephemeral := newNode(&Server{}, "", config.DaytonaServerConfig{})
+	dir, err := ephemeral.getStateDir()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(configDir, "tsnet"), dir)
+
+	persistent := newNode(&Server{Persistent: true}, "", config.DaytonaServerConfig{})
+	persistentDir, err := persistent.getStateDir()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(configDir, "tsnet-persistent"), persistentDir)
+
+	// Nodes in the tailnets of additional servers never reuse the node key of the node of the own server
+	staging := newNode(&Server{Persistent: true}, "staging", config.DaytonaServerConfig{})
+	stagingDir, err := staging.getStateDir()
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(configDir, "tsnet-profiles", "staging", "tsnet-persistent"), stagingDir)
+
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.MkdirAll(persistentDir, 0755))
+	require.NoError(t, os.MkdirAll(stagingDir, 0755))
+
+	// Only the state of the node that has to register again is removed
+	require.NoError(t, persistent.resetState())
+	require.NoDirExists(t, persistentDir)
+	require.DirExists(t, dir)
+	require.DirExists(t, stagingDir)
+}
+
+func TestRemoveOtherModeState(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DAYTONA_CONFIG_DIR", configDir)
+
+	ephemeral := newNode(&Server{}, "", config.DaytonaServerConfig{})
+	persistent := newNode(&Server{Persistent: true}, "", config.DaytonaServerConfig{})
+
+	persistentDir, err := persistent.getStateDir()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(persistentDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(persistentDir, "tailscaled.state"), []byte("{}"), 0600))
+
+	require.True(t, persistent.hasState())
+	require.False(t, ephemeral.hasState())
+
+	// Switching to an ephemeral node drops the node key of the persistent node
+	require.NoError(t, ephemeral.removeOtherModeState())
+	require.NoDirExists(t, persistentDir)
+	require.False(t, persistent.hasState())
+}
+
+func TestNodeNeedsLogin(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-time.Minute)
+	valid := now.Add(time.Hour)
+
+	require.True(t, nodeNeedsLogin(&ipnstate.Status{BackendState: "NeedsLogin"}, now))
+	require.False(t, nodeNeedsLogin(&ipnstate.Status{BackendState: "Running"}, now))
+	require.False(t, nodeNeedsLogin(&ipnstate.Status{BackendState: "Running", Self: &ipnstate.PeerStatus{}}, now))
+	require.True(t, nodeNeedsLogin(&ipnstate.Status{BackendState: "Running", Self: &ipnstate.PeerStatus{KeyExpiry: &expired}}, now))
+	require.False(t, nodeNeedsLogin(&ipnstate.Status{BackendState: "Running", Self: &ipnstate.PeerStatus{KeyExpiry: &valid}}, now))
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval after a failed renewal in which handshakes keep the current certificate instead of retrying
+const certificateRetryInterval = time.Minute
+
+// TlsPort serves a local port of the project over HTTPS on a tailnet port with the certificate issued by the Daytona
+// Server for the tailnet name of the project
+type TlsPort struct {
+	Port       uint16
+	TargetPort uint16
+}
+
+// ParseTlsPort parses a port mapping in the <port>[:<target port>] format, e.g. 3000 or 8443:3000
+func ParseTlsPort(value string) (*TlsPort, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("invalid tls port mapping %q: expected <port>[:<target port>]", value)
+	}
+
+	port, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid tls port mapping %q: invalid port %s", value, parts[0])
+	}
+
+	tlsPort := &TlsPort{
+		Port:       uint16(port),
+		TargetPort: uint16(port),
+	}
+
+	if len(parts) > 1 {
+		targetPort, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil || targetPort == 0 {
+			return nil, fmt.Errorf("invalid tls port mapping %q: invalid target port %s", value, parts[1])
+		}
+		tlsPort.TargetPort = uint16(targetPort)
+	}
+
+	return tlsPort, nil
+}
+
+// ParseTlsPorts parses port mappings and makes sure every port is mapped once
+func ParseTlsPorts(values []string) ([]TlsPort, error) {
+	tlsPorts := []TlsPort{}
+	ports := map[uint16]bool{}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		tlsPort, err := ParseTlsPort(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if ports[tlsPort.Port] {
+			return nil, fmt.Errorf("tls port %d is mapped more than once", tlsPort.Port)
+		}
+		ports[tlsPort.Port] = true
+
+		tlsPorts = append(tlsPorts, *tlsPort)
+	}
+
+	return tlsPorts, nil
+}
+
+// certificateCache holds the certificate the TLS ports are served with. The certificate is issued on the first
+// handshake and renewed once less than a third of its validity is left. Handshakes keep the current certificate while
+// it can not be renewed
+type certificateCache struct {
+	issue func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error)
+
+	mutex       sync.Mutex
+	key         crypto.Signer
+	certificate *tls.Certificate
+	issuedAt    time.Time
+	expiresAt   time.Time
+	retryAt     time.Time
+}
+
+func newCertificateCache(issue func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error)) *certificateCache {
+	return &certificateCache{
+		issue: issue,
+	}
+}
+
+// getCertificate is the GetCertificate callback of the TLS config of the ports
+func (c *certificateCache) getCertificate(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+
+	if c.certificate != nil && (c.expiresAt.Sub(now) > c.expiresAt.Sub(c.issuedAt)/3 || now.Before(c.retryAt)) {
+		return c.certificate, nil
+	}
+
+	err := c.renew(hello.Context())
+	if err != nil {
+		if c.certificate != nil && now.Before(c.expiresAt) {
+			log.Warnf("Failed to renew the certificate of the tls ports: %v", err)
+			c.retryAt = now.Add(certificateRetryInterval)
+			return c.certificate, nil
+		}
+		return nil, fmt.Errorf("failed to issue the certificate of the tls ports: %w", err)
+	}
+
+	return c.certificate, nil
+}
+
+// renew issues a certificate to the key of the cache. The key is generated once, so it never leaves the agent
+func (c *certificateCache) renew(ctx context.Context) error {
+	if c.key == nil {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		c.key = key
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, c.key)
+	if err != nil {
+		return err
+	}
+
+	issued, err := c.issue(ctx, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})))
+	if err != nil {
+		return err
+	}
+
+	certificate := &tls.Certificate{PrivateKey: c.key}
+	rest := []byte(issued.Certificate)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		certificate.Certificate = append(certificate.Certificate, block.Bytes)
+	}
+
+	if len(certificate.Certificate) == 0 {
+		return errors.New("the issued certificate is not PEM encoded")
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return err
+	}
+	certificate.Leaf = leaf
+
+	c.certificate = certificate
+	c.issuedAt = leaf.NotBefore
+	c.expiresAt = leaf.NotAfter
+	c.retryAt = time.Time{}
+
+	return nil
+}
+
+// listenTlsPorts listens on the TLS ports of the server. Ports whose target is not accessible to the project user are
+// skipped. The returned http servers serve the returned listeners
+func (n *node) listenTlsPorts(tsnetServer *tsnet.Server) ([]*http.Server, []net.Listener, error) {
+	s := n.server
+
+	httpServers := []*http.Server{}
+	listeners := []net.Listener{}
+
+	for _, tlsPort := range s.TlsPorts {
+		if s.AllowPort != nil && !s.AllowPort(tlsPort.TargetPort) {
+			n.logger.Warnf("Not serving tls port %d. Port %d is not accessible to the project user", tlsPort.Port, tlsPort.TargetPort)
+			continue
+		}
+
+		ln, err := tsnetServer.Listen("tcp", fmt.Sprintf(":%d", tlsPort.Port))
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, nil, err
+		}
+
+		tlsConfig := &tls.Config{
+			GetCertificate: s.certificates.getCertificate,
+			NextProtos:     []string{"h2", "http/1.1"},
+		}
+
+		httpServers = append(httpServers, &http.Server{
+			Handler:   s.tlsProxyHandler(tsnetServer, tlsPort.TargetPort),
+			TLSConfig: tlsConfig,
+		})
+		listeners = append(listeners, tls.NewListener(s.bandwidth.listener(ln), tlsConfig))
+	}
+
+	return httpServers, listeners, nil
+}
+
+// tlsProxyHandler returns the handler that proxies the requests received on a tls port to the local port
+func (s *Server) tlsProxyHandler(tsnetServer *tsnet.Server, port uint16) http.Handler {
+	transport := &http.Transport{}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.proxyHttpRequest(w, r, tsnetServer, transport, port)
+	})
+}
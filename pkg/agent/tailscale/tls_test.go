@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTlsPorts(t *testing.T) {
+	tlsPorts, err := ParseTlsPorts([]string{
+		"3000",
+		" 8443:5173 ",
+		"",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []TlsPort{
+		{Port: 3000, TargetPort: 3000},
+		{Port: 8443, TargetPort: 5173},
+	}, tlsPorts)
+
+	for _, value := range []string{
+		"0",
+		"70000",
+		"443:0",
+		"443:web",
+		"443:3000:extra",
+	} {
+		_, err := ParseTlsPorts([]string{value})
+		require.Error(t, err, value)
+	}
+
+	_, err = ParseTlsPorts([]string{"443:3000", "443:3001"})
+	require.Error(t, err)
+}
+
+func TestCertificateCache(t *testing.T) {
+	ca, err := tailnetca.LoadOrCreate(t.TempDir(), "daytona.local")
+	require.NoError(t, err)
+
+	issued := 0
+	var issueErr error
+	c := newCertificateCache(func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error) {
+		if issueErr != nil {
+			return nil, issueErr
+		}
+		issued++
+
+		certificate, err := ca.Sign(csr, []string{"ws1-p1.daytona.local"})
+		if err != nil {
+			return nil, err
+		}
+
+		return apiclient.NewProjectCertificate(certificate.CertPem, certificate.NotAfter.String()), nil
+	})
+	hello := &tls.ClientHelloInfo{}
+
+	t.Run("Certificate is issued on the first handshake and reused", func(t *testing.T) {
+		certificate, err := c.getCertificate(hello)
+		require.NoError(t, err)
+
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM([]byte(ca.GetCertificatePem())))
+		_, err = certificate.Leaf.Verify(x509.VerifyOptions{DNSName: "ws1-p1.daytona.local", Roots: roots})
+		require.NoError(t, err)
+
+		reused, err := c.getCertificate(hello)
+		require.NoError(t, err)
+		require.Same(t, certificate, reused)
+		require.Equal(t, 1, issued)
+	})
+
+	t.Run("Certificate is renewed once less than a third of its validity is left", func(t *testing.T) {
+		previous := c.certificate
+		c.issuedAt = time.Now().Add(-tailnetca.CertificateValidity)
+		c.expiresAt = time.Now().Add(tailnetca.CertificateValidity / 4)
+
+		certificate, err := c.getCertificate(hello)
+		require.NoError(t, err)
+		require.NotSame(t, previous, certificate)
+		require.Equal(t, previous.PrivateKey, certificate.PrivateKey)
+		require.Equal(t, 2, issued)
+	})
+
+	t.Run("Current certificate is kept while it can not be renewed", func(t *testing.T) {
+		previous := c.certificate
+		c.issuedAt = time.Now().Add(-tailnetca.CertificateValidity)
+		c.expiresAt = time.Now().Add(tailnetca.CertificateValidity / 4)
+		issueErr = errors.New("server is unavailable")
+
+		certificate, err := c.getCertificate(hello)
+		require.NoError(t, err)
+		require.Same(t, previous, certificate)
+
+		c.expiresAt = time.Now().Add(-time.Minute)
+		c.retryAt = time.Time{}
+
+		_, err = c.getCertificate(hello)
+		require.ErrorContains(t, err, "server is unavailable")
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"tailscale.com/tsnet"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Flows without datagrams in either direction for this long are closed unless the port sets its own timeout
+const defaultUdpIdleTimeout = 2 * time.Minute
+
+// Large enough for any UDP datagram
+const udpBufferSize = 64 * 1024
+
+// UdpPort forwards datagrams received on a tailnet port to a local port of the project
+type UdpPort struct {
+	Port       uint16
+	TargetPort uint16
+	// IdleTimeout after which the flow of a tailnet peer is closed
+	IdleTimeout time.Duration
+}
+
+// ParseUdpPort parses a port mapping in the <port>[:<target port>[:<idle timeout>]] format,
+// e.g. 27015 or 53:5353:30s
+func ParseUdpPort(value string) (*UdpPort, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid udp port mapping %q: expected <port>[:<target port>[:<idle timeout>]]", value)
+	}
+
+	port, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid udp port mapping %q: invalid port %s", value, parts[0])
+	}
+
+	udpPort := &UdpPort{
+		Port:        uint16(port),
+		TargetPort:  uint16(port),
+		IdleTimeout: defaultUdpIdleTimeout,
+	}
+
+	if len(parts) > 1 {
+		targetPort, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil || targetPort == 0 {
+			return nil, fmt.Errorf("invalid udp port mapping %q: invalid target port %s", value, parts[1])
+		}
+		udpPort.TargetPort = uint16(targetPort)
+	}
+
+	if len(parts) > 2 {
+		idleTimeout, err := time.ParseDuration(parts[2])
+		if err != nil || idleTimeout <= 0 {
+			return nil, fmt.Errorf("invalid udp port mapping %q: invalid idle timeout %s", value, parts[2])
+		}
+		udpPort.IdleTimeout = idleTimeout
+	}
+
+	return udpPort, nil
+}
+
+// ParseUdpPorts parses port mappings and makes sure every port is mapped once
+func ParseUdpPorts(values []string) ([]UdpPort, error) {
+	udpPorts := []UdpPort{}
+	ports := map[uint16]bool{}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		udpPort, err := ParseUdpPort(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if ports[udpPort.Port] {
+			return nil, fmt.Errorf("udp port %d is mapped more than once", udpPort.Port)
+		}
+		ports[udpPort.Port] = true
+
+		udpPorts = append(udpPorts, *udpPort)
+	}
+
+	return udpPorts, nil
+}
+
+// forwardUdpPorts listens on the UDP ports once the node is up on the tailnet. tsnet has no fallback handler
+// for UDP, so the ports have to be listened on explicitly
+func (n *node) forwardUdpPorts(ctx context.Context, tsnetServer *tsnet.Server) {
+	s := n.server
+
+	_, err := tsnetServer.Up(ctx)
+	if err != nil {
+		// Trace log because this is expected to fail when disconnected from the Daytona Server
+		log.Tracef("Failed to forward udp ports: %v", err)
+		return
+	}
+
+	ip4, _ := tsnetServer.TailscaleIPs()
+
+	for _, udpPort := range s.UdpPorts {
+		if s.AllowPort != nil && !s.AllowPort(udpPort.TargetPort) {
+			log.Warnf("Not forwarding udp port %d. Port %d is not accessible to the project user", udpPort.Port, udpPort.TargetPort)
+			continue
+		}
+
+		conn, err := tsnetServer.ListenPacket("udp", netip.AddrPortFrom(ip4, udpPort.Port).String())
+		if err != nil {
+			log.Errorf("Failed to listen on udp port %d: %v", udpPort.Port, err)
+			continue
+		}
+
+		forwarder := newUdpForwarder(conn, fmt.Sprintf("127.0.0.1:%d", udpPort.TargetPort), udpPort.IdleTimeout, &s.activeConnections)
+		forwarder.port = udpPort.Port
+		forwarder.metrics = s.metrics
+		forwarder.activity = s.activity
+		forwarder.allow = func(src net.Addr) bool {
+			addrPort, err := netip.ParseAddrPort(src.String())
+			return err == nil && s.allowPeer(tsnetServer, addrPort, udpPort.Port)
+		}
+
+		n.udpMutex.Lock()
+		n.udpForwarders = append(n.udpForwarders, forwarder)
+		n.udpMutex.Unlock()
+
+		go func() {
+			err := forwarder.serve()
+			if err != nil {
+				log.Tracef("Stopped forwarding udp port %d: %v", udpPort.Port, err)
+			}
+		}()
+	}
+}
+
+// closeUdpForwarders stops forwarding the UDP ports of the tsnet server that is being closed
+func (n *node) closeUdpForwarders() {
+	n.udpMutex.Lock()
+	defer n.udpMutex.Unlock()
+
+	for _, forwarder := range n.udpForwarders {
+		forwarder.close()
+	}
+	n.udpForwarders = nil
+}
+
+// udpForwarder tracks a flow per tailnet peer address. Each flow has its own local socket,
+// so replies from the target reach the peer that sent the request
+type udpForwarder struct {
+	conn        net.PacketConn
+	target      string
+	idleTimeout time.Duration
+	// Incremented while a flow is open
+	activeFlows *atomic.Int32
+	// allow restricts the peers a flow is opened for. Flows are opened for every peer if not set
+	allow func(src net.Addr) bool
+	// Tailnet port the forwarder listens on. Used as the label of its metrics
+	port     uint16
+	metrics  *metrics
+	activity *activityRecorder
+
+	mutex sync.Mutex
+	flows map[string]*udpFlow
+}
+
+type udpFlow struct {
+	conn       net.Conn
+	lastActive atomic.Int64
+}
+
+func newUdpForwarder(conn net.PacketConn, target string, idleTimeout time.Duration, activeFlows *atomic.Int32) *udpForwarder {
+	return &udpForwarder{
+		conn:        conn,
+		target:      target,
+		idleTimeout: idleTimeout,
+		activeFlows: activeFlows,
+		flows:       map[string]*udpFlow{},
+	}
+}
+
+// serve forwards datagrams until the listener is closed
+func (f *udpForwarder) serve() error {
+	defer f.close()
+
+	buf := make([]byte, udpBufferSize)
+
+	for {
+		n, src, err := f.conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		flow, err := f.getFlow(src)
+		if err != nil {
+			f.metrics.incDialFailures(metricsProtocolUdp, f.port)
+			log.Errorf("Dial failed: %v", err)
+			continue
+		}
+		if flow == nil {
+			continue
+		}
+
+		flow.lastActive.Store(time.Now().UnixNano())
+
+		written, err := flow.conn.Write(buf[:n])
+		f.metrics.addReceived(metricsProtocolUdp, f.port, written)
+		f.activity.observe(f.port)
+		if err != nil {
+			log.Debugf("Failed to forward datagram from %s: %v", src, err)
+		}
+	}
+}
+
+// getFlow returns the flow of the peer, opening one if needed. Returns nil if the peer is not allowed
+func (f *udpForwarder) getFlow(src net.Addr) (*udpFlow, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if flow, ok := f.flows[src.String()]; ok {
+		return flow, nil
+	}
+
+	if f.allow != nil && !f.allow(src) {
+		return nil, nil
+	}
+
+	conn, err := net.Dial("udp", f.target)
+	if err != nil {
+		return nil, err
+	}
+
+	flow := &udpFlow{conn: conn}
+	f.flows[src.String()] = flow
+	f.activeFlows.Add(1)
+
+	go f.reply(src, flow)
+
+	return flow, nil
+}
+
+// reply forwards datagrams from the target back to the peer until the flow is idle or closed
+func (f *udpForwarder) reply(src net.Addr, flow *udpFlow) {
+	defer f.removeFlow(src, flow)
+
+	buf := make([]byte, udpBufferSize)
+
+	for {
+		err := flow.conn.SetReadDeadline(time.Now().Add(f.idleTimeout))
+		if err != nil {
+			return
+		}
+
+		n, err := flow.conn.Read(buf)
+		if err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() && time.Since(time.Unix(0, flow.lastActive.Load())) < f.idleTimeout {
+				// The peer kept sending, so the flow is not idle
+				continue
+			}
+			return
+		}
+
+		flow.lastActive.Store(time.Now().UnixNano())
+
+		written, err := f.conn.WriteTo(buf[:n], src)
+		f.metrics.addSent(metricsProtocolUdp, f.port, written)
+		if err != nil {
+			log.Debugf("Failed to forward datagram to %s: %v", src, err)
+		}
+	}
+}
+
+func (f *udpForwarder) removeFlow(src net.Addr, flow *udpFlow) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	flow.conn.Close()
+
+	if f.flows[src.String()] == flow {
+		delete(f.flows, src.String())
+		f.activeFlows.Add(-1)
+	}
+}
+
+// close closes the listener and every open flow
+func (f *udpForwarder) close() {
+	f.conn.Close()
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	for key, flow := range f.flows {
+		flow.conn.Close()
+		delete(f.flows, key)
+		f.activeFlows.Add(-1)
+	}
+}
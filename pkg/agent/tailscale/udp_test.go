@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUdpPorts(t *testing.T) {
+	udpPorts, err := ParseUdpPorts([]string{
+		"27015",
+		" 53:5353:30s ",
+		"",
+		"9000:9001",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []UdpPort{
+		{Port: 27015, TargetPort: 27015, IdleTimeout: defaultUdpIdleTimeout},
+		{Port: 53, TargetPort: 5353, IdleTimeout: 30 * time.Second},
+		{Port: 9000, TargetPort: 9001, IdleTimeout: defaultUdpIdleTimeout},
+	}, udpPorts)
+
+	for _, value := range []string{
+		"0",
+		"70000",
+		"53:0",
+		"53:dns",
+		"53:5353:forever",
+		"53:5353:-1s",
+		"53:5353:30s:extra",
+	} {
+		_, err := ParseUdpPorts([]string{value})
+		require.Error(t, err, value)
+	}
+
+	_, err = ParseUdpPorts([]string{"53", "53:5353"})
+	require.Error(t, err)
+}
+
+func TestUdpForwarder(t *testing.T) {
+	target, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+
+	// Echo server
+	go func() {
+		buf := make([]byte, udpBufferSize)
+		for {
+			n, addr, err := target.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			_, _ = target.WriteTo(buf[:n], addr)
+		}
+	}()
+
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	activeFlows := &atomic.Int32{}
+	forwarder := newUdpForwarder(listener, target.LocalAddr().String(), 200*time.Millisecond, activeFlows)
+	go func() {
+		_ = forwarder.serve()
+	}()
+
+	clients := []net.Conn{}
+	for i := 0; i < 2; i++ {
+		client, err := net.Dial("udp", listener.LocalAddr().String())
+		require.NoError(t, err)
+		defer client.Close()
+		clients = append(clients, client)
+	}
+
+	for i, client := range clients {
+		message := []byte{byte('a' + i)}
+		_, err = client.Write(message)
+		require.NoError(t, err)
+
+		require.NoError(t, client.SetReadDeadline(time.Now().Add(2*time.Second)))
+		buf := make([]byte, 16)
+		n, err := client.Read(buf)
+		require.NoError(t, err)
+		require.Equal(t, message, buf[:n])
+	}
+
+	// Every client gets its own flow
+	require.Equal(t, int32(2), activeFlows.Load())
+
+	// Idle flows are closed
+	require.Eventually(t, func() bool {
+		return activeFlows.Load() == 0
+	}, 2*time.Second, 50*time.Millisecond)
+
+	forwarder.close()
+}
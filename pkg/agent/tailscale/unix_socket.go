@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+)
+
+// UnixSocketAccess restricts the tailnet peers that can connect to a mapped Unix socket
+type UnixSocketAccess string
+
+const (
+	// UnixSocketAccessAll allows every tailnet peer
+	UnixSocketAccessAll UnixSocketAccess = "all"
+	// UnixSocketAccessServer only allows connections proxied by the Daytona Server
+	UnixSocketAccessServer UnixSocketAccess = "server"
+	// UnixSocketAccessCli only allows connections from Daytona CLI clients
+	UnixSocketAccessCli UnixSocketAccess = "cli"
+)
+
+// Peers are identified by the ACL tags the server assigns to the nodes that join the tailnet with its network keys.
+// Unlike hostnames, tags can not be changed by the peer
+var serverTag = networkkey.GetScopeTag(networkkey.ScopeServer, "server")
+var clientTagPrefix = networkkey.GetScopeTagPrefix(networkkey.ScopeClient)
+var workspaceTagPrefix = networkkey.GetScopeTagPrefix(networkkey.ScopeWorkspace)
+var providerTagPrefix = networkkey.GetScopeTagPrefix(networkkey.ScopeProvider)
+
+// UnixSocket maps a tailnet port to a Unix socket inside the project
+type UnixSocket struct {
+	Port   uint16
+	Path   string
+	Access UnixSocketAccess
+}
+
+// ParseUnixSocket parses a socket mapping in the <port>:<path>[:<access>] format,
+// e.g. 2375:/var/run/docker.sock:cli
+func ParseUnixSocket(value string) (*UnixSocket, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid unix socket mapping %q: expected <port>:<path>[:<access>]", value)
+	}
+
+	port, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid unix socket mapping %q: invalid port %s", value, parts[0])
+	}
+
+	if !filepath.IsAbs(parts[1]) {
+		return nil, fmt.Errorf("invalid unix socket mapping %q: socket path must be absolute", value)
+	}
+
+	socket := &UnixSocket{
+		Port:   uint16(port),
+		Path:   filepath.Clean(parts[1]),
+		Access: UnixSocketAccessAll,
+	}
+
+	if len(parts) == 3 {
+		switch UnixSocketAccess(parts[2]) {
+		case UnixSocketAccessAll, UnixSocketAccessServer, UnixSocketAccessCli:
+			socket.Access = UnixSocketAccess(parts[2])
+		default:
+			return nil, fmt.Errorf("invalid unix socket mapping %q: access must be one of %s, %s or %s", value, UnixSocketAccessAll, UnixSocketAccessServer, UnixSocketAccessCli)
+		}
+	}
+
+	return socket, nil
+}
+
+// ParseUnixSockets parses socket mappings and makes sure every port is mapped once
+func ParseUnixSockets(values []string) ([]UnixSocket, error) {
+	sockets := []UnixSocket{}
+	ports := map[uint16]bool{}
+
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		socket, err := ParseUnixSocket(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if ports[socket.Port] {
+			return nil, fmt.Errorf("port %d is mapped to more than one unix socket", socket.Port)
+		}
+		ports[socket.Port] = true
+
+		sockets = append(sockets, *socket)
+	}
+
+	return sockets, nil
+}
+
+// AllowsPeer checks if the tailnet peer with the given ACL tags can connect to the socket
+func (s UnixSocket) AllowsPeer(tags []string) bool {
+	switch s.Access {
+	case UnixSocketAccessServer:
+		return slices.Contains(tags, serverTag)
+	case UnixSocketAccessCli:
+		return hasTagPrefix(tags, clientTagPrefix)
+	default:
+		return true
+	}
+}
+
+// hasTagPrefix checks if any of the ACL tags starts with the prefix
+func hasTagPrefix(tags []string, prefix string) bool {
+	return slices.ContainsFunc(tags, func(tag string) bool {
+		return strings.HasPrefix(tag, prefix)
+	})
+}
+
+// getUnixSocket returns the socket mapped to the port or nil if the port is not mapped
+func (s *Server) getUnixSocket(port uint16) *UnixSocket {
+	for _, socket := range s.UnixSockets {
+		if socket.Port == port {
+			return &socket
+		}
+	}
+
+	return nil
+}
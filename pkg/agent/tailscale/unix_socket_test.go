@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/tailscale"
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUnixSockets(t *testing.T) {
+	sockets, err := tailscale.ParseUnixSockets([]string{
+		"2375:/var/run/docker.sock:cli",
+		" 5432:/var/run/postgresql/.s.PGSQL.5432 ",
+		"",
+		"50051:/tmp/../run/grpc.sock:server",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []tailscale.UnixSocket{
+		{Port: 2375, Path: "/var/run/docker.sock", Access: tailscale.UnixSocketAccessCli},
+		{Port: 5432, Path: "/var/run/postgresql/.s.PGSQL.5432", Access: tailscale.UnixSocketAccessAll},
+		{Port: 50051, Path: "/run/grpc.sock", Access: tailscale.UnixSocketAccessServer},
+	}, sockets)
+
+	for _, value := range []string{
+		"/var/run/docker.sock",
+		"0:/var/run/docker.sock",
+		"70000:/var/run/docker.sock",
+		"2375:docker.sock",
+		"2375:/var/run/docker.sock:everyone",
+	} {
+		_, err := tailscale.ParseUnixSockets([]string{value})
+		require.Error(t, err, value)
+	}
+
+	_, err = tailscale.ParseUnixSockets([]string{"2375:/var/run/docker.sock", "2375:/run/podman/podman.sock"})
+	require.Error(t, err)
+}
+
+func TestUnixSocketAllowsPeer(t *testing.T) {
+	serverTags := []string{networkkey.GetScopeTag(networkkey.ScopeServer, "server")}
+	clientTags := []string{networkkey.GetScopeTag(networkkey.ScopeClient, "default")}
+	workspaceTags := []string{networkkey.GetScopeTag(networkkey.ScopeWorkspace, "ws1")}
+
+	socket := tailscale.UnixSocket{Port: 2375, Path: "/var/run/docker.sock", Access: tailscale.UnixSocketAccessAll}
+	require.True(t, socket.AllowsPeer(workspaceTags))
+	require.True(t, socket.AllowsPeer(nil))
+
+	socket.Access = tailscale.UnixSocketAccessServer
+	require.True(t, socket.AllowsPeer(serverTags))
+	require.False(t, socket.AllowsPeer(clientTags))
+	require.False(t, socket.AllowsPeer(nil))
+
+	// Peers are not identified by their hostname, which they choose themselves
+	socket.Access = tailscale.UnixSocketAccessCli
+	require.True(t, socket.AllowsPeer(clientTags))
+	require.False(t, socket.AllowsPeer(workspaceTags))
+	require.False(t, socket.AllowsPeer([]string{"tag:daytona-workspace-cli-abc"}))
+}
@@ -0,0 +1,248 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+const clipboardPollInterval = 500 * time.Millisecond
+
+// The toolbox is only reachable by the Daytona Server, which checks the origin of browser sessions
+var bridgeUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+type bridgeSession struct {
+	server        *Server
+	ws            *websocket.Conn
+	clipboardFile string
+
+	writeMutex sync.Mutex
+
+	clipboardMutex   sync.Mutex
+	clipboardModTime time.Time
+	clipboardContent []byte
+}
+
+// Bridge exchanges clipboard content and file drops with a browser session over a WebSocket.
+// The workspace side of the clipboard is a file that programs in the project can read and write.
+func (s *Server) Bridge(ctx *gin.Context) {
+	clipboardFile, err := s.getClipboardFile()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ws, err := bridgeUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer ws.Close()
+
+	// File content is base64 encoded
+	ws.SetReadLimit(int64(base64.StdEncoding.EncodedLen(config.MAX_FILE_DROP_SIZE) + 64*1024))
+
+	session := &bridgeSession{
+		server:        s,
+		ws:            ws,
+		clipboardFile: clipboardFile,
+	}
+
+	// Only changes made after the session started are sent to the browser
+	if info, err := os.Stat(clipboardFile); err == nil {
+		session.clipboardModTime = info.ModTime()
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go session.watchClipboard(done)
+
+	for {
+		var msg dto.BridgeMessage
+		err := ws.ReadJSON(&msg)
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				log.Debugf("bridge session closed: %v", err)
+			}
+			return
+		}
+
+		err = session.handle(msg)
+		if err != nil {
+			session.send(dto.BridgeMessage{Type: dto.BridgeMessageTypeError, Name: msg.Name, Error: err.Error()})
+		}
+	}
+}
+
+func (s *Server) getClipboardFile() (string, error) {
+	if s.ClipboardFile != "" {
+		return s.ClipboardFile, nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".daytona", "clipboard"), nil
+}
+
+func (b *bridgeSession) handle(msg dto.BridgeMessage) error {
+	switch msg.Type {
+	case dto.BridgeMessageTypeClipboard:
+		return b.setClipboard([]byte(msg.Data))
+	case dto.BridgeMessageTypeFileDrop:
+		path, err := b.writeFileDrop(msg)
+		if err != nil {
+			return err
+		}
+		return b.send(dto.BridgeMessage{Type: dto.BridgeMessageTypeAck, Name: msg.Name, Path: path})
+	default:
+		return fmt.Errorf("unsupported message type %q", msg.Type)
+	}
+}
+
+func (b *bridgeSession) setClipboard(content []byte) error {
+	if len(content) > config.MAX_CLIPBOARD_SIZE {
+		return fmt.Errorf("clipboard content exceeds %d bytes", config.MAX_CLIPBOARD_SIZE)
+	}
+
+	b.clipboardMutex.Lock()
+	defer b.clipboardMutex.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(b.clipboardFile), 0755)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(b.clipboardFile, content, 0600)
+	if err != nil {
+		return err
+	}
+
+	// Remember the content so the watcher does not echo it back to the browser
+	b.clipboardContent = content
+	if info, err := os.Stat(b.clipboardFile); err == nil {
+		b.clipboardModTime = info.ModTime()
+	}
+
+	return nil
+}
+
+func (b *bridgeSession) watchClipboard(done chan struct{}) {
+	ticker := time.NewTicker(clipboardPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			content, changed := b.readClipboardChange()
+			if !changed {
+				continue
+			}
+
+			err := b.send(dto.BridgeMessage{Type: dto.BridgeMessageTypeClipboard, Data: string(content)})
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (b *bridgeSession) readClipboardChange() ([]byte, bool) {
+	b.clipboardMutex.Lock()
+	defer b.clipboardMutex.Unlock()
+
+	info, err := os.Stat(b.clipboardFile)
+	if err != nil || info.ModTime().Equal(b.clipboardModTime) || info.Size() > config.MAX_CLIPBOARD_SIZE {
+		return nil, false
+	}
+	b.clipboardModTime = info.ModTime()
+
+	content, err := os.ReadFile(b.clipboardFile)
+	if err != nil || bytes.Equal(content, b.clipboardContent) {
+		return nil, false
+	}
+	b.clipboardContent = content
+
+	return content, true
+}
+
+// writeFileDrop writes the dropped file and returns its path. Existing files are never overwritten,
+// a numeric suffix is added to the name instead.
+func (b *bridgeSession) writeFileDrop(msg dto.BridgeMessage) (string, error) {
+	name := filepath.Base(msg.Name)
+	if msg.Name == "" || name == "." || name == string(filepath.Separator) {
+		return "", errors.New("file name is required")
+	}
+
+	content, err := base64.StdEncoding.DecodeString(msg.Data)
+	if err != nil {
+		return "", fmt.Errorf("invalid file content: %w", err)
+	}
+
+	if len(content) > config.MAX_FILE_DROP_SIZE {
+		return "", fmt.Errorf("file exceeds %d bytes", config.MAX_FILE_DROP_SIZE)
+	}
+
+	dir := b.server.resolvePath(msg.Path)
+	err = checkWritable(dir)
+	if err != nil {
+		return "", err
+	}
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	for i := 0; ; i++ {
+		path := filepath.Join(dir, name)
+		if i > 0 {
+			path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", base, i, ext))
+		}
+
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		_, err = file.Write(content)
+		closeErr := file.Close()
+		if err != nil {
+			return "", err
+		}
+
+		return path, closeErr
+	}
+}
+
+func (b *bridgeSession) send(msg dto.BridgeMessage) error {
+	b.writeMutex.Lock()
+	defer b.writeMutex.Unlock()
+
+	return b.ws.WriteJSON(msg)
+}
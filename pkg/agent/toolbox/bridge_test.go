@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"encoding/base64"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBridge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	projectDir := t.TempDir()
+	clipboardFile := filepath.Join(t.TempDir(), "clipboard")
+	s := &Server{ProjectDir: projectDir, ClipboardFile: clipboardFile}
+
+	router := gin.New()
+	router.GET("/bridge", s.Bridge)
+	httpServer := httptest.NewServer(router)
+	defer httpServer.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http")+"/bridge", nil)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	// Browser to workspace clipboard
+	require.NoError(t, ws.WriteJSON(dto.BridgeMessage{Type: dto.BridgeMessageTypeClipboard, Data: "from the browser"}))
+	require.Eventually(t, func() bool {
+		content, err := os.ReadFile(clipboardFile)
+		return err == nil && string(content) == "from the browser"
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// Dropped files never overwrite existing files
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "notes.txt"), []byte("existing"), 0644))
+	require.NoError(t, ws.WriteJSON(dto.BridgeMessage{
+		Type: dto.BridgeMessageTypeFileDrop,
+		Name: "../notes.txt",
+		Data: base64.StdEncoding.EncodeToString([]byte("dropped")),
+	}))
+
+	var ack dto.BridgeMessage
+	require.NoError(t, ws.ReadJSON(&ack))
+	require.Equal(t, dto.BridgeMessageTypeAck, ack.Type)
+	require.Equal(t, filepath.Join(projectDir, "notes (1).txt"), ack.Path)
+
+	content, err := os.ReadFile(ack.Path)
+	require.NoError(t, err)
+	require.Equal(t, "dropped", string(content))
+
+	// Workspace to browser clipboard
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, os.WriteFile(clipboardFile, []byte("from the workspace"), 0600))
+
+	var msg dto.BridgeMessage
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	require.NoError(t, ws.ReadJSON(&msg))
+	require.Equal(t, dto.BridgeMessage{Type: dto.BridgeMessageTypeClipboard, Data: "from the workspace"}, msg)
+
+	require.NoError(t, ws.WriteJSON(dto.BridgeMessage{Type: "unknown"}))
+	require.NoError(t, ws.ReadJSON(&msg))
+	require.Equal(t, dto.BridgeMessageTypeError, msg.Type)
+}
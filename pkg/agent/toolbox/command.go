@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// Time given to the command to exit after it was interrupted
+const commandWaitDelay = 5 * time.Second
+
+// RunCommand runs a project command in a shell and responds with its exit code and combined output once it exits
+func (s *Server) RunCommand(ctx *gin.Context) {
+	var req dto.RunCommandRequest
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil || strings.TrimSpace(req.Command) == "" {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("command is required"))
+		return
+	}
+
+	workdir := s.ProjectDir
+	if req.Workdir != "" {
+		workdir = filepath.Join(s.ProjectDir, req.Workdir)
+		rel, err := filepath.Rel(s.ProjectDir, workdir)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("workdir must be inside the project directory"))
+			return
+		}
+	}
+
+	timeout := time.Duration(config.MAX_COMMAND_TIMEOUT) * time.Second
+	if req.Timeout > 0 {
+		timeout = min(timeout, time.Duration(req.Timeout)*time.Second)
+	}
+
+	// The command is stopped if the caller disconnects
+	cmdCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+	defer cancel()
+
+	output := &tailBuffer{limit: config.MAX_COMMAND_OUTPUT_SIZE}
+
+	cmd := exec.CommandContext(cmdCtx, "sh", "-c", req.Command)
+	cmd.Dir = workdir
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.WaitDelay = commandWaitDelay
+
+	err = cmd.Run()
+
+	res := dto.RunCommandResponse{
+		ExitCode:  cmd.ProcessState.ExitCode(),
+		TimedOut:  errors.Is(cmdCtx.Err(), context.DeadlineExceeded),
+		Output:    output.String(),
+		Truncated: output.truncated,
+	}
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) && !res.TimedOut {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// tailBuffer keeps the last limit bytes written to it
+type tailBuffer struct {
+	mutex     sync.Mutex
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if len(b.buf) > b.limit {
+		b.buf = append(b.buf[:0], b.buf[len(b.buf)-b.limit:]...)
+		b.truncated = true
+	}
+
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return string(b.buf)
+}
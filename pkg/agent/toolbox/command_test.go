@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCommand(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	projectDir := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(projectDir, "api"), 0755))
+	s := &Server{ProjectDir: projectDir}
+
+	router := gin.New()
+	router.POST("/commands/run", s.RunCommand)
+
+	run := func(req dto.RunCommandRequest) (int, dto.RunCommandResponse) {
+		body, err := json.Marshal(req)
+		require.NoError(t, err)
+
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/commands/run", bytes.NewReader(body)))
+
+		var res dto.RunCommandResponse
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+		}
+		return recorder.Code, res
+	}
+
+	code, res := run(dto.RunCommandRequest{Command: "pwd && echo failed >&2 && exit 3", Workdir: "api"})
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 3, res.ExitCode)
+	require.Equal(t, filepath.Join(projectDir, "api")+"\nfailed\n", res.Output)
+	require.False(t, res.Truncated)
+
+	code, res = run(dto.RunCommandRequest{Command: "exec sleep 5", Timeout: 1})
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, res.TimedOut)
+	require.NotEqual(t, 0, res.ExitCode)
+
+	code, _ = run(dto.RunCommandRequest{Command: "ls", Workdir: "../"})
+	require.Equal(t, http.StatusBadRequest, code)
+
+	output := &tailBuffer{limit: 4}
+	_, _ = output.Write([]byte("abc"))
+	_, _ = output.Write([]byte("def"))
+	require.Equal(t, "cdef", output.String())
+	require.True(t, output.truncated)
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+const TOOLBOX_PORT = 2280
+
+// Hard limits of the browser bridge. The Daytona Server enforces the configured, usually lower, limits
+const (
+	MAX_CLIPBOARD_SIZE = 16 * 1024 * 1024
+	MAX_FILE_DROP_SIZE = 512 * 1024 * 1024
+)
+
+// Largest payload transferred by a single network throughput test
+const MAX_NETWORK_TEST_SIZE = 256 * 1024 * 1024
+
+// Only the end of the output of project commands is returned, the start is discarded
+const MAX_COMMAND_OUTPUT_SIZE = 1024 * 1024
+
+// Project commands are killed after this many seconds unless the request sets a lower timeout
+const MAX_COMMAND_TIMEOUT = 30 * 60
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/agent/drift"
+	"github.com/gin-gonic/gin"
+)
+
+func (s *Server) GetProjectDrift(ctx *gin.Context) {
+	projectDrift, err := drift.Check(ctx.Request.Context(), s.ProjectDir)
+	if err != nil {
+		if errors.Is(err, drift.ErrNoBaseline) {
+			ctx.AbortWithError(http.StatusNotFound, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to check project drift: %w", err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, projectDrift)
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type BridgeMessageType string
+
+const (
+	// Clipboard content, sent in both directions
+	BridgeMessageTypeClipboard BridgeMessageType = "clipboard"
+	// File dropped in the browser and written to the project
+	BridgeMessageTypeFileDrop BridgeMessageType = "file-drop"
+	// Sent by the workspace once a dropped file is written
+	BridgeMessageTypeAck   BridgeMessageType = "ack"
+	BridgeMessageTypeError BridgeMessageType = "error"
+)
+
+// BridgeMessage is exchanged over the clipboard and file drop WebSocket of browser IDE and terminal sessions
+type BridgeMessage struct {
+	Type BridgeMessageType `json:"type"`
+	// Clipboard text or base64 encoded file content
+	Data string `json:"data,omitempty"`
+	// Name of the dropped file
+	Name string `json:"name,omitempty"`
+	// Directory the file is dropped into, relative to the project directory. Set to the written file path in acks
+	Path  string `json:"path,omitempty"`
+	Error string `json:"error,omitempty"`
+}
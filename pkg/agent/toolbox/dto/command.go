@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type RunCommandRequest struct {
+	Command string `json:"command" validate:"required"`
+	// Working directory relative to the project directory
+	Workdir string `json:"workdir,omitempty" validate:"optional"`
+	// Timeout in seconds
+	Timeout uint32 `json:"timeout,omitempty" validate:"optional"`
+} // @name RunCommandRequest
+
+type RunCommandResponse struct {
+	ExitCode int    `json:"exitCode" validate:"required"`
+	Output   string `json:"output" validate:"required"`
+	// Set if the start of the output was discarded
+	Truncated bool `json:"truncated" validate:"required"`
+	TimedOut  bool `json:"timedOut" validate:"required"`
+} // @name RunCommandResponse
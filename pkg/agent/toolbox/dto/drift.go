@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type DriftKind string // @name DriftKind
+
+const (
+	DriftKindImage      DriftKind = "image"
+	DriftKindDockerfile DriftKind = "dockerfile"
+	DriftKindFeature    DriftKind = "feature"
+	DriftKindEnv        DriftKind = "env"
+)
+
+type DriftDifference struct {
+	Kind DriftKind `json:"kind" validate:"required"`
+	Key  string    `json:"key" validate:"required"`
+	// Expected is the value in the current devcontainer configuration
+	Expected string `json:"expected" validate:"required"`
+	// Actual is the value in the running project
+	Actual string `json:"actual" validate:"required"`
+} // @name DriftDifference
+
+type ProjectDrift struct {
+	Drifted     bool              `json:"drifted" validate:"required"`
+	Differences []DriftDifference `json:"differences" validate:"required"`
+} // @name ProjectDrift
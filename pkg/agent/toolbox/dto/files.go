@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type FileInfo struct {
+	Name        string `json:"name" validate:"required"`
+	Path        string `json:"path" validate:"required"`
+	IsDir       bool   `json:"isDir" validate:"required"`
+	Size        int64  `json:"size" validate:"required"`
+	Permissions string `json:"permissions" validate:"required"`
+	Writable    bool   `json:"writable" validate:"required"`
+	ModTime     string `json:"modTime" validate:"required"`
+} // @name FileInfo
+
+type FileList struct {
+	Path   string     `json:"path" validate:"required"`
+	Files  []FileInfo `json:"files" validate:"required"`
+	Total  int        `json:"total" validate:"required"`
+	Offset int        `json:"offset" validate:"required"`
+	Limit  int        `json:"limit" validate:"required"`
+} // @name FileList
+
+type MoveFileRequest struct {
+	Source      string `json:"source" validate:"required"`
+	Destination string `json:"destination" validate:"required"`
+} // @name MoveFileRequest
@@ -0,0 +1,10 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/workspace/project"
+
+type UpdateBranchRequest struct {
+	Strategy project.BranchUpdateStrategy `json:"strategy" validate:"required"`
+} // @name UpdateBranchRequest
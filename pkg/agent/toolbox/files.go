@@ -0,0 +1,357 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+	// Files larger than this are not previewed and must be downloaded instead
+	MaxPreviewSize = 1024 * 1024
+	// Uploads larger than this are rejected unless the server sets another limit
+	DefaultMaxUploadSize = 100 * 1024 * 1024
+)
+
+func (s *Server) ListFiles(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	offset, limit, err := getPagination(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to read directory: %w", err))
+		return
+	}
+
+	// Directories are listed first so they stay on the first pages
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].IsDir() != entries[j].IsDir() {
+			return entries[i].IsDir()
+		}
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	files := []dto.FileInfo{}
+	for i := offset; i < len(entries) && i < offset+limit; i++ {
+		info, err := entries[i].Info()
+		if err != nil {
+			continue
+		}
+		files = append(files, getFileInfo(filepath.Join(path, entries[i].Name()), info))
+	}
+
+	ctx.JSON(http.StatusOK, dto.FileList{
+		Path:   path,
+		Files:  files,
+		Total:  len(entries),
+		Offset: offset,
+		Limit:  limit,
+	})
+}
+
+func (s *Server) PreviewFile(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	info, err := os.Stat(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to stat file: %w", err))
+		return
+	}
+
+	if info.IsDir() {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("path is a directory"))
+		return
+	}
+
+	if info.Size() > MaxPreviewSize {
+		ctx.AbortWithError(http.StatusRequestEntityTooLarge, fmt.Errorf("file is larger than the %d byte preview limit", MaxPreviewSize))
+		return
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to read file: %w", err))
+		return
+	}
+
+	contentType := http.DetectContentType(content)
+	if !isPreviewable(contentType) {
+		ctx.AbortWithError(http.StatusUnsupportedMediaType, fmt.Errorf("preview is not supported for %s files", contentType))
+		return
+	}
+
+	// Previews are shown in the dashboard, so documents that can run scripts are served as plain text and the browser
+	// must neither sniff another type nor run scripts of the response
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Header("Content-Security-Policy", "sandbox")
+	ctx.Data(http.StatusOK, getPreviewContentType(contentType), content)
+}
+
+func (s *Server) DownloadFile(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	info, err := os.Stat(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to stat file: %w", err))
+		return
+	}
+
+	if info.IsDir() {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("path is a directory"))
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to open file: %w", err))
+		return
+	}
+	defer file.Close()
+
+	ctx.DataFromReader(http.StatusOK, info.Size(), "application/octet-stream", file, map[string]string{
+		"Content-Disposition": "attachment; filename=" + strconv.Quote(info.Name()),
+	})
+}
+
+func (s *Server) UploadFile(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	maxUploadSize := s.MaxUploadSize
+	if maxUploadSize <= 0 {
+		maxUploadSize = DefaultMaxUploadSize
+	}
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxUploadSize)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			ctx.AbortWithError(http.StatusRequestEntityTooLarge, fmt.Errorf("file is larger than the %d byte upload limit", maxUploadSize))
+			return
+		}
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	// Uploading to a directory keeps the uploaded file name
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, filepath.Base(fileHeader.Filename))
+	}
+
+	err = checkWritable(filepath.Dir(path))
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), err)
+		return
+	}
+
+	src, err := fileHeader.Open()
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read uploaded file: %w", err))
+		return
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to create file: %w", err))
+		return
+	}
+	defer dst.Close()
+
+	_, err = io.Copy(dst, src)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to write file: %w", err))
+		return
+	}
+
+	info, err := dst.Stat()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, getFileInfo(path, info))
+}
+
+func (s *Server) CreateFolder(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	err := checkWritable(filepath.Dir(path))
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), err)
+		return
+	}
+
+	err = os.MkdirAll(path, 0755)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to create folder: %w", err))
+		return
+	}
+
+	ctx.Status(http.StatusCreated)
+}
+
+func (s *Server) MoveFile(ctx *gin.Context) {
+	var req dto.MoveFileRequest
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	source := s.resolvePath(req.Source)
+	destination := s.resolvePath(req.Destination)
+
+	for _, dir := range []string{filepath.Dir(source), filepath.Dir(destination)} {
+		err = checkWritable(dir)
+		if err != nil {
+			ctx.AbortWithError(getStatusCode(err), err)
+			return
+		}
+	}
+
+	err = os.Rename(source, destination)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to move file: %w", err))
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+func (s *Server) DeleteFile(ctx *gin.Context) {
+	path := s.resolvePath(ctx.Query("path"))
+
+	if path == s.ProjectDir || path == "/" {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("refusing to delete the project directory"))
+		return
+	}
+
+	err := checkWritable(filepath.Dir(path))
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), err)
+		return
+	}
+
+	_, err = os.Lstat(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to stat file: %w", err))
+		return
+	}
+
+	err = os.RemoveAll(path)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to delete file: %w", err))
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// resolvePath resolves relative paths against the project directory
+func (s *Server) resolvePath(path string) string {
+	if path == "" {
+		return s.ProjectDir
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(s.ProjectDir, path)
+	}
+
+	return filepath.Clean(path)
+}
+
+func getPagination(ctx *gin.Context) (int, int, error) {
+	offset, err := strconv.Atoi(ctx.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		return 0, 0, errors.New("invalid offset")
+	}
+
+	limit, err := strconv.Atoi(ctx.DefaultQuery("limit", strconv.Itoa(DefaultPageSize)))
+	if err != nil || limit <= 0 {
+		return 0, 0, errors.New("invalid limit")
+	}
+
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return offset, limit, nil
+}
+
+func getFileInfo(path string, info fs.FileInfo) dto.FileInfo {
+	return dto.FileInfo{
+		Name:        info.Name(),
+		Path:        path,
+		IsDir:       info.IsDir(),
+		Size:        info.Size(),
+		Permissions: info.Mode().String(),
+		Writable:    unix.Access(path, unix.W_OK) == nil,
+		ModTime:     info.ModTime().Format(time.RFC3339),
+	}
+}
+
+// checkWritable verifies that the agent user can write to dir before changes are attempted
+func checkWritable(dir string) error {
+	err := unix.Access(dir, unix.W_OK)
+	if err != nil {
+		if errors.Is(err, unix.ENOENT) {
+			return fmt.Errorf("%s: %w", dir, fs.ErrNotExist)
+		}
+		return fmt.Errorf("%s is not writable: %w", dir, fs.ErrPermission)
+	}
+
+	return nil
+}
+
+func isPreviewable(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/") ||
+		strings.HasPrefix(contentType, "image/") ||
+		strings.HasPrefix(contentType, "application/json")
+}
+
+// getPreviewContentType serves HTML and XML, which includes SVG images, as plain text so that previews of workspace
+// files can not run scripts in the dashboard
+func getPreviewContentType(contentType string) string {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+
+	switch strings.TrimSpace(mediaType) {
+	case "text/html", "text/xml", "application/xml", "image/svg+xml", "application/xhtml+xml":
+		return "text/plain; charset=utf-8"
+	}
+
+	return contentType
+}
+
+func getStatusCode(err error) int {
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return http.StatusNotFound
+	case errors.Is(err, fs.ErrPermission):
+		return http.StatusForbidden
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRouter(t *testing.T) (*gin.Engine, string) {
+	t.Helper()
+
+	gin.SetMode(gin.TestMode)
+	projectDir := t.TempDir()
+	s := &Server{ProjectDir: projectDir, MaxUploadSize: 1024}
+
+	router := gin.New()
+	router.GET("/files/", s.ListFiles)
+	router.GET("/files/preview", s.PreviewFile)
+	router.POST("/files/upload", s.UploadFile)
+	router.DELETE("/files/", s.DeleteFile)
+
+	return router, projectDir
+}
+
+func TestListFiles(t *testing.T) {
+	router, projectDir := newTestRouter(t)
+
+	require.Nil(t, os.Mkdir(filepath.Join(projectDir, "src"), 0755))
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		require.Nil(t, os.WriteFile(filepath.Join(projectDir, name), []byte(name), 0644))
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/?offset=0&limit=2", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var list dto.FileList
+	require.Nil(t, json.Unmarshal(w.Body.Bytes(), &list))
+	require.Equal(t, 4, list.Total)
+	require.Len(t, list.Files, 2)
+	require.Equal(t, "src", list.Files[0].Name)
+	require.True(t, list.Files[0].IsDir)
+	require.Equal(t, "a.txt", list.Files[1].Name)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/?path=missing", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPreviewFile(t *testing.T) {
+	router, projectDir := newTestRouter(t)
+
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "README.md"), []byte("# Daytona"), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "large.txt"), []byte(strings.Repeat("a", MaxPreviewSize+1)), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "binary"), []byte{0x00, 0x01, 0x02}, 0644))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/preview?path=README.md", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "# Daytona", w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/preview?path=large.txt", nil))
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/preview?path=binary", nil))
+	require.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestPreviewFileServesScriptableDocumentsAsText(t *testing.T) {
+	router, projectDir := newTestRouter(t)
+
+	files := map[string]string{
+		"index.html": "<html><script>alert(1)</script></html>",
+		"icon.svg":   `<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"></svg>`,
+	}
+
+	for name, content := range files {
+		require.Nil(t, os.WriteFile(filepath.Join(projectDir, name), []byte(content), 0644))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/files/preview?path="+name, nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		require.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		require.Equal(t, "sandbox", w.Header().Get("Content-Security-Policy"))
+		require.Equal(t, content, w.Body.String())
+	}
+}
+
+func TestUploadFileLimit(t *testing.T) {
+	router, projectDir := newTestRouter(t)
+
+	upload := func(content string) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "upload.txt")
+		require.Nil(t, err)
+		_, err = part.Write([]byte(content))
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/files/upload", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := upload("small")
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "upload.txt"))
+	require.Nil(t, err)
+	require.Equal(t, "small", string(content))
+
+	w = upload(strings.Repeat("a", 2048))
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestDeleteProjectDir(t *testing.T) {
+	router, _ := newTestRouter(t)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/files/", nil))
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
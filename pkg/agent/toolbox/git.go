@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// FetchUpstream fetches the upstream branch of the project and responds with the git status of the project
+func (s *Server) FetchUpstream(ctx *gin.Context) {
+	gitService := &git.Service{ProjectDir: s.ProjectDir}
+
+	err := gitService.FetchUpstream(ctx.Request.Context())
+	if err != nil {
+		if errors.Is(err, project.ErrNoUpstreamBranch) {
+			ctx.AbortWithError(http.StatusConflict, err)
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to fetch upstream branch: %w", err))
+		return
+	}
+
+	status, err := gitService.GetGitStatus()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git status: %w", err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// UpdateBranch brings the checked out branch of the project up to date with its upstream branch
+func (s *Server) UpdateBranch(ctx *gin.Context) {
+	var req dto.UpdateBranchRequest
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	gitService := &git.Service{ProjectDir: s.ProjectDir}
+
+	update, err := gitService.UpdateBranch(ctx.Request.Context(), req.Strategy)
+	if err != nil {
+		switch {
+		case errors.Is(err, project.ErrInvalidBranchUpdateStrategy):
+			ctx.AbortWithError(http.StatusBadRequest, err)
+		case errors.Is(err, project.ErrNoUpstreamBranch), errors.Is(err, project.ErrBranchDiverged):
+			ctx.AbortWithError(http.StatusConflict, err)
+		default:
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to update branch: %w", err))
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, update)
+}
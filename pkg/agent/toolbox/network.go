@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/gin-gonic/gin"
+)
+
+const networkTestChunkSize = 64 * 1024
+
+// NetworkTestDownload sends the requested number of bytes to measure the throughput from the project to the client
+func (s *Server) NetworkTestDownload(ctx *gin.Context) {
+	size, err := strconv.ParseInt(ctx.Query("size"), 10, 64)
+	if err != nil || size <= 0 {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("size must be a positive number of bytes"))
+		return
+	}
+
+	if size > config.MAX_NETWORK_TEST_SIZE {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("size exceeds %d bytes", config.MAX_NETWORK_TEST_SIZE))
+		return
+	}
+
+	ctx.Header("Content-Type", "application/octet-stream")
+	ctx.Header("Content-Length", strconv.FormatInt(size, 10))
+	ctx.Status(http.StatusOK)
+
+	chunk := make([]byte, networkTestChunkSize)
+	for size > 0 {
+		n := min(size, int64(len(chunk)))
+		_, err := ctx.Writer.Write(chunk[:n])
+		if err != nil {
+			return
+		}
+		size -= n
+	}
+}
+
+// NetworkTestUpload discards the request body to measure the throughput from the client to the project
+func (s *Server) NetworkTestUpload(ctx *gin.Context) {
+	n, err := io.Copy(io.Discard, io.LimitReader(ctx.Request.Body, config.MAX_NETWORK_TEST_SIZE+1))
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	if n > config.MAX_NETWORK_TEST_SIZE {
+		ctx.AbortWithError(http.StatusRequestEntityTooLarge, fmt.Errorf("upload exceeds %d bytes", config.MAX_NETWORK_TEST_SIZE))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dto.NetworkTestUploadResponse{Bytes: n})
+}
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/gin-gonic/gin"
+)
+
+func (s *Server) ListPorts(ctx *gin.Context) {
+	sockets, err := ports.GetListeningSockets()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to read listening ports: %w", err))
+		return
+	}
+
+	listening := []uint16{}
+	for _, socket := range sockets {
+		if !slices.Contains(listening, socket.Port) {
+			listening = append(listening, socket.Port)
+		}
+	}
+
+	slices.Sort(listening)
+
+	ctx.JSON(http.StatusOK, dto.PortList{
+		Ports: listening,
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Server exposes project file operations to the Daytona Server over the tailnet
+type Server struct {
+	ProjectDir string
+	// File backing the workspace side of the browser clipboard bridge. Defaults to ~/.daytona/clipboard
+	ClipboardFile string
+	// Maximum size in bytes of uploaded files. Defaults to DefaultMaxUploadSize if 0
+	MaxUploadSize int64
+}
+
+func (s *Server) Start() error {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+	router.Use(gin.Recovery())
+	router.Use(errorMiddleware())
+
+	filesController := router.Group("/files")
+	{
+		filesController.GET("/", s.ListFiles)
+		filesController.GET("/preview", s.PreviewFile)
+		filesController.GET("/download", s.DownloadFile)
+		filesController.POST("/upload", s.UploadFile)
+		filesController.POST("/folder", s.CreateFolder)
+		filesController.POST("/move", s.MoveFile)
+		filesController.DELETE("/", s.DeleteFile)
+	}
+
+	router.GET("/ports", s.ListPorts)
+	router.GET("/drift", s.GetProjectDrift)
+	router.GET("/bridge", s.Bridge)
+	router.POST("/commands/run", s.RunCommand)
+
+	gitController := router.Group("/git")
+	{
+		gitController.POST("/fetch", s.FetchUpstream)
+		gitController.POST("/update", s.UpdateBranch)
+	}
+
+	networkController := router.Group("/network")
+	{
+		networkController.GET("/download", s.NetworkTestDownload)
+		networkController.POST("/upload", s.NetworkTestUpload)
+	}
+
+	log.Infof("Starting toolbox server on port %d", config.TOOLBOX_PORT)
+
+	return router.Run(fmt.Sprintf("localhost:%d", config.TOOLBOX_PORT))
+}
+
+func errorMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Next()
+
+		if len(ctx.Errors) > 0 {
+			log.WithFields(log.Fields{
+				"method":              ctx.Request.Method,
+				"path":                ctx.Request.URL.Path,
+				"status":              ctx.Writer.Status(),
+				correlation.LOG_FIELD: ctx.GetHeader(correlation.CORRELATION_ID_HEADER),
+			}).Error(ctx.Errors.String())
+			ctx.JSON(ctx.Writer.Status(), gin.H{"error": ctx.Errors[0].Err.Error()})
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/tunnel"
+	"github.com/gorilla/websocket"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Bounds of the delay between attempts to open the tunnel, which doubles after every failed attempt
+const (
+	initialRetryDelay = time.Second
+	maxRetryDelay     = time.Minute
+)
+
+// Client tunnels the connections of the Daytona Server to ports of the project over a single outbound WebSocket,
+// for networks that block WireGuard and DERP. The server opens a multiplexed stream for every connection
+type Client struct {
+	Server      config.DaytonaServerConfig
+	WorkspaceId string
+	ProjectName string
+	// AllowPort restricts the local ports reachable through the tunnel. All ports are reachable if not set
+	AllowPort func(port uint16) bool
+}
+
+// Run keeps the tunnel open until the context is canceled
+func (c *Client) Run(ctx context.Context) {
+	delay := initialRetryDelay
+
+	for {
+		connected := time.Now()
+		err := c.serve(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// A tunnel that stayed open for a while was not rejected, so the next attempt starts over
+		if time.Since(connected) > maxRetryDelay {
+			delay = initialRetryDelay
+		}
+
+		log.Errorf("Tunnel to the Daytona Server closed: %v. Reconnecting in %s", err, delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// serve opens the tunnel and serves the streams of the server until the tunnel or the context is closed
+func (c *Client) serve(ctx context.Context) error {
+	tunnelUrl, err := url.JoinPath(c.Server.ApiUrl, "workspace", c.WorkspaceId, c.ProjectName, "tunnel")
+	if err != nil {
+		return err
+	}
+
+	wsUrl, err := apiclient_util.GetWebSocketUrl(tunnelUrl)
+	if err != nil {
+		return err
+	}
+
+	ws, res, err := websocket.DefaultDialer.DialContext(ctx, wsUrl, http.Header{
+		"Authorization": []string{fmt.Sprintf("Bearer %s", c.Server.ApiKey)},
+	})
+	if err != nil {
+		if res != nil {
+			return fmt.Errorf("%w: %s", err, res.Status)
+		}
+		return err
+	}
+
+	session, err := tunnel.Accept(util.NewWebsocketNetConn(ws))
+	if err != nil {
+		ws.Close()
+		return err
+	}
+	defer session.Close()
+
+	stop := context.AfterFunc(ctx, func() {
+		session.Close()
+	})
+	defer stop()
+
+	log.Info("Tunneling connections through the Daytona Server")
+
+	err = tunnel.Serve(session, c.dial)
+	if err != nil {
+		return err
+	}
+
+	return fmt.Errorf("tunnel closed by the server")
+}
+
+func (c *Client) dial(port uint16) (net.Conn, error) {
+	if c.AllowPort != nil && !c.AllowPort(port) {
+		return nil, fmt.Errorf("port %d is not accessible to the project user", port)
+	}
+
+	return net.Dial("tcp", fmt.Sprintf("localhost:%d", port))
+}
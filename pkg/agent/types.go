@@ -4,11 +4,14 @@
 package agent
 
 import (
+	"context"
 	"io"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type SshServer interface {
@@ -17,14 +20,60 @@ type SshServer interface {
 
 type TailscaleServer interface {
 	Start() error
+	Stop(ctx context.Context) error
+}
+
+type ToolboxServer interface {
+	Start() error
+}
+
+type PortDetector interface {
+	DetectPorts() ([]uint16, error)
+}
+
+type ActivityDetector interface {
+	Start()
+	LastActivity() activity.Activity
+}
+
+type EnvironmentWatcher interface {
+	Start(ctx context.Context)
+	Changes() *project.EnvironmentChanges
 }
 
 type Agent struct {
-	Config           *config.Config
-	Git              git.IGitService
-	Ssh              SshServer
-	Tailscale        TailscaleServer
+	Config    *config.Config
+	Git       git.IGitService
+	Ssh       SshServer
+	Tailscale TailscaleServer
+	Toolbox   ToolboxServer
+	// Activity is optional, the idle time is not reported without it
+	Activity         ActivityDetector
 	LogWriter        io.Writer
 	TelemetryEnabled bool
-	startTime        time.Time
+	// ProjectUser is only set in VM mode
+	ProjectUser *ProjectUser
+	// Services are reported with the project state for other workspaces to resolve by name
+	Services []project.Service
+	// PortDetector is optional, the ports the project listens on are not reported without it
+	PortDetector PortDetector
+	// EnvironmentWatcher is optional, changes to the environment definition of the project are not reported without it
+	EnvironmentWatcher EnvironmentWatcher
+	// Events is optional, lifecycle and network events of the project are not reported without it
+	Events *EventPublisher
+	// Usage is optional, the CPU, memory, disk and inode usage of the project is not reported without it
+	Usage *UsageReporter
+	// IdleStopper is optional and requires Activity, the workspace is not stopped when the project is idle without it
+	IdleStopper *IdleStopper
+	// ReadyCheck returns an error until clients can connect to the project. The project is reported ready as soon as
+	// the agent reports its state if nil
+	ReadyCheck func(ctx context.Context) error
+	// Updater is optional, the agent keeps its version until the project is recreated without it
+	Updater *Updater
+	// NodeKeyExpiry is optional, the expiry of the tailnet node key is not reported without it
+	NodeKeyExpiry func() *time.Time
+	startTime     time.Time
+	usage         usageSampler
+	// Ports the project listened on at the previous state update
+	openPorts []uint16
 }
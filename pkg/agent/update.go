@@ -0,0 +1,423 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/activity"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the agent asks the server for the version it should run
+const updateCheckInterval = 10 * time.Minute
+
+// Restarting the agent drops the SSH sessions and tailnet connections of the project, so updates wait until the
+// project has not been used for this long
+const updateIdleTime = 5 * time.Minute
+
+// Time the updated agent has to pass its health check before the previous binary is restored
+const updateHealthTimeout = 2 * time.Minute
+
+// Interval at which the health check of the updated agent is retried
+const updateHealthCheckInterval = 5 * time.Second
+
+// Time the updated agent has to exit after it passed its health check before it is killed
+const updateStopTimeout = 10 * time.Second
+
+// UpdateSupervisorEnvVar is set to the path of the agent binary when the previous agent binary is started to supervise
+// an update
+const UpdateSupervisorEnvVar = "DAYTONA_AGENT_UPDATE_SUPERVISOR"
+
+// AgentUpdate is the version the server wants the agent to run
+type AgentUpdate struct {
+	Version string
+	// Hex encoded SHA-256 checksum of the binary of the version
+	Checksum string
+}
+
+// Updater keeps the agent binary at the version of the server. The binary of a new version is downloaded next to the
+// running one, verified and swapped in with a rename. The agent then restarts itself as a supervisor that runs the
+// previous binary, so that the health check and the rollback never depend on the new binary. The supervisor starts the
+// updated agent, restores the previous binary if the agent does not pass its health check and otherwise restarts
+// itself with the updated binary
+type Updater struct {
+	// Version of the running agent
+	CurrentVersion string
+	// BinaryPath is the path of the running agent binary. Its directory must be writable by the agent
+	BinaryPath string
+	// GetUpdate asks the server for the version the agent should run
+	GetUpdate func(ctx context.Context) (*AgentUpdate, error)
+	// Download writes the binary of the version to w
+	Download func(ctx context.Context, version string, w io.Writer) error
+	// CheckHealth returns nil once the agent of the version reported the project ready to the server. The supervisor
+	// runs it against the updated agent
+	CheckHealth func(ctx context.Context, version string) error
+
+	// exec replaces the running agent with the binary at the path and the environment. Defaults to syscall.Exec with
+	// the same arguments
+	exec func(path string, env []string) error
+	// Version that is not installed again until the agent restarts, because it failed its health check or is in place
+	// already but the agent could not restart itself with it
+	skippedVersion string
+}
+
+// pendingUpdate is written next to the binary before the supervisor starts, so that the agent that runs afterwards
+// knows the outcome of the update
+type pendingUpdate struct {
+	PreviousVersion string `json:"previousVersion"`
+	Version         string `json:"version"`
+	// Confirmed is set by the supervisor once the updated agent passed its health check
+	Confirmed bool   `json:"confirmed,omitempty"`
+	Failed    bool   `json:"failed,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (a *Agent) updateLoop() {
+	ctx := context.Background()
+
+	err := a.Updater.confirm(a.Events)
+	if err != nil {
+		log.Errorf("failed to confirm agent update: %s", err)
+	}
+
+	for {
+		time.Sleep(updateCheckInterval)
+
+		lastActivity := activity.Activity{}
+		if a.Activity != nil {
+			lastActivity = a.Activity.LastActivity()
+		}
+
+		err := a.Updater.check(ctx, time.Now(), lastActivity)
+		if errors.Is(err, fs.ErrPermission) {
+			log.Warnf("agent binary %s is not writable, updates are disabled: %s", a.Updater.BinaryPath, err)
+			return
+		}
+		if err != nil {
+			log.Errorf("failed to update agent: %s", err)
+		}
+	}
+}
+
+// check installs the version of the server if it differs from the running one and the project is not in use.
+// Returns without error once the new binary is in place if the agent could not restart itself with it
+func (u *Updater) check(ctx context.Context, now time.Time, lastActivity activity.Activity) error {
+	if now.Sub(lastActivity.At) < updateIdleTime {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	update, err := u.GetUpdate(ctx)
+	if err != nil {
+		return err
+	}
+
+	if update.Version == "" || update.Version == u.CurrentVersion || update.Version == u.skippedVersion {
+		return nil
+	}
+
+	log.Infof("Updating agent from %s to %s", u.CurrentVersion, update.Version)
+
+	newPath, err := u.download(ctx, update)
+	if err != nil {
+		return err
+	}
+
+	err = verifyBinaryVersion(ctx, newPath, update.Version)
+	if err == nil {
+		err = u.writePendingUpdate(&pendingUpdate{
+			PreviousVersion: u.CurrentVersion,
+			Version:         update.Version,
+		})
+	}
+	if err != nil {
+		os.Remove(newPath)
+		return err
+	}
+
+	// The previous binary stays available for the rollback under a second name while the rename swaps in the new one
+	os.Remove(u.previousPath())
+	err = os.Link(u.BinaryPath, u.previousPath())
+	if err != nil {
+		os.Remove(newPath)
+		os.Remove(u.pendingUpdatePath())
+		return err
+	}
+
+	err = os.Rename(newPath, u.BinaryPath)
+	if err != nil {
+		os.Remove(newPath)
+		os.Remove(u.previousPath())
+		os.Remove(u.pendingUpdatePath())
+		return err
+	}
+
+	err = u.startSupervisor()
+	if err != nil {
+		u.skippedVersion = update.Version
+		log.Errorf("failed to start the supervisor of the agent update, the updated agent will be started the next time the project starts: %s", err)
+	}
+
+	return nil
+}
+
+// confirm reports the outcome of an update once the agent runs after it. The agent that was restored reports the
+// failure. An updated agent that was started before the supervisor confirmed it, e.g. because the project restarted
+// during the update, hands over to the supervisor again
+func (u *Updater) confirm(events *EventPublisher) error {
+	pending, err := u.readPendingUpdate()
+	if err != nil || pending == nil {
+		return err
+	}
+
+	// The restored agent, or the previous one if the update never started
+	if pending.Version != u.CurrentVersion {
+		if pending.Failed {
+			u.skippedVersion = pending.Version
+			events.Publish(agentevent.TypeAgentUpdateFailed, fmt.Sprintf("Restored agent %s after the update to %s failed: %s", u.CurrentVersion, pending.Version, pending.Error), 0)
+		}
+		return os.Remove(u.pendingUpdatePath())
+	}
+
+	if !pending.Confirmed {
+		_, err := os.Stat(u.previousPath())
+		if err == nil {
+			return u.startSupervisor()
+		}
+		return os.Remove(u.pendingUpdatePath())
+	}
+
+	os.Remove(u.previousPath())
+	events.Publish(agentevent.TypeAgentUpdated, fmt.Sprintf("Updated agent from %s to %s", pending.PreviousVersion, pending.Version), 0)
+	return os.Remove(u.pendingUpdatePath())
+}
+
+// Supervise runs in the previous agent binary after an update. It starts the updated agent with the arguments of the
+// supervisor and waits for it to pass its health check. The supervisor restarts itself with the updated binary once
+// the check passes and restores the previous binary otherwise
+func (u *Updater) Supervise(ctx context.Context) error {
+	pending, err := u.readPendingUpdate()
+	if err != nil {
+		return err
+	}
+	if pending == nil {
+		return u.restart()
+	}
+
+	log.Infof("Supervising the update of the agent from %s to %s", pending.PreviousVersion, pending.Version)
+
+	child := exec.Command(u.BinaryPath, os.Args[1:]...)
+	child.Env = getAgentEnv()
+	child.Stdout = os.Stdout
+	child.Stderr = os.Stderr
+
+	err = child.Start()
+	if err != nil {
+		return u.rollback(pending, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- child.Wait()
+	}()
+
+	healthErr := u.waitHealthy(ctx, pending.Version, exited)
+	stopProcess(child.Process, exited)
+
+	if healthErr != nil {
+		return u.rollback(pending, healthErr)
+	}
+
+	pending.Confirmed = true
+	err = u.writePendingUpdate(pending)
+	if err != nil {
+		return err
+	}
+
+	return u.restart()
+}
+
+// rollback restores the previous binary after a failed update and restarts the supervisor with it
+func (u *Updater) rollback(pending *pendingUpdate, updateErr error) error {
+	log.Errorf("Updated agent failed its health check, restoring agent %s: %s", pending.PreviousVersion, updateErr)
+
+	pending.Failed = true
+	pending.Error = updateErr.Error()
+	err := u.writePendingUpdate(pending)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(u.previousPath(), u.BinaryPath)
+	if err != nil {
+		return err
+	}
+
+	return u.restart()
+}
+
+// waitHealthy returns nil once the updated agent passes its health check. Returns an error if the agent exits or does
+// not pass the check within the timeout
+func (u *Updater) waitHealthy(ctx context.Context, version string, exited chan error) error {
+	ctx, cancel := context.WithTimeout(ctx, updateHealthTimeout)
+	defer cancel()
+
+	for {
+		select {
+		case err := <-exited:
+			exited <- err
+			return fmt.Errorf("updated agent exited: %v", err)
+		default:
+		}
+
+		err := u.CheckHealth(ctx, version)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case err := <-exited:
+			exited <- err
+			return fmt.Errorf("updated agent exited: %v", err)
+		case <-time.After(updateHealthCheckInterval):
+		}
+	}
+}
+
+// stopProcess terminates the process and kills it if it does not exit in time
+func stopProcess(process *os.Process, exited chan error) {
+	process.Signal(syscall.SIGTERM)
+
+	select {
+	case <-exited:
+	case <-time.After(updateStopTimeout):
+		process.Kill()
+		<-exited
+	}
+}
+
+// download writes the binary of the update next to the running binary and verifies its checksum
+func (u *Updater) download(ctx context.Context, update *AgentUpdate) (string, error) {
+	newPath := u.BinaryPath + ".new"
+
+	f, err := os.OpenFile(newPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	err = u.Download(ctx, update.Version, io.MultiWriter(f, hash))
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(newPath)
+		return "", err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(checksum, update.Checksum) {
+		os.Remove(newPath)
+		return "", fmt.Errorf("checksum mismatch for agent %s: expected %s, got %s", update.Version, update.Checksum, checksum)
+	}
+
+	return newPath, nil
+}
+
+// verifyBinaryVersion runs the binary to make sure it starts on this machine and is the expected version
+func verifyBinaryVersion(ctx context.Context, path string, version string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, path, "version").Output()
+	if err != nil {
+		return fmt.Errorf("failed to run agent %s: %w", version, err)
+	}
+
+	if !strings.Contains(string(output), version) {
+		return fmt.Errorf("agent binary reports %q instead of version %s", strings.TrimSpace(string(output)), version)
+	}
+
+	return nil
+}
+
+// restart replaces the running process with the agent binary
+func (u *Updater) restart() error {
+	return u.execBinary(u.BinaryPath, getAgentEnv())
+}
+
+// startSupervisor replaces the running process with the supervisor of the update, which runs the previous binary
+func (u *Updater) startSupervisor() error {
+	return u.execBinary(u.previousPath(), append(getAgentEnv(), fmt.Sprintf("%s=%s", UpdateSupervisorEnvVar, u.BinaryPath)))
+}
+
+func (u *Updater) execBinary(path string, env []string) error {
+	if u.exec != nil {
+		return u.exec(path, env)
+	}
+
+	return syscall.Exec(path, os.Args, env)
+}
+
+// getAgentEnv returns the environment of the agent without the variable that starts the supervisor
+func getAgentEnv() []string {
+	return slices.DeleteFunc(os.Environ(), func(v string) bool {
+		return strings.HasPrefix(v, UpdateSupervisorEnvVar+"=")
+	})
+}
+
+func (u *Updater) previousPath() string {
+	return u.BinaryPath + ".previous"
+}
+
+func (u *Updater) pendingUpdatePath() string {
+	return u.BinaryPath + ".update"
+}
+
+func (u *Updater) readPendingUpdate() (*pendingUpdate, error) {
+	content, err := os.ReadFile(u.pendingUpdatePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var pending pendingUpdate
+	err = json.Unmarshal(content, &pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pending, nil
+}
+
+func (u *Updater) writePendingUpdate(pending *pendingUpdate) error {
+	content, err := json.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(u.pendingUpdatePath(), content, 0644)
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/activity"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdater(t *testing.T) {
+	binaryPath := filepath.Join(t.TempDir(), "daytona")
+	oldBinary := []byte("#!/bin/sh\necho Daytona version v0.1.0\n")
+	newBinary := []byte("#!/bin/sh\nif [ \"$1\" = version ]; then echo Daytona version v0.2.0; exit 0; fi\nexec sleep 30\n")
+	require.NoError(t, os.WriteFile(binaryPath, oldBinary, 0755))
+
+	checksum := sha256.Sum256(newBinary)
+	update := &AgentUpdate{Version: "v0.2.0", Checksum: hex.EncodeToString(checksum[:])}
+	execs := []string{}
+	healthErr := errors.New("ssh server not listening")
+
+	updater := &Updater{
+		CurrentVersion: "v0.1.0",
+		BinaryPath:     binaryPath,
+		GetUpdate: func(ctx context.Context) (*AgentUpdate, error) {
+			return update, nil
+		},
+		Download: func(ctx context.Context, version string, w io.Writer) error {
+			_, err := w.Write(newBinary)
+			return err
+		},
+		CheckHealth: func(ctx context.Context, version string) error {
+			return healthErr
+		},
+		exec: func(path string, env []string) error {
+			execs = append(execs, path)
+			return nil
+		},
+	}
+
+	now := time.Now()
+
+	// Not updated while the project is in use
+	require.NoError(t, updater.check(context.Background(), now, activity.Activity{At: now.Add(-time.Minute)}))
+	require.Empty(t, execs)
+
+	// Not installed if the checksum does not match
+	update.Checksum = "0000"
+	require.ErrorContains(t, updater.check(context.Background(), now, activity.Activity{}), "checksum mismatch")
+	requireBinary(t, binaryPath, oldBinary)
+	require.NoFileExists(t, binaryPath+".new")
+
+	// The previous binary supervises the update
+	update.Checksum = hex.EncodeToString(checksum[:])
+	require.NoError(t, updater.check(context.Background(), now, activity.Activity{}))
+	require.Equal(t, []string{binaryPath + ".previous"}, execs)
+	requireBinary(t, binaryPath, newBinary)
+	requireBinary(t, binaryPath+".previous", oldBinary)
+
+	// The supervisor restores the previous binary if the updated agent does not become healthy
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.NoError(t, updater.Supervise(ctx))
+	require.Equal(t, []string{binaryPath + ".previous", binaryPath}, execs)
+	requireBinary(t, binaryPath, oldBinary)
+
+	// The restored agent reports the failure and does not install the version again
+	events := &EventPublisher{}
+	require.NoError(t, updater.confirm(events))
+	require.Len(t, events.pending, 1)
+	require.Equal(t, agentevent.TypeAgentUpdateFailed, events.pending[0].Type)
+	require.NoFileExists(t, binaryPath+".update")
+
+	require.NoError(t, updater.check(context.Background(), now, activity.Activity{}))
+	require.Len(t, execs, 2)
+
+	// A healthy update is kept
+	updater.skippedVersion = ""
+	healthErr = nil
+	require.NoError(t, updater.check(context.Background(), now, activity.Activity{}))
+	require.NoError(t, updater.Supervise(context.Background()))
+	require.Equal(t, binaryPath, execs[len(execs)-1])
+	requireBinary(t, binaryPath, newBinary)
+
+	updater.CurrentVersion = "v0.2.0"
+	require.NoError(t, updater.confirm(events))
+	requireBinary(t, binaryPath, newBinary)
+	require.NoFileExists(t, binaryPath+".previous")
+	require.NoFileExists(t, binaryPath+".update")
+	require.Equal(t, agentevent.TypeAgentUpdated, events.pending[1].Type)
+}
+
+func TestUpdaterHandsUnconfirmedUpdateToSupervisor(t *testing.T) {
+	binaryPath := filepath.Join(t.TempDir(), "daytona")
+	require.NoError(t, os.WriteFile(binaryPath, []byte("new"), 0755))
+	require.NoError(t, os.WriteFile(binaryPath+".previous", []byte("old"), 0755))
+
+	execs := []string{}
+	updater := &Updater{
+		CurrentVersion: "v0.2.0",
+		BinaryPath:     binaryPath,
+		exec: func(path string, env []string) error {
+			execs = append(execs, path)
+			require.Contains(t, env, UpdateSupervisorEnvVar+"="+binaryPath)
+			return nil
+		},
+	}
+	require.NoError(t, updater.writePendingUpdate(&pendingUpdate{PreviousVersion: "v0.1.0", Version: "v0.2.0"}))
+
+	events := &EventPublisher{}
+	require.NoError(t, updater.confirm(events))
+	require.Equal(t, []string{binaryPath + ".previous"}, execs)
+	require.Empty(t, events.pending)
+	require.FileExists(t, binaryPath+".update")
+}
+
+func requireBinary(t *testing.T, path string, content []byte) {
+	t.Helper()
+
+	actual, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(content), string(actual))
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Root of the cgroup v2 hierarchy as seen from inside the project container
+const cgroupDir = "/sys/fs/cgroup"
+
+// usageSampler computes the resources used by the project container from the statistics of its cgroup
+type usageSampler struct {
+	// Defaults to the cgroup root
+	dir string
+
+	lastCpuUsage uint64
+	lastSampleAt time.Time
+}
+
+// sample returns the memory in use and the CPU cores used on average since the previous sample.
+// The CPU usage is 0 on the first sample
+func (u *usageSampler) sample() (*project.Resources, error) {
+	memory, err := os.ReadFile(u.path("memory.current"))
+	if err != nil {
+		return nil, err
+	}
+
+	memoryBytes, err := strconv.ParseUint(strings.TrimSpace(string(memory)), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	cpuUsage, err := readCpuUsage(u.path("cpu.stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	usage := &project.Resources{
+		Memory: memoryBytes / 1024 / 1024,
+	}
+
+	if !u.lastSampleAt.IsZero() && cpuUsage >= u.lastCpuUsage {
+		if elapsed := now.Sub(u.lastSampleAt).Microseconds(); elapsed > 0 {
+			usage.Cpus = float64(cpuUsage-u.lastCpuUsage) / float64(elapsed)
+		}
+	}
+
+	u.lastCpuUsage = cpuUsage
+	u.lastSampleAt = now
+
+	return usage, nil
+}
+
+// memoryLimit returns the memory the project container can use in MiB. Zero if the container has no memory limit
+func (u *usageSampler) memoryLimit() (uint64, error) {
+	limit, err := os.ReadFile(u.path("memory.max"))
+	if err != nil {
+		return 0, err
+	}
+
+	value := strings.TrimSpace(string(limit))
+	if value == "max" {
+		return 0, nil
+	}
+
+	limitBytes, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return limitBytes / 1024 / 1024, nil
+}
+
+func (u *usageSampler) path(name string) string {
+	if u.dir == "" {
+		return filepath.Join(cgroupDir, name)
+	}
+
+	return filepath.Join(u.dir, name)
+}
+
+// readCpuUsage returns the CPU time used by the cgroup in microseconds
+func readCpuUsage(statPath string) (uint64, error) {
+	file, err := os.Open(statPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "usage_usec" {
+			return strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, errors.New("usage_usec not found in " + statPath)
+}
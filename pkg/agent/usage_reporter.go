@@ -0,0 +1,262 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	log "github.com/sirupsen/logrus"
+)
+
+// Interval at which the resources used by the project are sampled and sent to the Daytona Server
+const usageReportInterval = 30 * time.Second
+
+// Samples kept while the Daytona Server can not be reached, an hour at the report interval. The oldest samples are
+// dropped beyond this
+const maxPendingUsageReports = 120
+
+// UsageReporter sends samples of the CPU, memory, disk and inode usage of the project to the Daytona Server.
+// Samples that could not be sent are retried with the next report
+type UsageReporter struct {
+	// Send delivers a batch of samples to the Daytona Server
+	Send func(ctx context.Context, reports []resourceusage.Report) error
+
+	pending []resourceusage.Report
+}
+
+// report queues the sample and sends every queued sample. The samples are kept for the next report if sending
+// them fails
+func (r *UsageReporter) report(ctx context.Context, sample resourceusage.Report) error {
+	r.pending = append(r.pending, sample)
+	if len(r.pending) > maxPendingUsageReports {
+		r.pending = r.pending[len(r.pending)-maxPendingUsageReports:]
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	err := r.Send(ctx, r.pending)
+	if err != nil {
+		return fmt.Errorf("%d usage samples not sent: %w", len(r.pending), err)
+	}
+
+	r.pending = nil
+	return nil
+}
+
+func (a *Agent) reportUsageLoop() {
+	sampler := &resourceSampler{
+		diskDir: a.Config.ProjectDir,
+	}
+
+	// The cgroup root only belongs to the project inside a container, projects on a VM use the whole machine
+	if a.Config.Mode == agent_config.ModeProject {
+		sampler.cgroup = &usageSampler{}
+	} else {
+		sampler.host = &hostUsageSampler{}
+	}
+
+	// The first sample only sets the baseline of the CPU usage
+	_, err := sampler.sample()
+	if err != nil {
+		log.Debugf("failed to sample resource usage: %s", err)
+	}
+
+	for {
+		time.Sleep(usageReportInterval)
+
+		sample, err := sampler.sample()
+		if err != nil {
+			log.Debugf("failed to sample resource usage: %s", err)
+			continue
+		}
+
+		err = a.Usage.report(context.Background(), *sample)
+		if err != nil {
+			log.Debugf("failed to report resource usage: %s", err)
+		}
+	}
+}
+
+// resourceSampler samples the CPU and memory usage of the project from its cgroup, or from the machine if host is
+// set, and the disk and inode usage of the disk of the project
+type resourceSampler struct {
+	cgroup  *usageSampler
+	host    *hostUsageSampler
+	diskDir string
+}
+
+func (s *resourceSampler) sample() (*resourceusage.Report, error) {
+	report := &resourceusage.Report{
+		SampledAt: time.Now(),
+	}
+
+	if s.cgroup != nil {
+		usage, err := s.cgroup.sample()
+		if err != nil {
+			return nil, err
+		}
+		report.Cpus = usage.Cpus
+		report.MemoryUsed = usage.Memory
+
+		report.MemoryLimit, err = s.cgroup.memoryLimit()
+		if err != nil {
+			return nil, err
+		}
+	} else if s.host != nil {
+		var err error
+		report.Cpus, report.MemoryUsed, report.MemoryLimit, err = s.host.sample()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(s.diskDir, &stat)
+	if err != nil {
+		return nil, err
+	}
+
+	report.DiskTotal = stat.Blocks * uint64(stat.Bsize) / 1024 / 1024
+	// Space reserved for root counts as used, in line with the disk pressure check
+	report.DiskUsed = (stat.Blocks - stat.Bavail) * uint64(stat.Bsize) / 1024 / 1024
+	report.InodesTotal = stat.Files
+	report.InodesUsed = stat.Files - stat.Ffree
+
+	return report, nil
+}
+
+// hostUsageSampler computes the resources used by the whole machine from /proc
+type hostUsageSampler struct {
+	// Defaults to /proc
+	dir string
+
+	lastBusy  uint64
+	lastTotal uint64
+}
+
+// sample returns the CPU cores used on average since the previous sample, the memory in use and the memory of the
+// machine in MiB. The CPU usage is 0 on the first sample
+func (h *hostUsageSampler) sample() (float64, uint64, uint64, error) {
+	dir := h.dir
+	if dir == "" {
+		dir = "/proc"
+	}
+
+	busy, total, err := readCpuTimes(filepath.Join(dir, "stat"))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	memoryUsed, memoryTotal, err := readMemInfo(filepath.Join(dir, "meminfo"))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	var cpus float64
+	if h.lastTotal != 0 && total > h.lastTotal && busy >= h.lastBusy {
+		cpus = float64(busy-h.lastBusy) / float64(total-h.lastTotal) * float64(runtime.NumCPU())
+	}
+
+	h.lastBusy = busy
+	h.lastTotal = total
+
+	return cpus, memoryUsed, memoryTotal, nil
+}
+
+// readCpuTimes returns the time all CPUs of the machine spent busy and in total, in clock ticks
+func readCpuTimes(statPath string) (uint64, uint64, error) {
+	file, err := os.Open(statPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 || fields[0] != "cpu" {
+			continue
+		}
+
+		var total, idle uint64
+		for i, field := range fields[1:] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return 0, 0, err
+			}
+			total += value
+			// Idle and iowait
+			if i == 3 || i == 4 {
+				idle += value
+			}
+		}
+
+		return total - idle, total, nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	return 0, 0, errors.New("cpu not found in " + statPath)
+}
+
+// readMemInfo returns the memory in use and the total memory of the machine in MiB
+func readMemInfo(memInfoPath string) (uint64, uint64, error) {
+	file, err := os.Open(memInfoPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		key := strings.TrimSuffix(fields[0], ":")
+		if key != "MemTotal" && key != "MemAvailable" {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		values[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	total, ok := values["MemTotal"]
+	if !ok {
+		return 0, 0, errors.New("MemTotal not found in " + memInfoPath)
+	}
+
+	available, ok := values["MemAvailable"]
+	if !ok || available > total {
+		return 0, 0, errors.New("MemAvailable not found in " + memInfoPath)
+	}
+
+	return (total - available) / 1024, total / 1024, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageReporter(t *testing.T) {
+	sent := [][]resourceusage.Report{}
+	sendErr := errors.New("server unavailable")
+
+	reporter := &UsageReporter{
+		Send: func(ctx context.Context, reports []resourceusage.Report) error {
+			if sendErr != nil {
+				return sendErr
+			}
+			sent = append(sent, reports)
+			return nil
+		},
+	}
+
+	// Samples that were not sent are retried with the next report
+	require.Error(t, reporter.report(context.Background(), resourceusage.Report{Cpus: 1}))
+
+	sendErr = nil
+	require.NoError(t, reporter.report(context.Background(), resourceusage.Report{Cpus: 2}))
+	require.Len(t, sent, 1)
+	require.Len(t, sent[0], 2)
+	require.Equal(t, 1.0, sent[0][0].Cpus)
+	require.Empty(t, reporter.pending)
+
+	// The oldest samples are dropped while the server can't be reached
+	sendErr = errors.New("server unavailable")
+	for i := 0; i < maxPendingUsageReports+10; i++ {
+		require.Error(t, reporter.report(context.Background(), resourceusage.Report{MemoryUsed: uint64(i)}))
+	}
+	require.Len(t, reporter.pending, maxPendingUsageReports)
+	require.Equal(t, uint64(10), reporter.pending[0].MemoryUsed)
+}
+
+func TestResourceSampler(t *testing.T) {
+	cgroup := t.TempDir()
+	writeFile(t, cgroup, "memory.current", "536870912\n")
+	writeFile(t, cgroup, "memory.max", "2147483648\n")
+	writeFile(t, cgroup, "cpu.stat", "usage_usec 1000\nuser_usec 800\nsystem_usec 200\n")
+
+	sampler := &resourceSampler{
+		cgroup:  &usageSampler{dir: cgroup},
+		diskDir: t.TempDir(),
+	}
+
+	report, err := sampler.sample()
+	require.NoError(t, err)
+	require.Equal(t, uint64(512), report.MemoryUsed)
+	require.Equal(t, uint64(2048), report.MemoryLimit)
+	require.NotZero(t, report.DiskTotal)
+	require.LessOrEqual(t, report.DiskUsed, report.DiskTotal)
+	require.LessOrEqual(t, report.InodesUsed, report.InodesTotal)
+
+	writeFile(t, cgroup, "memory.max", "max\n")
+
+	report, err = sampler.sample()
+	require.NoError(t, err)
+	require.Zero(t, report.MemoryLimit)
+}
+
+func TestHostUsageSampler(t *testing.T) {
+	proc := t.TempDir()
+	writeFile(t, proc, "meminfo", "MemTotal:       8388608 kB\nMemFree:         1048576 kB\nMemAvailable:    6291456 kB\n")
+	writeFile(t, proc, "stat", "cpu  100 0 100 700 100 0 0 0 0 0\ncpu0 100 0 100 700 100 0 0 0 0 0\n")
+
+	sampler := &hostUsageSampler{dir: proc}
+
+	cpus, memoryUsed, memoryTotal, err := sampler.sample()
+	require.NoError(t, err)
+	require.Zero(t, cpus)
+	require.Equal(t, uint64(2048), memoryUsed)
+	require.Equal(t, uint64(8192), memoryTotal)
+
+	// 300 of 1000 ticks busy since the previous sample
+	writeFile(t, proc, "stat", "cpu  300 0 200 1300 200 0 0 0 0 0\n")
+
+	cpus, _, _, err = sampler.sample()
+	require.NoError(t, err)
+	require.InDelta(t, 0.3*float64(runtime.NumCPU()), cpus, 0.001)
+}
+
+func writeFile(t *testing.T, dir string, name string, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+}
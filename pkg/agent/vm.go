@@ -0,0 +1,233 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"syscall"
+
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/ports"
+	log "github.com/sirupsen/logrus"
+)
+
+// ProjectUser is the unprivileged user that owns the project directory and runs
+// the agent and the project processes when the agent runs directly on a VM
+type ProjectUser struct {
+	Username string
+	HomeDir  string
+	Uid      uint32
+	Gid      uint32
+	Groups   []uint32
+}
+
+func LookupProjectUser(username string) (*ProjectUser, error) {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up project user %s: %w", username, err)
+	}
+
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUser := &ProjectUser{
+		Username: u.Username,
+		HomeDir:  u.HomeDir,
+		Uid:      uint32(uid),
+		Gid:      uint32(gid),
+	}
+
+	groupIds, err := u.GroupIds()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, groupId := range groupIds {
+		group, err := strconv.ParseUint(groupId, 10, 32)
+		if err != nil {
+			continue
+		}
+		projectUser.Groups = append(projectUser.Groups, uint32(group))
+	}
+
+	return projectUser, nil
+}
+
+// Credential returns the credential used to drop privileges when starting processes as the project user
+func (u *ProjectUser) Credential() *syscall.Credential {
+	// Processes keep the agent credential if it is already running as the project user
+	if uint32(os.Getuid()) == u.Uid {
+		return nil
+	}
+
+	return &syscall.Credential{
+		Uid:    u.Uid,
+		Gid:    u.Gid,
+		Groups: u.Groups,
+	}
+}
+
+// DropPrivileges switches the agent process to the project user so that neither the agent nor the SSH sessions
+// and processes it starts run as root
+func (u *ProjectUser) DropPrivileges() error {
+	if uint32(os.Getuid()) == u.Uid {
+		return nil
+	}
+
+	groups := make([]int, len(u.Groups))
+	for i, group := range u.Groups {
+		groups[i] = int(group)
+	}
+
+	err := syscall.Setgroups(groups)
+	if err != nil {
+		return fmt.Errorf("failed to set the groups of the project user: %w", err)
+	}
+
+	err = syscall.Setgid(int(u.Gid))
+	if err != nil {
+		return fmt.Errorf("failed to switch to the group of the project user: %w", err)
+	}
+
+	err = syscall.Setuid(int(u.Uid))
+	if err != nil {
+		return fmt.Errorf("failed to switch to the project user: %w", err)
+	}
+
+	// Make sure root can not be regained
+	if os.Geteuid() == 0 || syscall.Setuid(0) == nil {
+		return errors.New("failed to drop root privileges")
+	}
+
+	os.Setenv("HOME", u.HomeDir)
+	os.Setenv("USER", u.Username)
+	os.Setenv("LOGNAME", u.Username)
+
+	return nil
+}
+
+func (u *ProjectUser) Env() []string {
+	return []string{
+		fmt.Sprintf("HOME=%s", u.HomeDir),
+		fmt.Sprintf("USER=%s", u.Username),
+		fmt.Sprintf("LOGNAME=%s", u.Username),
+	}
+}
+
+// Chown recursively changes the owner of path to the project user
+func (u *ProjectUser) Chown(path string) error {
+	return filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		return os.Lchown(path, int(u.Uid), int(u.Gid))
+	})
+}
+
+// AllowPort only exposes the agent ports and ports opened by the project user so that
+// services of other users on the VM are not reachable from the tailnet
+func (u *ProjectUser) AllowPort(port uint16) bool {
+	if port == ssh_config.SSH_PORT || port == ssh_config.SSH_RESUME_PORT || port == toolbox_config.TOOLBOX_PORT {
+		return true
+	}
+
+	sockets, err := ports.GetListeningSockets()
+	if err != nil {
+		log.Errorf("failed to read listening sockets: %s", err)
+		return false
+	}
+
+	for _, socket := range sockets {
+		if socket.Port == port && socket.Uid == u.Uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowUnixSocket only allows mapping sockets that the project user can connect to
+// so that sockets of privileged services on the VM are not exposed to the tailnet
+func (u *ProjectUser) AllowUnixSocket(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		log.Errorf("failed to read unix socket %s: %s", path, err)
+		return false
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || info.Mode().Type() != fs.ModeSocket {
+		return false
+	}
+
+	// Connecting to a Unix socket requires write permission
+	perm := info.Mode().Perm()
+	if stat.Uid == u.Uid {
+		return perm&0200 != 0
+	}
+
+	if stat.Gid == u.Gid || slices.Contains(u.Groups, stat.Gid) {
+		return perm&0020 != 0
+	}
+
+	return perm&0002 != 0
+}
+
+func (a *Agent) startVMMode() error {
+	err := a.ensureDefaultProfile()
+	if err != nil {
+		return err
+	}
+
+	project, err := a.getProject()
+	if err != nil {
+		return err
+	}
+
+	// The agent runs as the project user so the repository and git config are owned by the user
+	a.setupRepository(project)
+
+	go func() {
+		err := a.runLifecycleCommands(project)
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to run lifecycle commands: %s", err))
+		}
+	}()
+
+	go a.updateProjectStateLoop()
+
+	if a.Events != nil {
+		go a.monitorPressureLoop()
+	}
+
+	if a.Usage != nil {
+		go a.reportUsageLoop()
+	}
+
+	if a.IdleStopper != nil && a.Activity != nil {
+		go a.stopWhenIdleLoop()
+	}
+
+	if a.Updater != nil {
+		go a.updateLoop()
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevent
+
+import "time"
+
+type Type string
+
+const (
+	// The agent joined the tailnet of a Daytona Server for the first time since it started
+	TypeConnected Type = "connected"
+	// The agent joined the tailnet again after losing the connection
+	TypeReconnected Type = "reconnected"
+	TypePortOpened  Type = "port-opened"
+	TypePortClosed  Type = "port-closed"
+	// A connection or request from the tailnet could not be proxied to a port of the project
+	TypeProxyError Type = "proxy-error"
+	// The kernel killed a process of the project because the project ran out of memory
+	TypeOom Type = "oom"
+	// The disk of the project is almost full
+	TypeDiskPressure Type = "disk-pressure"
+	// The project has been idle for almost the idle timeout and will be stopped unless it is used
+	TypeIdleWarning Type = "idle-warning"
+	// The agent asked the server to stop the workspace after the project was idle for the idle timeout
+	TypeIdleStop Type = "idle-stop"
+	// The agent replaced its binary with the version of the server and passed its health check
+	TypeAgentUpdated Type = "agent-updated"
+	// The updated agent failed its health check and the previous binary was restored
+	TypeAgentUpdateFailed Type = "agent-update-failed"
+	// The agent registered its tailnet node again with a new key before the key of the node expired
+	TypeNodeKeyRenewed Type = "node-key-renewed"
+)
+
+var Types = []Type{
+	TypeConnected,
+	TypeReconnected,
+	TypePortOpened,
+	TypePortClosed,
+	TypeProxyError,
+	TypeOom,
+	TypeDiskPressure,
+	TypeIdleWarning,
+	TypeIdleStop,
+	TypeAgentUpdated,
+	TypeAgentUpdateFailed,
+	TypeNodeKeyRenewed,
+}
+
+// MaxBatchSize is the largest number of events the agent of a project can report in one request
+const MaxBatchSize = 100
+
+// Report is an event as published by the agent of a project
+type Report struct {
+	Type Type `json:"type" validate:"required"`
+	// Human readable details, e.g. the error of a failed proxy connection
+	Message string `json:"message" validate:"required"`
+	// Port of the project the event is about. Zero for events that are not about a port
+	Port       uint32    `json:"port,omitempty" validate:"optional"`
+	OccurredAt time.Time `json:"occurredAt" validate:"required"`
+} // @name AgentEventReport
+
+// Event is a lifecycle or network event of the agent of a project, recorded by the server
+type Event struct {
+	Id          string    `json:"id" validate:"required"`
+	WorkspaceId string    `json:"workspaceId" validate:"required"`
+	ProjectName string    `json:"projectName" validate:"required"`
+	Type        Type      `json:"type" validate:"required"`
+	Message     string    `json:"message" validate:"required"`
+	Port        uint32    `json:"port,omitempty" validate:"optional"`
+	OccurredAt  time.Time `json:"occurredAt" validate:"required"`
+} // @name AgentEvent
+
+func (t Type) IsValid() bool {
+	for _, eventType := range Types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
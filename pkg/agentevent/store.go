@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevent
+
+import "time"
+
+type Filter struct {
+	WorkspaceId *string
+	ProjectName *string
+	Type        *Type
+	Since       *time.Time
+	Before      *time.Time
+}
+
+type Store interface {
+	List(filter *Filter) ([]*Event, error)
+	Save(event *Event) error
+	Delete(filter *Filter) error
+}
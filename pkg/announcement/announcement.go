@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcement
+
+import (
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+type AnnouncementType string // @name AnnouncementType
+
+const (
+	AnnouncementTypeInfo        AnnouncementType = "info"
+	AnnouncementTypeMaintenance AnnouncementType = "maintenance"
+	AnnouncementTypeDeprecation AnnouncementType = "deprecation"
+	AnnouncementTypeQuota       AnnouncementType = "quota"
+)
+
+type Announcement struct {
+	Id      string           `json:"id" validate:"required"`
+	Type    AnnouncementType `json:"type" validate:"required"`
+	Title   string           `json:"title" validate:"required"`
+	Message string           `json:"message,omitempty" validate:"optional"`
+	// Start and end of a maintenance window
+	StartsAt *time.Time `json:"startsAt,omitempty" validate:"optional"`
+	EndsAt   *time.Time `json:"endsAt,omitempty" validate:"optional"`
+	// Only clients older than this version are notified, e.g. of their deprecation
+	BelowVersion string `json:"belowVersion,omitempty" validate:"optional"`
+	// The announcement is no longer listed after this time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
+	// Only this user is notified. Every user is notified if empty
+	Recipient string    `json:"recipient,omitempty" validate:"optional"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name Announcement
+
+func (a *Announcement) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && now.After(*a.ExpiresAt)
+}
+
+// IsVisibleTo reports whether the user is notified of the announcement
+func (a *Announcement) IsVisibleTo(user string) bool {
+	return a.Recipient == "" || a.Recipient == user
+}
+
+// AppliesToVersion reports whether a client of the given version should be notified
+func (a *Announcement) AppliesToVersion(version string) bool {
+	if a.BelowVersion == "" {
+		return true
+	}
+
+	return IsVersionBelow(version, a.BelowVersion)
+}
+
+// IsVersionBelow compares semantic versions with or without the "v" prefix.
+// Development builds are considered older than every version.
+func IsVersionBelow(version, belowVersion string) bool {
+	v := canonicalVersion(version)
+	if !semver.IsValid(v) {
+		return true
+	}
+
+	return semver.Compare(v, canonicalVersion(belowVersion)) < 0
+}
+
+func canonicalVersion(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcement
+
+import "errors"
+
+type Store interface {
+	List() ([]*Announcement, error)
+	Find(id string) (*Announcement, error)
+	Save(announcement *Announcement) error
+	Delete(announcement *Announcement) error
+}
+
+var (
+	ErrAnnouncementNotFound = errors.New("announcement not found")
+)
+
+func IsAnnouncementNotFound(err error) bool {
+	return err.Error() == ErrAnnouncementNotFound.Error()
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+const ADMIN_IDENTITY_NAME = "admin"
+
+// AdminTokenProvider accepts a static bearer token. Only the hash of the token is kept in the server config.
+type AdminTokenProvider struct {
+	tokenHash string
+}
+
+func NewAdminTokenProvider(tokenHash string) *AdminTokenProvider {
+	return &AdminTokenProvider{
+		tokenHash: tokenHash,
+	}
+}
+
+func (p *AdminTokenProvider) Authenticate(req *http.Request) (*Identity, error) {
+	token, err := getBearerToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(apikeys.HashKey(token)), []byte(p.tokenHash)) != 1 {
+		return nil, ErrUnauthorized
+	}
+
+	return &Identity{
+		Name:       ADMIN_IDENTITY_NAME,
+		ApiKeyType: apikey.ApiKeyTypeClient,
+		Provider:   server.AuthProviderAdminToken,
+		Admin:      true,
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+)
+
+type ApiKeyProvider struct {
+	apiKeyService apikeys.IApiKeyService
+}
+
+func NewApiKeyProvider(apiKeyService apikeys.IApiKeyService) *ApiKeyProvider {
+	return &ApiKeyProvider{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (p *ApiKeyProvider) Authenticate(req *http.Request) (*Identity, error) {
+	token, err := getBearerToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.apiKeyService.IsValidApiKey(token) {
+		return nil, ErrUnauthorized
+	}
+
+	apiKeyType := apikey.ApiKeyTypeClient
+
+	if p.apiKeyService.IsWorkspaceApiKey(token) {
+		apiKeyType = apikey.ApiKeyTypeWorkspace
+	} else if p.apiKeyService.IsProjectApiKey(token) {
+		apiKeyType = apikey.ApiKeyTypeProject
+	}
+
+	apiKeyName, err := p.apiKeyService.GetApiKeyName(token)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	return &Identity{
+		Name:       apiKeyName,
+		ApiKeyType: apiKeyType,
+		Provider:   server.AuthProviderApiKey,
+		Admin:      apiKeyType == apikey.ApiKeyTypeClient && p.apiKeyService.IsAdminApiKey(token),
+	}, nil
+}
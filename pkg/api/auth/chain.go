@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+)
+
+const DEFAULT_ROUTE_GROUP = "default"
+
+// Chain tries its providers in order and returns the first identity that is authenticated
+type Chain []Provider
+
+func (c Chain) Authenticate(req *http.Request) (*Identity, error) {
+	for _, provider := range c {
+		identity, err := provider.Authenticate(req)
+		if err == nil {
+			return identity, nil
+		}
+	}
+
+	return nil, ErrUnauthorized
+}
+
+// RouteChains holds the provider chain of each route group
+type RouteChains map[string]Chain
+
+// GetChain returns the chain of the route group the path belongs to
+func (r RouteChains) GetChain(path string) Chain {
+	group := "/" + strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+
+	chain, ok := r[group]
+	if !ok {
+		return r[DEFAULT_ROUTE_GROUP]
+	}
+
+	return chain
+}
+
+func NewRouteChains(config *server.AuthConfig, apiKeyService apikeys.IApiKeyService) (RouteChains, error) {
+	apiKeyProvider := NewApiKeyProvider(apiKeyService)
+
+	if config == nil || len(config.RouteProviders) == 0 {
+		return RouteChains{
+			DEFAULT_ROUTE_GROUP: Chain{apiKeyProvider},
+		}, nil
+	}
+
+	providers := map[server.AuthProviderType]Provider{
+		server.AuthProviderApiKey: apiKeyProvider,
+	}
+	if config.Oidc != nil {
+		providers[server.AuthProviderOidc] = NewOidcProvider(config.Oidc)
+	}
+	if config.Mtls != nil {
+		providers[server.AuthProviderMtls] = NewMtlsProvider(config.Mtls)
+	}
+	if config.AdminTokenHash != "" {
+		providers[server.AuthProviderAdminToken] = NewAdminTokenProvider(config.AdminTokenHash)
+	}
+
+	routeChains := RouteChains{}
+	for group, providerTypes := range config.RouteProviders {
+		if len(providerTypes) == 0 {
+			return nil, fmt.Errorf("no auth providers configured for route group %s", group)
+		}
+
+		chain := Chain{}
+		for _, providerType := range providerTypes {
+			provider, ok := providers[providerType]
+			if !ok {
+				return nil, fmt.Errorf("auth provider %s used by route group %s is unknown or not configured", providerType, group)
+			}
+			chain = append(chain, provider)
+		}
+
+		routeChains[group] = chain
+	}
+
+	if _, ok := routeChains[DEFAULT_ROUTE_GROUP]; !ok {
+		routeChains[DEFAULT_ROUTE_GROUP] = Chain{apiKeyProvider}
+	}
+
+	return routeChains, nil
+}
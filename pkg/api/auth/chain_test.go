@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/api/auth"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	apikeys_service "github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouteChains(t *testing.T) {
+	apiKeyService := apikeys_service.NewApiKeyService(apikeys_service.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	clientKey, err := apiKeyService.Generate(apikey.ApiKeyTypeClient, "client")
+	require.Nil(t, err)
+
+	adminKey, err := apiKeyService.GenerateAdmin("admin-key")
+	require.Nil(t, err)
+
+	routeChains, err := auth.NewRouteChains(&server.AuthConfig{
+		RouteProviders: map[string][]server.AuthProviderType{
+			"/server": {server.AuthProviderAdminToken},
+			"default": {server.AuthProviderApiKey, server.AuthProviderMtls},
+		},
+		Mtls:           &server.MtlsAuthConfig{AllowedCommonNames: []string{"ci"}},
+		AdminTokenHash: apikeys.HashKey("admin-token"),
+	}, apiKeyService)
+	require.Nil(t, err)
+
+	authenticate := func(path, token, commonName string) (*auth.Identity, error) {
+		req := httptest.NewRequest("GET", path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if commonName != "" {
+			req.TLS = &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: commonName}}}},
+			}
+		}
+		return routeChains.GetChain(path).Authenticate(req)
+	}
+
+	identity, err := authenticate("/workspace/:workspaceId", clientKey, "")
+	require.Nil(t, err)
+	require.Equal(t, "client", identity.Name)
+	require.Equal(t, apikey.ApiKeyTypeClient, identity.ApiKeyType)
+	require.False(t, identity.Admin)
+
+	identity, err = authenticate("/workspace/:workspaceId", adminKey, "")
+	require.Nil(t, err)
+	require.True(t, identity.Admin)
+
+	identity, err = authenticate("/workspace/", "", "ci")
+	require.Nil(t, err)
+	require.Equal(t, server.AuthProviderMtls, identity.Provider)
+	require.False(t, identity.Admin)
+
+	_, err = authenticate("/workspace/", "", "other")
+	require.Equal(t, auth.ErrUnauthorized, err)
+
+	// Route groups only accept their configured providers
+	_, err = authenticate("/server/config", clientKey, "")
+	require.Equal(t, auth.ErrUnauthorized, err)
+
+	identity, err = authenticate("/server/config", "admin-token", "")
+	require.Nil(t, err)
+	require.Equal(t, auth.ADMIN_IDENTITY_NAME, identity.Name)
+	require.True(t, identity.Admin)
+}
+
+func TestNewRouteChainsFailsForUnconfiguredProvider(t *testing.T) {
+	_, err := auth.NewRouteChains(&server.AuthConfig{
+		RouteProviders: map[string][]server.AuthProviderType{
+			"default": {server.AuthProviderOidc},
+		},
+	}, nil)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"os"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+// MtlsProvider authenticates requests received on the mTLS listener with a verified client certificate
+type MtlsProvider struct {
+	allowedCommonNames []string
+}
+
+func NewMtlsProvider(config *server.MtlsAuthConfig) *MtlsProvider {
+	return &MtlsProvider{
+		allowedCommonNames: config.AllowedCommonNames,
+	}
+}
+
+func (p *MtlsProvider) Authenticate(req *http.Request) (*Identity, error) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil, ErrUnauthorized
+	}
+
+	commonName := req.TLS.VerifiedChains[0][0].Subject.CommonName
+	if commonName == "" {
+		return nil, ErrUnauthorized
+	}
+
+	if len(p.allowedCommonNames) > 0 && !slices.Contains(p.allowedCommonNames, commonName) {
+		return nil, ErrUnauthorized
+	}
+
+	return &Identity{
+		Name:       commonName,
+		ApiKeyType: apikey.ApiKeyTypeClient,
+		Provider:   server.AuthProviderMtls,
+	}, nil
+}
+
+// GetMtlsConfig returns the TLS config of the mTLS listener.
+// Client certificates are verified when presented so other providers keep working on the listener.
+func GetMtlsConfig(config *server.MtlsAuthConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := os.ReadFile(config.CaFile)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(ca) {
+		return nil, errors.New("failed to parse mTLS client CA file")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
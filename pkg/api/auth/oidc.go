@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server"
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultUsernameClaim = "email"
+
+// OidcProvider authenticates requests with an ID token of the configured issuer as the bearer token
+type OidcProvider struct {
+	config *server.OidcAuthConfig
+
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOidcProvider(config *server.OidcAuthConfig) *OidcProvider {
+	return &OidcProvider{
+		config: config,
+	}
+}
+
+func (p *OidcProvider) Authenticate(req *http.Request) (*Identity, error) {
+	token, err := getBearerToken(req)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := p.getVerifier(req.Context())
+	if err != nil {
+		log.Errorf("Failed to get OIDC provider %s: %s", p.config.IssuerUrl, err)
+		return nil, ErrUnauthorized
+	}
+
+	idToken, err := verifier.Verify(req.Context(), token)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	claims := map[string]interface{}{}
+	err = idToken.Claims(&claims)
+	if err != nil {
+		return nil, ErrUnauthorized
+	}
+
+	usernameClaim := p.config.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+
+	username, ok := claims[usernameClaim].(string)
+	if !ok || username == "" {
+		return nil, ErrUnauthorized
+	}
+
+	if len(p.config.AllowedUsers) > 0 && !slices.Contains(p.config.AllowedUsers, username) {
+		return nil, ErrUnauthorized
+	}
+
+	return &Identity{
+		Name:       username,
+		ApiKeyType: apikey.ApiKeyTypeClient,
+		Provider:   server.AuthProviderOidc,
+	}, nil
+}
+
+// getVerifier discovers the issuer on first use so the server can start while the issuer is unreachable
+func (p *OidcProvider) getVerifier(ctx context.Context) (*oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.verifier != nil {
+		return p.verifier, nil
+	}
+
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), p.config.IssuerUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	p.verifier = provider.Verifier(&oidc.Config{
+		ClientID: p.config.ClientId,
+	})
+
+	return p.verifier, nil
+}
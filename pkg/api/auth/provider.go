@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+var ErrUnauthorized = common.WithErrorCode(errors.New("unauthorized"), common.ErrorCodeUnauthorized)
+
+// Identity is the authenticated caller of a request
+type Identity struct {
+	// Name of the API key, OIDC user, certificate common name or "admin" for the admin token
+	Name string
+	// Callers that do not authenticate with an API key are treated as clients
+	ApiKeyType apikey.ApiKeyType
+	Provider   server.AuthProviderType
+	// Server administrators manage server-wide resources. Only admin API keys and the admin token are administrators
+	Admin bool
+}
+
+// Provider authenticates requests with one authentication scheme
+type Provider interface {
+	Authenticate(req *http.Request) (*Identity, error)
+}
+
+func ExtractToken(bearerToken string) string {
+	if !strings.HasPrefix(bearerToken, "Bearer ") {
+		return ""
+	}
+
+	return strings.TrimPrefix(bearerToken, "Bearer ")
+}
+
+func getBearerToken(req *http.Request) (string, error) {
+	token := ExtractToken(req.Header.Get("Authorization"))
+	if token == "" {
+		return "", ErrUnauthorized
+	}
+
+	return token, nil
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcement
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
+	"github.com/daytonaio/daytona/pkg/server/announcements/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAnnouncements 			godoc
+//
+//	@Tags			announcement
+//	@Summary		List announcements
+//	@Description	List the server announcements of the caller, such as maintenance windows, deprecations and quota warnings
+//	@Produce		json
+//	@Param			all	query	bool	false	"Include expired announcements"
+//	@Success		200	{array}	Announcement
+//	@Router			/announcement [get]
+//
+//	@id				ListAnnouncements
+func ListAnnouncements(ctx *gin.Context) {
+	includeExpired := false
+	allQuery := ctx.Query("all")
+	if allQuery != "" {
+		var err error
+		includeExpired, err = strconv.ParseBool(allQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid value for all flag: %w", err))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	announcements, err := server.AnnouncementService.List(includeExpired)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list announcements: %w", err))
+		return
+	}
+
+	apiKeyName := ctx.GetString("apiKeyName")
+	announcements = slices.DeleteFunc(announcements, func(a *announcement.Announcement) bool {
+		return !a.IsVisibleTo(apiKeyName)
+	})
+
+	ctx.JSON(200, announcements)
+}
+
+// CreateAnnouncement 			godoc
+//
+//	@Tags			announcement
+//	@Summary		Create an announcement
+//	@Description	Create an announcement that is shown once by every CLI connected to the server. Only server administrators can create announcements
+//	@Accept			json
+//	@Produce		json
+//	@Param			announcement	body		CreateAnnouncementDTO	true	"Create announcement"
+//	@Success		200				{object}	Announcement
+//	@Router			/announcement [post]
+//
+//	@id				CreateAnnouncement
+func CreateAnnouncement(ctx *gin.Context) {
+	var req dto.CreateAnnouncementDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	a, err := server.AnnouncementService.Create(req)
+	if err != nil {
+		abortWithAnnouncementError(ctx, "failed to create announcement", err)
+		return
+	}
+
+	ctx.JSON(200, a)
+}
+
+// DeleteAnnouncement 			godoc
+//
+//	@Tags			announcement
+//	@Summary		Delete an announcement
+//	@Description	Delete an announcement. Only server administrators can delete announcements
+//	@Param			announcementId	path	string	true	"Announcement ID"
+//	@Success		204
+//	@Router			/announcement/{announcementId} [delete]
+//
+//	@id				DeleteAnnouncement
+func DeleteAnnouncement(ctx *gin.Context) {
+	announcementId := ctx.Param("announcementId")
+
+	server := server.GetInstance(nil)
+
+	err := server.AnnouncementService.Delete(announcementId)
+	if err != nil {
+		abortWithAnnouncementError(ctx, "failed to delete announcement", err)
+		return
+	}
+
+	ctx.Status(204)
+}
+
+func abortWithAnnouncementError(ctx *gin.Context, message string, err error) {
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case announcement.IsAnnouncementNotFound(err):
+		statusCode = http.StatusNotFound
+	case announcements.IsInvalidAnnouncementRequest(err):
+		statusCode = http.StatusBadRequest
+	}
+
+	ctx.AbortWithError(statusCode, fmt.Errorf("%s: %w", message, err))
+}
@@ -4,6 +4,7 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -48,6 +49,21 @@ func RevokeApiKey(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
+	if !ctx.GetBool("serverAdmin") {
+		keys, err := server.ApiKeyService.ListClientKeys()
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to revoke api key: %w", err))
+			return
+		}
+
+		for _, key := range keys {
+			if key.Name == apiKeyName && key.Admin {
+				ctx.AbortWithError(http.StatusForbidden, errors.New("only administrators can revoke admin keys"))
+				return
+			}
+		}
+	}
+
 	err := server.ApiKeyService.Revoke(apiKeyName)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to revoke api key: %w", err))
@@ -4,8 +4,10 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
@@ -16,9 +18,10 @@ import (
 //
 //	@Tags			apiKey
 //	@Summary		Generate an API key
-//	@Description	Generate an API key
+//	@Description	Generate an API key. Fails with a conflict if a key with the name already exists
 //	@Produce		plain
 //	@Param			apiKeyName	path		string	true	"API key name"
+//	@Param			admin		query		bool	false	"Generate a key that can manage server-wide resources. Only administrators can generate admin keys"
 //	@Success		200			{string}	apiKey
 //	@Router			/apikey/{apiKeyName} [post]
 //
@@ -26,11 +29,37 @@ import (
 func GenerateApiKey(ctx *gin.Context) {
 	apiKeyName := ctx.Param("apiKeyName")
 
+	admin := false
+	adminQuery := ctx.Query("admin")
+	if adminQuery != "" {
+		var err error
+		admin, err = strconv.ParseBool(adminQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid value for admin flag: %w", err))
+			return
+		}
+	}
+
+	if admin && !ctx.GetBool("serverAdmin") {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only administrators can generate admin keys"))
+		return
+	}
+
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	var response string
+	var err error
+	if admin {
+		response, err = server.ApiKeyService.GenerateAdmin(apiKeyName)
+	} else {
+		response, err = server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	}
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get API keys: %w", err))
+		statusCode := http.StatusInternalServerError
+		if apikey.IsApiKeyAlreadyExists(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to generate API key %s: %w", apiKeyName, err))
 		return
 	}
 
@@ -0,0 +1,186 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// UploadArtifact godoc
+//
+//	@Tags			artifact
+//	@Summary		Upload an artifact
+//	@Description	Upload a project output file as an artifact
+//	@Accept			multipart/form-data
+//	@Param			workspaceId	path		string	true	"Workspace ID"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		formData	string	true	"Artifact path relative to the project directory"
+//	@Param			file		formData	file	true	"Artifact file"
+//	@Success		201			{object}	Artifact
+//	@Router			/workspace/{workspaceId}/{projectId}/artifacts [post]
+//
+//	@id				UploadArtifact
+func UploadArtifact(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	artifactPath := ctx.PostForm("path")
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	if artifactPath == "" {
+		artifactPath = fileHeader.Filename
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read artifact file: %w", err))
+		return
+	}
+	defer file.Close()
+
+	server := server.GetInstance(nil)
+
+	a, err := server.ArtifactService.Upload(workspaceId, projectId, artifactPath, file)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to upload artifact: %w", err))
+		return
+	}
+
+	ctx.JSON(201, a)
+}
+
+// ListArtifacts godoc
+//
+//	@Tags			artifact
+//	@Summary		List artifacts
+//	@Description	List artifacts
+//	@Produce		json
+//	@Param			workspaceId	query		string	false	"Workspace ID"
+//	@Param			projectName	query		string	false	"Project name"
+//	@Success		200			{array}		Artifact
+//	@Router			/artifact [get]
+//
+//	@id				ListArtifacts
+func ListArtifacts(ctx *gin.Context) {
+	filter := &artifact.Filter{}
+
+	if workspaceId := ctx.Query("workspaceId"); workspaceId != "" {
+		filter.WorkspaceId = &workspaceId
+	}
+
+	if projectName := ctx.Query("projectName"); projectName != "" {
+		filter.ProjectName = &projectName
+	}
+
+	server := server.GetInstance(nil)
+
+	artifacts, err := server.ArtifactService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list artifacts: %w", err))
+		return
+	}
+
+	ctx.JSON(200, artifacts)
+}
+
+// GetArtifact godoc
+//
+//	@Tags			artifact
+//	@Summary		Get artifact
+//	@Description	Get artifact
+//	@Produce		json
+//	@Param			artifactId	path		string	true	"Artifact ID"
+//	@Success		200			{object}	Artifact
+//	@Router			/artifact/{artifactId} [get]
+//
+//	@id				GetArtifact
+func GetArtifact(ctx *gin.Context) {
+	artifactId := ctx.Param("artifactId")
+
+	server := server.GetInstance(nil)
+
+	a, err := server.ArtifactService.Find(artifactId)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to find artifact: %w", err))
+		return
+	}
+
+	ctx.JSON(200, a)
+}
+
+// DownloadArtifact godoc
+//
+//	@Tags			artifact
+//	@Summary		Download artifact
+//	@Description	Download artifact content
+//	@Produce		octet-stream
+//	@Param			artifactId	path		string	true	"Artifact ID"
+//	@Success		200			{file}		binary
+//	@Router			/artifact/{artifactId}/download [get]
+//
+//	@id				DownloadArtifact
+func DownloadArtifact(ctx *gin.Context) {
+	artifactId := ctx.Param("artifactId")
+
+	server := server.GetInstance(nil)
+
+	a, err := server.ArtifactService.Find(artifactId)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to find artifact: %w", err))
+		return
+	}
+
+	content, err := server.ArtifactService.GetContent(artifactId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to read artifact: %w", err))
+		return
+	}
+	defer content.Close()
+
+	ctx.DataFromReader(200, int64(a.Size), "application/octet-stream", content, map[string]string{
+		"Content-Disposition": "attachment; filename=" + strconv.Quote(path.Base(a.Path)),
+	})
+}
+
+// DeleteArtifact godoc
+//
+//	@Tags			artifact
+//	@Summary		Delete artifact
+//	@Description	Delete artifact
+//	@Param			artifactId	path	string	true	"Artifact ID"
+//	@Success		204
+//	@Router			/artifact/{artifactId} [delete]
+//
+//	@id				DeleteArtifact
+func DeleteArtifact(ctx *gin.Context) {
+	artifactId := ctx.Param("artifactId")
+
+	server := server.GetInstance(nil)
+
+	err := server.ArtifactService.Delete(artifactId)
+	if err != nil {
+		ctx.AbortWithError(getStatusCode(err), fmt.Errorf("failed to delete artifact: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
+
+func getStatusCode(err error) int {
+	if artifact.IsArtifactNotFound(err) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
@@ -9,9 +9,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build/dto"
 	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/correlation"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/server"
 	builds_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
@@ -40,7 +45,8 @@ func CreateBuild(ctx *gin.Context) {
 	s := server.GetInstance(nil)
 
 	projectConfig, err := s.ProjectConfigService.Find(&config.ProjectConfigFilter{
-		Name: &createBuildDto.ProjectConfigName,
+		Name:           &createBuildDto.ProjectConfigName,
+		OrganizationId: util.Pointer(organization.GetOrganizationId(ctx.Request.Context())),
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get project config: %s", err.Error()))
@@ -63,20 +69,38 @@ func CreateBuild(ctx *gin.Context) {
 	}
 
 	newBuildDto := builds_dto.BuildCreationData{
-		Image:       projectConfig.Image,
-		User:        projectConfig.User,
-		BuildConfig: projectConfig.BuildConfig,
-		Repository:  repo,
-		EnvVars:     createBuildDto.EnvVars,
+		Image:          projectConfig.Image,
+		User:           projectConfig.User,
+		BuildConfig:    projectConfig.BuildConfig,
+		Repository:     repo,
+		EnvVars:        createBuildDto.EnvVars,
+		OrganizationId: projectConfig.OrganizationId,
+		CorrelationId:  correlation.GetId(ctx.Request.Context()),
 	}
 
+	var prebuild *config.PrebuildConfig
 	if createBuildDto.PrebuildId != nil {
 		newBuildDto.PrebuildId = *createBuildDto.PrebuildId
+		prebuild, _ = projectConfig.FindPrebuild(&config.PrebuildFilter{
+			Id: createBuildDto.PrebuildId,
+		})
+	}
+
+	newBuildDto.BuildSecrets = projectConfig.GetBuildSecrets(prebuild)
+
+	err = s.BuildSecretService.Validate(projectConfig.OrganizationId, newBuildDto.BuildSecrets)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if buildsecret.IsInvalidName(err) || buildsecret.IsBuildSecretNotSet(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, err)
+		return
 	}
 
 	buildId, err := s.BuildService.Create(newBuildDto)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create build: %s", err.Error()))
+		ctx.AbortWithError(http.StatusInternalServerError, common.WithErrorCode(fmt.Errorf("failed to create build: %w", err), common.ErrorCodeBuildFailed))
 		return
 	}
 
@@ -114,6 +138,45 @@ func GetBuild(ctx *gin.Context) {
 	ctx.JSON(200, b)
 }
 
+// SetBuildPriority godoc
+//
+//	@Tags			build
+//	@Summary		Set build priority
+//	@Description	Set the priority of a build. Pending builds of a higher priority preempt running builds of a lower priority when the build runner is at capacity. Only server administrators can set the priority of builds
+//	@Accept			json
+//	@Param			buildId				path	string				true	"Build ID"
+//	@Param			setBuildPriorityDto	body	SetBuildPriorityDTO	true	"Set Build Priority DTO"
+//	@Success		200
+//	@Router			/build/{buildId}/priority [post]
+//
+//	@id				SetBuildPriority
+func SetBuildPriority(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	var setBuildPriorityDto dto.SetBuildPriorityDTO
+	err := ctx.BindJSON(&setBuildPriorityDto)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.BuildService.SetPriority(buildId, build.BuildPriority(setBuildPriorityDto.Priority))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if build.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if build.IsInvalidBuildPriority(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to set build priority: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
 // ListBuilds godoc
 //
 //	@Tags			build
@@ -9,3 +9,7 @@ type CreateBuildDTO struct {
 	PrebuildId        *string           `json:"prebuildId" validate:"optional"`
 	EnvVars           map[string]string `json:"envVars" validate:"required"`
 } // @name CreateBuildDTO
+
+type SetBuildPriorityDTO struct {
+	Priority string `json:"priority" validate:"required"`
+} // @name SetBuildPriorityDTO
@@ -0,0 +1,16 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "time"
+
+// BuildSecret is a build secret without its value, values are never returned by the API
+type BuildSecret struct {
+	Name      string    `json:"name" validate:"required"`
+	UpdatedAt time.Time `json:"updatedAt" validate:"required"`
+} // @name BuildSecret
+
+type SetBuildSecretDTO struct {
+	Value string `json:"value" validate:"required"`
+} // @name SetBuildSecretDTO
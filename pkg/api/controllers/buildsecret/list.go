@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/buildsecret/dto"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListBuildSecrets godoc
+//
+//	@Tags			build-secret
+//	@Summary		List build secrets
+//	@Description	List the names of the build secrets of the organization
+//	@Produce		json
+//	@Success		200	{array}	dto.BuildSecret
+//	@Router			/build-secret [get]
+//
+//	@id				ListBuildSecrets
+func ListBuildSecrets(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	secrets, err := server.BuildSecretService.List(organization.GetOrganizationId(ctx.Request.Context()))
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list build secrets: %w", err))
+		return
+	}
+
+	result := []dto.BuildSecret{}
+	for _, secret := range secrets {
+		result = append(result, dto.BuildSecret{
+			Name:      secret.Name,
+			UpdatedAt: secret.UpdatedAt,
+		})
+	}
+
+	ctx.JSON(200, result)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// RemoveBuildSecret godoc
+//
+//	@Tags			build-secret
+//	@Summary		Remove build secret
+//	@Description	Remove a build secret of the organization
+//	@Param			name	path	string	true	"Build secret name"
+//	@Success		204
+//	@Router			/build-secret/{name} [delete]
+//
+//	@id				RemoveBuildSecret
+func RemoveBuildSecret(ctx *gin.Context) {
+	name := ctx.Param("name")
+
+	server := server.GetInstance(nil)
+
+	err := server.BuildSecretService.Delete(organization.GetOrganizationId(ctx.Request.Context()), name)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if buildsecret.IsBuildSecretNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove build secret: %w", err))
+		return
+	}
+
+	ctx.Status(204)
+}
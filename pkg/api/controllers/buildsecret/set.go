@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/buildsecret/dto"
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// SetBuildSecret godoc
+//
+//	@Tags			build-secret
+//	@Summary		Set build secret
+//	@Description	Set the value of a build secret of the organization
+//	@Param			name		path	string				true	"Build secret name"
+//	@Param			buildSecret	body	SetBuildSecretDTO	true	"Build secret value"
+//	@Success		201
+//	@Router			/build-secret/{name} [put]
+//
+//	@id				SetBuildSecret
+func SetBuildSecret(ctx *gin.Context) {
+	name := ctx.Param("name")
+
+	var req dto.SetBuildSecretDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.BuildSecretService.Set(organization.GetOrganizationId(ctx.Request.Context()), name, req.Value)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if buildsecret.IsInvalidName(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to set build secret: %w", err))
+		return
+	}
+
+	ctx.Status(201)
+}
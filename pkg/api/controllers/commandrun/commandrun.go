@@ -0,0 +1,133 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandrun
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/commandrun"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// RunProjectCommand godoc
+//
+//	@Tags			command run
+//	@Summary		Run a project command
+//	@Description	Run a named command declared by the project and wait for it to exit
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			commandName	path		string	true	"Command name"
+//	@Success		200			{object}	CommandRun
+//	@Router			/workspace/{workspaceId}/{projectId}/commands/{commandName}/run [post]
+//
+//	@id				RunProjectCommand
+func RunProjectCommand(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	commandName := ctx.Param("commandName")
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find workspace: %w", err))
+		return
+	}
+
+	p, err := w.GetProject(projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find project: %w", err))
+		return
+	}
+
+	run, err := server.CommandRunService.Run(ctx.Request.Context(), p, commandName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, project.ErrCommandNotFound) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to run command: %w", err))
+		return
+	}
+
+	ctx.JSON(200, run)
+}
+
+// ListCommandRuns godoc
+//
+//	@Tags			command run
+//	@Summary		List command runs
+//	@Description	List the history of project command runs, most recent first
+//	@Produce		json
+//	@Param			workspaceId	query	string	false	"Workspace ID"
+//	@Param			projectName	query	string	false	"Project name"
+//	@Param			commandName	query	string	false	"Command name"
+//	@Success		200			{array}	CommandRun
+//	@Router			/command-run [get]
+//
+//	@id				ListCommandRuns
+func ListCommandRuns(ctx *gin.Context) {
+	filter := &commandrun.Filter{}
+
+	if workspaceId := ctx.Query("workspaceId"); workspaceId != "" {
+		filter.WorkspaceId = &workspaceId
+	}
+
+	if projectName := ctx.Query("projectName"); projectName != "" {
+		filter.ProjectName = &projectName
+	}
+
+	if commandName := ctx.Query("commandName"); commandName != "" {
+		filter.CommandName = &commandName
+	}
+
+	server := server.GetInstance(nil)
+
+	runs, err := server.CommandRunService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list command runs: %w", err))
+		return
+	}
+
+	ctx.JSON(200, runs)
+}
+
+// GetCommandRun godoc
+//
+//	@Tags			command run
+//	@Summary		Get command run
+//	@Description	Get a project command run with its output
+//	@Produce		json
+//	@Param			runId	path		string	true	"Command run ID"
+//	@Success		200		{object}	CommandRun
+//	@Router			/command-run/{runId} [get]
+//
+//	@id				GetCommandRun
+func GetCommandRun(ctx *gin.Context) {
+	runId := ctx.Param("runId")
+
+	server := server.GetInstance(nil)
+
+	run, err := server.CommandRunService.Find(runId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if commandrun.IsCommandRunNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find command run: %w", err))
+		return
+	}
+
+	ctx.JSON(200, run)
+}
@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package deeplink
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/deeplink"
+	"github.com/gin-gonic/gin"
+)
+
+// Redirects the browser to the daytona:// protocol handler registered by the CLI.
+// Shareable http links are used since most chat and code review tools don't linkify custom schemes.
+func OpenLink(ctx *gin.Context) {
+	link, err := deeplink.FromQuery(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid link: %w", err))
+		return
+	}
+
+	ctx.Redirect(http.StatusFound, link.String())
+}
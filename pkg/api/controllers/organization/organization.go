@@ -0,0 +1,239 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/organizations"
+	"github.com/daytonaio/daytona/pkg/server/organizations/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListOrganizations 			godoc
+//
+//	@Tags			organization
+//	@Summary		List organizations
+//	@Description	List organizations the client API key is a member of
+//	@Produce		json
+//	@Success		200	{array}	Organization
+//	@Router			/organization [get]
+//
+//	@id				ListOrganizations
+func ListOrganizations(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	organizations, err := server.OrganizationService.List(ctx.GetString("apiKeyName"))
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list organizations: %w", err))
+		return
+	}
+
+	ctx.JSON(200, organizations)
+}
+
+// GetOrganization 			godoc
+//
+//	@Tags			organization
+//	@Summary		Get organization
+//	@Description	Get organization
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID or name"
+//	@Success		200				{object}	Organization
+//	@Router			/organization/{organizationId} [get]
+//
+//	@id				GetOrganization
+func GetOrganization(ctx *gin.Context) {
+	organizationId := ctx.Param("organizationId")
+
+	server := server.GetInstance(nil)
+
+	o, err := server.OrganizationService.Find(organizationId)
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to get organization", err)
+		return
+	}
+
+	if !o.HasMember(ctx.GetString("apiKeyName")) {
+		abortWithOrganizationError(ctx, "failed to get organization", organizations.ErrNotOrganizationMember)
+		return
+	}
+
+	ctx.JSON(200, o)
+}
+
+// CreateOrganization 			godoc
+//
+//	@Tags			organization
+//	@Summary		Create an organization
+//	@Description	Create an organization. The client API key creating the organization becomes its first member and admin
+//	@Accept			json
+//	@Produce		json
+//	@Param			organization	body		CreateOrganizationDTO	true	"Create organization"
+//	@Success		200				{object}	Organization
+//	@Router			/organization [post]
+//
+//	@id				CreateOrganization
+func CreateOrganization(ctx *gin.Context) {
+	var req dto.CreateOrganizationDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	quota := organization.Quota{}
+	if req.Quota != nil {
+		quota = *req.Quota
+	}
+
+	server := server.GetInstance(nil)
+
+	o, err := server.OrganizationService.Create(req.Name, ctx.GetString("apiKeyName"), quota)
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to create organization", err)
+		return
+	}
+
+	ctx.JSON(200, o)
+}
+
+// DeleteOrganization 			godoc
+//
+//	@Tags			organization
+//	@Summary		Delete organization
+//	@Description	Delete organization. Requires an admin of the organization and fails while workspaces, targets or project configs belong to it
+//	@Param			organizationId	path	string	true	"Organization ID or name"
+//	@Success		204
+//	@Router			/organization/{organizationId} [delete]
+//
+//	@id				DeleteOrganization
+func DeleteOrganization(ctx *gin.Context) {
+	organizationId := ctx.Param("organizationId")
+
+	server := server.GetInstance(nil)
+
+	err := server.OrganizationService.Delete(organizationId, ctx.GetString("apiKeyName"))
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to delete organization", err)
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// AddOrganizationMember 			godoc
+//
+//	@Tags			organization
+//	@Summary		Add organization member
+//	@Description	Add a client API key to the organization or make a member an admin. Requires an admin of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string						true	"Organization ID or name"
+//	@Param			member			body		AddOrganizationMemberDTO	true	"Member"
+//	@Success		200				{object}	Organization
+//	@Router			/organization/{organizationId}/member [post]
+//
+//	@id				AddOrganizationMember
+func AddOrganizationMember(ctx *gin.Context) {
+	organizationId := ctx.Param("organizationId")
+
+	var req dto.AddOrganizationMemberDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	o, err := server.OrganizationService.AddMember(organizationId, ctx.GetString("apiKeyName"), req.Name, req.Admin)
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to add organization member", err)
+		return
+	}
+
+	ctx.JSON(200, o)
+}
+
+// RemoveOrganizationMember 			godoc
+//
+//	@Tags			organization
+//	@Summary		Remove organization member
+//	@Description	Remove a client API key from the organization. Requires an admin of the organization
+//	@Produce		json
+//	@Param			organizationId	path		string	true	"Organization ID or name"
+//	@Param			member			path		string	true	"Member name"
+//	@Success		200				{object}	Organization
+//	@Router			/organization/{organizationId}/member/{member} [delete]
+//
+//	@id				RemoveOrganizationMember
+func RemoveOrganizationMember(ctx *gin.Context) {
+	organizationId := ctx.Param("organizationId")
+	member := ctx.Param("member")
+
+	server := server.GetInstance(nil)
+
+	o, err := server.OrganizationService.RemoveMember(organizationId, ctx.GetString("apiKeyName"), member)
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to remove organization member", err)
+		return
+	}
+
+	ctx.JSON(200, o)
+}
+
+// SetOrganizationQuota 			godoc
+//
+//	@Tags			organization
+//	@Summary		Set organization quota
+//	@Description	Set organization quota. Requires an admin of the organization
+//	@Accept			json
+//	@Produce		json
+//	@Param			organizationId	path		string				true	"Organization ID or name"
+//	@Param			quota			body		OrganizationQuota	true	"Quota"
+//	@Success		200				{object}	Organization
+//	@Router			/organization/{organizationId}/quota [put]
+//
+//	@id				SetOrganizationQuota
+func SetOrganizationQuota(ctx *gin.Context) {
+	organizationId := ctx.Param("organizationId")
+
+	var req organization.Quota
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	o, err := server.OrganizationService.SetQuota(organizationId, ctx.GetString("apiKeyName"), req)
+	if err != nil {
+		abortWithOrganizationError(ctx, "failed to set organization quota", err)
+		return
+	}
+
+	ctx.JSON(200, o)
+}
+
+func abortWithOrganizationError(ctx *gin.Context, message string, err error) {
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case organization.IsOrganizationNotFound(err):
+		statusCode = http.StatusNotFound
+	case organizations.IsNotOrganizationMember(err):
+		statusCode = http.StatusForbidden
+	case organizations.IsOrganizationAlreadyExists(err), organizations.IsOrganizationNotEmpty(err):
+		statusCode = http.StatusConflict
+	case organizations.IsInvalidOrganizationRequest(err):
+		statusCode = http.StatusBadRequest
+	}
+
+	ctx.AbortWithError(statusCode, fmt.Errorf("%s: %w", message, err))
+}
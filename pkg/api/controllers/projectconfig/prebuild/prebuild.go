@@ -7,8 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
@@ -72,6 +76,31 @@ func SetPrebuild(ctx *gin.Context) {
 	}
 
 	server := server.GetInstance(nil)
+
+	// Builds of the prebuild can only read the build secrets of the organization of the project config
+	projectConfig, err := server.ProjectConfigService.Find(&config.ProjectConfigFilter{
+		Name:           &configName,
+		OrganizationId: util.Pointer(organization.GetOrganizationId(ctx.Request.Context())),
+	})
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if config.IsProjectConfigNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find project config: %s", err.Error()))
+		return
+	}
+
+	err = server.BuildSecretService.Validate(projectConfig.OrganizationId, dto.BuildSecrets)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if buildsecret.IsInvalidName(err) || buildsecret.IsBuildSecretNotSet(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, err)
+		return
+	}
+
 	prebuild, err := server.ProjectConfigService.SetPrebuild(configName, dto)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set prebuild: %s", err.Error()))
@@ -99,6 +128,25 @@ func ListPrebuilds(ctx *gin.Context) {
 		return
 	}
 
+	projectConfigs, err := server.ProjectConfigService.List(nil)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get prebuilds: %s", err.Error()))
+		return
+	}
+
+	// Only list prebuilds of project configs that belong to the request organization
+	organizationId := organization.GetOrganizationId(ctx.Request.Context())
+	projectConfigNames := map[string]bool{}
+	for _, pc := range projectConfigs {
+		if pc.OrganizationId == organizationId {
+			projectConfigNames[pc.Name] = true
+		}
+	}
+
+	res = slices.DeleteFunc(res, func(p *dto.PrebuildDTO) bool {
+		return !projectConfigNames[p.ProjectConfigName]
+	})
+
 	ctx.JSON(200, res)
 }
 
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package prebuild
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/gin-gonic/gin"
+)
+
+// GetPrebuildStats godoc
+//
+//	@Tags			prebuild
+//	@Summary		Get prebuild stats
+//	@Description	Get prebuild hit/miss stats for workspace creations
+//	@Produce		json
+//	@Param			repositoryUrl	query		string	false	"Repository URL"
+//	@Param			branch			query		string	false	"Branch"
+//	@Success		200				{object}	PrebuildStatsDTO
+//	@Router			/project-config/prebuild/stats [get]
+//
+//	@id				GetPrebuildStats
+func GetPrebuildStats(ctx *gin.Context) {
+	filter := &config.PrebuildUsageFilter{}
+
+	if repositoryUrl := ctx.Query("repositoryUrl"); repositoryUrl != "" {
+		filter.RepositoryUrl = &repositoryUrl
+	}
+
+	if branch := ctx.Query("branch"); branch != "" {
+		filter.Branch = &branch
+	}
+
+	server := server.GetInstance(nil)
+	stats, err := server.ProjectConfigService.GetPrebuildStats(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get prebuild stats: %w", err))
+		return
+	}
+
+	ctx.JSON(200, stats)
+}
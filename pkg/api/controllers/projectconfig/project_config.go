@@ -8,12 +8,16 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
@@ -69,8 +73,9 @@ func GetDefaultProjectConfig(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	projectConfigs, err := server.ProjectConfigService.Find(&config.ProjectConfigFilter{
-		Url:     &decodedURLParam,
-		Default: util.Pointer(true),
+		Url:            &decodedURLParam,
+		Default:        util.Pointer(true),
+		OrganizationId: util.Pointer(organization.GetOrganizationId(ctx.Request.Context())),
 	})
 	if err != nil {
 		statusCode := http.StatusInternalServerError
@@ -106,6 +111,11 @@ func ListProjectConfigs(ctx *gin.Context) {
 		return
 	}
 
+	organizationId := organization.GetOrganizationId(ctx.Request.Context())
+	projectConfigs = slices.DeleteFunc(projectConfigs, func(pc *config.ProjectConfig) bool {
+		return pc.OrganizationId != organizationId
+	})
+
 	ctx.JSON(200, projectConfigs)
 }
 
@@ -128,9 +138,62 @@ func SetProjectConfig(ctx *gin.Context) {
 		return
 	}
 
+	err = project.ValidateMounts(req.Mounts)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	c, err := server.GetConfig()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	err = project.ValidateBindMountSources(req.Mounts, c.AllowedBindMountPaths)
+	if err != nil {
+		ctx.AbortWithError(http.StatusForbidden, err)
+		return
+	}
+
+	err = project.ValidateCommands(req.Commands)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	err = project.ValidatePorts(req.Ports)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	s := server.GetInstance(nil)
 
 	projectConfig := conversion.ToProjectConfig(req)
+	projectConfig.OrganizationId = organization.GetOrganizationId(ctx.Request.Context())
+
+	err = s.BuildSecretService.Validate(projectConfig.OrganizationId, req.BuildSecrets)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if buildsecret.IsInvalidName(err) || buildsecret.IsBuildSecretNotSet(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, err)
+		return
+	}
+
+	existingProjectConfig, err := s.ProjectConfigService.Find(&config.ProjectConfigFilter{
+		Name: &projectConfig.Name,
+	})
+	if err == nil && existingProjectConfig.OrganizationId != projectConfig.OrganizationId {
+		ctx.AbortWithError(http.StatusConflict, fmt.Errorf("project config %s already exists in another organization", projectConfig.Name))
+		return
+	}
+	if err != nil && !config.IsProjectConfigNotFound(err) {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find project config: %s", err.Error()))
+		return
+	}
 
 	err = s.ProjectConfigService.Save(projectConfig)
 	if err != nil {
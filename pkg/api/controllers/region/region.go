@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package region
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/region"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/regions"
+	"github.com/daytonaio/daytona/pkg/server/regions/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListRegions 			godoc
+//
+//	@Tags			region
+//	@Summary		List regions
+//	@Description	List the region of the server and the regional servers registered with it
+//	@Produce		json
+//	@Success		200	{array}	FederatedRegion
+//	@Router			/region [get]
+//
+//	@id				ListRegions
+func ListRegions(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	regionList, err := server.RegionService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list regions: %w", err))
+		return
+	}
+
+	ctx.JSON(200, regionList)
+}
+
+// RegisterRegion 			godoc
+//
+//	@Tags			region
+//	@Summary		Register a region
+//	@Description	Register a regional server with the primary server. Regional servers call this periodically as a heartbeat with an admin API key. A region can only be updated with the API key it was registered with
+//	@Accept			json
+//	@Param			region	body	RegisterRegionDTO	true	"Register region"
+//	@Success		200
+//	@Router			/region [post]
+//
+//	@id				RegisterRegion
+func RegisterRegion(ctx *gin.Context) {
+	var req dto.RegisterRegionDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.RegionService.Register(req, ctx.GetString("apiKeyName"))
+	if err != nil {
+		if regions.IsRegionConflict(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to register region: %w", err))
+			return
+		}
+		if regions.IsFederationDisabled(err) || regions.IsNotPrimary(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to register region: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to register region: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// RemoveRegion 			godoc
+//
+//	@Tags			region
+//	@Summary		Remove a region
+//	@Description	Remove a region until its server registers again. Only server administrators can remove regions
+//	@Param			regionName	path	string	true	"Region name"
+//	@Success		200
+//	@Router			/region/{regionName} [delete]
+//
+//	@id				RemoveRegion
+func RemoveRegion(ctx *gin.Context) {
+	regionName := ctx.Param("regionName")
+
+	server := server.GetInstance(nil)
+
+	err := server.RegionService.Remove(regionName)
+	if err != nil {
+		if region.IsRegionNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to remove region: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove region: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/rollout"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/server/rollouts/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListRollouts 			godoc
+//
+//	@Tags			rollout
+//	@Summary		List agent rollouts
+//	@Description	List agent rollouts with the health of their canary workspaces
+//	@Produce		json
+//	@Success		200	{array}	AgentRolloutStatus
+//	@Router			/rollout [get]
+//
+//	@id				ListRollouts
+func ListRollouts(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	rollouts, err := server.RolloutService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list rollouts: %w", err))
+		return
+	}
+
+	ctx.JSON(200, rollouts)
+}
+
+// GetRollout 			godoc
+//
+//	@Tags			rollout
+//	@Summary		Get agent rollout
+//	@Description	Get an agent rollout with the health of its canary workspaces
+//	@Produce		json
+//	@Param			rolloutId	path		string	true	"Rollout ID"
+//	@Success		200			{object}	AgentRolloutStatus
+//	@Router			/rollout/{rolloutId} [get]
+//
+//	@id				GetRollout
+func GetRollout(ctx *gin.Context) {
+	rolloutId := ctx.Param("rolloutId")
+
+	server := server.GetInstance(nil)
+
+	r, err := server.RolloutService.Find(rolloutId)
+	if err != nil {
+		abortWithRolloutError(ctx, "failed to get rollout", err)
+		return
+	}
+
+	ctx.JSON(200, r)
+}
+
+// CreateRollout 			godoc
+//
+//	@Tags			rollout
+//	@Summary		Start an agent rollout
+//	@Description	Select a percentage of workspaces that receive the new agent version the next time their projects start. Only server administrators can create rollouts
+//	@Accept			json
+//	@Produce		json
+//	@Param			rollout	body		CreateAgentRolloutDTO	true	"Create rollout"
+//	@Success		200		{object}	AgentRollout
+//	@Router			/rollout [post]
+//
+//	@id				CreateRollout
+func CreateRollout(ctx *gin.Context) {
+	var req dto.CreateRolloutDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	r, err := server.RolloutService.Create(req.AgentVersion, req.Percentage, req.MaxErrorRate)
+	if err != nil {
+		abortWithRolloutError(ctx, "failed to create rollout", err)
+		return
+	}
+
+	ctx.JSON(200, r)
+}
+
+// PromoteRollout 			godoc
+//
+//	@Tags			rollout
+//	@Summary		Promote agent rollout
+//	@Description	Make the rollout agent version the default for all workspaces. Only server administrators can promote rollouts
+//	@Produce		json
+//	@Param			rolloutId	path		string	true	"Rollout ID"
+//	@Success		200			{object}	AgentRollout
+//	@Router			/rollout/{rolloutId}/promote [post]
+//
+//	@id				PromoteRollout
+func PromoteRollout(ctx *gin.Context) {
+	rolloutId := ctx.Param("rolloutId")
+
+	server := server.GetInstance(nil)
+
+	r, err := server.RolloutService.Promote(rolloutId)
+	if err != nil {
+		abortWithRolloutError(ctx, "failed to promote rollout", err)
+		return
+	}
+
+	ctx.JSON(200, r)
+}
+
+// RollbackRollout 			godoc
+//
+//	@Tags			rollout
+//	@Summary		Roll back agent rollout
+//	@Description	Return the canary workspaces to the previous agent version. Only server administrators can roll back rollouts
+//	@Produce		json
+//	@Param			rolloutId	path		string	true	"Rollout ID"
+//	@Success		200			{object}	AgentRollout
+//	@Router			/rollout/{rolloutId}/rollback [post]
+//
+//	@id				RollbackRollout
+func RollbackRollout(ctx *gin.Context) {
+	rolloutId := ctx.Param("rolloutId")
+
+	server := server.GetInstance(nil)
+
+	r, err := server.RolloutService.Rollback(rolloutId)
+	if err != nil {
+		abortWithRolloutError(ctx, "failed to roll back rollout", err)
+		return
+	}
+
+	ctx.JSON(200, r)
+}
+
+func abortWithRolloutError(ctx *gin.Context, message string, err error) {
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case rollout.IsRolloutNotFound(err):
+		statusCode = http.StatusNotFound
+	case rollouts.IsRolloutInProgress(err):
+		statusCode = http.StatusConflict
+	case rollouts.IsInvalidRolloutRequest(err):
+		statusCode = http.StatusBadRequest
+	}
+
+	ctx.AbortWithError(statusCode, fmt.Errorf("%s: %w", message, err))
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListAuditEvents 		godoc
+//
+//	@Tags			server
+//	@Summary		List audit events
+//	@Description	List the actions recorded in the audit log, oldest first. Only server administrators can read the audit log
+//	@Produce		json
+//	@Param			action		query	string	false	"Action, e.g. target.scale-up"
+//	@Param			resource	query	string	false	"Name of the resource the action was taken on"
+//	@Param			since		query	string	false	"Only include events recorded within the duration, e.g. 24h"
+//	@Success		200			{array}	AuditEvent
+//	@Router			/server/audit [get]
+//
+//	@id				ListAuditEvents
+func ListAuditEvents(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can list audit events"))
+		return
+	}
+
+	filter := &audit.Filter{}
+
+	if action := ctx.Query("action"); action != "" {
+		filter.Action = (*audit.Action)(&action)
+	}
+
+	if resource := ctx.Query("resource"); resource != "" {
+		filter.Resource = &resource
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.ParseDuration(sinceQuery)
+		if err != nil || since <= 0 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since duration: %s", sinceQuery))
+			return
+		}
+		filter.Since = util.Pointer(time.Now().Add(-since))
+	}
+
+	server := server.GetInstance(nil)
+
+	events, err := server.AuditService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list audit events: %w", err))
+		return
+	}
+
+	ctx.JSON(200, events)
+}
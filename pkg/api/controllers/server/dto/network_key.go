@@ -0,0 +1,9 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type RevokeNetworkKeysDTO struct {
+	Scope     string `json:"scope" validate:"required"`
+	ScopeName string `json:"scopeName" validate:"required"`
+} // @name RevokeNetworkKeysDTO
@@ -0,0 +1,9 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type TailnetCa struct {
+	// PEM encoded certificate of the authority that signs the certificates of the TLS ports of projects
+	Certificate string `json:"certificate" validate:"required"`
+} // @name TailnetCaDTO
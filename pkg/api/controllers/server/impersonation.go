@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/impersonation"
+	"github.com/daytonaio/daytona/pkg/server"
+	impersonation_service "github.com/daytonaio/daytona/pkg/server/impersonation"
+	"github.com/daytonaio/daytona/pkg/server/impersonation/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListImpersonationSessions 		godoc
+//
+//	@Tags			server
+//	@Summary		List impersonation sessions
+//	@Description	List the impersonation sessions of all users for administrators, and the sessions in which the caller was impersonated for other users
+//	@Produce		json
+//	@Success		200	{array}	ImpersonationSession
+//	@Router			/server/impersonation [get]
+//
+//	@id				ListImpersonationSessions
+func ListImpersonationSessions(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can list impersonation sessions"))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	filter := &impersonation.Filter{}
+
+	apiKeyName := ctx.GetString("apiKeyName")
+	if !server.ImpersonationService.IsAdmin(apiKeyName, ctx.GetBool("serverAdmin")) {
+		filter.User = &apiKeyName
+	}
+
+	sessions, err := server.ImpersonationService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list impersonation sessions: %w", err))
+		return
+	}
+
+	ctx.JSON(200, sessions)
+}
+
+// StartImpersonation 		godoc
+//
+//	@Tags			server
+//	@Summary		Start impersonating a user
+//	@Description	Start a session in which the calling administrator can create and inspect the workspaces of a user. The user is notified and every request is recorded in the audit log
+//	@Accept			json
+//	@Produce		json
+//	@Param			startImpersonationDto	body		StartImpersonationDTO	true	"Start Impersonation DTO"
+//	@Success		200						{object}	ImpersonationSession
+//	@Router			/server/impersonation [post]
+//
+//	@id				StartImpersonation
+func StartImpersonation(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can impersonate users"))
+		return
+	}
+
+	var req dto.StartImpersonationDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	session, err := server.ImpersonationService.Start(ctx.GetString("apiKeyName"), ctx.GetBool("serverAdmin"), req)
+	if err != nil {
+		abortWithImpersonationError(ctx, "failed to start impersonation", err)
+		return
+	}
+
+	ctx.JSON(200, session)
+}
+
+// EndImpersonation 		godoc
+//
+//	@Tags			server
+//	@Summary		End an impersonation session
+//	@Description	End an impersonation session of the calling administrator before it expires
+//	@Produce		json
+//	@Param			sessionId	path		string	true	"Impersonation session ID"
+//	@Success		200			{object}	ImpersonationSession
+//	@Router			/server/impersonation/{sessionId}/end [post]
+//
+//	@id				EndImpersonation
+func EndImpersonation(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can end impersonation sessions"))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	session, err := server.ImpersonationService.End(ctx.Param("sessionId"), ctx.GetString("apiKeyName"), ctx.GetBool("serverAdmin"))
+	if err != nil {
+		abortWithImpersonationError(ctx, "failed to end impersonation", err)
+		return
+	}
+
+	ctx.JSON(200, session)
+}
+
+func abortWithImpersonationError(ctx *gin.Context, message string, err error) {
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case impersonation.IsSessionNotFound(err):
+		statusCode = http.StatusNotFound
+	case impersonation_service.IsNotAnAdmin(err), impersonation_service.IsSessionNotActive(err):
+		statusCode = http.StatusForbidden
+	case impersonation_service.IsInvalidImpersonationRequest(err):
+		statusCode = http.StatusBadRequest
+	}
+
+	ctx.AbortWithError(statusCode, fmt.Errorf("%s: %w", message, err))
+}
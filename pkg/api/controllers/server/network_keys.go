@@ -0,0 +1,153 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/server/dto"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/gin-gonic/gin"
+)
+
+// ListNetworkKeys 		godoc
+//
+//	@Tags			server
+//	@Summary		List network keys
+//	@Description	List the network keys issued by the server. Only server administrators can list network keys
+//	@Produce		json
+//	@Param			scope		query	string	false	"Scope of the keys"
+//	@Param			scopeName	query	string	false	"Workspace ID, API key name or provider name"
+//	@Success		200			{array}	ScopedNetworkKey
+//	@Router			/server/network-key [get]
+//
+//	@id				ListNetworkKeys
+func ListNetworkKeys(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can list network keys"))
+		return
+	}
+
+	filter := &networkkey.Filter{}
+
+	if scope := ctx.Query("scope"); scope != "" {
+		filter.Scope = (*networkkey.Scope)(&scope)
+	}
+
+	if scopeName := ctx.Query("scopeName"); scopeName != "" {
+		filter.ScopeName = &scopeName
+	}
+
+	server := server.GetInstance(nil)
+
+	keys, err := server.NetworkKeyService.List(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list network keys: %w", err))
+		return
+	}
+
+	ctx.JSON(200, keys)
+}
+
+// RevokeNetworkKey 		godoc
+//
+//	@Tags			server
+//	@Summary		Revoke a network key
+//	@Description	Revoke a network key and remove the node that joined with it from the network. Only server administrators can revoke network keys
+//	@Param			keyId	path	string	true	"Network key ID"
+//	@Success		200
+//	@Router			/server/network-key/{keyId} [delete]
+//
+//	@id				RevokeNetworkKey
+func RevokeNetworkKey(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can revoke network keys"))
+		return
+	}
+
+	keyId := ctx.Param("keyId")
+
+	server := server.GetInstance(nil)
+
+	err := server.NetworkKeyService.Revoke(keyId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if networkkey.IsNetworkKeyNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to revoke network key: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// RevokeNetworkKeys 		godoc
+//
+//	@Tags			server
+//	@Summary		Revoke the network keys of a scope
+//	@Description	Revoke all network keys of a workspace, client or provider and remove its nodes from the network. Only server administrators can revoke network keys
+//	@Accept			json
+//	@Param			revokeNetworkKeysDto	body	RevokeNetworkKeysDTO	true	"Revoke Network Keys DTO"
+//	@Success		200
+//	@Router			/server/network-key/revoke [post]
+//
+//	@id				RevokeNetworkKeys
+func RevokeNetworkKeys(ctx *gin.Context) {
+	if !isClient(ctx) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only clients can revoke network keys"))
+		return
+	}
+
+	var revokeDto dto.RevokeNetworkKeysDTO
+	err := ctx.BindJSON(&revokeDto)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.NetworkKeyService.RevokeScope(networkkey.Scope(revokeDto.Scope), revokeDto.ScopeName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if networkkeys.IsInvalidScope(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to revoke network keys: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// getNetworkKeyScope scopes network keys to the workspace of workspace and project API keys
+// and to the API key name of clients
+func getNetworkKeyScope(ctx *gin.Context) (networkkey.Scope, string) {
+	apiKeyType, _ := ctx.Get("apiKeyType")
+	apiKeyName := ctx.GetString("apiKeyName")
+
+	switch apiKeyType {
+	case apikey.ApiKeyTypeWorkspace:
+		return networkkey.ScopeWorkspace, apiKeyName
+	case apikey.ApiKeyTypeProject:
+		// Project API keys are named <workspaceId>/<projectName>
+		workspaceId, _, _ := strings.Cut(apiKeyName, "/")
+		return networkkey.ScopeWorkspace, workspaceId
+	default:
+		return networkkey.ScopeClient, apiKeyName
+	}
+}
+
+// Workspace and project API keys are available in projects and must not manage the keys of other nodes
+func isClient(ctx *gin.Context) bool {
+	apiKeyType, ok := ctx.Get("apiKeyType")
+	return ok && apiKeyType == apikey.ApiKeyTypeClient
+}
@@ -4,9 +4,14 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -51,6 +56,30 @@ func SetConfig(ctx *gin.Context) {
 		return
 	}
 
+	_, err = server.GetCleanupPolicies(c.CleanupPolicies)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid cleanup policies: %w", err))
+		return
+	}
+
+	_, err = server.GetBrowserBridgePolicy(c.BrowserBridge)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid browser bridge config: %w", err))
+		return
+	}
+
+	err = server.ValidateDerpConfig(c.Derp)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid DERP config: %w", err))
+		return
+	}
+
+	err = server.ValidateLogLevels(c.LogLevels)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid log levels: %w", err))
+		return
+	}
+
 	err = server.Save(c)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to save config: %w", err))
@@ -66,20 +95,47 @@ func SetConfig(ctx *gin.Context) {
 //	@Summary		Generate a new authentication key
 //	@Description	Generate a new authentication key
 //	@Produce		json
-//	@Success		200	{object}	NetworkKey
+//	@Param			persistent	query		bool	false	"Register the node as a persistent node that stays registered while it is offline. Only server administrators can register persistent nodes unless the server config allows them"
+//	@Success		200			{object}	NetworkKey
 //	@Router			/server/network-key [post]
 //
 //	@id				GenerateNetworkKey
 func GenerateNetworkKey(ctx *gin.Context) {
 	s := server.GetInstance(nil)
 
-	authKey, err := s.TailscaleServer.CreateAuthKey()
+	persistentQuery := ctx.Query("persistent")
+	persistent := false
+	var err error
+
+	if persistentQuery != "" {
+		persistent, err = strconv.ParseBool(persistentQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for persistent flag"))
+			return
+		}
+	}
+
+	if persistent {
+		err = server.CheckPersistentNodes(ctx.GetBool("serverAdmin"))
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if server.IsPersistentNodesNotAllowed(err) {
+				statusCode = http.StatusForbidden
+			}
+			ctx.AbortWithError(statusCode, fmt.Errorf("failed to generate network key: %w", err))
+			return
+		}
+	}
+
+	scope, scopeName := getNetworkKeyScope(ctx)
+
+	key, err := s.NetworkKeyService.Generate(scope, scopeName, persistent)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to generate network key: %w", err))
 		return
 	}
 
-	ctx.JSON(200, &server.NetworkKey{Key: authKey})
+	ctx.JSON(200, &server.NetworkKey{Key: key.Key, ExpiresAt: &key.ExpiresAt})
 }
 
 // GetServerLogFiles 		godoc
@@ -103,3 +159,69 @@ func GetServerLogFiles(ctx *gin.Context) {
 
 	ctx.JSON(200, logFiles)
 }
+
+// PreviewCleanup 		godoc
+//
+//	@Tags			server
+//	@Summary		Preview cleanup policies
+//	@Description	List the workspaces the cleanup policies would delete or stop on their next run
+//	@Produce		json
+//	@Success		200	{array}	CleanupCandidate
+//	@Router			/server/cleanup-policies/preview [get]
+//
+//	@id				PreviewCleanup
+func PreviewCleanup(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	candidates, err := server.WorkspaceService.PreviewCleanup()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to preview cleanup: %w", err))
+		return
+	}
+
+	ctx.JSON(200, candidates)
+}
+
+// GetCreationTimingReport 		godoc
+//
+//	@Tags			server
+//	@Summary		Get creation timing report
+//	@Description	Get the percentiles of workspace creation phase durations per provider and target
+//	@Produce		json
+//	@Param			target		query	string	false	"Target name"
+//	@Param			provider	query	string	false	"Provider name"
+//	@Param			since		query	string	false	"Only include timings recorded within the duration, e.g. 24h"
+//	@Success		200			{array}	CreationPhaseReport
+//	@Router			/server/creation-timings [get]
+//
+//	@id				GetCreationTimingReport
+func GetCreationTimingReport(ctx *gin.Context) {
+	filter := &creationtiming.Filter{}
+
+	if target := ctx.Query("target"); target != "" {
+		filter.Target = &target
+	}
+
+	if provider := ctx.Query("provider"); provider != "" {
+		filter.Provider = &provider
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.ParseDuration(sinceQuery)
+		if err != nil || since <= 0 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since duration: %s", sinceQuery))
+			return
+		}
+		filter.Since = util.Pointer(time.Now().Add(-since))
+	}
+
+	server := server.GetInstance(nil)
+
+	report, err := server.CreationTimingService.GetReport(filter)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get creation timing report: %w", err))
+		return
+	}
+
+	ctx.JSON(200, report)
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/server/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTailnetCa 			godoc
+//
+//	@Tags			server
+//	@Summary		Get tailnet CA
+//	@Description	Get the certificate of the authority that signs the certificates of the TLS ports of projects. Clients that trust it can open the TLS ports at the tailnet names of projects. The authority is constrained to the tailnet domain
+//	@Produce		json
+//	@Success		200	{object}	TailnetCaDTO
+//	@Router			/server/tailnet-ca [get]
+//
+//	@id				GetTailnetCa
+func GetTailnetCa(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	if server.TailnetCa == nil {
+		ctx.AbortWithError(http.StatusNotFound, errors.New("the server does not issue certificates"))
+		return
+	}
+
+	ctx.JSON(200, dto.TailnetCa{
+		Certificate: server.TailnetCa.GetCertificatePem(),
+	})
+}
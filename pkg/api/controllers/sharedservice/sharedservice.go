@@ -0,0 +1,191 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices/dto"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSharedServices 			godoc
+//
+//	@Tags			shared-service
+//	@Summary		List shared services
+//	@Description	List shared services and the workspaces attached to them
+//	@Produce		json
+//	@Param			target	query	string	false	"Target name"
+//	@Param			verbose	query	bool	false	"Verbose"
+//	@Success		200		{array}	SharedServiceDTO
+//	@Router			/shared-service [get]
+//
+//	@id				ListSharedServices
+func ListSharedServices(ctx *gin.Context) {
+	verbose := false
+	verboseQuery := ctx.Query("verbose")
+	if verboseQuery != "" {
+		var err error
+		verbose, err = strconv.ParseBool(verboseQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid value for verbose flag: %w", err))
+			return
+		}
+	}
+
+	var filter *sharedservice.Filter
+	if target := ctx.Query("target"); target != "" {
+		filter = &sharedservice.Filter{Target: &target}
+	}
+
+	server := server.GetInstance(nil)
+
+	services, err := server.SharedServiceService.List(ctx.Request.Context(), filter, verbose)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list shared services: %w", err))
+		return
+	}
+
+	ctx.JSON(200, services)
+}
+
+// CreateSharedService 			godoc
+//
+//	@Tags			shared-service
+//	@Summary		Create a shared service
+//	@Description	Create a service, like a database or a cache, on a target that workspaces on the same target can attach to
+//	@Accept			json
+//	@Produce		json
+//	@Param			sharedService	body		CreateSharedServiceDTO	true	"Create shared service"
+//	@Success		200				{object}	SharedService
+//	@Router			/shared-service [post]
+//
+//	@id				CreateSharedService
+func CreateSharedService(ctx *gin.Context) {
+	var req dto.CreateSharedServiceDTO
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	service, err := server.SharedServiceService.Create(ctx.Request.Context(), req)
+	if err != nil {
+		abortWithSharedServiceError(ctx, "failed to create shared service", err)
+		return
+	}
+
+	ctx.JSON(200, service)
+}
+
+// DeleteSharedService 			godoc
+//
+//	@Tags			shared-service
+//	@Summary		Delete a shared service
+//	@Description	Delete a shared service and its data
+//	@Param			serviceName	path	string	true	"Shared service name"
+//	@Param			force		query	bool	false	"Detach attached workspaces and ignore provider errors"
+//	@Success		204
+//	@Router			/shared-service/{serviceName} [delete]
+//
+//	@id				DeleteSharedService
+func DeleteSharedService(ctx *gin.Context) {
+	serviceName := ctx.Param("serviceName")
+
+	force := false
+	forceQuery := ctx.Query("force")
+	if forceQuery != "" {
+		var err error
+		force, err = strconv.ParseBool(forceQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid value for force flag: %w", err))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	err := server.SharedServiceService.Delete(serviceName, force)
+	if err != nil {
+		abortWithSharedServiceError(ctx, "failed to delete shared service", err)
+		return
+	}
+
+	ctx.Status(204)
+}
+
+// AttachSharedService 			godoc
+//
+//	@Tags			shared-service
+//	@Summary		Attach a workspace to a shared service
+//	@Description	Attach a workspace to a shared service. Projects receive the connection env vars when they are started or rebuilt
+//	@Param			serviceName	path	string	true	"Shared service name"
+//	@Param			workspaceId	path	string	true	"Workspace ID or name"
+//	@Success		200
+//	@Router			/shared-service/{serviceName}/workspace/{workspaceId} [post]
+//
+//	@id				AttachSharedService
+func AttachSharedService(ctx *gin.Context) {
+	serviceName := ctx.Param("serviceName")
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	err := server.SharedServiceService.Attach(workspaceId, serviceName)
+	if err != nil {
+		abortWithSharedServiceError(ctx, "failed to attach shared service", err)
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// DetachSharedService 			godoc
+//
+//	@Tags			shared-service
+//	@Summary		Detach a workspace from a shared service
+//	@Description	Detach a workspace from a shared service
+//	@Param			serviceName	path	string	true	"Shared service name"
+//	@Param			workspaceId	path	string	true	"Workspace ID or name"
+//	@Success		204
+//	@Router			/shared-service/{serviceName}/workspace/{workspaceId} [delete]
+//
+//	@id				DetachSharedService
+func DetachSharedService(ctx *gin.Context) {
+	serviceName := ctx.Param("serviceName")
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	err := server.SharedServiceService.Detach(workspaceId, serviceName)
+	if err != nil {
+		abortWithSharedServiceError(ctx, "failed to detach shared service", err)
+		return
+	}
+
+	ctx.Status(204)
+}
+
+func abortWithSharedServiceError(ctx *gin.Context, message string, err error) {
+	statusCode := http.StatusInternalServerError
+
+	switch {
+	case sharedservice.IsSharedServiceNotFound(err), workspace.IsWorkspaceNotFound(err):
+		statusCode = http.StatusNotFound
+	case sharedservices.IsInvalidSharedServiceRequest(err), sharedservices.IsTargetMismatch(err):
+		statusCode = http.StatusBadRequest
+	case sharedservices.IsSharedServiceExists(err), sharedservices.IsSharedServiceInUse(err):
+		statusCode = http.StatusConflict
+	}
+
+	ctx.AbortWithError(statusCode, fmt.Errorf("%s: %w", message, err))
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GetTargetAllocation godoc
+//
+//	@Tags			target
+//	@Summary		Get target allocation
+//	@Description	Get the resources reserved and used by the projects of the target and the capacity of its host
+//	@Produce		json
+//	@Param			target	path		string	true	"Target name"
+//	@Success		200		{object}	TargetAllocation
+//	@Router			/target/{target}/allocation [get]
+//
+//	@id				GetTargetAllocation
+func GetTargetAllocation(ctx *gin.Context) {
+	targetName := ctx.Param("target")
+
+	server := server.GetInstance(nil)
+
+	allocation, err := server.WorkspaceService.GetTargetAllocation(targetName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if provider.IsTargetNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get target allocation: %w", err))
+		return
+	}
+
+	ctx.JSON(200, allocation)
+}
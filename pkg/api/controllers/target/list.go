@@ -7,7 +7,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -31,6 +34,11 @@ func ListTargets(ctx *gin.Context) {
 		return
 	}
 
+	organizationId := organization.GetOrganizationId(ctx.Request.Context())
+	targets = slices.DeleteFunc(targets, func(t *provider.ProviderTarget) bool {
+		return t.OrganizationId != organizationId
+	})
+
 	for _, target := range targets {
 		p, err := server.ProviderManager.GetProvider(target.ProviderInfo.Name)
 		if err != nil {
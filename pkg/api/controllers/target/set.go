@@ -4,13 +4,16 @@
 package target
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/providertargets/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
 )
 
@@ -35,6 +38,22 @@ func SetTarget(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
 	target := conversion.ToProviderTarget(req)
+	target.OrganizationId = organization.GetOrganizationId(ctx.Request.Context())
+
+	// The bandwidth limit is set separately and kept when the target is replaced
+	existingTarget, err := server.ProviderTargetService.Find(&provider.TargetFilter{
+		Name: &target.Name,
+	})
+	if err == nil {
+		if existingTarget.OrganizationId != target.OrganizationId {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("target %s already exists in another organization", target.Name))
+			return
+		}
+		target.BandwidthLimit = existingTarget.BandwidthLimit
+	} else if !provider.IsTargetNotFound(err) {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to find target: %w", err))
+		return
+	}
 
 	err = server.ProviderTargetService.Save(target)
 	if err != nil {
@@ -76,3 +95,44 @@ func SetDefaultTarget(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// SetTargetBandwidthLimit godoc
+//
+//	@Tags			target
+//	@Summary		Set target bandwidth limit
+//	@Description	Set the default limit of the traffic the agent of each project on the target proxies from and to the tailnet. Workspace limits can only lower it in the directions they set. Only administrators can set it
+//	@Param			target	path	string			true	"Target name"
+//	@Param			limit	body	BandwidthLimit	true	"Bandwidth limit"
+//	@Success		200
+//	@Router			/target/{target}/bandwidth-limit [put]
+//
+//	@id				SetTargetBandwidthLimit
+func SetTargetBandwidthLimit(ctx *gin.Context) {
+	targetName := ctx.Param("target")
+
+	if !ctx.GetBool("serverAdmin") {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("only administrators can set the bandwidth limit of a target"))
+		return
+	}
+
+	var req project.BandwidthLimit
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.ProviderTargetService.SetBandwidthLimit(targetName, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if provider.IsTargetNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to set bandwidth limit of target %s: %w", targetName, err))
+		return
+	}
+
+	ctx.Status(200)
+}
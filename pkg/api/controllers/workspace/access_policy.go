@@ -0,0 +1,87 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectAccessPolicy 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project access policy
+//	@Description	Get the policy the project agent enforces on connections from tailnet peers to project ports
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	PortAccessPolicy
+//	@Router			/workspace/{workspaceId}/{projectId}/access-policy [get]
+//
+//	@id				GetProjectAccessPolicy
+func GetProjectAccessPolicy(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	policy, err := server.WorkspaceService.GetProjectAccessPolicy(workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(getAccessPolicyErrorStatus(err), fmt.Errorf("failed to get access policy of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, policy)
+}
+
+// SetProjectAccessPolicy 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Set project access policy
+//	@Description	Replace the policy the project agent enforces on connections from tailnet peers to project ports. Running agents pick up the policy within seconds
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			projectId	path		string				true	"Project ID"
+//	@Param			policy		body		PortAccessPolicy	true	"Access policy"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/{projectId}/access-policy [put]
+//
+//	@id				SetProjectAccessPolicy
+func SetProjectAccessPolicy(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req project.PortAccessPolicy
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.SetProjectAccessPolicy(workspaceId, projectId, req)
+	if err != nil {
+		ctx.AbortWithError(getAccessPolicyErrorStatus(err), fmt.Errorf("failed to set access policy of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+func getAccessPolicyErrorStatus(err error) int {
+	switch {
+	case workspaces.IsInvalidAccessPolicy(err):
+		return http.StatusBadRequest
+	case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
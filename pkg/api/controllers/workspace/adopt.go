@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// AdoptWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Adopt an existing container or VM
+//	@Description	Register an existing Docker container or a VM reachable over SSH as a workspace and install the agent on it
+//	@Param			workspace	body	AdoptWorkspaceDTO	true	"Adopt workspace"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/adopt [post]
+//
+//	@id				AdoptWorkspace
+func AdoptWorkspace(ctx *gin.Context) {
+	var adoptWorkspaceReq dto.AdoptWorkspaceDTO
+	err := ctx.BindJSON(&adoptWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.AdoptWorkspace(ctx.Request.Context(), adoptWorkspaceReq)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsWorkspaceAlreadyExists(err):
+			statusCode = http.StatusConflict
+		case workspaces.IsInvalidAdoption(err), workspaces.IsInvalidWorkspaceName(err):
+			statusCode = http.StatusBadRequest
+		case workspaces.IsOrganizationQuotaExceeded(err):
+			statusCode = http.StatusForbidden
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to adopt workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectAgentUpdate 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project agent update
+//	@Description	Get the agent version the project agent should run and the checksum of its binary. Agents of other versions download the binary and restart themselves with it
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			binaryName	query		string	true	"Name of the binary of the agent, e.g. daytona-linux-amd64"
+//	@Success		200			{object}	AgentUpdate
+//	@Router			/workspace/{workspaceId}/{projectId}/agent-update [get]
+//
+//	@id				GetProjectAgentUpdate
+func GetProjectAgentUpdate(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	binaryName := ctx.Query("binaryName")
+
+	if binaryName == "" {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("binaryName is required"))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	version, err := server.WorkspaceService.GetProjectAgentVersion(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get agent version of project %s: %w", projectId, err))
+		return
+	}
+
+	checksum, err := server.GetBinaryChecksum(binaryName, version)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get checksum of agent binary %s %s: %w", binaryName, version, err))
+		return
+	}
+
+	ctx.JSON(200, dto.AgentUpdate{
+		Version:  version,
+		Checksum: checksum,
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	ws "github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateWorkspaceAnnotations 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Update workspace annotations
+//	@Description	Set or remove workspace annotations. Keys must be namespaced as <prefix>/<name>
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			annotations	body		UpdateAnnotations	true	"Annotations"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/annotations [patch]
+//
+//	@id				UpdateWorkspaceAnnotations
+func UpdateWorkspaceAnnotations(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req dto.UpdateAnnotations
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.UpdateWorkspaceAnnotations(workspaceId, req.Set, req.Remove)
+	if err != nil {
+		ctx.AbortWithError(getAnnotationsErrorStatus(err), fmt.Errorf("failed to update annotations of workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+// UpdateProjectAnnotations 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Update project annotations
+//	@Description	Set or remove project annotations. Keys must be namespaced as <prefix>/<name>
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			projectId	path		string				true	"Project ID"
+//	@Param			annotations	body		UpdateAnnotations	true	"Annotations"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/{projectId}/annotations [patch]
+//
+//	@id				UpdateProjectAnnotations
+func UpdateProjectAnnotations(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.UpdateAnnotations
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.UpdateProjectAnnotations(workspaceId, projectId, req.Set, req.Remove)
+	if err != nil {
+		ctx.AbortWithError(getAnnotationsErrorStatus(err), fmt.Errorf("failed to update annotations of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+func getAnnotationsErrorStatus(err error) int {
+	switch {
+	case ws.IsInvalidAnnotations(err):
+		return http.StatusBadRequest
+	case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
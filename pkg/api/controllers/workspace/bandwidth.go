@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectBandwidthLimit 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project bandwidth limit
+//	@Description	Get the limit the project agent enforces on the traffic it proxies from and to the tailnet, merged from the limits of the workspace and its target
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	BandwidthLimit
+//	@Router			/workspace/{workspaceId}/{projectId}/bandwidth-limit [get]
+//
+//	@id				GetProjectBandwidthLimit
+func GetProjectBandwidthLimit(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	limit, err := server.WorkspaceService.GetProjectBandwidthLimit(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get bandwidth limit of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, limit)
+}
+
+// SetWorkspaceBandwidthLimit 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Set workspace bandwidth limit
+//	@Description	Replace the limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the target in the directions it sets. Running agents pick up the limit within seconds
+//	@Produce		json
+//	@Param			workspaceId	path		string			true	"Workspace ID or Name"
+//	@Param			limit		body		BandwidthLimit	true	"Bandwidth limit"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/bandwidth-limit [put]
+//
+//	@id				SetWorkspaceBandwidthLimit
+func SetWorkspaceBandwidthLimit(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var req project.BandwidthLimit
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.SetWorkspaceBandwidthLimit(workspaceId, req)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to set bandwidth limit of workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// IssueProjectCertificate 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Issue project certificate
+//	@Description	Sign a certificate for the tailnet name of the project, which the project agent serves its TLS ports with. Requires the API key of the project. The names requested by the CSR are ignored
+//	@Produce		json
+//	@Param			workspaceId	path		string						true	"Workspace ID or Name"
+//	@Param			projectId	path		string						true	"Project ID"
+//	@Param			request		body		IssueProjectCertificateDTO	true	"Certificate request"
+//	@Success		200			{object}	ProjectCertificate
+//	@Router			/workspace/{workspaceId}/{projectId}/certificate [post]
+//
+//	@id				IssueProjectCertificate
+func IssueProjectCertificate(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	var req dto.IssueProjectCertificate
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	s := server.GetInstance(nil)
+
+	certificate, err := s.WorkspaceService.IssueProjectCertificate(workspaceId, projectId, req.Csr)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		case workspaces.IsProjectRouted(err), workspaces.IsCertificatesUnavailable(err):
+			statusCode = http.StatusConflict
+		case tailnetca.IsInvalidCertificateRequest(err):
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to issue certificate of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, dto.ProjectCertificate{
+		Certificate: certificate.CertPem,
+		ExpiresAt:   certificate.NotAfter,
+	})
+}
@@ -4,12 +4,20 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/imagepolicy"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/region"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/regions"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,7 +25,7 @@ import (
 //
 //	@Tags			workspace
 //	@Summary		Create a workspace
-//	@Description	Create a workspace
+//	@Description	Create a workspace. Workspaces of other regions are created by the server of the region
 //	@Param			workspace	body	CreateWorkspaceDTO	true	"Create workspace"
 //	@Produce		json
 //	@Success		200	{object}	Workspace
@@ -34,12 +42,61 @@ func CreateWorkspace(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	w, err := server.WorkspaceService.CreateWorkspace(ctx.Request.Context(), createWorkspaceReq)
+	if createWorkspaceReq.Region != nil && *createWorkspaceReq.Region != server.RegionService.GetLocalRegion() {
+		createInRegion(ctx, *createWorkspaceReq.Region, createWorkspaceReq)
+		return
+	}
+
+	w, err := server.WorkspaceService.CreateWorkspace(workspaces.WithCreatorName(ctx.Request.Context(), ctx.GetString("apiKeyName")), createWorkspaceReq)
 	if err != nil {
 		if workspaces.IsWorkspaceAlreadyExists(err) {
 			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("workspace already exists: %w", err))
 			return
 		}
+		if workspaces.IsInvalidWorkspaceTtl(err) || errors.Is(err, project.ErrInvalidMount) || errors.Is(err, project.ErrInvalidCommand) || errors.Is(err, project.ErrInvalidPort) || errors.Is(err, project.ErrInvalidResources) || sharedservices.IsTargetMismatch(err) || sharedservice.IsSharedServiceNotFound(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if errors.Is(err, project.ErrPortConflict) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if provider.IsTargetNotFound(err) {
+			ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if workspaces.IsTargetOvercommitted(err) {
+			ctx.AbortWithError(http.StatusConflict, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if workspaces.IsOrganizationQuotaExceeded(err) {
+			ctx.AbortWithError(http.StatusForbidden, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if imagepolicy.IsImageNotAllowed(err) || errors.Is(err, project.ErrBindMountNotAllowed) {
+			ctx.AbortWithError(http.StatusForbidden, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+func createInRegion(ctx *gin.Context, regionName string, createWorkspaceReq dto.CreateWorkspaceDTO) {
+	server := server.GetInstance(nil)
+
+	w, err := server.RegionService.CreateWorkspace(ctx.Request.Context(), regionName, createWorkspaceReq)
+	if err != nil {
+		if region.IsRegionNotFound(err) || regions.IsFederationDisabled(err) {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
+		if regions.IsRegionOffline(err) {
+			ctx.AbortWithError(http.StatusServiceUnavailable, fmt.Errorf("failed to create workspace: %w", err))
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %w", err))
 		return
 	}
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RecordProjectCreationTimings 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Record project creation timings
+//	@Description	Record the duration of creation phases measured by the project agent
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			projectId	path	string			true	"Project ID"
+//	@Param			timings		body	[]PhaseDuration	true	"Phase durations"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/creation-timings [post]
+//
+//	@id				RecordProjectCreationTimings
+func RecordProjectCreationTimings(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var durations []creationtiming.PhaseDuration
+	err := ctx.BindJSON(&durations)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RecordProjectCreationTimings(workspaceId, projectId, durations)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		case creationtimings.IsInvalidPhase(err):
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to record creation timings: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// DiffWorkspaces 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Diff workspaces
+//	@Description	Compare the images, environment variables, build configuration, repositories and commands of the projects of two workspaces
+//	@Produce		json
+//	@Param			workspaceId			path		string	true	"Workspace ID or Name"
+//	@Param			otherWorkspaceId	path		string	true	"ID or Name of the workspace to compare with"
+//	@Success		200					{object}	WorkspaceDiff
+//	@Router			/workspace/{workspaceId}/diff/{otherWorkspaceId} [get]
+//
+//	@id				DiffWorkspaces
+func DiffWorkspaces(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	otherWorkspaceId := ctx.Param("otherWorkspaceId")
+
+	server := server.GetInstance(nil)
+
+	diff, err := server.WorkspaceService.DiffWorkspaces(workspaceId, otherWorkspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to diff workspaces: %w", err))
+		return
+	}
+
+	ctx.JSON(200, diff)
+}
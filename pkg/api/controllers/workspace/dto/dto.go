@@ -4,10 +4,68 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type SetProjectState struct {
 	Uptime    uint64             `json:"uptime" validate:"required"`
 	GitStatus *project.GitStatus `json:"gitStatus,omitempty" validate:"optional"`
+	Version   string             `json:"version,omitempty" validate:"optional"`
+	// Seconds since the agent last observed terminal, IDE or file activity
+	IdleSeconds        uint64 `json:"idleSeconds,omitempty" validate:"optional"`
+	LastActivitySource string `json:"lastActivitySource,omitempty" validate:"optional"`
+	// Address of the project container. Only reported by agents of routed projects
+	Address string `json:"address,omitempty" validate:"optional"`
+	// Resources used by the project container
+	Usage *project.Resources `json:"usage,omitempty" validate:"optional"`
+	// Services the project exposes to other workspaces
+	Services []project.Service `json:"services,omitempty" validate:"optional"`
+	// Ports processes of the project listen on, except for the ports of the agent
+	DetectedPorts []uint16 `json:"detectedPorts,omitempty" validate:"optional"`
+	// Files of the environment definition that changed since the project container was built
+	EnvironmentChanges *project.EnvironmentChanges `json:"environmentChanges,omitempty" validate:"optional"`
+	// Set once the agent accepts SSH connections and joined the tailnet. Projects of older agents, which do not report
+	// it, are ready once they report their state
+	Ready *bool `json:"ready,omitempty" validate:"optional"`
+	// Time the key of the tailnet node of the agent expires, in RFC3339
+	NodeKeyExpiry string `json:"nodeKeyExpiry,omitempty" validate:"optional"`
 } // @name SetProjectState
+
+type UpdateAnnotations struct {
+	Set    map[string]string `json:"set,omitempty" validate:"optional"`
+	Remove []string          `json:"remove,omitempty" validate:"optional"`
+} // @name UpdateAnnotations
+
+type SetProjectHostname struct {
+	Hostname string `json:"hostname" validate:"required"`
+} // @name SetProjectHostname
+
+type VerifySshAccess struct {
+	// Token the SSH client authenticated with
+	Token string `json:"token" validate:"required"`
+} // @name VerifySshAccessDTO
+
+type SshHostKey struct {
+	// PEM encoded private SSH host key of the project
+	Key string `json:"key" validate:"required"`
+} // @name SshHostKeyDTO
+
+type AgentUpdate struct {
+	// Version the agent of the project should run
+	Version string `json:"version" validate:"required"`
+	// Hex encoded SHA-256 checksum of the binary of the version that was requested
+	Checksum string `json:"checksum" validate:"required"`
+} // @name AgentUpdate
+
+type IssueProjectCertificate struct {
+	// PEM encoded certificate signing request. Only its public key is used
+	Csr string `json:"csr" validate:"required"`
+} // @name IssueProjectCertificateDTO
+
+type ProjectCertificate struct {
+	// PEM encoded certificate for the tailnet name of the project
+	Certificate string    `json:"certificate" validate:"required"`
+	ExpiresAt   time.Time `json:"expiresAt" validate:"required"`
+} // @name ProjectCertificate
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/agentevents"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RecordProjectEvents 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Record project events
+//	@Description	Record lifecycle and network events published by the project agent, e.g. reconnects to the tailnet or ports opened by the project. Only the agent of the project can record its events, at most 100 at once
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			events		body	[]AgentEventReport	true	"Events"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/events [post]
+//
+//	@id				RecordProjectEvents
+func RecordProjectEvents(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	var reports []agentevent.Report
+	err := ctx.BindJSON(&reports)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RecordProjectEvents(workspaceId, projectId, reports)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		case agentevents.IsInvalidEventType(err), agentevents.IsTooManyEvents(err):
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to record project events: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// ListWorkspaceEvents 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List workspace events
+//	@Description	List the lifecycle and network events of the project agents of a workspace in the order they occurred
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			project		query	string	false	"Project name"
+//	@Param			type		query	string	false	"Only include events of the type, e.g. proxy-error"
+//	@Param			since		query	string	false	"Only include events that occurred within the duration, e.g. 24h"
+//	@Success		200			{array}	AgentEvent
+//	@Router			/workspace/{workspaceId}/events [get]
+//
+//	@id				ListWorkspaceEvents
+func ListWorkspaceEvents(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	filter := agentevent.Filter{}
+
+	if projectName := ctx.Query("project"); projectName != "" {
+		filter.ProjectName = &projectName
+	}
+
+	if typeQuery := ctx.Query("type"); typeQuery != "" {
+		eventType := agentevent.Type(typeQuery)
+		if !eventType.IsValid() {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid event type: %s", typeQuery))
+			return
+		}
+		filter.Type = &eventType
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.ParseDuration(sinceQuery)
+		if err != nil || since <= 0 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since duration: %s", sinceQuery))
+			return
+		}
+		filter.Since = util.Pointer(time.Now().Add(-since))
+	}
+
+	server := server.GetInstance(nil)
+
+	events, err := server.WorkspaceService.ListWorkspaceEvents(workspaceId, filter)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list workspace events: %w", err))
+		return
+	}
+
+	ctx.JSON(200, events)
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+var forwardUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// ForwardPort tunnels a WebSocket connection to a port of the project.
+// Clients use it to reach projects that are not connected to the tailnet.
+func ForwardPort(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	port, err := strconv.ParseUint(ctx.Param("port"), 10, 16)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid port: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find workspace: %w", err))
+		return
+	}
+
+	p, err := w.GetProject(projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find project: %w", err))
+		return
+	}
+
+	projectConn, err := server.DialProject(ctx.Request.Context(), p, uint16(port))
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadGateway, fmt.Errorf("failed to reach port %d of project %s: %w", port, p.Name, err))
+		return
+	}
+	defer projectConn.Close()
+
+	forwardWebsocket(ctx, projectConn)
+}
+
+// forwardWebsocket upgrades the request to a WebSocket and copies its messages to and from the project connection
+// until either side closes
+func forwardWebsocket(ctx *gin.Context, projectConn net.Conn) {
+	ws, err := forwardUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	clientConn := util.NewWebsocketNetConn(ws)
+	defer clientConn.Close()
+
+	done := make(chan struct{}, 2)
+
+	go func() {
+		_, _ = io.Copy(projectConn, clientConn)
+		done <- struct{}{}
+	}()
+
+	go func() {
+		_, _ = io.Copy(clientConn, projectConn)
+		done <- struct{}{}
+	}()
+
+	<-done
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardWebsocket(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/forward", func(ctx *gin.Context) {
+		// The project side echoes what it receives, as a port forwarded by a provider would
+		conn, projectConn := net.Pipe()
+		go func() {
+			defer projectConn.Close()
+			_, _ = io.Copy(projectConn, projectConn)
+		}()
+
+		forwardWebsocket(ctx, conn)
+	})
+
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/forward", nil)
+	require.NoError(t, err)
+
+	clientConn := util.NewWebsocketNetConn(ws)
+	defer clientConn.Close()
+
+	for _, message := range []string{"hello", "through the websocket"} {
+		_, err = clientConn.Write([]byte(message))
+		require.NoError(t, err)
+
+		received := make([]byte, len(message))
+		_, err = io.ReadFull(clientConn, received)
+		require.NoError(t, err)
+		require.Equal(t, message, string(received))
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectHealth 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project agent health
+//	@Description	Get the tailnet status, uptime, forwarded connections and process health reported by the project agent
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	AgentHealth
+//	@Router			/workspace/{workspaceId}/{projectId}/health [get]
+//
+//	@id				GetProjectHealth
+func GetProjectHealth(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find workspace: %w", err))
+		return
+	}
+
+	p, err := w.GetProject(projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find project: %w", err))
+		return
+	}
+
+	if p.Networking == project.NetworkingAgentless || p.Networking == project.NetworkingRouted {
+		ctx.AbortWithError(http.StatusConflict, fmt.Errorf("project %s uses %s networking and does not report agent health", p.Name, p.Networking))
+		return
+	}
+
+	health, err := server.GetProjectAgentHealth(ctx.Request.Context(), p)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadGateway, fmt.Errorf("failed to get health of project %s: %w", p.Name, err))
+		return
+	}
+
+	ctx.JSON(200, health)
+}
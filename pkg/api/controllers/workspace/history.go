@@ -0,0 +1,83 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/statehistory"
+	"github.com/gin-gonic/gin"
+)
+
+// GetWorkspaceStateHistory 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get workspace state history
+//	@Description	Get the recorded state snapshots of the projects of a workspace. Removed workspaces can be queried by name
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			at			query	string	false	"Return the last known state of each project at the RFC3339 time instead of every snapshot"
+//	@Param			since		query	string	false	"Only include snapshots recorded within the duration, e.g. 24h"
+//	@Param			project		query	string	false	"Project name"
+//	@Success		200			{array}	StateSnapshot
+//	@Router			/workspace/{workspaceId}/history [get]
+//
+//	@id				GetWorkspaceStateHistory
+func GetWorkspaceStateHistory(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var snapshots []*statehistory.Snapshot
+	var err error
+
+	server := server.GetInstance(nil)
+
+	if atQuery := ctx.Query("at"); atQuery != "" {
+		at, err := time.Parse(time.RFC3339, atQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid at time: %s", atQuery))
+			return
+		}
+
+		snapshots, err = server.StateHistoryService.GetStateAt(workspaceId, at)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get workspace state: %w", err))
+			return
+		}
+	} else {
+		filter := &statehistory.Filter{
+			Workspace: &workspaceId,
+		}
+
+		if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+			since, err := time.ParseDuration(sinceQuery)
+			if err != nil || since <= 0 {
+				ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since duration: %s", sinceQuery))
+				return
+			}
+			filter.Since = util.Pointer(time.Now().Add(-since))
+		}
+
+		snapshots, err = server.StateHistoryService.List(filter)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get workspace state history: %w", err))
+			return
+		}
+	}
+
+	if projectName := ctx.Query("project"); projectName != "" {
+		filtered := []*statehistory.Snapshot{}
+		for _, s := range snapshots {
+			if s.ProjectName == projectName {
+				filtered = append(filtered, s)
+			}
+		}
+		snapshots = filtered
+	}
+
+	ctx.JSON(200, snapshots)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// SetProjectHostname 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Set project hostname
+//	@Description	Rename the project agent on the tailnet. Running agents are renamed in the control plane right away. Hostnames used by other nodes of the network or reserved for the server and CLI clients are refused
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			projectId	path		string				true	"Project ID"
+//	@Param			hostname	body		SetProjectHostname	true	"Hostname"
+//	@Success		200			{object}	Workspace
+//	@Router			/workspace/{workspaceId}/{projectId}/hostname [put]
+//
+//	@id				SetProjectHostname
+func SetProjectHostname(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.SetProjectHostname
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.SetProjectHostname(workspaceId, projectId, req.Hostname)
+	if err != nil {
+		ctx.AbortWithError(getHostnameErrorStatus(err), fmt.Errorf("failed to set hostname of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
+
+func getHostnameErrorStatus(err error) int {
+	switch {
+	case workspaces.IsInvalidHostname(err), workspaces.IsHostnameReserved(err):
+		return http.StatusBadRequest
+	case workspaces.IsHostnameTaken(err), workspaces.IsProjectRouted(err):
+		return http.StatusConflict
+	case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
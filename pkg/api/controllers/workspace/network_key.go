@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RenewProjectNetworkKey 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Renew project network key
+//	@Description	Issue a network key for the project agent to register its tailnet node, initially or again, e.g. before the node key expires or after switching between a persistent and an ephemeral node. Requires the API key of the project. The current node of the project is removed once the new node registered so that the new node keeps its hostname
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			persistent	query		bool	false	"Register the node as a persistent node that stays registered while it is offline. Only server administrators can register persistent nodes unless the server config allows them"
+//	@Success		200			{object}	NetworkKey
+//	@Router			/workspace/{workspaceId}/{projectId}/network-key [post]
+//
+//	@id				RenewProjectNetworkKey
+func RenewProjectNetworkKey(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	persistent := false
+	if persistentQuery := ctx.Query("persistent"); persistentQuery != "" {
+		var err error
+		persistent, err = strconv.ParseBool(persistentQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for persistent flag"))
+			return
+		}
+	}
+
+	if persistent {
+		err := server.CheckPersistentNodes(ctx.GetBool("serverAdmin"))
+		if err != nil {
+			statusCode := http.StatusInternalServerError
+			if server.IsPersistentNodesNotAllowed(err) {
+				statusCode = http.StatusForbidden
+			}
+			ctx.AbortWithError(statusCode, fmt.Errorf("failed to renew network key of project %s: %w", projectId, err))
+			return
+		}
+	}
+
+	s := server.GetInstance(nil)
+
+	key, err := s.WorkspaceService.RenewProjectNetworkKey(workspaceId, projectId, persistent)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		case workspaces.IsProjectRouted(err), workspaces.IsNetworkKeysUnavailable(err):
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to renew network key of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, &server.NetworkKey{Key: key.Key, ExpiresAt: &key.ExpiresAt})
+}
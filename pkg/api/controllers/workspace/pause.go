@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// PauseWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Pause workspace
+//	@Description	Checkpoint the running processes of the workspace projects and stop them. Projects are resumed from the checkpoint on start. Projects whose provider can't pause them or that fail to checkpoint are stopped
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/pause [post]
+//
+//	@id				PauseWorkspace
+func PauseWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.PauseWorkspace(ctx.Request.Context(), workspaceId)
+	if err != nil {
+		ctx.AbortWithError(getPauseErrorStatusCode(err), fmt.Errorf("failed to pause workspace %s: %w", workspaceId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// PauseProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Pause project
+//	@Description	Checkpoint the running processes of the project and stop it. The project is resumed from the checkpoint on start. The project is stopped if its provider can't pause it or checkpointing it fails
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/pause [post]
+//
+//	@id				PauseProject
+func PauseProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.PauseProject(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(getPauseErrorStatusCode(err), fmt.Errorf("failed to pause project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+func getPauseErrorStatusCode(err error) int {
+	if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+		return http.StatusNotFound
+	}
+
+	if workspaces.IsOperationInProgress(err) {
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
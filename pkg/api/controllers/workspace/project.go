@@ -4,6 +4,7 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -37,14 +38,59 @@ func SetProjectState(ctx *gin.Context) {
 		return
 	}
 
+	for _, service := range setProjectStateDTO.Services {
+		err = service.Validate()
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
+	}
+
 	server := server.GetInstance(nil)
 
-	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, &project.ProjectState{
-		Uptime:    setProjectStateDTO.Uptime,
-		UpdatedAt: time.Now().Format(time.RFC1123),
-		GitStatus: setProjectStateDTO.GitStatus,
-	})
+	now := time.Now()
+	state := &project.ProjectState{
+		Uptime:       setProjectStateDTO.Uptime,
+		UpdatedAt:    now.Format(time.RFC1123),
+		GitStatus:    setProjectStateDTO.GitStatus,
+		AgentVersion: setProjectStateDTO.Version,
+		Address:      setProjectStateDTO.Address,
+		Usage:        setProjectStateDTO.Usage,
+		Services:     setProjectStateDTO.Services,
+	}
+
+	if !setProjectStateDTO.EnvironmentChanges.IsEmpty() {
+		state.EnvironmentChanges = setProjectStateDTO.EnvironmentChanges
+	}
+
+	if len(setProjectStateDTO.DetectedPorts) > 0 {
+		state.DetectedPorts = project.NewDetectedPorts(setProjectStateDTO.DetectedPorts, now)
+	}
+
+	if server.TunnelService != nil {
+		state.Tunneled = server.TunnelService.IsConnected(workspaceId, projectId)
+	}
+
+	// Agents that fell back to the tunnel are reached through the server instead of the tailnet
+	state.Ready = setProjectStateDTO.Ready == nil || *setProjectStateDTO.Ready || state.Tunneled
+
+	// The agent reports a duration so clock skew between the project and the server does not matter
+	if setProjectStateDTO.LastActivitySource != "" {
+		state.LastActivityAt = now.Add(-time.Duration(setProjectStateDTO.IdleSeconds) * time.Second).Format(time.RFC3339)
+		state.LastActivitySource = setProjectStateDTO.LastActivitySource
+	}
+
+	// The expiry is set by the control server, so unlike the activity it does not depend on the clock of the project
+	if _, err := time.Parse(time.RFC3339, setProjectStateDTO.NodeKeyExpiry); err == nil {
+		state.NodeKeyExpiry = setProjectStateDTO.NodeKeyExpiry
+	}
+
+	_, err = server.WorkspaceService.SetProjectState(workspaceId, projectId, state)
 	if err != nil {
+		if errors.Is(err, project.ErrInvalidRouteAddress) {
+			ctx.AbortWithError(http.StatusBadRequest, err)
+			return
+		}
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
 	}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/gin-gonic/gin"
+)
+
+var errNotProjectApiKey = errors.New("the API key does not belong to the project")
+
+// isProjectApiKey reports whether the request is authenticated with the API key of the project. Project API keys are
+// named <workspaceId>/<projectName>, so agents identify their workspace by ID
+func isProjectApiKey(ctx *gin.Context, workspaceId, projectName string) bool {
+	apiKeyType, _ := ctx.Get("apiKeyType")
+	return apiKeyType == apikey.ApiKeyTypeProject && ctx.GetString("apiKeyName") == fmt.Sprintf("%s/%s", workspaceId, projectName)
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPublicPort 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Register public port
+//	@Description	Publish a port of the project forwarded to its public URL. Creates the preview DNS record of the port if records are managed per workspace and serves the URL over HTTPS once the preview certificate is issued
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			port		path	integer	true	"Port"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/public-port/{port} [post]
+//
+//	@id				RegisterPublicPort
+func RegisterPublicPort(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	port, err := strconv.ParseUint(ctx.Param("port"), 10, 16)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid port: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RegisterPublicPort(ctx.Request.Context(), workspaceId, projectId, uint16(port))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to register public port %d of project %s: %w", port, projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
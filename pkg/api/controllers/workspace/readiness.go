@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	ws "github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultReadyWaitTimeout = 30 * time.Second
+	maxReadyWaitTimeout     = 5 * time.Minute
+)
+
+// WaitForWorkspaceReady 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Wait for workspace readiness
+//	@Description	Wait until the project, or every project of the workspace, accepts SSH connections. Returns the readiness once the projects are ready or the timeout elapses
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			project		query		string	false	"Only wait for the project"
+//	@Param			timeout		query		string	false	"Maximum time to wait, e.g. 1m. Defaults to 30s and is capped at 5m"
+//	@Success		200			{object}	WorkspaceReadiness
+//	@Router			/workspace/{workspaceId}/ready [get]
+//
+//	@id				WaitForWorkspaceReady
+func WaitForWorkspaceReady(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectName := ctx.Query("project")
+
+	timeout := defaultReadyWaitTimeout
+	if timeoutQuery := ctx.Query("timeout"); timeoutQuery != "" {
+		var err error
+		timeout, err = time.ParseDuration(timeoutQuery)
+		if err != nil || timeout < 0 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid timeout: %s", timeoutQuery))
+			return
+		}
+		timeout = min(timeout, maxReadyWaitTimeout)
+	}
+
+	server := server.GetInstance(nil)
+
+	waitCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+	defer cancel()
+
+	readiness, err := server.WorkspaceService.WaitForWorkspaceReady(waitCtx, workspaceId, projectName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to wait for workspace readiness: %w", err))
+		return
+	}
+
+	ctx.JSON(200, readiness)
+}
+
+// RegisterReadyHook 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Register ready hook
+//	@Description	Register a callback the readiness of the workspace is posted to once its projects accept SSH connections. The url must be of a public host, redirects are not followed
+//	@Param			workspaceId	path	string		true	"Workspace ID or Name"
+//	@Param			hook		body	ReadyHook	true	"Ready hook"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/ready-hooks [post]
+//
+//	@id				RegisterReadyHook
+func RegisterReadyHook(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var hook ws.ReadyHook
+	err := ctx.BindJSON(&hook)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RegisterReadyHook(workspaceId, hook)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsInvalidReadyHookUrl(err) {
+			statusCode = http.StatusBadRequest
+		} else if workspaces.IsTooManyReadyHooks(err) {
+			statusCode = http.StatusTooManyRequests
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to register ready hook: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RebuildProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Rebuild project
+//	@Description	Recreate the project container from its current devcontainer configuration, keeping the project volume
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/rebuild [post]
+//
+//	@id				RebuildProject
+func RebuildProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RebuildProject(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		if workspaces.IsAdoptedWorkspaceNotManaged(err) || workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to rebuild project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
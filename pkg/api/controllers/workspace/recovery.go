@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// StartProjectRecovery 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Start project recovery
+//	@Description	Start a recovery container that shares the project directory and serves SSH on the project recovery hostname
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/recovery [post]
+//
+//	@id				StartProjectRecovery
+func StartProjectRecovery(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.StartProjectRecovery(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(getRecoveryErrorStatusCode(err), fmt.Errorf("failed to start recovery of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// StopProjectRecovery 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Stop project recovery
+//	@Description	Remove the recovery container of the project
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/recovery [delete]
+//
+//	@id				StopProjectRecovery
+func StopProjectRecovery(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.StopProjectRecovery(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		ctx.AbortWithError(getRecoveryErrorStatusCode(err), fmt.Errorf("failed to stop recovery of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+func getRecoveryErrorStatusCode(err error) int {
+	if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+		return http.StatusNotFound
+	}
+
+	if workspaces.IsRecoveryNotSupported(err) {
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
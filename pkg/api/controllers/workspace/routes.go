@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectRoutes 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project routes
+//	@Description	Get the routing table of the tailnet node of the project. Each entry forwards a range of tailnet ports to the container of a project that shares the node
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200			{array}	RoutingEntry
+//	@Router			/workspace/{workspaceId}/{projectId}/routes [get]
+//
+//	@id				GetProjectRoutes
+func GetProjectRoutes(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	routes, err := server.WorkspaceService.GetProjectRoutes(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get routes of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, routes)
+}
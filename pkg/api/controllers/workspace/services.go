@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/gin-gonic/gin"
+)
+
+// ListServiceEndpoints 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List service endpoints
+//	@Description	List the services project agents registered for other workspaces of the organization to resolve by name
+//	@Produce		json
+//	@Param			workspaceId	query	string	false	"Only list the services of the workspace"
+//	@Success		200			{array}	ServiceEndpoint
+//	@Router			/service-discovery [get]
+//
+//	@id				ListServiceEndpoints
+func ListServiceEndpoints(ctx *gin.Context) {
+	workspaceId := ctx.Query("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	requestCtx, _, err := getServiceDiscoveryCaller(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list service endpoints: %w", err))
+		return
+	}
+
+	endpoints, err := server.WorkspaceService.ListServiceEndpoints(requestCtx, workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list service endpoints: %w", err))
+		return
+	}
+
+	ctx.JSON(200, endpoints)
+}
+
+// ResolveService 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Resolve a service
+//	@Description	Resolve the name of a service, e.g. api-proj.daytona.internal, to its tailnet address. Only services of the organization of the caller are resolved. Names that are not qualified with a workspace are resolved in the workspace of the caller first
+//	@Produce		json
+//	@Param			name		path		string	true	"Service name"
+//	@Param			workspaceId	query		string	false	"Workspace ID or Name of the caller. Agents always resolve names in their own workspace first"
+//	@Success		200			{object}	ServiceEndpoint
+//	@Router			/service-discovery/{name} [get]
+//
+//	@id				ResolveService
+func ResolveService(ctx *gin.Context) {
+	name := ctx.Param("name")
+
+	server := server.GetInstance(nil)
+
+	requestCtx, workspaceId, err := getServiceDiscoveryCaller(ctx)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to resolve service %s: %w", name, err))
+		return
+	}
+
+	endpoint, err := server.WorkspaceService.ResolveService(requestCtx, name, workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, project.ErrServiceNotFound) || workspaces.IsWorkspaceNotFound(err):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, project.ErrAmbiguousService):
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to resolve service %s: %w", name, err))
+		return
+	}
+
+	ctx.JSON(200, endpoint)
+}
+
+// getServiceDiscoveryCaller returns the request context scoped to the organization of the caller and the workspace
+// of the caller. Agents are scoped to the organization of the workspace their API key belongs to, and the workspace
+// of clients is taken from the workspaceId query param
+func getServiceDiscoveryCaller(ctx *gin.Context) (context.Context, string, error) {
+	apiKeyType, _ := ctx.Get("apiKeyType")
+	if apiKeyType != apikey.ApiKeyTypeWorkspace && apiKeyType != apikey.ApiKeyTypeProject {
+		return ctx.Request.Context(), ctx.Query("workspaceId"), nil
+	}
+
+	// Project API keys are named <workspaceId>/<projectName>
+	workspaceId, _, _ := strings.Cut(ctx.GetString("apiKeyName"), "/")
+
+	w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return organization.WithOrganizationId(ctx.Request.Context(), w.OrganizationId), w.Id, nil
+}
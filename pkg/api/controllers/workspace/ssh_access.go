@@ -0,0 +1,133 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateSshToken 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Create SSH token
+//	@Description	Issue a short-lived token to authenticate with as the SSH password on the tailnet node of the project
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	SshTokenDTO
+//	@Router			/workspace/{workspaceId}/{projectId}/ssh-token [post]
+//
+//	@id				CreateSshToken
+func CreateSshToken(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	token, err := server.WorkspaceService.CreateSshToken(ctx.Request.Context(), workspaceId, projectId, ctx.GetString("apiKeyName"), ctx.GetBool("serverAdmin"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsSshAccessDenied(err):
+			statusCode = http.StatusForbidden
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to create ssh token for project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, token)
+}
+
+// VerifySshAccess 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Verify SSH access
+//	@Description	Verify the SSH token a client presents to the project agent on the tailnet. Only called by the agent of the project
+//	@Accept			json
+//	@Param			workspaceId		path	string				true	"Workspace ID or Name"
+//	@Param			projectId		path	string				true	"Project ID"
+//	@Param			verifySshAccess	body	VerifySshAccessDTO	true	"Verify SSH access"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/ssh-access [post]
+//
+//	@id				VerifySshAccess
+func VerifySshAccess(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.VerifySshAccess
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.VerifySshAccess(workspaceId, projectId, req.Token)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case workspaces.IsSshAccessDenied(err):
+			statusCode = http.StatusForbidden
+		case workspaces.IsWorkspaceNotFound(err), workspaces.IsProjectNotFound(err):
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to verify ssh access to project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// GetProjectSshHostKey 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get project SSH host key
+//	@Description	Get the private SSH host key the project agent serves SSH with, so that clients can verify the project with its public key. Only returned to the agent of the project
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	SshHostKeyDTO
+//	@Router			/workspace/{workspaceId}/{projectId}/ssh-host-key [get]
+//
+//	@id				GetProjectSshHostKey
+func GetProjectSshHostKey(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	key, err := server.WorkspaceService.GetProjectSshHostKey(workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || workspaces.IsSshHostKeyNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get ssh host key of project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.JSON(200, dto.SshHostKey{
+		Key: key,
+	})
+}
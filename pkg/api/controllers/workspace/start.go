@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,7 +29,11 @@ func StartWorkspace(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StartWorkspace(ctx.Request.Context(), workspaceId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start workspace %s: %w", workspaceId, err))
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to start workspace %s: %w", workspaceId, err))
 		return
 	}
 
@@ -54,7 +59,11 @@ func StartProject(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StartProject(ctx.Request.Context(), workspaceId, projectId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to start project %s: %w", projectId, err))
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to start project %s: %w", projectId, err))
 		return
 	}
 
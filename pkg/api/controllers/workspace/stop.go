@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,7 +29,11 @@ func StopWorkspace(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StopWorkspace(ctx.Request.Context(), workspaceId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to stop workspace %s: %w", workspaceId, err))
 		return
 	}
 
@@ -54,7 +59,48 @@ func StopProject(ctx *gin.Context) {
 
 	err := server.WorkspaceService.StopProject(ctx.Request.Context(), workspaceId, projectId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop project %s: %w", projectId, err))
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to stop project %s: %w", projectId, err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// StopIdleWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Stop idle workspace
+//	@Description	Stop the workspace if no project of it reported activity within the idle timeout of the server. Only the agent of the project can ask to stop its workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/idle-stop [post]
+//
+//	@id				StopIdleWorkspace
+func StopIdleWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.StopIdleWorkspace(ctx.Request.Context(), workspaceId, projectId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsWorkspaceNotIdle(err) || workspaces.IsAdoptedWorkspaceNotManaged(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to stop idle workspace %s: %w", workspaceId, err))
 		return
 	}
 
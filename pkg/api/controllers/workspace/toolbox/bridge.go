@@ -0,0 +1,130 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	log "github.com/sirupsen/logrus"
+)
+
+var bridgeUpgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// Bridge relays clipboard content and file drops between a browser IDE or terminal session and the project,
+// applying the browser bridge policy of the server config to every message
+func Bridge(ctx *gin.Context) {
+	c, err := server.GetConfig()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	policy, err := server.GetBrowserBridgePolicy(c.BrowserBridge)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("invalid browser bridge config: %w", err))
+		return
+	}
+
+	if policy.Disabled() {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("the browser bridge is disabled"))
+		return
+	}
+
+	dialToolbox, ok := getToolboxDialer(ctx)
+	if !ok {
+		return
+	}
+
+	dialer := websocket.Dialer{
+		NetDialContext:   dialToolbox,
+		HandshakeTimeout: 10 * time.Second,
+	}
+
+	projectWs, _, err := dialer.DialContext(ctx.Request.Context(), "ws://"+toolboxHost+"/bridge", nil)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadGateway, errors.Join(errors.New("failed to reach project toolbox"), err))
+		return
+	}
+	defer projectWs.Close()
+
+	browserWs, err := bridgeUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	defer browserWs.Close()
+
+	browserWs.SetReadLimit(int64(base64.StdEncoding.EncodedLen(max(policy.MaxFileDropSize, policy.MaxClipboardSize)) + 64*1024))
+
+	// Policy errors and relayed messages are both written to the browser
+	var browserWriteMutex sync.Mutex
+	writeToBrowser := func(msg dto.BridgeMessage) error {
+		browserWriteMutex.Lock()
+		defer browserWriteMutex.Unlock()
+		return browserWs.WriteJSON(msg)
+	}
+
+	errChan := make(chan error, 2)
+
+	go func() {
+		for {
+			var msg dto.BridgeMessage
+			err := browserWs.ReadJSON(&msg)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			err = policy.CheckToWorkspace(msg)
+			if err != nil {
+				err = writeToBrowser(dto.BridgeMessage{Type: dto.BridgeMessageTypeError, Name: msg.Name, Error: err.Error()})
+			} else {
+				err = projectWs.WriteJSON(msg)
+			}
+			if err != nil {
+				errChan <- err
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			var msg dto.BridgeMessage
+			err := projectWs.ReadJSON(&msg)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			if !policy.AllowFromWorkspace(msg) {
+				continue
+			}
+
+			err = writeToBrowser(msg)
+			if err != nil {
+				errChan <- err
+				return
+			}
+		}
+	}()
+
+	err = <-errChan
+	if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+		log.Debugf("browser bridge closed: %v", err)
+	}
+}
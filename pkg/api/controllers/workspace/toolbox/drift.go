@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// GetProjectDrift godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Get project drift
+//	@Description	Compare the running project with the current devcontainer configuration in the project
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	ProjectDrift
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/drift [get]
+//
+//	@id				GetProjectDrift
+func GetProjectDrift(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/drift")
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListFiles godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		List files
+//	@Description	List files in a project directory
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		query		string	false	"Directory path. Relative paths are resolved against the project directory"
+//	@Param			offset		query		int		false	"Pagination offset"
+//	@Param			limit		query		int		false	"Page size"
+//	@Success		200			{object}	FileList
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files [get]
+//
+//	@id				ListFiles
+func ListFiles(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/")
+}
+
+// PreviewFile godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Preview file
+//	@Description	Get the content of a text or image file. Files over 1MB are rejected. HTML and SVG files are returned as plain text
+//	@Produce		plain
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		query		string	true	"File path"
+//	@Success		200			{string}	string
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files/preview [get]
+//
+//	@id				PreviewFile
+func PreviewFile(ctx *gin.Context) {
+	proxyToToolboxWithResponse(ctx, "/files/preview", secureFilePreview)
+}
+
+// secureFilePreview keeps previews of workspace files from running scripts in the dashboard. The toolbox already serves
+// them this way, but it runs in the workspace and is not trusted to
+func secureFilePreview(res *http.Response) error {
+	if res.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	mediaType, _, _ := strings.Cut(res.Header.Get("Content-Type"), ";")
+	if !strings.HasPrefix(mediaType, "image/") || mediaType == "image/svg+xml" {
+		res.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	}
+
+	res.Header.Set("X-Content-Type-Options", "nosniff")
+	res.Header.Set("Content-Security-Policy", "sandbox")
+	return nil
+}
+
+// DownloadFile godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Download file
+//	@Description	Download file
+//	@Produce		octet-stream
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		query		string	true	"File path"
+//	@Success		200			{file}		binary
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files/download [get]
+//
+//	@id				DownloadFile
+func DownloadFile(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/download")
+}
+
+// UploadFile godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Upload file
+//	@Description	Upload a file. Uploading to a directory path keeps the original file name. Files over the upload limit of the project agent, 100MB unless DAYTONA_AGENT_MAX_UPLOAD_SIZE is set, are rejected
+//	@Accept			multipart/form-data
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Param			path		query		string	true	"Destination path"
+//	@Param			file		formData	file	true	"File"
+//	@Success		201			{object}	FileInfo
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files/upload [post]
+//
+//	@id				UploadFile
+func UploadFile(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/upload")
+}
+
+// CreateFolder godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Create folder
+//	@Description	Create folder
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			path		query	string	true	"Folder path"
+//	@Success		201
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files/folder [post]
+//
+//	@id				CreateFolder
+func CreateFolder(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/folder")
+}
+
+// MoveFile godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Move file
+//	@Description	Move or rename a file or folder
+//	@Accept			json
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			projectId	path	string			true	"Project ID"
+//	@Param			moveFile	body	MoveFileRequest	true	"Move file request"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files/move [post]
+//
+//	@id				MoveFile
+func MoveFile(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/move")
+}
+
+// DeleteFile godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Delete file
+//	@Description	Delete a file or folder
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			path		query	string	true	"File or folder path"
+//	@Success		204
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/files [delete]
+//
+//	@id				DeleteFile
+func DeleteFile(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/files/")
+}
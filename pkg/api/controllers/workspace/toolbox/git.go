@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateProjectBranch godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		Update project branch
+//	@Description	Fast-forward or rebase the checked out branch of the project onto its upstream branch. A rebase that conflicts is aborted and the conflicting files are returned
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceId	path		string				true	"Workspace ID or Name"
+//	@Param			projectId	path		string				true	"Project ID"
+//	@Param			update		body		UpdateBranchRequest	true	"Update strategy"
+//	@Success		200			{object}	BranchUpdate
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/git/update [post]
+//
+//	@id				UpdateProjectBranch
+func UpdateProjectBranch(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/git/update")
+}
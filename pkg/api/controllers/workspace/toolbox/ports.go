@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// ListPorts godoc
+//
+//	@Tags			workspace toolbox
+//	@Summary		List ports
+//	@Description	List TCP ports the project is listening on
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	PortList
+//	@Router			/workspace/{workspaceId}/{projectId}/toolbox/ports [get]
+//
+//	@id				ListPorts
+func ListPorts(ctx *gin.Context) {
+	proxyToToolbox(ctx, "/ports")
+}
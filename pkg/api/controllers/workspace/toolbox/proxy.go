@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package toolbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// Host of toolbox requests. Connections are opened by the toolbox dialer regardless of the address
+const toolboxHost = "toolbox"
+
+// proxyToToolbox forwards the request to the project agent toolbox
+func proxyToToolbox(ctx *gin.Context, toolboxPath string) {
+	proxyToToolboxWithResponse(ctx, toolboxPath, nil)
+}
+
+// proxyToToolboxWithResponse forwards the request to the project agent toolbox and lets modifyResponse change the
+// response of the toolbox before it is returned
+func proxyToToolboxWithResponse(ctx *gin.Context, toolboxPath string, modifyResponse func(*http.Response) error) {
+	dialToolbox, ok := getToolboxDialer(ctx)
+	if !ok {
+		return
+	}
+
+	target, err := url.Parse("http://" + toolboxHost)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to parse toolbox url: %w", err))
+		return
+	}
+
+	reverseProxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.Out.URL.Path = toolboxPath
+			r.Out.URL.RawPath = ""
+			r.Out.Header.Del("Authorization")
+			r.Out.Header.Set(correlation.CORRELATION_ID_HEADER, correlation.GetId(r.In.Context()))
+		},
+		Transport: &http.Transport{
+			DialContext: dialToolbox,
+		},
+		ModifyResponse: modifyResponse,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			ctx.AbortWithError(http.StatusBadGateway, errors.Join(errors.New("failed to reach project toolbox"), err))
+		},
+	}
+
+	reverseProxy.ServeHTTP(ctx.Writer, ctx.Request)
+}
+
+// getToolboxDialer returns a dial function that connects to the project toolbox over the tailnet or through the
+// provider of the project. It aborts the request and returns false if the project does not exist
+func getToolboxDialer(ctx *gin.Context) (func(context.Context, string, string) (net.Conn, error), bool) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.GetWorkspace(ctx.Request.Context(), workspaceId, false)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to find workspace: %w", err))
+		return nil, false
+	}
+
+	p, err := w.GetProject(projectId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("failed to find project: %w", err))
+		return nil, false
+	}
+
+	return func(dialCtx context.Context, network, addr string) (net.Conn, error) {
+		return server.DialProject(dialCtx, p, config.TOOLBOX_PORT)
+	}, true
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// ServeProjectTunnel accepts the WebSocket tunnel of a project agent that can't reach the tailnet.
+// Connections of the server to ports of the project are multiplexed over it until the agent disconnects. Only the
+// agent of the project can open its tunnel.
+func ServeProjectTunnel(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	if !isProjectApiKey(ctx, workspaceId, projectId) {
+		ctx.AbortWithError(http.StatusForbidden, errNotProjectApiKey)
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	if server.TunnelService == nil {
+		ctx.AbortWithError(http.StatusNotImplemented, errors.New("project tunnels are not enabled"))
+		return
+	}
+
+	ws, err := forwardUpgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	err = server.TunnelService.Serve(workspaceId, projectId, util.NewWebsocketNetConn(ws))
+	if err != nil {
+		log.Error(fmt.Errorf("failed to serve tunnel of project %s: %w", projectId, err))
+	}
+}
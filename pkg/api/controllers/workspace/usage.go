@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RecordProjectUsage 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Record project resource usage
+//	@Description	Record the CPU, memory, disk and inode usage sampled by the project agent
+//	@Param			workspaceId	path	string					true	"Workspace ID or Name"
+//	@Param			projectId	path	string					true	"Project ID"
+//	@Param			usage		body	[]ResourceUsageReport	true	"Usage samples"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/usage [post]
+//
+//	@id				RecordProjectUsage
+func RecordProjectUsage(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var reports []resourceusage.Report
+	err := ctx.BindJSON(&reports)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RecordProjectUsage(workspaceId, projectId, reports)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to record project usage: %w", err))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// ListWorkspaceUsage 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List workspace resource usage
+//	@Description	List the resource usage samples of the projects of a workspace in the order they were taken
+//	@Produce		json
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			project		query	string	false	"Project name"
+//	@Param			since		query	string	false	"Only include samples taken within the duration, e.g. 1h"
+//	@Success		200			{array}	ResourceUsageSample
+//	@Router			/workspace/{workspaceId}/usage [get]
+//
+//	@id				ListWorkspaceUsage
+func ListWorkspaceUsage(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	filter := resourceusage.Filter{}
+
+	if projectName := ctx.Query("project"); projectName != "" {
+		filter.ProjectName = &projectName
+	}
+
+	if sinceQuery := ctx.Query("since"); sinceQuery != "" {
+		since, err := time.ParseDuration(sinceQuery)
+		if err != nil || since <= 0 {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid since duration: %s", sinceQuery))
+			return
+		}
+		filter.Since = util.Pointer(time.Now().Add(-since))
+	}
+
+	server := server.GetInstance(nil)
+
+	samples, err := server.WorkspaceService.ListWorkspaceUsage(workspaceId, filter)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list workspace usage: %w", err))
+		return
+	}
+
+	ctx.JSON(200, samples)
+}
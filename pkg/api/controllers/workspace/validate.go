@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ValidateCreateWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Validate a workspace creation request
+//	@Description	Check that the workspace can be created without provisioning any resources. Repositories, branches, devcontainer configurations and images are checked and the creation time is estimated
+//	@Param			workspace	body	CreateWorkspaceDTO	true	"Create workspace"
+//	@Produce		json
+//	@Success		200	{object}	CreationValidation
+//	@Router			/workspace/validate [post]
+//
+//	@id				ValidateCreateWorkspace
+func ValidateCreateWorkspace(ctx *gin.Context) {
+	var createWorkspaceReq dto.CreateWorkspaceDTO
+	err := ctx.BindJSON(&createWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	if createWorkspaceReq.Region != nil && *createWorkspaceReq.Region != server.RegionService.GetLocalRegion() {
+		ctx.AbortWithError(http.StatusBadRequest, errors.New("workspaces can only be validated by the server of their region"))
+		return
+	}
+
+	validation, err := server.WorkspaceService.ValidateCreateWorkspace(workspaces.WithCreatorName(ctx.Request.Context(), ctx.GetString("apiKeyName")), createWorkspaceReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to validate workspace: %w", err))
+		return
+	}
+
+	ctx.JSON(200, validation)
+}
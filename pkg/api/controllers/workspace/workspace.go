@@ -7,9 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/gin-gonic/gin"
 )
 
@@ -54,7 +58,7 @@ func GetWorkspace(ctx *gin.Context) {
 //
 //	@Tags			workspace
 //	@Summary		List workspaces
-//	@Description	List workspaces
+//	@Description	List workspaces, including the workspaces of regional servers if the server is the primary server of a federation
 //	@Produce		json
 //	@Success		200	{array}	WorkspaceDTO
 //	@Router			/workspace [get]
@@ -82,6 +86,24 @@ func ListWorkspaces(ctx *gin.Context) {
 		return
 	}
 
+	if localRegion := server.RegionService.GetLocalRegion(); localRegion != "" {
+		for i := range workspaceList {
+			workspaceList[i].Region = localRegion
+		}
+
+		regionalWorkspaces, err := server.RegionService.ListWorkspaces(ctx.Request.Context(), verbose)
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list workspaces of regions: %w", err))
+			return
+		}
+		workspaceList = append(workspaceList, regionalWorkspaces...)
+	}
+
+	organizationId := organization.GetOrganizationId(ctx.Request.Context())
+	workspaceList = slices.DeleteFunc(workspaceList, func(w dto.WorkspaceDTO) bool {
+		return w.OrganizationId != organizationId
+	})
+
 	ctx.JSON(200, workspaceList)
 }
 
@@ -119,7 +141,11 @@ func RemoveWorkspace(ctx *gin.Context) {
 	}
 
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove workspace: %w", err))
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsOperationInProgress(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove workspace: %w", err))
 		return
 	}
 
@@ -15,6 +15,95 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/announcement": {
+            "get": {
+                "description": "List the server announcements of the caller, such as maintenance windows, deprecations and quota warnings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "announcement"
+                ],
+                "summary": "List announcements",
+                "operationId": "ListAnnouncements",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include expired announcements",
+                        "name": "all",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Announcement"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create an announcement that is shown once by every CLI connected to the server. Only server administrators can create announcements",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "announcement"
+                ],
+                "summary": "Create an announcement",
+                "operationId": "CreateAnnouncement",
+                "parameters": [
+                    {
+                        "description": "Create announcement",
+                        "name": "announcement",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateAnnouncementDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Announcement"
+                        }
+                    }
+                }
+            }
+        },
+        "/announcement/{announcementId}": {
+            "delete": {
+                "description": "Delete an announcement. Only server administrators can delete announcements",
+                "tags": [
+                    "announcement"
+                ],
+                "summary": "Delete an announcement",
+                "operationId": "DeleteAnnouncement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Announcement ID",
+                        "name": "announcementId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/apikey": {
             "get": {
                 "description": "List API keys",
@@ -41,7 +130,7 @@ const docTemplate = `{
         },
         "/apikey/{apiKeyName}": {
             "post": {
-                "description": "Generate an API key",
+                "description": "Generate an API key. Fails with a conflict if a key with the name already exists",
                 "produces": [
                     "text/plain"
                 ],
@@ -57,6 +146,12 @@ const docTemplate = `{
                         "name": "apiKeyName",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Generate a key that can manage server-wide resources. Only administrators can generate admin keys",
+                        "name": "admin",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -91,6 +186,126 @@ const docTemplate = `{
                 }
             }
         },
+        "/artifact": {
+            "get": {
+                "description": "List artifacts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artifact"
+                ],
+                "summary": "List artifacts",
+                "operationId": "ListArtifacts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "projectName",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Artifact"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/artifact/{artifactId}": {
+            "get": {
+                "description": "Get artifact",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artifact"
+                ],
+                "summary": "Get artifact",
+                "operationId": "GetArtifact",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artifact ID",
+                        "name": "artifactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Artifact"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete artifact",
+                "tags": [
+                    "artifact"
+                ],
+                "summary": "Delete artifact",
+                "operationId": "DeleteArtifact",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artifact ID",
+                        "name": "artifactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/artifact/{artifactId}/download": {
+            "get": {
+                "description": "Download artifact content",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "artifact"
+                ],
+                "summary": "Download artifact",
+                "operationId": "DownloadArtifact",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artifact ID",
+                        "name": "artifactId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
         "/build": {
             "get": {
                 "description": "List builds",
@@ -166,6 +381,85 @@ const docTemplate = `{
                 }
             }
         },
+        "/build-secret": {
+            "get": {
+                "description": "List the names of the build secrets of the organization",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "build-secret"
+                ],
+                "summary": "List build secrets",
+                "operationId": "ListBuildSecrets",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/BuildSecret"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/build-secret/{name}": {
+            "put": {
+                "description": "Set the value of a build secret of the organization",
+                "tags": [
+                    "build-secret"
+                ],
+                "summary": "Set build secret",
+                "operationId": "SetBuildSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build secret name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Build secret value",
+                        "name": "buildSecret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetBuildSecretDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a build secret of the organization",
+                "tags": [
+                    "build-secret"
+                ],
+                "summary": "Remove build secret",
+                "operationId": "RemoveBuildSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build secret name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
         "/build/prebuild/{prebuildId}": {
             "delete": {
                 "description": "Delete builds",
@@ -254,46 +548,156 @@ const docTemplate = `{
                 }
             }
         },
-        "/container-registry": {
-            "get": {
-                "description": "List container registries",
-                "produces": [
+        "/build/{buildId}/priority": {
+            "post": {
+                "description": "Set the priority of a build. Pending builds of a higher priority preempt running builds of a lower priority when the build runner is at capacity. Only server administrators can set the priority of builds",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "container-registry"
+                    "build"
                 ],
-                "summary": "List container registries",
-                "operationId": "ListContainerRegistries",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Set build priority",
+                "operationId": "SetBuildPriority",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Build ID",
+                        "name": "buildId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set Build Priority DTO",
+                        "name": "setBuildPriorityDto",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ContainerRegistry"
-                            }
+                            "$ref": "#/definitions/SetBuildPriorityDTO"
                         }
                     }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
                 }
             }
         },
-        "/container-registry/{server}": {
+        "/command-run": {
             "get": {
-                "description": "Get container registry credentials",
+                "description": "List the history of project command runs, most recent first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "container-registry"
+                    "command run"
                 ],
-                "summary": "Get container registry credentials",
-                "operationId": "GetContainerRegistry",
+                "summary": "List command runs",
+                "operationId": "ListCommandRuns",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Container Registry server name",
-                        "name": "server",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "projectName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Command name",
+                        "name": "commandName",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/CommandRun"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/command-run/{runId}": {
+            "get": {
+                "description": "Get a project command run with its output",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "command run"
+                ],
+                "summary": "Get command run",
+                "operationId": "GetCommandRun",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Command run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CommandRun"
+                        }
+                    }
+                }
+            }
+        },
+        "/container-registry": {
+            "get": {
+                "description": "List container registries",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "container-registry"
+                ],
+                "summary": "List container registries",
+                "operationId": "ListContainerRegistries",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ContainerRegistry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/container-registry/{server}": {
+            "get": {
+                "description": "Get container registry credentials",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "container-registry"
+                ],
+                "summary": "Get container registry credentials",
+                "operationId": "GetContainerRegistry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Container Registry server name",
+                        "name": "server",
                         "in": "path",
                         "required": true
                     }
@@ -860,217 +1264,482 @@ const docTemplate = `{
                 }
             }
         },
-        "/profile": {
+        "/organization": {
             "get": {
-                "description": "Get profile data",
-                "consumes": [
+                "description": "List organizations the client API key is a member of",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "profile"
+                    "organization"
                 ],
-                "summary": "Get profile data",
-                "operationId": "GetProfileData",
+                "summary": "List organizations",
+                "operationId": "ListOrganizations",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ProfileData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Organization"
+                            }
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Set profile data",
+            "post": {
+                "description": "Create an organization. The client API key creating the organization becomes its first member and admin",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "profile"
+                    "organization"
                 ],
-                "summary": "Set profile data",
-                "operationId": "SetProfileData",
+                "summary": "Create an organization",
+                "operationId": "CreateOrganization",
                 "parameters": [
                     {
-                        "description": "Profile data",
-                        "name": "profileData",
+                        "description": "Create organization",
+                        "name": "organization",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ProfileData"
+                            "$ref": "#/definitions/CreateOrganizationDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
-                    }
-                }
-            },
-            "delete": {
-                "description": "Delete profile data",
-                "tags": [
-                    "profile"
-                ],
-                "summary": "Delete profile data",
-                "operationId": "DeleteProfileData",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Organization"
+                        }
                     }
                 }
             }
         },
-        "/project-config": {
+        "/organization/{organizationId}": {
             "get": {
-                "description": "List project configs",
+                "description": "Get organization",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "project-config"
+                    "organization"
+                ],
+                "summary": "Get organization",
+                "operationId": "GetOrganization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID or name",
+                        "name": "organizationId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List project configs",
-                "operationId": "ListProjectConfigs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ProjectConfig"
-                            }
+                            "$ref": "#/definitions/Organization"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Set project config data",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Delete organization. Requires an admin of the organization and fails while workspaces, targets or project configs belong to it",
                 "tags": [
-                    "project-config"
+                    "organization"
                 ],
-                "summary": "Set project config data",
-                "operationId": "SetProjectConfig",
+                "summary": "Delete organization",
+                "operationId": "DeleteOrganization",
                 "parameters": [
                     {
-                        "description": "Project config",
-                        "name": "projectConfig",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateProjectConfigDTO"
-                        }
+                        "type": "string",
+                        "description": "Organization ID or name",
+                        "name": "organizationId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "/project-config/default/{gitUrl}": {
-            "get": {
-                "description": "Get project configs by git url",
+        "/organization/{organizationId}/member": {
+            "post": {
+                "description": "Add a client API key to the organization or make a member an admin. Requires an admin of the organization",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "project-config"
+                    "organization"
                 ],
-                "summary": "Get project configs by git url",
-                "operationId": "GetDefaultProjectConfig",
+                "summary": "Add organization member",
+                "operationId": "AddOrganizationMember",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Git URL",
-                        "name": "gitUrl",
+                        "description": "Organization ID or name",
+                        "name": "organizationId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Member",
+                        "name": "member",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/AddOrganizationMemberDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ProjectConfig"
+                            "$ref": "#/definitions/Organization"
                         }
                     }
                 }
             }
         },
-        "/project-config/prebuild": {
-            "get": {
-                "description": "List prebuilds",
-                "consumes": [
+        "/organization/{organizationId}/member/{member}": {
+            "delete": {
+                "description": "Remove a client API key from the organization. Requires an admin of the organization",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "prebuild"
+                    "organization"
+                ],
+                "summary": "Remove organization member",
+                "operationId": "RemoveOrganizationMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Organization ID or name",
+                        "name": "organizationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Member name",
+                        "name": "member",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List prebuilds",
-                "operationId": "ListPrebuilds",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/PrebuildDTO"
-                            }
+                            "$ref": "#/definitions/Organization"
                         }
                     }
                 }
             }
         },
-        "/project-config/prebuild/process-git-event": {
-            "post": {
-                "description": "ProcessGitEvent",
+        "/organization/{organizationId}/quota": {
+            "put": {
+                "description": "Set organization quota. Requires an admin of the organization",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "prebuild"
+                    "organization"
                 ],
-                "summary": "ProcessGitEvent",
-                "operationId": "ProcessGitEvent",
+                "summary": "Set organization quota",
+                "operationId": "SetOrganizationQuota",
                 "parameters": [
                     {
-                        "description": "Webhook event",
-                        "name": "workspace",
+                        "type": "string",
+                        "description": "Organization ID or name",
+                        "name": "organizationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Quota",
+                        "name": "quota",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object"
+                            "$ref": "#/definitions/OrganizationQuota"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Organization"
+                        }
                     }
                 }
             }
         },
-        "/project-config/{configName}": {
+        "/profile": {
             "get": {
-                "description": "Get project config data",
+                "description": "Get profile data",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "project-config"
-                ],
-                "summary": "Get project config data",
-                "operationId": "GetProjectConfig",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Config name",
-                        "name": "configName",
-                        "in": "path",
-                        "required": true
-                    }
+                    "profile"
+                ],
+                "summary": "Get profile data",
+                "operationId": "GetProfileData",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProfileData"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set profile data",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Set profile data",
+                "operationId": "SetProfileData",
+                "parameters": [
+                    {
+                        "description": "Profile data",
+                        "name": "profileData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ProfileData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete profile data",
+                "tags": [
+                    "profile"
+                ],
+                "summary": "Delete profile data",
+                "operationId": "DeleteProfileData",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/project-config": {
+            "get": {
+                "description": "List project configs",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "project-config"
+                ],
+                "summary": "List project configs",
+                "operationId": "ListProjectConfigs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProjectConfig"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set project config data",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "project-config"
+                ],
+                "summary": "Set project config data",
+                "operationId": "SetProjectConfig",
+                "parameters": [
+                    {
+                        "description": "Project config",
+                        "name": "projectConfig",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProjectConfigDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/project-config/default/{gitUrl}": {
+            "get": {
+                "description": "Get project configs by git url",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "project-config"
+                ],
+                "summary": "Get project configs by git url",
+                "operationId": "GetDefaultProjectConfig",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Git URL",
+                        "name": "gitUrl",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectConfig"
+                        }
+                    }
+                }
+            }
+        },
+        "/project-config/prebuild": {
+            "get": {
+                "description": "List prebuilds",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prebuild"
+                ],
+                "summary": "List prebuilds",
+                "operationId": "ListPrebuilds",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PrebuildDTO"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/project-config/prebuild/process-git-event": {
+            "post": {
+                "description": "ProcessGitEvent",
+                "tags": [
+                    "prebuild"
+                ],
+                "summary": "ProcessGitEvent",
+                "operationId": "ProcessGitEvent",
+                "parameters": [
+                    {
+                        "description": "Webhook event",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/project-config/prebuild/stats": {
+            "get": {
+                "description": "Get prebuild hit/miss stats for workspace creations",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prebuild"
+                ],
+                "summary": "Get prebuild stats",
+                "operationId": "GetPrebuildStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Repository URL",
+                        "name": "repositoryUrl",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Branch",
+                        "name": "branch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PrebuildStatsDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/project-config/{configName}": {
+            "get": {
+                "description": "Get project config data",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "project-config"
+                ],
+                "summary": "Get project config data",
+                "operationId": "GetProjectConfig",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Config name",
+                        "name": "configName",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -1386,241 +2055,280 @@ const docTemplate = `{
                 }
             }
         },
-        "/sample": {
+        "/region": {
             "get": {
-                "description": "List samples",
+                "description": "List the region of the server and the regional servers registered with it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sample"
+                    "region"
                 ],
-                "summary": "List samples",
-                "operationId": "ListSamples",
+                "summary": "List regions",
+                "operationId": "ListRegions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Sample"
+                                "$ref": "#/definitions/FederatedRegion"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/server/config": {
-            "get": {
-                "description": "Get the server configuration",
-                "produces": [
+            },
+            "post": {
+                "description": "Register a regional server with the primary server. Regional servers call this periodically as a heartbeat with an admin API key. A region can only be updated with the API key it was registered with",
+                "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "region"
                 ],
-                "summary": "Get the server configuration",
-                "operationId": "GetConfig",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Register a region",
+                "operationId": "RegisterRegion",
+                "parameters": [
+                    {
+                        "description": "Register region",
+                        "name": "region",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/ServerConfig"
+                            "$ref": "#/definitions/RegisterRegionDTO"
                         }
                     }
-                }
-            },
-            "post": {
-                "description": "Set the server configuration",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
                 ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/region/{regionName}": {
+            "delete": {
+                "description": "Remove a region until its server registers again. Only server administrators can remove regions",
                 "tags": [
-                    "server"
+                    "region"
                 ],
-                "summary": "Set the server configuration",
-                "operationId": "SetConfig",
+                "summary": "Remove a region",
+                "operationId": "RemoveRegion",
                 "parameters": [
                     {
-                        "description": "Server configuration",
-                        "name": "config",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ServerConfig"
-                        }
+                        "type": "string",
+                        "description": "Region name",
+                        "name": "regionName",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/ServerConfig"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/server/logs": {
+        "/rollout": {
             "get": {
-                "description": "List server log files",
+                "description": "List agent rollouts with the health of their canary workspaces",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "rollout"
                 ],
-                "summary": "List server log files",
-                "operationId": "GetServerLogFiles",
+                "summary": "List agent rollouts",
+                "operationId": "ListRollouts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/AgentRolloutStatus"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/server/network-key": {
+            },
             "post": {
-                "description": "Generate a new authentication key",
+                "description": "Select a percentage of workspaces that receive the new agent version the next time their projects start. Only server administrators can create rollouts",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "server"
+                    "rollout"
+                ],
+                "summary": "Start an agent rollout",
+                "operationId": "CreateRollout",
+                "parameters": [
+                    {
+                        "description": "Create rollout",
+                        "name": "rollout",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateAgentRolloutDTO"
+                        }
+                    }
                 ],
-                "summary": "Generate a new authentication key",
-                "operationId": "GenerateNetworkKey",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/NetworkKey"
+                            "$ref": "#/definitions/AgentRollout"
                         }
                     }
                 }
             }
         },
-        "/target": {
+        "/rollout/{rolloutId}": {
             "get": {
-                "description": "List targets",
+                "description": "Get an agent rollout with the health of its canary workspaces",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "target"
+                    "rollout"
+                ],
+                "summary": "Get agent rollout",
+                "operationId": "GetRollout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rollout ID",
+                        "name": "rolloutId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "List targets",
-                "operationId": "ListTargets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/ProviderTarget"
-                            }
+                            "$ref": "#/definitions/AgentRolloutStatus"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Set a target",
+            }
+        },
+        "/rollout/{rolloutId}/promote": {
+            "post": {
+                "description": "Make the rollout agent version the default for all workspaces. Only server administrators can promote rollouts",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "target"
+                    "rollout"
                 ],
-                "summary": "Set a target",
-                "operationId": "SetTarget",
+                "summary": "Promote agent rollout",
+                "operationId": "PromoteRollout",
                 "parameters": [
                     {
-                        "description": "Target to set",
-                        "name": "target",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/CreateProviderTargetDTO"
-                        }
+                        "type": "string",
+                        "description": "Rollout ID",
+                        "name": "rolloutId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AgentRollout"
+                        }
                     }
                 }
             }
         },
-        "/target/{target}": {
-            "delete": {
-                "description": "Remove a target",
+        "/rollout/{rolloutId}/rollback": {
+            "post": {
+                "description": "Return the canary workspaces to the previous agent version. Only server administrators can roll back rollouts",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "target"
+                    "rollout"
                 ],
-                "summary": "Remove a target",
-                "operationId": "RemoveTarget",
+                "summary": "Roll back agent rollout",
+                "operationId": "RollbackRollout",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Target name",
-                        "name": "target",
+                        "description": "Rollout ID",
+                        "name": "rolloutId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AgentRollout"
+                        }
                     }
                 }
             }
         },
-        "/target/{target}/set-default": {
-            "patch": {
-                "description": "Set target to default",
-                "tags": [
-                    "target"
+        "/sample": {
+            "get": {
+                "description": "List samples",
+                "produces": [
+                    "application/json"
                 ],
-                "summary": "Set target to default",
-                "operationId": "SetDefaultTarget",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Target name",
-                        "name": "target",
-                        "in": "path",
-                        "required": true
-                    }
+                "tags": [
+                    "sample"
                 ],
+                "summary": "List samples",
+                "operationId": "ListSamples",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Sample"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/workspace": {
+        "/server/audit": {
             "get": {
-                "description": "List workspaces",
+                "description": "List the actions recorded in the audit log, oldest first. Only server administrators can read the audit log",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "List workspaces",
-                "operationId": "ListWorkspaces",
+                "summary": "List audit events",
+                "operationId": "ListAuditEvents",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Verbose",
-                        "name": "verbose",
+                        "type": "string",
+                        "description": "Action, e.g. target.scale-up",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the resource the action was taken on",
+                        "name": "resource",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events recorded within the duration, e.g. 24h",
+                        "name": "since",
                         "in": "query"
                     }
                 ],
@@ -1630,30 +2338,78 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/WorkspaceDTO"
+                                "$ref": "#/definitions/AuditEvent"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/server/cleanup-policies/preview": {
+            "get": {
+                "description": "List the workspaces the cleanup policies would delete or stop on their next run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Preview cleanup policies",
+                "operationId": "PreviewCleanup",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/CleanupCandidate"
                             }
                         }
                     }
                 }
+            }
+        },
+        "/server/config": {
+            "get": {
+                "description": "Get the server configuration",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Get the server configuration",
+                "operationId": "GetConfig",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ServerConfig"
+                        }
+                    }
+                }
             },
             "post": {
-                "description": "Create a workspace",
+                "description": "Set the server configuration",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Create a workspace",
-                "operationId": "CreateWorkspace",
+                "summary": "Set the server configuration",
+                "operationId": "SetConfig",
                 "parameters": [
                     {
-                        "description": "Create workspace",
-                        "name": "workspace",
+                        "description": "Server configuration",
+                        "name": "config",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/CreateWorkspaceDTO"
+                            "$ref": "#/definitions/ServerConfig"
                         }
                     }
                 ],
@@ -1661,35 +2417,40 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Workspace"
+                            "$ref": "#/definitions/ServerConfig"
                         }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}": {
+        "/server/creation-timings": {
             "get": {
-                "description": "Get workspace info",
+                "description": "Get the percentiles of workspace creation phase durations per provider and target",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Get workspace info",
-                "operationId": "GetWorkspace",
+                "summary": "Get creation timing report",
+                "operationId": "GetCreationTimingReport",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
-                        "in": "path",
-                        "required": true
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "description": "Verbose",
-                        "name": "verbose",
+                        "type": "string",
+                        "description": "Provider name",
+                        "name": "provider",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include timings recorded within the duration, e.g. 24h",
+                        "name": "since",
                         "in": "query"
                     }
                 ],
@@ -1697,108 +2458,233 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/WorkspaceDTO"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/CreationPhaseReport"
+                            }
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Remove workspace",
+            }
+        },
+        "/server/impersonation": {
+            "get": {
+                "description": "List the impersonation sessions of all users for administrators, and the sessions in which the caller was impersonated for other users",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Remove workspace",
-                "operationId": "RemoveWorkspace",
+                "summary": "List impersonation sessions",
+                "operationId": "ListImpersonationSessions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ImpersonationSession"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Start a session in which the calling administrator can create and inspect the workspaces of a user. The user is notified and every request is recorded in the audit log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Start impersonating a user",
+                "operationId": "StartImpersonation",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Workspace ID",
-                        "name": "workspaceId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Force",
-                        "name": "force",
-                        "in": "query"
+                        "description": "Start Impersonation DTO",
+                        "name": "startImpersonationDto",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/StartImpersonationDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImpersonationSession"
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/start": {
+        "/server/impersonation/{sessionId}/end": {
             "post": {
-                "description": "Start workspace",
+                "description": "End an impersonation session of the calling administrator before it expires",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Start workspace",
-                "operationId": "StartWorkspace",
+                "summary": "End an impersonation session",
+                "operationId": "EndImpersonation",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
+                        "description": "Impersonation session ID",
+                        "name": "sessionId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImpersonationSession"
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/stop": {
-            "post": {
-                "description": "Stop workspace",
+        "/server/logs": {
+            "get": {
+                "description": "List server log files",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Stop workspace",
-                "operationId": "StopWorkspace",
+                "summary": "List server log files",
+                "operationId": "GetServerLogFiles",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/server/network-key": {
+            "get": {
+                "description": "List the network keys issued by the server. Only server administrators can list network keys",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "List network keys",
+                "operationId": "ListNetworkKeys",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
-                        "in": "path",
-                        "required": true
+                        "description": "Scope of the keys",
+                        "name": "scope",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace ID, API key name or provider name",
+                        "name": "scopeName",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ScopedNetworkKey"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Generate a new authentication key",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Generate a new authentication key",
+                "operationId": "GenerateNetworkKey",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Register the node as a persistent node that stays registered while it is offline. Only server administrators can register persistent nodes unless the server config allows them",
+                        "name": "persistent",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NetworkKey"
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/start": {
+        "/server/network-key/revoke": {
             "post": {
-                "description": "Start project",
+                "description": "Revoke all network keys of a workspace, client or provider and remove its nodes from the network. Only server administrators can revoke network keys",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "workspace"
+                    "server"
                 ],
-                "summary": "Start project",
-                "operationId": "StartProject",
+                "summary": "Revoke the network keys of a scope",
+                "operationId": "RevokeNetworkKeys",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
-                        "in": "path",
-                        "required": true
-                    },
+                        "description": "Revoke Network Keys DTO",
+                        "name": "revokeNetworkKeysDto",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RevokeNetworkKeysDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/server/network-key/{keyId}": {
+            "delete": {
+                "description": "Revoke a network key and remove the node that joined with it from the network. Only server administrators can revoke network keys",
+                "tags": [
+                    "server"
+                ],
+                "summary": "Revoke a network key",
+                "operationId": "RevokeNetworkKey",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
+                        "description": "Network key ID",
+                        "name": "keyId",
                         "in": "path",
                         "required": true
                     }
@@ -1810,120 +2696,5371 @@ const docTemplate = `{
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/state": {
-            "post": {
-                "description": "Set project state",
+        "/server/tailnet-ca": {
+            "get": {
+                "description": "Get the certificate of the authority that signs the certificates of the TLS ports of projects. Clients that trust it can open the TLS ports at the tailnet names of projects. The authority is constrained to the tailnet domain",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "server"
+                ],
+                "summary": "Get tailnet CA",
+                "operationId": "GetTailnetCa",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TailnetCaDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/service-discovery": {
+            "get": {
+                "description": "List the services project agents registered for other workspaces of the organization to resolve by name",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Set project state",
-                "operationId": "SetProjectState",
+                "summary": "List service endpoints",
+                "operationId": "ListServiceEndpoints",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
+                        "description": "Only list the services of the workspace",
                         "name": "workspaceId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Set State",
-                        "name": "setState",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/SetProjectState"
-                        }
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ServiceEndpoint"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/workspace/{workspaceId}/{projectId}/stop": {
-            "post": {
-                "description": "Stop project",
+        "/service-discovery/{name}": {
+            "get": {
+                "description": "Resolve the name of a service, e.g. api-proj.daytona.internal, to its tailnet address. Only services of the organization of the caller are resolved. Names that are not qualified with a workspace are resolved in the workspace of the caller first",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "workspace"
                 ],
-                "summary": "Stop project",
-                "operationId": "StopProject",
+                "summary": "Resolve a service",
+                "operationId": "ResolveService",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Workspace ID or Name",
-                        "name": "workspaceId",
+                        "description": "Service name",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Project ID",
-                        "name": "projectId",
-                        "in": "path",
-                        "required": true
+                        "description": "Workspace ID or Name of the caller. Agents always resolve names in their own workspace first",
+                        "name": "workspaceId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ServiceEndpoint"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "ApiKey": {
+        },
+        "/shared-service": {
+            "get": {
+                "description": "List shared services and the workspaces attached to them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shared-service"
+                ],
+                "summary": "List shared services",
+                "operationId": "ListSharedServices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Verbose",
+                        "name": "verbose",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/SharedServiceDTO"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a service, like a database or a cache, on a target that workspaces on the same target can attach to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shared-service"
+                ],
+                "summary": "Create a shared service",
+                "operationId": "CreateSharedService",
+                "parameters": [
+                    {
+                        "description": "Create shared service",
+                        "name": "sharedService",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateSharedServiceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SharedService"
+                        }
+                    }
+                }
+            }
+        },
+        "/shared-service/{serviceName}": {
+            "delete": {
+                "description": "Delete a shared service and its data",
+                "tags": [
+                    "shared-service"
+                ],
+                "summary": "Delete a shared service",
+                "operationId": "DeleteSharedService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shared service name",
+                        "name": "serviceName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Detach attached workspaces and ignore provider errors",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/shared-service/{serviceName}/workspace/{workspaceId}": {
+            "post": {
+                "description": "Attach a workspace to a shared service. Projects receive the connection env vars when they are started or rebuilt",
+                "tags": [
+                    "shared-service"
+                ],
+                "summary": "Attach a workspace to a shared service",
+                "operationId": "AttachSharedService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shared service name",
+                        "name": "serviceName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Detach a workspace from a shared service",
+                "tags": [
+                    "shared-service"
+                ],
+                "summary": "Detach a workspace from a shared service",
+                "operationId": "DetachSharedService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Shared service name",
+                        "name": "serviceName",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/target": {
+            "get": {
+                "description": "List targets",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "target"
+                ],
+                "summary": "List targets",
+                "operationId": "ListTargets",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ProviderTarget"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Set a target",
+                "tags": [
+                    "target"
+                ],
+                "summary": "Set a target",
+                "operationId": "SetTarget",
+                "parameters": [
+                    {
+                        "description": "Target to set",
+                        "name": "target",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateProviderTargetDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/target/{target}": {
+            "delete": {
+                "description": "Remove a target",
+                "tags": [
+                    "target"
+                ],
+                "summary": "Remove a target",
+                "operationId": "RemoveTarget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/target/{target}/allocation": {
+            "get": {
+                "description": "Get the resources reserved and used by the projects of the target and the capacity of its host",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "target"
+                ],
+                "summary": "Get target allocation",
+                "operationId": "GetTargetAllocation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/TargetAllocation"
+                        }
+                    }
+                }
+            }
+        },
+        "/target/{target}/bandwidth-limit": {
+            "put": {
+                "description": "Set the default limit of the traffic the agent of each project on the target proxies from and to the tailnet. Workspace limits can only lower it in the directions they set. Only administrators can set it",
+                "tags": [
+                    "target"
+                ],
+                "summary": "Set target bandwidth limit",
+                "operationId": "SetTargetBandwidthLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bandwidth limit",
+                        "name": "limit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/target/{target}/set-default": {
+            "patch": {
+                "description": "Set target to default",
+                "tags": [
+                    "target"
+                ],
+                "summary": "Set target to default",
+                "operationId": "SetDefaultTarget",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Target name",
+                        "name": "target",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace": {
+            "get": {
+                "description": "List workspaces, including the workspaces of regional servers if the server is the primary server of a federation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List workspaces",
+                "operationId": "ListWorkspaces",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Verbose",
+                        "name": "verbose",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WorkspaceDTO"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a workspace. Workspaces of other regions are created by the server of the region",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Create a workspace",
+                "operationId": "CreateWorkspace",
+                "parameters": [
+                    {
+                        "description": "Create workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/adopt": {
+            "post": {
+                "description": "Register an existing Docker container or a VM reachable over SSH as a workspace and install the agent on it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Adopt an existing container or VM",
+                "operationId": "AdoptWorkspace",
+                "parameters": [
+                    {
+                        "description": "Adopt workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/AdoptWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/validate": {
+            "post": {
+                "description": "Check that the workspace can be created without provisioning any resources. Repositories, branches, devcontainer configurations and images are checked and the creation time is estimated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Validate a workspace creation request",
+                "operationId": "ValidateCreateWorkspace",
+                "parameters": [
+                    {
+                        "description": "Create workspace",
+                        "name": "workspace",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/CreateWorkspaceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CreationValidation"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}": {
+            "get": {
+                "description": "Get workspace info",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get workspace info",
+                "operationId": "GetWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Verbose",
+                        "name": "verbose",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WorkspaceDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Remove workspace",
+                "operationId": "RemoveWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Force",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/annotations": {
+            "patch": {
+                "description": "Set or remove workspace annotations. Keys must be namespaced as \u003cprefix\u003e/\u003cname\u003e",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Update workspace annotations",
+                "operationId": "UpdateWorkspaceAnnotations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Annotations",
+                        "name": "annotations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateAnnotations"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/bandwidth-limit": {
+            "put": {
+                "description": "Replace the limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the target in the directions it sets. Running agents pick up the limit within seconds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Set workspace bandwidth limit",
+                "operationId": "SetWorkspaceBandwidthLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bandwidth limit",
+                        "name": "limit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/diff/{otherWorkspaceId}": {
+            "get": {
+                "description": "Compare the images, environment variables, build configuration, repositories and commands of the projects of two workspaces",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Diff workspaces",
+                "operationId": "DiffWorkspaces",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID or Name of the workspace to compare with",
+                        "name": "otherWorkspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WorkspaceDiff"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/events": {
+            "get": {
+                "description": "List the lifecycle and network events of the project agents of a workspace in the order they occurred",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List workspace events",
+                "operationId": "ListWorkspaceEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "project",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events of the type, e.g. proxy-error",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events that occurred within the duration, e.g. 24h",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AgentEvent"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/history": {
+            "get": {
+                "description": "Get the recorded state snapshots of the projects of a workspace. Removed workspaces can be queried by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get workspace state history",
+                "operationId": "GetWorkspaceStateHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Return the last known state of each project at the RFC3339 time instead of every snapshot",
+                        "name": "at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include snapshots recorded within the duration, e.g. 24h",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "project",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/StateSnapshot"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/pause": {
+            "post": {
+                "description": "Checkpoint the running processes of the workspace projects and stop them. Projects are resumed from the checkpoint on start. Projects whose provider can't pause them or that fail to checkpoint are stopped",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Pause workspace",
+                "operationId": "PauseWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/ready": {
+            "get": {
+                "description": "Wait until the project, or every project of the workspace, accepts SSH connections. Returns the readiness once the projects are ready or the timeout elapses",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Wait for workspace readiness",
+                "operationId": "WaitForWorkspaceReady",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only wait for the project",
+                        "name": "project",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Maximum time to wait, e.g. 1m. Defaults to 30s and is capped at 5m",
+                        "name": "timeout",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/WorkspaceReadiness"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/ready-hooks": {
+            "post": {
+                "description": "Register a callback the readiness of the workspace is posted to once its projects accept SSH connections. The url must be of a public host, redirects are not followed",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Register ready hook",
+                "operationId": "RegisterReadyHook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ready hook",
+                        "name": "hook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReadyHook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/start": {
+            "post": {
+                "description": "Start workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Start workspace",
+                "operationId": "StartWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/stop": {
+            "post": {
+                "description": "Stop workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop workspace",
+                "operationId": "StopWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/usage": {
+            "get": {
+                "description": "List the resource usage samples of the projects of a workspace in the order they were taken",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "List workspace resource usage",
+                "operationId": "ListWorkspaceUsage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project name",
+                        "name": "project",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include samples taken within the duration, e.g. 1h",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ResourceUsageSample"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/access-policy": {
+            "get": {
+                "description": "Get the policy the project agent enforces on connections from tailnet peers to project ports",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project access policy",
+                "operationId": "GetProjectAccessPolicy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PortAccessPolicy"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the policy the project agent enforces on connections from tailnet peers to project ports. Running agents pick up the policy within seconds",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Set project access policy",
+                "operationId": "SetProjectAccessPolicy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Access policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/PortAccessPolicy"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/agent-update": {
+            "get": {
+                "description": "Get the agent version the project agent should run and the checksum of its binary. Agents of other versions download the binary and restart themselves with it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project agent update",
+                "operationId": "GetProjectAgentUpdate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Name of the binary of the agent, e.g. daytona-linux-amd64",
+                        "name": "binaryName",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AgentUpdate"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/annotations": {
+            "patch": {
+                "description": "Set or remove project annotations. Keys must be namespaced as \u003cprefix\u003e/\u003cname\u003e",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Update project annotations",
+                "operationId": "UpdateProjectAnnotations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Annotations",
+                        "name": "annotations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateAnnotations"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/artifacts": {
+            "post": {
+                "description": "Upload a project output file as an artifact",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "artifact"
+                ],
+                "summary": "Upload an artifact",
+                "operationId": "UploadArtifact",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Artifact path relative to the project directory",
+                        "name": "path",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Artifact file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/Artifact"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/bandwidth-limit": {
+            "get": {
+                "description": "Get the limit the project agent enforces on the traffic it proxies from and to the tailnet, merged from the limits of the workspace and its target",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project bandwidth limit",
+                "operationId": "GetProjectBandwidthLimit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/certificate": {
+            "post": {
+                "description": "Sign a certificate for the tailnet name of the project, which the project agent serves its TLS ports with. Requires the API key of the project. The names requested by the CSR are ignored",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Issue project certificate",
+                "operationId": "IssueProjectCertificate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certificate request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/IssueProjectCertificateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectCertificate"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/commands/{commandName}/run": {
+            "post": {
+                "description": "Run a named command declared by the project and wait for it to exit",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "command run"
+                ],
+                "summary": "Run a project command",
+                "operationId": "RunProjectCommand",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Command name",
+                        "name": "commandName",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CommandRun"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/creation-timings": {
+            "post": {
+                "description": "Record the duration of creation phases measured by the project agent",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Record project creation timings",
+                "operationId": "RecordProjectCreationTimings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Phase durations",
+                        "name": "timings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PhaseDuration"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/events": {
+            "post": {
+                "description": "Record lifecycle and network events published by the project agent, e.g. reconnects to the tailnet or ports opened by the project. Only the agent of the project can record its events, at most 100 at once",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Record project events",
+                "operationId": "RecordProjectEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Events",
+                        "name": "events",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AgentEventReport"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/health": {
+            "get": {
+                "description": "Get the tailnet status, uptime, forwarded connections and process health reported by the project agent",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project agent health",
+                "operationId": "GetProjectHealth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AgentHealth"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/hostname": {
+            "put": {
+                "description": "Rename the project agent on the tailnet. Running agents are renamed in the control plane right away. Hostnames used by other nodes of the network or reserved for the server and CLI clients are refused",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Set project hostname",
+                "operationId": "SetProjectHostname",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Hostname",
+                        "name": "hostname",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetProjectHostname"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Workspace"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/idle-stop": {
+            "post": {
+                "description": "Stop the workspace if no project of it reported activity within the idle timeout of the server. Only the agent of the project can ask to stop its workspace",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop idle workspace",
+                "operationId": "StopIdleWorkspace",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/network-key": {
+            "post": {
+                "description": "Issue a network key for the project agent to register its tailnet node, initially or again, e.g. before the node key expires or after switching between a persistent and an ephemeral node. Requires the API key of the project. The current node of the project is removed once the new node registered so that the new node keeps its hostname",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Renew project network key",
+                "operationId": "RenewProjectNetworkKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Register the node as a persistent node that stays registered while it is offline. Only server administrators can register persistent nodes unless the server config allows them",
+                        "name": "persistent",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/NetworkKey"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/pause": {
+            "post": {
+                "description": "Checkpoint the running processes of the project and stop it. The project is resumed from the checkpoint on start. The project is stopped if its provider can't pause it or checkpointing it fails",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Pause project",
+                "operationId": "PauseProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/public-port/{port}": {
+            "post": {
+                "description": "Publish a port of the project forwarded to its public URL. Creates the preview DNS record of the port if records are managed per workspace and serves the URL over HTTPS once the preview certificate is issued",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Register public port",
+                "operationId": "RegisterPublicPort",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Port",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/rebuild": {
+            "post": {
+                "description": "Recreate the project container from its current devcontainer configuration, keeping the project volume",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Rebuild project",
+                "operationId": "RebuildProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/recovery": {
+            "post": {
+                "description": "Start a recovery container that shares the project directory and serves SSH on the project recovery hostname",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Start project recovery",
+                "operationId": "StartProjectRecovery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the recovery container of the project",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop project recovery",
+                "operationId": "StopProjectRecovery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/routes": {
+            "get": {
+                "description": "Get the routing table of the tailnet node of the project. Each entry forwards a range of tailnet ports to the container of a project that shares the node",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project routes",
+                "operationId": "GetProjectRoutes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/RoutingEntry"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/ssh-access": {
+            "post": {
+                "description": "Verify the SSH token a client presents to the project agent on the tailnet. Only called by the agent of the project",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Verify SSH access",
+                "operationId": "VerifySshAccess",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Verify SSH access",
+                        "name": "verifySshAccess",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/VerifySshAccessDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/ssh-host-key": {
+            "get": {
+                "description": "Get the private SSH host key the project agent serves SSH with, so that clients can verify the project with its public key. Only returned to the agent of the project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Get project SSH host key",
+                "operationId": "GetProjectSshHostKey",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SshHostKeyDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/ssh-token": {
+            "post": {
+                "description": "Issue a short-lived token to authenticate with as the SSH password on the tailnet node of the project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Create SSH token",
+                "operationId": "CreateSshToken",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SshTokenDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/start": {
+            "post": {
+                "description": "Start project",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Start project",
+                "operationId": "StartProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/state": {
+            "post": {
+                "description": "Set project state",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Set project state",
+                "operationId": "SetProjectState",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Set State",
+                        "name": "setState",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SetProjectState"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/stop": {
+            "post": {
+                "description": "Stop project",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Stop project",
+                "operationId": "StopProject",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/drift": {
+            "get": {
+                "description": "Compare the running project with the current devcontainer configuration in the project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Get project drift",
+                "operationId": "GetProjectDrift",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ProjectDrift"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files": {
+            "get": {
+                "description": "List files in a project directory",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "List files",
+                "operationId": "ListFiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Directory path. Relative paths are resolved against the project directory",
+                        "name": "path",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/FileList"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a file or folder",
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Delete file",
+                "operationId": "DeleteFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File or folder path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files/download": {
+            "get": {
+                "description": "Download file",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Download file",
+                "operationId": "DownloadFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files/folder": {
+            "post": {
+                "description": "Create folder",
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Create folder",
+                "operationId": "CreateFolder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Folder path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files/move": {
+            "post": {
+                "description": "Move or rename a file or folder",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Move file",
+                "operationId": "MoveFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Move file request",
+                        "name": "moveFile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MoveFileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files/preview": {
+            "get": {
+                "description": "Get the content of a text or image file. Files over 1MB are rejected. HTML and SVG files are returned as plain text",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Preview file",
+                "operationId": "PreviewFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/files/upload": {
+            "post": {
+                "description": "Upload a file. Uploading to a directory path keeps the original file name. Files over the upload limit of the project agent, 100MB unless DAYTONA_AGENT_MAX_UPLOAD_SIZE is set, are rejected",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Upload file",
+                "operationId": "UploadFile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Destination path",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/FileInfo"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/git/update": {
+            "post": {
+                "description": "Fast-forward or rebase the checked out branch of the project onto its upstream branch. A rebase that conflicts is aborted and the conflicting files are returned",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "Update project branch",
+                "operationId": "UpdateProjectBranch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update strategy",
+                        "name": "update",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/UpdateBranchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/BranchUpdate"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/toolbox/ports": {
+            "get": {
+                "description": "List TCP ports the project is listening on",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "workspace toolbox"
+                ],
+                "summary": "List ports",
+                "operationId": "ListPorts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PortList"
+                        }
+                    }
+                }
+            }
+        },
+        "/workspace/{workspaceId}/{projectId}/usage": {
+            "post": {
+                "description": "Record the CPU, memory, disk and inode usage sampled by the project agent",
+                "tags": [
+                    "workspace"
+                ],
+                "summary": "Record project resource usage",
+                "operationId": "RecordProjectUsage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Workspace ID or Name",
+                        "name": "workspaceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Usage samples",
+                        "name": "usage",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ResourceUsageReport"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "AddOrganizationMemberDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "admin": {
+                    "description": "Admin makes the member an admin of the organization",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "AdoptWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name",
+                "projectName",
+                "type"
+            ],
+            "properties": {
+                "containerId": {
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "password": {
+                    "description": "SSH credentials are only used to install the agent and are not stored",
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "privateKey": {
+                    "type": "string"
+                },
+                "projectDir": {
+                    "description": "Defaults to $HOME/\u003cprojectName\u003e of the user running the agent",
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Repository checked out in the project directory of the adopted machine",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GitRepository"
+                        }
+                    ]
+                },
+                "type": {
+                    "$ref": "#/definitions/workspace.AdoptionType"
+                },
+                "user": {
+                    "description": "User that runs the agent. Defaults to root for docker adoptions",
+                    "type": "string"
+                }
+            }
+        },
+        "AgentEvent": {
+            "type": "object",
+            "required": [
+                "id",
+                "message",
+                "occurredAt",
+                "projectName",
+                "type",
+                "workspaceId"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/agentevent.Type"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "AgentEventReport": {
+            "type": "object",
+            "required": [
+                "message",
+                "occurredAt",
+                "type"
+            ],
+            "properties": {
+                "message": {
+                    "description": "Human readable details, e.g. the error of a failed proxy connection",
+                    "type": "string"
+                },
+                "occurredAt": {
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Port of the project the event is about. Zero for events that are not about a port",
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/agentevent.Type"
+                }
+            }
+        },
+        "AgentHealth": {
+            "type": "object",
+            "required": [
+                "activeConnections",
+                "processes",
+                "status",
+                "tailnet",
+                "uptime"
+            ],
+            "properties": {
+                "activeConnections": {
+                    "description": "Connections currently forwarded from the tailnet to ports and sockets of the project",
+                    "type": "integer"
+                },
+                "lastControlContactAt": {
+                    "description": "LastControlContactAt is when the agent was last seen online by the control plane. Empty if it never was",
+                    "type": "string"
+                },
+                "processes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProcessHealth"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/AgentHealthStatus"
+                },
+                "tailnet": {
+                    "$ref": "#/definitions/TailnetHealth"
+                },
+                "uptime": {
+                    "description": "Uptime of the agent in seconds",
+                    "type": "integer"
+                }
+            }
+        },
+        "AgentHealthStatus": {
+            "type": "string",
+            "enum": [
+                "healthy",
+                "degraded"
+            ],
+            "x-enum-varnames": [
+                "AgentHealthStatusHealthy",
+                "AgentHealthStatusDegraded"
+            ]
+        },
+        "AgentRollout": {
+            "type": "object",
+            "required": [
+                "agentVersion",
+                "createdAt",
+                "id",
+                "maxErrorRate",
+                "percentage",
+                "previousVersion",
+                "serverVersion",
+                "state",
+                "updatedAt",
+                "workspaceIds"
+            ],
+            "properties": {
+                "agentVersion": {
+                    "description": "Agent version that the canary workspaces receive",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maxErrorRate": {
+                    "description": "The rollout is rolled back automatically once the canary error rate exceeds this value. 0 disables the check",
+                    "type": "number"
+                },
+                "percentage": {
+                    "description": "Percentage of workspaces selected for the canary",
+                    "type": "integer"
+                },
+                "previousVersion": {
+                    "description": "Agent version used by the rest of the fleet when the rollout started",
+                    "type": "string"
+                },
+                "serverVersion": {
+                    "description": "A promoted agent version only applies while the server runs the version the rollout was started on",
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/rollout.RolloutState"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "workspaceIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "AgentRolloutHealth": {
+            "type": "object",
+            "required": [
+                "baselineErrorRate",
+                "errorRate",
+                "pending",
+                "unhealthy",
+                "upgraded"
+            ],
+            "properties": {
+                "baselineErrorRate": {
+                    "type": "number"
+                },
+                "errorRate": {
+                    "type": "number"
+                },
+                "pending": {
+                    "description": "Canary projects that have not reported the new agent version yet",
+                    "type": "integer"
+                },
+                "unhealthy": {
+                    "description": "Running canary projects whose agent stopped sending heartbeats",
+                    "type": "integer"
+                },
+                "upgraded": {
+                    "description": "Canary projects running the new agent version with a recent heartbeat",
+                    "type": "integer"
+                }
+            }
+        },
+        "AgentRolloutStatus": {
+            "type": "object",
+            "required": [
+                "agentVersion",
+                "createdAt",
+                "health",
+                "id",
+                "maxErrorRate",
+                "percentage",
+                "previousVersion",
+                "serverVersion",
+                "state",
+                "updatedAt",
+                "workspaceIds"
+            ],
+            "properties": {
+                "agentVersion": {
+                    "description": "Agent version that the canary workspaces receive",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "health": {
+                    "$ref": "#/definitions/AgentRolloutHealth"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maxErrorRate": {
+                    "description": "The rollout is rolled back automatically once the canary error rate exceeds this value. 0 disables the check",
+                    "type": "number"
+                },
+                "percentage": {
+                    "description": "Percentage of workspaces selected for the canary",
+                    "type": "integer"
+                },
+                "previousVersion": {
+                    "description": "Agent version used by the rest of the fleet when the rollout started",
+                    "type": "string"
+                },
+                "serverVersion": {
+                    "description": "A promoted agent version only applies while the server runs the version the rollout was started on",
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/rollout.RolloutState"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "workspaceIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "AgentUpdate": {
+            "type": "object",
+            "required": [
+                "checksum",
+                "version"
+            ],
+            "properties": {
+                "checksum": {
+                    "description": "Hex encoded SHA-256 checksum of the binary of the version that was requested",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version the agent of the project should run",
+                    "type": "string"
+                }
+            }
+        },
+        "Announcement": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "belowVersion": {
+                    "description": "Only clients older than this version are notified, e.g. of their deprecation",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "The announcement is no longer listed after this time",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "description": "Only this user is notified. Every user is notified if empty",
+                    "type": "string"
+                },
+                "startsAt": {
+                    "description": "Start and end of a maintenance window",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/AnnouncementType"
+                }
+            }
+        },
+        "AnnouncementType": {
+            "type": "string",
+            "enum": [
+                "info",
+                "maintenance",
+                "deprecation",
+                "quota"
+            ],
+            "x-enum-varnames": [
+                "AnnouncementTypeInfo",
+                "AnnouncementTypeMaintenance",
+                "AnnouncementTypeDeprecation",
+                "AnnouncementTypeQuota"
+            ]
+        },
+        "ApiKey": {
+            "type": "object",
+            "required": [
+                "keyHash",
+                "name",
+                "type"
+            ],
+            "properties": {
+                "admin": {
+                    "description": "Admin client keys can manage server-wide resources, e.g. announcements and regions",
+                    "type": "boolean"
+                },
+                "keyHash": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Project or client name",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/apikey.ApiKeyType"
+                }
+            }
+        },
+        "Artifact": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "path",
+                "projectName",
+                "size",
+                "workspaceId"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path of the artifact relative to the project directory",
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "AuditEvent": {
+            "type": "object",
+            "required": [
+                "action",
+                "actor",
+                "createdAt",
+                "id",
+                "message",
+                "resource"
+            ],
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/audit.Action"
+                },
+                "actor": {
+                    "description": "Name of the user or API key, or the server component that took the action, e.g. \"autoscaler\"",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "impersonatedBy": {
+                    "description": "Name of the administrator that took the action on behalf of the actor",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "Human readable description of the action, including why it was taken",
+                    "type": "string"
+                },
+                "resource": {
+                    "type": "string"
+                }
+            }
+        },
+        "AuthConfig": {
+            "type": "object",
+            "properties": {
+                "adminTokenHash": {
+                    "description": "SHA-256 hex hash of the static admin token",
+                    "type": "string"
+                },
+                "mtls": {
+                    "$ref": "#/definitions/MtlsAuthConfig"
+                },
+                "oidc": {
+                    "$ref": "#/definitions/OidcAuthConfig"
+                },
+                "routeProviders": {
+                    "description": "Providers tried in order for each route group, keyed by the group path (e.g. \"/server\").\nThe \"default\" entry applies to groups that are not listed",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/server.AuthProviderType"
+                        }
+                    }
+                }
+            }
+        },
+        "AutoscalingConfig": {
+            "type": "object",
+            "required": [
+                "maxHosts",
+                "minHosts",
+                "target"
+            ],
+            "properties": {
+                "cooldownMinutes": {
+                    "description": "Minimum time between two scale events of the target. Defaults to 10 minutes",
+                    "type": "integer"
+                },
+                "maxHosts": {
+                    "type": "integer"
+                },
+                "minHosts": {
+                    "type": "integer"
+                },
+                "scaleDownUtilization": {
+                    "description": "Utilization of the pool, between 0 and 1, below which a host is drained. Defaults to 0.3",
+                    "type": "number"
+                },
+                "scaleUpUtilization": {
+                    "description": "Utilization of the pool, between 0 and 1, above which a host is added. Defaults to 0.8",
+                    "type": "number"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "BandwidthLimit": {
+            "type": "object",
+            "properties": {
+                "egressBytesPerSecond": {
+                    "description": "Maximum rate in bytes per second of the traffic from the project to tailnet peers. Unlimited if 0",
+                    "type": "integer"
+                },
+                "ingressBytesPerSecond": {
+                    "description": "Maximum rate in bytes per second of the traffic from tailnet peers to the project. Unlimited if 0",
+                    "type": "integer"
+                }
+            }
+        },
+        "BranchUpdate": {
+            "type": "object",
+            "required": [
+                "branch",
+                "previousSha",
+                "sha",
+                "strategy",
+                "upstream"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "conflicts": {
+                    "description": "Files that conflicted while rebasing. The rebase is aborted and the branch is left as it was if set",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "previousSha": {
+                    "type": "string"
+                },
+                "sha": {
+                    "type": "string"
+                },
+                "strategy": {
+                    "$ref": "#/definitions/BranchUpdateStrategy"
+                },
+                "upstream": {
+                    "type": "string"
+                }
+            }
+        },
+        "BranchUpdateStrategy": {
+            "type": "string",
+            "enum": [
+                "fast-forward",
+                "rebase"
+            ],
+            "x-enum-varnames": [
+                "BranchUpdateStrategyFastForward",
+                "BranchUpdateStrategyRebase"
+            ]
+        },
+        "BrowserBridgeConfig": {
+            "type": "object",
+            "properties": {
+                "clipboard": {
+                    "description": "Direction clipboard content may flow in. Defaults to both",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/server.BridgeDirection"
+                        }
+                    ]
+                },
+                "disableFileDrops": {
+                    "description": "Rejects files dropped in the browser",
+                    "type": "boolean"
+                },
+                "maxClipboardKb": {
+                    "description": "Maximum clipboard content size in kilobytes. Defaults to 1024",
+                    "type": "integer"
+                },
+                "maxFileDropMb": {
+                    "description": "Maximum dropped file size in megabytes. Defaults to 100",
+                    "type": "integer"
+                }
+            }
+        },
+        "Build": {
+            "type": "object",
+            "required": [
+                "containerConfig",
+                "createdAt",
+                "envVars",
+                "id",
+                "prebuildId",
+                "priority",
+                "repository",
+                "state",
+                "updatedAt"
+            ],
+            "properties": {
+                "architecture": {
+                    "type": "string"
+                },
+                "buildConfig": {
+                    "$ref": "#/definitions/BuildConfig"
+                },
+                "buildSecrets": {
+                    "description": "Names of the build secrets mounted into the build. Their values are resolved when the build runs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "containerConfig": {
+                    "$ref": "#/definitions/ContainerConfig"
+                },
+                "correlationId": {
+                    "description": "ID of the API request that created the build",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imagePrePulledAt": {
+                    "description": "Time the published image of the prebuild was pulled onto the targets that use it",
+                    "type": "string"
+                },
+                "organizationId": {
+                    "description": "Organization of the project config of the build. Build secrets are resolved in it",
+                    "type": "string"
+                },
+                "prebuildId": {
+                    "type": "string"
+                },
+                "priority": {
+                    "$ref": "#/definitions/build.BuildPriority"
+                },
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                },
+                "state": {
+                    "$ref": "#/definitions/build.BuildState"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "BuildConfig": {
+            "type": "object",
+            "properties": {
+                "cachedBuild": {
+                    "$ref": "#/definitions/CachedBuild"
+                },
+                "devcontainer": {
+                    "$ref": "#/definitions/DevcontainerConfig"
+                }
+            }
+        },
+        "BuildSecret": {
+            "type": "object",
+            "required": [
+                "name",
+                "updatedAt"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "CachedBuild": {
+            "type": "object",
+            "required": [
+                "image",
+                "user"
+            ],
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "CleanupCandidate": {
+            "type": "object",
+            "required": [
+                "action",
+                "policy",
+                "reason",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/workspace.CleanupAction"
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "policy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "CleanupPolicyConfig": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "dryRun": {
+                    "description": "Only log the actions the policy would take",
+                    "type": "boolean"
+                },
+                "maxAge": {
+                    "description": "Matching workspaces older than this duration (e.g. \"48h\") are deleted",
+                    "type": "string"
+                },
+                "maxIdle": {
+                    "description": "Matching running workspaces without terminal, IDE or file activity and with almost no CPU usage for this\nduration (e.g. \"2h\") are stopped. Requires agents that report their resource usage",
+                    "type": "string"
+                },
+                "maxRunning": {
+                    "description": "The oldest matching running workspaces above this count are stopped",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "Comma-separated annotation requirements, e.g. \"ci.example.com/purpose=pr-preview\". Supports key=value, key!=value, key and !key.\nAn empty selector matches every workspace",
+                    "type": "string"
+                }
+            }
+        },
+        "CloneTarget": {
+            "type": "string",
+            "enum": [
+                "branch",
+                "commit"
+            ],
+            "x-enum-varnames": [
+                "CloneTargetBranch",
+                "CloneTargetCommit"
+            ]
+        },
+        "CommandRun": {
+            "type": "object",
+            "required": [
+                "command",
+                "commandName",
+                "id",
+                "output",
+                "projectName",
+                "startedAt",
+                "state",
+                "timedOut",
+                "truncated",
+                "workspaceId"
+            ],
+            "properties": {
+                "command": {
+                    "type": "string"
+                },
+                "commandName": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "exitCode": {
+                    "description": "Set once the command exited",
+                    "type": "integer"
+                },
+                "finishedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "output": {
+                    "description": "End of the combined stdout and stderr of the command",
+                    "type": "string"
+                },
+                "projectName": {
+                    "type": "string"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/CommandRunState"
+                },
+                "timedOut": {
+                    "type": "boolean"
+                },
+                "truncated": {
+                    "type": "boolean"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "CommandRunState": {
+            "type": "string",
+            "enum": [
+                "running",
+                "succeeded",
+                "failed",
+                "error"
+            ],
+            "x-enum-varnames": [
+                "CommandRunStateRunning",
+                "CommandRunStateSucceeded",
+                "CommandRunStateFailed",
+                "CommandRunStateError"
+            ]
+        },
+        "ContainerConfig": {
+            "type": "object",
+            "required": [
+                "image",
+                "user"
+            ],
+            "properties": {
+                "image": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "ContainerRegistry": {
+            "type": "object",
+            "required": [
+                "password",
+                "server",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "server": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateAgentRolloutDTO": {
+            "type": "object",
+            "required": [
+                "agentVersion",
+                "percentage"
+            ],
+            "properties": {
+                "agentVersion": {
+                    "type": "string"
+                },
+                "maxErrorRate": {
+                    "type": "number"
+                },
+                "percentage": {
+                    "type": "integer"
+                }
+            }
+        },
+        "CreateAnnouncementDTO": {
+            "type": "object",
+            "required": [
+                "title",
+                "type"
+            ],
+            "properties": {
+                "belowVersion": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "Defaults to the end of the maintenance window if set",
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "description": "Only this user is notified. Every user is notified if empty",
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/AnnouncementType"
+                }
+            }
+        },
+        "CreateBuildDTO": {
+            "type": "object",
+            "required": [
+                "branch",
+                "envVars",
+                "projectConfigName"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "prebuildId": {
+                    "type": "string"
+                },
+                "projectConfigName": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateOrganizationDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "quota": {
+                    "$ref": "#/definitions/OrganizationQuota"
+                }
+            }
+        },
+        "CreatePrebuildDTO": {
+            "type": "object",
+            "required": [
+                "retention"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "buildSecrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "commitInterval": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "matrix": {
+                    "$ref": "#/definitions/PrebuildMatrix"
+                },
+                "retention": {
+                    "type": "integer"
+                },
+                "triggerFiles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "CreateProjectConfigDTO": {
+            "type": "object",
+            "required": [
+                "envVars",
+                "name",
+                "repositoryUrl"
+            ],
+            "properties": {
+                "buildConfig": {
+                    "$ref": "#/definitions/BuildConfig"
+                },
+                "buildSecrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCommand"
+                    }
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "gitProviderConfigId": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Mount"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectPort"
+                    }
+                },
+                "repositoryUrl": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateProjectDTO": {
+            "type": "object",
+            "required": [
+                "envVars",
+                "name",
+                "source"
+            ],
+            "properties": {
+                "architecture": {
+                    "description": "Architecture of the target host, e.g. arm64. Selects the matching variant of prebuild matrices",
+                    "type": "string"
+                },
+                "buildConfig": {
+                    "$ref": "#/definitions/BuildConfig"
+                },
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCommand"
+                    }
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "gitProviderConfigId": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Mount"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectPort"
+                    }
+                },
+                "resources": {
+                    "description": "CPU and memory reserved for the project on the target host",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "source": {
+                    "$ref": "#/definitions/CreateProjectSourceDTO"
+                },
+                "user": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreateProjectSourceDTO": {
+            "type": "object",
+            "required": [
+                "repository"
+            ],
+            "properties": {
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                }
+            }
+        },
+        "CreateProviderTargetDTO": {
+            "type": "object",
+            "required": [
+                "name",
+                "options",
+                "providerInfo"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "string"
+                },
+                "providerInfo": {
+                    "$ref": "#/definitions/provider.ProviderInfo"
+                }
+            }
+        },
+        "CreateSharedServiceDTO": {
+            "type": "object",
+            "required": [
+                "name",
+                "target"
+            ],
+            "properties": {
+                "connectionEnvVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "dataPath": {
+                    "type": "string"
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "template": {
+                    "description": "Preset for a common service, one of postgres, mysql or redis. Other fields override the values of the template",
+                    "type": "string"
+                }
+            }
+        },
+        "CreateWorkspaceDTO": {
+            "type": "object",
+            "required": [
+                "id",
+                "name",
+                "projects",
+                "target"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreateProjectDTO"
+                    }
+                },
+                "region": {
+                    "description": "Federated region to create the workspace in. Defaults to the region of the server",
+                    "type": "string"
+                },
+                "sharedNode": {
+                    "description": "Route the ports of all projects through the tailnet node of the first project instead of\nconnecting each project to the tailnet",
+                    "type": "boolean"
+                },
+                "sharedServices": {
+                    "description": "Names of shared services of the target to attach the workspace to",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "target": {
+                    "type": "string"
+                },
+                "ttl": {
+                    "description": "Duration after which the workspace is removed, e.g. 48h",
+                    "type": "string"
+                }
+            }
+        },
+        "CreationPhaseReport": {
+            "type": "object",
+            "required": [
+                "count",
+                "maxMs",
+                "p50Ms",
+                "p90Ms",
+                "p99Ms",
+                "phase",
+                "provider",
+                "target"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "maxMs": {
+                    "type": "integer"
+                },
+                "p50Ms": {
+                    "type": "integer"
+                },
+                "p90Ms": {
+                    "type": "integer"
+                },
+                "p99Ms": {
+                    "type": "integer"
+                },
+                "phase": {
+                    "$ref": "#/definitions/creationtiming.Phase"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreationValidation": {
+            "type": "object",
+            "required": [
+                "estimates",
+                "issues",
+                "valid"
+            ],
+            "properties": {
+                "estimates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCreationEstimate"
+                    }
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ValidationIssue"
+                    }
+                },
+                "valid": {
+                    "description": "False if an issue would make the workspace creation fail",
+                    "type": "boolean"
+                }
+            }
+        },
+        "DerpConfig": {
+            "type": "object",
+            "properties": {
+                "disableEmbedded": {
+                    "description": "Stops advertising the relay embedded in the server. At least one region is required if set",
+                    "type": "boolean"
+                },
+                "regions": {
+                    "description": "Self-hosted relay regions",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/DerpRegion"
+                    }
+                }
+            }
+        },
+        "DerpNode": {
+            "type": "object",
+            "required": [
+                "hostName",
+                "name"
+            ],
+            "properties": {
+                "derpPort": {
+                    "description": "Defaults to 443",
+                    "type": "integer"
+                },
+                "hostName": {
+                    "type": "string"
+                },
+                "ipv4": {
+                    "description": "Skips DNS resolution of the host name if set",
+                    "type": "string"
+                },
+                "ipv6": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "stunPort": {
+                    "description": "Defaults to 3478",
+                    "type": "integer"
+                }
+            }
+        },
+        "DerpRegion": {
+            "type": "object",
+            "required": [
+                "code",
+                "id",
+                "name",
+                "nodes"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique region ID, 999 is reserved for the embedded relay",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/DerpNode"
+                    }
+                }
+            }
+        },
+        "DetectedPort": {
+            "type": "object",
+            "required": [
+                "detectedAt",
+                "port"
+            ],
+            "properties": {
+                "detectedAt": {
+                    "description": "When the port was first detected, in RFC3339 format",
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                }
+            }
+        },
+        "DevcontainerConfig": {
+            "type": "object",
+            "required": [
+                "filePath"
+            ],
+            "properties": {
+                "filePath": {
+                    "type": "string"
+                }
+            }
+        },
+        "DriftDifference": {
+            "type": "object",
+            "required": [
+                "actual",
+                "expected",
+                "key",
+                "kind"
+            ],
+            "properties": {
+                "actual": {
+                    "description": "Actual is the value in the running project",
+                    "type": "string"
+                },
+                "expected": {
+                    "description": "Expected is the value in the current devcontainer configuration",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "kind": {
+                    "$ref": "#/definitions/DriftKind"
+                }
+            }
+        },
+        "DriftKind": {
+            "type": "string",
+            "enum": [
+                "image",
+                "dockerfile",
+                "feature",
+                "env"
+            ],
+            "x-enum-varnames": [
+                "DriftKindImage",
+                "DriftKindDockerfile",
+                "DriftKindFeature",
+                "DriftKindEnv"
+            ]
+        },
+        "EmbeddedRegistryConfig": {
+            "type": "object",
+            "properties": {
+                "gcIntervalMinutes": {
+                    "description": "Interval between garbage collection runs. 0 disables garbage collection",
+                    "type": "integer"
+                },
+                "quotaMb": {
+                    "description": "Storage limit in megabytes. 0 disables the limit",
+                    "type": "integer"
+                }
+            }
+        },
+        "EnvironmentChanges": {
+            "type": "object",
+            "properties": {
+                "local": {
+                    "description": "Files changed in the project directory",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "upstream": {
+                    "description": "Files changed on the upstream branch of the project that are not pulled yet",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "FRPSConfig": {
+            "type": "object",
+            "required": [
+                "domain",
+                "port",
+                "protocol"
+            ],
+            "properties": {
+                "domain": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "type": "string"
+                }
+            }
+        },
+        "FederatedRegion": {
+            "type": "object",
+            "required": [
+                "apiUrl",
+                "lastSeenAt",
+                "local",
+                "name",
+                "targets"
+            ],
+            "properties": {
+                "apiUrl": {
+                    "description": "API URL of the regional server",
+                    "type": "string"
+                },
+                "defaultTarget": {
+                    "type": "string"
+                },
+                "lastSeenAt": {
+                    "type": "string"
+                },
+                "local": {
+                    "description": "Set for the region of the server that handled the request",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "targets": {
+                    "description": "Names of the targets of the regional server",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "FederationConfig": {
+            "type": "object",
+            "required": [
+                "region"
+            ],
+            "properties": {
+                "apiUrl": {
+                    "description": "API URL the primary server and clients use to reach this server. Defaults to the public API URL of the server",
+                    "type": "string"
+                },
+                "primaryApiKey": {
+                    "description": "Admin API key of the primary server. Required if primaryUrl is set",
+                    "type": "string"
+                },
+                "primaryUrl": {
+                    "description": "API URL of the primary server. Empty on the primary server",
+                    "type": "string"
+                },
+                "region": {
+                    "description": "Name of the region of this server",
+                    "type": "string"
+                }
+            }
+        },
+        "FileInfo": {
+            "type": "object",
+            "required": [
+                "isDir",
+                "modTime",
+                "name",
+                "path",
+                "permissions",
+                "size",
+                "writable"
+            ],
+            "properties": {
+                "isDir": {
+                    "type": "boolean"
+                },
+                "modTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "permissions": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "writable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "FileList": {
+            "type": "object",
+            "required": [
+                "files",
+                "limit",
+                "offset",
+                "path",
+                "total"
+            ],
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FileInfo"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "FileStatus": {
+            "type": "object",
+            "required": [
+                "extra",
+                "name",
+                "staging",
+                "worktree"
+            ],
+            "properties": {
+                "extra": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "staging": {
+                    "$ref": "#/definitions/Status"
+                },
+                "worktree": {
+                    "$ref": "#/definitions/Status"
+                }
+            }
+        },
+        "GetRepositoryContext": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "prNumber": {
+                    "type": "integer"
+                },
+                "sha": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitBranch": {
+            "type": "object",
+            "required": [
+                "name",
+                "sha"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "sha": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitNamespace": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitProvider": {
+            "type": "object",
+            "required": [
+                "alias",
+                "id",
+                "providerId",
+                "token",
+                "username"
+            ],
+            "properties": {
+                "alias": {
+                    "type": "string"
+                },
+                "baseApiUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "providerId": {
+                    "type": "string"
+                },
+                "signingKey": {
+                    "type": "string"
+                },
+                "signingMethod": {
+                    "$ref": "#/definitions/SigningMethod"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitPullRequest": {
+            "type": "object",
+            "required": [
+                "branch",
+                "name",
+                "sha",
+                "sourceRepoId",
+                "sourceRepoName",
+                "sourceRepoOwner",
+                "sourceRepoUrl"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sha": {
+                    "type": "string"
+                },
+                "sourceRepoId": {
+                    "type": "string"
+                },
+                "sourceRepoName": {
+                    "type": "string"
+                },
+                "sourceRepoOwner": {
+                    "type": "string"
+                },
+                "sourceRepoUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitRepository": {
+            "type": "object",
+            "required": [
+                "branch",
+                "id",
+                "name",
+                "owner",
+                "sha",
+                "source",
+                "url"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "cloneTarget": {
+                    "$ref": "#/definitions/CloneTarget"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "prNumber": {
+                    "type": "integer"
+                },
+                "sha": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "GitStatus": {
+            "type": "object",
+            "required": [
+                "currentBranch",
+                "fileStatus"
+            ],
+            "properties": {
+                "ahead": {
+                    "type": "integer"
+                },
+                "behind": {
+                    "type": "integer"
+                },
+                "branchPublished": {
+                    "type": "boolean"
+                },
+                "currentBranch": {
+                    "type": "string"
+                },
+                "fileStatus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/FileStatus"
+                    }
+                }
+            }
+        },
+        "GitUser": {
+            "type": "object",
+            "required": [
+                "email",
+                "id",
+                "name",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "ImagePolicyConfig": {
+            "type": "object",
+            "properties": {
+                "allowedRegistries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cosignPublicKey": {
+                    "type": "string"
+                },
+                "verifySignatures": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ImpersonationConfig": {
+            "type": "object",
+            "required": [
+                "admins"
+            ],
+            "properties": {
+                "admins": {
+                    "description": "Names of the administrators that can impersonate users. Only callers authenticated with an admin API key or the\nadmin token can impersonate, the admin token identity is named \"admin\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxDurationMinutes": {
+                    "description": "Longest session an administrator can start. Defaults to 240 minutes",
+                    "type": "integer"
+                }
+            }
+        },
+        "ImpersonationSession": {
+            "type": "object",
+            "required": [
+                "admin",
+                "createdAt",
+                "expiresAt",
+                "id",
+                "reason",
+                "user"
+            ],
+            "properties": {
+                "admin": {
+                    "description": "Name of the administrator acting on behalf of the user",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endedAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "Why the user is impersonated, e.g. a support ticket. Shown to the user and recorded in the audit log",
+                    "type": "string"
+                },
+                "user": {
+                    "description": "API key, OIDC user or certificate name of the impersonated user",
+                    "type": "string"
+                }
+            }
+        },
+        "InstallProviderRequest": {
+            "type": "object",
+            "required": [
+                "downloadUrls",
+                "name"
+            ],
+            "properties": {
+                "downloadUrls": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "IssueProjectCertificateDTO": {
+            "type": "object",
+            "required": [
+                "csr"
+            ],
+            "properties": {
+                "csr": {
+                    "description": "PEM encoded certificate signing request. Only its public key is used",
+                    "type": "string"
+                }
+            }
+        },
+        "LogFileConfig": {
+            "type": "object",
+            "required": [
+                "maxAge",
+                "maxBackups",
+                "maxSize",
+                "path"
+            ],
+            "properties": {
+                "compress": {
+                    "type": "boolean"
+                },
+                "localTime": {
+                    "type": "boolean"
+                },
+                "maxAge": {
+                    "type": "integer"
+                },
+                "maxBackups": {
+                    "type": "integer"
+                },
+                "maxSize": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "LogLevelsConfig": {
+            "type": "object",
+            "properties": {
+                "agent": {
+                    "description": "Level of the agent logs of projects created or started after the change. Defaults to info",
+                    "type": "string"
+                },
+                "api": {
+                    "description": "Level of the API request logs. Successful requests are logged at info, failed requests at error. Defaults to the\nlevel of the server logs",
+                    "type": "string"
+                },
+                "frp": {
+                    "description": "Level of the frps tunnel logs. Defaults to error",
+                    "type": "string"
+                },
+                "headscale": {
+                    "description": "Level of the headscale coordination server logs. Defaults to error",
+                    "type": "string"
+                },
+                "server": {
+                    "description": "Level of the server logs. Defaults to info",
+                    "type": "string"
+                }
+            }
+        },
+        "MeteringConfig": {
+            "type": "object",
+            "required": [
+                "exporter"
+            ],
+            "properties": {
+                "exporter": {
+                    "$ref": "#/definitions/server.MeteringExporter"
+                },
+                "headers": {
+                    "description": "Headers sent with every http and kafka export request, e.g. for authentication",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "path": {
+                    "description": "File path for the file exporter",
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Endpoint for the http exporter or Kafka REST Proxy URL for the kafka exporter",
+                    "type": "string"
+                }
+            }
+        },
+        "Mount": {
+            "type": "object",
+            "required": [
+                "target",
+                "type"
+            ],
+            "properties": {
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "size": {
+                    "description": "Size limit of tmpfs mounts, e.g. 512m. Unlimited if empty",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Host path for bind mounts and volume name for volume mounts. Unused for tmpfs mounts",
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/MountType"
+                }
+            }
+        },
+        "MountType": {
+            "type": "string",
+            "enum": [
+                "bind",
+                "volume",
+                "tmpfs"
+            ],
+            "x-enum-varnames": [
+                "MountTypeBind",
+                "MountTypeVolume",
+                "MountTypeTmpfs"
+            ]
+        },
+        "MoveFileRequest": {
+            "type": "object",
+            "required": [
+                "destination",
+                "source"
+            ],
+            "properties": {
+                "destination": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "MtlsAuthConfig": {
+            "type": "object",
+            "required": [
+                "caFile",
+                "certFile",
+                "keyFile",
+                "port"
+            ],
+            "properties": {
+                "allowedCommonNames": {
+                    "description": "If set, only certificates with these common names are allowed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "caFile": {
+                    "type": "string"
+                },
+                "certFile": {
+                    "type": "string"
+                },
+                "keyFile": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                }
+            }
+        },
+        "NetworkKey": {
+            "type": "object",
+            "required": [
+                "key"
+            ],
+            "properties": {
+                "expiresAt": {
+                    "description": "Time after which the key can no longer be used to join the network, so that clients can renew cached keys in time",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "OidcAuthConfig": {
+            "type": "object",
+            "required": [
+                "clientId",
+                "issuerUrl"
+            ],
+            "properties": {
+                "allowedUsers": {
+                    "description": "If set, only these users are allowed",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "clientId": {
+                    "type": "string"
+                },
+                "issuerUrl": {
+                    "type": "string"
+                },
+                "usernameClaim": {
+                    "description": "ID token claim used as the identity name. Defaults to \"email\"",
+                    "type": "string"
+                }
+            }
+        },
+        "Organization": {
+            "type": "object",
+            "required": [
+                "admins",
+                "id",
+                "members",
+                "name",
+                "quota"
+            ],
+            "properties": {
+                "admins": {
+                    "description": "Names of the members that can manage the members, the quota and the organization itself",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "description": "Names of the client API keys that belong to the organization",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "quota": {
+                    "$ref": "#/definitions/OrganizationQuota"
+                }
+            }
+        },
+        "OrganizationQuota": {
+            "type": "object",
+            "required": [
+                "maxWorkspaces"
+            ],
+            "properties": {
+                "maxWorkspaces": {
+                    "description": "Maximum number of workspaces in the organization. 0 means unlimited",
+                    "type": "integer"
+                }
+            }
+        },
+        "PhaseDuration": {
+            "type": "object",
+            "required": [
+                "durationMs",
+                "phase"
+            ],
+            "properties": {
+                "durationMs": {
+                    "type": "integer"
+                },
+                "phase": {
+                    "$ref": "#/definitions/creationtiming.Phase"
+                }
+            }
+        },
+        "PortAccessAction": {
+            "type": "string",
+            "enum": [
+                "allow",
+                "deny"
+            ],
+            "x-enum-varnames": [
+                "PortAccessActionAllow",
+                "PortAccessActionDeny"
+            ]
+        },
+        "PortAccessPolicy": {
+            "type": "object",
+            "required": [
+                "rules"
+            ],
+            "properties": {
+                "defaultAction": {
+                    "description": "Action for connections that match no rule. Connections are allowed if empty",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PortAccessAction"
+                        }
+                    ]
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PortAccessRule"
+                    }
+                }
+            }
+        },
+        "PortAccessRule": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/PortAccessAction"
+                },
+                "peers": {
+                    "description": "Globs of the names the control server gave the tailnet peers, e.g. server or cli-*. The rule matches every peer if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "portRangeEnd": {
+                    "description": "Last port of the range. Defaults to the first port",
+                    "type": "integer"
+                },
+                "portRangeStart": {
+                    "description": "First port of the range. The rule matches every port if both ends of the range are 0",
+                    "type": "integer"
+                }
+            }
+        },
+        "PortList": {
+            "type": "object",
+            "required": [
+                "ports"
+            ],
+            "properties": {
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "PortProtocol": {
+            "type": "string",
+            "enum": [
+                "http",
+                "https",
+                "tcp"
+            ],
+            "x-enum-varnames": [
+                "PortProtocolHttp",
+                "PortProtocolHttps",
+                "PortProtocolTcp"
+            ]
+        },
+        "PortVisibility": {
+            "type": "string",
+            "enum": [
+                "private",
+                "public"
+            ],
+            "x-enum-varnames": [
+                "PortVisibilityPrivate",
+                "PortVisibilityPublic"
+            ]
+        },
+        "PrebuildConfig": {
+            "type": "object",
+            "required": [
+                "branch",
+                "commitInterval",
+                "id",
+                "retention",
+                "triggerFiles"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "buildSecrets": {
+                    "description": "Names of the build secrets that builds of the prebuild can read in addition to those of the project config",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "commitInterval": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "matrix": {
+                    "$ref": "#/definitions/PrebuildMatrix"
+                },
+                "retention": {
+                    "type": "integer"
+                },
+                "triggerFiles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "PrebuildDTO": {
+            "type": "object",
+            "required": [
+                "branch",
+                "id",
+                "projectConfigName",
+                "retention"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "buildSecrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "commitInterval": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "matrix": {
+                    "$ref": "#/definitions/PrebuildMatrix"
+                },
+                "projectConfigName": {
+                    "type": "string"
+                },
+                "retention": {
+                    "type": "integer"
+                },
+                "triggerFiles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "PrebuildMatrix": {
+            "type": "object",
+            "properties": {
+                "architectures": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "devcontainerPaths": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "images": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "PrebuildRepositoryStatsDTO": {
             "type": "object",
             "required": [
-                "keyHash",
+                "branch",
+                "hits",
+                "misses",
+                "repositoryUrl",
+                "timeSavedSeconds"
+            ],
+            "properties": {
+                "branch": {
+                    "type": "string"
+                },
+                "hits": {
+                    "type": "integer"
+                },
+                "lastCommitSha": {
+                    "type": "string"
+                },
+                "misses": {
+                    "type": "integer"
+                },
+                "repositoryUrl": {
+                    "type": "string"
+                },
+                "timeSavedSeconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "PrebuildStatsDTO": {
+            "type": "object",
+            "required": [
+                "hitRate",
+                "hits",
+                "misses",
+                "repositories",
+                "timeSavedSeconds",
+                "total"
+            ],
+            "properties": {
+                "hitRate": {
+                    "type": "number"
+                },
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                },
+                "repositories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PrebuildRepositoryStatsDTO"
+                    }
+                },
+                "timeSavedSeconds": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "PreviewDnsConfig": {
+            "type": "object",
+            "required": [
+                "provider",
+                "target"
+            ],
+            "properties": {
+                "acmeDirectoryUrl": {
+                    "description": "Defaults to Let's Encrypt",
+                    "type": "string"
+                },
+                "acmeEmail": {
+                    "type": "string"
+                },
+                "cloudflareApiToken": {
+                    "type": "string"
+                },
+                "cloudflareZoneId": {
+                    "type": "string"
+                },
+                "issueCertificate": {
+                    "description": "Issues a *.\u003cfrps domain\u003e certificate through the ACME DNS challenge and renews it 30 days before it expires. The\nserver terminates TLS of the public URLs of forwarded ports with it, which requires frps to serve HTTPS on its\nvhost HTTPS port and the frps protocol to be https",
+                    "type": "boolean"
+                },
+                "provider": {
+                    "$ref": "#/definitions/server.PreviewDnsProvider"
+                },
+                "records": {
+                    "description": "\"wildcard\" creates a *.\u003cfrps domain\u003e record when the server starts and deletes it when the server is purged.\n\"workspace\" creates a record for each public port of a workspace and deletes them with the workspace. Defaults to wildcard",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/server.PreviewDnsRecords"
+                        }
+                    ]
+                },
+                "route53AccessKeyId": {
+                    "description": "Credentials of the default AWS credential chain are used if empty",
+                    "type": "string"
+                },
+                "route53HostedZoneId": {
+                    "type": "string"
+                },
+                "route53SecretAccessKey": {
+                    "type": "string"
+                },
+                "target": {
+                    "description": "IP address or hostname of the frps server. A or AAAA records are created for IP addresses, CNAME records for hostnames",
+                    "type": "string"
+                }
+            }
+        },
+        "ProcessHealth": {
+            "type": "object",
+            "required": [
+                "healthy",
+                "name"
+            ],
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "healthy": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProfileData": {
+            "type": "object",
+            "required": [
+                "envVars"
+            ],
+            "properties": {
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "Project": {
+            "type": "object",
+            "required": [
+                "envVars",
+                "image",
                 "name",
-                "type"
+                "repository",
+                "target",
+                "user",
+                "workspaceId"
             ],
             "properties": {
-                "keyHash": {
+                "accessPolicy": {
+                    "description": "AccessPolicy restricts the tailnet peers that can connect to ports of the project. Every peer can connect if not set",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PortAccessPolicy"
+                        }
+                    ]
+                },
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "architecture": {
+                    "description": "Architecture of the target host, e.g. arm64. Only builds of the prebuild matrix variant of the architecture are\nused for the project",
+                    "type": "string"
+                },
+                "buildConfig": {
+                    "$ref": "#/definitions/BuildConfig"
+                },
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCommand"
+                    }
+                },
+                "envVars": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "gitProviderConfigId": {
+                    "type": "string"
+                },
+                "hostname": {
+                    "description": "Hostname of the project agent on the tailnet. Derived from the workspace ID and project name if empty",
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Mount"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "networking": {
+                    "$ref": "#/definitions/ProjectNetworking"
+                },
+                "paused": {
+                    "description": "Paused is set if the project was stopped with a checkpoint of its processes that it is resumed from on start",
+                    "type": "boolean"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectPort"
+                    }
+                },
+                "publicPorts": {
+                    "description": "Ports other than the declared public ports that were forwarded to their public URLs. Their preview DNS records\nare deleted with the workspace",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "repository": {
+                    "$ref": "#/definitions/GitRepository"
+                },
+                "resources": {
+                    "description": "Resources reserved for the project on the target host. The provider limits the project container to them",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "route": {
+                    "description": "Route is set if the project shares the tailnet node of another project of the workspace",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ProjectRoute"
+                        }
+                    ]
+                },
+                "sshHostPublicKey": {
+                    "description": "Public SSH host key of the project agent in the authorized_keys format",
+                    "type": "string"
+                },
+                "state": {
+                    "$ref": "#/definitions/ProjectState"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "user": {
+                    "type": "string"
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectAllocation": {
+            "type": "object",
+            "required": [
+                "project",
+                "workspaceId",
+                "workspaceName"
+            ],
+            "properties": {
+                "project": {
+                    "type": "string"
+                },
+                "reserved": {
+                    "description": "Not set if the project has no resource limits",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "used": {
+                    "description": "Not set if the project is stopped or its agent does not report usage",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "workspaceId": {
+                    "type": "string"
+                },
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectCertificate": {
+            "type": "object",
+            "required": [
+                "certificate",
+                "expiresAt"
+            ],
+            "properties": {
+                "certificate": {
+                    "description": "PEM encoded certificate for the tailnet name of the project",
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "ProjectCommand": {
+            "type": "object",
+            "required": [
+                "command",
+                "name"
+            ],
+            "properties": {
+                "command": {
+                    "type": "string"
+                },
+                "description": {
                     "type": "string"
                 },
                 "name": {
-                    "description": "Project or client name",
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/apikey.ApiKeyType"
+                "workdir": {
+                    "description": "Working directory relative to the project directory. Defaults to the project directory",
+                    "type": "string"
                 }
             }
         },
-        "Build": {
+        "ProjectConfig": {
             "type": "object",
             "required": [
-                "containerConfig",
-                "createdAt",
+                "default",
                 "envVars",
-                "id",
-                "prebuildId",
-                "repository",
-                "state",
-                "updatedAt"
+                "image",
+                "name",
+                "repositoryUrl",
+                "user"
             ],
             "properties": {
                 "buildConfig": {
                     "$ref": "#/definitions/BuildConfig"
                 },
-                "containerConfig": {
-                    "$ref": "#/definitions/ContainerConfig"
+                "buildSecrets": {
+                    "description": "Names of the build secrets that builds of the project config can read through secret mounts",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "createdAt": {
-                    "type": "string"
+                "commands": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectCommand"
+                    }
+                },
+                "default": {
+                    "type": "boolean"
                 },
                 "envVars": {
                     "type": "object",
@@ -1931,22 +8068,37 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "id": {
+                "gitProviderConfigId": {
                     "type": "string"
                 },
                 "image": {
                     "type": "string"
                 },
-                "prebuildId": {
+                "mounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Mount"
+                    }
+                },
+                "name": {
                     "type": "string"
                 },
-                "repository": {
-                    "$ref": "#/definitions/GitRepository"
+                "organizationId": {
+                    "type": "string"
                 },
-                "state": {
-                    "$ref": "#/definitions/build.BuildState"
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectPort"
+                    }
                 },
-                "updatedAt": {
+                "prebuilds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PrebuildConfig"
+                    }
+                },
+                "repositoryUrl": {
                     "type": "string"
                 },
                 "user": {
@@ -1954,363 +8106,529 @@ const docTemplate = `{
                 }
             }
         },
-        "BuildConfig": {
+        "ProjectCreationEstimate": {
             "type": "object",
+            "required": [
+                "projectName"
+            ],
             "properties": {
-                "cachedBuild": {
-                    "$ref": "#/definitions/CachedBuild"
+                "buildMs": {
+                    "description": "Not set if the project image is not built or no project was built on the target yet",
+                    "type": "integer"
                 },
-                "devcontainer": {
-                    "$ref": "#/definitions/DevcontainerConfig"
+                "creationMs": {
+                    "description": "Not set if no project was created on the target yet",
+                    "type": "integer"
+                },
+                "projectName": {
+                    "type": "string"
                 }
             }
         },
-        "CachedBuild": {
+        "ProjectDrift": {
             "type": "object",
             "required": [
-                "image",
-                "user"
+                "differences",
+                "drifted"
             ],
             "properties": {
-                "image": {
+                "differences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/DriftDifference"
+                    }
+                },
+                "drifted": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "ProjectInfo": {
+            "type": "object",
+            "required": [
+                "created",
+                "isRunning",
+                "name",
+                "workspaceId"
+            ],
+            "properties": {
+                "created": {
                     "type": "string"
                 },
-                "user": {
+                "isRunning": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "providerMetadata": {
+                    "type": "string"
+                },
+                "workspaceId": {
                     "type": "string"
                 }
             }
         },
-        "CloneTarget": {
+        "ProjectNetworking": {
             "type": "string",
             "enum": [
-                "branch",
-                "commit"
+                "tailnet",
+                "agentless",
+                "routed"
             ],
             "x-enum-varnames": [
-                "CloneTargetBranch",
-                "CloneTargetCommit"
+                "NetworkingTailnet",
+                "NetworkingAgentless",
+                "NetworkingRouted"
             ]
         },
-        "ContainerConfig": {
+        "ProjectPort": {
             "type": "object",
             "required": [
-                "image",
-                "user"
+                "name",
+                "port"
             ],
             "properties": {
-                "image": {
+                "name": {
                     "type": "string"
                 },
-                "user": {
-                    "type": "string"
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "Defaults to http",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PortProtocol"
+                        }
+                    ]
+                },
+                "visibility": {
+                    "description": "Defaults to private",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PortVisibility"
+                        }
+                    ]
                 }
             }
         },
-        "ContainerRegistry": {
+        "ProjectRoute": {
             "type": "object",
             "required": [
-                "password",
-                "server",
-                "username"
+                "gateway",
+                "portRangeEnd",
+                "portRangeStart"
             ],
             "properties": {
-                "password": {
+                "gateway": {
+                    "description": "Gateway is the name of the project whose agent runs the tailnet node",
                     "type": "string"
                 },
-                "server": {
-                    "type": "string"
+                "portRangeEnd": {
+                    "type": "integer"
                 },
-                "username": {
-                    "type": "string"
+                "portRangeStart": {
+                    "type": "integer"
                 }
             }
         },
-        "CreateBuildDTO": {
+        "ProjectService": {
             "type": "object",
             "required": [
-                "branch",
-                "envVars",
-                "projectConfigName"
+                "name",
+                "port",
+                "protocol"
             ],
             "properties": {
-                "branch": {
+                "name": {
                     "type": "string"
                 },
-                "envVars": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "prebuildId": {
-                    "type": "string"
+                "port": {
+                    "type": "integer"
                 },
-                "projectConfigName": {
-                    "type": "string"
+                "protocol": {
+                    "$ref": "#/definitions/ServiceProtocol"
                 }
             }
         },
-        "CreatePrebuildDTO": {
+        "ProjectState": {
             "type": "object",
             "required": [
-                "retention"
+                "gitStatus",
+                "updatedAt",
+                "uptime"
             ],
             "properties": {
-                "branch": {
+                "address": {
+                    "description": "Address of the project container. Only reported by agents of routed projects",
                     "type": "string"
                 },
-                "commitInterval": {
-                    "type": "integer"
+                "agentVersion": {
+                    "description": "AgentVersion is the version of the agent that reported the state",
+                    "type": "string"
                 },
-                "id": {
+                "detectedPorts": {
+                    "description": "Ports processes of the project listen on, for clients to offer forwarding them. Not reported by older agents",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/DetectedPort"
+                    }
+                },
+                "environmentChanges": {
+                    "description": "Files of the environment definition that changed since the project container was built. Not reported by older agents",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/EnvironmentChanges"
+                        }
+                    ]
+                },
+                "gitStatus": {
+                    "$ref": "#/definitions/GitStatus"
+                },
+                "lastActivityAt": {
+                    "description": "LastActivityAt is when the agent last observed terminal, IDE or file activity. Not set by older agents",
                     "type": "string"
                 },
-                "retention": {
-                    "type": "integer"
+                "lastActivitySource": {
+                    "type": "string"
                 },
-                "triggerFiles": {
+                "nodeKeyExpiry": {
+                    "description": "NodeKeyExpiry is when the key of the tailnet node of the agent expires, in RFC3339. The agent renews the key\nbefore then. Not set if the key does not expire or by older agents",
+                    "type": "string"
+                },
+                "ready": {
+                    "description": "Ready is set once the agent accepts SSH connections and can be reached over the tailnet or its tunnel",
+                    "type": "boolean"
+                },
+                "services": {
+                    "description": "Services the agent registered for other workspaces to resolve by name",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/ProjectService"
                     }
+                },
+                "tunneled": {
+                    "description": "Tunneled is set if the agent can't reach the tailnet and the project is reached through its tunnel to the server",
+                    "type": "boolean"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "uptime": {
+                    "type": "integer"
+                },
+                "usage": {
+                    "description": "Resources used by the project container. Not reported by older agents or outside of a container",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
                 }
             }
         },
-        "CreateProjectConfigDTO": {
+        "Provider": {
             "type": "object",
             "required": [
-                "envVars",
                 "name",
-                "repositoryUrl"
+                "version"
             ],
             "properties": {
-                "buildConfig": {
-                    "$ref": "#/definitions/BuildConfig"
-                },
-                "envVars": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "gitProviderConfigId": {
-                    "type": "string"
-                },
-                "image": {
+                "label": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "repositoryUrl": {
-                    "type": "string"
-                },
-                "user": {
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "CreateProjectDTO": {
+        "ProviderTarget": {
             "type": "object",
             "required": [
-                "envVars",
+                "isDefault",
                 "name",
-                "source"
+                "options",
+                "providerInfo"
             ],
             "properties": {
-                "buildConfig": {
-                    "$ref": "#/definitions/BuildConfig"
+                "bandwidthLimit": {
+                    "description": "Default limit of the traffic the agent of each project on the target proxies from and to the tailnet",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    ]
                 },
-                "envVars": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "isDefault": {
+                    "type": "boolean"
                 },
-                "gitProviderConfigId": {
+                "name": {
                     "type": "string"
                 },
-                "image": {
+                "options": {
+                    "description": "JSON encoded map of options",
                     "type": "string"
                 },
-                "name": {
+                "organizationId": {
                     "type": "string"
                 },
-                "source": {
-                    "$ref": "#/definitions/CreateProjectSourceDTO"
-                },
-                "user": {
-                    "type": "string"
+                "providerInfo": {
+                    "$ref": "#/definitions/provider.ProviderInfo"
                 }
             }
         },
-        "CreateProjectSourceDTO": {
+        "ProviderTargetManifest": {
             "type": "object",
-            "required": [
-                "repository"
-            ],
-            "properties": {
-                "repository": {
-                    "$ref": "#/definitions/GitRepository"
-                }
+            "additionalProperties": {
+                "$ref": "#/definitions/provider.ProviderTargetProperty"
             }
         },
-        "CreateProviderTargetDTO": {
+        "ReadyHook": {
             "type": "object",
             "required": [
-                "name",
-                "options",
-                "providerInfo"
+                "url"
             ],
             "properties": {
-                "name": {
+                "projectName": {
+                    "description": "Only wait for the project. Waits for every project of the workspace if empty",
                     "type": "string"
                 },
-                "options": {
-                    "type": "string"
+                "timeoutSeconds": {
+                    "description": "Seconds after which the hook is dropped if the projects did not become ready. Defaults to 10 minutes",
+                    "type": "integer"
                 },
-                "providerInfo": {
-                    "$ref": "#/definitions/provider.ProviderInfo"
+                "url": {
+                    "description": "Url the readiness of the workspace is posted to as JSON",
+                    "type": "string"
                 }
             }
         },
-        "CreateWorkspaceDTO": {
+        "RegisterRegionDTO": {
             "type": "object",
             "required": [
-                "id",
+                "apiKey",
+                "apiUrl",
                 "name",
-                "projects",
-                "target"
+                "targets"
             ],
             "properties": {
-                "id": {
+                "apiKey": {
+                    "description": "Client API key the primary server uses to call the regional server",
+                    "type": "string"
+                },
+                "apiUrl": {
+                    "type": "string"
+                },
+                "defaultTarget": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "projects": {
+                "targets": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/CreateProjectDTO"
+                        "type": "string"
                     }
-                },
-                "target": {
-                    "type": "string"
                 }
             }
         },
-        "DevcontainerConfig": {
+        "RelayHealth": {
             "type": "object",
             "required": [
-                "filePath"
+                "checkedAt",
+                "latencyMs",
+                "reachable",
+                "region"
             ],
             "properties": {
-                "filePath": {
+                "checkedAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "latencyMs": {
+                    "description": "Lowest round trip time of the probes in milliseconds. Zero if the region is unreachable",
+                    "type": "integer"
+                },
+                "reachable": {
+                    "type": "boolean"
+                },
+                "region": {
                     "type": "string"
                 }
             }
         },
-        "FRPSConfig": {
+        "RepositoryUrl": {
             "type": "object",
             "required": [
-                "domain",
-                "port",
-                "protocol"
+                "url"
             ],
             "properties": {
-                "domain": {
-                    "type": "string"
-                },
-                "port": {
-                    "type": "integer"
-                },
-                "protocol": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "FileStatus": {
+        "ResourceUsageReport": {
             "type": "object",
             "required": [
-                "extra",
-                "name",
-                "staging",
-                "worktree"
+                "cpus",
+                "diskTotal",
+                "diskUsed",
+                "inodesTotal",
+                "inodesUsed",
+                "memoryUsed",
+                "sampledAt"
             ],
             "properties": {
-                "extra": {
-                    "type": "string"
+                "cpus": {
+                    "description": "CPU cores used on average since the previous sample",
+                    "type": "number"
                 },
-                "name": {
-                    "type": "string"
+                "diskTotal": {
+                    "type": "integer"
                 },
-                "staging": {
-                    "$ref": "#/definitions/Status"
+                "diskUsed": {
+                    "type": "integer"
                 },
-                "worktree": {
-                    "$ref": "#/definitions/Status"
+                "inodesTotal": {
+                    "type": "integer"
+                },
+                "inodesUsed": {
+                    "type": "integer"
+                },
+                "memoryLimit": {
+                    "description": "Memory the project can use. Zero if the project container has no memory limit",
+                    "type": "integer"
+                },
+                "memoryUsed": {
+                    "type": "integer"
+                },
+                "sampledAt": {
+                    "type": "string"
                 }
             }
         },
-        "GetRepositoryContext": {
+        "ResourceUsageSample": {
             "type": "object",
             "required": [
-                "url"
+                "cpus",
+                "diskTotal",
+                "diskUsed",
+                "id",
+                "inodesTotal",
+                "inodesUsed",
+                "memoryUsed",
+                "projectName",
+                "sampledAt",
+                "workspaceId"
             ],
             "properties": {
-                "branch": {
-                    "type": "string"
+                "cpus": {
+                    "type": "number"
+                },
+                "diskTotal": {
+                    "type": "integer"
+                },
+                "diskUsed": {
+                    "type": "integer"
                 },
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "inodesTotal": {
+                    "type": "integer"
                 },
-                "owner": {
-                    "type": "string"
+                "inodesUsed": {
+                    "type": "integer"
                 },
-                "path": {
-                    "type": "string"
+                "memoryLimit": {
+                    "type": "integer"
                 },
-                "prNumber": {
+                "memoryUsed": {
                     "type": "integer"
                 },
-                "sha": {
+                "projectName": {
                     "type": "string"
                 },
-                "source": {
+                "sampledAt": {
                     "type": "string"
                 },
-                "url": {
+                "workspaceId": {
                     "type": "string"
                 }
             }
         },
-        "GitBranch": {
+        "Resources": {
+            "type": "object",
+            "properties": {
+                "cpus": {
+                    "description": "Number of CPU cores, e.g. 0.5",
+                    "type": "number"
+                },
+                "memory": {
+                    "description": "Memory in MiB",
+                    "type": "integer"
+                }
+            }
+        },
+        "RevokeNetworkKeysDTO": {
             "type": "object",
             "required": [
-                "name",
-                "sha"
+                "scope",
+                "scopeName"
             ],
             "properties": {
-                "name": {
+                "scope": {
                     "type": "string"
                 },
-                "sha": {
+                "scopeName": {
                     "type": "string"
                 }
             }
         },
-        "GitNamespace": {
+        "RoutingEntry": {
             "type": "object",
             "required": [
-                "id",
+                "address",
+                "portRangeEnd",
+                "portRangeStart",
+                "project"
+            ],
+            "properties": {
+                "address": {
+                    "description": "Address of the project container reported by its agent",
+                    "type": "string"
+                },
+                "portRangeEnd": {
+                    "type": "integer"
+                },
+                "portRangeStart": {
+                    "type": "integer"
+                },
+                "project": {
+                    "type": "string"
+                }
+            }
+        },
+        "Sample": {
+            "type": "object",
+            "required": [
+                "description",
+                "gitUrl",
                 "name"
             ],
             "properties": {
-                "id": {
+                "description": {
+                    "type": "string"
+                },
+                "gitUrl": {
                     "type": "string"
                 },
                 "name": {
@@ -2318,408 +8636,508 @@ const docTemplate = `{
                 }
             }
         },
-        "GitProvider": {
+        "ScopedNetworkKey": {
             "type": "object",
             "required": [
-                "alias",
+                "createdAt",
+                "expiresAt",
                 "id",
-                "providerId",
-                "token",
-                "username"
+                "persistent",
+                "scope",
+                "scopeName"
             ],
             "properties": {
-                "alias": {
+                "createdAt": {
                     "type": "string"
                 },
-                "baseApiUrl": {
+                "expiresAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "providerId": {
-                    "type": "string"
+                "persistent": {
+                    "description": "Nodes that joined with a persistent key stay registered while they are offline and keep their hostname across\nrestarts. They are only removed when the key or its scope is revoked",
+                    "type": "boolean"
                 },
-                "signingKey": {
+                "revokedAt": {
                     "type": "string"
                 },
-                "signingMethod": {
-                    "$ref": "#/definitions/SigningMethod"
-                },
-                "token": {
-                    "type": "string"
+                "scope": {
+                    "$ref": "#/definitions/networkkey.Scope"
                 },
-                "username": {
+                "scopeName": {
+                    "description": "Workspace ID, client API key name or provider name the key was issued to",
                     "type": "string"
                 }
             }
         },
-        "GitPullRequest": {
+        "ServerConfig": {
             "type": "object",
             "required": [
-                "branch",
-                "name",
-                "sha",
-                "sourceRepoId",
-                "sourceRepoName",
-                "sourceRepoOwner",
-                "sourceRepoUrl"
+                "apiPort",
+                "binariesPath",
+                "builderImage",
+                "builderRegistryServer",
+                "defaultProjectImage",
+                "defaultProjectUser",
+                "headscalePort",
+                "id",
+                "localBuilderRegistryImage",
+                "localBuilderRegistryPort",
+                "logFile",
+                "providersDir",
+                "registryUrl",
+                "serverDownloadUrl"
             ],
             "properties": {
-                "branch": {
+                "allowPersistentNodes": {
+                    "description": "Lets project agents and clients register persistent tailnet nodes that stay registered while they are offline.\nOnly server administrators can generate persistent network keys if false",
+                    "type": "boolean"
+                },
+                "allowedBindMountPaths": {
+                    "description": "Host paths that projects can bind mount, along with the paths below them. Bind mounts are refused if empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "apiPort": {
+                    "type": "integer"
+                },
+                "auth": {
+                    "$ref": "#/definitions/AuthConfig"
+                },
+                "autoscaling": {
+                    "description": "Autoscaling policies of targets whose provider places projects on a pool of hosts or VMs",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AutoscalingConfig"
+                    }
+                },
+                "binariesPath": {
                     "type": "string"
                 },
-                "name": {
+                "browserBridge": {
+                    "$ref": "#/definitions/BrowserBridgeConfig"
+                },
+                "buildImageNamespace": {
                     "type": "string"
                 },
-                "sha": {
+                "builderImage": {
                     "type": "string"
                 },
-                "sourceRepoId": {
+                "builderRegistryServer": {
+                    "type": "string"
+                },
+                "cleanupPolicies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CleanupPolicyConfig"
+                    }
+                },
+                "defaultProjectImage": {
+                    "type": "string"
+                },
+                "defaultProjectUser": {
+                    "type": "string"
+                },
+                "derp": {
+                    "$ref": "#/definitions/DerpConfig"
+                },
+                "embeddedRegistry": {
+                    "$ref": "#/definitions/EmbeddedRegistryConfig"
+                },
+                "federation": {
+                    "$ref": "#/definitions/FederationConfig"
+                },
+                "frps": {
+                    "$ref": "#/definitions/FRPSConfig"
+                },
+                "headscalePort": {
+                    "type": "integer"
+                },
+                "hostnameTemplate": {
+                    "description": "Template of the tailnet hostnames of new projects with the {user}, {workspace}, {workspaceId} and {project}\nplaceholders, e.g. \"{user}-{workspace}-{project}\". Hostnames are derived from the workspace ID and project name if empty",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "idleTimeoutMinutes": {
+                    "description": "Workspaces are stopped after a project saw no SSH, port, terminal, IDE or file activity for this many minutes.\nProjects override it with the DAYTONA_AGENT_IDLE_TIMEOUT env var, e.g. 2h. Workspaces are never stopped if 0",
+                    "type": "integer"
+                },
+                "imagePolicy": {
+                    "$ref": "#/definitions/ImagePolicyConfig"
+                },
+                "impersonation": {
+                    "description": "Lets support administrators act on behalf of users. Impersonation is disabled if unset",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ImpersonationConfig"
+                        }
+                    ]
+                },
+                "localBuilderRegistryImage": {
+                    "type": "string"
+                },
+                "localBuilderRegistryPort": {
+                    "type": "integer"
+                },
+                "logFile": {
+                    "$ref": "#/definitions/LogFileConfig"
+                },
+                "logLevels": {
+                    "$ref": "#/definitions/LogLevelsConfig"
+                },
+                "maxConcurrentProvisioningJobs": {
+                    "description": "Provider jobs that run on a target at the same time, e.g. workspace creations and starts and the image pre-pulls\nof prebuilds. Waiting jobs of a higher priority run first, creations and starts before pre-pulls. Unlimited if 0",
+                    "type": "integer"
+                },
+                "metering": {
+                    "$ref": "#/definitions/MeteringConfig"
+                },
+                "overcommitRatio": {
+                    "description": "Multiple of the CPU and memory of a target host that projects can reserve, e.g. 1.5. New projects that would\nreserve more are refused. Defaults to 1, targets whose provider does not report their capacity are not checked",
+                    "type": "number"
+                },
+                "previewDns": {
+                    "description": "Manages the DNS records and the certificate of public preview URLs under an organization owned frps domain",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/PreviewDnsConfig"
+                        }
+                    ]
+                },
+                "providersDir": {
+                    "type": "string"
+                },
+                "registryUrl": {
                     "type": "string"
                 },
-                "sourceRepoName": {
+                "samplesIndexUrl": {
                     "type": "string"
                 },
-                "sourceRepoOwner": {
+                "serverDownloadUrl": {
                     "type": "string"
                 },
-                "sourceRepoUrl": {
-                    "type": "string"
+                "stateHistory": {
+                    "$ref": "#/definitions/StateHistoryConfig"
+                },
+                "storage": {
+                    "description": "Stores artifacts and the logs of finished operations in a bucket instead of the server config directory",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/StorageConfig"
+                        }
+                    ]
                 }
             }
         },
-        "GitRepository": {
+        "ServiceEndpoint": {
             "type": "object",
             "required": [
-                "branch",
-                "id",
+                "address",
                 "name",
-                "owner",
-                "sha",
-                "source",
-                "url"
+                "port",
+                "projectName",
+                "protocol",
+                "service",
+                "workspaceId",
+                "workspaceName"
             ],
             "properties": {
-                "branch": {
-                    "type": "string"
-                },
-                "cloneTarget": {
-                    "$ref": "#/definitions/CloneTarget"
-                },
-                "id": {
+                "address": {
+                    "description": "Tailnet address of the service in the host:port format. The port differs from the registered port\nif the project shares the tailnet node of another project",
                     "type": "string"
                 },
                 "name": {
+                    "description": "Fully qualified name of the service, e.g. api-proj.my-workspace.daytona.internal",
                     "type": "string"
                 },
-                "owner": {
-                    "type": "string"
+                "port": {
+                    "description": "Port the service was registered with",
+                    "type": "integer"
                 },
-                "path": {
+                "projectName": {
                     "type": "string"
                 },
-                "prNumber": {
-                    "type": "integer"
+                "protocol": {
+                    "$ref": "#/definitions/ServiceProtocol"
                 },
-                "sha": {
+                "service": {
                     "type": "string"
                 },
-                "source": {
+                "workspaceId": {
                     "type": "string"
                 },
-                "url": {
+                "workspaceName": {
                     "type": "string"
                 }
             }
         },
-        "GitStatus": {
-            "type": "object",
-            "required": [
-                "currentBranch",
-                "fileStatus"
+        "ServiceProtocol": {
+            "type": "string",
+            "enum": [
+                "tcp",
+                "udp"
             ],
-            "properties": {
-                "ahead": {
-                    "type": "integer"
-                },
-                "behind": {
-                    "type": "integer"
-                },
-                "branchPublished": {
-                    "type": "boolean"
-                },
-                "currentBranch": {
-                    "type": "string"
-                },
-                "fileStatus": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/FileStatus"
-                    }
-                }
-            }
+            "x-enum-varnames": [
+                "ServiceProtocolTcp",
+                "ServiceProtocolUdp"
+            ]
         },
-        "GitUser": {
+        "SetBuildPriorityDTO": {
             "type": "object",
             "required": [
-                "email",
-                "id",
-                "name",
-                "username"
+                "priority"
             ],
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "username": {
+                "priority": {
                     "type": "string"
                 }
             }
         },
-        "InstallProviderRequest": {
+        "SetBuildSecretDTO": {
             "type": "object",
             "required": [
-                "downloadUrls",
-                "name"
+                "value"
             ],
             "properties": {
-                "downloadUrls": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
-                },
-                "name": {
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "LogFileConfig": {
+        "SetGitProviderConfig": {
             "type": "object",
             "required": [
-                "maxAge",
-                "maxBackups",
-                "maxSize",
-                "path"
+                "providerId",
+                "token"
             ],
             "properties": {
-                "compress": {
-                    "type": "boolean"
+                "alias": {
+                    "type": "string"
                 },
-                "localTime": {
-                    "type": "boolean"
+                "baseApiUrl": {
+                    "type": "string"
                 },
-                "maxAge": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
                 },
-                "maxBackups": {
-                    "type": "integer"
+                "providerId": {
+                    "type": "string"
                 },
-                "maxSize": {
-                    "type": "integer"
+                "signingKey": {
+                    "type": "string"
                 },
-                "path": {
+                "signingMethod": {
+                    "$ref": "#/definitions/SigningMethod"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "username": {
                     "type": "string"
                 }
             }
         },
-        "NetworkKey": {
+        "SetProjectHostname": {
             "type": "object",
             "required": [
-                "key"
+                "hostname"
             ],
             "properties": {
-                "key": {
+                "hostname": {
                     "type": "string"
                 }
             }
         },
-        "PrebuildConfig": {
+        "SetProjectState": {
             "type": "object",
             "required": [
-                "branch",
-                "commitInterval",
-                "id",
-                "retention",
-                "triggerFiles"
+                "uptime"
             ],
             "properties": {
-                "branch": {
-                    "type": "string"
-                },
-                "commitInterval": {
-                    "type": "integer"
-                },
-                "id": {
+                "address": {
+                    "description": "Address of the project container. Only reported by agents of routed projects",
                     "type": "string"
                 },
-                "retention": {
-                    "type": "integer"
-                },
-                "triggerFiles": {
+                "detectedPorts": {
+                    "description": "Ports processes of the project listen on, except for the ports of the agent",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     }
-                }
-            }
-        },
-        "PrebuildDTO": {
-            "type": "object",
-            "required": [
-                "branch",
-                "id",
-                "projectConfigName",
-                "retention"
-            ],
-            "properties": {
-                "branch": {
-                    "type": "string"
                 },
-                "commitInterval": {
+                "environmentChanges": {
+                    "description": "Files of the environment definition that changed since the project container was built",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/EnvironmentChanges"
+                        }
+                    ]
+                },
+                "gitStatus": {
+                    "$ref": "#/definitions/GitStatus"
+                },
+                "idleSeconds": {
+                    "description": "Seconds since the agent last observed terminal, IDE or file activity",
                     "type": "integer"
                 },
-                "id": {
+                "lastActivitySource": {
                     "type": "string"
                 },
-                "projectConfigName": {
+                "nodeKeyExpiry": {
+                    "description": "Time the key of the tailnet node of the agent expires, in RFC3339",
                     "type": "string"
                 },
-                "retention": {
-                    "type": "integer"
+                "ready": {
+                    "description": "Set once the agent accepts SSH connections and joined the tailnet. Projects of older agents, which do not report\nit, are ready once they report their state",
+                    "type": "boolean"
                 },
-                "triggerFiles": {
+                "services": {
+                    "description": "Services the project exposes to other workspaces",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/ProjectService"
                     }
+                },
+                "uptime": {
+                    "type": "integer"
+                },
+                "usage": {
+                    "description": "Resources used by the project container",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "version": {
+                    "type": "string"
                 }
             }
         },
-        "ProfileData": {
+        "SharedService": {
             "type": "object",
             "required": [
-                "envVars"
+                "connectionEnvVars",
+                "createdAt",
+                "envVars",
+                "image",
+                "name",
+                "port",
+                "target"
             ],
             "properties": {
-                "envVars": {
+                "connectionEnvVars": {
+                    "description": "Environment variables injected in the projects of attached workspaces.\nValues can reference the address of the service with {{host}} and {{port}}",
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
-                }
-            }
-        },
-        "Project": {
-            "type": "object",
-            "required": [
-                "envVars",
-                "image",
-                "name",
-                "repository",
-                "target",
-                "user",
-                "workspaceId"
-            ],
-            "properties": {
-                "buildConfig": {
-                    "$ref": "#/definitions/BuildConfig"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dataPath": {
+                    "description": "Path in the service container that is persisted in a volume",
+                    "type": "string"
                 },
                 "envVars": {
+                    "description": "Environment variables of the service container",
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "gitProviderConfigId": {
-                    "type": "string"
-                },
                 "image": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "repository": {
-                    "$ref": "#/definitions/GitRepository"
-                },
-                "state": {
-                    "$ref": "#/definitions/ProjectState"
+                "port": {
+                    "description": "Port the service listens on",
+                    "type": "integer"
                 },
                 "target": {
                     "type": "string"
-                },
-                "user": {
-                    "type": "string"
-                },
-                "workspaceId": {
-                    "type": "string"
                 }
             }
         },
-        "ProjectConfig": {
+        "SharedServiceDTO": {
             "type": "object",
             "required": [
-                "default",
+                "connectionEnvVars",
+                "createdAt",
                 "envVars",
                 "image",
                 "name",
-                "repositoryUrl",
-                "user"
+                "port",
+                "target",
+                "workspaces"
             ],
             "properties": {
-                "buildConfig": {
-                    "$ref": "#/definitions/BuildConfig"
+                "connectionEnvVars": {
+                    "description": "Environment variables injected in the projects of attached workspaces.\nValues can reference the address of the service with {{host}} and {{port}}",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "default": {
-                    "type": "boolean"
+                "createdAt": {
+                    "type": "string"
+                },
+                "dataPath": {
+                    "description": "Path in the service container that is persisted in a volume",
+                    "type": "string"
                 },
                 "envVars": {
+                    "description": "Environment variables of the service container",
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
-                "gitProviderConfigId": {
+                "image": {
                     "type": "string"
                 },
-                "image": {
+                "info": {
+                    "$ref": "#/definitions/SharedServiceInfo"
+                },
+                "name": {
                     "type": "string"
                 },
-                "name": {
+                "port": {
+                    "description": "Port the service listens on",
+                    "type": "integer"
+                },
+                "target": {
                     "type": "string"
                 },
-                "prebuilds": {
+                "workspaces": {
+                    "description": "IDs of the attached workspaces",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/PrebuildConfig"
+                        "type": "string"
                     }
-                },
-                "repositoryUrl": {
-                    "type": "string"
-                },
-                "user": {
-                    "type": "string"
                 }
             }
         },
-        "ProjectInfo": {
+        "SharedServiceInfo": {
             "type": "object",
             "required": [
-                "created",
+                "host",
                 "isRunning",
                 "name",
-                "workspaceId"
+                "port"
             ],
             "properties": {
-                "created": {
+                "host": {
+                    "description": "Address of the service as seen from project containers on the same target",
                     "type": "string"
                 },
                 "isRunning": {
@@ -2728,263 +9146,396 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "providerMetadata": {
-                    "type": "string"
+                "port": {
+                    "type": "integer"
                 },
-                "workspaceId": {
+                "providerMetadata": {
                     "type": "string"
                 }
             }
         },
-        "ProjectState": {
+        "SigningMethod": {
+            "type": "string",
+            "enum": [
+                "ssh",
+                "gpg"
+            ],
+            "x-enum-varnames": [
+                "SigningMethodSSH",
+                "SigningMethodGPG"
+            ]
+        },
+        "SshHostKeyDTO": {
             "type": "object",
             "required": [
-                "gitStatus",
-                "updatedAt",
-                "uptime"
+                "key"
             ],
             "properties": {
-                "gitStatus": {
-                    "$ref": "#/definitions/GitStatus"
-                },
-                "updatedAt": {
+                "key": {
+                    "description": "PEM encoded private SSH host key of the project",
                     "type": "string"
-                },
-                "uptime": {
-                    "type": "integer"
                 }
             }
         },
-        "Provider": {
+        "SshTokenDTO": {
             "type": "object",
             "required": [
-                "name",
-                "version"
+                "expiresAt",
+                "token"
             ],
             "properties": {
-                "label": {
+                "expiresAt": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
-                },
-                "version": {
+                "token": {
+                    "description": "Password the SSH client authenticates with on the tailnet node of the project",
                     "type": "string"
                 }
             }
         },
-        "ProviderTarget": {
+        "StartImpersonationDTO": {
             "type": "object",
             "required": [
-                "isDefault",
-                "name",
-                "options",
-                "providerInfo"
+                "reason",
+                "user"
             ],
             "properties": {
-                "isDefault": {
-                    "type": "boolean"
+                "durationMinutes": {
+                    "description": "Defaults to 30 minutes",
+                    "type": "integer"
                 },
-                "name": {
+                "reason": {
                     "type": "string"
                 },
-                "options": {
-                    "description": "JSON encoded map of options",
+                "user": {
+                    "description": "Name of the API key, OIDC user or certificate of the user to act on behalf of",
                     "type": "string"
-                },
-                "providerInfo": {
-                    "$ref": "#/definitions/provider.ProviderInfo"
                 }
             }
         },
-        "ProviderTargetManifest": {
-            "type": "object",
-            "additionalProperties": {
-                "$ref": "#/definitions/provider.ProviderTargetProperty"
-            }
-        },
-        "RepositoryUrl": {
+        "StateHistoryConfig": {
             "type": "object",
-            "required": [
-                "url"
-            ],
             "properties": {
-                "url": {
-                    "type": "string"
+                "intervalMinutes": {
+                    "description": "Interval between snapshots. 0 uses the default",
+                    "type": "integer"
+                },
+                "retentionDays": {
+                    "description": "How long snapshots are kept. 0 uses the default",
+                    "type": "integer"
                 }
             }
         },
-        "Sample": {
+        "StateSnapshot": {
             "type": "object",
             "required": [
-                "description",
-                "gitUrl",
-                "name"
+                "id",
+                "projectName",
+                "recordedAt",
+                "running",
+                "target",
+                "uptime",
+                "workspaceId",
+                "workspaceName"
             ],
             "properties": {
-                "description": {
+                "agentVersion": {
                     "type": "string"
                 },
-                "gitUrl": {
+                "gitBranch": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "ServerConfig": {
-            "type": "object",
-            "required": [
-                "apiPort",
-                "binariesPath",
-                "builderImage",
-                "builderRegistryServer",
-                "defaultProjectImage",
-                "defaultProjectUser",
-                "headscalePort",
-                "id",
-                "localBuilderRegistryImage",
-                "localBuilderRegistryPort",
-                "logFile",
-                "providersDir",
-                "registryUrl",
-                "serverDownloadUrl"
-            ],
-            "properties": {
-                "apiPort": {
-                    "type": "integer"
                 },
-                "binariesPath": {
+                "lastActivityAt": {
                     "type": "string"
                 },
-                "buildImageNamespace": {
+                "projectName": {
                     "type": "string"
                 },
-                "builderImage": {
+                "recordedAt": {
                     "type": "string"
                 },
-                "builderRegistryServer": {
-                    "type": "string"
+                "running": {
+                    "description": "Running is set while the project agent reports a non-zero uptime and the last report is recent",
+                    "type": "boolean"
                 },
-                "defaultProjectImage": {
+                "stateUpdatedAt": {
+                    "description": "StateUpdatedAt is when the agent last reported the state of the project. Empty if it never did",
                     "type": "string"
                 },
-                "defaultProjectUser": {
+                "target": {
                     "type": "string"
                 },
-                "frps": {
-                    "$ref": "#/definitions/FRPSConfig"
-                },
-                "headscalePort": {
+                "uptime": {
+                    "description": "Uptime of the project in seconds, as last reported by its agent",
                     "type": "integer"
                 },
-                "id": {
+                "workspaceId": {
                     "type": "string"
                 },
-                "localBuilderRegistryImage": {
+                "workspaceName": {
+                    "type": "string"
+                }
+            }
+        },
+        "Status": {
+            "type": "string",
+            "enum": [
+                "Unmodified",
+                "Untracked",
+                "Modified",
+                "Added",
+                "Deleted",
+                "Renamed",
+                "Copied",
+                "Updated but unmerged"
+            ],
+            "x-enum-varnames": [
+                "Unmodified",
+                "Untracked",
+                "Modified",
+                "Added",
+                "Deleted",
+                "Renamed",
+                "Copied",
+                "UpdatedButUnmerged"
+            ]
+        },
+        "StorageConfig": {
+            "type": "object",
+            "required": [
+                "bucket",
+                "provider"
+            ],
+            "properties": {
+                "accessKeyId": {
+                    "description": "Access key of S3 or HMAC key of a Cloud Storage service account. Credentials of the default AWS credential chain\nare used for S3 if empty",
                     "type": "string"
                 },
-                "localBuilderRegistryPort": {
+                "artifactRetentionDays": {
+                    "description": "Days after which artifacts are deleted. 0 keeps them until they are deleted with their workspace",
                     "type": "integer"
                 },
-                "logFile": {
-                    "$ref": "#/definitions/LogFileConfig"
+                "bucket": {
+                    "type": "string"
                 },
-                "providersDir": {
+                "endpoint": {
+                    "description": "Endpoint of an S3 compatible service, e.g. MinIO",
                     "type": "string"
                 },
-                "registryUrl": {
+                "logRetentionDays": {
+                    "description": "Days after which archived logs are deleted. 0 keeps them until they are deleted with their workspace or build",
+                    "type": "integer"
+                },
+                "prefix": {
+                    "description": "Prepended to the keys of the objects, so that several servers can share a bucket",
                     "type": "string"
                 },
-                "samplesIndexUrl": {
+                "provider": {
+                    "$ref": "#/definitions/server.StorageProvider"
+                },
+                "region": {
+                    "description": "Region of the S3 bucket. Defaults to us-east-1",
                     "type": "string"
                 },
-                "serverDownloadUrl": {
+                "secretAccessKey": {
                     "type": "string"
                 }
             }
         },
-        "SetGitProviderConfig": {
+        "TailnetCaDTO": {
             "type": "object",
             "required": [
-                "providerId",
-                "token"
+                "certificate"
             ],
             "properties": {
-                "alias": {
+                "certificate": {
+                    "description": "PEM encoded certificate of the authority that signs the certificates of the TLS ports of projects",
                     "type": "string"
+                }
+            }
+        },
+        "TailnetHealth": {
+            "type": "object",
+            "required": [
+                "online",
+                "state"
+            ],
+            "properties": {
+                "online": {
+                    "type": "boolean"
                 },
-                "baseApiUrl": {
+                "relay": {
+                    "description": "DERP relay region the agent is homed in",
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "relays": {
+                    "description": "Relay regions of the tailnet as probed from the project, sorted by region code",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RelayHealth"
+                    }
                 },
-                "providerId": {
+                "state": {
+                    "description": "Tailscale backend state, e.g. Running or NeedsLogin",
                     "type": "string"
                 },
-                "signingKey": {
-                    "type": "string"
+                "warnings": {
+                    "description": "Warnings reported by the tailscale client",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "TargetAllocation": {
+            "type": "object",
+            "required": [
+                "overcommitRatio",
+                "projects",
+                "reserved",
+                "target",
+                "used"
+            ],
+            "properties": {
+                "capacity": {
+                    "description": "Not set if the provider does not report the capacity of the target",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
                 },
-                "signingMethod": {
-                    "$ref": "#/definitions/SigningMethod"
+                "overcommitRatio": {
+                    "description": "Multiple of the capacity that projects can reserve before new projects are refused",
+                    "type": "number"
                 },
-                "token": {
-                    "type": "string"
+                "projects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ProjectAllocation"
+                    }
                 },
-                "username": {
+                "reserved": {
+                    "description": "Sum of the resources reserved by the projects of the target",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                },
+                "target": {
                     "type": "string"
+                },
+                "used": {
+                    "description": "Sum of the resources used by the running projects of the target, as reported by their agents",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/Resources"
+                        }
+                    ]
+                }
+            }
+        },
+        "UpdateAnnotations": {
+            "type": "object",
+            "properties": {
+                "remove": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "set": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "UpdateBranchRequest": {
+            "type": "object",
+            "required": [
+                "strategy"
+            ],
+            "properties": {
+                "strategy": {
+                    "$ref": "#/definitions/BranchUpdateStrategy"
                 }
             }
         },
-        "SetProjectState": {
+        "ValidationCheck": {
+            "type": "string",
+            "enum": [
+                "workspace",
+                "target",
+                "project",
+                "repository",
+                "branch",
+                "devcontainer",
+                "image",
+                "capacity"
+            ],
+            "x-enum-varnames": [
+                "ValidationCheckWorkspace",
+                "ValidationCheckTarget",
+                "ValidationCheckProject",
+                "ValidationCheckRepository",
+                "ValidationCheckBranch",
+                "ValidationCheckDevcontainer",
+                "ValidationCheckImage",
+                "ValidationCheckCapacity"
+            ]
+        },
+        "ValidationIssue": {
             "type": "object",
             "required": [
-                "uptime"
+                "check",
+                "message",
+                "severity"
             ],
             "properties": {
-                "gitStatus": {
-                    "$ref": "#/definitions/GitStatus"
+                "check": {
+                    "$ref": "#/definitions/ValidationCheck"
                 },
-                "uptime": {
-                    "type": "integer"
+                "message": {
+                    "type": "string"
+                },
+                "projectName": {
+                    "description": "Empty for issues of the workspace",
+                    "type": "string"
+                },
+                "severity": {
+                    "$ref": "#/definitions/ValidationSeverity"
                 }
             }
         },
-        "SigningMethod": {
+        "ValidationSeverity": {
             "type": "string",
             "enum": [
-                "ssh",
-                "gpg"
+                "error",
+                "warning"
             ],
             "x-enum-varnames": [
-                "SigningMethodSSH",
-                "SigningMethodGPG"
+                "ValidationSeverityError",
+                "ValidationSeverityWarning"
             ]
         },
-        "Status": {
-            "type": "string",
-            "enum": [
-                "Unmodified",
-                "Untracked",
-                "Modified",
-                "Added",
-                "Deleted",
-                "Renamed",
-                "Copied",
-                "Updated but unmerged"
+        "VerifySshAccessDTO": {
+            "type": "object",
+            "required": [
+                "token"
             ],
-            "x-enum-varnames": [
-                "Unmodified",
-                "Untracked",
-                "Modified",
-                "Added",
-                "Deleted",
-                "Renamed",
-                "Copied",
-                "UpdatedButUnmerged"
-            ]
+            "properties": {
+                "token": {
+                    "description": "Token the SSH client authenticated with",
+                    "type": "string"
+                }
+            }
         },
         "Workspace": {
             "type": "object",
@@ -2995,23 +9546,94 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "adoption": {
+                    "description": "Adoption is set for workspaces registered from an existing container or VM instead of being created by a provider",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WorkspaceAdoption"
+                        }
+                    ]
+                },
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "bandwidthLimit": {
+                    "description": "Limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the\ntarget in the directions it sets",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
+                "organizationId": {
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "sharedServices": {
+                    "description": "Names of the shared services of the target the projects of the workspace connect to",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "target": {
                     "type": "string"
                 }
             }
         },
+        "WorkspaceAdoption": {
+            "type": "object",
+            "required": [
+                "adoptedAt",
+                "type",
+                "user"
+            ],
+            "properties": {
+                "adoptedAt": {
+                    "type": "string"
+                },
+                "containerId": {
+                    "description": "ID or name of the adopted container on the server host",
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "projectDir": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/workspace.AdoptionType"
+                },
+                "user": {
+                    "description": "User that runs the agent",
+                    "type": "string"
+                }
+            }
+        },
         "WorkspaceDTO": {
             "type": "object",
             "required": [
@@ -3021,6 +9643,34 @@ const docTemplate = `{
                 "target"
             ],
             "properties": {
+                "adoption": {
+                    "description": "Adoption is set for workspaces registered from an existing container or VM instead of being created by a provider",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/WorkspaceAdoption"
+                        }
+                    ]
+                },
+                "annotations": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "bandwidthLimit": {
+                    "description": "Limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the\ntarget in the directions it sets",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/BandwidthLimit"
+                        }
+                    ]
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
@@ -3030,17 +9680,71 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "organizationId": {
+                    "type": "string"
+                },
                 "projects": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/Project"
                     }
                 },
+                "region": {
+                    "description": "Federated region of the server that manages the workspace. Empty if federation is disabled",
+                    "type": "string"
+                },
+                "sharedServices": {
+                    "description": "Names of the shared services of the target the projects of the workspace connect to",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "target": {
                     "type": "string"
                 }
             }
         },
+        "WorkspaceDiff": {
+            "type": "object",
+            "required": [
+                "differences",
+                "workspaceA",
+                "workspaceB"
+            ],
+            "properties": {
+                "differences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WorkspaceDifference"
+                    }
+                },
+                "workspaceA": {
+                    "type": "string"
+                },
+                "workspaceB": {
+                    "type": "string"
+                }
+            }
+        },
+        "WorkspaceDifference": {
+            "type": "object",
+            "required": [
+                "path"
+            ],
+            "properties": {
+                "a": {
+                    "type": "string"
+                },
+                "b": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path of the setting, e.g. projects.api.envVars.NODE_ENV",
+                    "type": "string"
+                }
+            }
+        },
         "WorkspaceInfo": {
             "type": "object",
             "required": [
@@ -3062,6 +9766,69 @@ const docTemplate = `{
                 }
             }
         },
+        "WorkspaceReadiness": {
+            "type": "object",
+            "required": [
+                "pendingProjects",
+                "ready",
+                "readyProjects",
+                "workspaceId"
+            ],
+            "properties": {
+                "pendingProjects": {
+                    "description": "Projects whose agent is still starting or that are stopped",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ready": {
+                    "description": "Ready is set once every project in question accepts SSH connections",
+                    "type": "boolean"
+                },
+                "readyProjects": {
+                    "description": "Projects that accept SSH connections",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "workspaceId": {
+                    "type": "string"
+                }
+            }
+        },
+        "agentevent.Type": {
+            "type": "string",
+            "enum": [
+                "connected",
+                "reconnected",
+                "port-opened",
+                "port-closed",
+                "proxy-error",
+                "oom",
+                "disk-pressure",
+                "idle-warning",
+                "idle-stop",
+                "agent-updated",
+                "agent-update-failed",
+                "node-key-renewed"
+            ],
+            "x-enum-varnames": [
+                "TypeConnected",
+                "TypeReconnected",
+                "TypePortOpened",
+                "TypePortClosed",
+                "TypeProxyError",
+                "TypeOom",
+                "TypeDiskPressure",
+                "TypeIdleWarning",
+                "TypeIdleStop",
+                "TypeAgentUpdated",
+                "TypeAgentUpdateFailed",
+                "TypeNodeKeyRenewed"
+            ]
+        },
         "apikey.ApiKeyType": {
             "type": "string",
             "enum": [
@@ -3075,6 +9842,36 @@ const docTemplate = `{
                 "ApiKeyTypeWorkspace"
             ]
         },
+        "audit.Action": {
+            "type": "string",
+            "enum": [
+                "target.scale-up",
+                "target.scale-down",
+                "impersonation.start",
+                "impersonation.end",
+                "impersonation.request"
+            ],
+            "x-enum-varnames": [
+                "ActionTargetScaleUp",
+                "ActionTargetScaleDown",
+                "ActionImpersonationStart",
+                "ActionImpersonationEnd",
+                "ActionImpersonationRequest"
+            ]
+        },
+        "build.BuildPriority": {
+            "type": "string",
+            "enum": [
+                "low",
+                "normal",
+                "high"
+            ],
+            "x-enum-varnames": [
+                "BuildPriorityLow",
+                "BuildPriorityNormal",
+                "BuildPriorityHigh"
+            ]
+        },
         "build.BuildState": {
             "type": "string",
             "enum": [
@@ -3098,6 +9895,44 @@ const docTemplate = `{
                 "BuildStateDeleting"
             ]
         },
+        "creationtiming.Phase": {
+            "type": "string",
+            "enum": [
+                "queue-wait",
+                "provision",
+                "project-create",
+                "image-pull",
+                "clone",
+                "build",
+                "agent-boot",
+                "lifecycle-commands"
+            ],
+            "x-enum-varnames": [
+                "PhaseQueueWait",
+                "PhaseProvision",
+                "PhaseProjectCreate",
+                "PhaseImagePull",
+                "PhaseClone",
+                "PhaseBuild",
+                "PhaseAgentBoot",
+                "PhaseLifecycleCommands"
+            ]
+        },
+        "networkkey.Scope": {
+            "type": "string",
+            "enum": [
+                "workspace",
+                "client",
+                "provider",
+                "server"
+            ],
+            "x-enum-varnames": [
+                "ScopeWorkspace",
+                "ScopeClient",
+                "ScopeProvider",
+                "ScopeServer"
+            ]
+        },
         "provider.ProviderInfo": {
             "type": "object",
             "required": [
@@ -3171,6 +10006,117 @@ const docTemplate = `{
                 "ProviderTargetPropertyTypeFloat",
                 "ProviderTargetPropertyTypeFilePath"
             ]
+        },
+        "rollout.RolloutState": {
+            "type": "string",
+            "enum": [
+                "canary",
+                "promoted",
+                "rolled-back"
+            ],
+            "x-enum-varnames": [
+                "RolloutStateCanary",
+                "RolloutStatePromoted",
+                "RolloutStateRolledBack"
+            ]
+        },
+        "server.AuthProviderType": {
+            "type": "string",
+            "enum": [
+                "api-key",
+                "oidc",
+                "mtls",
+                "admin-token"
+            ],
+            "x-enum-varnames": [
+                "AuthProviderApiKey",
+                "AuthProviderOidc",
+                "AuthProviderMtls",
+                "AuthProviderAdminToken"
+            ]
+        },
+        "server.BridgeDirection": {
+            "type": "string",
+            "enum": [
+                "both",
+                "to-workspace",
+                "from-workspace",
+                "none"
+            ],
+            "x-enum-varnames": [
+                "BridgeDirectionBoth",
+                "BridgeDirectionToWorkspace",
+                "BridgeDirectionFromWorkspace",
+                "BridgeDirectionNone"
+            ]
+        },
+        "server.MeteringExporter": {
+            "type": "string",
+            "enum": [
+                "file",
+                "http",
+                "kafka"
+            ],
+            "x-enum-varnames": [
+                "MeteringExporterFile",
+                "MeteringExporterHttp",
+                "MeteringExporterKafka"
+            ]
+        },
+        "server.PreviewDnsProvider": {
+            "type": "string",
+            "enum": [
+                "cloudflare",
+                "route53"
+            ],
+            "x-enum-varnames": [
+                "PreviewDnsProviderCloudflare",
+                "PreviewDnsProviderRoute53"
+            ]
+        },
+        "server.PreviewDnsRecords": {
+            "type": "string",
+            "enum": [
+                "wildcard",
+                "workspace"
+            ],
+            "x-enum-varnames": [
+                "PreviewDnsRecordsWildcard",
+                "PreviewDnsRecordsWorkspace"
+            ]
+        },
+        "server.StorageProvider": {
+            "type": "string",
+            "enum": [
+                "s3",
+                "gcs"
+            ],
+            "x-enum-varnames": [
+                "StorageProviderS3",
+                "StorageProviderGcs"
+            ]
+        },
+        "workspace.AdoptionType": {
+            "type": "string",
+            "enum": [
+                "docker",
+                "ssh"
+            ],
+            "x-enum-varnames": [
+                "AdoptionTypeDocker",
+                "AdoptionTypeSsh"
+            ]
+        },
+        "workspace.CleanupAction": {
+            "type": "string",
+            "enum": [
+                "delete",
+                "stop"
+            ],
+            "x-enum-varnames": [
+                "CleanupActionDelete",
+                "CleanupActionStop"
+            ]
         }
     },
     "securityDefinitions": {
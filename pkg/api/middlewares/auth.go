@@ -4,52 +4,23 @@
 package middlewares
 
 import (
-	"errors"
-	"strings"
-
-	"github.com/daytonaio/daytona/pkg/apikey"
-	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/api/auth"
 	"github.com/gin-gonic/gin"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+// AuthMiddleware authenticates requests with the provider chain configured for the route group
+func AuthMiddleware(routeChains auth.RouteChains) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		bearerToken := ctx.GetHeader("Authorization")
-		if bearerToken == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
-			return
-		}
-
-		token := ExtractToken(bearerToken)
-		if token == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
-			return
-		}
-
-		server := server.GetInstance(nil)
-
-		if !server.ApiKeyService.IsValidApiKey(token) {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
+		identity, err := routeChains.GetChain(ctx.FullPath()).Authenticate(ctx.Request)
+		if err != nil {
+			ctx.AbortWithError(401, auth.ErrUnauthorized)
 			return
 		}
 
-		apiKeyType := apikey.ApiKeyTypeClient
-
-		if server.ApiKeyService.IsWorkspaceApiKey(token) {
-			apiKeyType = apikey.ApiKeyTypeWorkspace
-		} else if server.ApiKeyService.IsProjectApiKey(token) {
-			apiKeyType = apikey.ApiKeyTypeProject
-		}
-
-		ctx.Set("apiKeyType", apiKeyType)
+		ctx.Set("apiKeyType", identity.ApiKeyType)
+		ctx.Set("apiKeyName", identity.Name)
+		ctx.Set("authProvider", identity.Provider)
+		ctx.Set("serverAdmin", identity.Admin)
 		ctx.Next()
 	}
 }
-
-func ExtractToken(bearerToken string) string {
-	if !strings.HasPrefix(bearerToken, "Bearer ") {
-		return ""
-	}
-
-	return strings.TrimPrefix(bearerToken, "Bearer ")
-}
@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"regexp"
+
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/gin-gonic/gin"
+)
+
+var correlationIdRegex = regexp.MustCompile(`^[a-zA-Z0-9_.:-]{1,128}$`)
+
+// CorrelationMiddleware adds the correlation ID sent by the client, or a new one if the client did not send
+// a valid ID, to the request context and the response headers
+func CorrelationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		correlationId := ctx.GetHeader(correlation.CORRELATION_ID_HEADER)
+		if !correlationIdRegex.MatchString(correlationId) {
+			correlationId = correlation.NewId()
+		}
+
+		ctx.Request = ctx.Request.WithContext(correlation.WithId(ctx.Request.Context(), correlationId))
+		ctx.Header(correlation.CORRELATION_ID_HEADER, correlationId)
+
+		ctx.Next()
+	}
+}
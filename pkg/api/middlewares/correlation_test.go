@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrelationMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		header *string
+		keep   bool
+	}{
+		{name: "valid header is kept", header: ptr("cli-1234_abc.def:5"), keep: true},
+		{name: "header of 128 characters is kept", header: ptr(strings.Repeat("a", 128)), keep: true},
+		{name: "missing header is generated"},
+		{name: "empty header is generated", header: ptr("")},
+		{name: "oversized header is generated", header: ptr(strings.Repeat("a", 129))},
+		{name: "header with invalid characters is generated", header: ptr("id with spaces")},
+		{name: "header with line breaks is generated", header: ptr("id\r\nX-Injected: 1")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var contextId string
+
+			router := gin.New()
+			router.Use(CorrelationMiddleware())
+			router.GET("/", func(ctx *gin.Context) {
+				contextId = correlation.GetId(ctx.Request.Context())
+				ctx.Status(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			if test.header != nil {
+				request.Header[correlation.CORRELATION_ID_HEADER] = []string{*test.header}
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			responseId := recorder.Header().Get(correlation.CORRELATION_ID_HEADER)
+			require.Equal(t, contextId, responseId)
+
+			if test.keep {
+				require.Equal(t, *test.header, responseId)
+				return
+			}
+
+			_, err := uuid.Parse(responseId)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
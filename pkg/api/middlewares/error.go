@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/common"
+)
+
+// ErrorResponse is the body of failed API requests. Scripts should rely on the code instead of the error message
+type ErrorResponse struct {
+	Error    string               `json:"error"`
+	Code     common.ErrorCode     `json:"code"`
+	Category common.ErrorCategory `json:"category"`
+	Hint     string               `json:"hint,omitempty"`
+} // @name ErrorResponse
+
+// statusErrorCodes are the codes of errors that were not given a code where they occurred
+var statusErrorCodes = map[int]common.ErrorCode{
+	http.StatusBadRequest:          common.ErrorCodeInvalidRequest,
+	http.StatusUnauthorized:        common.ErrorCodeUnauthorized,
+	http.StatusForbidden:           common.ErrorCodeForbidden,
+	http.StatusNotFound:            common.ErrorCodeNotFound,
+	http.StatusConflict:            common.ErrorCodeConflict,
+	http.StatusTooManyRequests:     common.ErrorCodeRateLimited,
+	http.StatusBadGateway:          common.ErrorCodeUnavailable,
+	http.StatusServiceUnavailable:  common.ErrorCodeUnavailable,
+	http.StatusGatewayTimeout:      common.ErrorCodeTimeout,
+	http.StatusInternalServerError: common.ErrorCodeInternal,
+}
+
+// NewErrorResponse classifies the error by the code attached to it or, if it has none, by the status code of the response
+func NewErrorResponse(err error, statusCode int) ErrorResponse {
+	if codedErr, ok := common.GetCodedError(err); ok {
+		return ErrorResponse{
+			Error:    err.Error(),
+			Code:     codedErr.Code,
+			Category: codedErr.Category,
+			Hint:     codedErr.Hint,
+		}
+	}
+
+	code, ok := statusErrorCodes[statusCode]
+	if !ok {
+		code = common.ErrorCodeInvalidRequest
+		if statusCode >= http.StatusInternalServerError {
+			code = common.ErrorCodeInternal
+		}
+	}
+
+	return ErrorResponse{
+		Error:    err.Error(),
+		Code:     code,
+		Category: code.Category(),
+		Hint:     code.Hint(),
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETagMiddleware sets an ETag computed from the body of successful responses and responds with
+// 304 Not Modified if the If-None-Match header of the request matches it, so that clients
+// polling list and info routes do not transfer and decode unchanged responses
+func ETagMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		writer := &bufferedResponseWriter{ResponseWriter: ctx.Writer}
+		ctx.Writer = writer
+
+		ctx.Next()
+
+		ctx.Writer = writer.ResponseWriter
+
+		if writer.Status() != http.StatusOK || writer.Written() {
+			_, _ = writer.ResponseWriter.Write(writer.body.Bytes())
+			return
+		}
+
+		hash := sha256.Sum256(writer.body.Bytes())
+		etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(hash[:16]))
+		ctx.Header("ETag", etag)
+
+		if matchesETag(ctx.GetHeader("If-None-Match"), etag) {
+			ctx.Writer.WriteHeader(http.StatusNotModified)
+			ctx.Writer.WriteHeaderNow()
+			return
+		}
+
+		_, _ = writer.ResponseWriter.Write(writer.body.Bytes())
+	}
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bufferedResponseWriter holds back the response body until the ETag is known
+type bufferedResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/faults"
+	"github.com/gin-gonic/gin"
+)
+
+// FaultInjectionMiddleware delays requests and fails them with a 500 status code as configured by the injector
+func FaultInjectionMiddleware(injector *faults.Injector) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		err := injector.Inject(ctx.Request.Context())
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/impersonation"
+	"github.com/daytonaio/daytona/pkg/server"
+	impersonation_service "github.com/daytonaio/daytona/pkg/server/impersonation"
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+)
+
+// ImpersonationMiddleware lets administrators act on behalf of the user of the active impersonation session selected
+// with the impersonation header. Requests are restricted to creating and inspecting workspaces, and are recorded in
+// the audit log
+func ImpersonationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		sessionId := ctx.GetHeader(impersonation.IMPERSONATION_HEADER)
+		// Administrators manage their sessions as themselves, so that a session can be ended while it is in use
+		if sessionId == "" || strings.HasPrefix(ctx.FullPath(), "/server/impersonation") {
+			ctx.Next()
+			return
+		}
+
+		apiKeyType, _ := ctx.Get("apiKeyType")
+		if apiKeyType != apikey.ApiKeyTypeClient {
+			ctx.AbortWithError(403, errors.New("only clients can impersonate users"))
+			return
+		}
+
+		server := server.GetInstance(nil)
+
+		session, err := server.ImpersonationService.Authorize(sessionId, ctx.GetString("apiKeyName"), ctx.GetBool("serverAdmin"))
+		if err != nil {
+			switch {
+			case impersonation.IsSessionNotFound(err):
+				ctx.AbortWithError(404, fmt.Errorf("impersonation session %s not found", sessionId))
+			case impersonation_service.IsNotAnAdmin(err), impersonation_service.IsSessionNotActive(err):
+				ctx.AbortWithError(403, err)
+			default:
+				ctx.AbortWithError(500, fmt.Errorf("failed to authorize impersonation: %w", err))
+			}
+			return
+		}
+
+		if !impersonation.IsRouteAllowed(ctx.Request.Method, ctx.FullPath()) {
+			ctx.AbortWithError(403, fmt.Errorf("%s %s is not allowed while impersonating %s, only creating and inspecting workspaces is", ctx.Request.Method, ctx.Request.URL.Path, session.User))
+			return
+		}
+
+		err = server.ImpersonationService.RecordRequest(session, ctx.Request.Method, ctx.Request.URL.Path)
+		if err != nil {
+			// Impersonated requests must not go unaudited
+			log.Errorf("Failed to record impersonated request: %v", err)
+			ctx.AbortWithError(500, errors.New("failed to record impersonated request"))
+			return
+		}
+
+		ctx.Set("apiKeyName", session.User)
+		ctx.Set("serverAdmin", false)
+		ctx.Next()
+	}
+}
@@ -6,12 +6,31 @@ package middlewares
 import (
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/correlation"
 	"github.com/gin-gonic/gin"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func LoggingMiddleware() gin.HandlerFunc {
+// LoggingMiddleware logs the API requests at the given level, or at the level of the server logs if it is empty
+func LoggingMiddleware(level string) gin.HandlerFunc {
+	logger := log.StandardLogger()
+	if level != "" {
+		logLevel, err := log.ParseLevel(level)
+		if err == nil {
+			// The request logger shares the output and the formatter of the server logs
+			std := log.StandardLogger()
+			logger = &log.Logger{
+				Out:          std.Out,
+				Formatter:    std.Formatter,
+				Hooks:        std.Hooks,
+				ReportCaller: std.ReportCaller,
+				ExitFunc:     std.ExitFunc,
+				Level:        logLevel,
+			}
+		}
+	}
+
 	return func(ctx *gin.Context) {
 		startTime := time.Now()
 		ctx.Next()
@@ -20,22 +39,25 @@ func LoggingMiddleware() gin.HandlerFunc {
 		reqMethod := ctx.Request.Method
 		reqUri := ctx.Request.RequestURI
 		statusCode := ctx.Writer.Status()
+		correlationId := correlation.GetId(ctx.Request.Context())
 
 		if len(ctx.Errors) > 0 {
-			log.WithFields(log.Fields{
-				"method":  reqMethod,
-				"URI":     reqUri,
-				"status":  statusCode,
-				"latency": latencyTime,
-				"error":   ctx.Errors.String(),
+			logger.WithFields(log.Fields{
+				"method":              reqMethod,
+				"URI":                 reqUri,
+				"status":              statusCode,
+				"latency":             latencyTime,
+				"error":               ctx.Errors.String(),
+				correlation.LOG_FIELD: correlationId,
 			}).Error("API ERROR")
-			ctx.JSON(statusCode, gin.H{"error": ctx.Errors[0].Err.Error()})
+			ctx.JSON(statusCode, NewErrorResponse(ctx.Errors[0].Err, statusCode))
 		} else {
-			log.WithFields(log.Fields{
-				"method":  reqMethod,
-				"URI":     reqUri,
-				"status":  statusCode,
-				"latency": latencyTime,
+			logger.WithFields(log.Fields{
+				"method":              reqMethod,
+				"URI":                 reqUri,
+				"status":              statusCode,
+				"latency":             latencyTime,
+				correlation.LOG_FIELD: correlationId,
 			}).Info("API REQUEST")
 		}
 
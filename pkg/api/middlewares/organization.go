@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// OrganizationMiddleware scopes client requests to the organization selected with the organization header.
+// The authenticated client API key must be a member of the organization.
+func OrganizationMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		organizationIdOrName := ctx.GetHeader(organization.ORGANIZATION_HEADER)
+		apiKeyType, _ := ctx.Get("apiKeyType")
+		if organizationIdOrName == "" || apiKeyType != apikey.ApiKeyTypeClient {
+			ctx.Next()
+			return
+		}
+
+		server := server.GetInstance(nil)
+
+		o, err := server.OrganizationService.Find(organizationIdOrName)
+		if err != nil {
+			if organization.IsOrganizationNotFound(err) {
+				ctx.AbortWithError(404, fmt.Errorf("organization %s not found", organizationIdOrName))
+				return
+			}
+			ctx.AbortWithError(500, fmt.Errorf("failed to find organization: %w", err))
+			return
+		}
+
+		if !o.HasMember(ctx.GetString("apiKeyName")) {
+			ctx.AbortWithError(403, errors.New("not a member of the organization"))
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(organization.WithOrganizationId(ctx.Request.Context(), o.Id))
+		ctx.Next()
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/gin-gonic/gin"
+)
+
+// WorkspaceOrganizationMiddleware only lets client requests reach the workspaces of the organization the request is
+// scoped to. Workspaces of other organizations are reported as not found
+func WorkspaceOrganizationMiddleware() gin.HandlerFunc {
+	return organizationScopeMiddleware([]string{"workspaceId", "otherWorkspaceId"}, func(ctx context.Context, idOrName string) (string, bool, error) {
+		w, err := server.GetInstance(nil).WorkspaceService.GetWorkspace(ctx, idOrName, false)
+		if err != nil {
+			if workspaces.IsWorkspaceNotFound(err) {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+
+		return w.OrganizationId, true, nil
+	})
+}
+
+// TargetOrganizationMiddleware only lets client requests reach the targets of the organization the request is
+// scoped to. Targets of other organizations are reported as not found
+func TargetOrganizationMiddleware() gin.HandlerFunc {
+	return organizationScopeMiddleware([]string{"target"}, func(ctx context.Context, name string) (string, bool, error) {
+		t, err := server.GetInstance(nil).ProviderTargetService.Find(&provider.TargetFilter{Name: &name})
+		if err != nil {
+			if provider.IsTargetNotFound(err) {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+
+		return t.OrganizationId, true, nil
+	})
+}
+
+// ProjectConfigOrganizationMiddleware only lets client requests reach the project configs of the organization the
+// request is scoped to. Project configs of other organizations are reported as not found
+func ProjectConfigOrganizationMiddleware() gin.HandlerFunc {
+	return organizationScopeMiddleware([]string{"configName"}, func(ctx context.Context, name string) (string, bool, error) {
+		pc, err := server.GetInstance(nil).ProjectConfigService.Find(&config.ProjectConfigFilter{Name: &name})
+		if err != nil {
+			if config.IsProjectConfigNotFound(err) {
+				return "", false, nil
+			}
+			return "", false, err
+		}
+
+		return pc.OrganizationId, true, nil
+	})
+}
+
+// organizationScopeMiddleware aborts client requests for resources, named by the route params, that belong to another
+// organization. Missing resources are left to the handlers
+func organizationScopeMiddleware(params []string, getOrganizationId func(ctx context.Context, idOrName string) (string, bool, error)) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		apiKeyType, _ := ctx.Get("apiKeyType")
+		if apiKeyType != apikey.ApiKeyTypeClient {
+			ctx.Next()
+			return
+		}
+
+		organizationId := organization.GetOrganizationId(ctx.Request.Context())
+
+		for _, param := range params {
+			idOrName := ctx.Param(param)
+			if idOrName == "" {
+				continue
+			}
+
+			resourceOrganizationId, found, err := getOrganizationId(ctx.Request.Context(), idOrName)
+			if err != nil {
+				ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to check the organization of %s: %w", idOrName, err))
+				return
+			}
+
+			if found && resourceOrganizationId != organizationId {
+				ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("%s not found", idOrName))
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
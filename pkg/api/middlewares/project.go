@@ -4,32 +4,20 @@
 package middlewares
 
 import (
-	"errors"
-
-	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/api/auth"
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/gin-gonic/gin"
 )
 
+// ProjectAuthMiddleware only allows requests authenticated with a project or workspace API key
 func ProjectAuthMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
-		bearerToken := ctx.GetHeader("Authorization")
-		if bearerToken == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
-			return
-		}
-
-		token := ExtractToken(bearerToken)
-		if token == "" {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
+		apiKeyType, _ := ctx.Get("apiKeyType")
+		if apiKeyType != apikey.ApiKeyTypeProject && apiKeyType != apikey.ApiKeyTypeWorkspace {
+			ctx.AbortWithError(401, auth.ErrUnauthorized)
 			return
 		}
 
-		server := server.GetInstance(nil)
-
-		if !server.ApiKeyService.IsProjectApiKey(token) && !server.ApiKeyService.IsWorkspaceApiKey(token) {
-			ctx.AbortWithError(401, errors.New("unauthorized"))
-		}
-
 		ctx.Next()
 	}
 }
@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ServerAdminMiddleware only lets server administrators, authenticated with an admin API key or the admin token,
+// reach the route
+func ServerAdminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !ctx.GetBool("serverAdmin") {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("only server administrators can perform this action"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -20,33 +20,47 @@ package api
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/daytonaio/daytona/internal/faults"
+	"github.com/daytonaio/daytona/pkg/api/auth"
 	"github.com/daytonaio/daytona/pkg/api/docs"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/deeplink"
 	"github.com/daytonaio/daytona/pkg/frpc"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/gin-contrib/cors"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers/announcement"
 	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
+	"github.com/daytonaio/daytona/pkg/api/controllers/artifact"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
 	"github.com/daytonaio/daytona/pkg/api/controllers/build"
+	"github.com/daytonaio/daytona/pkg/api/controllers/buildsecret"
+	"github.com/daytonaio/daytona/pkg/api/controllers/commandrun"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	deeplink_controller "github.com/daytonaio/daytona/pkg/api/controllers/deeplink"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/health"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
+	"github.com/daytonaio/daytona/pkg/api/controllers/organization"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig"
 	"github.com/daytonaio/daytona/pkg/api/controllers/projectconfig/prebuild"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider"
+	"github.com/daytonaio/daytona/pkg/api/controllers/region"
+	"github.com/daytonaio/daytona/pkg/api/controllers/rollout"
 	"github.com/daytonaio/daytona/pkg/api/controllers/sample"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
+	"github.com/daytonaio/daytona/pkg/api/controllers/sharedservice"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/toolbox"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -64,6 +78,9 @@ type ApiServerConfig struct {
 	TelemetryService telemetry.TelemetryService
 	Frps             *daytonaServer.FRPSConfig
 	ServerId         string
+	Auth             *daytonaServer.AuthConfig
+	// Log level of the API request logs. Requests are logged at the level of the server logs if empty
+	LogLevel string
 }
 
 func NewApiServer(config ApiServerConfig) *ApiServer {
@@ -73,6 +90,8 @@ func NewApiServer(config ApiServerConfig) *ApiServer {
 		version:          config.Version,
 		frps:             config.Frps,
 		serverId:         config.ServerId,
+		auth:             config.Auth,
+		logLevel:         config.LogLevel,
 	}
 }
 
@@ -84,6 +103,9 @@ type ApiServer struct {
 	version          string
 	frps             *daytonaServer.FRPSConfig
 	serverId         string
+	auth             *daytonaServer.AuthConfig
+	logLevel         string
+	mtlsServer       *http.Server
 }
 
 func (a *ApiServer) Start() error {
@@ -110,8 +132,9 @@ func (a *ApiServer) Start() error {
 		a.router.Use(gin.Recovery())
 	}
 
+	a.router.Use(middlewares.CorrelationMiddleware())
 	a.router.Use(middlewares.TelemetryMiddleware(a.telemetryService))
-	a.router.Use(middlewares.LoggingMiddleware())
+	a.router.Use(middlewares.LoggingMiddleware(a.logLevel))
 	a.router.Use(middlewares.SetVersionMiddleware(a.version))
 
 	public := a.router.Group("/")
@@ -122,15 +145,46 @@ func (a *ApiServer) Start() error {
 		healthController.GET("/", health.HealthCheck)
 	}
 
+	public.GET(deeplink.OpenRoutePath, deeplink_controller.OpenLink)
+
+	authRouteChains, err := auth.NewRouteChains(a.auth, daytonaServer.GetInstance(nil).ApiKeyService)
+	if err != nil {
+		return err
+	}
+
 	protected := a.router.Group("/")
-	protected.Use(middlewares.AuthMiddleware())
+	// Public routes are left out so health checks keep working while faults are injected
+	if injector := faults.GetInjector(faults.LayerApi); injector != nil {
+		protected.Use(middlewares.FaultInjectionMiddleware(injector))
+	}
+	protected.Use(middlewares.AuthMiddleware(authRouteChains))
+	// Organization membership is checked for the impersonated user
+	protected.Use(middlewares.ImpersonationMiddleware())
+	protected.Use(middlewares.OrganizationMiddleware())
 
 	serverController := protected.Group("/server")
 	{
-		serverController.GET("/config", server.GetConfig)
+		serverController.GET("/config", middlewares.ETagMiddleware(), server.GetConfig)
 		serverController.POST("/config", server.SetConfig)
 		serverController.POST("/network-key", server.GenerateNetworkKey)
+		serverController.GET("/network-key", middlewares.ServerAdminMiddleware(), server.ListNetworkKeys)
+		serverController.POST("/network-key/revoke", middlewares.ServerAdminMiddleware(), server.RevokeNetworkKeys)
+		serverController.DELETE("/network-key/:keyId", middlewares.ServerAdminMiddleware(), server.RevokeNetworkKey)
 		serverController.GET("/logs", server.GetServerLogFiles)
+		serverController.GET("/cleanup-policies/preview", server.PreviewCleanup)
+		serverController.GET("/creation-timings", server.GetCreationTimingReport)
+		serverController.GET("/audit", middlewares.ServerAdminMiddleware(), server.ListAuditEvents)
+		serverController.GET("/tailnet-ca", server.GetTailnetCa)
+		serverController.GET("/impersonation", server.ListImpersonationSessions)
+		serverController.POST("/impersonation", server.StartImpersonation)
+		serverController.POST("/impersonation/:sessionId/end", server.EndImpersonation)
+	}
+
+	regionController := protected.Group("/region")
+	{
+		regionController.GET("/", region.ListRegions)
+		regionController.POST("/", middlewares.ServerAdminMiddleware(), region.RegisterRegion)
+		regionController.DELETE("/:regionName", middlewares.ServerAdminMiddleware(), region.RemoveRegion)
 	}
 
 	binaryController := protected.Group("/binary")
@@ -139,16 +193,57 @@ func (a *ApiServer) Start() error {
 		binaryController.GET("/:version/:binaryName", binary.GetBinary)
 	}
 
-	workspaceController := protected.Group("/workspace")
+	workspaceController := protected.Group("/workspace", middlewares.WorkspaceOrganizationMiddleware())
 	{
-		workspaceController.GET("/:workspaceId", workspace.GetWorkspace)
-		workspaceController.GET("/", workspace.ListWorkspaces)
+		workspaceController.GET("/:workspaceId", middlewares.ETagMiddleware(), workspace.GetWorkspace)
+		workspaceController.GET("/:workspaceId/diff/:otherWorkspaceId", workspace.DiffWorkspaces)
+		workspaceController.GET("/:workspaceId/history", workspace.GetWorkspaceStateHistory)
+		workspaceController.GET("/:workspaceId/events", workspace.ListWorkspaceEvents)
+		workspaceController.GET("/:workspaceId/usage", workspace.ListWorkspaceUsage)
+		workspaceController.GET("/:workspaceId/ready", workspace.WaitForWorkspaceReady)
+		workspaceController.POST("/:workspaceId/ready-hooks", workspace.RegisterReadyHook)
+		workspaceController.GET("/", middlewares.ETagMiddleware(), workspace.ListWorkspaces)
 		workspaceController.POST("/", workspace.CreateWorkspace)
+		workspaceController.POST("/adopt", workspace.AdoptWorkspace)
+		workspaceController.POST("/validate", workspace.ValidateCreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/pause", workspace.PauseWorkspace)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.POST("/:workspaceId/:projectId/pause", workspace.PauseProject)
+		workspaceController.POST("/:workspaceId/:projectId/rebuild", workspace.RebuildProject)
+		workspaceController.POST("/:workspaceId/:projectId/recovery", workspace.StartProjectRecovery)
+		workspaceController.DELETE("/:workspaceId/:projectId/recovery", workspace.StopProjectRecovery)
+		workspaceController.PATCH("/:workspaceId/annotations", workspace.UpdateWorkspaceAnnotations)
+		workspaceController.PATCH("/:workspaceId/:projectId/annotations", workspace.UpdateProjectAnnotations)
+		workspaceController.POST("/:workspaceId/:projectId/commands/:commandName/run", commandrun.RunProjectCommand)
+		workspaceController.GET("/:workspaceId/:projectId/forward/:port", workspace.ForwardPort)
+		workspaceController.GET("/:workspaceId/:projectId/health", workspace.GetProjectHealth)
+		workspaceController.POST("/:workspaceId/:projectId/public-port/:port", workspace.RegisterPublicPort)
+		workspaceController.POST("/:workspaceId/:projectId/ssh-token", workspace.CreateSshToken)
+		workspaceController.PUT("/:workspaceId/:projectId/hostname", workspace.SetProjectHostname)
+		workspaceController.GET("/:workspaceId/:projectId/routes", workspace.GetProjectRoutes)
+		workspaceController.GET("/:workspaceId/:projectId/access-policy", workspace.GetProjectAccessPolicy)
+		workspaceController.PUT("/:workspaceId/:projectId/access-policy", workspace.SetProjectAccessPolicy)
+		workspaceController.PUT("/:workspaceId/bandwidth-limit", workspace.SetWorkspaceBandwidthLimit)
+		workspaceController.GET("/:workspaceId/:projectId/bandwidth-limit", workspace.GetProjectBandwidthLimit)
+
+		toolboxController := workspaceController.Group("/:workspaceId/:projectId/toolbox")
+		{
+			toolboxController.GET("/files", toolbox.ListFiles)
+			toolboxController.GET("/files/preview", toolbox.PreviewFile)
+			toolboxController.GET("/files/download", toolbox.DownloadFile)
+			toolboxController.POST("/files/upload", toolbox.UploadFile)
+			toolboxController.POST("/files/folder", toolbox.CreateFolder)
+			toolboxController.POST("/files/move", toolbox.MoveFile)
+			toolboxController.DELETE("/files", toolbox.DeleteFile)
+			toolboxController.GET("/ports", toolbox.ListPorts)
+			toolboxController.GET("/drift", toolbox.GetProjectDrift)
+			toolboxController.POST("/git/update", toolbox.UpdateProjectBranch)
+			toolboxController.GET("/bridge", toolbox.Bridge)
+		}
 	}
 
 	projectConfigController := protected.Group("/project-config")
@@ -157,10 +252,11 @@ func (a *ApiServer) Start() error {
 		prebuildRoutePath := "/prebuild"
 		projectConfigPrebuildsGroup := projectConfigController.Group(prebuildRoutePath)
 		{
-			projectConfigPrebuildsGroup.GET("/", prebuild.ListPrebuilds)
+			projectConfigPrebuildsGroup.GET("/", middlewares.ETagMiddleware(), prebuild.ListPrebuilds)
+			projectConfigPrebuildsGroup.GET("/stats", prebuild.GetPrebuildStats)
 		}
 
-		projectConfigNameGroup := projectConfigController.Group(":configName")
+		projectConfigNameGroup := projectConfigController.Group(":configName", middlewares.ProjectConfigOrganizationMiddleware())
 		{
 			projectConfigNameGroup.PUT(prebuildRoutePath+"/", prebuild.SetPrebuild)
 			projectConfigNameGroup.GET(prebuildRoutePath+"/", prebuild.ListPrebuildsForProjectConfig)
@@ -172,7 +268,7 @@ func (a *ApiServer) Start() error {
 			projectConfigNameGroup.DELETE("/", projectconfig.DeleteProjectConfig)
 		}
 
-		projectConfigController.GET("/", projectconfig.ListProjectConfigs)
+		projectConfigController.GET("/", middlewares.ETagMiddleware(), projectconfig.ListProjectConfigs)
 		projectConfigController.PUT("/", projectconfig.SetProjectConfig)
 		projectConfigController.GET("/default/:gitUrl", projectconfig.GetDefaultProjectConfig)
 	}
@@ -182,48 +278,58 @@ func (a *ApiServer) Start() error {
 	providerController := protected.Group("/provider")
 	{
 		providerController.POST("/install", provider.InstallProvider)
-		providerController.GET("/", provider.ListProviders)
+		providerController.GET("/", middlewares.ETagMiddleware(), provider.ListProviders)
 		providerController.POST("/:provider/uninstall", provider.UninstallProvider)
 		providerController.GET("/:provider/target-manifest", provider.GetTargetManifest)
 	}
 
 	containerRegistryController := protected.Group("/container-registry")
 	{
-		containerRegistryController.GET("/", containerregistry.ListContainerRegistries)
+		containerRegistryController.GET("/", middlewares.ETagMiddleware(), containerregistry.ListContainerRegistries)
 		containerRegistryController.GET("/:server", containerregistry.GetContainerRegistry)
 		containerRegistryController.PUT("/:server", containerregistry.SetContainerRegistry)
 		containerRegistryController.DELETE("/:server", containerregistry.RemoveContainerRegistry)
 	}
 
+	buildSecretController := protected.Group("/build-secret")
+	{
+		buildSecretController.GET("/", middlewares.ETagMiddleware(), buildsecret.ListBuildSecrets)
+		buildSecretController.PUT("/:name", buildsecret.SetBuildSecret)
+		buildSecretController.DELETE("/:name", buildsecret.RemoveBuildSecret)
+	}
+
 	buildController := protected.Group("/build")
 	{
 		buildController.POST("/", build.CreateBuild)
 		buildController.GET("/:buildId", build.GetBuild)
-		buildController.GET("/", build.ListBuilds)
+		buildController.POST("/:buildId/priority", middlewares.ServerAdminMiddleware(), build.SetBuildPriority)
+		buildController.GET("/", middlewares.ETagMiddleware(), build.ListBuilds)
 		buildController.DELETE("/", build.DeleteAllBuilds)
 		buildController.DELETE("/:buildId", build.DeleteBuild)
 		buildController.DELETE("/prebuild/:prebuildId", build.DeleteBuildsFromPrebuild)
 	}
 
-	targetController := protected.Group("/target")
+	targetController := protected.Group("/target", middlewares.TargetOrganizationMiddleware())
 	{
-		targetController.GET("/", target.ListTargets)
+		targetController.GET("/", middlewares.ETagMiddleware(), target.ListTargets)
 		targetController.PUT("/", target.SetTarget)
 		targetController.PATCH("/:target/set-default", target.SetDefaultTarget)
+		targetController.PUT("/:target/bandwidth-limit", target.SetTargetBandwidthLimit)
+		targetController.GET("/:target/allocation", target.GetTargetAllocation)
 		targetController.DELETE("/:target", target.RemoveTarget)
 	}
 
 	logController := protected.Group("/log")
 	{
 		logController.GET("/server", log_controller.ReadServerLog)
-		logController.GET("/workspace/:workspaceId", log_controller.ReadWorkspaceLog)
-		logController.GET("/workspace/:workspaceId/:projectName", log_controller.ReadProjectLog)
+		logController.GET("/workspace/:workspaceId", middlewares.WorkspaceOrganizationMiddleware(), log_controller.ReadWorkspaceLog)
+		logController.GET("/workspace/:workspaceId/:projectName", middlewares.WorkspaceOrganizationMiddleware(), log_controller.ReadProjectLog)
 		logController.GET("/build/:buildId", log_controller.ReadBuildLog)
 	}
 
 	gitProviderController := protected.Group("/gitprovider")
 	{
-		gitProviderController.GET("/", gitprovider.ListGitProviders)
+		gitProviderController.GET("/", middlewares.ETagMiddleware(), gitprovider.ListGitProviders)
 		gitProviderController.PUT("/", gitprovider.SetGitProvider)
 		gitProviderController.DELETE("/:gitProviderId", gitprovider.RemoveGitProvider)
 		gitProviderController.GET("/:gitProviderId/user", gitprovider.GetGitUser)
@@ -245,6 +351,26 @@ func (a *ApiServer) Start() error {
 		apiKeyController.DELETE("/:apiKeyName", apikey.RevokeApiKey)
 	}
 
+	organizationController := protected.Group("/organization")
+	{
+		organizationController.GET("/", organization.ListOrganizations)
+		organizationController.POST("/", organization.CreateOrganization)
+		organizationController.GET("/:organizationId", organization.GetOrganization)
+		organizationController.DELETE("/:organizationId", organization.DeleteOrganization)
+		organizationController.POST("/:organizationId/member", organization.AddOrganizationMember)
+		organizationController.DELETE("/:organizationId/member/:member", organization.RemoveOrganizationMember)
+		organizationController.PUT("/:organizationId/quota", organization.SetOrganizationQuota)
+	}
+
+	announcementController := protected.Group("/announcement")
+	{
+		announcementController.GET("/", announcement.ListAnnouncements)
+		announcementController.POST("/", middlewares.ServerAdminMiddleware(), announcement.CreateAnnouncement)
+		announcementController.DELETE("/:announcementId", middlewares.ServerAdminMiddleware(), announcement.DeleteAnnouncement)
+	}
+
+	addRolloutRoutes(protected)
+
 	profileDataController := protected.Group("/profile")
 	{
 		profileDataController.GET("/", profiledata.GetProfileData)
@@ -252,6 +378,35 @@ func (a *ApiServer) Start() error {
 		profileDataController.DELETE("/", profiledata.DeleteProfileData)
 	}
 
+	commandRunController := protected.Group("/command-run")
+	{
+		commandRunController.GET("/", commandrun.ListCommandRuns)
+		commandRunController.GET("/:runId", commandrun.GetCommandRun)
+	}
+
+	sharedServiceController := protected.Group("/shared-service")
+	{
+		sharedServiceController.GET("/", middlewares.ETagMiddleware(), sharedservice.ListSharedServices)
+		sharedServiceController.POST("/", sharedservice.CreateSharedService)
+		sharedServiceController.DELETE("/:serviceName", sharedservice.DeleteSharedService)
+		sharedServiceController.POST("/:serviceName/workspace/:workspaceId", middlewares.WorkspaceOrganizationMiddleware(), sharedservice.AttachSharedService)
+		sharedServiceController.DELETE("/:serviceName/workspace/:workspaceId", middlewares.WorkspaceOrganizationMiddleware(), sharedservice.DetachSharedService)
+	}
+
+	serviceDiscoveryController := protected.Group("/service-discovery")
+	{
+		serviceDiscoveryController.GET("/", workspace.ListServiceEndpoints)
+		serviceDiscoveryController.GET("/:name", workspace.ResolveService)
+	}
+
+	artifactController := protected.Group("/artifact")
+	{
+		artifactController.GET("/", artifact.ListArtifacts)
+		artifactController.GET("/:artifactId", artifact.GetArtifact)
+		artifactController.GET("/:artifactId/download", artifact.DownloadArtifact)
+		artifactController.DELETE("/:artifactId", artifact.DeleteArtifact)
+	}
+
 	samplesController := protected.Group("/sample")
 	{
 		samplesController.GET("/", sample.ListSamples)
@@ -261,6 +416,17 @@ func (a *ApiServer) Start() error {
 	projectGroup.Use(middlewares.ProjectAuthMiddleware())
 	{
 		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/state", workspace.SetProjectState)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/artifacts", artifact.UploadArtifact)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/creation-timings", workspace.RecordProjectCreationTimings)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/events", workspace.RecordProjectEvents)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/usage", workspace.RecordProjectUsage)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/idle-stop", workspace.StopIdleWorkspace)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/ssh-access", workspace.VerifySshAccess)
+		projectGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/ssh-host-key", workspace.GetProjectSshHostKey)
+		projectGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/agent-update", workspace.GetProjectAgentUpdate)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/network-key", workspace.RenewProjectNetworkKey)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/certificate", workspace.IssueProjectCertificate)
+		projectGroup.GET(workspaceController.BasePath()+"/:workspaceId/:projectId/tunnel", workspace.ServeProjectTunnel)
 	}
 
 	a.httpServer = &http.Server{
@@ -278,6 +444,13 @@ func (a *ApiServer) Start() error {
 		errChan <- a.httpServer.Serve(listener)
 	}()
 
+	if a.auth != nil && a.auth.Mtls != nil {
+		err = a.startMtlsServer(errChan)
+		if err != nil {
+			return err
+		}
+	}
+
 	if a.frps == nil {
 		return <-errChan
 	}
@@ -315,6 +488,33 @@ func (a *ApiServer) Start() error {
 	return <-errChan
 }
 
+// startMtlsServer serves the API on an additional TLS listener that verifies client certificates
+func (a *ApiServer) startMtlsServer(errChan chan error) error {
+	tlsConfig, err := auth.GetMtlsConfig(a.auth.Mtls)
+	if err != nil {
+		return err
+	}
+
+	a.mtlsServer = &http.Server{
+		Addr:      fmt.Sprintf(":%d", a.auth.Mtls.Port),
+		Handler:   a.router,
+		TLSConfig: tlsConfig,
+	}
+
+	listener, err := net.Listen("tcp", a.mtlsServer.Addr)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Starting mTLS api listener on port %d", a.auth.Mtls.Port)
+
+	go func() {
+		errChan <- a.mtlsServer.Serve(tls.NewListener(listener, tlsConfig))
+	}()
+
+	return nil
+}
+
 func (a *ApiServer) HealthCheck() error {
 	resp, err := http.Get(fmt.Sprintf("http://localhost:%d%s", a.apiPort, constants.HEALTH_CHECK_ROUTE))
 	if err != nil {
@@ -334,4 +534,22 @@ func (a *ApiServer) Stop() {
 	if err := a.httpServer.Shutdown(ctx); err != nil {
 		log.Error(err)
 	}
+	if a.mtlsServer != nil {
+		if err := a.mtlsServer.Shutdown(ctx); err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// addRolloutRoutes adds the agent rollout routes. Rollouts change the agent version of the whole fleet, so only server
+// administrators can start, promote or roll them back
+func addRolloutRoutes(router *gin.RouterGroup) {
+	rolloutController := router.Group("/rollout")
+	{
+		rolloutController.GET("/", rollout.ListRollouts)
+		rolloutController.POST("/", middlewares.ServerAdminMiddleware(), rollout.CreateRollout)
+		rolloutController.GET("/:rolloutId", rollout.GetRollout)
+		rolloutController.POST("/:rolloutId/promote", middlewares.ServerAdminMiddleware(), rollout.PromoteRollout)
+		rolloutController.POST("/:rolloutId/rollback", middlewares.ServerAdminMiddleware(), rollout.RollbackRollout)
+	}
 }
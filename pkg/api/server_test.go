@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRolloutRoutesRequireServerAdmin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	protected := router.Group("/", func(ctx *gin.Context) {
+		// Project and workspace API keys are not server administrators
+		ctx.Set("serverAdmin", false)
+		ctx.Next()
+	})
+	addRolloutRoutes(protected)
+
+	for _, path := range []string{"/rollout/", "/rollout/r1/promote", "/rollout/r1/rollback"} {
+		t.Run(path, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, nil))
+
+			require.Equal(t, http.StatusForbidden, recorder.Code)
+		})
+	}
+}
@@ -26,6 +26,13 @@ type ApiGenerateApiKeyRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
 	apiKeyName string
+	admin      *bool
+}
+
+// Generate a key that can manage server-wide resources. Only administrators can generate admin keys
+func (r ApiGenerateApiKeyRequest) Admin(admin bool) ApiGenerateApiKeyRequest {
+	r.admin = &admin
+	return r
 }
 
 func (r ApiGenerateApiKeyRequest) Execute() (string, *http.Response, error) {
@@ -35,7 +42,7 @@ func (r ApiGenerateApiKeyRequest) Execute() (string, *http.Response, error) {
 /*
 GenerateApiKey Generate an API key
 
-Generate an API key
+Generate an API key. Fails with a conflict if a key with the name already exists
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@param apiKeyName API key name
@@ -72,6 +79,9 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.admin != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "admin", r.admin, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
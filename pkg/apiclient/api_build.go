@@ -723,3 +723,121 @@ func (a *BuildAPIService) ListBuildsExecute(r ApiListBuildsRequest) ([]Build, *h
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type ApiSetBuildPriorityRequest struct {
+	ctx                 context.Context
+	ApiService          *BuildAPIService
+	buildId             string
+	setBuildPriorityDto *SetBuildPriorityDTO
+}
+
+// Set Build Priority DTO
+func (r ApiSetBuildPriorityRequest) SetBuildPriorityDto(setBuildPriorityDto SetBuildPriorityDTO) ApiSetBuildPriorityRequest {
+	r.setBuildPriorityDto = &setBuildPriorityDto
+	return r
+}
+
+func (r ApiSetBuildPriorityRequest) Execute() (*http.Response, error) {
+	return r.ApiService.SetBuildPriorityExecute(r)
+}
+
+/*
+SetBuildPriority Set build priority
+
+Set the priority of a build. Pending builds of a higher priority preempt running builds of a lower priority when the build runner is at capacity. Only server administrators can set the priority of builds
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param buildId Build ID
+	@return ApiSetBuildPriorityRequest
+*/
+func (a *BuildAPIService) SetBuildPriority(ctx context.Context, buildId string) ApiSetBuildPriorityRequest {
+	return ApiSetBuildPriorityRequest{
+		ApiService: a,
+		ctx:        ctx,
+		buildId:    buildId,
+	}
+}
+
+// Execute executes the request
+func (a *BuildAPIService) SetBuildPriorityExecute(r ApiSetBuildPriorityRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "BuildAPIService.SetBuildPriority")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/build/{buildId}/priority"
+	localVarPath = strings.Replace(localVarPath, "{"+"buildId"+"}", url.PathEscape(parameterValueToString(r.buildId, "buildId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.setBuildPriorityDto == nil {
+		return nil, reportError("setBuildPriorityDto is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.setBuildPriorityDto
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
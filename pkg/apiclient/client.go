@@ -48,16 +48,26 @@ type APIClient struct {
 
 	// API Services
 
+	AnnouncementAPI *AnnouncementAPIService
+
 	ApiKeyAPI *ApiKeyAPIService
 
+	ArtifactAPI *ArtifactAPIService
+
 	BuildAPI *BuildAPIService
 
+	BuildSecretAPI *BuildSecretAPIService
+
+	CommandRunAPI *CommandRunAPIService
+
 	ContainerRegistryAPI *ContainerRegistryAPIService
 
 	DefaultAPI *DefaultAPIService
 
 	GitProviderAPI *GitProviderAPIService
 
+	OrganizationAPI *OrganizationAPIService
+
 	PrebuildAPI *PrebuildAPIService
 
 	ProfileAPI *ProfileAPIService
@@ -66,13 +76,21 @@ type APIClient struct {
 
 	ProviderAPI *ProviderAPIService
 
+	RegionAPI *RegionAPIService
+
+	RolloutAPI *RolloutAPIService
+
 	SampleAPI *SampleAPIService
 
 	ServerAPI *ServerAPIService
 
+	SharedServiceAPI *SharedServiceAPIService
+
 	TargetAPI *TargetAPIService
 
 	WorkspaceAPI *WorkspaceAPIService
+
+	WorkspaceToolboxAPI *WorkspaceToolboxAPIService
 }
 
 type service struct {
@@ -91,19 +109,28 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.common.client = c
 
 	// API Services
+	c.AnnouncementAPI = (*AnnouncementAPIService)(&c.common)
 	c.ApiKeyAPI = (*ApiKeyAPIService)(&c.common)
+	c.ArtifactAPI = (*ArtifactAPIService)(&c.common)
 	c.BuildAPI = (*BuildAPIService)(&c.common)
+	c.BuildSecretAPI = (*BuildSecretAPIService)(&c.common)
+	c.CommandRunAPI = (*CommandRunAPIService)(&c.common)
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
 	c.DefaultAPI = (*DefaultAPIService)(&c.common)
 	c.GitProviderAPI = (*GitProviderAPIService)(&c.common)
+	c.OrganizationAPI = (*OrganizationAPIService)(&c.common)
 	c.PrebuildAPI = (*PrebuildAPIService)(&c.common)
 	c.ProfileAPI = (*ProfileAPIService)(&c.common)
 	c.ProjectConfigAPI = (*ProjectConfigAPIService)(&c.common)
 	c.ProviderAPI = (*ProviderAPIService)(&c.common)
+	c.RegionAPI = (*RegionAPIService)(&c.common)
+	c.RolloutAPI = (*RolloutAPIService)(&c.common)
 	c.SampleAPI = (*SampleAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
+	c.SharedServiceAPI = (*SharedServiceAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
+	c.WorkspaceToolboxAPI = (*WorkspaceToolboxAPIService)(&c.common)
 
 	return c
 }
@@ -21,6 +21,8 @@ var _ MappedNullable = &ApiKey{}
 
 // ApiKey struct for ApiKey
 type ApiKey struct {
+	// Admin client keys can manage server-wide resources, e.g. announcements and regions
+	Admin   *bool  `json:"admin,omitempty"`
 	KeyHash string `json:"keyHash"`
 	// Project or client name
 	Name string           `json:"name"`
@@ -49,6 +51,38 @@ func NewApiKeyWithDefaults() *ApiKey {
 	return &this
 }
 
+// GetAdmin returns the Admin field value if set, zero value otherwise.
+func (o *ApiKey) GetAdmin() bool {
+	if o == nil || IsNil(o.Admin) {
+		var ret bool
+		return ret
+	}
+	return *o.Admin
+}
+
+// GetAdminOk returns a tuple with the Admin field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetAdminOk() (*bool, bool) {
+	if o == nil || IsNil(o.Admin) {
+		return nil, false
+	}
+	return o.Admin, true
+}
+
+// HasAdmin returns a boolean if a field has been set.
+func (o *ApiKey) HasAdmin() bool {
+	if o != nil && !IsNil(o.Admin) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdmin gets a reference to the given bool and assigns it to the Admin field.
+func (o *ApiKey) SetAdmin(v bool) {
+	o.Admin = &v
+}
+
 // GetKeyHash returns the KeyHash field value
 func (o *ApiKey) GetKeyHash() string {
 	if o == nil {
@@ -131,6 +165,9 @@ func (o ApiKey) MarshalJSON() ([]byte, error) {
 
 func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Admin) {
+		toSerialize["admin"] = o.Admin
+	}
 	toSerialize["keyHash"] = o.KeyHash
 	toSerialize["name"] = o.Name
 	toSerialize["type"] = o.Type
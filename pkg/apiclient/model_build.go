@@ -21,17 +21,27 @@ var _ MappedNullable = &Build{}
 
 // Build struct for Build
 type Build struct {
-	BuildConfig     *BuildConfig      `json:"buildConfig,omitempty"`
-	ContainerConfig ContainerConfig   `json:"containerConfig"`
-	CreatedAt       string            `json:"createdAt"`
-	EnvVars         map[string]string `json:"envVars"`
-	Id              string            `json:"id"`
-	Image           *string           `json:"image,omitempty"`
-	PrebuildId      string            `json:"prebuildId"`
-	Repository      GitRepository     `json:"repository"`
-	State           BuildBuildState   `json:"state"`
-	UpdatedAt       string            `json:"updatedAt"`
-	User            *string           `json:"user,omitempty"`
+	Architecture *string      `json:"architecture,omitempty"`
+	BuildConfig  *BuildConfig `json:"buildConfig,omitempty"`
+	// Names of the build secrets mounted into the build. Their values are resolved when the build runs
+	BuildSecrets    []string        `json:"buildSecrets,omitempty"`
+	ContainerConfig ContainerConfig `json:"containerConfig"`
+	// ID of the API request that created the build
+	CorrelationId *string           `json:"correlationId,omitempty"`
+	CreatedAt     string            `json:"createdAt"`
+	EnvVars       map[string]string `json:"envVars"`
+	Id            string            `json:"id"`
+	Image         *string           `json:"image,omitempty"`
+	// Time the published image of the prebuild was pulled onto the targets that use it
+	ImagePrePulledAt *string `json:"imagePrePulledAt,omitempty"`
+	// Organization of the project config of the build. Build secrets are resolved in it
+	OrganizationId *string            `json:"organizationId,omitempty"`
+	PrebuildId     string             `json:"prebuildId"`
+	Priority       BuildBuildPriority `json:"priority"`
+	Repository     GitRepository      `json:"repository"`
+	State          BuildBuildState    `json:"state"`
+	UpdatedAt      string             `json:"updatedAt"`
+	User           *string            `json:"user,omitempty"`
 }
 
 type _Build Build
@@ -40,13 +50,14 @@ type _Build Build
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewBuild(containerConfig ContainerConfig, createdAt string, envVars map[string]string, id string, prebuildId string, repository GitRepository, state BuildBuildState, updatedAt string) *Build {
+func NewBuild(containerConfig ContainerConfig, createdAt string, envVars map[string]string, id string, prebuildId string, priority BuildBuildPriority, repository GitRepository, state BuildBuildState, updatedAt string) *Build {
 	this := Build{}
 	this.ContainerConfig = containerConfig
 	this.CreatedAt = createdAt
 	this.EnvVars = envVars
 	this.Id = id
 	this.PrebuildId = prebuildId
+	this.Priority = priority
 	this.Repository = repository
 	this.State = state
 	this.UpdatedAt = updatedAt
@@ -61,6 +72,38 @@ func NewBuildWithDefaults() *Build {
 	return &this
 }
 
+// GetArchitecture returns the Architecture field value if set, zero value otherwise.
+func (o *Build) GetArchitecture() string {
+	if o == nil || IsNil(o.Architecture) {
+		var ret string
+		return ret
+	}
+	return *o.Architecture
+}
+
+// GetArchitectureOk returns a tuple with the Architecture field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetArchitectureOk() (*string, bool) {
+	if o == nil || IsNil(o.Architecture) {
+		return nil, false
+	}
+	return o.Architecture, true
+}
+
+// HasArchitecture returns a boolean if a field has been set.
+func (o *Build) HasArchitecture() bool {
+	if o != nil && !IsNil(o.Architecture) {
+		return true
+	}
+
+	return false
+}
+
+// SetArchitecture gets a reference to the given string and assigns it to the Architecture field.
+func (o *Build) SetArchitecture(v string) {
+	o.Architecture = &v
+}
+
 // GetBuildConfig returns the BuildConfig field value if set, zero value otherwise.
 func (o *Build) GetBuildConfig() BuildConfig {
 	if o == nil || IsNil(o.BuildConfig) {
@@ -93,6 +136,38 @@ func (o *Build) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *Build) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *Build) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *Build) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
 // GetContainerConfig returns the ContainerConfig field value
 func (o *Build) GetContainerConfig() ContainerConfig {
 	if o == nil {
@@ -117,6 +192,38 @@ func (o *Build) SetContainerConfig(v ContainerConfig) {
 	o.ContainerConfig = v
 }
 
+// GetCorrelationId returns the CorrelationId field value if set, zero value otherwise.
+func (o *Build) GetCorrelationId() string {
+	if o == nil || IsNil(o.CorrelationId) {
+		var ret string
+		return ret
+	}
+	return *o.CorrelationId
+}
+
+// GetCorrelationIdOk returns a tuple with the CorrelationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetCorrelationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CorrelationId) {
+		return nil, false
+	}
+	return o.CorrelationId, true
+}
+
+// HasCorrelationId returns a boolean if a field has been set.
+func (o *Build) HasCorrelationId() bool {
+	if o != nil && !IsNil(o.CorrelationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCorrelationId gets a reference to the given string and assigns it to the CorrelationId field.
+func (o *Build) SetCorrelationId(v string) {
+	o.CorrelationId = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *Build) GetCreatedAt() string {
 	if o == nil {
@@ -221,6 +328,70 @@ func (o *Build) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetImagePrePulledAt returns the ImagePrePulledAt field value if set, zero value otherwise.
+func (o *Build) GetImagePrePulledAt() string {
+	if o == nil || IsNil(o.ImagePrePulledAt) {
+		var ret string
+		return ret
+	}
+	return *o.ImagePrePulledAt
+}
+
+// GetImagePrePulledAtOk returns a tuple with the ImagePrePulledAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetImagePrePulledAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ImagePrePulledAt) {
+		return nil, false
+	}
+	return o.ImagePrePulledAt, true
+}
+
+// HasImagePrePulledAt returns a boolean if a field has been set.
+func (o *Build) HasImagePrePulledAt() bool {
+	if o != nil && !IsNil(o.ImagePrePulledAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetImagePrePulledAt gets a reference to the given string and assigns it to the ImagePrePulledAt field.
+func (o *Build) SetImagePrePulledAt(v string) {
+	o.ImagePrePulledAt = &v
+}
+
+// GetOrganizationId returns the OrganizationId field value if set, zero value otherwise.
+func (o *Build) GetOrganizationId() string {
+	if o == nil || IsNil(o.OrganizationId) {
+		var ret string
+		return ret
+	}
+	return *o.OrganizationId
+}
+
+// GetOrganizationIdOk returns a tuple with the OrganizationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Build) GetOrganizationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OrganizationId) {
+		return nil, false
+	}
+	return o.OrganizationId, true
+}
+
+// HasOrganizationId returns a boolean if a field has been set.
+func (o *Build) HasOrganizationId() bool {
+	if o != nil && !IsNil(o.OrganizationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrganizationId gets a reference to the given string and assigns it to the OrganizationId field.
+func (o *Build) SetOrganizationId(v string) {
+	o.OrganizationId = &v
+}
+
 // GetPrebuildId returns the PrebuildId field value
 func (o *Build) GetPrebuildId() string {
 	if o == nil {
@@ -245,6 +416,30 @@ func (o *Build) SetPrebuildId(v string) {
 	o.PrebuildId = v
 }
 
+// GetPriority returns the Priority field value
+func (o *Build) GetPriority() BuildBuildPriority {
+	if o == nil {
+		var ret BuildBuildPriority
+		return ret
+	}
+
+	return o.Priority
+}
+
+// GetPriorityOk returns a tuple with the Priority field value
+// and a boolean to check if the value has been set.
+func (o *Build) GetPriorityOk() (*BuildBuildPriority, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Priority, true
+}
+
+// SetPriority sets field value
+func (o *Build) SetPriority(v BuildBuildPriority) {
+	o.Priority = v
+}
+
 // GetRepository returns the Repository field value
 func (o *Build) GetRepository() GitRepository {
 	if o == nil {
@@ -359,17 +554,33 @@ func (o Build) MarshalJSON() ([]byte, error) {
 
 func (o Build) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Architecture) {
+		toSerialize["architecture"] = o.Architecture
+	}
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
 	toSerialize["containerConfig"] = o.ContainerConfig
+	if !IsNil(o.CorrelationId) {
+		toSerialize["correlationId"] = o.CorrelationId
+	}
 	toSerialize["createdAt"] = o.CreatedAt
 	toSerialize["envVars"] = o.EnvVars
 	toSerialize["id"] = o.Id
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.ImagePrePulledAt) {
+		toSerialize["imagePrePulledAt"] = o.ImagePrePulledAt
+	}
+	if !IsNil(o.OrganizationId) {
+		toSerialize["organizationId"] = o.OrganizationId
+	}
 	toSerialize["prebuildId"] = o.PrebuildId
+	toSerialize["priority"] = o.Priority
 	toSerialize["repository"] = o.Repository
 	toSerialize["state"] = o.State
 	toSerialize["updatedAt"] = o.UpdatedAt
@@ -389,6 +600,7 @@ func (o *Build) UnmarshalJSON(data []byte) (err error) {
 		"envVars",
 		"id",
 		"prebuildId",
+		"priority",
 		"repository",
 		"state",
 		"updatedAt",
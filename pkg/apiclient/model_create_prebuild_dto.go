@@ -21,11 +21,13 @@ var _ MappedNullable = &CreatePrebuildDTO{}
 
 // CreatePrebuildDTO struct for CreatePrebuildDTO
 type CreatePrebuildDTO struct {
-	Branch         *string  `json:"branch,omitempty"`
-	CommitInterval *int32   `json:"commitInterval,omitempty"`
-	Id             *string  `json:"id,omitempty"`
-	Retention      int32    `json:"retention"`
-	TriggerFiles   []string `json:"triggerFiles,omitempty"`
+	Branch         *string         `json:"branch,omitempty"`
+	BuildSecrets   []string        `json:"buildSecrets,omitempty"`
+	CommitInterval *int32          `json:"commitInterval,omitempty"`
+	Id             *string         `json:"id,omitempty"`
+	Matrix         *PrebuildMatrix `json:"matrix,omitempty"`
+	Retention      int32           `json:"retention"`
+	TriggerFiles   []string        `json:"triggerFiles,omitempty"`
 }
 
 type _CreatePrebuildDTO CreatePrebuildDTO
@@ -80,6 +82,38 @@ func (o *CreatePrebuildDTO) SetBranch(v string) {
 	o.Branch = &v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *CreatePrebuildDTO) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
 // GetCommitInterval returns the CommitInterval field value if set, zero value otherwise.
 func (o *CreatePrebuildDTO) GetCommitInterval() int32 {
 	if o == nil || IsNil(o.CommitInterval) {
@@ -144,6 +178,38 @@ func (o *CreatePrebuildDTO) SetId(v string) {
 	o.Id = &v
 }
 
+// GetMatrix returns the Matrix field value if set, zero value otherwise.
+func (o *CreatePrebuildDTO) GetMatrix() PrebuildMatrix {
+	if o == nil || IsNil(o.Matrix) {
+		var ret PrebuildMatrix
+		return ret
+	}
+	return *o.Matrix
+}
+
+// GetMatrixOk returns a tuple with the Matrix field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePrebuildDTO) GetMatrixOk() (*PrebuildMatrix, bool) {
+	if o == nil || IsNil(o.Matrix) {
+		return nil, false
+	}
+	return o.Matrix, true
+}
+
+// HasMatrix returns a boolean if a field has been set.
+func (o *CreatePrebuildDTO) HasMatrix() bool {
+	if o != nil && !IsNil(o.Matrix) {
+		return true
+	}
+
+	return false
+}
+
+// SetMatrix gets a reference to the given PrebuildMatrix and assigns it to the Matrix field.
+func (o *CreatePrebuildDTO) SetMatrix(v PrebuildMatrix) {
+	o.Matrix = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *CreatePrebuildDTO) GetRetention() int32 {
 	if o == nil {
@@ -213,12 +279,18 @@ func (o CreatePrebuildDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Branch) {
 		toSerialize["branch"] = o.Branch
 	}
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
 	if !IsNil(o.CommitInterval) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.Matrix) {
+		toSerialize["matrix"] = o.Matrix
+	}
 	toSerialize["retention"] = o.Retention
 	if !IsNil(o.TriggerFiles) {
 		toSerialize["triggerFiles"] = o.TriggerFiles
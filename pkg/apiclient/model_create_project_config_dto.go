@@ -22,10 +22,14 @@ var _ MappedNullable = &CreateProjectConfigDTO{}
 // CreateProjectConfigDTO struct for CreateProjectConfigDTO
 type CreateProjectConfigDTO struct {
 	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
+	BuildSecrets        []string          `json:"buildSecrets,omitempty"`
+	Commands            []ProjectCommand  `json:"commands,omitempty"`
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               *string           `json:"image,omitempty"`
+	Mounts              []Mount           `json:"mounts,omitempty"`
 	Name                string            `json:"name"`
+	Ports               []ProjectPort     `json:"ports,omitempty"`
 	RepositoryUrl       string            `json:"repositoryUrl"`
 	User                *string           `json:"user,omitempty"`
 }
@@ -84,6 +88,70 @@ func (o *CreateProjectConfigDTO) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *CreateProjectConfigDTO) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
+// GetCommands returns the Commands field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetCommands() []ProjectCommand {
+	if o == nil || IsNil(o.Commands) {
+		var ret []ProjectCommand
+		return ret
+	}
+	return o.Commands
+}
+
+// GetCommandsOk returns a tuple with the Commands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetCommandsOk() ([]ProjectCommand, bool) {
+	if o == nil || IsNil(o.Commands) {
+		return nil, false
+	}
+	return o.Commands, true
+}
+
+// HasCommands returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasCommands() bool {
+	if o != nil && !IsNil(o.Commands) {
+		return true
+	}
+
+	return false
+}
+
+// SetCommands gets a reference to the given []ProjectCommand and assigns it to the Commands field.
+func (o *CreateProjectConfigDTO) SetCommands(v []ProjectCommand) {
+	o.Commands = v
+}
+
 // GetEnvVars returns the EnvVars field value
 func (o *CreateProjectConfigDTO) GetEnvVars() map[string]string {
 	if o == nil {
@@ -172,6 +240,38 @@ func (o *CreateProjectConfigDTO) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetMounts returns the Mounts field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetMounts() []Mount {
+	if o == nil || IsNil(o.Mounts) {
+		var ret []Mount
+		return ret
+	}
+	return o.Mounts
+}
+
+// GetMountsOk returns a tuple with the Mounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetMountsOk() ([]Mount, bool) {
+	if o == nil || IsNil(o.Mounts) {
+		return nil, false
+	}
+	return o.Mounts, true
+}
+
+// HasMounts returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasMounts() bool {
+	if o != nil && !IsNil(o.Mounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetMounts gets a reference to the given []Mount and assigns it to the Mounts field.
+func (o *CreateProjectConfigDTO) SetMounts(v []Mount) {
+	o.Mounts = v
+}
+
 // GetName returns the Name field value
 func (o *CreateProjectConfigDTO) GetName() string {
 	if o == nil {
@@ -196,6 +296,38 @@ func (o *CreateProjectConfigDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetPorts returns the Ports field value if set, zero value otherwise.
+func (o *CreateProjectConfigDTO) GetPorts() []ProjectPort {
+	if o == nil || IsNil(o.Ports) {
+		var ret []ProjectPort
+		return ret
+	}
+	return o.Ports
+}
+
+// GetPortsOk returns a tuple with the Ports field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectConfigDTO) GetPortsOk() ([]ProjectPort, bool) {
+	if o == nil || IsNil(o.Ports) {
+		return nil, false
+	}
+	return o.Ports, true
+}
+
+// HasPorts returns a boolean if a field has been set.
+func (o *CreateProjectConfigDTO) HasPorts() bool {
+	if o != nil && !IsNil(o.Ports) {
+		return true
+	}
+
+	return false
+}
+
+// SetPorts gets a reference to the given []ProjectPort and assigns it to the Ports field.
+func (o *CreateProjectConfigDTO) SetPorts(v []ProjectPort) {
+	o.Ports = v
+}
+
 // GetRepositoryUrl returns the RepositoryUrl field value
 func (o *CreateProjectConfigDTO) GetRepositoryUrl() string {
 	if o == nil {
@@ -265,6 +397,12 @@ func (o CreateProjectConfigDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
+	if !IsNil(o.Commands) {
+		toSerialize["commands"] = o.Commands
+	}
 	toSerialize["envVars"] = o.EnvVars
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
@@ -272,7 +410,13 @@ func (o CreateProjectConfigDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.Mounts) {
+		toSerialize["mounts"] = o.Mounts
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Ports) {
+		toSerialize["ports"] = o.Ports
+	}
 	toSerialize["repositoryUrl"] = o.RepositoryUrl
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
@@ -21,13 +21,20 @@ var _ MappedNullable = &CreateProjectDTO{}
 
 // CreateProjectDTO struct for CreateProjectDTO
 type CreateProjectDTO struct {
-	BuildConfig         *BuildConfig           `json:"buildConfig,omitempty"`
-	EnvVars             map[string]string      `json:"envVars"`
-	GitProviderConfigId *string                `json:"gitProviderConfigId,omitempty"`
-	Image               *string                `json:"image,omitempty"`
-	Name                string                 `json:"name"`
-	Source              CreateProjectSourceDTO `json:"source"`
-	User                *string                `json:"user,omitempty"`
+	// Architecture of the target host, e.g. arm64. Selects the matching variant of prebuild matrices
+	Architecture        *string           `json:"architecture,omitempty"`
+	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
+	Commands            []ProjectCommand  `json:"commands,omitempty"`
+	EnvVars             map[string]string `json:"envVars"`
+	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
+	Image               *string           `json:"image,omitempty"`
+	Mounts              []Mount           `json:"mounts,omitempty"`
+	Name                string            `json:"name"`
+	Ports               []ProjectPort     `json:"ports,omitempty"`
+	// CPU and memory reserved for the project on the target host
+	Resources *Resources             `json:"resources,omitempty"`
+	Source    CreateProjectSourceDTO `json:"source"`
+	User      *string                `json:"user,omitempty"`
 }
 
 type _CreateProjectDTO CreateProjectDTO
@@ -52,6 +59,38 @@ func NewCreateProjectDTOWithDefaults() *CreateProjectDTO {
 	return &this
 }
 
+// GetArchitecture returns the Architecture field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetArchitecture() string {
+	if o == nil || IsNil(o.Architecture) {
+		var ret string
+		return ret
+	}
+	return *o.Architecture
+}
+
+// GetArchitectureOk returns a tuple with the Architecture field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetArchitectureOk() (*string, bool) {
+	if o == nil || IsNil(o.Architecture) {
+		return nil, false
+	}
+	return o.Architecture, true
+}
+
+// HasArchitecture returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasArchitecture() bool {
+	if o != nil && !IsNil(o.Architecture) {
+		return true
+	}
+
+	return false
+}
+
+// SetArchitecture gets a reference to the given string and assigns it to the Architecture field.
+func (o *CreateProjectDTO) SetArchitecture(v string) {
+	o.Architecture = &v
+}
+
 // GetBuildConfig returns the BuildConfig field value if set, zero value otherwise.
 func (o *CreateProjectDTO) GetBuildConfig() BuildConfig {
 	if o == nil || IsNil(o.BuildConfig) {
@@ -84,6 +123,38 @@ func (o *CreateProjectDTO) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetCommands returns the Commands field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetCommands() []ProjectCommand {
+	if o == nil || IsNil(o.Commands) {
+		var ret []ProjectCommand
+		return ret
+	}
+	return o.Commands
+}
+
+// GetCommandsOk returns a tuple with the Commands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetCommandsOk() ([]ProjectCommand, bool) {
+	if o == nil || IsNil(o.Commands) {
+		return nil, false
+	}
+	return o.Commands, true
+}
+
+// HasCommands returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasCommands() bool {
+	if o != nil && !IsNil(o.Commands) {
+		return true
+	}
+
+	return false
+}
+
+// SetCommands gets a reference to the given []ProjectCommand and assigns it to the Commands field.
+func (o *CreateProjectDTO) SetCommands(v []ProjectCommand) {
+	o.Commands = v
+}
+
 // GetEnvVars returns the EnvVars field value
 func (o *CreateProjectDTO) GetEnvVars() map[string]string {
 	if o == nil {
@@ -172,6 +243,38 @@ func (o *CreateProjectDTO) SetImage(v string) {
 	o.Image = &v
 }
 
+// GetMounts returns the Mounts field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetMounts() []Mount {
+	if o == nil || IsNil(o.Mounts) {
+		var ret []Mount
+		return ret
+	}
+	return o.Mounts
+}
+
+// GetMountsOk returns a tuple with the Mounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetMountsOk() ([]Mount, bool) {
+	if o == nil || IsNil(o.Mounts) {
+		return nil, false
+	}
+	return o.Mounts, true
+}
+
+// HasMounts returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasMounts() bool {
+	if o != nil && !IsNil(o.Mounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetMounts gets a reference to the given []Mount and assigns it to the Mounts field.
+func (o *CreateProjectDTO) SetMounts(v []Mount) {
+	o.Mounts = v
+}
+
 // GetName returns the Name field value
 func (o *CreateProjectDTO) GetName() string {
 	if o == nil {
@@ -196,6 +299,70 @@ func (o *CreateProjectDTO) SetName(v string) {
 	o.Name = v
 }
 
+// GetPorts returns the Ports field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetPorts() []ProjectPort {
+	if o == nil || IsNil(o.Ports) {
+		var ret []ProjectPort
+		return ret
+	}
+	return o.Ports
+}
+
+// GetPortsOk returns a tuple with the Ports field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetPortsOk() ([]ProjectPort, bool) {
+	if o == nil || IsNil(o.Ports) {
+		return nil, false
+	}
+	return o.Ports, true
+}
+
+// HasPorts returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasPorts() bool {
+	if o != nil && !IsNil(o.Ports) {
+		return true
+	}
+
+	return false
+}
+
+// SetPorts gets a reference to the given []ProjectPort and assigns it to the Ports field.
+func (o *CreateProjectDTO) SetPorts(v []ProjectPort) {
+	o.Ports = v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *CreateProjectDTO) GetResources() Resources {
+	if o == nil || IsNil(o.Resources) {
+		var ret Resources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateProjectDTO) GetResourcesOk() (*Resources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *CreateProjectDTO) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given Resources and assigns it to the Resources field.
+func (o *CreateProjectDTO) SetResources(v Resources) {
+	o.Resources = &v
+}
+
 // GetSource returns the Source field value
 func (o *CreateProjectDTO) GetSource() CreateProjectSourceDTO {
 	if o == nil {
@@ -262,9 +429,15 @@ func (o CreateProjectDTO) MarshalJSON() ([]byte, error) {
 
 func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Architecture) {
+		toSerialize["architecture"] = o.Architecture
+	}
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.Commands) {
+		toSerialize["commands"] = o.Commands
+	}
 	toSerialize["envVars"] = o.EnvVars
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
@@ -272,7 +445,16 @@ func (o CreateProjectDTO) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
+	if !IsNil(o.Mounts) {
+		toSerialize["mounts"] = o.Mounts
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Ports) {
+		toSerialize["ports"] = o.Ports
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	toSerialize["source"] = o.Source
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
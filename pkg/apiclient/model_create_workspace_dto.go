@@ -24,7 +24,15 @@ type CreateWorkspaceDTO struct {
 	Id       string             `json:"id"`
 	Name     string             `json:"name"`
 	Projects []CreateProjectDTO `json:"projects"`
-	Target   string             `json:"target"`
+	// Federated region to create the workspace in. Defaults to the region of the server
+	Region *string `json:"region,omitempty"`
+	// Route the ports of all projects through the tailnet node of the first project instead of connecting each project to the tailnet
+	SharedNode *bool `json:"sharedNode,omitempty"`
+	// Names of shared services of the target to attach the workspace to
+	SharedServices []string `json:"sharedServices,omitempty"`
+	Target         string   `json:"target"`
+	// Duration after which the workspace is removed, e.g. 48h
+	Ttl *string `json:"ttl,omitempty"`
 }
 
 type _CreateWorkspaceDTO CreateWorkspaceDTO
@@ -122,6 +130,102 @@ func (o *CreateWorkspaceDTO) SetProjects(v []CreateProjectDTO) {
 	o.Projects = v
 }
 
+// GetRegion returns the Region field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetRegion() string {
+	if o == nil || IsNil(o.Region) {
+		var ret string
+		return ret
+	}
+	return *o.Region
+}
+
+// GetRegionOk returns a tuple with the Region field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetRegionOk() (*string, bool) {
+	if o == nil || IsNil(o.Region) {
+		return nil, false
+	}
+	return o.Region, true
+}
+
+// HasRegion returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasRegion() bool {
+	if o != nil && !IsNil(o.Region) {
+		return true
+	}
+
+	return false
+}
+
+// SetRegion gets a reference to the given string and assigns it to the Region field.
+func (o *CreateWorkspaceDTO) SetRegion(v string) {
+	o.Region = &v
+}
+
+// GetSharedNode returns the SharedNode field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetSharedNode() bool {
+	if o == nil || IsNil(o.SharedNode) {
+		var ret bool
+		return ret
+	}
+	return *o.SharedNode
+}
+
+// GetSharedNodeOk returns a tuple with the SharedNode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetSharedNodeOk() (*bool, bool) {
+	if o == nil || IsNil(o.SharedNode) {
+		return nil, false
+	}
+	return o.SharedNode, true
+}
+
+// HasSharedNode returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasSharedNode() bool {
+	if o != nil && !IsNil(o.SharedNode) {
+		return true
+	}
+
+	return false
+}
+
+// SetSharedNode gets a reference to the given bool and assigns it to the SharedNode field.
+func (o *CreateWorkspaceDTO) SetSharedNode(v bool) {
+	o.SharedNode = &v
+}
+
+// GetSharedServices returns the SharedServices field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetSharedServices() []string {
+	if o == nil || IsNil(o.SharedServices) {
+		var ret []string
+		return ret
+	}
+	return o.SharedServices
+}
+
+// GetSharedServicesOk returns a tuple with the SharedServices field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetSharedServicesOk() ([]string, bool) {
+	if o == nil || IsNil(o.SharedServices) {
+		return nil, false
+	}
+	return o.SharedServices, true
+}
+
+// HasSharedServices returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasSharedServices() bool {
+	if o != nil && !IsNil(o.SharedServices) {
+		return true
+	}
+
+	return false
+}
+
+// SetSharedServices gets a reference to the given []string and assigns it to the SharedServices field.
+func (o *CreateWorkspaceDTO) SetSharedServices(v []string) {
+	o.SharedServices = v
+}
+
 // GetTarget returns the Target field value
 func (o *CreateWorkspaceDTO) GetTarget() string {
 	if o == nil {
@@ -146,6 +250,38 @@ func (o *CreateWorkspaceDTO) SetTarget(v string) {
 	o.Target = v
 }
 
+// GetTtl returns the Ttl field value if set, zero value otherwise.
+func (o *CreateWorkspaceDTO) GetTtl() string {
+	if o == nil || IsNil(o.Ttl) {
+		var ret string
+		return ret
+	}
+	return *o.Ttl
+}
+
+// GetTtlOk returns a tuple with the Ttl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceDTO) GetTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.Ttl) {
+		return nil, false
+	}
+	return o.Ttl, true
+}
+
+// HasTtl returns a boolean if a field has been set.
+func (o *CreateWorkspaceDTO) HasTtl() bool {
+	if o != nil && !IsNil(o.Ttl) {
+		return true
+	}
+
+	return false
+}
+
+// SetTtl gets a reference to the given string and assigns it to the Ttl field.
+func (o *CreateWorkspaceDTO) SetTtl(v string) {
+	o.Ttl = &v
+}
+
 func (o CreateWorkspaceDTO) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -159,7 +295,19 @@ func (o CreateWorkspaceDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize["id"] = o.Id
 	toSerialize["name"] = o.Name
 	toSerialize["projects"] = o.Projects
+	if !IsNil(o.Region) {
+		toSerialize["region"] = o.Region
+	}
+	if !IsNil(o.SharedNode) {
+		toSerialize["sharedNode"] = o.SharedNode
+	}
+	if !IsNil(o.SharedServices) {
+		toSerialize["sharedServices"] = o.SharedServices
+	}
 	toSerialize["target"] = o.Target
+	if !IsNil(o.Ttl) {
+		toSerialize["ttl"] = o.Ttl
+	}
 	return toSerialize, nil
 }
 
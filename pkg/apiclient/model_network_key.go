@@ -21,7 +21,9 @@ var _ MappedNullable = &NetworkKey{}
 
 // NetworkKey struct for NetworkKey
 type NetworkKey struct {
-	Key string `json:"key"`
+	// Time after which the key can no longer be used to join the network, so that clients can renew cached keys in time
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	Key       string  `json:"key"`
 }
 
 type _NetworkKey NetworkKey
@@ -44,6 +46,38 @@ func NewNetworkKeyWithDefaults() *NetworkKey {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *NetworkKey) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NetworkKey) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *NetworkKey) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *NetworkKey) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetKey returns the Key field value
 func (o *NetworkKey) GetKey() string {
 	if o == nil {
@@ -78,6 +112,9 @@ func (o NetworkKey) MarshalJSON() ([]byte, error) {
 
 func (o NetworkKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["key"] = o.Key
 	return toSerialize, nil
 }
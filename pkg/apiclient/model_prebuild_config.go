@@ -21,11 +21,14 @@ var _ MappedNullable = &PrebuildConfig{}
 
 // PrebuildConfig struct for PrebuildConfig
 type PrebuildConfig struct {
-	Branch         string   `json:"branch"`
-	CommitInterval int32    `json:"commitInterval"`
-	Id             string   `json:"id"`
-	Retention      int32    `json:"retention"`
-	TriggerFiles   []string `json:"triggerFiles"`
+	Branch string `json:"branch"`
+	// Names of the build secrets that builds of the prebuild can read in addition to those of the project config
+	BuildSecrets   []string        `json:"buildSecrets,omitempty"`
+	CommitInterval int32           `json:"commitInterval"`
+	Id             string          `json:"id"`
+	Matrix         *PrebuildMatrix `json:"matrix,omitempty"`
+	Retention      int32           `json:"retention"`
+	TriggerFiles   []string        `json:"triggerFiles"`
 }
 
 type _PrebuildConfig PrebuildConfig
@@ -76,6 +79,38 @@ func (o *PrebuildConfig) SetBranch(v string) {
 	o.Branch = v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *PrebuildConfig) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
 // GetCommitInterval returns the CommitInterval field value
 func (o *PrebuildConfig) GetCommitInterval() int32 {
 	if o == nil {
@@ -124,6 +159,38 @@ func (o *PrebuildConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetMatrix returns the Matrix field value if set, zero value otherwise.
+func (o *PrebuildConfig) GetMatrix() PrebuildMatrix {
+	if o == nil || IsNil(o.Matrix) {
+		var ret PrebuildMatrix
+		return ret
+	}
+	return *o.Matrix
+}
+
+// GetMatrixOk returns a tuple with the Matrix field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildConfig) GetMatrixOk() (*PrebuildMatrix, bool) {
+	if o == nil || IsNil(o.Matrix) {
+		return nil, false
+	}
+	return o.Matrix, true
+}
+
+// HasMatrix returns a boolean if a field has been set.
+func (o *PrebuildConfig) HasMatrix() bool {
+	if o != nil && !IsNil(o.Matrix) {
+		return true
+	}
+
+	return false
+}
+
+// SetMatrix gets a reference to the given PrebuildMatrix and assigns it to the Matrix field.
+func (o *PrebuildConfig) SetMatrix(v PrebuildMatrix) {
+	o.Matrix = &v
+}
+
 // GetRetention returns the Retention field value
 func (o *PrebuildConfig) GetRetention() int32 {
 	if o == nil {
@@ -183,8 +250,14 @@ func (o PrebuildConfig) MarshalJSON() ([]byte, error) {
 func (o PrebuildConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["branch"] = o.Branch
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
 	toSerialize["commitInterval"] = o.CommitInterval
 	toSerialize["id"] = o.Id
+	if !IsNil(o.Matrix) {
+		toSerialize["matrix"] = o.Matrix
+	}
 	toSerialize["retention"] = o.Retention
 	toSerialize["triggerFiles"] = o.TriggerFiles
 	return toSerialize, nil
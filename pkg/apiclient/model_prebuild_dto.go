@@ -21,12 +21,14 @@ var _ MappedNullable = &PrebuildDTO{}
 
 // PrebuildDTO struct for PrebuildDTO
 type PrebuildDTO struct {
-	Branch            string   `json:"branch"`
-	CommitInterval    *int32   `json:"commitInterval,omitempty"`
-	Id                string   `json:"id"`
-	ProjectConfigName string   `json:"projectConfigName"`
-	Retention         int32    `json:"retention"`
-	TriggerFiles      []string `json:"triggerFiles,omitempty"`
+	Branch            string          `json:"branch"`
+	BuildSecrets      []string        `json:"buildSecrets,omitempty"`
+	CommitInterval    *int32          `json:"commitInterval,omitempty"`
+	Id                string          `json:"id"`
+	Matrix            *PrebuildMatrix `json:"matrix,omitempty"`
+	ProjectConfigName string          `json:"projectConfigName"`
+	Retention         int32           `json:"retention"`
+	TriggerFiles      []string        `json:"triggerFiles,omitempty"`
 }
 
 type _PrebuildDTO PrebuildDTO
@@ -76,6 +78,38 @@ func (o *PrebuildDTO) SetBranch(v string) {
 	o.Branch = v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *PrebuildDTO) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
 // GetCommitInterval returns the CommitInterval field value if set, zero value otherwise.
 func (o *PrebuildDTO) GetCommitInterval() int32 {
 	if o == nil || IsNil(o.CommitInterval) {
@@ -132,6 +166,38 @@ func (o *PrebuildDTO) SetId(v string) {
 	o.Id = v
 }
 
+// GetMatrix returns the Matrix field value if set, zero value otherwise.
+func (o *PrebuildDTO) GetMatrix() PrebuildMatrix {
+	if o == nil || IsNil(o.Matrix) {
+		var ret PrebuildMatrix
+		return ret
+	}
+	return *o.Matrix
+}
+
+// GetMatrixOk returns a tuple with the Matrix field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PrebuildDTO) GetMatrixOk() (*PrebuildMatrix, bool) {
+	if o == nil || IsNil(o.Matrix) {
+		return nil, false
+	}
+	return o.Matrix, true
+}
+
+// HasMatrix returns a boolean if a field has been set.
+func (o *PrebuildDTO) HasMatrix() bool {
+	if o != nil && !IsNil(o.Matrix) {
+		return true
+	}
+
+	return false
+}
+
+// SetMatrix gets a reference to the given PrebuildMatrix and assigns it to the Matrix field.
+func (o *PrebuildDTO) SetMatrix(v PrebuildMatrix) {
+	o.Matrix = &v
+}
+
 // GetProjectConfigName returns the ProjectConfigName field value
 func (o *PrebuildDTO) GetProjectConfigName() string {
 	if o == nil {
@@ -223,10 +289,16 @@ func (o PrebuildDTO) MarshalJSON() ([]byte, error) {
 func (o PrebuildDTO) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["branch"] = o.Branch
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
 	if !IsNil(o.CommitInterval) {
 		toSerialize["commitInterval"] = o.CommitInterval
 	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.Matrix) {
+		toSerialize["matrix"] = o.Matrix
+	}
 	toSerialize["projectConfigName"] = o.ProjectConfigName
 	toSerialize["retention"] = o.Retention
 	if !IsNil(o.TriggerFiles) {
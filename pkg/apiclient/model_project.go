@@ -21,16 +21,37 @@ var _ MappedNullable = &Project{}
 
 // Project struct for Project
 type Project struct {
+	// AccessPolicy restricts the tailnet peers that can connect to ports of the project. Every peer can connect if not set
+	AccessPolicy *PortAccessPolicy  `json:"accessPolicy,omitempty"`
+	Annotations  *map[string]string `json:"annotations,omitempty"`
+	// Architecture of the target host, e.g. arm64. Only builds of the prebuild matrix variant of the architecture are used for the project
+	Architecture        *string           `json:"architecture,omitempty"`
 	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
+	Commands            []ProjectCommand  `json:"commands,omitempty"`
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
-	Image               string            `json:"image"`
-	Name                string            `json:"name"`
-	Repository          GitRepository     `json:"repository"`
-	State               *ProjectState     `json:"state,omitempty"`
-	Target              string            `json:"target"`
-	User                string            `json:"user"`
-	WorkspaceId         string            `json:"workspaceId"`
+	// Hostname of the project agent on the tailnet. Derived from the workspace ID and project name if empty
+	Hostname   *string            `json:"hostname,omitempty"`
+	Image      string             `json:"image"`
+	Mounts     []Mount            `json:"mounts,omitempty"`
+	Name       string             `json:"name"`
+	Networking *ProjectNetworking `json:"networking,omitempty"`
+	// Paused is set if the project was stopped with a checkpoint of its processes that it is resumed from on start
+	Paused *bool         `json:"paused,omitempty"`
+	Ports  []ProjectPort `json:"ports,omitempty"`
+	// Ports other than the declared public ports that were forwarded to their public URLs. Their preview DNS records are deleted with the workspace
+	PublicPorts []int32       `json:"publicPorts,omitempty"`
+	Repository  GitRepository `json:"repository"`
+	// Resources reserved for the project on the target host. The provider limits the project container to them
+	Resources *Resources `json:"resources,omitempty"`
+	// Route is set if the project shares the tailnet node of another project of the workspace
+	Route *ProjectRoute `json:"route,omitempty"`
+	// Public SSH host key of the project agent in the authorized_keys format
+	SshHostPublicKey *string       `json:"sshHostPublicKey,omitempty"`
+	State            *ProjectState `json:"state,omitempty"`
+	Target           string        `json:"target"`
+	User             string        `json:"user"`
+	WorkspaceId      string        `json:"workspaceId"`
 }
 
 type _Project Project
@@ -59,6 +80,102 @@ func NewProjectWithDefaults() *Project {
 	return &this
 }
 
+// GetAccessPolicy returns the AccessPolicy field value if set, zero value otherwise.
+func (o *Project) GetAccessPolicy() PortAccessPolicy {
+	if o == nil || IsNil(o.AccessPolicy) {
+		var ret PortAccessPolicy
+		return ret
+	}
+	return *o.AccessPolicy
+}
+
+// GetAccessPolicyOk returns a tuple with the AccessPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetAccessPolicyOk() (*PortAccessPolicy, bool) {
+	if o == nil || IsNil(o.AccessPolicy) {
+		return nil, false
+	}
+	return o.AccessPolicy, true
+}
+
+// HasAccessPolicy returns a boolean if a field has been set.
+func (o *Project) HasAccessPolicy() bool {
+	if o != nil && !IsNil(o.AccessPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetAccessPolicy gets a reference to the given PortAccessPolicy and assigns it to the AccessPolicy field.
+func (o *Project) SetAccessPolicy(v PortAccessPolicy) {
+	o.AccessPolicy = &v
+}
+
+// GetAnnotations returns the Annotations field value if set, zero value otherwise.
+func (o *Project) GetAnnotations() map[string]string {
+	if o == nil || IsNil(o.Annotations) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.Annotations
+}
+
+// GetAnnotationsOk returns a tuple with the Annotations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetAnnotationsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.Annotations) {
+		return nil, false
+	}
+	return o.Annotations, true
+}
+
+// HasAnnotations returns a boolean if a field has been set.
+func (o *Project) HasAnnotations() bool {
+	if o != nil && !IsNil(o.Annotations) {
+		return true
+	}
+
+	return false
+}
+
+// SetAnnotations gets a reference to the given map[string]string and assigns it to the Annotations field.
+func (o *Project) SetAnnotations(v map[string]string) {
+	o.Annotations = &v
+}
+
+// GetArchitecture returns the Architecture field value if set, zero value otherwise.
+func (o *Project) GetArchitecture() string {
+	if o == nil || IsNil(o.Architecture) {
+		var ret string
+		return ret
+	}
+	return *o.Architecture
+}
+
+// GetArchitectureOk returns a tuple with the Architecture field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetArchitectureOk() (*string, bool) {
+	if o == nil || IsNil(o.Architecture) {
+		return nil, false
+	}
+	return o.Architecture, true
+}
+
+// HasArchitecture returns a boolean if a field has been set.
+func (o *Project) HasArchitecture() bool {
+	if o != nil && !IsNil(o.Architecture) {
+		return true
+	}
+
+	return false
+}
+
+// SetArchitecture gets a reference to the given string and assigns it to the Architecture field.
+func (o *Project) SetArchitecture(v string) {
+	o.Architecture = &v
+}
+
 // GetBuildConfig returns the BuildConfig field value if set, zero value otherwise.
 func (o *Project) GetBuildConfig() BuildConfig {
 	if o == nil || IsNil(o.BuildConfig) {
@@ -91,6 +208,38 @@ func (o *Project) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetCommands returns the Commands field value if set, zero value otherwise.
+func (o *Project) GetCommands() []ProjectCommand {
+	if o == nil || IsNil(o.Commands) {
+		var ret []ProjectCommand
+		return ret
+	}
+	return o.Commands
+}
+
+// GetCommandsOk returns a tuple with the Commands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetCommandsOk() ([]ProjectCommand, bool) {
+	if o == nil || IsNil(o.Commands) {
+		return nil, false
+	}
+	return o.Commands, true
+}
+
+// HasCommands returns a boolean if a field has been set.
+func (o *Project) HasCommands() bool {
+	if o != nil && !IsNil(o.Commands) {
+		return true
+	}
+
+	return false
+}
+
+// SetCommands gets a reference to the given []ProjectCommand and assigns it to the Commands field.
+func (o *Project) SetCommands(v []ProjectCommand) {
+	o.Commands = v
+}
+
 // GetEnvVars returns the EnvVars field value
 func (o *Project) GetEnvVars() map[string]string {
 	if o == nil {
@@ -147,6 +296,38 @@ func (o *Project) SetGitProviderConfigId(v string) {
 	o.GitProviderConfigId = &v
 }
 
+// GetHostname returns the Hostname field value if set, zero value otherwise.
+func (o *Project) GetHostname() string {
+	if o == nil || IsNil(o.Hostname) {
+		var ret string
+		return ret
+	}
+	return *o.Hostname
+}
+
+// GetHostnameOk returns a tuple with the Hostname field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetHostnameOk() (*string, bool) {
+	if o == nil || IsNil(o.Hostname) {
+		return nil, false
+	}
+	return o.Hostname, true
+}
+
+// HasHostname returns a boolean if a field has been set.
+func (o *Project) HasHostname() bool {
+	if o != nil && !IsNil(o.Hostname) {
+		return true
+	}
+
+	return false
+}
+
+// SetHostname gets a reference to the given string and assigns it to the Hostname field.
+func (o *Project) SetHostname(v string) {
+	o.Hostname = &v
+}
+
 // GetImage returns the Image field value
 func (o *Project) GetImage() string {
 	if o == nil {
@@ -171,6 +352,38 @@ func (o *Project) SetImage(v string) {
 	o.Image = v
 }
 
+// GetMounts returns the Mounts field value if set, zero value otherwise.
+func (o *Project) GetMounts() []Mount {
+	if o == nil || IsNil(o.Mounts) {
+		var ret []Mount
+		return ret
+	}
+	return o.Mounts
+}
+
+// GetMountsOk returns a tuple with the Mounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetMountsOk() ([]Mount, bool) {
+	if o == nil || IsNil(o.Mounts) {
+		return nil, false
+	}
+	return o.Mounts, true
+}
+
+// HasMounts returns a boolean if a field has been set.
+func (o *Project) HasMounts() bool {
+	if o != nil && !IsNil(o.Mounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetMounts gets a reference to the given []Mount and assigns it to the Mounts field.
+func (o *Project) SetMounts(v []Mount) {
+	o.Mounts = v
+}
+
 // GetName returns the Name field value
 func (o *Project) GetName() string {
 	if o == nil {
@@ -195,6 +408,134 @@ func (o *Project) SetName(v string) {
 	o.Name = v
 }
 
+// GetNetworking returns the Networking field value if set, zero value otherwise.
+func (o *Project) GetNetworking() ProjectNetworking {
+	if o == nil || IsNil(o.Networking) {
+		var ret ProjectNetworking
+		return ret
+	}
+	return *o.Networking
+}
+
+// GetNetworkingOk returns a tuple with the Networking field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetNetworkingOk() (*ProjectNetworking, bool) {
+	if o == nil || IsNil(o.Networking) {
+		return nil, false
+	}
+	return o.Networking, true
+}
+
+// HasNetworking returns a boolean if a field has been set.
+func (o *Project) HasNetworking() bool {
+	if o != nil && !IsNil(o.Networking) {
+		return true
+	}
+
+	return false
+}
+
+// SetNetworking gets a reference to the given ProjectNetworking and assigns it to the Networking field.
+func (o *Project) SetNetworking(v ProjectNetworking) {
+	o.Networking = &v
+}
+
+// GetPaused returns the Paused field value if set, zero value otherwise.
+func (o *Project) GetPaused() bool {
+	if o == nil || IsNil(o.Paused) {
+		var ret bool
+		return ret
+	}
+	return *o.Paused
+}
+
+// GetPausedOk returns a tuple with the Paused field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPausedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Paused) {
+		return nil, false
+	}
+	return o.Paused, true
+}
+
+// HasPaused returns a boolean if a field has been set.
+func (o *Project) HasPaused() bool {
+	if o != nil && !IsNil(o.Paused) {
+		return true
+	}
+
+	return false
+}
+
+// SetPaused gets a reference to the given bool and assigns it to the Paused field.
+func (o *Project) SetPaused(v bool) {
+	o.Paused = &v
+}
+
+// GetPorts returns the Ports field value if set, zero value otherwise.
+func (o *Project) GetPorts() []ProjectPort {
+	if o == nil || IsNil(o.Ports) {
+		var ret []ProjectPort
+		return ret
+	}
+	return o.Ports
+}
+
+// GetPortsOk returns a tuple with the Ports field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPortsOk() ([]ProjectPort, bool) {
+	if o == nil || IsNil(o.Ports) {
+		return nil, false
+	}
+	return o.Ports, true
+}
+
+// HasPorts returns a boolean if a field has been set.
+func (o *Project) HasPorts() bool {
+	if o != nil && !IsNil(o.Ports) {
+		return true
+	}
+
+	return false
+}
+
+// SetPorts gets a reference to the given []ProjectPort and assigns it to the Ports field.
+func (o *Project) SetPorts(v []ProjectPort) {
+	o.Ports = v
+}
+
+// GetPublicPorts returns the PublicPorts field value if set, zero value otherwise.
+func (o *Project) GetPublicPorts() []int32 {
+	if o == nil || IsNil(o.PublicPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.PublicPorts
+}
+
+// GetPublicPortsOk returns a tuple with the PublicPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPublicPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.PublicPorts) {
+		return nil, false
+	}
+	return o.PublicPorts, true
+}
+
+// HasPublicPorts returns a boolean if a field has been set.
+func (o *Project) HasPublicPorts() bool {
+	if o != nil && !IsNil(o.PublicPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetPublicPorts gets a reference to the given []int32 and assigns it to the PublicPorts field.
+func (o *Project) SetPublicPorts(v []int32) {
+	o.PublicPorts = v
+}
+
 // GetRepository returns the Repository field value
 func (o *Project) GetRepository() GitRepository {
 	if o == nil {
@@ -219,6 +560,102 @@ func (o *Project) SetRepository(v GitRepository) {
 	o.Repository = v
 }
 
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *Project) GetResources() Resources {
+	if o == nil || IsNil(o.Resources) {
+		var ret Resources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetResourcesOk() (*Resources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *Project) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given Resources and assigns it to the Resources field.
+func (o *Project) SetResources(v Resources) {
+	o.Resources = &v
+}
+
+// GetRoute returns the Route field value if set, zero value otherwise.
+func (o *Project) GetRoute() ProjectRoute {
+	if o == nil || IsNil(o.Route) {
+		var ret ProjectRoute
+		return ret
+	}
+	return *o.Route
+}
+
+// GetRouteOk returns a tuple with the Route field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetRouteOk() (*ProjectRoute, bool) {
+	if o == nil || IsNil(o.Route) {
+		return nil, false
+	}
+	return o.Route, true
+}
+
+// HasRoute returns a boolean if a field has been set.
+func (o *Project) HasRoute() bool {
+	if o != nil && !IsNil(o.Route) {
+		return true
+	}
+
+	return false
+}
+
+// SetRoute gets a reference to the given ProjectRoute and assigns it to the Route field.
+func (o *Project) SetRoute(v ProjectRoute) {
+	o.Route = &v
+}
+
+// GetSshHostPublicKey returns the SshHostPublicKey field value if set, zero value otherwise.
+func (o *Project) GetSshHostPublicKey() string {
+	if o == nil || IsNil(o.SshHostPublicKey) {
+		var ret string
+		return ret
+	}
+	return *o.SshHostPublicKey
+}
+
+// GetSshHostPublicKeyOk returns a tuple with the SshHostPublicKey field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetSshHostPublicKeyOk() (*string, bool) {
+	if o == nil || IsNil(o.SshHostPublicKey) {
+		return nil, false
+	}
+	return o.SshHostPublicKey, true
+}
+
+// HasSshHostPublicKey returns a boolean if a field has been set.
+func (o *Project) HasSshHostPublicKey() bool {
+	if o != nil && !IsNil(o.SshHostPublicKey) {
+		return true
+	}
+
+	return false
+}
+
+// SetSshHostPublicKey gets a reference to the given string and assigns it to the SshHostPublicKey field.
+func (o *Project) SetSshHostPublicKey(v string) {
+	o.SshHostPublicKey = &v
+}
+
 // GetState returns the State field value if set, zero value otherwise.
 func (o *Project) GetState() ProjectState {
 	if o == nil || IsNil(o.State) {
@@ -333,16 +770,55 @@ func (o Project) MarshalJSON() ([]byte, error) {
 
 func (o Project) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AccessPolicy) {
+		toSerialize["accessPolicy"] = o.AccessPolicy
+	}
+	if !IsNil(o.Annotations) {
+		toSerialize["annotations"] = o.Annotations
+	}
+	if !IsNil(o.Architecture) {
+		toSerialize["architecture"] = o.Architecture
+	}
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.Commands) {
+		toSerialize["commands"] = o.Commands
+	}
 	toSerialize["envVars"] = o.EnvVars
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
+	if !IsNil(o.Hostname) {
+		toSerialize["hostname"] = o.Hostname
+	}
 	toSerialize["image"] = o.Image
+	if !IsNil(o.Mounts) {
+		toSerialize["mounts"] = o.Mounts
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.Networking) {
+		toSerialize["networking"] = o.Networking
+	}
+	if !IsNil(o.Paused) {
+		toSerialize["paused"] = o.Paused
+	}
+	if !IsNil(o.Ports) {
+		toSerialize["ports"] = o.Ports
+	}
+	if !IsNil(o.PublicPorts) {
+		toSerialize["publicPorts"] = o.PublicPorts
+	}
 	toSerialize["repository"] = o.Repository
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
+	if !IsNil(o.Route) {
+		toSerialize["route"] = o.Route
+	}
+	if !IsNil(o.SshHostPublicKey) {
+		toSerialize["sshHostPublicKey"] = o.SshHostPublicKey
+	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
@@ -21,12 +21,18 @@ var _ MappedNullable = &ProjectConfig{}
 
 // ProjectConfig struct for ProjectConfig
 type ProjectConfig struct {
-	BuildConfig         *BuildConfig      `json:"buildConfig,omitempty"`
+	BuildConfig *BuildConfig `json:"buildConfig,omitempty"`
+	// Names of the build secrets that builds of the project config can read through secret mounts
+	BuildSecrets        []string          `json:"buildSecrets,omitempty"`
+	Commands            []ProjectCommand  `json:"commands,omitempty"`
 	Default             bool              `json:"default"`
 	EnvVars             map[string]string `json:"envVars"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId,omitempty"`
 	Image               string            `json:"image"`
+	Mounts              []Mount           `json:"mounts,omitempty"`
 	Name                string            `json:"name"`
+	OrganizationId      *string           `json:"organizationId,omitempty"`
+	Ports               []ProjectPort     `json:"ports,omitempty"`
 	Prebuilds           []PrebuildConfig  `json:"prebuilds,omitempty"`
 	RepositoryUrl       string            `json:"repositoryUrl"`
 	User                string            `json:"user"`
@@ -89,6 +95,70 @@ func (o *ProjectConfig) SetBuildConfig(v BuildConfig) {
 	o.BuildConfig = &v
 }
 
+// GetBuildSecrets returns the BuildSecrets field value if set, zero value otherwise.
+func (o *ProjectConfig) GetBuildSecrets() []string {
+	if o == nil || IsNil(o.BuildSecrets) {
+		var ret []string
+		return ret
+	}
+	return o.BuildSecrets
+}
+
+// GetBuildSecretsOk returns a tuple with the BuildSecrets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetBuildSecretsOk() ([]string, bool) {
+	if o == nil || IsNil(o.BuildSecrets) {
+		return nil, false
+	}
+	return o.BuildSecrets, true
+}
+
+// HasBuildSecrets returns a boolean if a field has been set.
+func (o *ProjectConfig) HasBuildSecrets() bool {
+	if o != nil && !IsNil(o.BuildSecrets) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildSecrets gets a reference to the given []string and assigns it to the BuildSecrets field.
+func (o *ProjectConfig) SetBuildSecrets(v []string) {
+	o.BuildSecrets = v
+}
+
+// GetCommands returns the Commands field value if set, zero value otherwise.
+func (o *ProjectConfig) GetCommands() []ProjectCommand {
+	if o == nil || IsNil(o.Commands) {
+		var ret []ProjectCommand
+		return ret
+	}
+	return o.Commands
+}
+
+// GetCommandsOk returns a tuple with the Commands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetCommandsOk() ([]ProjectCommand, bool) {
+	if o == nil || IsNil(o.Commands) {
+		return nil, false
+	}
+	return o.Commands, true
+}
+
+// HasCommands returns a boolean if a field has been set.
+func (o *ProjectConfig) HasCommands() bool {
+	if o != nil && !IsNil(o.Commands) {
+		return true
+	}
+
+	return false
+}
+
+// SetCommands gets a reference to the given []ProjectCommand and assigns it to the Commands field.
+func (o *ProjectConfig) SetCommands(v []ProjectCommand) {
+	o.Commands = v
+}
+
 // GetDefault returns the Default field value
 func (o *ProjectConfig) GetDefault() bool {
 	if o == nil {
@@ -193,6 +263,38 @@ func (o *ProjectConfig) SetImage(v string) {
 	o.Image = v
 }
 
+// GetMounts returns the Mounts field value if set, zero value otherwise.
+func (o *ProjectConfig) GetMounts() []Mount {
+	if o == nil || IsNil(o.Mounts) {
+		var ret []Mount
+		return ret
+	}
+	return o.Mounts
+}
+
+// GetMountsOk returns a tuple with the Mounts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetMountsOk() ([]Mount, bool) {
+	if o == nil || IsNil(o.Mounts) {
+		return nil, false
+	}
+	return o.Mounts, true
+}
+
+// HasMounts returns a boolean if a field has been set.
+func (o *ProjectConfig) HasMounts() bool {
+	if o != nil && !IsNil(o.Mounts) {
+		return true
+	}
+
+	return false
+}
+
+// SetMounts gets a reference to the given []Mount and assigns it to the Mounts field.
+func (o *ProjectConfig) SetMounts(v []Mount) {
+	o.Mounts = v
+}
+
 // GetName returns the Name field value
 func (o *ProjectConfig) GetName() string {
 	if o == nil {
@@ -217,6 +319,70 @@ func (o *ProjectConfig) SetName(v string) {
 	o.Name = v
 }
 
+// GetOrganizationId returns the OrganizationId field value if set, zero value otherwise.
+func (o *ProjectConfig) GetOrganizationId() string {
+	if o == nil || IsNil(o.OrganizationId) {
+		var ret string
+		return ret
+	}
+	return *o.OrganizationId
+}
+
+// GetOrganizationIdOk returns a tuple with the OrganizationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetOrganizationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OrganizationId) {
+		return nil, false
+	}
+	return o.OrganizationId, true
+}
+
+// HasOrganizationId returns a boolean if a field has been set.
+func (o *ProjectConfig) HasOrganizationId() bool {
+	if o != nil && !IsNil(o.OrganizationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrganizationId gets a reference to the given string and assigns it to the OrganizationId field.
+func (o *ProjectConfig) SetOrganizationId(v string) {
+	o.OrganizationId = &v
+}
+
+// GetPorts returns the Ports field value if set, zero value otherwise.
+func (o *ProjectConfig) GetPorts() []ProjectPort {
+	if o == nil || IsNil(o.Ports) {
+		var ret []ProjectPort
+		return ret
+	}
+	return o.Ports
+}
+
+// GetPortsOk returns a tuple with the Ports field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectConfig) GetPortsOk() ([]ProjectPort, bool) {
+	if o == nil || IsNil(o.Ports) {
+		return nil, false
+	}
+	return o.Ports, true
+}
+
+// HasPorts returns a boolean if a field has been set.
+func (o *ProjectConfig) HasPorts() bool {
+	if o != nil && !IsNil(o.Ports) {
+		return true
+	}
+
+	return false
+}
+
+// SetPorts gets a reference to the given []ProjectPort and assigns it to the Ports field.
+func (o *ProjectConfig) SetPorts(v []ProjectPort) {
+	o.Ports = v
+}
+
 // GetPrebuilds returns the Prebuilds field value if set, zero value otherwise.
 func (o *ProjectConfig) GetPrebuilds() []PrebuildConfig {
 	if o == nil || IsNil(o.Prebuilds) {
@@ -310,13 +476,28 @@ func (o ProjectConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.BuildConfig) {
 		toSerialize["buildConfig"] = o.BuildConfig
 	}
+	if !IsNil(o.BuildSecrets) {
+		toSerialize["buildSecrets"] = o.BuildSecrets
+	}
+	if !IsNil(o.Commands) {
+		toSerialize["commands"] = o.Commands
+	}
 	toSerialize["default"] = o.Default
 	toSerialize["envVars"] = o.EnvVars
 	if !IsNil(o.GitProviderConfigId) {
 		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
 	}
 	toSerialize["image"] = o.Image
+	if !IsNil(o.Mounts) {
+		toSerialize["mounts"] = o.Mounts
+	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OrganizationId) {
+		toSerialize["organizationId"] = o.OrganizationId
+	}
+	if !IsNil(o.Ports) {
+		toSerialize["ports"] = o.Ports
+	}
 	if !IsNil(o.Prebuilds) {
 		toSerialize["prebuilds"] = o.Prebuilds
 	}
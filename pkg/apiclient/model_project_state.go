@@ -21,9 +21,30 @@ var _ MappedNullable = &ProjectState{}
 
 // ProjectState struct for ProjectState
 type ProjectState struct {
-	GitStatus GitStatus `json:"gitStatus"`
-	UpdatedAt string    `json:"updatedAt"`
-	Uptime    int32     `json:"uptime"`
+	// Address of the project container. Only reported by agents of routed projects
+	Address *string `json:"address,omitempty"`
+	// AgentVersion is the version of the agent that reported the state
+	AgentVersion *string `json:"agentVersion,omitempty"`
+	// Ports processes of the project listen on, for clients to offer forwarding them. Not reported by older agents
+	DetectedPorts []DetectedPort `json:"detectedPorts,omitempty"`
+	// Files of the environment definition that changed since the project container was built. Not reported by older agents
+	EnvironmentChanges *EnvironmentChanges `json:"environmentChanges,omitempty"`
+	GitStatus          GitStatus           `json:"gitStatus"`
+	// LastActivityAt is when the agent last observed terminal, IDE or file activity. Not set by older agents
+	LastActivityAt     *string `json:"lastActivityAt,omitempty"`
+	LastActivitySource *string `json:"lastActivitySource,omitempty"`
+	// NodeKeyExpiry is when the key of the tailnet node of the agent expires, in RFC3339. The agent renews the key before then. Not set if the key does not expire or by older agents
+	NodeKeyExpiry *string `json:"nodeKeyExpiry,omitempty"`
+	// Ready is set once the agent accepts SSH connections and can be reached over the tailnet or its tunnel
+	Ready *bool `json:"ready,omitempty"`
+	// Services the agent registered for other workspaces to resolve by name
+	Services []ProjectService `json:"services,omitempty"`
+	// Tunneled is set if the agent can't reach the tailnet and the project is reached through its tunnel to the server
+	Tunneled  *bool  `json:"tunneled,omitempty"`
+	UpdatedAt string `json:"updatedAt"`
+	Uptime    int32  `json:"uptime"`
+	// Resources used by the project container. Not reported by older agents or outside of a container
+	Usage *Resources `json:"usage,omitempty"`
 }
 
 type _ProjectState ProjectState
@@ -48,6 +69,134 @@ func NewProjectStateWithDefaults() *ProjectState {
 	return &this
 }
 
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *ProjectState) GetAddress() string {
+	if o == nil || IsNil(o.Address) {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetAddressOk returns a tuple with the Address field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetAddressOk() (*string, bool) {
+	if o == nil || IsNil(o.Address) {
+		return nil, false
+	}
+	return o.Address, true
+}
+
+// HasAddress returns a boolean if a field has been set.
+func (o *ProjectState) HasAddress() bool {
+	if o != nil && !IsNil(o.Address) {
+		return true
+	}
+
+	return false
+}
+
+// SetAddress gets a reference to the given string and assigns it to the Address field.
+func (o *ProjectState) SetAddress(v string) {
+	o.Address = &v
+}
+
+// GetAgentVersion returns the AgentVersion field value if set, zero value otherwise.
+func (o *ProjectState) GetAgentVersion() string {
+	if o == nil || IsNil(o.AgentVersion) {
+		var ret string
+		return ret
+	}
+	return *o.AgentVersion
+}
+
+// GetAgentVersionOk returns a tuple with the AgentVersion field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetAgentVersionOk() (*string, bool) {
+	if o == nil || IsNil(o.AgentVersion) {
+		return nil, false
+	}
+	return o.AgentVersion, true
+}
+
+// HasAgentVersion returns a boolean if a field has been set.
+func (o *ProjectState) HasAgentVersion() bool {
+	if o != nil && !IsNil(o.AgentVersion) {
+		return true
+	}
+
+	return false
+}
+
+// SetAgentVersion gets a reference to the given string and assigns it to the AgentVersion field.
+func (o *ProjectState) SetAgentVersion(v string) {
+	o.AgentVersion = &v
+}
+
+// GetDetectedPorts returns the DetectedPorts field value if set, zero value otherwise.
+func (o *ProjectState) GetDetectedPorts() []DetectedPort {
+	if o == nil || IsNil(o.DetectedPorts) {
+		var ret []DetectedPort
+		return ret
+	}
+	return o.DetectedPorts
+}
+
+// GetDetectedPortsOk returns a tuple with the DetectedPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetDetectedPortsOk() ([]DetectedPort, bool) {
+	if o == nil || IsNil(o.DetectedPorts) {
+		return nil, false
+	}
+	return o.DetectedPorts, true
+}
+
+// HasDetectedPorts returns a boolean if a field has been set.
+func (o *ProjectState) HasDetectedPorts() bool {
+	if o != nil && !IsNil(o.DetectedPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetDetectedPorts gets a reference to the given []DetectedPort and assigns it to the DetectedPorts field.
+func (o *ProjectState) SetDetectedPorts(v []DetectedPort) {
+	o.DetectedPorts = v
+}
+
+// GetEnvironmentChanges returns the EnvironmentChanges field value if set, zero value otherwise.
+func (o *ProjectState) GetEnvironmentChanges() EnvironmentChanges {
+	if o == nil || IsNil(o.EnvironmentChanges) {
+		var ret EnvironmentChanges
+		return ret
+	}
+	return *o.EnvironmentChanges
+}
+
+// GetEnvironmentChangesOk returns a tuple with the EnvironmentChanges field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetEnvironmentChangesOk() (*EnvironmentChanges, bool) {
+	if o == nil || IsNil(o.EnvironmentChanges) {
+		return nil, false
+	}
+	return o.EnvironmentChanges, true
+}
+
+// HasEnvironmentChanges returns a boolean if a field has been set.
+func (o *ProjectState) HasEnvironmentChanges() bool {
+	if o != nil && !IsNil(o.EnvironmentChanges) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironmentChanges gets a reference to the given EnvironmentChanges and assigns it to the EnvironmentChanges field.
+func (o *ProjectState) SetEnvironmentChanges(v EnvironmentChanges) {
+	o.EnvironmentChanges = &v
+}
+
 // GetGitStatus returns the GitStatus field value
 func (o *ProjectState) GetGitStatus() GitStatus {
 	if o == nil {
@@ -72,6 +221,198 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = v
 }
 
+// GetLastActivityAt returns the LastActivityAt field value if set, zero value otherwise.
+func (o *ProjectState) GetLastActivityAt() string {
+	if o == nil || IsNil(o.LastActivityAt) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivityAt
+}
+
+// GetLastActivityAtOk returns a tuple with the LastActivityAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLastActivityAtOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivityAt) {
+		return nil, false
+	}
+	return o.LastActivityAt, true
+}
+
+// HasLastActivityAt returns a boolean if a field has been set.
+func (o *ProjectState) HasLastActivityAt() bool {
+	if o != nil && !IsNil(o.LastActivityAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivityAt gets a reference to the given string and assigns it to the LastActivityAt field.
+func (o *ProjectState) SetLastActivityAt(v string) {
+	o.LastActivityAt = &v
+}
+
+// GetLastActivitySource returns the LastActivitySource field value if set, zero value otherwise.
+func (o *ProjectState) GetLastActivitySource() string {
+	if o == nil || IsNil(o.LastActivitySource) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivitySource
+}
+
+// GetLastActivitySourceOk returns a tuple with the LastActivitySource field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLastActivitySourceOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivitySource) {
+		return nil, false
+	}
+	return o.LastActivitySource, true
+}
+
+// HasLastActivitySource returns a boolean if a field has been set.
+func (o *ProjectState) HasLastActivitySource() bool {
+	if o != nil && !IsNil(o.LastActivitySource) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivitySource gets a reference to the given string and assigns it to the LastActivitySource field.
+func (o *ProjectState) SetLastActivitySource(v string) {
+	o.LastActivitySource = &v
+}
+
+// GetNodeKeyExpiry returns the NodeKeyExpiry field value if set, zero value otherwise.
+func (o *ProjectState) GetNodeKeyExpiry() string {
+	if o == nil || IsNil(o.NodeKeyExpiry) {
+		var ret string
+		return ret
+	}
+	return *o.NodeKeyExpiry
+}
+
+// GetNodeKeyExpiryOk returns a tuple with the NodeKeyExpiry field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetNodeKeyExpiryOk() (*string, bool) {
+	if o == nil || IsNil(o.NodeKeyExpiry) {
+		return nil, false
+	}
+	return o.NodeKeyExpiry, true
+}
+
+// HasNodeKeyExpiry returns a boolean if a field has been set.
+func (o *ProjectState) HasNodeKeyExpiry() bool {
+	if o != nil && !IsNil(o.NodeKeyExpiry) {
+		return true
+	}
+
+	return false
+}
+
+// SetNodeKeyExpiry gets a reference to the given string and assigns it to the NodeKeyExpiry field.
+func (o *ProjectState) SetNodeKeyExpiry(v string) {
+	o.NodeKeyExpiry = &v
+}
+
+// GetReady returns the Ready field value if set, zero value otherwise.
+func (o *ProjectState) GetReady() bool {
+	if o == nil || IsNil(o.Ready) {
+		var ret bool
+		return ret
+	}
+	return *o.Ready
+}
+
+// GetReadyOk returns a tuple with the Ready field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetReadyOk() (*bool, bool) {
+	if o == nil || IsNil(o.Ready) {
+		return nil, false
+	}
+	return o.Ready, true
+}
+
+// HasReady returns a boolean if a field has been set.
+func (o *ProjectState) HasReady() bool {
+	if o != nil && !IsNil(o.Ready) {
+		return true
+	}
+
+	return false
+}
+
+// SetReady gets a reference to the given bool and assigns it to the Ready field.
+func (o *ProjectState) SetReady(v bool) {
+	o.Ready = &v
+}
+
+// GetServices returns the Services field value if set, zero value otherwise.
+func (o *ProjectState) GetServices() []ProjectService {
+	if o == nil || IsNil(o.Services) {
+		var ret []ProjectService
+		return ret
+	}
+	return o.Services
+}
+
+// GetServicesOk returns a tuple with the Services field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetServicesOk() ([]ProjectService, bool) {
+	if o == nil || IsNil(o.Services) {
+		return nil, false
+	}
+	return o.Services, true
+}
+
+// HasServices returns a boolean if a field has been set.
+func (o *ProjectState) HasServices() bool {
+	if o != nil && !IsNil(o.Services) {
+		return true
+	}
+
+	return false
+}
+
+// SetServices gets a reference to the given []ProjectService and assigns it to the Services field.
+func (o *ProjectState) SetServices(v []ProjectService) {
+	o.Services = v
+}
+
+// GetTunneled returns the Tunneled field value if set, zero value otherwise.
+func (o *ProjectState) GetTunneled() bool {
+	if o == nil || IsNil(o.Tunneled) {
+		var ret bool
+		return ret
+	}
+	return *o.Tunneled
+}
+
+// GetTunneledOk returns a tuple with the Tunneled field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetTunneledOk() (*bool, bool) {
+	if o == nil || IsNil(o.Tunneled) {
+		return nil, false
+	}
+	return o.Tunneled, true
+}
+
+// HasTunneled returns a boolean if a field has been set.
+func (o *ProjectState) HasTunneled() bool {
+	if o != nil && !IsNil(o.Tunneled) {
+		return true
+	}
+
+	return false
+}
+
+// SetTunneled gets a reference to the given bool and assigns it to the Tunneled field.
+func (o *ProjectState) SetTunneled(v bool) {
+	o.Tunneled = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil {
@@ -120,6 +461,38 @@ func (o *ProjectState) SetUptime(v int32) {
 	o.Uptime = v
 }
 
+// GetUsage returns the Usage field value if set, zero value otherwise.
+func (o *ProjectState) GetUsage() Resources {
+	if o == nil || IsNil(o.Usage) {
+		var ret Resources
+		return ret
+	}
+	return *o.Usage
+}
+
+// GetUsageOk returns a tuple with the Usage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetUsageOk() (*Resources, bool) {
+	if o == nil || IsNil(o.Usage) {
+		return nil, false
+	}
+	return o.Usage, true
+}
+
+// HasUsage returns a boolean if a field has been set.
+func (o *ProjectState) HasUsage() bool {
+	if o != nil && !IsNil(o.Usage) {
+		return true
+	}
+
+	return false
+}
+
+// SetUsage gets a reference to the given Resources and assigns it to the Usage field.
+func (o *ProjectState) SetUsage(v Resources) {
+	o.Usage = &v
+}
+
 func (o ProjectState) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -130,9 +503,42 @@ func (o ProjectState) MarshalJSON() ([]byte, error) {
 
 func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Address) {
+		toSerialize["address"] = o.Address
+	}
+	if !IsNil(o.AgentVersion) {
+		toSerialize["agentVersion"] = o.AgentVersion
+	}
+	if !IsNil(o.DetectedPorts) {
+		toSerialize["detectedPorts"] = o.DetectedPorts
+	}
+	if !IsNil(o.EnvironmentChanges) {
+		toSerialize["environmentChanges"] = o.EnvironmentChanges
+	}
 	toSerialize["gitStatus"] = o.GitStatus
+	if !IsNil(o.LastActivityAt) {
+		toSerialize["lastActivityAt"] = o.LastActivityAt
+	}
+	if !IsNil(o.LastActivitySource) {
+		toSerialize["lastActivitySource"] = o.LastActivitySource
+	}
+	if !IsNil(o.NodeKeyExpiry) {
+		toSerialize["nodeKeyExpiry"] = o.NodeKeyExpiry
+	}
+	if !IsNil(o.Ready) {
+		toSerialize["ready"] = o.Ready
+	}
+	if !IsNil(o.Services) {
+		toSerialize["services"] = o.Services
+	}
+	if !IsNil(o.Tunneled) {
+		toSerialize["tunneled"] = o.Tunneled
+	}
 	toSerialize["updatedAt"] = o.UpdatedAt
 	toSerialize["uptime"] = o.Uptime
+	if !IsNil(o.Usage) {
+		toSerialize["usage"] = o.Usage
+	}
 	return toSerialize, nil
 }
 
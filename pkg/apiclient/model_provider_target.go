@@ -21,11 +21,14 @@ var _ MappedNullable = &ProviderTarget{}
 
 // ProviderTarget struct for ProviderTarget
 type ProviderTarget struct {
-	IsDefault bool   `json:"isDefault"`
-	Name      string `json:"name"`
+	// Default limit of the traffic the agent of each project on the target proxies from and to the tailnet
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty"`
+	IsDefault      bool            `json:"isDefault"`
+	Name           string          `json:"name"`
 	// JSON encoded map of options
-	Options      string               `json:"options"`
-	ProviderInfo ProviderProviderInfo `json:"providerInfo"`
+	Options        string               `json:"options"`
+	OrganizationId *string              `json:"organizationId,omitempty"`
+	ProviderInfo   ProviderProviderInfo `json:"providerInfo"`
 }
 
 type _ProviderTarget ProviderTarget
@@ -51,6 +54,38 @@ func NewProviderTargetWithDefaults() *ProviderTarget {
 	return &this
 }
 
+// GetBandwidthLimit returns the BandwidthLimit field value if set, zero value otherwise.
+func (o *ProviderTarget) GetBandwidthLimit() BandwidthLimit {
+	if o == nil || IsNil(o.BandwidthLimit) {
+		var ret BandwidthLimit
+		return ret
+	}
+	return *o.BandwidthLimit
+}
+
+// GetBandwidthLimitOk returns a tuple with the BandwidthLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetBandwidthLimitOk() (*BandwidthLimit, bool) {
+	if o == nil || IsNil(o.BandwidthLimit) {
+		return nil, false
+	}
+	return o.BandwidthLimit, true
+}
+
+// HasBandwidthLimit returns a boolean if a field has been set.
+func (o *ProviderTarget) HasBandwidthLimit() bool {
+	if o != nil && !IsNil(o.BandwidthLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetBandwidthLimit gets a reference to the given BandwidthLimit and assigns it to the BandwidthLimit field.
+func (o *ProviderTarget) SetBandwidthLimit(v BandwidthLimit) {
+	o.BandwidthLimit = &v
+}
+
 // GetIsDefault returns the IsDefault field value
 func (o *ProviderTarget) GetIsDefault() bool {
 	if o == nil {
@@ -123,6 +158,38 @@ func (o *ProviderTarget) SetOptions(v string) {
 	o.Options = v
 }
 
+// GetOrganizationId returns the OrganizationId field value if set, zero value otherwise.
+func (o *ProviderTarget) GetOrganizationId() string {
+	if o == nil || IsNil(o.OrganizationId) {
+		var ret string
+		return ret
+	}
+	return *o.OrganizationId
+}
+
+// GetOrganizationIdOk returns a tuple with the OrganizationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetOrganizationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OrganizationId) {
+		return nil, false
+	}
+	return o.OrganizationId, true
+}
+
+// HasOrganizationId returns a boolean if a field has been set.
+func (o *ProviderTarget) HasOrganizationId() bool {
+	if o != nil && !IsNil(o.OrganizationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrganizationId gets a reference to the given string and assigns it to the OrganizationId field.
+func (o *ProviderTarget) SetOrganizationId(v string) {
+	o.OrganizationId = &v
+}
+
 // GetProviderInfo returns the ProviderInfo field value
 func (o *ProviderTarget) GetProviderInfo() ProviderProviderInfo {
 	if o == nil {
@@ -157,9 +224,15 @@ func (o ProviderTarget) MarshalJSON() ([]byte, error) {
 
 func (o ProviderTarget) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.BandwidthLimit) {
+		toSerialize["bandwidthLimit"] = o.BandwidthLimit
+	}
 	toSerialize["isDefault"] = o.IsDefault
 	toSerialize["name"] = o.Name
 	toSerialize["options"] = o.Options
+	if !IsNil(o.OrganizationId) {
+		toSerialize["organizationId"] = o.OrganizationId
+	}
 	toSerialize["providerInfo"] = o.ProviderInfo
 	return toSerialize, nil
 }
@@ -21,23 +21,53 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                   int32         `json:"apiPort"`
-	BinariesPath              string        `json:"binariesPath"`
-	BuildImageNamespace       *string       `json:"buildImageNamespace,omitempty"`
-	BuilderImage              string        `json:"builderImage"`
-	BuilderRegistryServer     string        `json:"builderRegistryServer"`
-	DefaultProjectImage       string        `json:"defaultProjectImage"`
-	DefaultProjectUser        string        `json:"defaultProjectUser"`
-	Frps                      *FRPSConfig   `json:"frps,omitempty"`
-	HeadscalePort             int32         `json:"headscalePort"`
-	Id                        string        `json:"id"`
-	LocalBuilderRegistryImage string        `json:"localBuilderRegistryImage"`
-	LocalBuilderRegistryPort  int32         `json:"localBuilderRegistryPort"`
-	LogFile                   LogFileConfig `json:"logFile"`
-	ProvidersDir              string        `json:"providersDir"`
-	RegistryUrl               string        `json:"registryUrl"`
-	SamplesIndexUrl           *string       `json:"samplesIndexUrl,omitempty"`
-	ServerDownloadUrl         string        `json:"serverDownloadUrl"`
+	// Lets project agents and clients register persistent tailnet nodes that stay registered while they are offline. Only server administrators can generate persistent network keys if false
+	AllowPersistentNodes *bool `json:"allowPersistentNodes,omitempty"`
+	// Host paths that projects can bind mount, along with the paths below them. Bind mounts are refused if empty
+	AllowedBindMountPaths []string    `json:"allowedBindMountPaths,omitempty"`
+	ApiPort               int32       `json:"apiPort"`
+	Auth                  *AuthConfig `json:"auth,omitempty"`
+	// Autoscaling policies of targets whose provider places projects on a pool of hosts or VMs
+	Autoscaling           []AutoscalingConfig     `json:"autoscaling,omitempty"`
+	BinariesPath          string                  `json:"binariesPath"`
+	BrowserBridge         *BrowserBridgeConfig    `json:"browserBridge,omitempty"`
+	BuildImageNamespace   *string                 `json:"buildImageNamespace,omitempty"`
+	BuilderImage          string                  `json:"builderImage"`
+	BuilderRegistryServer string                  `json:"builderRegistryServer"`
+	CleanupPolicies       []CleanupPolicyConfig   `json:"cleanupPolicies,omitempty"`
+	DefaultProjectImage   string                  `json:"defaultProjectImage"`
+	DefaultProjectUser    string                  `json:"defaultProjectUser"`
+	Derp                  *DerpConfig             `json:"derp,omitempty"`
+	EmbeddedRegistry      *EmbeddedRegistryConfig `json:"embeddedRegistry,omitempty"`
+	Federation            *FederationConfig       `json:"federation,omitempty"`
+	Frps                  *FRPSConfig             `json:"frps,omitempty"`
+	HeadscalePort         int32                   `json:"headscalePort"`
+	// Template of the tailnet hostnames of new projects with the {user}, {workspace}, {workspaceId} and {project} placeholders, e.g. \"{user}-{workspace}-{project}\". Hostnames are derived from the workspace ID and project name if empty
+	HostnameTemplate *string `json:"hostnameTemplate,omitempty"`
+	Id               string  `json:"id"`
+	// Workspaces are stopped after a project saw no SSH, port, terminal, IDE or file activity for this many minutes. Projects override it with the DAYTONA_AGENT_IDLE_TIMEOUT env var, e.g. 2h. Workspaces are never stopped if 0
+	IdleTimeoutMinutes *int32             `json:"idleTimeoutMinutes,omitempty"`
+	ImagePolicy        *ImagePolicyConfig `json:"imagePolicy,omitempty"`
+	// Lets support administrators act on behalf of users. Impersonation is disabled if unset
+	Impersonation             *ImpersonationConfig `json:"impersonation,omitempty"`
+	LocalBuilderRegistryImage string               `json:"localBuilderRegistryImage"`
+	LocalBuilderRegistryPort  int32                `json:"localBuilderRegistryPort"`
+	LogFile                   LogFileConfig        `json:"logFile"`
+	LogLevels                 *LogLevelsConfig     `json:"logLevels,omitempty"`
+	// Provider jobs that run on a target at the same time, e.g. workspace creations and starts and the image pre-pulls of prebuilds. Waiting jobs of a higher priority run first, creations and starts before pre-pulls. Unlimited if 0
+	MaxConcurrentProvisioningJobs *int32          `json:"maxConcurrentProvisioningJobs,omitempty"`
+	Metering                      *MeteringConfig `json:"metering,omitempty"`
+	// Multiple of the CPU and memory of a target host that projects can reserve, e.g. 1.5. New projects that would reserve more are refused. Defaults to 1, targets whose provider does not report their capacity are not checked
+	OvercommitRatio *float32 `json:"overcommitRatio,omitempty"`
+	// Manages the DNS records and the certificate of public preview URLs under an organization owned frps domain
+	PreviewDns        *PreviewDnsConfig   `json:"previewDns,omitempty"`
+	ProvidersDir      string              `json:"providersDir"`
+	RegistryUrl       string              `json:"registryUrl"`
+	SamplesIndexUrl   *string             `json:"samplesIndexUrl,omitempty"`
+	ServerDownloadUrl string              `json:"serverDownloadUrl"`
+	StateHistory      *StateHistoryConfig `json:"stateHistory,omitempty"`
+	// Stores artifacts and the logs of finished operations in a bucket instead of the server config directory
+	Storage *StorageConfig `json:"storage,omitempty"`
 }
 
 type _ServerConfig ServerConfig
@@ -73,6 +103,70 @@ func NewServerConfigWithDefaults() *ServerConfig {
 	return &this
 }
 
+// GetAllowPersistentNodes returns the AllowPersistentNodes field value if set, zero value otherwise.
+func (o *ServerConfig) GetAllowPersistentNodes() bool {
+	if o == nil || IsNil(o.AllowPersistentNodes) {
+		var ret bool
+		return ret
+	}
+	return *o.AllowPersistentNodes
+}
+
+// GetAllowPersistentNodesOk returns a tuple with the AllowPersistentNodes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetAllowPersistentNodesOk() (*bool, bool) {
+	if o == nil || IsNil(o.AllowPersistentNodes) {
+		return nil, false
+	}
+	return o.AllowPersistentNodes, true
+}
+
+// HasAllowPersistentNodes returns a boolean if a field has been set.
+func (o *ServerConfig) HasAllowPersistentNodes() bool {
+	if o != nil && !IsNil(o.AllowPersistentNodes) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowPersistentNodes gets a reference to the given bool and assigns it to the AllowPersistentNodes field.
+func (o *ServerConfig) SetAllowPersistentNodes(v bool) {
+	o.AllowPersistentNodes = &v
+}
+
+// GetAllowedBindMountPaths returns the AllowedBindMountPaths field value if set, zero value otherwise.
+func (o *ServerConfig) GetAllowedBindMountPaths() []string {
+	if o == nil || IsNil(o.AllowedBindMountPaths) {
+		var ret []string
+		return ret
+	}
+	return o.AllowedBindMountPaths
+}
+
+// GetAllowedBindMountPathsOk returns a tuple with the AllowedBindMountPaths field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetAllowedBindMountPathsOk() ([]string, bool) {
+	if o == nil || IsNil(o.AllowedBindMountPaths) {
+		return nil, false
+	}
+	return o.AllowedBindMountPaths, true
+}
+
+// HasAllowedBindMountPaths returns a boolean if a field has been set.
+func (o *ServerConfig) HasAllowedBindMountPaths() bool {
+	if o != nil && !IsNil(o.AllowedBindMountPaths) {
+		return true
+	}
+
+	return false
+}
+
+// SetAllowedBindMountPaths gets a reference to the given []string and assigns it to the AllowedBindMountPaths field.
+func (o *ServerConfig) SetAllowedBindMountPaths(v []string) {
+	o.AllowedBindMountPaths = v
+}
+
 // GetApiPort returns the ApiPort field value
 func (o *ServerConfig) GetApiPort() int32 {
 	if o == nil {
@@ -97,6 +191,70 @@ func (o *ServerConfig) SetApiPort(v int32) {
 	o.ApiPort = v
 }
 
+// GetAuth returns the Auth field value if set, zero value otherwise.
+func (o *ServerConfig) GetAuth() AuthConfig {
+	if o == nil || IsNil(o.Auth) {
+		var ret AuthConfig
+		return ret
+	}
+	return *o.Auth
+}
+
+// GetAuthOk returns a tuple with the Auth field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetAuthOk() (*AuthConfig, bool) {
+	if o == nil || IsNil(o.Auth) {
+		return nil, false
+	}
+	return o.Auth, true
+}
+
+// HasAuth returns a boolean if a field has been set.
+func (o *ServerConfig) HasAuth() bool {
+	if o != nil && !IsNil(o.Auth) {
+		return true
+	}
+
+	return false
+}
+
+// SetAuth gets a reference to the given AuthConfig and assigns it to the Auth field.
+func (o *ServerConfig) SetAuth(v AuthConfig) {
+	o.Auth = &v
+}
+
+// GetAutoscaling returns the Autoscaling field value if set, zero value otherwise.
+func (o *ServerConfig) GetAutoscaling() []AutoscalingConfig {
+	if o == nil || IsNil(o.Autoscaling) {
+		var ret []AutoscalingConfig
+		return ret
+	}
+	return o.Autoscaling
+}
+
+// GetAutoscalingOk returns a tuple with the Autoscaling field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetAutoscalingOk() ([]AutoscalingConfig, bool) {
+	if o == nil || IsNil(o.Autoscaling) {
+		return nil, false
+	}
+	return o.Autoscaling, true
+}
+
+// HasAutoscaling returns a boolean if a field has been set.
+func (o *ServerConfig) HasAutoscaling() bool {
+	if o != nil && !IsNil(o.Autoscaling) {
+		return true
+	}
+
+	return false
+}
+
+// SetAutoscaling gets a reference to the given []AutoscalingConfig and assigns it to the Autoscaling field.
+func (o *ServerConfig) SetAutoscaling(v []AutoscalingConfig) {
+	o.Autoscaling = v
+}
+
 // GetBinariesPath returns the BinariesPath field value
 func (o *ServerConfig) GetBinariesPath() string {
 	if o == nil {
@@ -121,6 +279,38 @@ func (o *ServerConfig) SetBinariesPath(v string) {
 	o.BinariesPath = v
 }
 
+// GetBrowserBridge returns the BrowserBridge field value if set, zero value otherwise.
+func (o *ServerConfig) GetBrowserBridge() BrowserBridgeConfig {
+	if o == nil || IsNil(o.BrowserBridge) {
+		var ret BrowserBridgeConfig
+		return ret
+	}
+	return *o.BrowserBridge
+}
+
+// GetBrowserBridgeOk returns a tuple with the BrowserBridge field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBrowserBridgeOk() (*BrowserBridgeConfig, bool) {
+	if o == nil || IsNil(o.BrowserBridge) {
+		return nil, false
+	}
+	return o.BrowserBridge, true
+}
+
+// HasBrowserBridge returns a boolean if a field has been set.
+func (o *ServerConfig) HasBrowserBridge() bool {
+	if o != nil && !IsNil(o.BrowserBridge) {
+		return true
+	}
+
+	return false
+}
+
+// SetBrowserBridge gets a reference to the given BrowserBridgeConfig and assigns it to the BrowserBridge field.
+func (o *ServerConfig) SetBrowserBridge(v BrowserBridgeConfig) {
+	o.BrowserBridge = &v
+}
+
 // GetBuildImageNamespace returns the BuildImageNamespace field value if set, zero value otherwise.
 func (o *ServerConfig) GetBuildImageNamespace() string {
 	if o == nil || IsNil(o.BuildImageNamespace) {
@@ -201,6 +391,38 @@ func (o *ServerConfig) SetBuilderRegistryServer(v string) {
 	o.BuilderRegistryServer = v
 }
 
+// GetCleanupPolicies returns the CleanupPolicies field value if set, zero value otherwise.
+func (o *ServerConfig) GetCleanupPolicies() []CleanupPolicyConfig {
+	if o == nil || IsNil(o.CleanupPolicies) {
+		var ret []CleanupPolicyConfig
+		return ret
+	}
+	return o.CleanupPolicies
+}
+
+// GetCleanupPoliciesOk returns a tuple with the CleanupPolicies field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetCleanupPoliciesOk() ([]CleanupPolicyConfig, bool) {
+	if o == nil || IsNil(o.CleanupPolicies) {
+		return nil, false
+	}
+	return o.CleanupPolicies, true
+}
+
+// HasCleanupPolicies returns a boolean if a field has been set.
+func (o *ServerConfig) HasCleanupPolicies() bool {
+	if o != nil && !IsNil(o.CleanupPolicies) {
+		return true
+	}
+
+	return false
+}
+
+// SetCleanupPolicies gets a reference to the given []CleanupPolicyConfig and assigns it to the CleanupPolicies field.
+func (o *ServerConfig) SetCleanupPolicies(v []CleanupPolicyConfig) {
+	o.CleanupPolicies = v
+}
+
 // GetDefaultProjectImage returns the DefaultProjectImage field value
 func (o *ServerConfig) GetDefaultProjectImage() string {
 	if o == nil {
@@ -249,6 +471,102 @@ func (o *ServerConfig) SetDefaultProjectUser(v string) {
 	o.DefaultProjectUser = v
 }
 
+// GetDerp returns the Derp field value if set, zero value otherwise.
+func (o *ServerConfig) GetDerp() DerpConfig {
+	if o == nil || IsNil(o.Derp) {
+		var ret DerpConfig
+		return ret
+	}
+	return *o.Derp
+}
+
+// GetDerpOk returns a tuple with the Derp field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetDerpOk() (*DerpConfig, bool) {
+	if o == nil || IsNil(o.Derp) {
+		return nil, false
+	}
+	return o.Derp, true
+}
+
+// HasDerp returns a boolean if a field has been set.
+func (o *ServerConfig) HasDerp() bool {
+	if o != nil && !IsNil(o.Derp) {
+		return true
+	}
+
+	return false
+}
+
+// SetDerp gets a reference to the given DerpConfig and assigns it to the Derp field.
+func (o *ServerConfig) SetDerp(v DerpConfig) {
+	o.Derp = &v
+}
+
+// GetEmbeddedRegistry returns the EmbeddedRegistry field value if set, zero value otherwise.
+func (o *ServerConfig) GetEmbeddedRegistry() EmbeddedRegistryConfig {
+	if o == nil || IsNil(o.EmbeddedRegistry) {
+		var ret EmbeddedRegistryConfig
+		return ret
+	}
+	return *o.EmbeddedRegistry
+}
+
+// GetEmbeddedRegistryOk returns a tuple with the EmbeddedRegistry field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetEmbeddedRegistryOk() (*EmbeddedRegistryConfig, bool) {
+	if o == nil || IsNil(o.EmbeddedRegistry) {
+		return nil, false
+	}
+	return o.EmbeddedRegistry, true
+}
+
+// HasEmbeddedRegistry returns a boolean if a field has been set.
+func (o *ServerConfig) HasEmbeddedRegistry() bool {
+	if o != nil && !IsNil(o.EmbeddedRegistry) {
+		return true
+	}
+
+	return false
+}
+
+// SetEmbeddedRegistry gets a reference to the given EmbeddedRegistryConfig and assigns it to the EmbeddedRegistry field.
+func (o *ServerConfig) SetEmbeddedRegistry(v EmbeddedRegistryConfig) {
+	o.EmbeddedRegistry = &v
+}
+
+// GetFederation returns the Federation field value if set, zero value otherwise.
+func (o *ServerConfig) GetFederation() FederationConfig {
+	if o == nil || IsNil(o.Federation) {
+		var ret FederationConfig
+		return ret
+	}
+	return *o.Federation
+}
+
+// GetFederationOk returns a tuple with the Federation field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetFederationOk() (*FederationConfig, bool) {
+	if o == nil || IsNil(o.Federation) {
+		return nil, false
+	}
+	return o.Federation, true
+}
+
+// HasFederation returns a boolean if a field has been set.
+func (o *ServerConfig) HasFederation() bool {
+	if o != nil && !IsNil(o.Federation) {
+		return true
+	}
+
+	return false
+}
+
+// SetFederation gets a reference to the given FederationConfig and assigns it to the Federation field.
+func (o *ServerConfig) SetFederation(v FederationConfig) {
+	o.Federation = &v
+}
+
 // GetFrps returns the Frps field value if set, zero value otherwise.
 func (o *ServerConfig) GetFrps() FRPSConfig {
 	if o == nil || IsNil(o.Frps) {
@@ -305,6 +623,38 @@ func (o *ServerConfig) SetHeadscalePort(v int32) {
 	o.HeadscalePort = v
 }
 
+// GetHostnameTemplate returns the HostnameTemplate field value if set, zero value otherwise.
+func (o *ServerConfig) GetHostnameTemplate() string {
+	if o == nil || IsNil(o.HostnameTemplate) {
+		var ret string
+		return ret
+	}
+	return *o.HostnameTemplate
+}
+
+// GetHostnameTemplateOk returns a tuple with the HostnameTemplate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetHostnameTemplateOk() (*string, bool) {
+	if o == nil || IsNil(o.HostnameTemplate) {
+		return nil, false
+	}
+	return o.HostnameTemplate, true
+}
+
+// HasHostnameTemplate returns a boolean if a field has been set.
+func (o *ServerConfig) HasHostnameTemplate() bool {
+	if o != nil && !IsNil(o.HostnameTemplate) {
+		return true
+	}
+
+	return false
+}
+
+// SetHostnameTemplate gets a reference to the given string and assigns it to the HostnameTemplate field.
+func (o *ServerConfig) SetHostnameTemplate(v string) {
+	o.HostnameTemplate = &v
+}
+
 // GetId returns the Id field value
 func (o *ServerConfig) GetId() string {
 	if o == nil {
@@ -329,6 +679,102 @@ func (o *ServerConfig) SetId(v string) {
 	o.Id = v
 }
 
+// GetIdleTimeoutMinutes returns the IdleTimeoutMinutes field value if set, zero value otherwise.
+func (o *ServerConfig) GetIdleTimeoutMinutes() int32 {
+	if o == nil || IsNil(o.IdleTimeoutMinutes) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleTimeoutMinutes
+}
+
+// GetIdleTimeoutMinutesOk returns a tuple with the IdleTimeoutMinutes field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetIdleTimeoutMinutesOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleTimeoutMinutes) {
+		return nil, false
+	}
+	return o.IdleTimeoutMinutes, true
+}
+
+// HasIdleTimeoutMinutes returns a boolean if a field has been set.
+func (o *ServerConfig) HasIdleTimeoutMinutes() bool {
+	if o != nil && !IsNil(o.IdleTimeoutMinutes) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleTimeoutMinutes gets a reference to the given int32 and assigns it to the IdleTimeoutMinutes field.
+func (o *ServerConfig) SetIdleTimeoutMinutes(v int32) {
+	o.IdleTimeoutMinutes = &v
+}
+
+// GetImagePolicy returns the ImagePolicy field value if set, zero value otherwise.
+func (o *ServerConfig) GetImagePolicy() ImagePolicyConfig {
+	if o == nil || IsNil(o.ImagePolicy) {
+		var ret ImagePolicyConfig
+		return ret
+	}
+	return *o.ImagePolicy
+}
+
+// GetImagePolicyOk returns a tuple with the ImagePolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetImagePolicyOk() (*ImagePolicyConfig, bool) {
+	if o == nil || IsNil(o.ImagePolicy) {
+		return nil, false
+	}
+	return o.ImagePolicy, true
+}
+
+// HasImagePolicy returns a boolean if a field has been set.
+func (o *ServerConfig) HasImagePolicy() bool {
+	if o != nil && !IsNil(o.ImagePolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetImagePolicy gets a reference to the given ImagePolicyConfig and assigns it to the ImagePolicy field.
+func (o *ServerConfig) SetImagePolicy(v ImagePolicyConfig) {
+	o.ImagePolicy = &v
+}
+
+// GetImpersonation returns the Impersonation field value if set, zero value otherwise.
+func (o *ServerConfig) GetImpersonation() ImpersonationConfig {
+	if o == nil || IsNil(o.Impersonation) {
+		var ret ImpersonationConfig
+		return ret
+	}
+	return *o.Impersonation
+}
+
+// GetImpersonationOk returns a tuple with the Impersonation field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetImpersonationOk() (*ImpersonationConfig, bool) {
+	if o == nil || IsNil(o.Impersonation) {
+		return nil, false
+	}
+	return o.Impersonation, true
+}
+
+// HasImpersonation returns a boolean if a field has been set.
+func (o *ServerConfig) HasImpersonation() bool {
+	if o != nil && !IsNil(o.Impersonation) {
+		return true
+	}
+
+	return false
+}
+
+// SetImpersonation gets a reference to the given ImpersonationConfig and assigns it to the Impersonation field.
+func (o *ServerConfig) SetImpersonation(v ImpersonationConfig) {
+	o.Impersonation = &v
+}
+
 // GetLocalBuilderRegistryImage returns the LocalBuilderRegistryImage field value
 func (o *ServerConfig) GetLocalBuilderRegistryImage() string {
 	if o == nil {
@@ -401,6 +847,166 @@ func (o *ServerConfig) SetLogFile(v LogFileConfig) {
 	o.LogFile = v
 }
 
+// GetLogLevels returns the LogLevels field value if set, zero value otherwise.
+func (o *ServerConfig) GetLogLevels() LogLevelsConfig {
+	if o == nil || IsNil(o.LogLevels) {
+		var ret LogLevelsConfig
+		return ret
+	}
+	return *o.LogLevels
+}
+
+// GetLogLevelsOk returns a tuple with the LogLevels field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetLogLevelsOk() (*LogLevelsConfig, bool) {
+	if o == nil || IsNil(o.LogLevels) {
+		return nil, false
+	}
+	return o.LogLevels, true
+}
+
+// HasLogLevels returns a boolean if a field has been set.
+func (o *ServerConfig) HasLogLevels() bool {
+	if o != nil && !IsNil(o.LogLevels) {
+		return true
+	}
+
+	return false
+}
+
+// SetLogLevels gets a reference to the given LogLevelsConfig and assigns it to the LogLevels field.
+func (o *ServerConfig) SetLogLevels(v LogLevelsConfig) {
+	o.LogLevels = &v
+}
+
+// GetMaxConcurrentProvisioningJobs returns the MaxConcurrentProvisioningJobs field value if set, zero value otherwise.
+func (o *ServerConfig) GetMaxConcurrentProvisioningJobs() int32 {
+	if o == nil || IsNil(o.MaxConcurrentProvisioningJobs) {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrentProvisioningJobs
+}
+
+// GetMaxConcurrentProvisioningJobsOk returns a tuple with the MaxConcurrentProvisioningJobs field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMaxConcurrentProvisioningJobsOk() (*int32, bool) {
+	if o == nil || IsNil(o.MaxConcurrentProvisioningJobs) {
+		return nil, false
+	}
+	return o.MaxConcurrentProvisioningJobs, true
+}
+
+// HasMaxConcurrentProvisioningJobs returns a boolean if a field has been set.
+func (o *ServerConfig) HasMaxConcurrentProvisioningJobs() bool {
+	if o != nil && !IsNil(o.MaxConcurrentProvisioningJobs) {
+		return true
+	}
+
+	return false
+}
+
+// SetMaxConcurrentProvisioningJobs gets a reference to the given int32 and assigns it to the MaxConcurrentProvisioningJobs field.
+func (o *ServerConfig) SetMaxConcurrentProvisioningJobs(v int32) {
+	o.MaxConcurrentProvisioningJobs = &v
+}
+
+// GetMetering returns the Metering field value if set, zero value otherwise.
+func (o *ServerConfig) GetMetering() MeteringConfig {
+	if o == nil || IsNil(o.Metering) {
+		var ret MeteringConfig
+		return ret
+	}
+	return *o.Metering
+}
+
+// GetMeteringOk returns a tuple with the Metering field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetMeteringOk() (*MeteringConfig, bool) {
+	if o == nil || IsNil(o.Metering) {
+		return nil, false
+	}
+	return o.Metering, true
+}
+
+// HasMetering returns a boolean if a field has been set.
+func (o *ServerConfig) HasMetering() bool {
+	if o != nil && !IsNil(o.Metering) {
+		return true
+	}
+
+	return false
+}
+
+// SetMetering gets a reference to the given MeteringConfig and assigns it to the Metering field.
+func (o *ServerConfig) SetMetering(v MeteringConfig) {
+	o.Metering = &v
+}
+
+// GetOvercommitRatio returns the OvercommitRatio field value if set, zero value otherwise.
+func (o *ServerConfig) GetOvercommitRatio() float32 {
+	if o == nil || IsNil(o.OvercommitRatio) {
+		var ret float32
+		return ret
+	}
+	return *o.OvercommitRatio
+}
+
+// GetOvercommitRatioOk returns a tuple with the OvercommitRatio field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetOvercommitRatioOk() (*float32, bool) {
+	if o == nil || IsNil(o.OvercommitRatio) {
+		return nil, false
+	}
+	return o.OvercommitRatio, true
+}
+
+// HasOvercommitRatio returns a boolean if a field has been set.
+func (o *ServerConfig) HasOvercommitRatio() bool {
+	if o != nil && !IsNil(o.OvercommitRatio) {
+		return true
+	}
+
+	return false
+}
+
+// SetOvercommitRatio gets a reference to the given float32 and assigns it to the OvercommitRatio field.
+func (o *ServerConfig) SetOvercommitRatio(v float32) {
+	o.OvercommitRatio = &v
+}
+
+// GetPreviewDns returns the PreviewDns field value if set, zero value otherwise.
+func (o *ServerConfig) GetPreviewDns() PreviewDnsConfig {
+	if o == nil || IsNil(o.PreviewDns) {
+		var ret PreviewDnsConfig
+		return ret
+	}
+	return *o.PreviewDns
+}
+
+// GetPreviewDnsOk returns a tuple with the PreviewDns field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetPreviewDnsOk() (*PreviewDnsConfig, bool) {
+	if o == nil || IsNil(o.PreviewDns) {
+		return nil, false
+	}
+	return o.PreviewDns, true
+}
+
+// HasPreviewDns returns a boolean if a field has been set.
+func (o *ServerConfig) HasPreviewDns() bool {
+	if o != nil && !IsNil(o.PreviewDns) {
+		return true
+	}
+
+	return false
+}
+
+// SetPreviewDns gets a reference to the given PreviewDnsConfig and assigns it to the PreviewDns field.
+func (o *ServerConfig) SetPreviewDns(v PreviewDnsConfig) {
+	o.PreviewDns = &v
+}
+
 // GetProvidersDir returns the ProvidersDir field value
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil {
@@ -505,6 +1111,70 @@ func (o *ServerConfig) SetServerDownloadUrl(v string) {
 	o.ServerDownloadUrl = v
 }
 
+// GetStateHistory returns the StateHistory field value if set, zero value otherwise.
+func (o *ServerConfig) GetStateHistory() StateHistoryConfig {
+	if o == nil || IsNil(o.StateHistory) {
+		var ret StateHistoryConfig
+		return ret
+	}
+	return *o.StateHistory
+}
+
+// GetStateHistoryOk returns a tuple with the StateHistory field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetStateHistoryOk() (*StateHistoryConfig, bool) {
+	if o == nil || IsNil(o.StateHistory) {
+		return nil, false
+	}
+	return o.StateHistory, true
+}
+
+// HasStateHistory returns a boolean if a field has been set.
+func (o *ServerConfig) HasStateHistory() bool {
+	if o != nil && !IsNil(o.StateHistory) {
+		return true
+	}
+
+	return false
+}
+
+// SetStateHistory gets a reference to the given StateHistoryConfig and assigns it to the StateHistory field.
+func (o *ServerConfig) SetStateHistory(v StateHistoryConfig) {
+	o.StateHistory = &v
+}
+
+// GetStorage returns the Storage field value if set, zero value otherwise.
+func (o *ServerConfig) GetStorage() StorageConfig {
+	if o == nil || IsNil(o.Storage) {
+		var ret StorageConfig
+		return ret
+	}
+	return *o.Storage
+}
+
+// GetStorageOk returns a tuple with the Storage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetStorageOk() (*StorageConfig, bool) {
+	if o == nil || IsNil(o.Storage) {
+		return nil, false
+	}
+	return o.Storage, true
+}
+
+// HasStorage returns a boolean if a field has been set.
+func (o *ServerConfig) HasStorage() bool {
+	if o != nil && !IsNil(o.Storage) {
+		return true
+	}
+
+	return false
+}
+
+// SetStorage gets a reference to the given StorageConfig and assigns it to the Storage field.
+func (o *ServerConfig) SetStorage(v StorageConfig) {
+	o.Storage = &v
+}
+
 func (o ServerConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -515,29 +1185,89 @@ func (o ServerConfig) MarshalJSON() ([]byte, error) {
 
 func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.AllowPersistentNodes) {
+		toSerialize["allowPersistentNodes"] = o.AllowPersistentNodes
+	}
+	if !IsNil(o.AllowedBindMountPaths) {
+		toSerialize["allowedBindMountPaths"] = o.AllowedBindMountPaths
+	}
 	toSerialize["apiPort"] = o.ApiPort
+	if !IsNil(o.Auth) {
+		toSerialize["auth"] = o.Auth
+	}
+	if !IsNil(o.Autoscaling) {
+		toSerialize["autoscaling"] = o.Autoscaling
+	}
 	toSerialize["binariesPath"] = o.BinariesPath
+	if !IsNil(o.BrowserBridge) {
+		toSerialize["browserBridge"] = o.BrowserBridge
+	}
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
 	toSerialize["builderImage"] = o.BuilderImage
 	toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
+	if !IsNil(o.CleanupPolicies) {
+		toSerialize["cleanupPolicies"] = o.CleanupPolicies
+	}
 	toSerialize["defaultProjectImage"] = o.DefaultProjectImage
 	toSerialize["defaultProjectUser"] = o.DefaultProjectUser
+	if !IsNil(o.Derp) {
+		toSerialize["derp"] = o.Derp
+	}
+	if !IsNil(o.EmbeddedRegistry) {
+		toSerialize["embeddedRegistry"] = o.EmbeddedRegistry
+	}
+	if !IsNil(o.Federation) {
+		toSerialize["federation"] = o.Federation
+	}
 	if !IsNil(o.Frps) {
 		toSerialize["frps"] = o.Frps
 	}
 	toSerialize["headscalePort"] = o.HeadscalePort
+	if !IsNil(o.HostnameTemplate) {
+		toSerialize["hostnameTemplate"] = o.HostnameTemplate
+	}
 	toSerialize["id"] = o.Id
+	if !IsNil(o.IdleTimeoutMinutes) {
+		toSerialize["idleTimeoutMinutes"] = o.IdleTimeoutMinutes
+	}
+	if !IsNil(o.ImagePolicy) {
+		toSerialize["imagePolicy"] = o.ImagePolicy
+	}
+	if !IsNil(o.Impersonation) {
+		toSerialize["impersonation"] = o.Impersonation
+	}
 	toSerialize["localBuilderRegistryImage"] = o.LocalBuilderRegistryImage
 	toSerialize["localBuilderRegistryPort"] = o.LocalBuilderRegistryPort
 	toSerialize["logFile"] = o.LogFile
+	if !IsNil(o.LogLevels) {
+		toSerialize["logLevels"] = o.LogLevels
+	}
+	if !IsNil(o.MaxConcurrentProvisioningJobs) {
+		toSerialize["maxConcurrentProvisioningJobs"] = o.MaxConcurrentProvisioningJobs
+	}
+	if !IsNil(o.Metering) {
+		toSerialize["metering"] = o.Metering
+	}
+	if !IsNil(o.OvercommitRatio) {
+		toSerialize["overcommitRatio"] = o.OvercommitRatio
+	}
+	if !IsNil(o.PreviewDns) {
+		toSerialize["previewDns"] = o.PreviewDns
+	}
 	toSerialize["providersDir"] = o.ProvidersDir
 	toSerialize["registryUrl"] = o.RegistryUrl
 	if !IsNil(o.SamplesIndexUrl) {
 		toSerialize["samplesIndexUrl"] = o.SamplesIndexUrl
 	}
 	toSerialize["serverDownloadUrl"] = o.ServerDownloadUrl
+	if !IsNil(o.StateHistory) {
+		toSerialize["stateHistory"] = o.StateHistory
+	}
+	if !IsNil(o.Storage) {
+		toSerialize["storage"] = o.Storage
+	}
 	return toSerialize, nil
 }
 
@@ -21,8 +21,26 @@ var _ MappedNullable = &SetProjectState{}
 
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    int32      `json:"uptime"`
+	// Address of the project container. Only reported by agents of routed projects
+	Address *string `json:"address,omitempty"`
+	// Ports processes of the project listen on, except for the ports of the agent
+	DetectedPorts []int32 `json:"detectedPorts,omitempty"`
+	// Files of the environment definition that changed since the project container was built
+	EnvironmentChanges *EnvironmentChanges `json:"environmentChanges,omitempty"`
+	GitStatus          *GitStatus          `json:"gitStatus,omitempty"`
+	// Seconds since the agent last observed terminal, IDE or file activity
+	IdleSeconds        *int32  `json:"idleSeconds,omitempty"`
+	LastActivitySource *string `json:"lastActivitySource,omitempty"`
+	// Time the key of the tailnet node of the agent expires, in RFC3339
+	NodeKeyExpiry *string `json:"nodeKeyExpiry,omitempty"`
+	// Set once the agent accepts SSH connections and joined the tailnet. Projects of older agents, which do not report it, are ready once they report their state
+	Ready *bool `json:"ready,omitempty"`
+	// Services the project exposes to other workspaces
+	Services []ProjectService `json:"services,omitempty"`
+	Uptime   int32            `json:"uptime"`
+	// Resources used by the project container
+	Usage   *Resources `json:"usage,omitempty"`
+	Version *string    `json:"version,omitempty"`
 }
 
 type _SetProjectState SetProjectState
@@ -45,6 +63,102 @@ func NewSetProjectStateWithDefaults() *SetProjectState {
 	return &this
 }
 
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *SetProjectState) GetAddress() string {
+	if o == nil || IsNil(o.Address) {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetAddressOk returns a tuple with the Address field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetAddressOk() (*string, bool) {
+	if o == nil || IsNil(o.Address) {
+		return nil, false
+	}
+	return o.Address, true
+}
+
+// HasAddress returns a boolean if a field has been set.
+func (o *SetProjectState) HasAddress() bool {
+	if o != nil && !IsNil(o.Address) {
+		return true
+	}
+
+	return false
+}
+
+// SetAddress gets a reference to the given string and assigns it to the Address field.
+func (o *SetProjectState) SetAddress(v string) {
+	o.Address = &v
+}
+
+// GetDetectedPorts returns the DetectedPorts field value if set, zero value otherwise.
+func (o *SetProjectState) GetDetectedPorts() []int32 {
+	if o == nil || IsNil(o.DetectedPorts) {
+		var ret []int32
+		return ret
+	}
+	return o.DetectedPorts
+}
+
+// GetDetectedPortsOk returns a tuple with the DetectedPorts field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetDetectedPortsOk() ([]int32, bool) {
+	if o == nil || IsNil(o.DetectedPorts) {
+		return nil, false
+	}
+	return o.DetectedPorts, true
+}
+
+// HasDetectedPorts returns a boolean if a field has been set.
+func (o *SetProjectState) HasDetectedPorts() bool {
+	if o != nil && !IsNil(o.DetectedPorts) {
+		return true
+	}
+
+	return false
+}
+
+// SetDetectedPorts gets a reference to the given []int32 and assigns it to the DetectedPorts field.
+func (o *SetProjectState) SetDetectedPorts(v []int32) {
+	o.DetectedPorts = v
+}
+
+// GetEnvironmentChanges returns the EnvironmentChanges field value if set, zero value otherwise.
+func (o *SetProjectState) GetEnvironmentChanges() EnvironmentChanges {
+	if o == nil || IsNil(o.EnvironmentChanges) {
+		var ret EnvironmentChanges
+		return ret
+	}
+	return *o.EnvironmentChanges
+}
+
+// GetEnvironmentChangesOk returns a tuple with the EnvironmentChanges field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetEnvironmentChangesOk() (*EnvironmentChanges, bool) {
+	if o == nil || IsNil(o.EnvironmentChanges) {
+		return nil, false
+	}
+	return o.EnvironmentChanges, true
+}
+
+// HasEnvironmentChanges returns a boolean if a field has been set.
+func (o *SetProjectState) HasEnvironmentChanges() bool {
+	if o != nil && !IsNil(o.EnvironmentChanges) {
+		return true
+	}
+
+	return false
+}
+
+// SetEnvironmentChanges gets a reference to the given EnvironmentChanges and assigns it to the EnvironmentChanges field.
+func (o *SetProjectState) SetEnvironmentChanges(v EnvironmentChanges) {
+	o.EnvironmentChanges = &v
+}
+
 // GetGitStatus returns the GitStatus field value if set, zero value otherwise.
 func (o *SetProjectState) GetGitStatus() GitStatus {
 	if o == nil || IsNil(o.GitStatus) {
@@ -77,6 +191,166 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetIdleSeconds returns the IdleSeconds field value if set, zero value otherwise.
+func (o *SetProjectState) GetIdleSeconds() int32 {
+	if o == nil || IsNil(o.IdleSeconds) {
+		var ret int32
+		return ret
+	}
+	return *o.IdleSeconds
+}
+
+// GetIdleSecondsOk returns a tuple with the IdleSeconds field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetIdleSecondsOk() (*int32, bool) {
+	if o == nil || IsNil(o.IdleSeconds) {
+		return nil, false
+	}
+	return o.IdleSeconds, true
+}
+
+// HasIdleSeconds returns a boolean if a field has been set.
+func (o *SetProjectState) HasIdleSeconds() bool {
+	if o != nil && !IsNil(o.IdleSeconds) {
+		return true
+	}
+
+	return false
+}
+
+// SetIdleSeconds gets a reference to the given int32 and assigns it to the IdleSeconds field.
+func (o *SetProjectState) SetIdleSeconds(v int32) {
+	o.IdleSeconds = &v
+}
+
+// GetLastActivitySource returns the LastActivitySource field value if set, zero value otherwise.
+func (o *SetProjectState) GetLastActivitySource() string {
+	if o == nil || IsNil(o.LastActivitySource) {
+		var ret string
+		return ret
+	}
+	return *o.LastActivitySource
+}
+
+// GetLastActivitySourceOk returns a tuple with the LastActivitySource field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetLastActivitySourceOk() (*string, bool) {
+	if o == nil || IsNil(o.LastActivitySource) {
+		return nil, false
+	}
+	return o.LastActivitySource, true
+}
+
+// HasLastActivitySource returns a boolean if a field has been set.
+func (o *SetProjectState) HasLastActivitySource() bool {
+	if o != nil && !IsNil(o.LastActivitySource) {
+		return true
+	}
+
+	return false
+}
+
+// SetLastActivitySource gets a reference to the given string and assigns it to the LastActivitySource field.
+func (o *SetProjectState) SetLastActivitySource(v string) {
+	o.LastActivitySource = &v
+}
+
+// GetNodeKeyExpiry returns the NodeKeyExpiry field value if set, zero value otherwise.
+func (o *SetProjectState) GetNodeKeyExpiry() string {
+	if o == nil || IsNil(o.NodeKeyExpiry) {
+		var ret string
+		return ret
+	}
+	return *o.NodeKeyExpiry
+}
+
+// GetNodeKeyExpiryOk returns a tuple with the NodeKeyExpiry field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetNodeKeyExpiryOk() (*string, bool) {
+	if o == nil || IsNil(o.NodeKeyExpiry) {
+		return nil, false
+	}
+	return o.NodeKeyExpiry, true
+}
+
+// HasNodeKeyExpiry returns a boolean if a field has been set.
+func (o *SetProjectState) HasNodeKeyExpiry() bool {
+	if o != nil && !IsNil(o.NodeKeyExpiry) {
+		return true
+	}
+
+	return false
+}
+
+// SetNodeKeyExpiry gets a reference to the given string and assigns it to the NodeKeyExpiry field.
+func (o *SetProjectState) SetNodeKeyExpiry(v string) {
+	o.NodeKeyExpiry = &v
+}
+
+// GetReady returns the Ready field value if set, zero value otherwise.
+func (o *SetProjectState) GetReady() bool {
+	if o == nil || IsNil(o.Ready) {
+		var ret bool
+		return ret
+	}
+	return *o.Ready
+}
+
+// GetReadyOk returns a tuple with the Ready field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetReadyOk() (*bool, bool) {
+	if o == nil || IsNil(o.Ready) {
+		return nil, false
+	}
+	return o.Ready, true
+}
+
+// HasReady returns a boolean if a field has been set.
+func (o *SetProjectState) HasReady() bool {
+	if o != nil && !IsNil(o.Ready) {
+		return true
+	}
+
+	return false
+}
+
+// SetReady gets a reference to the given bool and assigns it to the Ready field.
+func (o *SetProjectState) SetReady(v bool) {
+	o.Ready = &v
+}
+
+// GetServices returns the Services field value if set, zero value otherwise.
+func (o *SetProjectState) GetServices() []ProjectService {
+	if o == nil || IsNil(o.Services) {
+		var ret []ProjectService
+		return ret
+	}
+	return o.Services
+}
+
+// GetServicesOk returns a tuple with the Services field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetServicesOk() ([]ProjectService, bool) {
+	if o == nil || IsNil(o.Services) {
+		return nil, false
+	}
+	return o.Services, true
+}
+
+// HasServices returns a boolean if a field has been set.
+func (o *SetProjectState) HasServices() bool {
+	if o != nil && !IsNil(o.Services) {
+		return true
+	}
+
+	return false
+}
+
+// SetServices gets a reference to the given []ProjectService and assigns it to the Services field.
+func (o *SetProjectState) SetServices(v []ProjectService) {
+	o.Services = v
+}
+
 // GetUptime returns the Uptime field value
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil {
@@ -101,6 +375,70 @@ func (o *SetProjectState) SetUptime(v int32) {
 	o.Uptime = v
 }
 
+// GetUsage returns the Usage field value if set, zero value otherwise.
+func (o *SetProjectState) GetUsage() Resources {
+	if o == nil || IsNil(o.Usage) {
+		var ret Resources
+		return ret
+	}
+	return *o.Usage
+}
+
+// GetUsageOk returns a tuple with the Usage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetUsageOk() (*Resources, bool) {
+	if o == nil || IsNil(o.Usage) {
+		return nil, false
+	}
+	return o.Usage, true
+}
+
+// HasUsage returns a boolean if a field has been set.
+func (o *SetProjectState) HasUsage() bool {
+	if o != nil && !IsNil(o.Usage) {
+		return true
+	}
+
+	return false
+}
+
+// SetUsage gets a reference to the given Resources and assigns it to the Usage field.
+func (o *SetProjectState) SetUsage(v Resources) {
+	o.Usage = &v
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *SetProjectState) GetVersion() string {
+	if o == nil || IsNil(o.Version) {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetVersionOk returns a tuple with the Version field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetVersionOk() (*string, bool) {
+	if o == nil || IsNil(o.Version) {
+		return nil, false
+	}
+	return o.Version, true
+}
+
+// HasVersion returns a boolean if a field has been set.
+func (o *SetProjectState) HasVersion() bool {
+	if o != nil && !IsNil(o.Version) {
+		return true
+	}
+
+	return false
+}
+
+// SetVersion gets a reference to the given string and assigns it to the Version field.
+func (o *SetProjectState) SetVersion(v string) {
+	o.Version = &v
+}
+
 func (o SetProjectState) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -111,10 +449,40 @@ func (o SetProjectState) MarshalJSON() ([]byte, error) {
 
 func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Address) {
+		toSerialize["address"] = o.Address
+	}
+	if !IsNil(o.DetectedPorts) {
+		toSerialize["detectedPorts"] = o.DetectedPorts
+	}
+	if !IsNil(o.EnvironmentChanges) {
+		toSerialize["environmentChanges"] = o.EnvironmentChanges
+	}
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.IdleSeconds) {
+		toSerialize["idleSeconds"] = o.IdleSeconds
+	}
+	if !IsNil(o.LastActivitySource) {
+		toSerialize["lastActivitySource"] = o.LastActivitySource
+	}
+	if !IsNil(o.NodeKeyExpiry) {
+		toSerialize["nodeKeyExpiry"] = o.NodeKeyExpiry
+	}
+	if !IsNil(o.Ready) {
+		toSerialize["ready"] = o.Ready
+	}
+	if !IsNil(o.Services) {
+		toSerialize["services"] = o.Services
+	}
 	toSerialize["uptime"] = o.Uptime
+	if !IsNil(o.Usage) {
+		toSerialize["usage"] = o.Usage
+	}
+	if !IsNil(o.Version) {
+		toSerialize["version"] = o.Version
+	}
 	return toSerialize, nil
 }
 
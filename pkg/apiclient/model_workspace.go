@@ -21,10 +21,20 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       string    `json:"id"`
-	Name     string    `json:"name"`
-	Projects []Project `json:"projects"`
-	Target   string    `json:"target"`
+	// Adoption is set for workspaces registered from an existing container or VM instead of being created by a provider
+	Adoption    *WorkspaceAdoption `json:"adoption,omitempty"`
+	Annotations *map[string]string `json:"annotations,omitempty"`
+	// Limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the target in the directions it sets
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty"`
+	CreatedAt      *string         `json:"createdAt,omitempty"`
+	ExpiresAt      *string         `json:"expiresAt,omitempty"`
+	Id             string          `json:"id"`
+	Name           string          `json:"name"`
+	OrganizationId *string         `json:"organizationId,omitempty"`
+	Projects       []Project       `json:"projects"`
+	// Names of the shared services of the target the projects of the workspace connect to
+	SharedServices []string `json:"sharedServices,omitempty"`
+	Target         string   `json:"target"`
 }
 
 type _Workspace Workspace
@@ -50,6 +60,166 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetAdoption returns the Adoption field value if set, zero value otherwise.
+func (o *Workspace) GetAdoption() WorkspaceAdoption {
+	if o == nil || IsNil(o.Adoption) {
+		var ret WorkspaceAdoption
+		return ret
+	}
+	return *o.Adoption
+}
+
+// GetAdoptionOk returns a tuple with the Adoption field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetAdoptionOk() (*WorkspaceAdoption, bool) {
+	if o == nil || IsNil(o.Adoption) {
+		return nil, false
+	}
+	return o.Adoption, true
+}
+
+// HasAdoption returns a boolean if a field has been set.
+func (o *Workspace) HasAdoption() bool {
+	if o != nil && !IsNil(o.Adoption) {
+		return true
+	}
+
+	return false
+}
+
+// SetAdoption gets a reference to the given WorkspaceAdoption and assigns it to the Adoption field.
+func (o *Workspace) SetAdoption(v WorkspaceAdoption) {
+	o.Adoption = &v
+}
+
+// GetAnnotations returns the Annotations field value if set, zero value otherwise.
+func (o *Workspace) GetAnnotations() map[string]string {
+	if o == nil || IsNil(o.Annotations) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.Annotations
+}
+
+// GetAnnotationsOk returns a tuple with the Annotations field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetAnnotationsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.Annotations) {
+		return nil, false
+	}
+	return o.Annotations, true
+}
+
+// HasAnnotations returns a boolean if a field has been set.
+func (o *Workspace) HasAnnotations() bool {
+	if o != nil && !IsNil(o.Annotations) {
+		return true
+	}
+
+	return false
+}
+
+// SetAnnotations gets a reference to the given map[string]string and assigns it to the Annotations field.
+func (o *Workspace) SetAnnotations(v map[string]string) {
+	o.Annotations = &v
+}
+
+// GetBandwidthLimit returns the BandwidthLimit field value if set, zero value otherwise.
+func (o *Workspace) GetBandwidthLimit() BandwidthLimit {
+	if o == nil || IsNil(o.BandwidthLimit) {
+		var ret BandwidthLimit
+		return ret
+	}
+	return *o.BandwidthLimit
+}
+
+// GetBandwidthLimitOk returns a tuple with the BandwidthLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetBandwidthLimitOk() (*BandwidthLimit, bool) {
+	if o == nil || IsNil(o.BandwidthLimit) {
+		return nil, false
+	}
+	return o.BandwidthLimit, true
+}
+
+// HasBandwidthLimit returns a boolean if a field has been set.
+func (o *Workspace) HasBandwidthLimit() bool {
+	if o != nil && !IsNil(o.BandwidthLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetBandwidthLimit gets a reference to the given BandwidthLimit and assigns it to the BandwidthLimit field.
+func (o *Workspace) SetBandwidthLimit(v BandwidthLimit) {
+	o.BandwidthLimit = &v
+}
+
+// GetCreatedAt returns the CreatedAt field value if set, zero value otherwise.
+func (o *Workspace) GetCreatedAt() string {
+	if o == nil || IsNil(o.CreatedAt) {
+		var ret string
+		return ret
+	}
+	return *o.CreatedAt
+}
+
+// GetCreatedAtOk returns a tuple with the CreatedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetCreatedAtOk() (*string, bool) {
+	if o == nil || IsNil(o.CreatedAt) {
+		return nil, false
+	}
+	return o.CreatedAt, true
+}
+
+// HasCreatedAt returns a boolean if a field has been set.
+func (o *Workspace) HasCreatedAt() bool {
+	if o != nil && !IsNil(o.CreatedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetCreatedAt gets a reference to the given string and assigns it to the CreatedAt field.
+func (o *Workspace) SetCreatedAt(v string) {
+	o.CreatedAt = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *Workspace) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *Workspace) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *Workspace) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetId returns the Id field value
 func (o *Workspace) GetId() string {
 	if o == nil {
@@ -98,6 +268,38 @@ func (o *Workspace) SetName(v string) {
 	o.Name = v
 }
 
+// GetOrganizationId returns the OrganizationId field value if set, zero value otherwise.
+func (o *Workspace) GetOrganizationId() string {
+	if o == nil || IsNil(o.OrganizationId) {
+		var ret string
+		return ret
+	}
+	return *o.OrganizationId
+}
+
+// GetOrganizationIdOk returns a tuple with the OrganizationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetOrganizationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OrganizationId) {
+		return nil, false
+	}
+	return o.OrganizationId, true
+}
+
+// HasOrganizationId returns a boolean if a field has been set.
+func (o *Workspace) HasOrganizationId() bool {
+	if o != nil && !IsNil(o.OrganizationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOrganizationId gets a reference to the given string and assigns it to the OrganizationId field.
+func (o *Workspace) SetOrganizationId(v string) {
+	o.OrganizationId = &v
+}
+
 // GetProjects returns the Projects field value
 func (o *Workspace) GetProjects() []Project {
 	if o == nil {
@@ -122,6 +324,38 @@ func (o *Workspace) SetProjects(v []Project) {
 	o.Projects = v
 }
 
+// GetSharedServices returns the SharedServices field value if set, zero value otherwise.
+func (o *Workspace) GetSharedServices() []string {
+	if o == nil || IsNil(o.SharedServices) {
+		var ret []string
+		return ret
+	}
+	return o.SharedServices
+}
+
+// GetSharedServicesOk returns a tuple with the SharedServices field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetSharedServicesOk() ([]string, bool) {
+	if o == nil || IsNil(o.SharedServices) {
+		return nil, false
+	}
+	return o.SharedServices, true
+}
+
+// HasSharedServices returns a boolean if a field has been set.
+func (o *Workspace) HasSharedServices() bool {
+	if o != nil && !IsNil(o.SharedServices) {
+		return true
+	}
+
+	return false
+}
+
+// SetSharedServices gets a reference to the given []string and assigns it to the SharedServices field.
+func (o *Workspace) SetSharedServices(v []string) {
+	o.SharedServices = v
+}
+
 // GetTarget returns the Target field value
 func (o *Workspace) GetTarget() string {
 	if o == nil {
@@ -156,9 +390,30 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Adoption) {
+		toSerialize["adoption"] = o.Adoption
+	}
+	if !IsNil(o.Annotations) {
+		toSerialize["annotations"] = o.Annotations
+	}
+	if !IsNil(o.BandwidthLimit) {
+		toSerialize["bandwidthLimit"] = o.BandwidthLimit
+	}
+	if !IsNil(o.CreatedAt) {
+		toSerialize["createdAt"] = o.CreatedAt
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	toSerialize["id"] = o.Id
 	toSerialize["name"] = o.Name
+	if !IsNil(o.OrganizationId) {
+		toSerialize["organizationId"] = o.OrganizationId
+	}
 	toSerialize["projects"] = o.Projects
+	if !IsNil(o.SharedServices) {
+		toSerialize["sharedServices"] = o.SharedServices
+	}
 	toSerialize["target"] = o.Target
 	return toSerialize, nil
 }
@@ -16,4 +16,6 @@ type ApiKey struct {
 	Type    ApiKeyType `json:"type" validate:"required"`
 	// Project or client name
 	Name string `json:"name" validate:"required"`
+	// Admin client keys can manage server-wide resources, e.g. announcements and regions
+	Admin bool `json:"admin" validate:"optional"`
 } // @name ApiKey
@@ -14,9 +14,14 @@ type Store interface {
 }
 
 var (
-	ErrApiKeyNotFound = errors.New("api key not found")
+	ErrApiKeyNotFound      = errors.New("api key not found")
+	ErrApiKeyAlreadyExists = errors.New("an api key with the same name already exists")
 )
 
 func IsApiKeyNotFound(err error) bool {
 	return err.Error() == ErrApiKeyNotFound.Error()
 }
+
+func IsApiKeyAlreadyExists(err error) bool {
+	return err.Error() == ErrApiKeyAlreadyExists.Error()
+}
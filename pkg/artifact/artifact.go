@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import "time"
+
+// Artifact is a file produced inside a project and uploaded to the server
+type Artifact struct {
+	Id          string `json:"id" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	ProjectName string `json:"projectName" validate:"required"`
+	// Path of the artifact relative to the project directory
+	Path      string    `json:"path" validate:"required"`
+	Size      int       `json:"size" validate:"required"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name Artifact
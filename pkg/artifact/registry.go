@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Registry keeps track of project output paths that should be uploaded as artifacts
+// once the project stops. Paths are relative to the project directory.
+type Registry struct {
+	path string
+}
+
+func NewRegistry(configDir string) *Registry {
+	return &Registry{
+		path: filepath.Join(configDir, "artifacts.json"),
+	}
+}
+
+func (r *Registry) List() ([]string, error) {
+	content, err := os.ReadFile(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+
+	var paths []string
+	err = json.Unmarshal(content, &paths)
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+func (r *Registry) Register(paths ...string) error {
+	registered, err := r.List()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		p = filepath.Clean(p)
+		if !slices.Contains(registered, p) {
+			registered = append(registered, p)
+		}
+	}
+
+	return r.save(registered)
+}
+
+func (r *Registry) Unregister(paths ...string) error {
+	registered, err := r.List()
+	if err != nil {
+		return err
+	}
+
+	registered = slices.DeleteFunc(registered, func(p string) bool {
+		return slices.Contains(paths, p)
+	})
+
+	return r.save(registered)
+}
+
+func (r *Registry) save(paths []string) error {
+	content, err := json.MarshalIndent(paths, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(r.path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, content, 0644)
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import "errors"
+
+type Store interface {
+	List(filter *Filter) ([]*Artifact, error)
+	Find(id string) (*Artifact, error)
+	Save(artifact *Artifact) error
+	Delete(artifact *Artifact) error
+}
+
+type Filter struct {
+	WorkspaceId *string
+	ProjectName *string
+}
+
+var (
+	ErrArtifactNotFound = errors.New("artifact not found")
+)
+
+func IsArtifactNotFound(err error) bool {
+	return err.Error() == ErrArtifactNotFound.Error()
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import "time"
+
+type Action string
+
+const (
+	ActionTargetScaleUp        Action = "target.scale-up"
+	ActionTargetScaleDown      Action = "target.scale-down"
+	ActionImpersonationStart   Action = "impersonation.start"
+	ActionImpersonationEnd     Action = "impersonation.end"
+	ActionImpersonationRequest Action = "impersonation.request"
+)
+
+// Event records an action taken on a resource of the server, either by a user or by the server itself
+type Event struct {
+	Id string `json:"id" validate:"required"`
+	// Name of the user or API key, or the server component that took the action, e.g. "autoscaler"
+	Actor    string `json:"actor" validate:"required"`
+	Action   Action `json:"action" validate:"required"`
+	Resource string `json:"resource" validate:"required"`
+	// Human readable description of the action, including why it was taken
+	Message string `json:"message" validate:"required"`
+	// Name of the administrator that took the action on behalf of the actor
+	ImpersonatedBy string    `json:"impersonatedBy,omitempty" validate:"optional"`
+	CreatedAt      time.Time `json:"createdAt" validate:"required"`
+} // @name AuditEvent
@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import "time"
+
+type Filter struct {
+	Action   *Action
+	Resource *string
+	Since    *time.Time
+}
+
+type Store interface {
+	List(filter *Filter) ([]*Event, error)
+	Save(event *Event) error
+}
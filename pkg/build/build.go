@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
@@ -36,8 +37,18 @@ type Build struct {
 	Repository      *gitprovider.GitRepository      `json:"repository" validate:"required"`
 	EnvVars         map[string]string               `json:"envVars" validate:"required"`
 	PrebuildId      string                          `json:"prebuildId" validate:"required"`
+	Architecture    *string                         `json:"architecture,omitempty" validate:"optional"`
+	Priority        BuildPriority                   `json:"priority" validate:"required"`
 	CreatedAt       time.Time                       `json:"createdAt" validate:"required"`
 	UpdatedAt       time.Time                       `json:"updatedAt" validate:"required"`
+	// ID of the API request that created the build
+	CorrelationId string `json:"correlationId,omitempty" validate:"optional"`
+	// Names of the build secrets mounted into the build. Their values are resolved when the build runs
+	BuildSecrets []string `json:"buildSecrets,omitempty" validate:"optional"`
+	// Organization of the project config of the build. Build secrets are resolved in it
+	OrganizationId string `json:"organizationId,omitempty" validate:"optional"`
+	// Time the published image of the prebuild was pulled onto the targets that use it
+	ImagePrePulledAt *time.Time `json:"imagePrePulledAt,omitempty" validate:"optional"`
 } // @name Build
 
 func (b *Build) Compare(other *Build) (bool, error) {
@@ -67,6 +78,7 @@ func (b *Build) Compare(other *Build) (bool, error) {
 }
 
 // GetBuildHash returns a SHA-256 hash of the build's configuration, repository branch and environment variables.
+// Builds created from a prebuild matrix variant also include the variant's image and architecture.
 func (b *Build) GetBuildHash() (string, error) {
 	var buildJson []byte
 	var err error
@@ -82,6 +94,13 @@ func (b *Build) GetBuildHash() (string, error) {
 	}
 
 	data := string(buildJson) + b.Repository.Url + b.Repository.Branch + string(envVarsJson)
+	if b.Architecture != nil {
+		data += b.ContainerConfig.Image + *b.Architecture
+	}
+	// Only the names of build secrets are part of the hash, their values are not known until the build runs
+	if len(b.BuildSecrets) > 0 {
+		data += strings.Join(b.BuildSecrets, ",")
+	}
 	hash := sha256.Sum256([]byte(data))
 	hashStr := hex.EncodeToString(hash[:])
 	return hashStr, nil
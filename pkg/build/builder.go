@@ -13,10 +13,13 @@ import (
 )
 
 type IBuilder interface {
-	Build(build Build) (string, string, error)
+	// Build builds the image of the build with the values of its build secrets mounted by name
+	Build(build Build, secrets map[string]string) (string, string, error)
 	CleanUp() error
 	Publish(build Build) error
 	GetImageName(build Build) (string, error)
+	// Stop aborts a build that is in progress, Build returns an error afterwards
+	Stop(build Build) error
 }
 
 type Builder struct {
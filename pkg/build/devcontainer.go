@@ -6,12 +6,14 @@ package build
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
 	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/client"
 )
 
@@ -27,7 +29,7 @@ type DevcontainerBuilder struct {
 	builderDockerPort uint16
 }
 
-func (b *DevcontainerBuilder) Build(build Build) (string, string, error) {
+func (b *DevcontainerBuilder) Build(build Build, secrets map[string]string) (string, string, error) {
 	builderType, err := detect.DetectProjectBuilderType(build.BuildConfig, b.projectDir, nil)
 	if err != nil {
 		return "", "", err
@@ -37,13 +39,44 @@ func (b *DevcontainerBuilder) Build(build Build) (string, string, error) {
 		return "", "", errors.New("failed to detect devcontainer config")
 	}
 
-	return b.buildDevcontainer(build)
+	return b.buildDevcontainer(build, secrets)
 }
 
 func (b *DevcontainerBuilder) CleanUp() error {
 	return os.RemoveAll(b.projectDir)
 }
 
+// Stop removes the containers of the build, which makes the devcontainer CLI fail
+func (b *DevcontainerBuilder) Stop(build Build) error {
+	ctx := context.Background()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", fmt.Sprintf("daytona.build.id=%s", build.Id))),
+		All:     true,
+	})
+	if err != nil {
+		return err
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: cli,
+	})
+
+	for _, c := range containers {
+		err = dockerClient.RemoveContainer(c.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (b *DevcontainerBuilder) Publish(build Build) error {
 	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
 	defer buildLogger.Close()
@@ -64,7 +97,7 @@ func (b *DevcontainerBuilder) Publish(build Build) error {
 	return dockerClient.PushImage(*build.Image, b.buildImageContainerRegistry, buildLogger)
 }
 
-func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, error) {
+func (b *DevcontainerBuilder) buildDevcontainer(build Build, secrets map[string]string) (string, string, error) {
 	buildLogger := b.loggerFactory.CreateBuildLogger(build.Id, logs.LogSourceBuilder)
 	defer buildLogger.Close()
 
@@ -82,7 +115,7 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
 
-	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(docker.CreateDevcontainerOptions{
+	createOpts := docker.CreateDevcontainerOptions{
 		BuildConfig:              build.BuildConfig,
 		ProjectName:              build.Id,
 		ContainerRegistry:        b.buildImageContainerRegistry,
@@ -92,10 +125,16 @@ func (b *DevcontainerBuilder) buildDevcontainer(build Build) (string, string, er
 		IdLabels: map[string]string{
 			"daytona.build.id": build.Id,
 		},
-		ProjectDir: b.projectDir,
-		LogWriter:  buildLogger,
-		EnvVars:    build.EnvVars,
-	})
+		ProjectDir:   b.projectDir,
+		LogWriter:    buildLogger,
+		EnvVars:      build.EnvVars,
+		BuildSecrets: secrets,
+	}
+	if build.Architecture != nil {
+		createOpts.Architecture = *build.Architecture
+	}
+
+	containerId, remoteUser, err := dockerClient.CreateFromDevcontainer(createOpts)
 	if err != nil {
 		return b.defaultProjectImage, b.defaultProjectUser, err
 	}
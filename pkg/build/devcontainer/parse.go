@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"encoding/json"
+
+	"github.com/tailscale/hujson"
+)
+
+// ParseConfiguration parses the content of a devcontainer.json file
+func ParseConfiguration(content []byte) (*Configuration, error) {
+	// devcontainer.json allows comments and trailing commas
+	standardized, err := hujson.Standardize(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Configuration
+	err = json.Unmarshal(standardized, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
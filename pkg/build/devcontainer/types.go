@@ -4,21 +4,32 @@
 package devcontainer
 
 type Configuration struct {
-	Name                 string                 `json:"name"`
-	DockerFile           string                 `json:"dockerFile"`
-	RunArgs              []string               `json:"runArgs"`
-	InitializeCommand    Command                `json:"initializeCommand"`
-	OnCreateCommand      Command                `json:"onCreateCommand"`
-	UpdateContentCommand Command                `json:"updateContentCommand"`
-	PostCreateCommand    Command                `json:"postCreateCommand"`
-	PostStartCommand     Command                `json:"postStartCommand"`
-	PostAttachCommand    Command                `json:"postAttachCommand"`
-	WaitFor              WaitFor                `json:"waitFor"`
-	RemoteUser           string                 `json:"remoteUser"`
-	Features             map[string]interface{} `json:"features"`
-	ForwardPorts         []int                  `json:"forwardPorts"`
-	Customizations       map[string]interface{} `json:"customizations"`
-	ConfigFilePath       ConfigFilePath         `json:"configFilePath"`
+	Name                 string                    `json:"name"`
+	Image                string                    `json:"image"`
+	DockerFile           string                    `json:"dockerFile"`
+	Build                *BuildOptions             `json:"build"`
+	RunArgs              []string                  `json:"runArgs"`
+	InitializeCommand    Command                   `json:"initializeCommand"`
+	OnCreateCommand      Command                   `json:"onCreateCommand"`
+	UpdateContentCommand Command                   `json:"updateContentCommand"`
+	PostCreateCommand    Command                   `json:"postCreateCommand"`
+	PostStartCommand     Command                   `json:"postStartCommand"`
+	PostAttachCommand    Command                   `json:"postAttachCommand"`
+	WaitFor              WaitFor                   `json:"waitFor"`
+	RemoteUser           string                    `json:"remoteUser"`
+	Features             map[string]interface{}    `json:"features"`
+	ContainerEnv         map[string]string         `json:"containerEnv"`
+	ForwardPorts         []int                     `json:"forwardPorts"`
+	PortsAttributes      map[string]PortAttributes `json:"portsAttributes"`
+	Customizations       map[string]interface{}    `json:"customizations"`
+	ConfigFilePath       ConfigFilePath            `json:"configFilePath"`
+}
+
+type BuildOptions struct {
+	Dockerfile string            `json:"dockerfile"`
+	Context    string            `json:"context"`
+	Args       map[string]string `json:"args"`
+	Target     string            `json:"target"`
 }
 
 type Command interface{}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"errors"
+	"sort"
+)
+
+type BuildPriority string
+
+const (
+	// Used for builds triggered by prebuilds
+	BuildPriorityLow BuildPriority = "low"
+	// Used for builds created by users
+	BuildPriorityNormal BuildPriority = "normal"
+	BuildPriorityHigh   BuildPriority = "high"
+)
+
+var ErrInvalidBuildPriority = errors.New("invalid build priority, must be one of low, normal or high")
+
+func IsInvalidBuildPriority(err error) bool {
+	return err.Error() == ErrInvalidBuildPriority.Error()
+}
+
+func (p BuildPriority) IsValid() bool {
+	switch p {
+	case BuildPriorityLow, BuildPriorityNormal, BuildPriorityHigh:
+		return true
+	}
+	return false
+}
+
+// Rank returns a value that is higher for builds that should run first. Builds saved before priorities
+// were introduced have no priority and are ranked as normal.
+func (p BuildPriority) Rank() int {
+	switch p {
+	case BuildPriorityLow:
+		return 0
+	case BuildPriorityHigh:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// SortByPriority orders builds by descending priority, builds of the same priority are ordered by creation time
+func SortByPriority(builds []*Build) {
+	sort.SliceStable(builds, func(i, j int) bool {
+		if builds[i].Priority.Rank() != builds[j].Priority.Rank() {
+			return builds[i].Priority.Rank() > builds[j].Priority.Rank()
+		}
+		return builds[i].CreatedAt.Before(builds[j].CreatedAt)
+	})
+}
+
+// GetPreemptionCandidate returns the running build that should be stopped to make room for the pending build.
+// Only builds of a lower priority can be preempted, the most recently created build of the lowest priority is chosen
+// so that older builds are more likely to finish.
+func GetPreemptionCandidate(pending *Build, running []*Build) *Build {
+	var candidate *Build
+
+	for _, b := range running {
+		if b.Priority.Rank() >= pending.Priority.Rank() {
+			continue
+		}
+
+		if candidate == nil || b.Priority.Rank() < candidate.Priority.Rank() ||
+			(b.Priority.Rank() == candidate.Priority.Rank() && b.CreatedAt.After(candidate.CreatedAt)) {
+			candidate = b
+		}
+	}
+
+	return candidate
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortByPriority(t *testing.T) {
+	now := time.Now()
+
+	builds := []*build.Build{
+		{Id: "prebuild", Priority: build.BuildPriorityLow, CreatedAt: now.Add(-time.Hour)},
+		{Id: "newer", Priority: build.BuildPriorityNormal, CreatedAt: now},
+		{Id: "legacy", CreatedAt: now.Add(-time.Minute)},
+		{Id: "bumped", Priority: build.BuildPriorityHigh, CreatedAt: now},
+	}
+
+	build.SortByPriority(builds)
+
+	ids := []string{}
+	for _, b := range builds {
+		ids = append(ids, b.Id)
+	}
+	require.Equal(t, []string{"bumped", "legacy", "newer", "prebuild"}, ids)
+}
+
+func TestGetPreemptionCandidate(t *testing.T) {
+	now := time.Now()
+
+	oldPrebuild := &build.Build{Id: "old-prebuild", Priority: build.BuildPriorityLow, CreatedAt: now.Add(-time.Hour)}
+	newPrebuild := &build.Build{Id: "new-prebuild", Priority: build.BuildPriorityLow, CreatedAt: now}
+	userBuild := &build.Build{Id: "user", Priority: build.BuildPriorityNormal, CreatedAt: now}
+	running := []*build.Build{oldPrebuild, userBuild, newPrebuild}
+
+	require.Equal(t, newPrebuild, build.GetPreemptionCandidate(&build.Build{Priority: build.BuildPriorityNormal}, running))
+	require.Equal(t, newPrebuild, build.GetPreemptionCandidate(&build.Build{Priority: build.BuildPriorityHigh}, running))
+	require.Nil(t, build.GetPreemptionCandidate(&build.Build{Priority: build.BuildPriorityLow}, running))
+	require.Nil(t, build.GetPreemptionCandidate(&build.Build{Priority: build.BuildPriorityNormal}, []*build.Build{userBuild}))
+}
@@ -5,6 +5,7 @@ package build
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"sync"
@@ -34,6 +35,10 @@ type BuildRunnerInstanceConfig struct {
 	BasePath          string
 	TelemetryEnabled  bool
 	TelemetryService  telemetry.TelemetryService
+	// Maximum number of builds that run at the same time, unlimited if 0
+	MaxConcurrentBuilds int
+	// Resolves the values of the build secrets of builds. Builds that mount secrets fail if not set
+	BuildSecretStore BuildSecretStore
 }
 
 type BuildRunner struct {
@@ -42,12 +47,23 @@ type BuildRunner struct {
 	runInterval       string
 	containerRegistry *containerregistry.ContainerRegistry
 	gitProviderStore  GitProviderStore
+	buildSecretStore  BuildSecretStore
 	buildStore        Store
 	builderFactory    IBuilderFactory
 	loggerFactory     logs.LoggerFactory
 	basePath          string
 	telemetryEnabled  bool
 	telemetryService  telemetry.TelemetryService
+
+	maxConcurrentBuilds int
+	runningBuilds       map[string]*runningBuild
+	runningBuildsMutex  sync.Mutex
+}
+
+type runningBuild struct {
+	build     *Build
+	builder   IBuilder
+	preempted bool
 }
 
 type BuildProcessConfig struct {
@@ -63,6 +79,11 @@ type GitProviderStore interface {
 	ListConfigsForUrl(url string) ([]*gitprovider.GitProviderConfig, error)
 }
 
+type BuildSecretStore interface {
+	// Resolve returns the values of the build secrets of the organization by name
+	Resolve(organizationId string, names []string) (map[string]string, error)
+}
+
 func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 	runner := &BuildRunner{
 		Id:                config.BuildRunnerId,
@@ -70,12 +91,16 @@ func NewBuildRunner(config BuildRunnerInstanceConfig) *BuildRunner {
 		runInterval:       config.Interval,
 		containerRegistry: config.ContainerRegistry,
 		gitProviderStore:  config.GitProviderStore,
+		buildSecretStore:  config.BuildSecretStore,
 		buildStore:        config.BuildStore,
 		builderFactory:    config.BuilderFactory,
 		loggerFactory:     config.LoggerFactory,
 		basePath:          config.BasePath,
 		telemetryEnabled:  config.TelemetryEnabled,
 		telemetryService:  config.TelemetryService,
+
+		maxConcurrentBuilds: config.MaxConcurrentBuilds,
+		runningBuilds:       map[string]*runningBuild{},
 	}
 
 	return runner
@@ -108,9 +133,15 @@ func (r *BuildRunner) RunBuilds() {
 		return
 	}
 
+	SortByPriority(builds)
+
 	var wg sync.WaitGroup
 	for _, b := range builds {
 		if b.State == BuildStatePendingRun {
+			if !r.hasCapacity(b) {
+				continue
+			}
+
 			wg.Add(1)
 
 			if b.BuildConfig == nil {
@@ -153,6 +184,8 @@ func (r *BuildRunner) RunBuilds() {
 
 			b.BuildConfig.CachedBuild = GetCachedBuild(b, builds)
 
+			r.trackBuild(b, builder)
+
 			go r.RunBuildProcess(BuildProcessConfig{
 				Builder:     builder,
 				BuildLogger: buildLogger,
@@ -232,6 +265,11 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 	if config.Wg != nil {
 		defer config.Wg.Done()
 	}
+	defer r.untrackBuild(config.Build.Id)
+
+	if config.Build.CorrelationId != "" {
+		config.BuildLogger.Write([]byte(fmt.Sprintf("Correlation ID: %s\n", config.Build.CorrelationId)))
+	}
 
 	config.Build.State = BuildStateRunning
 	err := r.buildStore.Save(config.Build)
@@ -259,7 +297,13 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 		return
 	}
 
-	image, user, err := config.Builder.Build(*config.Build)
+	secrets, err := r.resolveBuildSecrets(config.Build)
+	if err != nil {
+		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
+		return
+	}
+
+	image, user, err := config.Builder.Build(*config.Build, secrets)
 	if err != nil {
 		r.handleBuildError(*config.Build, config.Builder, err, config.BuildLogger)
 		return
@@ -301,9 +345,17 @@ func (r *BuildRunner) RunBuildProcess(config BuildProcessConfig) {
 }
 
 func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, buildLogger logs.Logger) {
+	if r.isPreempted(b.Id) {
+		r.requeuePreemptedBuild(b, builder, buildLogger)
+		return
+	}
+
 	var errMsg string
 	errMsg += "################################################\n"
 	errMsg += fmt.Sprintf("#### BUILD FAILED FOR %s: %s\n", b.Id, err.Error())
+	if b.CorrelationId != "" {
+		errMsg += fmt.Sprintf("#### Correlation ID: %s\n", b.CorrelationId)
+	}
 	errMsg += "################################################\n"
 
 	b.State = BuildStateError
@@ -326,6 +378,104 @@ func (r *BuildRunner) handleBuildError(b Build, builder IBuilder, err error, bui
 	}
 }
 
+// hasCapacity reports whether the build can start now. When the runner is at capacity and a running build
+// has a lower priority, that build is preempted so the pending build can start on one of the next runs.
+func (r *BuildRunner) hasCapacity(b *Build) bool {
+	r.runningBuildsMutex.Lock()
+	defer r.runningBuildsMutex.Unlock()
+
+	if r.maxConcurrentBuilds <= 0 || len(r.runningBuilds) < r.maxConcurrentBuilds {
+		return true
+	}
+
+	running := []*Build{}
+	for _, rb := range r.runningBuilds {
+		// Builds that are already being preempted free their slot soon
+		if rb.preempted {
+			return false
+		}
+		running = append(running, rb.build)
+	}
+
+	candidate := GetPreemptionCandidate(b, running)
+	if candidate == nil {
+		return false
+	}
+
+	rb := r.runningBuilds[candidate.Id]
+	rb.preempted = true
+
+	log.Infof("Preempting build %s (%s priority) to run build %s (%s priority)", candidate.Id, candidate.Priority, b.Id, b.Priority)
+
+	go func() {
+		err := rb.builder.Stop(*candidate)
+		if err != nil {
+			log.Errorf("failed to stop preempted build %s: %v", candidate.Id, err)
+		}
+	}()
+
+	return false
+}
+
+// resolveBuildSecrets returns the values of the build secrets of the build. The values are only passed to the builder
+// and are never saved with the build. Only secrets of the organization of the build are resolved
+func (r *BuildRunner) resolveBuildSecrets(b *Build) (map[string]string, error) {
+	if len(b.BuildSecrets) == 0 {
+		return nil, nil
+	}
+
+	if r.buildSecretStore == nil {
+		return nil, errors.New("build secrets are not available to the build runner")
+	}
+
+	return r.buildSecretStore.Resolve(b.OrganizationId, b.BuildSecrets)
+}
+
+func (r *BuildRunner) trackBuild(b *Build, builder IBuilder) {
+	r.runningBuildsMutex.Lock()
+	defer r.runningBuildsMutex.Unlock()
+
+	r.runningBuilds[b.Id] = &runningBuild{
+		build:   b,
+		builder: builder,
+	}
+}
+
+func (r *BuildRunner) untrackBuild(id string) {
+	r.runningBuildsMutex.Lock()
+	defer r.runningBuildsMutex.Unlock()
+
+	delete(r.runningBuilds, id)
+}
+
+func (r *BuildRunner) isPreempted(id string) bool {
+	r.runningBuildsMutex.Lock()
+	defer r.runningBuildsMutex.Unlock()
+
+	rb, ok := r.runningBuilds[id]
+	return ok && rb.preempted
+}
+
+// Preempted builds are not failed, they run again once capacity is available
+func (r *BuildRunner) requeuePreemptedBuild(b Build, builder IBuilder, buildLogger logs.Logger) {
+	msg := fmt.Sprintf("Build %s was preempted by a build of higher priority and will be retried\n", b.Id)
+
+	b.State = BuildStatePendingRun
+	err := r.buildStore.Save(&b)
+	if err != nil {
+		msg += fmt.Sprintf("Error saving build: %s\n", err.Error())
+	}
+
+	if builder != nil {
+		cleanupErr := builder.CleanUp()
+		if cleanupErr != nil {
+			msg += fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())
+		}
+	}
+
+	buildLogger.Write([]byte(msg + "\n"))
+}
+
 func (r *BuildRunner) logTelemetry(ctx context.Context, b Build, err error) {
 	telemetryProps := telemetry.NewBuildRunnerEventProps(ctx, b.Id, string(b.State))
 	event := telemetry.BuildRunnerEventRunBuild
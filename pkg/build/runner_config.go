@@ -21,6 +21,8 @@ type Config struct {
 	Id               string `json:"id" validate:"required"`
 	Interval         string `json:"interval" validate:"required"`
 	TelemetryEnabled bool   `json:"telemetryEnabled" validate:"required"`
+	// Maximum number of builds that run at the same time, unlimited if 0
+	MaxConcurrentBuilds int `json:"maxConcurrentBuilds" validate:"optional"`
 } // @name BuildRunnerConfig
 
 func GetConfig() (*Config, error) {
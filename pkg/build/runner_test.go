@@ -115,7 +115,7 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 
 	runningBuild := *mocks.MockBuild
 	runningBuild.State = build.BuildStateRunning
-	s.mockBuilder.On("Build", runningBuild).Return("image", "user", nil)
+	s.mockBuilder.On("Build", runningBuild, map[string]string(nil)).Return("image", "user", nil)
 
 	successBuild := *mocks.MockBuild
 	successBuild.State = build.BuildStateSuccess
@@ -145,3 +145,36 @@ func (s *BuildRunnerTestSuite) TestRunBuildProcess() {
 	s.Require().Equal(mocks.MockBuild.User, util.Pointer("user"))
 	s.Require().Equal(mocks.MockBuild.State, build.BuildStatePublished)
 }
+
+func (s *BuildRunnerTestSuite) TestRunBuildProcessWithoutBuildSecretStore() {
+	secretBuild := *mocks.MockBuild
+	secretBuild.Id = "secret-build"
+	secretBuild.BuildSecrets = []string{"npm_token"}
+	s.Require().NoError(s.mockBuildStore.Save(&secretBuild))
+
+	s.mockGitProviderConfigStore.On("ListConfigsForUrl", secretBuild.Repository.Url).Return([]*gitprovider.GitProviderConfig{&gitProviderConfig}, nil)
+
+	mockGitService := git_mocks.NewMockGitService()
+	mockGitService.On("CloneRepository", secretBuild.Repository, &http.BasicAuth{
+		Username: gitProviderConfig.Username,
+	}).Return(nil)
+
+	mockBuilder := &mocks.MockBuilder{}
+	mockBuilder.On("CleanUp").Return(nil)
+
+	mockLogger := logger_mocks.NewMockLogger()
+	mockLogger.On("Write", mock.Anything).Return(0, nil)
+
+	s.Runner.RunBuildProcess(build.BuildProcessConfig{
+		Builder:     mockBuilder,
+		BuildLogger: mockLogger,
+		Build:       &secretBuild,
+		GitService:  mockGitService,
+	})
+
+	mockBuilder.AssertNotCalled(s.T(), "Build", mock.Anything, mock.Anything)
+
+	savedBuild, err := s.mockBuildStore.Find(&build.Filter{Id: &secretBuild.Id})
+	s.Require().NoError(err)
+	s.Require().Equal(build.BuildStateError, savedBuild.State)
+}
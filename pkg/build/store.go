@@ -33,6 +33,9 @@ type Filter struct {
 	RepositoryUrl *string
 	Branch        *string
 	EnvVars       *map[string]string
+	Image         *string
+	// Architecture of the build. An empty architecture matches builds without one
+	Architecture *string
 }
 
 func (f *Filter) StatesToInterface() []interface{} {
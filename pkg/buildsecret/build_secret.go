@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// BuildSecret is a value that builds of project configs and prebuilds can read while they run, e.g. the token of a
+// private package registry. Builds read it through a BuildKit secret mount, so it is never stored in an image layer.
+// Secrets belong to an organization and are only available to builds of its project configs
+type BuildSecret struct {
+	// Id of the secret mount, e.g. RUN --mount=type=secret,id=npm_token
+	Name           string
+	OrganizationId string
+	Value          string
+	UpdatedAt      time.Time
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// IsValidName returns true if the name can be used as the id of a BuildKit secret mount
+func IsValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// ValidateNames returns an error if any of the names of build secrets referenced by a project config or prebuild is
+// not a valid name
+func ValidateNames(names []string) error {
+	for _, name := range names {
+		if !IsValidName(name) {
+			return fmt.Errorf("%w: %s", ErrInvalidName, name)
+		}
+	}
+
+	return nil
+}
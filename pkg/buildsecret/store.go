@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import "errors"
+
+type Store interface {
+	List(organizationId string) ([]*BuildSecret, error)
+	Find(organizationId, name string) (*BuildSecret, error)
+	Save(secret *BuildSecret) error
+	Delete(secret *BuildSecret) error
+}
+
+var (
+	ErrBuildSecretNotFound = errors.New("build secret not found")
+	ErrBuildSecretNotSet   = errors.New("build secret is not set")
+	ErrInvalidName         = errors.New("invalid build secret name, only letters, digits, '_', '.' and '-' are allowed")
+)
+
+func IsBuildSecretNotFound(err error) bool {
+	return err.Error() == ErrBuildSecretNotFound.Error()
+}
+
+func IsBuildSecretNotSet(err error) bool {
+	return errors.Is(err, ErrBuildSecretNotSet)
+}
+
+func IsInvalidName(err error) bool {
+	return errors.Is(err, ErrInvalidName)
+}
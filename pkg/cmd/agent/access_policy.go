@@ -0,0 +1,44 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// getAccessPolicyFetcher returns a function that fetches the port access policy of the project from the server
+func getAccessPolicyFetcher(c *config.Config, telemetryEnabled bool) func(ctx context.Context) (*project.PortAccessPolicy, error) {
+	return func(ctx context.Context) (*project.PortAccessPolicy, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		policyDTO, res, err := apiClient.WorkspaceAPI.GetProjectAccessPolicy(ctx, c.WorkspaceId, c.ProjectName).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		policy := &project.PortAccessPolicy{
+			Rules:         []project.PortAccessRule{},
+			DefaultAction: project.PortAccessAction(policyDTO.GetDefaultAction()),
+		}
+		for _, rule := range policyDTO.Rules {
+			policy.Rules = append(policy.Rules, project.PortAccessRule{
+				Action:         project.PortAccessAction(rule.Action),
+				PortRangeStart: uint16(rule.GetPortRangeStart()),
+				PortRangeEnd:   uint16(rule.GetPortRangeEnd()),
+				Peers:          rule.Peers,
+			})
+		}
+
+		return policy, nil
+	}
+}
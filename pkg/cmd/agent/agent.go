@@ -6,14 +6,25 @@
 package agent
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 
+	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/pkg/agent"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agent/drift"
 	"github.com/daytonaio/daytona/pkg/agent/ssh"
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/daytonaio/daytona/pkg/agent/tailscale"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/tunnel"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
@@ -21,6 +32,8 @@ import (
 )
 
 var hostModeFlag bool
+var vmModeFlag bool
+var recoveryModeFlag bool
 
 var AgentCmd = &cobra.Command{
 	Use:   "agent",
@@ -35,11 +48,36 @@ var AgentCmd = &cobra.Command{
 			agentMode = config.ModeHost
 		}
 
+		if vmModeFlag {
+			agentMode = config.ModeVM
+		}
+
+		if recoveryModeFlag {
+			agentMode = config.ModeRecovery
+		}
+
 		c, err := config.GetConfig(agentMode)
 		if err != nil {
 			return err
 		}
-		c.ProjectDir = filepath.Join(os.Getenv("HOME"), c.ProjectName)
+
+		homeDir := os.Getenv("HOME")
+
+		var projectUser *agent.ProjectUser
+		if c.Mode == config.ModeVM {
+			projectUser, err = agent.LookupProjectUser(c.ProjectUser)
+			if err != nil {
+				return err
+			}
+
+			err = projectUser.DropPrivileges()
+			if err != nil {
+				return err
+			}
+			homeDir = projectUser.HomeDir
+		}
+
+		c.ProjectDir = filepath.Join(homeDir, c.ProjectName)
 
 		if projectDir := os.Getenv("DAYTONA_PROJECT_DIR"); projectDir != "" {
 			c.ProjectDir = projectDir
@@ -59,36 +97,238 @@ var AgentCmd = &cobra.Command{
 
 		git := &git.Service{
 			ProjectDir:        c.ProjectDir,
-			GitConfigFileName: filepath.Join(os.Getenv("HOME"), ".gitconfig"),
+			GitConfigFileName: filepath.Join(homeDir, ".gitconfig"),
 			LogWriter:         gitLogWriter,
 		}
 
 		sshServer := &ssh.Server{
 			ProjectDir:        c.ProjectDir,
-			DefaultProjectDir: os.Getenv("HOME"),
+			DefaultProjectDir: homeDir,
+		}
+
+		toolboxServer := &toolbox.Server{
+			ProjectDir:    c.ProjectDir,
+			MaxUploadSize: c.MaxUploadSize,
 		}
 
 		tailscaleHostname := project.GetProjectHostname(c.WorkspaceId, c.ProjectName)
+		if c.Hostname != "" {
+			tailscaleHostname = c.Hostname
+		}
 		if hostModeFlag {
 			tailscaleHostname = c.WorkspaceId
 		}
+		if recoveryModeFlag {
+			tailscaleHostname = project.GetProjectRecoveryHostname(c.WorkspaceId, c.ProjectName)
+		}
 
 		telemetryEnabled := os.Getenv("DAYTONA_TELEMETRY_ENABLED") == "true"
 
+		// The previous agent binary supervises the update to a new one instead of running the project
+		if binaryPath := os.Getenv(agent.UpdateSupervisorEnvVar); binaryPath != "" {
+			updater := &agent.Updater{
+				CurrentVersion: internal.Version,
+				BinaryPath:     binaryPath,
+				CheckHealth:    getAgentHealthCheck(c, telemetryEnabled),
+			}
+			return updater.Supervise(cmd.Context())
+		}
+
 		tailscaleServer := &tailscale.Server{
 			Hostname:         tailscaleHostname,
 			Server:           c.Server,
 			TelemetryEnabled: telemetryEnabled,
 			ClientId:         c.ClientId,
+			MetricsPort:      c.MetricsPort,
+			IdleTimeout:      c.ConnIdleTimeout,
+			MaxConnections:   c.MaxConnections,
+			ProxyBufferSize:  c.ProxyBufferSize,
+			ProxyCompression: c.ProxyCompression,
+			HttpProxyPort:    c.HttpProxyPort,
+			// Recovery agents are short lived and must not take over the node of the project agent
+			Persistent: c.PersistentNode && !recoveryModeFlag,
+		}
+
+		// The recovery container is only reachable from the tailnet of the own server
+		if !recoveryModeFlag {
+			tailscaleServer.AdditionalServers = c.AdditionalServers
+		}
+
+		tailscaleServer.PortConnectionLimits, err = tailscale.ParseConnectionLimits(c.PortConnectionLimits)
+		if err != nil {
+			return err
+		}
+
+		tailscaleServer.UnixSockets, err = tailscale.ParseUnixSockets(c.UnixSockets)
+		if err != nil {
+			return err
+		}
+
+		tailscaleServer.UdpPorts, err = tailscale.ParseUdpPorts(c.UdpPorts)
+		if err != nil {
+			return err
+		}
+
+		tailscaleServer.TlsPorts, err = tailscale.ParseTlsPorts(c.TlsPorts)
+		if err != nil {
+			return err
+		}
+
+		tailscaleServer.ProcessChecks = map[string]func(ctx context.Context) error{
+			"ssh": tailscale.ListenerCheck(fmt.Sprintf("localhost:%d", ssh_config.SSH_PORT)),
+		}
+		// The recovery container only serves SSH
+		if !recoveryModeFlag {
+			tailscaleServer.ProcessChecks["toolbox"] = tailscale.ListenerCheck(fmt.Sprintf("localhost:%d", toolbox_config.TOOLBOX_PORT))
+		}
+
+		if projectUser != nil {
+			tailscaleServer.AllowPort = projectUser.AllowPort
+			tailscaleServer.AllowUnixSocket = projectUser.AllowUnixSocket
+		}
+
+		// The ports served by the agent itself are not reported as ports of the project
+		portDetector := &agent.ListeningPortDetector{
+			IgnoredPorts: []uint16{ssh_config.SSH_PORT, ssh_config.SSH_RESUME_PORT, toolbox_config.TOOLBOX_PORT},
+		}
+		if c.ServiceProxyPort != 0 {
+			portDetector.IgnoredPorts = append(portDetector.IgnoredPorts, c.ServiceProxyPort)
+		}
+		if projectUser != nil {
+			portDetector.Uid = &projectUser.Uid
+		}
+
+		// Host and recovery agents do not run the project, so they have no events of it to report
+		var events *agent.EventPublisher
+		var usage *agent.UsageReporter
+		var idleStopper *agent.IdleStopper
+		// Host agents share their binary with the host and recovery agents only live until the project is fixed
+		var updater *agent.Updater
+		if !hostModeFlag && !recoveryModeFlag {
+			events = &agent.EventPublisher{
+				Send: getEventSender(c, telemetryEnabled),
+			}
+			tailscaleServer.PublishEvent = events.Publish
+			usage = &agent.UsageReporter{
+				Send: getUsageSender(c, telemetryEnabled),
+			}
+			if c.IdleTimeout > 0 {
+				idleStopper = &agent.IdleStopper{
+					Timeout: c.IdleTimeout,
+					Stop:    getIdleStopper(c, telemetryEnabled),
+				}
+			}
+
+			binaryPath, err := os.Executable()
+			if err != nil {
+				return err
+			}
+			updater = &agent.Updater{
+				CurrentVersion: internal.Version,
+				BinaryPath:     binaryPath,
+				GetUpdate:      getAgentUpdateFetcher(c, telemetryEnabled),
+				Download:       getAgentDownloader(c),
+				CheckHealth:    getAgentHealthCheck(c, telemetryEnabled),
+			}
 		}
 
 		agent := agent.Agent{
 			Config:           c,
 			Git:              git,
 			Ssh:              sshServer,
-			Tailscale:        tailscaleServer,
+			Toolbox:          toolboxServer,
 			LogWriter:        agentLogWriter,
 			TelemetryEnabled: telemetryEnabled,
+			ProjectUser:      projectUser,
+			Events:           events,
+			Usage:            usage,
+			IdleStopper:      idleStopper,
+			Updater:          updater,
+		}
+
+		if c.Gateway && !recoveryModeFlag {
+			tailscaleServer.GetRoutes = getRoutesFetcher(c, telemetryEnabled)
+		}
+
+		if !hostModeFlag && !recoveryModeFlag {
+			tailscaleServer.GetAccessPolicy = getAccessPolicyFetcher(c, telemetryEnabled)
+			tailscaleServer.GetBandwidthLimit = getBandwidthLimitFetcher(c, telemetryEnabled)
+			tailscaleServer.RenewNetworkKey = getNetworkKeyRenewer(c, telemetryEnabled)
+			tailscaleServer.IssueCertificate = getCertificateIssuer(c, telemetryEnabled)
+
+			sshServer.HostKey, err = fetchSshHostKey(cmd.Context(), c, telemetryEnabled)
+			if err != nil {
+				log.Warnf("Failed to fetch the SSH host key of the project, serving SSH with a generated key: %v", err)
+			}
+
+			agent.Services, err = project.ParseServices(c.Services)
+			if err != nil {
+				return err
+			}
+
+			tailscaleServer.ServiceProxyPort = c.ServiceProxyPort
+			tailscaleServer.ResolveService = getServiceResolver(c, telemetryEnabled)
+
+			if c.TailnetSsh {
+				sshServer.VerifyToken = getSshTokenVerifier(c, telemetryEnabled)
+				tailscaleServer.ServeSsh = sshServer.ServeTailnet
+			}
+
+			if c.TunnelFallbackAttempts >= 0 {
+				tunnelClient := &tunnel.Client{
+					Server:      c.Server,
+					WorkspaceId: c.WorkspaceId,
+					ProjectName: c.ProjectName,
+				}
+				if projectUser != nil {
+					tunnelClient.AllowPort = projectUser.AllowPort
+				}
+				tailscaleServer.Fallback = tunnelClient.Run
+				tailscaleServer.FallbackAttempts = c.TunnelFallbackAttempts
+			}
+		}
+
+		if (c.Networking != string(project.NetworkingAgentless) && c.Networking != string(project.NetworkingRouted)) || recoveryModeFlag {
+			agent.Tailscale = tailscaleServer
+			agent.NodeKeyExpiry = tailscaleServer.NodeKeyExpiry
+		}
+
+		sshCheck := tailscale.ListenerCheck(fmt.Sprintf("localhost:%d", ssh_config.SSH_PORT))
+		agent.ReadyCheck = func(ctx context.Context) error {
+			err := sshCheck(ctx)
+			if err != nil {
+				return fmt.Errorf("ssh server not listening: %w", err)
+			}
+			if agent.Tailscale != nil && !tailscaleServer.Connected() {
+				return errors.New("not connected to the tailnet")
+			}
+			return nil
+		}
+
+		// The recovery container only serves SSH so that the project can be fixed
+		if recoveryModeFlag {
+			agent.Toolbox = nil
+		}
+
+		if !hostModeFlag && !recoveryModeFlag {
+			detector := &activity.Detector{
+				ProjectDir: c.ProjectDir,
+			}
+			sshServer.RecordActivity = func() {
+				detector.Record(activity.SourceSsh)
+			}
+			// Traffic to the ports of the agent itself, e.g. SSH keepalives, does not count as use of the project
+			tailscaleServer.RecordActivity = func(port uint16) {
+				if !slices.Contains(portDetector.IgnoredPorts, port) {
+					detector.Record(activity.SourcePorts)
+				}
+			}
+			agent.Activity = detector
+			agent.PortDetector = portDetector
+			agent.EnvironmentWatcher = &drift.Watcher{
+				ProjectDir: c.ProjectDir,
+				Git:        git,
+			}
 		}
 
 		return agent.Start()
@@ -97,7 +337,12 @@ var AgentCmd = &cobra.Command{
 
 func init() {
 	AgentCmd.Flags().BoolVar(&hostModeFlag, "host", false, "Run the agent in host mode")
+	AgentCmd.Flags().BoolVar(&vmModeFlag, "vm", false, "Run the project directly on the host, without a container")
+	AgentCmd.Flags().BoolVar(&recoveryModeFlag, "recovery", false, "Only serve SSH from the recovery container of a project")
+	AgentCmd.MarkFlagsMutuallyExclusive("host", "vm", "recovery")
 	AgentCmd.AddCommand(logsCmd)
+	AgentCmd.AddCommand(installCmd)
+	AgentCmd.AddCommand(uninstallCmd)
 }
 
 func setLogLevel() {
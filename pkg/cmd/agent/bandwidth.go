@@ -0,0 +1,34 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// getBandwidthLimitFetcher returns a function that fetches the bandwidth limit of the project from the server
+func getBandwidthLimitFetcher(c *config.Config, telemetryEnabled bool) func(ctx context.Context) (*project.BandwidthLimit, error) {
+	return func(ctx context.Context) (*project.BandwidthLimit, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		limitDTO, res, err := apiClient.WorkspaceAPI.GetProjectBandwidthLimit(ctx, c.WorkspaceId, c.ProjectName).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return &project.BandwidthLimit{
+			IngressBytesPerSecond: uint64(max(limitDTO.GetIngressBytesPerSecond(), 0)),
+			EgressBytesPerSecond:  uint64(max(limitDTO.GetEgressBytesPerSecond(), 0)),
+		}, nil
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// getCertificateIssuer returns a function that asks the server to sign a certificate for the tailnet name of the
+// project, which the TLS ports of the agent are served with
+func getCertificateIssuer(c *config.Config, telemetryEnabled bool) func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error) {
+	return func(ctx context.Context, csr string) (*apiclient.ProjectCertificate, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		certificate, res, err := apiClient.WorkspaceAPI.IssueProjectCertificate(ctx, c.WorkspaceId, c.ProjectName).Request(*apiclient.NewIssueProjectCertificateDTO(csr)).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return certificate, nil
+	}
+}
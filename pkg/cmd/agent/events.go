@@ -0,0 +1,42 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// getEventSender returns a function that sends a batch of events published by the agent to the server
+func getEventSender(c *config.Config, telemetryEnabled bool) func(ctx context.Context, reports []agentevent.Report) error {
+	return func(ctx context.Context, reports []agentevent.Report) error {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return err
+		}
+
+		events := []apiclient.AgentEventReport{}
+		for _, report := range reports {
+			event := apiclient.NewAgentEventReport(report.Message, report.OccurredAt.Format(time.RFC3339Nano), apiclient.AgenteventType(report.Type))
+			if report.Port != 0 {
+				event.SetPort(int32(report.Port))
+			}
+			events = append(events, *event)
+		}
+
+		res, err := apiClient.WorkspaceAPI.RecordProjectEvents(ctx, c.WorkspaceId, c.ProjectName).Events(events).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return nil
+	}
+}
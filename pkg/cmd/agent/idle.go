@@ -0,0 +1,30 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+)
+
+// getIdleStopper returns a function that asks the server to stop the workspace of the idle project
+func getIdleStopper(c *config.Config, telemetryEnabled bool) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.WorkspaceAPI.StopIdleWorkspace(ctx, c.WorkspaceId, c.ProjectName).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// getNetworkKeyRenewer returns a function that asks the server for a network key to register the tailnet node of
+// the project again before its node key expires
+func getNetworkKeyRenewer(c *config.Config, telemetryEnabled bool) func(ctx context.Context, persistent bool) (*apiclient.NetworkKey, error) {
+	return func(ctx context.Context, persistent bool) (*apiclient.NetworkKey, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		key, res, err := apiClient.WorkspaceAPI.RenewProjectNetworkKey(ctx, c.WorkspaceId, c.ProjectName).Persistent(persistent).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return key, nil
+	}
+}
@@ -0,0 +1,41 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// getRoutesFetcher returns a function that fetches the routing table of the gateway node from the server
+func getRoutesFetcher(c *config.Config, telemetryEnabled bool) func(ctx context.Context) ([]project.RoutingEntry, error) {
+	return func(ctx context.Context) ([]project.RoutingEntry, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		routesDTO, res, err := apiClient.WorkspaceAPI.GetProjectRoutes(ctx, c.WorkspaceId, c.ProjectName).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		routes := []project.RoutingEntry{}
+		for _, route := range routesDTO {
+			routes = append(routes, project.RoutingEntry{
+				Project:        route.Project,
+				Address:        route.Address,
+				PortRangeStart: uint16(route.PortRangeStart),
+				PortRangeEnd:   uint16(route.PortRangeEnd),
+			})
+		}
+
+		return routes, nil
+	}
+}
@@ -0,0 +1,146 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/agent"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/kardianos/service"
+	"github.com/spf13/cobra"
+)
+
+const agentServiceName = "daytona-agent"
+
+type program struct {
+	service.Interface
+}
+
+var installCmd = &cobra.Command{
+	Use:   "install",
+	Short: "Install the agent as a systemd service that runs the project directly on the host",
+	Long:  "Install the agent as a systemd service that runs the project directly on the host.\nThe service runs as DAYTONA_PROJECT_USER and the DAYTONA_* environment variables of the current shell are stored in the service definition.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig(config.ModeVM)
+		if err != nil {
+			return err
+		}
+
+		projectUser, err := agent.LookupProjectUser(c.ProjectUser)
+		if err != nil {
+			return err
+		}
+
+		// Projects set up by previous versions of the agent service were owned by root
+		projectDir := filepath.Join(projectUser.HomeDir, c.ProjectName)
+		if dir := os.Getenv("DAYTONA_PROJECT_DIR"); dir != "" {
+			projectDir = dir
+		}
+		if _, err := os.Stat(projectDir); err == nil {
+			err = projectUser.Chown(projectDir)
+			if err != nil {
+				return fmt.Errorf("failed to change the owner of the project directory: %w", err)
+			}
+		}
+
+		if c.LogFilePath != nil {
+			err = projectUser.Chown(*c.LogFilePath)
+			if err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to change the owner of the agent log file: %w", err)
+			}
+		}
+
+		s, err := getAgentService(projectUser.Username)
+		if err != nil {
+			return err
+		}
+
+		err = s.Install()
+		if err != nil {
+			return fmt.Errorf("failed to install the agent service: %w", err)
+		}
+
+		err = s.Start()
+		if err != nil {
+			return fmt.Errorf("failed to start the agent service: %w", err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("The agent service %s has been installed and started", agentServiceName))
+		return nil
+	},
+}
+
+var uninstallCmd = &cobra.Command{
+	Use:   "uninstall",
+	Short: "Stop and uninstall the agent systemd service",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := getAgentService("")
+		if err != nil {
+			return err
+		}
+
+		status, err := s.Status()
+		if err != nil {
+			return fmt.Errorf("failed to get the agent service status: %w", err)
+		}
+
+		if status == service.StatusRunning {
+			err = s.Stop()
+			if err != nil {
+				return fmt.Errorf("failed to stop the agent service: %w", err)
+			}
+		}
+
+		err = s.Uninstall()
+		if err != nil {
+			return fmt.Errorf("failed to uninstall the agent service: %w", err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("The agent service %s has been uninstalled", agentServiceName))
+		return nil
+	},
+}
+
+// getAgentService returns the agent service that runs as the user. The user is only needed to install the service
+func getAgentService(userName string) (service.Service, error) {
+	if runtime.GOOS != "linux" || !strings.HasSuffix(service.Platform(), "systemd") {
+		return nil, fmt.Errorf("the agent service is only supported on Linux with systemd. %s detected", service.Platform())
+	}
+
+	// Installing a systemd service requires root, the service itself runs as the project user
+	if os.Getuid() != 0 {
+		return nil, errors.New("the agent service must be installed as root")
+	}
+
+	envVars := map[string]string{}
+	for _, envVar := range os.Environ() {
+		key, value, _ := strings.Cut(envVar, "=")
+		if strings.HasPrefix(key, "DAYTONA_") || key == "AGENT_LOG_LEVEL" {
+			envVars[key] = value
+		}
+	}
+
+	return service.New(program{}, &service.Config{
+		Name:        agentServiceName,
+		DisplayName: "Daytona Agent",
+		Description: "Daytona Agent running the project directly on the host.",
+		Arguments:   []string{"agent", "--vm"},
+		UserName:    userName,
+		EnvVars:     envVars,
+		Option: service.KeyValue{
+			"Restart": "always",
+		},
+	})
+}
@@ -0,0 +1,41 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// getServiceResolver returns a function that resolves service names with the server, preferring the services
+// of the workspace of the agent
+func getServiceResolver(c *config.Config, telemetryEnabled bool) func(ctx context.Context, name string) (*project.ServiceEndpoint, error) {
+	return func(ctx context.Context, name string) (*project.ServiceEndpoint, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint, res, err := apiClient.WorkspaceAPI.ResolveService(ctx, name).WorkspaceId(c.WorkspaceId).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return &project.ServiceEndpoint{
+			Name:          endpoint.Name,
+			Service:       endpoint.Service,
+			WorkspaceId:   endpoint.WorkspaceId,
+			WorkspaceName: endpoint.WorkspaceName,
+			ProjectName:   endpoint.ProjectName,
+			Port:          uint16(endpoint.Port),
+			Address:       endpoint.Address,
+			Protocol:      project.ServiceProtocol(endpoint.Protocol),
+		}, nil
+	}
+}
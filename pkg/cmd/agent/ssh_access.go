@@ -0,0 +1,33 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// getSshTokenVerifier returns a function that asks the server whether an SSH token grants access to the project
+func getSshTokenVerifier(c *config.Config, telemetryEnabled bool) func(ctx context.Context, token string) error {
+	return func(ctx context.Context, token string) error {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.WorkspaceAPI.VerifySshAccess(ctx, c.WorkspaceId, c.ProjectName).VerifySshAccess(apiclient.VerifySshAccessDTO{
+			Token: token,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return nil
+	}
+}
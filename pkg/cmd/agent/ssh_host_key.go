@@ -0,0 +1,29 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+)
+
+// fetchSshHostKey fetches the SSH host key of the project from the server. The key is only handed out to the agent
+// of the project, so it is kept out of the environment of the project processes
+func fetchSshHostKey(ctx context.Context, c *config.Config, telemetryEnabled bool) (string, error) {
+	apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+	if err != nil {
+		return "", err
+	}
+
+	hostKey, res, err := apiClient.WorkspaceAPI.GetProjectSshHostKey(ctx, c.WorkspaceId, c.ProjectName).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return hostKey.Key, nil
+}
@@ -0,0 +1,105 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"runtime"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+)
+
+// Name of the binary the server serves for the platform of the agent
+var agentBinaryName = fmt.Sprintf("daytona-%s-%s", runtime.GOOS, runtime.GOARCH)
+
+// getAgentUpdateFetcher returns a function that asks the server for the version the agent of the project should run
+func getAgentUpdateFetcher(c *config.Config, telemetryEnabled bool) func(ctx context.Context) (*agent.AgentUpdate, error) {
+	return func(ctx context.Context) (*agent.AgentUpdate, error) {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return nil, err
+		}
+
+		update, res, err := apiClient.WorkspaceAPI.GetProjectAgentUpdate(ctx, c.WorkspaceId, c.ProjectName).BinaryName(agentBinaryName).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return &agent.AgentUpdate{
+			Version:  update.Version,
+			Checksum: update.Checksum,
+		}, nil
+	}
+}
+
+// getAgentHealthCheck returns a function that returns nil once the agent of the version reported the project ready
+// to the server
+func getAgentHealthCheck(c *config.Config, telemetryEnabled bool) func(ctx context.Context, version string) error {
+	return func(ctx context.Context, version string) error {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return err
+		}
+
+		workspace, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, c.WorkspaceId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		for _, project := range workspace.Projects {
+			if project.Name != c.ProjectName {
+				continue
+			}
+
+			state := project.GetState()
+			if state.GetAgentVersion() != version {
+				return fmt.Errorf("agent %s did not report the state of the project yet", version)
+			}
+			if !state.GetReady() {
+				return fmt.Errorf("agent %s did not report the project ready", version)
+			}
+			return nil
+		}
+
+		return errors.New("project not found")
+	}
+}
+
+// getAgentDownloader returns a function that downloads the agent binary of a version from the server
+func getAgentDownloader(c *config.Config) func(ctx context.Context, version string, w io.Writer) error {
+	return func(ctx context.Context, version string, w io.Writer) error {
+		downloadUrl, err := url.JoinPath(c.Server.ApiUrl, "binary", version, agentBinaryName)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Server.ApiKey))
+
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to download agent %s: %s", version, res.Status)
+		}
+
+		_, err = io.Copy(w, res.Body)
+		return err
+	}
+}
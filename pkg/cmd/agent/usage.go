@@ -0,0 +1,49 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"math"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+)
+
+// getUsageSender returns a function that sends a batch of resource usage samples of the project to the server
+func getUsageSender(c *config.Config, telemetryEnabled bool) func(ctx context.Context, reports []resourceusage.Report) error {
+	return func(ctx context.Context, reports []resourceusage.Report) error {
+		apiClient, err := apiclient_util.GetAgentApiClient(c.Server.ApiUrl, c.Server.ApiKey, c.ClientId, telemetryEnabled)
+		if err != nil {
+			return err
+		}
+
+		usage := []apiclient.ResourceUsageReport{}
+		for _, report := range reports {
+			sample := apiclient.NewResourceUsageReport(float32(report.Cpus), toInt32(report.DiskTotal), toInt32(report.DiskUsed),
+				toInt32(report.InodesTotal), toInt32(report.InodesUsed), toInt32(report.MemoryUsed), report.SampledAt.Format(time.RFC3339Nano))
+			if report.MemoryLimit != 0 {
+				sample.SetMemoryLimit(toInt32(report.MemoryLimit))
+			}
+			usage = append(usage, *sample)
+		}
+
+		res, err := apiClient.WorkspaceAPI.RecordProjectUsage(ctx, c.WorkspaceId, c.ProjectName).Usage(usage).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return nil
+	}
+}
+
+// toInt32 caps the value to the integers of the API, filesystems with dynamic inode allocation report billions of inodes
+func toInt32(value uint64) int32 {
+	return int32(min(value, math.MaxInt32))
+}
@@ -4,14 +4,12 @@
 package apikey
 
 import (
-	"context"
 	"errors"
 
 	"github.com/spf13/cobra"
 
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
-	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views/apikey"
 	view "github.com/daytonaio/daytona/pkg/views/apikey"
 )
@@ -22,7 +20,7 @@ var GenerateCmd = &cobra.Command{
 	Aliases: []string{"g", "new"},
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var keyName string
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -47,12 +45,12 @@ var GenerateCmd = &cobra.Command{
 			}
 		}
 
-		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).Execute()
+		key, _, err := apiClient.ApiKeyAPI.GenerateApiKey(ctx, keyName).Admin(adminFlag).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(nil, err)
 		}
 
-		serverConfig, _, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+		serverConfig, _, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
 		if err != nil {
 			return err
 		}
@@ -67,3 +65,9 @@ var GenerateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+var adminFlag bool
+
+func init() {
+	GenerateCmd.Flags().BoolVar(&adminFlag, "admin", false, "Generate a key that can manage server-wide resources, e.g. announcements and regions. Only administrators can generate admin keys")
+}
@@ -4,8 +4,6 @@
 package apikey
 
 import (
-	"context"
-
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
 	"github.com/daytonaio/daytona/pkg/views/apikey"
@@ -17,7 +15,7 @@ var listCmd = &cobra.Command{
 	Short:   "List API keys",
 	Aliases: []string{"ls"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient.GetApiClient(nil)
 		if err != nil {
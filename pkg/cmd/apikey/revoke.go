@@ -4,7 +4,6 @@
 package apikey
 
 import (
-	"context"
 	"errors"
 	"fmt"
 
@@ -28,7 +27,7 @@ var revokeCmd = &cobra.Command{
 	Aliases: []string{"r", "rm", "delete"},
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		c, err := config.GetConfig()
 		if err != nil {
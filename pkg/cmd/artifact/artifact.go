@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var ArtifactCmd = &cobra.Command{
+	Use:     "artifacts",
+	Aliases: []string{"artifact"},
+	Short:   "Manage project artifacts",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	ArtifactCmd.AddCommand(artifactListCmd)
+	ArtifactCmd.AddCommand(artifactDownloadCmd)
+	ArtifactCmd.AddCommand(artifactDeleteCmd)
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var artifactDeleteCmd = &cobra.Command{
+	Use:     "delete [ARTIFACT_ID]",
+	Short:   "Delete an artifact",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"remove", "rm"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.ArtifactAPI.DeleteArtifact(context.Background(), args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage("Artifact deleted")
+		return nil
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var outputDirFlag string
+
+var artifactDownloadCmd = &cobra.Command{
+	Use:   "download [ARTIFACT_ID]",
+	Short: "Download an artifact",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		artifact, res, err := apiClient.ArtifactAPI.GetArtifact(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		content, res, err := apiClient.ArtifactAPI.DownloadArtifact(ctx, artifact.Id).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		defer os.Remove(content.Name())
+		defer content.Close()
+
+		destination := filepath.Join(outputDirFlag, filepath.FromSlash(artifact.Path))
+
+		err = os.MkdirAll(filepath.Dir(destination), 0755)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Create(destination)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(file, content)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Artifact downloaded to %s", destination))
+		return nil
+	},
+}
+
+func init() {
+	artifactDownloadCmd.Flags().StringVarP(&outputDirFlag, "output", "o", ".", "Directory to download the artifact to")
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/artifact"
+	"github.com/spf13/cobra"
+)
+
+var projectNameFlag string
+
+var artifactListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE]",
+	Short:   "List artifacts",
+	Args:    cobra.RangeArgs(0, 1),
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.ArtifactAPI.ListArtifacts(ctx)
+
+		if len(args) > 0 {
+			workspace, err := apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+			req = req.WorkspaceId(workspace.Id)
+		}
+
+		if projectNameFlag != "" {
+			req = req.ProjectName(projectNameFlag)
+		}
+
+		artifactList, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(artifactList)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListArtifacts(artifactList)
+		return nil
+	},
+}
+
+func init() {
+	artifactListCmd.Flags().StringVarP(&projectNameFlag, "project", "p", "", "Filter artifacts by project name")
+	format.RegisterFormatFlag(artifactListCmd)
+}
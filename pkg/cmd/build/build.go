@@ -21,4 +21,5 @@ func init() {
 	BuildCmd.AddCommand(buildRunCmd)
 	BuildCmd.AddCommand(buildDeleteCmd)
 	BuildCmd.AddCommand(buildLogsCmd)
+	BuildCmd.AddCommand(buildPriorityCmd)
 }
@@ -4,7 +4,6 @@
 package build
 
 import (
-	"context"
 	"fmt"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
@@ -20,7 +19,7 @@ var buildDeleteCmd = &cobra.Command{
 	Aliases: []string{"remove", "rm"},
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var buildId string
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -22,7 +22,7 @@ var buildInfoCmd = &cobra.Command{
 	Aliases: []string{"view", "inspect"},
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var build *apiclient.Build
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
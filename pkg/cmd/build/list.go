@@ -18,7 +18,7 @@ var buildListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
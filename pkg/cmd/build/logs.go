@@ -4,7 +4,6 @@
 package build
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -35,7 +34,7 @@ var buildLogsCmd = &cobra.Command{
 			query += "follow=true"
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var buildId string
 
 		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"fmt"
+	"slices"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var buildPriorityCmd = &cobra.Command{
+	Use:   "priority BUILD PRIORITY",
+	Short: "Set the priority of a build",
+	Long:  "Set the priority of a build to low, normal or high. Prebuilds run with low priority and builds started by users with normal priority. When the build runner is at capacity, pending builds preempt running builds of a lower priority. Only server administrators can set the priority of builds.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		priority := apiclient.BuildBuildPriority(args[1])
+		if !slices.Contains(apiclient.AllowedBuildBuildPriorityEnumValues, priority) {
+			return fmt.Errorf("invalid priority %s, must be one of low, normal or high", args[1])
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.BuildAPI.SetBuildPriority(ctx, args[0]).SetBuildPriorityDto(*apiclient.NewSetBuildPriorityDTO(string(priority))).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Priority of build %s set to %s", args[0], priority))
+		return nil
+	},
+}
@@ -24,7 +24,7 @@ var buildRunCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var projectConfig *apiclient.ProjectConfig
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var BuildSecretCmd = &cobra.Command{
+	Use:     "build-secret",
+	Aliases: []string{"build-secrets"},
+	Short:   "Manage secrets mounted into builds",
+	Long:    "Manage secrets that builds of project configs and prebuilds can read through BuildKit secret mounts, e.g. RUN --mount=type=secret,id=NAME. The values of build secrets are never stored in the built images.",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	BuildSecretCmd.AddCommand(buildSecretListCmd)
+	BuildSecretCmd.AddCommand(buildSecretSetCmd)
+	BuildSecretCmd.AddCommand(buildSecretDeleteCmd)
+}
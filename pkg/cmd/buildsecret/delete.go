@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var buildSecretDeleteCmd = &cobra.Command{
+	Use:     "delete NAME",
+	Aliases: []string{"remove", "rm"},
+	Short:   "Delete a build secret",
+	Long:    "Delete a build secret. Builds of project configs and prebuilds that still mount the secret fail until it is set again.",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.BuildSecretAPI.RemoveBuildSecret(context.Background(), args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Build secret %s deleted successfully", args[0]))
+		return nil
+	},
+}
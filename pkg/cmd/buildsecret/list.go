@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	buildsecret_view "github.com/daytonaio/daytona/pkg/views/buildsecret"
+	"github.com/spf13/cobra"
+)
+
+var buildSecretListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "Lists build secrets",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		buildSecrets, res, err := apiClient.BuildSecretAPI.ListBuildSecrets(context.Background()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(buildSecrets)
+			formattedData.Print()
+			return nil
+		}
+
+		buildsecret_view.ListBuildSecrets(buildSecrets)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(buildSecretListCmd)
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var valueFileFlag string
+
+var buildSecretSetCmd = &cobra.Command{
+	Use:     "set NAME",
+	Short:   "Set the value of a build secret",
+	Long:    "Set the value of a build secret. The value is prompted for unless it is read from a file with --value-file, use '-' to read it from stdin.",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"add", "update"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		value, err := readValue(name)
+		if err != nil {
+			return err
+		}
+
+		if value == "" {
+			return errors.New("the value of a build secret can not be empty")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.BuildSecretAPI.SetBuildSecret(context.Background(), name).BuildSecret(apiclient.SetBuildSecretDTO{
+			Value: value,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Build secret %s set successfully", name))
+		return nil
+	},
+}
+
+func readValue(name string) (string, error) {
+	if valueFileFlag != "" {
+		var data []byte
+		var err error
+		if valueFileFlag == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(valueFileFlag)
+		}
+		return string(data), err
+	}
+
+	var value string
+	err := huh.NewInput().
+		Title(fmt.Sprintf("Value of %s", name)).
+		EchoMode(huh.EchoModePassword).
+		Value(&value).
+		WithTheme(views.GetCustomTheme()).
+		Run()
+
+	return value, err
+}
+
+func init() {
+	buildSecretSetCmd.Flags().StringVar(&valueFileFlag, "value-file", "", "Read the value from a file, e.g. an SSH key, or from stdin if '-'")
+}
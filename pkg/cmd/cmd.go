@@ -14,20 +14,29 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
 	. "github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	. "github.com/daytonaio/daytona/pkg/cmd/apikey"
+	. "github.com/daytonaio/daytona/pkg/cmd/artifact"
 	. "github.com/daytonaio/daytona/pkg/cmd/autocomplete"
 	. "github.com/daytonaio/daytona/pkg/cmd/build"
+	. "github.com/daytonaio/daytona/pkg/cmd/buildsecret"
 	. "github.com/daytonaio/daytona/pkg/cmd/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
+	. "github.com/daytonaio/daytona/pkg/cmd/network"
+	. "github.com/daytonaio/daytona/pkg/cmd/notifications"
+	. "github.com/daytonaio/daytona/pkg/cmd/organization"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
 	. "github.com/daytonaio/daytona/pkg/cmd/prebuild"
 	. "github.com/daytonaio/daytona/pkg/cmd/profile"
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
+	. "github.com/daytonaio/daytona/pkg/cmd/projectcommand"
 	. "github.com/daytonaio/daytona/pkg/cmd/projectconfig"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
+	. "github.com/daytonaio/daytona/pkg/cmd/sharedservice"
 	. "github.com/daytonaio/daytona/pkg/cmd/target"
 	. "github.com/daytonaio/daytona/pkg/cmd/telemetry"
+	. "github.com/daytonaio/daytona/pkg/cmd/urlhandler"
 	. "github.com/daytonaio/daytona/pkg/cmd/workspace"
 	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
@@ -38,6 +47,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const interruptGracePeriod = 3 * time.Second
+
 var rootCmd = &cobra.Command{
 	Use:               "daytona",
 	Short:             "Daytona is a Dev Environment Manager",
@@ -54,18 +65,27 @@ func Execute() error {
 	rootCmd.AddGroup(&cobra.Group{ID: PROFILE_GROUP, Title: "Profile"})
 
 	rootCmd.AddCommand(CodeCmd)
+	rootCmd.AddCommand(OpenCmd)
 	rootCmd.AddCommand(SshCmd)
 	rootCmd.AddCommand(SshProxyCmd)
+	rootCmd.AddCommand(SshTokenCmd)
+	rootCmd.AddCommand(GuiCmd)
+	rootCmd.AddCommand(ProjectCommandCmd)
 	rootCmd.AddCommand(CreateCmd)
+	rootCmd.AddCommand(quickstartCmd)
+	rootCmd.AddCommand(AdoptCmd)
 	rootCmd.AddCommand(DeleteCmd)
 	rootCmd.AddCommand(ProjectConfigCmd)
 	rootCmd.AddCommand(ServeCmd)
 	rootCmd.AddCommand(DaemonServeCmd)
 	rootCmd.AddCommand(ServerCmd)
 	rootCmd.AddCommand(ApiKeyCmd)
+	rootCmd.AddCommand(OrganizationCmd)
 	rootCmd.AddCommand(ContainerRegistryCmd)
+	rootCmd.AddCommand(BuildSecretCmd)
 	rootCmd.AddCommand(ProviderCmd)
 	rootCmd.AddCommand(TargetCmd)
+	rootCmd.AddCommand(SharedServiceCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(ideCmd)
 	rootCmd.AddCommand(ProfileCmd)
@@ -74,14 +94,28 @@ func Execute() error {
 	rootCmd.AddCommand(purgeCmd)
 	rootCmd.AddCommand(GitProviderCmd)
 	rootCmd.AddCommand(StartCmd)
+	rootCmd.AddCommand(RebuildCmd)
+	rootCmd.AddCommand(UpdateCmd)
+	rootCmd.AddCommand(RecoverCmd)
 	rootCmd.AddCommand(StopCmd)
 	rootCmd.AddCommand(RestartCmd)
 	rootCmd.AddCommand(InfoCmd)
+	rootCmd.AddCommand(DiffCmd)
+	rootCmd.AddCommand(ExportCmd)
+	rootCmd.AddCommand(StateHistoryCmd)
 	rootCmd.AddCommand(PrebuildCmd)
 	rootCmd.AddCommand(BuildCmd)
+	rootCmd.AddCommand(ArtifactCmd)
 	rootCmd.AddCommand(PortForwardCmd)
+	rootCmd.AddCommand(PortsCmd)
+	rootCmd.AddCommand(DebugCmd)
+	rootCmd.AddCommand(ServicesCmd)
+	rootCmd.AddCommand(SyncCmd)
+	rootCmd.AddCommand(NetworkCmd)
+	rootCmd.AddCommand(NotificationsCmd)
 	rootCmd.AddCommand(EnvCmd)
 	rootCmd.AddCommand(TelemetryCmd)
+	rootCmd.AddCommand(UrlHandlerCmd)
 
 	SetupRootCommand(rootCmd)
 
@@ -95,7 +129,15 @@ func Execute() error {
 		return cmd.Help()
 	}
 
-	err = rootCmd.Execute()
+	if !isCompletion {
+		FetchPendingNotifications(cmd)
+	}
+
+	err = rootCmd.ExecuteContext(apiclient.InterruptContext())
+
+	if !isCompletion {
+		ShowPendingNotifications(cmd)
+	}
 
 	endTime := time.Now()
 
@@ -156,6 +198,7 @@ func SetupRootCommand(cmd *cobra.Command) {
 
 	cmd.CompletionOptions.HiddenDefaultCmd = true
 	cmd.PersistentFlags().BoolP("help", "", false, "help for daytona")
+	cmd.PersistentFlags().StringVar(&apiclient.OrganizationFlag, "org", "", "Organization to scope the command to (overrides the profile organization)")
 	cmd.Flags().BoolP("version", "v", false, "Display the version of Daytona")
 
 	cmd.PreRun = func(cmd *cobra.Command, args []string) {
@@ -183,6 +226,8 @@ func RunInitialScreenFlow(cmd *cobra.Command, args []string) error {
 	switch command {
 	case "server":
 		return ServerCmd.RunE(cmd, []string{})
+	case "quickstart":
+		return quickstartCmd.RunE(cmd, []string{})
 	case "create":
 		return CreateCmd.RunE(cmd, []string{})
 	case "code":
@@ -255,31 +300,51 @@ func PreRun(rootCmd *cobra.Command, args []string, telemetryEnabled bool, client
 		if err != nil {
 			log.Trace(err)
 		}
+	}
 
-		go func() {
-			interruptChannel := make(chan os.Signal, 1)
-			signal.Notify(interruptChannel, os.Interrupt)
-
-			for range interruptChannel {
-				endTime := time.Now()
-				execTime := endTime.Sub(startTime)
-				props := GetCmdTelemetryData(cmd, flags)
-				props["exec time (µs)"] = execTime.Microseconds()
-				props["error"] = "interrupted"
-
-				err := telemetryService.TrackCliEvent(telemetry.CliEventCmdEnd, clientId, props)
-				if err != nil {
-					log.Trace(err)
-				}
-				telemetryService.Close()
-				os.Exit(0)
-			}
-		}()
+	if !isCompletion && !handlesInterrupts(cmd) {
+		go handleInterrupt(cmd, flags, telemetryService, clientId, startTime)
 	}
 
 	return telemetryService, cmd, flags, isCompletion, nil
 }
 
+// handlesInterrupts reports whether the command is long-running and shuts down gracefully on its own
+func handlesInterrupts(cmd *cobra.Command) bool {
+	return strings.HasSuffix(cmd.CommandPath(), "serve") || cmd.CommandPath() == cmd.Root().Name()+" agent"
+}
+
+// handleInterrupt cancels pending API requests on the first interrupt so the
+// command can return on its own. The process exits if it has not done so after
+// a grace period or on a second interrupt.
+func handleInterrupt(cmd *cobra.Command, flags []string, telemetryService telemetry.TelemetryService, clientId string, startTime time.Time) {
+	interruptChannel := make(chan os.Signal, 1)
+	signal.Notify(interruptChannel, os.Interrupt)
+
+	<-interruptChannel
+	apiclient.CancelRequests()
+
+	select {
+	case <-interruptChannel:
+	case <-time.After(interruptGracePeriod):
+	}
+
+	if telemetryService != nil {
+		execTime := time.Since(startTime)
+		props := GetCmdTelemetryData(cmd, flags)
+		props["exec time (µs)"] = execTime.Microseconds()
+		props["error"] = "interrupted"
+
+		err := telemetryService.TrackCliEvent(telemetry.CliEventCmdEnd, clientId, props)
+		if err != nil {
+			log.Trace(err)
+		}
+		telemetryService.Close()
+	}
+
+	os.Exit(0)
+}
+
 func PostRun(cmd *cobra.Command, cmdErr error, telemetryService telemetry.TelemetryService, clientId string, startTime time.Time, endTime time.Time, flags []string) {
 	if telemetryService != nil && !strings.HasSuffix(cmd.CommandPath(), "daemon-serve") {
 		execTime := endTime.Sub(startTime)
@@ -4,7 +4,6 @@
 package gitprovider
 
 import (
-	"context"
 	"fmt"
 	"slices"
 	"strings"
@@ -24,7 +23,7 @@ var GitProviderAddCmd = &cobra.Command{
 	Short:   "Register a Git provider",
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
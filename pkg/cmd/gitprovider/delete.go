@@ -21,7 +21,7 @@ var gitProviderDeleteCmd = &cobra.Command{
 	Aliases: []string{"remove", "rm"},
 	Short:   "Unregister a Git provider",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
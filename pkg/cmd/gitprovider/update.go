@@ -4,8 +4,6 @@
 package gitprovider
 
 import (
-	"context"
-
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -20,7 +18,7 @@ var gitProviderUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Update a Git provider",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -69,11 +69,16 @@ var ideCmd = &cobra.Command{
 			}
 		}
 
-		c.DefaultIdeId = chosenIde.Id
-
 		telemetry.AdditionalData["ide"] = chosenIde.Id
 
-		err = c.Save()
+		// A default IDE set on the active profile takes precedence, so update it instead of the global default
+		activeProfile, err := c.GetActiveProfile()
+		if err == nil && activeProfile.DefaultIdeId != "" {
+			activeProfile.DefaultIdeId = chosenIde.Id
+			err = c.EditProfile(activeProfile)
+		} else {
+			err = c.SetDefaultIde(chosenIde.Id)
+		}
 		if err != nil {
 			return err
 		}
@@ -6,27 +6,40 @@ package cmd
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
+	events_view "github.com/daytonaio/daytona/pkg/views/workspace/events"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 	"github.com/spf13/cobra"
 )
 
 var followFlag bool
 var workspaceFlag bool
+var eventsFlag bool
+var eventTypeFlag string
+var eventsSinceFlag string
+
+// Interval at which new events are fetched while events are followed
+const eventsPollInterval = 2 * time.Second
 
 var logsCmd = &cobra.Command{
-	Use:     "logs [WORKSPACE] [PROJECT_NAME]",
-	Short:   "View logs for a workspace/project",
+	Use:   "logs [WORKSPACE] [PROJECT_NAME]",
+	Short: "View logs for a workspace/project",
+	Long: `View logs for a workspace/project.
+
+Use --events to view the lifecycle and network events reported by the project agents instead, e.g. reconnects to the tailnet, ports opened or closed by the project, proxy errors, OOM kills and disk pressure.`,
 	Args:    cobra.RangeArgs(0, 2),
 	GroupID: util.WORKSPACE_GROUP,
 	Aliases: []string{"lg", "log"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		c, err := config.GetConfig()
 		if err != nil {
 			return err
@@ -97,6 +110,14 @@ var logsCmd = &cobra.Command{
 			})
 		}
 
+		if eventsFlag {
+			var projectName string
+			if len(args) == 2 {
+				projectName = args[1]
+			}
+			return readEvents(ctx, apiClient, workspace, projectName)
+		}
+
 		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, followFlag, showWorkspaceLogs, nil)
 
 		return nil
@@ -106,4 +127,70 @@ var logsCmd = &cobra.Command{
 func init() {
 	logsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
 	logsCmd.Flags().BoolVarP(&workspaceFlag, "workspace", "w", false, "View workspace logs")
+	logsCmd.Flags().BoolVar(&eventsFlag, "events", false, "View the events reported by the project agents instead of logs")
+	logsCmd.Flags().StringVar(&eventTypeFlag, "type", "", "Only show events of the type, e.g. proxy-error. Requires --events")
+	logsCmd.Flags().StringVar(&eventsSinceFlag, "since", "24h", "Only show events that occurred within the duration. Requires --events")
+}
+
+// readEvents prints the events of the workspace, or of the project if set. New events are printed as they are
+// recorded if the events are followed
+func readEvents(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO, projectName string) error {
+	if eventTypeFlag != "" && !slices.Contains(apiclient.AllowedAgenteventTypeEnumValues, apiclient.AgenteventType(eventTypeFlag)) {
+		return fmt.Errorf("invalid event type %s", eventTypeFlag)
+	}
+
+	listEvents := func(since string) ([]apiclient.AgentEvent, error) {
+		req := apiClient.WorkspaceAPI.ListWorkspaceEvents(ctx, workspace.Id).Since(since)
+		if projectName != "" {
+			req = req.Project(projectName)
+		}
+		if eventTypeFlag != "" {
+			req = req.Type_(eventTypeFlag)
+		}
+
+		events, res, err := req.Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return events, nil
+	}
+
+	events, err := listEvents(eventsSinceFlag)
+	if err != nil {
+		return err
+	}
+
+	if !followFlag {
+		events_view.ListEvents(workspace.Name, events)
+		return nil
+	}
+
+	seen := map[string]bool{}
+	lastPoll := time.Now()
+
+	for {
+		for _, event := range events {
+			if !seen[event.Id] {
+				seen[event.Id] = true
+				events_view.RenderEvent(event)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(eventsPollInterval):
+		}
+
+		// Agents send their events in batches every few seconds, so events that occurred shortly before the last
+		// poll may only be recorded after it
+		since := time.Since(lastPoll) + time.Minute
+		lastPoll = time.Now()
+
+		events, err = listEvents(since.String())
+		if err != nil {
+			return err
+		}
+	}
 }
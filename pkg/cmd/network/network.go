@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var NetworkCmd = &cobra.Command{
+	Use:     "network",
+	Short:   "Diagnose the connection to workspaces",
+	Args:    cobra.NoArgs,
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	NetworkCmd.AddCommand(networkTestCmd)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	network_view "github.com/daytonaio/daytona/pkg/views/network"
+	"github.com/spf13/cobra"
+)
+
+var pingsFlag int
+var sizeFlag int64
+
+var networkTestCmd = &cobra.Command{
+	Use:   "test [WORKSPACE] [PROJECT]",
+	Short: "Measure latency, connection path and throughput to a project",
+	Long:  "Ping the project agent over the Daytona network, report whether the connection is direct or relayed through DERP and measure the throughput in both directions.",
+	Args:  cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if pingsFlag < 1 {
+			return errors.New("at least one ping is required")
+		}
+		if sizeFlag < 0 || sizeFlag*1024*1024 > toolbox_config.MAX_NETWORK_TEST_SIZE {
+			return fmt.Errorf("size must be between 0 and %d megabytes", toolbox_config.MAX_NETWORK_TEST_SIZE/1024/1024)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		var projectName string
+		if len(args) == 2 {
+			projectName = args[1]
+		} else {
+			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspace.Id, projectName, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if format.FormatFlag == "" {
+			views.RenderInfoMessage("Testing the connection to " + projectName + "...")
+		}
+
+		result, err := tailscale.TestNetwork(cmd.Context(), activeProfile, workspace.Id, projectName, tailscale.NetworkTestOptions{
+			Pings: pingsFlag,
+			Size:  sizeFlag * 1024 * 1024,
+		})
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(result)
+			formattedData.Print()
+			return nil
+		}
+
+		network_view.RenderTestResult(projectName, result)
+		return nil
+	},
+}
+
+func init() {
+	networkTestCmd.Flags().IntVar(&pingsFlag, "pings", 10, "Number of pings sent to the project agent")
+	networkTestCmd.Flags().Int64Var(&sizeFlag, "size", 10, "Megabytes transferred in each direction of the throughput test, 0 skips the test")
+	format.RegisterFormatFlag(networkTestCmd)
+}
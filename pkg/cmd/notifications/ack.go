@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var ackAllFlag bool
+
+var notificationsAckCmd = &cobra.Command{
+	Use:   "ack [ID]...",
+	Short: "Acknowledge server announcements",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !ackAllFlag {
+			return errors.New("specify the announcements to acknowledge or use --all")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		announcements, res, err := apiClient.AnnouncementAPI.ListAnnouncements(cmd.Context()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		activeIds := []string{}
+		for _, a := range announcements {
+			activeIds = append(activeIds, a.Id)
+		}
+
+		ids := args
+		if ackAllFlag {
+			ids = activeIds
+		}
+
+		for _, id := range ids {
+			if !slices.Contains(activeIds, id) {
+				return fmt.Errorf("announcement %s not found", id)
+			}
+		}
+
+		err = c.AckAnnouncements(activeProfile.Id, ids, activeIds)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("%d announcement(s) acknowledged", len(ids)))
+		return nil
+	},
+}
+
+func init() {
+	notificationsAckCmd.Flags().BoolVarP(&ackAllFlag, "all", "a", false, "Acknowledge all active announcements")
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/notifications"
+	"github.com/spf13/cobra"
+)
+
+var allFlag bool
+
+var notificationsListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List server announcements",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		announcements, res, err := apiClient.AnnouncementAPI.ListAnnouncements(cmd.Context()).All(allFlag).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(announcements)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListNotifications(announcements, activeProfile.AckedAnnouncements)
+		return nil
+	},
+}
+
+func init() {
+	notificationsListCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Include expired announcements")
+	format.RegisterFormatFlag(notificationsListCmd)
+}
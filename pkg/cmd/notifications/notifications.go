@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var NotificationsCmd = &cobra.Command{
+	Use:     "notifications",
+	Aliases: []string{"notification"},
+	Short:   "Manage server announcements such as maintenance windows and deprecations",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	NotificationsCmd.AddCommand(notificationsListCmd)
+	NotificationsCmd.AddCommand(notificationsAckCmd)
+}
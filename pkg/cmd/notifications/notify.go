@@ -0,0 +1,160 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"context"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/notifications"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Pending announcements are fetched while the command runs. Once it finished, the CLI waits at most this long for
+// slow servers
+const showTimeout = 300 * time.Millisecond
+
+const fetchTimeout = 10 * time.Second
+
+// Commands that run in the background or whose output is read by other programs
+var silentCommands = []string{"serve", "daemon-serve", "agent", "ssh-proxy", "url-handler", "autocomplete", "notifications"}
+
+type pendingFetch struct {
+	profileId     string
+	announcements chan []apiclient.Announcement
+}
+
+var pending *pendingFetch
+
+// FetchPendingNotifications starts fetching the announcements of the active profile server in the background, so
+// that the command does not wait for the server
+func FetchPendingNotifications(cmd *cobra.Command) {
+	if !shouldShowNotifications(cmd) {
+		return
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return
+	}
+
+	fetch := &pendingFetch{
+		profileId:     activeProfile.Id,
+		announcements: make(chan []apiclient.Announcement, 1),
+	}
+	pending = fetch
+
+	go func() {
+		defer close(fetch.announcements)
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+
+		announcements, _, err := apiClient.AnnouncementAPI.ListAnnouncements(ctx).Execute()
+		if err != nil {
+			log.Debugf("failed to fetch announcements: %v", err)
+			return
+		}
+		fetch.announcements <- announcements
+	}()
+}
+
+// ShowPendingNotifications prints the announcements fetched by FetchPendingNotifications that were not shown before.
+// Every announcement is shown once per profile, the impersonation banner after every command while it is active.
+func ShowPendingNotifications(cmd *cobra.Command) {
+	if !shouldShowNotifications(cmd) {
+		return
+	}
+
+	c, err := config.GetConfig()
+	if err != nil {
+		return
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return
+	}
+
+	if session := activeProfile.GetActiveImpersonation(); session != nil {
+		view.RenderImpersonationBanner(session.User, session.ExpiresAt)
+	}
+
+	// The command may have switched to a profile of another server
+	if pending == nil || pending.profileId != activeProfile.Id {
+		return
+	}
+
+	var announcements []apiclient.Announcement
+	var ok bool
+	select {
+	case announcements, ok = <-pending.announcements:
+		if !ok {
+			return
+		}
+	case <-time.After(showTimeout):
+		return
+	}
+
+	activeIds := []string{}
+	shownIds := []string{}
+	for _, a := range announcements {
+		activeIds = append(activeIds, a.Id)
+
+		if slices.Contains(activeProfile.SeenAnnouncements, a.Id) || slices.Contains(activeProfile.AckedAnnouncements, a.Id) {
+			continue
+		}
+
+		if a.BelowVersion != nil && *a.BelowVersion != "" && !announcement.IsVersionBelow(internal.Version, *a.BelowVersion) {
+			continue
+		}
+
+		view.RenderNotification(a)
+		shownIds = append(shownIds, a.Id)
+	}
+
+	// Also prunes IDs of announcements that were deleted or expired
+	if len(shownIds) > 0 || len(activeIds) < len(activeProfile.SeenAnnouncements) {
+		err = c.MarkAnnouncementsSeen(activeProfile.Id, shownIds, activeIds)
+		if err != nil {
+			log.Debugf("failed to save seen announcements: %v", err)
+		}
+	}
+}
+
+func shouldShowNotifications(cmd *cobra.Command) bool {
+	if internal.WorkspaceMode() || format.FormatFlag != "" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return false
+	}
+
+	path := strings.Fields(cmd.CommandPath())
+	for _, name := range path[min(1, len(path)):] {
+		if slices.Contains(silentCommands, name) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var maxWorkspacesFlag int32
+
+var createCmd = &cobra.Command{
+	Use:   "create [NAME]",
+	Short: "Create an organization",
+	Long:  "Create an organization. The API key of the active profile becomes its first member",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.CreateOrganization(ctx).Organization(apiclient.CreateOrganizationDTO{
+			Name: args[0],
+			Quota: &apiclient.OrganizationQuota{
+				MaxWorkspaces: maxWorkspacesFlag,
+			},
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Organization '%s' created.\nRun 'daytona organization use %s' to scope your profile to it", o.Name, o.Name))
+		return nil
+	},
+}
+
+func init() {
+	createCmd.Flags().Int32Var(&maxWorkspacesFlag, "max-workspaces", 0, "Maximum number of workspaces in the organization (0 is unlimited)")
+}
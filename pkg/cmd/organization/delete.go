@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete [ORGANIZATION]",
+	Short:   "Delete an organization",
+	Aliases: []string{"remove", "rm"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.GetOrganization(ctx, args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		res, err = apiClient.OrganizationAPI.DeleteOrganization(ctx, o.Id).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		if activeProfile.Organization == o.Id || activeProfile.Organization == o.Name {
+			activeProfile.Organization = ""
+			err = c.EditProfile(activeProfile)
+			if err != nil {
+				return err
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Organization '%s' deleted", o.Name))
+		return nil
+	},
+}
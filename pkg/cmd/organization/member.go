@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var addMemberCmd = &cobra.Command{
+	Use:   "add-member [ORGANIZATION] [API_KEY_NAME]",
+	Short: "Add a client API key to an organization",
+	Long:  "Add a client API key to an organization. Only admins of the organization can manage its members and quota or delete it",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.AddOrganizationMember(context.Background(), args[0]).Member(apiclient.AddOrganizationMemberDTO{
+			Name:  args[1],
+			Admin: &adminFlag,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if adminFlag {
+			views.RenderInfoMessage(fmt.Sprintf("'%s' is now an admin of organization '%s'", args[1], o.Name))
+			return nil
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("'%s' is now a member of organization '%s'", args[1], o.Name))
+		return nil
+	},
+}
+
+var removeMemberCmd = &cobra.Command{
+	Use:   "remove-member [ORGANIZATION] [API_KEY_NAME]",
+	Short: "Remove a client API key from an organization",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.RemoveOrganizationMember(context.Background(), args[0], args[1]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("'%s' removed from organization '%s'", args[1], o.Name))
+		return nil
+	},
+}
+
+var adminFlag bool
+
+func init() {
+	addMemberCmd.Flags().BoolVar(&adminFlag, "admin", false, "Make the member an admin of the organization")
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var OrganizationCmd = &cobra.Command{
+	Use:     "organization",
+	Short:   "Manage organizations",
+	Long:    "Manage organizations.\nTargets, project configs, prebuilds and workspaces created while an organization is selected belong to the organization and are only listed for its members.",
+	Aliases: []string{"org", "organizations"},
+	Args:    cobra.NoArgs,
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	OrganizationCmd.AddCommand(listCmd)
+	OrganizationCmd.AddCommand(createCmd)
+	OrganizationCmd.AddCommand(deleteCmd)
+	OrganizationCmd.AddCommand(useCmd)
+	OrganizationCmd.AddCommand(addMemberCmd)
+	OrganizationCmd.AddCommand(removeMemberCmd)
+	OrganizationCmd.AddCommand(setQuotaCmd)
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var setQuotaCmd = &cobra.Command{
+	Use:   "set-quota [ORGANIZATION]",
+	Short: "Set the quota of an organization",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.SetOrganizationQuota(context.Background(), args[0]).Quota(apiclient.OrganizationQuota{
+			MaxWorkspaces: maxWorkspacesFlag,
+		}).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Quota of organization '%s' updated", o.Name))
+		return nil
+	},
+}
+
+func init() {
+	setQuotaCmd.Flags().Int32Var(&maxWorkspacesFlag, "max-workspaces", 0, "Maximum number of workspaces in the organization (0 is unlimited)")
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var noneFlag bool
+
+var useCmd = &cobra.Command{
+	Use:   "use [ORGANIZATION]",
+	Short: "Scope the active profile to an organization",
+	Long:  "Scope the active profile to an organization.\nUse --none to go back to resources that do not belong to any organization.\nThe --org flag overrides the organization for a single command.",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 && !noneFlag {
+			return errors.New("an organization or the --none flag is required")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		if noneFlag {
+			activeProfile.Organization = ""
+			err = c.EditProfile(activeProfile)
+			if err != nil {
+				return err
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Profile '%s' is no longer scoped to an organization", activeProfile.Name))
+			return nil
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		o, res, err := apiClient.OrganizationAPI.GetOrganization(context.Background(), args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		activeProfile.Organization = o.Name
+		err = c.EditProfile(activeProfile)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Profile '%s' is now scoped to organization '%s'", activeProfile.Name, o.Name))
+		return nil
+	},
+}
+
+func init() {
+	useCmd.Flags().BoolVar(&noneFlag, "none", false, "Stop scoping the active profile to an organization")
+}
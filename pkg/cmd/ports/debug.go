@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_debug "github.com/daytonaio/daytona/pkg/views/debug"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var presetFlag string
+
+var DebugCmd = &cobra.Command{
+	Use:     "debug WORKSPACE [PROJECT]",
+	Short:   "Forward the debugger port of a project and print how to attach an IDE to it",
+	Long:    "Forward the debugger port of a project and print how to attach an IDE to it.\nProjects are configured for debugging when created with the --debug flag, e.g. 'daytona create --debug node'",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		var projectName string
+		if len(args) == 2 {
+			projectName = args[1]
+		} else {
+			projectName, err = apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, "", nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		if !hasProject(workspace, projectName) {
+			return fmt.Errorf("project %s not found in workspace %s", projectName, workspace.Name)
+		}
+
+		preset, err := getDebugPreset(workspace, projectName)
+		if err != nil {
+			return err
+		}
+
+		portName := fmt.Sprint(preset.Port)
+		if getDeclaredPort(workspace, projectName, preset.Port) != nil {
+			portName = project.DebugPortName
+		}
+
+		localPort, err := c.GetLocalPort(activeProfile.Id, workspace.Id, projectName, portName, preset.Port)
+		if err != nil {
+			return err
+		}
+
+		hostPort, errChan := tailscale.ForwardPortTo(workspace.Id, projectName, preset.Port, localPort, activeProfile)
+
+		if hostPort == nil {
+			return <-errChan
+		}
+
+		views_debug.RenderInstructions(projectName, *preset, *hostPort)
+		views.RenderInfoMessage("Forwarding the debugger port. Press Ctrl+C to stop")
+
+		for {
+			err := <-errChan
+			if err != nil {
+				log.Debug(err)
+			}
+		}
+	},
+}
+
+func init() {
+	DebugCmd.Flags().StringVar(&presetFlag, "preset", "", fmt.Sprintf("Debug preset to use if the project was not created with one (%s)", strings.Join(project.GetDebugPresetNames(), "/")))
+}
+
+// getDebugPreset returns the preset of the --preset flag or the preset the project was created with
+func getDebugPreset(workspace *apiclient.WorkspaceDTO, projectName string) (*project.DebugPreset, error) {
+	if presetFlag != "" {
+		return project.GetDebugPreset(presetFlag)
+	}
+
+	ports := []project.Port{}
+	for _, p := range workspace.Projects {
+		if p.Name != projectName {
+			continue
+		}
+		for _, port := range p.Ports {
+			ports = append(ports, conversion.ToPort(port))
+		}
+	}
+
+	preset := project.FindDebugPreset(ports)
+	if preset == nil {
+		return nil, fmt.Errorf("project %s was not created with a debug preset. Create it with --debug or pass --preset", projectName)
+	}
+
+	return preset, nil
+}
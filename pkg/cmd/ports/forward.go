@@ -5,116 +5,290 @@ package ports
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
-	"hash/fnv"
-	"strconv"
+	"net"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/daytonaio/daytona/pkg/portforward"
 	"github.com/daytonaio/daytona/pkg/views"
+	views_ports "github.com/daytonaio/daytona/pkg/views/ports"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	log "github.com/sirupsen/logrus"
 	qrcode "github.com/skip2/go-qrcode"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
-var publicPreview bool
-var workspaceId string
-var projectName string
+// Minimum time between lookups of the transport of the project after failed connections
+const forwardTransportCheckInterval = 10 * time.Second
+
+var forwardProjectFlag string
+var forwardPublicFlag bool
+var forwardPlainFlag bool
 
 var PortForwardCmd = &cobra.Command{
-	Use:     "forward [PORT] [WORKSPACE] [PROJECT]",
-	Short:   "Forward a port from a project to your local machine",
+	Use:   "forward WORKSPACE REMOTE_PORT[:LOCAL_PORT]...",
+	Short: "Forward ports from a project to your local machine",
+	Long: "Forward ports from a project to your local machine. Ports are forwarded to the local port given after the colon or, without one, to the local port allocated to them, see daytona ports map.\n" +
+		"In a terminal, forwards are listed in an interactive view in which ports can be added and removed while the others stay forwarded. " +
+		"Local connections wait for the project while its agent reconnects, so forwards survive reconnects of the agent.",
+	Example: "  daytona forward my-workspace 3000 5432:15432",
 	GroupID: util.WORKSPACE_GROUP,
-	Args:    cobra.RangeArgs(2, 3),
+	Args:    cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		workspaceArg, specArgs, projectArg := parseForwardArgs(args)
+		if projectArg != "" {
+			forwardProjectFlag = projectArg
+		}
+
+		specs := []portforward.Spec{}
+		for _, arg := range specArgs {
+			spec, err := portforward.ParseSpec(arg)
+			if err != nil {
+				return err
+			}
+			specs = append(specs, spec)
+		}
+
+		interactive := !forwardPlainFlag && !forwardPublicFlag && term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+		if len(specs) == 0 && !interactive {
+			return errors.New("no ports to forward")
+		}
+
 		c, err := config.GetConfig()
 		if err != nil {
 			return err
 		}
+
 		activeProfile, err := c.GetActiveProfile()
 		if err != nil {
 			return err
 		}
 
-		port, err := strconv.Atoi(args[0])
+		workspace, err := apiclient_util.GetWorkspace(workspaceArg, true)
 		if err != nil {
 			return err
 		}
-		workspace, err := apiclient.GetWorkspace(args[1], true)
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, forwardProjectFlag, &activeProfile)
 		if err != nil {
 			return err
 		}
-		workspaceId = workspace.Id
 
-		if len(args) == 3 {
-			projectName = args[2]
-		} else {
-			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspaceId, projectName, nil)
-			if err != nil {
-				return err
-			}
+		dial, err := getForwardDialer(workspace, projectName, &activeProfile)
+		if err != nil {
+			return err
 		}
 
-		hostPort, errChan := tailscale.ForwardPort(workspaceId, projectName, uint16(port), activeProfile)
+		forwarder := &portForwarder{
+			manager:     &portforward.Manager{Dial: dial},
+			config:      c,
+			profileId:   activeProfile.Id,
+			workspace:   workspace,
+			projectName: projectName,
+		}
+		defer forwarder.manager.Close()
 
-		if hostPort == nil {
-			if err = <-errChan; err != nil {
+		for _, spec := range specs {
+			err := forwarder.add(spec)
+			if err != nil {
 				return err
 			}
-		} else {
-			if *hostPort != uint16(port) {
-				views.RenderInfoMessage(fmt.Sprintf("Port %d already in use.", port))
+
+			// The public URL and its QR code are printed, which the interactive view would draw over
+			if forwarder.isPublic(spec.RemotePort) {
+				interactive = false
 			}
-			views.RenderInfoMessage(fmt.Sprintf("Port available at http://localhost:%d\n", *hostPort))
 		}
 
-		if publicPreview {
-			go func() {
-				errChan <- ForwardPublicPort(workspaceId, projectName, *hostPort, uint16(port))
-			}()
+		if interactive {
+			return views_ports.RunPortForwardView(fmt.Sprintf("Forwarding ports of %s/%s", workspace.Name, projectName), forwarder)
 		}
 
-		for {
-			err := <-errChan
-			if err != nil {
-				log.Debug(err)
+		for _, f := range forwarder.List() {
+			views.RenderInfoMessage(fmt.Sprintf("Port %d available at http://localhost:%d", f.RemotePort, f.LocalPort))
+
+			if forwardPublicFlag || forwarder.isPublic(f.RemotePort) {
+				go func() {
+					err := ForwardPublicPort(workspace.Id, projectName, f.LocalPort, f.RemotePort)
+					if err != nil {
+						log.Error(err)
+					}
+				}()
 			}
 		}
+
+		views.RenderInfoMessage("Press Ctrl+C to stop")
+
+		<-cmd.Context().Done()
+
+		return nil
 	},
 }
 
 func init() {
-	PortForwardCmd.Flags().BoolVar(&publicPreview, "public", false, "Should be port be available publicly via an URL")
+	PortForwardCmd.Flags().StringVarP(&forwardProjectFlag, "project", "p", "", "Project to forward ports from. Defaults to the first project of the workspace")
+	PortForwardCmd.Flags().BoolVar(&forwardPublicFlag, "public", false, "Should be port be available publicly via an URL")
+	PortForwardCmd.Flags().BoolVar(&forwardPlainFlag, "plain", false, "Print the forwarded ports instead of showing the interactive view")
+}
+
+// parseForwardArgs returns the workspace, port forwards and project of the arguments. Arguments in the PORT WORKSPACE
+// [PROJECT] order of previous versions are still accepted
+func parseForwardArgs(args []string) (string, []string, string) {
+	if len(args) >= 2 && len(args) <= 3 {
+		_, portErr := portforward.ParseSpec(args[0])
+		_, workspaceErr := portforward.ParseSpec(args[1])
+		if portErr == nil && workspaceErr != nil {
+			projectArg := ""
+			usage := fmt.Sprintf("daytona forward %s %s", args[1], args[0])
+			if len(args) == 3 {
+				projectArg = args[2]
+				usage += " -p " + projectArg
+			}
+			views.RenderTip(fmt.Sprintf("daytona forward PORT WORKSPACE [PROJECT] is deprecated, use %s", usage))
+			return args[1], args[:1], projectArg
+		}
+	}
+
+	return args[0], args[1:], ""
+}
+
+// portForwarder forwards ports of a project to the local ports allocated to them unless other local ports are given
+type portForwarder struct {
+	manager     *portforward.Manager
+	config      *config.Config
+	profileId   string
+	workspace   *apiclient.WorkspaceDTO
+	projectName string
+}
+
+func (f *portForwarder) List() []portforward.Status {
+	return f.manager.List()
+}
+
+func (f *portForwarder) Add(spec string) error {
+	s, err := portforward.ParseSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	return f.add(s)
+}
+
+func (f *portForwarder) Remove(remotePort uint16) error {
+	return f.manager.Remove(remotePort)
+}
+
+func (f *portForwarder) add(spec portforward.Spec) error {
+	localPort := spec.LocalPort
+
+	if localPort == 0 {
+		// Undeclared ports are allocated by their port number like detected ones
+		portName := fmt.Sprint(spec.RemotePort)
+		if declaredPort := getDeclaredPort(f.workspace, f.projectName, spec.RemotePort); declaredPort != nil {
+			portName = declaredPort.Name
+		}
+
+		var err error
+		localPort, err = f.config.GetLocalPort(f.profileId, f.workspace.Id, f.projectName, portName, spec.RemotePort)
+		if err != nil {
+			return err
+		}
+	}
+
+	return f.manager.Add(spec.RemotePort, localPort)
+}
+
+func (f *portForwarder) isPublic(remotePort uint16) bool {
+	declaredPort := getDeclaredPort(f.workspace, f.projectName, remotePort)
+
+	return declaredPort != nil && conversion.ToPort(*declaredPort).GetVisibility() == project.PortVisibilityPublic
+}
+
+// getForwardDialer returns a function that connects to ports of the project. The project is looked up again after a
+// failed connection, so that forwards follow the agent when it falls back to the tunnel of the server or joins the
+// tailnet again
+func getForwardDialer(workspace *apiclient.WorkspaceDTO, projectName string, profile *config.Profile) (func(ctx context.Context, port uint16) (net.Conn, error), error) {
+	dial, err := tailscale.GetProjectDialer(workspace, projectName, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutex sync.Mutex
+	tunneled := tailscale.IsProjectTunneled(workspace, projectName)
+	var checkedAt time.Time
+
+	return func(ctx context.Context, port uint16) (net.Conn, error) {
+		mutex.Lock()
+		currentDial := dial
+		mutex.Unlock()
+
+		conn, err := currentDial(ctx, port)
+		if err == nil {
+			return conn, nil
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if time.Since(checkedAt) < forwardTransportCheckInterval {
+			return nil, err
+		}
+		checkedAt = time.Now()
+
+		latest, wsErr := apiclient_util.GetWorkspace(workspace.Id, true)
+		if wsErr != nil || tailscale.IsProjectTunneled(latest, projectName) == tunneled {
+			return nil, err
+		}
+
+		newDial, dialErr := tailscale.GetProjectDialer(latest, projectName, profile)
+		if dialErr != nil {
+			return nil, err
+		}
+
+		dial = newDial
+		tunneled = !tunneled
+
+		return nil, err
+	}, nil
 }
 
 func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uint16) error {
 	views.RenderInfoMessage("Forwarding port to a public URL...")
 
-	apiClient, err := apiclient.GetApiClient(nil)
+	apiClient, err := apiclient_util.GetApiClient(nil)
 	if err != nil {
 		return err
 	}
 
 	serverConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
-		return apiclient.HandleErrorResponse(res, err)
+		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	h := fnv.New64()
-	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, serverConfig.Id)))
-
-	subDomain := fmt.Sprintf("%d-%s", targetPort, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+	subDomain := util.GetPublicPortSubdomain(serverConfig.Id, workspaceId, projectName, targetPort)
 
 	if serverConfig.Frps == nil {
 		return errors.New("frps config is missing")
 	}
 
+	// Without the preview DNS record or certificate the URL might not resolve or only serve plain HTTP, but the
+	// forward itself still works
+	res, err = apiClient.WorkspaceAPI.RegisterPublicPort(context.Background(), workspaceId, projectName, int32(targetPort)).Execute()
+	if err != nil {
+		log.Warnf("Failed to register the public port with the server: %s", apiclient_util.HandleErrorResponse(res, err))
+	}
+
 	go func() {
 		time.Sleep(1 * time.Second)
 		var url = fmt.Sprintf("%s://%s.%s", serverConfig.Frps.Protocol, subDomain, serverConfig.Frps.Domain)
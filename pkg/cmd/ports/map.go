@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	views_ports "github.com/daytonaio/daytona/pkg/views/ports"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var PortsMapCmd = &cobra.Command{
+	Use:   "map [WORKSPACE]",
+	Short: "List the local ports allocated to the ports of workspaces",
+	Long:  "List the local ports allocated to the ports of workspaces in the active profile.\nA port is forwarded to the same local port across sessions. If its port number is taken locally when it is first forwarded, it gets an alternate between 50000 and 60000 that is derived from the workspace, project and port",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		allocations, err := c.ListLocalPorts(activeProfile.Id)
+		if err != nil {
+			return err
+		}
+
+		// Allocations are local, the server only resolves the workspace names and is not required
+		workspaceNames := map[string]string{}
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err == nil {
+			workspaces, _, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+			if err != nil {
+				log.Debug(err)
+			}
+			for _, w := range workspaces {
+				workspaceNames[w.Id] = w.Name
+			}
+		}
+
+		if len(args) == 1 {
+			filtered := []config.LocalPortAllocation{}
+			for _, a := range allocations {
+				if a.WorkspaceId == args[0] || workspaceNames[a.WorkspaceId] == args[0] {
+					filtered = append(filtered, a)
+				}
+			}
+			allocations = filtered
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(allocations)
+			formattedData.Print()
+			return nil
+		}
+
+		views_ports.ListLocalPorts(allocations, workspaceNames)
+		return nil
+	},
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_ports "github.com/daytonaio/daytona/pkg/views/ports"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var forwardFlag bool
+
+var PortsCmd = &cobra.Command{
+	Use:     "ports WORKSPACE [PROJECT]",
+	Short:   "List the ports declared by projects and the local ports they are forwarded to",
+	Long:    "List the ports declared by projects and the local ports they are forwarded to.\nPorts that processes of a project listen on without being declared are listed as detected and forwarded as well",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		mappings := []views_ports.PortMapping{}
+
+		for _, project := range workspace.Projects {
+			if len(args) == 2 && project.Name != args[1] {
+				continue
+			}
+
+			for _, port := range project.Ports {
+				localPort, err := c.GetLocalPort(activeProfile.Id, workspace.Id, project.Name, port.Name, uint16(port.Port))
+				if err != nil {
+					return err
+				}
+
+				p := conversion.ToPort(port)
+				mappings = append(mappings, views_ports.PortMapping{
+					Project:    project.Name,
+					Name:       p.Name,
+					Port:       p.Port,
+					Protocol:   string(p.GetProtocol()),
+					Visibility: string(p.GetVisibility()),
+					LocalPort:  localPort,
+				})
+			}
+
+			if project.State == nil {
+				continue
+			}
+
+			for _, detectedPort := range project.State.DetectedPorts {
+				if getDeclaredPort(workspace, project.Name, uint16(detectedPort.Port)) != nil {
+					continue
+				}
+
+				// Detected ports have no name, they are allocated local ports by their port number
+				name := fmt.Sprint(detectedPort.Port)
+				localPort, err := c.GetLocalPort(activeProfile.Id, workspace.Id, project.Name, name, uint16(detectedPort.Port))
+				if err != nil {
+					return err
+				}
+
+				mappings = append(mappings, views_ports.PortMapping{
+					Project:    project.Name,
+					Name:       name,
+					Port:       uint16(detectedPort.Port),
+					Protocol:   string(apiclient.PortProtocolTcp),
+					Visibility: string(apiclient.PortVisibilityPrivate),
+					LocalPort:  localPort,
+					Detected:   true,
+				})
+			}
+		}
+
+		if len(args) == 2 && len(mappings) == 0 && !hasProject(workspace, args[1]) {
+			return fmt.Errorf("project %s not found in workspace %s", args[1], workspace.Name)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(mappings)
+			formattedData.Print()
+			return nil
+		}
+
+		views_ports.ListPortMappings(mappings)
+
+		if !forwardFlag || len(mappings) == 0 {
+			return nil
+		}
+
+		return forwardPortMappings(workspace.Id, mappings, activeProfile)
+	},
+}
+
+func init() {
+	PortsCmd.Flags().BoolVar(&forwardFlag, "forward", false, "Forward the listed ports to their local ports until interrupted")
+	format.RegisterFormatFlag(PortsCmd)
+
+	format.RegisterFormatFlag(PortsMapCmd)
+	PortsCmd.AddCommand(PortsMapCmd)
+}
+
+// forwardPortMappings forwards each port to its allocated local port and exposes public ports through a public preview URL
+func forwardPortMappings(workspaceId string, mappings []views_ports.PortMapping, profile config.Profile) error {
+	errChan := make(chan error)
+
+	for _, m := range mappings {
+		_, portErrChan := tailscale.ForwardPortTo(workspaceId, m.Project, m.Port, m.LocalPort, profile)
+
+		go func() {
+			for err := range portErrChan {
+				errChan <- fmt.Errorf("%s/%s: %w", m.Project, m.Name, err)
+			}
+		}()
+
+		if m.Visibility == string(project.PortVisibilityPublic) {
+			go func() {
+				errChan <- ForwardPublicPort(workspaceId, m.Project, m.LocalPort, m.Port)
+			}()
+		}
+	}
+
+	views.RenderInfoMessage("Forwarding ports. Press Ctrl+C to stop")
+
+	for {
+		err := <-errChan
+		if err != nil {
+			log.Debug(err)
+		}
+	}
+}
+
+func hasProject(workspace *apiclient.WorkspaceDTO, projectName string) bool {
+	for _, project := range workspace.Projects {
+		if project.Name == projectName {
+			return true
+		}
+	}
+	return false
+}
+
+// getDeclaredPort returns the port the project declares with the given port number, nil if it does not declare one
+func getDeclaredPort(workspace *apiclient.WorkspaceDTO, projectName string, portNumber uint16) *apiclient.ProjectPort {
+	for _, project := range workspace.Projects {
+		if project.Name != projectName {
+			continue
+		}
+
+		for _, port := range project.Ports {
+			if uint16(port.Port) == portNumber {
+				return &port
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	views_ports "github.com/daytonaio/daytona/pkg/views/ports"
+	"github.com/spf13/cobra"
+)
+
+var ServicesCmd = &cobra.Command{
+	Use:     "services [WORKSPACE]",
+	Short:   "List the services projects registered for other workspaces to resolve by name",
+	Long:    "List the services projects registered for other workspaces to resolve by name. Processes of a project reach the services of other workspaces through the SOCKS5 proxy the agent serves on DAYTONA_AGENT_SERVICE_PROXY_PORT, e.g. with ALL_PROXY=socks5h://localhost:1080 curl http://api-proj.daytona.internal:8080. Names without the workspace resolve to the project of the same workspace first.",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.WorkspaceAPI.ListServiceEndpoints(cmd.Context())
+		if len(args) == 1 {
+			req = req.WorkspaceId(args[0])
+		}
+
+		endpoints, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(endpoints)
+			formattedData.Print()
+			return nil
+		}
+
+		views_ports.ListServiceEndpoints(endpoints)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(ServicesCmd)
+}
@@ -4,7 +4,6 @@
 package prebuild
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -29,7 +28,7 @@ var prebuildAddCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var prebuildAddView add.PrebuildAddView
 		var projectConfig *apiclient.ProjectConfig
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -157,6 +156,9 @@ var prebuildAddCmd = &cobra.Command{
 			newPrebuild.TriggerFiles = prebuildAddView.TriggerFiles
 		}
 
+		newPrebuild.Matrix = getPrebuildMatrixFromFlags()
+		newPrebuild.BuildSecrets = buildSecretsFlag
+
 		prebuildId, res, err := apiClient.PrebuildAPI.SetPrebuild(ctx, prebuildAddView.ProjectConfigName).Prebuild(newPrebuild).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -183,4 +185,20 @@ func init() {
 	prebuildAddCmd.Flags().IntVarP(&retentionFlag, "retention", "r", 0, "Maximum number of resulting builds stored at a time")
 	prebuildAddCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	prebuildAddCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
+	prebuildAddCmd.Flags().StringSliceVar(&matrixDevcontainerPathsFlag, "matrix-devcontainer-path", nil, "Devcontainer config paths to build a variant for")
+	prebuildAddCmd.Flags().StringSliceVar(&matrixImagesFlag, "matrix-image", nil, "Base images to build a variant for")
+	prebuildAddCmd.Flags().StringSliceVar(&matrixArchitecturesFlag, "matrix-arch", nil, "Architectures to build a variant for (e.g. amd64, arm64)")
+	prebuildAddCmd.Flags().StringSliceVar(&buildSecretsFlag, "build-secret", nil, "Names of the build secrets builds of the prebuild can read in addition to those of the project config")
+}
+
+func getPrebuildMatrixFromFlags() *apiclient.PrebuildMatrix {
+	if len(matrixDevcontainerPathsFlag) == 0 && len(matrixImagesFlag) == 0 && len(matrixArchitecturesFlag) == 0 {
+		return nil
+	}
+
+	return &apiclient.PrebuildMatrix{
+		DevcontainerPaths: matrixDevcontainerPathsFlag,
+		Images:            matrixImagesFlag,
+		Architectures:     matrixArchitecturesFlag,
+	}
 }
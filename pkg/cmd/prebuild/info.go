@@ -24,7 +24,7 @@ var prebuildInfoCmd = &cobra.Command{
 		var prebuild *apiclient.PrebuildDTO
 		var res *http.Response
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package prebuild
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/prebuild/stats"
+	"github.com/spf13/cobra"
+)
+
+var repositoryUrlFlag string
+
+var prebuildStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show how often workspace creations used a prebuild and the time saved",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.PrebuildAPI.GetPrebuildStats(ctx)
+		if repositoryUrlFlag != "" {
+			req = req.RepositoryUrl(repositoryUrlFlag)
+		}
+		if branchFlag != "" {
+			req = req.Branch(branchFlag)
+		}
+
+		stats, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(stats)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderStats(stats)
+		return nil
+	},
+}
+
+func init() {
+	prebuildStatsCmd.Flags().StringVar(&repositoryUrlFlag, "repository-url", "", "Only show stats for the repository URL")
+	prebuildStatsCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Only show stats for the branch")
+	format.RegisterFormatFlag(prebuildStatsCmd)
+}
@@ -4,7 +4,6 @@
 package prebuild
 
 import (
-	"context"
 	"errors"
 	"strconv"
 
@@ -28,7 +27,7 @@ var prebuildUpdateCmd = &cobra.Command{
 		var prebuild *apiclient.PrebuildDTO
 		var projectConfigRecieved string
 		var retention int
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -155,6 +154,16 @@ var prebuildUpdateCmd = &cobra.Command{
 			newPrebuild.TriggerFiles = prebuildAddView.TriggerFiles
 		}
 
+		newPrebuild.Matrix = prebuild.Matrix
+		if matrix := getPrebuildMatrixFromFlags(); matrix != nil {
+			newPrebuild.Matrix = matrix
+		}
+
+		newPrebuild.BuildSecrets = prebuild.BuildSecrets
+		if len(buildSecretsFlag) > 0 {
+			newPrebuild.BuildSecrets = buildSecretsFlag
+		}
+
 		prebuildId, res, err := apiClient.PrebuildAPI.SetPrebuild(ctx, prebuildAddView.ProjectConfigName).Prebuild(newPrebuild).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -186,6 +195,12 @@ var (
 	commitIntervalFlag int
 	triggerFilesFlag   []string
 	runFlag            bool
+
+	matrixDevcontainerPathsFlag []string
+	matrixImagesFlag            []string
+	matrixArchitecturesFlag     []string
+
+	buildSecretsFlag []string
 )
 
 func init() {
@@ -194,4 +209,8 @@ func init() {
 	prebuildUpdateCmd.Flags().IntVarP(&commitIntervalFlag, "commit-interval", "c", 0, "Commit interval for running a prebuild - leave blank to ignore push events")
 	prebuildUpdateCmd.Flags().StringSliceVarP(&triggerFilesFlag, "trigger-files", "t", nil, "Full paths of files whose changes should explicitly trigger a  prebuild")
 	prebuildUpdateCmd.Flags().BoolVar(&runFlag, "run", false, "Run the prebuild once after updating it")
+	prebuildUpdateCmd.Flags().StringSliceVar(&matrixDevcontainerPathsFlag, "matrix-devcontainer-path", nil, "Devcontainer config paths to build a variant for")
+	prebuildUpdateCmd.Flags().StringSliceVar(&matrixImagesFlag, "matrix-image", nil, "Base images to build a variant for")
+	prebuildUpdateCmd.Flags().StringSliceVar(&matrixArchitecturesFlag, "matrix-arch", nil, "Architectures to build a variant for (e.g. amd64, arm64)")
+	prebuildUpdateCmd.Flags().StringSliceVar(&buildSecretsFlag, "build-secret", nil, "Names of the build secrets builds of the prebuild can read in addition to those of the project config")
 }
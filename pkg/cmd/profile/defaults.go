@@ -0,0 +1,90 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/spf13/cobra"
+)
+
+var defaultTargetFlag string
+var defaultIdeFlag string
+var defaultTtlFlag string
+var envFlag []string
+var unsetEnvFlag []string
+
+func addProfileDefaultsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&defaultTargetFlag, "default-target", "", "Target used when creating workspaces with this profile")
+	cmd.Flags().StringVar(&defaultIdeFlag, "default-ide", "", "IDE used when opening workspaces with this profile")
+	cmd.Flags().StringVar(&defaultTtlFlag, "default-ttl", "", "Duration after which workspaces created with this profile are removed (e.g. 48h)")
+	cmd.Flags().StringArrayVar(&envFlag, "env", []string{}, "Environment variables set in workspaces created with this profile (e.g. --env 'KEY1=VALUE1' --env 'KEY2=VALUE2' ...')")
+	cmd.Flags().StringArrayVar(&unsetEnvFlag, "unset-env", []string{}, "Remove profile environment variables by key")
+}
+
+// applyProfileDefaultsFlags updates the profile defaults from the command flags and reports whether any were set.
+// Setting a default to an empty string clears it.
+func applyProfileDefaultsFlags(cmd *cobra.Command, p *config.Profile) (bool, error) {
+	changed := false
+
+	if cmd.Flags().Changed("default-target") {
+		p.DefaultTarget = defaultTargetFlag
+		changed = true
+	}
+
+	if cmd.Flags().Changed("default-ide") {
+		if defaultIdeFlag != "" && !isValidIde(defaultIdeFlag) {
+			return false, fmt.Errorf("unknown IDE: %s", defaultIdeFlag)
+		}
+		p.DefaultIdeId = defaultIdeFlag
+		changed = true
+	}
+
+	if cmd.Flags().Changed("default-ttl") {
+		if defaultTtlFlag != "" {
+			ttl, err := time.ParseDuration(defaultTtlFlag)
+			if err != nil || ttl <= 0 {
+				return false, fmt.Errorf("invalid TTL %s: must be a positive duration, e.g. 48h", defaultTtlFlag)
+			}
+		}
+		p.DefaultWorkspaceTtl = defaultTtlFlag
+		changed = true
+	}
+
+	for _, key := range unsetEnvFlag {
+		delete(p.EnvVars, key)
+		changed = true
+	}
+
+	for _, envVar := range envFlag {
+		key, value, found := strings.Cut(envVar, "=")
+		if !found || key == "" {
+			return false, fmt.Errorf("invalid environment variable format: %s", envVar)
+		}
+		if p.EnvVars == nil {
+			p.EnvVars = map[string]string{}
+		}
+		p.EnvVars[key] = value
+		changed = true
+	}
+
+	if len(p.EnvVars) == 0 {
+		p.EnvVars = nil
+	}
+
+	return changed, nil
+}
+
+func isValidIde(ideId string) bool {
+	for _, ide := range config.GetIdeList() {
+		if ide.Id == ideId {
+			return true
+		}
+	}
+
+	return false
+}
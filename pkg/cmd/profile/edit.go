@@ -48,6 +48,21 @@ var profileEditCmd = &cobra.Command{
 			return errors.New("profile does not exist")
 		}
 
+		defaultsChanged, err := applyProfileDefaultsFlags(cmd, chosenProfile)
+		if err != nil {
+			return err
+		}
+
+		if defaultsChanged && profileNameFlag == "" && apiUrlFlag == "" && apiKeyFlag == "" {
+			err = c.EditProfile(*chosenProfile)
+			if err != nil {
+				return err
+			}
+
+			profile.RenderDefaults(*chosenProfile)
+			return nil
+		}
+
 		if profileNameFlag != "" {
 			chosenProfile.Name = profileNameFlag
 		}
@@ -114,4 +129,5 @@ func init() {
 	profileEditCmd.Flags().StringVarP(&profileNameFlag, "name", "n", "", "Profile name")
 	profileEditCmd.Flags().StringVarP(&apiUrlFlag, "api-url", "a", "", "API URL")
 	profileEditCmd.Flags().StringVarP(&apiKeyFlag, "api-key", "k", "", "API Key")
+	addProfileDefaultsFlags(profileEditCmd)
 }
@@ -56,19 +56,18 @@ var ProfileUseCmd = &cobra.Command{
 				return nil
 			}
 
-			profile, err := c.GetProfile(chosenProfile.Id)
+			selectedProfile, err := c.GetProfile(chosenProfile.Id)
 			if err != nil {
 				return err
 			}
 
-			c.ActiveProfileId = profile.Id
-
-			err = c.Save()
+			err = c.SetActiveProfile(selectedProfile.Id)
 			if err != nil {
 				return err
 			}
 
-			views.RenderInfoMessage(fmt.Sprintf("Active profile set to: %s", profile.Name))
+			views.RenderInfoMessage(fmt.Sprintf("Active profile set to: %s", selectedProfile.Name))
+			profile.RenderDefaults(selectedProfile)
 		} else {
 			profileArg := args[0]
 
@@ -81,18 +80,17 @@ var ProfileUseCmd = &cobra.Command{
 				}
 			}
 
-			if chosenProfile == (config.Profile{}) {
+			if chosenProfile.Id == "" {
 				return fmt.Errorf("profile does not exist: %s", profileArg)
 			}
 
-			c.ActiveProfileId = chosenProfile.Id
-
-			err = c.Save()
+			err = c.SetActiveProfile(chosenProfile.Id)
 			if err != nil {
 				return err
 			}
 
 			views.RenderInfoMessage(fmt.Sprintf("Active profile set to: %s", chosenProfile.Name))
+			profile.RenderDefaults(chosenProfile)
 		}
 		return nil
 	},
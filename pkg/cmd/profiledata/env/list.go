@@ -4,8 +4,6 @@
 package env
 
 import (
-	"context"
-
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/format"
 	"github.com/daytonaio/daytona/pkg/views/env"
@@ -21,7 +19,7 @@ var listCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
 		if err != nil {
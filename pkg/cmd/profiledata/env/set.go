@@ -4,7 +4,6 @@
 package env
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -23,7 +22,7 @@ var setCmd = &cobra.Command{
 		if err != nil {
 			return err
 		}
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
 		if err != nil {
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcommand
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/projectcommand"
+	"github.com/spf13/cobra"
+)
+
+var commandNameFlag string
+
+var historyCmd = &cobra.Command{
+	Use:   "history [WORKSPACE]",
+	Short: "List past project command runs with their exit codes",
+	Args:  cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.CommandRunAPI.ListCommandRuns(ctx)
+
+		if len(args) > 0 {
+			workspace, err := apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+			req = req.WorkspaceId(workspace.Id)
+		}
+
+		if projectNameFlag != "" {
+			req = req.ProjectName(projectNameFlag)
+		}
+
+		if commandNameFlag != "" {
+			req = req.CommandName(commandNameFlag)
+		}
+
+		runs, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(runs)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListCommandRuns(runs)
+		return nil
+	},
+}
+
+func init() {
+	historyCmd.Flags().StringVarP(&projectNameFlag, "project", "p", "", "Filter runs by project name")
+	historyCmd.Flags().StringVarP(&commandNameFlag, "command", "c", "", "Filter runs by command name")
+	format.RegisterFormatFlag(historyCmd)
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcommand
+
+import (
+	"errors"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/projectcommand"
+	"github.com/spf13/cobra"
+)
+
+var listCmd = &cobra.Command{
+	Use:     "list WORKSPACE",
+	Short:   "List the commands declared by a project",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := getProjectName(workspace.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, project := range workspace.Projects {
+			if project.Name != projectName {
+				continue
+			}
+
+			if format.FormatFlag != "" {
+				formattedData := format.NewFormatter(project.Commands)
+				formattedData.Print()
+				return nil
+			}
+
+			view.ListCommands(projectName, project.Commands)
+			return nil
+		}
+
+		return errors.New("project not found in workspace")
+	},
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&projectNameFlag, "project", "p", "", "Project to list the commands of. Defaults to the first project of the workspace")
+	format.RegisterFormatFlag(listCmd)
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcommand
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/spf13/cobra"
+)
+
+var projectNameFlag string
+
+var ProjectCommandCmd = &cobra.Command{
+	Use:     "cmd",
+	Aliases: []string{"command", "commands"},
+	Short:   "Run the named commands declared by projects",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+func init() {
+	ProjectCommandCmd.AddCommand(runCmd)
+	ProjectCommandCmd.AddCommand(listCmd)
+	ProjectCommandCmd.AddCommand(historyCmd)
+}
+
+// getProjectName returns the --project flag value or the first project of the workspace
+func getProjectName(workspaceId string) (string, error) {
+	return apiclient.GetFirstWorkspaceProjectName(workspaceId, projectNameFlag, nil)
+}
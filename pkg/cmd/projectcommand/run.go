@@ -0,0 +1,83 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcommand
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/projectcommand"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var runCmd = &cobra.Command{
+	Use:   "run WORKSPACE COMMAND",
+	Short: "Run a named project command",
+	Long:  "Run a command declared by the project, e.g. test or migrate-db, and exit with its exit code. Runs are recorded with their output and can be listed with 'daytona cmd history'.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := getProjectName(workspace.Id)
+		if err != nil {
+			return err
+		}
+
+		var run *apiclient.CommandRun
+		runCommand := func() error {
+			var res *http.Response
+			run, res, err = apiClient.CommandRunAPI.RunProjectCommand(ctx, workspace.Id, projectName, args[1]).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		}
+
+		if format.FormatFlag != "" {
+			err = runCommand()
+		} else {
+			err = views_util.WithInlineSpinner(fmt.Sprintf("Running %s in %s", args[1], projectName), runCommand)
+		}
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(run)
+			formattedData.Print()
+		} else {
+			view.RenderCommandRun(*run, true)
+		}
+
+		// The exit code of the command is the exit code of the CLI
+		if run.ExitCode == nil {
+			os.Exit(1)
+		}
+		if *run.ExitCode != 0 {
+			os.Exit(int(*run.ExitCode))
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	runCmd.Flags().StringVarP(&projectNameFlag, "project", "p", "", "Project of the command. Defaults to the first project of the workspace")
+	format.RegisterFormatFlag(runCmd)
+}
@@ -28,7 +28,7 @@ var projectConfigAddCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var projectConfig *apiclient.ProjectConfig
 		var projectConfigName *string
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -144,6 +144,10 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		RepositoryUrl:       createDtos[0].Source.Repository.Url,
 		EnvVars:             createDtos[0].EnvVars,
 		GitProviderConfigId: createDtos[0].GitProviderConfigId,
+		Mounts:              createDtos[0].Mounts,
+		Commands:            createDtos[0].Commands,
+		Ports:               createDtos[0].Ports,
+		BuildSecrets:        buildSecretsFlag,
 	}
 
 	res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(createProjectConfig).Execute()
@@ -159,6 +163,10 @@ func RunProjectConfigAddFlow(apiClient *apiclient.APIClient, gitProviders []apic
 		Prebuilds:           nil,
 		RepositoryUrl:       createProjectConfig.RepositoryUrl,
 		GitProviderConfigId: createProjectConfig.GitProviderConfigId,
+		Mounts:              createProjectConfig.Mounts,
+		Commands:            createProjectConfig.Commands,
+		Ports:               createProjectConfig.Ports,
+		BuildSecrets:        createProjectConfig.BuildSecrets,
 	}
 
 	if createProjectConfig.Image != nil {
@@ -241,6 +249,10 @@ func processCmdArgument(argument string, apiClient *apiclient.APIClient, ctx con
 		RepositoryUrl:       repoUrl,
 		EnvVars:             project.EnvVars,
 		GitProviderConfigId: project.GitProviderConfigId,
+		Mounts:              project.Mounts,
+		Commands:            project.Commands,
+		Ports:               project.Ports,
+		BuildSecrets:        buildSecretsFlag,
 	}
 
 	if newProjectConfig.Image == nil {
@@ -275,6 +287,7 @@ func getExistingProjectConfigNames(apiClient *apiclient.APIClient) ([]string, er
 }
 
 var nameFlag string
+var buildSecretsFlag []string
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -283,11 +296,16 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Branches:          new([]string),
 	DevcontainerPath:  new(string),
 	EnvVars:           new([]string),
+	Mounts:            new([]string),
+	Commands:          new([]string),
+	Ports:             new([]string),
+	Debug:             new(string),
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
 }
 
 func init() {
 	projectConfigAddCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the project config name")
+	projectConfigAddCmd.Flags().StringSliceVar(&buildSecretsFlag, "build-secret", nil, "Names of the build secrets builds of the project config can read")
 	workspace_util.AddProjectConfigurationFlags(projectConfigAddCmd, projectConfigurationFlags, false)
 }
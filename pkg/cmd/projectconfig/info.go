@@ -22,7 +22,7 @@ var projectConfigInfoCmd = &cobra.Command{
 	Aliases: []string{"view", "inspect"},
 	Args:    cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
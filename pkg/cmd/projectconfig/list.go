@@ -18,7 +18,7 @@ var projectConfigListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var specifyGitProviders bool
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
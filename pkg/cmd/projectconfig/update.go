@@ -4,7 +4,6 @@
 package projectconfig
 
 import (
-	"context"
 	"net/http"
 	"net/url"
 
@@ -24,7 +23,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var projectConfig *apiclient.ProjectConfig
 		var res *http.Response
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -68,6 +67,9 @@ var projectConfigUpdateCmd = &cobra.Command{
 				BuildConfig:         projectConfig.BuildConfig,
 				EnvVars:             projectConfig.EnvVars,
 				GitProviderConfigId: projectConfig.GitProviderConfigId,
+				Mounts:              projectConfig.Mounts,
+				Commands:            projectConfig.Commands,
+				Ports:               projectConfig.Ports,
 			},
 		}
 
@@ -118,6 +120,14 @@ var projectConfigUpdateCmd = &cobra.Command{
 			RepositoryUrl:       createDto[0].Source.Repository.Url,
 			EnvVars:             createDto[0].EnvVars,
 			GitProviderConfigId: createDto[0].GitProviderConfigId,
+			Mounts:              createDto[0].Mounts,
+			Commands:            createDto[0].Commands,
+			Ports:               createDto[0].Ports,
+			BuildSecrets:        projectConfig.BuildSecrets,
+		}
+
+		if len(buildSecretsFlag) > 0 {
+			newProjectConfig.BuildSecrets = buildSecretsFlag
 		}
 
 		res, err = apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(newProjectConfig).Execute()
@@ -129,3 +139,7 @@ var projectConfigUpdateCmd = &cobra.Command{
 		return nil
 	},
 }
+
+func init() {
+	projectConfigUpdateCmd.Flags().StringSliceVar(&buildSecretsFlag, "build-secret", nil, "Names of the build secrets builds of the project config can read")
+}
@@ -37,7 +37,7 @@ var providerInstallCmd = &cobra.Command{
 			return err
 		}
 
-		serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(cmd.Context()).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
@@ -208,10 +208,10 @@ func ConvertOSToStringMap(downloadUrls map[os.OperatingSystem]string) map[string
 func InstallProvider(apiClient *apiclient.APIClient, providerToInstall provider_view.ProviderView, providersManifest *manager.ProvidersManifest) error {
 	downloadUrls := ConvertOSToStringMap((*providersManifest)[providerToInstall.Name].Versions[providerToInstall.Version].DownloadUrls)
 	err := views_util.WithInlineSpinner("Installing", func() error {
-		res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
+		res, err := apiClient.ProviderAPI.InstallProvider(context.Background()).Provider(apiclient.InstallProviderRequest{
 			Name:         providerToInstall.Name,
 			DownloadUrls: downloadUrls,
-		}))
+		}).Execute()
 
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
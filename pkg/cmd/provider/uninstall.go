@@ -4,7 +4,6 @@
 package provider
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/daytonaio/daytona/internal/util/apiclient"
@@ -22,7 +21,7 @@ var providerUninstallCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	Aliases: []string{"u"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -25,7 +25,7 @@ var providerUpdateCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	Aliases: []string{"up"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -42,7 +42,7 @@ var providerUpdateCmd = &cobra.Command{
 			return nil
 		}
 
-		serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
@@ -104,10 +104,10 @@ func updateProvider(providerName string, providersManifest *manager.ProvidersMan
 
 	downloadUrls := ConvertOSToStringMap(version.DownloadUrls)
 
-	res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
+	res, err := apiClient.ProviderAPI.InstallProvider(context.Background()).Provider(apiclient.InstallProviderRequest{
 		Name:         providerName,
 		DownloadUrls: downloadUrls,
-	}))
+	}).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
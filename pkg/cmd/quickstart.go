@@ -0,0 +1,292 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/provider"
+	"github.com/daytonaio/daytona/pkg/cmd/server"
+	"github.com/daytonaio/daytona/pkg/cmd/workspace"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/views"
+	provider_view "github.com/daytonaio/daytona/pkg/views/provider"
+	"github.com/spf13/cobra"
+)
+
+// Starter repository the sample workspace is created from
+const quickstartRepoUrl = "https://github.com/daytonaio/sample-go"
+
+const quickstartWorkspaceName = "quickstart"
+
+var quickstartRepoFlag string
+var quickstartKeepFlag bool
+var quickstartYesFlag bool
+
+var quickstartCmd = &cobra.Command{
+	Use:     "quickstart",
+	Short:   "Create a sample workspace to get started with Daytona",
+	Long:    "Start the local Daytona Server and use its profile, make sure a provider and a target are installed, create a sample workspace from a starter repository, open it in your IDE and delete it once you are done exploring.",
+	Args:    cobra.NoArgs,
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		views.RenderInfoMessageBold("1/4 Configuring the local profile")
+		profile, err := ensureLocalProfile(cmd)
+		if err != nil {
+			return err
+		}
+		if profile == nil {
+			views.RenderInfoMessage("Operation cancelled.")
+			return nil
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(profile)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold("2/4 Checking providers")
+		target, err := ensureQuickstartTarget(ctx, apiClient)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("3/4 Creating a sample workspace from %s on target %s", quickstartRepoFlag, target))
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		workspaceName := workspace_util.GetSuggestedName(quickstartWorkspaceName, util.ArrayMap(workspaceList, func(w apiclient.WorkspaceDTO) string {
+			return w.Name
+		}))
+
+		err = setFlags(workspace.CreateCmd, map[string]string{"name": workspaceName, "target": target, "yes": fmt.Sprint(quickstartYesFlag)})
+		if err != nil {
+			return err
+		}
+
+		createErr := workspace.CreateCmd.RunE(cmd, []string{quickstartRepoFlag})
+
+		views.RenderInfoMessageBold("4/4 Cleaning up")
+		err = cleanupQuickstartWorkspace(ctx, apiClient, workspaceName, createErr != nil)
+		if err != nil {
+			return errors.Join(createErr, err)
+		}
+
+		return createErr
+	},
+}
+
+// ensureLocalProfile starts the local server if it is not running and activates the profile the server
+// created for it. Returns nil if the user did not confirm switching to the local profile
+func ensureLocalProfile(cmd *cobra.Command) (*config.Profile, error) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := c.GetProfile("default")
+	if err == nil {
+		_, err = apiclient_util.GetApiClient(&profile)
+		if err != nil && !apiclient_util.IsHealthCheckFailed(err) {
+			return nil, err
+		}
+	}
+
+	if err != nil {
+		views.RenderInfoMessage("The local Daytona Server is not running")
+
+		err = setFlags(server.ServerCmd, map[string]string{"yes": fmt.Sprint(quickstartYesFlag)})
+		if err != nil {
+			return nil, err
+		}
+
+		err = server.ServerCmd.RunE(cmd, []string{})
+		if err != nil {
+			return nil, err
+		}
+
+		// The server adds the local profile on its first start
+		c, err = config.GetConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		profile, err = c.GetProfile("default")
+		if err != nil {
+			return nil, errors.New("the local profile was not created. Make sure the Daytona Server is running with `daytona server`")
+		}
+	}
+
+	if c.ActiveProfileId != profile.Id {
+		switchProfile := quickstartYesFlag
+		if !switchProfile {
+			err = huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Switch the active profile to %s?", profile.Name)).
+						Description("The quickstart runs on the local Daytona Server").
+						Value(&switchProfile),
+				),
+			).WithTheme(views.GetCustomTheme()).Run()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !switchProfile {
+			return nil, nil
+		}
+
+		err = c.SetActiveProfile(profile.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Using profile %s (%s)", profile.Name, profile.Api.Url))
+
+	return &profile, nil
+}
+
+// ensureQuickstartTarget installs the default providers if none is installed and returns the name of the target
+// the sample workspace is created on
+func ensureQuickstartTarget(ctx context.Context, apiClient *apiclient.APIClient) (string, error) {
+	providers, res, err := apiClient.ProviderAPI.ListProviders(ctx).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(providers) == 0 {
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+		if err != nil {
+			return "", apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		providersManifest, err := manager.NewProviderManager(manager.ProviderManagerConfig{RegistryUrl: serverConfig.RegistryUrl}).GetProvidersManifest()
+		if err != nil {
+			return "", err
+		}
+
+		if providersManifest == nil {
+			return "", errors.New("could not get providers manifest")
+		}
+
+		for name, providerManifest := range *providersManifest {
+			if !providerManifest.Default {
+				continue
+			}
+
+			version := "latest"
+			if _, ok := providerManifest.Versions[version]; !ok {
+				version, _ = providerManifest.FindLatestVersion()
+			}
+
+			err = provider.InstallProvider(apiClient, provider_view.ProviderView{Name: name, Version: version}, providersManifest)
+			if err != nil {
+				return "", err
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Provider %s has been successfully installed", name))
+		}
+	} else {
+		for _, p := range providers {
+			views.RenderInfoMessage(fmt.Sprintf("Provider %s %s is installed", p.Name, p.Version))
+		}
+	}
+
+	targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(targets) == 0 {
+		return "", errors.New("no targets found. Add one with `daytona target set` and run the quickstart again")
+	}
+
+	for _, t := range targets {
+		if t.IsDefault {
+			return t.Name, nil
+		}
+	}
+
+	return targets[0].Name, nil
+}
+
+// cleanupQuickstartWorkspace deletes the sample workspace once the user is done with it. A workspace left behind
+// by a failed creation is deleted without asking
+func cleanupQuickstartWorkspace(ctx context.Context, apiClient *apiclient.APIClient, workspaceName string, failed bool) error {
+	workspaceDTO, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceName).Execute()
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if !failed {
+		// Automatically confirmed prompts never delete the workspace that was just created
+		if quickstartKeepFlag || quickstartYesFlag {
+			views.RenderInfoMessage(fmt.Sprintf("Workspace %s was kept. Open it again with `daytona code %s` and delete it with `daytona delete %s`", workspaceName, workspaceName, workspaceName))
+			return nil
+		}
+
+		deleteWorkspace := true
+		err = huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title(fmt.Sprintf("Delete the sample workspace %s?", workspaceName)).
+					Description("Answer once you are done exploring the workspace").
+					Affirmative("Delete").
+					Negative("Keep").
+					Value(&deleteWorkspace),
+			),
+		).WithTheme(views.GetCustomTheme()).Run()
+		if err != nil {
+			return err
+		}
+
+		if !deleteWorkspace {
+			views.RenderInfoMessage(fmt.Sprintf("Workspace %s was kept. Delete it with `daytona delete %s`", workspaceName, workspaceName))
+			return nil
+		}
+	}
+
+	err = workspace.RemoveWorkspace(ctx, apiClient, workspaceDTO, failed)
+	if err != nil {
+		return err
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Workspace %s successfully deleted. Create your own with `daytona create`", workspaceName))
+	return nil
+}
+
+func setFlags(cmd *cobra.Command, values map[string]string) error {
+	for name, value := range values {
+		err := cmd.Flags().Set(name, value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	quickstartCmd.Flags().StringVar(&quickstartRepoFlag, "repo", quickstartRepoUrl, "Starter repository the sample workspace is created from")
+	quickstartCmd.Flags().BoolVar(&quickstartKeepFlag, "keep", false, "Keep the sample workspace instead of offering to delete it. The workspace is also kept when prompts are automatically confirmed")
+	quickstartCmd.Flags().BoolVarP(&quickstartYesFlag, "yes", "y", false, "Automatically confirm any prompts")
+}
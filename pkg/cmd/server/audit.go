@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var auditActionFlag string
+var auditResourceFlag string
+var auditSinceFlag string
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List the actions recorded in the server audit log",
+	Long:  "List the actions recorded in the server audit log, e.g. the autoscaler adding or draining hosts of a target, together with the reason they were taken. Requires an admin API key.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.ServerAPI.ListAuditEvents(cmd.Context())
+		if auditActionFlag != "" {
+			req = req.Action(auditActionFlag)
+		}
+		if auditResourceFlag != "" {
+			req = req.Resource(auditResourceFlag)
+		}
+		if auditSinceFlag != "" {
+			req = req.Since(auditSinceFlag)
+		}
+
+		events, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(events)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListAuditEvents(events)
+		return nil
+	},
+}
+
+func init() {
+	auditCmd.Flags().StringVar(&auditActionFlag, "action", "", "Only include events of the action, e.g. target.scale-up")
+	auditCmd.Flags().StringVar(&auditResourceFlag, "resource", "", "Only include events of the resource, e.g. a target name")
+	auditCmd.Flags().StringVar(&auditSinceFlag, "since", "", "Only include events recorded within the duration, e.g. 24h")
+	format.RegisterFormatFlag(auditCmd)
+}
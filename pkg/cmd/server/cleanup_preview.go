@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var cleanupPreviewCmd = &cobra.Command{
+	Use:   "cleanup-preview",
+	Short: "List the workspaces the cleanup policies would delete or stop",
+	Long:  "List the workspaces the configured cleanup policies would delete or stop on their next run. Policies are evaluated by the server every 5 minutes and are defined in the server config or with 'daytona server config apply'.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		candidates, res, err := apiClient.ServerAPI.PreviewCleanup(cmd.Context()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(candidates)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListCleanupCandidates(candidates)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(cleanupPreviewCmd)
+}
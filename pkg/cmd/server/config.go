@@ -33,4 +33,7 @@ var configCmd = &cobra.Command{
 
 func init() {
 	format.RegisterFormatFlag(configCmd)
+
+	configCmd.AddCommand(configExportCmd)
+	configCmd.AddCommand(configApplyCmd)
 }
@@ -0,0 +1,286 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/manifest"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var applyFileFlag string
+var applyDryRunFlag bool
+
+var configApplyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Create or update server configuration from declarative YAML",
+	Long:  "Create or update targets, project configs, prebuilds and policies from a multi-document YAML manifest, e.g. one produced by 'daytona server config export'. Resources that are not in the manifest are left untouched.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if applyFileFlag == "" {
+			return errors.New("a manifest file must be specified with --file")
+		}
+
+		var data []byte
+		var err error
+		if applyFileFlag == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(applyFileFlag)
+		}
+		if err != nil {
+			return err
+		}
+
+		documents, err := manifest.Parse(data)
+		if err != nil {
+			return err
+		}
+
+		if len(documents) == 0 {
+			views.RenderInfoMessage("No documents found in manifest")
+			return nil
+		}
+
+		manifest.Sort(documents)
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+
+		for _, document := range documents {
+			if applyDryRunFlag {
+				views.RenderInfoMessageBold(fmt.Sprintf("%s %s would be applied", document.Kind, document.Metadata.Name))
+				continue
+			}
+
+			switch document.Kind {
+			case manifest.KindTarget:
+				err = applyTarget(ctx, apiClient, document)
+			case manifest.KindProjectConfig:
+				err = applyProjectConfig(ctx, apiClient, document)
+			case manifest.KindPrebuild:
+				err = applyPrebuild(ctx, apiClient, document)
+			case manifest.KindImagePolicy:
+				err = applyImagePolicy(ctx, apiClient, document)
+			case manifest.KindCleanupPolicy:
+				err = applyCleanupPolicy(ctx, apiClient, document)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to apply %s %s: %w", document.Kind, document.Metadata.Name, err)
+			}
+
+			views.RenderInfoMessageBold(fmt.Sprintf("%s %s applied", document.Kind, document.Metadata.Name))
+		}
+
+		return nil
+	},
+}
+
+func applyTarget(ctx context.Context, apiClient *apiclient.APIClient, document manifest.Document) error {
+	var spec manifest.TargetSpec
+	err := document.DecodeSpec(&spec)
+	if err != nil {
+		return err
+	}
+
+	if spec.Provider.Name == "" {
+		return errors.New("spec.provider.name is required")
+	}
+
+	options := "{}"
+	if len(spec.Options) > 0 {
+		optionsJson, err := json.Marshal(spec.Options)
+		if err != nil {
+			return err
+		}
+		options = string(optionsJson)
+	}
+
+	res, err := apiClient.TargetAPI.SetTarget(ctx).Target(apiclient.CreateProviderTargetDTO{
+		Name:         document.Metadata.Name,
+		Options:      options,
+		ProviderInfo: spec.Provider,
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if spec.Default {
+		res, err = apiClient.TargetAPI.SetDefaultTarget(ctx, document.Metadata.Name).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+	}
+
+	return nil
+}
+
+func applyProjectConfig(ctx context.Context, apiClient *apiclient.APIClient, document manifest.Document) error {
+	var spec manifest.ProjectConfigSpec
+	err := document.DecodeSpec(&spec)
+	if err != nil {
+		return err
+	}
+
+	if spec.RepositoryUrl == "" {
+		return errors.New("spec.repositoryUrl is required")
+	}
+
+	envVars := spec.EnvVars
+	if envVars == nil {
+		envVars = map[string]string{}
+	}
+
+	res, err := apiClient.ProjectConfigAPI.SetProjectConfig(ctx).ProjectConfig(apiclient.CreateProjectConfigDTO{
+		Name:                document.Metadata.Name,
+		RepositoryUrl:       spec.RepositoryUrl,
+		Image:               spec.Image,
+		User:                spec.User,
+		BuildConfig:         spec.BuildConfig,
+		EnvVars:             envVars,
+		GitProviderConfigId: spec.GitProviderConfigId,
+		Mounts:              spec.Mounts,
+		Commands:            spec.Commands,
+		Ports:               spec.Ports,
+		BuildSecrets:        spec.BuildSecrets,
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if spec.Default {
+		res, err = apiClient.ProjectConfigAPI.SetDefaultProjectConfig(ctx, document.Metadata.Name).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+	}
+
+	return nil
+}
+
+func applyPrebuild(ctx context.Context, apiClient *apiclient.APIClient, document manifest.Document) error {
+	var spec manifest.PrebuildSpec
+	err := document.DecodeSpec(&spec)
+	if err != nil {
+		return err
+	}
+
+	if spec.ProjectConfig == "" || spec.Branch == "" {
+		return errors.New("spec.projectConfig and spec.branch are required")
+	}
+
+	existing, res, err := apiClient.PrebuildAPI.ListPrebuildsForProjectConfig(ctx, spec.ProjectConfig).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	prebuild := apiclient.CreatePrebuildDTO{
+		Branch:         &spec.Branch,
+		CommitInterval: spec.CommitInterval,
+		TriggerFiles:   spec.TriggerFiles,
+		Retention:      spec.Retention,
+		Matrix:         spec.Matrix,
+		BuildSecrets:   spec.BuildSecrets,
+	}
+
+	// Prebuilds are matched by branch so that re-applying a manifest updates
+	// the existing prebuild instead of failing with a duplicate
+	for _, p := range existing {
+		if p.Branch == spec.Branch {
+			prebuild.Id = &p.Id
+			break
+		}
+	}
+
+	_, res, err = apiClient.PrebuildAPI.SetPrebuild(ctx, spec.ProjectConfig).Prebuild(prebuild).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func applyImagePolicy(ctx context.Context, apiClient *apiclient.APIClient, document manifest.Document) error {
+	if document.Metadata.Name != manifest.ImagePolicyName {
+		return fmt.Errorf("the image policy must be named %q", manifest.ImagePolicyName)
+	}
+
+	var spec manifest.ImagePolicySpec
+	err := document.DecodeSpec(&spec)
+	if err != nil {
+		return err
+	}
+
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	imagePolicy := apiclient.ImagePolicyConfig(spec)
+	serverConfig.ImagePolicy = &imagePolicy
+
+	_, res, err = apiClient.ServerAPI.SetConfig(ctx).Config(*serverConfig).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func applyCleanupPolicy(ctx context.Context, apiClient *apiclient.APIClient, document manifest.Document) error {
+	var spec manifest.CleanupPolicySpec
+	err := document.DecodeSpec(&spec)
+	if err != nil {
+		return err
+	}
+
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	policy := apiclient.CleanupPolicyConfig{
+		Name:       document.Metadata.Name,
+		Selector:   &spec.Selector,
+		MaxAge:     &spec.MaxAge,
+		MaxRunning: &spec.MaxRunning,
+		MaxIdle:    &spec.MaxIdle,
+		DryRun:     &spec.DryRun,
+	}
+
+	index := slices.IndexFunc(serverConfig.CleanupPolicies, func(p apiclient.CleanupPolicyConfig) bool {
+		return p.Name == policy.Name
+	})
+	if index >= 0 {
+		serverConfig.CleanupPolicies[index] = policy
+	} else {
+		serverConfig.CleanupPolicies = append(serverConfig.CleanupPolicies, policy)
+	}
+
+	_, res, err = apiClient.ServerAPI.SetConfig(ctx).Config(*serverConfig).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func init() {
+	configApplyCmd.Flags().StringVarP(&applyFileFlag, "file", "f", "", "Path to the manifest file, or - to read from stdin")
+	configApplyCmd.Flags().BoolVar(&applyDryRunFlag, "dry-run", false, "Validate the manifest and list the documents without applying them")
+}
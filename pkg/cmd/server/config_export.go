@@ -0,0 +1,225 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/manifest"
+	"github.com/spf13/cobra"
+)
+
+var exportKindsFlag []string
+var exportOutputFlag string
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export targets, project configs, prebuilds and policies as declarative YAML",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kinds := manifest.Kinds
+		if len(exportKindsFlag) > 0 {
+			kinds = []manifest.Kind{}
+			for _, k := range exportKindsFlag {
+				kind, err := manifest.ParseKind(k)
+				if err != nil {
+					return err
+				}
+				kinds = append(kinds, kind)
+			}
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+		documents := []manifest.Document{}
+
+		for _, kind := range manifest.Kinds {
+			if !slices.Contains(kinds, kind) {
+				continue
+			}
+
+			var exported []manifest.Document
+			switch kind {
+			case manifest.KindTarget:
+				exported, err = exportTargets(ctx, apiClient)
+			case manifest.KindProjectConfig:
+				exported, err = exportProjectConfigs(ctx, apiClient)
+			case manifest.KindPrebuild:
+				exported, err = exportPrebuilds(ctx, apiClient)
+			case manifest.KindImagePolicy:
+				exported, err = exportImagePolicy(ctx, apiClient)
+			case manifest.KindCleanupPolicy:
+				exported, err = exportCleanupPolicies(ctx, apiClient)
+			}
+			if err != nil {
+				return err
+			}
+
+			documents = append(documents, exported...)
+		}
+
+		data, err := manifest.Marshal(documents)
+		if err != nil {
+			return err
+		}
+
+		if exportOutputFlag != "" {
+			return os.WriteFile(exportOutputFlag, data, 0644)
+		}
+
+		fmt.Print(string(data))
+		return nil
+	},
+}
+
+func exportTargets(ctx context.Context, apiClient *apiclient.APIClient) ([]manifest.Document, error) {
+	targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	documents := []manifest.Document{}
+	for _, target := range targets {
+		spec := manifest.TargetSpec{
+			Provider: target.ProviderInfo,
+			Default:  target.IsDefault,
+		}
+
+		if target.Options != "" {
+			err = json.Unmarshal([]byte(target.Options), &spec.Options)
+			if err != nil {
+				return nil, fmt.Errorf("target %s has invalid options: %w", target.Name, err)
+			}
+		}
+
+		document, err := manifest.NewDocument(manifest.KindTarget, target.Name, spec)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	return documents, nil
+}
+
+func exportProjectConfigs(ctx context.Context, apiClient *apiclient.APIClient) ([]manifest.Document, error) {
+	projectConfigs, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	documents := []manifest.Document{}
+	for _, pc := range projectConfigs {
+		spec := manifest.ProjectConfigSpec{
+			RepositoryUrl:       pc.RepositoryUrl,
+			BuildConfig:         pc.BuildConfig,
+			EnvVars:             pc.EnvVars,
+			GitProviderConfigId: pc.GitProviderConfigId,
+			Default:             pc.Default,
+			Mounts:              pc.Mounts,
+			Commands:            pc.Commands,
+			Ports:               pc.Ports,
+			BuildSecrets:        pc.BuildSecrets,
+		}
+		if pc.Image != "" {
+			spec.Image = &pc.Image
+		}
+		if pc.User != "" {
+			spec.User = &pc.User
+		}
+
+		document, err := manifest.NewDocument(manifest.KindProjectConfig, pc.Name, spec)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	return documents, nil
+}
+
+func exportPrebuilds(ctx context.Context, apiClient *apiclient.APIClient) ([]manifest.Document, error) {
+	prebuilds, res, err := apiClient.PrebuildAPI.ListPrebuilds(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	documents := []manifest.Document{}
+	for _, prebuild := range prebuilds {
+		spec := manifest.PrebuildSpec{
+			ProjectConfig:  prebuild.ProjectConfigName,
+			Branch:         prebuild.Branch,
+			CommitInterval: prebuild.CommitInterval,
+			TriggerFiles:   prebuild.TriggerFiles,
+			Retention:      prebuild.Retention,
+			Matrix:         prebuild.Matrix,
+			BuildSecrets:   prebuild.BuildSecrets,
+		}
+
+		document, err := manifest.NewDocument(manifest.KindPrebuild, prebuild.Id, spec)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	return documents, nil
+}
+
+func exportImagePolicy(ctx context.Context, apiClient *apiclient.APIClient) ([]manifest.Document, error) {
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if serverConfig.ImagePolicy == nil {
+		return []manifest.Document{}, nil
+	}
+
+	document, err := manifest.NewDocument(manifest.KindImagePolicy, manifest.ImagePolicyName, manifest.ImagePolicySpec(*serverConfig.ImagePolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	return []manifest.Document{*document}, nil
+}
+
+func exportCleanupPolicies(ctx context.Context, apiClient *apiclient.APIClient) ([]manifest.Document, error) {
+	serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	documents := []manifest.Document{}
+	for _, policy := range serverConfig.CleanupPolicies {
+		document, err := manifest.NewDocument(manifest.KindCleanupPolicy, policy.Name, manifest.CleanupPolicySpec{
+			Selector:   policy.GetSelector(),
+			MaxAge:     policy.GetMaxAge(),
+			MaxRunning: policy.GetMaxRunning(),
+			MaxIdle:    policy.GetMaxIdle(),
+			DryRun:     policy.GetDryRun(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, *document)
+	}
+
+	return documents, nil
+}
+
+func init() {
+	configExportCmd.Flags().StringSliceVarP(&exportKindsFlag, "kind", "k", nil, "Only export documents of the given kinds (Target, ProjectConfig, Prebuild, ImagePolicy, CleanupPolicy)")
+	configExportCmd.Flags().StringVarP(&exportOutputFlag, "output", "o", "", "Write the manifest to a file instead of stdout")
+}
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var creationTimingsTargetFlag string
+var creationTimingsProviderFlag string
+var creationTimingsSinceFlag string
+
+var creationTimingsCmd = &cobra.Command{
+	Use:   "creation-timings",
+	Short: "Show how long each phase of workspace creation takes",
+	Long:  "Show the 50th, 90th and 99th percentile durations of each workspace creation phase, e.g. image pull, build, clone and agent boot, per provider and target.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.ServerAPI.GetCreationTimingReport(cmd.Context())
+		if creationTimingsTargetFlag != "" {
+			req = req.Target(creationTimingsTargetFlag)
+		}
+		if creationTimingsProviderFlag != "" {
+			req = req.Provider(creationTimingsProviderFlag)
+		}
+		if creationTimingsSinceFlag != "" {
+			req = req.Since(creationTimingsSinceFlag)
+		}
+
+		report, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(report)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListCreationTimings(report)
+		return nil
+	},
+}
+
+func init() {
+	creationTimingsCmd.Flags().StringVar(&creationTimingsTargetFlag, "target", "", "Only include workspaces created on the target")
+	creationTimingsCmd.Flags().StringVar(&creationTimingsProviderFlag, "provider", "", "Only include workspaces created by the provider")
+	creationTimingsCmd.Flags().StringVar(&creationTimingsSinceFlag, "since", "", "Only include workspaces created within the duration, e.g. 24h")
+	format.RegisterFormatFlag(creationTimingsCmd)
+}
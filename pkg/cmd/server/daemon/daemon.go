@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"html"
 	"os"
 	"runtime"
 	"strings"
@@ -39,6 +40,17 @@ func Start(logFilePath string) error {
 	}
 
 	_, err = os.Stat(serviceFilePath)
+	if err == nil && isOutdated(serviceFilePath) {
+		// Replace units installed by older versions so they pick up the
+		// current restart and logging settings
+		_ = s.Stop()
+		err = s.Uninstall()
+		if err != nil {
+			return err
+		}
+		_, err = os.Stat(serviceFilePath)
+	}
+
 	if os.IsNotExist(err) {
 		err = s.Install()
 		if err != nil {
@@ -132,6 +144,9 @@ func getServiceConfig() (*service.Config, error) {
 		Arguments:   []string{"daemon-serve"},
 	}
 
+	svcConfig.Option = service.KeyValue{}
+	svcConfig.EnvVars = util.GetEnvVarsFromShell()
+
 	switch runtime.GOOS {
 	case "linux":
 		// Fix for running as root on Linux
@@ -141,13 +156,21 @@ func getServiceConfig() (*service.Config, error) {
 		if !strings.HasSuffix(service.Platform(), "systemd") {
 			return nil, fmt.Errorf("on Linux, `server` is only supported with systemd. %s detected", service.Platform())
 		}
-		fallthrough
+		svcConfig.Option["SystemdScript"] = systemdUnit
 	case "darwin":
-		if user != "" && user != "root" {
-			svcConfig.Option = service.KeyValue{"UserService": true}
+		// launchd has no log management of its own, so the output file is
+		// passed to the server which rotates it
+		outputFilePath, err := getOutputFilePath()
+		if err != nil {
+			return nil, err
 		}
+		svcConfig.Option["LaunchdConfig"] = fmt.Sprintf(launchdPlist, html.EscapeString(outputFilePath))
+		svcConfig.EnvVars[OutputFileEnvVar] = outputFilePath
+	}
+
+	if user != "" && user != "root" {
+		svcConfig.Option["UserService"] = true
 	}
-	svcConfig.EnvVars = util.GetEnvVarsFromShell()
 
 	return svcConfig, nil
 }
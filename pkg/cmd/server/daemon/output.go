@@ -0,0 +1,121 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// OutputFileEnvVar is set for daemons whose service manager writes the
+// process output to a plain file (launchd)
+const OutputFileEnvVar = "DAYTONA_DAEMON_OUTPUT_FILE"
+
+const outputFileName = "daytona-daemon.log"
+
+const rotateCheckInterval = time.Minute
+
+func getOutputFilePath() (string, error) {
+	c, err := server.GetConfig()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(filepath.Dir(c.LogFile.Path), outputFileName), nil
+}
+
+func isOutdated(serviceFilePath string) bool {
+	content, err := os.ReadFile(serviceFilePath)
+	if err != nil {
+		return false
+	}
+
+	return !strings.Contains(string(content), unitMarker)
+}
+
+// RotateOutput keeps the daemon output file below maxSizeMb until ctx is done.
+// The service manager keeps the file open, so it is copied and truncated
+// in place instead of renamed.
+func RotateOutput(ctx context.Context, path string, maxSizeMb, maxBackups int) {
+	ticker := time.NewTicker(rotateCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		err := rotateOutputFile(path, maxSizeMb, maxBackups)
+		if err != nil {
+			log.Errorf("failed to rotate daemon output file: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func rotateOutputFile(path string, maxSizeMb, maxBackups int) error {
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if info.Size() < int64(maxSizeMb)*1024*1024 {
+		return nil
+	}
+
+	if maxBackups < 1 {
+		maxBackups = 1
+	}
+
+	_ = os.Remove(backupPath(path, maxBackups))
+	for i := maxBackups - 1; i >= 1; i-- {
+		err = os.Rename(backupPath(path, i), backupPath(path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err = copyFile(path, backupPath(path, 1))
+	if err != nil {
+		return err
+	}
+
+	return os.Truncate(path, 0)
+}
+
+// backupPath names backups daytona-daemon-N.log so they are listed by `daytona server logs`
+func backupPath(path string, index int) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(path, ext), index, ext)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateOutputFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, outputFileName)
+
+	content := strings.Repeat("a", 1024*1024)
+	require.Nil(t, os.WriteFile(path, []byte(content), 0644))
+
+	// Below the size limit nothing is rotated
+	require.Nil(t, rotateOutputFile(path, 2, 2))
+	_, err := os.Stat(backupPath(path, 1))
+	require.True(t, os.IsNotExist(err))
+
+	for i := 0; i < 3; i++ {
+		require.Nil(t, os.WriteFile(path, []byte(content), 0644))
+		require.Nil(t, rotateOutputFile(path, 1, 2))
+	}
+
+	info, err := os.Stat(path)
+	require.Nil(t, err)
+	require.Zero(t, info.Size())
+
+	require.Equal(t, filepath.Join(dir, "daytona-daemon-1.log"), backupPath(path, 1))
+	for i := 1; i <= 2; i++ {
+		backup, err := os.ReadFile(backupPath(path, i))
+		require.Nil(t, err)
+		require.Len(t, backup, len(content))
+	}
+
+	_, err = os.Stat(backupPath(path, 3))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestIsOutdated(t *testing.T) {
+	dir := t.TempDir()
+
+	legacy := filepath.Join(dir, "legacy.service")
+	require.Nil(t, os.WriteFile(legacy, []byte("[Unit]\nDescription=Daytona Server daemon.\n"), 0644))
+	require.True(t, isOutdated(legacy))
+
+	current := filepath.Join(dir, "current.service")
+	require.Nil(t, os.WriteFile(current, []byte(systemdUnit), 0644))
+	require.False(t, isOutdated(current))
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kardianos/service"
+	"github.com/shirou/gopsutil/process"
+)
+
+type DaemonStatus struct {
+	Installed       bool       `json:"installed"`
+	Running         bool       `json:"running"`
+	Outdated        bool       `json:"outdated"`
+	ServiceManager  string     `json:"serviceManager"`
+	ServiceFilePath string     `json:"serviceFilePath"`
+	Pid             int32      `json:"pid,omitempty"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	CpuPercent      float64    `json:"cpuPercent,omitempty"`
+	MemoryBytes     uint64     `json:"memoryBytes,omitempty"`
+	Threads         int32      `json:"threads,omitempty"`
+	LogCommand      string     `json:"logCommand,omitempty"`
+}
+
+func Status() (*DaemonStatus, error) {
+	cfg, err := getServiceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := service.New(program{}, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceFilePath, err := getServiceFilePath(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &DaemonStatus{
+		ServiceManager:  service.Platform(),
+		ServiceFilePath: serviceFilePath,
+		LogCommand:      getLogCommand(cfg),
+	}
+
+	_, err = os.Stat(serviceFilePath)
+	if os.IsNotExist(err) {
+		return status, nil
+	}
+
+	status.Installed = true
+	status.Outdated = isOutdated(serviceFilePath)
+
+	serviceStatus, err := s.Status()
+	if err != nil && !errors.Is(err, service.ErrNotInstalled) {
+		return nil, err
+	}
+	status.Running = serviceStatus == service.StatusRunning
+	if !status.Running {
+		return status, nil
+	}
+
+	pid, err := getPid(cfg)
+	if err != nil || pid == 0 {
+		// Resource usage is best effort, the daemon state is still accurate
+		return status, nil
+	}
+	status.Pid = pid
+
+	p, err := process.NewProcess(pid)
+	if err != nil {
+		return status, nil
+	}
+
+	if createTime, err := p.CreateTime(); err == nil {
+		startedAt := time.UnixMilli(createTime)
+		status.StartedAt = &startedAt
+	}
+	if cpuPercent, err := p.CPUPercent(); err == nil {
+		status.CpuPercent = cpuPercent
+	}
+	if memoryInfo, err := p.MemoryInfo(); err == nil {
+		status.MemoryBytes = memoryInfo.RSS
+	}
+	if threads, err := p.NumThreads(); err == nil {
+		status.Threads = threads
+	}
+
+	return status, nil
+}
+
+func getLogCommand(cfg *service.Config) string {
+	switch runtime.GOOS {
+	case "linux":
+		if userService, _ := cfg.Option["UserService"].(bool); userService {
+			return "journalctl --user -t " + syslogIdentifier + " -f"
+		}
+		return "journalctl -t " + syslogIdentifier + " -f"
+	case "darwin":
+		if outputFilePath, ok := cfg.EnvVars[OutputFileEnvVar]; ok {
+			return "tail -f " + outputFilePath
+		}
+	}
+
+	return ""
+}
+
+var launchdPidRegex = regexp.MustCompile(`"PID"\s*=\s*(\d+);`)
+
+func getPid(cfg *service.Config) (int32, error) {
+	userService, _ := cfg.Option["UserService"].(bool)
+
+	var out []byte
+	var err error
+
+	switch runtime.GOOS {
+	case "linux":
+		args := []string{"show", "--property", "MainPID", "--value", cfg.Name}
+		if userService {
+			args = append([]string{"--user"}, args...)
+		}
+		out, err = exec.Command("systemctl", args...).Output()
+		if err != nil {
+			return 0, err
+		}
+	case "darwin":
+		out, err = exec.Command("launchctl", "list", cfg.Name).Output()
+		if err != nil {
+			return 0, err
+		}
+		match := launchdPidRegex.FindSubmatch(out)
+		if match == nil {
+			return 0, errors.New("daemon PID not found")
+		}
+		out = match[1]
+	default:
+		return 0, errors.New("daemon mode not supported on current OS")
+	}
+
+	pid, err := strconv.ParseInt(strings.TrimSpace(string(out)), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(pid), nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package daemon
+
+// unitMarker is written into every service file so that units installed
+// by older versions can be detected and replaced on the next start
+const unitMarker = "daytona-unit-version: 2"
+
+const syslogIdentifier = "daytona-server"
+
+// systemdUnit restarts the server on failure with a back-off, sends its
+// output to journald and only gives up if it crashes repeatedly
+const systemdUnit = `# ` + unitMarker + `
+[Unit]
+Description={{.Description}}
+ConditionFileIsExecutable={{.Path|cmdEscape}}
+After=network-online.target
+Wants=network-online.target
+StartLimitIntervalSec=300
+StartLimitBurst=5
+
+[Service]
+Type=simple
+ExecStart={{.Path|cmdEscape}}{{range .Arguments}} {{.|cmd}}{{end}}
+{{- if .WorkingDirectory}}
+WorkingDirectory={{.WorkingDirectory|cmdEscape}}
+{{- end}}
+{{- if .UserName}}
+User={{.UserName}}
+{{- end}}
+Restart=on-failure
+RestartSec=5
+TimeoutStopSec=30
+KillMode=mixed
+LimitNOFILE=65536
+StandardOutput=journal
+StandardError=journal
+SyslogIdentifier=` + syslogIdentifier + `
+{{range $k, $v := .EnvVars -}}
+Environment={{printf "%s=%s" $k $v | cmd}}
+{{end}}
+[Install]
+WantedBy={{if .Option.UserService}}default.target{{else}}multi-user.target{{end}}
+`
+
+// launchdPlist restarts the server unless it exits cleanly. The output path
+// placeholder is filled in by getServiceConfig.
+const launchdPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- ` + unitMarker + ` -->
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{html .Name}}</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>{{html .Path}}</string>
+		{{- range .Config.Arguments}}
+		<string>{{html .}}</string>
+		{{- end}}
+	</array>
+	{{- if .EnvVars}}
+	<key>EnvironmentVariables</key>
+	<dict>
+		{{- range $k, $v := .EnvVars}}
+		<key>{{html $k}}</key>
+		<string>{{html $v}}</string>
+		{{- end}}
+	</dict>
+	{{- end}}
+	{{- if .UserName}}
+	<key>UserName</key>
+	<string>{{html .UserName}}</string>
+	{{- end}}
+	{{- if .WorkingDirectory}}
+	<key>WorkingDirectory</key>
+	<string>{{html .WorkingDirectory}}</string>
+	{{- end}}
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+	<key>ThrottleInterval</key>
+	<integer>10</integer>
+	<key>ProcessType</key>
+	<string>Background</string>
+	<key>SoftResourceLimits</key>
+	<dict>
+		<key>NumberOfFiles</key>
+		<integer>65536</integer>
+	</dict>
+	<key>StandardOutPath</key>
+	<string>%[1]s</string>
+	<key>StandardErrorPath</key>
+	<string>%[1]s</string>
+</dict>
+</plist>
+`
@@ -0,0 +1,180 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var impersonateReasonFlag string
+var impersonateDurationFlag time.Duration
+
+var impersonateCmd = &cobra.Command{
+	Use:   "impersonate",
+	Short: "Act on behalf of a user to support them",
+	Args:  cobra.NoArgs,
+}
+
+var impersonateStartCmd = &cobra.Command{
+	Use:   "start [USER]",
+	Short: "Start impersonating a user",
+	Long:  "Start impersonating a user with the active profile. Until the session ends or expires, commands of the profile create and inspect the workspaces of the user, and nothing else.\nThe user is notified and every request is recorded in the audit log.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if impersonateReasonFlag == "" {
+			return errors.New("a reason is required, e.g. the support ticket")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		if session := activeProfile.GetActiveImpersonation(); session != nil {
+			return fmt.Errorf("already impersonating %s, run `daytona server impersonate end` first", session.User)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		startImpersonationDto := apiclient.NewStartImpersonationDTO(impersonateReasonFlag, args[0])
+		if impersonateDurationFlag > 0 {
+			startImpersonationDto.SetDurationMinutes(int32(impersonateDurationFlag.Minutes()))
+		}
+
+		session, res, err := apiClient.ServerAPI.StartImpersonation(cmd.Context()).StartImpersonationDto(*startImpersonationDto).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		expiresAt, err := time.Parse(time.RFC3339, session.ExpiresAt)
+		if err != nil {
+			return err
+		}
+
+		activeProfile.Impersonation = &config.ImpersonationSession{
+			Id:        session.Id,
+			User:      session.User,
+			ExpiresAt: expiresAt,
+		}
+		err = c.EditProfile(activeProfile)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Impersonating %s until %s with profile '%s'", session.User, expiresAt.Local().Format("15:04"), activeProfile.Name))
+		return nil
+	},
+}
+
+var impersonateListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List impersonation sessions",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		sessions, res, err := apiClient.ServerAPI.ListImpersonationSessions(cmd.Context()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(sessions)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListImpersonationSessions(sessions)
+		return nil
+	},
+}
+
+var impersonateEndCmd = &cobra.Command{
+	Use:   "end [SESSION_ID]",
+	Short: "End an impersonation session",
+	Long:  "End an impersonation session. Ends the session of the active profile if no session ID is given.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		var sessionId string
+		if len(args) == 1 {
+			sessionId = args[0]
+		} else if activeProfile.Impersonation != nil {
+			sessionId = activeProfile.Impersonation.Id
+		} else {
+			return errors.New("the active profile is not impersonating a user")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		session, res, err := apiClient.ServerAPI.EndImpersonation(cmd.Context(), sessionId).Execute()
+		if err != nil {
+			// Sessions that expired can not be ended anymore, but are still cleared from the profile
+			expired := activeProfile.Impersonation != nil && activeProfile.Impersonation.Id == sessionId && activeProfile.GetActiveImpersonation() == nil
+			if !expired {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+		}
+
+		if activeProfile.Impersonation != nil && activeProfile.Impersonation.Id == sessionId {
+			activeProfile.Impersonation = nil
+			err = c.EditProfile(activeProfile)
+			if err != nil {
+				return err
+			}
+		}
+
+		if session == nil {
+			views.RenderInfoMessage("The impersonation session already expired")
+			return nil
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Stopped impersonating %s", session.User))
+		return nil
+	},
+}
+
+func init() {
+	impersonateStartCmd.Flags().StringVar(&impersonateReasonFlag, "reason", "", "Why the user is impersonated, e.g. a support ticket. Shown to the user")
+	impersonateStartCmd.Flags().DurationVar(&impersonateDurationFlag, "duration", 0, "How long the session lasts, e.g. 1h. Defaults to 30m")
+	format.RegisterFormatFlag(impersonateListCmd)
+
+	impersonateCmd.AddCommand(impersonateStartCmd)
+	impersonateCmd.AddCommand(impersonateListCmd)
+	impersonateCmd.AddCommand(impersonateEndCmd)
+}
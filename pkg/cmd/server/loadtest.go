@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/server/loadtest"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var loadtestWorkspacesFlag int
+var loadtestConcurrencyFlag int
+var loadtestProjectsFlag int
+var loadtestCyclesFlag int
+var loadtestProviderLatencyFlag time.Duration
+
+var loadtestCmd = &cobra.Command{
+	Use:   "loadtest",
+	Short: "Measure the throughput of the server under concurrent workspace operations",
+	Long:  "Create, stop, start and remove workspaces concurrently against the workspace service of the server backed by a temporary database and a mock provider, and report the throughput and latency percentiles of each operation along with the CPU and memory used by the server. Useful to size the control plane. The active server and its providers are not used.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, err := os.MkdirTemp("", "daytona-loadtest-")
+		if err != nil {
+			return err
+		}
+		defer os.RemoveAll(dir)
+
+		// Logs of the workspace service would drown the report
+		log.SetLevel(log.ErrorLevel)
+
+		workspaceService, err := loadtest.NewWorkspaceService(dir, loadtestProviderLatencyFlag)
+		if err != nil {
+			return err
+		}
+
+		if format.FormatFlag == "" {
+			views.RenderInfoMessageBold(fmt.Sprintf("Running %d workspaces with a concurrency of %d", loadtestWorkspacesFlag, loadtestConcurrencyFlag))
+		}
+
+		report, err := loadtest.Run(cmd.Context(), workspaceService, loadtest.Config{
+			Workspaces:  loadtestWorkspacesFlag,
+			Concurrency: loadtestConcurrencyFlag,
+			Projects:    loadtestProjectsFlag,
+			Cycles:      loadtestCyclesFlag,
+		})
+		if report == nil {
+			return err
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(report)
+			formattedData.Print()
+			return err
+		}
+
+		view.RenderLoadtestReport(report)
+		return err
+	},
+}
+
+func init() {
+	loadtestCmd.Flags().IntVarP(&loadtestWorkspacesFlag, "workspaces", "n", 100, "Number of workspaces to create")
+	loadtestCmd.Flags().IntVarP(&loadtestConcurrencyFlag, "concurrency", "c", 10, "Maximum number of workspaces operated on at the same time")
+	loadtestCmd.Flags().IntVar(&loadtestProjectsFlag, "projects", 1, "Number of projects of every workspace")
+	loadtestCmd.Flags().IntVar(&loadtestCyclesFlag, "cycles", 1, "Number of times every workspace is stopped and started before it is removed")
+	loadtestCmd.Flags().DurationVar(&loadtestProviderLatencyFlag, "provider-latency", 0, "Time the mock provider takes to complete each operation")
+	format.RegisterFormatFlag(loadtestCmd)
+}
@@ -4,8 +4,6 @@
 package logs
 
 import (
-	"context"
-
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/views/server"
 	"github.com/spf13/cobra"
@@ -16,7 +14,7 @@ var listCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	Short:   "Lists Daytona Server Log Files",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiclient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -40,7 +40,7 @@ var LogsCmd = &cobra.Command{
 	Use:   "logs",
 	Short: "Output Daytona Server logs",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		c, err := config.GetConfig()
 		if err != nil {
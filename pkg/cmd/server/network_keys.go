@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var networkKeysScopeFlag string
+var networkKeysScopeNameFlag string
+
+var networkKeysCmd = &cobra.Command{
+	Use:   "network-keys",
+	Short: "Manage the network keys issued by the server",
+	Args:  cobra.NoArgs,
+}
+
+var networkKeysListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List network keys",
+	Long:    "List the network keys issued by the server. Requires an admin API key.",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.ServerAPI.ListNetworkKeys(cmd.Context())
+		if networkKeysScopeFlag != "" {
+			req = req.Scope(networkKeysScopeFlag)
+		}
+		if networkKeysScopeNameFlag != "" {
+			req = req.ScopeName(networkKeysScopeNameFlag)
+		}
+
+		keys, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(keys)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListNetworkKeys(keys)
+		return nil
+	},
+}
+
+var networkKeysRevokeCmd = &cobra.Command{
+	Use:   "revoke [KEY_ID]",
+	Short: "Revoke network keys",
+	Long:  "Revoke a network key, or all keys of a scope with --scope and --scope-name, and remove the nodes that joined with them from the network. Requires an admin API key.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 1 {
+			res, err := apiClient.ServerAPI.RevokeNetworkKey(cmd.Context(), args[0]).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Network key %s revoked", args[0]))
+			return nil
+		}
+
+		if networkKeysScopeFlag == "" || networkKeysScopeNameFlag == "" {
+			return errors.New("a key ID or both --scope and --scope-name are required")
+		}
+
+		res, err := apiClient.ServerAPI.RevokeNetworkKeys(cmd.Context()).RevokeNetworkKeysDto(*apiclient.NewRevokeNetworkKeysDTO(networkKeysScopeFlag, networkKeysScopeNameFlag)).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Network keys of %s %s revoked", networkKeysScopeFlag, networkKeysScopeNameFlag))
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{networkKeysListCmd, networkKeysRevokeCmd} {
+		cmd.Flags().StringVar(&networkKeysScopeFlag, "scope", "", "Scope of the keys, one of workspace, client or provider")
+		cmd.Flags().StringVar(&networkKeysScopeNameFlag, "scope-name", "", "Workspace ID, API key name or provider name the keys were issued to")
+	}
+	format.RegisterFormatFlag(networkKeysListCmd)
+
+	networkKeysCmd.AddCommand(networkKeysListCmd)
+	networkKeysCmd.AddCommand(networkKeysRevokeCmd)
+}
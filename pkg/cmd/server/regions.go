@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var regionsCmd = &cobra.Command{
+	Use:   "regions",
+	Short: "Manage the regions of a server federation",
+	Args:  cobra.NoArgs,
+}
+
+var regionsListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List the region of the server and the regional servers registered with it",
+	Aliases: []string{"ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		regionList, res, err := apiClient.RegionAPI.ListRegions(cmd.Context()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(regionList)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListRegions(regionList)
+		return nil
+	},
+}
+
+var regionsRemoveCmd = &cobra.Command{
+	Use:     "remove [REGION]",
+	Short:   "Remove a region until its server registers again",
+	Aliases: []string{"rm", "delete"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.RegionAPI.RemoveRegion(cmd.Context(), args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Region %s removed", args[0]))
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(regionsListCmd)
+
+	regionsCmd.AddCommand(regionsListCmd)
+	regionsCmd.AddCommand(regionsRemoveCmd)
+}
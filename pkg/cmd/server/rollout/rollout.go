@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var RolloutCmd = &cobra.Command{
+	Use:   "rollout",
+	Short: "Manage staged agent upgrades",
+	Long:  "Manage staged agent upgrades.\nA rollout gives a new agent version to a percentage of workspaces the next time their projects start. Monitor the health of the canary workspaces, then promote the version to the whole fleet or roll it back.\nServer upgrades started with 'daytona server upgrade --agent-canary-percentage' stage the agent of the new version the same way.",
+	Args:  cobra.NoArgs,
+}
+
+func init() {
+	RolloutCmd.AddCommand(listCmd)
+	RolloutCmd.AddCommand(startCmd)
+	RolloutCmd.AddCommand(statusCmd)
+	RolloutCmd.AddCommand(promoteCmd)
+	RolloutCmd.AddCommand(rollbackCmd)
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var percentageFlag int32
+var maxErrorRateFlag float32
+
+var startCmd = &cobra.Command{
+	Use:   "start [AGENT_VERSION]",
+	Short: "Start rolling out an agent version to a percentage of workspaces",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiclient.CreateAgentRolloutDTO{
+			AgentVersion: args[0],
+			Percentage:   percentageFlag,
+		}
+		if cmd.Flags().Changed("max-error-rate") {
+			req.MaxErrorRate = &maxErrorRateFlag
+		}
+
+		r, res, err := apiClient.RolloutAPI.CreateRollout(context.Background()).Rollout(req).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Rollout %s started. %d workspace(s) will receive agent version %s the next time their projects start", r.Id, len(r.WorkspaceIds), r.AgentVersion))
+		return nil
+	},
+}
+
+func init() {
+	startCmd.Flags().Int32VarP(&percentageFlag, "percentage", "p", 10, "Percentage of workspaces that receive the new agent version")
+	startCmd.Flags().Float32Var(&maxErrorRateFlag, "max-error-rate", 0, "Roll back automatically once this share (0-1) of canary projects stops sending heartbeats")
+}
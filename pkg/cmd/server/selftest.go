@@ -0,0 +1,390 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+	"tailscale.com/tsnet"
+)
+
+const selftestOutput = "daytona-selftest"
+
+var selftestRepoFlag string
+var selftestTargetFlag string
+var selftestTimeoutFlag time.Duration
+var selftestKeepFlag bool
+
+var selftestCmd = &cobra.Command{
+	Use:   "selftest",
+	Short: "Run an end-to-end smoke test against the active Daytona Server",
+	Long:  "Create a workspace from a small public repository, wait for its agent, forward a port, run a command and delete the workspace, reporting the duration of every step. Useful after installing or upgrading the server.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), selftestTimeoutFlag)
+		defer cancel()
+
+		t := &selftest{
+			ctx:       ctx,
+			apiClient: apiClient,
+			profile:   activeProfile,
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Running selftest against %s", activeProfile.Api.Url))
+
+		t.run("Check server API", t.checkServer)
+		t.run("Select target", t.selectTarget)
+		t.run("Resolve repository", t.resolveRepository)
+		t.run("Create workspace", t.createWorkspace)
+		t.run("Wait for agent", t.waitForAgent)
+		t.run("Connect to project", t.connect)
+		t.run("Forward port", t.forwardPort)
+		t.run("Execute command", t.execCommand)
+
+		if t.listener != nil {
+			t.listener.Close()
+		}
+		if t.tsConn != nil {
+			t.tsConn.Close()
+		}
+
+		if t.workspaceId != "" {
+			if selftestKeepFlag {
+				t.skip("Delete workspace")
+				views.RenderInfoMessage(fmt.Sprintf("Workspace %s was kept for inspection", t.workspaceName))
+			} else {
+				t.always("Delete workspace", t.deleteWorkspace)
+			}
+		}
+
+		view.RenderSelftestSummary(t.steps)
+
+		if t.failed {
+			return errors.New("selftest failed")
+		}
+
+		return nil
+	},
+}
+
+type selftest struct {
+	ctx       context.Context
+	apiClient *apiclient.APIClient
+	profile   config.Profile
+
+	steps  []view.SelftestStep
+	failed bool
+
+	target        string
+	repository    *apiclient.GitRepository
+	workspaceId   string
+	workspaceName string
+	projectName   string
+	hostname      string
+	tsConn        *tsnet.Server
+	listener      net.Listener
+}
+
+// run executes a step unless a previous one failed
+func (t *selftest) run(name string, fn func() error) {
+	if t.failed {
+		t.skip(name)
+		return
+	}
+
+	t.always(name, fn)
+}
+
+func (t *selftest) always(name string, fn func() error) {
+	start := time.Now()
+	err := fn()
+
+	step := view.SelftestStep{
+		Name:     name,
+		Duration: time.Since(start),
+	}
+	if err != nil {
+		step.Error = err.Error()
+		t.failed = true
+	}
+
+	t.steps = append(t.steps, step)
+	view.RenderSelftestStep(step)
+}
+
+func (t *selftest) skip(name string) {
+	step := view.SelftestStep{Name: name, Skipped: true}
+	t.steps = append(t.steps, step)
+	view.RenderSelftestStep(step)
+}
+
+func (t *selftest) checkServer() error {
+	_, res, err := t.apiClient.ServerAPI.GetConfig(t.ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func (t *selftest) selectTarget() error {
+	targets, res, err := t.apiClient.TargetAPI.ListTargets(t.ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, target := range targets {
+		if (selftestTargetFlag != "" && target.Name == selftestTargetFlag) || (selftestTargetFlag == "" && target.IsDefault) {
+			t.target = target.Name
+			return nil
+		}
+	}
+
+	if selftestTargetFlag != "" {
+		return fmt.Errorf("target %s not found", selftestTargetFlag)
+	}
+
+	return errors.New("no default target set. Use --target to select one")
+}
+
+func (t *selftest) resolveRepository() error {
+	repo, res, err := t.apiClient.GitProviderAPI.GetGitContext(t.ctx).Repository(apiclient.GetRepositoryContext{
+		Url: selftestRepoFlag,
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	t.projectName, err = workspace_util.GetSanitizedProjectName(repo.Name)
+	if err != nil {
+		return err
+	}
+
+	t.repository = repo
+	return nil
+}
+
+func (t *selftest) createWorkspace() error {
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+	name := "selftest-" + id[:8]
+
+	workspace, res, err := t.apiClient.WorkspaceAPI.CreateWorkspace(t.ctx).Workspace(apiclient.CreateWorkspaceDTO{
+		Id:     id,
+		Name:   name,
+		Target: t.target,
+		Projects: []apiclient.CreateProjectDTO{
+			{
+				Name:    t.projectName,
+				EnvVars: map[string]string{},
+				Source: apiclient.CreateProjectSourceDTO{
+					Repository: *t.repository,
+				},
+			},
+		},
+	}).Execute()
+	// The server may have created the workspace even if the request failed
+	// (e.g. it timed out), so it is always scheduled for deletion
+	t.workspaceId = id
+	t.workspaceName = name
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	t.workspaceId = workspace.Id
+	return nil
+}
+
+func (t *selftest) waitForAgent() error {
+	for {
+		workspace, res, err := t.apiClient.WorkspaceAPI.GetWorkspace(t.ctx, t.workspaceId).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		for _, p := range workspace.Projects {
+			if p.Name == t.projectName && p.State != nil && p.State.Uptime > 0 {
+				t.hostname = p.GetHostname()
+				if t.hostname == "" {
+					t.hostname = project.GetProjectHostname(t.workspaceId, t.projectName)
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-t.ctx.Done():
+			return errors.New("timed out waiting for the agent to report its state")
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+func (t *selftest) connect() error {
+	var err error
+	t.tsConn, err = tailscale.GetConnection(&t.profile)
+	if err != nil {
+		return err
+	}
+
+	for {
+		conn, err := t.dialProject()
+		if err == nil {
+			return conn.Close()
+		}
+
+		select {
+		case <-t.ctx.Done():
+			return fmt.Errorf("could not reach the project over the tailnet: %w", err)
+		case <-time.After(500 * time.Millisecond):
+		}
+	}
+}
+
+func (t *selftest) dialProject() (net.Conn, error) {
+	return t.tsConn.Dial(t.ctx, "tcp", fmt.Sprintf("%s:%d", t.hostname, ssh_config.SSH_PORT))
+}
+
+// forwardPort exposes the project's SSH port on a local port and checks that
+// the SSH banner comes through
+func (t *selftest) forwardPort() error {
+	var err error
+	t.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			conn, err := t.listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				dialConn, err := t.dialProject()
+				if err != nil {
+					return
+				}
+				defer dialConn.Close()
+
+				go func() {
+					_, _ = io.Copy(dialConn, conn)
+				}()
+				_, _ = io.Copy(conn, dialConn)
+			}()
+		}
+	}()
+
+	conn, err := net.DialTimeout("tcp", t.listener.Addr().String(), 10*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	if err != nil {
+		return err
+	}
+
+	banner, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("no data received through the forwarded port: %w", err)
+	}
+
+	if !strings.HasPrefix(banner, "SSH-") {
+		return fmt.Errorf("unexpected data received through the forwarded port: %q", strings.TrimSpace(banner))
+	}
+
+	return nil
+}
+
+func (t *selftest) execCommand() error {
+	client, err := ssh.Dial("tcp", t.listener.Addr().String(), &ssh.ClientConfig{
+		User: "daytona",
+		// The connection is end-to-end encrypted by the tailnet
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	output, err := session.CombinedOutput("echo " + selftestOutput)
+	if err != nil {
+		return fmt.Errorf("command failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	if strings.TrimSpace(string(output)) != selftestOutput {
+		return fmt.Errorf("unexpected command output: %q", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+func (t *selftest) deleteWorkspace() error {
+	// The test context may have expired, cleanup gets its own deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	res, err := t.apiClient.WorkspaceAPI.RemoveWorkspace(ctx, t.workspaceId).Force(t.failed).Execute()
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
+
+func init() {
+	selftestCmd.Flags().StringVar(&selftestRepoFlag, "repo", "https://github.com/octocat/Hello-World", "Repository used for the test workspace")
+	selftestCmd.Flags().StringVarP(&selftestTargetFlag, "target", "t", "", "Target to create the test workspace on (defaults to the default target)")
+	selftestCmd.Flags().DurationVar(&selftestTimeoutFlag, "timeout", 10*time.Minute, "Maximum duration of the test, excluding cleanup")
+	selftestCmd.Flags().BoolVar(&selftestKeepFlag, "keep", false, "Keep the test workspace instead of deleting it")
+}
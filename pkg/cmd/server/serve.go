@@ -4,13 +4,16 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
 	"os/signal"
+	"path"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -20,26 +23,58 @@ import (
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/dns"
+	"github.com/daytonaio/daytona/pkg/imagepolicy"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/metering"
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/daytonaio/daytona/pkg/ociregistry"
 	"github.com/daytonaio/daytona/pkg/posthogservice"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/region"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/agentevents"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/artifacts"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/daytonaio/daytona/pkg/server/autoscaler"
 	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/buildsecrets"
+	"github.com/daytonaio/daytona/pkg/server/commandruns"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
+	"github.com/daytonaio/daytona/pkg/server/impersonation"
+	metering_service "github.com/daytonaio/daytona/pkg/server/metering"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/daytonaio/daytona/pkg/server/organizations"
+	"github.com/daytonaio/daytona/pkg/server/previewdns"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/regions"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/resourceusage"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
+	statehistory_service "github.com/daytonaio/daytona/pkg/server/statehistory"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/daytonaio/daytona/pkg/server/tunnels"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/statehistory"
+	"github.com/daytonaio/daytona/pkg/storage"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/views"
 	started_view "github.com/daytonaio/daytona/pkg/views/server/started"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -78,6 +113,16 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
+		err = server.ValidateLogLevels(c.LogLevels)
+		if err != nil {
+			return err
+		}
+
+		if _, logLevelSet := os.LookupEnv("LOG_LEVEL"); !logLevelSet && c.LogLevels != nil && c.LogLevels.Server != "" {
+			logLevel, _ := log.ParseLevel(c.LogLevels.Server)
+			log.SetLevel(logLevel)
+		}
+
 		telemetryService := posthogservice.NewTelemetryService(posthogservice.PosthogServiceConfig{
 			ApiKey:   internal.PosthogApiKey,
 			Endpoint: internal.PosthogEndpoint,
@@ -90,6 +135,8 @@ var ServeCmd = &cobra.Command{
 			Version:          internal.Version,
 			ServerId:         c.Id,
 			Frps:             c.Frps,
+			Auth:             c.Auth,
+			LogLevel:         getLogLevel(c.LogLevels, func(l *server.LogLevelsConfig) string { return l.Api }),
 		})
 
 		server, err := GetInstance(c, configDir, internal.Version, telemetryService)
@@ -175,8 +222,13 @@ var ServeCmd = &cobra.Command{
 			return err
 		}
 
+		if outputFilePath := os.Getenv(daemon.OutputFileEnvVar); outputFilePath != "" {
+			go daemon.RotateOutput(context.Background(), outputFilePath, c.LogFile.MaxSize, c.LogFile.MaxBackups)
+		}
+
 		interruptChannel := make(chan os.Signal, 1)
-		signal.Notify(interruptChannel, os.Interrupt)
+		// Service managers stop the daemon with SIGTERM
+		signal.Notify(interruptChannel, os.Interrupt, syscall.SIGTERM)
 
 		select {
 		case err := <-apiServerErrChan:
@@ -200,7 +252,16 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
-	loggerFactory := logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir)
+	logsStorage, err := getStorageBackend(c, "logs")
+	if err != nil {
+		return nil, err
+	}
+	loggerFactory := getLoggerFactory(&wsLogsDir, &buildLogsDir, logsStorage)
+
+	err = startLogRetentionPoller(c, logsStorage)
+	if err != nil {
+		return nil, err
+	}
 
 	dbPath, err := getDbPath()
 	if err != nil {
@@ -217,6 +278,10 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
+	buildSecretStore, err := db.NewBuildSecretStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
 	buildStore, err := db.NewBuildStore(dbConnection)
 	if err != nil {
 		return nil, err
@@ -225,6 +290,10 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
+	prebuildUsageStore, err := db.NewPrebuildUsageStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
 	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
 	if err != nil {
 		return nil, err
@@ -241,6 +310,75 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	if err != nil {
 		return nil, err
 	}
+	artifactStore, err := db.NewArtifactStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	commandRunStore, err := db.NewCommandRunStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	organizationStore, err := db.NewOrganizationStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	rolloutStore, err := db.NewRolloutStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	announcementStore, err := db.NewAnnouncementStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	sharedServiceStore, err := db.NewSharedServiceStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	creationTimingStore, err := db.NewCreationTimingStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	agentEventStore, err := db.NewAgentEventStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	resourceUsageStore, err := db.NewResourceUsageStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	stateSnapshotStore, err := db.NewStateSnapshotStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	networkKeyStore, err := db.NewNetworkKeyStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	regionStore, err := db.NewRegionStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+	auditEventStore, err := db.NewAuditEventStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	impersonationSessionStore, err := db.NewImpersonationSessionStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.ValidateDerpConfig(c.Derp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DERP config: %w", err)
+	}
+
+	if c.HostnameTemplate != "" {
+		err = project.ValidateHostnameTemplate(c.HostnameTemplate)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	headscaleServer := headscale.NewHeadscaleServer(&headscale.HeadscaleServerConfig{
 		ServerId:      c.Id,
@@ -249,16 +387,32 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		HeadscalePort: c.HeadscalePort,
 		ConfigDir:     filepath.Join(configDir, "headscale"),
 		Frps:          c.Frps,
+		Derp:          c.Derp,
+		LogLevel:      getLogLevel(c.LogLevels, func(l *server.LogLevelsConfig) string { return l.Headscale }),
 	})
 	err = headscaleServer.Init()
 	if err != nil {
 		return nil, err
 	}
 
+	networkKeyService := networkkeys.NewNetworkKeyService(networkkeys.NetworkKeyServiceConfig{
+		NetworkKeyStore: networkKeyStore,
+		ControlServer:   headscaleServer,
+	})
+
+	err = networkKeyService.StartRevocationPoller()
+	if err != nil {
+		return nil, err
+	}
+
 	containerRegistryService := containerregistries.NewContainerRegistryService(containerregistries.ContainerRegistryServiceConfig{
 		Store: containerRegistryStore,
 	})
 
+	buildSecretService := buildsecrets.NewBuildSecretService(buildsecrets.BuildSecretServiceConfig{
+		Store: buildSecretStore,
+	})
+
 	buildService := builds.NewBuildService(builds.BuildServiceConfig{
 		BuildStore:    buildStore,
 		LoggerFactory: loggerFactory,
@@ -274,6 +428,7 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 	projectConfigService := projectconfig.NewProjectConfigService(projectconfig.ProjectConfigServiceConfig{
 		PrebuildWebhookEndpoint: prebuildWebhookEndpoint,
 		ConfigStore:             projectConfigStore,
+		PrebuildUsageStore:      prebuildUsageStore,
 		BuildService:            buildService,
 		GitProviderService:      gitProviderService,
 	})
@@ -283,9 +438,36 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		return nil, err
 	}
 
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: apiKeyStore,
+	})
+
+	organizationService := organizations.NewOrganizationService(organizations.OrganizationServiceConfig{
+		OrganizationStore:  organizationStore,
+		ApiKeyService:      apiKeyService,
+		WorkspaceStore:     workspaceStore,
+		TargetStore:        providerTargetStore,
+		ProjectConfigStore: projectConfigStore,
+	})
+
+	rolloutService := rollouts.NewRolloutService(rollouts.RolloutServiceConfig{
+		RolloutStore:   rolloutStore,
+		WorkspaceStore: workspaceStore,
+		ServerVersion:  version,
+	})
+
+	err = rolloutService.StartPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	announcementService := announcements.NewAnnouncementService(announcements.AnnouncementServiceConfig{
+		AnnouncementStore: announcementStore,
+	})
+
 	var localContainerRegistry server.ILocalContainerRegistry
 
-	if c.BuilderRegistryServer != "local" {
+	if c.BuilderRegistryServer != "local" && c.BuilderRegistryServer != "embedded" {
 		_, err := containerRegistryService.Find(c.BuilderRegistryServer)
 		if err != nil {
 			log.Errorf("Failed to find container registry credentials for builder registry server %s\n", c.BuilderRegistryServer)
@@ -312,14 +494,18 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		c.BuilderRegistryServer = util.GetFrpcRegistryDomain(c.Id, c.Frps.Domain)
 	}
 
+	if c.BuilderRegistryServer == "embedded" {
+		localContainerRegistry, err = getEmbeddedContainerRegistry(c, configDir, apiKeyService, containerRegistryService)
+		if err != nil {
+			return nil, err
+		}
+		c.BuilderRegistryServer = util.GetFrpcRegistryDomain(c.Id, c.Frps.Domain)
+	}
+
 	providerTargetService := providertargets.NewProviderTargetService(providertargets.ProviderTargetServiceConfig{
 		TargetStore: providerTargetStore,
 	})
 
-	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
-		ApiKeyStore: apiKeyStore,
-	})
-
 	headscaleUrl := util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
 
 	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{
@@ -332,7 +518,11 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		RegistryUrl:           c.RegistryUrl,
 		BaseDir:               c.ProvidersDir,
 		CreateProviderNetworkKey: func(providerName string) (string, error) {
-			return headscaleServer.CreateAuthKey()
+			key, err := networkKeyService.Generate(networkkey.ScopeProvider, providerName, false)
+			if err != nil {
+				return "", err
+			}
+			return key.Key, nil
 		},
 		ServerPort: c.HeadscalePort,
 		ApiPort:    c.ApiPort,
@@ -342,55 +532,470 @@ func GetInstance(c *server.Config, configDir string, version string, telemetrySe
 		ProviderManager: providerManager,
 	})
 
-	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+	var imagePolicy imagepolicy.IImagePolicy
+	if c.ImagePolicy != nil {
+		imagePolicy = imagepolicy.NewImagePolicy(imagepolicy.ImagePolicyConfig{
+			AllowedRegistries: c.ImagePolicy.AllowedRegistries,
+			VerifySignatures:  c.ImagePolicy.VerifySignatures,
+			CosignPublicKey:   c.ImagePolicy.CosignPublicKey,
+		})
+	}
+
+	cleanupPolicies, err := server.GetCleanupPolicies(c.CleanupPolicies)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedServiceService := sharedservices.NewSharedServiceService(sharedservices.SharedServiceServiceConfig{
+		SharedServiceStore:       sharedServiceStore,
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              providerTargetStore,
-		ApiKeyService:            apiKeyService,
-		GitProviderService:       gitProviderService,
 		ContainerRegistryService: containerRegistryService,
-		BuilderImage:             c.BuilderImage,
-		BuildService:             buildService,
-		ProjectConfigService:     projectConfigService,
-		ServerApiUrl:             util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain),
-		ServerVersion:            version,
-		ServerUrl:                headscaleUrl,
-		DefaultProjectImage:      c.DefaultProjectImage,
-		DefaultProjectUser:       c.DefaultProjectUser,
 		Provisioner:              provisioner,
-		LoggerFactory:            loggerFactory,
-		TelemetryService:         telemetryService,
 	})
 
+	creationTimingService := creationtimings.NewCreationTimingService(creationtimings.CreationTimingServiceConfig{
+		CreationTimingStore: creationTimingStore,
+	})
+
+	agentEventService := agentevents.NewAgentEventService(agentevents.AgentEventServiceConfig{
+		AgentEventStore: agentEventStore,
+	})
+
+	err = agentEventService.StartPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	resourceUsageService := resourceusage.NewResourceUsageService(resourceusage.ResourceUsageServiceConfig{
+		ResourceUsageStore: resourceUsageStore,
+	})
+
+	err = resourceUsageService.StartPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	previewDnsService, err := getPreviewDnsService(c, configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	tailnetCa, err := tailnetca.LoadOrCreate(filepath.Join(configDir, "tailnet-ca"), project.TailnetDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:                workspaceStore,
+		TargetStore:                   providerTargetStore,
+		ApiKeyService:                 apiKeyService,
+		OrganizationService:           organizationService,
+		RolloutService:                rolloutService,
+		SharedServiceService:          sharedServiceService,
+		CreationTimingService:         creationTimingService,
+		AgentEventService:             agentEventService,
+		ResourceUsageService:          resourceUsageService,
+		NetworkKeyService:             networkKeyService,
+		PreviewDnsService:             previewDnsService,
+		TailnetCa:                     tailnetCa,
+		GitProviderService:            gitProviderService,
+		ContainerRegistryService:      containerRegistryService,
+		BuilderImage:                  c.BuilderImage,
+		ImagePolicy:                   imagePolicy,
+		CleanupPolicies:               cleanupPolicies,
+		HostnameTemplate:              c.HostnameTemplate,
+		OvercommitRatio:               c.OvercommitRatio,
+		IdleTimeout:                   time.Duration(c.IdleTimeoutMinutes) * time.Minute,
+		AllowedBindMountPaths:         c.AllowedBindMountPaths,
+		MaxConcurrentProvisioningJobs: int(c.MaxConcurrentProvisioningJobs),
+		AgentLogLevel:                 getLogLevel(c.LogLevels, func(l *server.LogLevelsConfig) string { return l.Agent }),
+		ControlServer:                 headscaleServer,
+		GetTailnetHttpClient:          headscaleServer.HTTPClient,
+		BuildService:                  buildService,
+		ProjectConfigService:          projectConfigService,
+		ServerApiUrl:                  util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain),
+		ServerVersion:                 version,
+		DaytonaDownloadUrl:            getDaytonaScriptUrl(c),
+		ServerUrl:                     headscaleUrl,
+		DefaultProjectImage:           c.DefaultProjectImage,
+		DefaultProjectUser:            c.DefaultProjectUser,
+		Provisioner:                   provisioner,
+		LoggerFactory:                 loggerFactory,
+		TelemetryService:              telemetryService,
+	})
+
+	regionService := getRegionService(c, regionStore, apiKeyService, providerTargetStore)
+
+	err = regionService.StartRegistrationPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	err = workspaceService.StartExpiryPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	err = workspaceService.StartCleanupPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	err = workspaceService.StartImagePrePullPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	err = workspaceService.StartBranchStatusPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	if previewDnsService != nil {
+		err = previewDnsService.Start()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c.Metering != nil {
+		exporter, err := getMeteringExporter(c.Metering)
+		if err != nil {
+			return nil, err
+		}
+
+		meteringService := metering_service.NewMeteringService(metering_service.MeteringServiceConfig{
+			ServerId:       c.Id,
+			Exporter:       exporter,
+			WorkspaceStore: workspaceStore,
+			BuildStore:     buildStore,
+			ArtifactStore:  artifactStore,
+		})
+
+		err = meteringService.StartPoller()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stateHistoryService := getStateHistoryService(c, stateSnapshotStore, workspaceStore)
+
+	err = stateHistoryService.StartPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	auditService := audit_service.NewAuditService(audit_service.AuditServiceConfig{
+		AuditEventStore: auditEventStore,
+	})
+
+	impersonationConfig := c.Impersonation
+	if impersonationConfig == nil {
+		impersonationConfig = &server.ImpersonationConfig{}
+	}
+
+	impersonationService := impersonation.NewImpersonationService(impersonation.ImpersonationServiceConfig{
+		ImpersonationSessionStore: impersonationSessionStore,
+		AuditService:              auditService,
+		AnnouncementService:       announcementService,
+		Admins:                    impersonationConfig.Admins,
+		MaxDuration:               time.Duration(impersonationConfig.MaxDurationMinutes) * time.Minute,
+	})
+
+	autoscalerService, err := getAutoscalerService(c, providerTargetStore, provisioner, auditService, workspaceService)
+	if err != nil {
+		return nil, err
+	}
+
+	err = autoscalerService.StartPoller()
+	if err != nil {
+		return nil, err
+	}
+
 	profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
 		ProfileDataStore: profileDataStore,
 	})
 
+	artifactsStorage, err := getStorageBackend(c, "artifacts")
+	if err != nil {
+		return nil, err
+	}
+
+	var artifactRetention time.Duration
+	if artifactsStorage != nil {
+		artifactRetention = time.Duration(c.Storage.ArtifactRetentionDays) * 24 * time.Hour
+	} else {
+		artifactsStorage = storage.NewLocalBackend(filepath.Join(configDir, "artifacts"))
+	}
+
+	artifactService := artifacts.NewArtifactService(artifacts.ArtifactServiceConfig{
+		ArtifactStore: artifactStore,
+		Storage:       artifactsStorage,
+		Retention:     artifactRetention,
+	})
+
+	err = artifactService.StartRetentionPoller()
+	if err != nil {
+		return nil, err
+	}
+
+	commandRunService := commandruns.NewCommandRunService(commandruns.CommandRunServiceConfig{
+		CommandRunStore:      commandRunStore,
+		GetTailnetHttpClient: headscaleServer.HTTPClient,
+		ForwardProjectPort:   workspaceService.ForwardProjectPort,
+	})
+
 	s := server.GetInstance(&server.ServerInstanceConfig{
 		Config:                   *c,
 		Version:                  version,
 		TailscaleServer:          headscaleServer,
 		ProviderTargetService:    providerTargetService,
 		ContainerRegistryService: containerRegistryService,
+		BuildSecretService:       buildSecretService,
 		BuildService:             buildService,
 		ProjectConfigService:     projectConfigService,
 		LocalContainerRegistry:   localContainerRegistry,
 		ApiKeyService:            apiKeyService,
+		OrganizationService:      organizationService,
+		RolloutService:           rolloutService,
+		AnnouncementService:      announcementService,
+		SharedServiceService:     sharedServiceService,
 		WorkspaceService:         workspaceService,
 		GitProviderService:       gitProviderService,
 		ProviderManager:          providerManager,
 		ProfileDataService:       profileDataService,
+		ArtifactService:          artifactService,
+		CommandRunService:        commandRunService,
+		CreationTimingService:    creationTimingService,
+		NetworkKeyService:        networkKeyService,
+		PreviewDnsService:        previewDnsService,
+		TailnetCa:                tailnetCa,
+		RegionService:            regionService,
+		StateHistoryService:      stateHistoryService,
+		AuditService:             auditService,
+		ImpersonationService:     impersonationService,
+		TunnelService:            tunnels.NewTunnelService(),
 		TelemetryService:         telemetryService,
 	})
 
 	return s, s.Initialize()
 }
 
+func getStateHistoryService(c *server.Config, stateSnapshotStore statehistory.Store, workspaceStore workspace.Store) statehistory_service.IStateHistoryService {
+	config := statehistory_service.StateHistoryServiceConfig{
+		StateSnapshotStore: stateSnapshotStore,
+		WorkspaceStore:     workspaceStore,
+	}
+
+	if c.StateHistory != nil {
+		config.Interval = time.Duration(c.StateHistory.IntervalMinutes) * time.Minute
+		config.Retention = time.Duration(c.StateHistory.RetentionDays) * 24 * time.Hour
+	}
+
+	return statehistory_service.NewStateHistoryService(config)
+}
+
+func getAutoscalerService(c *server.Config, targetStore provider.TargetStore, provisioner provisioner.IProvisioner, auditService audit_service.IAuditService, workspaceService workspaces.IWorkspaceService) (autoscaler.IAutoscalerService, error) {
+	policies := []autoscaler.Policy{}
+	for _, policy := range c.Autoscaling {
+		policies = append(policies, autoscaler.Policy{
+			Target:               policy.Target,
+			MinHosts:             policy.MinHosts,
+			MaxHosts:             policy.MaxHosts,
+			ScaleUpUtilization:   policy.ScaleUpUtilization,
+			ScaleDownUtilization: policy.ScaleDownUtilization,
+			Cooldown:             time.Duration(policy.CooldownMinutes) * time.Minute,
+		})
+	}
+
+	return autoscaler.NewAutoscalerService(autoscaler.AutoscalerServiceConfig{
+		Policies:            policies,
+		TargetStore:         targetStore,
+		Scaler:              provisioner,
+		AuditService:        auditService,
+		GetTargetAllocation: workspaceService.GetTargetAllocation,
+		GetCreationDemand:   workspaceService.GetCreationDemand,
+	})
+}
+
+func getRegionService(c *server.Config, regionStore region.Store, apiKeyService apikeys.IApiKeyService, targetStore provider.TargetStore) regions.IRegionService {
+	config := regions.RegionServiceConfig{
+		RegionStore:   regionStore,
+		ApiKeyService: apiKeyService,
+		TargetStore:   targetStore,
+	}
+
+	if c.Federation != nil {
+		config.LocalRegion = c.Federation.Region
+		config.LocalApiUrl = c.Federation.ApiUrl
+		config.PrimaryUrl = c.Federation.PrimaryUrl
+		config.PrimaryApiKey = c.Federation.PrimaryApiKey
+
+		if config.LocalApiUrl == "" {
+			config.LocalApiUrl = util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
+		}
+	}
+
+	return regions.NewRegionService(config)
+}
+
+func getMeteringExporter(c *server.MeteringConfig) (metering.Exporter, error) {
+	switch c.Exporter {
+	case server.MeteringExporterFile:
+		if c.Path == "" {
+			return nil, errors.New("metering file exporter requires a path")
+		}
+		return metering.NewFileExporter(c.Path), nil
+	case server.MeteringExporterHttp:
+		if c.Url == "" {
+			return nil, errors.New("metering http exporter requires a url")
+		}
+		return metering.NewHttpExporter(c.Url, c.Headers), nil
+	case server.MeteringExporterKafka:
+		if c.Url == "" || c.Topic == "" {
+			return nil, errors.New("metering kafka exporter requires a Kafka REST Proxy url and a topic")
+		}
+		return metering.NewKafkaExporter(c.Url, c.Topic, c.Headers), nil
+	}
+
+	return nil, fmt.Errorf("unknown metering exporter: %s", c.Exporter)
+}
+
+// getPreviewDnsService returns nil if the DNS records of public preview URLs are not managed by the server
+func getPreviewDnsService(c *server.Config, configDir string) (previewdns.IPreviewDnsService, error) {
+	if c.PreviewDns == nil {
+		return nil, nil
+	}
+
+	if c.Frps == nil {
+		return nil, errors.New("preview dns requires the frps config")
+	}
+
+	var provider dns.Provider
+	switch c.PreviewDns.Provider {
+	case server.PreviewDnsProviderCloudflare:
+		if c.PreviewDns.CloudflareApiToken == "" || c.PreviewDns.CloudflareZoneId == "" {
+			return nil, errors.New("cloudflare preview dns requires an api token and a zone id")
+		}
+		provider = dns.NewCloudflareProvider(c.PreviewDns.CloudflareApiToken, c.PreviewDns.CloudflareZoneId)
+	case server.PreviewDnsProviderRoute53:
+		if c.PreviewDns.Route53HostedZoneId == "" {
+			return nil, errors.New("route53 preview dns requires a hosted zone id")
+		}
+		route53Provider, err := dns.NewRoute53Provider(c.PreviewDns.Route53HostedZoneId, c.PreviewDns.Route53AccessKeyId, c.PreviewDns.Route53SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		provider = route53Provider
+	default:
+		return nil, fmt.Errorf("unknown preview dns provider: %s", c.PreviewDns.Provider)
+	}
+
+	switch c.PreviewDns.Records {
+	case "", server.PreviewDnsRecordsWildcard, server.PreviewDnsRecordsWorkspace:
+	default:
+		return nil, fmt.Errorf("unknown preview dns records: %s", c.PreviewDns.Records)
+	}
+
+	return previewdns.NewPreviewDnsService(previewdns.PreviewDnsServiceConfig{
+		Provider:         provider,
+		ServerId:         c.Id,
+		Domain:           c.Frps.Domain,
+		FrpsPort:         c.Frps.Port,
+		Target:           c.PreviewDns.Target,
+		WorkspaceRecords: c.PreviewDns.Records == server.PreviewDnsRecordsWorkspace,
+		IssueCertificate: c.PreviewDns.IssueCertificate,
+		AcmeEmail:        c.PreviewDns.AcmeEmail,
+		AcmeDirectoryUrl: c.PreviewDns.AcmeDirectoryUrl,
+		CertificateDir:   filepath.Join(configDir, "preview-tls"),
+	}), nil
+}
+
+// getStorageBackend returns nil if artifacts and logs are stored in the server config directory. The objects are
+// stored under the prefix in the bucket
+func getStorageBackend(c *server.Config, prefix string) (storage.Backend, error) {
+	if c.Storage == nil {
+		return nil, nil
+	}
+
+	if c.Storage.Bucket == "" {
+		return nil, errors.New("storage requires a bucket")
+	}
+
+	prefix = path.Join(c.Storage.Prefix, prefix)
+
+	switch c.Storage.Provider {
+	case server.StorageProviderS3:
+		backend, err := storage.NewS3Backend(storage.S3BackendConfig{
+			Bucket:          c.Storage.Bucket,
+			Prefix:          prefix,
+			Region:          c.Storage.Region,
+			Endpoint:        c.Storage.Endpoint,
+			AccessKeyId:     c.Storage.AccessKeyId,
+			SecretAccessKey: c.Storage.SecretAccessKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return backend, nil
+	case server.StorageProviderGcs:
+		backend, err := storage.NewGcsBackend(c.Storage.Bucket, prefix, c.Storage.AccessKeyId, c.Storage.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		return backend, nil
+	default:
+		return nil, fmt.Errorf("unknown storage provider: %s", c.Storage.Provider)
+	}
+}
+
+func getLoggerFactory(wsLogsDir, buildLogsDir *string, logsStorage storage.Backend) logs.LoggerFactory {
+	if logsStorage == nil {
+		return logs.NewLoggerFactory(wsLogsDir, buildLogsDir)
+	}
+
+	return logs.NewArchivingLoggerFactory(wsLogsDir, buildLogsDir, logsStorage)
+}
+
+// startLogRetentionPoller periodically deletes the archived logs that are older than the log retention of the storage
+func startLogRetentionPoller(c *server.Config, logsStorage storage.Backend) error {
+	if logsStorage == nil || c.Storage.LogRetentionDays == 0 {
+		return nil
+	}
+
+	retention := time.Duration(c.Storage.LogRetentionDays) * 24 * time.Hour
+
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc("@every 1h", func() {
+		deleted, err := storage.DeleteModifiedBefore(context.Background(), logsStorage, "", time.Now().Add(-retention))
+		if err != nil {
+			log.Errorf("Failed to delete expired logs: %s", err)
+			return
+		}
+		if deleted > 0 {
+			log.Debugf("Deleted %d expired log segments", deleted)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
 func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetryService telemetry.TelemetryService) (*build.BuildRunner, error) {
 	logsDir, err := build.GetBuildLogsDir()
 	if err != nil {
 		return nil, err
 	}
-	loggerFactory := logs.NewLoggerFactory(nil, &logsDir)
+	logsStorage, err := getStorageBackend(c, "logs")
+	if err != nil {
+		return nil, err
+	}
+	loggerFactory := getLoggerFactory(nil, &logsDir, logsStorage)
 
 	dbPath, err := getDbPath()
 	if err != nil {
@@ -413,6 +1018,15 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		return nil, err
 	}
 
+	buildSecretStore, err := db.NewBuildSecretStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	buildSecretService := buildsecrets.NewBuildSecretService(buildsecrets.BuildSecretServiceConfig{
+		Store: buildSecretStore,
+	})
+
 	buildImageNamespace := c.BuildImageNamespace
 	if buildImageNamespace != "" {
 		buildImageNamespace = fmt.Sprintf("/%s", buildImageNamespace)
@@ -472,6 +1086,9 @@ func GetBuildRunner(c *server.Config, buildRunnerConfig *build.Config, telemetry
 		LoggerFactory:     loggerFactory,
 		BasePath:          filepath.Join(configDir, "builds"),
 		TelemetryService:  telemetryService,
+
+		MaxConcurrentBuilds: buildRunnerConfig.MaxConcurrentBuilds,
+		BuildSecretStore:    buildSecretService,
 	}), nil
 }
 
@@ -529,7 +1146,13 @@ func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 		}
 	}
 
-	apiKey, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
+	// Only the hash of the key of a removed default profile is stored, so it is replaced
+	err = server.ApiKeyService.Revoke("default")
+	if err != nil && !apikey.IsApiKeyNotFound(err) {
+		return err
+	}
+
+	apiKey, err := server.ApiKeyService.GenerateAdmin("default")
 	if err != nil {
 		return err
 	}
@@ -543,3 +1166,76 @@ func ensureDefaultProfile(server *server.Server, apiPort uint32) error {
 		},
 	})
 }
+
+const embeddedRegistryApiKeyName = "embedded-registry"
+
+// getEmbeddedContainerRegistry creates the embedded registry and stores credentials for it
+// so that builds can push to and projects can pull from the registry
+func getEmbeddedContainerRegistry(c *server.Config, configDir string, apiKeyService apikeys.IApiKeyService, containerRegistryService containerregistries.IContainerRegistryService) (server.ILocalContainerRegistry, error) {
+	err := ensureEmbeddedRegistryCredentials(util.GetFrpcRegistryDomain(c.Id, c.Frps.Domain), apiKeyService, containerRegistryService)
+	if err != nil {
+		return nil, err
+	}
+
+	embeddedRegistryConfig := c.EmbeddedRegistry
+	if embeddedRegistryConfig == nil {
+		embeddedRegistryConfig = &server.EmbeddedRegistryConfig{}
+	}
+
+	return registry.NewEmbeddedContainerRegistry(&registry.EmbeddedContainerRegistryConfig{
+		DataPath:   filepath.Join(configDir, "registry"),
+		Port:       c.LocalBuilderRegistryPort,
+		Quota:      int64(embeddedRegistryConfig.QuotaMb) * 1024 * 1024,
+		GcInterval: time.Duration(embeddedRegistryConfig.GcIntervalMinutes) * time.Minute,
+		Authenticator: func(username, password string) ociregistry.Access {
+			if !apiKeyService.IsValidApiKey(password) {
+				return ociregistry.AccessNone
+			}
+			// Keys of workspaces and projects only pull their images
+			if apiKeyService.IsProjectApiKey(password) || apiKeyService.IsWorkspaceApiKey(password) {
+				return ociregistry.AccessPull
+			}
+			return ociregistry.AccessPush
+		},
+		Frps:     c.Frps,
+		ServerId: c.Id,
+	}), nil
+}
+
+// ensureEmbeddedRegistryCredentials keeps the stored registry credential across restarts so that images pulled with it
+// keep working. A new credential is only generated if the stored one is missing or was revoked
+func ensureEmbeddedRegistryCredentials(registryServer string, apiKeyService apikeys.IApiKeyService, containerRegistryService containerregistries.IContainerRegistryService) error {
+	cr, err := containerRegistryService.Find(registryServer)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	if cr != nil && apiKeyService.IsValidApiKey(cr.Password) {
+		return nil
+	}
+
+	err = apiKeyService.Revoke(embeddedRegistryApiKeyName)
+	if err != nil && !apikey.IsApiKeyNotFound(err) {
+		return err
+	}
+
+	key, err := apiKeyService.Generate(apikey.ApiKeyTypeClient, embeddedRegistryApiKeyName)
+	if err != nil {
+		return err
+	}
+
+	return containerRegistryService.Save(&containerregistry.ContainerRegistry{
+		Server:   registryServer,
+		Username: "daytona",
+		Password: key,
+	})
+}
+
+// getLogLevel returns the configured log level of a component or an empty string if it is not set
+func getLogLevel(c *server.LogLevelsConfig, level func(*server.LogLevelsConfig) string) string {
+	if c == nil {
+		return ""
+	}
+
+	return level(c)
+}
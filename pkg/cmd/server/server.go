@@ -12,6 +12,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/api"
 	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
 	"github.com/daytonaio/daytona/pkg/cmd/server/logs"
+	"github.com/daytonaio/daytona/pkg/cmd/server/rollout"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	view "github.com/daytonaio/daytona/pkg/views/server"
@@ -78,11 +79,24 @@ var startCmd = &cobra.Command{
 }
 
 func init() {
+	ServerCmd.AddCommand(auditCmd)
 	ServerCmd.AddCommand(configureCmd)
 	ServerCmd.AddCommand(configCmd)
+	ServerCmd.AddCommand(cleanupPreviewCmd)
+	ServerCmd.AddCommand(creationTimingsCmd)
+	ServerCmd.AddCommand(impersonateCmd)
+	ServerCmd.AddCommand(networkKeysCmd)
+	ServerCmd.AddCommand(regionsCmd)
+	ServerCmd.AddCommand(loadtestCmd)
+	ServerCmd.AddCommand(migrateCmd)
 	ServerCmd.AddCommand(logs.LogsCmd)
+	ServerCmd.AddCommand(rollout.RolloutCmd)
 	ServerCmd.AddCommand(startCmd)
 	ServerCmd.AddCommand(stopCmd)
 	ServerCmd.AddCommand(restartCmd)
+	ServerCmd.AddCommand(selftestCmd)
+	ServerCmd.AddCommand(statusCmd)
+	ServerCmd.AddCommand(tailnetCaCmd)
+	ServerCmd.AddCommand(upgradeCmd)
 	ServerCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Skip the confirmation prompt")
 }
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
+	"github.com/daytonaio/daytona/pkg/server"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	"github.com/spf13/cobra"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the Daytona Server daemon state, health, version and resource usage",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		daemonStatus, err := daemon.Status()
+		if err != nil {
+			return err
+		}
+
+		status := view.ServerStatus{
+			Daemon:  daemonStatus,
+			ApiUrl:  fmt.Sprintf("http://localhost:%d", c.ApiPort),
+			LogFile: c.LogFile.Path,
+		}
+
+		client := http.Client{Timeout: 5 * time.Second}
+		resp, err := client.Get(status.ApiUrl + constants.HEALTH_CHECK_ROUTE)
+		if err == nil {
+			resp.Body.Close()
+			status.Healthy = resp.StatusCode == http.StatusOK
+			status.Version = resp.Header.Get(middlewares.SERVER_VERSION_HEADER)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(status)
+			formattedData.Print()
+			return nil
+		}
+
+		view.RenderStatus(status)
+		return nil
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(statusCmd)
+}
@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/spf13/cobra"
+)
+
+var tailnetCaCmd = &cobra.Command{
+	Use:   "tailnet-ca",
+	Short: "Print the certificate of the tailnet CA",
+	Long:  "Print the PEM encoded certificate of the authority that signs the certificates of the TLS ports of projects, which are opted in with DAYTONA_AGENT_TLS_PORTS=<port>[:<target port>] in the env vars of the project. Add the certificate to the trust store of the system or browser to open the ports at https://<hostname>.daytona.local:<port>. The authority is only trusted for names in the tailnet domain.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		ca, res, err := apiClient.ServerAPI.GetTailnetCa(cmd.Context()).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		fmt.Print(ca.Certificate)
+		return nil
+	},
+}
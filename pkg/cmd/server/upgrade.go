@@ -0,0 +1,455 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/internal/constants"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/serverupgrade"
+	"github.com/daytonaio/daytona/pkg/views"
+	view "github.com/daytonaio/daytona/pkg/views/server"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+// Number of snapshots of previous versions kept in the upgrades directory of the server
+const upgradeSnapshotsKept = 3
+
+// Interval at which the health of the upgraded server is checked
+const upgradeHealthCheckInterval = 2 * time.Second
+
+var upgradeForceFlag bool
+var upgradeYesFlag bool
+var upgradeHealthTimeoutFlag time.Duration
+var agentCanaryPercentageFlag int
+var agentMaxErrorRateFlag float64
+var previousVersionFlag string
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade [VERSION]",
+	Short: "Upgrade the Daytona Server in place",
+	Long:  "Upgrade the Daytona Server and this binary to the given version, or the latest one. The installed providers and the agents of running projects are checked against the requirements of the version first. The server is stopped while a snapshot of its binary and state, including the database, the config, the state of the control server and the installed providers, is taken and the migrations of the version are applied. If the upgraded server does not start healthy, the snapshot is restored and the previous version is started again.\nWith --agent-canary-percentage, the agent of the new version is rolled out to a share of the workspaces only. The rest of the fleet keeps its agent version until the rollout is promoted with 'daytona server rollout promote'.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if runtime.GOOS == "windows" {
+			return errors.New("in-place upgrades are not supported on Windows")
+		}
+
+		if agentCanaryPercentageFlag < 0 || agentCanaryPercentageFlag > 100 {
+			return rollouts.ErrInvalidPercentage
+		}
+
+		ctx := cmd.Context()
+
+		version := "latest"
+		if len(args) > 0 {
+			version = args[0]
+		}
+
+		c, err := server.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		compatibility, err := serverupgrade.GetCompatibility(ctx, c.RegistryUrl, version)
+		if err != nil {
+			return err
+		}
+
+		if compatibility.Version == internal.Version {
+			views.RenderInfoMessage(fmt.Sprintf("The Daytona Server is already at version %s", internal.Version))
+			return nil
+		}
+
+		daemonStatus, err := daemon.Status()
+		if err != nil {
+			return err
+		}
+
+		if !daemonStatus.Running && isServerHealthy(c) {
+			return errors.New("the server is not running as a daemon. Stop the server started with 'daytona serve' before upgrading it")
+		}
+
+		installation := getInstallation(ctx)
+		incompatibilities := compatibility.Check(installation)
+		if len(incompatibilities) > 0 {
+			view.RenderIncompatibilities(compatibility.Version, incompatibilities)
+			if !upgradeForceFlag {
+				return errors.New("resolve the incompatibilities or upgrade with --force")
+			}
+		}
+
+		if !upgradeYesFlag {
+			err = view.ConfirmUpgrade(internal.Version, compatibility.Version, &upgradeYesFlag)
+			if err != nil {
+				return err
+			}
+			if !upgradeYesFlag {
+				views.RenderInfoMessage("Operation cancelled.")
+				return nil
+			}
+		}
+
+		binaryPath, err := os.Executable()
+		if err == nil {
+			binaryPath, err = filepath.EvalSymlinks(binaryPath)
+		}
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Downloading Daytona %s...", compatibility.Version))
+		newPath, err := serverupgrade.DownloadBinary(ctx, c.RegistryUrl, compatibility, serverupgrade.GetBinaryName(runtime.GOOS, runtime.GOARCH), binaryPath)
+		if errors.Is(err, fs.ErrPermission) {
+			return fmt.Errorf("%s is not writable, run the upgrade as the owner of the binary: %w", binaryPath, err)
+		}
+		if err != nil {
+			return err
+		}
+		defer os.Remove(newPath)
+
+		if daemonStatus.Running {
+			views.RenderInfoMessage("Stopping the Daytona Server daemon...")
+			err = daemon.Stop()
+			if err != nil {
+				return err
+			}
+		}
+
+		snapshot, err := createUpgradeSnapshot(c, binaryPath)
+		if err != nil {
+			return errors.Join(err, restartDaemon(c, daemonStatus.Running))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Saved the binary and state of version %s to %s", internal.Version, snapshot.Dir))
+
+		err = upgradeServer(ctx, c, compatibility.Version, newPath, binaryPath, daemonStatus.Running, getMigrateArgs())
+		if err != nil {
+			log.Error(err)
+			views.RenderInfoMessage(fmt.Sprintf("Rolling back to version %s...", internal.Version))
+
+			rollbackErr := rollbackServer(c, snapshot, daemonStatus.Running)
+			if rollbackErr != nil {
+				return fmt.Errorf("upgrade to %s failed: %w. Rolling back failed as well, restore the snapshot in %s manually: %w", compatibility.Version, err, snapshot.Dir, rollbackErr)
+			}
+
+			return fmt.Errorf("upgrade to %s failed and the server was rolled back to %s: %w", compatibility.Version, internal.Version, err)
+		}
+
+		err = serverupgrade.PruneSnapshots(filepath.Dir(snapshot.Dir), upgradeSnapshotsKept)
+		if err != nil {
+			log.Errorf("failed to remove old upgrade snapshots: %s", err)
+		}
+
+		views.RenderContainerLayout(views.GetBoldedInfoMessage(fmt.Sprintf("Daytona Server upgraded from %s to %s", internal.Version, compatibility.Version)))
+		if agentCanaryPercentageFlag > 0 {
+			views.RenderTip(fmt.Sprintf("Agent version %s is rolled out to %d%% of the workspaces. Check its health with 'daytona server rollout list'", compatibility.Version, agentCanaryPercentageFlag))
+		}
+		if !daemonStatus.Running {
+			views.RenderTip("Start the server with 'daytona server'")
+		}
+
+		return nil
+	},
+}
+
+var migrateCmd = &cobra.Command{
+	Use:    "migrate",
+	Short:  "Apply the database migrations of this version",
+	Args:   cobra.NoArgs,
+	Hidden: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dbPath, err := getDbPath()
+		if err != nil {
+			return err
+		}
+
+		dbConnection := db.GetSQLiteConnection(dbPath)
+
+		err = db.Migrate(dbConnection)
+		if err != nil {
+			return err
+		}
+
+		if agentCanaryPercentageFlag == 0 {
+			return nil
+		}
+
+		return stageAgentUpgrade(dbConnection)
+	},
+}
+
+// stageAgentUpgrade starts a rollout of the agent of this version so that the agents of the fleet are not all
+// replaced when the upgraded server starts
+func stageAgentUpgrade(dbConnection *gorm.DB) error {
+	rolloutStore, err := db.NewRolloutStore(dbConnection)
+	if err != nil {
+		return err
+	}
+
+	workspaceStore, err := db.NewWorkspaceStore(dbConnection)
+	if err != nil {
+		return err
+	}
+
+	rolloutService := rollouts.NewRolloutService(rollouts.RolloutServiceConfig{
+		RolloutStore:   rolloutStore,
+		WorkspaceStore: workspaceStore,
+		ServerVersion:  internal.Version,
+	})
+
+	r, err := rolloutService.CreateForServerUpgrade(previousVersionFlag, agentCanaryPercentageFlag, agentMaxErrorRateFlag)
+	if err != nil {
+		// Without workspaces or with the same agent version there is nothing to stage
+		if errors.Is(err, rollouts.ErrNoWorkspaces) || errors.Is(err, rollouts.ErrInvalidAgentVersion) {
+			log.Infof("Skipping the staged agent upgrade: %s", err)
+			return nil
+		}
+		return fmt.Errorf("failed to stage the agent upgrade: %w", err)
+	}
+
+	log.Infof("Staged agent version %s for %d workspace(s) in rollout %s", r.AgentVersion, len(r.WorkspaceIds), r.Id)
+	return nil
+}
+
+// getMigrateArgs returns the arguments of the migrate command of the upgraded binary
+func getMigrateArgs() []string {
+	args := []string{"server", "migrate"}
+	if agentCanaryPercentageFlag == 0 {
+		return args
+	}
+
+	return append(args,
+		"--previous-version", internal.Version,
+		"--agent-canary-percentage", fmt.Sprint(agentCanaryPercentageFlag),
+		"--agent-max-error-rate", fmt.Sprint(agentMaxErrorRateFlag),
+	)
+}
+
+// getInstallation collects the versions of the providers and the agents of running projects from the server. The
+// checks that depend on them are skipped with a warning if the server can not be reached
+func getInstallation(ctx context.Context) serverupgrade.Installation {
+	installation := serverupgrade.Installation{
+		ServerVersion: internal.Version,
+		Providers:     map[string]string{},
+	}
+
+	err := addServerInstallation(ctx, &installation)
+	if err != nil {
+		views.RenderTip(fmt.Sprintf("Skipping the provider and agent compatibility checks, the server could not be reached: %s", err))
+	}
+
+	return installation
+}
+
+func addServerInstallation(ctx context.Context, installation *serverupgrade.Installation) error {
+	c, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	profile, err := c.GetProfile("default")
+	if err != nil {
+		return err
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(&profile)
+	if err != nil {
+		return err
+	}
+
+	providers, res, err := apiClient.ProviderAPI.ListProviders(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, provider := range providers {
+		installation.Providers[provider.Name] = provider.Version
+	}
+
+	workspaces, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, workspace := range workspaces {
+		for _, project := range workspace.Projects {
+			// Stopped projects download the agent of the upgraded server when they start
+			if project.State == nil || project.State.Uptime == 0 {
+				continue
+			}
+
+			installation.Agents = append(installation.Agents, serverupgrade.Agent{
+				WorkspaceName: workspace.Name,
+				ProjectName:   project.Name,
+				Version:       project.State.GetAgentVersion(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// createUpgradeSnapshot saves the binary and the state of the server: the database, the config, the state of the
+// headscale control server and the installed providers
+func createUpgradeSnapshot(c *server.Config, binaryPath string) (*serverupgrade.Snapshot, error) {
+	configDir, err := server.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	configFilePath, err := server.GetConfigFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	dbPath, err := getDbPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return serverupgrade.CreateSnapshot(filepath.Join(configDir, "upgrades"), internal.Version, []string{binaryPath, dbPath, configFilePath, filepath.Join(configDir, "headscale"), c.ProvidersDir})
+}
+
+// upgradeServer swaps in the new binary, applies its migrations and waits for the upgraded daemon to become healthy
+func upgradeServer(ctx context.Context, c *server.Config, version, newPath, binaryPath string, startDaemon bool, migrateArgs []string) error {
+	err := serverupgrade.InstallBinary(newPath, binaryPath)
+	if err != nil {
+		return err
+	}
+
+	views.RenderInfoMessage("Applying migrations...")
+	output, err := exec.CommandContext(ctx, binaryPath, migrateArgs...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to apply migrations: %w: %s", err, output)
+	}
+
+	if !startDaemon {
+		return nil
+	}
+
+	views.RenderInfoMessage("Starting the Daytona Server daemon...")
+	err = daemon.Start(c.LogFile.Path)
+	if err != nil {
+		return err
+	}
+
+	return waitForServerVersion(ctx, c, version)
+}
+
+func rollbackServer(c *server.Config, snapshot *serverupgrade.Snapshot, startDaemon bool) error {
+	daemonStatus, err := daemon.Status()
+	if err == nil && daemonStatus.Installed {
+		err = daemon.Stop()
+	}
+	if err != nil {
+		return err
+	}
+
+	err = snapshot.Restore()
+	if err != nil {
+		return err
+	}
+
+	return restartDaemon(c, startDaemon)
+}
+
+func restartDaemon(c *server.Config, startDaemon bool) error {
+	if !startDaemon {
+		return nil
+	}
+
+	views.RenderInfoMessage("Starting the Daytona Server daemon...")
+	return daemon.Start(c.LogFile.Path)
+}
+
+// waitForServerVersion waits until the API of the server is healthy and reports the version
+func waitForServerVersion(ctx context.Context, c *server.Config, version string) error {
+	ctx, cancel := context.WithTimeout(ctx, upgradeHealthTimeoutFlag)
+	defer cancel()
+
+	client := http.Client{Timeout: 5 * time.Second}
+	healthUrl := fmt.Sprintf("http://localhost:%d%s", c.ApiPort, constants.HEALTH_CHECK_ROUTE)
+
+	var err error
+	for {
+		err = checkServerVersion(ctx, &client, healthUrl, version)
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the upgraded server did not become healthy within %s: %w", upgradeHealthTimeoutFlag, err)
+		case <-time.After(upgradeHealthCheckInterval):
+		}
+	}
+}
+
+func checkServerVersion(ctx context.Context, client *http.Client, healthUrl, version string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check returned status %d", resp.StatusCode)
+	}
+
+	serverVersion := resp.Header.Get(middlewares.SERVER_VERSION_HEADER)
+	if serverVersion != version {
+		return fmt.Errorf("server reports version %s instead of %s", serverVersion, version)
+	}
+
+	return nil
+}
+
+func isServerHealthy(c *server.Config) bool {
+	client := http.Client{Timeout: 5 * time.Second}
+
+	resp, err := client.Get(fmt.Sprintf("http://localhost:%d%s", c.ApiPort, constants.HEALTH_CHECK_ROUTE))
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK
+}
+
+func init() {
+	upgradeCmd.Flags().BoolVar(&upgradeForceFlag, "force", false, "Upgrade even if the installation is not compatible with the version")
+	upgradeCmd.Flags().BoolVarP(&upgradeYesFlag, "yes", "y", false, "Skip the confirmation prompt")
+	upgradeCmd.Flags().DurationVar(&upgradeHealthTimeoutFlag, "health-timeout", 2*time.Minute, "Time the upgraded server has to become healthy before it is rolled back")
+	upgradeCmd.Flags().IntVar(&agentCanaryPercentageFlag, "agent-canary-percentage", 0, "Roll the agent of the new version out to this percentage of workspaces only (0 upgrades all agents)")
+	upgradeCmd.Flags().Float64Var(&agentMaxErrorRateFlag, "agent-max-error-rate", 0, "Roll the agent upgrade back automatically once this share (0-1) of canary projects stops sending heartbeats")
+
+	migrateCmd.Flags().StringVar(&previousVersionFlag, "previous-version", "", "Version of the server that is upgraded")
+	migrateCmd.Flags().IntVar(&agentCanaryPercentageFlag, "agent-canary-percentage", 0, "Stage the agent of this version for this percentage of workspaces")
+	migrateCmd.Flags().Float64Var(&agentMaxErrorRateFlag, "agent-max-error-rate", 0, "Roll the staged agent upgrade back once this share of canary projects stops sending heartbeats")
+}
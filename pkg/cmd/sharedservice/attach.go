@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var attachCmd = &cobra.Command{
+	Use:   "attach WORKSPACE SERVICE",
+	Short: "Attach a workspace to a shared service",
+	Long:  "Attach a workspace to a shared service. Projects receive the connection env vars of the service the next time they are started or rebuilt.",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.SharedServiceAPI.AttachSharedService(cmd.Context(), args[1], args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s attached to shared service %s.\nRebuild its projects with 'daytona rebuild %s' to apply the connection env vars", args[0], args[1], args[0]))
+		return nil
+	},
+}
+
+var detachCmd = &cobra.Command{
+	Use:   "detach WORKSPACE SERVICE",
+	Short: "Detach a workspace from a shared service",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		res, err := apiClient.SharedServiceAPI.DetachSharedService(cmd.Context(), args[1], args[0]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s detached from shared service %s", args[0], args[1]))
+		return nil
+	},
+}
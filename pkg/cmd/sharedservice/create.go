@@ -0,0 +1,133 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var targetFlag string
+var templateFlag string
+var imageFlag string
+var portFlag uint16
+var envFlag []string
+var connectionEnvFlag []string
+var dataPathFlag string
+
+var createCmd = &cobra.Command{
+	Use:   "create NAME",
+	Short: "Create a shared service on a target",
+	Long: "Create a shared service on a target from a template (postgres, mysql or redis) or a custom image.\n" +
+		"Projects of attached workspaces receive DAYTONA_SERVICE_<NAME>_HOST, DAYTONA_SERVICE_<NAME>_PORT and the connection env vars of the service.",
+	Example: "  daytona shared-service create db --template postgres -t local\n" +
+		"  daytona shared-service create search --image opensearchproject/opensearch:2 --port 9200 --env discovery.type=single-node --connection-env 'SEARCH_URL=http://{{host}}:{{port}}'",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if templateFlag == "" && (imageFlag == "" || portFlag == 0) {
+			return errors.New("specify a template or an image and a port")
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		if targetFlag == "" {
+			targetFlag = activeProfile.DefaultTarget
+		}
+		if targetFlag == "" {
+			return errors.New("specify the target of the service with --target")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		req := apiclient.CreateSharedServiceDTO{
+			Name:   args[0],
+			Target: targetFlag,
+		}
+		if templateFlag != "" {
+			req.Template = &templateFlag
+		}
+		if imageFlag != "" {
+			req.Image = &imageFlag
+		}
+		if portFlag != 0 {
+			port := int32(portFlag)
+			req.Port = &port
+		}
+		if dataPathFlag != "" {
+			req.DataPath = &dataPathFlag
+		}
+
+		envVars, err := parseEnvVars(envFlag)
+		if err != nil {
+			return err
+		}
+		req.EnvVars = &envVars
+
+		connectionEnvVars, err := parseEnvVars(connectionEnvFlag)
+		if err != nil {
+			return err
+		}
+		req.ConnectionEnvVars = &connectionEnvVars
+
+		var service *apiclient.SharedService
+		err = views_util.WithInlineSpinner("Creating shared service", func() error {
+			var res *http.Response
+			service, res, err = apiClient.SharedServiceAPI.CreateSharedService(cmd.Context()).SharedService(req).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Shared service %s created on target %s.\nAttach workspaces with 'daytona shared-service attach WORKSPACE %s' or 'daytona create --shared-service %s'", service.Name, service.Target, service.Name, service.Name))
+		return nil
+	},
+}
+
+func parseEnvVars(values []string) (map[string]string, error) {
+	envVars := map[string]string{}
+
+	for _, value := range values {
+		key, val, ok := strings.Cut(value, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid environment variable format: %s", value)
+		}
+		envVars[key] = val
+	}
+
+	return envVars, nil
+}
+
+func init() {
+	createCmd.Flags().StringVarP(&targetFlag, "target", "t", "", "Target to run the service on. Defaults to the default target of the profile")
+	createCmd.Flags().StringVar(&templateFlag, "template", "", "Service template (postgres, mysql or redis)")
+	createCmd.Flags().StringVar(&imageFlag, "image", "", "Image of the service. Overrides the image of the template")
+	createCmd.Flags().Uint16Var(&portFlag, "port", 0, "Port the service listens on")
+	createCmd.Flags().StringArrayVar(&envFlag, "env", []string{}, "Environment variable of the service container in the KEY=VALUE format")
+	createCmd.Flags().StringArrayVar(&connectionEnvFlag, "connection-env", []string{}, "Environment variable injected in attached projects in the KEY=VALUE format. Values can reference {{host}} and {{port}}")
+	createCmd.Flags().StringVar(&dataPathFlag, "data-path", "", "Path in the service container persisted in a volume")
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var forceFlag bool
+
+var deleteCmd = &cobra.Command{
+	Use:     "delete NAME",
+	Short:   "Delete a shared service and its data",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"remove", "rm"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		err = views_util.WithInlineSpinner("Deleting shared service", func() error {
+			var res *http.Response
+			res, err = apiClient.SharedServiceAPI.DeleteSharedService(cmd.Context(), args[0]).Force(forceFlag).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Shared service %s deleted", args[0]))
+		return nil
+	},
+}
+
+func init() {
+	deleteCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Detach attached workspaces and delete the service even if the provider fails to remove it")
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	view "github.com/daytonaio/daytona/pkg/views/sharedservice"
+	"github.com/spf13/cobra"
+)
+
+var listTargetFlag string
+
+var listCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List shared services",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.SharedServiceAPI.ListSharedServices(cmd.Context()).Verbose(true)
+		if listTargetFlag != "" {
+			req = req.Target(listTargetFlag)
+		}
+
+		services, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(services)
+			formattedData.Print()
+			return nil
+		}
+
+		view.ListSharedServices(services)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.Flags().StringVarP(&listTargetFlag, "target", "t", "", "Only list the services of a target")
+	format.RegisterFormatFlag(listCmd)
+}
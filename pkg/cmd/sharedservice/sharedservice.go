@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/spf13/cobra"
+)
+
+var SharedServiceCmd = &cobra.Command{
+	Use:     "shared-service",
+	Aliases: []string{"shared-services", "services"},
+	Short:   "Manage services, like databases and caches, that workspaces on a target share",
+	GroupID: util.SERVER_GROUP,
+}
+
+func init() {
+	SharedServiceCmd.AddCommand(createCmd)
+	SharedServiceCmd.AddCommand(listCmd)
+	SharedServiceCmd.AddCommand(deleteCmd)
+	SharedServiceCmd.AddCommand(attachCmd)
+	SharedServiceCmd.AddCommand(detachCmd)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/common"
+	target_view "github.com/daytonaio/daytona/pkg/views/target"
+	"github.com/daytonaio/daytona/pkg/views/target/info"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/spf13/cobra"
+)
+
+var allocationFlag bool
+
+var targetDescribeCmd = &cobra.Command{
+	Use:     "describe [TARGET_NAME]",
+	Short:   "Describe a target",
+	Long:    "Describe a target. With --allocation, shows the resources of the target host that are reserved and used by projects",
+	Aliases: []string{"info"},
+	Args:    cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var target *apiclient.ProviderTarget
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		targetList, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if len(targetList) == 0 {
+			views_util.NotifyEmptyTargetList(true)
+			return nil
+		}
+
+		var targetName string
+		if len(args) == 0 {
+			c, err := config.GetConfig()
+			if err != nil {
+				return err
+			}
+
+			activeProfile, err := c.GetActiveProfile()
+			if err != nil {
+				return err
+			}
+
+			if format.FormatFlag != "" {
+				format.UnblockStdOut()
+			}
+
+			selectedTarget, err := target_view.GetTargetFromPrompt(targetList, activeProfile.Name, nil, false, "Describe")
+			if format.FormatFlag != "" {
+				format.BlockStdOut()
+			}
+			if err != nil {
+				if common.IsCtrlCAbort(err) {
+					return nil
+				} else {
+					return err
+				}
+			}
+
+			if selectedTarget == nil {
+				return nil
+			}
+
+			targetName = selectedTarget.Name
+		} else {
+			targetName = args[0]
+		}
+
+		for _, t := range targetList {
+			if t.Name == targetName {
+				target = &t
+				break
+			}
+		}
+
+		if target == nil {
+			return fmt.Errorf("target '%s' not found", targetName)
+		}
+
+		var allocation *apiclient.TargetAllocation
+		if allocationFlag {
+			allocation, res, err = apiClient.TargetAPI.GetTargetAllocation(ctx, target.Name).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+		}
+
+		if format.FormatFlag != "" {
+			var data interface{} = target
+			if allocation != nil {
+				data = allocation
+			}
+
+			formattedData := format.NewFormatter(data)
+			formattedData.Print()
+			return nil
+		}
+
+		info.Render(target, allocation, false)
+		return nil
+	},
+}
+
+func init() {
+	targetDescribeCmd.Flags().BoolVar(&allocationFlag, "allocation", false, "Show the resources of the target host reserved and used by projects")
+	format.RegisterFormatFlag(targetDescribeCmd)
+}
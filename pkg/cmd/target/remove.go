@@ -30,7 +30,7 @@ var targetRemoveCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var selectedTargetName string
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			return err
@@ -30,7 +30,7 @@ var TargetSetCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	Aliases: []string{"s", "add", "update", "register", "edit"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var isNewProvider bool
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -48,7 +48,7 @@ var TargetSetCmd = &cobra.Command{
 			return err
 		}
 
-		serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
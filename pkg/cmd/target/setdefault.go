@@ -4,7 +4,6 @@
 package target
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
@@ -22,7 +21,7 @@ var targetSetDefaultCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var targetName string
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
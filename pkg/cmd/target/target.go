@@ -16,6 +16,7 @@ var TargetCmd = &cobra.Command{
 
 func init() {
 	TargetCmd.AddCommand(targetListCmd)
+	TargetCmd.AddCommand(targetDescribeCmd)
 	TargetCmd.AddCommand(TargetSetCmd)
 	TargetCmd.AddCommand(targetRemoveCmd)
 	TargetCmd.AddCommand(targetSetDefaultCmd)
@@ -0,0 +1,93 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package urlhandler
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/cmd/workspace"
+	"github.com/daytonaio/daytona/pkg/deeplink"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var UrlHandlerCmd = &cobra.Command{
+	Use:   "url-handler",
+	Short: "Manage the daytona:// link handler",
+	Args:  cobra.NoArgs,
+}
+
+var registerCmd = &cobra.Command{
+	Use:   "register",
+	Short: "Register Daytona as the handler for daytona:// links",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		executable, err := os.Executable()
+		if err != nil {
+			return err
+		}
+
+		executable, err = filepath.EvalSymlinks(executable)
+		if err != nil {
+			return err
+		}
+
+		err = deeplink.RegisterProtocolHandler(executable)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage("Daytona is now registered as the handler for daytona:// links")
+		return nil
+	},
+}
+
+var unregisterCmd = &cobra.Command{
+	Use:   "unregister",
+	Short: "Remove the daytona:// link handler",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		err := deeplink.UnregisterProtocolHandler()
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage("The daytona:// link handler has been removed")
+		return nil
+	},
+}
+
+var openCmd = &cobra.Command{
+	Use:   "open [LINK]",
+	Short: "Open a daytona:// link in the configured IDE",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		link, err := deeplink.Parse(args[0])
+		if err != nil {
+			return err
+		}
+
+		codeArgs := []string{link.Workspace}
+		if link.Project != "" {
+			codeArgs = append(codeArgs, link.Project)
+		}
+
+		if link.Ide != "" {
+			err = workspace.CodeCmd.Flags().Set("ide", link.Ide)
+			if err != nil {
+				return err
+			}
+		}
+
+		workspace.CodeCmd.SetContext(cmd.Context())
+		return workspace.CodeCmd.RunE(workspace.CodeCmd, codeArgs)
+	},
+}
+
+func init() {
+	UrlHandlerCmd.AddCommand(registerCmd)
+	UrlHandlerCmd.AddCommand(unregisterCmd)
+	UrlHandlerCmd.AddCommand(openCmd)
+}
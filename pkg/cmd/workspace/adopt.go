@@ -0,0 +1,163 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/docker/pkg/stringid"
+	"github.com/spf13/cobra"
+)
+
+var adoptContainerFlag string
+var adoptSshFlag string
+var adoptIdentityFileFlag string
+var adoptUserFlag string
+var adoptProjectFlag string
+var adoptProjectDirFlag string
+var adoptRepoFlag string
+
+var AdoptCmd = &cobra.Command{
+	Use:     "adopt [WORKSPACE_NAME]",
+	Short:   "Register an existing container or VM as a workspace",
+	Long:    "Register an existing container or VM as a workspace.\nThe Daytona agent is installed on the machine so it joins the network and can be used like any other workspace. Docker containers must run on the server host, VMs must be reachable from the server over SSH.",
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		if (adoptContainerFlag == "") == (adoptSshFlag == "") {
+			return errors.New("exactly one of --container or --ssh is required")
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		projectName := adoptProjectFlag
+		if projectName == "" {
+			projectName = args[0]
+		}
+
+		id := stringid.GenerateRandomID()
+		id = stringid.TruncateID(id)
+
+		req := apiclient.AdoptWorkspaceDTO{
+			Id:          id,
+			Name:        args[0],
+			ProjectName: projectName,
+		}
+
+		if adoptUserFlag != "" {
+			req.User = &adoptUserFlag
+		}
+
+		if adoptProjectDirFlag != "" {
+			req.ProjectDir = &adoptProjectDirFlag
+		}
+
+		if adoptRepoFlag != "" {
+			repoUrl, err := util.GetValidatedUrl(adoptRepoFlag)
+			if err != nil {
+				return err
+			}
+
+			repo, res, err := apiClient.GitProviderAPI.GetGitContext(ctx).Repository(apiclient.GetRepositoryContext{
+				Url: repoUrl,
+			}).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			req.Repository = repo
+		}
+
+		if adoptContainerFlag != "" {
+			req.Type = apiclient.AdoptionTypeDocker
+			req.ContainerId = &adoptContainerFlag
+		} else {
+			err = setSshAdoption(&req)
+			if err != nil {
+				return err
+			}
+		}
+
+		w, res, err := apiClient.WorkspaceAPI.AdoptWorkspace(ctx).Workspace(req).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s adopted. Connect to it with 'daytona code %s'", w.Name, w.Name))
+		return nil
+	},
+}
+
+// setSshAdoption parses the [USER@]HOST[:PORT] destination and reads the credentials used to install the agent
+func setSshAdoption(req *apiclient.AdoptWorkspaceDTO) error {
+	req.Type = apiclient.AdoptionTypeSsh
+
+	host := adoptSshFlag
+	if user, rest, found := strings.Cut(host, "@"); found {
+		req.User = &user
+		host = rest
+	}
+
+	if h, p, found := strings.Cut(host, ":"); found {
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return fmt.Errorf("invalid SSH port: %s", p)
+		}
+		port32 := int32(port)
+		req.Port = &port32
+		host = h
+	}
+	req.Host = &host
+
+	if req.User == nil {
+		return errors.New("the SSH destination must include a user, e.g. ubuntu@10.0.0.5")
+	}
+
+	if adoptIdentityFileFlag != "" {
+		key, err := os.ReadFile(adoptIdentityFileFlag)
+		if err != nil {
+			return err
+		}
+		privateKey := string(key)
+		req.PrivateKey = &privateKey
+		return nil
+	}
+
+	var password string
+	err := huh.NewInput().
+		Title(fmt.Sprintf("Password for %s@%s", *req.User, host)).
+		EchoMode(huh.EchoModePassword).
+		Value(&password).
+		WithTheme(views.GetCustomTheme()).
+		Run()
+	if err != nil {
+		return err
+	}
+	req.Password = &password
+
+	return nil
+}
+
+func init() {
+	AdoptCmd.Flags().StringVar(&adoptContainerFlag, "container", "", "ID or name of a Docker container on the server host")
+	AdoptCmd.Flags().StringVar(&adoptSshFlag, "ssh", "", "SSH destination of the VM in the [USER@]HOST[:PORT] format")
+	AdoptCmd.Flags().StringVarP(&adoptIdentityFileFlag, "identity-file", "i", "", "Private key used to connect to the VM. The password is prompted for if not set")
+	AdoptCmd.Flags().StringVar(&adoptUserFlag, "user", "", "User that runs the agent in the container (default root)")
+	AdoptCmd.Flags().StringVar(&adoptProjectFlag, "project", "", "Project name (defaults to the workspace name)")
+	AdoptCmd.Flags().StringVar(&adoptProjectDirFlag, "project-dir", "", "Project directory on the machine (defaults to $HOME/<project>)")
+	AdoptCmd.Flags().StringVar(&adoptRepoFlag, "repo", "", "URL of the repository checked out in the project directory")
+}
@@ -16,11 +16,13 @@ import (
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/deeplink"
 	"github.com/daytonaio/daytona/pkg/ide"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	ide_views "github.com/daytonaio/daytona/pkg/views/ide"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	info_view "github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -30,7 +32,6 @@ var CodeCmd = &cobra.Command{
 	Use:     "code [WORKSPACE] [PROJECT]",
 	Short:   "Open a workspace in your preferred IDE",
 	Args:    cobra.RangeArgs(0, 2),
-	Aliases: []string{"open"},
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, err := config.GetConfig()
@@ -38,7 +39,7 @@ var CodeCmd = &cobra.Command{
 			return err
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var workspaceId string
 		var projectName string
 		var providerConfigId *string
@@ -50,7 +51,7 @@ var CodeCmd = &cobra.Command{
 			return err
 		}
 
-		ideId = c.DefaultIdeId
+		ideId = c.GetDefaultIdeId()
 
 		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
 		if err != nil {
@@ -112,6 +113,16 @@ var CodeCmd = &cobra.Command{
 			ideId = ideFlag
 		}
 
+		if linkFlag {
+			link := deeplink.Link{
+				Workspace: workspace.Name,
+				Project:   projectName,
+				Ide:       ideFlag,
+			}
+			ide_views.RenderDeepLink(link.String(), link.HttpUrl(activeProfile.Api.Url))
+			return nil
+		}
+
 		if !workspace_util.IsProjectRunning(workspace, projectName) {
 			wsRunningStatus, err := AutoStartWorkspace(workspace.Name, projectName)
 			if err != nil {
@@ -135,6 +146,8 @@ var CodeCmd = &cobra.Command{
 			log.Warn(err)
 		}
 
+		info_view.RenderEnvironmentChangesNotice(workspace, projectName)
+
 		yesFlag, _ := cmd.Flags().GetBool("yes")
 		ideList := config.GetIdeList()
 		ide_views.RenderIdeOpeningMessage(workspace.Name, projectName, ideId, ideList)
@@ -204,6 +217,7 @@ func openIDE(ideId string, activeProfile config.Profile, workspaceId string, pro
 }
 
 var ideFlag string
+var linkFlag bool
 
 func init() {
 	ideList := config.GetIdeList()
@@ -215,6 +229,7 @@ func init() {
 	CodeCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", fmt.Sprintf("Specify the IDE (%s)", ideListStr))
 
 	CodeCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	CodeCmd.Flags().BoolVar(&linkFlag, "link", false, "Print a shareable link that opens the project instead of opening it")
 
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
@@ -28,7 +29,6 @@ import (
 	"github.com/daytonaio/daytona/pkg/views/workspace/create"
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
-	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/pkg/stringid"
 	log "github.com/sirupsen/logrus"
 	"tailscale.com/tsnet"
@@ -42,9 +42,10 @@ var CreateCmd = &cobra.Command{
 	Use:     "create [REPOSITORY_URL | PROJECT_CONFIG_NAME]...",
 	Short:   "Create a workspace",
 	GroupID: util.WORKSPACE_GROUP,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		ctx := cmd.Context()
 		var projects []apiclient.CreateProjectDTO
+		var draft *workspace_util.CreateDraft
 		var workspaceName string
 		var existingWorkspaceNames []string
 		var existingProjectConfigNames []string
@@ -74,6 +75,14 @@ var CreateCmd = &cobra.Command{
 			workspaceName = nameFlag
 		}
 
+		if targetNameFlag == "" {
+			targetNameFlag = activeProfile.DefaultTarget
+		}
+
+		if ttlFlag == "" {
+			ttlFlag = activeProfile.DefaultWorkspaceTtl
+		}
+
 		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -82,8 +91,13 @@ var CreateCmd = &cobra.Command{
 			existingWorkspaceNames = append(existingWorkspaceNames, workspaceInfo.Name)
 		}
 
+		overrides, err := workspace_util.GetOverrideFiles(overrideFileFlag)
+		if err != nil {
+			return err
+		}
+
 		if promptUsingTUI {
-			err = processPrompting(ctx, apiClient, &workspaceName, &projects, existingWorkspaceNames)
+			draft, err = processPrompting(ctx, apiClient, activeProfile.Id, &workspaceName, &projects, existingWorkspaceNames, overrides)
 			if err != nil {
 				if common.IsCtrlCAbort(err) {
 					return nil
@@ -91,12 +105,28 @@ var CreateCmd = &cobra.Command{
 					return err
 				}
 			}
+
+			defer func() {
+				if err != nil && draft != nil {
+					views.RenderInfoMessage("Your answers were saved. Run 'daytona create' to resume the workspace creation")
+				}
+			}()
 		} else {
 			existingProjectConfigNames, err = processCmdArguments(ctx, args, apiClient, &projects)
 			if err != nil {
 				return err
 			}
 
+			for i := range projects {
+				applied := workspace_util.ApplyOverrides(&projects[i], overrides)
+				if len(applied) > 0 {
+					logs_view.DisplayLogEntry(logs.LogEntry{
+						ProjectName: &projects[i].Name,
+						Msg:         fmt.Sprintf("Using overrides from %s\n", strings.Join(applied, ", ")),
+					}, i)
+				}
+			}
+
 			initialSuggestion := projects[0].Name
 
 			if workspaceName == "" {
@@ -108,12 +138,17 @@ var CreateCmd = &cobra.Command{
 			return errors.New("workspace name and repository urls are required")
 		}
 
+		err = workspace_util.ValidateCreateProjects(projects)
+		if err != nil {
+			return err
+		}
+
 		projectNames := []string{}
 		for i := range projects {
 			if profileData != nil && profileData.EnvVars != nil {
-				projects[i].EnvVars = util.MergeEnvVars(profileData.EnvVars, projects[i].EnvVars)
+				projects[i].EnvVars = util.MergeEnvVars(profileData.EnvVars, activeProfile.EnvVars, projects[i].EnvVars)
 			} else {
-				projects[i].EnvVars = util.MergeEnvVars(projects[i].EnvVars)
+				projects[i].EnvVars = util.MergeEnvVars(activeProfile.EnvVars, projects[i].EnvVars)
 			}
 			projectNames = append(projectNames, projects[i].Name)
 		}
@@ -128,11 +163,41 @@ var CreateCmd = &cobra.Command{
 			}, i)
 		}
 
+		createRegion, err := getCreationRegion(ctx, apiClient)
+		if err != nil {
+			return err
+		}
+
+		if createRegion != nil && !createRegion.Local {
+			if dryRunFlag {
+				return fmt.Errorf("workspaces in region %s can only be validated by the server of the region", createRegion.Name)
+			}
+
+			err = createInRegion(ctx, apiClient, createRegion, apiclient.CreateWorkspaceDTO{
+				Id:       stringid.TruncateID(stringid.GenerateRandomID()),
+				Name:     workspaceName,
+				Projects: projects,
+			})
+			if err == nil && draft != nil {
+				discardCreateDraft()
+			}
+			return err
+		}
+
 		targetList, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
+		// Reuse the target chosen before the resumed creation failed
+		if draft != nil && draft.Target != "" && !cmd.Flags().Changed("target") {
+			for _, t := range targetList {
+				if t.Name == draft.Target {
+					targetNameFlag = draft.Target
+				}
+			}
+		}
+
 		target, err := workspace_util.GetTarget(workspace_util.GetTargetConfig{
 			Ctx:               ctx,
 			ApiClient:         apiClient,
@@ -148,6 +213,66 @@ var CreateCmd = &cobra.Command{
 			return err
 		}
 
+		if draft != nil {
+			draft.Target = target.Name
+			err = workspace_util.SaveCreateDraft(draft)
+			if err != nil {
+				log.Warn(err)
+			}
+		}
+
+		id := stringid.GenerateRandomID()
+		id = stringid.TruncateID(id)
+
+		if cpusFlag > 0 || memoryFlag > 0 {
+			resources := apiclient.Resources{}
+			if cpusFlag > 0 {
+				resources.Cpus = &cpusFlag
+			}
+			if memoryFlag > 0 {
+				resources.Memory = &memoryFlag
+			}
+
+			for i := range projects {
+				projects[i].Resources = &resources
+			}
+		}
+
+		if archFlag != "" {
+			for i := range projects {
+				projects[i].Architecture = &archFlag
+			}
+		}
+
+		createWorkspaceDto := apiclient.CreateWorkspaceDTO{
+			Id:       id,
+			Name:     workspaceName,
+			Target:   target.Name,
+			Projects: projects,
+		}
+		if ttlFlag != "" {
+			createWorkspaceDto.Ttl = &ttlFlag
+		}
+		if len(sharedServicesFlag) > 0 {
+			createWorkspaceDto.SharedServices = sharedServicesFlag
+		}
+		if sharedNodeFlag {
+			createWorkspaceDto.SharedNode = &sharedNodeFlag
+		}
+
+		if dryRunFlag {
+			validation, res, err := apiClient.WorkspaceAPI.ValidateCreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			create.RenderValidation(validation)
+			if !validation.Valid {
+				return errors.New("the workspace can not be created")
+			}
+			return nil
+		}
+
 		logs_view.CalculateLongestPrefixLength(projectNames)
 
 		logs_view.DisplayLogEntry(logs.LogEntry{
@@ -167,22 +292,19 @@ var CreateCmd = &cobra.Command{
 			}
 		}
 
-		id := stringid.GenerateRandomID()
-		id = stringid.TruncateID(id)
-
 		logsContext, stopLogs := context.WithCancel(context.Background())
 		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, id, projectNames, true, true, nil)
 
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceDTO{
-			Id:       id,
-			Name:     workspaceName,
-			Target:   target.Name,
-			Projects: projects,
-		}).Execute()
+		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
 		if err != nil {
 			stopLogs()
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
+
+		if draft != nil {
+			discardCreateDraft()
+			draft = nil
+		}
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, projects[0].GitProviderConfigId)
 		if err != nil {
 			log.Warn(err)
@@ -204,7 +326,7 @@ var CreateCmd = &cobra.Command{
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
 
-		chosenIdeId := c.DefaultIdeId
+		chosenIdeId := c.GetDefaultIdeId()
 		if ideFlag != "" {
 			chosenIdeId = ideFlag
 		}
@@ -219,7 +341,7 @@ var CreateCmd = &cobra.Command{
 		}
 
 		fmt.Println()
-		info.Render(wsInfo, chosenIde.Name, false)
+		info.Render(wsInfo, chosenIde.Name, nil, nil, false)
 
 		if noIdeFlag {
 			views.RenderCreationInfoMessage("Run 'daytona code' when you're ready to start developing")
@@ -240,9 +362,17 @@ var CreateCmd = &cobra.Command{
 
 var nameFlag string
 var targetNameFlag string
+var ttlFlag string
+var sharedServicesFlag []string
+var sharedNodeFlag bool
+var cpusFlag float32
+var memoryFlag int32
+var archFlag string
 var noIdeFlag bool
 var blankFlag bool
 var multiProjectFlag bool
+var dryRunFlag bool
+var overrideFileFlag string
 
 var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Builder:           new(views_util.BuildChoice),
@@ -251,6 +381,10 @@ var projectConfigurationFlags = workspace_util.ProjectConfigurationFlags{
 	Branches:          new([]string),
 	DevcontainerPath:  new(string),
 	EnvVars:           new([]string),
+	Mounts:            new([]string),
+	Commands:          new([]string),
+	Ports:             new([]string),
+	Debug:             new(string),
 	Manual:            new(bool),
 	GitProviderConfig: new(string),
 }
@@ -266,33 +400,34 @@ func init() {
 	CreateCmd.Flags().StringVar(&nameFlag, "name", "", "Specify the workspace name")
 	CreateCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", fmt.Sprintf("Specify the IDE (%s)", ideListStr))
 	CreateCmd.Flags().StringVarP(&targetNameFlag, "target", "t", "", "Specify the target (e.g. 'local')")
+	CreateCmd.Flags().StringVar(&regionFlag, "region", "", "Create the workspace in a federated region. Defaults to the region with the lowest latency if no target is set")
+	CreateCmd.Flags().StringVar(&ttlFlag, "ttl", "", "Remove the workspace after the specified duration (e.g. 48h)")
+	CreateCmd.Flags().StringSliceVar(&sharedServicesFlag, "shared-service", []string{}, "Attach the workspace to shared services of the target")
 	CreateCmd.Flags().BoolVar(&blankFlag, "blank", false, "Create a blank project without using existing configurations")
 	CreateCmd.Flags().BoolVarP(&noIdeFlag, "no-ide", "n", false, "Do not open the workspace in the IDE after workspace creation")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
+	CreateCmd.Flags().BoolVar(&sharedNodeFlag, "shared-node", false, "Reach all projects through the tailnet node of the first project. Only ports below 10000 of the other projects are reachable")
+	CreateCmd.Flags().Float32Var(&cpusFlag, "cpus", 0, "Reserve CPU cores for each project on the target host (e.g. 1.5)")
+	CreateCmd.Flags().Int32Var(&memoryFlag, "memory", 0, "Reserve memory in MiB for each project on the target host")
+	CreateCmd.Flags().StringVar(&archFlag, "arch", "", "Architecture of the target host (e.g. arm64). Selects the matching variant of prebuild matrices")
 	CreateCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	CreateCmd.Flags().BoolVar(&dryRunFlag, "dry-run", false, "Validate the workspace creation request and show the issues found without creating the workspace")
+	CreateCmd.Flags().StringVar(&overrideFileFlag, "override-file", "", fmt.Sprintf("Apply this override file after the %s files in the home directory and the current repository", workspace_util.OverrideFileName))
 	CreateCmd.Flags().StringSliceVar(projectConfigurationFlags.Branches, "branch", []string{}, "Specify the Git branches to use in the projects")
 
 	workspace_util.AddProjectConfigurationFlags(CreateCmd, projectConfigurationFlags, true)
 }
 
-func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, workspaceName *string, projects *[]apiclient.CreateProjectDTO, workspaceNames []string) error {
+// processPrompting runs the creation wizard and returns the draft the answers were saved to, so the creation
+// can be resumed if it fails. A draft left by a previous failed creation is offered for resuming first
+func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, profileId string, workspaceName *string, projects *[]apiclient.CreateProjectDTO, workspaceNames []string, overrides []*workspace_util.OverrideFile) (*workspace_util.CreateDraft, error) {
 	if workspace_util.CheckAnyProjectConfigurationFlagSet(projectConfigurationFlags) || (projectConfigurationFlags.Branches != nil && len(*projectConfigurationFlags.Branches) > 0) {
-		return errors.New("please provide the repository URL in order to set up custom project details through the CLI")
-	}
-
-	gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
-	if err != nil {
-		return apiclient_util.HandleErrorResponse(res, err)
-	}
-
-	projectConfigs, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
-	if err != nil {
-		return apiclient_util.HandleErrorResponse(res, err)
+		return nil, errors.New("please provide the repository URL in order to set up custom project details through the CLI")
 	}
 
 	apiServerConfig, res, err := apiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
-		return apiclient_util.HandleErrorResponse(res, err)
+		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
 
 	projectDefaults := &views_util.ProjectConfigDefaults{
@@ -302,18 +437,43 @@ func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, works
 		DevcontainerFilePath: create.DEVCONTAINER_FILEPATH,
 	}
 
-	*projects, err = workspace_util.GetProjectsCreationDataFromPrompt(workspace_util.ProjectsDataPromptConfig{
-		UserGitProviders: gitProviders,
-		ProjectConfigs:   projectConfigs,
-		Manual:           *projectConfigurationFlags.Manual,
-		MultiProject:     multiProjectFlag,
-		BlankProject:     blankFlag,
-		ApiClient:        apiClient,
-		Defaults:         projectDefaults,
-	})
-
+	draft, err := getResumableDraft(profileId)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if draft != nil {
+		*projects = draft.Projects
+		if *workspaceName == "" {
+			*workspaceName = draft.WorkspaceName
+		}
+	} else {
+		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		projectConfigs, res, err := apiClient.ProjectConfigAPI.ListProjectConfigs(ctx).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		*projects, err = workspace_util.GetProjectsCreationDataFromPrompt(workspace_util.ProjectsDataPromptConfig{
+			UserGitProviders: gitProviders,
+			ProjectConfigs:   projectConfigs,
+			Manual:           *projectConfigurationFlags.Manual,
+			MultiProject:     multiProjectFlag,
+			BlankProject:     blankFlag,
+			ApiClient:        apiClient,
+			Defaults:         projectDefaults,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		draft = &workspace_util.CreateDraft{
+			ProfileId: profileId,
+		}
 	}
 
 	initialSuggestion := (*projects)[0].Name
@@ -322,6 +482,24 @@ func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, works
 
 	dedupProjectNames(projects)
 
+	// Save the selected projects before the submission form, so they are kept even if it is cancelled
+	if draft.WorkspaceName == "" {
+		draft.WorkspaceName = suggestedName
+	}
+	draft.Projects = *projects
+	err = workspace_util.SaveCreateDraft(draft)
+	if err != nil {
+		log.Warn(err)
+	}
+
+	appliedOverrides := map[string][]string{}
+	for i := range *projects {
+		applied := workspace_util.ApplyOverrides(&(*projects)[i], overrides)
+		if len(applied) > 0 {
+			appliedOverrides[(*projects)[i].Name] = applied
+		}
+	}
+
 	submissionFormConfig := create.SubmissionFormConfig{
 		ChosenName:    workspaceName,
 		SuggestedName: suggestedName,
@@ -329,14 +507,60 @@ func processPrompting(ctx context.Context, apiClient *apiclient.APIClient, works
 		ProjectList:   projects,
 		NameLabel:     "Workspace",
 		Defaults:      projectDefaults,
+		Overrides:     appliedOverrides,
+		Validate: func() error {
+			return workspace_util.ValidateCreateProjects(*projects)
+		},
 	}
 
 	err = create.RunSubmissionForm(submissionFormConfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	draft.WorkspaceName = *workspaceName
+	draft.Projects = *projects
+	err = workspace_util.SaveCreateDraft(draft)
+	if err != nil {
+		log.Warn(err)
+	}
+
+	return draft, nil
+}
+
+// getResumableDraft returns the draft of a previous failed creation if the user chooses to resume it
+func getResumableDraft(profileId string) (*workspace_util.CreateDraft, error) {
+	draft, err := workspace_util.GetCreateDraft(profileId)
+	if err != nil || draft == nil {
+		return nil, err
+	}
+
+	resume := yesFlag
+	if !resume {
+		projectNames := []string{}
+		for _, p := range draft.Projects {
+			projectNames = append(projectNames, p.Name)
+		}
+
+		resume, err = create.RunResumeDraftForm(draft.WorkspaceName, projectNames, draft.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !resume {
+		return nil, workspace_util.DeleteCreateDraft()
+	}
+
+	return draft, nil
+}
+
+// discardCreateDraft removes the saved answers once the workspace was created
+func discardCreateDraft() {
+	err := workspace_util.DeleteCreateDraft()
+	if err != nil {
+		log.Warn(err)
+	}
 }
 
 func processCmdArguments(ctx context.Context, repoUrls []string, apiClient *apiclient.APIClient, projects *[]apiclient.CreateProjectDTO) ([]string, error) {
@@ -496,9 +720,14 @@ func waitForDial(workspace *apiclient.Workspace, activeProfile *config.Profile,
 	spinner := time.After(15 * time.Second)
 	timeout := time.After(2 * time.Minute)
 
+	sshAddress, err := conversion.ToProject(&workspace.Projects[0]).GetTailnetAddress(ssh_config.SSH_PORT)
+	if err != nil {
+		return err
+	}
+
 	go func() {
 		for {
-			dialConn, err := tsConn.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", project.GetProjectHostname(workspace.Id, workspace.Projects[0].Name), ssh_config.SSH_PORT))
+			dialConn, err := tsConn.Dial(context.Background(), "tcp", sshAddress)
 			if err == nil {
 				connectChan <- dialConn.Close()
 				return
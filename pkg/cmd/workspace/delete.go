@@ -63,7 +63,7 @@ var DeleteCmd = &cobra.Command{
 			return nil
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		var workspaceDeleteList = []*apiclient.WorkspaceDTO{}
 		var workspaceDeleteListNames = []string{}
@@ -141,6 +141,7 @@ func init() {
 	DeleteCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Delete all workspaces")
 	DeleteCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Confirm deletion without prompt")
 	DeleteCmd.Flags().BoolVarP(&forceFlag, "force", "f", false, "Delete a workspace by force")
+	DeleteCmd.Flags().BoolVar(&waitFlag, "wait", false, "Wait for the operation in progress on the workspace to finish instead of failing")
 }
 
 func DeleteAllWorkspaces(force bool) error {
@@ -169,7 +170,7 @@ func DeleteAllWorkspaces(force bool) error {
 func RemoveWorkspace(ctx context.Context, apiClient *apiclient.APIClient, workspace *apiclient.WorkspaceDTO, force bool) error {
 	message := fmt.Sprintf("Deleting workspace %s", workspace.Name)
 	err := views_util.WithInlineSpinner(message, func() error {
-		res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, workspace.Id).Force(force).Execute()
+		res, err := apiclient_util.WaitForOperation(ctx, waitFlag, apiClient.WorkspaceAPI.RemoveWorkspace(ctx, workspace.Id).Force(force).Execute)
 		if err != nil {
 			return apiclient_util.HandleErrorResponse(res, err)
 		}
@@ -189,7 +190,8 @@ func RemoveWorkspace(ctx context.Context, apiClient *apiclient.APIClient, worksp
 				return err
 			}
 		}
-		return nil
+
+		return c.RemoveLocalPorts(activeProfile.Id, workspace.Id)
 	})
 
 	if err != nil {
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	"github.com/daytonaio/daytona/pkg/views/workspace/diff"
+	"github.com/spf13/cobra"
+)
+
+var DiffCmd = &cobra.Command{
+	Use:     "diff [WORKSPACE_A] [WORKSPACE_B]",
+	Short:   "Compare the configuration of two workspaces",
+	Long:    "Compare the images, environment variables, build configuration, repositories and commands of the projects of two workspaces. Projects are matched by name.",
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspaceDiff, res, err := apiClient.WorkspaceAPI.DiffWorkspaces(cmd.Context(), args[0], args[1]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(workspaceDiff)
+			formattedData.Print()
+			return nil
+		}
+
+		diff.Render(workspaceDiff)
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	format.RegisterFormatFlag(DiffCmd)
+}
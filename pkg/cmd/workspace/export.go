@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+var exportFormatFlag string
+var exportOutputFlag string
+
+var ExportCmd = &cobra.Command{
+	Use:     "export [WORKSPACE]",
+	Short:   "Export a workspace to run it outside Daytona",
+	Long:    "Generate devcontainer or Docker Compose files with the image, environment variables, mounts and ports of the projects of a workspace, so an equivalent environment can be started without Daytona.",
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workspaceDTO, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		w := &workspace.Workspace{
+			Name: workspaceDTO.Name,
+		}
+		for _, p := range workspaceDTO.Projects {
+			w.Projects = append(w.Projects, conversion.ToProject(&p))
+		}
+
+		files, err := workspace.Export(w, workspace.ExportFormat(exportFormatFlag))
+		if err != nil {
+			return err
+		}
+
+		outputDir := exportOutputFlag
+		if outputDir == "" {
+			outputDir = fmt.Sprintf("%s-export", workspaceDTO.Name)
+		}
+
+		paths := []string{}
+		for path := range files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			filePath := filepath.Join(outputDir, filepath.FromSlash(path))
+
+			err = os.MkdirAll(filepath.Dir(filePath), 0755)
+			if err != nil {
+				return err
+			}
+
+			err = os.WriteFile(filePath, files[path], 0644)
+			if err != nil {
+				return err
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace %s exported to %s. Follow %s to start it", workspaceDTO.Name, outputDir, filepath.Join(outputDir, workspace.ExportReadmeFileName)))
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	ExportCmd.Flags().StringVar(&exportFormatFlag, "format", string(workspace.ExportFormatDevcontainer), fmt.Sprintf("Export format (%s|%s)", workspace.ExportFormatDevcontainer, workspace.ExportFormatCompose))
+	ExportCmd.Flags().StringVarP(&exportOutputFlag, "output", "o", "", "Directory to write the exported files to (default \"<workspace>-export\")")
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/ide"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var guiBackendFlag string
+var guiNoBrowserFlag bool
+
+var GuiCmd = &cobra.Command{
+	Use:     "gui [WORKSPACE] [PROJECT]",
+	Short:   "Open a virtual desktop of a project to run GUI applications",
+	Long:    "Start a virtual display with an Xpra or VNC server in the project and open its web client. The server is installed on first use and is only reachable over the Daytona network.",
+	Args:    cobra.RangeArgs(0, 2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		backend := ide.GuiBackend(guiBackendFlag)
+		if backend != ide.GuiBackendXpra && backend != ide.GuiBackendVnc {
+			return fmt.Errorf("invalid backend %s, supported backends are %s and %s", guiBackendFlag, ide.GuiBackendXpra, ide.GuiBackendVnc)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+		var workspace *apiclient.WorkspaceDTO
+		var projectName string
+		var providerConfigId *string
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Open GUI of")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(args[0], true)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(args) < 2 {
+			selectedProject, err := selectWorkspaceProject(workspace.Id, &activeProfile)
+			if err != nil {
+				return err
+			}
+			if selectedProject == nil {
+				return nil
+			}
+			projectName = selectedProject.Name
+			providerConfigId = selectedProject.GitProviderConfigId
+		} else {
+			projectName = args[1]
+			for _, project := range workspace.Projects {
+				if project.Name == projectName {
+					providerConfigId = project.GitProviderConfigId
+					break
+				}
+			}
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			wsRunningStatus, err := AutoStartWorkspace(workspace.Name, projectName)
+			if err != nil {
+				return err
+			}
+			if !wsRunningStatus {
+				return nil
+			}
+		}
+
+		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
+		if err != nil {
+			log.Warn(err)
+		}
+
+		yesFlag, _ := cmd.Flags().GetBool("yes")
+
+		return ide.OpenGUI(activeProfile, workspace.Id, projectName, ide.GuiOptions{
+			Backend:   backend,
+			NoBrowser: guiNoBrowserFlag,
+			Yes:       yesFlag,
+		}, gpgKey)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	GuiCmd.Flags().StringVar(&guiBackendFlag, "backend", string(ide.GuiBackendXpra), fmt.Sprintf("Display server to use (%s or %s)", ide.GuiBackendXpra, ide.GuiBackendVnc))
+	GuiCmd.Flags().BoolVar(&guiNoBrowserFlag, "no-browser", false, "Do not open the web client in the browser")
+	GuiCmd.Flags().BoolP("yes", "y", false, "Automatically confirm the installation of the display server")
+}
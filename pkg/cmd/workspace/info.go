@@ -4,8 +4,6 @@
 package workspace
 
 import (
-	"context"
-
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -23,7 +21,7 @@ var InfoCmd = &cobra.Command{
 	Args:    cobra.RangeArgs(0, 1),
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -69,7 +67,7 @@ var InfoCmd = &cobra.Command{
 			return nil
 		}
 
-		info.Render(workspace, "", false)
+		info.Render(workspace, "", apiclient_util.GetProjectsDrift(ctx, apiClient, workspace), apiclient_util.GetProjectsUsage(ctx, apiClient, workspace), false)
 		return nil
 	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
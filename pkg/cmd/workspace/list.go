@@ -4,8 +4,6 @@
 package workspace
 
 import (
-	"context"
-
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient"
@@ -24,7 +22,7 @@ var ListCmd = &cobra.Command{
 	Aliases: []string{"ls"},
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var specifyGitProviders bool
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
@@ -0,0 +1,193 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var portFlag uint16
+
+var OpenCmd = &cobra.Command{
+	Use:     "open [WORKSPACE] [PROJECT]",
+	Short:   "Open the web application running in a project in your browser",
+	Args:    cobra.RangeArgs(0, 2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		ctx := cmd.Context()
+		var workspace *apiclient.WorkspaceDTO
+		var project *apiclient.Project
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(true).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Open")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(url.PathEscape(args[0]), true)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(args) == 2 {
+			for _, p := range workspace.Projects {
+				if p.Name == args[1] {
+					project = &p
+					break
+				}
+			}
+			if project == nil {
+				return fmt.Errorf("project %s not found in workspace %s", args[1], workspace.Name)
+			}
+		} else {
+			project, err = selectWorkspaceProject(workspace.Id, &activeProfile)
+			if err != nil {
+				return err
+			}
+			if project == nil {
+				return nil
+			}
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, project.Name) {
+			return errors.New("project is not running. Start it with `daytona start`")
+		}
+
+		targetPort := portFlag
+		if targetPort == 0 {
+			targetPort, err = detectApplicationPort(ctx, apiClient, workspace.Id, project)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Forwarded to the same local port as by daytona forward, see daytona ports map
+		portName := fmt.Sprint(targetPort)
+		for _, port := range project.Ports {
+			if uint16(port.Port) == targetPort {
+				portName = port.Name
+			}
+		}
+
+		localPort, err := c.GetLocalPort(activeProfile.Id, workspace.Id, project.Name, portName, targetPort)
+		if err != nil {
+			return err
+		}
+
+		hostPort, errChan := tailscale.ForwardPortTo(workspace.Id, project.Name, targetPort, localPort, activeProfile)
+		if hostPort == nil {
+			return <-errChan
+		}
+
+		appUrl := fmt.Sprintf("http://localhost:%d", *hostPort)
+		views.RenderInfoMessage(fmt.Sprintf("Port %d forwarded to %s. Press Ctrl+C to stop forwarding.", targetPort, appUrl))
+
+		err = browser.OpenURL(appUrl)
+		if err != nil {
+			log.Error(err)
+		}
+
+		for {
+			err := <-errChan
+			if err != nil {
+				log.Debug(err)
+			}
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	OpenCmd.Flags().Uint16Var(&portFlag, "port", 0, "Project port to open instead of the detected one")
+}
+
+func detectApplicationPort(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, project *apiclient.Project) (uint16, error) {
+	portList, res, err := apiClient.WorkspaceToolboxAPI.ListPorts(ctx, workspaceId, project.Name).Execute()
+	if err != nil {
+		return 0, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	listeningPorts := []uint16{}
+	for _, port := range portList.Ports {
+		listeningPorts = append(listeningPorts, uint16(port))
+	}
+
+	devcontainerConfig, err := getDevcontainerConfig(ctx, apiClient, workspaceId, project)
+	if err != nil {
+		log.Debugf("failed to read devcontainer config: %v", err)
+	}
+
+	port, err := ports.SelectApplicationPort(listeningPorts, devcontainerConfig)
+	if err != nil {
+		if errors.Is(err, ports.ErrNoApplicationPort) {
+			return 0, errors.New("no web application port detected. Use the --port flag to specify one")
+		}
+		return 0, err
+	}
+
+	return port, nil
+}
+
+func getDevcontainerConfig(ctx context.Context, apiClient *apiclient.APIClient, workspaceId string, project *apiclient.Project) (*devcontainer.Configuration, error) {
+	if project.BuildConfig == nil || project.BuildConfig.Devcontainer == nil {
+		return nil, nil
+	}
+
+	content, res, err := apiClient.WorkspaceToolboxAPI.PreviewFile(ctx, workspaceId, project.Name).Path(project.BuildConfig.Devcontainer.FilePath).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return devcontainer.ParseConfiguration([]byte(content))
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var rebuildProjectFlag string
+var rebuildPullFlag bool
+
+var RebuildCmd = &cobra.Command{
+	Use:     "rebuild [WORKSPACE]",
+	Short:   "Rebuild workspace projects to apply devcontainer configuration changes",
+	Long:    "Rebuild workspace projects to apply devcontainer configuration changes.\nThe project container is recreated from the current configuration and the existing project volume is reattached, keeping uncommitted changes.",
+	Args:    cobra.RangeArgs(0, 1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		var workspace *apiclient.WorkspaceDTO
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(true).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Rebuild")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		projects := []apiclient.Project{}
+		for _, project := range workspace.Projects {
+			if rebuildProjectFlag == "" || project.Name == rebuildProjectFlag {
+				projects = append(projects, project)
+			}
+		}
+
+		if len(projects) == 0 {
+			return fmt.Errorf("project %s not found in workspace %s", rebuildProjectFlag, workspace.Name)
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		for _, project := range projects {
+			if rebuildPullFlag {
+				update, res, err := apiClient.WorkspaceToolboxAPI.UpdateProjectBranch(ctx, workspace.Id, project.Name).Update(*apiclient.NewUpdateBranchRequest(apiclient.BranchUpdateStrategyFastForward)).Execute()
+				if err != nil {
+					log.Errorf("Failed to update project %s: %v\n\n", project.Name, apiclient_util.HandleErrorResponse(res, err))
+					continue
+				}
+
+				renderBranchUpdate(project.Name, update)
+			}
+
+			from := time.Now().Truncate(time.Second)
+
+			logsContext, stopLogs := context.WithCancel(context.Background())
+			go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, []string{project.Name}, true, true, &from)
+
+			res, err := apiclient_util.WaitForOperation(ctx, waitFlag, apiClient.WorkspaceAPI.RebuildProject(ctx, workspace.Id, project.Name).Execute)
+			time.Sleep(100 * time.Millisecond)
+			stopLogs()
+			if err != nil {
+				log.Errorf("Failed to rebuild project %s: %v\n\n", project.Name, apiclient_util.HandleErrorResponse(res, err))
+				continue
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully rebuilt", project.Name, workspace.Name))
+		}
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	RebuildCmd.Flags().StringVarP(&rebuildProjectFlag, "project", "p", "", "Rebuild a single project in the workspace (project name)")
+	RebuildCmd.Flags().BoolVar(&rebuildPullFlag, "pull", false, "Update the branches to their upstream branches before rebuilding to apply upstream changes of the environment definition")
+	RebuildCmd.Flags().BoolVar(&waitFlag, "wait", false, "Wait for the operation in progress on the workspace to finish instead of failing")
+
+	err := RebuildCmd.RegisterFlagCompletionFunc("project", getProjectNameCompletions)
+	if err != nil {
+		log.Error("failed to register completion function: ", err)
+	}
+}
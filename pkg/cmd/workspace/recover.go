@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const recoveryConnectTimeout = 2 * time.Minute
+
+var recoverKeepFlag bool
+var recoverStopFlag bool
+
+var RecoverCmd = &cobra.Command{
+	Use:   "recover [WORKSPACE] [PROJECT]",
+	Short: "Open a recovery console for a project that does not start",
+	Long: "Open a recovery console for a project whose container, devcontainer config or SSH server is broken.\n" +
+		"A minimal recovery container that shares the project directory is started and an SSH session is opened in it, " +
+		"so the project can be fixed without losing work. The recovery container is removed when the session ends.",
+	Args:    cobra.RangeArgs(0, 2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			return err
+		}
+
+		var workspace *apiclient.WorkspaceDTO
+		var projectName string
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Recover")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(args) < 2 {
+			selectedProject, err := selectWorkspaceProject(workspace.Id, &activeProfile)
+			if err != nil {
+				return err
+			}
+			if selectedProject == nil {
+				return nil
+			}
+			projectName = selectedProject.Name
+		} else {
+			projectName = args[1]
+		}
+
+		if recoverStopFlag {
+			res, err := apiClient.WorkspaceAPI.StopProjectRecovery(ctx, workspace.Id, projectName).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Recovery of project '%s' stopped", projectName))
+			return nil
+		}
+
+		from := time.Now().Truncate(time.Second)
+
+		logsContext, stopLogs := context.WithCancel(context.Background())
+		go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, []string{projectName}, true, true, &from)
+
+		res, err := apiClient.WorkspaceAPI.StartProjectRecovery(ctx, workspace.Id, projectName).Execute()
+		time.Sleep(100 * time.Millisecond)
+		stopLogs()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if !recoverKeepFlag {
+			defer func() {
+				res, err := apiClient.WorkspaceAPI.StopProjectRecovery(context.Background(), workspace.Id, projectName).Execute()
+				if err != nil {
+					log.Errorf("Failed to stop recovery of project %s: %v", projectName, apiclient_util.HandleErrorResponse(res, err))
+				}
+			}()
+		}
+
+		err = views_util.WithInlineSpinner("Waiting for the recovery container", func() error {
+			return waitForRecovery(ctx, workspace.Id, projectName, &activeProfile)
+		})
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Recovery console of project '%s'. The project directory is mounted at its usual path", projectName))
+
+		return openRecoverySsh(activeProfile, workspace.Id, projectName)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	RecoverCmd.Flags().BoolVar(&recoverKeepFlag, "keep", false, "Keep the recovery container running after the session ends")
+	RecoverCmd.Flags().BoolVar(&recoverStopFlag, "stop", false, "Remove a recovery container that was kept running")
+	RecoverCmd.MarkFlagsMutuallyExclusive("keep", "stop")
+}
+
+// waitForRecovery waits until the SSH server of the recovery container is reachable on the tailnet
+func waitForRecovery(ctx context.Context, workspaceId, projectName string, profile *config.Profile) error {
+	dial, err := getProjectRecoveryDialer(workspaceId, projectName, profile)
+	if err != nil {
+		return err
+	}
+
+	timeout := time.After(recoveryConnectTimeout)
+
+	for {
+		dialCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		conn, err := dial(dialCtx, ssh_config.SSH_PORT)
+		cancel()
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return errors.New("timed out waiting for the recovery container. Check the project logs for errors")
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+func openRecoverySsh(profile config.Profile, workspaceId, projectName string) error {
+	daytonaPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	sshCommand := exec.Command("ssh",
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "UserKnownHostsFile=/dev/null",
+		"-o", fmt.Sprintf("ProxyCommand=\"%s\" ssh-proxy --recovery %s %s %s", daytonaPath, profile.Id, workspaceId, projectName),
+		fmt.Sprintf("root@%s-recovery", config.GetProjectHostname(profile.Id, workspaceId, projectName)),
+	)
+	sshCommand.Stdin = os.Stdin
+	sshCommand.Stdout = os.Stdout
+	sshCommand.Stderr = os.Stderr
+
+	return sshCommand.Run()
+}
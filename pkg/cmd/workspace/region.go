@@ -0,0 +1,199 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/constants"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/region"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	latencyTimeout = 3 * time.Second
+	latencySamples = 3
+)
+
+var regionFlag string
+
+// getCreationRegion returns the region set with --region or, if neither a region nor a target was chosen, the online
+// region with the lowest latency. Returns nil if federation is disabled
+func getCreationRegion(ctx context.Context, apiClient *apiclient.APIClient) (*apiclient.FederatedRegion, error) {
+	if regionFlag == "" && targetNameFlag != "" {
+		return nil, nil
+	}
+
+	regionList, res, err := apiClient.RegionAPI.ListRegions(ctx).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if len(regionList) == 0 {
+		if regionFlag != "" {
+			return nil, errors.New("federation is not enabled on the server")
+		}
+		return nil, nil
+	}
+
+	if regionFlag != "" {
+		for _, r := range regionList {
+			if r.Name == regionFlag {
+				return &r, nil
+			}
+		}
+		return nil, fmt.Errorf("region %s not found", regionFlag)
+	}
+
+	return getNearestRegion(ctx, regionList), nil
+}
+
+// getNearestRegion measures the latency to the health check of each online region and returns the fastest one.
+// The local region is returned if no region responds
+func getNearestRegion(ctx context.Context, regionList []apiclient.FederatedRegion) *apiclient.FederatedRegion {
+	var wg sync.WaitGroup
+	latencies := make([]time.Duration, len(regionList))
+
+	for i, r := range regionList {
+		if !isRegionOnline(r) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, r apiclient.FederatedRegion) {
+			defer wg.Done()
+			latencies[i] = measureLatency(ctx, r.ApiUrl)
+		}(i, r)
+	}
+
+	wg.Wait()
+
+	var nearest *apiclient.FederatedRegion
+	var nearestLatency time.Duration
+
+	for i := range regionList {
+		if latencies[i] == 0 {
+			continue
+		}
+		log.Debugf("latency to region %s: %s", regionList[i].Name, latencies[i])
+
+		if nearest == nil || latencies[i] < nearestLatency {
+			nearest = &regionList[i]
+			nearestLatency = latencies[i]
+		}
+	}
+
+	if nearest == nil {
+		for i := range regionList {
+			if regionList[i].Local {
+				return &regionList[i]
+			}
+		}
+	}
+
+	return nearest
+}
+
+// measureLatency returns the lowest round trip time of a few health checks, or 0 if the server is unreachable.
+// The first request also establishes the connection, so more than one sample is needed
+func measureLatency(ctx context.Context, apiUrl string) time.Duration {
+	client := &http.Client{Timeout: latencyTimeout}
+	var lowest time.Duration
+
+	for i := 0; i < latencySamples; i++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(apiUrl, "/")+constants.HEALTH_CHECK_ROUTE+"/", nil)
+		if err != nil {
+			return 0
+		}
+
+		start := time.Now()
+		res, err := client.Do(req)
+		if err != nil {
+			return 0
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			return 0
+		}
+
+		latency := time.Since(start)
+		if lowest == 0 || latency < lowest {
+			lowest = latency
+		}
+	}
+
+	return lowest
+}
+
+func isRegionOnline(r apiclient.FederatedRegion) bool {
+	if r.Local {
+		return true
+	}
+
+	lastSeenAt, err := time.Parse(time.RFC3339, r.LastSeenAt)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(lastSeenAt) < region.OfflineThreshold
+}
+
+// getRegionTarget returns the target of the region to create the workspace on
+func getRegionTarget(r *apiclient.FederatedRegion) (string, error) {
+	if targetNameFlag != "" {
+		if !slices.Contains(r.Targets, targetNameFlag) {
+			return "", fmt.Errorf("target %s not found in region %s, available targets: %s", targetNameFlag, r.Name, strings.Join(r.Targets, ", "))
+		}
+		return targetNameFlag, nil
+	}
+
+	if r.DefaultTarget != nil && *r.DefaultTarget != "" {
+		return *r.DefaultTarget, nil
+	}
+
+	if len(r.Targets) == 1 {
+		return r.Targets[0], nil
+	}
+
+	return "", fmt.Errorf("region %s has no default target, choose one of %s with --target", r.Name, strings.Join(r.Targets, ", "))
+}
+
+// createInRegion creates the workspace through the primary server on the server of a remote region. The workspace
+// connects to the control plane of its region, so it is not reachable through the network of the active profile
+func createInRegion(ctx context.Context, apiClient *apiclient.APIClient, r *apiclient.FederatedRegion, createWorkspaceDto apiclient.CreateWorkspaceDTO) error {
+	target, err := getRegionTarget(r)
+	if err != nil {
+		return err
+	}
+
+	createWorkspaceDto.Target = target
+	createWorkspaceDto.Region = &r.Name
+	if ttlFlag != "" {
+		createWorkspaceDto.Ttl = &ttlFlag
+	}
+	if len(sharedServicesFlag) > 0 {
+		createWorkspaceDto.SharedServices = sharedServicesFlag
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Creating workspace %s on target %s in region %s...", createWorkspaceDto.Name, target, r.Name))
+
+	_, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceDto).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Workspace %s created in region %s. Add a profile for %s with 'daytona profile add' to connect to it", createWorkspaceDto.Name, r.Name, r.ApiUrl))
+	return nil
+}
@@ -4,7 +4,6 @@
 package workspace
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/daytonaio/daytona/internal/util"
@@ -26,7 +25,7 @@ var RestartCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var workspaceId string
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -79,6 +78,7 @@ var RestartCmd = &cobra.Command{
 
 func init() {
 	RestartCmd.Flags().StringVarP(&restartProjectFlag, "project", "p", "", "Restart a single project in the workspace (project name)")
+	RestartCmd.Flags().BoolVar(&waitFlag, "wait", false, "Wait for the operation in progress on the workspace to finish instead of failing")
 }
 
 func RestartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName string) error {
@@ -7,14 +7,17 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net"
 	"os"
 	"time"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
-	"github.com/daytonaio/daytona/internal/util/apiclient"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/agent/ssh/resume"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
 	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
@@ -25,6 +28,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var sshProxyRecoveryFlag bool
+
 var SshProxyCmd = &cobra.Command{
 	Use:    "ssh-proxy [PROFILE_ID] [WORKSPACE_ID] [PROJECT]",
 	Args:   cobra.RangeArgs(2, 3),
@@ -47,18 +52,26 @@ var SshProxyCmd = &cobra.Command{
 		if len(args) == 3 {
 			projectName = args[2]
 		} else {
-			projectName, err = apiclient.GetFirstWorkspaceProjectName(workspaceId, projectName, &profile)
+			projectName, err = apiclient_util.GetFirstWorkspaceProjectName(workspaceId, projectName, &profile)
 			if err != nil {
 				return err
 			}
 		}
 
-		workspace, err := apiclient.GetWorkspace(workspaceId, true)
+		workspace, err := apiclient_util.GetWorkspace(workspaceId, true)
 		if err != nil {
 			return err
 		}
 
-		if workspace.Target == "local" && profile.Id == "default" {
+		// ssh verifies the project with the host key the server reports once the proxy is connected
+		if !sshProxyRecoveryFlag {
+			err = config.SetKnownHostsEntry(profileId, workspaceId, projectName, workspace_util.GetSshHostPublicKey(workspace, projectName))
+			if err != nil {
+				log.Warnf("Failed to update the known host key of project %s: %v", projectName, err)
+			}
+		}
+
+		if !sshProxyRecoveryFlag && workspace.Target == "local" && profile.Id == "default" {
 			// If the workspace is local, we directly access the ssh port through the container
 			project := workspace.Projects[0]
 
@@ -132,14 +145,33 @@ var SshProxyCmd = &cobra.Command{
 			}
 		}
 
-		tsConn, err := tailscale.GetConnection(&profile)
+		var dial func(ctx context.Context, port uint16) (net.Conn, error)
+		if sshProxyRecoveryFlag {
+			dial, err = getProjectRecoveryDialer(workspace.Id, projectName, &profile)
+		} else {
+			dial, err = tailscale.GetProjectDialer(workspace, projectName, &profile)
+		}
 		if err != nil {
 			return err
 		}
 
+		// Resumable sessions keep the SSH connection open while the tailnet connection is reestablished
+		resumeClient := &resume.Client{
+			Dial: func(ctx context.Context) (net.Conn, error) {
+				return dial(ctx, ssh_config.SSH_RESUME_PORT)
+			},
+		}
+
+		err = resumeClient.Connect(cmd.Context(), os.Stdout)
+		if err == nil {
+			return resumeClient.Proxy(cmd.Context(), os.Stdin)
+		}
+		// Agents of older versions do not support resumable sessions
+		log.Debugf("failed to open resumable session, falling back to a direct connection: %v", err)
+
 		errChan := make(chan error)
 
-		dialConn, err := tsConn.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", project.GetProjectHostname(workspaceId, projectName), ssh_config.SSH_PORT))
+		dialConn, err := dial(context.Background(), ssh_config.SSH_PORT)
 		if err != nil {
 			return err
 		}
@@ -164,3 +196,22 @@ var SshProxyCmd = &cobra.Command{
 		return <-errChan
 	},
 }
+
+// getProjectRecoveryDialer returns a function that connects to ports of the recovery container of the project over the tailnet
+func getProjectRecoveryDialer(workspaceId, projectName string, profile *config.Profile) (func(ctx context.Context, port uint16) (net.Conn, error), error) {
+	tsConn, err := tailscale.GetConnection(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryHostname := project.GetProjectRecoveryHostname(workspaceId, projectName)
+
+	return func(ctx context.Context, port uint16) (net.Conn, error) {
+		return tsConn.Dial(ctx, "tcp", fmt.Sprintf("%s:%d", recoveryHostname, port))
+	}, nil
+}
+
+func init() {
+	SshProxyCmd.Flags().BoolVar(&sshProxyRecoveryFlag, "recovery", false, "Connect to the recovery container of the project")
+	_ = SshProxyCmd.Flags().MarkHidden("recovery")
+}
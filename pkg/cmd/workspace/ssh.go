@@ -4,7 +4,6 @@
 package workspace
 
 import (
-	"context"
 	"fmt"
 	"path/filepath"
 	"regexp"
@@ -20,6 +19,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/ide"
 	"github.com/daytonaio/daytona/pkg/views"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	info_view "github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -46,7 +46,7 @@ var SshCmd = &cobra.Command{
 			return err
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 		var workspace *apiclient.WorkspaceDTO
 		var projectName string
 		var providerConfigId *string
@@ -121,6 +121,9 @@ var SshCmd = &cobra.Command{
 		sshArgs := []string{}
 		if len(args) > 2 {
 			sshArgs = append(sshArgs, args[2:]...)
+		} else {
+			// The output of remote commands is left as is
+			info_view.RenderEnvironmentChangesNotice(workspace, projectName)
 		}
 
 		gpgKey, err := GetGitProviderGpgKey(apiClient, ctx, providerConfigId)
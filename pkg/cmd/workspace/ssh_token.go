@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/spf13/cobra"
+)
+
+var SshTokenCmd = &cobra.Command{
+	Use:   "ssh-token WORKSPACE PROJECT",
+	Short: "Issue a token for plain SSH access to a project",
+	Long: `Issue a short-lived token to use as the password of plain SSH clients on the tailnet node of a project, e.g. ssh daytona@<project hostname>.
+
+The project agent must serve SSH on the tailnet. The token expires after a few minutes, established connections are kept open.`,
+	Args:    cobra.ExactArgs(2),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], false)
+		if err != nil {
+			return err
+		}
+
+		token, res, err := apiClient.WorkspaceAPI.CreateSshToken(cmd.Context(), workspace.Id, args[1]).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		fmt.Println(token.Token)
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
@@ -30,6 +30,7 @@ const (
 
 var startProjectFlag string
 var allFlag bool
+var waitFlag bool
 var codeFlag bool
 
 var StartCmd = &cobra.Command{
@@ -45,7 +46,7 @@ var StartCmd = &cobra.Command{
 		var providerConfigId *string
 		projectProviderMetadata := ""
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -93,7 +94,7 @@ var StartCmd = &cobra.Command{
 				}
 
 				ideList = config.GetIdeList()
-				ideId = c.DefaultIdeId
+				ideId = c.GetDefaultIdeId()
 
 				wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceName).Execute()
 				if err != nil {
@@ -164,6 +165,7 @@ func init() {
 	StartCmd.PersistentFlags().BoolVarP(&allFlag, "all", "a", false, "Start all workspaces")
 	StartCmd.PersistentFlags().BoolVarP(&codeFlag, "code", "c", false, "Open the workspace in the IDE after workspace start")
 	StartCmd.PersistentFlags().BoolVarP(&yesFlag, "yes", "y", false, "Automatically confirm any prompts")
+	StartCmd.PersistentFlags().BoolVar(&waitFlag, "wait", false, "Wait for the operation in progress on the workspace to finish instead of failing")
 
 	err := StartCmd.RegisterFlagCompletionFunc("project", getProjectNameCompletions)
 	if err != nil {
@@ -302,7 +304,7 @@ func StartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName str
 	go apiclient_util.ReadWorkspaceLogs(logsContext, activeProfile, workspace.Id, projectNames, true, true, &from)
 
 	if projectName == "" {
-		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute()
+		res, err := apiclient_util.WaitForOperation(ctx, waitFlag, apiClient.WorkspaceAPI.StartWorkspace(ctx, workspaceId).Execute)
 		if err != nil {
 			stopLogs()
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -311,7 +313,7 @@ func StartWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName str
 		stopLogs()
 		return nil
 	} else {
-		res, err := apiClient.WorkspaceAPI.StartProject(ctx, workspaceId, projectName).Execute()
+		res, err := apiclient_util.WaitForOperation(ctx, waitFlag, apiClient.WorkspaceAPI.StartProject(ctx, workspaceId, projectName).Execute)
 		if err != nil {
 			stopLogs()
 			return apiclient_util.HandleErrorResponse(res, err)
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/format"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/statehistory"
+	"github.com/spf13/cobra"
+)
+
+var stateHistoryAtFlag string
+var stateHistorySinceFlag string
+var stateHistoryProjectFlag string
+
+var StateHistoryCmd = &cobra.Command{
+	Use:   "state-history [WORKSPACE]",
+	Short: "Show the recorded states of a workspace",
+	Long: `Show the periodic snapshots of the state of the projects of a workspace recorded by the server, e.g. to find out when a workspace stopped.
+
+Use --at to show the last known state at a point in time, e.g. --at "2024-05-01 14:00", --at 14:00 or --at 10h for ten hours ago.`,
+	Args:    cobra.ExactArgs(1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		req := apiClient.WorkspaceAPI.GetWorkspaceStateHistory(cmd.Context(), args[0])
+		if stateHistoryAtFlag != "" {
+			at, err := workspace_util.ParsePointInTime(stateHistoryAtFlag, time.Now())
+			if err != nil {
+				return err
+			}
+			req = req.At(at.Format(time.RFC3339))
+		}
+		if stateHistorySinceFlag != "" {
+			req = req.Since(stateHistorySinceFlag)
+		}
+		if stateHistoryProjectFlag != "" {
+			req = req.Project(stateHistoryProjectFlag)
+		}
+
+		snapshots, res, err := req.Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if format.FormatFlag != "" {
+			formattedData := format.NewFormatter(snapshots)
+			formattedData.Print()
+			return nil
+		}
+
+		statehistory.ListSnapshots(args[0], snapshots)
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	StateHistoryCmd.Flags().StringVar(&stateHistoryAtFlag, "at", "", "Show the last known state at the time instead of every snapshot")
+	StateHistoryCmd.Flags().StringVar(&stateHistorySinceFlag, "since", "24h", "Only show snapshots recorded within the duration. Ignored with --at")
+	StateHistoryCmd.Flags().StringVarP(&stateHistoryProjectFlag, "project", "p", "", "Only show the project")
+	format.RegisterFormatFlag(StateHistoryCmd)
+}
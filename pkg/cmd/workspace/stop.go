@@ -20,10 +20,12 @@ import (
 )
 
 var stopProjectFlag string
+var pauseFlag bool
 
 var StopCmd = &cobra.Command{
 	Use:     "stop [WORKSPACE]",
 	Short:   "Stop a workspace",
+	Long:    "Stop a workspace\n\nWith --pause, the running processes of the projects are checkpointed, e.g. with CRIU on Docker or by suspending the VM, and the projects continue where they were paused on start. Projects whose provider can't pause them or that fail to checkpoint are stopped.",
 	GroupID: util.WORKSPACE_GROUP,
 	Args:    cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -47,7 +49,7 @@ var StopCmd = &cobra.Command{
 			return stopAllWorkspaces(activeProfile, from)
 		}
 
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
@@ -81,7 +83,7 @@ var StopCmd = &cobra.Command{
 					return p.Name
 				})
 				apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
-				views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
+				views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully %s", workspace.Name, stoppedVerb()))
 			}
 		} else {
 			workspaceId := args[0]
@@ -108,9 +110,9 @@ var StopCmd = &cobra.Command{
 			apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
 
 			if stopProjectFlag != "" {
-				views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully stopped", stopProjectFlag, workspaceId))
+				views.RenderInfoMessage(fmt.Sprintf("Project '%s' from workspace '%s' successfully %s", stopProjectFlag, workspaceId, stoppedVerb()))
 			} else {
-				views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully stopped", workspaceId))
+				views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully %s", workspaceId, stoppedVerb()))
 			}
 		}
 		return nil
@@ -123,6 +125,8 @@ var StopCmd = &cobra.Command{
 func init() {
 	StopCmd.Flags().StringVarP(&stopProjectFlag, "project", "p", "", "Stop a single project in the workspace (project name)")
 	StopCmd.Flags().BoolVarP(&allFlag, "all", "a", false, "Stop all workspaces")
+	StopCmd.Flags().BoolVar(&pauseFlag, "pause", false, "Checkpoint the running processes so that they are resumed on start instead of stopping them")
+	StopCmd.Flags().BoolVar(&waitFlag, "wait", false, "Wait for the operation in progress on the workspace to finish instead of failing")
 }
 
 func stopAllWorkspaces(activeProfile config.Profile, from time.Time) error {
@@ -149,7 +153,7 @@ func stopAllWorkspaces(activeProfile config.Profile, from time.Time) error {
 		})
 
 		apiclient_util.ReadWorkspaceLogs(ctx, activeProfile, workspace.Id, projectNames, false, true, &from)
-		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully stopped", workspace.Name))
+		views.RenderInfoMessage(fmt.Sprintf("- Workspace '%s' successfully %s", workspace.Name, stoppedVerb()))
 	}
 	return nil
 }
@@ -161,8 +165,13 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 
 	if projectName == "" {
 		message = fmt.Sprintf("Workspace '%s' is stopping", workspaceId)
+		execute := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute
+		if pauseFlag {
+			message = fmt.Sprintf("Workspace '%s' is pausing", workspaceId)
+			execute = apiClient.WorkspaceAPI.PauseWorkspace(ctx, workspaceId).Execute
+		}
 		stopFunc = func() error {
-			res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute()
+			res, err := apiclient_util.WaitForOperation(ctx, waitFlag, execute)
 			if err != nil {
 				return apiclient_util.HandleErrorResponse(res, err)
 			}
@@ -170,8 +179,13 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 		}
 	} else {
 		message = fmt.Sprintf("Project '%s' from workspace '%s' is stopping", projectName, workspaceId)
+		execute := apiClient.WorkspaceAPI.StopProject(ctx, workspaceId, projectName).Execute
+		if pauseFlag {
+			message = fmt.Sprintf("Project '%s' from workspace '%s' is pausing", projectName, workspaceId)
+			execute = apiClient.WorkspaceAPI.PauseProject(ctx, workspaceId, projectName).Execute
+		}
 		stopFunc = func() error {
-			res, err := apiClient.WorkspaceAPI.StopProject(ctx, workspaceId, projectName).Execute()
+			res, err := apiclient_util.WaitForOperation(ctx, waitFlag, execute)
 			if err != nil {
 				return apiclient_util.HandleErrorResponse(res, err)
 			}
@@ -186,3 +200,10 @@ func StopWorkspace(apiClient *apiclient.APIClient, workspaceId, projectName stri
 
 	return nil
 }
+
+func stoppedVerb() string {
+	if pauseFlag {
+		return "paused"
+	}
+	return "stopped"
+}
@@ -0,0 +1,268 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
+	"github.com/daytonaio/daytona/pkg/filesync"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/pkg/sftp"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh"
+)
+
+var syncProjectFlag string
+var syncIgnoreFlag []string
+var syncIgnoreVcsFlag bool
+var syncConflictFlag string
+var syncIntervalFlag time.Duration
+var syncOnceFlag bool
+
+var SyncCmd = &cobra.Command{
+	Use:   "sync WORKSPACE LOCAL_PATH:REMOTE_PATH",
+	Short: "Sync a local directory with a directory of a project in both directions",
+	Long: "Sync a local directory with a directory of a project in both directions, so that local editors can be used without IDE remote plugins. Files are transferred over SFTP through the SSH server of the project.\n" +
+		"Relative remote paths, or an empty one, are resolved against the project directory. Paths are excluded with --ignore patterns in the .gitignore format.\n" +
+		"A file changed on both sides is resolved according to --conflict and the losing version is kept next to it as a .sync-conflict-<time> copy.",
+	Example: "  daytona sync my-workspace ./src:src --ignore node_modules/ --ignore '*.log'",
+	GroupID: util.WORKSPACE_GROUP,
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		conflict := filesync.ConflictMode(syncConflictFlag)
+		err := conflict.Validate()
+		if err != nil {
+			return err
+		}
+
+		localPath, remotePath, err := parseSyncPaths(args[1])
+		if err != nil {
+			return err
+		}
+
+		c, err := config.GetConfig()
+		if err != nil {
+			return err
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			return err
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0], true)
+		if err != nil {
+			return err
+		}
+
+		projectName, err := apiclient_util.GetFirstWorkspaceProjectName(workspace.Id, syncProjectFlag, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		if !workspace_util.IsProjectRunning(workspace, projectName) {
+			return errors.New("project is not running. Start it with `daytona start`")
+		}
+
+		dial, err := tailscale.GetProjectDialer(workspace, projectName, &activeProfile)
+		if err != nil {
+			return err
+		}
+
+		conn, err := dial(ctx, ssh_config.SSH_PORT)
+		if err != nil {
+			return err
+		}
+
+		sshClient, err := newSyncSshClient(conn, workspace_util.GetSshHostPublicKey(workspace, projectName))
+		if err != nil {
+			return err
+		}
+		defer sshClient.Close()
+
+		if !path.IsAbs(remotePath) {
+			projectDir, err := getRemoteProjectDir(sshClient)
+			if err != nil {
+				return err
+			}
+			remotePath = path.Join(projectDir, remotePath)
+		}
+
+		sftpClient, err := sftp.NewClient(sshClient)
+		if err != nil {
+			return err
+		}
+		defer sftpClient.Close()
+
+		localPath, err = filepath.Abs(localPath)
+		if err != nil {
+			return err
+		}
+
+		err = os.MkdirAll(localPath, 0755)
+		if err != nil {
+			return err
+		}
+
+		err = sftpClient.MkdirAll(remotePath)
+		if err != nil {
+			return fmt.Errorf("failed to create remote directory %s: %w", remotePath, err)
+		}
+
+		statePath, err := getSyncStatePath(activeProfile.Id, workspace.Id, projectName, localPath, remotePath)
+		if err != nil {
+			return err
+		}
+
+		ignorePatterns := syncIgnoreFlag
+		if syncIgnoreVcsFlag {
+			ignorePatterns = append([]string{".git/"}, ignorePatterns...)
+		}
+
+		session := &filesync.Session{
+			Local:     &filesync.LocalFS{Root: localPath},
+			Remote:    &filesync.SftpFS{Client: sftpClient, Root: remotePath},
+			Ignore:    filesync.NewIgnore(ignorePatterns),
+			Conflict:  conflict,
+			StatePath: statePath,
+		}
+
+		if !syncOnceFlag {
+			views.RenderInfoMessage(fmt.Sprintf("Syncing %s with %s in project %s. Press Ctrl+C to stop.", localPath, remotePath, projectName))
+		}
+
+		for {
+			result, err := session.Sync(time.Now())
+			if result == nil {
+				return err
+			}
+			if err != nil {
+				log.Error(err)
+			}
+
+			renderSyncResult(result)
+
+			if syncOnceFlag {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(syncIntervalFlag):
+			}
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	SyncCmd.Flags().StringVarP(&syncProjectFlag, "project", "p", "", "Project to sync with. Defaults to the first project of the workspace")
+	SyncCmd.Flags().StringArrayVar(&syncIgnoreFlag, "ignore", nil, "Pattern in the .gitignore format of the paths excluded from the sync")
+	SyncCmd.Flags().BoolVar(&syncIgnoreVcsFlag, "ignore-vcs", true, "Exclude the .git directories from the sync")
+	SyncCmd.Flags().StringVar(&syncConflictFlag, "conflict", string(filesync.ConflictNewer), fmt.Sprintf("Version kept when a file changed on both sides: %s, %s or %s", filesync.ConflictNewer, filesync.ConflictLocal, filesync.ConflictRemote))
+	SyncCmd.Flags().DurationVar(&syncIntervalFlag, "interval", 2*time.Second, "Interval at which the directories are compared")
+	SyncCmd.Flags().BoolVar(&syncOnceFlag, "once", false, "Sync once and exit")
+}
+
+// parseSyncPaths splits LOCAL_PATH:REMOTE_PATH at the last colon, so that local paths can contain Windows drive letters
+func parseSyncPaths(arg string) (string, string, error) {
+	i := strings.LastIndex(arg, ":")
+	if i <= 0 {
+		return "", "", fmt.Errorf("invalid sync paths %q, expected LOCAL_PATH:REMOTE_PATH", arg)
+	}
+
+	return arg[:i], arg[i+1:], nil
+}
+
+// newSyncSshClient connects to the SSH server of the project. The host key is verified if the workspace reports one
+func newSyncSshClient(conn net.Conn, hostPublicKey string) (*ssh.Client, error) {
+	hostKeyCallback := ssh.InsecureIgnoreHostKey()
+	if hostPublicKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostPublicKey))
+		if err != nil {
+			return nil, err
+		}
+		hostKeyCallback = ssh.FixedHostKey(key)
+	}
+
+	address := conn.RemoteAddr().String()
+	c, chans, reqs, err := ssh.NewClientConn(conn, address, &ssh.ClientConfig{
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// getRemoteProjectDir returns the directory the SSH server of the project runs commands in
+func getRemoteProjectDir(sshClient *ssh.Client) (string, error) {
+	session, err := sshClient.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	output, err := session.Output("pwd")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the project directory: %w", err)
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// getSyncStatePath returns the file the state of the sync between the directories is persisted to
+func getSyncStatePath(profileId, workspaceId, projectName, localPath, remotePath string) (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join([]string{profileId, workspaceId, projectName, localPath, remotePath}, "\n")))
+
+	return filepath.Join(configDir, "sync", hex.EncodeToString(hash[:8])+".json"), nil
+}
+
+func renderSyncResult(result *filesync.Result) {
+	for _, p := range result.RemoteChanges {
+		fmt.Printf("-> %s\n", p)
+	}
+	for _, p := range result.LocalChanges {
+		fmt.Printf("<- %s\n", p)
+	}
+	for _, p := range result.Conflicts {
+		views.RenderInfoMessage(fmt.Sprintf("Conflict: kept the other version as %s", p))
+	}
+	for _, p := range result.Unresolved {
+		views.RenderInfoMessage(fmt.Sprintf("Not synced: %s is a directory on one side and a file on the other", p))
+	}
+}
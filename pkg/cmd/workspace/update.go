@@ -0,0 +1,123 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var updateProjectFlag string
+var updateRebaseFlag bool
+
+var UpdateCmd = &cobra.Command{
+	Use:     "update [WORKSPACE]",
+	Short:   "Update the checked out branches of workspace projects to their upstream branches",
+	Long:    "Update the checked out branches of workspace projects to their upstream branches.\nBranches are fast-forwarded by default. Local changes are stashed during the update and a rebase that conflicts is aborted.",
+	Args:    cobra.RangeArgs(0, 1),
+	GroupID: util.WORKSPACE_GROUP,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		var workspace *apiclient.WorkspaceDTO
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(true).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			if len(workspaceList) == 0 {
+				views_util.NotifyEmptyWorkspaceList(true)
+				return nil
+			}
+
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Update")
+			if workspace == nil {
+				return nil
+			}
+		} else {
+			workspace, err = apiclient_util.GetWorkspace(args[0], false)
+			if err != nil {
+				return err
+			}
+		}
+
+		projects := []apiclient.Project{}
+		for _, project := range workspace.Projects {
+			if updateProjectFlag == "" || project.Name == updateProjectFlag {
+				projects = append(projects, project)
+			}
+		}
+
+		if len(projects) == 0 {
+			return fmt.Errorf("project %s not found in workspace %s", updateProjectFlag, workspace.Name)
+		}
+
+		strategy := apiclient.BranchUpdateStrategyFastForward
+		if updateRebaseFlag {
+			strategy = apiclient.BranchUpdateStrategyRebase
+		}
+
+		for _, project := range projects {
+			update, res, err := apiClient.WorkspaceToolboxAPI.UpdateProjectBranch(ctx, workspace.Id, project.Name).Update(*apiclient.NewUpdateBranchRequest(strategy)).Execute()
+			if err != nil {
+				log.Errorf("Failed to update project %s: %v\n\n", project.Name, apiclient_util.HandleErrorResponse(res, err))
+				continue
+			}
+
+			renderBranchUpdate(project.Name, update)
+		}
+
+		return nil
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func renderBranchUpdate(projectName string, update *apiclient.BranchUpdate) {
+	if len(update.Conflicts) > 0 {
+		views.RenderInfoMessageBold(fmt.Sprintf("Rebasing branch '%s' of project '%s' onto '%s' conflicts in:\n%s\nThe rebase was aborted and the branch was left unchanged", update.Branch, projectName, update.Upstream, strings.Join(update.Conflicts, "\n")))
+		return
+	}
+
+	if update.Sha == update.PreviousSha {
+		views.RenderInfoMessage(fmt.Sprintf("Branch '%s' of project '%s' is up to date with '%s'", update.Branch, projectName, update.Upstream))
+		return
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("Branch '%s' of project '%s' updated from %s to %s", update.Branch, projectName, shortSha(update.PreviousSha), shortSha(update.Sha)))
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
+func init() {
+	UpdateCmd.Flags().StringVarP(&updateProjectFlag, "project", "p", "", "Update a single project in the workspace (project name)")
+	UpdateCmd.Flags().BoolVar(&updateRebaseFlag, "rebase", false, "Rebase the branches onto their upstream branches instead of fast-forwarding them")
+
+	err := UpdateCmd.RegisterFlagCompletionFunc("project", getProjectNameCompletions)
+	if err != nil {
+		log.Error("failed to register completion function: ", err)
+	}
+}
@@ -47,6 +47,9 @@ func AddProjectFromConfig(projectConfig *apiclient.ProjectConfig, apiClient *api
 		Image:       &projectConfig.Image,
 		User:        &projectConfig.User,
 		EnvVars:     projectConfig.EnvVars,
+		Mounts:      projectConfig.Mounts,
+		Commands:    projectConfig.Commands,
+		Ports:       projectConfig.Ports,
 	}
 	*projects = append(*projects, *project)
 
@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// ParseCommand parses the value of the --command flag, e.g. test=go test ./...
+func ParseCommand(value string) (apiclient.ProjectCommand, error) {
+	c, err := project.ParseCommand(value)
+	if err != nil {
+		return apiclient.ProjectCommand{}, err
+	}
+
+	return conversion.ToCommandDTO(c), nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// Drafts older than this are discarded instead of offered for resuming
+const createDraftMaxAge = 24 * time.Hour
+
+// CreateDraft holds the answers given in an interactive workspace creation, so a failed creation
+// can be resumed without going through the prompts again
+type CreateDraft struct {
+	ProfileId     string                       `json:"profileId"`
+	WorkspaceName string                       `json:"workspaceName"`
+	Projects      []apiclient.CreateProjectDTO `json:"projects"`
+	Target        string                       `json:"target,omitempty"`
+	UpdatedAt     time.Time                    `json:"updatedAt"`
+}
+
+// GetCreateDraft returns the draft saved for the profile, nil if there is none or it expired
+func GetCreateDraft(profileId string) (*CreateDraft, error) {
+	draftPath, err := getCreateDraftPath()
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := os.ReadFile(draftPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var draft CreateDraft
+	err = json.Unmarshal(content, &draft)
+	if err != nil {
+		// A corrupted draft is not worth failing the creation for
+		return nil, DeleteCreateDraft()
+	}
+
+	if draft.ProfileId != profileId || len(draft.Projects) == 0 || time.Since(draft.UpdatedAt) > createDraftMaxAge {
+		return nil, nil
+	}
+
+	return &draft, nil
+}
+
+// SaveCreateDraft replaces the saved draft. The file is only readable by the user since projects can hold secrets
+// in their environment variables
+func SaveCreateDraft(draft *CreateDraft) error {
+	draftPath, err := getCreateDraftPath()
+	if err != nil {
+		return err
+	}
+
+	draft.UpdatedAt = time.Now()
+
+	content, err := json.MarshalIndent(draft, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(draftPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(draftPath, content, 0600)
+}
+
+func DeleteCreateDraft() error {
+	draftPath, err := getCreateDraftPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(draftPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func getCreateDraftPath() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "create-draft.json"), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDraft(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("DAYTONA_CONFIG_DIR", dir)
+
+	draft, err := GetCreateDraft("default")
+	require.NoError(t, err)
+	require.Nil(t, draft)
+
+	require.NoError(t, SaveCreateDraft(&CreateDraft{
+		ProfileId:     "default",
+		WorkspaceName: "daytona",
+		Projects:      []apiclient.CreateProjectDTO{{Name: "daytona", EnvVars: map[string]string{"TOKEN": "secret"}}},
+		Target:        "local",
+	}))
+
+	info, err := os.Stat(filepath.Join(dir, "create-draft.json"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	draft, err = GetCreateDraft("default")
+	require.NoError(t, err)
+	require.Equal(t, "daytona", draft.WorkspaceName)
+	require.Equal(t, "local", draft.Target)
+	require.Equal(t, "secret", draft.Projects[0].EnvVars["TOKEN"])
+
+	draft, err = GetCreateDraft("other")
+	require.NoError(t, err)
+	require.Nil(t, draft, "drafts are only resumed in the profile they were created in")
+
+	require.NoError(t, DeleteCreateDraft())
+	draft, err = GetCreateDraft("default")
+	require.NoError(t, err)
+	require.Nil(t, draft)
+	require.NoError(t, DeleteCreateDraft())
+}
+
+func TestCreateDraftExpired(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("DAYTONA_CONFIG_DIR", dir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "create-draft.json"), []byte(`{
+  "profileId": "default",
+  "workspaceName": "daytona",
+  "projects": [{"name": "daytona", "envVars": {}, "source": {"repository": {"id": "", "name": "", "owner": "", "sha": "", "source": "", "url": ""}}}],
+  "updatedAt": "`+time.Now().Add(-2*createDraftMaxAge).Format(time.RFC3339)+`"
+}`), 0600))
+
+	draft, err := GetCreateDraft("default")
+	require.NoError(t, err)
+	require.Nil(t, draft)
+}
+
+func TestValidateCreateProjects(t *testing.T) {
+	require.NoError(t, ValidateCreateProjects([]apiclient.CreateProjectDTO{
+		{Name: "api", Ports: []apiclient.ProjectPort{{Name: "web", Port: 3000}}},
+		{Name: "web", Ports: []apiclient.ProjectPort{{Name: "web", Port: 8080}}},
+	}))
+
+	require.ErrorContains(t, ValidateCreateProjects([]apiclient.CreateProjectDTO{
+		{Name: "api", Image: new(string)},
+	}), "image is required")
+
+	require.ErrorContains(t, ValidateCreateProjects([]apiclient.CreateProjectDTO{
+		{Name: "api", Mounts: []apiclient.Mount{{Type: apiclient.MountTypeTmpfs, Target: "cache"}}},
+	}), "project api")
+
+	require.ErrorContains(t, ValidateCreateProjects([]apiclient.CreateProjectDTO{
+		{Name: "api", Ports: []apiclient.ProjectPort{{Name: "web", Port: 3000}}},
+		{Name: "web", Ports: []apiclient.ProjectPort{{Name: "web", Port: 3000}}},
+	}), "both declare port 3000")
+}
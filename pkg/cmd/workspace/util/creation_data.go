@@ -101,6 +101,9 @@ func GetProjectsCreationDataFromPrompt(config ProjectsDataPromptConfig) ([]apicl
 					Image:       config.Defaults.Image,
 					User:        config.Defaults.ImageUser,
 					EnvVars:     projectConfig.EnvVars,
+					Mounts:      projectConfig.Mounts,
+					Commands:    projectConfig.Commands,
+					Ports:       projectConfig.Ports,
 				}
 
 				if projectConfig.Image != "" {
@@ -288,6 +291,37 @@ func GetCreateProjectDtoFromFlags(projectConfigurationFlags ProjectConfiguration
 
 	project.EnvVars = envVars
 
+	for _, value := range *projectConfigurationFlags.Mounts {
+		mount, err := ParseMount(value)
+		if err != nil {
+			return nil, err
+		}
+		project.Mounts = append(project.Mounts, mount)
+	}
+
+	for _, value := range *projectConfigurationFlags.Commands {
+		command, err := ParseCommand(value)
+		if err != nil {
+			return nil, err
+		}
+		project.Commands = append(project.Commands, command)
+	}
+
+	for _, value := range *projectConfigurationFlags.Ports {
+		port, err := ParsePort(value)
+		if err != nil {
+			return nil, err
+		}
+		project.Ports = append(project.Ports, port)
+	}
+
+	if *projectConfigurationFlags.Debug != "" {
+		err := ApplyDebugPreset(project, *projectConfigurationFlags.Debug)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return project, nil
 }
 
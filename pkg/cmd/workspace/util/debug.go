@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// ApplyDebugPreset declares the debug port of the debug preset on the project
+func ApplyDebugPreset(projectDto *apiclient.CreateProjectDTO, presetName string) error {
+	preset, err := project.GetDebugPreset(presetName)
+	if err != nil {
+		return err
+	}
+
+	ports := []project.Port{}
+	for _, port := range projectDto.Ports {
+		ports = append(ports, conversion.ToPort(port))
+	}
+
+	ports, err = preset.Apply(ports)
+	if err != nil {
+		return err
+	}
+
+	projectDto.Ports = []apiclient.ProjectPort{}
+	for _, port := range ports {
+		projectDto.Ports = append(projectDto.Ports, conversion.ToPortDTO(port))
+	}
+
+	return nil
+}
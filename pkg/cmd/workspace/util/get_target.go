@@ -44,7 +44,7 @@ func GetTarget(config GetTargetConfig) (*target_view.TargetView, error) {
 		}
 	}
 
-	serverConfig, res, err := config.ApiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+	serverConfig, res, err := config.ApiClient.ServerAPI.GetConfig(context.Background()).Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
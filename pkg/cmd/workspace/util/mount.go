@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// ParseMount parses the value of the --mount flag, e.g. type=bind,source=/data,target=/data,readonly
+func ParseMount(value string) (apiclient.Mount, error) {
+	m, err := project.ParseMount(value)
+	if err != nil {
+		return apiclient.Mount{}, err
+	}
+
+	return conversion.ToMountDTO(m), nil
+}
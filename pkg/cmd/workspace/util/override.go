@@ -0,0 +1,203 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/go-git/go-git/v5"
+	"sigs.k8s.io/yaml"
+)
+
+const OverrideFileName = "daytona.override.yaml"
+
+// ProjectOverride replaces the image and user and adds environment variables and mounts on top of the project config.
+// A mount replaces the project mount with the same target.
+type ProjectOverride struct {
+	Image   *string           `json:"image,omitempty"`
+	User    *string           `json:"user,omitempty"`
+	EnvVars map[string]string `json:"envVars,omitempty"`
+	Mounts  []apiclient.Mount `json:"mounts,omitempty"`
+}
+
+// OverrideFile applies its top-level fields to every project it covers and then the entry
+// in Projects matching the project name
+type OverrideFile struct {
+	ProjectOverride
+	Projects map[string]ProjectOverride `json:"projects,omitempty"`
+
+	Path string `json:"-"`
+	// RepositoryUrl limits the file to projects created from this repository. Empty for files that apply to all projects
+	RepositoryUrl string `json:"-"`
+}
+
+// GetOverrideFiles returns the override files in the order of precedence, lowest first: the file in the user
+// home directory, the file in the root of the git repository containing the working directory and the file passed
+// explicitly. Missing home and repository files are skipped.
+func GetOverrideFiles(explicitPath string) ([]*OverrideFile, error) {
+	overrides := []*OverrideFile{}
+
+	homeDir, err := os.UserHomeDir()
+	if err == nil {
+		override, err := readOverrideFile(filepath.Join(homeDir, OverrideFileName), false)
+		if err != nil {
+			return nil, err
+		}
+		if override != nil {
+			overrides = append(overrides, override)
+		}
+	}
+
+	override, err := getRepositoryOverrideFile()
+	if err != nil {
+		return nil, err
+	}
+	if override != nil {
+		overrides = append(overrides, override)
+	}
+
+	if explicitPath != "" {
+		override, err := readOverrideFile(explicitPath, true)
+		if err != nil {
+			return nil, err
+		}
+		overrides = append(overrides, override)
+	}
+
+	return overrides, nil
+}
+
+// ApplyOverrides layers the override files on top of the project and returns the paths of the files that changed it.
+// An image override replaces the build configuration of the project.
+func ApplyOverrides(project *apiclient.CreateProjectDTO, overrides []*OverrideFile) []string {
+	applied := []string{}
+
+	for _, o := range overrides {
+		if o.RepositoryUrl != "" && normalizeRepositoryUrl(o.RepositoryUrl) != normalizeRepositoryUrl(project.Source.Repository.Url) {
+			continue
+		}
+
+		changed := applyProjectOverride(project, o.ProjectOverride)
+		if projectOverride, ok := o.Projects[project.Name]; ok {
+			changed = applyProjectOverride(project, projectOverride) || changed
+		}
+
+		if changed {
+			applied = append(applied, o.Path)
+		}
+	}
+
+	return applied
+}
+
+func applyProjectOverride(project *apiclient.CreateProjectDTO, override ProjectOverride) bool {
+	changed := false
+
+	if override.Image != nil {
+		project.Image = override.Image
+		project.BuildConfig = nil
+		changed = true
+	}
+
+	if override.User != nil {
+		project.User = override.User
+		changed = true
+	}
+
+	if len(override.EnvVars) > 0 {
+		if project.EnvVars == nil {
+			project.EnvVars = map[string]string{}
+		}
+		for key, value := range override.EnvVars {
+			project.EnvVars[key] = value
+		}
+		changed = true
+	}
+
+	for _, m := range override.Mounts {
+		i := slices.IndexFunc(project.Mounts, func(existing apiclient.Mount) bool {
+			return path.Clean(existing.Target) == path.Clean(m.Target)
+		})
+		if i >= 0 {
+			project.Mounts[i] = m
+		} else {
+			project.Mounts = append(project.Mounts, m)
+		}
+		changed = true
+	}
+
+	return changed
+}
+
+func getRepositoryOverrideFile() (*OverrideFile, error) {
+	repo, err := git.PlainOpenWithOptions(".", &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		// Not inside a git repository
+		return nil, nil
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, nil
+	}
+
+	remote, err := repo.Remote(git.DefaultRemoteName)
+	if err != nil || len(remote.Config().URLs) == 0 {
+		// Without a remote the file can not be matched to a project
+		return nil, nil
+	}
+
+	override, err := readOverrideFile(filepath.Join(worktree.Filesystem.Root(), OverrideFileName), false)
+	if err != nil || override == nil {
+		return nil, err
+	}
+
+	override.RepositoryUrl = remote.Config().URLs[0]
+	return override, nil
+}
+
+func readOverrideFile(path string, required bool) (*OverrideFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !required {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var override OverrideFile
+	err = yaml.UnmarshalStrict(data, &override)
+	if err != nil {
+		return nil, fmt.Errorf("invalid override file %s: %w", path, err)
+	}
+
+	override.Path = path
+	return &override, nil
+}
+
+// normalizeRepositoryUrl makes HTTPS and SSH remote URLs of the same repository comparable
+func normalizeRepositoryUrl(url string) string {
+	url = strings.ToLower(strings.TrimSpace(url))
+	url = strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+
+	if i := strings.Index(url, "://"); i >= 0 {
+		url = url[i+3:]
+	} else if host, path, found := strings.Cut(url, ":"); found {
+		// scp-like syntax, e.g. git@github.com:daytonaio/daytona
+		url = host + "/" + path
+	}
+
+	if _, hostAndPath, found := strings.Cut(url, "@"); found {
+		url = hostAndPath
+	}
+
+	return url
+}
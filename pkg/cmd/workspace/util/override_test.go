@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyOverrides(t *testing.T) {
+	dir := t.TempDir()
+
+	homePath := filepath.Join(dir, "home.yaml")
+	require.NoError(t, os.WriteFile(homePath, []byte(`
+envVars:
+  EDITOR: vim
+  LOG_LEVEL: info
+projects:
+  api:
+    user: developer
+`), 0644))
+
+	repoPath := filepath.Join(dir, "repo.yaml")
+	require.NoError(t, os.WriteFile(repoPath, []byte(`
+image: golang:1.23
+envVars:
+  LOG_LEVEL: debug
+mounts:
+  - type: bind
+    source: /mnt/datasets
+    target: /data
+    readOnly: true
+`), 0644))
+
+	home, err := readOverrideFile(homePath, true)
+	require.NoError(t, err)
+	repo, err := readOverrideFile(repoPath, true)
+	require.NoError(t, err)
+	repo.RepositoryUrl = "git@github.com:daytonaio/api.git"
+
+	project := apiclient.CreateProjectDTO{
+		Name:        "api",
+		BuildConfig: &apiclient.BuildConfig{},
+		EnvVars:     map[string]string{"LOG_LEVEL": "warn", "PORT": "8080"},
+		Mounts: []apiclient.Mount{
+			{Type: apiclient.MountTypeVolume, Source: apiclient.PtrString("api-data"), Target: "/data"},
+			{Type: apiclient.MountTypeTmpfs, Target: "/cache"},
+		},
+		Source: apiclient.CreateProjectSourceDTO{
+			Repository: apiclient.GitRepository{Url: "https://github.com/daytonaio/api"},
+		},
+	}
+
+	applied := ApplyOverrides(&project, []*OverrideFile{home, repo})
+	require.Equal(t, []string{homePath, repoPath}, applied)
+	require.Equal(t, map[string]string{"EDITOR": "vim", "LOG_LEVEL": "debug", "PORT": "8080"}, project.EnvVars)
+	require.Equal(t, "golang:1.23", *project.Image)
+	require.Equal(t, "developer", *project.User)
+	require.Nil(t, project.BuildConfig)
+	require.Len(t, project.Mounts, 2)
+	require.Equal(t, apiclient.MountTypeBind, project.Mounts[0].Type)
+	require.Equal(t, "/mnt/datasets", *project.Mounts[0].Source)
+
+	other := apiclient.CreateProjectDTO{
+		Name: "web",
+		Source: apiclient.CreateProjectSourceDTO{
+			Repository: apiclient.GitRepository{Url: "https://github.com/daytonaio/web"},
+		},
+	}
+
+	applied = ApplyOverrides(&other, []*OverrideFile{home, repo})
+	require.Equal(t, []string{homePath}, applied)
+	require.Nil(t, other.Image)
+	require.Equal(t, map[string]string{"EDITOR": "vim", "LOG_LEVEL": "info"}, other.EnvVars)
+}
+
+func TestReadOverrideFileRejectsUnknownFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), OverrideFileName)
+	require.NoError(t, os.WriteFile(path, []byte("imag: ubuntu\n"), 0644))
+
+	_, err := readOverrideFile(path, true)
+	require.Error(t, err)
+
+	override, err := readOverrideFile(filepath.Join(t.TempDir(), OverrideFileName), false)
+	require.NoError(t, err)
+	require.Nil(t, override)
+}
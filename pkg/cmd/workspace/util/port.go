@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// ParsePort parses the value of the --port flag, e.g. name=web,port=3000,visibility=public
+func ParsePort(value string) (apiclient.ProjectPort, error) {
+	p, err := project.ParsePort(value)
+	if err != nil {
+		return apiclient.ProjectPort{}, err
+	}
+
+	return conversion.ToPortDTO(p), nil
+}
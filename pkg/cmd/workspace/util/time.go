@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParsePointInTime parses an RFC3339 time, a local "2006-01-02 15:04" time, a local "15:04" time of the current day
+// or a duration before now, e.g. 10h
+func ParsePointInTime(value string, now time.Time) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+
+	t, err = time.ParseInLocation("2006-01-02 15:04", value, now.Location())
+	if err == nil {
+		return t, nil
+	}
+
+	t, err = time.ParseInLocation("15:04", value, now.Location())
+	if err == nil {
+		return time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location()), nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q: use RFC3339, \"YYYY-MM-DD HH:MM\", \"HH:MM\" or a duration, e.g. 10h", value)
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePointInTime(t *testing.T) {
+	now := time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"2024-05-01T14:00:00Z": time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+		"2024-05-01 14:00":     time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC),
+		"08:15":                time.Date(2024, 5, 2, 8, 15, 0, 0, time.UTC),
+		"90m":                  time.Date(2024, 5, 2, 8, 0, 0, 0, time.UTC),
+	}
+
+	for value, expected := range tests {
+		actual, err := ParsePointInTime(value, now)
+		require.NoError(t, err, value)
+		require.True(t, expected.Equal(actual), value)
+	}
+
+	_, err := ParsePointInTime("yesterday", now)
+	require.Error(t, err)
+
+	_, err = ParsePointInTime("-1h", now)
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// ValidateCreateProjects runs the checks the server runs on workspace creation, so invalid projects are
+// reported before the creation is submitted
+func ValidateCreateProjects(projects []apiclient.CreateProjectDTO) error {
+	domainProjects := []*project.Project{}
+
+	for _, p := range projects {
+		if p.BuildConfig == nil || p.BuildConfig.Devcontainer == nil {
+			if p.Image != nil && *p.Image == "" {
+				return fmt.Errorf("project %s: image is required", p.Name)
+			}
+		}
+
+		domainProject := &project.Project{Name: p.Name}
+		for _, m := range p.Mounts {
+			domainProject.Mounts = append(domainProject.Mounts, conversion.ToMount(m))
+		}
+		for _, c := range p.Commands {
+			domainProject.Commands = append(domainProject.Commands, conversion.ToCommand(c))
+		}
+		for _, port := range p.Ports {
+			domainProject.Ports = append(domainProject.Ports, conversion.ToPort(port))
+		}
+
+		err := errors.Join(
+			project.ValidateMounts(domainProject.Mounts),
+			project.ValidateCommands(domainProject.Commands),
+			project.ValidatePorts(domainProject.Ports),
+		)
+		if err != nil {
+			return fmt.Errorf("project %s: %w", p.Name, err)
+		}
+
+		domainProjects = append(domainProjects, domainProject)
+	}
+
+	return project.ValidateProjectPorts(domainProjects)
+}
@@ -6,9 +6,11 @@ package util
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +21,10 @@ type ProjectConfigurationFlags struct {
 	Branches          *[]string
 	DevcontainerPath  *string
 	EnvVars           *[]string
+	Mounts            *[]string
+	Commands          *[]string
+	Ports             *[]string
+	Debug             *string
 	Manual            *bool
 	GitProviderConfig *string
 }
@@ -29,6 +35,10 @@ func AddProjectConfigurationFlags(cmd *cobra.Command, flags ProjectConfiguration
 	cmd.Flags().StringVar(flags.DevcontainerPath, "devcontainer-path", "", "Automatically assign the devcontainer builder with the path passed as the flag value")
 	cmd.Flags().Var(flags.Builder, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s)", views_util.AUTOMATIC, views_util.DEVCONTAINER, views_util.NONE))
 	cmd.Flags().StringArrayVar(flags.EnvVars, "env", []string{}, "Specify environment variables (e.g. --env 'KEY1=VALUE1' --env 'KEY2=VALUE2' ...')")
+	cmd.Flags().StringArrayVar(flags.Mounts, "mount", []string{}, "Add a mount to the project (e.g. --mount 'type=bind,source=/data,target=/data,readonly' --mount 'type=tmpfs,target=/cache,size=512m')")
+	cmd.Flags().StringArrayVar(flags.Commands, "command", []string{}, "Add a named command to the project, runnable with 'daytona cmd run' (e.g. --command 'test=go test ./...')")
+	cmd.Flags().StringArrayVar(flags.Ports, "port", []string{}, "Declare a named port of the project, listed by 'daytona ports' and injected into the other projects of the workspace as <PROJECT>_<NAME>_URL (e.g. --port 'web=3000' --port 'name=api,port=8080,protocol=http,visibility=public')")
+	cmd.Flags().StringVar(flags.Debug, "debug", "", fmt.Sprintf("Configure the project for remote debugging with a debug preset (%s), see 'daytona debug'", strings.Join(project.GetDebugPresetNames(), "/")))
 	cmd.Flags().BoolVar(flags.Manual, "manual", false, "Manually enter the Git repository")
 	cmd.Flags().StringVar(flags.GitProviderConfig, "git-provider-config", "", "Specify the Git provider configuration ID or alias")
 
@@ -44,11 +54,15 @@ func AddProjectConfigurationFlags(cmd *cobra.Command, flags ProjectConfiguration
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "devcontainer-path")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "builder")
 		cmd.MarkFlagsMutuallyExclusive("multi-project", "env")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "mount")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "command")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "port")
+		cmd.MarkFlagsMutuallyExclusive("multi-project", "debug")
 	}
 }
 
 func CheckAnyProjectConfigurationFlagSet(flags ProjectConfigurationFlags) bool {
-	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0
+	return *flags.GitProviderConfig != "" || *flags.CustomImage != "" || *flags.CustomImageUser != "" || *flags.DevcontainerPath != "" || *flags.Builder != "" || len(*flags.EnvVars) > 0 || len(*flags.Mounts) > 0 || len(*flags.Commands) > 0 || len(*flags.Ports) > 0 || *flags.Debug != ""
 }
 
 func IsProjectRunning(workspace *apiclient.WorkspaceDTO, projectName string) bool {
@@ -60,6 +74,17 @@ func IsProjectRunning(workspace *apiclient.WorkspaceDTO, projectName string) boo
 	return false
 }
 
+// GetSshHostPublicKey returns the public SSH host key of the project agent, or an empty string if the server did not
+// report one
+func GetSshHostPublicKey(workspace *apiclient.WorkspaceDTO, projectName string) string {
+	for _, project := range workspace.GetProjects() {
+		if project.GetName() == projectName {
+			return project.GetSshHostPublicKey()
+		}
+	}
+	return ""
+}
+
 func GetProjectProviderMetadata(workspace *apiclient.WorkspaceDTO, projectName string) (string, error) {
 	if workspace.Info != nil {
 		for _, project := range workspace.Info.Projects {
@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacemode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+)
+
+var artifactsCmd = &cobra.Command{
+	Use:     "artifacts",
+	Aliases: []string{"artifact"},
+	Short:   "Manage project artifacts",
+	GroupID: util.WORKSPACE_GROUP,
+}
+
+var artifactsRegisterCmd = &cobra.Command{
+	Use:   "register [PATH]...",
+	Short: "Register output paths to upload as artifacts when the project stops",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		registry, err := getArtifactRegistry()
+		if err != nil {
+			return err
+		}
+
+		paths, err := getProjectRelativePaths(args)
+		if err != nil {
+			return err
+		}
+
+		err = registry.Register(paths...)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Registered %s", strings.Join(paths, ", ")))
+		return nil
+	},
+}
+
+var artifactsUploadCmd = &cobra.Command{
+	Use:   "upload [PATH]...",
+	Short: "Upload artifacts now - uploads all registered paths if none are provided",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var paths []string
+		var err error
+
+		if len(args) > 0 {
+			paths, err = getProjectRelativePaths(args)
+		} else {
+			var registry *artifact.Registry
+			registry, err = getArtifactRegistry()
+			if err != nil {
+				return err
+			}
+			paths, err = registry.List()
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(paths) == 0 {
+			views.RenderInfoMessage("No artifact paths registered")
+			return nil
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
+		artifacts, err := apiclient_util.UploadArtifacts(context.Background(), apiClient, workspaceId, projectName, getProjectDir(), paths)
+		if err != nil {
+			return err
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Uploaded %d artifacts", len(artifacts)))
+		return nil
+	},
+}
+
+func getArtifactRegistry() (*artifact.Registry, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact.NewRegistry(configDir), nil
+}
+
+func getProjectDir() string {
+	if projectDir := os.Getenv("DAYTONA_PROJECT_DIR"); projectDir != "" {
+		return projectDir
+	}
+
+	return filepath.Join(os.Getenv("HOME"), projectName)
+}
+
+func getProjectRelativePaths(paths []string) ([]string, error) {
+	projectDir := getProjectDir()
+	result := []string{}
+
+	for _, p := range paths {
+		absPath, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+
+		relPath, err := filepath.Rel(projectDir, absPath)
+		if err != nil || strings.HasPrefix(relPath, "..") {
+			return nil, fmt.Errorf("%s is not inside the project directory %s", p, projectDir)
+		}
+
+		result = append(result, relPath)
+	}
+
+	return result, nil
+}
+
+func init() {
+	artifactsCmd.AddCommand(artifactsRegisterCmd)
+	artifactsCmd.AddCommand(artifactsUploadCmd)
+}
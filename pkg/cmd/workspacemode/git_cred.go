@@ -5,7 +5,6 @@ package workspacemode
 
 import (
 	"bufio"
-	"context"
 	"fmt"
 	"net/url"
 	"os"
@@ -24,7 +23,7 @@ var gitCredCmd = &cobra.Command{
 		if args[0] != "get" {
 			return nil
 		}
-		ctx := context.Background()
+		ctx := cmd.Context()
 		result, err := parseFromStdin()
 		host := result["host"]
 		if err != nil || host == "" {
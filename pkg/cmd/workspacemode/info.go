@@ -4,6 +4,8 @@
 package workspacemode
 
 import (
+	"context"
+
 	"github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -19,9 +21,14 @@ var infoCmd = &cobra.Command{
 	Args:    cobra.ExactArgs(0),
 	GroupID: util.WORKSPACE_GROUP,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			return err
+		}
+
 		var workspace *apiclient.WorkspaceDTO
 
-		workspace, err := apiclient_util.GetWorkspace(workspaceId, true)
+		workspace, err = apiclient_util.GetWorkspace(workspaceId, true)
 		if err != nil {
 			return err
 		}
@@ -36,7 +43,7 @@ var infoCmd = &cobra.Command{
 			return nil
 		}
 
-		info.Render(workspace, "", false)
+		info.Render(workspace, "", apiclient_util.GetProjectsDrift(context.Background(), apiClient, workspace), apiclient_util.GetProjectsUsage(context.Background(), apiClient, workspace), false)
 		return nil
 	},
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	cmd "github.com/daytonaio/daytona/pkg/cmd"
 	. "github.com/daytonaio/daytona/pkg/cmd/agent"
 
@@ -41,6 +42,7 @@ func Execute() error {
 	workspaceModeRootCmd.AddCommand(infoCmd)
 	workspaceModeRootCmd.AddCommand(portForwardCmd)
 	workspaceModeRootCmd.AddCommand(exposeCmd)
+	workspaceModeRootCmd.AddCommand(artifactsCmd)
 
 	clientId := config.GetClientId()
 	telemetryEnabled := config.TelemetryEnabled()
@@ -51,7 +53,7 @@ func Execute() error {
 		return err
 	}
 
-	err = workspaceModeRootCmd.Execute()
+	err = workspaceModeRootCmd.ExecuteContext(apiclient.InterruptContext())
 
 	endTime := time.Now()
 	if !isComplete {
@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandrun
+
+import "time"
+
+type CommandRunState string // @name CommandRunState
+
+const (
+	CommandRunStateRunning   CommandRunState = "running"
+	CommandRunStateSucceeded CommandRunState = "succeeded"
+	CommandRunStateFailed    CommandRunState = "failed"
+	// The command could not be started or the agent did not respond
+	CommandRunStateError CommandRunState = "error"
+)
+
+// CommandRun is an execution of a named project command
+type CommandRun struct {
+	Id          string          `json:"id" validate:"required"`
+	WorkspaceId string          `json:"workspaceId" validate:"required"`
+	ProjectName string          `json:"projectName" validate:"required"`
+	CommandName string          `json:"commandName" validate:"required"`
+	Command     string          `json:"command" validate:"required"`
+	State       CommandRunState `json:"state" validate:"required"`
+	// Set once the command exited
+	ExitCode *int `json:"exitCode,omitempty" validate:"optional"`
+	// End of the combined stdout and stderr of the command
+	Output     string     `json:"output" validate:"required"`
+	Truncated  bool       `json:"truncated" validate:"required"`
+	TimedOut   bool       `json:"timedOut" validate:"required"`
+	Error      string     `json:"error,omitempty" validate:"optional"`
+	StartedAt  time.Time  `json:"startedAt" validate:"required"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty" validate:"optional"`
+} // @name CommandRun
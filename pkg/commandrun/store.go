@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandrun
+
+import "errors"
+
+type Store interface {
+	// List returns the runs matching the filter, most recent first
+	List(filter *Filter) ([]*CommandRun, error)
+	Find(id string) (*CommandRun, error)
+	Save(run *CommandRun) error
+	Delete(run *CommandRun) error
+}
+
+type Filter struct {
+	WorkspaceId *string
+	ProjectName *string
+	CommandName *string
+}
+
+var (
+	ErrCommandRunNotFound = errors.New("command run not found")
+)
+
+func IsCommandRunNotFound(err error) bool {
+	return err.Error() == ErrCommandRunNotFound.Error()
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"errors"
+)
+
+// ErrorCategory groups error codes by the part of the system that caused the failure
+type ErrorCategory string
+
+const (
+	ErrorCategoryAuth     ErrorCategory = "auth"
+	ErrorCategoryProvider ErrorCategory = "provider"
+	ErrorCategoryBuild    ErrorCategory = "build"
+	ErrorCategoryNetwork  ErrorCategory = "network"
+	ErrorCategoryQuota    ErrorCategory = "quota"
+	// ErrorCategoryRequest covers invalid requests and requests for missing or conflicting resources
+	ErrorCategoryRequest  ErrorCategory = "request"
+	ErrorCategoryInternal ErrorCategory = "internal"
+)
+
+// Exit codes of the CLI by error category. Errors without a category exit with 1
+var errorCategoryExitCodes = map[ErrorCategory]int{
+	ErrorCategoryRequest:  2,
+	ErrorCategoryAuth:     3,
+	ErrorCategoryNetwork:  4,
+	ErrorCategoryQuota:    5,
+	ErrorCategoryProvider: 6,
+	ErrorCategoryBuild:    7,
+	ErrorCategoryInternal: 8,
+}
+
+// ExitCode returns the exit code of the CLI for errors of the category
+func (c ErrorCategory) ExitCode() int {
+	if code, ok := errorCategoryExitCodes[c]; ok {
+		return code
+	}
+	return 1
+}
+
+// ErrorCode is a machine-readable identifier of a failure that is stable across releases, unlike error messages
+type ErrorCode string
+
+const (
+	ErrorCodeUnauthorized     ErrorCode = "unauthorized"
+	ErrorCodeForbidden        ErrorCode = "forbidden"
+	ErrorCodeProviderNotFound ErrorCode = "provider_not_found"
+	ErrorCodeProviderFailed   ErrorCode = "provider_failed"
+	ErrorCodeBuildFailed      ErrorCode = "build_failed"
+	ErrorCodeUnavailable      ErrorCode = "unavailable"
+	ErrorCodeTimeout          ErrorCode = "timeout"
+	ErrorCodeQuotaExceeded    ErrorCode = "quota_exceeded"
+	ErrorCodeCapacityExceeded ErrorCode = "capacity_exceeded"
+	ErrorCodeRateLimited      ErrorCode = "rate_limited"
+	ErrorCodeInvalidRequest   ErrorCode = "invalid_request"
+	ErrorCodeNotFound         ErrorCode = "not_found"
+	ErrorCodeConflict         ErrorCode = "conflict"
+	// ErrorCodeOperationInProgress is returned if a workspace is busy with another operation
+	ErrorCodeOperationInProgress ErrorCode = "operation_in_progress"
+	ErrorCodeInternal            ErrorCode = "internal"
+)
+
+type errorCodeInfo struct {
+	category ErrorCategory
+	hint     string
+}
+
+var errorCodes = map[ErrorCode]errorCodeInfo{
+	ErrorCodeUnauthorized:        {ErrorCategoryAuth, "Run 'daytona profile edit' to set a valid API key for the profile"},
+	ErrorCodeForbidden:           {ErrorCategoryAuth, "Ask an admin of the organization for access"},
+	ErrorCodeProviderNotFound:    {ErrorCategoryProvider, "Run 'daytona provider install' to install the provider of the target"},
+	ErrorCodeProviderFailed:      {ErrorCategoryProvider, "Check the target options with 'daytona target list' and the server logs with 'daytona server logs'"},
+	ErrorCodeBuildFailed:         {ErrorCategoryBuild, "Check the build logs with 'daytona build logs'"},
+	ErrorCodeUnavailable:         {ErrorCategoryNetwork, "Check that the Daytona Server is running and reachable with 'daytona server selftest'"},
+	ErrorCodeTimeout:             {ErrorCategoryNetwork, "Retry the command or raise the timeout of API requests"},
+	ErrorCodeQuotaExceeded:       {ErrorCategoryQuota, "Delete unused workspaces or ask an admin to raise the quota of the organization"},
+	ErrorCodeCapacityExceeded:    {ErrorCategoryQuota, "Use another target or free up resources on the target host"},
+	ErrorCodeRateLimited:         {ErrorCategoryQuota, "Wait a moment and retry"},
+	ErrorCodeInvalidRequest:      {ErrorCategoryRequest, ""},
+	ErrorCodeNotFound:            {ErrorCategoryRequest, ""},
+	ErrorCodeConflict:            {ErrorCategoryRequest, ""},
+	ErrorCodeOperationInProgress: {ErrorCategoryRequest, "Retry once the operation is done or pass --wait to queue behind it"},
+	ErrorCodeInternal:            {ErrorCategoryInternal, "Check the server logs with 'daytona server logs'"},
+}
+
+// Category returns the category of the code. Unknown codes belong to the internal category
+func (c ErrorCode) Category() ErrorCategory {
+	if info, ok := errorCodes[c]; ok {
+		return info.category
+	}
+	return ErrorCategoryInternal
+}
+
+// Hint returns the default remediation hint of the code, if any
+func (c ErrorCode) Hint() string {
+	return errorCodes[c].hint
+}
+
+// CodedError attaches an error code, its category and a remediation hint to an error
+type CodedError struct {
+	Code     ErrorCode
+	Category ErrorCategory
+	Hint     string
+	Err      error
+}
+
+func (e *CodedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// WithErrorCode attaches the code along with its category and default hint to the error. Returns nil if err is nil
+func WithErrorCode(err error, code ErrorCode) error {
+	if err == nil {
+		return nil
+	}
+
+	return &CodedError{
+		Code:     code,
+		Category: code.Category(),
+		Hint:     code.Hint(),
+		Err:      err,
+	}
+}
+
+// GetCodedError returns the outermost coded error in the chain of err
+func GetCodedError(err error) (*CodedError, bool) {
+	var codedErr *CodedError
+	if errors.As(err, &codedErr) {
+		return codedErr, true
+	}
+	return nil, false
+}
+
+// GetExitCode returns the exit code of the CLI for the error
+func GetExitCode(err error) int {
+	if codedErr, ok := GetCodedError(err); ok {
+		return codedErr.Category.ExitCode()
+	}
+	return 1
+}
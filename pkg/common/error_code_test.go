@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithErrorCode(t *testing.T) {
+	require.NoError(t, WithErrorCode(nil, ErrorCodeQuotaExceeded))
+
+	errQuota := WithErrorCode(errors.New("organization workspace quota exceeded"), ErrorCodeQuotaExceeded)
+	err := fmt.Errorf("failed to create workspace: %w", errQuota)
+
+	require.True(t, errors.Is(err, errQuota))
+	require.Equal(t, "failed to create workspace: organization workspace quota exceeded", err.Error())
+
+	codedErr, ok := GetCodedError(err)
+	require.True(t, ok)
+	require.Equal(t, ErrorCodeQuotaExceeded, codedErr.Code)
+	require.Equal(t, ErrorCategoryQuota, codedErr.Category)
+	require.NotEmpty(t, codedErr.Hint)
+
+	require.Equal(t, 5, GetExitCode(err))
+	require.Equal(t, 1, GetExitCode(errors.New("unclassified")))
+	require.Equal(t, ErrorCategoryInternal, ErrorCode("unknown").Category())
+}
@@ -27,11 +27,18 @@ try_download() {
   local temp_file=$2
   local exit_code=0
 
+  # The API key is passed through stdin or a private file so that it does not show up in the process list
   if command -v wget > /dev/null 2>&1; then
-    wget -q "$url" -O $temp_file --header="Authorization: Bearer $DAYTONA_SERVER_API_KEY" && return 0
+    local wgetrc
+    wgetrc=$(mktemp)
+    chmod 600 "$wgetrc"
+    printf 'header = Authorization: Bearer %s\n' "$DAYTONA_SERVER_API_KEY" > "$wgetrc"
+    WGETRC="$wgetrc" wget -q "$url" -O $temp_file
     exit_code=$?
+    rm -f "$wgetrc"
+    [ $exit_code -eq 0 ] && return 0
   elif command -v curl > /dev/null 2>&1; then
-    curl -fsSL "$url" -H "Authorization: Bearer $DAYTONA_SERVER_API_KEY" -o "$temp_file" && return 0
+    printf 'Authorization: Bearer %s\n' "$DAYTONA_SERVER_API_KEY" | curl -fsSL "$url" -H @- -o "$temp_file" && return 0
     exit_code=$?
   else
     echo "error: Make sure curl or wget is available in the project container"
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package correlation
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// CORRELATION_ID_HEADER carries the ID of the CLI command or API request that caused a request.
+// Servers respond with the header so clients can show the ID in error messages.
+const CORRELATION_ID_HEADER = "X-Correlation-Id"
+
+// LOG_FIELD is the log field correlated log entries are tagged with
+const LOG_FIELD = "correlationId"
+
+type CorrelationContextKey string
+
+const CORRELATION_ID_CONTEXT_KEY CorrelationContextKey = "correlation-id"
+
+func NewId() string {
+	return uuid.NewString()
+}
+
+func WithId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, CORRELATION_ID_CONTEXT_KEY, correlationId)
+}
+
+// GetId returns the correlation ID of the request or an empty string if the context does not carry one
+func GetId(ctx context.Context) string {
+	correlationId, ok := ctx.Value(CORRELATION_ID_CONTEXT_KEY).(string)
+	if !ok {
+		return ""
+	}
+
+	return correlationId
+}
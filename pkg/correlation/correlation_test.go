@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package correlation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCorrelationId(t *testing.T) {
+	require.Empty(t, GetId(context.Background()))
+
+	ctx := WithId(context.Background(), "cli-1")
+	require.Equal(t, "cli-1", GetId(ctx))
+
+	require.NotEqual(t, NewId(), NewId())
+}
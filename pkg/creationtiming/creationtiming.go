@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtiming
+
+import (
+	"time"
+)
+
+type Phase string
+
+const (
+	// Time between the creation request and the start of provisioning, spent on validation and repository lookups
+	PhaseQueueWait Phase = "queue-wait"
+	// Time the provider takes to create the workspace
+	PhaseProvision Phase = "provision"
+	// Time the provider takes to create a project, including the image pull, clone and build phases
+	PhaseProjectCreate Phase = "project-create"
+	PhaseImagePull     Phase = "image-pull"
+	PhaseClone         Phase = "clone"
+	PhaseBuild         Phase = "build"
+	// Time between starting a project and the first state report of its agent
+	PhaseAgentBoot Phase = "agent-boot"
+	// Time spent running the onCreate and postCreate commands of the project
+	PhaseLifecycleCommands Phase = "lifecycle-commands"
+)
+
+var Phases = []Phase{
+	PhaseQueueWait,
+	PhaseProvision,
+	PhaseProjectCreate,
+	PhaseImagePull,
+	PhaseClone,
+	PhaseBuild,
+	PhaseAgentBoot,
+	PhaseLifecycleCommands,
+}
+
+// PhaseDuration is the duration of a phase as measured by a provider or an agent
+type PhaseDuration struct {
+	Phase      Phase `json:"phase" validate:"required"`
+	DurationMs int64 `json:"durationMs" validate:"required"`
+} // @name PhaseDuration
+
+type PhaseTiming struct {
+	Id          string `json:"id" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	// Empty for workspace phases
+	ProjectName string    `json:"projectName" validate:"optional"`
+	Target      string    `json:"target" validate:"required"`
+	Provider    string    `json:"provider" validate:"required"`
+	Phase       Phase     `json:"phase" validate:"required"`
+	DurationMs  int64     `json:"durationMs" validate:"required"`
+	RecordedAt  time.Time `json:"recordedAt" validate:"required"`
+} // @name PhaseTiming
+
+func (p Phase) IsValid() bool {
+	for _, phase := range Phases {
+		if p == phase {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtiming
+
+import (
+	"math"
+	"slices"
+	"sort"
+)
+
+// PhaseReport summarizes the durations of a phase on a target
+type PhaseReport struct {
+	Phase    Phase  `json:"phase" validate:"required"`
+	Target   string `json:"target" validate:"required"`
+	Provider string `json:"provider" validate:"required"`
+	Count    int    `json:"count" validate:"required"`
+	P50Ms    int64  `json:"p50Ms" validate:"required"`
+	P90Ms    int64  `json:"p90Ms" validate:"required"`
+	P99Ms    int64  `json:"p99Ms" validate:"required"`
+	MaxMs    int64  `json:"maxMs" validate:"required"`
+} // @name CreationPhaseReport
+
+// GetReport groups the timings by target and phase and computes the duration percentiles of each group.
+// Reports are ordered by provider, target and the order of the phases during creation.
+func GetReport(timings []*PhaseTiming) []PhaseReport {
+	type groupKey struct {
+		target string
+		phase  Phase
+	}
+
+	groups := map[groupKey][]int64{}
+	providers := map[string]string{}
+
+	for _, t := range timings {
+		key := groupKey{target: t.Target, phase: t.Phase}
+		groups[key] = append(groups[key], t.DurationMs)
+		providers[t.Target] = t.Provider
+	}
+
+	reports := []PhaseReport{}
+	for key, durations := range groups {
+		slices.Sort(durations)
+
+		reports = append(reports, PhaseReport{
+			Phase:    key.phase,
+			Target:   key.target,
+			Provider: providers[key.target],
+			Count:    len(durations),
+			P50Ms:    percentile(durations, 50),
+			P90Ms:    percentile(durations, 90),
+			P99Ms:    percentile(durations, 99),
+			MaxMs:    durations[len(durations)-1],
+		})
+	}
+
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Provider != reports[j].Provider {
+			return reports[i].Provider < reports[j].Provider
+		}
+		if reports[i].Target != reports[j].Target {
+			return reports[i].Target < reports[j].Target
+		}
+		return slices.Index(Phases, reports[i].Phase) < slices.Index(Phases, reports[j].Phase)
+	})
+
+	return reports
+}
+
+// percentile uses the nearest-rank method on sorted durations
+func percentile(sorted []int64, p float64) int64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
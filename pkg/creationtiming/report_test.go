@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtiming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReport(t *testing.T) {
+	timings := []*PhaseTiming{}
+	for i := 1; i <= 100; i++ {
+		timings = append(timings, &PhaseTiming{Target: "local", Provider: "docker-provider", Phase: PhaseImagePull, DurationMs: int64(i * 10)})
+	}
+	timings = append(timings,
+		&PhaseTiming{Target: "local", Provider: "docker-provider", Phase: PhaseQueueWait, DurationMs: 5},
+		&PhaseTiming{Target: "aws", Provider: "aws-provider", Phase: PhaseProvision, DurationMs: 30000},
+	)
+
+	reports := GetReport(timings)
+
+	require.Equal(t, []PhaseReport{
+		{Phase: PhaseProvision, Target: "aws", Provider: "aws-provider", Count: 1, P50Ms: 30000, P90Ms: 30000, P99Ms: 30000, MaxMs: 30000},
+		{Phase: PhaseQueueWait, Target: "local", Provider: "docker-provider", Count: 1, P50Ms: 5, P90Ms: 5, P99Ms: 5, MaxMs: 5},
+		{Phase: PhaseImagePull, Target: "local", Provider: "docker-provider", Count: 100, P50Ms: 500, P90Ms: 900, P99Ms: 990, MaxMs: 1000},
+	}, reports)
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtiming
+
+import "time"
+
+type Filter struct {
+	Target   *string
+	Provider *string
+	Phase    *Phase
+	Since    *time.Time
+}
+
+type Store interface {
+	List(filter *Filter) ([]*PhaseTiming, error)
+	Save(timing *PhaseTiming) error
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type AgentEventStore struct {
+	db *gorm.DB
+}
+
+func NewAgentEventStore(db *gorm.DB) (*AgentEventStore, error) {
+	err := db.AutoMigrate(&AgentEventDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AgentEventStore{db: db}, nil
+}
+
+func (s *AgentEventStore) List(filter *agentevent.Filter) ([]*agentevent.Event, error) {
+	eventDTOs := []AgentEventDTO{}
+	tx := processAgentEventFilters(s.db, filter).Order("occurred_at").Find(&eventDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	events := []*agentevent.Event{}
+	for _, eventDTO := range eventDTOs {
+		events = append(events, ToAgentEvent(eventDTO))
+	}
+
+	return events, nil
+}
+
+func (s *AgentEventStore) Save(event *agentevent.Event) error {
+	tx := s.db.Save(ToAgentEventDTO(event))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *AgentEventStore) Delete(filter *agentevent.Filter) error {
+	tx := processAgentEventFilters(s.db, filter).Delete(&AgentEventDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processAgentEventFilters(tx *gorm.DB, filter *agentevent.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.WorkspaceId != nil {
+		tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+	}
+	if filter.ProjectName != nil {
+		tx = tx.Where("project_name = ?", *filter.ProjectName)
+	}
+	if filter.Type != nil {
+		tx = tx.Where("type = ?", string(*filter.Type))
+	}
+	if filter.Since != nil {
+		tx = tx.Where("occurred_at >= ?", *filter.Since)
+	}
+	if filter.Before != nil {
+		tx = tx.Where("occurred_at < ?", *filter.Before)
+	}
+
+	return tx
+}
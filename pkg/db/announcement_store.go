@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type AnnouncementStore struct {
+	db *gorm.DB
+}
+
+func NewAnnouncementStore(db *gorm.DB) (*AnnouncementStore, error) {
+	err := db.AutoMigrate(&AnnouncementDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnnouncementStore{db: db}, nil
+}
+
+func (s *AnnouncementStore) List() ([]*announcement.Announcement, error) {
+	announcementDTOs := []AnnouncementDTO{}
+	tx := s.db.Order("created_at").Find(&announcementDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	announcements := []*announcement.Announcement{}
+	for _, announcementDTO := range announcementDTOs {
+		announcements = append(announcements, ToAnnouncement(announcementDTO))
+	}
+
+	return announcements, nil
+}
+
+func (s *AnnouncementStore) Find(id string) (*announcement.Announcement, error) {
+	announcementDTO := AnnouncementDTO{}
+	tx := s.db.Where("id = ?", id).First(&announcementDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, announcement.ErrAnnouncementNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToAnnouncement(announcementDTO), nil
+}
+
+func (s *AnnouncementStore) Save(a *announcement.Announcement) error {
+	tx := s.db.Save(ToAnnouncementDTO(a))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *AnnouncementStore) Delete(a *announcement.Announcement) error {
+	tx := s.db.Where("id = ?", a.Id).Delete(&AnnouncementDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return announcement.ErrAnnouncementNotFound
+	}
+
+	return nil
+}
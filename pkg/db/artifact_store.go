@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"github.com/daytonaio/daytona/pkg/artifact"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"gorm.io/gorm"
+)
+
+type ArtifactStore struct {
+	db *gorm.DB
+}
+
+func NewArtifactStore(db *gorm.DB) (*ArtifactStore, error) {
+	err := db.AutoMigrate(&ArtifactDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtifactStore{db: db}, nil
+}
+
+func (s *ArtifactStore) List(filter *artifact.Filter) ([]*artifact.Artifact, error) {
+	artifactDTOs := []ArtifactDTO{}
+	tx := processArtifactFilters(s.db, filter).Order("created_at desc").Find(&artifactDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	artifacts := []*artifact.Artifact{}
+	for _, artifactDTO := range artifactDTOs {
+		artifacts = append(artifacts, ToArtifact(artifactDTO))
+	}
+
+	return artifacts, nil
+}
+
+func (s *ArtifactStore) Find(id string) (*artifact.Artifact, error) {
+	artifactDTO := ArtifactDTO{}
+	tx := s.db.Where("id = ?", id).First(&artifactDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, artifact.ErrArtifactNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToArtifact(artifactDTO), nil
+}
+
+func (s *ArtifactStore) Save(a *artifact.Artifact) error {
+	artifactDTO := ToArtifactDTO(a)
+	tx := s.db.Save(&artifactDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *ArtifactStore) Delete(a *artifact.Artifact) error {
+	tx := s.db.Where("id = ?", a.Id).Delete(&ArtifactDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return artifact.ErrArtifactNotFound
+	}
+
+	return nil
+}
+
+func processArtifactFilters(tx *gorm.DB, filter *artifact.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+	}
+	return tx
+}
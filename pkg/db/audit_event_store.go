@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type AuditEventStore struct {
+	db *gorm.DB
+}
+
+func NewAuditEventStore(db *gorm.DB) (*AuditEventStore, error) {
+	err := db.AutoMigrate(&AuditEventDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditEventStore{db: db}, nil
+}
+
+func (s *AuditEventStore) List(filter *audit.Filter) ([]*audit.Event, error) {
+	eventDTOs := []AuditEventDTO{}
+	tx := processAuditEventFilters(s.db, filter).Order("created_at").Find(&eventDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	events := []*audit.Event{}
+	for _, eventDTO := range eventDTOs {
+		events = append(events, ToAuditEvent(eventDTO))
+	}
+
+	return events, nil
+}
+
+func (s *AuditEventStore) Save(event *audit.Event) error {
+	tx := s.db.Save(ToAuditEventDTO(event))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processAuditEventFilters(tx *gorm.DB, filter *audit.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Action != nil {
+		tx = tx.Where("action = ?", string(*filter.Action))
+	}
+	if filter.Resource != nil {
+		tx = tx.Where("resource = ?", *filter.Resource)
+	}
+	if filter.Since != nil {
+		tx = tx.Where("created_at >= ?", *filter.Since)
+	}
+
+	return tx
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type BuildSecretStore struct {
+	db *gorm.DB
+}
+
+func NewBuildSecretStore(db *gorm.DB) (*BuildSecretStore, error) {
+	err := db.AutoMigrate(&BuildSecretDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildSecretStore{db: db}, nil
+}
+
+func (s *BuildSecretStore) List(organizationId string) ([]*buildsecret.BuildSecret, error) {
+	buildSecretDTOs := []BuildSecretDTO{}
+	tx := s.db.Where("organization_id = ?", organizationId).Order("name").Find(&buildSecretDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	secrets := []*buildsecret.BuildSecret{}
+	for _, buildSecretDTO := range buildSecretDTOs {
+		secrets = append(secrets, ToBuildSecret(buildSecretDTO))
+	}
+
+	return secrets, nil
+}
+
+func (s *BuildSecretStore) Find(organizationId, name string) (*buildsecret.BuildSecret, error) {
+	buildSecretDTO := BuildSecretDTO{}
+	tx := s.db.Where("organization_id = ? AND name = ?", organizationId, name).First(&buildSecretDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, buildsecret.ErrBuildSecretNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToBuildSecret(buildSecretDTO), nil
+}
+
+func (s *BuildSecretStore) Save(secret *buildsecret.BuildSecret) error {
+	tx := s.db.Save(ToBuildSecretDTO(secret))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *BuildSecretStore) Delete(secret *buildsecret.BuildSecret) error {
+	tx := s.db.Delete(ToBuildSecretDTO(secret))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return buildsecret.ErrBuildSecretNotFound
+	}
+
+	return nil
+}
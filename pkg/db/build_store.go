@@ -134,6 +134,16 @@ func processBuildFilters(tx *gorm.DB, filter *build.Filter) *gorm.DB {
 				tx = tx.Where("env_vars = ?", string(envVarsJSON))
 			}
 		}
+		if filter.Image != nil {
+			tx = tx.Where("json_extract(container_config, '$.image') = ?", *filter.Image)
+		}
+		if filter.Architecture != nil {
+			if *filter.Architecture == "" {
+				tx = tx.Where("COALESCE(architecture, '') = ''")
+			} else {
+				tx = tx.Where("architecture = ?", *filter.Architecture)
+			}
+		}
 	}
 	return tx
 }
@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/commandrun"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type CommandRunStore struct {
+	db *gorm.DB
+}
+
+func NewCommandRunStore(db *gorm.DB) (*CommandRunStore, error) {
+	err := db.AutoMigrate(&CommandRunDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommandRunStore{db: db}, nil
+}
+
+func (s *CommandRunStore) List(filter *commandrun.Filter) ([]*commandrun.CommandRun, error) {
+	runDTOs := []CommandRunDTO{}
+	tx := processCommandRunFilters(s.db, filter).Order("started_at desc").Find(&runDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	runs := []*commandrun.CommandRun{}
+	for _, runDTO := range runDTOs {
+		runs = append(runs, ToCommandRun(runDTO))
+	}
+
+	return runs, nil
+}
+
+func (s *CommandRunStore) Find(id string) (*commandrun.CommandRun, error) {
+	runDTO := CommandRunDTO{}
+	tx := s.db.Where("id = ?", id).First(&runDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, commandrun.ErrCommandRunNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToCommandRun(runDTO), nil
+}
+
+func (s *CommandRunStore) Save(run *commandrun.CommandRun) error {
+	runDTO := ToCommandRunDTO(run)
+	tx := s.db.Save(&runDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *CommandRunStore) Delete(run *commandrun.CommandRun) error {
+	tx := s.db.Where("id = ?", run.Id).Delete(&CommandRunDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return commandrun.ErrCommandRunNotFound
+	}
+
+	return nil
+}
+
+func processCommandRunFilters(tx *gorm.DB, filter *commandrun.Filter) *gorm.DB {
+	if filter != nil {
+		if filter.WorkspaceId != nil {
+			tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+		}
+		if filter.ProjectName != nil {
+			tx = tx.Where("project_name = ?", *filter.ProjectName)
+		}
+		if filter.CommandName != nil {
+			tx = tx.Where("command_name = ?", *filter.CommandName)
+		}
+	}
+	return tx
+}
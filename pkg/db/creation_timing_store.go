@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type CreationTimingStore struct {
+	db *gorm.DB
+}
+
+func NewCreationTimingStore(db *gorm.DB) (*CreationTimingStore, error) {
+	err := db.AutoMigrate(&PhaseTimingDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreationTimingStore{db: db}, nil
+}
+
+func (s *CreationTimingStore) List(filter *creationtiming.Filter) ([]*creationtiming.PhaseTiming, error) {
+	timingDTOs := []PhaseTimingDTO{}
+	tx := processCreationTimingFilters(s.db, filter).Order("recorded_at").Find(&timingDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	timings := []*creationtiming.PhaseTiming{}
+	for _, timingDTO := range timingDTOs {
+		timings = append(timings, ToPhaseTiming(timingDTO))
+	}
+
+	return timings, nil
+}
+
+func (s *CreationTimingStore) Save(timing *creationtiming.PhaseTiming) error {
+	tx := s.db.Save(ToPhaseTimingDTO(timing))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processCreationTimingFilters(tx *gorm.DB, filter *creationtiming.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Target != nil {
+		tx = tx.Where("target = ?", *filter.Target)
+	}
+	if filter.Provider != nil {
+		tx = tx.Where("provider = ?", *filter.Provider)
+	}
+	if filter.Phase != nil {
+		tx = tx.Where("phase = ?", string(*filter.Phase))
+	}
+	if filter.Since != nil {
+		tx = tx.Where("recorded_at >= ?", *filter.Since)
+	}
+
+	return tx
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+)
+
+type AgentEventDTO struct {
+	Id          string    `gorm:"primaryKey"`
+	WorkspaceId string    `json:"workspaceId" gorm:"index"`
+	ProjectName string    `json:"projectName"`
+	Type        string    `json:"type"`
+	Message     string    `json:"message"`
+	Port        uint32    `json:"port"`
+	OccurredAt  time.Time `json:"occurredAt" gorm:"index"`
+}
+
+func ToAgentEventDTO(event *agentevent.Event) AgentEventDTO {
+	return AgentEventDTO{
+		Id:          event.Id,
+		WorkspaceId: event.WorkspaceId,
+		ProjectName: event.ProjectName,
+		Type:        string(event.Type),
+		Message:     event.Message,
+		Port:        event.Port,
+		OccurredAt:  event.OccurredAt,
+	}
+}
+
+func ToAgentEvent(eventDTO AgentEventDTO) *agentevent.Event {
+	return &agentevent.Event{
+		Id:          eventDTO.Id,
+		WorkspaceId: eventDTO.WorkspaceId,
+		ProjectName: eventDTO.ProjectName,
+		Type:        agentevent.Type(eventDTO.Type),
+		Message:     eventDTO.Message,
+		Port:        eventDTO.Port,
+		OccurredAt:  eventDTO.OccurredAt,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+)
+
+type AnnouncementDTO struct {
+	Id           string     `gorm:"primaryKey"`
+	Type         string     `json:"type"`
+	Title        string     `json:"title"`
+	Message      string     `json:"message"`
+	StartsAt     *time.Time `json:"startsAt,omitempty"`
+	EndsAt       *time.Time `json:"endsAt,omitempty"`
+	BelowVersion string     `json:"belowVersion"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	Recipient    string     `json:"recipient"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+func ToAnnouncementDTO(a *announcement.Announcement) AnnouncementDTO {
+	return AnnouncementDTO{
+		Id:           a.Id,
+		Type:         string(a.Type),
+		Title:        a.Title,
+		Message:      a.Message,
+		StartsAt:     a.StartsAt,
+		EndsAt:       a.EndsAt,
+		BelowVersion: a.BelowVersion,
+		ExpiresAt:    a.ExpiresAt,
+		Recipient:    a.Recipient,
+		CreatedAt:    a.CreatedAt,
+	}
+}
+
+func ToAnnouncement(announcementDTO AnnouncementDTO) *announcement.Announcement {
+	return &announcement.Announcement{
+		Id:           announcementDTO.Id,
+		Type:         announcement.AnnouncementType(announcementDTO.Type),
+		Title:        announcementDTO.Title,
+		Message:      announcementDTO.Message,
+		StartsAt:     announcementDTO.StartsAt,
+		EndsAt:       announcementDTO.EndsAt,
+		BelowVersion: announcementDTO.BelowVersion,
+		ExpiresAt:    announcementDTO.ExpiresAt,
+		Recipient:    announcementDTO.Recipient,
+		CreatedAt:    announcementDTO.CreatedAt,
+	}
+}
@@ -11,20 +11,36 @@ type ApiKeyDTO struct {
 	KeyHash string `gorm:"primaryKey"`
 	Type    apikey.ApiKeyType
 	Name    string `gorm:"uniqueIndex"`
+	// Unset for keys generated before admin keys existed
+	Admin *bool
 }
 
+// Name of the client key the server generates for its default profile
+const defaultClientApiKeyName = "default"
+
 func ToApiKeyDTO(apiKey apikey.ApiKey) ApiKeyDTO {
 	return ApiKeyDTO{
 		KeyHash: apiKey.KeyHash,
 		Type:    apiKey.Type,
 		Name:    apiKey.Name,
+		Admin:   &apiKey.Admin,
 	}
 }
 
 func ToApiKey(apiKeyDTO ApiKeyDTO) apikey.ApiKey {
+	admin := false
+	if apiKeyDTO.Admin != nil {
+		admin = *apiKeyDTO.Admin
+	} else {
+		// Before admin keys existed, the key of the default profile of the server was the only key not generated by
+		// another client
+		admin = apiKeyDTO.Type == apikey.ApiKeyTypeClient && apiKeyDTO.Name == defaultClientApiKeyName
+	}
+
 	return apikey.ApiKey{
 		KeyHash: apiKeyDTO.KeyHash,
 		Type:    apiKeyDTO.Type,
 		Name:    apiKeyDTO.Name,
+		Admin:   admin,
 	}
 }
@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/artifact"
+)
+
+type ArtifactDTO struct {
+	Id          string `gorm:"primaryKey"`
+	WorkspaceId string `gorm:"index"`
+	ProjectName string
+	Path        string
+	Size        int
+	CreatedAt   time.Time
+}
+
+func ToArtifactDTO(a *artifact.Artifact) ArtifactDTO {
+	return ArtifactDTO{
+		Id:          a.Id,
+		WorkspaceId: a.WorkspaceId,
+		ProjectName: a.ProjectName,
+		Path:        a.Path,
+		Size:        a.Size,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func ToArtifact(artifactDTO ArtifactDTO) *artifact.Artifact {
+	return &artifact.Artifact{
+		Id:          artifactDTO.Id,
+		WorkspaceId: artifactDTO.WorkspaceId,
+		ProjectName: artifactDTO.ProjectName,
+		Path:        artifactDTO.Path,
+		Size:        artifactDTO.Size,
+		CreatedAt:   artifactDTO.CreatedAt,
+	}
+}
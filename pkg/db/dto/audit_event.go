@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+)
+
+type AuditEventDTO struct {
+	Id             string    `gorm:"primaryKey"`
+	Actor          string    `json:"actor"`
+	Action         string    `json:"action" gorm:"index"`
+	Resource       string    `json:"resource" gorm:"index"`
+	Message        string    `json:"message"`
+	ImpersonatedBy string    `json:"impersonatedBy"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"index"`
+}
+
+func ToAuditEventDTO(event *audit.Event) AuditEventDTO {
+	return AuditEventDTO{
+		Id:             event.Id,
+		Actor:          event.Actor,
+		Action:         string(event.Action),
+		Resource:       event.Resource,
+		Message:        event.Message,
+		ImpersonatedBy: event.ImpersonatedBy,
+		CreatedAt:      event.CreatedAt,
+	}
+}
+
+func ToAuditEvent(eventDTO AuditEventDTO) *audit.Event {
+	return &audit.Event{
+		Id:             eventDTO.Id,
+		Actor:          eventDTO.Actor,
+		Action:         audit.Action(eventDTO.Action),
+		Resource:       eventDTO.Resource,
+		Message:        eventDTO.Message,
+		ImpersonatedBy: eventDTO.ImpersonatedBy,
+		CreatedAt:      eventDTO.CreatedAt,
+	}
+}
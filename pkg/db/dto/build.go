@@ -11,47 +11,65 @@ import (
 )
 
 type BuildDTO struct {
-	Id              string                          `json:"id" gorm:"primaryKey"`
-	State           string                          `json:"state"`
-	Image           *string                         `json:"image,omitempty"`
-	User            *string                         `json:"user,omitempty"`
-	ContainerConfig containerconfig.ContainerConfig `gorm:"serializer:json"`
-	BuildConfig     *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
-	Repository      RepositoryDTO                   `gorm:"serializer:json"`
-	EnvVars         map[string]string               `json:"envVars" gorm:"serializer:json"`
-	PrebuildId      string                          `json:"prebuildId"`
-	CreatedAt       time.Time                       `json:"createdAt"`
-	UpdatedAt       time.Time                       `json:"updatedAt"`
+	Id               string                          `json:"id" gorm:"primaryKey"`
+	State            string                          `json:"state"`
+	Image            *string                         `json:"image,omitempty"`
+	User             *string                         `json:"user,omitempty"`
+	ContainerConfig  containerconfig.ContainerConfig `gorm:"serializer:json"`
+	BuildConfig      *ProjectBuildDTO                `json:"build,omitempty" gorm:"serializer:json"`
+	Repository       RepositoryDTO                   `gorm:"serializer:json"`
+	EnvVars          map[string]string               `json:"envVars" gorm:"serializer:json"`
+	PrebuildId       string                          `json:"prebuildId"`
+	Architecture     *string                         `json:"architecture,omitempty"`
+	Priority         string                          `json:"priority" gorm:"default:normal"`
+	CreatedAt        time.Time                       `json:"createdAt"`
+	UpdatedAt        time.Time                       `json:"updatedAt"`
+	CorrelationId    string                          `json:"correlationId,omitempty"`
+	BuildSecrets     []string                        `json:"buildSecrets,omitempty" gorm:"serializer:json"`
+	OrganizationId   string                          `json:"organizationId,omitempty"`
+	ImagePrePulledAt *time.Time                      `json:"imagePrePulledAt,omitempty"`
 }
 
 func ToBuildDTO(build *build.Build) BuildDTO {
 	return BuildDTO{
-		Id:              build.Id,
-		State:           string(build.State),
-		Image:           build.Image,
-		User:            build.User,
-		ContainerConfig: build.ContainerConfig,
-		BuildConfig:     ToProjectBuildDTO(build.BuildConfig),
-		Repository:      ToRepositoryDTO(build.Repository),
-		EnvVars:         build.EnvVars,
-		PrebuildId:      build.PrebuildId,
-		CreatedAt:       build.CreatedAt,
-		UpdatedAt:       build.UpdatedAt,
+		Id:               build.Id,
+		State:            string(build.State),
+		Image:            build.Image,
+		User:             build.User,
+		ContainerConfig:  build.ContainerConfig,
+		BuildConfig:      ToProjectBuildDTO(build.BuildConfig),
+		Repository:       ToRepositoryDTO(build.Repository),
+		EnvVars:          build.EnvVars,
+		PrebuildId:       build.PrebuildId,
+		Architecture:     build.Architecture,
+		Priority:         string(build.Priority),
+		CreatedAt:        build.CreatedAt,
+		UpdatedAt:        build.UpdatedAt,
+		CorrelationId:    build.CorrelationId,
+		BuildSecrets:     build.BuildSecrets,
+		OrganizationId:   build.OrganizationId,
+		ImagePrePulledAt: build.ImagePrePulledAt,
 	}
 }
 
 func ToBuild(buildDTO BuildDTO) *build.Build {
 	return &build.Build{
-		Id:              buildDTO.Id,
-		State:           build.BuildState(buildDTO.State),
-		Image:           buildDTO.Image,
-		User:            buildDTO.User,
-		ContainerConfig: buildDTO.ContainerConfig,
-		BuildConfig:     ToProjectBuild(buildDTO.BuildConfig),
-		Repository:      ToRepository(buildDTO.Repository),
-		EnvVars:         buildDTO.EnvVars,
-		PrebuildId:      buildDTO.PrebuildId,
-		CreatedAt:       buildDTO.CreatedAt,
-		UpdatedAt:       buildDTO.UpdatedAt,
+		Id:               buildDTO.Id,
+		State:            build.BuildState(buildDTO.State),
+		Image:            buildDTO.Image,
+		User:             buildDTO.User,
+		ContainerConfig:  buildDTO.ContainerConfig,
+		BuildConfig:      ToProjectBuild(buildDTO.BuildConfig),
+		Repository:       ToRepository(buildDTO.Repository),
+		EnvVars:          buildDTO.EnvVars,
+		PrebuildId:       buildDTO.PrebuildId,
+		Architecture:     buildDTO.Architecture,
+		Priority:         build.BuildPriority(buildDTO.Priority),
+		CreatedAt:        buildDTO.CreatedAt,
+		UpdatedAt:        buildDTO.UpdatedAt,
+		CorrelationId:    buildDTO.CorrelationId,
+		BuildSecrets:     buildDTO.BuildSecrets,
+		OrganizationId:   buildDTO.OrganizationId,
+		ImagePrePulledAt: buildDTO.ImagePrePulledAt,
 	}
 }
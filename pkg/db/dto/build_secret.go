@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+)
+
+type BuildSecretDTO struct {
+	OrganizationId string    `gorm:"primaryKey"`
+	Name           string    `gorm:"primaryKey"`
+	Value          string    `json:"value"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+func ToBuildSecretDTO(secret *buildsecret.BuildSecret) BuildSecretDTO {
+	return BuildSecretDTO{
+		OrganizationId: secret.OrganizationId,
+		Name:           secret.Name,
+		Value:          secret.Value,
+		UpdatedAt:      secret.UpdatedAt,
+	}
+}
+
+func ToBuildSecret(dto BuildSecretDTO) *buildsecret.BuildSecret {
+	return &buildsecret.BuildSecret{
+		OrganizationId: dto.OrganizationId,
+		Name:           dto.Name,
+		Value:          dto.Value,
+		UpdatedAt:      dto.UpdatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/commandrun"
+)
+
+type CommandRunDTO struct {
+	Id          string `gorm:"primaryKey"`
+	WorkspaceId string `gorm:"index"`
+	ProjectName string
+	CommandName string
+	Command     string
+	State       string
+	ExitCode    *int
+	Output      string
+	Truncated   bool
+	TimedOut    bool
+	Error       string
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+func ToCommandRunDTO(run *commandrun.CommandRun) CommandRunDTO {
+	return CommandRunDTO{
+		Id:          run.Id,
+		WorkspaceId: run.WorkspaceId,
+		ProjectName: run.ProjectName,
+		CommandName: run.CommandName,
+		Command:     run.Command,
+		State:       string(run.State),
+		ExitCode:    run.ExitCode,
+		Output:      run.Output,
+		Truncated:   run.Truncated,
+		TimedOut:    run.TimedOut,
+		Error:       run.Error,
+		StartedAt:   run.StartedAt,
+		FinishedAt:  run.FinishedAt,
+	}
+}
+
+func ToCommandRun(runDTO CommandRunDTO) *commandrun.CommandRun {
+	return &commandrun.CommandRun{
+		Id:          runDTO.Id,
+		WorkspaceId: runDTO.WorkspaceId,
+		ProjectName: runDTO.ProjectName,
+		CommandName: runDTO.CommandName,
+		Command:     runDTO.Command,
+		State:       commandrun.CommandRunState(runDTO.State),
+		ExitCode:    runDTO.ExitCode,
+		Output:      runDTO.Output,
+		Truncated:   runDTO.Truncated,
+		TimedOut:    runDTO.TimedOut,
+		Error:       runDTO.Error,
+		StartedAt:   runDTO.StartedAt,
+		FinishedAt:  runDTO.FinishedAt,
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+)
+
+type PhaseTimingDTO struct {
+	Id          string    `gorm:"primaryKey"`
+	WorkspaceId string    `json:"workspaceId"`
+	ProjectName string    `json:"projectName"`
+	Target      string    `json:"target" gorm:"index"`
+	Provider    string    `json:"provider"`
+	Phase       string    `json:"phase"`
+	DurationMs  int64     `json:"durationMs"`
+	RecordedAt  time.Time `json:"recordedAt" gorm:"index"`
+}
+
+func ToPhaseTimingDTO(timing *creationtiming.PhaseTiming) PhaseTimingDTO {
+	return PhaseTimingDTO{
+		Id:          timing.Id,
+		WorkspaceId: timing.WorkspaceId,
+		ProjectName: timing.ProjectName,
+		Target:      timing.Target,
+		Provider:    timing.Provider,
+		Phase:       string(timing.Phase),
+		DurationMs:  timing.DurationMs,
+		RecordedAt:  timing.RecordedAt,
+	}
+}
+
+func ToPhaseTiming(timingDTO PhaseTimingDTO) *creationtiming.PhaseTiming {
+	return &creationtiming.PhaseTiming{
+		Id:          timingDTO.Id,
+		WorkspaceId: timingDTO.WorkspaceId,
+		ProjectName: timingDTO.ProjectName,
+		Target:      timingDTO.Target,
+		Provider:    timingDTO.Provider,
+		Phase:       creationtiming.Phase(timingDTO.Phase),
+		DurationMs:  timingDTO.DurationMs,
+		RecordedAt:  timingDTO.RecordedAt,
+	}
+}
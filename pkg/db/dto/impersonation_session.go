@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/impersonation"
+)
+
+type ImpersonationSessionDTO struct {
+	Id        string     `gorm:"primaryKey"`
+	Admin     string     `json:"admin" gorm:"index"`
+	User      string     `json:"user" gorm:"index"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt time.Time  `json:"expiresAt"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+}
+
+func ToImpersonationSessionDTO(session *impersonation.Session) ImpersonationSessionDTO {
+	return ImpersonationSessionDTO{
+		Id:        session.Id,
+		Admin:     session.Admin,
+		User:      session.User,
+		Reason:    session.Reason,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+		EndedAt:   session.EndedAt,
+	}
+}
+
+func ToImpersonationSession(sessionDTO ImpersonationSessionDTO) *impersonation.Session {
+	return &impersonation.Session{
+		Id:        sessionDTO.Id,
+		Admin:     sessionDTO.Admin,
+		User:      sessionDTO.User,
+		Reason:    sessionDTO.Reason,
+		CreatedAt: sessionDTO.CreatedAt,
+		ExpiresAt: sessionDTO.ExpiresAt,
+		EndedAt:   sessionDTO.EndedAt,
+	}
+}
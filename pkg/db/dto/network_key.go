@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+)
+
+type NetworkKeyDTO struct {
+	Id         string     `gorm:"primaryKey"`
+	Scope      string     `json:"scope" gorm:"index:idx_network_key_scope"`
+	ScopeName  string     `json:"scopeName" gorm:"index:idx_network_key_scope"`
+	Key        string     `json:"key"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty"`
+	Persistent bool       `json:"persistent"`
+}
+
+func ToNetworkKeyDTO(key *networkkey.NetworkKey) NetworkKeyDTO {
+	return NetworkKeyDTO{
+		Id:         key.Id,
+		Scope:      string(key.Scope),
+		ScopeName:  key.ScopeName,
+		Key:        key.Key,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		RevokedAt:  key.RevokedAt,
+		Persistent: key.Persistent,
+	}
+}
+
+func ToNetworkKey(keyDTO NetworkKeyDTO) *networkkey.NetworkKey {
+	return &networkkey.NetworkKey{
+		Id:         keyDTO.Id,
+		Scope:      networkkey.Scope(keyDTO.Scope),
+		ScopeName:  keyDTO.ScopeName,
+		Key:        keyDTO.Key,
+		CreatedAt:  keyDTO.CreatedAt,
+		ExpiresAt:  keyDTO.ExpiresAt,
+		RevokedAt:  keyDTO.RevokedAt,
+		Persistent: keyDTO.Persistent,
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/organization"
+
+type OrganizationDTO struct {
+	Id            string   `gorm:"primaryKey"`
+	Name          string   `json:"name" gorm:"unique"`
+	Members       []string `json:"members" gorm:"serializer:json"`
+	Admins        []string `json:"admins" gorm:"serializer:json"`
+	MaxWorkspaces int      `json:"maxWorkspaces"`
+}
+
+func ToOrganizationDTO(o *organization.Organization) OrganizationDTO {
+	return OrganizationDTO{
+		Id:            o.Id,
+		Name:          o.Name,
+		Members:       o.Members,
+		Admins:        o.Admins,
+		MaxWorkspaces: o.Quota.MaxWorkspaces,
+	}
+}
+
+func ToOrganization(organizationDTO OrganizationDTO) *organization.Organization {
+	admins := organizationDTO.Admins
+	// Organizations created before admins were introduced are administered by the member that created them
+	if len(admins) == 0 && len(organizationDTO.Members) > 0 {
+		admins = organizationDTO.Members[:1]
+	}
+
+	return &organization.Organization{
+		Id:      organizationDTO.Id,
+		Name:    organizationDTO.Name,
+		Members: organizationDTO.Members,
+		Admins:  admins,
+		Quota: organization.Quota{
+			MaxWorkspaces: organizationDTO.MaxWorkspaces,
+		},
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+)
+
+type PrebuildUsageDTO struct {
+	Id            string `gorm:"primaryKey"`
+	WorkspaceId   string
+	ProjectName   string
+	RepositoryUrl string `gorm:"index"`
+	Branch        string
+	Hit           bool
+	BuildId       string
+	PrebuildId    string `gorm:"index"`
+	CommitSha     string
+	TimeSaved     time.Duration
+	CreatedAt     time.Time
+}
+
+func ToPrebuildUsageDTO(usage *config.PrebuildUsage) PrebuildUsageDTO {
+	return PrebuildUsageDTO{
+		Id:            usage.Id,
+		WorkspaceId:   usage.WorkspaceId,
+		ProjectName:   usage.ProjectName,
+		RepositoryUrl: usage.RepositoryUrl,
+		Branch:        usage.Branch,
+		Hit:           usage.Hit,
+		BuildId:       usage.BuildId,
+		PrebuildId:    usage.PrebuildId,
+		CommitSha:     usage.CommitSha,
+		TimeSaved:     usage.TimeSaved,
+		CreatedAt:     usage.CreatedAt,
+	}
+}
+
+func ToPrebuildUsage(usageDTO PrebuildUsageDTO) *config.PrebuildUsage {
+	return &config.PrebuildUsage{
+		Id:            usageDTO.Id,
+		WorkspaceId:   usageDTO.WorkspaceId,
+		ProjectName:   usageDTO.ProjectName,
+		RepositoryUrl: usageDTO.RepositoryUrl,
+		Branch:        usageDTO.Branch,
+		Hit:           usageDTO.Hit,
+		BuildId:       usageDTO.BuildId,
+		PrebuildId:    usageDTO.PrebuildId,
+		CommitSha:     usageDTO.CommitSha,
+		TimeSaved:     usageDTO.TimeSaved,
+		CreatedAt:     usageDTO.CreatedAt,
+	}
+}
@@ -38,9 +38,13 @@ type GitStatusDTO struct {
 }
 
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt    string             `json:"updatedAt"`
+	Uptime       uint64             `json:"uptime"`
+	GitStatus    *GitStatusDTO      `json:"gitStatus"`
+	AgentVersion string             `json:"agentVersion,omitempty"`
+	Address      string             `json:"address,omitempty"`
+	Usage        *project.Resources `json:"usage,omitempty"`
+	Services     []project.Service  `json:"services,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
@@ -52,16 +56,30 @@ type ProjectBuildDTO struct {
 }
 
 type ProjectDTO struct {
-	Name                string           `json:"name"`
-	Image               string           `json:"image"`
-	User                string           `json:"user"`
-	Build               *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
-	Repository          RepositoryDTO    `json:"repository" gorm:"serializer:json"`
-	WorkspaceId         string           `json:"workspaceId"`
-	Target              string           `json:"target"`
-	ApiKey              string           `json:"apiKey"`
-	State               *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
-	GitProviderConfigId *string          `json:"gitProviderConfigId,omitempty"`
+	Name                string                    `json:"name"`
+	Image               string                    `json:"image"`
+	User                string                    `json:"user"`
+	Build               *ProjectBuildDTO          `json:"build,omitempty" gorm:"serializer:json"`
+	Repository          RepositoryDTO             `json:"repository" gorm:"serializer:json"`
+	WorkspaceId         string                    `json:"workspaceId"`
+	Target              string                    `json:"target"`
+	ApiKey              string                    `json:"apiKey"`
+	State               *ProjectStateDTO          `json:"state,omitempty" gorm:"serializer:json"`
+	GitProviderConfigId *string                   `json:"gitProviderConfigId,omitempty"`
+	Annotations         map[string]string         `json:"annotations,omitempty"`
+	Mounts              []project.Mount           `json:"mounts,omitempty"`
+	Commands            []project.Command         `json:"commands,omitempty"`
+	Ports               []project.Port            `json:"ports,omitempty"`
+	PublicPorts         []uint16                  `json:"publicPorts,omitempty"`
+	Networking          string                    `json:"networking,omitempty"`
+	Hostname            string                    `json:"hostname,omitempty"`
+	Route               *project.ProjectRoute     `json:"route,omitempty"`
+	AccessPolicy        *project.PortAccessPolicy `json:"accessPolicy,omitempty"`
+	Resources           *project.Resources        `json:"resources,omitempty"`
+	Architecture        string                    `json:"architecture,omitempty"`
+	Paused              bool                      `json:"paused,omitempty"`
+	SshHostKey          string                    `json:"sshHostKey,omitempty"`
+	SshHostPublicKey    string                    `json:"sshHostPublicKey,omitempty"`
 }
 
 func ToProjectDTO(project *project.Project) ProjectDTO {
@@ -76,6 +94,20 @@ func ToProjectDTO(project *project.Project) ProjectDTO {
 		State:               ToProjectStateDTO(project.State),
 		ApiKey:              project.ApiKey,
 		GitProviderConfigId: project.GitProviderConfigId,
+		Annotations:         project.Annotations,
+		Mounts:              project.Mounts,
+		Commands:            project.Commands,
+		Ports:               project.Ports,
+		PublicPorts:         project.PublicPorts,
+		Networking:          string(project.Networking),
+		Hostname:            project.Hostname,
+		Route:               project.Route,
+		AccessPolicy:        project.AccessPolicy,
+		Resources:           project.Resources,
+		Architecture:        project.Architecture,
+		Paused:              project.Paused,
+		SshHostKey:          project.SshHostKey,
+		SshHostPublicKey:    project.SshHostPublicKey,
 	}
 }
 
@@ -134,9 +166,13 @@ func ToProjectStateDTO(state *project.ProjectState) *ProjectStateDTO {
 	}
 
 	return &ProjectStateDTO{
-		UpdatedAt: state.UpdatedAt,
-		Uptime:    state.Uptime,
-		GitStatus: ToGitStatusDTO(state.GitStatus),
+		UpdatedAt:    state.UpdatedAt,
+		Uptime:       state.Uptime,
+		GitStatus:    ToGitStatusDTO(state.GitStatus),
+		AgentVersion: state.AgentVersion,
+		Address:      state.Address,
+		Usage:        state.Usage,
+		Services:     state.Services,
 	}
 }
 
@@ -168,6 +204,20 @@ func ToProject(projectDTO ProjectDTO) *project.Project {
 		State:               ToProjectState(projectDTO.State),
 		ApiKey:              projectDTO.ApiKey,
 		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Annotations:         projectDTO.Annotations,
+		Mounts:              projectDTO.Mounts,
+		Commands:            projectDTO.Commands,
+		Ports:               projectDTO.Ports,
+		PublicPorts:         projectDTO.PublicPorts,
+		Networking:          project.Networking(projectDTO.Networking),
+		Hostname:            projectDTO.Hostname,
+		Route:               projectDTO.Route,
+		AccessPolicy:        projectDTO.AccessPolicy,
+		Resources:           projectDTO.Resources,
+		Architecture:        projectDTO.Architecture,
+		Paused:              projectDTO.Paused,
+		SshHostKey:          projectDTO.SshHostKey,
+		SshHostPublicKey:    projectDTO.SshHostPublicKey,
 	}
 }
 
@@ -209,9 +259,13 @@ func ToProjectState(stateDTO *ProjectStateDTO) *project.ProjectState {
 	}
 
 	return &project.ProjectState{
-		UpdatedAt: stateDTO.UpdatedAt,
-		Uptime:    stateDTO.Uptime,
-		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		UpdatedAt:    stateDTO.UpdatedAt,
+		Uptime:       stateDTO.Uptime,
+		GitStatus:    ToGitStatus(stateDTO.GitStatus),
+		AgentVersion: stateDTO.AgentVersion,
+		Address:      stateDTO.Address,
+		Usage:        stateDTO.Usage,
+		Services:     stateDTO.Services,
 	}
 }
 
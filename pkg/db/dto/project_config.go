@@ -4,6 +4,7 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 )
 
@@ -17,14 +18,21 @@ type ProjectConfigDTO struct {
 	Prebuilds           []PrebuildDTO     `gorm:"serializer:json"`
 	IsDefault           bool              `json:"isDefault"`
 	GitProviderConfigId *string           `json:"gitProviderConfigId" validate:"optional"`
+	OrganizationId      string            `json:"organizationId"`
+	Mounts              []project.Mount   `json:"mounts,omitempty" gorm:"serializer:json"`
+	Commands            []project.Command `json:"commands,omitempty" gorm:"serializer:json"`
+	Ports               []project.Port    `json:"ports,omitempty" gorm:"serializer:json"`
+	BuildSecrets        []string          `json:"buildSecrets,omitempty" gorm:"serializer:json"`
 }
 
 type PrebuildDTO struct {
-	Id             string   `json:"id"`
-	Branch         string   `json:"branch"`
-	CommitInterval *int     `json:"commitInterval,omitempty"`
-	TriggerFiles   []string `json:"triggerFiles,omitempty"`
-	Retention      int      `json:"retention"`
+	Id             string                 `json:"id"`
+	Branch         string                 `json:"branch"`
+	CommitInterval *int                   `json:"commitInterval,omitempty"`
+	TriggerFiles   []string               `json:"triggerFiles,omitempty"`
+	Retention      int                    `json:"retention"`
+	Matrix         *config.PrebuildMatrix `json:"matrix,omitempty"`
+	BuildSecrets   []string               `json:"buildSecrets,omitempty"`
 }
 
 func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
@@ -43,6 +51,11 @@ func ToProjectConfigDTO(projectConfig *config.ProjectConfig) ProjectConfigDTO {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfig.IsDefault,
 		GitProviderConfigId: projectConfig.GitProviderConfigId,
+		OrganizationId:      projectConfig.OrganizationId,
+		Mounts:              projectConfig.Mounts,
+		Commands:            projectConfig.Commands,
+		Ports:               projectConfig.Ports,
+		BuildSecrets:        projectConfig.BuildSecrets,
 	}
 }
 
@@ -62,6 +75,11 @@ func ToProjectConfig(projectConfigDTO ProjectConfigDTO) *config.ProjectConfig {
 		Prebuilds:           prebuilds,
 		IsDefault:           projectConfigDTO.IsDefault,
 		GitProviderConfigId: projectConfigDTO.GitProviderConfigId,
+		OrganizationId:      projectConfigDTO.OrganizationId,
+		Mounts:              projectConfigDTO.Mounts,
+		Commands:            projectConfigDTO.Commands,
+		Ports:               projectConfigDTO.Ports,
+		BuildSecrets:        projectConfigDTO.BuildSecrets,
 	}
 }
 
@@ -72,6 +90,8 @@ func ToPrebuildDTO(prebuild *config.PrebuildConfig) PrebuildDTO {
 		CommitInterval: prebuild.CommitInterval,
 		TriggerFiles:   prebuild.TriggerFiles,
 		Retention:      prebuild.Retention,
+		Matrix:         prebuild.Matrix,
+		BuildSecrets:   prebuild.BuildSecrets,
 	}
 }
 
@@ -82,5 +102,7 @@ func ToPrebuild(prebuildDTO PrebuildDTO) *config.PrebuildConfig {
 		CommitInterval: prebuildDTO.CommitInterval,
 		TriggerFiles:   prebuildDTO.TriggerFiles,
 		Retention:      prebuildDTO.Retention,
+		Matrix:         prebuildDTO.Matrix,
+		BuildSecrets:   prebuildDTO.BuildSecrets,
 	}
 }
@@ -3,15 +3,20 @@
 
 package dto
 
-import "github.com/daytonaio/daytona/pkg/provider"
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
 
 type ProviderTargetDTO struct {
-	Name            string  `json:"name" gorm:"primaryKey"`
-	ProviderName    string  `json:"providerName"`
-	ProviderLabel   *string `json:"providerLabel,omitempty"`
-	ProviderVersion string  `json:"providerVersion"`
-	Options         string  `json:"options"`
-	IsDefault       bool    `json:"isDefault"`
+	Name            string                  `json:"name" gorm:"primaryKey"`
+	ProviderName    string                  `json:"providerName"`
+	ProviderLabel   *string                 `json:"providerLabel,omitempty"`
+	ProviderVersion string                  `json:"providerVersion"`
+	Options         string                  `json:"options"`
+	IsDefault       bool                    `json:"isDefault"`
+	OrganizationId  string                  `json:"organizationId"`
+	BandwidthLimit  *project.BandwidthLimit `json:"bandwidthLimit,omitempty" gorm:"serializer:json"`
 }
 
 func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTargetDTO {
@@ -22,6 +27,8 @@ func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTarget
 		ProviderVersion: providerTarget.ProviderInfo.Version,
 		Options:         providerTarget.Options,
 		IsDefault:       providerTarget.IsDefault,
+		OrganizationId:  providerTarget.OrganizationId,
+		BandwidthLimit:  providerTarget.BandwidthLimit,
 	}
 }
 
@@ -33,7 +40,9 @@ func ToProviderTarget(providerTargetDTO ProviderTargetDTO) *provider.ProviderTar
 			Label:   providerTargetDTO.ProviderLabel,
 			Version: providerTargetDTO.ProviderVersion,
 		},
-		Options:   providerTargetDTO.Options,
-		IsDefault: providerTargetDTO.IsDefault,
+		Options:        providerTargetDTO.Options,
+		IsDefault:      providerTargetDTO.IsDefault,
+		OrganizationId: providerTargetDTO.OrganizationId,
+		BandwidthLimit: providerTargetDTO.BandwidthLimit,
 	}
 }
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/region"
+)
+
+type RegionDTO struct {
+	Name          string    `gorm:"primaryKey"`
+	ApiUrl        string    `json:"apiUrl"`
+	ApiKey        string    `json:"apiKey"`
+	RegisteredBy  string    `json:"registeredBy"`
+	Targets       []string  `json:"targets" gorm:"serializer:json"`
+	DefaultTarget string    `json:"defaultTarget"`
+	LastSeenAt    time.Time `json:"lastSeenAt"`
+}
+
+func ToRegionDTO(region *region.Region) RegionDTO {
+	return RegionDTO{
+		Name:          region.Name,
+		ApiUrl:        region.ApiUrl,
+		ApiKey:        region.ApiKey,
+		RegisteredBy:  region.RegisteredBy,
+		Targets:       region.Targets,
+		DefaultTarget: region.DefaultTarget,
+		LastSeenAt:    region.LastSeenAt,
+	}
+}
+
+func ToRegion(regionDTO RegionDTO) *region.Region {
+	return &region.Region{
+		Name:          regionDTO.Name,
+		ApiUrl:        regionDTO.ApiUrl,
+		ApiKey:        regionDTO.ApiKey,
+		RegisteredBy:  regionDTO.RegisteredBy,
+		Targets:       regionDTO.Targets,
+		DefaultTarget: regionDTO.DefaultTarget,
+		LastSeenAt:    regionDTO.LastSeenAt,
+	}
+}
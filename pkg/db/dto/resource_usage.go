@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+)
+
+type ResourceUsageSampleDTO struct {
+	Id          string    `gorm:"primaryKey"`
+	WorkspaceId string    `json:"workspaceId" gorm:"index"`
+	ProjectName string    `json:"projectName"`
+	Cpus        float64   `json:"cpus"`
+	MemoryUsed  uint64    `json:"memoryUsed"`
+	MemoryLimit uint64    `json:"memoryLimit"`
+	DiskUsed    uint64    `json:"diskUsed"`
+	DiskTotal   uint64    `json:"diskTotal"`
+	InodesUsed  uint64    `json:"inodesUsed"`
+	InodesTotal uint64    `json:"inodesTotal"`
+	SampledAt   time.Time `json:"sampledAt" gorm:"index"`
+}
+
+func ToResourceUsageSampleDTO(sample *resourceusage.Sample) ResourceUsageSampleDTO {
+	return ResourceUsageSampleDTO{
+		Id:          sample.Id,
+		WorkspaceId: sample.WorkspaceId,
+		ProjectName: sample.ProjectName,
+		Cpus:        sample.Cpus,
+		MemoryUsed:  sample.MemoryUsed,
+		MemoryLimit: sample.MemoryLimit,
+		DiskUsed:    sample.DiskUsed,
+		DiskTotal:   sample.DiskTotal,
+		InodesUsed:  sample.InodesUsed,
+		InodesTotal: sample.InodesTotal,
+		SampledAt:   sample.SampledAt,
+	}
+}
+
+func ToResourceUsageSample(sampleDTO ResourceUsageSampleDTO) *resourceusage.Sample {
+	return &resourceusage.Sample{
+		Id:          sampleDTO.Id,
+		WorkspaceId: sampleDTO.WorkspaceId,
+		ProjectName: sampleDTO.ProjectName,
+		Cpus:        sampleDTO.Cpus,
+		MemoryUsed:  sampleDTO.MemoryUsed,
+		MemoryLimit: sampleDTO.MemoryLimit,
+		DiskUsed:    sampleDTO.DiskUsed,
+		DiskTotal:   sampleDTO.DiskTotal,
+		InodesUsed:  sampleDTO.InodesUsed,
+		InodesTotal: sampleDTO.InodesTotal,
+		SampledAt:   sampleDTO.SampledAt,
+	}
+}
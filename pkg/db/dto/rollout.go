@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/rollout"
+)
+
+type RolloutDTO struct {
+	Id              string    `gorm:"primaryKey"`
+	AgentVersion    string    `json:"agentVersion"`
+	PreviousVersion string    `json:"previousVersion"`
+	ServerVersion   string    `json:"serverVersion"`
+	Percentage      int       `json:"percentage"`
+	WorkspaceIds    []string  `json:"workspaceIds" gorm:"serializer:json"`
+	MaxErrorRate    float64   `json:"maxErrorRate"`
+	State           string    `json:"state"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func ToRolloutDTO(r *rollout.Rollout) RolloutDTO {
+	return RolloutDTO{
+		Id:              r.Id,
+		AgentVersion:    r.AgentVersion,
+		PreviousVersion: r.PreviousVersion,
+		ServerVersion:   r.ServerVersion,
+		Percentage:      r.Percentage,
+		WorkspaceIds:    r.WorkspaceIds,
+		MaxErrorRate:    r.MaxErrorRate,
+		State:           string(r.State),
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+}
+
+func ToRollout(rolloutDTO RolloutDTO) *rollout.Rollout {
+	return &rollout.Rollout{
+		Id:              rolloutDTO.Id,
+		AgentVersion:    rolloutDTO.AgentVersion,
+		PreviousVersion: rolloutDTO.PreviousVersion,
+		ServerVersion:   rolloutDTO.ServerVersion,
+		Percentage:      rolloutDTO.Percentage,
+		WorkspaceIds:    rolloutDTO.WorkspaceIds,
+		MaxErrorRate:    rolloutDTO.MaxErrorRate,
+		State:           rollout.RolloutState(rolloutDTO.State),
+		CreatedAt:       rolloutDTO.CreatedAt,
+		UpdatedAt:       rolloutDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+)
+
+type SharedServiceDTO struct {
+	Name              string            `gorm:"primaryKey"`
+	Target            string            `gorm:"index"`
+	Image             string            `json:"image"`
+	Port              uint16            `json:"port"`
+	EnvVars           map[string]string `json:"envVars" gorm:"serializer:json"`
+	ConnectionEnvVars map[string]string `json:"connectionEnvVars" gorm:"serializer:json"`
+	DataPath          string            `json:"dataPath"`
+	CreatedAt         time.Time         `json:"createdAt"`
+}
+
+func ToSharedServiceDTO(service *sharedservice.SharedService) SharedServiceDTO {
+	return SharedServiceDTO{
+		Name:              service.Name,
+		Target:            service.Target,
+		Image:             service.Image,
+		Port:              service.Port,
+		EnvVars:           service.EnvVars,
+		ConnectionEnvVars: service.ConnectionEnvVars,
+		DataPath:          service.DataPath,
+		CreatedAt:         service.CreatedAt,
+	}
+}
+
+func ToSharedService(serviceDTO SharedServiceDTO) *sharedservice.SharedService {
+	return &sharedservice.SharedService{
+		Name:              serviceDTO.Name,
+		Target:            serviceDTO.Target,
+		Image:             serviceDTO.Image,
+		Port:              serviceDTO.Port,
+		EnvVars:           serviceDTO.EnvVars,
+		ConnectionEnvVars: serviceDTO.ConnectionEnvVars,
+		DataPath:          serviceDTO.DataPath,
+		CreatedAt:         serviceDTO.CreatedAt,
+	}
+}
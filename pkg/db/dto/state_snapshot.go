@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/statehistory"
+)
+
+type StateSnapshotDTO struct {
+	Id             string    `gorm:"primaryKey"`
+	WorkspaceId    string    `json:"workspaceId" gorm:"index"`
+	WorkspaceName  string    `json:"workspaceName" gorm:"index"`
+	ProjectName    string    `json:"projectName"`
+	Target         string    `json:"target"`
+	Running        bool      `json:"running"`
+	Uptime         uint64    `json:"uptime"`
+	StateUpdatedAt string    `json:"stateUpdatedAt"`
+	AgentVersion   string    `json:"agentVersion"`
+	LastActivityAt string    `json:"lastActivityAt"`
+	GitBranch      string    `json:"gitBranch"`
+	RecordedAt     time.Time `json:"recordedAt" gorm:"index"`
+}
+
+func ToStateSnapshotDTO(snapshot *statehistory.Snapshot) StateSnapshotDTO {
+	return StateSnapshotDTO{
+		Id:             snapshot.Id,
+		WorkspaceId:    snapshot.WorkspaceId,
+		WorkspaceName:  snapshot.WorkspaceName,
+		ProjectName:    snapshot.ProjectName,
+		Target:         snapshot.Target,
+		Running:        snapshot.Running,
+		Uptime:         snapshot.Uptime,
+		StateUpdatedAt: snapshot.StateUpdatedAt,
+		AgentVersion:   snapshot.AgentVersion,
+		LastActivityAt: snapshot.LastActivityAt,
+		GitBranch:      snapshot.GitBranch,
+		RecordedAt:     snapshot.RecordedAt,
+	}
+}
+
+func ToStateSnapshot(snapshotDTO StateSnapshotDTO) *statehistory.Snapshot {
+	return &statehistory.Snapshot{
+		Id:             snapshotDTO.Id,
+		WorkspaceId:    snapshotDTO.WorkspaceId,
+		WorkspaceName:  snapshotDTO.WorkspaceName,
+		ProjectName:    snapshotDTO.ProjectName,
+		Target:         snapshotDTO.Target,
+		Running:        snapshotDTO.Running,
+		Uptime:         snapshotDTO.Uptime,
+		StateUpdatedAt: snapshotDTO.StateUpdatedAt,
+		AgentVersion:   snapshotDTO.AgentVersion,
+		LastActivityAt: snapshotDTO.LastActivityAt,
+		GitBranch:      snapshotDTO.GitBranch,
+		RecordedAt:     snapshotDTO.RecordedAt,
+	}
+}
@@ -5,16 +5,25 @@ package dto
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id             string                  `gorm:"primaryKey"`
+	Name           string                  `json:"name" gorm:"unique"`
+	Target         string                  `json:"target"`
+	OrganizationId string                  `json:"organizationId"`
+	ApiKey         string                  `json:"apiKey"`
+	Projects       []ProjectDTO            `gorm:"serializer:json"`
+	Annotations    map[string]string       `json:"annotations" gorm:"serializer:json"`
+	ExpiresAt      *time.Time              `json:"expiresAt"`
+	CreatedAt      *time.Time              `json:"createdAt"`
+	Adoption       *workspace.Adoption     `json:"adoption,omitempty" gorm:"serializer:json"`
+	SharedServices []string                `json:"sharedServices,omitempty" gorm:"serializer:json"`
+	BandwidthLimit *project.BandwidthLimit `json:"bandwidthLimit,omitempty" gorm:"serializer:json"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +38,17 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:             workspace.Id,
+		Name:           workspace.Name,
+		Target:         workspace.Target,
+		OrganizationId: workspace.OrganizationId,
+		ApiKey:         workspace.ApiKey,
+		Annotations:    workspace.Annotations,
+		ExpiresAt:      workspace.ExpiresAt,
+		CreatedAt:      workspace.CreatedAt,
+		Adoption:       workspace.Adoption,
+		SharedServices: workspace.SharedServices,
+		BandwidthLimit: workspace.BandwidthLimit,
 	}
 
 	for _, project := range workspace.Projects {
@@ -44,10 +60,17 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:             workspaceDTO.Id,
+		Name:           workspaceDTO.Name,
+		Target:         workspaceDTO.Target,
+		OrganizationId: workspaceDTO.OrganizationId,
+		ApiKey:         workspaceDTO.ApiKey,
+		Annotations:    workspaceDTO.Annotations,
+		ExpiresAt:      workspaceDTO.ExpiresAt,
+		CreatedAt:      workspaceDTO.CreatedAt,
+		Adoption:       workspaceDTO.Adoption,
+		SharedServices: workspaceDTO.SharedServices,
+		BandwidthLimit: workspaceDTO.BandwidthLimit,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
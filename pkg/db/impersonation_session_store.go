@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/impersonation"
+)
+
+type ImpersonationSessionStore struct {
+	db *gorm.DB
+}
+
+func NewImpersonationSessionStore(db *gorm.DB) (*ImpersonationSessionStore, error) {
+	err := db.AutoMigrate(&ImpersonationSessionDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImpersonationSessionStore{db: db}, nil
+}
+
+func (s *ImpersonationSessionStore) List(filter *impersonation.Filter) ([]*impersonation.Session, error) {
+	sessionDTOs := []ImpersonationSessionDTO{}
+	tx := processImpersonationSessionFilters(s.db, filter).Order("created_at").Find(&sessionDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	sessions := []*impersonation.Session{}
+	for _, sessionDTO := range sessionDTOs {
+		sessions = append(sessions, ToImpersonationSession(sessionDTO))
+	}
+
+	return sessions, nil
+}
+
+func (s *ImpersonationSessionStore) Find(id string) (*impersonation.Session, error) {
+	sessionDTO := ImpersonationSessionDTO{}
+	tx := s.db.Where("id = ?", id).First(&sessionDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, impersonation.ErrSessionNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToImpersonationSession(sessionDTO), nil
+}
+
+func (s *ImpersonationSessionStore) Save(session *impersonation.Session) error {
+	tx := s.db.Save(ToImpersonationSessionDTO(session))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processImpersonationSessionFilters(tx *gorm.DB, filter *impersonation.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Admin != nil {
+		tx = tx.Where("admin = ?", *filter.Admin)
+	}
+	if filter.User != nil {
+		tx = tx.Where(`"user" = ?`, *filter.User)
+	}
+
+	return tx
+}
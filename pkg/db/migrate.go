@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+// Migrate updates the tables of all stores to the schema of this version. Stores also migrate their table when they
+// are created, Migrate lets an upgrade apply the schema before the server starts
+func Migrate(db *gorm.DB) error {
+	return db.AutoMigrate(
+		&AgentEventDTO{},
+		&AnnouncementDTO{},
+		&ApiKeyDTO{},
+		&ArtifactDTO{},
+		&AuditEventDTO{},
+		&BuildDTO{},
+		&BuildSecretDTO{},
+		&CommandRunDTO{},
+		&ContainerRegistryDTO{},
+		&GitProviderConfigDTO{},
+		&ImpersonationSessionDTO{},
+		&NetworkKeyDTO{},
+		&OrganizationDTO{},
+		&PhaseTimingDTO{},
+		&PrebuildUsageDTO{},
+		&ProfileDataDTO{},
+		&ProjectConfigDTO{},
+		&ProviderTargetDTO{},
+		&RegionDTO{},
+		&ResourceUsageSampleDTO{},
+		&RolloutDTO{},
+		&SharedServiceDTO{},
+		&StateSnapshotDTO{},
+		&WorkspaceDTO{},
+	)
+}
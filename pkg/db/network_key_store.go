@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/networkkey"
+)
+
+type NetworkKeyStore struct {
+	db *gorm.DB
+}
+
+func NewNetworkKeyStore(db *gorm.DB) (*NetworkKeyStore, error) {
+	err := db.AutoMigrate(&NetworkKeyDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkKeyStore{db: db}, nil
+}
+
+func (s *NetworkKeyStore) List(filter *networkkey.Filter) ([]*networkkey.NetworkKey, error) {
+	keyDTOs := []NetworkKeyDTO{}
+	tx := processNetworkKeyFilters(s.db, filter).Order("created_at").Find(&keyDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	keys := []*networkkey.NetworkKey{}
+	for _, keyDTO := range keyDTOs {
+		keys = append(keys, ToNetworkKey(keyDTO))
+	}
+
+	return keys, nil
+}
+
+func (s *NetworkKeyStore) Find(id string) (*networkkey.NetworkKey, error) {
+	keyDTO := NetworkKeyDTO{}
+	tx := s.db.Where("id = ?", id).First(&keyDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, networkkey.ErrNetworkKeyNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToNetworkKey(keyDTO), nil
+}
+
+func (s *NetworkKeyStore) Save(key *networkkey.NetworkKey) error {
+	tx := s.db.Save(ToNetworkKeyDTO(key))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *NetworkKeyStore) Delete(key *networkkey.NetworkKey) error {
+	tx := s.db.Where("id = ?", key.Id).Delete(&NetworkKeyDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return networkkey.ErrNetworkKeyNotFound
+	}
+
+	return nil
+}
+
+func processNetworkKeyFilters(tx *gorm.DB, filter *networkkey.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Scope != nil {
+		tx = tx.Where("scope = ?", string(*filter.Scope))
+	}
+	if filter.ScopeName != nil {
+		tx = tx.Where("scope_name = ?", *filter.ScopeName)
+	}
+	if filter.Revoked != nil {
+		if *filter.Revoked {
+			tx = tx.Where("revoked_at IS NOT NULL")
+		} else {
+			tx = tx.Where("revoked_at IS NULL")
+		}
+	}
+
+	return tx
+}
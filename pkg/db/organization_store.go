@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/organization"
+)
+
+type OrganizationStore struct {
+	db *gorm.DB
+}
+
+func NewOrganizationStore(db *gorm.DB) (*OrganizationStore, error) {
+	err := db.AutoMigrate(&OrganizationDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrganizationStore{db: db}, nil
+}
+
+func (s *OrganizationStore) List() ([]*organization.Organization, error) {
+	organizationDTOs := []OrganizationDTO{}
+	tx := s.db.Find(&organizationDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	organizations := []*organization.Organization{}
+	for _, organizationDTO := range organizationDTOs {
+		organizations = append(organizations, ToOrganization(organizationDTO))
+	}
+
+	return organizations, nil
+}
+
+func (s *OrganizationStore) Find(idOrName string) (*organization.Organization, error) {
+	organizationDTO := OrganizationDTO{}
+	tx := s.db.Where("id = ? OR name = ?", idOrName, idOrName).First(&organizationDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, organization.ErrOrganizationNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToOrganization(organizationDTO), nil
+}
+
+func (s *OrganizationStore) Save(o *organization.Organization) error {
+	tx := s.db.Save(ToOrganizationDTO(o))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *OrganizationStore) Delete(o *organization.Organization) error {
+	tx := s.db.Delete(ToOrganizationDTO(o))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return organization.ErrOrganizationNotFound
+	}
+
+	return nil
+}
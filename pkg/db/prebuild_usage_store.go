@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"gorm.io/gorm"
+)
+
+type PrebuildUsageStore struct {
+	db *gorm.DB
+}
+
+func NewPrebuildUsageStore(db *gorm.DB) (*PrebuildUsageStore, error) {
+	err := db.AutoMigrate(&PrebuildUsageDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrebuildUsageStore{db: db}, nil
+}
+
+func (s *PrebuildUsageStore) List(filter *config.PrebuildUsageFilter) ([]*config.PrebuildUsage, error) {
+	usageDTOs := []PrebuildUsageDTO{}
+	tx := processPrebuildUsageFilters(s.db, filter).Order("created_at desc").Find(&usageDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	usages := []*config.PrebuildUsage{}
+	for _, usageDTO := range usageDTOs {
+		usages = append(usages, ToPrebuildUsage(usageDTO))
+	}
+
+	return usages, nil
+}
+
+func (s *PrebuildUsageStore) Save(usage *config.PrebuildUsage) error {
+	usageDTO := ToPrebuildUsageDTO(usage)
+	tx := s.db.Save(&usageDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processPrebuildUsageFilters(tx *gorm.DB, filter *config.PrebuildUsageFilter) *gorm.DB {
+	if filter != nil {
+		if filter.RepositoryUrl != nil {
+			tx = tx.Where("repository_url = ?", *filter.RepositoryUrl)
+		}
+		if filter.Branch != nil {
+			tx = tx.Where("branch = ?", *filter.Branch)
+		}
+		if filter.PrebuildId != nil {
+			tx = tx.Where("prebuild_id = ?", *filter.PrebuildId)
+		}
+	}
+	return tx
+}
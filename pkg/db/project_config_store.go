@@ -88,6 +88,9 @@ func processProjectConfigFilters(tx *gorm.DB, filter *config.ProjectConfigFilter
 		if filter.GitProviderConfigId != nil {
 			tx = tx.Where("git_provider_config_id = ?", *filter.GitProviderConfigId)
 		}
+		if filter.OrganizationId != nil {
+			tx = tx.Where("organization_id = ?", *filter.OrganizationId)
+		}
 	}
 
 	return tx
@@ -82,6 +82,9 @@ func processTargetFilters(tx *gorm.DB, filter *provider.TargetFilter) *gorm.DB {
 		if filter.Default != nil {
 			tx = tx.Where("is_default = ?", *filter.Default)
 		}
+		if filter.OrganizationId != nil {
+			tx = tx.Where("organization_id = ?", *filter.OrganizationId)
+		}
 	}
 
 	return tx
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/region"
+)
+
+type RegionStore struct {
+	db *gorm.DB
+}
+
+func NewRegionStore(db *gorm.DB) (*RegionStore, error) {
+	err := db.AutoMigrate(&RegionDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegionStore{db: db}, nil
+}
+
+func (s *RegionStore) List() ([]*region.Region, error) {
+	regionDTOs := []RegionDTO{}
+	tx := s.db.Order("name").Find(&regionDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	regions := []*region.Region{}
+	for _, regionDTO := range regionDTOs {
+		regions = append(regions, ToRegion(regionDTO))
+	}
+
+	return regions, nil
+}
+
+func (s *RegionStore) Find(name string) (*region.Region, error) {
+	regionDTO := RegionDTO{}
+	tx := s.db.Where("name = ?", name).First(&regionDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, region.ErrRegionNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToRegion(regionDTO), nil
+}
+
+func (s *RegionStore) Save(region *region.Region) error {
+	tx := s.db.Save(ToRegionDTO(region))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *RegionStore) Delete(r *region.Region) error {
+	tx := s.db.Where("name = ?", r.Name).Delete(&RegionDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return region.ErrRegionNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+)
+
+type ResourceUsageStore struct {
+	db *gorm.DB
+}
+
+func NewResourceUsageStore(db *gorm.DB) (*ResourceUsageStore, error) {
+	err := db.AutoMigrate(&ResourceUsageSampleDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceUsageStore{db: db}, nil
+}
+
+func (s *ResourceUsageStore) List(filter *resourceusage.Filter) ([]*resourceusage.Sample, error) {
+	sampleDTOs := []ResourceUsageSampleDTO{}
+	tx := processResourceUsageFilters(s.db, filter).Order("sampled_at").Find(&sampleDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	samples := []*resourceusage.Sample{}
+	for _, sampleDTO := range sampleDTOs {
+		samples = append(samples, ToResourceUsageSample(sampleDTO))
+	}
+
+	return samples, nil
+}
+
+func (s *ResourceUsageStore) Save(sample *resourceusage.Sample) error {
+	tx := s.db.Save(ToResourceUsageSampleDTO(sample))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *ResourceUsageStore) Delete(filter *resourceusage.Filter) error {
+	tx := processResourceUsageFilters(s.db, filter).Delete(&ResourceUsageSampleDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processResourceUsageFilters(tx *gorm.DB, filter *resourceusage.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.WorkspaceId != nil {
+		tx = tx.Where("workspace_id = ?", *filter.WorkspaceId)
+	}
+	if filter.ProjectName != nil {
+		tx = tx.Where("project_name = ?", *filter.ProjectName)
+	}
+	if filter.Since != nil {
+		tx = tx.Where("sampled_at >= ?", *filter.Since)
+	}
+	if filter.Before != nil {
+		tx = tx.Where("sampled_at < ?", *filter.Before)
+	}
+
+	return tx
+}
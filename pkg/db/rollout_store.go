@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/rollout"
+)
+
+type RolloutStore struct {
+	db *gorm.DB
+}
+
+func NewRolloutStore(db *gorm.DB) (*RolloutStore, error) {
+	err := db.AutoMigrate(&RolloutDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RolloutStore{db: db}, nil
+}
+
+func (s *RolloutStore) List() ([]*rollout.Rollout, error) {
+	rolloutDTOs := []RolloutDTO{}
+	tx := s.db.Order("created_at").Find(&rolloutDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	rollouts := []*rollout.Rollout{}
+	for _, rolloutDTO := range rolloutDTOs {
+		rollouts = append(rollouts, ToRollout(rolloutDTO))
+	}
+
+	return rollouts, nil
+}
+
+func (s *RolloutStore) Find(id string) (*rollout.Rollout, error) {
+	rolloutDTO := RolloutDTO{}
+	tx := s.db.Where("id = ?", id).First(&rolloutDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, rollout.ErrRolloutNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToRollout(rolloutDTO), nil
+}
+
+func (s *RolloutStore) Save(r *rollout.Rollout) error {
+	tx := s.db.Save(ToRolloutDTO(r))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+)
+
+type SharedServiceStore struct {
+	db *gorm.DB
+}
+
+func NewSharedServiceStore(db *gorm.DB) (*SharedServiceStore, error) {
+	err := db.AutoMigrate(&SharedServiceDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedServiceStore{db: db}, nil
+}
+
+func (s *SharedServiceStore) List(filter *sharedservice.Filter) ([]*sharedservice.SharedService, error) {
+	serviceDTOs := []SharedServiceDTO{}
+	tx := processSharedServiceFilters(s.db, filter).Order("name").Find(&serviceDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	services := []*sharedservice.SharedService{}
+	for _, serviceDTO := range serviceDTOs {
+		services = append(services, ToSharedService(serviceDTO))
+	}
+
+	return services, nil
+}
+
+func (s *SharedServiceStore) Find(name string) (*sharedservice.SharedService, error) {
+	serviceDTO := SharedServiceDTO{}
+	tx := s.db.Where("name = ?", name).First(&serviceDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, sharedservice.ErrSharedServiceNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSharedService(serviceDTO), nil
+}
+
+func (s *SharedServiceStore) Save(service *sharedservice.SharedService) error {
+	tx := s.db.Save(ToSharedServiceDTO(service))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SharedServiceStore) Delete(service *sharedservice.SharedService) error {
+	tx := s.db.Where("name = ?", service.Name).Delete(&SharedServiceDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return sharedservice.ErrSharedServiceNotFound
+	}
+
+	return nil
+}
+
+func processSharedServiceFilters(tx *gorm.DB, filter *sharedservice.Filter) *gorm.DB {
+	if filter != nil && filter.Target != nil {
+		tx = tx.Where("target = ?", *filter.Target)
+	}
+	return tx
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/statehistory"
+)
+
+type StateSnapshotStore struct {
+	db *gorm.DB
+}
+
+func NewStateSnapshotStore(db *gorm.DB) (*StateSnapshotStore, error) {
+	err := db.AutoMigrate(&StateSnapshotDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StateSnapshotStore{db: db}, nil
+}
+
+func (s *StateSnapshotStore) List(filter *statehistory.Filter) ([]*statehistory.Snapshot, error) {
+	snapshotDTOs := []StateSnapshotDTO{}
+	tx := processStateSnapshotFilters(s.db, filter).Order("recorded_at").Find(&snapshotDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	snapshots := []*statehistory.Snapshot{}
+	for _, snapshotDTO := range snapshotDTOs {
+		snapshots = append(snapshots, ToStateSnapshot(snapshotDTO))
+	}
+
+	return snapshots, nil
+}
+
+func (s *StateSnapshotStore) Save(snapshot *statehistory.Snapshot) error {
+	tx := s.db.Save(ToStateSnapshotDTO(snapshot))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *StateSnapshotStore) DeleteBefore(before time.Time) error {
+	tx := s.db.Where("recorded_at < ?", before).Delete(&StateSnapshotDTO{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func processStateSnapshotFilters(tx *gorm.DB, filter *statehistory.Filter) *gorm.DB {
+	if filter == nil {
+		return tx
+	}
+
+	if filter.Workspace != nil {
+		tx = tx.Where("(workspace_id = ? OR workspace_name = ?)", *filter.Workspace, *filter.Workspace)
+	}
+	if filter.Since != nil {
+		tx = tx.Where("recorded_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		tx = tx.Where("recorded_at <= ?", *filter.Until)
+	}
+
+	return tx
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package deeplink
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const Scheme = "daytona"
+
+// OpenRoutePath is the server route that redirects browsers to the daytona:// protocol handler
+const OpenRoutePath = "/open"
+
+type Link struct {
+	Workspace string
+	Project   string
+	Ide       string
+}
+
+func (l Link) query() url.Values {
+	query := url.Values{}
+	query.Set("workspace", l.Workspace)
+	if l.Project != "" {
+		query.Set("project", l.Project)
+	}
+	if l.Ide != "" {
+		query.Set("ide", l.Ide)
+	}
+	return query
+}
+
+// String returns the daytona://open link handled by the registered protocol handler
+func (l Link) String() string {
+	u := url.URL{
+		Scheme:   Scheme,
+		Host:     "open",
+		RawQuery: l.query().Encode(),
+	}
+
+	return u.String()
+}
+
+// HttpUrl returns a link to the server open route that can be shared in places where
+// custom schemes are not clickable, e.g. PR comments or chat messages
+func (l Link) HttpUrl(serverApiUrl string) string {
+	return fmt.Sprintf("%s%s?%s", strings.TrimSuffix(serverApiUrl, "/"), OpenRoutePath, l.query().Encode())
+}
+
+func Parse(rawUrl string) (*Link, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != Scheme {
+		return nil, fmt.Errorf("invalid link scheme %s", u.Scheme)
+	}
+
+	if u.Host != "open" {
+		return nil, fmt.Errorf("unsupported link action %s", u.Host)
+	}
+
+	return FromQuery(u.Query())
+}
+
+func FromQuery(query url.Values) (*Link, error) {
+	link := &Link{
+		Workspace: query.Get("workspace"),
+		Project:   query.Get("project"),
+		Ide:       query.Get("ide"),
+	}
+
+	if link.Workspace == "" {
+		return nil, errors.New("link is missing the workspace")
+	}
+
+	return link, nil
+}
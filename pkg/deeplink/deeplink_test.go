@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package deeplink_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/deeplink"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLink(t *testing.T) {
+	link := deeplink.Link{
+		Workspace: "my-workspace",
+		Project:   "api",
+		Ide:       "vscode",
+	}
+
+	require.Equal(t, "daytona://open?ide=vscode&project=api&workspace=my-workspace", link.String())
+	require.Equal(t, "https://api-1.daytona.io/open?ide=vscode&project=api&workspace=my-workspace", link.HttpUrl("https://api-1.daytona.io/"))
+
+	parsed, err := deeplink.Parse(link.String())
+	require.Nil(t, err)
+	require.Equal(t, link, *parsed)
+}
+
+func TestParseInvalidLink(t *testing.T) {
+	_, err := deeplink.Parse("https://open?workspace=my-workspace")
+	require.NotNil(t, err)
+
+	_, err = deeplink.Parse("daytona://open?project=api")
+	require.NotNil(t, err)
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package deeplink
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+const desktopFileName = "daytona-url-handler.desktop"
+const macAppName = "DaytonaURLHandler.app"
+
+// RegisterProtocolHandler registers the daytona:// scheme with the OS so that links
+// are handled by `<executable> url-handler open <link>`
+func RegisterProtocolHandler(executable string) error {
+	switch runtime.GOOS {
+	case "linux":
+		return registerLinux(executable)
+	case "darwin":
+		return registerDarwin(executable)
+	case "windows":
+		return registerWindows(executable)
+	}
+
+	return fmt.Errorf("protocol handler registration is not supported on %s", runtime.GOOS)
+}
+
+func UnregisterProtocolHandler() error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	switch runtime.GOOS {
+	case "linux":
+		return removeIfExists(filepath.Join(homeDir, ".local", "share", "applications", desktopFileName))
+	case "darwin":
+		return removeIfExists(filepath.Join(homeDir, "Applications", macAppName))
+	case "windows":
+		return exec.Command("reg", "delete", `HKCU\Software\Classes\`+Scheme, "/f").Run()
+	}
+
+	return fmt.Errorf("protocol handler registration is not supported on %s", runtime.GOOS)
+}
+
+func registerLinux(executable string) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	applicationsDir := filepath.Join(homeDir, ".local", "share", "applications")
+	err = os.MkdirAll(applicationsDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	desktopFile := fmt.Sprintf(`[Desktop Entry]
+Name=Daytona
+Exec="%s" url-handler open %%u
+Type=Application
+Terminal=true
+NoDisplay=true
+MimeType=x-scheme-handler/%s;
+`, executable, Scheme)
+
+	err = os.WriteFile(filepath.Join(applicationsDir, desktopFileName), []byte(desktopFile), 0644)
+	if err != nil {
+		return err
+	}
+
+	xdgMime, err := exec.LookPath("xdg-mime")
+	if err != nil {
+		return errors.New("xdg-mime not found. Install xdg-utils to register the protocol handler")
+	}
+
+	return exec.Command(xdgMime, "default", desktopFileName, "x-scheme-handler/"+Scheme).Run()
+}
+
+func registerDarwin(executable string) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+
+	appPath := filepath.Join(homeDir, "Applications", macAppName)
+	err = os.MkdirAll(filepath.Dir(appPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	// Links are opened in Terminal since the handler may need to prompt the user
+	script := []string{
+		"on open location theURL",
+		fmt.Sprintf(`tell application "Terminal" to do script quoted form of "%s" & " url-handler open " & quoted form of theURL`, executable),
+		"end open location",
+	}
+
+	args := []string{"-o", appPath}
+	for _, line := range script {
+		args = append(args, "-e", line)
+	}
+
+	output, err := exec.Command("osacompile", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to compile url handler app: %s", output)
+	}
+
+	plist := filepath.Join(appPath, "Contents", "Info.plist")
+	for _, entry := range []string{
+		"Add :CFBundleIdentifier string io.daytona.urlhandler",
+		"Add :CFBundleURLTypes array",
+		"Add :CFBundleURLTypes:0 dict",
+		"Add :CFBundleURLTypes:0:CFBundleURLName string Daytona",
+		"Add :CFBundleURLTypes:0:CFBundleURLSchemes array",
+		"Add :CFBundleURLTypes:0:CFBundleURLSchemes:0 string " + Scheme,
+	} {
+		// CFBundleIdentifier may already be set by osacompile
+		_ = exec.Command("/usr/libexec/PlistBuddy", "-c", entry, plist).Run()
+	}
+
+	lsregister := "/System/Library/Frameworks/CoreServices.framework/Frameworks/LaunchServices.framework/Support/lsregister"
+	return exec.Command(lsregister, "-f", appPath).Run()
+}
+
+func registerWindows(executable string) error {
+	key := `HKCU\Software\Classes\` + Scheme
+	commands := [][]string{
+		{"add", key, "/ve", "/d", "URL:Daytona Protocol", "/f"},
+		{"add", key, "/v", "URL Protocol", "/d", "", "/f"},
+		{"add", key + `\shell\open\command`, "/ve", "/d", fmt.Sprintf(`"%s" url-handler open "%%1"`, executable), "/f"},
+	}
+
+	for _, args := range commands {
+		output, err := exec.Command("reg", args...).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to register protocol handler: %s", output)
+		}
+	}
+
+	return nil
+}
+
+func removeIfExists(path string) error {
+	err := os.RemoveAll(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/acme"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LetsEncryptDirectoryUrl is the ACME directory certificates are issued from by default
+const LetsEncryptDirectoryUrl = "https://acme-v02.api.letsencrypt.org/directory"
+
+// Time given to the challenge records to propagate to the authoritative name servers before they are checked
+const defaultPropagationDelay = 30 * time.Second
+
+type CertificateRequest struct {
+	// Names of the certificate, e.g. *.preview.example.com
+	Domains []string
+	// Contact email of the ACME account
+	Email string
+	// Defaults to Let's Encrypt
+	DirectoryUrl string
+	// Key of the ACME account. The account is registered if it does not exist
+	AccountKey crypto.Signer
+	// Defaults to 30s
+	PropagationDelay time.Duration
+}
+
+type Certificate struct {
+	// PEM encoded certificate chain
+	CertPem []byte
+	// PEM encoded private key
+	KeyPem   []byte
+	NotAfter time.Time
+}
+
+// IssueCertificate obtains a certificate through the ACME DNS-01 challenge, for which the provider creates the
+// challenge records. The records are deleted once the authorizations are done
+func IssueCertificate(ctx context.Context, provider Provider, req CertificateRequest) (*Certificate, error) {
+	directoryUrl := req.DirectoryUrl
+	if directoryUrl == "" {
+		directoryUrl = LetsEncryptDirectoryUrl
+	}
+
+	propagationDelay := req.PropagationDelay
+	if propagationDelay == 0 {
+		propagationDelay = defaultPropagationDelay
+	}
+
+	client := &acme.Client{
+		Key:          req.AccountKey,
+		DirectoryURL: directoryUrl,
+	}
+
+	account := &acme.Account{}
+	if req.Email != "" {
+		account.Contact = []string{"mailto:" + req.Email}
+	}
+
+	_, err := client.Register(ctx, account, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return nil, fmt.Errorf("failed to register ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(req.Domains...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate order: %w", err)
+	}
+
+	for _, authzUrl := range order.AuthzURLs {
+		err = authorize(ctx, client, provider, authzUrl, propagationDelay)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("certificate order failed: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: req.Domains}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize certificate order: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &Certificate{
+		NotAfter: leaf.NotAfter,
+	}
+
+	for _, der := range chain {
+		certificate.CertPem = append(certificate.CertPem, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	certificate.KeyPem = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return certificate, nil
+}
+
+func authorize(ctx context.Context, client *acme.Client, provider Provider, authzUrl string, propagationDelay time.Duration) error {
+	authz, err := client.GetAuthorization(ctx, authzUrl)
+	if err != nil {
+		return err
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	var challenge *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "dns-01" {
+			challenge = c
+			break
+		}
+	}
+	if challenge == nil {
+		return fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return err
+	}
+
+	// Wildcard identifiers are validated on the challenge record of the base domain
+	recordName := "_acme-challenge." + authz.Identifier.Value
+
+	err = provider.UpsertRecord(ctx, Record{
+		Name:  recordName,
+		Type:  RecordTypeTXT,
+		Value: value,
+		Ttl:   60,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create challenge record %s: %w", recordName, err)
+	}
+
+	defer func() {
+		err := provider.DeleteRecord(context.Background(), recordName, RecordTypeTXT)
+		if err != nil {
+			log.Warnf("Failed to delete challenge record %s: %v", recordName, err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(propagationDelay):
+	}
+
+	_, err = client.Accept(ctx, challenge)
+	if err != nil {
+		return fmt.Errorf("failed to accept challenge for %s: %w", authz.Identifier.Value, err)
+	}
+
+	_, err = client.WaitAuthorization(ctx, authz.URI)
+	if err != nil {
+		return fmt.Errorf("authorization of %s failed: %w", authz.Identifier.Value, err)
+	}
+
+	return nil
+}
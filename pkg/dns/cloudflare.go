@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const cloudflareApiUrl = "https://api.cloudflare.com/client/v4"
+
+// CloudflareProvider manages the records of a Cloudflare zone with an API token that has the DNS edit permission
+type CloudflareProvider struct {
+	apiUrl   string
+	apiToken string
+	zoneId   string
+	client   *http.Client
+}
+
+func NewCloudflareProvider(apiToken, zoneId string) *CloudflareProvider {
+	return &CloudflareProvider{
+		apiUrl:   cloudflareApiUrl,
+		apiToken: apiToken,
+		zoneId:   zoneId,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type cloudflareRecord struct {
+	Id      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	Ttl     uint32 `json:"ttl"`
+	Proxied bool   `json:"proxied"`
+}
+
+type cloudflareResponse struct {
+	Success bool `json:"success"`
+	Errors  []struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"errors"`
+	Result json.RawMessage `json:"result"`
+}
+
+func (p *CloudflareProvider) UpsertRecord(ctx context.Context, record Record) error {
+	existing, err := p.findRecords(ctx, record.Name, record.Type)
+	if err != nil {
+		return err
+	}
+
+	body := cloudflareRecord{
+		Type:    string(record.Type),
+		Name:    record.Name,
+		Content: record.Value,
+		Ttl:     record.ttl(),
+	}
+
+	if len(existing) == 0 {
+		return p.do(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records", p.zoneId), body, nil)
+	}
+
+	return p.do(ctx, http.MethodPut, fmt.Sprintf("/zones/%s/dns_records/%s", p.zoneId, existing[0].Id), body, nil)
+}
+
+func (p *CloudflareProvider) DeleteRecord(ctx context.Context, name string, recordType RecordType) error {
+	existing, err := p.findRecords(ctx, name, recordType)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range existing {
+		err = p.do(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/dns_records/%s", p.zoneId, r.Id), nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *CloudflareProvider) findRecords(ctx context.Context, name string, recordType RecordType) ([]cloudflareRecord, error) {
+	query := url.Values{}
+	query.Set("name", name)
+	query.Set("type", string(recordType))
+
+	records := []cloudflareRecord{}
+	err := p.do(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/dns_records?%s", p.zoneId, query.Encode()), nil, &records)
+	return records, err
+}
+
+func (p *CloudflareProvider) do(ctx context.Context, method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, p.apiUrl+path, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+p.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response cloudflareResponse
+	err = json.NewDecoder(io.LimitReader(res.Body, 10*1024*1024)).Decode(&response)
+	if err != nil {
+		return fmt.Errorf("cloudflare request %s %s failed with status %d", method, path, res.StatusCode)
+	}
+
+	if !response.Success {
+		if len(response.Errors) > 0 {
+			return fmt.Errorf("cloudflare request %s %s failed: %s (code %d)", method, path, response.Errors[0].Message, response.Errors[0].Code)
+		}
+		return fmt.Errorf("cloudflare request %s %s failed with status %d", method, path, res.StatusCode)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(response.Result, result)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudflareProvider(t *testing.T) {
+	records := map[string]cloudflareRecord{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.True(t, strings.HasPrefix(r.URL.Path, "/zones/zone/dns_records"))
+
+		id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/zones/zone/dns_records"), "/")
+		var result interface{}
+
+		switch r.Method {
+		case http.MethodGet:
+			found := []cloudflareRecord{}
+			for _, record := range records {
+				if record.Name == r.URL.Query().Get("name") && record.Type == r.URL.Query().Get("type") {
+					found = append(found, record)
+				}
+			}
+			result = found
+		case http.MethodPost, http.MethodPut:
+			var record cloudflareRecord
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&record))
+			if id == "" {
+				id = record.Name
+			}
+			record.Id = id
+			records[id] = record
+			result = record
+		case http.MethodDelete:
+			delete(records, id)
+		}
+
+		require.Nil(t, json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "result": result}))
+	}))
+	defer server.Close()
+
+	provider := NewCloudflareProvider("token", "zone")
+	provider.apiUrl = server.URL
+
+	ctx := context.Background()
+	require.Nil(t, provider.UpsertRecord(ctx, NewTargetRecord("*.preview.example.com", "203.0.113.10")))
+	require.Nil(t, provider.UpsertRecord(ctx, NewTargetRecord("*.preview.example.com", "203.0.113.11")))
+	require.Equal(t, map[string]cloudflareRecord{
+		"*.preview.example.com": {Id: "*.preview.example.com", Type: "A", Name: "*.preview.example.com", Content: "203.0.113.11", Ttl: defaultTtl},
+	}, records)
+
+	require.Nil(t, provider.DeleteRecord(ctx, "*.preview.example.com", RecordTypeA))
+	require.Empty(t, records)
+	require.Nil(t, provider.DeleteRecord(ctx, "*.preview.example.com", RecordTypeA))
+}
+
+func TestNewTargetRecord(t *testing.T) {
+	require.Equal(t, RecordTypeA, NewTargetRecord("a.example.com", "203.0.113.10").Type)
+	require.Equal(t, RecordTypeAAAA, NewTargetRecord("a.example.com", "2001:db8::1").Type)
+	require.Equal(t, Record{Name: "a.example.com", Type: RecordTypeCNAME, Value: "frps.example.com"}, NewTargetRecord("a.example.com", "frps.example.com."))
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"net"
+	"strings"
+)
+
+type RecordType string
+
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeTXT   RecordType = "TXT"
+)
+
+// Default TTL of the records in seconds
+const defaultTtl = 300
+
+type Record struct {
+	// Fully qualified name without the trailing dot, e.g. *.preview.example.com
+	Name  string
+	Type  RecordType
+	Value string
+	// TTL in seconds. Defaults to 5 minutes
+	Ttl uint32
+}
+
+// Provider manages the records of a DNS zone
+type Provider interface {
+	// UpsertRecord creates the record or replaces the value of the record with the same name and type
+	UpsertRecord(ctx context.Context, record Record) error
+	// DeleteRecord deletes the record with the given name and type. Deleting a record that does not exist is not an error
+	DeleteRecord(ctx context.Context, name string, recordType RecordType) error
+}
+
+// NewTargetRecord returns the record that points name to the target. IP addresses are targeted with A or AAAA
+// records, hostnames with CNAME records
+func NewTargetRecord(name, target string) Record {
+	record := Record{
+		Name:  name,
+		Type:  RecordTypeCNAME,
+		Value: strings.TrimSuffix(target, "."),
+	}
+
+	if ip := net.ParseIP(target); ip != nil {
+		record.Type = RecordTypeA
+		if ip.To4() == nil {
+			record.Type = RecordTypeAAAA
+		}
+	}
+
+	return record
+}
+
+func (r Record) ttl() uint32 {
+	if r.Ttl == 0 {
+		return defaultTtl
+	}
+	return r.Ttl
+}
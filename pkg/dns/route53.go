@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// Route53Provider manages the records of a Route 53 hosted zone
+type Route53Provider struct {
+	hostedZoneId string
+	client       *route53.Route53
+}
+
+// NewRoute53Provider returns a provider that authenticates with the access key if set, otherwise with the
+// credentials of the default AWS credential chain
+func NewRoute53Provider(hostedZoneId, accessKeyId, secretAccessKey string) (*Route53Provider, error) {
+	// Route 53 is a global service, its API is only served from us-east-1
+	config := aws.NewConfig().WithRegion("us-east-1")
+	if accessKeyId != "" {
+		config = config.WithCredentials(credentials.NewStaticCredentials(accessKeyId, secretAccessKey, ""))
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
+
+	return &Route53Provider{
+		hostedZoneId: hostedZoneId,
+		client:       route53.New(sess),
+	}, nil
+}
+
+func (p *Route53Provider) UpsertRecord(ctx context.Context, record Record) error {
+	value := record.Value
+	if record.Type == RecordTypeTXT {
+		value = fmt.Sprintf("%q", value)
+	}
+
+	return p.change(ctx, route53.ChangeActionUpsert, &route53.ResourceRecordSet{
+		Name: aws.String(record.Name),
+		Type: aws.String(string(record.Type)),
+		TTL:  aws.Int64(int64(record.ttl())),
+		ResourceRecords: []*route53.ResourceRecord{
+			{Value: aws.String(value)},
+		},
+	})
+}
+
+func (p *Route53Provider) DeleteRecord(ctx context.Context, name string, recordType RecordType) error {
+	// Deletions must match the current TTL and values of the record set
+	output, err := p.client.ListResourceRecordSetsWithContext(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(p.hostedZoneId),
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(string(recordType)),
+		MaxItems:        aws.String("1"),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, recordSet := range output.ResourceRecordSets {
+		if route53RecordName(aws.StringValue(recordSet.Name)) != strings.TrimSuffix(name, ".") || aws.StringValue(recordSet.Type) != string(recordType) {
+			continue
+		}
+
+		return p.change(ctx, route53.ChangeActionDelete, recordSet)
+	}
+
+	return nil
+}
+
+func (p *Route53Provider) change(ctx context.Context, action string, recordSet *route53.ResourceRecordSet) error {
+	_, err := p.client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(p.hostedZoneId),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: recordSet,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s route53 record %s: %w", strings.ToLower(action), aws.StringValue(recordSet.Name), err)
+	}
+
+	return nil
+}
+
+// route53RecordName converts a record name returned by Route 53 to the name it was created with. Route 53 returns
+// fully qualified names with escaped wildcards
+func route53RecordName(name string) string {
+	return strings.TrimSuffix(strings.ReplaceAll(name, `\052`, "*"), ".")
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Prefix of the environment variables of the builder container that hold the values of build secrets
+const buildSecretEnvPrefix = "DAYTONA_BUILD_SECRET_"
+
+// addBuildSecretOptions makes the secrets available to the image build of the devcontainer as BuildKit secrets.
+// The values are read from environment variables of the builder container, so they are neither written to the
+// override config nor stored in the layers of the image. Returns the environment of the builder container and false
+// if the devcontainer is not built from a Dockerfile, in which case no secrets can be mounted
+func addBuildSecretOptions(devcontainerConfig map[string]interface{}, secrets map[string]string) ([]string, bool) {
+	build, ok := devcontainerConfig["build"].(map[string]interface{})
+	if !ok {
+		// Legacy configs set the Dockerfile at the top level
+		if _, ok := devcontainerConfig["dockerFile"]; !ok {
+			return nil, false
+		}
+		build = map[string]interface{}{}
+		devcontainerConfig["build"] = build
+	}
+
+	if _, ok := build["dockerfile"]; !ok {
+		if _, ok := devcontainerConfig["dockerFile"]; !ok {
+			return nil, false
+		}
+	}
+
+	options, _ := build["options"].([]interface{})
+	env := []string{}
+
+	for i, name := range slices.Sorted(maps.Keys(secrets)) {
+		envVar := fmt.Sprintf("%s%d", buildSecretEnvPrefix, i)
+		options = append(options, fmt.Sprintf("--secret=id=%s,env=%s", name, envVar))
+		env = append(env, fmt.Sprintf("%s=%s", envVar, secrets[name]))
+	}
+
+	build["options"] = options
+
+	return env, true
+}
@@ -9,7 +9,9 @@ import (
 	"io"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -37,17 +39,31 @@ type IDockerClient interface {
 	DestroyProject(project *project.Project, projectDir string, sshClient *ssh.Client) error
 	DestroyWorkspace(workspace *workspace.Workspace, workspaceDir string, sshClient *ssh.Client) error
 
+	RebuildProject(opts *CreateProjectOptions) error
+
+	CreateSharedService(service *sharedservice.SharedService, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	DestroySharedService(service *sharedservice.SharedService) error
+	GetSharedServiceInfo(service *sharedservice.SharedService) (*sharedservice.SharedServiceInfo, error)
+
 	StartProject(opts *CreateProjectOptions, daytonaDownloadUrl string) error
 	StopProject(project *project.Project, logWriter io.Writer) error
+	PauseProject(project *project.Project) error
+	ResumeProject(project *project.Project) error
+
+	StartProjectRecovery(opts *CreateProjectOptions, daytonaDownloadUrl string) error
+	StopProjectRecovery(project *project.Project) error
 
 	GetProjectInfo(project *project.Project) (*project.ProjectInfo, error)
+	GetProjectCreationTimings(project *project.Project) []creationtiming.PhaseDuration
 	GetWorkspaceInfo(ws *workspace.Workspace) (*workspace.WorkspaceInfo, error)
+	GetHostCapacity() (*project.Resources, error)
 
 	GetProjectContainerName(project *project.Project) string
 	GetProjectVolumeName(project *project.Project) string
 	ExecSync(containerID string, config container.ExecOptions, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	InspectImage(imageName string, cr *containerregistry.ContainerRegistry) error
 	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
 	DeleteImage(imageName string, force bool, logWriter io.Writer) error
 
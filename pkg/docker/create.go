@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/git"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -40,17 +41,26 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 	// This is only an optimisation for images with tag 'latest'
 	pulledImages := map[string]bool{}
 
+	err := d.validateProjectMounts(opts.Project.Mounts, opts.SshClient)
+	if err != nil {
+		return err
+	}
+
 	if opts.Project.BuildConfig != nil {
+		start := time.Now()
 		err := d.PullImage(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
 		if err != nil {
 			return err
 		}
 		pulledImages[opts.BuilderImage] = true
+		recordCreationPhase(opts.Project, creationtiming.PhaseImagePull, start)
 
+		start = time.Now()
 		err = d.cloneProjectRepository(opts)
 		if err != nil {
 			return err
 		}
+		recordCreationPhase(opts.Project, creationtiming.PhaseClone, start)
 
 		builderType, err := detect.DetectProjectBuilderType(opts.Project.BuildConfig, opts.ProjectDir, opts.SshClient)
 		if err != nil {
@@ -59,8 +69,13 @@ func (d *DockerClient) CreateProject(opts *CreateProjectOptions) error {
 
 		switch builderType {
 		case detect.BuilderTypeDevcontainer:
+			start := time.Now()
 			_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
-			return err
+			if err != nil {
+				return err
+			}
+			recordCreationPhase(opts.Project, creationtiming.PhaseBuild, start)
+			return nil
 		case detect.BuilderTypeImage:
 			return d.createProjectFromImage(opts, pulledImages, true)
 		default:
@@ -211,6 +226,8 @@ func (d *DockerClient) toCreateDevcontainerOptions(opts *CreateProjectOptions, p
 		BuilderImage:             opts.BuilderImage,
 		BuilderContainerRegistry: opts.BuilderContainerRegistry,
 		EnvVars:                  opts.Project.EnvVars,
+		Mounts:                   opts.Project.Mounts,
+		Resources:                opts.Project.Resources,
 		IdLabels: map[string]string{
 			"daytona.workspace.id": opts.Project.WorkspaceId,
 			"daytona.project.name": opts.Project.Name,
@@ -21,6 +21,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/build/devcontainer"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
@@ -52,6 +53,13 @@ type CreateDevcontainerOptions struct {
 	IdLabels                 map[string]string
 	BuilderImage             string
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// Target platform architecture of the devcontainer, e.g. "arm64" or "linux/arm64"
+	Architecture string
+	Mounts       []project.Mount
+	// Resources the devcontainer is limited to
+	Resources *project.Resources
+	// Secrets mounted into the image build by name, their values are not stored in the image
+	BuildSecrets map[string]string
 }
 
 func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (string, RemoteUser, error) {
@@ -243,6 +251,35 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 		devcontainerConfig["dockerComposeFile"] = path.Join(paths.OverridesTarget, "daytona-compose-override.yml")
 	}
 
+	if opts.Architecture != "" {
+		platform := opts.Architecture
+		if !strings.Contains(platform, "/") {
+			platform = "linux/" + platform
+		}
+
+		runArgs, _ := devcontainerConfig["runArgs"].([]interface{})
+		devcontainerConfig["runArgs"] = append(runArgs, "--platform="+platform)
+	}
+
+	if opts.Resources != nil {
+		runArgs, _ := devcontainerConfig["runArgs"].([]interface{})
+		devcontainerConfig["runArgs"] = append(runArgs, toDevcontainerRunArgs(opts.Resources)...)
+	}
+
+	if len(opts.Mounts) > 0 {
+		mounts, _ := devcontainerConfig["mounts"].([]interface{})
+		devcontainerConfig["mounts"] = append(mounts, toDevcontainerMounts(opts.Mounts)...)
+	}
+
+	var buildSecretEnv []string
+	if len(opts.BuildSecrets) > 0 {
+		var ok bool
+		buildSecretEnv, ok = addBuildSecretOptions(devcontainerConfig, opts.BuildSecrets)
+		if !ok {
+			opts.LogWriter.Write([]byte("Build secrets can only be mounted into devcontainers built from a Dockerfile. Continuing without build secrets.\n"))
+		}
+	}
+
 	envVars["DAYTONA_PROJECT_DIR"] = workspaceFolder
 
 	devcontainerConfig["containerEnv"] = envVars
@@ -298,7 +335,7 @@ func (d *DockerClient) CreateFromDevcontainer(opts CreateDevcontainerOptions) (s
 			Source: paths.OverridesDir,
 			Target: paths.OverridesTarget,
 		},
-	})
+	}, buildSecretEnv...)
 	if err != nil {
 		return "", "", err
 	}
@@ -520,7 +557,7 @@ func (d *DockerClient) runInitializeCommand(projectDir string, initializeCommand
 	return fmt.Errorf("invalid command type: %v", initializeCommand)
 }
 
-func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontainerOptions, paths DevcontainerPaths, workdir, socketForwardId string, writeOutput bool, extraMounts []mount.Mount) (string, error) {
+func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontainerOptions, paths DevcontainerPaths, workdir, socketForwardId string, writeOutput bool, extraMounts []mount.Mount, extraEnv ...string) (string, error) {
 	ctx := context.Background()
 
 	mounts := []mount.Mount{
@@ -538,7 +575,7 @@ func (d *DockerClient) execDevcontainerCommand(cmd string, opts *CreateDevcontai
 	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
 		Image:      opts.BuilderImage,
 		Entrypoint: []string{"sh"},
-		Env:        []string{"DOCKER_HOST=tcp://localhost:2375"},
+		Env:        append([]string{"DOCKER_HOST=tcp://localhost:2375"}, extraEnv...),
 		Cmd:        append([]string{"-c"}, cmd),
 		Tty:        true,
 		WorkingDir: workdir,
@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
@@ -22,11 +23,13 @@ func (d *DockerClient) createProjectFromImage(opts *CreateProjectOptions, pulled
 		return d.initProjectContainer(opts, mountProjectDir)
 	}
 
+	start := time.Now()
 	err := d.PullImage(opts.Project.Image, opts.ContainerRegistry, opts.LogWriter)
 	if err != nil {
 		return err
 	}
 	pulledImages[opts.Project.Image] = true
+	recordCreationPhase(opts.Project, creationtiming.PhaseImagePull, start)
 
 	return d.initProjectContainer(opts, mountProjectDir)
 }
@@ -42,6 +45,7 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 			Target: fmt.Sprintf("/home/%s/%s", opts.Project.User, opts.Project.Name),
 		})
 	}
+	mounts = append(mounts, toDockerMounts(opts.Project.Mounts)...)
 
 	c, err := d.apiClient.ContainerCreate(ctx, GetContainerCreateConfig(opts.Project), &container.HostConfig{
 		Privileged: true,
@@ -49,6 +53,7 @@ func (d *DockerClient) initProjectContainer(opts *CreateProjectOptions, mountPro
 		ExtraHosts: []string{
 			"host.docker.internal:host-gateway",
 		},
+		Resources: toDockerResources(opts.Project.Resources),
 	}, nil, nil, d.GetProjectContainerName(opts.Project))
 	if err != nil {
 		return err
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Providers usually create a new docker client for every request, so the timings are kept until the
+// provider collects them after the project is created
+var (
+	creationTimings      = map[string][]creationtiming.PhaseDuration{}
+	creationTimingsMutex sync.Mutex
+)
+
+func getCreationTimingsKey(p *project.Project) string {
+	return fmt.Sprintf("%s/%s", p.WorkspaceId, p.Name)
+}
+
+// recordCreationPhase adds the time since start to the phase, e.g. pulling both the builder and the project image
+// count as a single image pull phase
+func recordCreationPhase(p *project.Project, phase creationtiming.Phase, start time.Time) {
+	creationTimingsMutex.Lock()
+	defer creationTimingsMutex.Unlock()
+
+	key := getCreationTimingsKey(p)
+	durationMs := time.Since(start).Milliseconds()
+
+	for i, d := range creationTimings[key] {
+		if d.Phase == phase {
+			creationTimings[key][i].DurationMs += durationMs
+			return
+		}
+	}
+
+	creationTimings[key] = append(creationTimings[key], creationtiming.PhaseDuration{
+		Phase:      phase,
+		DurationMs: durationMs,
+	})
+}
+
+// GetProjectCreationTimings returns the image pull, clone and build durations of the last creation of the project.
+// The timings are removed once returned.
+func (d *DockerClient) GetProjectCreationTimings(p *project.Project) []creationtiming.PhaseDuration {
+	creationTimingsMutex.Lock()
+	defer creationTimingsMutex.Unlock()
+
+	key := getCreationTimingsKey(p)
+	timings := creationTimings[key]
+	delete(creationTimings, key)
+
+	if timings == nil {
+		return []creationtiming.PhaseDuration{}
+	}
+
+	return timings
+}
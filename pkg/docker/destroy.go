@@ -24,7 +24,12 @@ func (d *DockerClient) DestroyWorkspace(workspace *workspace.Workspace, workspac
 }
 
 func (d *DockerClient) DestroyProject(project *project.Project, projectDir string, sshClient *ssh.Client) error {
-	err := d.removeProjectContainer(project)
+	err := d.removeProjectContainer(project, true)
+	if err != nil {
+		return err
+	}
+
+	err = d.StopProjectRecovery(project)
 	if err != nil {
 		return err
 	}
@@ -36,7 +41,7 @@ func (d *DockerClient) DestroyProject(project *project.Project, projectDir strin
 	}
 }
 
-func (d *DockerClient) removeProjectContainer(p *project.Project) error {
+func (d *DockerClient) removeProjectContainer(p *project.Project, removeVolume bool) error {
 	ctx := context.Background()
 
 	containerName := d.GetProjectContainerName(p)
@@ -54,9 +59,11 @@ func (d *DockerClient) removeProjectContainer(p *project.Project) error {
 		return err
 	}
 
-	err = d.apiClient.VolumeRemove(ctx, containerName, true)
-	if err != nil && !client.IsErrNotFound(err) {
-		return err
+	if removeVolume {
+		err = d.apiClient.VolumeRemove(ctx, containerName, true)
+		if err != nil && !client.IsErrNotFound(err) {
+			return err
+		}
 	}
 
 	// TODO: Add logging
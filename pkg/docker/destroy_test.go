@@ -6,6 +6,7 @@ package docker_test
 import (
 	"os"
 
+	"github.com/daytonaio/daytona/pkg/docker"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/stretchr/testify/mock"
@@ -40,6 +41,13 @@ func (s *DockerClientTestSuite) TestDestroyProject() {
 
 	s.mockClient.On("VolumeRemove", mock.Anything, s.dockerClient.GetProjectVolumeName(project1), true).Return(nil)
 
+	s.mockClient.On("ContainerRemove", mock.Anything, docker.GetProjectRecoveryContainerName(project1),
+		container.RemoveOptions{
+			Force:         true,
+			RemoveVolumes: true,
+		},
+	).Return(nil)
+
 	projectDir := s.T().TempDir()
 
 	err := s.dockerClient.DestroyProject(project1, projectDir, nil)
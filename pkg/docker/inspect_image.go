@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+)
+
+// InspectImage looks up the manifest of the image in its registry without pulling it, e.g. to check that
+// the image exists and the credentials of the registry can pull it
+func (d *DockerClient) InspectImage(imageName string, cr *containerregistry.ContainerRegistry) error {
+	_, err := d.apiClient.DistributionInspect(context.Background(), imageName, getRegistryAuth(cr))
+	return err
+}
@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"errors"
+
+	"github.com/docker/docker/api/types/registry"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestInspectImage() {
+	s.mockClient.On("DistributionInspect", mock.Anything, "daytonaio/workspace-project:latest", mock.Anything).Return(registry.DistributionInspect{}, nil).Once()
+
+	err := s.dockerClient.InspectImage("daytonaio/workspace-project:latest", nil)
+	require.Nil(s.T(), err)
+
+	s.mockClient.On("DistributionInspect", mock.Anything, "daytonaio/missing:latest", mock.Anything).Return(registry.DistributionInspect{}, errors.New("manifest unknown")).Once()
+
+	err = s.dockerClient.InspectImage("daytonaio/missing:latest", nil)
+	require.NotNil(s.T(), err)
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/mount"
+)
+
+// validateProjectMounts checks the mounts against the host running the project containers.
+// Bind mount sources must exist on that host, which is the remote machine for targets reached over SSH.
+func (d *DockerClient) validateProjectMounts(mounts []project.Mount, sshClient *ssh.Client) error {
+	err := project.ValidateMounts(mounts)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range mounts {
+		if m.Type != project.MountTypeBind {
+			continue
+		}
+
+		if sshClient != nil {
+			err = sshClient.Exec(fmt.Sprintf("test -e '%s'", strings.ReplaceAll(m.Source, "'", `'\''`)), nil)
+		} else {
+			_, err = os.Stat(m.Source)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: bind source %s does not exist on the target host", project.ErrInvalidMount, m.Source)
+		}
+	}
+
+	return nil
+}
+
+func toDockerMounts(mounts []project.Mount) []mount.Mount {
+	result := []mount.Mount{}
+
+	for _, m := range mounts {
+		dockerMount := mount.Mount{
+			Type:     mount.Type(m.Type),
+			Source:   m.Source,
+			Target:   m.Target,
+			ReadOnly: m.ReadOnly,
+		}
+
+		// Sizes are checked by validateProjectMounts before any container is created
+		if size, _ := m.SizeBytes(); m.Type == project.MountTypeTmpfs && size > 0 {
+			dockerMount.TmpfsOptions = &mount.TmpfsOptions{
+				SizeBytes: size,
+			}
+		}
+
+		result = append(result, dockerMount)
+	}
+
+	return result
+}
+
+// toDevcontainerMounts formats the mounts in the docker --mount syntax used by the devcontainer.json mounts property
+func toDevcontainerMounts(mounts []project.Mount) []interface{} {
+	result := []interface{}{}
+
+	for _, m := range mounts {
+		result = append(result, m.MountFlag())
+	}
+
+	return result
+}
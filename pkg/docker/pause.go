@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/checkpoint"
+	"github.com/docker/docker/api/types/container"
+	log "github.com/sirupsen/logrus"
+)
+
+// pauseCheckpointId is the checkpoint a paused project container is resumed from
+const pauseCheckpointId = "daytona-pause"
+
+// PauseProject checkpoints the processes of the project container with CRIU and stops the container. Requires
+// the experimental features of the Docker daemon and CRIU on the host. Compose containers of the project keep running
+// so that the connections of the project processes to them are restored on resume
+func (d *DockerClient) PauseProject(p *project.Project) error {
+	containerName := d.GetProjectContainerName(p)
+	ctx := context.Background()
+
+	// A checkpoint left by a previous pause is stale once the project was started anew
+	d.deletePauseCheckpoint(ctx, containerName)
+
+	err := d.apiClient.CheckpointCreate(ctx, containerName, checkpoint.CreateOptions{
+		CheckpointID: pauseCheckpointId,
+		Exit:         true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to checkpoint project container: %w", err)
+	}
+
+	return nil
+}
+
+// ResumeProject starts the project container from the checkpoint of its processes. The agent is restored with
+// the other processes and is not started again
+func (d *DockerClient) ResumeProject(p *project.Project) error {
+	containerName := d.GetProjectContainerName(p)
+	ctx := context.Background()
+
+	err := d.apiClient.ContainerStart(ctx, containerName, container.StartOptions{
+		CheckpointID: pauseCheckpointId,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore project container: %w", err)
+	}
+
+	d.deletePauseCheckpoint(ctx, containerName)
+
+	return nil
+}
+
+func (d *DockerClient) deletePauseCheckpoint(ctx context.Context, containerName string) {
+	err := d.apiClient.CheckpointDelete(ctx, containerName, checkpoint.DeleteOptions{
+		CheckpointID: pauseCheckpointId,
+	})
+	if err != nil {
+		log.Tracef("failed to delete checkpoint of container %s: %v", containerName, err)
+	}
+}
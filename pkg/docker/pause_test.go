@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"errors"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/checkpoint"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestPauseProject() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("CheckpointDelete", mock.Anything, containerName, checkpoint.DeleteOptions{CheckpointID: "daytona-pause"}).Return(errors.New("no such checkpoint"))
+	s.mockClient.On("CheckpointCreate", mock.Anything, containerName, checkpoint.CreateOptions{CheckpointID: "daytona-pause", Exit: true}).Return(nil)
+
+	err := s.dockerClient.PauseProject(project1)
+	require.Nil(s.T(), err)
+	s.mockClient.AssertCalled(s.T(), "CheckpointCreate", mock.Anything, containerName, checkpoint.CreateOptions{CheckpointID: "daytona-pause", Exit: true})
+}
+
+func (s *DockerClientTestSuite) TestResumeProject() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerStart", mock.Anything, containerName, container.StartOptions{CheckpointID: "daytona-pause"}).Return(nil)
+	s.mockClient.On("CheckpointDelete", mock.Anything, containerName, checkpoint.DeleteOptions{CheckpointID: "daytona-pause"}).Return(nil)
+
+	err := s.dockerClient.ResumeProject(project1)
+	require.Nil(s.T(), err)
+	s.mockClient.AssertCalled(s.T(), "ContainerStart", mock.Anything, containerName, container.StartOptions{CheckpointID: "daytona-pause"})
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/build/detect"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const rebuildBackupRepository = "daytona-rebuild-backup"
+
+// containerBackup is a snapshot of a project container that is restored if the rebuild of the project fails
+type containerBackup struct {
+	Name       string
+	Image      string
+	Config     *container.Config
+	HostConfig *container.HostConfig
+}
+
+// RebuildProject recreates the project container from the current configuration in the project directory.
+// The project directory and volume are kept so that uncommitted changes survive the rebuild. The previous container
+// is committed to an image first and restored from it if the rebuild fails.
+func (d *DockerClient) RebuildProject(opts *CreateProjectOptions) error {
+	backup, err := d.backupProjectContainer(opts.Project)
+	if err != nil {
+		return fmt.Errorf("failed to back up the project container: %w", err)
+	}
+
+	err = d.removeProjectContainer(opts.Project, false)
+	if err == nil {
+		err = d.rebuildProjectContainer(opts)
+	}
+	if err != nil {
+		if backup == nil {
+			return err
+		}
+
+		restoreErr := d.restoreProjectContainer(opts.Project, backup)
+		if restoreErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to restore the previous project container: %w", restoreErr))
+		}
+
+		if opts.LogWriter != nil {
+			opts.LogWriter.Write([]byte(fmt.Sprintf("Rebuild failed, the previous container was restored: %s\n", err)))
+		}
+		return err
+	}
+
+	if backup != nil {
+		_, err = d.apiClient.ImageRemove(context.Background(), backup.Image, image.RemoveOptions{})
+		if err != nil {
+			log.Errorf("failed to remove the rebuild backup image %s: %s", backup.Image, err)
+		}
+	}
+
+	return nil
+}
+
+// backupProjectContainer commits the project container to an image. Returns nil if the project has no container
+func (d *DockerClient) backupProjectContainer(p *project.Project) (*containerBackup, error) {
+	ctx := context.Background()
+
+	c, err := d.apiClient.ContainerInspect(ctx, d.GetProjectContainerName(p))
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if c.ContainerJSONBase == nil || c.Config == nil {
+		return nil, nil
+	}
+
+	backup := &containerBackup{
+		Name:       strings.TrimPrefix(c.Name, "/"),
+		Image:      fmt.Sprintf("%s:%s", rebuildBackupRepository, c.ID),
+		Config:     c.Config,
+		HostConfig: c.HostConfig,
+	}
+
+	_, err = d.apiClient.ContainerCommit(ctx, c.ID, container.CommitOptions{
+		Reference: backup.Image,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// restoreProjectContainer replaces whatever the failed rebuild left behind with a container created from the backup.
+// The container keeps the configuration and labels of the previous container and is started with the project
+func (d *DockerClient) restoreProjectContainer(p *project.Project, backup *containerBackup) error {
+	err := d.removeProjectContainer(p, false)
+	if err != nil {
+		return err
+	}
+
+	config := *backup.Config
+	config.Image = backup.Image
+
+	_, err = d.apiClient.ContainerCreate(context.Background(), &config, backup.HostConfig, nil, nil, backup.Name)
+	return err
+}
+
+func (d *DockerClient) rebuildProjectContainer(opts *CreateProjectOptions) error {
+	// Rebuilding always pulls the latest image
+	pulledImages := map[string]bool{}
+
+	if opts.Project.BuildConfig == nil {
+		return d.createProjectFromImage(opts, pulledImages, false)
+	}
+
+	err := d.PullImage(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
+	if err != nil {
+		return err
+	}
+	pulledImages[opts.BuilderImage] = true
+
+	if !d.projectRepositoryExists(opts) {
+		err := d.cloneProjectRepository(opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	builderType, err := detect.DetectProjectBuilderType(opts.Project.BuildConfig, opts.ProjectDir, opts.SshClient)
+	if err != nil {
+		return err
+	}
+
+	switch builderType {
+	case detect.BuilderTypeDevcontainer:
+		_, _, err := d.CreateFromDevcontainer(d.toCreateDevcontainerOptions(opts, true))
+		return err
+	case detect.BuilderTypeImage:
+		return d.createProjectFromImage(opts, pulledImages, true)
+	default:
+		return fmt.Errorf("unknown builder type: %s", builderType)
+	}
+}
+
+func (d *DockerClient) projectRepositoryExists(opts *CreateProjectOptions) bool {
+	gitDir := filepath.Join(opts.ProjectDir, ".git")
+
+	if opts.SshClient != nil {
+		return opts.SshClient.Exec(fmt.Sprintf("test -d %s", gitDir), nil) == nil
+	}
+
+	_, err := os.Stat(gitDir)
+	return err == nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	t_docker "github.com/daytonaio/daytona/internal/testing/docker"
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestRebuildProject() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	var networkingConfig *network.NetworkingConfig
+	var platform *v1.Platform
+
+	projectDir := s.T().TempDir()
+	err := os.MkdirAll(filepath.Join(projectDir, ".git"), 0755)
+	require.Nil(s.T(), err)
+	err = os.WriteFile(filepath.Join(projectDir, "uncommitted.txt"), []byte("changes"), 0644)
+	require.Nil(s.T(), err)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{ID: "old", Name: "/" + containerName},
+		Config:            &container.Config{},
+	}, nil)
+	s.mockClient.On("ContainerCommit", mock.Anything, "old", container.CommitOptions{Reference: "daytona-rebuild-backup:old"}).Return(types.IDResponse{ID: "backup"}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, containerName, container.RemoveOptions{RemoveVolumes: true, Force: true}).Return(nil)
+	s.mockClient.On("ImageRemove", mock.Anything, "daytona-rebuild-backup:old", image.RemoveOptions{}).Return([]image.DeleteResponse{}, nil)
+
+	s.mockClient.On("ImageList", mock.Anything,
+		image.ListOptions{
+			Filters: filters.NewArgs(filters.Arg("reference", project1.Image)),
+		},
+	).Return([]image.Summary{}, nil)
+
+	s.mockClient.On("ImagePull", mock.Anything, project1.Image, mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+	s.mockClient.On("ImagePull", mock.Anything, "daytonaio/workspace-project", mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+
+	s.mockClient.On("ContainerStart", mock.Anything, "123", container.StartOptions{}).Return(nil)
+	s.mockClient.On("ContainerStop", mock.Anything, "123", container.StopOptions{
+		Signal: "SIGKILL",
+	}).Return(nil)
+
+	// The container user is only patched when the tests do not run as root
+	_, client := net.Pipe()
+	s.mockClient.On("ContainerExecCreate", mock.Anything, mock.Anything, mock.Anything).Return(types.IDResponse{ID: "exec-id"}, nil).Maybe()
+	s.mockClient.On("ContainerExecAttach", mock.Anything, "exec-id", container.ExecStartOptions{}).
+		Return(types.HijackedResponse{
+			Conn:   client,
+			Reader: bufio.NewReader(t_docker.NewPipeReader("")),
+		}, nil).Maybe()
+	s.mockClient.On("ContainerExecInspect", mock.Anything, "exec-id").Return(container.ExecInspect{}, nil).Maybe()
+
+	s.mockClient.On("ContainerCreate", mock.Anything, docker.GetContainerCreateConfig(project1),
+		&container.HostConfig{
+			Privileged: true,
+			ExtraHosts: []string{
+				"host.docker.internal:host-gateway",
+			},
+			Mounts: []mount.Mount{
+				{
+					Type:   mount.TypeBind,
+					Source: projectDir,
+					Target: fmt.Sprintf("/home/%s/%s", project1.User, project1.Name),
+				},
+			},
+		},
+		networkingConfig,
+		platform,
+		containerName,
+	).Return(container.CreateResponse{ID: "123"}, nil)
+
+	err = s.dockerClient.RebuildProject(&docker.CreateProjectOptions{
+		Project:      project1,
+		ProjectDir:   projectDir,
+		BuilderImage: "daytonaio/workspace-project",
+	})
+	require.Nil(s.T(), err)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "uncommitted.txt"))
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "changes", string(content))
+}
+
+func (s *DockerClientTestSuite) TestRebuildProjectRestoresPreviousContainer() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	var networkingConfig *network.NetworkingConfig
+	var platform *v1.Platform
+
+	project := *project1
+	project.BuildConfig = nil
+	containerName := s.dockerClient.GetProjectContainerName(&project)
+
+	previousConfig := &container.Config{
+		Image:  "previous-image",
+		Labels: map[string]string{"daytona.workspace.id": project.WorkspaceId},
+	}
+	previousHostConfig := &container.HostConfig{Privileged: true}
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{ID: "old", Name: "/" + containerName, HostConfig: previousHostConfig},
+		Config:            previousConfig,
+	}, nil)
+	s.mockClient.On("ContainerCommit", mock.Anything, "old", container.CommitOptions{Reference: "daytona-rebuild-backup:old"}).Return(types.IDResponse{ID: "backup"}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, containerName, container.RemoveOptions{RemoveVolumes: true, Force: true}).Return(nil)
+
+	s.mockClient.On("ImageList", mock.Anything,
+		image.ListOptions{
+			Filters: filters.NewArgs(filters.Arg("reference", project.Image)),
+		},
+	).Return([]image.Summary{}, nil)
+	s.mockClient.On("ImagePull", mock.Anything, project.Image, mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+
+	s.mockClient.On("ContainerCreate", mock.Anything, docker.GetContainerCreateConfig(&project), mock.Anything, networkingConfig, platform, containerName).
+		Return(container.CreateResponse{}, errors.New("no space left on device"))
+
+	// The previous container is recreated from the backup with its configuration
+	restoredConfig := *previousConfig
+	restoredConfig.Image = "daytona-rebuild-backup:old"
+	s.mockClient.On("ContainerCreate", mock.Anything, &restoredConfig, previousHostConfig, networkingConfig, platform, containerName).
+		Return(container.CreateResponse{ID: "restored"}, nil)
+
+	err := s.dockerClient.RebuildProject(&docker.CreateProjectOptions{
+		Project:    &project,
+		ProjectDir: s.T().TempDir(),
+		LogWriter:  io.Discard,
+	})
+	require.EqualError(s.T(), err, "no space left on device")
+
+	s.mockClient.AssertCalled(s.T(), "ContainerCreate", mock.Anything, &restoredConfig, previousHostConfig, networkingConfig, platform, containerName)
+}
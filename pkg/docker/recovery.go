@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+)
+
+func GetProjectRecoveryContainerName(p *project.Project) string {
+	return p.WorkspaceId + "-" + p.Name + "-recovery"
+}
+
+// StartProjectRecovery starts a minimal container from the builder image that mounts the project directory
+// and runs the agent in recovery mode. It is used to fix projects whose container or agent does not start,
+// e.g. because of a broken devcontainer config, without losing work
+func (d *DockerClient) StartProjectRecovery(opts *CreateProjectOptions, daytonaDownloadUrl string) error {
+	ctx := context.Background()
+
+	err := d.StopProjectRecovery(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	err = d.PullImage(opts.BuilderImage, opts.BuilderContainerRegistry, opts.LogWriter)
+	if err != nil {
+		return err
+	}
+
+	projectDir := fmt.Sprintf("/home/%s/%s", opts.Project.User, opts.Project.Name)
+
+	envVars := []string{fmt.Sprintf("DAYTONA_PROJECT_DIR=%s", projectDir)}
+	for key, value := range opts.Project.EnvVars {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image: opts.BuilderImage,
+		Labels: map[string]string{
+			"daytona.workspace.id":               opts.Project.WorkspaceId,
+			"daytona.workspace.project.name":     opts.Project.Name,
+			"daytona.workspace.project.recovery": "true",
+		},
+		User:       "root",
+		Env:        envVars,
+		Entrypoint: []string{"sleep", "infinity"},
+	}, &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: opts.ProjectDir,
+				Target: projectDir,
+			},
+		},
+		ExtraHosts: []string{
+			"host.docker.internal:host-gateway",
+		},
+	}, nil, nil, GetProjectRecoveryContainerName(opts.Project))
+	if err != nil {
+		return err
+	}
+
+	err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	errChan := make(chan error)
+
+	go func() {
+		result, err := d.ExecSync(c.ID, container.ExecOptions{
+			Cmd:          []string{"bash", "-c", util.GetProjectRecoveryScript(daytonaDownloadUrl, opts.Project.ApiKey)},
+			AttachStdout: true,
+			AttachStderr: true,
+		}, opts.LogWriter)
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		if result.ExitCode != 0 {
+			errChan <- errors.New(result.StdErr)
+		}
+	}()
+
+	go func() {
+		// The agent keeps running, so the recovery is considered started if it does not exit right away
+		time.Sleep(5 * time.Second)
+		errChan <- nil
+	}()
+
+	return <-errChan
+}
+
+// StopProjectRecovery removes the recovery container of the project. The project directory is kept
+func (d *DockerClient) StopProjectRecovery(p *project.Project) error {
+	return d.RemoveContainer(GetProjectRecoveryContainerName(p))
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestStopProjectRecovery() {
+	require.Equal(s.T(), "123-test-recovery", docker.GetProjectRecoveryContainerName(project1))
+
+	s.mockClient.On("ContainerRemove", mock.Anything, "123-test-recovery", container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}).Return(nil)
+
+	err := s.dockerClient.StopProjectRecovery(project1)
+	require.Nil(s.T(), err)
+}
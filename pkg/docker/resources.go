@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/container"
+)
+
+// GetHostCapacity returns the CPU and memory of the host the Docker daemon runs on
+func (d *DockerClient) GetHostCapacity() (*project.Resources, error) {
+	info, err := d.apiClient.Info(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return &project.Resources{
+		Cpus:   float64(info.NCPU),
+		Memory: uint64(info.MemTotal) / 1024 / 1024,
+	}, nil
+}
+
+// toDockerResources limits the project container to the resources reserved for the project
+func toDockerResources(resources *project.Resources) container.Resources {
+	if resources == nil {
+		return container.Resources{}
+	}
+
+	return container.Resources{
+		NanoCPUs: int64(resources.Cpus * 1e9),
+		Memory:   int64(resources.Memory) * 1024 * 1024,
+	}
+}
+
+// toDevcontainerRunArgs limits the devcontainer to the resources reserved for the project
+func toDevcontainerRunArgs(resources *project.Resources) []interface{} {
+	runArgs := []interface{}{}
+	if resources == nil {
+		return runArgs
+	}
+
+	if resources.Cpus > 0 {
+		runArgs = append(runArgs, "--cpus="+strconv.FormatFloat(resources.Cpus, 'f', -1, 64))
+	}
+	if resources.Memory > 0 {
+		runArgs = append(runArgs, fmt.Sprintf("--memory=%dm", resources.Memory))
+	}
+
+	return runArgs
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/api/types/system"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestGetHostCapacity() {
+	s.mockClient.On("Info", mock.Anything).Return(system.Info{NCPU: 8, MemTotal: 16 * 1024 * 1024 * 1024}, nil)
+
+	capacity, err := s.dockerClient.GetHostCapacity()
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), &project.Resources{Cpus: 8, Memory: 16 * 1024}, capacity)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/client"
+)
+
+func GetSharedServiceContainerName(service *sharedservice.SharedService) string {
+	return "daytona-service-" + service.Name
+}
+
+// CreateSharedService starts the service container on the default bridge network so that project containers
+// on the same Docker host can reach it by its IP address. The container is restarted with the Docker daemon.
+func (d *DockerClient) CreateSharedService(service *sharedservice.SharedService, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	err := d.PullImage(service.Image, cr, logWriter)
+	if err != nil {
+		return err
+	}
+
+	envVars := []string{}
+	for key, value := range service.EnvVars {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	containerName := GetSharedServiceContainerName(service)
+
+	mounts := []mount.Mount{}
+	if service.DataPath != "" {
+		mounts = append(mounts, mount.Mount{
+			Type:   mount.TypeVolume,
+			Source: containerName,
+			Target: service.DataPath,
+		})
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image: service.Image,
+		Env:   envVars,
+		Labels: map[string]string{
+			"daytona.shared-service.name": service.Name,
+		},
+	}, &container.HostConfig{
+		Mounts: mounts,
+		RestartPolicy: container.RestartPolicy{
+			Name: container.RestartPolicyUnlessStopped,
+		},
+	}, nil, nil, containerName)
+	if err != nil {
+		return err
+	}
+
+	return d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+}
+
+func (d *DockerClient) DestroySharedService(service *sharedservice.SharedService) error {
+	containerName := GetSharedServiceContainerName(service)
+
+	err := d.RemoveContainer(containerName)
+	if err != nil {
+		return err
+	}
+
+	err = d.apiClient.VolumeRemove(context.Background(), containerName, true)
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DockerClient) GetSharedServiceInfo(service *sharedservice.SharedService) (*sharedservice.SharedServiceInfo, error) {
+	serviceInfo := &sharedservice.SharedServiceInfo{
+		Name: service.Name,
+		Port: service.Port,
+	}
+
+	info, err := d.apiClient.ContainerInspect(context.Background(), GetSharedServiceContainerName(service))
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			serviceInfo.ProviderMetadata = ContainerNotFoundMetadata
+			return serviceInfo, nil
+		}
+		return nil, err
+	}
+
+	serviceInfo.IsRunning = info.State != nil && info.State.Running
+
+	if info.NetworkSettings != nil {
+		if bridge, ok := info.NetworkSettings.Networks["bridge"]; ok {
+			serviceInfo.Host = bridge.IPAddress
+		} else {
+			for _, network := range info.NetworkSettings.Networks {
+				serviceInfo.Host = network.IPAddress
+				break
+			}
+		}
+	}
+
+	return serviceInfo, nil
+}
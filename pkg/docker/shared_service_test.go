@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/network"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var sharedService1 = &sharedservice.SharedService{
+	Name:   "postgres",
+	Target: "local",
+	Image:  "postgres:16",
+	Port:   5432,
+}
+
+func (s *DockerClientTestSuite) TestGetSharedServiceInfo() {
+	inspectResult := types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: &types.ContainerState{
+				Running: true,
+			},
+		},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"bridge": {IPAddress: "172.17.0.5"},
+			},
+		},
+	}
+
+	s.mockClient.On("ContainerInspect", mock.Anything, docker.GetSharedServiceContainerName(sharedService1)).Return(inspectResult, nil)
+
+	info, err := s.dockerClient.GetSharedServiceInfo(sharedService1)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), &sharedservice.SharedServiceInfo{
+		Name:      "postgres",
+		IsRunning: true,
+		Host:      "172.17.0.5",
+		Port:      5432,
+	}, info)
+}
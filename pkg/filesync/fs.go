@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"io"
+	"io/fs"
+	"time"
+)
+
+// Suffix of the files content is written to before they are renamed over the synced file. They are never synced
+const tempFileSuffix = ".daytona-sync.tmp"
+
+// Entry is a file or directory of a synced tree
+type Entry struct {
+	// Path relative to the root of the tree, separated by slashes
+	Path  string      `json:"path"`
+	IsDir bool        `json:"isDir,omitempty"`
+	Size  int64       `json:"size,omitempty"`
+	Mode  fs.FileMode `json:"mode,omitempty"`
+	// Modification time in seconds, the precision of SFTP
+	ModTime int64 `json:"modTime,omitempty"`
+}
+
+// sameAs reports whether the entries are the same version of a path. The modification time of directories changes
+// with their content, so only their type is compared
+func (e Entry) sameAs(other Entry) bool {
+	if e.IsDir || other.IsDir {
+		return e.IsDir == other.IsDir
+	}
+
+	return e.Size == other.Size && e.ModTime == other.ModTime
+}
+
+// FS is one side of a sync. Symbolic links and special files are not synced
+type FS interface {
+	// Walk returns the entries of the tree that the ignore rules do not exclude, keyed by path
+	Walk(ignore *Ignore) (map[string]Entry, error)
+	Open(path string) (io.ReadCloser, error)
+	// Write replaces the file at the path with the content of r, creating its parent directories. Readers of the file
+	// never see partially written content
+	Write(path string, r io.Reader, mode fs.FileMode, modTime time.Time) error
+	Mkdir(path string, mode fs.FileMode) error
+	// Remove removes a file or an empty directory
+	Remove(path string) error
+	Rename(from, to string) error
+}
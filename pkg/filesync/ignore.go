@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// Ignore excludes paths from the sync with patterns in the .gitignore format
+type Ignore struct {
+	matcher gitignore.Matcher
+}
+
+func NewIgnore(patterns []string) *Ignore {
+	parsed := []gitignore.Pattern{}
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		if p == "" || strings.HasPrefix(p, "#") {
+			continue
+		}
+		parsed = append(parsed, gitignore.ParsePattern(p, nil))
+	}
+
+	return &Ignore{matcher: gitignore.NewMatcher(parsed)}
+}
+
+// Match reports whether the path, relative to the root of the tree and separated by slashes, is excluded
+func (i *Ignore) Match(path string, isDir bool) bool {
+	if strings.HasSuffix(path, tempFileSuffix) {
+		return true
+	}
+
+	if i == nil {
+		return false
+	}
+
+	return i.matcher.Match(strings.Split(path, "/"), isDir)
+}
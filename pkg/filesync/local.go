@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LocalFS is a tree of the local file system
+type LocalFS struct {
+	Root string
+}
+
+func (l *LocalFS) Walk(ignore *Ignore) (map[string]Entry, error) {
+	entries := map[string]Entry{}
+
+	err := filepath.WalkDir(l.Root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Removed while walking
+			if os.IsNotExist(err) && p != l.Root {
+				return nil
+			}
+			return err
+		}
+
+		if p == l.Root {
+			return nil
+		}
+
+		rel, err := filepath.Rel(l.Root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if ignore.Match(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		entries[rel] = Entry{
+			Path:    rel,
+			IsDir:   d.IsDir(),
+			Size:    sizeOf(info),
+			Mode:    info.Mode().Perm(),
+			ModTime: info.ModTime().Unix(),
+		}
+
+		return nil
+	})
+
+	return entries, err
+}
+
+func (l *LocalFS) Open(path string) (io.ReadCloser, error) {
+	return os.Open(l.path(path))
+}
+
+func (l *LocalFS) Write(path string, r io.Reader, mode fs.FileMode, modTime time.Time) error {
+	target := l.path(path)
+
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	tempPath := target + tempFileSuffix
+	f, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, r)
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempPath, mode)
+	}
+	if err == nil {
+		err = os.Chtimes(tempPath, modTime, modTime)
+	}
+	if err == nil {
+		err = os.Rename(tempPath, target)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+	}
+
+	return err
+}
+
+func (l *LocalFS) Mkdir(path string, mode fs.FileMode) error {
+	return os.MkdirAll(l.path(path), mode)
+}
+
+func (l *LocalFS) Remove(path string) error {
+	return os.Remove(l.path(path))
+}
+
+func (l *LocalFS) Rename(from, to string) error {
+	return os.Rename(l.path(from), l.path(to))
+}
+
+func (l *LocalFS) path(path string) string {
+	return filepath.Join(l.Root, filepath.FromSlash(path))
+}
+
+func sizeOf(info fs.FileInfo) int64 {
+	if info.IsDir() {
+		return 0
+	}
+
+	return info.Size()
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/sftp"
+)
+
+// SftpFS is a tree of a project, accessed through the SFTP subsystem of its SSH server
+type SftpFS struct {
+	Client *sftp.Client
+	Root   string
+}
+
+func (s *SftpFS) Walk(ignore *Ignore) (map[string]Entry, error) {
+	entries := map[string]Entry{}
+	root := path.Clean(s.Root)
+
+	walker := s.Client.Walk(root)
+	for walker.Step() {
+		p := walker.Path()
+
+		err := walker.Err()
+		if err != nil {
+			// Removed while walking
+			if os.IsNotExist(err) && p != root {
+				continue
+			}
+			return nil, err
+		}
+
+		if p == root {
+			continue
+		}
+
+		rel := strings.TrimPrefix(strings.TrimPrefix(p, root), "/")
+		info := walker.Stat()
+
+		if ignore.Match(rel, info.IsDir()) {
+			if info.IsDir() {
+				walker.SkipDir()
+			}
+			continue
+		}
+
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			continue
+		}
+
+		entries[rel] = Entry{
+			Path:    rel,
+			IsDir:   info.IsDir(),
+			Size:    sizeOf(info),
+			Mode:    info.Mode().Perm(),
+			ModTime: info.ModTime().Unix(),
+		}
+	}
+
+	return entries, nil
+}
+
+func (s *SftpFS) Open(p string) (io.ReadCloser, error) {
+	return s.Client.Open(s.path(p))
+}
+
+func (s *SftpFS) Write(p string, r io.Reader, mode fs.FileMode, modTime time.Time) error {
+	target := s.path(p)
+
+	err := s.Client.MkdirAll(path.Dir(target))
+	if err != nil {
+		return err
+	}
+
+	tempPath := target + tempFileSuffix
+	f, err := s.Client.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.ReadFrom(r)
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = s.Client.Chmod(tempPath, mode)
+	}
+	if err == nil {
+		err = s.Client.Chtimes(tempPath, modTime, modTime)
+	}
+	if err == nil {
+		// Unlike Rename, replaces the existing file
+		err = s.Client.PosixRename(tempPath, target)
+	}
+	if err != nil {
+		s.Client.Remove(tempPath)
+	}
+
+	return err
+}
+
+func (s *SftpFS) Mkdir(p string, mode fs.FileMode) error {
+	err := s.Client.MkdirAll(s.path(p))
+	if err != nil {
+		return err
+	}
+
+	return s.Client.Chmod(s.path(p), mode)
+}
+
+func (s *SftpFS) Remove(p string) error {
+	return s.Client.Remove(s.path(p))
+}
+
+func (s *SftpFS) Rename(from, to string) error {
+	return s.Client.PosixRename(s.path(from), s.path(to))
+}
+
+func (s *SftpFS) path(p string) string {
+	return path.Join(s.Root, p)
+}
@@ -0,0 +1,314 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// ConflictMode decides which version of a file changed on both sides is kept
+type ConflictMode string
+
+const (
+	// The version modified last wins
+	ConflictNewer ConflictMode = "newer"
+	ConflictLocal ConflictMode = "local"
+	// The version of the project wins
+	ConflictRemote ConflictMode = "remote"
+)
+
+func (m ConflictMode) Validate() error {
+	switch m {
+	case ConflictNewer, ConflictLocal, ConflictRemote:
+		return nil
+	}
+
+	return fmt.Errorf("invalid conflict mode %q, must be one of %s, %s, %s", m, ConflictNewer, ConflictLocal, ConflictRemote)
+}
+
+// Session syncs a local tree with a tree of a project in both directions. Changes are detected by comparing each side
+// with the entries of the last sync, so a path changed on one side only is copied or deleted on the other. A file
+// changed on both sides is a conflict: the losing version is kept next to it as a conflict copy, which is synced as well
+type Session struct {
+	Local    FS
+	Remote   FS
+	Ignore   *Ignore
+	Conflict ConflictMode
+	// StatePath is the file the entries of the last sync are persisted to, so that a restarted session does not
+	// take the changes made in between for conflicts
+	StatePath string
+
+	// Entries of the last sync
+	state map[string]Entry
+	// Paths changed to a directory on one side and to a file on the other that were reported already
+	reportedUnresolved map[string]bool
+}
+
+// Result lists the changes of a sync
+type Result struct {
+	// Paths written or deleted on the local side
+	LocalChanges []string
+	// Paths written or deleted on the remote side
+	RemoteChanges []string
+	// Paths of the conflict copies the losing versions were moved to
+	Conflicts []string
+	// Paths changed to a directory on one side and to a file on the other. They are left as they are until one side
+	// is changed to match the other
+	Unresolved []string
+}
+
+func (r *Result) Empty() bool {
+	return len(r.LocalChanges) == 0 && len(r.RemoteChanges) == 0 && len(r.Conflicts) == 0 && len(r.Unresolved) == 0
+}
+
+type side struct {
+	fs      FS
+	entries map[string]Entry
+	changes *[]string
+}
+
+// Sync reconciles the two sides once. Paths that fail to sync are retried by the next sync and their errors are
+// returned together with the changes that were made
+func (s *Session) Sync(now time.Time) (*Result, error) {
+	base, err := s.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	localEntries, err := s.Local.Walk(s.Ignore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list local files: %w", err)
+	}
+
+	remoteEntries, err := s.Remote.Walk(s.Ignore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote files: %w", err)
+	}
+
+	result := &Result{}
+	local := side{fs: s.Local, entries: localEntries, changes: &result.LocalChanges}
+	remote := side{fs: s.Remote, entries: remoteEntries, changes: &result.RemoteChanges}
+
+	paths := map[string]bool{}
+	for _, entries := range []map[string]Entry{base, localEntries, remoteEntries} {
+		for p := range entries {
+			paths[p] = true
+		}
+	}
+
+	sorted := []string{}
+	for p := range paths {
+		sorted = append(sorted, p)
+	}
+	// Parents are created before their children
+	sort.Strings(sorted)
+
+	if s.reportedUnresolved == nil {
+		s.reportedUnresolved = map[string]bool{}
+	}
+
+	state := map[string]Entry{}
+	// Deletions run after the copies, children before their parents
+	deletions := []func(){}
+	errs := []error{}
+
+	// Paths whose children are left as they are
+	skipped := map[string]bool{}
+
+	for _, p := range sorted {
+		baseEntry, inBase := base[p]
+
+		if skipped[path.Dir(p)] {
+			skipped[p] = true
+			if inBase {
+				state[p] = baseEntry
+			}
+			continue
+		}
+		localEntry, inLocal := localEntries[p]
+		remoteEntry, inRemote := remoteEntries[p]
+
+		localChanged := changed(localEntry, inLocal, baseEntry, inBase)
+		remoteChanged := changed(remoteEntry, inRemote, baseEntry, inBase)
+
+		var from, to side
+		switch {
+		case !localChanged && !remoteChanged:
+			if inBase {
+				state[p] = baseEntry
+			}
+			continue
+		case localChanged && !remoteChanged:
+			from, to = local, remote
+		case remoteChanged && !localChanged:
+			from, to = remote, local
+		case !inLocal && !inRemote:
+			// Deleted on both sides
+			continue
+		case inLocal && inRemote && localEntry.sameAs(remoteEntry):
+			state[p] = localEntry
+			continue
+		case !inLocal:
+			// A change wins over a deletion
+			from, to = remote, local
+		case !inRemote:
+			from, to = local, remote
+		case localEntry.IsDir != remoteEntry.IsDir:
+			if !s.reportedUnresolved[p] {
+				result.Unresolved = append(result.Unresolved, p)
+				s.reportedUnresolved[p] = true
+			}
+			skipped[p] = true
+			if inBase {
+				state[p] = baseEntry
+			}
+			continue
+		default:
+			from, to = s.resolveConflict(local, remote, p)
+
+			conflictPath := fmt.Sprintf("%s.sync-conflict-%s", p, now.Format("20060102-150405"))
+			err := to.fs.Rename(p, conflictPath)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to keep conflicting version of %s: %w", p, err))
+				if inBase {
+					state[p] = baseEntry
+				}
+				continue
+			}
+			result.Conflicts = append(result.Conflicts, conflictPath)
+		}
+
+		delete(s.reportedUnresolved, p)
+
+		entry, exists := from.entries[p]
+		if !exists {
+			deletions = append(deletions, func() {
+				err := to.fs.Remove(p)
+				if err != nil && !os.IsNotExist(err) {
+					// Directories that are not empty, e.g. because they contain ignored files, are kept and synced back
+					if !to.entries[p].IsDir {
+						errs = append(errs, fmt.Errorf("failed to delete %s: %w", p, err))
+						state[p] = baseEntry
+					}
+					return
+				}
+				*to.changes = append(*to.changes, p)
+			})
+			continue
+		}
+
+		err := s.copy(from.fs, to.fs, entry)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to sync %s: %w", p, err))
+			if inBase {
+				state[p] = baseEntry
+			}
+			continue
+		}
+
+		*to.changes = append(*to.changes, p)
+		state[p] = entry
+	}
+
+	for i := len(deletions) - 1; i >= 0; i-- {
+		deletions[i]()
+	}
+
+	err = s.saveState(state)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func (s *Session) resolveConflict(local, remote side, p string) (from, to side) {
+	switch s.Conflict {
+	case ConflictLocal:
+		return local, remote
+	case ConflictRemote:
+		return remote, local
+	}
+
+	if local.entries[p].ModTime >= remote.entries[p].ModTime {
+		return local, remote
+	}
+
+	return remote, local
+}
+
+func (s *Session) copy(from, to FS, entry Entry) error {
+	if entry.IsDir {
+		return to.Mkdir(entry.Path, entry.Mode)
+	}
+
+	r, err := from.Open(entry.Path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return to.Write(entry.Path, r, entry.Mode, time.Unix(entry.ModTime, 0))
+}
+
+// changed reports whether a side differs from the last sync
+func changed(entry Entry, exists bool, baseEntry Entry, inBase bool) bool {
+	if exists != inBase {
+		return true
+	}
+
+	return exists && !entry.sameAs(baseEntry)
+}
+
+func (s *Session) loadState() (map[string]Entry, error) {
+	if s.state != nil {
+		return s.state, nil
+	}
+
+	state := map[string]Entry{}
+	if s.StatePath == "" {
+		return state, nil
+	}
+
+	content, err := os.ReadFile(s.StatePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal(content, &state)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync state %s: %w", s.StatePath, err)
+	}
+
+	return state, nil
+}
+
+func (s *Session) saveState(state map[string]Entry) error {
+	s.state = state
+	if s.StatePath == "" {
+		return nil
+	}
+
+	content, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.StatePath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.StatePath, content, 0644)
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package filesync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionSync(t *testing.T) {
+	localRoot := t.TempDir()
+	remoteRoot := t.TempDir()
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	newSession := func() *Session {
+		return &Session{
+			Local:     &LocalFS{Root: localRoot},
+			Remote:    &LocalFS{Root: remoteRoot},
+			Ignore:    NewIgnore([]string{"node_modules/", "*.log"}),
+			Conflict:  ConflictNewer,
+			StatePath: statePath,
+		}
+	}
+	session := newSession()
+
+	writeFile(t, localRoot, "main.go", "package main", now.Add(-time.Hour))
+	writeFile(t, remoteRoot, "docs/README.md", "# Docs", now.Add(-time.Hour))
+	writeFile(t, localRoot, "node_modules/pkg/index.js", "", now)
+	writeFile(t, remoteRoot, "debug.log", "", now)
+
+	result, err := session.Sync(now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs", "docs/README.md"}, result.LocalChanges)
+	require.Equal(t, []string{"main.go"}, result.RemoteChanges)
+	requireFile(t, remoteRoot, "main.go", "package main")
+	requireFile(t, localRoot, "docs/README.md", "# Docs")
+	require.NoFileExists(t, filepath.Join(remoteRoot, "node_modules/pkg/index.js"))
+	require.NoFileExists(t, filepath.Join(localRoot, "debug.log"))
+
+	// Changes on one side are applied to the other
+	writeFile(t, localRoot, "main.go", "package main\n\nfunc main() {}", now.Add(-time.Minute))
+	require.NoError(t, os.RemoveAll(filepath.Join(remoteRoot, "docs")))
+
+	result, err = session.Sync(now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs/README.md", "docs"}, result.LocalChanges)
+	require.Equal(t, []string{"main.go"}, result.RemoteChanges)
+	requireFile(t, remoteRoot, "main.go", "package main\n\nfunc main() {}")
+	require.NoDirExists(t, filepath.Join(localRoot, "docs"))
+
+	// The newer version of a file changed on both sides wins, the other one is kept as a conflict copy
+	writeFile(t, localRoot, "main.go", "local", now.Add(-2*time.Second))
+	writeFile(t, remoteRoot, "main.go", "remote", now.Add(-time.Second))
+
+	result, err = session.Sync(now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"main.go.sync-conflict-20241001-120000"}, result.Conflicts)
+	requireFile(t, localRoot, "main.go", "remote")
+	requireFile(t, localRoot, "main.go.sync-conflict-20241001-120000", "local")
+
+	result, err = session.Sync(now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"main.go.sync-conflict-20241001-120000"}, result.RemoteChanges)
+
+	// A restarted session continues from the persisted state
+	session = newSession()
+	result, err = session.Sync(now)
+	require.NoError(t, err)
+	require.True(t, result.Empty())
+}
+
+func TestSessionSyncUnresolved(t *testing.T) {
+	localRoot := t.TempDir()
+	remoteRoot := t.TempDir()
+	now := time.Now()
+
+	session := &Session{
+		Local:    &LocalFS{Root: localRoot},
+		Remote:   &LocalFS{Root: remoteRoot},
+		Conflict: ConflictLocal,
+	}
+
+	writeFile(t, localRoot, "build", "", now)
+	writeFile(t, remoteRoot, "build/out", "", now)
+
+	result, err := session.Sync(now)
+	require.NoError(t, err)
+	require.Equal(t, []string{"build"}, result.Unresolved)
+	require.FileExists(t, filepath.Join(localRoot, "build"))
+
+	// Reported once
+	result, err = session.Sync(now)
+	require.NoError(t, err)
+	require.Empty(t, result.Unresolved)
+}
+
+func TestConflictModeValidate(t *testing.T) {
+	require.NoError(t, ConflictRemote.Validate())
+	require.Error(t, ConflictMode("theirs").Validate())
+}
+
+func writeFile(t *testing.T, root, path, content string, modTime time.Time) {
+	t.Helper()
+
+	p := filepath.Join(root, filepath.FromSlash(path))
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	require.NoError(t, os.Chtimes(p, modTime, modTime))
+}
+
+func requireFile(t *testing.T, root, path, content string) {
+	t.Helper()
+
+	actual, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+	require.NoError(t, err)
+	require.Equal(t, content, string(actual))
+}
@@ -59,3 +59,42 @@ func GetService(params FrpcConnectParams) (HealthCheckFunc, *client.Service, err
 		return nil
 	}, service, nil
 }
+
+type HttpsProxyParams struct {
+	Name      string
+	SubDomain string
+	// Address plain HTTP requests are passed on to once TLS is terminated. The host of the requests is kept
+	LocalAddr string
+	CertPath  string
+	KeyPath   string
+}
+
+// GetHttpsProxyConfig returns the config of a proxy that terminates TLS for the subdomain with the certificate
+func GetHttpsProxyConfig(params HttpsProxyParams) v1.ProxyConfigurer {
+	httpsConfig := &v1.HTTPSProxyConfig{}
+	httpsConfig.GetBaseConfig().Name = params.Name
+	httpsConfig.GetBaseConfig().Type = string(v1.ProxyTypeHTTPS)
+	httpsConfig.GetBaseConfig().Plugin = v1.TypedClientPluginOptions{
+		Type: v1.PluginHTTPS2HTTP,
+		ClientPluginOptions: &v1.HTTPS2HTTPPluginOptions{
+			Type:      v1.PluginHTTPS2HTTP,
+			LocalAddr: params.LocalAddr,
+			CrtPath:   params.CertPath,
+			KeyPath:   params.KeyPath,
+		},
+	}
+	httpsConfig.SubDomain = params.SubDomain
+
+	return httpsConfig
+}
+
+// NewService returns a service that registers the proxies with the frps server
+func NewService(serverDomain string, serverPort int, proxies []v1.ProxyConfigurer) (*client.Service, error) {
+	cfg := client.ServiceOptions{}
+	cfg.Common = &v1.ClientCommonConfig{}
+	cfg.Common.ServerAddr = serverDomain
+	cfg.Common.ServerPort = serverPort
+	cfg.ProxyCfgs = proxies
+
+	return client.NewService(cfg)
+}
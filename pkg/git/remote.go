@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+var ErrRemoteFileNotFound = errors.New("file not found in the repository")
+
+// ListRemoteBranches lists the branches of the repository without cloning it
+func ListRemoteBranches(ctx context.Context, url string, auth *http.BasicAuth) ([]string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &config.RemoteConfig{
+		Name: "origin",
+		URLs: []string{url},
+	})
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{
+		Auth:            auth,
+		InsecureSkipTLS: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	branches := []string{}
+	for _, ref := range refs {
+		if ref.Name().IsBranch() {
+			branches = append(branches, ref.Name().Short())
+		}
+	}
+
+	return branches, nil
+}
+
+// ReadRemoteFile reads a file from the latest commit of the repository branch. Only the latest commit is fetched
+// into memory and nothing is checked out
+func ReadRemoteFile(ctx context.Context, repo *gitprovider.GitRepository, path string, auth *http.BasicAuth) ([]byte, error) {
+	cloneOptions := &git.CloneOptions{
+		URL:             repo.Url,
+		Auth:            auth,
+		SingleBranch:    true,
+		Depth:           1,
+		NoCheckout:      true,
+		InsecureSkipTLS: true,
+	}
+
+	if repo.Branch != "" {
+		cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(repo.Branch)
+	}
+
+	r, err := git.CloneContext(ctx, memory.NewStorage(), nil, cloneOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := r.CommitObject(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := commit.File(path)
+	if err != nil {
+		if errors.Is(err, object.ErrFileNotFound) {
+			return nil, ErrRemoteFileNotFound
+		}
+		return nil, err
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
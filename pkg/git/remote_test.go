@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemote(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GIT_AUTHOR_NAME", "Daytona")
+	t.Setenv("GIT_AUTHOR_EMAIL", "daytona@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Daytona")
+	t.Setenv("GIT_COMMITTER_EMAIL", "daytona@example.com")
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	upstreamDir := filepath.Join(dir, "upstream")
+	otherDir := filepath.Join(dir, "other")
+
+	runGit(t, dir, "init", "--quiet", "--bare", "--initial-branch=main", upstreamDir)
+	runGit(t, dir, "clone", "--quiet", upstreamDir, otherDir)
+	commitFile(t, otherDir, "README.md", "main")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+	commitFile(t, otherDir, "README.md", "feature")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:feature")
+
+	url := "file://" + upstreamDir
+
+	branches, err := git.ListRemoteBranches(ctx, url, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"main", "feature"}, branches)
+
+	_, err = git.ListRemoteBranches(ctx, "file://"+filepath.Join(dir, "missing"), nil)
+	require.Error(t, err)
+
+	content, err := git.ReadRemoteFile(ctx, &gitprovider.GitRepository{Url: url, Branch: "feature"}, "README.md", nil)
+	require.NoError(t, err)
+	require.Equal(t, "feature", string(content))
+
+	content, err = git.ReadRemoteFile(ctx, &gitprovider.GitRepository{Url: url}, "README.md", nil)
+	require.NoError(t, err)
+	require.Equal(t, "main", string(content))
+
+	_, err = git.ReadRemoteFile(ctx, &gitprovider.GitRepository{Url: url, Branch: "main"}, ".devcontainer/devcontainer.json", nil)
+	require.ErrorIs(t, err, git.ErrRemoteFileNotFound)
+}
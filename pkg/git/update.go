@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// FetchUpstream fetches the upstream branch of the checked out branch, so that the git status reports
+// how far behind the branch is
+func (s *Service) FetchUpstream(ctx context.Context) error {
+	upstream, err := s.getUpstreamBranch()
+	if err != nil {
+		return err
+	}
+	if upstream == "" {
+		return project.ErrNoUpstreamBranch
+	}
+
+	_, err = s.runGit(ctx, "fetch", "--quiet")
+	return err
+}
+
+// GetUpstreamChanges returns the files under the paths that changed on the upstream branch since the checked out
+// branch diverged from it, i.e. the changes an update of the branch brings in. The upstream branch is not fetched
+func (s *Service) GetUpstreamChanges(ctx context.Context, paths []string) ([]string, error) {
+	upstream, err := s.getUpstreamBranch()
+	if err != nil {
+		return nil, err
+	}
+	if upstream == "" {
+		return nil, project.ErrNoUpstreamBranch
+	}
+
+	out, err := s.runGit(ctx, append([]string{"diff", "--name-only", "HEAD...@{upstream}", "--"}, paths...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if out == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(out, "\n"), nil
+}
+
+// UpdateBranch fetches the upstream branch and brings the checked out branch up to date with it. Local changes
+// are stashed during the update. A rebase that conflicts is aborted and the conflicting files are reported
+func (s *Service) UpdateBranch(ctx context.Context, strategy project.BranchUpdateStrategy) (*project.BranchUpdate, error) {
+	err := strategy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.FetchUpstream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	branch, err := s.runGit(ctx, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	upstream, err := s.getUpstreamBranch()
+	if err != nil {
+		return nil, err
+	}
+
+	previousSha, err := s.runGit(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	update := &project.BranchUpdate{
+		Branch:      branch,
+		Upstream:    upstream,
+		Strategy:    strategy,
+		PreviousSha: previousSha,
+	}
+
+	switch strategy {
+	case project.BranchUpdateStrategyFastForward:
+		_, err = s.runGit(ctx, "merge", "--ff-only", "--autostash", "@{upstream}")
+		if err != nil {
+			// Fast-forwarding only fails on its own if the branches diverged
+			if _, mergeBaseErr := s.runGit(ctx, "merge-base", "--is-ancestor", "HEAD", "@{upstream}"); mergeBaseErr != nil {
+				return nil, project.ErrBranchDiverged
+			}
+			return nil, err
+		}
+	case project.BranchUpdateStrategyRebase:
+		_, err = s.runGit(ctx, "rebase", "--autostash", "@{upstream}")
+		if err != nil {
+			conflicts, conflictsErr := s.runGit(ctx, "diff", "--name-only", "--diff-filter=U")
+			if conflictsErr != nil || conflicts == "" {
+				return nil, err
+			}
+
+			_, err = s.runGit(ctx, "rebase", "--abort")
+			if err != nil {
+				return nil, fmt.Errorf("failed to abort the conflicting rebase: %w", err)
+			}
+
+			update.Conflicts = strings.Split(conflicts, "\n")
+		}
+	}
+
+	update.Sha, err = s.runGit(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	return update, nil
+}
+
+// runGit runs the git command in the project directory and returns its trimmed output
+func (s *Service) runGit(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", s.ProjectDir}, args...)...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
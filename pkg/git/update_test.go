@@ -0,0 +1,147 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBranch(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GIT_AUTHOR_NAME", "Daytona")
+	t.Setenv("GIT_AUTHOR_EMAIL", "daytona@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Daytona")
+	t.Setenv("GIT_COMMITTER_EMAIL", "daytona@example.com")
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	upstreamDir := filepath.Join(dir, "upstream")
+	projectDir := filepath.Join(dir, "project")
+	otherDir := filepath.Join(dir, "other")
+
+	runGit(t, dir, "init", "--quiet", "--bare", "--initial-branch=main", upstreamDir)
+	runGit(t, dir, "clone", "--quiet", upstreamDir, otherDir)
+	commitFile(t, otherDir, "README.md", "initial")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+	runGit(t, dir, "clone", "--quiet", upstreamDir, projectDir)
+
+	service := &git.Service{ProjectDir: projectDir}
+
+	_, err := service.UpdateBranch(ctx, "merge")
+	require.ErrorIs(t, err, project.ErrInvalidBranchUpdateStrategy)
+
+	// The upstream branch moves ahead and the project is fast-forwarded
+	commitFile(t, otherDir, "README.md", "second")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+
+	require.NoError(t, service.FetchUpstream(ctx))
+	status, err := service.GetGitStatus()
+	require.NoError(t, err)
+	require.Equal(t, 1, status.Behind)
+
+	update, err := service.UpdateBranch(ctx, project.BranchUpdateStrategyFastForward)
+	require.NoError(t, err)
+	require.True(t, update.Updated())
+	require.Equal(t, "main", update.Branch)
+	require.Equal(t, "origin/main", update.Upstream)
+	require.Empty(t, update.Conflicts)
+
+	// Both branches change the same file, so fast-forwarding is impossible and rebasing conflicts
+	commitFile(t, otherDir, "README.md", "upstream change")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+	commitFile(t, projectDir, "README.md", "local change")
+
+	_, err = service.UpdateBranch(ctx, project.BranchUpdateStrategyFastForward)
+	require.ErrorIs(t, err, project.ErrBranchDiverged)
+
+	update, err = service.UpdateBranch(ctx, project.BranchUpdateStrategyRebase)
+	require.NoError(t, err)
+	require.False(t, update.Updated())
+	require.Equal(t, []string{"README.md"}, update.Conflicts)
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "local change", string(content))
+
+	// Changes to other files are rebased onto the upstream branch
+	runGit(t, projectDir, "reset", "--quiet", "--hard", "HEAD~1")
+	commitFile(t, projectDir, "main.go", "package main")
+
+	update, err = service.UpdateBranch(ctx, project.BranchUpdateStrategyRebase)
+	require.NoError(t, err)
+	require.True(t, update.Updated())
+	require.Empty(t, update.Conflicts)
+
+	status, err = service.GetGitStatus()
+	require.NoError(t, err)
+	require.Equal(t, 0, status.Behind)
+	require.Equal(t, 1, status.Ahead)
+}
+
+func TestGetUpstreamChanges(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("GIT_AUTHOR_NAME", "Daytona")
+	t.Setenv("GIT_AUTHOR_EMAIL", "daytona@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "Daytona")
+	t.Setenv("GIT_COMMITTER_EMAIL", "daytona@example.com")
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	upstreamDir := filepath.Join(dir, "upstream")
+	projectDir := filepath.Join(dir, "project")
+	otherDir := filepath.Join(dir, "other")
+
+	runGit(t, dir, "init", "--quiet", "--bare", "--initial-branch=main", upstreamDir)
+	runGit(t, dir, "clone", "--quiet", upstreamDir, otherDir)
+	commitFile(t, otherDir, "README.md", "initial")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+	runGit(t, dir, "clone", "--quiet", upstreamDir, projectDir)
+
+	service := &git.Service{ProjectDir: projectDir}
+
+	require.NoError(t, os.Mkdir(filepath.Join(otherDir, ".devcontainer"), 0755))
+	commitFile(t, otherDir, ".devcontainer/devcontainer.json", "{}")
+	commitFile(t, otherDir, "README.md", "second")
+	runGit(t, otherDir, "push", "--quiet", "origin", "HEAD:main")
+
+	// Changes are only known once the upstream branch is fetched
+	changes, err := service.GetUpstreamChanges(ctx, []string{".devcontainer"})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	require.NoError(t, service.FetchUpstream(ctx))
+
+	changes, err = service.GetUpstreamChanges(ctx, []string{".devcontainer", ".devcontainer.json"})
+	require.NoError(t, err)
+	require.Equal(t, []string{".devcontainer/devcontainer.json"}, changes)
+
+	// Local commits on the checked out branch are not upstream changes
+	commitFile(t, projectDir, "main.go", "package main")
+	changes, err = service.GetUpstreamChanges(ctx, []string{"main.go"})
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	runGit(t, projectDir, "checkout", "--quiet", "-b", "feature")
+	_, err = service.GetUpstreamChanges(ctx, []string{".devcontainer"})
+	require.ErrorIs(t, err, project.ErrNoUpstreamBranch)
+}
+
+func commitFile(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "--quiet", "-m", "Update "+name)
+}
+
+func runGit(t *testing.T, dir string, args ...string) {
+	out, err := exec.Command("git", append([]string{"-C", dir}, args...)...).CombinedOutput()
+	require.NoError(t, err, string(out))
+}
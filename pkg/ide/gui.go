@@ -0,0 +1,184 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ide
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/pkg/browser"
+	log "github.com/sirupsen/logrus"
+)
+
+type GuiBackend string
+
+const (
+	GuiBackendXpra GuiBackend = "xpra"
+	GuiBackendVnc  GuiBackend = "vnc"
+)
+
+const (
+	// X display used by GUI applications started in the project
+	GuiDisplay = ":99"
+
+	xpraPort   = 14500
+	noVncPort  = 6080
+	vncPort    = 5900
+	guiLogFile = "/tmp/daytona-gui.log"
+)
+
+type GuiOptions struct {
+	Backend GuiBackend
+	// Only print the forwarded address instead of opening the browser
+	NoBrowser bool
+	// Install missing packages without prompting
+	Yes bool
+}
+
+type guiBackendCommands struct {
+	binary   string
+	packages map[string]string
+	start    string
+	webPort  uint16
+	webPath  string
+}
+
+var guiBackends = map[GuiBackend]guiBackendCommands{
+	GuiBackendXpra: {
+		binary: "xpra",
+		packages: map[string]string{
+			"apt-get": "sudo apt-get update && sudo DEBIAN_FRONTEND=noninteractive apt-get install -y xpra xvfb",
+			"yum":     "sudo yum install -y xpra",
+		},
+		start:   fmt.Sprintf("xpra list 2>/dev/null | grep -q 'LIVE session at %[1]s' || xpra start %[1]s --bind-tcp=127.0.0.1:%[2]d --html=on --daemon=yes --mdns=no --notifications=no --log-file=%[3]s", GuiDisplay, xpraPort, guiLogFile),
+		webPort: xpraPort,
+		webPath: "/",
+	},
+	GuiBackendVnc: {
+		binary: "x11vnc",
+		packages: map[string]string{
+			"apt-get": "sudo apt-get update && sudo DEBIAN_FRONTEND=noninteractive apt-get install -y xvfb x11vnc novnc websockify",
+			"yum":     "sudo yum install -y xorg-x11-server-Xvfb x11vnc novnc python3-websockify",
+		},
+		// The VNC server has no password. It only listens on localhost, which the agent forwards tailnet connections to
+		start: fmt.Sprintf(`(pgrep -f "Xvfb %[1]s" >/dev/null || (nohup Xvfb %[1]s -nolisten tcp -screen 0 1920x1080x24 >>%[4]s 2>&1 &)) && sleep 1 && `+
+			`(pgrep x11vnc >/dev/null || x11vnc -display %[1]s -forever -shared -nopw -localhost -rfbport %[2]d -bg -o %[4]s) && `+
+			`(pgrep -f "websockify.*%[3]d" >/dev/null || (nohup websockify --web=/usr/share/novnc 127.0.0.1:%[3]d 127.0.0.1:%[2]d >>%[4]s 2>&1 &))`,
+			GuiDisplay, vncPort, noVncPort, guiLogFile),
+		webPort: noVncPort,
+		webPath: "/vnc.html?autoconnect=true&resize=remote",
+	},
+}
+
+// OpenGUI starts a virtual display with a VNC or Xpra server in the project, installing the server if needed,
+// and forwards its web client over the tailnet
+func OpenGUI(activeProfile config.Profile, workspaceId, projectName string, opts GuiOptions, gpgKey string) error {
+	backend, ok := guiBackends[opts.Backend]
+	if !ok {
+		return fmt.Errorf("unsupported GUI backend %s", opts.Backend)
+	}
+
+	err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName, gpgKey)
+	if err != nil {
+		return err
+	}
+
+	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
+
+	err = ensureGuiBackendInstalled(projectHostname, opts.Backend, backend, opts.Yes)
+	if err != nil {
+		return err
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Starting %s server on display %s...", opts.Backend, GuiDisplay))
+	err = runRemoteCommand(projectHostname, backend.start)
+	if err != nil {
+		return fmt.Errorf("failed to start the %s server, see %s in the project for details: %w", opts.Backend, guiLogFile, err)
+	}
+
+	webPort, errChan := tailscale.ForwardPort(workspaceId, projectName, backend.webPort, activeProfile)
+	if webPort == nil {
+		if err := <-errChan; err != nil {
+			return err
+		}
+	}
+	waitForPort(*webPort)
+
+	guiUrl := fmt.Sprintf("http://localhost:%d%s", *webPort, backend.webPath)
+	message := fmt.Sprintf("GUI of %s available at %s\nStart applications in the project with DISPLAY=%s, e.g. `DISPLAY=%s xterm &`", projectName, guiUrl, GuiDisplay, GuiDisplay)
+
+	if opts.Backend == GuiBackendVnc {
+		// Native VNC clients connect to the VNC server directly
+		vncHostPort, vncErrChan := tailscale.ForwardPort(workspaceId, projectName, vncPort, activeProfile)
+		if vncHostPort != nil {
+			message += fmt.Sprintf("\nVNC clients can connect to localhost:%d", *vncHostPort)
+			go func() {
+				for err := range vncErrChan {
+					log.Debug(err)
+				}
+			}()
+		}
+	}
+
+	views.RenderInfoMessageBold(message)
+
+	if !opts.NoBrowser {
+		err = browser.OpenURL(guiUrl)
+		if err != nil {
+			log.Error("Error opening URL: " + err.Error())
+		}
+	}
+
+	for {
+		err := <-errChan
+		if err != nil {
+			// Connection errors to the forwarded port should not exit the process
+			log.Debug(err)
+		}
+	}
+}
+
+func ensureGuiBackendInstalled(hostname string, name GuiBackend, backend guiBackendCommands, yes bool) error {
+	if runRemoteCommand(hostname, fmt.Sprintf("command -v %s >/dev/null", backend.binary)) == nil {
+		return nil
+	}
+
+	if !yes {
+		var confirmInstall bool
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title(fmt.Sprintf("Would you like to install %s in the project?", name)).
+					Description("A display server is required to run GUI applications in the project.").
+					Value(&confirmInstall),
+			),
+		).WithTheme(views.GetCustomTheme())
+
+		err := form.Run()
+		if err != nil {
+			return err
+		}
+
+		if !confirmInstall {
+			return fmt.Errorf("%s is required but not installed", name)
+		}
+	}
+
+	packageManager, err := detectPackageManager(hostname)
+	if err != nil {
+		return err
+	}
+
+	installCmd, ok := backend.packages[packageManager]
+	if !ok {
+		return errors.New("installing the GUI backend is not supported by the package manager " + packageManager)
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Installing %s using %s...", name, packageManager))
+	return runRemoteCommand(hostname, installCmd)
+}
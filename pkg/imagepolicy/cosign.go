@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+type SignatureVerifier interface {
+	// Verify returns the digest of the manifest whose signature was verified
+	Verify(image, publicKey string) (string, error)
+}
+
+// CosignVerifier verifies image signatures using the cosign binary available on the server host
+type CosignVerifier struct{}
+
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func (v *CosignVerifier) Verify(image, publicKey string) (string, error) {
+	if publicKey == "" {
+		return "", errors.New("cosign public key is not configured")
+	}
+
+	cosignPath, err := exec.LookPath("cosign")
+	if err != nil {
+		return "", errors.New("cosign binary not found in PATH")
+	}
+
+	cmd := exec.Command(cosignPath, "verify", "--key", publicKey, "--output", "json", image)
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s", strings.TrimSpace(stderr.String()))
+	}
+
+	return parseVerifiedDigest(output)
+}
+
+// parseVerifiedDigest returns the manifest digest the signatures in the cosign output were verified against
+func parseVerifiedDigest(output []byte) (string, error) {
+	var payloads []cosignPayload
+	err := json.Unmarshal(output, &payloads)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse cosign output: %w", err)
+	}
+
+	if len(payloads) == 0 {
+		return "", errors.New("cosign verified no signatures")
+	}
+
+	digest := payloads[0].Critical.Image.DockerManifestDigest
+	if digest == "" {
+		return "", errors.New("cosign output does not contain the verified digest")
+	}
+
+	for _, p := range payloads[1:] {
+		if p.Critical.Image.DockerManifestDigest != digest {
+			return "", errors.New("cosign verified signatures of different digests")
+		}
+	}
+
+	return digest, nil
+}
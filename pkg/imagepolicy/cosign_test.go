@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVerifiedDigest(t *testing.T) {
+	digest, err := parseVerifiedDigest([]byte(`[{"critical":{"identity":{"docker-reference":"ghcr.io/daytonaio/workspace-project"},"image":{"docker-manifest-digest":"sha256:abc"},"type":"cosign container image signature"},"optional":null}]`))
+	require.NoError(t, err)
+	require.Equal(t, "sha256:abc", digest)
+
+	_, err = parseVerifiedDigest([]byte(`[]`))
+	require.Error(t, err)
+
+	_, err = parseVerifiedDigest([]byte(`[{"critical":{"image":{"docker-manifest-digest":"sha256:abc"}}},{"critical":{"image":{"docker-manifest-digest":"sha256:def"}}}]`))
+	require.Error(t, err)
+}
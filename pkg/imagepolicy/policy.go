@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrImageNotAllowed = errors.New("image is not allowed by the server image policy")
+
+func IsImageNotAllowed(err error) bool {
+	return errors.Is(err, ErrImageNotAllowed)
+}
+
+type IImagePolicy interface {
+	// Validate returns the reference of the image to use. Images with verified signatures are pinned to the verified
+	// digest so that the tag can not be moved to another image between the check and the pull
+	Validate(image string) (string, error)
+}
+
+type ImagePolicyConfig struct {
+	// Registries images are allowed to be pulled from. An empty list allows all registries.
+	AllowedRegistries []string
+	VerifySignatures  bool
+	// Cosign key reference - a file path, URL or KMS URI
+	CosignPublicKey string
+	Verifier        SignatureVerifier
+}
+
+func NewImagePolicy(config ImagePolicyConfig) IImagePolicy {
+	verifier := config.Verifier
+	if verifier == nil {
+		verifier = &CosignVerifier{}
+	}
+
+	return &ImagePolicy{
+		allowedRegistries: config.AllowedRegistries,
+		verifySignatures:  config.VerifySignatures,
+		cosignPublicKey:   config.CosignPublicKey,
+		verifier:          verifier,
+	}
+}
+
+type ImagePolicy struct {
+	allowedRegistries []string
+	verifySignatures  bool
+	cosignPublicKey   string
+	verifier          SignatureVerifier
+}
+
+func (p *ImagePolicy) Validate(image string) (string, error) {
+	if len(p.allowedRegistries) > 0 {
+		registry := GetImageRegistry(image)
+		if !p.isRegistryAllowed(registry) {
+			return "", fmt.Errorf("%w: registry %s is not in the allowlist", ErrImageNotAllowed, registry)
+		}
+	}
+
+	if !p.verifySignatures {
+		return image, nil
+	}
+
+	digest, err := p.verifier.Verify(image, p.cosignPublicKey)
+	if err != nil {
+		return "", fmt.Errorf("%w: signature verification failed for %s: %s", ErrImageNotAllowed, image, err)
+	}
+
+	return PinDigest(image, digest)
+}
+
+func (p *ImagePolicy) isRegistryAllowed(registry string) bool {
+	for _, allowed := range p.allowedRegistries {
+		allowed = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(allowed, "https://"), "http://"), "/")
+		if allowed == "*" || allowed == registry {
+			return true
+		}
+
+		// Allow matching on subdomains, e.g. *.azurecr.io
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(registry, allowed[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PinDigest returns the image reference pinned to the digest. An image that is already pinned must be pinned to the
+// same digest
+func PinDigest(image, digest string) (string, error) {
+	name, pinned, found := strings.Cut(image, "@")
+	if found {
+		if pinned != digest {
+			return "", fmt.Errorf("%w: %s was verified as %s", ErrImageNotAllowed, image, digest)
+		}
+		return image, nil
+	}
+
+	return name + "@" + digest, nil
+}
+
+// GetImageRegistry returns the registry host of an image reference, defaulting to docker.io
+func GetImageRegistry(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 1 {
+		return "docker.io"
+	}
+
+	if strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost" {
+		return parts[0]
+	}
+
+	return "docker.io"
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/imagepolicy"
+	"github.com/stretchr/testify/require"
+)
+
+const verifiedDigest = "sha256:4e07f3bd88fb4a468d5fbcc1a0b1d7e7e7a56c4d6fd6a6c4e5b2c1d2e3f4a5b6"
+
+type mockVerifier struct {
+	verified []string
+	err      error
+}
+
+func (v *mockVerifier) Verify(image, publicKey string) (string, error) {
+	v.verified = append(v.verified, image)
+	return verifiedDigest, v.err
+}
+
+func TestGetImageRegistry(t *testing.T) {
+	require.Equal(t, "docker.io", imagepolicy.GetImageRegistry("ubuntu:22.04"))
+	require.Equal(t, "docker.io", imagepolicy.GetImageRegistry("daytonaio/workspace-project:latest"))
+	require.Equal(t, "ghcr.io", imagepolicy.GetImageRegistry("ghcr.io/daytonaio/workspace-project"))
+	require.Equal(t, "localhost:3000", imagepolicy.GetImageRegistry("localhost:3000/daytona/build"))
+}
+
+func TestAllowedRegistries(t *testing.T) {
+	policy := imagepolicy.NewImagePolicy(imagepolicy.ImagePolicyConfig{
+		AllowedRegistries: []string{"ghcr.io", "*.azurecr.io"},
+	})
+
+	image, err := policy.Validate("ghcr.io/daytonaio/workspace-project")
+	require.Nil(t, err)
+	require.Equal(t, "ghcr.io/daytonaio/workspace-project", image)
+
+	_, err = policy.Validate("myorg.azurecr.io/base:1.0")
+	require.Nil(t, err)
+
+	_, err = policy.Validate("ubuntu:22.04")
+	require.True(t, imagepolicy.IsImageNotAllowed(err))
+}
+
+func TestSignatureVerification(t *testing.T) {
+	verifier := &mockVerifier{}
+	policy := imagepolicy.NewImagePolicy(imagepolicy.ImagePolicyConfig{
+		VerifySignatures: true,
+		CosignPublicKey:  "cosign.pub",
+		Verifier:         verifier,
+	})
+
+	image, err := policy.Validate("ghcr.io/daytonaio/workspace-project:1.0")
+	require.Nil(t, err)
+	require.Equal(t, []string{"ghcr.io/daytonaio/workspace-project:1.0"}, verifier.verified)
+	// The verified digest is used instead of the mutable tag
+	require.Equal(t, "ghcr.io/daytonaio/workspace-project:1.0@"+verifiedDigest, image)
+
+	image, err = policy.Validate(image)
+	require.Nil(t, err)
+	require.Equal(t, "ghcr.io/daytonaio/workspace-project:1.0@"+verifiedDigest, image)
+
+	_, err = policy.Validate("ghcr.io/daytonaio/workspace-project@sha256:0000000000000000000000000000000000000000000000000000000000000000")
+	require.True(t, imagepolicy.IsImageNotAllowed(err))
+
+	verifier.err = errors.New("no matching signatures")
+	_, err = policy.Validate("ghcr.io/daytonaio/workspace-project")
+	require.True(t, imagepolicy.IsImageNotAllowed(err))
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Requests of an administrator that carry the ID of an active session in this header act on behalf of the user of the session
+const IMPERSONATION_HEADER = "X-Daytona-Impersonation"
+
+// Session lets an administrator act on behalf of a user for a limited time, e.g. to reproduce a support case
+type Session struct {
+	Id string `json:"id" validate:"required"`
+	// Name of the administrator acting on behalf of the user
+	Admin string `json:"admin" validate:"required"`
+	// API key, OIDC user or certificate name of the impersonated user
+	User string `json:"user" validate:"required"`
+	// Why the user is impersonated, e.g. a support ticket. Shown to the user and recorded in the audit log
+	Reason    string     `json:"reason" validate:"required"`
+	CreatedAt time.Time  `json:"createdAt" validate:"required"`
+	ExpiresAt time.Time  `json:"expiresAt" validate:"required"`
+	EndedAt   *time.Time `json:"endedAt,omitempty" validate:"optional"`
+} // @name ImpersonationSession
+
+func (s *Session) IsActive(now time.Time) bool {
+	return s.EndedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// Route groups whose resources can be inspected while impersonating a user
+var inspectableRouteGroups = []string{"/workspace", "/target", "/project-config", "/region", "/sample"}
+
+// Workspace routes that expose the files or the ports of projects are not inspectable
+var privateWorkspaceRoutes = []string{"/toolbox/", "/forward/"}
+
+// IsRouteAllowed reports whether a request to the route can be made while impersonating a user. Impersonation is
+// restricted to creating and inspecting workspaces
+func IsRouteAllowed(method, path string) bool {
+	switch method {
+	case http.MethodGet:
+		if strings.HasPrefix(path, "/log/workspace/") {
+			return true
+		}
+
+		group := "/" + strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)[0]
+		if !slices.Contains(inspectableRouteGroups, group) {
+			return false
+		}
+
+		for _, route := range privateWorkspaceRoutes {
+			if group == "/workspace" && strings.Contains(path, route) {
+				return false
+			}
+		}
+
+		return true
+	case http.MethodPost:
+		return path == "/workspace/" || path == "/workspace/validate"
+	}
+
+	return false
+}
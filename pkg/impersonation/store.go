@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation
+
+import "errors"
+
+type Filter struct {
+	Admin *string
+	User  *string
+}
+
+type Store interface {
+	List(filter *Filter) ([]*Session, error)
+	Find(id string) (*Session, error)
+	Save(session *Session) error
+}
+
+var (
+	ErrSessionNotFound = errors.New("impersonation session not found")
+)
+
+func IsSessionNotFound(err error) bool {
+	return err.Error() == ErrSessionNotFound.Error()
+}
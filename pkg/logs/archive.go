@@ -0,0 +1,245 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/storage"
+	log "github.com/sirupsen/logrus"
+)
+
+// Time after which archiving a log file is given up. The log file is kept and archived on the next close
+const archiveTimeout = 5 * time.Minute
+
+// logArchive moves log files to a storage backend once the last logger writing them is closed, so that the logs of
+// finished operations do not fill the disk of the server. Every close archives the file as a new segment, which
+// readers return in order before the log file that is being written. All methods work on local files only on a nil
+// receiver
+type logArchive struct {
+	backend storage.Backend
+
+	mutex sync.Mutex
+	files map[string]*archivedFile
+}
+
+type archivedFile struct {
+	// Guards the log file while it is opened or archived
+	mutex   sync.Mutex
+	writers int
+	// Loggers that are opening or closing the file. Guarded by the mutex of the archive
+	refs int
+}
+
+func newLogArchive(backend storage.Backend) *logArchive {
+	return &logArchive{
+		backend: backend,
+		files:   map[string]*archivedFile{},
+	}
+}
+
+// openLogFile opens the log file for appending and creates it if it does not exist
+func (a *logArchive) openLogFile(filePath string) (*os.File, error) {
+	if a == nil {
+		return openLogFile(filePath)
+	}
+
+	f := a.acquire(filePath)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	logFile, err := openLogFile(filePath)
+	if err != nil {
+		a.release(filePath, f)
+		return nil, err
+	}
+
+	f.writers++
+
+	return logFile, nil
+}
+
+// closeLogFile closes the log file and archives it under the key if no other logger writes it
+func (a *logArchive) closeLogFile(logFile *os.File, key string) error {
+	err := logFile.Close()
+	if a == nil {
+		return err
+	}
+
+	filePath := logFile.Name()
+
+	a.mutex.Lock()
+	f := a.files[filePath]
+	a.mutex.Unlock()
+
+	f.mutex.Lock()
+	f.writers--
+	if f.writers == 0 && err == nil {
+		err = a.archive(filePath, key)
+		if err != nil {
+			log.Warnf("Failed to archive log file %s: %v", filePath, err)
+		}
+	}
+	f.mutex.Unlock()
+
+	a.release(filePath, f)
+
+	return err
+}
+
+// deleteArchived deletes the archived segments of the log files whose keys start with the prefix
+func (a *logArchive) deleteArchived(prefix string) error {
+	if a == nil {
+		return nil
+	}
+
+	return storage.DeletePrefix(context.Background(), a.backend, prefix)
+}
+
+// newLogReader returns a reader of the archived segments of the log file followed by the log file. Returns an error
+// if the log file does not exist and was never archived
+func (a *logArchive) newLogReader(filePath, key string) (io.Reader, error) {
+	if a == nil {
+		return os.Open(filePath)
+	}
+
+	segments, err := a.backend.List(context.Background(), key+".")
+	if err != nil {
+		log.Warnf("Failed to list archived segments of %s, only reading the local log file: %v", key, err)
+		return os.Open(filePath)
+	}
+
+	if len(segments) == 0 {
+		return os.Open(filePath)
+	}
+
+	reader := &archivedLogReader{
+		backend:  a.backend,
+		filePath: filePath,
+	}
+	for _, segment := range segments {
+		reader.segments = append(reader.segments, segment.Key)
+	}
+
+	return reader, nil
+}
+
+func (a *logArchive) archive(filePath, key string) error {
+	logFile, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer logFile.Close()
+
+	info, err := logFile.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(), archiveTimeout)
+		defer cancel()
+
+		// Zero padded so that the segments are listed in the order they were archived
+		_, err = a.backend.Put(ctx, fmt.Sprintf("%s.%020d", key, time.Now().UnixNano()), logFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.Remove(filePath)
+}
+
+func (a *logArchive) acquire(filePath string) *archivedFile {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	f, ok := a.files[filePath]
+	if !ok {
+		f = &archivedFile{}
+		a.files[filePath] = f
+	}
+	f.refs++
+
+	return f
+}
+
+func (a *logArchive) release(filePath string, f *archivedFile) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	f.refs--
+	if f.refs == 0 {
+		delete(a.files, filePath)
+	}
+}
+
+// archivedLogReader reads the archived segments of a log file in order, then the log file. The log file is kept open
+// at its end, so that followers of the log read what is appended to it
+type archivedLogReader struct {
+	backend  storage.Backend
+	segments []string
+	filePath string
+
+	segment io.ReadCloser
+	logFile *os.File
+}
+
+func (r *archivedLogReader) Read(p []byte) (int, error) {
+	for r.segment != nil || len(r.segments) > 0 {
+		if r.segment == nil {
+			segment, err := r.backend.Get(context.Background(), r.segments[0])
+			if err != nil {
+				return 0, err
+			}
+			r.segment = segment
+			r.segments = r.segments[1:]
+		}
+
+		n, err := r.segment.Read(p)
+		if err == io.EOF {
+			r.segment.Close()
+			r.segment = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+
+		return n, err
+	}
+
+	if r.logFile == nil {
+		logFile, err := os.Open(r.filePath)
+		if err != nil {
+			// Nothing is written until the next operation opens the log file again
+			if os.IsNotExist(err) {
+				return 0, io.EOF
+			}
+			return 0, err
+		}
+		r.logFile = logFile
+	}
+
+	return r.logFile.Read(p)
+}
+
+func openLogFile(filePath string) (*os.File, error) {
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/storage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivingLoggerFactory(t *testing.T) {
+	logsDir := t.TempDir()
+	backend := storage.NewLocalBackend(t.TempDir())
+	factory := NewArchivingLoggerFactory(&logsDir, &logsDir, backend)
+
+	serverLogger := factory.CreateProjectLogger("ws1", "p1", LogSourceServer)
+	providerLogger := factory.CreateProjectLogger("ws1", "p1", LogSourceProvider)
+
+	_, err := serverLogger.Write([]byte("first"))
+	require.NoError(t, err)
+	_, err = providerLogger.Write([]byte("second"))
+	require.NoError(t, err)
+
+	// The log file is archived once the last logger writing it is closed
+	require.NoError(t, serverLogger.Close())
+	objects, err := backend.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	require.NoError(t, providerLogger.Close())
+	objects, err = backend.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	require.True(t, strings.HasPrefix(objects[0].Key, "workspaces/ws1/p1/log."))
+
+	_, err = os.Stat(filepath.Join(logsDir, "ws1", "p1", "log"))
+	require.True(t, os.IsNotExist(err))
+
+	_, err = serverLogger.Write([]byte("third"))
+	require.NoError(t, err)
+
+	reader, err := factory.CreateProjectLogReader("ws1", "p1")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	entries := strings.Split(strings.TrimSuffix(string(content), LogDelimiter), LogDelimiter)
+	require.Len(t, entries, 3)
+	require.Contains(t, entries[0], `"msg":"first"`)
+	require.Contains(t, entries[1], `"msg":"second"`)
+	require.Contains(t, entries[2], `"msg":"third"`)
+
+	require.NoError(t, serverLogger.Close())
+	require.NoError(t, factory.CreateWorkspaceLogger("ws1", LogSourceServer).Cleanup())
+
+	objects, err = backend.List(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	_, err = factory.CreateProjectLogReader("ws1", "p1")
+	require.True(t, os.IsNotExist(err))
+}
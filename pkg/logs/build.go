@@ -18,17 +18,12 @@ type buildLogger struct {
 	logFile *os.File
 	logger  *logrus.Logger
 	source  LogSource
+	archive *logArchive
 }
 
 func (bl *buildLogger) Write(p []byte) (n int, err error) {
 	if bl.logFile == nil {
-		filePath := filepath.Join(bl.logsDir, bl.buildId, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
-		if err != nil {
-			return len(p), err
-		}
-
-		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		logFile, err := bl.archive.openLogFile(filepath.Join(bl.logsDir, bl.buildId, "log"))
 		if err != nil {
 			return len(p), err
 		}
@@ -58,7 +53,7 @@ func (bl *buildLogger) Write(p []byte) (n int, err error) {
 
 func (bl *buildLogger) Close() error {
 	if bl.logFile != nil {
-		err := bl.logFile.Close()
+		err := bl.archive.closeLogFile(bl.logFile, getBuildLogsPrefix(bl.buildId)+"log")
 		bl.logFile = nil
 		return err
 	}
@@ -66,9 +61,14 @@ func (bl *buildLogger) Close() error {
 }
 
 func (bl *buildLogger) Cleanup() error {
+	err := bl.archive.deleteArchived(getBuildLogsPrefix(bl.buildId))
+	if err != nil {
+		return err
+	}
+
 	buildLogsDir := filepath.Join(bl.logsDir, bl.buildId)
 
-	_, err := os.Stat(buildLogsDir)
+	_, err = os.Stat(buildLogsDir)
 	if os.IsNotExist(err) {
 		return nil
 	} else if err != nil {
@@ -86,10 +86,15 @@ func (l *loggerFactoryImpl) CreateBuildLogger(buildId string, source LogSource)
 		buildId: buildId,
 		logger:  logger,
 		source:  source,
+		archive: l.archive,
 	}
 }
 
 func (l *loggerFactoryImpl) CreateBuildLogReader(buildId string) (io.Reader, error) {
 	filePath := filepath.Join(l.buildLogsDir, buildId, "log")
-	return os.Open(filePath)
+	return l.archive.newLogReader(filePath, getBuildLogsPrefix(buildId)+"log")
+}
+
+func getBuildLogsPrefix(buildId string) string {
+	return "builds/" + buildId + "/"
 }
@@ -5,6 +5,8 @@ package logs
 
 import (
 	"io"
+
+	"github.com/daytonaio/daytona/pkg/storage"
 )
 
 var LogDelimiter = "!-#_^*|\n"
@@ -44,6 +46,7 @@ type LoggerFactory interface {
 type loggerFactoryImpl struct {
 	wsLogsDir    string
 	buildLogsDir string
+	archive      *logArchive
 }
 
 func NewLoggerFactory(wsLogsDir *string, buildLogsDir *string) LoggerFactory {
@@ -59,3 +62,12 @@ func NewLoggerFactory(wsLogsDir *string, buildLogsDir *string) LoggerFactory {
 
 	return loggerFactoryImpl
 }
+
+// NewArchivingLoggerFactory returns a logger factory that moves log files to the storage backend whenever their
+// loggers are closed. Readers return the archived logs followed by the logs that are being written
+func NewArchivingLoggerFactory(wsLogsDir *string, buildLogsDir *string, backend storage.Backend) LoggerFactory {
+	loggerFactoryImpl := NewLoggerFactory(wsLogsDir, buildLogsDir).(*loggerFactoryImpl)
+	loggerFactoryImpl.archive = newLogArchive(backend)
+
+	return loggerFactoryImpl
+}
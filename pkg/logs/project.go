@@ -20,17 +20,12 @@ type projectLogger struct {
 	logFile     *os.File
 	logger      *logrus.Logger
 	source      LogSource
+	archive     *logArchive
 }
 
 func (pl *projectLogger) Write(p []byte) (n int, err error) {
 	if pl.logFile == nil {
-		filePath := filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
-		if err != nil {
-			return len(p), err
-		}
-
-		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		logFile, err := pl.archive.openLogFile(filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName, "log"))
 		if err != nil {
 			return len(p), err
 		}
@@ -62,7 +57,7 @@ func (pl *projectLogger) Write(p []byte) (n int, err error) {
 
 func (pl *projectLogger) Close() error {
 	if pl.logFile != nil {
-		err := pl.logFile.Close()
+		err := pl.archive.closeLogFile(pl.logFile, getProjectLogsPrefix(pl.workspaceId, pl.projectName)+"log")
 		pl.logFile = nil
 		return err
 	}
@@ -70,9 +65,14 @@ func (pl *projectLogger) Close() error {
 }
 
 func (pl *projectLogger) Cleanup() error {
+	err := pl.archive.deleteArchived(getProjectLogsPrefix(pl.workspaceId, pl.projectName))
+	if err != nil {
+		return err
+	}
+
 	projectLogsDir := filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName)
 
-	_, err := os.Stat(projectLogsDir)
+	_, err = os.Stat(projectLogsDir)
 	if os.IsNotExist(err) {
 		return nil
 	} else if err != nil {
@@ -91,10 +91,15 @@ func (l *loggerFactoryImpl) CreateProjectLogger(workspaceId, projectName string,
 		projectName: projectName,
 		logger:      logger,
 		source:      source,
+		archive:     l.archive,
 	}
 }
 
 func (l *loggerFactoryImpl) CreateProjectLogReader(workspaceId, projectName string) (io.Reader, error) {
 	filePath := filepath.Join(l.wsLogsDir, workspaceId, projectName, "log")
-	return os.Open(filePath)
+	return l.archive.newLogReader(filePath, getProjectLogsPrefix(workspaceId, projectName)+"log")
+}
+
+func getProjectLogsPrefix(workspaceId, projectName string) string {
+	return getWorkspaceLogsPrefix(workspaceId) + projectName + "/"
 }
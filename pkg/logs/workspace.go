@@ -19,16 +19,12 @@ type workspaceLogger struct {
 	logFile     *os.File
 	logger      *logrus.Logger
 	source      LogSource
+	archive     *logArchive
 }
 
 func (w *workspaceLogger) Write(p []byte) (n int, err error) {
 	if w.logFile == nil {
-		filePath := filepath.Join(w.logsDir, w.workspaceId, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
-		if err != nil {
-			return len(p), err
-		}
-		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		logFile, err := w.archive.openLogFile(filepath.Join(w.logsDir, w.workspaceId, "log"))
 		if err != nil {
 			return len(p), err
 		}
@@ -59,7 +55,7 @@ func (w *workspaceLogger) Write(p []byte) (n int, err error) {
 
 func (w *workspaceLogger) Close() error {
 	if w.logFile != nil {
-		err := w.logFile.Close()
+		err := w.archive.closeLogFile(w.logFile, getWorkspaceLogsPrefix(w.workspaceId)+"log")
 		w.logFile = nil
 		return err
 	}
@@ -67,9 +63,14 @@ func (w *workspaceLogger) Close() error {
 }
 
 func (w *workspaceLogger) Cleanup() error {
+	err := w.archive.deleteArchived(getWorkspaceLogsPrefix(w.workspaceId))
+	if err != nil {
+		return err
+	}
+
 	workspaceLogsDir := filepath.Join(w.logsDir, w.workspaceId)
 
-	_, err := os.Stat(workspaceLogsDir)
+	_, err = os.Stat(workspaceLogsDir)
 	if os.IsNotExist(err) {
 		return nil
 	} else if err != nil {
@@ -87,10 +88,16 @@ func (l *loggerFactoryImpl) CreateWorkspaceLogger(workspaceId string, source Log
 		logsDir:     l.wsLogsDir,
 		logger:      logger,
 		source:      source,
+		archive:     l.archive,
 	}
 }
 
 func (l *loggerFactoryImpl) CreateWorkspaceLogReader(workspaceId string) (io.Reader, error) {
 	filePath := filepath.Join(l.wsLogsDir, workspaceId, "log")
-	return os.Open(filePath)
+	return l.archive.newLogReader(filePath, getWorkspaceLogsPrefix(workspaceId)+"log")
+}
+
+// getWorkspaceLogsPrefix returns the prefix of the archived logs of the workspace and its projects
+func getWorkspaceLogsPrefix(workspaceId string) string {
+	return "workspaces/" + workspaceId + "/"
 }
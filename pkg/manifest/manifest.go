@@ -0,0 +1,190 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const ApiVersion = "daytona.io/v1alpha1"
+
+type Kind string
+
+const (
+	KindTarget        Kind = "Target"
+	KindProjectConfig Kind = "ProjectConfig"
+	KindPrebuild      Kind = "Prebuild"
+	KindImagePolicy   Kind = "ImagePolicy"
+	KindCleanupPolicy Kind = "CleanupPolicy"
+)
+
+// Kinds are listed in the order documents are applied so that
+// dependencies (e.g. project configs of prebuilds) exist first
+var Kinds = []Kind{KindTarget, KindProjectConfig, KindPrebuild, KindImagePolicy, KindCleanupPolicy}
+
+type Metadata struct {
+	Name        string            `json:"name"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Document follows the Kubernetes resource layout so manifests can be
+// kept in GitOps repositories and handled by the usual YAML tooling
+type Document struct {
+	ApiVersion string          `json:"apiVersion"`
+	Kind       Kind            `json:"kind"`
+	Metadata   Metadata        `json:"metadata"`
+	Spec       json.RawMessage `json:"spec"`
+}
+
+func NewDocument(kind Kind, name string, spec interface{}) (*Document, error) {
+	specJson, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		ApiVersion: ApiVersion,
+		Kind:       kind,
+		Metadata:   Metadata{Name: name},
+		Spec:       specJson,
+	}, nil
+}
+
+func (d *Document) DecodeSpec(v interface{}) error {
+	if len(d.Spec) == 0 {
+		return fmt.Errorf("%s %s: spec is required", d.Kind, d.Metadata.Name)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(d.Spec))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+	if err != nil {
+		return fmt.Errorf("%s %s: invalid spec: %w", d.Kind, d.Metadata.Name, err)
+	}
+
+	return nil
+}
+
+func (d *Document) Validate() error {
+	if d.ApiVersion != ApiVersion {
+		return fmt.Errorf("unsupported apiVersion %q, expected %q", d.ApiVersion, ApiVersion)
+	}
+
+	if !slices.Contains(Kinds, d.Kind) {
+		return fmt.Errorf("unsupported kind %q", d.Kind)
+	}
+
+	if d.Metadata.Name == "" {
+		return fmt.Errorf("%s: metadata.name is required", d.Kind)
+	}
+
+	return nil
+}
+
+// Parse reads a multi-document YAML stream. Empty documents are skipped.
+func Parse(data []byte) ([]Document, error) {
+	documents := []Document{}
+
+	for i, raw := range splitDocuments(data) {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+
+		var document Document
+		err := yaml.UnmarshalStrict([]byte(raw), &document)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+
+		// A document consisting only of comments decodes to the zero value
+		if document.ApiVersion == "" && document.Kind == "" && len(document.Spec) == 0 {
+			continue
+		}
+
+		err = document.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+
+		documents = append(documents, document)
+	}
+
+	return documents, nil
+}
+
+// Marshal renders documents as a multi-document YAML stream
+func Marshal(documents []Document) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for i, document := range documents {
+		data, err := yaml.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Sort orders documents by kind in apply order, keeping the relative order
+// of documents of the same kind
+func Sort(documents []Document) {
+	slices.SortStableFunc(documents, func(a, b Document) int {
+		return slices.Index(Kinds, a.Kind) - slices.Index(Kinds, b.Kind)
+	})
+}
+
+func ParseKind(value string) (Kind, error) {
+	for _, kind := range Kinds {
+		if strings.EqualFold(string(kind), value) {
+			return kind, nil
+		}
+	}
+
+	return "", errors.New("kind must be one of: " + strings.Join(kindNames(), ", "))
+}
+
+func kindNames() []string {
+	names := []string{}
+	for _, kind := range Kinds {
+		names = append(names, string(kind))
+	}
+	return names
+}
+
+func splitDocuments(data []byte) []string {
+	documents := []string{}
+	var current strings.Builder
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimRight(line, " \t") == "---" {
+			documents = append(documents, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+	}
+	documents = append(documents, current.String())
+
+	return documents
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+const testManifest = `# Daytona server configuration
+apiVersion: daytona.io/v1alpha1
+kind: Prebuild
+metadata:
+  name: main
+spec:
+  projectConfig: api
+  branch: main
+  commitInterval: 10
+  retention: 3
+---
+apiVersion: daytona.io/v1alpha1
+kind: Target
+metadata:
+  name: local
+spec:
+  provider:
+    name: docker-provider
+    version: v0.0.1
+  options:
+    Sock Path: /var/run/docker.sock
+  default: true
+---
+# trailing comment only
+`
+
+func TestParse(t *testing.T) {
+	documents, err := manifest.Parse([]byte(testManifest))
+	require.Nil(t, err)
+	require.Len(t, documents, 2)
+
+	manifest.Sort(documents)
+	require.Equal(t, manifest.KindTarget, documents[0].Kind)
+	require.Equal(t, manifest.KindPrebuild, documents[1].Kind)
+
+	var target manifest.TargetSpec
+	require.Nil(t, documents[0].DecodeSpec(&target))
+	require.Equal(t, "docker-provider", target.Provider.Name)
+	require.Equal(t, "/var/run/docker.sock", target.Options["Sock Path"])
+	require.True(t, target.Default)
+
+	var prebuild manifest.PrebuildSpec
+	require.Nil(t, documents[1].DecodeSpec(&prebuild))
+	require.Equal(t, "api", prebuild.ProjectConfig)
+	require.Equal(t, int32(10), *prebuild.CommitInterval)
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := manifest.Parse([]byte("apiVersion: daytona.io/v2\nkind: Target\nmetadata:\n  name: local\n"))
+	require.ErrorContains(t, err, "unsupported apiVersion")
+
+	_, err = manifest.Parse([]byte("apiVersion: daytona.io/v1alpha1\nkind: Workspace\nmetadata:\n  name: ws\n"))
+	require.ErrorContains(t, err, "unsupported kind")
+
+	documents, err := manifest.Parse([]byte("apiVersion: daytona.io/v1alpha1\nkind: Target\nmetadata:\n  name: local\nspec:\n  unknown: true\n"))
+	require.Nil(t, err)
+	require.Error(t, documents[0].DecodeSpec(&manifest.TargetSpec{}))
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	image := "daytonaio/workspace-project:latest"
+	projectConfig, err := manifest.NewDocument(manifest.KindProjectConfig, "api", manifest.ProjectConfigSpec{
+		RepositoryUrl: "https://github.com/daytonaio/daytona.git",
+		Image:         &image,
+		EnvVars:       map[string]string{"FOO": "bar"},
+	})
+	require.Nil(t, err)
+
+	verify := true
+	imagePolicy, err := manifest.NewDocument(manifest.KindImagePolicy, manifest.ImagePolicyName, manifest.ImagePolicySpec(apiclient.ImagePolicyConfig{
+		AllowedRegistries: []string{"ghcr.io"},
+		VerifySignatures:  &verify,
+	}))
+	require.Nil(t, err)
+
+	data, err := manifest.Marshal([]manifest.Document{*projectConfig, *imagePolicy})
+	require.Nil(t, err)
+
+	documents, err := manifest.Parse(data)
+	require.Nil(t, err)
+	require.Len(t, documents, 2)
+
+	var pc manifest.ProjectConfigSpec
+	require.Nil(t, documents[0].DecodeSpec(&pc))
+	require.Equal(t, "api", documents[0].Metadata.Name)
+	require.Equal(t, image, *pc.Image)
+	require.Equal(t, "bar", pc.EnvVars["FOO"])
+
+	var policy manifest.ImagePolicySpec
+	require.Nil(t, documents[1].DecodeSpec(&policy))
+	require.Equal(t, []string{"ghcr.io"}, policy.AllowedRegistries)
+	require.True(t, *policy.VerifySignatures)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import "github.com/daytonaio/daytona/pkg/apiclient"
+
+type TargetSpec struct {
+	Provider apiclient.ProviderProviderInfo `json:"provider"`
+	Options  map[string]interface{}         `json:"options,omitempty"`
+	Default  bool                           `json:"default,omitempty"`
+}
+
+type ProjectConfigSpec struct {
+	RepositoryUrl       string                     `json:"repositoryUrl"`
+	Image               *string                    `json:"image,omitempty"`
+	User                *string                    `json:"user,omitempty"`
+	BuildConfig         *apiclient.BuildConfig     `json:"buildConfig,omitempty"`
+	EnvVars             map[string]string          `json:"envVars,omitempty"`
+	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty"`
+	Default             bool                       `json:"default,omitempty"`
+	Mounts              []apiclient.Mount          `json:"mounts,omitempty"`
+	Commands            []apiclient.ProjectCommand `json:"commands,omitempty"`
+	Ports               []apiclient.ProjectPort    `json:"ports,omitempty"`
+	BuildSecrets        []string                   `json:"buildSecrets,omitempty"`
+}
+
+// PrebuildSpec identifies a prebuild by its project config and branch;
+// metadata.name is only used for display
+type PrebuildSpec struct {
+	ProjectConfig  string                    `json:"projectConfig"`
+	Branch         string                    `json:"branch"`
+	CommitInterval *int32                    `json:"commitInterval,omitempty"`
+	TriggerFiles   []string                  `json:"triggerFiles,omitempty"`
+	Retention      int32                     `json:"retention"`
+	Matrix         *apiclient.PrebuildMatrix `json:"matrix,omitempty"`
+	BuildSecrets   []string                  `json:"buildSecrets,omitempty"`
+}
+
+type ImagePolicySpec apiclient.ImagePolicyConfig
+
+// ImagePolicyName is the name of the single server-wide image policy
+const ImagePolicyName = "default"
+
+// CleanupPolicySpec takes the policy name from metadata.name
+type CleanupPolicySpec struct {
+	Selector   string `json:"selector,omitempty"`
+	MaxAge     string `json:"maxAge,omitempty"`
+	MaxRunning int32  `json:"maxRunning,omitempty"`
+	MaxIdle    string `json:"maxIdle,omitempty"`
+	DryRun     bool   `json:"dryRun,omitempty"`
+}
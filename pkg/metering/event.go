@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import "time"
+
+type EventType string
+
+const (
+	EventTypeWorkspaceUsage EventType = "workspace.usage"
+	EventTypeBuildUsage     EventType = "build.usage"
+	EventTypeStorageUsage   EventType = "storage.usage"
+)
+
+type Unit string
+
+const (
+	UnitSeconds Unit = "seconds"
+	UnitMinutes Unit = "minutes"
+	UnitGbHours Unit = "gb-hours"
+)
+
+// Event is a normalized usage record covering the period between StartTime and EndTime
+type Event struct {
+	Id             string    `json:"id"`
+	Type           EventType `json:"type"`
+	Quantity       float64   `json:"quantity"`
+	Unit           Unit      `json:"unit"`
+	ServerId       string    `json:"serverId"`
+	OrganizationId string    `json:"organizationId,omitempty"`
+	WorkspaceId    string    `json:"workspaceId,omitempty"`
+	ProjectName    string    `json:"projectName,omitempty"`
+	BuildId        string    `json:"buildId,omitempty"`
+	// Size is the target the workspace runs on, which determines the machine size
+	Size      string    `json:"size,omitempty"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
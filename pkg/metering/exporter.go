@@ -0,0 +1,9 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+// Exporter sends metering events to an external system
+type Exporter interface {
+	Export(events []Event) error
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testEvents = []Event{
+	{Id: "1", Type: EventTypeWorkspaceUsage, Quantity: 60, Unit: UnitSeconds, WorkspaceId: "ws1"},
+	{Id: "2", Type: EventTypeBuildUsage, Quantity: 1, Unit: UnitMinutes, BuildId: "b1"},
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metering", "events.jsonl")
+	exporter := NewFileExporter(path)
+
+	require.Nil(t, exporter.Export(testEvents))
+	require.Nil(t, exporter.Export(testEvents[:1]))
+
+	content, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 3)
+
+	var event Event
+	require.Nil(t, json.Unmarshal([]byte(lines[1]), &event))
+	require.Equal(t, testEvents[1], event)
+}
+
+func TestKafkaExporter(t *testing.T) {
+	var body map[string][]kafkaRecord
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/topics/usage", r.URL.Path)
+		require.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+	}))
+	defer server.Close()
+
+	exporter := NewKafkaExporter(server.URL, "usage", map[string]string{"Authorization": "secret"})
+	require.Nil(t, exporter.Export(testEvents))
+
+	require.Len(t, body["records"], 2)
+	require.Equal(t, "ws1", body["records"][0].Key)
+	require.Equal(t, testEvents[1], body["records"][1].Value)
+}
+
+func TestHttpExporterFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	exporter := NewHttpExporter(server.URL, nil)
+	require.NotNil(t, exporter.Export(testEvents))
+}
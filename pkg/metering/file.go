@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileExporter appends events to a file, one JSON object per line
+type FileExporter struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileExporter(path string) *FileExporter {
+	return &FileExporter{
+		path: path,
+	}
+}
+
+func (e *FileExporter) Export(events []Event) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(e.path), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(e.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, event := range events {
+		err = encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
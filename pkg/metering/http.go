@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HttpExporter posts events as a JSON array to an endpoint
+type HttpExporter struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewHttpExporter(url string, headers map[string]string) *HttpExporter {
+	return &HttpExporter{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (e *HttpExporter) Export(events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	return post(e.client, e.url, "application/json", e.headers, body)
+}
+
+func post(client *http.Client, url, contentType string, headers map[string]string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("metering export to %s failed with status %d: %s", url, res.StatusCode, string(message))
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// KafkaExporter produces events to a Kafka topic through a Kafka REST Proxy (v2 API).
+// Records are keyed by workspace ID so events of a workspace stay on the same partition.
+type KafkaExporter struct {
+	restProxyUrl string
+	topic        string
+	headers      map[string]string
+	client       *http.Client
+}
+
+func NewKafkaExporter(restProxyUrl, topic string, headers map[string]string) *KafkaExporter {
+	return &KafkaExporter{
+		restProxyUrl: restProxyUrl,
+		topic:        topic,
+		headers:      headers,
+		client:       &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key,omitempty"`
+	Value Event  `json:"value"`
+}
+
+func (e *KafkaExporter) Export(events []Event) error {
+	records := []kafkaRecord{}
+	for _, event := range events {
+		records = append(records, kafkaRecord{
+			Key:   event.WorkspaceId,
+			Value: event,
+		})
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"records": records,
+	})
+	if err != nil {
+		return err
+	}
+
+	topicUrl, err := url.JoinPath(e.restProxyUrl, "topics", e.topic)
+	if err != nil {
+		return err
+	}
+
+	return post(e.client, topicUrl, "application/vnd.kafka.json.v2+json", e.headers, body)
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkey
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+type Scope string
+
+const (
+	ScopeWorkspace Scope = "workspace"
+	ScopeClient    Scope = "client"
+	ScopeProvider  Scope = "provider"
+	ScopeServer    Scope = "server"
+)
+
+func (s Scope) IsValid() bool {
+	switch s {
+	case ScopeWorkspace, ScopeClient, ScopeProvider, ScopeServer:
+		return true
+	}
+	return false
+}
+
+// NetworkKey is a single use key that lets one node join the Daytona network.
+// Nodes that joined with a key are tagged with its scope so they can be removed when the scope is revoked.
+type NetworkKey struct {
+	Id    string `json:"id" validate:"required"`
+	Scope Scope  `json:"scope" validate:"required"`
+	// Workspace ID, client API key name or provider name the key was issued to
+	ScopeName string     `json:"scopeName" validate:"required"`
+	Key       string     `json:"-"`
+	CreatedAt time.Time  `json:"createdAt" validate:"required"`
+	ExpiresAt time.Time  `json:"expiresAt" validate:"required"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty" validate:"optional"`
+	// Nodes that joined with a persistent key stay registered while they are offline and keep their hostname across
+	// restarts. They are only removed when the key or its scope is revoked
+	Persistent bool `json:"persistent" validate:"required"`
+} // @name ScopedNetworkKey
+
+func (k *NetworkKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *NetworkKey) IsExpired() bool {
+	return time.Now().After(k.ExpiresAt)
+}
+
+// Tag returns the ACL tag assigned to nodes that joined with the key
+func (k *NetworkKey) Tag() string {
+	return GetScopeTag(k.Scope, k.ScopeName)
+}
+
+// GetScopeTag returns the ACL tag of the scope. Tags may only contain lowercase letters, digits and dashes, so the
+// name is hashed instead of normalized. Normalizing would give distinct names such as API keys "Dev_1" and "dev-1"
+// the same tag and revoking one scope would remove the nodes of the other
+func GetScopeTag(scope Scope, scopeName string) string {
+	hash := sha256.Sum256([]byte(scopeName))
+	return GetScopeTagPrefix(scope) + hex.EncodeToString(hash[:16])
+}
+
+// GetScopeTagPrefix returns the prefix shared by the ACL tags of every name of the scope
+func GetScopeTagPrefix(scope Scope) string {
+	return fmt.Sprintf("tag:daytona-%s-", scope)
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkey
+
+import "errors"
+
+type Filter struct {
+	Scope     *Scope
+	ScopeName *string
+	Revoked   *bool
+}
+
+type Store interface {
+	List(filter *Filter) ([]*NetworkKey, error)
+	Find(id string) (*NetworkKey, error)
+	Save(key *NetworkKey) error
+	Delete(key *NetworkKey) error
+}
+
+var (
+	ErrNetworkKeyNotFound = errors.New("network key not found")
+)
+
+func IsNetworkKeyNotFound(err error) bool {
+	return err.Error() == ErrNetworkKeyNotFound.Error()
+}
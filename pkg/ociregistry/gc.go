@@ -0,0 +1,175 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	log "github.com/sirupsen/logrus"
+)
+
+type GarbageCollectResult struct {
+	DeletedManifests int
+	DeletedBlobs     int
+	FreedBytes       int64
+}
+
+// referencedContent holds the descriptors of image manifests and indexes
+type referencedContent struct {
+	Config *struct {
+		Digest digest.Digest `json:"digest"`
+	} `json:"config,omitempty"`
+	Layers []struct {
+		Digest digest.Digest `json:"digest"`
+	} `json:"layers,omitempty"`
+	Manifests []struct {
+		Digest digest.Digest `json:"digest"`
+	} `json:"manifests,omitempty"`
+}
+
+// GarbageCollect removes manifests that are not reachable from a tag and blobs that are not referenced by any manifest.
+// Content modified within the grace period is kept so that images which are still being pushed are not removed.
+func (s *Storage) GarbageCollect(gracePeriod time.Duration) (*GarbageCollectResult, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	result := &GarbageCollectResult{}
+	cutoff := time.Now().Add(-gracePeriod)
+	marked := map[digest.Digest]bool{}
+
+	repositories, err := s.listRepositories()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range repositories {
+		tags, err := s.listTags(repository)
+		if err != nil {
+			return nil, err
+		}
+
+		reachable := map[digest.Digest]bool{}
+		queue := []digest.Digest{}
+		for _, dgst := range tags {
+			queue = append(queue, dgst)
+		}
+
+		for len(queue) > 0 {
+			dgst := queue[0]
+			queue = queue[1:]
+			if reachable[dgst] {
+				continue
+			}
+			reachable[dgst] = true
+
+			for _, child := range s.markManifest(dgst, marked) {
+				queue = append(queue, child)
+			}
+		}
+
+		manifestsDir := filepath.Join(s.repositoryPath(repository), "manifests")
+		err = filepath.WalkDir(manifestsDir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			dgst := digest.NewDigestFromEncoded(digest.Algorithm(filepath.Base(filepath.Dir(path))), d.Name())
+			if reachable[dgst] {
+				return nil
+			}
+
+			if !isOlderThan(d, cutoff) {
+				s.markManifest(dgst, marked)
+				return nil
+			}
+
+			result.DeletedManifests++
+			return os.Remove(path)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	blobsDir := filepath.Join(s.root, "blobs")
+	err = filepath.WalkDir(blobsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		dgst := digest.NewDigestFromEncoded(digest.Algorithm(filepath.Base(filepath.Dir(path))), d.Name())
+		if marked[dgst] || !isOlderThan(d, cutoff) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		err = os.Remove(path)
+		if err != nil {
+			return err
+		}
+
+		result.DeletedBlobs++
+		result.FreedBytes += info.Size()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.usage -= result.FreedBytes
+
+	return result, nil
+}
+
+// markManifest marks the manifest blob and the blobs it references, returning the child manifests of an index
+func (s *Storage) markManifest(dgst digest.Digest, marked map[digest.Digest]bool) []digest.Digest {
+	marked[dgst] = true
+
+	content, err := os.ReadFile(s.blobPath(dgst))
+	if err != nil {
+		log.Debugf("failed to read manifest %s: %v", dgst, err)
+		return nil
+	}
+
+	var references referencedContent
+	err = json.Unmarshal(content, &references)
+	if err != nil {
+		log.Debugf("failed to parse manifest %s: %v", dgst, err)
+		return nil
+	}
+
+	if references.Config != nil {
+		marked[references.Config.Digest] = true
+	}
+
+	for _, layer := range references.Layers {
+		marked[layer.Digest] = true
+	}
+
+	children := []digest.Digest{}
+	for _, manifest := range references.Manifests {
+		marked[manifest.Digest] = true
+		children = append(children, manifest.Digest)
+	}
+
+	return children
+}
+
+func isOlderThan(d fs.DirEntry, cutoff time.Time) bool {
+	info, err := d.Info()
+	if err != nil {
+		return false
+	}
+
+	return info.ModTime().Before(cutoff)
+}
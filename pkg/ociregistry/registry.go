@@ -0,0 +1,473 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+	log "github.com/sirupsen/logrus"
+)
+
+// Maximum accepted manifest size
+const maxManifestSize = 4 << 20
+
+var repositoryNameRegex = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*(/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*)*$`)
+var tagRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+
+// Access is the access the credentials of a registry request grant
+type Access int
+
+const (
+	AccessNone Access = iota
+	AccessPull
+	AccessPush
+)
+
+// Authenticator returns the access the basic auth credentials of a registry request grant
+type Authenticator func(username, password string) Access
+
+type RegistryConfig struct {
+	Storage       *Storage
+	Authenticator Authenticator
+}
+
+// Registry serves the OCI distribution API backed by Storage
+type Registry struct {
+	storage       *Storage
+	authenticator Authenticator
+}
+
+func NewRegistry(config RegistryConfig) *Registry {
+	return &Registry{
+		storage:       config.Storage,
+		authenticator: config.Authenticator,
+	}
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Docker-Distribution-API-Version", "registry/2.0")
+
+	if r.authenticator != nil {
+		access := AccessNone
+		username, password, ok := req.BasicAuth()
+		if ok {
+			access = r.authenticator(username, password)
+		}
+
+		if access == AccessNone {
+			w.Header().Set("WWW-Authenticate", `Basic realm="Daytona Registry"`)
+			writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "authentication required")
+			return
+		}
+
+		if access < AccessPush && req.Method != http.MethodGet && req.Method != http.MethodHead {
+			writeError(w, http.StatusForbidden, "DENIED", "push access denied")
+			return
+		}
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if path == req.URL.Path {
+		writeError(w, http.StatusNotFound, "NAME_UNKNOWN", "not found")
+		return
+	}
+
+	if path == "" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if path == "_catalog" {
+		r.handleCatalog(w, req)
+		return
+	}
+
+	if name, ok := strings.CutSuffix(path, "/tags/list"); ok {
+		if r.validateName(w, name) {
+			r.handleTags(w, req, name)
+		}
+		return
+	}
+
+	if i := strings.LastIndex(path, "/manifests/"); i != -1 {
+		name, reference := path[:i], path[i+len("/manifests/"):]
+		if r.validateName(w, name) && validateReference(w, reference) {
+			r.handleManifest(w, req, name, reference)
+		}
+		return
+	}
+
+	if i := strings.LastIndex(path, "/blobs/uploads"); i != -1 {
+		name, id := path[:i], strings.TrimPrefix(path[i+len("/blobs/uploads"):], "/")
+		if r.validateName(w, name) {
+			r.handleUpload(w, req, name, id)
+		}
+		return
+	}
+
+	if i := strings.LastIndex(path, "/blobs/"); i != -1 {
+		name := path[:i]
+		dgst, err := digest.Parse(path[i+len("/blobs/"):])
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+			return
+		}
+		if r.validateName(w, name) {
+			r.handleBlob(w, req, dgst)
+		}
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "NAME_UNKNOWN", "not found")
+}
+
+func (r *Registry) handleCatalog(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+		return
+	}
+
+	repositories, err := r.storage.ListRepositories()
+	if err != nil {
+		writeStorageError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string][]string{
+		"repositories": paginate(repositories, req),
+	})
+}
+
+func (r *Registry) handleTags(w http.ResponseWriter, req *http.Request, name string) {
+	if req.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+		return
+	}
+
+	tags, err := r.storage.ListTags(name)
+	if err != nil {
+		writeStorageError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name": name,
+		"tags": paginate(tags, req),
+	})
+}
+
+func (r *Registry) handleBlob(w http.ResponseWriter, req *http.Request, dgst digest.Digest) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		f, err := r.storage.OpenBlob(dgst)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		http.ServeContent(w, req, "", info.ModTime(), f)
+	default:
+		// Blobs are only removed by garbage collection
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+	}
+}
+
+func (r *Registry) handleUpload(w http.ResponseWriter, req *http.Request, name, id string) {
+	if id == "" {
+		if req.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+			return
+		}
+		r.startUpload(w, req, name)
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		size, err := r.storage.UploadSize(id)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		writeUploadStatus(w, name, id, size, http.StatusNoContent)
+	case http.MethodPatch:
+		size, err := r.storage.AppendUpload(id, req.Body)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		writeUploadStatus(w, name, id, size, http.StatusAccepted)
+	case http.MethodPut:
+		dgst, err := digest.Parse(req.URL.Query().Get("digest"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+			return
+		}
+		r.completeUpload(w, req, name, id, dgst)
+	case http.MethodDelete:
+		err := r.storage.CancelUpload(id)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+	}
+}
+
+func (r *Registry) startUpload(w http.ResponseWriter, req *http.Request, name string) {
+	query := req.URL.Query()
+
+	// Blobs are shared between repositories so mounting only has to check that the blob exists
+	if mount := query.Get("mount"); mount != "" {
+		dgst, err := digest.Parse(mount)
+		if err == nil {
+			if _, err := r.storage.StatBlob(dgst); err == nil {
+				writeBlobCreated(w, name, dgst)
+				return
+			}
+		}
+	}
+
+	id, err := r.storage.CreateUpload()
+	if err != nil {
+		writeStorageError(w, err)
+		return
+	}
+
+	if query.Get("digest") != "" {
+		dgst, err := digest.Parse(query.Get("digest"))
+		if err != nil {
+			_ = r.storage.CancelUpload(id)
+			writeError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+			return
+		}
+		r.completeUpload(w, req, name, id, dgst)
+		return
+	}
+
+	writeUploadStatus(w, name, id, 0, http.StatusAccepted)
+}
+
+func (r *Registry) completeUpload(w http.ResponseWriter, req *http.Request, name, id string, dgst digest.Digest) {
+	_, err := r.storage.AppendUpload(id, req.Body)
+	if err == nil {
+		err = r.storage.CommitUpload(id, dgst)
+	}
+	if err != nil {
+		if !errors.Is(err, ErrUploadUnknown) {
+			_ = r.storage.CancelUpload(id)
+		}
+		writeStorageError(w, err)
+		return
+	}
+
+	writeBlobCreated(w, name, dgst)
+}
+
+func (r *Registry) handleManifest(w http.ResponseWriter, req *http.Request, name, reference string) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		dgst, mediaType, err := r.storage.ResolveManifest(name, reference)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+
+		f, err := r.storage.OpenBlob(dgst)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", mediaType)
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size(), 10))
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.WriteHeader(http.StatusOK)
+
+		if req.Method == http.MethodGet {
+			_, err = io.Copy(w, f)
+			if err != nil {
+				log.Debugf("failed to write manifest %s: %v", dgst, err)
+			}
+		}
+	case http.MethodPut:
+		content, err := io.ReadAll(io.LimitReader(req.Body, maxManifestSize+1))
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		if len(content) > maxManifestSize {
+			writeError(w, http.StatusRequestEntityTooLarge, "SIZE_INVALID", "manifest is too large")
+			return
+		}
+
+		err = r.verifyManifestReferences(name, content)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "MANIFEST_BLOB_UNKNOWN", err.Error())
+			return
+		}
+
+		dgst, err := r.storage.PutManifest(name, reference, req.Header.Get("Content-Type"), content)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+
+		w.Header().Set("Location", fmt.Sprintf("/v2/%s/manifests/%s", name, dgst))
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		err := r.storage.DeleteManifest(name, reference)
+		if err != nil {
+			writeStorageError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "UNSUPPORTED", "method not allowed")
+	}
+}
+
+// verifyManifestReferences checks that an image manifest's blobs or an index's manifests were pushed
+func (r *Registry) verifyManifestReferences(name string, content []byte) error {
+	var references referencedContent
+	err := json.Unmarshal(content, &references)
+	if err != nil {
+		return fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	blobs := []digest.Digest{}
+	if references.Config != nil {
+		blobs = append(blobs, references.Config.Digest)
+	}
+	for _, layer := range references.Layers {
+		blobs = append(blobs, layer.Digest)
+	}
+
+	for _, dgst := range blobs {
+		if _, err := r.storage.StatBlob(dgst); err != nil {
+			return fmt.Errorf("blob %s: %w", dgst, err)
+		}
+	}
+
+	for _, manifest := range references.Manifests {
+		if _, _, err := r.storage.ResolveManifest(name, manifest.Digest.String()); err != nil {
+			return fmt.Errorf("manifest %s: %w", manifest.Digest, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) validateName(w http.ResponseWriter, name string) bool {
+	if !repositoryNameRegex.MatchString(name) {
+		writeError(w, http.StatusBadRequest, "NAME_INVALID", "invalid repository name")
+		return false
+	}
+	return true
+}
+
+func validateReference(w http.ResponseWriter, reference string) bool {
+	if _, err := digest.Parse(reference); err == nil || tagRegex.MatchString(reference) {
+		return true
+	}
+
+	writeError(w, http.StatusBadRequest, "TAG_INVALID", "invalid tag")
+	return false
+}
+
+func paginate(values []string, req *http.Request) []string {
+	if last := req.URL.Query().Get("last"); last != "" {
+		i, _ := slices.BinarySearch(values, last)
+		for i < len(values) && values[i] == last {
+			i++
+		}
+		values = values[i:]
+	}
+
+	if n, err := strconv.Atoi(req.URL.Query().Get("n")); err == nil && n >= 0 && n < len(values) {
+		values = values[:n]
+	}
+
+	return values
+}
+
+func writeUploadStatus(w http.ResponseWriter, name, id string, size int64, status int) {
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/uploads/%s", name, id))
+	w.Header().Set("Docker-Upload-UUID", id)
+	w.Header().Set("Range", fmt.Sprintf("0-%d", max(size-1, 0)))
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(status)
+}
+
+func writeBlobCreated(w http.ResponseWriter, name string, dgst digest.Digest) {
+	w.Header().Set("Location", fmt.Sprintf("/v2/%s/blobs/%s", name, dgst))
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	w.Header().Set("Content-Length", "0")
+	w.WriteHeader(http.StatusCreated)
+}
+
+func writeStorageError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrBlobUnknown):
+		writeError(w, http.StatusNotFound, "BLOB_UNKNOWN", err.Error())
+	case errors.Is(err, ErrManifestUnknown):
+		writeError(w, http.StatusNotFound, "MANIFEST_UNKNOWN", err.Error())
+	case errors.Is(err, ErrUploadUnknown):
+		writeError(w, http.StatusNotFound, "BLOB_UPLOAD_UNKNOWN", err.Error())
+	case errors.Is(err, ErrDigestInvalid):
+		writeError(w, http.StatusBadRequest, "DIGEST_INVALID", err.Error())
+	case errors.Is(err, ErrQuotaExceeded):
+		writeError(w, http.StatusInsufficientStorage, "DENIED", err.Error())
+	default:
+		log.Error(err)
+		writeError(w, http.StatusInternalServerError, "UNKNOWN", "internal server error")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{
+			"code":    code,
+			"message": message,
+		}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(data)
+	if err != nil {
+		log.Debugf("failed to write response: %v", err)
+	}
+}
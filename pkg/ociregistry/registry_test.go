@@ -0,0 +1,188 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/ociregistry"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+const apiKey = "test-api-key"
+const pullApiKey = "test-pull-api-key"
+
+func newTestRegistry(t *testing.T, quota int64) (*ociregistry.Storage, *httptest.Server) {
+	storage, err := ociregistry.NewStorage(t.TempDir(), quota)
+	require.Nil(t, err)
+
+	server := httptest.NewServer(ociregistry.NewRegistry(ociregistry.RegistryConfig{
+		Storage: storage,
+		Authenticator: func(username, password string) ociregistry.Access {
+			switch password {
+			case apiKey:
+				return ociregistry.AccessPush
+			case pullApiKey:
+				return ociregistry.AccessPull
+			}
+			return ociregistry.AccessNone
+		},
+	}))
+	t.Cleanup(server.Close)
+
+	return storage, server
+}
+
+func doRequest(t *testing.T, method, url string, body []byte, headers map[string]string) *http.Response {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	require.Nil(t, err)
+
+	req.SetBasicAuth("daytona", apiKey)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	t.Cleanup(func() { res.Body.Close() })
+
+	return res
+}
+
+func pushImage(t *testing.T, serverUrl, repository, tag string, layer []byte) digest.Digest {
+	layerDigest := digest.FromBytes(layer)
+	config := []byte(`{"architecture":"amd64","os":"linux"}`)
+	configDigest := digest.FromBytes(config)
+
+	// Chunked layer upload
+	res := doRequest(t, http.MethodPost, serverUrl+"/v2/"+repository+"/blobs/uploads/", nil, nil)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	location := res.Header.Get("Location")
+
+	res = doRequest(t, http.MethodPatch, serverUrl+location, layer[:len(layer)/2], nil)
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	res = doRequest(t, http.MethodPut, serverUrl+location+"?digest="+layerDigest.String(), layer[len(layer)/2:], nil)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	// Monolithic config upload
+	res = doRequest(t, http.MethodPost, serverUrl+"/v2/"+repository+"/blobs/uploads/?digest="+configDigest.String(), config, nil)
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	manifest := []byte(fmt.Sprintf(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"digest":"%s","size":%d},"layers":[{"digest":"%s","size":%d}]}`, configDigest, len(config), layerDigest, len(layer)))
+	res = doRequest(t, http.MethodPut, serverUrl+"/v2/"+repository+"/manifests/"+tag, manifest, map[string]string{
+		"Content-Type": "application/vnd.oci.image.manifest.v1+json",
+	})
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+
+	return digest.Digest(res.Header.Get("Docker-Content-Digest"))
+}
+
+func TestRegistryAuth(t *testing.T) {
+	_, server := newTestRegistry(t, 0)
+
+	res, err := http.Get(server.URL + "/v2/")
+	require.Nil(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	require.Contains(t, res.Header.Get("WWW-Authenticate"), "Basic")
+
+	res = doRequest(t, http.MethodGet, server.URL+"/v2/", nil, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestRegistryPullAccess(t *testing.T) {
+	_, server := newTestRegistry(t, 0)
+
+	pushImage(t, server.URL, "project", "latest", []byte("layer"))
+
+	request := func(method, path string) int {
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		require.Nil(t, err)
+		req.SetBasicAuth("daytona", pullApiKey)
+
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		defer res.Body.Close()
+
+		return res.StatusCode
+	}
+
+	require.Equal(t, http.StatusOK, request(http.MethodGet, "/v2/project/manifests/latest"))
+	require.Equal(t, http.StatusOK, request(http.MethodHead, "/v2/project/manifests/latest"))
+	require.Equal(t, http.StatusForbidden, request(http.MethodPost, "/v2/project/blobs/uploads/"))
+	require.Equal(t, http.StatusForbidden, request(http.MethodDelete, "/v2/project/manifests/latest"))
+}
+
+func TestRegistryPushPull(t *testing.T) {
+	_, server := newTestRegistry(t, 0)
+
+	layer := []byte("layer content")
+	manifestDigest := pushImage(t, server.URL, "daytona/p-project", "latest", layer)
+
+	res := doRequest(t, http.MethodGet, server.URL+"/v2/daytona/p-project/manifests/latest", nil, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "application/vnd.oci.image.manifest.v1+json", res.Header.Get("Content-Type"))
+	require.Equal(t, manifestDigest.String(), res.Header.Get("Docker-Content-Digest"))
+
+	res = doRequest(t, http.MethodGet, server.URL+"/v2/daytona/p-project/blobs/"+digest.FromBytes(layer).String(), nil, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	content, err := io.ReadAll(res.Body)
+	require.Nil(t, err)
+	require.Equal(t, layer, content)
+
+	res = doRequest(t, http.MethodGet, server.URL+"/v2/daytona/p-project/tags/list", nil, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	content, err = io.ReadAll(res.Body)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"name":"daytona/p-project","tags":["latest"]}`, string(content))
+
+	res = doRequest(t, http.MethodGet, server.URL+"/v2/_catalog", nil, nil)
+	content, err = io.ReadAll(res.Body)
+	require.Nil(t, err)
+	require.JSONEq(t, `{"repositories":["daytona/p-project"]}`, string(content))
+}
+
+func TestRegistryRejectsManifestWithUnknownBlobs(t *testing.T) {
+	_, server := newTestRegistry(t, 0)
+
+	manifest := []byte(fmt.Sprintf(`{"schemaVersion":2,"layers":[{"digest":"%s","size":1}]}`, digest.FromString("missing")))
+	res := doRequest(t, http.MethodPut, server.URL+"/v2/project/manifests/latest", manifest, map[string]string{
+		"Content-Type": "application/vnd.oci.image.manifest.v1+json",
+	})
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestRegistryQuota(t *testing.T) {
+	storage, server := newTestRegistry(t, 16)
+
+	blob := []byte("content exceeding the quota")
+	res := doRequest(t, http.MethodPost, server.URL+"/v2/project/blobs/uploads/?digest="+digest.FromBytes(blob).String(), blob, nil)
+	require.Equal(t, http.StatusInsufficientStorage, res.StatusCode)
+	require.Equal(t, int64(0), storage.Usage())
+}
+
+func TestGarbageCollect(t *testing.T) {
+	storage, server := newTestRegistry(t, 0)
+
+	oldLayer := []byte("old layer")
+	pushImage(t, server.URL, "project", "latest", oldLayer)
+	pushImage(t, server.URL, "project", "latest", []byte("new layer"))
+
+	result, err := storage.GarbageCollect(0)
+	require.Nil(t, err)
+	require.Equal(t, 1, result.DeletedManifests)
+	require.Equal(t, 2, result.DeletedBlobs)
+
+	res := doRequest(t, http.MethodHead, server.URL+"/v2/project/blobs/"+digest.FromBytes(oldLayer).String(), nil, nil)
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	res = doRequest(t, http.MethodHead, server.URL+"/v2/project/manifests/latest", nil, nil)
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
@@ -0,0 +1,483 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociregistry
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/opencontainers/go-digest"
+)
+
+var (
+	ErrBlobUnknown     = errors.New("blob unknown")
+	ErrManifestUnknown = errors.New("manifest unknown")
+	ErrUploadUnknown   = errors.New("upload unknown")
+	ErrDigestInvalid   = errors.New("digest does not match content")
+	ErrQuotaExceeded   = errors.New("registry storage quota exceeded")
+)
+
+// Storage keeps blobs content-addressed on disk, shared between repositories.
+// Repositories only hold references to manifests and tags:
+//
+//	blobs/<algorithm>/<hex>
+//	uploads/<uuid>
+//	repositories/<name>/manifests/<algorithm>/<hex>	- contains the manifest media type
+//	repositories/<name>/tags/<tag>			- contains the manifest digest
+type Storage struct {
+	root  string
+	quota int64
+
+	mutex sync.Mutex
+	usage int64
+}
+
+// NewStorage creates a registry storage in root. A quota of 0 disables the storage limit.
+func NewStorage(root string, quota int64) (*Storage, error) {
+	for _, dir := range []string{"blobs", "uploads", "repositories"} {
+		err := os.MkdirAll(filepath.Join(root, dir), 0755)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s := &Storage{
+		root:  root,
+		quota: quota,
+	}
+
+	usage, err := dirSize(filepath.Join(root, "blobs"))
+	if err != nil {
+		return nil, err
+	}
+	uploadsUsage, err := dirSize(filepath.Join(root, "uploads"))
+	if err != nil {
+		return nil, err
+	}
+	s.usage = usage + uploadsUsage
+
+	return s, nil
+}
+
+// Usage returns the number of bytes used by blobs and in-progress uploads
+func (s *Storage) Usage() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.usage
+}
+
+func (s *Storage) Quota() int64 {
+	return s.quota
+}
+
+func (s *Storage) StatBlob(dgst digest.Digest) (int64, error) {
+	info, err := os.Stat(s.blobPath(dgst))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrBlobUnknown
+		}
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+func (s *Storage) OpenBlob(dgst digest.Digest) (*os.File, error) {
+	f, err := os.Open(s.blobPath(dgst))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrBlobUnknown
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *Storage) CreateUpload() (string, error) {
+	id := uuid.NewString()
+
+	f, err := os.Create(s.uploadPath(id))
+	if err != nil {
+		return "", err
+	}
+
+	return id, f.Close()
+}
+
+func (s *Storage) UploadSize(id string) (int64, error) {
+	info, err := os.Stat(s.uploadPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrUploadUnknown
+		}
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// AppendUpload writes the content of r to the end of the upload and returns the new upload size.
+// Writing stops with ErrQuotaExceeded once the storage quota is reached.
+func (s *Storage) AppendUpload(id string, r io.Reader) (int64, error) {
+	f, err := os.OpenFile(s.uploadPath(id), os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, ErrUploadUnknown
+		}
+		return 0, err
+	}
+	defer f.Close()
+
+	var written int64
+	if s.quota > 0 {
+		remaining := s.quota - s.Usage()
+		// Read one byte past the remaining quota to detect uploads that exceed it
+		written, err = io.Copy(f, io.LimitReader(r, remaining+1))
+		if err == nil && written > remaining {
+			err = ErrQuotaExceeded
+		}
+	} else {
+		written, err = io.Copy(f, r)
+	}
+
+	s.addUsage(written)
+
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+// CommitUpload verifies the upload content against dgst and moves it to the blob store
+func (s *Storage) CommitUpload(id string, dgst digest.Digest) error {
+	uploadPath := s.uploadPath(id)
+
+	f, err := os.Open(uploadPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ErrUploadUnknown
+		}
+		return err
+	}
+
+	verifier := dgst.Verifier()
+	size, err := io.Copy(verifier, f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+
+	if !verifier.Verified() {
+		return ErrDigestInvalid
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	blobPath := s.blobPath(dgst)
+	if _, err := os.Stat(blobPath); err == nil {
+		// The blob was already pushed - release the duplicate upload and
+		// refresh the blob so garbage collection treats it as new content
+		s.usage -= size
+		now := time.Now()
+		err = os.Chtimes(blobPath, now, now)
+		if err != nil {
+			return err
+		}
+		return os.Remove(uploadPath)
+	}
+
+	err = os.MkdirAll(filepath.Dir(blobPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(uploadPath, blobPath)
+}
+
+func (s *Storage) CancelUpload(id string) error {
+	size, err := s.UploadSize(id)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.uploadPath(id))
+	if err != nil {
+		return err
+	}
+
+	s.addUsage(-size)
+
+	return nil
+}
+
+// PutManifest stores the manifest content as a blob and links it to the repository.
+// If reference is a tag, the tag is pointed to the manifest.
+func (s *Storage) PutManifest(repository, reference, mediaType string, content []byte) (digest.Digest, error) {
+	dgst := digest.FromBytes(content)
+
+	if refDigest, err := digest.Parse(reference); err == nil && refDigest != dgst {
+		return "", ErrDigestInvalid
+	}
+
+	id, err := s.CreateUpload()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = s.AppendUpload(id, bytes.NewReader(content))
+	if err != nil {
+		_ = s.CancelUpload(id)
+		return "", err
+	}
+
+	err = s.CommitUpload(id, dgst)
+	if err != nil {
+		return "", err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	manifestPath := s.manifestPath(repository, dgst)
+	err = os.MkdirAll(filepath.Dir(manifestPath), 0755)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.WriteFile(manifestPath, []byte(mediaType), 0644)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := digest.Parse(reference); err != nil {
+		tagPath := s.tagPath(repository, reference)
+		err = os.MkdirAll(filepath.Dir(tagPath), 0755)
+		if err != nil {
+			return "", err
+		}
+
+		err = os.WriteFile(tagPath, []byte(dgst.String()), 0644)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return dgst, nil
+}
+
+// ResolveManifest returns the digest and media type of the manifest a tag or digest reference points to
+func (s *Storage) ResolveManifest(repository, reference string) (digest.Digest, string, error) {
+	dgst, err := digest.Parse(reference)
+	if err != nil {
+		content, err := os.ReadFile(s.tagPath(repository, reference))
+		if err != nil {
+			if os.IsNotExist(err) {
+				return "", "", ErrManifestUnknown
+			}
+			return "", "", err
+		}
+
+		dgst, err = digest.Parse(string(content))
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	mediaType, err := os.ReadFile(s.manifestPath(repository, dgst))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", "", ErrManifestUnknown
+		}
+		return "", "", err
+	}
+
+	return dgst, string(mediaType), nil
+}
+
+// DeleteManifest removes a tag, or a manifest together with every tag pointing to it.
+// Manifest content is left for garbage collection.
+func (s *Storage) DeleteManifest(repository, reference string) error {
+	dgst, _, err := s.ResolveManifest(repository, reference)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := digest.Parse(reference); err != nil {
+		return os.Remove(s.tagPath(repository, reference))
+	}
+
+	tags, err := s.listTags(repository)
+	if err != nil {
+		return err
+	}
+
+	for tag, tagDigest := range tags {
+		if tagDigest == dgst {
+			err = os.Remove(s.tagPath(repository, tag))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return os.Remove(s.manifestPath(repository, dgst))
+}
+
+func (s *Storage) ListTags(repository string) ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tags, err := s.listTags(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for tag := range tags {
+		names = append(names, tag)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func (s *Storage) ListRepositories() ([]string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.listRepositories()
+}
+
+func (s *Storage) listRepositories() ([]string, error) {
+	repositoriesDir := filepath.Join(s.root, "repositories")
+	repositories := []string{}
+
+	err := filepath.WalkDir(repositoriesDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() && d.Name() == "manifests" {
+			name, err := filepath.Rel(repositoriesDir, filepath.Dir(path))
+			if err != nil {
+				return err
+			}
+			repositories = append(repositories, filepath.ToSlash(name))
+			return filepath.SkipDir
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Sort(repositories)
+
+	return repositories, nil
+}
+
+func (s *Storage) listTags(repository string) (map[string]digest.Digest, error) {
+	tagsDir := filepath.Join(s.repositoryPath(repository), "tags")
+
+	entries, err := os.ReadDir(tagsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]digest.Digest{}, nil
+		}
+		return nil, err
+	}
+
+	tags := map[string]digest.Digest{}
+	for _, entry := range entries {
+		content, err := os.ReadFile(filepath.Join(tagsDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		dgst, err := digest.Parse(string(content))
+		if err != nil {
+			continue
+		}
+
+		tags[entry.Name()] = dgst
+	}
+
+	return tags, nil
+}
+
+func (s *Storage) addUsage(size int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.usage += size
+}
+
+func (s *Storage) blobPath(dgst digest.Digest) string {
+	return filepath.Join(s.root, "blobs", dgst.Algorithm().String(), dgst.Encoded())
+}
+
+func (s *Storage) uploadPath(id string) string {
+	return filepath.Join(s.root, "uploads", filepath.Base(id))
+}
+
+func (s *Storage) repositoryPath(repository string) string {
+	return filepath.Join(s.root, "repositories", filepath.FromSlash(repository))
+}
+
+func (s *Storage) manifestPath(repository string, dgst digest.Digest) string {
+	return filepath.Join(s.repositoryPath(repository), "manifests", dgst.Algorithm().String(), dgst.Encoded())
+}
+
+func (s *Storage) tagPath(repository, tag string) string {
+	return filepath.Join(s.repositoryPath(repository), "tags", tag)
+}
+
+func dirSize(path string) (int64, error) {
+	var size int64
+
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to calculate size of %s: %w", path, err)
+	}
+
+	return size, nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import "context"
+
+const ORGANIZATION_HEADER = "X-Daytona-Organization"
+
+type OrganizationContextKey string
+
+const ORGANIZATION_ID_CONTEXT_KEY OrganizationContextKey = "organization-id"
+
+func WithOrganizationId(ctx context.Context, organizationId string) context.Context {
+	return context.WithValue(ctx, ORGANIZATION_ID_CONTEXT_KEY, organizationId)
+}
+
+// GetOrganizationId returns the organization selected for the request or an empty string if
+// the request is not scoped to an organization
+func GetOrganizationId(ctx context.Context) string {
+	organizationId, ok := ctx.Value(ORGANIZATION_ID_CONTEXT_KEY).(string)
+	if !ok {
+		return ""
+	}
+
+	return organizationId
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import "slices"
+
+type Organization struct {
+	Id   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+	// Names of the client API keys that belong to the organization
+	Members []string `json:"members" validate:"required"`
+	// Names of the members that can manage the members, the quota and the organization itself
+	Admins []string `json:"admins" validate:"required"`
+	Quota  Quota    `json:"quota" validate:"required"`
+} // @name Organization
+
+type Quota struct {
+	// Maximum number of workspaces in the organization. 0 means unlimited
+	MaxWorkspaces int `json:"maxWorkspaces" validate:"required"`
+} // @name OrganizationQuota
+
+func (o *Organization) HasMember(name string) bool {
+	return slices.Contains(o.Members, name)
+}
+
+func (o *Organization) HasAdmin(name string) bool {
+	return slices.Contains(o.Admins, name)
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import "errors"
+
+type Store interface {
+	List() ([]*Organization, error)
+	Find(idOrName string) (*Organization, error)
+	Save(organization *Organization) error
+	Delete(organization *Organization) error
+}
+
+var (
+	ErrOrganizationNotFound = errors.New("organization not found")
+)
+
+func IsOrganizationNotFound(err error) bool {
+	return err.Error() == ErrOrganizationNotFound.Error()
+}
@@ -0,0 +1,322 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Time a local connection waits for the project to become reachable again, e.g. while its agent reconnects to the
+	// tailnet, before it is closed
+	dialRetryTimeout = 30 * time.Second
+	// Bounds of the delay between attempts to connect to the project
+	dialRetryMinDelay = 250 * time.Millisecond
+	dialRetryMaxDelay = 5 * time.Second
+)
+
+var (
+	ErrAlreadyForwarded = errors.New("port is already forwarded")
+	ErrNotForwarded     = errors.New("port is not forwarded")
+)
+
+// Spec is a port of the project and the local port it is forwarded to. LocalPort is zero if it is not given
+type Spec struct {
+	RemotePort uint16
+	LocalPort  uint16
+}
+
+// ParseSpec parses a port forward in the REMOTE_PORT[:LOCAL_PORT] format
+func ParseSpec(spec string) (Spec, error) {
+	remote, local, hasLocal := strings.Cut(strings.TrimSpace(spec), ":")
+
+	remotePort, err := parsePort(remote)
+	if err != nil {
+		return Spec{}, fmt.Errorf("invalid port forward %q, expected REMOTE_PORT[:LOCAL_PORT]: %w", spec, err)
+	}
+
+	s := Spec{RemotePort: remotePort}
+
+	if hasLocal {
+		s.LocalPort, err = parsePort(local)
+		if err != nil {
+			return Spec{}, fmt.Errorf("invalid port forward %q, expected REMOTE_PORT[:LOCAL_PORT]: %w", spec, err)
+		}
+	}
+
+	return s, nil
+}
+
+func parsePort(port string) (uint16, error) {
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || p == 0 {
+		return 0, fmt.Errorf("invalid port %q", port)
+	}
+
+	return uint16(p), nil
+}
+
+func (s Spec) String() string {
+	if s.LocalPort == 0 {
+		return fmt.Sprint(s.RemotePort)
+	}
+
+	return fmt.Sprintf("%d:%d", s.RemotePort, s.LocalPort)
+}
+
+// Status is a forwarded port as reported by Manager.List
+type Status struct {
+	RemotePort uint16
+	LocalPort  uint16
+	// Connections is the number of local connections currently proxied to the project
+	Connections int
+	// Error of the last failed attempt to connect to the project. Cleared once a connection to the project succeeds
+	Error string
+}
+
+// Manager forwards local ports to ports of a project. Forwards can be added and removed while others are in use.
+// Local connections are only proxied once the project accepts them, so that a forward outlives reconnects of the
+// agent of the project: connections opened in the meantime wait for it and only the connections in flight are lost
+type Manager struct {
+	// Dial connects to a port of the project. It is called for each local connection and retried while it fails
+	Dial func(ctx context.Context, port uint16) (net.Conn, error)
+
+	mutex    sync.Mutex
+	forwards map[uint16]*forward
+}
+
+type forward struct {
+	remotePort uint16
+	localPort  uint16
+	listener   net.Listener
+	cancel     context.CancelFunc
+
+	mutex   sync.Mutex
+	conns   map[net.Conn]struct{}
+	lastErr error
+}
+
+// Add starts forwarding the local port to the port of the project. Returns once the local port is listening
+func (m *Manager) Add(remotePort, localPort uint16) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, ok := m.forwards[remotePort]; ok {
+		return fmt.Errorf("%w: %d", ErrAlreadyForwarded, remotePort)
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", localPort))
+	if err != nil {
+		return fmt.Errorf("failed to listen on local port %d: %w", localPort, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	f := &forward{
+		remotePort: remotePort,
+		localPort:  uint16(listener.Addr().(*net.TCPAddr).Port),
+		listener:   listener,
+		cancel:     cancel,
+		conns:      map[net.Conn]struct{}{},
+	}
+
+	if m.forwards == nil {
+		m.forwards = map[uint16]*forward{}
+	}
+	m.forwards[remotePort] = f
+
+	go m.serve(ctx, f)
+
+	return nil
+}
+
+// Remove stops forwarding the port of the project and closes its local connections
+func (m *Manager) Remove(remotePort uint16) error {
+	m.mutex.Lock()
+	f, ok := m.forwards[remotePort]
+	delete(m.forwards, remotePort)
+	m.mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrNotForwarded, remotePort)
+	}
+
+	f.close()
+
+	return nil
+}
+
+// List returns the forwarded ports ordered by the port of the project
+func (m *Manager) List() []Status {
+	m.mutex.Lock()
+	forwards := make([]*forward, 0, len(m.forwards))
+	for _, f := range m.forwards {
+		forwards = append(forwards, f)
+	}
+	m.mutex.Unlock()
+
+	statuses := []Status{}
+	for _, f := range forwards {
+		statuses = append(statuses, f.status())
+	}
+
+	slices.SortFunc(statuses, func(a, b Status) int {
+		return int(a.RemotePort) - int(b.RemotePort)
+	})
+
+	return statuses
+}
+
+// Close removes all forwards
+func (m *Manager) Close() {
+	m.mutex.Lock()
+	forwards := m.forwards
+	m.forwards = nil
+	m.mutex.Unlock()
+
+	for _, f := range forwards {
+		f.close()
+	}
+}
+
+func (m *Manager) serve(ctx context.Context, f *forward) {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Errorf("Stopped forwarding port %d: %v", f.remotePort, err)
+			}
+			return
+		}
+
+		if !f.track(conn) {
+			conn.Close()
+			return
+		}
+
+		go func() {
+			defer f.untrack(conn)
+			m.proxy(ctx, f, conn)
+		}()
+	}
+}
+
+// proxy copies the local connection to a connection to the port of the project in both directions
+func (m *Manager) proxy(ctx context.Context, f *forward, conn net.Conn) {
+	defer conn.Close()
+
+	remoteConn, err := m.dial(ctx, f)
+	if err != nil {
+		log.Debugf("Failed to connect to port %d: %v", f.remotePort, err)
+		return
+	}
+	defer remoteConn.Close()
+
+	done := make(chan struct{})
+
+	go func() {
+		_, _ = io.Copy(remoteConn, conn)
+		remoteConn.Close()
+		close(done)
+	}()
+
+	_, _ = io.Copy(conn, remoteConn)
+	conn.Close()
+
+	<-done
+}
+
+// dial connects to the port of the project, retrying until the project is reachable or the retry timeout expires
+func (m *Manager) dial(ctx context.Context, f *forward) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialRetryTimeout)
+	defer cancel()
+
+	delay := dialRetryMinDelay
+
+	for {
+		remoteConn, err := m.Dial(ctx, f.remotePort)
+		f.setError(err)
+		if err == nil {
+			return remoteConn, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, dialRetryMaxDelay)
+	}
+}
+
+// track records the local connection so that it is closed with the forward. Returns false if the forward is closed
+func (f *forward) track(conn net.Conn) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.conns == nil {
+		return false
+	}
+
+	f.conns[conn] = struct{}{}
+
+	return true
+}
+
+func (f *forward) untrack(conn net.Conn) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	delete(f.conns, conn)
+}
+
+func (f *forward) setError(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.lastErr = err
+}
+
+func (f *forward) status() Status {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	s := Status{
+		RemotePort:  f.remotePort,
+		LocalPort:   f.localPort,
+		Connections: len(f.conns),
+	}
+
+	if f.lastErr != nil {
+		s.Error = f.lastErr.Error()
+	}
+
+	return s
+}
+
+func (f *forward) close() {
+	f.cancel()
+	f.listener.Close()
+
+	f.mutex.Lock()
+	conns := f.conns
+	f.conns = nil
+	f.mutex.Unlock()
+
+	for conn := range conns {
+		conn.Close()
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package portforward
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec("3000")
+	require.NoError(t, err)
+	require.Equal(t, Spec{RemotePort: 3000}, spec)
+	require.Equal(t, "3000", spec.String())
+
+	spec, err = ParseSpec("3000:8080")
+	require.NoError(t, err)
+	require.Equal(t, Spec{RemotePort: 3000, LocalPort: 8080}, spec)
+	require.Equal(t, "3000:8080", spec.String())
+
+	for _, invalid := range []string{"", "0", "http", "3000:", ":8080", "3000:70000", "3000:8080:9090"} {
+		_, err := ParseSpec(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestManager(t *testing.T) {
+	echo := startEchoServer(t)
+
+	// The project is unreachable for the first attempts, e.g. while its agent reconnects
+	var unreachable atomic.Int32
+	unreachable.Store(2)
+
+	m := &Manager{
+		Dial: func(ctx context.Context, port uint16) (net.Conn, error) {
+			if unreachable.Add(-1) >= 0 {
+				return nil, errors.New("agent offline")
+			}
+			var d net.Dialer
+			return d.DialContext(ctx, "tcp", echo)
+		},
+	}
+	defer m.Close()
+
+	require.NoError(t, m.Add(3000, 0))
+	require.ErrorIs(t, m.Add(3000, 0), ErrAlreadyForwarded)
+	require.NoError(t, m.Add(4000, 0))
+
+	statuses := m.List()
+	require.Len(t, statuses, 2)
+	require.Equal(t, uint16(3000), statuses[0].RemotePort)
+	require.Equal(t, uint16(4000), statuses[1].RemotePort)
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", statuses[0].LocalPort))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello\n"))
+	require.NoError(t, err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "hello\n", line)
+
+	require.Equal(t, 1, m.List()[0].Connections)
+	require.Empty(t, m.List()[0].Error)
+
+	// Removing a forward closes its connections and frees the local port
+	require.NoError(t, m.Remove(3000))
+	require.ErrorIs(t, m.Remove(3000), ErrNotForwarded)
+	_, err = conn.Read(make([]byte, 1))
+	require.Error(t, err)
+
+	require.Len(t, m.List(), 1)
+	require.NoError(t, m.Add(3000, statuses[0].LocalPort))
+}
+
+func TestManagerDialError(t *testing.T) {
+	m := &Manager{
+		Dial: func(ctx context.Context, port uint16) (net.Conn, error) {
+			return nil, fmt.Errorf("nothing listens on port %d", port)
+		},
+	}
+	defer m.Close()
+
+	require.NoError(t, m.Add(3000, 0))
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", m.List()[0].LocalPort))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Eventually(t, func() bool {
+		return m.List()[0].Error == "nothing listens on port 3000"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func startEchoServer(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				scanner := bufio.NewScanner(conn)
+				for scanner.Scan() {
+					fmt.Fprintln(conn, scanner.Text())
+				}
+			}()
+		}
+	}()
+
+	return ln.Addr().String()
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// TCP_LISTEN is the socket state of listening sockets in /proc/net/tcp
+const TCP_LISTEN = "0A"
+
+type ListeningSocket struct {
+	Port uint16
+	// Uid of the socket owner
+	Uid uint32
+}
+
+// GetListeningSockets returns the listening TCP sockets of the host. Only supported on Linux.
+func GetListeningSockets() ([]ListeningSocket, error) {
+	sockets := []ListeningSocket{}
+
+	for _, procFile := range []string{"/proc/net/tcp", "/proc/net/tcp6"} {
+		file, err := os.Open(procFile)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		listening, err := ParseListeningSockets(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		sockets = append(sockets, listening...)
+	}
+
+	return sockets, nil
+}
+
+// ParseListeningSockets parses the listening sockets from the content of /proc/net/tcp or /proc/net/tcp6
+func ParseListeningSockets(r io.Reader) ([]ListeningSocket, error) {
+	sockets := []ListeningSocket{}
+	scanner := bufio.NewScanner(r)
+
+	// Skip the header line
+	scanner.Scan()
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 8 || fields[3] != TCP_LISTEN {
+			continue
+		}
+
+		localAddress := fields[1]
+		portHex := localAddress[strings.LastIndex(localAddress, ":")+1:]
+
+		port, err := strconv.ParseUint(portHex, 16, 16)
+		if err != nil {
+			continue
+		}
+
+		uid, err := strconv.ParseUint(fields[7], 10, 32)
+		if err != nil {
+			continue
+		}
+
+		sockets = append(sockets, ListeningSocket{
+			Port: uint16(port),
+			Uid:  uint32(uid),
+		})
+	}
+
+	return sockets, scanner.Err()
+}
@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/stretchr/testify/require"
+)
+
+const procNetTcp = `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000:08AE 00000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 21533 1 0000000000000000 100 0 0 10 0
+   1: 0100007F:0BB8 00000000:0000 0A 00000000:00000000 00:00000000 00000000  1000        0 31337 1 0000000000000000 100 0 0 10 0
+   2: 0100007F:0BB8 0100007F:D2F0 01 00000000:00000000 00:00000000 00000000  1000        0 31338 1 0000000000000000 20 4 30 10 -1
+`
+
+func TestParseListeningSockets(t *testing.T) {
+	sockets, err := ports.ParseListeningSockets(strings.NewReader(procNetTcp))
+	require.Nil(t, err)
+	require.Equal(t, []ports.ListeningSocket{
+		{Port: 2222, Uid: 0},
+		{Port: 3000, Uid: 1000},
+	}, sockets)
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+)
+
+var ErrNoApplicationPort = errors.New("no application port detected")
+
+// Ports commonly used by web development servers, ordered by likelihood
+var commonWebPorts = []uint16{3000, 5173, 8080, 8000, 4200, 5000, 8888, 80}
+
+// Ports that are never expected to serve a web application
+var ignoredPorts = []uint16{22, 2222, 2280, 3306, 5432, 6379, 27017}
+
+// SelectApplicationPort picks the port most likely to serve the project's web application from the listening ports.
+// Ports marked to open in the browser or preview in devcontainer portsAttributes take precedence,
+// followed by forwardPorts, well-known web development ports and finally the lowest remaining port.
+func SelectApplicationPort(listeningPorts []uint16, config *devcontainer.Configuration) (uint16, error) {
+	candidates := []uint16{}
+	for _, port := range listeningPorts {
+		if !slices.Contains(ignoredPorts, port) {
+			candidates = append(candidates, port)
+		}
+	}
+
+	if config != nil {
+		for _, port := range candidates {
+			attributes, ok := config.PortsAttributes[strconv.Itoa(int(port))]
+			if !ok || attributes.OnAutoForward == nil {
+				continue
+			}
+			if *attributes.OnAutoForward == "openBrowser" || *attributes.OnAutoForward == "openPreview" {
+				return port, nil
+			}
+		}
+
+		for _, port := range config.ForwardPorts {
+			if slices.Contains(candidates, uint16(port)) {
+				return uint16(port), nil
+			}
+		}
+	}
+
+	for _, port := range commonWebPorts {
+		if slices.Contains(candidates, port) {
+			return port, nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		return 0, ErrNoApplicationPort
+	}
+
+	return slices.Min(candidates), nil
+}
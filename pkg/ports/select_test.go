@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectApplicationPort(t *testing.T) {
+	openBrowser := "openBrowser"
+
+	port, err := ports.SelectApplicationPort([]uint16{2222, 2280, 5432, 3000, 9229}, nil)
+	require.Nil(t, err)
+	require.Equal(t, uint16(3000), port)
+
+	port, err = ports.SelectApplicationPort([]uint16{3000, 9229}, &devcontainer.Configuration{
+		ForwardPorts: []int{9229},
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint16(9229), port)
+
+	port, err = ports.SelectApplicationPort([]uint16{3000, 4000, 9229}, &devcontainer.Configuration{
+		ForwardPorts: []int{9229},
+		PortsAttributes: map[string]devcontainer.PortAttributes{
+			"4000": {OnAutoForward: &openBrowser},
+		},
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint16(4000), port)
+
+	port, err = ports.SelectApplicationPort([]uint16{9229, 4001}, nil)
+	require.Nil(t, err)
+	require.Equal(t, uint16(4001), port)
+
+	_, err = ports.SelectApplicationPort([]uint16{2222, 2280}, nil)
+	require.ErrorIs(t, err, ports.ErrNoApplicationPort)
+}
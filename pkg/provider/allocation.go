@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "github.com/daytonaio/daytona/pkg/workspace/project"
+
+// TargetAllocation compares the resources reserved and used by the projects of a target with the capacity of its host
+type TargetAllocation struct {
+	Target string `json:"target" validate:"required"`
+	// Not set if the provider does not report the capacity of the target
+	Capacity *project.Resources `json:"capacity,omitempty" validate:"optional"`
+	// Sum of the resources reserved by the projects of the target
+	Reserved project.Resources `json:"reserved" validate:"required"`
+	// Sum of the resources used by the running projects of the target, as reported by their agents
+	Used project.Resources `json:"used" validate:"required"`
+	// Multiple of the capacity that projects can reserve before new projects are refused
+	OvercommitRatio float64             `json:"overcommitRatio" validate:"required"`
+	Projects        []ProjectAllocation `json:"projects" validate:"required"`
+} // @name TargetAllocation
+
+// CreationDemand is the demand for new workspaces on a target
+type CreationDemand struct {
+	// Workspaces that are being created on the target
+	InFlight int
+	// Workspaces that were refused because the target was at capacity
+	Refused int
+}
+
+type ProjectAllocation struct {
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	Project       string `json:"project" validate:"required"`
+	// Not set if the project has no resource limits
+	Reserved *project.Resources `json:"reserved,omitempty" validate:"optional"`
+	// Not set if the project is stopped or its agent does not report usage
+	Used *project.Resources `json:"used,omitempty" validate:"optional"`
+} // @name ProjectAllocation
+
+// Utilization returns the largest fraction of the CPU or memory of the target that its projects reserve, relative to
+// the overcommitted capacity, or use. It returns false if the provider does not report the capacity of the target
+func (a *TargetAllocation) Utilization() (float64, bool) {
+	if a.Capacity == nil {
+		return 0, false
+	}
+
+	overcommitRatio := a.OvercommitRatio
+	if overcommitRatio <= 0 {
+		overcommitRatio = 1
+	}
+
+	return max(fractionOf(a.Reserved, *a.Capacity, overcommitRatio), fractionOf(a.Used, *a.Capacity, 1)), true
+}
+
+func fractionOf(resources, capacity project.Resources, ratio float64) float64 {
+	fraction := 0.0
+
+	if capacity.Cpus > 0 {
+		fraction = max(fraction, resources.Cpus/(capacity.Cpus*ratio))
+	}
+	if capacity.Memory > 0 {
+		fraction = max(fraction, float64(resources.Memory)/(float64(capacity.Memory)*ratio))
+	}
+
+	return fraction
+}
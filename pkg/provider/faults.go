@@ -0,0 +1,149 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/internal/faults"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// WithFaultInjection wraps the provider so that calls managing workspaces, projects and shared services
+// are delayed and dropped as configured for the provider layer. Other calls are passed through.
+func WithFaultInjection(p Provider) Provider {
+	injector := faults.GetInjector(faults.LayerProvider)
+	if injector == nil {
+		return p
+	}
+
+	return &faultInjectingProvider{
+		Provider: p,
+		injector: injector,
+	}
+}
+
+type faultInjectingProvider struct {
+	Provider
+	injector *faults.Injector
+}
+
+func (p *faultInjectingProvider) inject() error {
+	return p.injector.Inject(context.Background())
+}
+
+func (p *faultInjectingProvider) CreateWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.CreateWorkspace(req)
+}
+
+func (p *faultInjectingProvider) StartWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.StartWorkspace(req)
+}
+
+func (p *faultInjectingProvider) StopWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.StopWorkspace(req)
+}
+
+func (p *faultInjectingProvider) DestroyWorkspace(req *WorkspaceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.DestroyWorkspace(req)
+}
+
+func (p *faultInjectingProvider) GetWorkspaceInfo(req *WorkspaceRequest) (*workspace.WorkspaceInfo, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.GetWorkspaceInfo(req)
+}
+
+func (p *faultInjectingProvider) CreateProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.CreateProject(req)
+}
+
+func (p *faultInjectingProvider) StartProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.StartProject(req)
+}
+
+func (p *faultInjectingProvider) StopProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.StopProject(req)
+}
+
+func (p *faultInjectingProvider) PauseProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.PauseProject(req)
+}
+
+func (p *faultInjectingProvider) ResumeProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.ResumeProject(req)
+}
+
+func (p *faultInjectingProvider) DestroyProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.DestroyProject(req)
+}
+
+func (p *faultInjectingProvider) RebuildProject(req *ProjectRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.RebuildProject(req)
+}
+
+func (p *faultInjectingProvider) GetProjectInfo(req *ProjectRequest) (*project.ProjectInfo, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.GetProjectInfo(req)
+}
+
+func (p *faultInjectingProvider) CreateSharedService(req *SharedServiceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.CreateSharedService(req)
+}
+
+func (p *faultInjectingProvider) DestroySharedService(req *SharedServiceRequest) (*util.Empty, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.DestroySharedService(req)
+}
+
+func (p *faultInjectingProvider) GetSharedServiceInfo(req *SharedServiceRequest) (*sharedservice.SharedServiceInfo, error) {
+	if err := p.inject(); err != nil {
+		return nil, err
+	}
+	return p.Provider.GetSharedServiceInfo(req)
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrPullImageNotSupported    = errors.New("pulling images is not supported by the provider")
+	ErrInspectImageNotSupported = errors.New("inspecting images is not supported by the provider")
+)
+
+func IsPullImageNotSupported(err error) bool {
+	return err != nil && err.Error() == ErrPullImageNotSupported.Error()
+}
+
+// pullImageError maps the error returned by providers built before image pre-pulls were added to
+// ErrPullImageNotSupported
+func pullImageError(err error) error {
+	if err != nil && strings.Contains(err.Error(), "can't find method") {
+		return ErrPullImageNotSupported
+	}
+	return err
+}
+
+func IsInspectImageNotSupported(err error) bool {
+	return err != nil && err.Error() == ErrInspectImageNotSupported.Error()
+}
+
+// inspectImageError maps the error returned by providers built before image inspection was added to
+// ErrInspectImageNotSupported
+func inspectImageError(err error) error {
+	if err != nil && strings.Contains(err.Error(), "can't find method") {
+		return ErrInspectImageNotSupported
+	}
+	return err
+}
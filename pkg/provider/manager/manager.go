@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/common"
 	os_util "github.com/daytonaio/daytona/pkg/os"
 	. "github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
@@ -97,7 +98,7 @@ type ProviderManager struct {
 func (m *ProviderManager) GetProvider(name string) (*Provider, error) {
 	pluginRef, ok := m.pluginRefs[name]
 	if !ok {
-		return nil, errors.New("provider not found")
+		return nil, common.WithErrorCode(errors.New("provider not found"), common.ErrorCodeProviderNotFound)
 	}
 
 	p, err := m.dispenseProvider(pluginRef.client, name)
@@ -333,5 +334,7 @@ func (m *ProviderManager) dispenseProvider(client *plugin.Client, name string) (
 		return nil, errors.New("unexpected type from plugin")
 	}
 
+	provider = WithFaultInjection(provider)
+
 	return &provider, nil
 }
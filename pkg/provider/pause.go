@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrPauseNotSupported = errors.New("pausing projects is not supported by the provider")
+)
+
+func IsPauseNotSupported(err error) bool {
+	return err != nil && err.Error() == ErrPauseNotSupported.Error()
+}
+
+// pauseError maps the error returned by providers built before pausing was added to ErrPauseNotSupported
+func pauseError(err error) error {
+	if err != nil && strings.Contains(err.Error(), "can't find method") {
+		return ErrPauseNotSupported
+	}
+	return err
+}
@@ -4,9 +4,12 @@
 package provider
 
 import (
+	"net"
 	"net/rpc"
 
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/hashicorp/go-plugin"
@@ -19,6 +22,15 @@ type Provider interface {
 
 	GetTargetManifest() (*ProviderTargetManifest, error)
 	GetPresetTargets() (*[]ProviderTarget, error)
+	// Returns the CPU and memory of the target host, e.g. from the info of a remote Docker daemon.
+	// Targets of providers that do not implement it are not checked for overcommitment
+	GetTargetCapacity(*TargetRequest) (*project.Resources, error)
+	// Returns the hosts of targets that place projects on a pool of hosts or VMs.
+	// Targets of providers that do not implement it are not autoscaled
+	GetTargetPool(*TargetRequest) (*TargetPool, error)
+	// Adds hosts to the pool of the target or drains the hosts with the fewest projects until it has the requested
+	// number of hosts. Drained hosts stop accepting projects and are removed once their projects are gone
+	ScaleTarget(*ScaleTargetRequest) (*TargetPool, error)
 
 	CreateWorkspace(*WorkspaceRequest) (*util.Empty, error)
 	StartWorkspace(*WorkspaceRequest) (*util.Empty, error)
@@ -30,17 +42,46 @@ type Provider interface {
 	StartProject(*ProjectRequest) (*util.Empty, error)
 	StopProject(*ProjectRequest) (*util.Empty, error)
 	DestroyProject(*ProjectRequest) (*util.Empty, error)
+	// Recreates the project container. The previous container must be kept or restored if the rebuild fails
+	RebuildProject(*ProjectRequest) (*util.Empty, error)
 	GetProjectInfo(*ProjectRequest) (*project.ProjectInfo, error)
+	// Returns the durations of the provider phases, e.g. image pull, clone and build, of the last creation of the project
+	GetProjectCreationTimings(*ProjectRequest) (*[]creationtiming.PhaseDuration, error)
+	// Returns how the project agent is reached. Projects of providers that do not implement it use the tailnet
+	GetProjectNetworking(*ProjectRequest) (*project.Networking, error)
+	// Opens a connection to a port of a project with agentless networking through the API of the target,
+	// e.g. a Kubernetes port-forward or `daytona expose` run with exec
+	ForwardProjectPort(*ForwardPortRequest) (net.Conn, error)
+	// Starts a minimal recovery container that shares the project directory and runs the agent in recovery mode,
+	// reachable on the tailnet with the project recovery hostname
+	StartProjectRecovery(*ProjectRequest) (*util.Empty, error)
+	StopProjectRecovery(*ProjectRequest) (*util.Empty, error)
+	// Checkpoints the running processes of the project and stops it, e.g. with CRIU on Docker or by suspending the VM
+	// on cloud providers. Returns an error with the message of ErrPauseNotSupported if the target can't pause projects
+	PauseProject(*ProjectRequest) (*util.Empty, error)
+	// Starts a paused project from its checkpoint so that the processes continue where they were paused
+	ResumeProject(*ProjectRequest) (*util.Empty, error)
+	// Pulls an image onto the hosts of the target so that projects created from it do not wait for the pull,
+	// e.g. a published prebuild image. Returns an error with the message of ErrPullImageNotSupported if the provider
+	// can't pull images
+	PullImage(*ImagePullRequest) (*util.Empty, error)
+	// Looks up the image in its registry from the target without pulling it, e.g. to validate a workspace before
+	// it is created. Returns an error with the message of ErrInspectImageNotSupported if the provider can't inspect images
+	InspectImage(*ImagePullRequest) (*util.Empty, error)
+
+	CreateSharedService(*SharedServiceRequest) (*util.Empty, error)
+	DestroySharedService(*SharedServiceRequest) (*util.Empty, error)
+	GetSharedServiceInfo(*SharedServiceRequest) (*sharedservice.SharedServiceInfo, error)
 }
 
 type ProviderPlugin struct {
 	Impl Provider
 }
 
-func (p *ProviderPlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &ProviderRPCServer{Impl: p.Impl}, nil
+func (p *ProviderPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &ProviderRPCServer{Impl: p.Impl, broker: b}, nil
 }
 
 func (p *ProviderPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &ProviderRPCClient{client: c}, nil
+	return &ProviderRPCClient{client: c, broker: b}, nil
 }
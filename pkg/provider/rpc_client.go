@@ -4,15 +4,20 @@
 package provider
 
 import (
+	"net"
 	"net/rpc"
 
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/hashicorp/go-plugin"
 )
 
 type ProviderRPCClient struct {
 	client *rpc.Client
+	broker *plugin.MuxBroker
 }
 
 func (m *ProviderRPCClient) Initialize(req InitializeProviderRequest) (*util.Empty, error) {
@@ -91,8 +96,100 @@ func (m *ProviderRPCClient) DestroyProject(projectReq *ProjectRequest) (*util.Em
 	return new(util.Empty), err
 }
 
+func (m *ProviderRPCClient) RebuildProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.RebuildProject", projectReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
 func (m *ProviderRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*project.ProjectInfo, error) {
 	var resp project.ProjectInfo
 	err := m.client.Call("Plugin.GetProjectInfo", projectReq, &resp)
 	return &resp, err
 }
+
+func (m *ProviderRPCClient) GetProjectCreationTimings(projectReq *ProjectRequest) (*[]creationtiming.PhaseDuration, error) {
+	var resp []creationtiming.PhaseDuration
+	err := m.client.Call("Plugin.GetProjectCreationTimings", projectReq, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClient) GetTargetCapacity(targetReq *TargetRequest) (*project.Resources, error) {
+	var resp project.Resources
+	err := m.client.Call("Plugin.GetTargetCapacity", targetReq, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClient) GetTargetPool(targetReq *TargetRequest) (*TargetPool, error) {
+	var resp TargetPool
+	err := m.client.Call("Plugin.GetTargetPool", targetReq, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClient) ScaleTarget(scaleReq *ScaleTargetRequest) (*TargetPool, error) {
+	var resp TargetPool
+	err := m.client.Call("Plugin.ScaleTarget", scaleReq, &resp)
+	return &resp, err
+}
+
+func (m *ProviderRPCClient) GetProjectNetworking(projectReq *ProjectRequest) (*project.Networking, error) {
+	var resp project.Networking
+	err := m.client.Call("Plugin.GetProjectNetworking", projectReq, &resp)
+	return &resp, err
+}
+
+// ForwardProjectPort dials the connection opened by the provider over the plugin connection
+func (m *ProviderRPCClient) ForwardProjectPort(forwardReq *ForwardPortRequest) (net.Conn, error) {
+	var brokerId uint32
+	err := m.client.Call("Plugin.ForwardProjectPort", forwardReq, &brokerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.broker.Dial(brokerId)
+}
+
+func (m *ProviderRPCClient) StartProjectRecovery(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.StartProjectRecovery", projectReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) StopProjectRecovery(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.StopProjectRecovery", projectReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) PauseProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.PauseProject", projectReq, new(util.Empty))
+	return new(util.Empty), pauseError(err)
+}
+
+func (m *ProviderRPCClient) ResumeProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.ResumeProject", projectReq, new(util.Empty))
+	return new(util.Empty), pauseError(err)
+}
+
+func (m *ProviderRPCClient) PullImage(pullReq *ImagePullRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.PullImage", pullReq, new(util.Empty))
+	return new(util.Empty), pullImageError(err)
+}
+
+func (m *ProviderRPCClient) InspectImage(inspectReq *ImagePullRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.InspectImage", inspectReq, new(util.Empty))
+	return new(util.Empty), inspectImageError(err)
+}
+
+func (m *ProviderRPCClient) CreateSharedService(serviceReq *SharedServiceRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.CreateSharedService", serviceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) DestroySharedService(serviceReq *SharedServiceRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.DestroySharedService", serviceReq, new(util.Empty))
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) GetSharedServiceInfo(serviceReq *SharedServiceRequest) (*sharedservice.SharedServiceInfo, error) {
+	var resp sharedservice.SharedServiceInfo
+	err := m.client.Call("Plugin.GetSharedServiceInfo", serviceReq, &resp)
+	return &resp, err
+}
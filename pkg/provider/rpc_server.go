@@ -4,13 +4,21 @@
 package provider
 
 import (
+	"io"
+	"net"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/hashicorp/go-plugin"
+	log "github.com/sirupsen/logrus"
 )
 
 type ProviderRPCServer struct {
-	Impl Provider
+	Impl   Provider
+	broker *plugin.MuxBroker
 }
 
 func (m *ProviderRPCServer) Initialize(arg InitializeProviderRequest, resp *util.Empty) error {
@@ -107,6 +115,11 @@ func (m *ProviderRPCServer) DestroyProject(arg *ProjectRequest, resp *util.Empty
 	return err
 }
 
+func (m *ProviderRPCServer) RebuildProject(arg *ProjectRequest, resp *util.Empty) error {
+	_, err := m.Impl.RebuildProject(arg)
+	return err
+}
+
 func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *project.ProjectInfo) error {
 	info, err := m.Impl.GetProjectInfo(arg)
 	if err != nil {
@@ -116,3 +129,146 @@ func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *project.Pr
 	*resp = *info
 	return nil
 }
+
+func (m *ProviderRPCServer) GetProjectCreationTimings(arg *ProjectRequest, resp *[]creationtiming.PhaseDuration) error {
+	timings, err := m.Impl.GetProjectCreationTimings(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *timings
+	return nil
+}
+
+func (m *ProviderRPCServer) GetTargetCapacity(arg *TargetRequest, resp *project.Resources) error {
+	capacity, err := m.Impl.GetTargetCapacity(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *capacity
+	return nil
+}
+
+func (m *ProviderRPCServer) GetTargetPool(arg *TargetRequest, resp *TargetPool) error {
+	pool, err := m.Impl.GetTargetPool(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *pool
+	return nil
+}
+
+func (m *ProviderRPCServer) ScaleTarget(arg *ScaleTargetRequest, resp *TargetPool) error {
+	pool, err := m.Impl.ScaleTarget(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *pool
+	return nil
+}
+
+func (m *ProviderRPCServer) GetProjectNetworking(arg *ProjectRequest, resp *project.Networking) error {
+	networking, err := m.Impl.GetProjectNetworking(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *networking
+	return nil
+}
+
+// ForwardProjectPort relays the connection opened by the provider over a new plugin connection and responds with its broker ID
+func (m *ProviderRPCServer) ForwardProjectPort(arg *ForwardPortRequest, resp *uint32) error {
+	conn, err := m.Impl.ForwardProjectPort(arg)
+	if err != nil {
+		return err
+	}
+
+	brokerId := m.broker.NextId()
+
+	go func() {
+		defer conn.Close()
+
+		brokerConn, err := m.broker.Accept(brokerId)
+		if err != nil {
+			log.Errorf("failed to accept forwarded connection to port %d of project %s: %v", arg.Port, arg.Project.Name, err)
+			return
+		}
+		defer brokerConn.Close()
+
+		pipe(conn, brokerConn)
+	}()
+
+	*resp = brokerId
+	return nil
+}
+
+func (m *ProviderRPCServer) StartProjectRecovery(arg *ProjectRequest, resp *util.Empty) error {
+	_, err := m.Impl.StartProjectRecovery(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) StopProjectRecovery(arg *ProjectRequest, resp *util.Empty) error {
+	_, err := m.Impl.StopProjectRecovery(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) PauseProject(arg *ProjectRequest, resp *util.Empty) error {
+	_, err := m.Impl.PauseProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) ResumeProject(arg *ProjectRequest, resp *util.Empty) error {
+	_, err := m.Impl.ResumeProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) PullImage(arg *ImagePullRequest, resp *util.Empty) error {
+	_, err := m.Impl.PullImage(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) InspectImage(arg *ImagePullRequest, resp *util.Empty) error {
+	_, err := m.Impl.InspectImage(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) CreateSharedService(arg *SharedServiceRequest, resp *util.Empty) error {
+	_, err := m.Impl.CreateSharedService(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) DestroySharedService(arg *SharedServiceRequest, resp *util.Empty) error {
+	_, err := m.Impl.DestroySharedService(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) GetSharedServiceInfo(arg *SharedServiceRequest, resp *sharedservice.SharedServiceInfo) error {
+	info, err := m.Impl.GetSharedServiceInfo(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *info
+	return nil
+}
+
+// pipe copies data in both directions until one of the connections is closed
+func pipe(a, b net.Conn) {
+	done := make(chan struct{}, 2)
+
+	go func() {
+		_, _ = io.Copy(a, b)
+		done <- struct{}{}
+	}()
+
+	go func() {
+		_, _ = io.Copy(b, a)
+		done <- struct{}{}
+	}()
+
+	<-done
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+// forwardingProvider forwards ports of projects to an echo server. Calls it does not implement panic
+type forwardingProvider struct {
+	provider.Provider
+	networking project.Networking
+}
+
+func (p *forwardingProvider) GetProjectNetworking(req *provider.ProjectRequest) (*project.Networking, error) {
+	if p.networking == "" {
+		return nil, errors.New("networking is not supported")
+	}
+
+	return &p.networking, nil
+}
+
+func (p *forwardingProvider) ForwardProjectPort(req *provider.ForwardPortRequest) (net.Conn, error) {
+	if req.Port != 2280 {
+		return nil, errors.New("port is not exposed")
+	}
+
+	conn, projectConn := net.Pipe()
+	go func() {
+		defer projectConn.Close()
+		_, _ = io.Copy(projectConn, projectConn)
+	}()
+
+	return conn, nil
+}
+
+func dispenseProvider(t *testing.T, impl provider.Provider) provider.Provider {
+	t.Helper()
+
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{
+		"provider": &provider.ProviderPlugin{Impl: impl},
+	}, nil)
+	t.Cleanup(func() {
+		client.Close()
+	})
+
+	raw, err := client.Dispense("provider")
+	require.NoError(t, err)
+
+	return raw.(provider.Provider)
+}
+
+func TestForwardProjectPortRelaysThroughBroker(t *testing.T) {
+	p := dispenseProvider(t, &forwardingProvider{})
+
+	conn, err := p.ForwardProjectPort(&provider.ForwardPortRequest{
+		Project: &project.Project{Name: "p1"},
+		Port:    2280,
+	})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	for _, message := range []string{"hello", "over the broker"} {
+		_, err = conn.Write([]byte(message))
+		require.NoError(t, err)
+
+		received := make([]byte, len(message))
+		_, err = io.ReadFull(conn, received)
+		require.NoError(t, err)
+		require.Equal(t, message, string(received))
+	}
+}
+
+func TestForwardProjectPortReturnsProviderErrors(t *testing.T) {
+	p := dispenseProvider(t, &forwardingProvider{})
+
+	_, err := p.ForwardProjectPort(&provider.ForwardPortRequest{
+		Project: &project.Project{Name: "p1"},
+		Port:    22,
+	})
+	require.ErrorContains(t, err, "port is not exposed")
+}
+
+func TestGetProjectNetworking(t *testing.T) {
+	p := dispenseProvider(t, &forwardingProvider{networking: project.NetworkingAgentless})
+
+	networking, err := p.GetProjectNetworking(&provider.ProjectRequest{Project: &project.Project{Name: "p1"}})
+	require.NoError(t, err)
+	require.Equal(t, project.NetworkingAgentless, *networking)
+
+	p = dispenseProvider(t, &forwardingProvider{})
+
+	_, err = p.GetProjectNetworking(&provider.ProjectRequest{Project: &project.Project{Name: "p1"}})
+	require.ErrorContains(t, err, "networking is not supported")
+}
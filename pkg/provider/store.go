@@ -6,8 +6,9 @@ package provider
 import "errors"
 
 type TargetFilter struct {
-	Name    *string
-	Default *bool
+	Name           *string
+	Default        *bool
+	OrganizationId *string
 }
 
 type TargetStore interface {
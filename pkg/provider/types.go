@@ -6,6 +6,7 @@ package provider
 import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
@@ -43,14 +44,55 @@ type ProjectRequest struct {
 	GitProviderConfig        *gitprovider.GitProviderConfig
 	BuilderImage             string
 	BuilderContainerRegistry *containerregistry.ContainerRegistry
+	// CorrelationId identifies the API request that caused the call. Providers should include it in their logs
+	CorrelationId string
+}
+
+type TargetRequest struct {
+	TargetOptions string
+}
+
+type ScaleTargetRequest struct {
+	TargetOptions string
+	// Number of hosts the pool of the target should have
+	Hosts uint32
+}
+
+// TargetPool is the set of hosts or VMs of a target that projects are placed on
+type TargetPool struct {
+	// Hosts that accept new projects
+	Hosts uint32
+	// Hosts that no longer accept new projects and are removed once their projects are gone
+	Draining uint32
+}
+
+type ForwardPortRequest struct {
+	TargetOptions string
+	Project       *project.Project
+	Port          uint16
+}
+
+type ImagePullRequest struct {
+	TargetOptions     string
+	Image             string
+	ContainerRegistry *containerregistry.ContainerRegistry
+}
+
+type SharedServiceRequest struct {
+	TargetOptions     string
+	ContainerRegistry *containerregistry.ContainerRegistry
+	SharedService     *sharedservice.SharedService
 }
 
 type ProviderTarget struct {
 	Name         string       `json:"name" validate:"required"`
 	ProviderInfo ProviderInfo `json:"providerInfo" validate:"required"`
 	// JSON encoded map of options
-	Options   string `json:"options" validate:"required"`
-	IsDefault bool   `json:"isDefault" validate:"required"`
+	Options        string `json:"options" validate:"required"`
+	IsDefault      bool   `json:"isDefault" validate:"required"`
+	OrganizationId string `json:"organizationId,omitempty" validate:"optional"`
+	// Default limit of the traffic the agent of each project on the target proxies from and to the tailnet
+	BandwidthLimit *project.BandwidthLimit `json:"bandwidthLimit,omitempty" validate:"optional"`
 } // @name ProviderTarget
 
 type ProviderTargetManifest map[string]ProviderTargetProperty // @name ProviderTargetManifest
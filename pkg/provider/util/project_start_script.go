@@ -8,3 +8,8 @@ import "fmt"
 func GetProjectStartScript(daytonaDownloadUrl string, apiKey string) string {
 	return fmt.Sprintf(`curl -sfL -H "Authorization: Bearer %s" %s | sudo -E bash && daytona agent`, apiKey, daytonaDownloadUrl)
 }
+
+// GetProjectRecoveryScript installs and starts the agent in recovery mode, which only serves SSH
+func GetProjectRecoveryScript(daytonaDownloadUrl string, apiKey string) string {
+	return fmt.Sprintf(`curl -sfL -H "Authorization: Bearer %s" %s | bash && daytona agent --recovery`, apiKey, daytonaDownloadUrl)
+}
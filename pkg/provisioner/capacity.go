@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) GetTargetCapacity(target *provider.ProviderTarget) (*project.Resources, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*targetProvider).GetTargetCapacity(&provider.TargetRequest{
+		TargetOptions: target.Options,
+	})
+}
+
+func (p *Provisioner) GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*targetProvider).GetTargetPool(&provider.TargetRequest{
+		TargetOptions: target.Options,
+	})
+}
+
+func (p *Provisioner) ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*targetProvider).ScaleTarget(&provider.ScaleTargetRequest{
+		TargetOptions: target.Options,
+		Hosts:         hosts,
+	})
+}
@@ -4,8 +4,11 @@
 package provisioner
 
 import (
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
@@ -19,7 +22,7 @@ func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *pr
 		Workspace:     workspace,
 	})
 
-	return err
+	return common.WithErrorCode(err, common.ErrorCodeProviderFailed)
 }
 
 func (p *Provisioner) CreateProject(params ProjectParams) error {
@@ -35,7 +38,25 @@ func (p *Provisioner) CreateProject(params ProjectParams) error {
 		GitProviderConfig:        params.GitProviderConfig,
 		BuilderImage:             params.BuilderImage,
 		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		CorrelationId:            params.CorrelationId,
 	})
 
-	return err
+	return common.WithErrorCode(err, common.ErrorCodeProviderFailed)
+}
+
+func (p *Provisioner) GetProjectCreationTimings(project *project.Project, target *provider.ProviderTarget) ([]creationtiming.PhaseDuration, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	timings, err := (*targetProvider).GetProjectCreationTimings(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       project,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return *timings, nil
 }
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+func (p *Provisioner) PullImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).PullImage(&provider.ImagePullRequest{
+		TargetOptions:     target.Options,
+		Image:             image,
+		ContainerRegistry: cr,
+	})
+
+	return err
+}
+
+func (p *Provisioner) InspectImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).InspectImage(&provider.ImagePullRequest{
+		TargetOptions:     target.Options,
+		Image:             image,
+		ContainerRegistry: cr,
+	})
+
+	return err
+}
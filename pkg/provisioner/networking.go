@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"net"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) GetProjectNetworking(proj *project.Project, target *provider.ProviderTarget) (project.Networking, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return "", err
+	}
+
+	networking, err := (*targetProvider).GetProjectNetworking(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return *networking, nil
+}
+
+func (p *Provisioner) ForwardProjectPort(proj *project.Project, target *provider.ProviderTarget, port uint16) (net.Conn, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*targetProvider).ForwardProjectPort(&provider.ForwardPortRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+		Port:          port,
+	})
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) PauseProject(proj *project.Project, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).PauseProject(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+
+	return err
+}
+
+func (p *Provisioner) ResumeProject(proj *project.Project, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).ResumeProject(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+
+	return err
+}
@@ -5,11 +5,14 @@ package provisioner
 
 import (
 	"context"
+	"net"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
@@ -21,17 +24,44 @@ type ProjectParams struct {
 	GitProviderConfig             *gitprovider.GitProviderConfig
 	BuilderImage                  string
 	BuilderImageContainerRegistry *containerregistry.ContainerRegistry
+	// ID of the API request that caused the operation, passed on to the provider
+	CorrelationId string
 }
 
 type IProvisioner interface {
 	CreateProject(params ProjectParams) error
+	CreateSharedService(service *sharedservice.SharedService, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error
 	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	DestroyProject(project *project.Project, target *provider.ProviderTarget) error
+	DestroySharedService(service *sharedservice.SharedService, target *provider.ProviderTarget) error
 	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	// ForwardProjectPort opens a connection to a port of a project with agentless networking
+	ForwardProjectPort(project *project.Project, target *provider.ProviderTarget, port uint16) (net.Conn, error)
+	GetProjectCreationTimings(project *project.Project, target *provider.ProviderTarget) ([]creationtiming.PhaseDuration, error)
+	GetProjectNetworking(project *project.Project, target *provider.ProviderTarget) (project.Networking, error)
+	// GetTargetCapacity returns the CPU and memory of the target host
+	GetTargetCapacity(target *provider.ProviderTarget) (*project.Resources, error)
+	// GetTargetPool returns the hosts of the target if its provider places projects on a pool of hosts
+	GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error)
+	GetSharedServiceInfo(ctx context.Context, service *sharedservice.SharedService, target *provider.ProviderTarget) (*sharedservice.SharedServiceInfo, error)
 	GetWorkspaceInfo(ctx context.Context, workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
+	// PauseProject checkpoints the processes of the project and stops it
+	PauseProject(project *project.Project, target *provider.ProviderTarget) error
+	// PullImage pulls the image onto the hosts of the target ahead of project creation
+	PullImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error
+	// InspectImage checks that the image can be pulled on the target without pulling it
+	InspectImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error
+	RebuildProject(params ProjectParams) error
+	// ResumeProject starts a paused project from the checkpoint of its processes
+	ResumeProject(project *project.Project, target *provider.ProviderTarget) error
+	// ScaleTarget adds hosts to or drains hosts from the pool of the target
+	ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error)
 	StartProject(params ProjectParams) error
+	// StartProjectRecovery starts the recovery container of the project
+	StartProjectRecovery(params ProjectParams) error
 	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	StopProject(project *project.Project, target *provider.ProviderTarget) error
+	StopProjectRecovery(project *project.Project, target *provider.ProviderTarget) error
 	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 }
 
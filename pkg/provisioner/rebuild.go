@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+func (p *Provisioner) RebuildProject(params ProjectParams) error {
+	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).RebuildProject(&provider.ProjectRequest{
+		TargetOptions:            params.Target.Options,
+		Project:                  params.Project,
+		ContainerRegistry:        params.ContainerRegistry,
+		GitProviderConfig:        params.GitProviderConfig,
+		BuilderImage:             params.BuilderImage,
+		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		CorrelationId:            params.CorrelationId,
+	})
+
+	return err
+}
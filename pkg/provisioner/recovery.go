@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+func (p *Provisioner) StartProjectRecovery(params ProjectParams) error {
+	targetProvider, err := p.providerManager.GetProvider(params.Target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).StartProjectRecovery(&provider.ProjectRequest{
+		TargetOptions:            params.Target.Options,
+		Project:                  params.Project,
+		ContainerRegistry:        params.ContainerRegistry,
+		GitProviderConfig:        params.GitProviderConfig,
+		BuilderImage:             params.BuilderImage,
+		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		CorrelationId:            params.CorrelationId,
+	})
+
+	return err
+}
+
+func (p *Provisioner) StopProjectRecovery(proj *project.Project, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).StopProjectRecovery(&provider.ProjectRequest{
+		TargetOptions: target.Options,
+		Project:       proj,
+	})
+
+	return err
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+)
+
+type SharedServiceInfoResult struct {
+	Info *sharedservice.SharedServiceInfo
+	Err  error
+}
+
+func (p *Provisioner) CreateSharedService(service *sharedservice.SharedService, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).CreateSharedService(&provider.SharedServiceRequest{
+		TargetOptions:     target.Options,
+		ContainerRegistry: cr,
+		SharedService:     service,
+	})
+
+	return err
+}
+
+func (p *Provisioner) DestroySharedService(service *sharedservice.SharedService, target *provider.ProviderTarget) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).DestroySharedService(&provider.SharedServiceRequest{
+		TargetOptions: target.Options,
+		SharedService: service,
+	})
+
+	return err
+}
+
+// Gets the shared service info from the provider - the context is used to cancel the request if it takes too long
+func (p *Provisioner) GetSharedServiceInfo(ctx context.Context, service *sharedservice.SharedService, target *provider.ProviderTarget) (*sharedservice.SharedServiceInfo, error) {
+	ch := make(chan SharedServiceInfoResult, 1)
+
+	go func() {
+		targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+		if err != nil {
+			ch <- SharedServiceInfoResult{nil, err}
+			return
+		}
+
+		info, err := (*targetProvider).GetSharedServiceInfo(&provider.SharedServiceRequest{
+			TargetOptions: target.Options,
+			SharedService: service,
+		})
+
+		ch <- SharedServiceInfoResult{info, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case data := <-ch:
+		return data.Info, data.Err
+	}
+}
@@ -4,6 +4,7 @@
 package provisioner
 
 import (
+	"github.com/daytonaio/daytona/pkg/common"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
@@ -19,7 +20,7 @@ func (p *Provisioner) StartWorkspace(workspace *workspace.Workspace, target *pro
 		Workspace:     workspace,
 	})
 
-	return err
+	return common.WithErrorCode(err, common.ErrorCodeProviderFailed)
 }
 
 func (p *Provisioner) StartProject(params ProjectParams) error {
@@ -35,7 +36,8 @@ func (p *Provisioner) StartProject(params ProjectParams) error {
 		GitProviderConfig:        params.GitProviderConfig,
 		BuilderImage:             params.BuilderImage,
 		BuilderContainerRegistry: params.BuilderImageContainerRegistry,
+		CorrelationId:            params.CorrelationId,
 	})
 
-	return err
+	return common.WithErrorCode(err, common.ErrorCodeProviderFailed)
 }
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package region
+
+import "time"
+
+// Regions that miss heartbeats for longer than this are considered offline
+const OfflineThreshold = 3 * time.Minute
+
+// Region is a regional Daytona Server registered with a primary server
+type Region struct {
+	Name string `json:"name" validate:"required"`
+	// API URL of the regional server
+	ApiUrl string `json:"apiUrl" validate:"required"`
+	// Client API key of the regional server used by the primary server
+	ApiKey string `json:"-"`
+	// Name of the API key of the primary server the regional server registered with. Only that key can update the region
+	RegisteredBy string `json:"-"`
+	// Names of the targets of the regional server
+	Targets       []string `json:"targets" validate:"required"`
+	DefaultTarget string   `json:"defaultTarget,omitempty" validate:"optional"`
+	// Set for the region of the server that handled the request
+	Local      bool      `json:"local" validate:"required"`
+	LastSeenAt time.Time `json:"lastSeenAt" validate:"required"`
+} // @name FederatedRegion
+
+func (r *Region) IsOnline() bool {
+	return time.Since(r.LastSeenAt) < OfflineThreshold
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package region
+
+import "errors"
+
+type Store interface {
+	List() ([]*Region, error)
+	Find(name string) (*Region, error)
+	Save(region *Region) error
+	Delete(region *Region) error
+}
+
+var (
+	ErrRegionNotFound = errors.New("region not found")
+)
+
+func IsRegionNotFound(err error) bool {
+	return err.Error() == ErrRegionNotFound.Error()
+}
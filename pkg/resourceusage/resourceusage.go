@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import "time"
+
+// CPU cores below which a project counts as idle, low enough to ignore language servers and file watchers
+const IdleCpus = 0.05
+
+// Report is a sample of the resources used by a project as taken by its agent. Memory and disk sizes are in MiB
+type Report struct {
+	// CPU cores used on average since the previous sample
+	Cpus       float64 `json:"cpus" validate:"required"`
+	MemoryUsed uint64  `json:"memoryUsed" validate:"required"`
+	// Memory the project can use. Zero if the project container has no memory limit
+	MemoryLimit uint64    `json:"memoryLimit,omitempty" validate:"optional"`
+	DiskUsed    uint64    `json:"diskUsed" validate:"required"`
+	DiskTotal   uint64    `json:"diskTotal" validate:"required"`
+	InodesUsed  uint64    `json:"inodesUsed" validate:"required"`
+	InodesTotal uint64    `json:"inodesTotal" validate:"required"`
+	SampledAt   time.Time `json:"sampledAt" validate:"required"`
+} // @name ResourceUsageReport
+
+// Sample is a report of the agent of a project, recorded by the server
+type Sample struct {
+	Id          string    `json:"id" validate:"required"`
+	WorkspaceId string    `json:"workspaceId" validate:"required"`
+	ProjectName string    `json:"projectName" validate:"required"`
+	Cpus        float64   `json:"cpus" validate:"required"`
+	MemoryUsed  uint64    `json:"memoryUsed" validate:"required"`
+	MemoryLimit uint64    `json:"memoryLimit,omitempty" validate:"optional"`
+	DiskUsed    uint64    `json:"diskUsed" validate:"required"`
+	DiskTotal   uint64    `json:"diskTotal" validate:"required"`
+	InodesUsed  uint64    `json:"inodesUsed" validate:"required"`
+	InodesTotal uint64    `json:"inodesTotal" validate:"required"`
+	SampledAt   time.Time `json:"sampledAt" validate:"required"`
+} // @name ResourceUsageSample
+
+// IsIdle reports whether the projects used less than IdleCpus in every sample. The usage is unknown without
+// samples, so projects without samples never count as idle
+func IsIdle(samples []*Sample) bool {
+	if len(samples) == 0 {
+		return false
+	}
+
+	for _, s := range samples {
+		if s.Cpus >= IdleCpus {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Latest returns the most recent sample of each project, keyed by project name
+func Latest(samples []*Sample) map[string]*Sample {
+	latest := map[string]*Sample{}
+	for _, s := range samples {
+		if l, ok := latest[s.ProjectName]; !ok || s.SampledAt.After(l.SampledAt) {
+			latest[s.ProjectName] = s
+		}
+	}
+
+	return latest
+}
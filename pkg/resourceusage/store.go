@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import "time"
+
+type Filter struct {
+	WorkspaceId *string
+	ProjectName *string
+	Since       *time.Time
+	Before      *time.Time
+}
+
+type Store interface {
+	List(filter *Filter) ([]*Sample, error)
+	Save(sample *Sample) error
+	Delete(filter *Filter) error
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"slices"
+	"time"
+)
+
+type RolloutState string
+
+const (
+	RolloutStateCanary     RolloutState = "canary"
+	RolloutStatePromoted   RolloutState = "promoted"
+	RolloutStateRolledBack RolloutState = "rolled-back"
+)
+
+type Rollout struct {
+	Id string `json:"id" validate:"required"`
+	// Agent version that the canary workspaces receive
+	AgentVersion string `json:"agentVersion" validate:"required"`
+	// Agent version used by the rest of the fleet when the rollout started
+	PreviousVersion string `json:"previousVersion" validate:"required"`
+	// A promoted agent version only applies while the server runs the version the rollout was started on
+	ServerVersion string `json:"serverVersion" validate:"required"`
+	// Percentage of workspaces selected for the canary
+	Percentage   int      `json:"percentage" validate:"required"`
+	WorkspaceIds []string `json:"workspaceIds" validate:"required"`
+	// The rollout is rolled back automatically once the canary error rate exceeds this value. 0 disables the check
+	MaxErrorRate float64      `json:"maxErrorRate" validate:"required"`
+	State        RolloutState `json:"state" validate:"required"`
+	CreatedAt    time.Time    `json:"createdAt" validate:"required"`
+	UpdatedAt    time.Time    `json:"updatedAt" validate:"required"`
+} // @name AgentRollout
+
+type RolloutHealth struct {
+	// Canary projects running the new agent version with a recent heartbeat
+	Upgraded int `json:"upgraded" validate:"required"`
+	// Canary projects that have not reported the new agent version yet
+	Pending int `json:"pending" validate:"required"`
+	// Running canary projects whose agent stopped sending heartbeats
+	Unhealthy         int     `json:"unhealthy" validate:"required"`
+	ErrorRate         float64 `json:"errorRate" validate:"required"`
+	BaselineErrorRate float64 `json:"baselineErrorRate" validate:"required"`
+} // @name AgentRolloutHealth
+
+type RolloutStatus struct {
+	Rollout
+	Health RolloutHealth `json:"health" validate:"required"`
+} // @name AgentRolloutStatus
+
+func (r *Rollout) IsActive() bool {
+	return r.State == RolloutStateCanary
+}
+
+func (r *Rollout) HasWorkspace(workspaceId string) bool {
+	return slices.Contains(r.WorkspaceIds, workspaceId)
+}
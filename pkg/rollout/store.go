@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import "errors"
+
+type Store interface {
+	List() ([]*Rollout, error)
+	Find(id string) (*Rollout, error)
+	Save(rollout *Rollout) error
+}
+
+var (
+	ErrRolloutNotFound = errors.New("rollout not found")
+)
+
+func IsRolloutNotFound(err error) bool {
+	return err.Error() == ErrRolloutNotFound.Error()
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevents
+
+import (
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *AgentEventService) StartPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc("@hourly", func() {
+		err := s.EnforceRetention()
+		if err != nil {
+			log.Errorf("Failed to remove expired agent events: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
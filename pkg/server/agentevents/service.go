@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevents
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/google/uuid"
+)
+
+// Longer messages, e.g. errors that include a response body, are truncated
+const maxMessageLength = 1024
+
+// Events are kept long enough to look into recent connection problems of a workspace
+const DefaultRetention = 7 * 24 * time.Hour
+
+var (
+	ErrInvalidEventType = errors.New("invalid agent event type")
+	ErrTooManyEvents    = fmt.Errorf("at most %d agent events can be recorded at once", agentevent.MaxBatchSize)
+)
+
+func IsInvalidEventType(err error) bool {
+	return err.Error() == ErrInvalidEventType.Error()
+}
+
+func IsTooManyEvents(err error) bool {
+	return err.Error() == ErrTooManyEvents.Error()
+}
+
+type IAgentEventService interface {
+	// Record saves the events of a project. No event is saved if any of them is invalid
+	Record(events []*agentevent.Event) error
+	List(filter *agentevent.Filter) ([]*agentevent.Event, error)
+	Delete(filter *agentevent.Filter) error
+	EnforceRetention() error
+	StartPoller() error
+}
+
+type AgentEventServiceConfig struct {
+	AgentEventStore agentevent.Store
+	// Events older than this are removed. Defaults to DefaultRetention
+	Retention time.Duration
+}
+
+func NewAgentEventService(config AgentEventServiceConfig) IAgentEventService {
+	retention := config.Retention
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &AgentEventService{
+		agentEventStore: config.AgentEventStore,
+		retention:       retention,
+	}
+}
+
+type AgentEventService struct {
+	agentEventStore agentevent.Store
+	retention       time.Duration
+}
+
+func (s *AgentEventService) Record(events []*agentevent.Event) error {
+	if len(events) > agentevent.MaxBatchSize {
+		return ErrTooManyEvents
+	}
+
+	for _, e := range events {
+		if !e.Type.IsValid() {
+			return ErrInvalidEventType
+		}
+	}
+
+	now := time.Now()
+
+	for _, e := range events {
+		if e.Id == "" {
+			e.Id = uuid.NewString()
+		}
+
+		// Events of agents with a clock ahead of the server would otherwise outlive the retention
+		if e.OccurredAt.IsZero() || e.OccurredAt.After(now) {
+			e.OccurredAt = now
+		}
+
+		if len(e.Message) > maxMessageLength {
+			e.Message = e.Message[:maxMessageLength]
+		}
+
+		err := s.agentEventStore.Save(e)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *AgentEventService) List(filter *agentevent.Filter) ([]*agentevent.Event, error) {
+	return s.agentEventStore.List(filter)
+}
+
+func (s *AgentEventService) Delete(filter *agentevent.Filter) error {
+	return s.agentEventStore.Delete(filter)
+}
+
+func (s *AgentEventService) EnforceRetention() error {
+	before := time.Now().Add(-s.retention)
+	return s.agentEventStore.Delete(&agentevent.Filter{Before: &before})
+}
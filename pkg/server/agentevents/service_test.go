@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agentevents_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	t_agentevents "github.com/daytonaio/daytona/internal/testing/server/agentevents"
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/server/agentevents"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentEventService(t *testing.T) {
+	service := agentevents.NewAgentEventService(agentevents.AgentEventServiceConfig{
+		AgentEventStore: t_agentevents.NewInMemoryAgentEventStore(),
+	})
+
+	now := time.Now()
+	err := service.Record([]*agentevent.Event{
+		{WorkspaceId: "ws1", ProjectName: "api", Type: agentevent.TypeConnected, Message: "Connected", OccurredAt: now.Add(-time.Minute)},
+		{WorkspaceId: "ws1", ProjectName: "api", Type: agentevent.TypePortOpened, Message: "Port 3000 opened", Port: 3000, OccurredAt: now},
+		{WorkspaceId: "ws1", ProjectName: "web", Type: agentevent.TypeOom, Message: strings.Repeat("x", 2000)},
+		{WorkspaceId: "ws2", ProjectName: "api", Type: agentevent.TypeDiskPressure, Message: "Disk 95% full"},
+	})
+	require.Nil(t, err)
+
+	t.Run("Record rejects unknown types", func(t *testing.T) {
+		err := service.Record([]*agentevent.Event{
+			{WorkspaceId: "ws1", ProjectName: "api", Type: agentevent.TypePortClosed, Message: "Port 3000 closed"},
+			{WorkspaceId: "ws1", ProjectName: "api", Type: "unknown"},
+		})
+		require.True(t, agentevents.IsInvalidEventType(err))
+
+		portClosed := agentevent.TypePortClosed
+		events, err := service.List(&agentevent.Filter{Type: &portClosed})
+		require.Nil(t, err)
+		require.Empty(t, events)
+	})
+
+	t.Run("List filters by workspace and project", func(t *testing.T) {
+		workspaceId := "ws1"
+		projectName := "api"
+		events, err := service.List(&agentevent.Filter{WorkspaceId: &workspaceId, ProjectName: &projectName})
+		require.Nil(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, agentevent.TypeConnected, events[0].Type)
+		require.Equal(t, uint32(3000), events[1].Port)
+		require.NotEmpty(t, events[0].Id)
+	})
+
+	t.Run("Record truncates long messages", func(t *testing.T) {
+		oom := agentevent.TypeOom
+		events, err := service.List(&agentevent.Filter{Type: &oom})
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Len(t, events[0].Message, 1024)
+		require.False(t, events[0].OccurredAt.IsZero())
+	})
+
+	t.Run("Record rejects batches that are too large", func(t *testing.T) {
+		events := []*agentevent.Event{}
+		for i := 0; i <= agentevent.MaxBatchSize; i++ {
+			events = append(events, &agentevent.Event{WorkspaceId: "ws3", ProjectName: "api", Type: agentevent.TypeConnected})
+		}
+		require.True(t, agentevents.IsTooManyEvents(service.Record(events)))
+	})
+
+	t.Run("EnforceRetention removes expired events", func(t *testing.T) {
+		err := service.Record([]*agentevent.Event{
+			{WorkspaceId: "ws2", ProjectName: "api", Type: agentevent.TypeReconnected, OccurredAt: now.Add(-agentevents.DefaultRetention - time.Hour)},
+		})
+		require.Nil(t, err)
+
+		err = service.EnforceRetention()
+		require.Nil(t, err)
+
+		workspaceId := "ws2"
+		events, err := service.List(&agentevent.Filter{WorkspaceId: &workspaceId})
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, agentevent.TypeDiskPressure, events[0].Type)
+	})
+
+	t.Run("Delete removes the events of a workspace", func(t *testing.T) {
+		workspaceId := "ws1"
+		err := service.Delete(&agentevent.Filter{WorkspaceId: &workspaceId})
+		require.Nil(t, err)
+
+		events, err := service.List(nil)
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "ws2", events[0].WorkspaceId)
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcements
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/server/announcements/dto"
+	"github.com/google/uuid"
+	"golang.org/x/mod/semver"
+)
+
+var announcementTypes = []announcement.AnnouncementType{
+	announcement.AnnouncementTypeInfo,
+	announcement.AnnouncementTypeMaintenance,
+	announcement.AnnouncementTypeDeprecation,
+	announcement.AnnouncementTypeQuota,
+}
+
+func (s *AnnouncementService) Create(req dto.CreateAnnouncementDTO) (*announcement.Announcement, error) {
+	if !slices.Contains(announcementTypes, req.Type) {
+		return nil, ErrInvalidType
+	}
+
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, ErrTitleRequired
+	}
+
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return nil, ErrInvalidWindow
+	}
+
+	if req.BelowVersion != "" && !semver.IsValid("v"+strings.TrimPrefix(req.BelowVersion, "v")) {
+		return nil, ErrInvalidBelowVersion
+	}
+
+	expiresAt := req.ExpiresAt
+	if expiresAt == nil {
+		expiresAt = req.EndsAt
+	}
+
+	a := &announcement.Announcement{
+		Id:           uuid.NewString(),
+		Type:         req.Type,
+		Title:        req.Title,
+		Message:      req.Message,
+		StartsAt:     req.StartsAt,
+		EndsAt:       req.EndsAt,
+		BelowVersion: req.BelowVersion,
+		ExpiresAt:    expiresAt,
+		Recipient:    req.Recipient,
+		CreatedAt:    time.Now(),
+	}
+
+	return a, s.announcementStore.Save(a)
+}
+
+func (s *AnnouncementService) List(includeExpired bool) ([]*announcement.Announcement, error) {
+	announcements, err := s.announcementStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if includeExpired {
+		return announcements, nil
+	}
+
+	now := time.Now()
+	return slices.DeleteFunc(announcements, func(a *announcement.Announcement) bool {
+		return a.IsExpired(now)
+	}), nil
+}
+
+func (s *AnnouncementService) Delete(id string) error {
+	a, err := s.announcementStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	return s.announcementStore.Delete(a)
+}
@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+)
+
+type CreateAnnouncementDTO struct {
+	Type         announcement.AnnouncementType `json:"type" validate:"required"`
+	Title        string                        `json:"title" validate:"required"`
+	Message      string                        `json:"message,omitempty" validate:"optional"`
+	StartsAt     *time.Time                    `json:"startsAt,omitempty" validate:"optional"`
+	EndsAt       *time.Time                    `json:"endsAt,omitempty" validate:"optional"`
+	BelowVersion string                        `json:"belowVersion,omitempty" validate:"optional"`
+	// Defaults to the end of the maintenance window if set
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
+	// Only this user is notified. Every user is notified if empty
+	Recipient string `json:"recipient,omitempty" validate:"optional"`
+} // @name CreateAnnouncementDTO
@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcements
+
+import "errors"
+
+var (
+	ErrInvalidType         = errors.New("type must be one of info, maintenance, deprecation or quota")
+	ErrTitleRequired       = errors.New("title is required")
+	ErrInvalidWindow       = errors.New("maintenance window must end after it starts")
+	ErrInvalidBelowVersion = errors.New("below version must be a semantic version")
+)
+
+func IsInvalidAnnouncementRequest(err error) bool {
+	return err.Error() == ErrInvalidType.Error() || err.Error() == ErrTitleRequired.Error() || err.Error() == ErrInvalidWindow.Error() || err.Error() == ErrInvalidBelowVersion.Error()
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcements
+
+import (
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/server/announcements/dto"
+)
+
+type IAnnouncementService interface {
+	Create(req dto.CreateAnnouncementDTO) (*announcement.Announcement, error)
+	// List returns the announcements that have not expired, or all announcements if includeExpired is set
+	List(includeExpired bool) ([]*announcement.Announcement, error)
+	Delete(id string) error
+}
+
+type AnnouncementServiceConfig struct {
+	AnnouncementStore announcement.Store
+}
+
+func NewAnnouncementService(config AnnouncementServiceConfig) IAnnouncementService {
+	return &AnnouncementService{
+		announcementStore: config.AnnouncementStore,
+	}
+}
+
+type AnnouncementService struct {
+	announcementStore announcement.Store
+}
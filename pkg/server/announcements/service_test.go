@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package announcements_test
+
+import (
+	"testing"
+	"time"
+
+	t_announcements "github.com/daytonaio/daytona/internal/testing/server/announcements"
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
+	"github.com/daytonaio/daytona/pkg/server/announcements/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnnouncements(t *testing.T) {
+	service := announcements.NewAnnouncementService(announcements.AnnouncementServiceConfig{
+		AnnouncementStore: t_announcements.NewInMemoryAnnouncementStore(),
+	})
+
+	_, err := service.Create(dto.CreateAnnouncementDTO{Type: "outage", Title: "Down"})
+	require.Equal(t, announcements.ErrInvalidType, err)
+
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	_, err = service.Create(dto.CreateAnnouncementDTO{Type: announcement.AnnouncementTypeMaintenance, Title: "Upgrade", StartsAt: &future, EndsAt: &past})
+	require.Equal(t, announcements.ErrInvalidWindow, err)
+
+	maintenance, err := service.Create(dto.CreateAnnouncementDTO{Type: announcement.AnnouncementTypeMaintenance, Title: "Upgrade", StartsAt: &now, EndsAt: &future})
+	require.Nil(t, err)
+	require.Equal(t, &future, maintenance.ExpiresAt)
+
+	deprecation, err := service.Create(dto.CreateAnnouncementDTO{Type: announcement.AnnouncementTypeDeprecation, Title: "Upgrade the CLI", BelowVersion: "0.40.0"})
+	require.Nil(t, err)
+	require.True(t, deprecation.AppliesToVersion("v0.39.2"))
+	require.False(t, deprecation.AppliesToVersion("0.40.0"))
+	require.True(t, deprecation.AppliesToVersion("dev"))
+
+	_, err = service.Create(dto.CreateAnnouncementDTO{Type: announcement.AnnouncementTypeInfo, Title: "Old news", ExpiresAt: &past})
+	require.Nil(t, err)
+
+	active, err := service.List(false)
+	require.Nil(t, err)
+	require.Len(t, active, 2)
+
+	all, err := service.List(true)
+	require.Nil(t, err)
+	require.Len(t, all, 3)
+
+	require.Nil(t, service.Delete(maintenance.Id))
+	require.True(t, announcement.IsAnnouncementNotFound(service.Delete(maintenance.Id)))
+}
@@ -35,15 +35,33 @@ func (s *ApiKeyService) Revoke(name string) error {
 }
 
 func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string, error) {
+	return s.generate(keyType, name, false)
+}
+
+func (s *ApiKeyService) GenerateAdmin(name string) (string, error) {
+	return s.generate(apikey.ApiKeyTypeClient, name, true)
+}
+
+// generate rejects names that are already taken, since the name of a key is the identity of its requests
+func (s *ApiKeyService) generate(keyType apikey.ApiKeyType, name string, admin bool) (string, error) {
+	_, err := s.apiKeyStore.FindByName(name)
+	if err == nil {
+		return "", apikey.ErrApiKeyAlreadyExists
+	}
+	if !apikey.IsApiKeyNotFound(err) {
+		return "", err
+	}
+
 	key := apikeys.GenerateRandomKey()
 
 	apiKey := &apikey.ApiKey{
 		KeyHash: apikeys.HashKey(key),
 		Type:    keyType,
 		Name:    name,
+		Admin:   admin,
 	}
 
-	err := s.apiKeyStore.Save(apiKey)
+	err = s.apiKeyStore.Save(apiKey)
 	if err != nil {
 		return "", err
 	}
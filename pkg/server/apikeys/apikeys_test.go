@@ -70,3 +70,33 @@ func (s *ApiKeyServiceTestSuite) TestGenerate() {
 	require.Nil(err)
 	require.ElementsMatch(expectedKeys, apiKeys)
 }
+
+func (s *ApiKeyServiceTestSuite) TestGenerateAdmin() {
+	require := s.Require()
+
+	key, err := s.apiKeyService.GenerateAdmin("admin")
+	require.Nil(err)
+
+	apiKey, err := s.apiKeyStore.FindByName("admin")
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyTypeClient, apiKey.Type)
+	require.True(apiKey.Admin)
+	require.True(s.apiKeyService.IsAdminApiKey(key))
+
+	clientKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "client")
+	require.Nil(err)
+	require.False(s.apiKeyService.IsAdminApiKey(clientKey))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerateDuplicateName() {
+	require := s.Require()
+
+	_, err := s.apiKeyService.GenerateAdmin("admin")
+	require.Nil(err)
+
+	_, err = s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "admin")
+	require.True(apikey.IsApiKeyAlreadyExists(err))
+
+	_, err = s.apiKeyService.Generate(apikey.ApiKeyTypeClient, clientKeyNames[0])
+	require.True(apikey.IsApiKeyAlreadyExists(err))
+}
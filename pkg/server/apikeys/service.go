@@ -7,8 +7,12 @@ import "github.com/daytonaio/daytona/pkg/apikey"
 
 type IApiKeyService interface {
 	Generate(keyType apikey.ApiKeyType, name string) (string, error)
+	// GenerateAdmin generates a client key that can manage server-wide resources
+	GenerateAdmin(name string) (string, error)
+	GetApiKeyName(apiKey string) (string, error)
 	IsProjectApiKey(apiKey string) bool
 	IsWorkspaceApiKey(apiKey string) bool
+	IsAdminApiKey(apiKey string) bool
 	IsValidApiKey(apiKey string) bool
 	ListClientKeys() ([]*apikey.ApiKey, error)
 	Revoke(name string) error
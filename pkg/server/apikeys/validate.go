@@ -44,3 +44,25 @@ func (s *ApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
 
 	return true
 }
+
+func (s *ApiKeyService) IsAdminApiKey(apiKey string) bool {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return false
+	}
+
+	return key.Type == apikey.ApiKeyTypeClient && key.Admin
+}
+
+func (s *ApiKeyService) GetApiKeyName(apiKey string) (string, error) {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return "", err
+	}
+
+	return key.Name, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifacts
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/storage"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+const retentionPollInterval = "@every 1h"
+
+type IArtifactService interface {
+	Upload(workspaceId, projectName, path string, content io.Reader) (*artifact.Artifact, error)
+	List(filter *artifact.Filter) ([]*artifact.Artifact, error)
+	Find(id string) (*artifact.Artifact, error)
+	GetContent(id string) (io.ReadCloser, error)
+	Delete(id string) error
+	EnforceRetention() error
+	StartRetentionPoller() error
+}
+
+type ArtifactServiceConfig struct {
+	ArtifactStore artifact.Store
+	// Stores the content of the artifacts keyed by their IDs
+	Storage storage.Backend
+	// Artifacts are deleted once they are older. 0 keeps them until they are deleted with their workspace
+	Retention time.Duration
+}
+
+func NewArtifactService(config ArtifactServiceConfig) IArtifactService {
+	return &ArtifactService{
+		artifactStore: config.ArtifactStore,
+		storage:       config.Storage,
+		retention:     config.Retention,
+	}
+}
+
+type ArtifactService struct {
+	artifactStore artifact.Store
+	storage       storage.Backend
+	retention     time.Duration
+}
+
+func (s *ArtifactService) Upload(workspaceId, projectName, path string, content io.Reader) (*artifact.Artifact, error) {
+	if path == "" {
+		return nil, errors.New("artifact path is required")
+	}
+
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+
+	size, err := s.storage.Put(context.Background(), id, content)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &artifact.Artifact{
+		Id:          id,
+		WorkspaceId: workspaceId,
+		ProjectName: projectName,
+		Path:        filepath.ToSlash(filepath.Clean(path)),
+		Size:        int(size),
+		CreatedAt:   time.Now(),
+	}
+
+	err = s.artifactStore.Save(a)
+	if err != nil {
+		s.storage.Delete(context.Background(), id)
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (s *ArtifactService) List(filter *artifact.Filter) ([]*artifact.Artifact, error) {
+	return s.artifactStore.List(filter)
+}
+
+func (s *ArtifactService) Find(id string) (*artifact.Artifact, error) {
+	return s.artifactStore.Find(id)
+}
+
+func (s *ArtifactService) GetContent(id string) (io.ReadCloser, error) {
+	a, err := s.artifactStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.storage.Get(context.Background(), a.Id)
+}
+
+func (s *ArtifactService) Delete(id string) error {
+	a, err := s.artifactStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.storage.Delete(context.Background(), a.Id)
+	if err != nil {
+		return err
+	}
+
+	return s.artifactStore.Delete(a)
+}
+
+// EnforceRetention deletes the artifacts that are older than the retention of the service
+func (s *ArtifactService) EnforceRetention() error {
+	if s.retention == 0 {
+		return nil
+	}
+
+	artifacts, err := s.artifactStore.List(nil)
+	if err != nil {
+		return err
+	}
+
+	before := time.Now().Add(-s.retention)
+	for _, a := range artifacts {
+		if !a.CreatedAt.Before(before) {
+			continue
+		}
+
+		err = s.Delete(a.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ArtifactService) StartRetentionPoller() error {
+	if s.retention == 0 {
+		return nil
+	}
+
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(retentionPollInterval, func() {
+		err := s.EnforceRetention()
+		if err != nil {
+			log.Errorf("Failed to delete expired artifacts: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifacts_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	t_artifacts "github.com/daytonaio/daytona/internal/testing/server/artifacts"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/server/artifacts"
+	"github.com/daytonaio/daytona/pkg/storage"
+	"github.com/stretchr/testify/suite"
+)
+
+type ArtifactServiceTestSuite struct {
+	suite.Suite
+	artifactService artifacts.IArtifactService
+	artifactStore   artifact.Store
+}
+
+func NewArtifactServiceTestSuite() *ArtifactServiceTestSuite {
+	return &ArtifactServiceTestSuite{}
+}
+
+func (s *ArtifactServiceTestSuite) SetupTest() {
+	s.artifactStore = t_artifacts.NewInMemoryArtifactStore()
+	s.artifactService = artifacts.NewArtifactService(artifacts.ArtifactServiceConfig{
+		ArtifactStore: s.artifactStore,
+		Storage:       storage.NewLocalBackend(s.T().TempDir()),
+		Retention:     time.Hour,
+	})
+}
+
+func TestArtifactService(t *testing.T) {
+	suite.Run(t, NewArtifactServiceTestSuite())
+}
+
+func (s *ArtifactServiceTestSuite) TestUpload() {
+	require := s.Require()
+
+	a, err := s.artifactService.Upload("workspace1", "project1", "reports/junit.xml", strings.NewReader("<testsuites/>"))
+	require.Nil(err)
+	require.Equal("reports/junit.xml", a.Path)
+	require.Equal(len("<testsuites/>"), a.Size)
+
+	content, err := s.artifactService.GetContent(a.Id)
+	require.Nil(err)
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	require.Nil(err)
+	require.Equal("<testsuites/>", string(data))
+}
+
+func (s *ArtifactServiceTestSuite) TestList() {
+	require := s.Require()
+
+	_, err := s.artifactService.Upload("workspace1", "project1", "out.txt", strings.NewReader("1"))
+	require.Nil(err)
+	_, err = s.artifactService.Upload("workspace2", "project1", "out.txt", strings.NewReader("2"))
+	require.Nil(err)
+
+	artifacts, err := s.artifactService.List(&artifact.Filter{
+		WorkspaceId: util.Pointer("workspace1"),
+	})
+	require.Nil(err)
+	require.Len(artifacts, 1)
+	require.Equal("workspace1", artifacts[0].WorkspaceId)
+}
+
+func (s *ArtifactServiceTestSuite) TestDelete() {
+	require := s.Require()
+
+	a, err := s.artifactService.Upload("workspace1", "project1", "out.txt", strings.NewReader("1"))
+	require.Nil(err)
+
+	err = s.artifactService.Delete(a.Id)
+	require.Nil(err)
+
+	_, err = s.artifactService.Find(a.Id)
+	require.True(artifact.IsArtifactNotFound(err))
+}
+
+func (s *ArtifactServiceTestSuite) TestEnforceRetention() {
+	require := s.Require()
+
+	expired, err := s.artifactService.Upload("workspace1", "project1", "old.txt", strings.NewReader("1"))
+	require.Nil(err)
+	expired.CreatedAt = time.Now().Add(-2 * time.Hour)
+	require.Nil(s.artifactStore.Save(expired))
+
+	a, err := s.artifactService.Upload("workspace1", "project1", "new.txt", strings.NewReader("2"))
+	require.Nil(err)
+
+	err = s.artifactService.EnforceRetention()
+	require.Nil(err)
+
+	_, err = s.artifactService.Find(expired.Id)
+	require.True(artifact.IsArtifactNotFound(err))
+
+	_, err = s.artifactService.Find(a.Id)
+	require.Nil(err)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package audit
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/google/uuid"
+)
+
+type IAuditService interface {
+	// Record stores the event, assigning its id and time
+	Record(event *audit.Event) error
+	List(filter *audit.Filter) ([]*audit.Event, error)
+}
+
+type AuditServiceConfig struct {
+	AuditEventStore audit.Store
+}
+
+func NewAuditService(config AuditServiceConfig) IAuditService {
+	return &AuditService{
+		auditEventStore: config.AuditEventStore,
+	}
+}
+
+type AuditService struct {
+	auditEventStore audit.Store
+}
+
+func (s *AuditService) Record(event *audit.Event) error {
+	event.Id = uuid.NewString()
+	event.CreatedAt = time.Now()
+
+	return s.auditEventStore.Save(event)
+}
+
+func (s *AuditService) List(filter *audit.Filter) ([]*audit.Event, error) {
+	return s.auditEventStore.List(filter)
+}
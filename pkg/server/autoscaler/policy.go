@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package autoscaler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+const (
+	DefaultScaleUpUtilization   = 0.8
+	DefaultScaleDownUtilization = 0.3
+	DefaultCooldown             = 10 * time.Minute
+)
+
+var ErrInvalidPolicy = errors.New("invalid autoscaling policy")
+
+// Policy bounds the number of hosts of the pool of a target and sets the utilization it is kept between
+type Policy struct {
+	Target               string
+	MinHosts             uint32
+	MaxHosts             uint32
+	ScaleUpUtilization   float64
+	ScaleDownUtilization float64
+	Cooldown             time.Duration
+}
+
+// withDefaults returns the policy with the unset thresholds and cooldown set to their defaults
+func (p Policy) withDefaults() Policy {
+	if p.ScaleUpUtilization <= 0 {
+		p.ScaleUpUtilization = DefaultScaleUpUtilization
+	}
+	if p.ScaleDownUtilization <= 0 {
+		p.ScaleDownUtilization = DefaultScaleDownUtilization
+	}
+	if p.Cooldown <= 0 {
+		p.Cooldown = DefaultCooldown
+	}
+
+	return p
+}
+
+func (p Policy) Validate() error {
+	p = p.withDefaults()
+
+	if p.Target == "" {
+		return fmt.Errorf("%w: target is required", ErrInvalidPolicy)
+	}
+	if p.MaxHosts == 0 || p.MinHosts > p.MaxHosts {
+		return fmt.Errorf("%w for target %s: max hosts must be at least 1 and not below min hosts", ErrInvalidPolicy, p.Target)
+	}
+	if p.ScaleUpUtilization > 1 || p.ScaleDownUtilization >= p.ScaleUpUtilization {
+		return fmt.Errorf("%w for target %s: scale down utilization must be below scale up utilization, which must be at most 1", ErrInvalidPolicy, p.Target)
+	}
+
+	return nil
+}
+
+// decision is the number of hosts the pool should have and why
+type decision struct {
+	hosts  uint32
+	reason string
+}
+
+// decide returns the number of hosts the pool should have. The utilization is ignored if it is not known.
+// Hosts are added one at a time, and drained one at a time only if the remaining hosts would stay below the
+// scale up utilization and no workspaces are being created
+func (p Policy) decide(pool provider.TargetPool, utilization float64, utilizationKnown bool, demand provider.CreationDemand) decision {
+	hosts := pool.Hosts
+
+	switch {
+	case hosts < p.MinHosts:
+		return decision{p.MinHosts, fmt.Sprintf("the pool has fewer than the minimum of %d hosts", p.MinHosts)}
+	case hosts > p.MaxHosts:
+		return decision{p.MaxHosts, fmt.Sprintf("the pool has more than the maximum of %d hosts", p.MaxHosts)}
+	}
+
+	if demand.Refused > 0 && hosts < p.MaxHosts {
+		return decision{hosts + 1, fmt.Sprintf("%d workspaces were refused because the target was at capacity", demand.Refused)}
+	}
+
+	if !utilizationKnown {
+		return decision{hosts, ""}
+	}
+
+	if utilization >= p.ScaleUpUtilization && hosts < p.MaxHosts {
+		return decision{hosts + 1, fmt.Sprintf("utilization of %.0f%% is above %.0f%%", utilization*100, p.ScaleUpUtilization*100)}
+	}
+
+	if utilization <= p.ScaleDownUtilization && hosts > p.MinHosts && hosts > 1 && demand.InFlight == 0 {
+		remaining := utilization * float64(hosts) / float64(hosts-1)
+		if remaining < p.ScaleUpUtilization {
+			return decision{hosts - 1, fmt.Sprintf("utilization of %.0f%% is below %.0f%%", utilization*100, p.ScaleDownUtilization*100)}
+		}
+	}
+
+	return decision{hosts, ""}
+}
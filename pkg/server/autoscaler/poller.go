@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package autoscaler
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *AutoscalerService) StartPoller() error {
+	if len(s.policies) == 0 {
+		return nil
+	}
+
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(fmt.Sprintf("@every %s", s.interval), func() {
+		err := s.Evaluate()
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
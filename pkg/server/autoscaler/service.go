@@ -0,0 +1,175 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package autoscaler
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/provider"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Actor of the audit events recorded by the autoscaler
+const auditActor = "autoscaler"
+
+const DefaultInterval = time.Minute
+
+type IAutoscalerService interface {
+	// Evaluate scales the pools of the targets with an autoscaling policy
+	Evaluate() error
+	StartPoller() error
+}
+
+// targetScaler reads and scales the host pools of targets
+type targetScaler interface {
+	GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error)
+	ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error)
+}
+
+type targetStore interface {
+	Find(filter *provider.TargetFilter) (*provider.ProviderTarget, error)
+}
+
+type AutoscalerServiceConfig struct {
+	Policies            []Policy
+	TargetStore         targetStore
+	Scaler              targetScaler
+	AuditService        audit_service.IAuditService
+	GetTargetAllocation func(targetName string) (*provider.TargetAllocation, error)
+	GetCreationDemand   func(targetName string, since time.Time) provider.CreationDemand
+	// Interval between evaluations. Defaults to DefaultInterval
+	Interval time.Duration
+}
+
+func NewAutoscalerService(config AutoscalerServiceConfig) (IAutoscalerService, error) {
+	policies := []Policy{}
+	for _, policy := range config.Policies {
+		err := policy.Validate()
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy.withDefaults())
+	}
+
+	interval := config.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &AutoscalerService{
+		policies:            policies,
+		targetStore:         config.TargetStore,
+		scaler:              config.Scaler,
+		auditService:        config.AuditService,
+		getTargetAllocation: config.GetTargetAllocation,
+		getCreationDemand:   config.GetCreationDemand,
+		interval:            interval,
+		startedAt:           time.Now(),
+		lastScaledAt:        map[string]time.Time{},
+	}, nil
+}
+
+type AutoscalerService struct {
+	policies            []Policy
+	targetStore         targetStore
+	scaler              targetScaler
+	auditService        audit_service.IAuditService
+	getTargetAllocation func(targetName string) (*provider.TargetAllocation, error)
+	getCreationDemand   func(targetName string, since time.Time) provider.CreationDemand
+	interval            time.Duration
+	startedAt           time.Time
+
+	// Time of the last scale event, keyed by target name
+	lastScaledAt map[string]time.Time
+	mutex        sync.Mutex
+}
+
+func (s *AutoscalerService) Evaluate() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var errs []error
+
+	for _, policy := range s.policies {
+		err := s.evaluate(policy)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to autoscale target %s: %w", policy.Target, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *AutoscalerService) evaluate(policy Policy) error {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &policy.Target})
+	if err != nil {
+		return err
+	}
+
+	pool, err := s.scaler.GetTargetPool(target)
+	if err != nil {
+		return fmt.Errorf("failed to get the host pool from provider %s: %w", target.ProviderInfo.Name, err)
+	}
+
+	allocation, err := s.getTargetAllocation(target.Name)
+	if err != nil {
+		return err
+	}
+	utilization, utilizationKnown := allocation.Utilization()
+
+	lastScaledAt, scaled := s.lastScaledAt[target.Name]
+	since := s.startedAt
+	if scaled {
+		since = lastScaledAt
+	}
+
+	demand := s.getCreationDemand(target.Name, since)
+
+	decision := policy.decide(*pool, utilization, utilizationKnown, demand)
+	if decision.hosts == pool.Hosts {
+		return nil
+	}
+
+	// The pool is always brought within its bounds, other scale events wait for the cooldown
+	withinBounds := pool.Hosts >= policy.MinHosts && pool.Hosts <= policy.MaxHosts
+	if withinBounds && scaled && time.Since(lastScaledAt) < policy.Cooldown {
+		log.Debugf("Not scaling target %s to %d hosts during the cooldown: %s", target.Name, decision.hosts, decision.reason)
+		return nil
+	}
+
+	_, err = s.scaler.ScaleTarget(target, decision.hosts)
+	if err != nil {
+		return fmt.Errorf("failed to scale to %d hosts: %w", decision.hosts, err)
+	}
+
+	s.lastScaledAt[target.Name] = time.Now()
+
+	action := audit.ActionTargetScaleUp
+	direction := "up"
+	if decision.hosts < pool.Hosts {
+		action = audit.ActionTargetScaleDown
+		direction = "down"
+	}
+
+	message := fmt.Sprintf("Scaled %s from %d to %d hosts because %s", direction, pool.Hosts, decision.hosts, decision.reason)
+	log.Infof("Autoscaling target %s: %s", target.Name, message)
+
+	err = s.auditService.Record(&audit.Event{
+		Actor:    auditActor,
+		Action:   action,
+		Resource: target.Name,
+		Message:  message,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record the scale event: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package autoscaler_test
+
+import (
+	"testing"
+	"time"
+
+	t_audit "github.com/daytonaio/daytona/internal/testing/server/audit"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/provider"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/daytonaio/daytona/pkg/server/autoscaler"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTargetStore struct{}
+
+func (s *fakeTargetStore) Find(filter *provider.TargetFilter) (*provider.ProviderTarget, error) {
+	return &provider.ProviderTarget{Name: *filter.Name, ProviderInfo: provider.ProviderInfo{Name: "pool-provider"}}, nil
+}
+
+type fakeScaler struct {
+	pool provider.TargetPool
+}
+
+func (s *fakeScaler) GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error) {
+	pool := s.pool
+	return &pool, nil
+}
+
+func (s *fakeScaler) ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error) {
+	s.pool.Hosts = hosts
+	return s.GetTargetPool(target)
+}
+
+type testAutoscaler struct {
+	service      autoscaler.IAutoscalerService
+	scaler       *fakeScaler
+	auditService audit_service.IAuditService
+	allocation   *provider.TargetAllocation
+	demand       provider.CreationDemand
+}
+
+func newTestAutoscaler(t *testing.T, policy autoscaler.Policy, hosts uint32) *testAutoscaler {
+	a := &testAutoscaler{
+		scaler: &fakeScaler{pool: provider.TargetPool{Hosts: hosts}},
+		auditService: audit_service.NewAuditService(audit_service.AuditServiceConfig{
+			AuditEventStore: t_audit.NewInMemoryAuditEventStore(),
+		}),
+		allocation: &provider.TargetAllocation{
+			Target:          policy.Target,
+			Capacity:        &project.Resources{Cpus: 10, Memory: 10240},
+			OvercommitRatio: 1,
+		},
+	}
+
+	service, err := autoscaler.NewAutoscalerService(autoscaler.AutoscalerServiceConfig{
+		Policies:     []autoscaler.Policy{policy},
+		TargetStore:  &fakeTargetStore{},
+		Scaler:       a.scaler,
+		AuditService: a.auditService,
+		GetTargetAllocation: func(targetName string) (*provider.TargetAllocation, error) {
+			return a.allocation, nil
+		},
+		GetCreationDemand: func(targetName string, since time.Time) provider.CreationDemand {
+			return a.demand
+		},
+	})
+	require.NoError(t, err)
+
+	a.service = service
+	return a
+}
+
+func (a *testAutoscaler) events(t *testing.T) []*audit.Event {
+	events, err := a.auditService.List(nil)
+	require.NoError(t, err)
+	return events
+}
+
+func TestAutoscaler(t *testing.T) {
+	policy := autoscaler.Policy{Target: "pool", MinHosts: 1, MaxHosts: 3}
+
+	t.Run("Refused workspaces add a host", func(t *testing.T) {
+		a := newTestAutoscaler(t, policy, 1)
+		a.demand = provider.CreationDemand{Refused: 2}
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(2), a.scaler.pool.Hosts)
+
+		events := a.events(t)
+		require.Len(t, events, 1)
+		require.Equal(t, audit.ActionTargetScaleUp, events[0].Action)
+		require.Equal(t, "pool", events[0].Resource)
+		require.Equal(t, "autoscaler", events[0].Actor)
+		require.Contains(t, events[0].Message, "2 workspaces were refused")
+
+		// The cooldown holds back the next scale event
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(2), a.scaler.pool.Hosts)
+		require.Len(t, a.events(t), 1)
+	})
+
+	t.Run("High utilization adds a host up to the maximum", func(t *testing.T) {
+		a := newTestAutoscaler(t, policy, 2)
+		a.allocation.Reserved = project.Resources{Cpus: 9}
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(3), a.scaler.pool.Hosts)
+		require.Contains(t, a.events(t)[0].Message, "utilization of 90% is above 80%")
+
+		a = newTestAutoscaler(t, policy, 3)
+		a.allocation.Reserved = project.Resources{Cpus: 9}
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(3), a.scaler.pool.Hosts)
+		require.Empty(t, a.events(t))
+	})
+
+	t.Run("Low utilization drains a host", func(t *testing.T) {
+		a := newTestAutoscaler(t, policy, 3)
+		a.allocation.Used = project.Resources{Cpus: 1, Memory: 1024}
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(2), a.scaler.pool.Hosts)
+
+		events := a.events(t)
+		require.Len(t, events, 1)
+		require.Equal(t, audit.ActionTargetScaleDown, events[0].Action)
+	})
+
+	t.Run("Hosts are not drained while workspaces are being created", func(t *testing.T) {
+		a := newTestAutoscaler(t, policy, 3)
+		a.demand = provider.CreationDemand{InFlight: 1}
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(3), a.scaler.pool.Hosts)
+	})
+
+	t.Run("Pools without a known capacity are only brought within bounds", func(t *testing.T) {
+		a := newTestAutoscaler(t, autoscaler.Policy{Target: "pool", MinHosts: 2, MaxHosts: 3}, 0)
+		a.allocation.Capacity = nil
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(2), a.scaler.pool.Hosts)
+
+		require.NoError(t, a.service.Evaluate())
+		require.Equal(t, uint32(2), a.scaler.pool.Hosts)
+	})
+
+	t.Run("Invalid policies are rejected", func(t *testing.T) {
+		_, err := autoscaler.NewAutoscalerService(autoscaler.AutoscalerServiceConfig{
+			Policies: []autoscaler.Policy{{Target: "pool", MinHosts: 3, MaxHosts: 2}},
+		})
+		require.ErrorIs(t, err, autoscaler.ErrInvalidPolicy)
+
+		_, err = autoscaler.NewAutoscalerService(autoscaler.AutoscalerServiceConfig{
+			Policies: []autoscaler.Policy{{Target: "pool", MaxHosts: 2, ScaleUpUtilization: 0.2, ScaleDownUtilization: 0.5}},
+		})
+		require.ErrorIs(t, err, autoscaler.ErrInvalidPolicy)
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+)
+
+const (
+	defaultMaxClipboardKb = 1024
+	defaultMaxFileDropMb  = 100
+)
+
+// BrowserBridgePolicy decides which bridge messages are relayed between browser sessions and workspaces
+type BrowserBridgePolicy struct {
+	ClipboardToWorkspace   bool
+	ClipboardFromWorkspace bool
+	FileDrops              bool
+	MaxClipboardSize       int
+	MaxFileDropSize        int
+}
+
+// GetBrowserBridgePolicy applies the defaults to the config. Without a config clipboard content flows both ways
+// and file drops are allowed
+func GetBrowserBridgePolicy(c *BrowserBridgeConfig) (*BrowserBridgePolicy, error) {
+	if c == nil {
+		c = &BrowserBridgeConfig{}
+	}
+
+	policy := &BrowserBridgePolicy{
+		FileDrops:        !c.DisableFileDrops,
+		MaxClipboardSize: defaultMaxClipboardKb * 1024,
+		MaxFileDropSize:  defaultMaxFileDropMb * 1024 * 1024,
+	}
+
+	switch c.Clipboard {
+	case "", BridgeDirectionBoth:
+		policy.ClipboardToWorkspace = true
+		policy.ClipboardFromWorkspace = true
+	case BridgeDirectionToWorkspace:
+		policy.ClipboardToWorkspace = true
+	case BridgeDirectionFromWorkspace:
+		policy.ClipboardFromWorkspace = true
+	case BridgeDirectionNone:
+	default:
+		return nil, fmt.Errorf("unknown clipboard direction %q", c.Clipboard)
+	}
+
+	if c.MaxClipboardKb > 0 {
+		policy.MaxClipboardSize = int(c.MaxClipboardKb) * 1024
+	}
+	if policy.MaxClipboardSize > config.MAX_CLIPBOARD_SIZE {
+		return nil, fmt.Errorf("maxClipboardKb can not exceed %d", config.MAX_CLIPBOARD_SIZE/1024)
+	}
+
+	if c.MaxFileDropMb > 0 {
+		policy.MaxFileDropSize = int(c.MaxFileDropMb) * 1024 * 1024
+	}
+	if policy.MaxFileDropSize > config.MAX_FILE_DROP_SIZE {
+		return nil, fmt.Errorf("maxFileDropMb can not exceed %d", config.MAX_FILE_DROP_SIZE/1024/1024)
+	}
+
+	return policy, nil
+}
+
+// Disabled reports whether the policy rejects every message
+func (p *BrowserBridgePolicy) Disabled() bool {
+	return !p.ClipboardToWorkspace && !p.ClipboardFromWorkspace && !p.FileDrops
+}
+
+// CheckToWorkspace returns an error if a message from the browser must not reach the workspace
+func (p *BrowserBridgePolicy) CheckToWorkspace(msg dto.BridgeMessage) error {
+	switch msg.Type {
+	case dto.BridgeMessageTypeClipboard:
+		if !p.ClipboardToWorkspace {
+			return errors.New("copying to the workspace clipboard is disabled")
+		}
+		if len(msg.Data) > p.MaxClipboardSize {
+			return fmt.Errorf("clipboard content exceeds the %d KB limit", p.MaxClipboardSize/1024)
+		}
+	case dto.BridgeMessageTypeFileDrop:
+		if !p.FileDrops {
+			return errors.New("file drops are disabled")
+		}
+		if base64.StdEncoding.DecodedLen(len(msg.Data)) > p.MaxFileDropSize {
+			return fmt.Errorf("%s exceeds the %d MB file drop limit", msg.Name, p.MaxFileDropSize/1024/1024)
+		}
+	default:
+		return fmt.Errorf("unsupported message type %q", msg.Type)
+	}
+
+	return nil
+}
+
+// AllowFromWorkspace reports whether a message from the workspace may be relayed to the browser
+func (p *BrowserBridgePolicy) AllowFromWorkspace(msg dto.BridgeMessage) bool {
+	if msg.Type != dto.BridgeMessageTypeClipboard {
+		return true
+	}
+
+	return p.ClipboardFromWorkspace && len(msg.Data) <= p.MaxClipboardSize
+}
@@ -4,15 +4,23 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
 type BuildCreationData struct {
-	Image       string                     `json:"image" validate:"required"`
-	User        string                     `json:"user" validate:"required"`
-	BuildConfig *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
-	Repository  *gitprovider.GitRepository `json:"repository" validate:"optional"`
-	EnvVars     map[string]string          `json:"envVars" validate:"required"`
-	PrebuildId  string                     `json:"prebuildId" validate:"required"`
+	Image        string                     `json:"image" validate:"required"`
+	User         string                     `json:"user" validate:"required"`
+	BuildConfig  *buildconfig.BuildConfig   `json:"buildConfig" validate:"optional"`
+	Repository   *gitprovider.GitRepository `json:"repository" validate:"optional"`
+	EnvVars      map[string]string          `json:"envVars" validate:"required"`
+	PrebuildId   string                     `json:"prebuildId" validate:"required"`
+	Architecture *string                    `json:"architecture,omitempty" validate:"optional"`
+	Priority     build.BuildPriority        `json:"priority,omitempty" validate:"optional"`
+	BuildSecrets []string                   `json:"buildSecrets,omitempty" validate:"optional"`
+	// Organization of the project config the build is created for
+	OrganizationId string `json:"organizationId,omitempty" validate:"optional"`
+	// Set by the server from the request that creates the build
+	CorrelationId string `json:"-"`
 } // @name BuildCreationData
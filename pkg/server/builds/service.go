@@ -8,6 +8,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server/builds/dto"
@@ -21,6 +22,9 @@ type IBuildService interface {
 	List(filter *build.Filter) ([]*build.Build, error)
 	MarkForDeletion(filter *build.Filter, force bool) []error
 	Delete(id string) error
+	SetPriority(id string, priority build.BuildPriority) error
+	// MarkImagePrePulled records that the image of the published build was pulled onto its targets
+	MarkImagePrePulled(id string) error
 	AwaitEmptyList(time.Duration) error
 	GetBuildLogReader(buildId string) (io.Reader, error)
 }
@@ -45,6 +49,14 @@ func NewBuildService(config BuildServiceConfig) IBuildService {
 func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	var newBuild build.Build
 
+	if b.Priority == "" {
+		b.Priority = build.BuildPriorityNormal
+	}
+
+	if !b.Priority.IsValid() {
+		return "", build.ErrInvalidBuildPriority
+	}
+
 	id := stringid.GenerateRandomID()
 	id = stringid.TruncateID(id)
 
@@ -58,6 +70,11 @@ func (s *BuildService) Create(b dto.BuildCreationData) (string, error) {
 	newBuild.Repository = b.Repository
 	newBuild.EnvVars = b.EnvVars
 	newBuild.PrebuildId = b.PrebuildId
+	newBuild.Architecture = b.Architecture
+	newBuild.Priority = b.Priority
+	newBuild.CorrelationId = b.CorrelationId
+	newBuild.BuildSecrets = b.BuildSecrets
+	newBuild.OrganizationId = b.OrganizationId
 
 	err := s.buildStore.Save(&newBuild)
 	if err != nil {
@@ -103,6 +120,43 @@ func (s *BuildService) Delete(id string) error {
 	return s.buildStore.Delete(id)
 }
 
+// SetPriority changes the priority of a build. The build runner applies it the next time it schedules builds,
+// so raising the priority of a pending build can preempt running builds of a lower priority.
+func (s *BuildService) SetPriority(id string, priority build.BuildPriority) error {
+	if !priority.IsValid() {
+		return build.ErrInvalidBuildPriority
+	}
+
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	b.Priority = priority
+
+	return s.buildStore.Save(b)
+}
+
+func (s *BuildService) MarkImagePrePulled(id string) error {
+	b, err := s.buildStore.Find(&build.Filter{
+		Id: &id,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The build was deleted while the image was pulled
+	if b.State != build.BuildStatePublished {
+		return nil
+	}
+
+	b.ImagePrePulledAt = util.Pointer(time.Now())
+
+	return s.buildStore.Save(b)
+}
+
 func (s *BuildService) AwaitEmptyList(waitTime time.Duration) error {
 	timeout := time.NewTimer(waitTime)
 	defer timeout.Stop()
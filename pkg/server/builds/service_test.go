@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	build_internal "github.com/daytonaio/daytona/internal/testing/build"
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server/builds"
@@ -139,6 +140,36 @@ func (s *BuildServiceTestSuite) TestFind() {
 	require.Equal(build1, build)
 }
 
+func (s *BuildServiceTestSuite) TestFindArchitecture() {
+	require := s.Require()
+
+	arm64Build := *build1
+	arm64Build.Id = "id1-arm64"
+	arm64Build.Architecture = util.Pointer("arm64")
+	require.Nil(s.buildStore.Save(&arm64Build))
+
+	// Builds of other matrix variants are never substituted for the requested one
+	b, err := s.buildService.Find(&build.Filter{
+		Image:        &build1Image,
+		Architecture: util.Pointer(""),
+	})
+	require.Nil(err)
+	require.Equal(build1.Id, b.Id)
+
+	b, err = s.buildService.Find(&build.Filter{
+		Image:        &build1Image,
+		Architecture: util.Pointer("arm64"),
+	})
+	require.Nil(err)
+	require.Equal(arm64Build.Id, b.Id)
+
+	_, err = s.buildService.Find(&build.Filter{
+		Image:        &build1Image,
+		Architecture: util.Pointer("amd64"),
+	})
+	require.True(build.IsBuildNotFound(err))
+}
+
 func (s *BuildServiceTestSuite) TestSave() {
 	expectedBuilds = append(expectedBuilds, build4)
 
@@ -161,6 +192,22 @@ func (s *BuildServiceTestSuite) TestSave() {
 	require.Contains(expectedBuilds, build4)
 }
 
+func (s *BuildServiceTestSuite) TestSetPriority() {
+	require := s.Require()
+
+	err := s.buildService.SetPriority(build3.Id, build.BuildPriorityHigh)
+	require.Nil(err)
+
+	b, err := s.buildService.Find(&build.Filter{
+		Id: &build3.Id,
+	})
+	require.Nil(err)
+	require.Equal(build.BuildPriorityHigh, b.Priority)
+
+	err = s.buildService.SetPriority(build3.Id, "urgent")
+	require.True(build.IsInvalidBuildPriority(err))
+}
+
 func (s *BuildServiceTestSuite) TestMarkForDeletion() {
 	expectedBuilds = append(expectedBuilds, build3)
 
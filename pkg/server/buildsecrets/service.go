@@ -0,0 +1,92 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecrets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+)
+
+// IBuildSecretService manages the build secrets of organizations. Secrets of one organization are never visible to
+// another one
+type IBuildSecretService interface {
+	Delete(organizationId, name string) error
+	List(organizationId string) ([]*buildsecret.BuildSecret, error)
+	// Resolve returns the values of the secrets of the organization by name. Returns an error if any of the secrets
+	// is not set
+	Resolve(organizationId string, names []string) (map[string]string, error)
+	Set(organizationId, name, value string) error
+	// Validate returns an error if any of the names is not a valid name or is not set in the organization
+	Validate(organizationId string, names []string) error
+}
+
+type BuildSecretServiceConfig struct {
+	Store buildsecret.Store
+}
+
+type BuildSecretService struct {
+	store buildsecret.Store
+}
+
+func NewBuildSecretService(config BuildSecretServiceConfig) IBuildSecretService {
+	return &BuildSecretService{
+		store: config.Store,
+	}
+}
+
+func (s *BuildSecretService) List(organizationId string) ([]*buildsecret.BuildSecret, error) {
+	return s.store.List(organizationId)
+}
+
+func (s *BuildSecretService) Set(organizationId, name, value string) error {
+	if !buildsecret.IsValidName(name) {
+		return buildsecret.ErrInvalidName
+	}
+
+	return s.store.Save(&buildsecret.BuildSecret{
+		Name:           name,
+		OrganizationId: organizationId,
+		Value:          value,
+		UpdatedAt:      time.Now(),
+	})
+}
+
+func (s *BuildSecretService) Delete(organizationId, name string) error {
+	secret, err := s.store.Find(organizationId, name)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(secret)
+}
+
+func (s *BuildSecretService) Resolve(organizationId string, names []string) (map[string]string, error) {
+	values := map[string]string{}
+
+	for _, name := range names {
+		secret, err := s.store.Find(organizationId, name)
+		if err != nil {
+			if buildsecret.IsBuildSecretNotFound(err) {
+				return nil, fmt.Errorf("%w: %s", buildsecret.ErrBuildSecretNotSet, name)
+			}
+			return nil, err
+		}
+
+		values[name] = secret.Value
+	}
+
+	return values, nil
+}
+
+func (s *BuildSecretService) Validate(organizationId string, names []string) error {
+	err := buildsecret.ValidateNames(names)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.Resolve(organizationId, names)
+	return err
+}
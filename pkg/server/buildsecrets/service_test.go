@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecrets_test
+
+import (
+	"testing"
+
+	t_buildsecrets "github.com/daytonaio/daytona/internal/testing/server/buildsecrets"
+	"github.com/daytonaio/daytona/pkg/buildsecret"
+	"github.com/daytonaio/daytona/pkg/server/buildsecrets"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSecretService(t *testing.T) {
+	service := buildsecrets.NewBuildSecretService(buildsecrets.BuildSecretServiceConfig{
+		Store: t_buildsecrets.NewInMemoryBuildSecretStore(),
+	})
+
+	t.Run("SetBuildSecret", func(t *testing.T) {
+		require.Nil(t, service.Set("org1", "npm_token", "token1"))
+		require.Nil(t, service.Set("org1", "npm_token", "token2"))
+		require.Nil(t, service.Set("org1", "pip.conf", "conf"))
+		require.Nil(t, service.Set("org2", "npm_token", "token3"))
+
+		secrets, err := service.List("org1")
+		require.Nil(t, err)
+		require.Len(t, secrets, 2)
+		require.Equal(t, "npm_token", secrets[0].Name)
+		require.Equal(t, "token2", secrets[0].Value)
+	})
+
+	t.Run("SetInvalidName", func(t *testing.T) {
+		err := service.Set("org1", "id=token,src=/etc/passwd", "value")
+		require.True(t, buildsecret.IsInvalidName(err))
+	})
+
+	t.Run("ResolveBuildSecrets", func(t *testing.T) {
+		values, err := service.Resolve("org1", []string{"npm_token", "pip.conf"})
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"npm_token": "token2", "pip.conf": "conf"}, values)
+
+		_, err = service.Resolve("org1", []string{"npm_token", "ssh_key"})
+		require.True(t, buildsecret.IsBuildSecretNotSet(err))
+	})
+
+	t.Run("ResolveBuildSecretsOfOtherOrganization", func(t *testing.T) {
+		values, err := service.Resolve("org2", []string{"npm_token"})
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"npm_token": "token3"}, values)
+
+		_, err = service.Resolve("org2", []string{"pip.conf"})
+		require.True(t, buildsecret.IsBuildSecretNotSet(err))
+	})
+
+	t.Run("ValidateBuildSecrets", func(t *testing.T) {
+		require.Nil(t, service.Validate("org1", []string{"npm_token", "pip.conf"}))
+		require.True(t, buildsecret.IsBuildSecretNotSet(service.Validate("org2", []string{"pip.conf"})))
+		require.True(t, buildsecret.IsInvalidName(service.Validate("org1", []string{"id=token,src=/etc/passwd"})))
+	})
+
+	t.Run("DeleteBuildSecret", func(t *testing.T) {
+		err := service.Delete("org2", "pip.conf")
+		require.True(t, buildsecret.IsBuildSecretNotFound(err))
+
+		require.Nil(t, service.Delete("org1", "pip.conf"))
+
+		err = service.Delete("org1", "pip.conf")
+		require.True(t, buildsecret.IsBuildSecretNotFound(err))
+	})
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// GetCleanupPolicies parses and validates the configured cleanup policies
+func GetCleanupPolicies(configs []CleanupPolicyConfig) ([]workspace.CleanupPolicy, error) {
+	policies := []workspace.CleanupPolicy{}
+	names := map[string]bool{}
+
+	for _, c := range configs {
+		if c.Name == "" {
+			return nil, errors.New("cleanup policy name is required")
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicate cleanup policy %s", c.Name)
+		}
+		names[c.Name] = true
+
+		selector, err := workspace.ParseSelector(c.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("cleanup policy %s: %w", c.Name, err)
+		}
+
+		policy := workspace.CleanupPolicy{
+			Name:       c.Name,
+			Selector:   selector,
+			MaxRunning: int(c.MaxRunning),
+			DryRun:     c.DryRun,
+		}
+
+		if c.MaxAge != "" {
+			policy.MaxAge, err = time.ParseDuration(c.MaxAge)
+			if err != nil || policy.MaxAge <= 0 {
+				return nil, fmt.Errorf("cleanup policy %s: invalid max age %s", c.Name, c.MaxAge)
+			}
+		}
+
+		if c.MaxIdle != "" {
+			policy.MaxIdle, err = time.ParseDuration(c.MaxIdle)
+			if err != nil || policy.MaxIdle <= 0 {
+				return nil, fmt.Errorf("cleanup policy %s: invalid max idle time %s", c.Name, c.MaxIdle)
+			}
+		}
+
+		if policy.MaxAge == 0 && policy.MaxRunning == 0 && policy.MaxIdle == 0 {
+			return nil, fmt.Errorf("cleanup policy %s must set a max age, a max idle time or a max running count", c.Name)
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
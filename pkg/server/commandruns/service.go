@@ -0,0 +1,193 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandruns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	toolbox_dto "github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/commandrun"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/docker/docker/pkg/stringid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Older runs of a project command are deleted
+const maxRunsPerCommand = 50
+
+type ICommandRunService interface {
+	// Run executes a named command of the project through its agent and waits for it to exit
+	Run(ctx context.Context, p *project.Project, commandName string) (*commandrun.CommandRun, error)
+	List(filter *commandrun.Filter) ([]*commandrun.CommandRun, error)
+	Find(id string) (*commandrun.CommandRun, error)
+}
+
+type CommandRunServiceConfig struct {
+	CommandRunStore commandrun.Store
+	// Returns a client that reaches project agents over the tailnet
+	GetTailnetHttpClient func() *http.Client
+	// Opens a connection to a port of a project with agentless networking. Commands of such projects can not run if nil
+	ForwardProjectPort func(ctx context.Context, workspaceId string, projectName string, port uint16) (net.Conn, error)
+}
+
+func NewCommandRunService(config CommandRunServiceConfig) ICommandRunService {
+	return &CommandRunService{
+		commandRunStore:      config.CommandRunStore,
+		getTailnetHttpClient: config.GetTailnetHttpClient,
+		forwardProjectPort:   config.ForwardProjectPort,
+	}
+}
+
+type CommandRunService struct {
+	commandRunStore      commandrun.Store
+	getTailnetHttpClient func() *http.Client
+	forwardProjectPort   func(ctx context.Context, workspaceId string, projectName string, port uint16) (net.Conn, error)
+}
+
+func (s *CommandRunService) Run(ctx context.Context, p *project.Project, commandName string) (*commandrun.CommandRun, error) {
+	command, err := p.GetCommand(commandName)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &commandrun.CommandRun{
+		Id:          stringid.TruncateID(stringid.GenerateRandomID()),
+		WorkspaceId: p.WorkspaceId,
+		ProjectName: p.Name,
+		CommandName: command.Name,
+		Command:     command.Command,
+		State:       commandrun.CommandRunStateRunning,
+		StartedAt:   time.Now(),
+	}
+
+	err = s.commandRunStore.Save(run)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.runOnAgent(ctx, p, command)
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+
+	if err != nil {
+		run.State = commandrun.CommandRunStateError
+		run.Error = err.Error()
+	} else {
+		run.ExitCode = &res.ExitCode
+		run.Output = res.Output
+		run.Truncated = res.Truncated
+		run.TimedOut = res.TimedOut
+
+		run.State = commandrun.CommandRunStateSucceeded
+		if res.ExitCode != 0 || res.TimedOut {
+			run.State = commandrun.CommandRunStateFailed
+		}
+	}
+
+	err = s.commandRunStore.Save(run)
+	if err != nil {
+		return nil, err
+	}
+
+	s.pruneRuns(run)
+
+	return run, nil
+}
+
+func (s *CommandRunService) List(filter *commandrun.Filter) ([]*commandrun.CommandRun, error) {
+	return s.commandRunStore.List(filter)
+}
+
+func (s *CommandRunService) Find(id string) (*commandrun.CommandRun, error) {
+	return s.commandRunStore.Find(id)
+}
+
+func (s *CommandRunService) runOnAgent(ctx context.Context, p *project.Project, command *project.Command) (*toolbox_dto.RunCommandResponse, error) {
+	body, err := json.Marshal(toolbox_dto.RunCommandRequest{
+		Command: command.Command,
+		Workdir: command.Workdir,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := p.GetTailnetAddress(toolbox_config.TOOLBOX_PORT)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("http://%s/commands/run", address)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if correlationId := correlation.GetId(ctx); correlationId != "" {
+		req.Header.Set(correlation.CORRELATION_ID_HEADER, correlationId)
+	}
+
+	res, err := s.getHttpClient(p).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the project agent: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("project agent responded with status %d: %s", res.StatusCode, message)
+	}
+
+	var runResponse toolbox_dto.RunCommandResponse
+	err = json.NewDecoder(res.Body).Decode(&runResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runResponse, nil
+}
+
+func (s *CommandRunService) pruneRuns(run *commandrun.CommandRun) {
+	runs, err := s.commandRunStore.List(&commandrun.Filter{
+		WorkspaceId: &run.WorkspaceId,
+		ProjectName: &run.ProjectName,
+		CommandName: &run.CommandName,
+	})
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	for i := maxRunsPerCommand; i < len(runs); i++ {
+		err := s.commandRunStore.Delete(runs[i])
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
+
+// getHttpClient returns a client that reaches the agent of the project over the tailnet or through its provider
+func (s *CommandRunService) getHttpClient(p *project.Project) *http.Client {
+	if p.Networking != project.NetworkingAgentless || s.forwardProjectPort == nil {
+		return s.getTailnetHttpClient()
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return s.forwardProjectPort(ctx, p.WorkspaceId, p.Name, toolbox_config.TOOLBOX_PORT)
+			},
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package commandruns_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	t_commandruns "github.com/daytonaio/daytona/internal/testing/server/commandruns"
+	"github.com/daytonaio/daytona/pkg/agent/toolbox/dto"
+	"github.com/daytonaio/daytona/pkg/commandrun"
+	"github.com/daytonaio/daytona/pkg/server/commandruns"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRun(t *testing.T) {
+	agentReachable := true
+	client := &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			if !agentReachable {
+				return nil, errors.New("connection refused")
+			}
+
+			var runReq dto.RunCommandRequest
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&runReq))
+			require.Equal(t, "/commands/run", req.URL.Path)
+
+			recorder := httptest.NewRecorder()
+			exitCode := 0
+			if runReq.Command == "exit 1" {
+				exitCode = 1
+			}
+			require.NoError(t, json.NewEncoder(recorder).Encode(dto.RunCommandResponse{ExitCode: exitCode, Output: "ran " + runReq.Command}))
+			return recorder.Result(), nil
+		}),
+	}
+
+	store := t_commandruns.NewInMemoryCommandRunStore()
+	service := commandruns.NewCommandRunService(commandruns.CommandRunServiceConfig{
+		CommandRunStore:      store,
+		GetTailnetHttpClient: func() *http.Client { return client },
+	})
+
+	p := &project.Project{
+		Name:        "api",
+		WorkspaceId: "ws",
+		Commands: []project.Command{
+			{Name: "test", Command: "go test ./..."},
+			{Name: "lint", Command: "exit 1"},
+		},
+	}
+
+	run, err := service.Run(context.Background(), p, "test")
+	require.NoError(t, err)
+	require.Equal(t, commandrun.CommandRunStateSucceeded, run.State)
+	require.Equal(t, 0, *run.ExitCode)
+	require.Equal(t, "ran go test ./...", run.Output)
+
+	run, err = service.Run(context.Background(), p, "lint")
+	require.NoError(t, err)
+	require.Equal(t, commandrun.CommandRunStateFailed, run.State)
+	require.Equal(t, 1, *run.ExitCode)
+
+	agentReachable = false
+	run, err = service.Run(context.Background(), p, "test")
+	require.NoError(t, err)
+	require.Equal(t, commandrun.CommandRunStateError, run.State)
+	require.Nil(t, run.ExitCode)
+
+	_, err = service.Run(context.Background(), p, "seed")
+	require.ErrorIs(t, err, project.ErrCommandNotFound)
+
+	commandName := "test"
+	runs, err := service.List(&commandrun.Filter{CommandName: &commandName})
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+}
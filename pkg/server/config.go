@@ -14,7 +14,7 @@ import (
 )
 
 func GetConfig() (*Config, error) {
-	configFilePath, err := configFilePath()
+	configFilePath, err := GetConfigFilePath()
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +65,7 @@ func GetConfig() (*Config, error) {
 	return &c, nil
 }
 
-func configFilePath() (string, error) {
+func GetConfigFilePath() (string, error) {
 	configDir, err := GetConfigDir()
 	if err != nil {
 		return "", err
@@ -82,7 +82,7 @@ func Save(c Config) error {
 		return err
 	}
 
-	configFilePath, err := configFilePath()
+	configFilePath, err := GetConfigFilePath()
 	if err != nil {
 		return err
 	}
@@ -125,3 +125,23 @@ func directoryValidator(path *string) error {
 	}
 	return err
 }
+
+// CheckPersistentNodes returns ErrPersistentNodesNotAllowed if the caller may not generate persistent network keys.
+// Persistent nodes stay in the tailnet while they are offline, so they are limited to server administrators unless
+// the server config allows them
+func CheckPersistentNodes(serverAdmin bool) error {
+	if serverAdmin {
+		return nil
+	}
+
+	c, err := GetConfig()
+	if err != nil {
+		return err
+	}
+
+	if !c.AllowPersistentNodes {
+		return ErrPersistentNodesNotAllowed
+	}
+
+	return nil
+}
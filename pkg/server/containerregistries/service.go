@@ -75,7 +75,8 @@ func (s *ContainerRegistryService) Delete(server string) error {
 func getImageServer(imageName string) string {
 	parts := strings.Split(imageName, "/")
 
-	if len(parts) < 3 {
+	// The first component is only a registry host if it looks like one, e.g. "registry.example.com/image"
+	if len(parts) < 2 || (!strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost") {
 		return "docker.io"
 	}
 
@@ -51,5 +51,10 @@ func TestContainerRegistryService(t *testing.T) {
 
 		require.Nil(t, err)
 		require.EqualValues(t, crOrg, cr)
+
+		cr, err = service.FindByImageName("example.com/image:latest")
+
+		require.Nil(t, err)
+		require.EqualValues(t, crOrg, cr)
 	})
 }
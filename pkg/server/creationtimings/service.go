@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtimings
+
+import (
+	"errors"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidPhase = errors.New("invalid creation phase")
+
+func IsInvalidPhase(err error) bool {
+	return err.Error() == ErrInvalidPhase.Error()
+}
+
+type ICreationTimingService interface {
+	Record(timing *creationtiming.PhaseTiming) error
+	List(filter *creationtiming.Filter) ([]*creationtiming.PhaseTiming, error)
+	GetReport(filter *creationtiming.Filter) ([]creationtiming.PhaseReport, error)
+}
+
+type CreationTimingServiceConfig struct {
+	CreationTimingStore creationtiming.Store
+}
+
+func NewCreationTimingService(config CreationTimingServiceConfig) ICreationTimingService {
+	return &CreationTimingService{
+		creationTimingStore: config.CreationTimingStore,
+	}
+}
+
+type CreationTimingService struct {
+	creationTimingStore creationtiming.Store
+}
+
+func (s *CreationTimingService) Record(timing *creationtiming.PhaseTiming) error {
+	if !timing.Phase.IsValid() {
+		return ErrInvalidPhase
+	}
+
+	if timing.Id == "" {
+		timing.Id = uuid.NewString()
+	}
+
+	if timing.RecordedAt.IsZero() {
+		timing.RecordedAt = time.Now()
+	}
+
+	return s.creationTimingStore.Save(timing)
+}
+
+func (s *CreationTimingService) List(filter *creationtiming.Filter) ([]*creationtiming.PhaseTiming, error) {
+	return s.creationTimingStore.List(filter)
+}
+
+func (s *CreationTimingService) GetReport(filter *creationtiming.Filter) ([]creationtiming.PhaseReport, error) {
+	timings, err := s.creationTimingStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return creationtiming.GetReport(timings), nil
+}
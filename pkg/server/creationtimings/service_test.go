@@ -0,0 +1,56 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package creationtimings_test
+
+import (
+	"testing"
+	"time"
+
+	t_creationtimings "github.com/daytonaio/daytona/internal/testing/server/creationtimings"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreationTimingService(t *testing.T) {
+	service := creationtimings.NewCreationTimingService(creationtimings.CreationTimingServiceConfig{
+		CreationTimingStore: t_creationtimings.NewInMemoryCreationTimingStore(),
+	})
+
+	for _, target := range []string{"local", "remote"} {
+		for _, d := range []int64{100, 200, 300} {
+			err := service.Record(&creationtiming.PhaseTiming{
+				WorkspaceId: "ws",
+				ProjectName: "api",
+				Target:      target,
+				Provider:    "docker-provider",
+				Phase:       creationtiming.PhaseImagePull,
+				DurationMs:  d,
+			})
+			require.Nil(t, err)
+		}
+	}
+
+	t.Run("Record rejects unknown phases", func(t *testing.T) {
+		err := service.Record(&creationtiming.PhaseTiming{Phase: "unknown"})
+		require.True(t, creationtimings.IsInvalidPhase(err))
+	})
+
+	t.Run("GetReport filters by target", func(t *testing.T) {
+		target := "remote"
+		report, err := service.GetReport(&creationtiming.Filter{Target: &target})
+		require.Nil(t, err)
+		require.Len(t, report, 1)
+		require.Equal(t, "remote", report[0].Target)
+		require.Equal(t, 3, report[0].Count)
+		require.Equal(t, int64(200), report[0].P50Ms)
+	})
+
+	t.Run("GetReport filters by recording time", func(t *testing.T) {
+		since := time.Now().Add(time.Hour)
+		report, err := service.GetReport(&creationtiming.Filter{Since: &since})
+		require.Nil(t, err)
+		require.Empty(t, report)
+	})
+}
@@ -29,6 +29,7 @@ const defaultProjectUser = "daytona"
 const defaultLocalBuilderRegistryPort = 3988
 const defaultLocalBuilderRegistryImage = "registry:2.8.3"
 const defaultBuilderRegistryServer = "local"
+const defaultEmbeddedRegistryGcIntervalMinutes = 60
 const defaultBuildImageNamespace = ""
 
 var defaultLogFileConfig = LogFileConfig{
@@ -191,6 +192,9 @@ func getDefaultConfig() (*Config, error) {
 		BuilderRegistryServer:     defaultBuilderRegistryServer,
 		BuildImageNamespace:       defaultBuildImageNamespace,
 		SamplesIndexUrl:           defaultSamplesIndexUrl,
+		EmbeddedRegistry: &EmbeddedRegistryConfig{
+			GcIntervalMinutes: defaultEmbeddedRegistryGcIntervalMinutes,
+		},
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
@@ -0,0 +1,111 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"tailscale.com/tailcfg"
+)
+
+const (
+	EmbeddedDerpRegionId   = 999
+	EmbeddedDerpRegionCode = "local"
+)
+
+// ValidateDerpConfig checks that regions are unique and reachable
+func ValidateDerpConfig(c *DerpConfig) error {
+	if c == nil {
+		return nil
+	}
+
+	if c.DisableEmbedded && len(c.Regions) == 0 {
+		return errors.New("at least one region is required when the embedded relay is disabled")
+	}
+
+	codes := map[string]bool{}
+	if !c.DisableEmbedded {
+		codes[EmbeddedDerpRegionCode] = true
+	}
+	ids := map[uint32]bool{}
+
+	for _, region := range c.Regions {
+		if region.Id == 0 || region.Id == EmbeddedDerpRegionId {
+			return fmt.Errorf("region %s: id must be set and can not be %d", region.Code, EmbeddedDerpRegionId)
+		}
+		if region.Code == "" {
+			return fmt.Errorf("region %d: code is required", region.Id)
+		}
+		if ids[region.Id] {
+			return fmt.Errorf("duplicate region id %d", region.Id)
+		}
+		if codes[region.Code] {
+			return fmt.Errorf("duplicate region code %s", region.Code)
+		}
+		ids[region.Id] = true
+		codes[region.Code] = true
+
+		if len(region.Nodes) == 0 {
+			return fmt.Errorf("region %s: at least one node is required", region.Code)
+		}
+
+		for _, node := range region.Nodes {
+			if node.Name == "" || node.HostName == "" {
+				return fmt.Errorf("region %s: nodes require a name and a host name", region.Code)
+			}
+			if node.IPv4 != "" {
+				if addr, err := netip.ParseAddr(node.IPv4); err != nil || !addr.Is4() {
+					return fmt.Errorf("region %s: invalid IPv4 address %s of node %s", region.Code, node.IPv4, node.Name)
+				}
+			}
+			if node.IPv6 != "" {
+				if addr, err := netip.ParseAddr(node.IPv6); err != nil || !addr.Is6() {
+					return fmt.Errorf("region %s: invalid IPv6 address %s of node %s", region.Code, node.IPv6, node.Name)
+				}
+			}
+			if node.DerpPort > 65535 || node.StunPort > 65535 {
+				return fmt.Errorf("region %s: invalid port of node %s", region.Code, node.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetDerpMap returns the self-hosted regions of the config. The embedded relay is added by the control plane
+func GetDerpMap(c *DerpConfig) *tailcfg.DERPMap {
+	derpMap := &tailcfg.DERPMap{
+		Regions: map[int]*tailcfg.DERPRegion{},
+	}
+
+	if c == nil {
+		return derpMap
+	}
+
+	for _, region := range c.Regions {
+		derpRegion := &tailcfg.DERPRegion{
+			RegionID:   int(region.Id),
+			RegionCode: region.Code,
+			RegionName: region.Name,
+		}
+
+		for _, node := range region.Nodes {
+			derpRegion.Nodes = append(derpRegion.Nodes, &tailcfg.DERPNode{
+				Name:     node.Name,
+				RegionID: int(region.Id),
+				HostName: node.HostName,
+				IPv4:     node.IPv4,
+				IPv6:     node.IPv6,
+				DERPPort: int(node.DerpPort),
+				STUNPort: int(node.StunPort),
+			})
+		}
+
+		derpMap.Regions[int(region.Id)] = derpRegion
+	}
+
+	return derpMap
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// tunnelDialTimeout bounds the attempt to reach a project over the tailnet before the tunnel of its agent is used
+const tunnelDialTimeout = 5 * time.Second
+
+// DialProject connects to a port of the project over the tailnet or, if the project uses agentless networking,
+// through the API of its target. Projects whose agents opened a tunnel are only reached through it if the tailnet fails
+func (s *Server) DialProject(ctx context.Context, p *project.Project, port uint16) (net.Conn, error) {
+	if p.Networking == project.NetworkingAgentless {
+		return s.WorkspaceService.ForwardProjectPort(ctx, p.WorkspaceId, p.Name, port)
+	}
+
+	address, err := p.GetTailnetAddress(port)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.TunnelService == nil || !s.TunnelService.IsConnected(p.WorkspaceId, p.Name) {
+		return s.TailscaleServer.Dial(ctx, "tcp", address)
+	}
+
+	tailnetCtx, cancel := context.WithTimeout(ctx, tunnelDialTimeout)
+	defer cancel()
+
+	conn, err := s.TailscaleServer.Dial(tailnetCtx, "tcp", address)
+	if err == nil {
+		return conn, nil
+	}
+	log.Debugf("failed to reach project %s over the tailnet, using its tunnel: %v", p.Name, err)
+
+	return s.TunnelService.Dial(ctx, p.WorkspaceId, p.Name, port)
+}
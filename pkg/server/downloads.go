@@ -5,7 +5,10 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -58,3 +61,33 @@ func (s *Server) GetBinaryPath(binaryName, binaryVersion string) (string, error)
 
 	return binaryPath, nil
 }
+
+// GetBinaryChecksum returns the hex encoded SHA-256 checksum of the binary served for the name and version
+func (s *Server) GetBinaryChecksum(binaryName, binaryVersion string) (string, error) {
+	key := binaryVersion + "/" + binaryName
+	if checksum, ok := s.binaryChecksums.Load(key); ok {
+		return checksum.(string), nil
+	}
+
+	binaryPath, err := s.GetBinaryPath(binaryName, binaryVersion)
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, f)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	s.binaryChecksums.Store(key, checksum)
+
+	return checksum, nil
+}
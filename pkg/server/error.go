@@ -8,9 +8,14 @@ import (
 )
 
 var (
-	ErrLogFileNotFound = errors.New("log file not found")
+	ErrLogFileNotFound           = errors.New("log file not found")
+	ErrPersistentNodesNotAllowed = errors.New("persistent nodes are not allowed by the server config")
 )
 
 func IsLogFileNotFound(err error) bool {
 	return err.Error() == ErrLogFileNotFound.Error()
 }
+
+func IsPersistentNodesNotAllowed(err error) bool {
+	return err.Error() == ErrPersistentNodesNotAllowed.Error()
+}
@@ -5,6 +5,7 @@ package headscale
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	v1 "github.com/juanfont/headscale/gen/go/headscale/v1"
@@ -13,14 +14,17 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
-func (s *HeadscaleServer) CreateAuthKey() (string, error) {
+// CreateAuthKey creates a single use key. Nodes registered with the key are tagged with the given tags.
+// Ephemeral nodes are removed once they are inactive for EphemeralNodeInactivityTimeout.
+func (s *HeadscaleServer) CreateAuthKey(tags []string, expiresAt time.Time, ephemeral bool) (string, error) {
 	log.Debug("Creating headscale auth key")
 
 	request := &v1.CreatePreAuthKeyRequest{
 		Reusable:   false,
 		User:       "daytona",
-		Ephemeral:  true,
-		Expiration: timestamppb.New(time.Now().Add(100000 * time.Hour)),
+		Ephemeral:  ephemeral,
+		Expiration: timestamppb.New(expiresAt),
+		AclTags:    tags,
 	}
 
 	ctx, client, conn, cancel, err := s.getClient()
@@ -39,3 +43,60 @@ func (s *HeadscaleServer) CreateAuthKey() (string, error) {
 
 	return response.PreAuthKey.Key, nil
 }
+
+func (s *HeadscaleServer) ExpireAuthKey(key string) error {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+	defer cancel()
+	defer conn.Close()
+
+	_, err = client.ExpirePreAuthKey(ctx, &v1.ExpirePreAuthKeyRequest{
+		User: "daytona",
+		Key:  key,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to expire auth key: %w", err)
+	}
+
+	return nil
+}
+
+func (s *HeadscaleServer) DeleteNodes(authKeys []string, tags []string) error {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+	defer cancel()
+	defer conn.Close()
+
+	response, err := client.ListNodes(ctx, &v1.ListNodesRequest{
+		User: "daytona",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range response.Nodes {
+		registeredWithKey := node.PreAuthKey != nil && slices.Contains(authKeys, node.PreAuthKey.Key)
+		tagged := slices.ContainsFunc(node.ForcedTags, func(tag string) bool {
+			return slices.Contains(tags, tag)
+		})
+
+		if !registeredWithKey && !tagged {
+			continue
+		}
+
+		log.Debugf("Removing node %s from the network", node.Name)
+
+		_, err = client.DeleteNode(ctx, &v1.DeleteNodeRequest{
+			NodeId: node.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete node %s: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
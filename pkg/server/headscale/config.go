@@ -5,6 +5,7 @@ package headscale
 
 import (
 	"fmt"
+	"io"
 	"io/fs"
 	"net/netip"
 	"os"
@@ -12,6 +13,8 @@ import (
 	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	hstypes "github.com/juanfont/headscale/hscontrol/types"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
@@ -20,18 +23,25 @@ import (
 )
 
 func (s *HeadscaleServer) getHeadscaleConfig() (*hstypes.Config, error) {
+	derpMapPath, err := s.writeDerpMap()
+	if err != nil {
+		return nil, err
+	}
+
+	embeddedDerp := s.derp == nil || !s.derp.DisableEmbedded
+
 	cfg := &hstypes.Config{
 		ServerURL:                      fmt.Sprintf("https://%s.%s", s.serverId, s.frpsDomain),
 		Addr:                           fmt.Sprintf("0.0.0.0:%d", s.headscalePort),
 		EphemeralNodeInactivityTimeout: 5 * time.Minute,
-		BaseDomain:                     "daytona.local",
+		BaseDomain:                     project.TailnetDomain,
 		DERP: hstypes.DERPConfig{
-			ServerEnabled:                      true,
-			AutomaticallyAddEmbeddedDerpRegion: true,
-			ServerRegionID:                     999,
-			ServerRegionCode:                   "local",
+			ServerEnabled:                      embeddedDerp,
+			AutomaticallyAddEmbeddedDerpRegion: embeddedDerp,
+			ServerRegionID:                     server.EmbeddedDerpRegionId,
+			ServerRegionCode:                   server.EmbeddedDerpRegionCode,
 			ServerRegionName:                   "Daytona embedded DERP",
-			Paths:                              []string{},
+			Paths:                              []string{derpMapPath},
 			ServerPrivateKeyPath:               filepath.Join(s.configDir, "derp_server_private.key"),
 			UpdateFrequency:                    24 * time.Hour,
 			AutoUpdate:                         true,
@@ -85,13 +95,21 @@ func (s *HeadscaleServer) getHeadscaleConfig() (*hstypes.Config, error) {
 	cfg.PrefixV4 = &v4Prefix
 	cfg.PrefixV6 = &v6Prefix
 
+	// Headscale logs are written to the server logs at debug, unless a headscale level is configured
+	var logWriter io.Writer = &util.DebugLogWriter{}
+
 	logLevelEnv, logLevelSet := os.LookupEnv("LOG_LEVEL")
 	if logLevelSet {
-		var err error
 		cfg.Log.Level, err = zerolog.ParseLevel(logLevelEnv)
 		if err != nil {
 			cfg.Log.Level = zerolog.ErrorLevel
 		}
+	} else if s.logLevel != "" {
+		cfg.Log.Level, err = zerolog.ParseLevel(s.logLevel)
+		if err != nil {
+			cfg.Log.Level = zerolog.ErrorLevel
+		}
+		logWriter = &util.InfoLogWriter{}
 	} else {
 		cfg.Log.Level = zerolog.ErrorLevel
 	}
@@ -99,7 +117,7 @@ func (s *HeadscaleServer) getHeadscaleConfig() (*hstypes.Config, error) {
 	zerolog.SetGlobalLevel(cfg.Log.Level)
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	log.Logger = log.Output(zerolog.ConsoleWriter{
-		Out:        &util.DebugLogWriter{},
+		Out:        logWriter,
 		TimeFormat: time.RFC3339,
 	})
 
@@ -4,13 +4,17 @@
 package headscale
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"path/filepath"
+	"time"
 
 	"tailscale.com/tsnet"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/networkkey"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -24,7 +28,7 @@ func (s *HeadscaleServer) Connect() error {
 		log.Fatal(err)
 	}
 
-	authKey, err := s.CreateAuthKey()
+	authKey, err := s.CreateAuthKey([]string{networkkey.GetScopeTag(networkkey.ScopeServer, "server")}, time.Now().Add(time.Hour), true)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -59,3 +63,7 @@ func (s *HeadscaleServer) Connect() error {
 func (s *HeadscaleServer) HTTPClient() *http.Client {
 	return tsNetServer.HTTPClient()
 }
+
+func (s *HeadscaleServer) Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	return tsNetServer.Dial(ctx, network, address)
+}
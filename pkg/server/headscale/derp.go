@@ -0,0 +1,24 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package headscale
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"gopkg.in/yaml.v3"
+)
+
+// writeDerpMap writes the self-hosted relay regions in the format Headscale loads DERP map paths in
+func (s *HeadscaleServer) writeDerpMap() (string, error) {
+	content, err := yaml.Marshal(server.GetDerpMap(s.derp))
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.configDir, "derp.yaml")
+
+	return path, os.WriteFile(path, content, 0600)
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package headscale
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	hsderp "github.com/juanfont/headscale/hscontrol/derp"
+	hstypes "github.com/juanfont/headscale/hscontrol/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDerpMap(t *testing.T) {
+	derpConfig := &server.DerpConfig{
+		DisableEmbedded: true,
+		Regions: []server.DerpRegion{
+			{
+				Id:   900,
+				Code: "eu",
+				Name: "Frankfurt",
+				Nodes: []server.DerpNode{
+					{Name: "900a", HostName: "derp-eu.example.com", IPv4: "203.0.113.10", DerpPort: 8443},
+				},
+			},
+		},
+	}
+	require.NoError(t, server.ValidateDerpConfig(derpConfig))
+
+	s := NewHeadscaleServer(&HeadscaleServerConfig{ConfigDir: t.TempDir(), Derp: derpConfig})
+	path, err := s.writeDerpMap()
+	require.NoError(t, err)
+
+	// The written file must be loadable by Headscale
+	derpMap := hsderp.GetDERPMap(hstypes.DERPConfig{Paths: []string{path}})
+	require.Len(t, derpMap.Regions, 1)
+	region := derpMap.Regions[900]
+	require.NotNil(t, region)
+	require.Equal(t, "eu", region.RegionCode)
+	require.Len(t, region.Nodes, 1)
+	require.Equal(t, "derp-eu.example.com", region.Nodes[0].HostName)
+	require.Equal(t, 8443, region.Nodes[0].DERPPort)
+
+	derpConfig.DisableEmbedded = false
+	require.NoError(t, server.ValidateDerpConfig(derpConfig))
+
+	derpConfig.Regions = append(derpConfig.Regions, server.DerpRegion{Id: 901, Code: "eu", Nodes: derpConfig.Regions[0].Nodes})
+	require.ErrorContains(t, server.ValidateDerpConfig(derpConfig), "duplicate region code eu")
+}
@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package headscale
+
+import (
+	"fmt"
+
+	v1 "github.com/juanfont/headscale/gen/go/headscale/v1"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// RenameNode sets the DNS name of the nodes registered with or currently named after the hostname
+func (s *HeadscaleServer) RenameNode(hostname, newHostname string) error {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+	defer cancel()
+	defer conn.Close()
+
+	response, err := client.ListNodes(ctx, &v1.ListNodesRequest{
+		User: "daytona",
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range response.Nodes {
+		if node.Name != hostname && node.GivenName != hostname {
+			continue
+		}
+
+		log.Debugf("Renaming node %s to %s", node.GivenName, newHostname)
+
+		_, err = client.RenameNode(ctx, &v1.RenameNodeRequest{
+			NodeId:  node.Id,
+			NewName: newHostname,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rename node %s: %w", node.GivenName, err)
+		}
+	}
+
+	return nil
+}
+
+// TakeOverHostname gives the hostname to the node that registered with the auth key and removes the other nodes
+// registered with or currently named after the hostname. Nothing is changed and false is returned if no node
+// registered with the auth key yet
+func (s *HeadscaleServer) TakeOverHostname(hostname, authKey string) (bool, error) {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get client: %w", err)
+	}
+	defer cancel()
+	defer conn.Close()
+
+	response, err := client.ListNodes(ctx, &v1.ListNodesRequest{
+		User: "daytona",
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	var newNode *v1.Node
+	for _, node := range response.Nodes {
+		if node.PreAuthKey != nil && node.PreAuthKey.Key == authKey {
+			newNode = node
+			break
+		}
+	}
+
+	if newNode == nil {
+		return false, nil
+	}
+
+	for _, node := range response.Nodes {
+		if node.Id == newNode.Id || (node.Name != hostname && node.GivenName != hostname) {
+			continue
+		}
+
+		log.Debugf("Removing node %s to release its hostname", node.GivenName)
+
+		_, err = client.DeleteNode(ctx, &v1.DeleteNodeRequest{
+			NodeId: node.Id,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to delete node %s: %w", node.GivenName, err)
+		}
+	}
+
+	if newNode.GivenName != hostname {
+		log.Debugf("Renaming node %s to %s", newNode.GivenName, hostname)
+
+		_, err = client.RenameNode(ctx, &v1.RenameNodeRequest{
+			NodeId:  newNode.Id,
+			NewName: hostname,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to rename node %s: %w", newNode.GivenName, err)
+		}
+	}
+
+	return true, nil
+}
+
+// IsHostnameRegistered checks if a node is registered with or currently named after the hostname
+func (s *HeadscaleServer) IsHostnameRegistered(hostname string) (bool, error) {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to get client: %w", err)
+	}
+	defer cancel()
+	defer conn.Close()
+
+	response, err := client.ListNodes(ctx, &v1.ListNodesRequest{
+		User: "daytona",
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, node := range response.Nodes {
+		if node.Name == hostname || node.GivenName == hostname {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
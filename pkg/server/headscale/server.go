@@ -24,6 +24,9 @@ type HeadscaleServerConfig struct {
 	HeadscalePort uint32
 	ConfigDir     string
 	Frps          *server.FRPSConfig
+	Derp          *server.DerpConfig
+	// Log level of headscale. Headscale logs at error if empty
+	LogLevel string
 }
 
 func NewHeadscaleServer(config *HeadscaleServerConfig) *HeadscaleServer {
@@ -34,6 +37,8 @@ func NewHeadscaleServer(config *HeadscaleServerConfig) *HeadscaleServer {
 		headscalePort: config.HeadscalePort,
 		configDir:     config.ConfigDir,
 		frps:          config.Frps,
+		derp:          config.Derp,
+		logLevel:      config.LogLevel,
 	}
 }
 
@@ -44,6 +49,8 @@ type HeadscaleServer struct {
 	headscalePort uint32
 	configDir     string
 	frps          *server.FRPSConfig
+	derp          *server.DerpConfig
+	logLevel      string
 
 	stopChan       chan struct{}
 	disconnectChan chan struct{}
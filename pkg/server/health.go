@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// GetProjectAgentHealth queries the health endpoint the project agent serves on the tailnet.
+// Projects with agentless networking do not run the tailnet listener and can not be queried
+func (s *Server) GetProjectAgentHealth(ctx context.Context, p *project.Project) (*project.AgentHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s/health", p.GetHostname()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.TailscaleServer.HTTPClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the project agent: %w", err)
+	}
+	defer res.Body.Close()
+
+	// Agents built before the health endpoint answer every path with a plain text Ok
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "application/json" {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("project agent does not report its health, status %d: %s", res.StatusCode, message)
+	}
+
+	var health project.AgentHealth
+	err = json.NewDecoder(res.Body).Decode(&health)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health, nil
+}
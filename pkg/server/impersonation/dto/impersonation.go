@@ -0,0 +1,12 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type StartImpersonationDTO struct {
+	// Name of the API key, OIDC user or certificate of the user to act on behalf of
+	User   string `json:"user" validate:"required"`
+	Reason string `json:"reason" validate:"required"`
+	// Defaults to 30 minutes
+	DurationMinutes uint32 `json:"durationMinutes,omitempty" validate:"optional"`
+} // @name StartImpersonationDTO
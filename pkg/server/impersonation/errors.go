@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation
+
+import "errors"
+
+var (
+	ErrNotAnAdmin             = errors.New("only the administrators listed in the impersonation config can impersonate users")
+	ErrUserRequired           = errors.New("user is required")
+	ErrReasonRequired         = errors.New("a reason is required to impersonate a user")
+	ErrCannotImpersonateAdmin = errors.New("administrators can not be impersonated")
+	ErrDurationTooLong        = errors.New("duration exceeds the maximum impersonation duration")
+	ErrSessionNotActive       = errors.New("impersonation session has ended or expired")
+)
+
+func IsNotAnAdmin(err error) bool {
+	return err.Error() == ErrNotAnAdmin.Error()
+}
+
+func IsSessionNotActive(err error) bool {
+	return err.Error() == ErrSessionNotActive.Error()
+}
+
+func IsInvalidImpersonationRequest(err error) bool {
+	return err.Error() == ErrUserRequired.Error() || err.Error() == ErrReasonRequired.Error() || err.Error() == ErrCannotImpersonateAdmin.Error() || err.Error() == ErrDurationTooLong.Error()
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/announcement"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/impersonation"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
+	announcements_dto "github.com/daytonaio/daytona/pkg/server/announcements/dto"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+	"github.com/daytonaio/daytona/pkg/server/impersonation/dto"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultSessionDuration = 30 * time.Minute
+	DefaultMaxDuration     = 4 * time.Hour
+)
+
+// Users that do not run the CLI while they are impersonated are notified the next time they do
+const notificationRetention = 7 * 24 * time.Hour
+
+type IImpersonationService interface {
+	// Start lets the admin act on behalf of the user until the session expires or is ended. The start is recorded
+	// in the audit log and the user is notified
+	Start(admin string, serverAdmin bool, req dto.StartImpersonationDTO) (*impersonation.Session, error)
+	// End ends an active session of the admin before it expires
+	End(id, admin string, serverAdmin bool) (*impersonation.Session, error)
+	List(filter *impersonation.Filter) ([]*impersonation.Session, error)
+	// Authorize returns the session if it is active and was started by the admin
+	Authorize(id, admin string, serverAdmin bool) (*impersonation.Session, error)
+	// RecordRequest records a request made on behalf of the user of the session in the audit log
+	RecordRequest(session *impersonation.Session, method, path string) error
+	// IsAdmin returns true if the caller authenticated as a server admin and is one of the configured admins. Names
+	// alone are not trusted since any client can generate a key with the name of an admin
+	IsAdmin(name string, serverAdmin bool) bool
+}
+
+type ImpersonationServiceConfig struct {
+	ImpersonationSessionStore impersonation.Store
+	AuditService              audit_service.IAuditService
+	AnnouncementService       announcements.IAnnouncementService
+	// Names of the server admins that can impersonate users. Impersonation is disabled if empty
+	Admins []string
+	// Longest session an admin can start. Defaults to DefaultMaxDuration
+	MaxDuration time.Duration
+}
+
+func NewImpersonationService(config ImpersonationServiceConfig) IImpersonationService {
+	maxDuration := config.MaxDuration
+	if maxDuration == 0 {
+		maxDuration = DefaultMaxDuration
+	}
+
+	return &ImpersonationService{
+		impersonationSessionStore: config.ImpersonationSessionStore,
+		auditService:              config.AuditService,
+		announcementService:       config.AnnouncementService,
+		admins:                    config.Admins,
+		maxDuration:               maxDuration,
+	}
+}
+
+type ImpersonationService struct {
+	impersonationSessionStore impersonation.Store
+	auditService              audit_service.IAuditService
+	announcementService       announcements.IAnnouncementService
+	admins                    []string
+	maxDuration               time.Duration
+}
+
+func (s *ImpersonationService) Start(admin string, serverAdmin bool, req dto.StartImpersonationDTO) (*impersonation.Session, error) {
+	if !s.IsAdmin(admin, serverAdmin) {
+		return nil, ErrNotAnAdmin
+	}
+
+	if req.User == "" {
+		return nil, ErrUserRequired
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, ErrReasonRequired
+	}
+
+	// Also prevents admins from impersonating themselves
+	if slices.Contains(s.admins, req.User) {
+		return nil, ErrCannotImpersonateAdmin
+	}
+
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	if duration == 0 {
+		duration = min(DefaultSessionDuration, s.maxDuration)
+	}
+	if duration > s.maxDuration {
+		return nil, ErrDurationTooLong
+	}
+
+	now := time.Now()
+	session := &impersonation.Session{
+		Id:        uuid.NewString(),
+		Admin:     admin,
+		User:      req.User,
+		Reason:    reason,
+		CreatedAt: now,
+		ExpiresAt: now.Add(duration),
+	}
+
+	err := s.impersonationSessionStore.Save(session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auditService.Record(&audit.Event{
+		Actor:    admin,
+		Action:   audit.ActionImpersonationStart,
+		Resource: session.User,
+		Message:  fmt.Sprintf("Started acting on behalf of %s until %s: %s", session.User, session.ExpiresAt.Format(time.RFC3339), reason),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := session.ExpiresAt.Add(notificationRetention)
+	_, err = s.announcementService.Create(announcements_dto.CreateAnnouncementDTO{
+		Type:      announcement.AnnouncementTypeInfo,
+		Title:     fmt.Sprintf("Administrator %s is acting on your behalf", admin),
+		Message:   fmt.Sprintf("Reason: %s. Access ends at %s at the latest.", reason, session.ExpiresAt.Format(time.RFC1123)),
+		ExpiresAt: &expiresAt,
+		Recipient: session.User,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *ImpersonationService) End(id, admin string, serverAdmin bool) (*impersonation.Session, error) {
+	session, err := s.Authorize(id, admin, serverAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session.EndedAt = &now
+
+	err = s.impersonationSessionStore.Save(session)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auditService.Record(&audit.Event{
+		Actor:    admin,
+		Action:   audit.ActionImpersonationEnd,
+		Resource: session.User,
+		Message:  fmt.Sprintf("Stopped acting on behalf of %s", session.User),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *ImpersonationService) List(filter *impersonation.Filter) ([]*impersonation.Session, error) {
+	return s.impersonationSessionStore.List(filter)
+}
+
+func (s *ImpersonationService) Authorize(id, admin string, serverAdmin bool) (*impersonation.Session, error) {
+	// Admins that were removed from the config can no longer use the sessions they started
+	if !s.IsAdmin(admin, serverAdmin) {
+		return nil, ErrNotAnAdmin
+	}
+
+	session, err := s.impersonationSessionStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sessions of other admins are not revealed
+	if session.Admin != admin {
+		return nil, impersonation.ErrSessionNotFound
+	}
+
+	if !session.IsActive(time.Now()) {
+		return nil, ErrSessionNotActive
+	}
+
+	return session, nil
+}
+
+func (s *ImpersonationService) RecordRequest(session *impersonation.Session, method, path string) error {
+	return s.auditService.Record(&audit.Event{
+		Actor:          session.User,
+		Action:         audit.ActionImpersonationRequest,
+		Resource:       path,
+		Message:        fmt.Sprintf("%s %s in impersonation session %s: %s", method, path, session.Id, session.Reason),
+		ImpersonatedBy: session.Admin,
+	})
+}
+
+func (s *ImpersonationService) IsAdmin(name string, serverAdmin bool) bool {
+	return serverAdmin && slices.Contains(s.admins, name)
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package impersonation_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	t_announcements "github.com/daytonaio/daytona/internal/testing/server/announcements"
+	t_audit "github.com/daytonaio/daytona/internal/testing/server/audit"
+	t_impersonation "github.com/daytonaio/daytona/internal/testing/server/impersonation"
+	"github.com/daytonaio/daytona/pkg/audit"
+	"github.com/daytonaio/daytona/pkg/impersonation"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
+	audit_service "github.com/daytonaio/daytona/pkg/server/audit"
+	impersonation_service "github.com/daytonaio/daytona/pkg/server/impersonation"
+	"github.com/daytonaio/daytona/pkg/server/impersonation/dto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImpersonationService(t *testing.T) {
+	auditService := audit_service.NewAuditService(audit_service.AuditServiceConfig{
+		AuditEventStore: t_audit.NewInMemoryAuditEventStore(),
+	})
+	announcementService := announcements.NewAnnouncementService(announcements.AnnouncementServiceConfig{
+		AnnouncementStore: t_announcements.NewInMemoryAnnouncementStore(),
+	})
+
+	service := impersonation_service.NewImpersonationService(impersonation_service.ImpersonationServiceConfig{
+		ImpersonationSessionStore: t_impersonation.NewInMemoryImpersonationSessionStore(),
+		AuditService:              auditService,
+		AnnouncementService:       announcementService,
+		Admins:                    []string{"support", "admin"},
+		MaxDuration:               time.Hour,
+	})
+
+	var session *impersonation.Session
+
+	t.Run("Start requires an admin and a reason", func(t *testing.T) {
+		_, err := service.Start("alice", true, dto.StartImpersonationDTO{User: "bob", Reason: "ticket 42"})
+		require.Equal(t, impersonation_service.ErrNotAnAdmin, err)
+
+		// A client key named after an admin is not enough
+		_, err = service.Start("support", false, dto.StartImpersonationDTO{User: "bob", Reason: "ticket 42"})
+		require.Equal(t, impersonation_service.ErrNotAnAdmin, err)
+
+		_, err = service.Start("support", true, dto.StartImpersonationDTO{User: "bob", Reason: " "})
+		require.Equal(t, impersonation_service.ErrReasonRequired, err)
+
+		_, err = service.Start("support", true, dto.StartImpersonationDTO{User: "admin", Reason: "ticket 42"})
+		require.Equal(t, impersonation_service.ErrCannotImpersonateAdmin, err)
+
+		_, err = service.Start("support", true, dto.StartImpersonationDTO{User: "bob", Reason: "ticket 42", DurationMinutes: 90})
+		require.Equal(t, impersonation_service.ErrDurationTooLong, err)
+	})
+
+	t.Run("Start notifies the user and records the start", func(t *testing.T) {
+		var err error
+		session, err = service.Start("support", true, dto.StartImpersonationDTO{User: "bob", Reason: "ticket 42"})
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(impersonation_service.DefaultSessionDuration), session.ExpiresAt, time.Minute)
+
+		notifications, err := announcementService.List(false)
+		require.Nil(t, err)
+		require.Len(t, notifications, 1)
+		require.Equal(t, "bob", notifications[0].Recipient)
+		require.Contains(t, notifications[0].Message, "ticket 42")
+
+		events, err := auditService.List(nil)
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, audit.ActionImpersonationStart, events[0].Action)
+		require.Equal(t, "support", events[0].Actor)
+	})
+
+	t.Run("Authorize only accepts active sessions of the admin", func(t *testing.T) {
+		_, err := service.Authorize(session.Id, "support", true)
+		require.Nil(t, err)
+
+		_, err = service.Authorize(session.Id, "admin", true)
+		require.True(t, impersonation.IsSessionNotFound(err))
+
+		_, err = service.Authorize(session.Id, "alice", true)
+		require.True(t, impersonation_service.IsNotAnAdmin(err))
+
+		_, err = service.Authorize(session.Id, "support", false)
+		require.True(t, impersonation_service.IsNotAnAdmin(err))
+	})
+
+	t.Run("RecordRequest marks the event as impersonated", func(t *testing.T) {
+		err := service.RecordRequest(session, http.MethodGet, "/workspace/")
+		require.Nil(t, err)
+
+		action := audit.ActionImpersonationRequest
+		events, err := auditService.List(&audit.Filter{Action: &action})
+		require.Nil(t, err)
+		require.Len(t, events, 1)
+		require.Equal(t, "bob", events[0].Actor)
+		require.Equal(t, "support", events[0].ImpersonatedBy)
+	})
+
+	t.Run("End deactivates the session", func(t *testing.T) {
+		_, err := service.End(session.Id, "support", true)
+		require.Nil(t, err)
+
+		_, err = service.Authorize(session.Id, "support", true)
+		require.True(t, impersonation_service.IsSessionNotActive(err))
+	})
+}
+
+func TestIsRouteAllowed(t *testing.T) {
+	require.True(t, impersonation.IsRouteAllowed(http.MethodGet, "/workspace/:workspaceId"))
+	require.True(t, impersonation.IsRouteAllowed(http.MethodGet, "/log/workspace/:workspaceId"))
+	require.True(t, impersonation.IsRouteAllowed(http.MethodPost, "/workspace/"))
+	require.False(t, impersonation.IsRouteAllowed(http.MethodGet, "/workspace/:workspaceId/:projectId/toolbox/files"))
+	require.False(t, impersonation.IsRouteAllowed(http.MethodDelete, "/workspace/:workspaceId"))
+	require.False(t, impersonation.IsRouteAllowed(http.MethodGet, "/apikey/"))
+	require.False(t, impersonation.IsRouteAllowed(http.MethodGet, "/log/server"))
+	require.False(t, impersonation.IsRouteAllowed(http.MethodPost, "/workspace/:workspaceId/stop"))
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/db"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/projectconfig"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+)
+
+// TargetName is the name of the target the workspaces of the load test are created on
+const TargetName = "loadtest"
+
+// NewWorkspaceService returns a workspace service backed by a SQLite database in the directory, like the one of the
+// Daytona Server, and a provider that takes the latency to complete every operation
+func NewWorkspaceService(dir string, providerLatency time.Duration) (workspaces.IWorkspaceService, error) {
+	dbConnection := db.GetSQLiteConnection(filepath.Join(dir, "db"))
+
+	apiKeyStore, err := db.NewApiKeyStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	containerRegistryStore, err := db.NewContainerRegistryStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	buildStore, err := db.NewBuildStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	projectConfigStore, err := db.NewProjectConfigStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	prebuildUsageStore, err := db.NewPrebuildUsageStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	gitProviderConfigStore, err := db.NewGitProviderConfigStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	providerTargetStore, err := db.NewProviderTargetStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceStore, err := db.NewWorkspaceStore(dbConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	err = providerTargetStore.Save(&provider.ProviderTarget{
+		Name: TargetName,
+		ProviderInfo: provider.ProviderInfo{
+			Name:    TargetName,
+			Version: "v0.0.0",
+		},
+		Options:   "{}",
+		IsDefault: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceLogsDir := filepath.Join(dir, "logs")
+	buildLogsDir := filepath.Join(dir, "build-logs")
+	loggerFactory := logs.NewLoggerFactory(&workspaceLogsDir, &buildLogsDir)
+
+	buildService := builds.NewBuildService(builds.BuildServiceConfig{
+		BuildStore:    buildStore,
+		LoggerFactory: loggerFactory,
+	})
+
+	gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore:        gitProviderConfigStore,
+		ProjectConfigStore: projectConfigStore,
+	})
+
+	return workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    providerTargetStore,
+		ApiKeyService: apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+			ApiKeyStore: apiKeyStore,
+		}),
+		ContainerRegistryService: containerregistries.NewContainerRegistryService(containerregistries.ContainerRegistryServiceConfig{
+			Store: containerRegistryStore,
+		}),
+		BuildService: buildService,
+		ProjectConfigService: projectconfig.NewProjectConfigService(projectconfig.ProjectConfigServiceConfig{
+			ConfigStore:        projectConfigStore,
+			PrebuildUsageStore: prebuildUsageStore,
+			BuildService:       buildService,
+			GitProviderService: gitProviderService,
+		}),
+		GitProviderService:  gitProviderService,
+		ServerApiUrl:        "http://localhost:3986",
+		ServerUrl:           "http://localhost:3987",
+		ServerVersion:       "v0.0.0-loadtest",
+		DefaultProjectImage: "daytonaio/workspace-project:latest",
+		DefaultProjectUser:  "daytona",
+		BuilderImage:        "daytonaio/workspace-project:latest",
+		Provisioner:         &mockProvisioner{latency: providerLatency},
+		LoggerFactory:       loggerFactory,
+	}), nil
+}
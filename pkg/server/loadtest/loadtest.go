@@ -0,0 +1,230 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationStop   Operation = "stop"
+	OperationStart  Operation = "start"
+	OperationRemove Operation = "remove"
+)
+
+// Operations in the order they are run on every workspace
+var Operations = []Operation{OperationCreate, OperationStop, OperationStart, OperationRemove}
+
+type Config struct {
+	// Number of workspaces created over the test
+	Workspaces int `json:"workspaces"`
+	// Maximum number of workspaces operated on at the same time
+	Concurrency int `json:"concurrency"`
+	// Number of projects of every workspace
+	Projects int `json:"projects"`
+	// Number of times every workspace is stopped and started before it is removed
+	Cycles int `json:"cycles"`
+}
+
+// workspaceService is the part of the workspace service that the load test drives
+type workspaceService interface {
+	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error)
+	StartWorkspace(ctx context.Context, workspaceId string) error
+	StopWorkspace(ctx context.Context, workspaceId string) error
+	RemoveWorkspace(ctx context.Context, workspaceId string) error
+}
+
+type OperationReport struct {
+	Operation Operation `json:"operation"`
+	Count     int       `json:"count"`
+	Errors    int       `json:"errors"`
+	// Last error returned by the operation
+	LastError string `json:"lastError,omitempty"`
+	// Completed operations per second over the duration of the test
+	Throughput float64       `json:"throughput"`
+	P50        time.Duration `json:"p50"`
+	P90        time.Duration `json:"p90"`
+	P99        time.Duration `json:"p99"`
+	Max        time.Duration `json:"max"`
+}
+
+type Report struct {
+	Config     Config            `json:"config"`
+	Duration   time.Duration     `json:"duration"`
+	Operations []OperationReport `json:"operations"`
+	Resources  ResourceUsage     `json:"resources"`
+}
+
+type recorder struct {
+	mutex     sync.Mutex
+	latencies map[Operation][]time.Duration
+	errors    map[Operation]int
+	lastError map[Operation]error
+}
+
+func (r *recorder) record(operation Operation, fn func() error) error {
+	start := time.Now()
+	err := fn()
+	latency := time.Since(start)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err != nil {
+		r.errors[operation]++
+		r.lastError[operation] = err
+		return err
+	}
+
+	r.latencies[operation] = append(r.latencies[operation], latency)
+	return nil
+}
+
+// Run creates, cycles and removes the workspaces of the config against the service and reports the latency of every
+// operation, along with the resources the process used in the meantime. Failed operations are counted and the
+// remaining operations of their workspace are skipped
+func Run(ctx context.Context, service workspaceService, config Config) (*Report, error) {
+	if config.Workspaces <= 0 || config.Concurrency <= 0 || config.Projects <= 0 || config.Cycles < 0 {
+		return nil, fmt.Errorf("invalid load test config: %+v", config)
+	}
+
+	r := &recorder{
+		latencies: map[Operation][]time.Duration{},
+		errors:    map[Operation]int{},
+		lastError: map[Operation]error{},
+	}
+
+	sampler := newResourceSampler()
+	go sampler.run()
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, config.Concurrency)
+
+	for i := 0; i < config.Workspaces && ctx.Err() == nil; i++ {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			simulate(ctx, service, r, config, i)
+		}()
+	}
+
+	wg.Wait()
+
+	duration := time.Since(start)
+	resources := sampler.stop()
+
+	report := &Report{
+		Config:     config,
+		Duration:   duration,
+		Operations: []OperationReport{},
+		Resources:  resources,
+	}
+
+	for _, operation := range Operations {
+		latencies := r.latencies[operation]
+		slices.Sort(latencies)
+
+		operationReport := OperationReport{
+			Operation:  operation,
+			Count:      len(latencies),
+			Errors:     r.errors[operation],
+			Throughput: float64(len(latencies)) / duration.Seconds(),
+		}
+		if err := r.lastError[operation]; err != nil {
+			operationReport.LastError = err.Error()
+		}
+		if len(latencies) > 0 {
+			operationReport.P50 = percentile(latencies, 50)
+			operationReport.P90 = percentile(latencies, 90)
+			operationReport.P99 = percentile(latencies, 99)
+			operationReport.Max = latencies[len(latencies)-1]
+		}
+
+		report.Operations = append(report.Operations, operationReport)
+	}
+
+	return report, ctx.Err()
+}
+
+// simulate runs the lifecycle of a workspace. The workspace is removed once it is created, even if stopping or
+// starting it failed or the test was canceled
+func simulate(ctx context.Context, service workspaceService, r *recorder, config Config, index int) {
+	id := fmt.Sprintf("loadtest-%d-%d", time.Now().UnixNano(), index)
+
+	req := dto.CreateWorkspaceDTO{
+		Id:       id,
+		Name:     id,
+		Target:   TargetName,
+		Projects: []dto.CreateProjectDTO{},
+	}
+	for i := 0; i < config.Projects; i++ {
+		req.Projects = append(req.Projects, dto.CreateProjectDTO{
+			Name:    fmt.Sprintf("project-%d", i),
+			EnvVars: map[string]string{},
+			Source: dto.CreateProjectSourceDTO{
+				Repository: &gitprovider.GitRepository{
+					Id:     "loadtest",
+					Url:    "https://github.com/daytonaio/loadtest.git",
+					Name:   "loadtest",
+					Owner:  "daytonaio",
+					Branch: "main",
+					// A known commit keeps the git provider out of the test
+					Sha:    "0000000000000000000000000000000000000000",
+					Source: "github.com",
+				},
+			},
+		})
+	}
+
+	err := r.record(OperationCreate, func() error {
+		_, err := service.CreateWorkspace(ctx, req)
+		return err
+	})
+	if err != nil {
+		return
+	}
+
+	for i := 0; i < config.Cycles; i++ {
+		err = r.record(OperationStop, func() error {
+			return service.StopWorkspace(ctx, id)
+		})
+		if err != nil {
+			break
+		}
+
+		err = r.record(OperationStart, func() error {
+			return service.StartWorkspace(ctx, id)
+		})
+		if err != nil {
+			break
+		}
+	}
+
+	_ = r.record(OperationRemove, func() error {
+		return service.RemoveWorkspace(context.WithoutCancel(ctx), id)
+	})
+}
+
+// percentile uses the nearest-rank method on sorted latencies
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
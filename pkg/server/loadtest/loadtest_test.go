@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+var errStartFailed = errors.New("start failed")
+
+type fakeWorkspaceService struct {
+	mutex   sync.Mutex
+	created map[string]bool
+	// Workspaces whose id ends with failStartSuffix fail to start
+	failStartSuffix string
+}
+
+func (s *fakeWorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.created[req.Id] = true
+	return &workspace.Workspace{Id: req.Id}, nil
+}
+
+func (s *fakeWorkspaceService) StartWorkspace(ctx context.Context, workspaceId string) error {
+	if strings.HasSuffix(workspaceId, s.failStartSuffix) {
+		return errStartFailed
+	}
+	return nil
+}
+
+func (s *fakeWorkspaceService) StopWorkspace(ctx context.Context, workspaceId string) error {
+	return nil
+}
+
+func (s *fakeWorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.created, workspaceId)
+	return nil
+}
+
+func TestRun(t *testing.T) {
+	service := &fakeWorkspaceService{
+		created:         map[string]bool{},
+		failStartSuffix: "-3",
+	}
+
+	report, err := Run(context.Background(), service, Config{
+		Workspaces:  10,
+		Concurrency: 4,
+		Projects:    2,
+		Cycles:      2,
+	})
+	require.Nil(t, err)
+
+	counts := map[Operation][2]int{}
+	for _, o := range report.Operations {
+		counts[o.Operation] = [2]int{o.Count, o.Errors}
+	}
+
+	require.Equal(t, [2]int{10, 0}, counts[OperationCreate])
+	// Workspace 3 stops once and is removed after it fails to start
+	require.Equal(t, [2]int{19, 0}, counts[OperationStop])
+	require.Equal(t, [2]int{18, 1}, counts[OperationStart])
+	require.Equal(t, [2]int{10, 0}, counts[OperationRemove])
+
+	require.Equal(t, errStartFailed.Error(), report.Operations[2].LastError)
+	require.Empty(t, service.created)
+}
+
+func TestRunInvalidConfig(t *testing.T) {
+	_, err := Run(context.Background(), &fakeWorkspaceService{}, Config{Workspaces: 1})
+	require.NotNil(t, err)
+}
+
+func TestPercentile(t *testing.T) {
+	latencies := []time.Duration{}
+	for i := 1; i <= 100; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	require.Equal(t, 50*time.Millisecond, percentile(latencies, 50))
+	require.Equal(t, 90*time.Millisecond, percentile(latencies, 90))
+	require.Equal(t, 99*time.Millisecond, percentile(latencies, 99))
+	require.Equal(t, time.Millisecond, percentile(latencies[:1], 99))
+}
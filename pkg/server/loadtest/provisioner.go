@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+var ErrNotSupported = errors.New("not supported by the load test provider")
+
+// mockProvisioner stands in for the providers so that the load test only measures the server. Every call that would
+// change a workspace or project waits for the configured latency of the provider
+type mockProvisioner struct {
+	latency time.Duration
+}
+
+func (p *mockProvisioner) wait() error {
+	time.Sleep(p.latency)
+	return nil
+}
+
+func (p *mockProvisioner) CreateProject(params provisioner.ProjectParams) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) CreateSharedService(service *sharedservice.SharedService, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) DestroyProject(project *project.Project, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) DestroySharedService(service *sharedservice.SharedService, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) ForwardProjectPort(project *project.Project, target *provider.ProviderTarget, port uint16) (net.Conn, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *mockProvisioner) GetProjectCreationTimings(project *project.Project, target *provider.ProviderTarget) ([]creationtiming.PhaseDuration, error) {
+	return []creationtiming.PhaseDuration{}, nil
+}
+
+func (p *mockProvisioner) GetProjectNetworking(proj *project.Project, target *provider.ProviderTarget) (project.Networking, error) {
+	return project.NetworkingTailnet, nil
+}
+
+func (p *mockProvisioner) GetTargetCapacity(target *provider.ProviderTarget) (*project.Resources, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *mockProvisioner) GetTargetPool(target *provider.ProviderTarget) (*provider.TargetPool, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *mockProvisioner) GetSharedServiceInfo(ctx context.Context, service *sharedservice.SharedService, target *provider.ProviderTarget) (*sharedservice.SharedServiceInfo, error) {
+	return &sharedservice.SharedServiceInfo{}, nil
+}
+
+func (p *mockProvisioner) GetWorkspaceInfo(ctx context.Context, w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error) {
+	return &workspace.WorkspaceInfo{Name: w.Name, Projects: []*project.ProjectInfo{}}, nil
+}
+
+func (p *mockProvisioner) PauseProject(project *project.Project, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) PullImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) InspectImage(image string, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry) error {
+	return nil
+}
+
+func (p *mockProvisioner) RebuildProject(params provisioner.ProjectParams) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) ResumeProject(project *project.Project, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) ScaleTarget(target *provider.ProviderTarget, hosts uint32) (*provider.TargetPool, error) {
+	return nil, ErrNotSupported
+}
+
+func (p *mockProvisioner) StartProject(params provisioner.ProjectParams) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) StartProjectRecovery(params provisioner.ProjectParams) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) StopProject(project *project.Project, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) StopProjectRecovery(project *project.Project, target *provider.ProviderTarget) error {
+	return p.wait()
+}
+
+func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+	return p.wait()
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"runtime"
+	"time"
+)
+
+// Interval at which the resource usage of the process is sampled during the test
+const resourceSampleInterval = 100 * time.Millisecond
+
+// ResourceUsage of the process over the test. The services of the server run in the process that runs the test, so
+// this is the usage of a server under the same load
+type ResourceUsage struct {
+	// User and system CPU time spent by the process, in seconds
+	CpuSeconds     float64 `json:"cpuSeconds"`
+	PeakHeapBytes  uint64  `json:"peakHeapBytes"`
+	PeakGoroutines int     `json:"peakGoroutines"`
+	GcCycles       uint32  `json:"gcCycles"`
+}
+
+type resourceSampler struct {
+	startCpu      float64
+	startGcCycles uint32
+	usage         ResourceUsage
+	done          chan struct{}
+	stopped       chan struct{}
+}
+
+func newResourceSampler() *resourceSampler {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	return &resourceSampler{
+		startCpu:      processCpuSeconds(),
+		startGcCycles: memStats.NumGC,
+		done:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+}
+
+func (s *resourceSampler) run() {
+	defer close(s.stopped)
+
+	for {
+		s.sample()
+
+		select {
+		case <-s.done:
+			return
+		case <-time.After(resourceSampleInterval):
+		}
+	}
+}
+
+func (s *resourceSampler) sample() {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	s.usage.PeakHeapBytes = max(s.usage.PeakHeapBytes, memStats.HeapAlloc)
+	s.usage.PeakGoroutines = max(s.usage.PeakGoroutines, runtime.NumGoroutine())
+	s.usage.GcCycles = memStats.NumGC - s.startGcCycles
+}
+
+// stop takes a last sample and returns the usage since the sampler was created
+func (s *resourceSampler) stop() ResourceUsage {
+	close(s.done)
+	<-s.stopped
+
+	s.sample()
+	s.usage.CpuSeconds = processCpuSeconds() - s.startCpu
+
+	return s.usage
+}
@@ -0,0 +1,24 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"syscall"
+	"time"
+)
+
+// processCpuSeconds returns the user and system CPU time spent by the process. Zero if it can't be read
+func processCpuSeconds() float64 {
+	var usage syscall.Rusage
+	err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage)
+	if err != nil {
+		return 0
+	}
+
+	cpuTime := time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+
+	return cpuTime.Seconds()
+}
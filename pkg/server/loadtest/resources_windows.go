@@ -0,0 +1,31 @@
+//go:build windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// processCpuSeconds returns the user and kernel CPU time spent by the process. Zero if it can't be read
+func processCpuSeconds() float64 {
+	var creationTime, exitTime, kernelTime, userTime windows.Filetime
+	err := windows.GetProcessTimes(windows.CurrentProcess(), &creationTime, &exitTime, &kernelTime, &userTime)
+	if err != nil {
+		return 0
+	}
+
+	// Nanoseconds returns the time since the epoch, which is offset from the raw 100ns intervals of the duration
+	cpuTime := time.Duration(filetimeTicks(kernelTime)+filetimeTicks(userTime)) * 100
+
+	return cpuTime.Seconds()
+}
+
+// filetimeTicks returns the number of 100ns intervals of a duration reported as a Filetime
+func filetimeTicks(ft windows.Filetime) int64 {
+	return int64(ft.HighDateTime)<<32 | int64(ft.LowDateTime)
+}
@@ -59,6 +59,9 @@ func (s *Server) initLogs() error {
 	log.SetFormatter(logFormatter)
 
 	frpLogLevel := "error"
+	if s.config.LogLevels != nil && s.config.LogLevels.Frp != "" {
+		frpLogLevel = s.config.LogLevels.Frp
+	}
 	if os.Getenv("FRP_LOG_LEVEL") != "" {
 		frpLogLevel = os.Getenv("FRP_LOG_LEVEL")
 	}
@@ -73,6 +76,37 @@ func (s *Server) initLogs() error {
 	return nil
 }
 
+// ValidateLogLevels checks that the configured levels are known log levels
+func ValidateLogLevels(c *LogLevelsConfig) error {
+	if c == nil {
+		return nil
+	}
+
+	levels := []struct {
+		component string
+		level     string
+	}{
+		{"server", c.Server},
+		{"api", c.Api},
+		{"headscale", c.Headscale},
+		{"frp", c.Frp},
+		{"agent", c.Agent},
+	}
+
+	for _, l := range levels {
+		if l.level == "" {
+			continue
+		}
+
+		_, err := log.ParseLevel(l.level)
+		if err != nil {
+			return fmt.Errorf("invalid %s log level: %w", l.component, err)
+		}
+	}
+
+	return nil
+}
+
 func (s *Server) GetLogReader(logFileQuery string) (io.Reader, error) {
 	logFilePath := s.config.LogFile.Path
 	if logFileQuery != "" {
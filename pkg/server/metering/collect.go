@@ -0,0 +1,162 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/metering"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Events kept for retry when the exporter is unavailable. Older events are dropped first.
+const maxPendingEvents = 10000
+
+const bytesPerGb = 1024 * 1024 * 1024
+
+// Collect samples the usage since the previous collection and exports it.
+// Running projects are measured in workspace-seconds, running builds in build-minutes
+// and workspace artifacts in storage GB-hours.
+func (s *MeteringService) Collect() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.lastCollectedAt.IsZero() {
+		s.lastCollectedAt = now
+		return nil
+	}
+
+	start := s.lastCollectedAt
+	period := now.Sub(start)
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	events := s.collectWorkspaceUsage(workspaces, start, now, period)
+
+	buildEvents, err := s.collectBuildUsage(start, now, period)
+	if err != nil {
+		return err
+	}
+	events = append(events, buildEvents...)
+
+	storageEvents, err := s.collectStorageUsage(workspaces, start, now, period)
+	if err != nil {
+		return err
+	}
+	events = append(events, storageEvents...)
+
+	s.lastCollectedAt = now
+
+	events = append(s.pending, events...)
+	if len(events) == 0 {
+		return nil
+	}
+
+	err = s.exporter.Export(events)
+	if err != nil {
+		if len(events) > maxPendingEvents {
+			log.Warnf("Dropping %d metering events", len(events)-maxPendingEvents)
+			events = events[len(events)-maxPendingEvents:]
+		}
+		s.pending = events
+		return err
+	}
+
+	s.pending = nil
+	return nil
+}
+
+func (s *MeteringService) collectWorkspaceUsage(workspaces []*workspace.Workspace, start, end time.Time, period time.Duration) []metering.Event {
+	events := []metering.Event{}
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			if p.State == nil || p.State.Uptime == 0 {
+				continue
+			}
+
+			event := s.newEvent(metering.EventTypeWorkspaceUsage, period.Seconds(), metering.UnitSeconds, start, end)
+			event.OrganizationId = w.OrganizationId
+			event.WorkspaceId = w.Id
+			event.ProjectName = p.Name
+			event.Size = w.Target
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func (s *MeteringService) collectBuildUsage(start, end time.Time, period time.Duration) ([]metering.Event, error) {
+	builds, err := s.buildStore.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStateRunning},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := []metering.Event{}
+	for _, b := range builds {
+		event := s.newEvent(metering.EventTypeBuildUsage, period.Minutes(), metering.UnitMinutes, start, end)
+		event.BuildId = b.Id
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (s *MeteringService) collectStorageUsage(workspaces []*workspace.Workspace, start, end time.Time, period time.Duration) ([]metering.Event, error) {
+	artifacts, err := s.artifactStore.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Bytes stored per workspace project
+	type projectKey struct {
+		workspaceId string
+		projectName string
+	}
+	usage := map[projectKey]int{}
+	for _, a := range artifacts {
+		usage[projectKey{a.WorkspaceId, a.ProjectName}] += a.Size
+	}
+
+	organizations := map[string]string{}
+	for _, w := range workspaces {
+		organizations[w.Id] = w.OrganizationId
+	}
+
+	events := []metering.Event{}
+	for key, size := range usage {
+		if size == 0 {
+			continue
+		}
+
+		event := s.newEvent(metering.EventTypeStorageUsage, float64(size)/bytesPerGb*period.Hours(), metering.UnitGbHours, start, end)
+		event.OrganizationId = organizations[key.workspaceId]
+		event.WorkspaceId = key.workspaceId
+		event.ProjectName = key.projectName
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (s *MeteringService) newEvent(eventType metering.EventType, quantity float64, unit metering.Unit, start, end time.Time) metering.Event {
+	return metering.Event{
+		Id:        uuid.NewString(),
+		Type:      eventType,
+		Quantity:  quantity,
+		Unit:      unit,
+		ServerId:  s.serverId,
+		StartTime: start,
+		EndTime:   end,
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+const collectInterval = "0 * * * * *"
+
+func (s *MeteringService) StartPoller() error {
+	// The first collection only marks the start of the metered period
+	err := s.Collect()
+	if err != nil {
+		return err
+	}
+
+	scheduler := build.NewCronScheduler()
+
+	err = scheduler.AddFunc(collectInterval, func() {
+		err := s.Collect()
+		if err != nil {
+			log.Errorf("Failed to export metering events: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
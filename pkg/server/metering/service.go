@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/metering"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+type IMeteringService interface {
+	Collect() error
+	StartPoller() error
+}
+
+type MeteringServiceConfig struct {
+	ServerId       string
+	Exporter       metering.Exporter
+	WorkspaceStore workspace.Store
+	BuildStore     build.Store
+	ArtifactStore  artifact.Store
+}
+
+func NewMeteringService(config MeteringServiceConfig) IMeteringService {
+	return &MeteringService{
+		serverId:       config.ServerId,
+		exporter:       config.Exporter,
+		workspaceStore: config.WorkspaceStore,
+		buildStore:     config.BuildStore,
+		artifactStore:  config.ArtifactStore,
+	}
+}
+
+type MeteringService struct {
+	serverId       string
+	exporter       metering.Exporter
+	workspaceStore workspace.Store
+	buildStore     build.Store
+	artifactStore  artifact.Store
+
+	mu              sync.Mutex
+	lastCollectedAt time.Time
+	// Events that failed to export and are retried on the next collection
+	pending []metering.Event
+}
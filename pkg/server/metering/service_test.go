@@ -0,0 +1,99 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package metering_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	t_build "github.com/daytonaio/daytona/internal/testing/build"
+	t_artifacts "github.com/daytonaio/daytona/internal/testing/server/artifacts"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/artifact"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/metering"
+	metering_service "github.com/daytonaio/daytona/pkg/server/metering"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type testExporter struct {
+	events []metering.Event
+	err    error
+}
+
+func (e *testExporter) Export(events []metering.Event) error {
+	if e.err != nil {
+		return e.err
+	}
+	e.events = append(e.events, events...)
+	return nil
+}
+
+func TestCollect(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	buildStore := t_build.NewInMemoryBuildStore()
+	artifactStore := t_artifacts.NewInMemoryArtifactStore()
+
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:             "ws1",
+		Target:         "large",
+		OrganizationId: "org1",
+		Projects: []*project.Project{
+			{Name: "running", State: &project.ProjectState{Uptime: 10}},
+			{Name: "stopped", State: &project.ProjectState{Uptime: 0}},
+		},
+	}))
+	require.Nil(t, buildStore.Save(&build.Build{Id: "b1", State: build.BuildStateRunning}))
+	require.Nil(t, buildStore.Save(&build.Build{Id: "b2", State: build.BuildStateSuccess}))
+	require.Nil(t, artifactStore.Save(&artifact.Artifact{Id: "a1", WorkspaceId: "ws1", ProjectName: "running", Size: 1024 * 1024 * 1024}))
+
+	exporter := &testExporter{}
+	service := metering_service.NewMeteringService(metering_service.MeteringServiceConfig{
+		ServerId:       "server",
+		Exporter:       exporter,
+		WorkspaceStore: workspaceStore,
+		BuildStore:     buildStore,
+		ArtifactStore:  artifactStore,
+	})
+
+	// The first collection starts the metered period
+	require.Nil(t, service.Collect())
+	require.Empty(t, exporter.events)
+
+	time.Sleep(10 * time.Millisecond)
+
+	exporter.err = errors.New("unavailable")
+	require.NotNil(t, service.Collect())
+	require.Empty(t, exporter.events)
+
+	// Failed events are retried with the next collection
+	exporter.err = nil
+	require.Nil(t, service.Collect())
+	require.Len(t, exporter.events, 6)
+
+	eventTypes := map[metering.EventType]int{}
+	for _, event := range exporter.events {
+		eventTypes[event.Type]++
+		require.Greater(t, event.Quantity, 0.0)
+		require.Equal(t, "server", event.ServerId)
+
+		switch event.Type {
+		case metering.EventTypeWorkspaceUsage:
+			require.Equal(t, "running", event.ProjectName)
+			require.Equal(t, "large", event.Size)
+			require.Equal(t, "org1", event.OrganizationId)
+		case metering.EventTypeBuildUsage:
+			require.Equal(t, "b1", event.BuildId)
+		}
+	}
+
+	require.Equal(t, map[metering.EventType]int{
+		metering.EventTypeWorkspaceUsage: 2,
+		metering.EventTypeBuildUsage:     2,
+		metering.EventTypeStorageUsage:   2,
+	}, eventTypes)
+}
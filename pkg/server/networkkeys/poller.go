@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkeys
+
+import (
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+const revocationPollInterval = "0 */5 * * * *"
+
+// StartRevocationPoller periodically removes nodes that joined with revoked keys and prunes expired keys
+func (s *NetworkKeyService) StartRevocationPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(revocationPollInterval, func() {
+		err := s.EnforceRevocations()
+		if err != nil {
+			log.Errorf("Failed to enforce network key revocations: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkeys
+
+import (
+	"errors"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/google/uuid"
+)
+
+const DefaultKeyLifetime = 24 * time.Hour
+
+// Expired keys are kept for a while so their revocation can still be enforced
+const keyRetention = 24 * time.Hour
+
+var ErrInvalidScope = errors.New("invalid network key scope, must be one of workspace, client, provider or server")
+
+func IsInvalidScope(err error) bool {
+	return err.Error() == ErrInvalidScope.Error()
+}
+
+type INetworkKeyService interface {
+	// Generate issues a single use key for a node of the scope. Nodes that join with a key that is not persistent
+	// are removed from the network shortly after they go offline
+	Generate(scope networkkey.Scope, scopeName string, persistent bool) (*networkkey.NetworkKey, error)
+	List(filter *networkkey.Filter) ([]*networkkey.NetworkKey, error)
+	// Revoke expires the key and removes the node that joined with it from the network
+	Revoke(id string) error
+	// RevokeScope revokes all keys of the scope and removes every node tagged with the scope
+	RevokeScope(scope networkkey.Scope, scopeName string) error
+	// EnforceRevocations removes nodes that joined with revoked keys and prunes old keys
+	EnforceRevocations() error
+	StartRevocationPoller() error
+}
+
+// controlServer manages auth keys and nodes of the Daytona network
+type controlServer interface {
+	CreateAuthKey(tags []string, expiresAt time.Time, ephemeral bool) (string, error)
+	ExpireAuthKey(key string) error
+	// DeleteNodes removes nodes that registered with one of the auth keys or are tagged with one of the tags
+	DeleteNodes(authKeys []string, tags []string) error
+}
+
+type NetworkKeyServiceConfig struct {
+	NetworkKeyStore networkkey.Store
+	ControlServer   controlServer
+	// Issued keys expire after the lifetime, which rotates keys since nodes request a new key whenever they reconnect.
+	// Defaults to DefaultKeyLifetime
+	KeyLifetime time.Duration
+}
+
+func NewNetworkKeyService(config NetworkKeyServiceConfig) INetworkKeyService {
+	keyLifetime := config.KeyLifetime
+	if keyLifetime == 0 {
+		keyLifetime = DefaultKeyLifetime
+	}
+
+	return &NetworkKeyService{
+		networkKeyStore: config.NetworkKeyStore,
+		controlServer:   config.ControlServer,
+		keyLifetime:     keyLifetime,
+	}
+}
+
+type NetworkKeyService struct {
+	networkKeyStore networkkey.Store
+	controlServer   controlServer
+	keyLifetime     time.Duration
+}
+
+func (s *NetworkKeyService) Generate(scope networkkey.Scope, scopeName string, persistent bool) (*networkkey.NetworkKey, error) {
+	if !scope.IsValid() || scopeName == "" {
+		return nil, ErrInvalidScope
+	}
+
+	now := time.Now()
+	key := &networkkey.NetworkKey{
+		Id:         uuid.NewString(),
+		Scope:      scope,
+		ScopeName:  scopeName,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(s.keyLifetime),
+		Persistent: persistent,
+	}
+
+	authKey, err := s.controlServer.CreateAuthKey([]string{key.Tag()}, key.ExpiresAt, !persistent)
+	if err != nil {
+		return nil, err
+	}
+	key.Key = authKey
+
+	err = s.networkKeyStore.Save(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (s *NetworkKeyService) List(filter *networkkey.Filter) ([]*networkkey.NetworkKey, error) {
+	return s.networkKeyStore.List(filter)
+}
+
+func (s *NetworkKeyService) Revoke(id string) error {
+	key, err := s.networkKeyStore.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.revoke(key)
+	if err != nil {
+		return err
+	}
+
+	return s.controlServer.DeleteNodes([]string{key.Key}, nil)
+}
+
+func (s *NetworkKeyService) RevokeScope(scope networkkey.Scope, scopeName string) error {
+	if !scope.IsValid() || scopeName == "" {
+		return ErrInvalidScope
+	}
+
+	keys, err := s.networkKeyStore.List(&networkkey.Filter{
+		Scope:     &scope,
+		ScopeName: &scopeName,
+	})
+	if err != nil {
+		return err
+	}
+
+	authKeys := []string{}
+	for _, key := range keys {
+		err = s.revoke(key)
+		if err != nil {
+			return err
+		}
+		authKeys = append(authKeys, key.Key)
+	}
+
+	// Nodes are also matched by tag since the keys they joined with may have been pruned
+	return s.controlServer.DeleteNodes(authKeys, []string{networkkey.GetScopeTag(scope, scopeName)})
+}
+
+func (s *NetworkKeyService) revoke(key *networkkey.NetworkKey) error {
+	if key.IsRevoked() {
+		return nil
+	}
+
+	if !key.IsExpired() {
+		err := s.controlServer.ExpireAuthKey(key.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	key.RevokedAt = &now
+
+	return s.networkKeyStore.Save(key)
+}
+
+func (s *NetworkKeyService) EnforceRevocations() error {
+	keys, err := s.networkKeyStore.List(nil)
+	if err != nil {
+		return err
+	}
+
+	revokedKeys := []string{}
+	for _, key := range keys {
+		if time.Since(key.ExpiresAt) > keyRetention {
+			err = s.networkKeyStore.Delete(key)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if key.IsRevoked() {
+			revokedKeys = append(revokedKeys, key.Key)
+		}
+	}
+
+	if len(revokedKeys) == 0 {
+		return nil
+	}
+
+	return s.controlServer.DeleteNodes(revokedKeys, nil)
+}
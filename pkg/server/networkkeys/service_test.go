@@ -0,0 +1,137 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package networkkeys_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	t_networkkeys "github.com/daytonaio/daytona/internal/testing/server/networkkeys"
+	"github.com/daytonaio/daytona/pkg/networkkey"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeControlServer struct {
+	tags         map[string][]string
+	ephemeral    map[string]bool
+	expired      []string
+	deletedKeys  []string
+	deletedTags  []string
+	createdCount int
+}
+
+func (s *fakeControlServer) CreateAuthKey(tags []string, expiresAt time.Time, ephemeral bool) (string, error) {
+	s.createdCount++
+	key := fmt.Sprintf("key-%d", s.createdCount)
+	s.tags[key] = tags
+	s.ephemeral[key] = ephemeral
+	return key, nil
+}
+
+func (s *fakeControlServer) ExpireAuthKey(key string) error {
+	s.expired = append(s.expired, key)
+	return nil
+}
+
+func (s *fakeControlServer) DeleteNodes(authKeys []string, tags []string) error {
+	s.deletedKeys = append(s.deletedKeys, authKeys...)
+	s.deletedTags = append(s.deletedTags, tags...)
+	return nil
+}
+
+func TestNetworkKeyService(t *testing.T) {
+	controlServer := &fakeControlServer{tags: map[string][]string{}, ephemeral: map[string]bool{}}
+	store := t_networkkeys.NewInMemoryNetworkKeyStore()
+
+	service := networkkeys.NewNetworkKeyService(networkkeys.NetworkKeyServiceConfig{
+		NetworkKeyStore: store,
+		ControlServer:   controlServer,
+	})
+
+	t.Run("Generate tags keys with the scope", func(t *testing.T) {
+		key, err := service.Generate(networkkey.ScopeWorkspace, "ws-1", false)
+		require.Nil(t, err)
+		require.Equal(t, []string{networkkey.GetScopeTag(networkkey.ScopeWorkspace, "ws-1")}, controlServer.tags[key.Key])
+		require.WithinDuration(t, time.Now().Add(networkkeys.DefaultKeyLifetime), key.ExpiresAt, time.Minute)
+		require.True(t, controlServer.ephemeral[key.Key])
+	})
+
+	t.Run("Generate tags keys of similar names differently", func(t *testing.T) {
+		key, err := service.Generate(networkkey.ScopeClient, "Dev_1", false)
+		require.Nil(t, err)
+		otherKey, err := service.Generate(networkkey.ScopeClient, "dev-1", false)
+		require.Nil(t, err)
+
+		require.NotEqual(t, controlServer.tags[key.Key], controlServer.tags[otherKey.Key])
+		require.True(t, strings.HasPrefix(controlServer.tags[key.Key][0], networkkey.GetScopeTagPrefix(networkkey.ScopeClient)))
+	})
+
+	t.Run("Generate issues persistent keys", func(t *testing.T) {
+		key, err := service.Generate(networkkey.ScopeWorkspace, "ws-1", true)
+		require.Nil(t, err)
+		require.True(t, key.Persistent)
+		require.False(t, controlServer.ephemeral[key.Key])
+	})
+
+	t.Run("Generate rejects unknown scopes", func(t *testing.T) {
+		_, err := service.Generate("unknown", "ws-1", false)
+		require.True(t, networkkeys.IsInvalidScope(err))
+	})
+
+	t.Run("Revoke expires the key and deletes its node", func(t *testing.T) {
+		key, err := service.Generate(networkkey.ScopeClient, "default", false)
+		require.Nil(t, err)
+
+		err = service.Revoke(key.Id)
+		require.Nil(t, err)
+		require.Contains(t, controlServer.expired, key.Key)
+		require.Contains(t, controlServer.deletedKeys, key.Key)
+
+		revoked := true
+		keys, err := service.List(&networkkey.Filter{Revoked: &revoked})
+		require.Nil(t, err)
+		require.Len(t, keys, 1)
+		require.Equal(t, key.Id, keys[0].Id)
+	})
+
+	t.Run("Revoke returns not found for unknown keys", func(t *testing.T) {
+		err := service.Revoke("unknown")
+		require.True(t, networkkey.IsNetworkKeyNotFound(err))
+	})
+
+	t.Run("RevokeScope deletes the nodes tagged with the scope", func(t *testing.T) {
+		err := service.RevokeScope(networkkey.ScopeWorkspace, "ws-1")
+		require.Nil(t, err)
+		require.Contains(t, controlServer.deletedTags, networkkey.GetScopeTag(networkkey.ScopeWorkspace, "ws-1"))
+
+		scope := networkkey.ScopeWorkspace
+		scopeName := "ws-1"
+		keys, err := service.List(&networkkey.Filter{Scope: &scope, ScopeName: &scopeName})
+		require.Nil(t, err)
+		for _, key := range keys {
+			require.True(t, key.IsRevoked())
+		}
+	})
+
+	t.Run("EnforceRevocations prunes old keys", func(t *testing.T) {
+		err := store.Save(&networkkey.NetworkKey{
+			Id:        "old",
+			Scope:     networkkey.ScopeProvider,
+			ScopeName: "docker-provider",
+			Key:       "old-key",
+			CreatedAt: time.Now().Add(-72 * time.Hour),
+			ExpiresAt: time.Now().Add(-48 * time.Hour),
+		})
+		require.Nil(t, err)
+
+		err = service.EnforceRevocations()
+		require.Nil(t, err)
+
+		_, err = store.Find("old")
+		require.True(t, networkkey.IsNetworkKeyNotFound(err))
+	})
+}
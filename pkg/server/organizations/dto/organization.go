@@ -0,0 +1,17 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/organization"
+
+type CreateOrganizationDTO struct {
+	Name  string              `json:"name" validate:"required"`
+	Quota *organization.Quota `json:"quota,omitempty" validate:"optional"`
+} // @name CreateOrganizationDTO
+
+type AddOrganizationMemberDTO struct {
+	Name string `json:"name" validate:"required"`
+	// Admin makes the member an admin of the organization
+	Admin bool `json:"admin,omitempty" validate:"optional"`
+} // @name AddOrganizationMemberDTO
@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organizations
+
+import "errors"
+
+var (
+	ErrOrganizationAlreadyExists = errors.New("organization already exists")
+	ErrInvalidOrganizationName   = errors.New("name is not a valid alphanumeric string")
+	ErrNotOrganizationMember     = errors.New("not a member of the organization")
+	ErrMemberNotFound            = errors.New("member must be the name of an existing client API key")
+	ErrLastOrganizationMember    = errors.New("can not remove the last member of the organization")
+	ErrNotOrganizationAdmin      = errors.New("not an admin of the organization")
+	ErrLastOrganizationAdmin     = errors.New("can not remove the last admin of the organization")
+	ErrOrganizationNotEmpty      = errors.New("organization still has workspaces, targets or project configs")
+)
+
+func IsOrganizationAlreadyExists(err error) bool {
+	return err.Error() == ErrOrganizationAlreadyExists.Error()
+}
+
+func IsNotOrganizationMember(err error) bool {
+	return err.Error() == ErrNotOrganizationMember.Error() || err.Error() == ErrNotOrganizationAdmin.Error()
+}
+
+func IsOrganizationNotEmpty(err error) bool {
+	return err.Error() == ErrOrganizationNotEmpty.Error()
+}
+
+func IsInvalidOrganizationRequest(err error) bool {
+	return err.Error() == ErrInvalidOrganizationName.Error() || err.Error() == ErrMemberNotFound.Error() || err.Error() == ErrLastOrganizationMember.Error() || err.Error() == ErrLastOrganizationAdmin.Error()
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organizations
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/google/uuid"
+)
+
+var organizationNameRegex = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+func (s *OrganizationService) Create(name, owner string, quota organization.Quota) (*organization.Organization, error) {
+	if !organizationNameRegex.MatchString(name) {
+		return nil, ErrInvalidOrganizationName
+	}
+
+	_, err := s.organizationStore.Find(name)
+	if err == nil {
+		return nil, ErrOrganizationAlreadyExists
+	}
+
+	o := &organization.Organization{
+		Id:      uuid.NewString(),
+		Name:    name,
+		Members: []string{owner},
+		Admins:  []string{owner},
+		Quota:   quota,
+	}
+
+	return o, s.organizationStore.Save(o)
+}
+
+func (s *OrganizationService) Find(idOrName string) (*organization.Organization, error) {
+	return s.organizationStore.Find(idOrName)
+}
+
+// List returns the organizations the member belongs to
+func (s *OrganizationService) List(member string) ([]*organization.Organization, error) {
+	organizations, err := s.organizationStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*organization.Organization{}
+	for _, o := range organizations {
+		if o.HasMember(member) {
+			result = append(result, o)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *OrganizationService) Delete(idOrName, requester string) error {
+	o, err := s.findAsAdmin(idOrName, requester)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkEmpty(o)
+	if err != nil {
+		return err
+	}
+
+	return s.organizationStore.Delete(o)
+}
+
+func (s *OrganizationService) AddMember(idOrName, requester, member string, admin bool) (*organization.Organization, error) {
+	o, err := s.findAsAdmin(idOrName, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.HasMember(member) {
+		clientKeys, err := s.apiKeyService.ListClientKeys()
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.ContainsFunc(clientKeys, func(key *apikey.ApiKey) bool { return key.Name == member }) {
+			return nil, ErrMemberNotFound
+		}
+
+		o.Members = append(o.Members, member)
+	}
+
+	if admin && !o.HasAdmin(member) {
+		o.Admins = append(o.Admins, member)
+	}
+
+	return o, s.organizationStore.Save(o)
+}
+
+func (s *OrganizationService) RemoveMember(idOrName, requester, member string) (*organization.Organization, error) {
+	o, err := s.findAsAdmin(idOrName, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.HasMember(member) {
+		return o, nil
+	}
+
+	if len(o.Members) == 1 {
+		return nil, ErrLastOrganizationMember
+	}
+
+	if o.HasAdmin(member) && len(o.Admins) == 1 {
+		return nil, ErrLastOrganizationAdmin
+	}
+
+	o.Members = slices.DeleteFunc(o.Members, func(m string) bool { return m == member })
+	o.Admins = slices.DeleteFunc(o.Admins, func(m string) bool { return m == member })
+
+	return o, s.organizationStore.Save(o)
+}
+
+func (s *OrganizationService) SetQuota(idOrName, requester string, quota organization.Quota) (*organization.Organization, error) {
+	o, err := s.findAsAdmin(idOrName, requester)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Quota = quota
+
+	return o, s.organizationStore.Save(o)
+}
+
+// findAsAdmin returns the organization if the requester is one of its admins
+func (s *OrganizationService) findAsAdmin(idOrName, requester string) (*organization.Organization, error) {
+	o, err := s.organizationStore.Find(idOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !o.HasMember(requester) {
+		return nil, ErrNotOrganizationMember
+	}
+
+	if !o.HasAdmin(requester) {
+		return nil, ErrNotOrganizationAdmin
+	}
+
+	return o, nil
+}
+
+// checkEmpty returns ErrOrganizationNotEmpty if workspaces, targets or project configs still belong to the
+// organization. They would be left unreachable by the requests of any organization otherwise
+func (s *OrganizationService) checkEmpty(o *organization.Organization) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	if slices.ContainsFunc(workspaces, func(w *workspace.Workspace) bool { return w.OrganizationId == o.Id }) {
+		return ErrOrganizationNotEmpty
+	}
+
+	targets, err := s.targetStore.List(&provider.TargetFilter{OrganizationId: &o.Id})
+	if err != nil {
+		return err
+	}
+
+	if len(targets) > 0 {
+		return ErrOrganizationNotEmpty
+	}
+
+	projectConfigs, err := s.projectConfigStore.List(&config.ProjectConfigFilter{OrganizationId: &o.Id})
+	if err != nil {
+		return err
+	}
+
+	if len(projectConfigs) > 0 {
+		return ErrOrganizationNotEmpty
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organizations
+
+import (
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+)
+
+type IOrganizationService interface {
+	AddMember(idOrName, requester, member string, admin bool) (*organization.Organization, error)
+	Create(name, owner string, quota organization.Quota) (*organization.Organization, error)
+	Delete(idOrName, requester string) error
+	Find(idOrName string) (*organization.Organization, error)
+	List(member string) ([]*organization.Organization, error)
+	RemoveMember(idOrName, requester, member string) (*organization.Organization, error)
+	SetQuota(idOrName, requester string, quota organization.Quota) (*organization.Organization, error)
+}
+
+type OrganizationServiceConfig struct {
+	OrganizationStore  organization.Store
+	ApiKeyService      apikeys.IApiKeyService
+	WorkspaceStore     workspace.Store
+	TargetStore        provider.TargetStore
+	ProjectConfigStore config.Store
+}
+
+func NewOrganizationService(config OrganizationServiceConfig) IOrganizationService {
+	return &OrganizationService{
+		organizationStore:  config.OrganizationStore,
+		apiKeyService:      config.ApiKeyService,
+		workspaceStore:     config.WorkspaceStore,
+		targetStore:        config.TargetStore,
+		projectConfigStore: config.ProjectConfigStore,
+	}
+}
+
+type OrganizationService struct {
+	organizationStore  organization.Store
+	apiKeyService      apikeys.IApiKeyService
+	workspaceStore     workspace.Store
+	targetStore        provider.TargetStore
+	projectConfigStore config.Store
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organizations_test
+
+import (
+	"testing"
+
+	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_organizations "github.com/daytonaio/daytona/internal/testing/server/organizations"
+	t_projectconfig "github.com/daytonaio/daytona/internal/testing/server/projectconfig"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	. "github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/organizations"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationServiceTestSuite struct {
+	suite.Suite
+	organizationService organizations.IOrganizationService
+	organizationStore   Store
+	targetStore         provider.TargetStore
+}
+
+func NewOrganizationServiceTestSuite() *OrganizationServiceTestSuite {
+	return &OrganizationServiceTestSuite{}
+}
+
+func (s *OrganizationServiceTestSuite) SetupTest() {
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	for _, name := range []string{"alice", "bob"} {
+		_, err := apiKeyService.Generate(apikey.ApiKeyTypeClient, name)
+		s.Require().Nil(err)
+	}
+
+	s.organizationStore = t_organizations.NewInMemoryOrganizationStore()
+	s.targetStore = t_targets.NewInMemoryTargetStore()
+	s.organizationService = organizations.NewOrganizationService(organizations.OrganizationServiceConfig{
+		OrganizationStore:  s.organizationStore,
+		ApiKeyService:      apiKeyService,
+		WorkspaceStore:     t_workspaces.NewInMemoryWorkspaceStore(),
+		TargetStore:        s.targetStore,
+		ProjectConfigStore: t_projectconfig.NewInMemoryProjectConfigStore(),
+	})
+}
+
+func TestOrganizationService(t *testing.T) {
+	suite.Run(t, NewOrganizationServiceTestSuite())
+}
+
+func (s *OrganizationServiceTestSuite) TestCreate() {
+	o, err := s.organizationService.Create("team", "alice", Quota{MaxWorkspaces: 2})
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"alice"}, o.Members)
+	s.Require().Equal([]string{"alice"}, o.Admins)
+
+	organizationFromStore, err := s.organizationStore.Find("team")
+	s.Require().Nil(err)
+	s.Require().Equal(o, organizationFromStore)
+
+	_, err = s.organizationService.Create("team", "bob", Quota{})
+	s.Require().True(organizations.IsOrganizationAlreadyExists(err))
+}
+
+func (s *OrganizationServiceTestSuite) TestMembers() {
+	_, err := s.organizationService.Create("team", "alice", Quota{})
+	s.Require().Nil(err)
+
+	_, err = s.organizationService.AddMember("team", "bob", "bob", false)
+	s.Require().True(organizations.IsNotOrganizationMember(err))
+
+	_, err = s.organizationService.AddMember("team", "alice", "carol", false)
+	s.Require().Equal(organizations.ErrMemberNotFound, err)
+
+	o, err := s.organizationService.AddMember("team", "alice", "bob", false)
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"alice", "bob"}, o.Members)
+	s.Require().Equal([]string{"alice"}, o.Admins)
+
+	list, err := s.organizationService.List("bob")
+	s.Require().Nil(err)
+	s.Require().Len(list, 1)
+
+	_, err = s.organizationService.RemoveMember("team", "bob", "alice")
+	s.Require().Equal(organizations.ErrNotOrganizationAdmin, err)
+
+	_, err = s.organizationService.RemoveMember("team", "alice", "alice")
+	s.Require().Equal(organizations.ErrLastOrganizationAdmin, err)
+
+	o, err = s.organizationService.RemoveMember("team", "alice", "bob")
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"alice"}, o.Members)
+
+	_, err = s.organizationService.RemoveMember("team", "alice", "alice")
+	s.Require().Equal(organizations.ErrLastOrganizationMember, err)
+}
+
+func (s *OrganizationServiceTestSuite) TestAdmins() {
+	_, err := s.organizationService.Create("team", "alice", Quota{MaxWorkspaces: 2})
+	s.Require().Nil(err)
+
+	_, err = s.organizationService.AddMember("team", "alice", "bob", false)
+	s.Require().Nil(err)
+
+	_, err = s.organizationService.SetQuota("team", "bob", Quota{MaxWorkspaces: 100})
+	s.Require().Equal(organizations.ErrNotOrganizationAdmin, err)
+
+	_, err = s.organizationService.AddMember("team", "bob", "bob", true)
+	s.Require().Equal(organizations.ErrNotOrganizationAdmin, err)
+
+	err = s.organizationService.Delete("team", "bob")
+	s.Require().Equal(organizations.ErrNotOrganizationAdmin, err)
+
+	o, err := s.organizationService.AddMember("team", "alice", "bob", true)
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"alice", "bob"}, o.Admins)
+
+	o, err = s.organizationService.SetQuota("team", "bob", Quota{MaxWorkspaces: 100})
+	s.Require().Nil(err)
+	s.Require().Equal(100, o.Quota.MaxWorkspaces)
+
+	o, err = s.organizationService.RemoveMember("team", "bob", "alice")
+	s.Require().Nil(err)
+	s.Require().Equal([]string{"bob"}, o.Admins)
+}
+
+func (s *OrganizationServiceTestSuite) TestDeleteFailsWhileNotEmpty() {
+	o, err := s.organizationService.Create("team", "alice", Quota{})
+	s.Require().Nil(err)
+
+	err = s.targetStore.Save(&provider.ProviderTarget{
+		Name:           "team-target",
+		OrganizationId: o.Id,
+	})
+	s.Require().Nil(err)
+
+	err = s.organizationService.Delete("team", "alice")
+	s.Require().Equal(organizations.ErrOrganizationNotEmpty, err)
+
+	err = s.targetStore.Delete(&provider.ProviderTarget{Name: "team-target"})
+	s.Require().Nil(err)
+
+	err = s.organizationService.Delete("team", "alice")
+	s.Require().Nil(err)
+
+	_, err = s.organizationStore.Find("team")
+	s.Require().True(IsOrganizationNotFound(err))
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package previewdns
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/dns"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	certificateFileName = "cert.pem"
+	keyFileName         = "key.pem"
+	accountKeyFileName  = "account.key"
+)
+
+// Certificates are renewed once they expire in less than this
+const renewBefore = 30 * 24 * time.Hour
+
+func (s *PreviewDnsService) RenewCertificate(ctx context.Context) error {
+	if !s.issueCertificate {
+		return nil
+	}
+
+	s.certificateMutex.Lock()
+	defer s.certificateMutex.Unlock()
+
+	notAfter, err := s.getCertificateExpiry()
+	if err == nil && time.Until(notAfter) > renewBefore {
+		return nil
+	}
+
+	accountKey, err := s.getAccountKey()
+	if err != nil {
+		return err
+	}
+
+	domain := fmt.Sprintf("*.%s", s.domain)
+	log.Infof("Issuing certificate for %s", domain)
+
+	certificate, err := dns.IssueCertificate(ctx, s.provider, dns.CertificateRequest{
+		Domains:      []string{domain},
+		Email:        s.acmeEmail,
+		DirectoryUrl: s.acmeDirectoryUrl,
+		AccountKey:   accountKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to issue certificate for %s: %w", domain, err)
+	}
+
+	// The key is written first so that the certificate never pairs with the key it replaces
+	err = os.WriteFile(filepath.Join(s.certificateDir, keyFileName), certificate.KeyPem, 0600)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filepath.Join(s.certificateDir, certificateFileName), certificate.CertPem, 0644)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Certificate for %s issued, valid until %s", domain, certificate.NotAfter.Format(time.RFC3339))
+
+	return s.tlsProxy.restart()
+}
+
+// hasCertificate reports whether the preview certificate was issued and has not expired
+func (s *PreviewDnsService) hasCertificate() bool {
+	if !s.issueCertificate {
+		return false
+	}
+
+	notAfter, err := s.getCertificateExpiry()
+	return err == nil && time.Now().Before(notAfter)
+}
+
+func (s *PreviewDnsService) getCertificateExpiry() (time.Time, error) {
+	content, err := os.ReadFile(filepath.Join(s.certificateDir, certificateFileName))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return time.Time{}, errors.New("invalid certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+// getAccountKey returns the key of the ACME account and creates it on first use
+func (s *PreviewDnsService) getAccountKey() (crypto.Signer, error) {
+	path := filepath.Join(s.certificateDir, accountKeyFileName)
+
+	content, err := os.ReadFile(path)
+	if err == nil {
+		block, _ := pem.Decode(content)
+		if block == nil {
+			return nil, fmt.Errorf("invalid ACME account key %s", path)
+		}
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(s.certificateDir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
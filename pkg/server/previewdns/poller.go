@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package previewdns
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+const renewInterval = "0 0 3 * * *"
+
+func (s *PreviewDnsService) Start() error {
+	s.createWildcardRecord()
+
+	if !s.issueCertificate && s.workspaceRecords {
+		return nil
+	}
+
+	// Issuing waits for the challenge records to propagate, which must not delay the server start
+	go s.renewCertificate()
+
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(renewInterval, func() {
+		s.createWildcardRecord()
+		s.renewCertificate()
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+// createWildcardRecord creates the wildcard record unless records are managed per workspace. Public URLs don't resolve
+// while the DNS provider fails, which must not stop the server
+func (s *PreviewDnsService) createWildcardRecord() {
+	if s.workspaceRecords {
+		return
+	}
+
+	err := s.provider.UpsertRecord(context.Background(), s.getWildcardRecord())
+	if err != nil {
+		log.Errorf("Failed to create preview record: %s", err)
+	}
+}
+
+func (s *PreviewDnsService) renewCertificate() {
+	err := s.RenewCertificate(context.Background())
+	if err != nil {
+		log.Errorf("Failed to renew preview certificate: %s", err)
+	}
+}
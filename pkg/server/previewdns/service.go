@@ -0,0 +1,189 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package previewdns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/dns"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+type IPreviewDnsService interface {
+	// CreateWorkspaceRecords creates the records of the public ports of the workspace if records are managed per workspace
+	CreateWorkspaceRecords(ctx context.Context, ws *workspace.Workspace) error
+	// CreatePortRecord publishes a port of a project forwarded to its public URL. Creates the record of the port if
+	// records are managed per workspace and serves the URL over HTTPS once the preview certificate is issued
+	CreatePortRecord(ctx context.Context, ws *workspace.Workspace, projectName string, port uint16) error
+	// DeleteWorkspaceRecords deletes the records of the public ports of the workspace if records are managed per
+	// workspace and stops serving them over HTTPS
+	DeleteWorkspaceRecords(ctx context.Context, ws *workspace.Workspace) error
+	// RenewCertificate issues the preview certificate if it is missing or expires soon
+	RenewCertificate(ctx context.Context) error
+	// Start creates the wildcard record and starts renewing the preview certificate. Failures of the DNS provider are
+	// logged and the record is created again with the next renewal
+	Start() error
+	// Purge deletes the wildcard record
+	Purge(ctx context.Context) error
+}
+
+type PreviewDnsServiceConfig struct {
+	Provider dns.Provider
+	ServerId string
+	// Domain public ports are published under
+	Domain string
+	// Port of the frps server the preview TLS proxy registers with
+	FrpsPort uint32
+	// IP address or hostname the records point to
+	Target string
+	// Creates a record for each public port of a workspace instead of a wildcard record
+	WorkspaceRecords bool
+	// Issues a certificate for the wildcard domain through the DNS provider
+	IssueCertificate bool
+	AcmeEmail        string
+	AcmeDirectoryUrl string
+	// Directory the certificate, its key and the ACME account key are written to
+	CertificateDir string
+}
+
+func NewPreviewDnsService(config PreviewDnsServiceConfig) IPreviewDnsService {
+	return &PreviewDnsService{
+		provider:         config.Provider,
+		serverId:         config.ServerId,
+		domain:           config.Domain,
+		target:           config.Target,
+		workspaceRecords: config.WorkspaceRecords,
+		issueCertificate: config.IssueCertificate,
+		acmeEmail:        config.AcmeEmail,
+		acmeDirectoryUrl: config.AcmeDirectoryUrl,
+		certificateDir:   config.CertificateDir,
+		tlsProxy: &tlsProxy{
+			frpsDomain:     config.Domain,
+			frpsPort:       int(config.FrpsPort),
+			certificateDir: config.CertificateDir,
+		},
+	}
+}
+
+type PreviewDnsService struct {
+	provider         dns.Provider
+	serverId         string
+	domain           string
+	target           string
+	workspaceRecords bool
+	issueCertificate bool
+	acmeEmail        string
+	acmeDirectoryUrl string
+	certificateDir   string
+
+	// Serializes certificate renewals
+	certificateMutex sync.Mutex
+
+	tlsProxy *tlsProxy
+}
+
+func (s *PreviewDnsService) CreateWorkspaceRecords(ctx context.Context, ws *workspace.Workspace) error {
+	if !s.workspaceRecords {
+		return nil
+	}
+
+	for _, name := range s.getWorkspaceRecordNames(ws) {
+		err := s.provider.UpsertRecord(ctx, dns.NewTargetRecord(name, s.target))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *PreviewDnsService) CreatePortRecord(ctx context.Context, ws *workspace.Workspace, projectName string, port uint16) error {
+	subdomain := util.GetPublicPortSubdomain(s.serverId, ws.Id, projectName, port)
+
+	if s.workspaceRecords {
+		err := s.provider.UpsertRecord(ctx, dns.NewTargetRecord(fmt.Sprintf("%s.%s", subdomain, s.domain), s.target))
+		if err != nil {
+			return err
+		}
+	}
+
+	if !s.hasCertificate() {
+		return nil
+	}
+
+	return s.tlsProxy.add(subdomain)
+}
+
+func (s *PreviewDnsService) DeleteWorkspaceRecords(ctx context.Context, ws *workspace.Workspace) error {
+	var errs []error
+
+	err := s.tlsProxy.remove(s.getWorkspaceSubdomains(ws))
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if !s.workspaceRecords {
+		return errors.Join(errs...)
+	}
+
+	recordType := dns.NewTargetRecord(s.domain, s.target).Type
+
+	for _, name := range s.getWorkspaceRecordNames(ws) {
+		err := s.provider.DeleteRecord(ctx, name, recordType)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *PreviewDnsService) Purge(ctx context.Context) error {
+	if s.workspaceRecords {
+		return nil
+	}
+
+	record := s.getWildcardRecord()
+	return s.provider.DeleteRecord(ctx, record.Name, record.Type)
+}
+
+func (s *PreviewDnsService) getWildcardRecord() dns.Record {
+	return dns.NewTargetRecord(fmt.Sprintf("*.%s", s.domain), s.target)
+}
+
+// getWorkspaceRecordNames returns the names of the records of the public ports declared by the projects of the workspace
+// and of the ports forwarded to their public URLs
+func (s *PreviewDnsService) getWorkspaceRecordNames(ws *workspace.Workspace) []string {
+	names := []string{}
+	for _, subdomain := range s.getWorkspaceSubdomains(ws) {
+		names = append(names, fmt.Sprintf("%s.%s", subdomain, s.domain))
+	}
+
+	return names
+}
+
+func (s *PreviewDnsService) getWorkspaceSubdomains(ws *workspace.Workspace) []string {
+	subdomains := []string{}
+
+	for _, p := range ws.Projects {
+		ports := slices.Clone(p.PublicPorts)
+		for _, port := range p.Ports {
+			if port.Visibility == project.PortVisibilityPublic && !slices.Contains(ports, port.Port) {
+				ports = append(ports, port.Port)
+			}
+		}
+
+		for _, port := range ports {
+			subdomains = append(subdomains, util.GetPublicPortSubdomain(s.serverId, ws.Id, p.Name, port))
+		}
+	}
+
+	return subdomains
+}
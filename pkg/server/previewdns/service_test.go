@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package previewdns_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/dns"
+	"github.com/daytonaio/daytona/pkg/server/previewdns"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProvider struct {
+	records map[string]dns.Record
+	err     error
+}
+
+func (p *fakeProvider) UpsertRecord(ctx context.Context, record dns.Record) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.records[record.Name+"/"+string(record.Type)] = record
+	return nil
+}
+
+func (p *fakeProvider) DeleteRecord(ctx context.Context, name string, recordType dns.RecordType) error {
+	delete(p.records, name+"/"+string(recordType))
+	return nil
+}
+
+var ws = &workspace.Workspace{
+	Id: "ws1",
+	Projects: []*project.Project{
+		{
+			Name: "web",
+			Ports: []project.Port{
+				{Name: "app", Port: 3000, Visibility: project.PortVisibilityPublic},
+				{Name: "admin", Port: 8080},
+			},
+		},
+	},
+}
+
+func TestWildcardRecord(t *testing.T) {
+	provider := &fakeProvider{records: map[string]dns.Record{}}
+	service := previewdns.NewPreviewDnsService(previewdns.PreviewDnsServiceConfig{
+		Provider: provider,
+		ServerId: "server1",
+		Domain:   "preview.example.com",
+		Target:   "203.0.113.10",
+	})
+
+	require.Nil(t, service.Start())
+	require.Equal(t, map[string]dns.Record{
+		"*.preview.example.com/A": {Name: "*.preview.example.com", Type: dns.RecordTypeA, Value: "203.0.113.10"},
+	}, provider.records)
+
+	require.Nil(t, service.CreateWorkspaceRecords(context.Background(), ws))
+	require.Len(t, provider.records, 1)
+
+	require.Nil(t, service.Purge(context.Background()))
+	require.Empty(t, provider.records)
+}
+
+func TestWorkspaceRecords(t *testing.T) {
+	provider := &fakeProvider{records: map[string]dns.Record{}}
+	service := previewdns.NewPreviewDnsService(previewdns.PreviewDnsServiceConfig{
+		Provider:         provider,
+		ServerId:         "server1",
+		Domain:           "preview.example.com",
+		Target:           "frps.example.com",
+		WorkspaceRecords: true,
+	})
+
+	require.Nil(t, service.Start())
+	require.Empty(t, provider.records)
+
+	name := util.GetPublicPortSubdomain("server1", "ws1", "web", 3000) + ".preview.example.com"
+
+	require.Nil(t, service.CreateWorkspaceRecords(context.Background(), ws))
+	require.Equal(t, map[string]dns.Record{
+		name + "/CNAME": {Name: name, Type: dns.RecordTypeCNAME, Value: "frps.example.com"},
+	}, provider.records)
+
+	require.Nil(t, service.DeleteWorkspaceRecords(context.Background(), ws))
+	require.Empty(t, provider.records)
+}
+
+func TestPortRecords(t *testing.T) {
+	provider := &fakeProvider{records: map[string]dns.Record{}}
+	service := previewdns.NewPreviewDnsService(previewdns.PreviewDnsServiceConfig{
+		Provider:         provider,
+		ServerId:         "server1",
+		Domain:           "preview.example.com",
+		Target:           "frps.example.com",
+		WorkspaceRecords: true,
+	})
+
+	name := util.GetPublicPortSubdomain("server1", "ws1", "web", 8080) + ".preview.example.com"
+
+	require.Nil(t, service.CreatePortRecord(context.Background(), ws, "web", 8080))
+	require.Equal(t, map[string]dns.Record{
+		name + "/CNAME": {Name: name, Type: dns.RecordTypeCNAME, Value: "frps.example.com"},
+	}, provider.records)
+
+	forwarded := &workspace.Workspace{
+		Id: "ws1",
+		Projects: []*project.Project{
+			{Name: "web", Ports: ws.Projects[0].Ports, PublicPorts: []uint16{8080}},
+		},
+	}
+
+	require.Nil(t, service.DeleteWorkspaceRecords(context.Background(), forwarded))
+	require.Empty(t, provider.records)
+}
+
+func TestStartWithFailingProvider(t *testing.T) {
+	provider := &fakeProvider{records: map[string]dns.Record{}, err: errors.New("provider unavailable")}
+	service := previewdns.NewPreviewDnsService(previewdns.PreviewDnsServiceConfig{
+		Provider: provider,
+		ServerId: "server1",
+		Domain:   "preview.example.com",
+		Target:   "203.0.113.10",
+	})
+
+	require.Nil(t, service.Start())
+	require.Empty(t, provider.records)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package previewdns
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/fatedier/frp/client"
+	v1 "github.com/fatedier/frp/pkg/config/v1"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// tlsProxy serves the public URLs of ports over HTTPS with the preview certificate. frps only passes HTTPS connections
+// on by their SNI, so the server registers an HTTPS proxy for the subdomain of each public port that terminates TLS
+// with the certificate and passes the requests on to the HTTP proxy of the port on frps
+type tlsProxy struct {
+	frpsDomain     string
+	frpsPort       int
+	certificateDir string
+
+	mutex      sync.Mutex
+	subdomains []string
+	service    *client.Service
+}
+
+// add serves the subdomain over HTTPS. The proxy is started on the first subdomain
+func (p *tlsProxy) add(subdomain string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if slices.Contains(p.subdomains, subdomain) {
+		return nil
+	}
+	p.subdomains = append(p.subdomains, subdomain)
+
+	if p.service == nil {
+		return p.start()
+	}
+
+	return p.service.UpdateAllConfigurer(p.getProxyConfigs(), nil)
+}
+
+func (p *tlsProxy) remove(subdomains []string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.subdomains = slices.DeleteFunc(p.subdomains, func(subdomain string) bool {
+		return slices.Contains(subdomains, subdomain)
+	})
+
+	if p.service == nil {
+		return nil
+	}
+
+	return p.service.UpdateAllConfigurer(p.getProxyConfigs(), nil)
+}
+
+// restart registers the proxies again so that they serve the renewed certificate
+func (p *tlsProxy) restart() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.service == nil {
+		return nil
+	}
+
+	p.service.Close()
+	p.service = nil
+
+	return p.start()
+}
+
+func (p *tlsProxy) start() error {
+	service, err := frpc.NewService(p.frpsDomain, p.frpsPort, p.getProxyConfigs())
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		err := service.Run(context.Background())
+		if err != nil {
+			log.Errorf("Preview TLS proxy stopped: %s", err)
+		}
+	}()
+
+	p.service = service
+	return nil
+}
+
+func (p *tlsProxy) getProxyConfigs() []v1.ProxyConfigurer {
+	configs := []v1.ProxyConfigurer{}
+
+	for _, subdomain := range p.subdomains {
+		configs = append(configs, frpc.GetHttpsProxyConfig(frpc.HttpsProxyParams{
+			Name:      fmt.Sprintf("preview-tls-%s", subdomain),
+			SubDomain: subdomain,
+			// frps routes the requests by their host to the HTTP proxy of the client that forwards the port
+			LocalAddr: fmt.Sprintf("%s:80", p.frpsDomain),
+			CertPath:  filepath.Join(p.certificateDir, certificateFileName),
+			KeyPath:   filepath.Join(p.certificateDir, keyFileName),
+		}))
+	}
+
+	return configs
+}
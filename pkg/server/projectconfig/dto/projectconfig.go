@@ -4,7 +4,9 @@
 package dto
 
 import (
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 )
 
 type CreateProjectConfigDTO struct {
@@ -15,21 +17,47 @@ type CreateProjectConfigDTO struct {
 	RepositoryUrl       string                   `json:"repositoryUrl" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Mounts              []project.Mount          `json:"mounts,omitempty" validate:"optional"`
+	Commands            []project.Command        `json:"commands,omitempty" validate:"optional"`
+	Ports               []project.Port           `json:"ports,omitempty" validate:"optional"`
+	BuildSecrets        []string                 `json:"buildSecrets,omitempty" validate:"optional"`
 } // @name CreateProjectConfigDTO
 
 type PrebuildDTO struct {
-	Id                string   `json:"id" validate:"required"`
-	ProjectConfigName string   `json:"projectConfigName" validate:"required"`
-	Branch            string   `json:"branch" validate:"required"`
-	CommitInterval    *int     `json:"commitInterval" validate:"optional"`
-	TriggerFiles      []string `json:"triggerFiles" validate:"optional"`
-	Retention         int      `json:"retention" validate:"required"`
+	Id                string                 `json:"id" validate:"required"`
+	ProjectConfigName string                 `json:"projectConfigName" validate:"required"`
+	Branch            string                 `json:"branch" validate:"required"`
+	CommitInterval    *int                   `json:"commitInterval" validate:"optional"`
+	TriggerFiles      []string               `json:"triggerFiles" validate:"optional"`
+	Retention         int                    `json:"retention" validate:"required"`
+	Matrix            *config.PrebuildMatrix `json:"matrix,omitempty" validate:"optional"`
+	BuildSecrets      []string               `json:"buildSecrets,omitempty" validate:"optional"`
 } // @name PrebuildDTO
 
 type CreatePrebuildDTO struct {
-	Id             *string  `json:"id" validate:"optional"`
-	Branch         string   `json:"branch" validate:"optional"`
-	CommitInterval *int     `json:"commitInterval" validate:"optional"`
-	TriggerFiles   []string `json:"triggerFiles" validate:"optional"`
-	Retention      int      `json:"retention" validate:"required"`
+	Id             *string                `json:"id" validate:"optional"`
+	Branch         string                 `json:"branch" validate:"optional"`
+	CommitInterval *int                   `json:"commitInterval" validate:"optional"`
+	TriggerFiles   []string               `json:"triggerFiles" validate:"optional"`
+	Retention      int                    `json:"retention" validate:"required"`
+	Matrix         *config.PrebuildMatrix `json:"matrix,omitempty" validate:"optional"`
+	BuildSecrets   []string               `json:"buildSecrets,omitempty" validate:"optional"`
 } // @name CreatePrebuildDTO
+
+type PrebuildStatsDTO struct {
+	Total            int                          `json:"total" validate:"required"`
+	Hits             int                          `json:"hits" validate:"required"`
+	Misses           int                          `json:"misses" validate:"required"`
+	HitRate          float64                      `json:"hitRate" validate:"required"`
+	TimeSavedSeconds int64                        `json:"timeSavedSeconds" validate:"required"`
+	Repositories     []PrebuildRepositoryStatsDTO `json:"repositories" validate:"required"`
+} // @name PrebuildStatsDTO
+
+type PrebuildRepositoryStatsDTO struct {
+	RepositoryUrl    string `json:"repositoryUrl" validate:"required"`
+	Branch           string `json:"branch" validate:"required"`
+	Hits             int    `json:"hits" validate:"required"`
+	Misses           int    `json:"misses" validate:"required"`
+	TimeSavedSeconds int64  `json:"timeSavedSeconds" validate:"required"`
+	LastCommitSha    string `json:"lastCommitSha,omitempty" validate:"optional"`
+} // @name PrebuildRepositoryStatsDTO
@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	build_dto "github.com/daytonaio/daytona/pkg/server/builds/dto"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 	"github.com/daytonaio/daytona/pkg/workspace/project/containerconfig"
 	log "github.com/sirupsen/logrus"
@@ -55,6 +56,8 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		CommitInterval: createPrebuildDto.CommitInterval,
 		TriggerFiles:   createPrebuildDto.TriggerFiles,
 		Retention:      createPrebuildDto.Retention,
+		Matrix:         createPrebuildDto.Matrix,
+		BuildSecrets:   createPrebuildDto.BuildSecrets,
 	}
 
 	if createPrebuildDto.Id != nil {
@@ -105,6 +108,8 @@ func (s *ProjectConfigService) SetPrebuild(projectConfigName string, createPrebu
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		Matrix:            prebuild.Matrix,
+		BuildSecrets:      prebuild.BuildSecrets,
 	}, nil
 }
 
@@ -126,6 +131,8 @@ func (s *ProjectConfigService) FindPrebuild(projectConfigFilter *config.ProjectC
 		CommitInterval:    prebuild.CommitInterval,
 		TriggerFiles:      prebuild.TriggerFiles,
 		Retention:         prebuild.Retention,
+		Matrix:            prebuild.Matrix,
+		BuildSecrets:      prebuild.BuildSecrets,
 	}, nil
 }
 
@@ -145,6 +152,8 @@ func (s *ProjectConfigService) ListPrebuilds(projectConfigFilter *config.Project
 				CommitInterval:    prebuild.CommitInterval,
 				TriggerFiles:      prebuild.TriggerFiles,
 				Retention:         prebuild.Retention,
+				Matrix:            prebuild.Matrix,
+				BuildSecrets:      prebuild.BuildSecrets,
 			})
 		}
 	}
@@ -261,16 +270,7 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 		// Check if the commit's affected files and prebuild config's trigger files have any overlap
 		if len(prebuild.TriggerFiles) > 0 {
 			if slicesHaveCommonEntry(prebuild.TriggerFiles, data.AffectedFiles) {
-				buildsToTrigger = append(buildsToTrigger, build.Build{
-					ContainerConfig: containerconfig.ContainerConfig{
-						Image: projectConfig.Image,
-						User:  projectConfig.User,
-					},
-					BuildConfig: projectConfig.BuildConfig,
-					Repository:  repo,
-					EnvVars:     projectConfig.EnvVars,
-					PrebuildId:  prebuild.Id,
-				})
+				buildsToTrigger = append(buildsToTrigger, getPrebuildBuilds(projectConfig, prebuild, repo)...)
 				continue
 			}
 		}
@@ -280,16 +280,7 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 			GetNewest:   util.Pointer(true),
 		})
 		if err != nil {
-			buildsToTrigger = append(buildsToTrigger, build.Build{
-				ContainerConfig: containerconfig.ContainerConfig{
-					Image: projectConfig.Image,
-					User:  projectConfig.User,
-				},
-				BuildConfig: projectConfig.BuildConfig,
-				Repository:  repo,
-				EnvVars:     projectConfig.EnvVars,
-				PrebuildId:  prebuild.Id,
-			})
+			buildsToTrigger = append(buildsToTrigger, getPrebuildBuilds(projectConfig, prebuild, repo)...)
 			continue
 		}
 
@@ -300,27 +291,23 @@ func (s *ProjectConfigService) ProcessGitEvent(data gitprovider.GitEventData) er
 
 		// Check if the commit interval has been reached
 		if prebuild.CommitInterval != nil && commitsRange >= *prebuild.CommitInterval {
-			buildsToTrigger = append(buildsToTrigger, build.Build{
-				ContainerConfig: containerconfig.ContainerConfig{
-					Image: projectConfig.Image,
-					User:  projectConfig.User,
-				},
-				BuildConfig: projectConfig.BuildConfig,
-				Repository:  repo,
-				EnvVars:     projectConfig.EnvVars,
-				PrebuildId:  prebuild.Id,
-			})
+			buildsToTrigger = append(buildsToTrigger, getPrebuildBuilds(projectConfig, prebuild, repo)...)
 		}
 	}
 
-	for _, build := range buildsToTrigger {
+	for _, b := range buildsToTrigger {
 		createBuildDto := build_dto.BuildCreationData{
-			Image:       build.ContainerConfig.Image,
-			User:        build.ContainerConfig.User,
-			BuildConfig: build.BuildConfig,
-			Repository:  build.Repository,
-			EnvVars:     build.EnvVars,
-			PrebuildId:  build.PrebuildId,
+			Image:          b.ContainerConfig.Image,
+			User:           b.ContainerConfig.User,
+			BuildConfig:    b.BuildConfig,
+			Repository:     b.Repository,
+			EnvVars:        b.EnvVars,
+			PrebuildId:     b.PrebuildId,
+			Architecture:   b.Architecture,
+			BuildSecrets:   b.BuildSecrets,
+			OrganizationId: b.OrganizationId,
+			// Prebuilds must not delay builds created by users
+			Priority: build.BuildPriorityLow,
 		}
 
 		_, err = s.buildService.Create(createBuildDto)
@@ -357,13 +344,16 @@ func (s *ProjectConfigService) EnforceRetentionPolicy() error {
 
 		associatedBuilds := buildMap[prebuild.Id]
 
-		if len(associatedBuilds) > prebuild.Retention {
+		// Retention applies to each variant of the prebuild matrix
+		retention := prebuild.Retention * len((&config.PrebuildConfig{Matrix: prebuild.Matrix}).Variants())
+
+		if len(associatedBuilds) > retention {
 			// Sort the builds by creation time in ascending order (oldest first)
 			sort.Slice(associatedBuilds, func(i, j int) bool {
 				return associatedBuilds[i].CreatedAt.Before(associatedBuilds[j].CreatedAt)
 			})
 
-			numToDelete := len(associatedBuilds) - prebuild.Retention
+			numToDelete := len(associatedBuilds) - retention
 
 			// Mark the oldest builds for deletion
 			for i := 0; i < numToDelete; i++ {
@@ -400,6 +390,46 @@ func (s *ProjectConfigService) StartRetentionPoller() error {
 	return nil
 }
 
+// Returns a build for every variant of the prebuild matrix
+func getPrebuildBuilds(projectConfig *config.ProjectConfig, prebuild *config.PrebuildConfig, repo *gitprovider.GitRepository) []build.Build {
+	var builds []build.Build
+
+	for _, variant := range prebuild.Variants() {
+		b := build.Build{
+			ContainerConfig: containerconfig.ContainerConfig{
+				Image: projectConfig.Image,
+				User:  projectConfig.User,
+			},
+			BuildConfig:    projectConfig.BuildConfig,
+			Repository:     repo,
+			EnvVars:        projectConfig.EnvVars,
+			PrebuildId:     prebuild.Id,
+			BuildSecrets:   projectConfig.GetBuildSecrets(prebuild),
+			OrganizationId: projectConfig.OrganizationId,
+		}
+
+		if variant.DevcontainerPath != "" {
+			b.BuildConfig = &buildconfig.BuildConfig{
+				Devcontainer: &buildconfig.DevcontainerConfig{
+					FilePath: variant.DevcontainerPath,
+				},
+			}
+		}
+
+		if variant.Image != "" {
+			b.ContainerConfig.Image = variant.Image
+		}
+
+		if variant.Architecture != "" {
+			b.Architecture = util.Pointer(variant.Architecture)
+		}
+
+		builds = append(builds, b)
+	}
+
+	return builds
+}
+
 func slicesHaveCommonEntry(slice1, slice2 []string) bool {
 	entryMap := make(map[string]bool)
 
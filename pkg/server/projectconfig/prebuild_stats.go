@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectconfig
+
+import (
+	"sort"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/projectconfig/dto"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+func (s *ProjectConfigService) RecordPrebuildUsage(usage *config.PrebuildUsage) error {
+	usage.Id = stringid.TruncateID(stringid.GenerateRandomID())
+	usage.CreatedAt = time.Now()
+
+	return s.prebuildUsageStore.Save(usage)
+}
+
+func (s *ProjectConfigService) GetPrebuildStats(filter *config.PrebuildUsageFilter) (*dto.PrebuildStatsDTO, error) {
+	usages, err := s.prebuildUsageStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Oldest first so that the last recorded hit sets the repository's commit
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].CreatedAt.Before(usages[j].CreatedAt)
+	})
+
+	stats := &dto.PrebuildStatsDTO{
+		Repositories: []dto.PrebuildRepositoryStatsDTO{},
+	}
+	repositoryIndexes := map[string]int{}
+	var timeSaved time.Duration
+
+	for _, usage := range usages {
+		key := usage.RepositoryUrl + "@" + usage.Branch
+		index, ok := repositoryIndexes[key]
+		if !ok {
+			stats.Repositories = append(stats.Repositories, dto.PrebuildRepositoryStatsDTO{
+				RepositoryUrl: usage.RepositoryUrl,
+				Branch:        usage.Branch,
+			})
+			index = len(stats.Repositories) - 1
+			repositoryIndexes[key] = index
+		}
+
+		repositoryStats := &stats.Repositories[index]
+
+		if usage.Hit {
+			stats.Hits++
+			repositoryStats.Hits++
+			repositoryStats.TimeSavedSeconds += int64(usage.TimeSaved.Seconds())
+			repositoryStats.LastCommitSha = usage.CommitSha
+			timeSaved += usage.TimeSaved
+		} else {
+			stats.Misses++
+			repositoryStats.Misses++
+		}
+	}
+
+	stats.Total = len(usages)
+	stats.TimeSavedSeconds = int64(timeSaved.Seconds())
+	if stats.Total > 0 {
+		stats.HitRate = float64(stats.Hits) / float64(stats.Total)
+	}
+
+	return stats, nil
+}
@@ -140,6 +140,7 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventCommitInterval() {
 		Repository: repository1,
 		User:       projectConfig1.User,
 		Image:      projectConfig1.Image,
+		Priority:   build.BuildPriorityLow,
 	}).Return("", nil)
 
 	s.buildService.On("Find", &build.Filter{
@@ -178,6 +179,7 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 		Repository: repository1,
 		User:       projectConfig1.User,
 		Image:      projectConfig1.Image,
+		Priority:   build.BuildPriorityLow,
 	}).Return("", nil)
 
 	data := gitprovider.GitEventData{
@@ -194,6 +196,50 @@ func (s *ProjectConfigServiceTestSuite) TestProcessGitEventTriggerFiles() {
 	require.Nil(err)
 }
 
+func (s *ProjectConfigServiceTestSuite) TestProcessGitEventMatrix() {
+	require := s.Require()
+
+	matrixConfig := *projectConfig4
+	matrixConfig.RepositoryUrl = repository1.Url
+	matrixConfig.Prebuilds = []*config.PrebuildConfig{
+		{
+			Id:           "matrix",
+			Branch:       "matrix",
+			Retention:    1,
+			TriggerFiles: []string{"file1"},
+			Matrix: &config.PrebuildMatrix{
+				Architectures: []string{"amd64", "arm64"},
+			},
+		},
+	}
+	require.Nil(s.projectConfigStore.Save(&matrixConfig))
+
+	s.gitProviderService.On("GetGitProviderForUrl", repository1.Url).Return(&s.gitProvider, "github", nil)
+	s.gitProvider.On("GetRepositoryContext", gitprovider.GetRepositoryContext{
+		Url: repository1.Url,
+	}).Return(repository1, nil)
+
+	for _, arch := range []string{"amd64", "arm64"} {
+		s.buildService.On("Create", build_dto.BuildCreationData{
+			PrebuildId:   "matrix",
+			Repository:   repository1,
+			User:         matrixConfig.User,
+			Image:        matrixConfig.Image,
+			Architecture: util.Pointer(arch),
+			Priority:     build.BuildPriorityLow,
+		}).Return("", nil).Once()
+	}
+
+	err := s.projectConfigService.ProcessGitEvent(gitprovider.GitEventData{
+		Url:           repository1.Url,
+		Branch:        "matrix",
+		Sha:           "sha4",
+		Owner:         repository1.Owner,
+		AffectedFiles: []string{"file1"},
+	})
+	require.Nil(err)
+}
+
 func (s *ProjectConfigServiceTestSuite) TestEnforceRetentionPolicy() {
 	require := s.Require()
 
@@ -233,3 +279,38 @@ func (s *ProjectConfigServiceTestSuite) TestEnforceRetentionPolicy() {
 	err := s.projectConfigService.EnforceRetentionPolicy()
 	require.Nil(err)
 }
+
+func (s *ProjectConfigServiceTestSuite) TestGetPrebuildStats() {
+	require := s.Require()
+
+	usages := []*config.PrebuildUsage{
+		{RepositoryUrl: repository1.Url, Branch: repository1.Branch, Hit: true, PrebuildId: prebuild1.Id, CommitSha: "sha1", TimeSaved: 2 * time.Minute},
+		{RepositoryUrl: repository1.Url, Branch: repository1.Branch, Hit: true, PrebuildId: prebuild1.Id, CommitSha: "sha2", TimeSaved: time.Minute},
+		{RepositoryUrl: repository1.Url, Branch: repository1.Branch},
+		{RepositoryUrl: projectConfig3.RepositoryUrl, Branch: "main"},
+	}
+
+	for _, usage := range usages {
+		require.Nil(s.projectConfigService.RecordPrebuildUsage(usage))
+		time.Sleep(time.Millisecond)
+	}
+
+	stats, err := s.projectConfigService.GetPrebuildStats(nil)
+	require.Nil(err)
+	require.Equal(4, stats.Total)
+	require.Equal(2, stats.Hits)
+	require.Equal(2, stats.Misses)
+	require.Equal(0.5, stats.HitRate)
+	require.Equal(int64(180), stats.TimeSavedSeconds)
+	require.ElementsMatch([]dto.PrebuildRepositoryStatsDTO{
+		{RepositoryUrl: repository1.Url, Branch: repository1.Branch, Hits: 2, Misses: 1, TimeSavedSeconds: 180, LastCommitSha: "sha2"},
+		{RepositoryUrl: projectConfig3.RepositoryUrl, Branch: "main", Misses: 1},
+	}, stats.Repositories)
+
+	stats, err = s.projectConfigService.GetPrebuildStats(&config.PrebuildUsageFilter{
+		RepositoryUrl: &projectConfig3.RepositoryUrl,
+	})
+	require.Nil(err)
+	require.Equal(1, stats.Total)
+	require.Equal(0.0, stats.HitRate)
+}
@@ -26,6 +26,9 @@ type IProjectConfigService interface {
 	ListPrebuilds(projectConfigFilter *config.ProjectConfigFilter, prebuildFilter *config.PrebuildFilter) ([]*dto.PrebuildDTO, error)
 	DeletePrebuild(projectConfigName string, id string, force bool) []error
 
+	RecordPrebuildUsage(usage *config.PrebuildUsage) error
+	GetPrebuildStats(filter *config.PrebuildUsageFilter) (*dto.PrebuildStatsDTO, error)
+
 	StartRetentionPoller() error
 	EnforceRetentionPolicy() error
 	ProcessGitEvent(gitprovider.GitEventData) error
@@ -34,6 +37,7 @@ type IProjectConfigService interface {
 type ProjectConfigServiceConfig struct {
 	PrebuildWebhookEndpoint string
 	ConfigStore             config.Store
+	PrebuildUsageStore      config.PrebuildUsageStore
 	BuildService            builds.IBuildService
 	GitProviderService      gitproviders.IGitProviderService
 }
@@ -41,6 +45,7 @@ type ProjectConfigServiceConfig struct {
 type ProjectConfigService struct {
 	prebuildWebhookEndpoint string
 	configStore             config.Store
+	prebuildUsageStore      config.PrebuildUsageStore
 	buildService            builds.IBuildService
 	gitProviderService      gitproviders.IGitProviderService
 }
@@ -50,6 +55,7 @@ func NewProjectConfigService(config ProjectConfigServiceConfig) IProjectConfigSe
 	return &ProjectConfigService{
 		prebuildWebhookEndpoint: config.PrebuildWebhookEndpoint,
 		configStore:             config.ConfigStore,
+		prebuildUsageStore:      config.PrebuildUsageStore,
 		buildService:            config.BuildService,
 		gitProviderService:      config.GitProviderService,
 	}
@@ -68,8 +74,9 @@ func (s *ProjectConfigService) SetDefault(projectConfigName string) error {
 	}
 
 	defaultProjectConfig, err := s.Find(&config.ProjectConfigFilter{
-		Url:     &projectConfig.RepositoryUrl,
-		Default: util.Pointer(true),
+		Url:            &projectConfig.RepositoryUrl,
+		Default:        util.Pointer(true),
+		OrganizationId: &projectConfig.OrganizationId,
 	})
 	if err != nil && err != config.ErrProjectConfigNotFound {
 		return err
@@ -114,6 +114,7 @@ func (s *ProjectConfigServiceTestSuite) SetupTest() {
 	s.projectConfigStore = projectconfig_internal.NewInMemoryProjectConfigStore()
 	s.projectConfigService = projectconfig.NewProjectConfigService(projectconfig.ProjectConfigServiceConfig{
 		ConfigStore:        s.projectConfigStore,
+		PrebuildUsageStore: projectconfig_internal.NewInMemoryPrebuildUsageStore(),
 		GitProviderService: &s.gitProviderService,
 		BuildService:       &s.buildService,
 	})
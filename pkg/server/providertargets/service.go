@@ -6,6 +6,7 @@ package providertargets
 import (
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type IProviderTargetService interface {
@@ -15,6 +16,8 @@ type IProviderTargetService interface {
 	Map() (map[string]*provider.ProviderTarget, error)
 	Save(target *provider.ProviderTarget) error
 	SetDefault(target *provider.ProviderTarget) error
+	// SetBandwidthLimit replaces the default bandwidth limit of the projects on the target
+	SetBandwidthLimit(targetName string, limit project.BandwidthLimit) error
 }
 
 type ProviderTargetServiceConfig struct {
@@ -75,7 +78,8 @@ func (s *ProviderTargetService) SetDefault(target *provider.ProviderTarget) erro
 	}
 
 	defaultTarget, err := s.Find(&provider.TargetFilter{
-		Default: util.Pointer(true),
+		Default:        util.Pointer(true),
+		OrganizationId: &currentTarget.OrganizationId,
 	})
 	if err != nil && err != provider.ErrTargetNotFound {
 		return err
@@ -92,3 +96,19 @@ func (s *ProviderTargetService) SetDefault(target *provider.ProviderTarget) erro
 	currentTarget.IsDefault = true
 	return s.targetStore.Save(currentTarget)
 }
+
+func (s *ProviderTargetService) SetBandwidthLimit(targetName string, limit project.BandwidthLimit) error {
+	target, err := s.Find(&provider.TargetFilter{
+		Name: &targetName,
+	})
+	if err != nil {
+		return err
+	}
+
+	target.BandwidthLimit = &limit
+	if limit.IsEmpty() {
+		target.BandwidthLimit = nil
+	}
+
+	return s.targetStore.Save(target)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/daytonaio/daytona/internal/testing/provider/targets"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -126,6 +127,33 @@ func (s *ProviderTargetServiceTestSuite) TestSetDefault() {
 	require.Equal(providerTarget2, providerTarget)
 }
 
+func (s *ProviderTargetServiceTestSuite) TestSetBandwidthLimit() {
+	require := s.Require()
+
+	limit := project.BandwidthLimit{IngressBytesPerSecond: 1000, EgressBytesPerSecond: 2000}
+
+	err := s.providerTargetService.SetBandwidthLimit(providerTarget2.Name, limit)
+	require.Nil(err)
+
+	providerTarget, err := s.providerTargetService.Find(&provider.TargetFilter{
+		Name: &providerTarget2.Name,
+	})
+	require.Nil(err)
+	require.Equal(&limit, providerTarget.BandwidthLimit)
+
+	err = s.providerTargetService.SetBandwidthLimit(providerTarget2.Name, project.BandwidthLimit{})
+	require.Nil(err)
+
+	providerTarget, err = s.providerTargetService.Find(&provider.TargetFilter{
+		Name: &providerTarget2.Name,
+	})
+	require.Nil(err)
+	require.Nil(providerTarget.BandwidthLimit)
+
+	err = s.providerTargetService.SetBandwidthLimit("unknown", limit)
+	require.NotNil(err)
+}
+
 func (s *ProviderTargetServiceTestSuite) TestSave() {
 	expectedProviderTargets = append(expectedProviderTargets, providerTarget4)
 
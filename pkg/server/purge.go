@@ -94,6 +94,19 @@ func (s *Server) Purge(ctx context.Context, force bool) []error {
 		}
 	}
 
+	if s.PreviewDnsService != nil {
+		fmt.Println("Deleting preview DNS records...")
+		err = s.PreviewDnsService.Purge(ctx)
+		if err != nil {
+			s.trackPurgeError(ctx, force, err)
+			if !force {
+				return []error{err}
+			} else {
+				fmt.Printf("Failed to delete preview DNS records: %v\n", err)
+			}
+		}
+	}
+
 	if telemetryEnabled {
 		err := s.TelemetryService.TrackServerEvent(telemetry.ServerEventPurgeCompleted, telemetry.ClientId(ctx), telemetryProps)
 		if err != nil {
@@ -0,0 +1,13 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type RegisterRegionDTO struct {
+	Name   string `json:"name" validate:"required"`
+	ApiUrl string `json:"apiUrl" validate:"required"`
+	// Client API key the primary server uses to call the regional server
+	ApiKey        string   `json:"apiKey" validate:"required"`
+	Targets       []string `json:"targets" validate:"required"`
+	DefaultTarget string   `json:"defaultTarget,omitempty" validate:"optional"`
+} // @name RegisterRegionDTO
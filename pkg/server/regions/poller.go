@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package regions
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/server/regions/dto"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	heartbeatInterval = "0 * * * * *"
+	heartbeatTimeout  = 30 * time.Second
+)
+
+// Name of the client API key the primary server uses to call this server
+const primaryApiKeyName = "federation-primary"
+
+func (s *RegionService) StartRegistrationPoller() error {
+	if s.localRegion == "" || s.primaryUrl == "" {
+		return nil
+	}
+
+	// A new key is generated on every start since only the hash of the previous key is stored
+	err := s.apiKeyService.Revoke(primaryApiKeyName)
+	if err != nil && !apikey.IsApiKeyNotFound(err) {
+		return err
+	}
+
+	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, primaryApiKeyName)
+	if err != nil {
+		return err
+	}
+
+	register := func() {
+		err := s.registerWithPrimary(apiKey)
+		if err != nil {
+			log.Errorf("failed to register region %s with the primary server: %v", s.localRegion, err)
+		}
+	}
+
+	go register()
+
+	scheduler := build.NewCronScheduler()
+
+	err = scheduler.AddFunc(heartbeatInterval, register)
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+
+	return nil
+}
+
+func (s *RegionService) registerWithPrimary(apiKey string) error {
+	localRegion, err := s.getLocal()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), heartbeatTimeout)
+	defer cancel()
+
+	return callServer(ctx, s.primaryUrl, s.primaryApiKey, http.MethodPost, "/region", dto.RegisterRegionDTO{
+		Name:          localRegion.Name,
+		ApiUrl:        localRegion.ApiUrl,
+		ApiKey:        apiKey,
+		Targets:       localRegion.Targets,
+		DefaultTarget: localRegion.DefaultTarget,
+	}, nil)
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package regions
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/region"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/regions/dto"
+	workspaces_dto "github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+var (
+	ErrFederationDisabled = errors.New("federation is not enabled on this server")
+	ErrNotPrimary         = errors.New("regions can only be registered with the primary server")
+	ErrRegionOffline      = errors.New("region is offline")
+	ErrRegionConflict     = errors.New("a region with the same name or API URL was registered with another API key")
+)
+
+func IsFederationDisabled(err error) bool {
+	return err.Error() == ErrFederationDisabled.Error()
+}
+
+func IsNotPrimary(err error) bool {
+	return err.Error() == ErrNotPrimary.Error()
+}
+
+func IsRegionOffline(err error) bool {
+	return err.Error() == ErrRegionOffline.Error()
+}
+
+func IsRegionConflict(err error) bool {
+	return err.Error() == ErrRegionConflict.Error()
+}
+
+type IRegionService interface {
+	// Register saves a heartbeat of a regional server sent with the named API key. Regions registered with another
+	// API key, under the same name or API URL, are not overwritten
+	Register(req dto.RegisterRegionDTO, apiKeyName string) error
+	// List returns the region of this server followed by the registered regions
+	List() ([]*region.Region, error)
+	Remove(name string) error
+	// GetLocalRegion returns the region of this server or an empty string if federation is disabled
+	GetLocalRegion() string
+	// ListWorkspaces lists the workspaces of the online regions. Regions that fail to respond are skipped
+	ListWorkspaces(ctx context.Context, verbose bool) ([]workspaces_dto.WorkspaceDTO, error)
+	// CreateWorkspace creates the workspace on the server of the region
+	CreateWorkspace(ctx context.Context, regionName string, req workspaces_dto.CreateWorkspaceDTO) (*workspace.Workspace, error)
+	// StartRegistrationPoller registers a regional server with the primary server and keeps sending heartbeats
+	StartRegistrationPoller() error
+}
+
+type targetStore interface {
+	List(filter *provider.TargetFilter) ([]*provider.ProviderTarget, error)
+}
+
+type RegionServiceConfig struct {
+	RegionStore region.Store
+	// Region of this server. Federation is disabled if empty
+	LocalRegion string
+	LocalApiUrl string
+	// API URL and client API key of the primary server. Empty on the primary server
+	PrimaryUrl    string
+	PrimaryApiKey string
+	ApiKeyService apikeys.IApiKeyService
+	TargetStore   targetStore
+}
+
+func NewRegionService(config RegionServiceConfig) IRegionService {
+	return &RegionService{
+		regionStore:   config.RegionStore,
+		localRegion:   config.LocalRegion,
+		localApiUrl:   config.LocalApiUrl,
+		primaryUrl:    config.PrimaryUrl,
+		primaryApiKey: config.PrimaryApiKey,
+		apiKeyService: config.ApiKeyService,
+		targetStore:   config.TargetStore,
+	}
+}
+
+type RegionService struct {
+	regionStore   region.Store
+	localRegion   string
+	localApiUrl   string
+	primaryUrl    string
+	primaryApiKey string
+	apiKeyService apikeys.IApiKeyService
+	targetStore   targetStore
+	registerMutex sync.Mutex
+}
+
+func (s *RegionService) GetLocalRegion() string {
+	return s.localRegion
+}
+
+func (s *RegionService) Register(req dto.RegisterRegionDTO, apiKeyName string) error {
+	if s.localRegion == "" {
+		return ErrFederationDisabled
+	}
+
+	if s.primaryUrl != "" || req.Name == s.localRegion {
+		return ErrNotPrimary
+	}
+
+	s.registerMutex.Lock()
+	defer s.registerMutex.Unlock()
+
+	regions, err := s.regionStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range regions {
+		// Regions saved before the API key was recorded are taken over by the first key that registers them
+		if (r.Name == req.Name || r.ApiUrl == req.ApiUrl) && r.RegisteredBy != "" && r.RegisteredBy != apiKeyName {
+			return ErrRegionConflict
+		}
+	}
+
+	return s.regionStore.Save(&region.Region{
+		Name:          req.Name,
+		ApiUrl:        req.ApiUrl,
+		ApiKey:        req.ApiKey,
+		RegisteredBy:  apiKeyName,
+		Targets:       req.Targets,
+		DefaultTarget: req.DefaultTarget,
+		LastSeenAt:    time.Now(),
+	})
+}
+
+func (s *RegionService) List() ([]*region.Region, error) {
+	if s.localRegion == "" {
+		return []*region.Region{}, nil
+	}
+
+	localRegion, err := s.getLocal()
+	if err != nil {
+		return nil, err
+	}
+
+	regions, err := s.regionStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*region.Region{localRegion}, regions...), nil
+}
+
+func (s *RegionService) Remove(name string) error {
+	r, err := s.regionStore.Find(name)
+	if err != nil {
+		return err
+	}
+
+	return s.regionStore.Delete(r)
+}
+
+func (s *RegionService) getLocal() (*region.Region, error) {
+	targets, err := s.targetStore.List(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	localRegion := &region.Region{
+		Name:       s.localRegion,
+		ApiUrl:     s.localApiUrl,
+		Targets:    []string{},
+		Local:      true,
+		LastSeenAt: time.Now(),
+	}
+
+	for _, t := range targets {
+		localRegion.Targets = append(localRegion.Targets, t.Name)
+		if t.IsDefault {
+			localRegion.DefaultTarget = t.Name
+		}
+	}
+
+	return localRegion, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package regions_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	t_regions "github.com/daytonaio/daytona/internal/testing/server/regions"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/region"
+	"github.com/daytonaio/daytona/pkg/server/regions"
+	"github.com/daytonaio/daytona/pkg/server/regions/dto"
+	workspaces_dto "github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+type targetStore struct{}
+
+func (s *targetStore) List(filter *provider.TargetFilter) ([]*provider.ProviderTarget, error) {
+	return []*provider.ProviderTarget{
+		{Name: "local", IsDefault: true},
+		{Name: "aws"},
+	}, nil
+}
+
+func TestRegionService(t *testing.T) {
+	var createRequest workspaces_dto.CreateWorkspaceDTO
+
+	regionalServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer regional-key", r.Header.Get("Authorization"))
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode([]workspaces_dto.WorkspaceDTO{
+				{Workspace: workspace.Workspace{Id: "ws-eu", Name: "eu"}},
+			})
+		case http.MethodPost:
+			json.NewDecoder(r.Body).Decode(&createRequest)
+			json.NewEncoder(w).Encode(workspace.Workspace{Id: createRequest.Id, Name: createRequest.Name, Target: createRequest.Target})
+		}
+	}))
+	defer regionalServer.Close()
+
+	regionStore := t_regions.NewInMemoryRegionStore()
+
+	service := regions.NewRegionService(regions.RegionServiceConfig{
+		RegionStore: regionStore,
+		LocalRegion: "us-east",
+		LocalApiUrl: "https://us.example.com",
+		TargetStore: &targetStore{},
+	})
+
+	err := service.Register(dto.RegisterRegionDTO{
+		Name:    "eu-west",
+		ApiUrl:  regionalServer.URL,
+		ApiKey:  "regional-key",
+		Targets: []string{"local"},
+	}, "eu-west-key")
+	require.Nil(t, err)
+
+	t.Run("List returns the local region first", func(t *testing.T) {
+		regionList, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, regionList, 2)
+		require.True(t, regionList[0].Local)
+		require.Equal(t, "us-east", regionList[0].Name)
+		require.Equal(t, []string{"local", "aws"}, regionList[0].Targets)
+		require.Equal(t, "local", regionList[0].DefaultTarget)
+		require.Equal(t, "eu-west", regionList[1].Name)
+	})
+
+	t.Run("Register rejects the local region", func(t *testing.T) {
+		err := service.Register(dto.RegisterRegionDTO{Name: "us-east"}, "eu-west-key")
+		require.True(t, regions.IsNotPrimary(err))
+	})
+
+	t.Run("Register rejects regions registered with another API key", func(t *testing.T) {
+		err := service.Register(dto.RegisterRegionDTO{
+			Name:    "eu-west",
+			ApiUrl:  "https://attacker.example.com",
+			ApiKey:  "attacker-key",
+			Targets: []string{"local"},
+		}, "other-key")
+		require.True(t, regions.IsRegionConflict(err))
+
+		err = service.Register(dto.RegisterRegionDTO{
+			Name:    "eu-central",
+			ApiUrl:  regionalServer.URL,
+			ApiKey:  "attacker-key",
+			Targets: []string{"local"},
+		}, "other-key")
+		require.True(t, regions.IsRegionConflict(err))
+
+		r, err := regionStore.Find("eu-west")
+		require.Nil(t, err)
+		require.Equal(t, regionalServer.URL, r.ApiUrl)
+		require.Equal(t, "regional-key", r.ApiKey)
+
+		// Heartbeats with the same API key update the region
+		err = service.Register(dto.RegisterRegionDTO{
+			Name:    "eu-west",
+			ApiUrl:  regionalServer.URL,
+			ApiKey:  "regional-key",
+			Targets: []string{"local", "aws"},
+		}, "eu-west-key")
+		require.Nil(t, err)
+	})
+
+	t.Run("ListWorkspaces sets the region of regional workspaces", func(t *testing.T) {
+		workspaces, err := service.ListWorkspaces(context.Background(), false)
+		require.Nil(t, err)
+		require.Len(t, workspaces, 1)
+		require.Equal(t, "ws-eu", workspaces[0].Id)
+		require.Equal(t, "eu-west", workspaces[0].Region)
+	})
+
+	t.Run("CreateWorkspace forwards the request to the region", func(t *testing.T) {
+		regionName := "eu-west"
+		w, err := service.CreateWorkspace(context.Background(), regionName, workspaces_dto.CreateWorkspaceDTO{
+			Id:     "ws-new",
+			Name:   "new",
+			Target: "local",
+			Region: &regionName,
+		})
+		require.Nil(t, err)
+		require.Equal(t, "ws-new", w.Id)
+		require.Nil(t, createRequest.Region)
+	})
+
+	t.Run("CreateWorkspace rejects offline regions", func(t *testing.T) {
+		err := regionStore.Save(&region.Region{
+			Name:       "ap-south",
+			ApiUrl:     regionalServer.URL,
+			LastSeenAt: time.Now().Add(-time.Hour),
+		})
+		require.Nil(t, err)
+
+		_, err = service.CreateWorkspace(context.Background(), "ap-south", workspaces_dto.CreateWorkspaceDTO{})
+		require.True(t, regions.IsRegionOffline(err))
+	})
+
+	t.Run("Register is rejected by regional servers", func(t *testing.T) {
+		regionalService := regions.NewRegionService(regions.RegionServiceConfig{
+			RegionStore: t_regions.NewInMemoryRegionStore(),
+			LocalRegion: "eu-west",
+			PrimaryUrl:  "https://us.example.com",
+			TargetStore: &targetStore{},
+		})
+
+		err := regionalService.Register(dto.RegisterRegionDTO{Name: "ap-south"}, "ap-south-key")
+		require.True(t, regions.IsNotPrimary(err))
+	})
+}
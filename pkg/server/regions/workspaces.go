@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package regions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/region"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Regions that take longer to list their workspaces are left out of the list
+const listTimeout = 10 * time.Second
+
+func (s *RegionService) ListWorkspaces(ctx context.Context, verbose bool) ([]dto.WorkspaceDTO, error) {
+	if s.localRegion == "" {
+		return []dto.WorkspaceDTO{}, nil
+	}
+
+	regions, err := s.regionStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, listTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	results := make([][]dto.WorkspaceDTO, len(regions))
+
+	for i, r := range regions {
+		if !r.IsOnline() {
+			log.Debugf("skipping workspaces of offline region %s", r.Name)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, r *region.Region) {
+			defer wg.Done()
+
+			workspaces := []dto.WorkspaceDTO{}
+			err := callServer(ctx, r.ApiUrl, r.ApiKey, http.MethodGet, fmt.Sprintf("/workspace?verbose=%t", verbose), nil, &workspaces)
+			if err != nil {
+				log.Warnf("failed to list workspaces of region %s: %v", r.Name, err)
+				return
+			}
+
+			for j := range workspaces {
+				workspaces[j].Region = r.Name
+			}
+			results[i] = workspaces
+		}(i, r)
+	}
+
+	wg.Wait()
+
+	workspaces := []dto.WorkspaceDTO{}
+	for _, result := range results {
+		workspaces = append(workspaces, result...)
+	}
+
+	return workspaces, nil
+}
+
+func (s *RegionService) CreateWorkspace(ctx context.Context, regionName string, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error) {
+	if s.localRegion == "" {
+		return nil, ErrFederationDisabled
+	}
+
+	r, err := s.regionStore.Find(regionName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsOnline() {
+		return nil, ErrRegionOffline
+	}
+
+	// The regional server creates the workspace on one of its own targets
+	req.Region = nil
+
+	w := &workspace.Workspace{}
+	err = callServer(ctx, r.ApiUrl, r.ApiKey, http.MethodPost, "/workspace", req, w)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace in region %s: %w", r.Name, err)
+	}
+
+	return w, nil
+}
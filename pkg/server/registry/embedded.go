@@ -0,0 +1,151 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/ociregistry"
+	"github.com/daytonaio/daytona/pkg/server"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Content pushed within the grace period is never garbage collected
+const gcGracePeriod = time.Hour
+
+type EmbeddedContainerRegistryConfig struct {
+	DataPath string
+	Port     uint32
+	// Quota is the storage limit in bytes. 0 disables the limit
+	Quota         int64
+	GcInterval    time.Duration
+	Authenticator ociregistry.Authenticator
+	Frps          *server.FRPSConfig
+	ServerId      string
+}
+
+func NewEmbeddedContainerRegistry(config *EmbeddedContainerRegistryConfig) *EmbeddedContainerRegistry {
+	return &EmbeddedContainerRegistry{
+		dataPath:      config.DataPath,
+		port:          config.Port,
+		quota:         config.Quota,
+		gcInterval:    config.GcInterval,
+		authenticator: config.Authenticator,
+		frps:          config.Frps,
+		serverId:      config.ServerId,
+	}
+}
+
+// EmbeddedContainerRegistry serves an OCI registry from the server process
+// so that builds can be pushed without Docker or an external registry
+type EmbeddedContainerRegistry struct {
+	dataPath      string
+	port          uint32
+	quota         int64
+	gcInterval    time.Duration
+	authenticator ociregistry.Authenticator
+	frps          *server.FRPSConfig
+	serverId      string
+
+	httpServer *http.Server
+	stopGc     context.CancelFunc
+}
+
+func (s *EmbeddedContainerRegistry) Start() error {
+	// Free the port if the server previously ran the registry container
+	err := RemoveRegistryContainer()
+	if err != nil {
+		log.Debugf("Failed to remove local container registry: %v", err)
+	}
+
+	storage, err := ociregistry.NewStorage(s.dataPath, s.quota)
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		return fmt.Errorf("cannot start registry: %w", err)
+	}
+
+	s.httpServer = &http.Server{
+		Handler: ociregistry.NewRegistry(ociregistry.RegistryConfig{
+			Storage:       storage,
+			Authenticator: s.authenticator,
+		}),
+	}
+
+	go func() {
+		err := s.httpServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Embedded container registry stopped: %v", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopGc = cancel
+	go s.runGarbageCollector(ctx, storage)
+
+	if s.frps == nil {
+		return nil
+	}
+
+	errChan := make(chan error, 1)
+	errChan <- nil
+
+	return runFrpcTunnel(s.frps, s.serverId, s.port, errChan)
+}
+
+func (s *EmbeddedContainerRegistry) Stop() error {
+	if s.stopGc != nil {
+		s.stopGc()
+	}
+
+	if s.httpServer == nil {
+		return nil
+	}
+
+	return s.httpServer.Shutdown(context.Background())
+}
+
+func (s *EmbeddedContainerRegistry) Purge() error {
+	err := s.Stop()
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(s.dataPath)
+}
+
+func (s *EmbeddedContainerRegistry) runGarbageCollector(ctx context.Context, storage *ociregistry.Storage) {
+	if s.gcInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.gcInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			result, err := storage.GarbageCollect(gcGracePeriod)
+			if err != nil {
+				log.Errorf("Failed to garbage collect embedded container registry: %v", err)
+				continue
+			}
+			if result.DeletedBlobs > 0 || result.DeletedManifests > 0 {
+				log.Infof("Embedded container registry garbage collection freed %d bytes (%d manifests, %d blobs)", result.FreedBytes, result.DeletedManifests, result.DeletedBlobs)
+			}
+		}
+	}
+}
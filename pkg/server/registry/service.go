@@ -136,12 +136,17 @@ func (s *LocalContainerRegistry) Start() error {
 		return <-errChan
 	}
 
+	return runFrpcTunnel(s.frps, s.serverId, s.port, errChan)
+}
+
+// runFrpcTunnel exposes the registry port through frps and blocks until errChan receives
+func runFrpcTunnel(frps *server.FRPSConfig, serverId string, port uint32, errChan chan error) error {
 	healthCheck, frpcService, err := frpc.GetService(frpc.FrpcConnectParams{
-		ServerDomain: s.frps.Domain,
-		ServerPort:   int(s.frps.Port),
-		Name:         fmt.Sprintf("daytona-server-registry-%s", s.serverId),
-		Port:         int(s.port),
-		SubDomain:    fmt.Sprintf("registry-%s", s.serverId),
+		ServerDomain: frps.Domain,
+		ServerPort:   int(frps.Port),
+		Name:         fmt.Sprintf("daytona-server-registry-%s", serverId),
+		Port:         int(port),
+		SubDomain:    fmt.Sprintf("registry-%s", serverId),
 	})
 	if err != nil {
 		return err
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/google/uuid"
+)
+
+// Agents report every 30 seconds, so samples are only kept long enough for recent utilization and idle detection
+const DefaultRetention = 24 * time.Hour
+
+type IResourceUsageService interface {
+	Record(samples []*resourceusage.Sample) error
+	List(filter *resourceusage.Filter) ([]*resourceusage.Sample, error)
+	Delete(filter *resourceusage.Filter) error
+	EnforceRetention() error
+	StartPoller() error
+}
+
+type ResourceUsageServiceConfig struct {
+	ResourceUsageStore resourceusage.Store
+	// Samples older than this are removed. Defaults to DefaultRetention
+	Retention time.Duration
+}
+
+func NewResourceUsageService(config ResourceUsageServiceConfig) IResourceUsageService {
+	retention := config.Retention
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &ResourceUsageService{
+		resourceUsageStore: config.ResourceUsageStore,
+		retention:          retention,
+	}
+}
+
+type ResourceUsageService struct {
+	resourceUsageStore resourceusage.Store
+	retention          time.Duration
+}
+
+func (s *ResourceUsageService) Record(samples []*resourceusage.Sample) error {
+	now := time.Now()
+
+	for _, sample := range samples {
+		if sample.Id == "" {
+			sample.Id = uuid.NewString()
+		}
+
+		// Agents with a clock ahead of the server would otherwise report the latest usage for too long
+		if sample.SampledAt.IsZero() || sample.SampledAt.After(now) {
+			sample.SampledAt = now
+		}
+
+		err := s.resourceUsageStore.Save(sample)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *ResourceUsageService) List(filter *resourceusage.Filter) ([]*resourceusage.Sample, error) {
+	return s.resourceUsageStore.List(filter)
+}
+
+func (s *ResourceUsageService) Delete(filter *resourceusage.Filter) error {
+	return s.resourceUsageStore.Delete(filter)
+}
+
+func (s *ResourceUsageService) EnforceRetention() error {
+	before := time.Now().Add(-s.retention)
+	return s.resourceUsageStore.Delete(&resourceusage.Filter{Before: &before})
+}
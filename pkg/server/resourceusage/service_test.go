@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package resourceusage_test
+
+import (
+	"testing"
+	"time"
+
+	t_resourceusage "github.com/daytonaio/daytona/internal/testing/server/resourceusage"
+	resourceusage_model "github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/daytonaio/daytona/pkg/server/resourceusage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceUsageService(t *testing.T) {
+	service := resourceusage.NewResourceUsageService(resourceusage.ResourceUsageServiceConfig{
+		ResourceUsageStore: t_resourceusage.NewInMemoryResourceUsageStore(),
+		Retention:          time.Hour,
+	})
+
+	now := time.Now()
+	err := service.Record([]*resourceusage_model.Sample{
+		{WorkspaceId: "ws1", ProjectName: "api", Cpus: 0.5, MemoryUsed: 512, SampledAt: now.Add(-2 * time.Hour)},
+		{WorkspaceId: "ws1", ProjectName: "api", Cpus: 1.5, MemoryUsed: 1024, SampledAt: now.Add(-time.Minute)},
+		{WorkspaceId: "ws1", ProjectName: "web", Cpus: 0.01, MemoryUsed: 128, SampledAt: now.Add(time.Hour)},
+		{WorkspaceId: "ws2", ProjectName: "api", Cpus: 2},
+	})
+	require.Nil(t, err)
+
+	t.Run("List filters by workspace and time", func(t *testing.T) {
+		workspaceId := "ws1"
+		since := now.Add(-10 * time.Minute)
+		samples, err := service.List(&resourceusage_model.Filter{WorkspaceId: &workspaceId, Since: &since})
+		require.Nil(t, err)
+		require.Len(t, samples, 2)
+		require.Equal(t, 1.5, samples[0].Cpus)
+		require.NotEmpty(t, samples[0].Id)
+
+		// Samples from the future are recorded as taken when they were received
+		require.False(t, samples[1].SampledAt.After(time.Now()))
+
+		latest := resourceusage_model.Latest(samples)
+		require.Equal(t, uint64(1024), latest["api"].MemoryUsed)
+		require.Equal(t, uint64(128), latest["web"].MemoryUsed)
+	})
+
+	t.Run("EnforceRetention removes expired samples", func(t *testing.T) {
+		require.Nil(t, service.EnforceRetention())
+
+		samples, err := service.List(nil)
+		require.Nil(t, err)
+		require.Len(t, samples, 3)
+	})
+
+	t.Run("Delete removes the samples of a workspace", func(t *testing.T) {
+		workspaceId := "ws1"
+		require.Nil(t, service.Delete(&resourceusage_model.Filter{WorkspaceId: &workspaceId}))
+
+		samples, err := service.List(nil)
+		require.Nil(t, err)
+		require.Len(t, samples, 1)
+		require.Equal(t, "ws2", samples[0].WorkspaceId)
+	})
+}
@@ -0,0 +1,10 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type CreateRolloutDTO struct {
+	AgentVersion string  `json:"agentVersion" validate:"required"`
+	Percentage   int     `json:"percentage" validate:"required"`
+	MaxErrorRate float64 `json:"maxErrorRate,omitempty" validate:"optional"`
+} // @name CreateAgentRolloutDTO
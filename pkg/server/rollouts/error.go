@@ -0,0 +1,23 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import "errors"
+
+var (
+	ErrRolloutInProgress   = errors.New("another rollout is already in progress")
+	ErrRolloutNotActive    = errors.New("rollout is no longer in the canary stage")
+	ErrInvalidAgentVersion = errors.New("agent version is required and must differ from the current fleet version")
+	ErrInvalidPercentage   = errors.New("percentage must be between 1 and 100")
+	ErrInvalidMaxErrorRate = errors.New("max error rate must be between 0 and 1")
+	ErrNoWorkspaces        = errors.New("there are no workspaces to include in the rollout")
+)
+
+func IsRolloutInProgress(err error) bool {
+	return err.Error() == ErrRolloutInProgress.Error() || err.Error() == ErrRolloutNotActive.Error()
+}
+
+func IsInvalidRolloutRequest(err error) bool {
+	return err.Error() == ErrInvalidAgentVersion.Error() || err.Error() == ErrInvalidPercentage.Error() || err.Error() == ErrInvalidMaxErrorRate.Error() || err.Error() == ErrNoWorkspaces.Error()
+}
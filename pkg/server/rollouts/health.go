@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/rollout"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Agents report their state every few seconds so a running project that has been silent for longer is considered unhealthy
+const heartbeatTimeout = 30 * time.Second
+
+func (s *RolloutService) Find(id string) (*rollout.RolloutStatus, error) {
+	r, err := s.rolloutStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return &rollout.RolloutStatus{
+		Rollout: *r,
+		Health:  getHealth(r, workspaces),
+	}, nil
+}
+
+func (s *RolloutService) List() ([]*rollout.RolloutStatus, error) {
+	rollouts, err := s.rolloutStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*rollout.RolloutStatus{}
+	for _, r := range rollouts {
+		result = append(result, &rollout.RolloutStatus{
+			Rollout: *r,
+			Health:  getHealth(r, workspaces),
+		})
+	}
+
+	return result, nil
+}
+
+func getHealth(r *rollout.Rollout, workspaces []*workspace.Workspace) rollout.RolloutHealth {
+	health := rollout.RolloutHealth{}
+	baselineRunning, baselineUnhealthy := 0, 0
+
+	for _, w := range workspaces {
+		canary := r.HasWorkspace(w.Id)
+
+		for _, p := range w.Projects {
+			running := p.State != nil && p.State.Uptime > 0
+			alive := isAlive(p.State)
+
+			if !canary {
+				if running {
+					baselineRunning++
+					if !alive {
+						baselineUnhealthy++
+					}
+				}
+				continue
+			}
+
+			switch {
+			case running && !alive:
+				health.Unhealthy++
+			case alive && p.State.AgentVersion == r.AgentVersion:
+				health.Upgraded++
+			default:
+				health.Pending++
+			}
+		}
+	}
+
+	if reporting := health.Upgraded + health.Unhealthy; reporting > 0 {
+		health.ErrorRate = float64(health.Unhealthy) / float64(reporting)
+	}
+
+	if baselineRunning > 0 {
+		health.BaselineErrorRate = float64(baselineUnhealthy) / float64(baselineRunning)
+	}
+
+	return health
+}
+
+func isAlive(state *project.ProjectState) bool {
+	if state == nil {
+		return false
+	}
+
+	updatedAt, err := time.Parse(time.RFC1123, state.UpdatedAt)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(updatedAt) < heartbeatTimeout
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import (
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+const monitorInterval = "0 * * * * *"
+
+// StartPoller rolls back canary rollouts whose error rate exceeds their configured limit
+func (s *RolloutService) StartPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(monitorInterval, func() {
+		err := s.checkRollouts()
+		if err != nil {
+			log.Errorf("Failed to check agent rollouts: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+func (s *RolloutService) checkRollouts() error {
+	rollouts, err := s.List()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rollouts {
+		if !r.IsActive() || r.MaxErrorRate == 0 || r.Health.ErrorRate <= r.MaxErrorRate {
+			continue
+		}
+
+		log.Warnf("Rolling back agent rollout %s: error rate %.2f exceeds %.2f", r.Id, r.Health.ErrorRate, r.MaxErrorRate)
+
+		_, err := s.Rollback(r.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/rollout"
+	"github.com/google/uuid"
+)
+
+func (s *RolloutService) Create(agentVersion string, percentage int, maxErrorRate float64) (*rollout.Rollout, error) {
+	rollouts, err := s.rolloutStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(rollouts, agentVersion, s.getFleetVersion(rollouts, s.serverVersion), percentage, maxErrorRate)
+}
+
+// CreateForServerUpgrade stages the agent version of the upgraded server. Only the canary workspaces receive it, the
+// rest of the fleet keeps the agent version it had before the upgrade until the rollout is promoted
+func (s *RolloutService) CreateForServerUpgrade(previousServerVersion string, percentage int, maxErrorRate float64) (*rollout.Rollout, error) {
+	rollouts, err := s.rolloutStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.create(rollouts, s.serverVersion, s.getFleetVersion(rollouts, previousServerVersion), percentage, maxErrorRate)
+}
+
+func (s *RolloutService) create(rollouts []*rollout.Rollout, agentVersion, fleetVersion string, percentage int, maxErrorRate float64) (*rollout.Rollout, error) {
+	if percentage < 1 || percentage > 100 {
+		return nil, ErrInvalidPercentage
+	}
+
+	if maxErrorRate < 0 || maxErrorRate > 1 {
+		return nil, ErrInvalidMaxErrorRate
+	}
+
+	for _, r := range rollouts {
+		if r.IsActive() {
+			return nil, ErrRolloutInProgress
+		}
+	}
+
+	if agentVersion == "" || agentVersion == fleetVersion {
+		return nil, ErrInvalidAgentVersion
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(workspaces) == 0 {
+		return nil, ErrNoWorkspaces
+	}
+
+	workspaceIds := []string{}
+	for _, w := range workspaces {
+		workspaceIds = append(workspaceIds, w.Id)
+	}
+
+	rand.Shuffle(len(workspaceIds), func(i, j int) {
+		workspaceIds[i], workspaceIds[j] = workspaceIds[j], workspaceIds[i]
+	})
+
+	canarySize := int(math.Ceil(float64(len(workspaceIds)*percentage) / 100))
+
+	now := time.Now()
+	r := &rollout.Rollout{
+		Id:              uuid.NewString(),
+		AgentVersion:    agentVersion,
+		PreviousVersion: fleetVersion,
+		ServerVersion:   s.serverVersion,
+		Percentage:      percentage,
+		WorkspaceIds:    workspaceIds[:canarySize],
+		MaxErrorRate:    maxErrorRate,
+		State:           rollout.RolloutStateCanary,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	return r, s.rolloutStore.Save(r)
+}
+
+func (s *RolloutService) Promote(id string) (*rollout.Rollout, error) {
+	return s.finish(id, rollout.RolloutStatePromoted)
+}
+
+func (s *RolloutService) Rollback(id string) (*rollout.Rollout, error) {
+	return s.finish(id, rollout.RolloutStateRolledBack)
+}
+
+// GetAgentVersion returns the agent version a workspace should receive the next time its projects start
+func (s *RolloutService) GetAgentVersion(workspaceId string) (string, error) {
+	rollouts, err := s.rolloutStore.List()
+	if err != nil {
+		return "", err
+	}
+
+	for _, r := range rollouts {
+		if r.IsActive() && r.HasWorkspace(workspaceId) {
+			return r.AgentVersion, nil
+		}
+	}
+
+	return s.getFleetVersion(rollouts, s.serverVersion), nil
+}
+
+func (s *RolloutService) finish(id string, state rollout.RolloutState) (*rollout.Rollout, error) {
+	r, err := s.rolloutStore.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.IsActive() {
+		return nil, ErrRolloutNotActive
+	}
+
+	r.State = state
+	r.UpdatedAt = time.Now()
+
+	return r, s.rolloutStore.Save(r)
+}
+
+// getFleetVersion returns the agent version of the workspaces outside of the canary while the server runs the
+// version. It is set by the latest rollout started on the server version: the agent version once the rollout is
+// promoted, the version the fleet had before otherwise. Without rollouts the fleet uses the agent of the server
+func (s *RolloutService) getFleetVersion(rollouts []*rollout.Rollout, serverVersion string) string {
+	var latest *rollout.Rollout
+	for _, r := range rollouts {
+		if r.ServerVersion != serverVersion {
+			continue
+		}
+		if latest == nil || r.CreatedAt.After(latest.CreatedAt) {
+			latest = r
+		}
+	}
+
+	if latest == nil {
+		return serverVersion
+	}
+
+	if latest.State == rollout.RolloutStatePromoted {
+		return latest.AgentVersion
+	}
+
+	return latest.PreviousVersion
+}
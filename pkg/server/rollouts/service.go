@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts
+
+import (
+	"github.com/daytonaio/daytona/pkg/rollout"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+type IRolloutService interface {
+	Create(agentVersion string, percentage int, maxErrorRate float64) (*rollout.Rollout, error)
+	// CreateForServerUpgrade stages the agent version of the upgraded server instead of giving it to the whole fleet
+	CreateForServerUpgrade(previousServerVersion string, percentage int, maxErrorRate float64) (*rollout.Rollout, error)
+	Find(id string) (*rollout.RolloutStatus, error)
+	List() ([]*rollout.RolloutStatus, error)
+	Promote(id string) (*rollout.Rollout, error)
+	Rollback(id string) (*rollout.Rollout, error)
+	GetAgentVersion(workspaceId string) (string, error)
+	StartPoller() error
+}
+
+type RolloutServiceConfig struct {
+	RolloutStore   rollout.Store
+	WorkspaceStore workspace.Store
+	ServerVersion  string
+}
+
+func NewRolloutService(config RolloutServiceConfig) IRolloutService {
+	return &RolloutService{
+		rolloutStore:   config.RolloutStore,
+		workspaceStore: config.WorkspaceStore,
+		serverVersion:  config.ServerVersion,
+	}
+}
+
+type RolloutService struct {
+	rolloutStore   rollout.Store
+	workspaceStore workspace.Store
+	serverVersion  string
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollouts_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	t_rollouts "github.com/daytonaio/daytona/internal/testing/server/rollouts"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/rollout"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+const serverVersion = "0.1.0"
+
+func TestRollout(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	for i := 0; i < 4; i++ {
+		require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+			Id:       fmt.Sprintf("ws%d", i),
+			Projects: []*project.Project{{Name: "p"}},
+		}))
+	}
+
+	service := rollouts.NewRolloutService(rollouts.RolloutServiceConfig{
+		RolloutStore:   t_rollouts.NewInMemoryRolloutStore(),
+		WorkspaceStore: workspaceStore,
+		ServerVersion:  serverVersion,
+	})
+
+	_, err := service.Create(serverVersion, 50, 0)
+	require.Equal(t, rollouts.ErrInvalidAgentVersion, err)
+
+	r, err := service.Create("0.2.0", 50, 0)
+	require.Nil(t, err)
+	require.Len(t, r.WorkspaceIds, 2)
+	require.Equal(t, serverVersion, r.PreviousVersion)
+
+	_, err = service.Create("0.3.0", 50, 0)
+	require.Equal(t, rollouts.ErrRolloutInProgress, err)
+
+	canaryId := r.WorkspaceIds[0]
+	controlId := ""
+	for i := 0; i < 4; i++ {
+		if !r.HasWorkspace(fmt.Sprintf("ws%d", i)) {
+			controlId = fmt.Sprintf("ws%d", i)
+			break
+		}
+	}
+
+	version, err := service.GetAgentVersion(canaryId)
+	require.Nil(t, err)
+	require.Equal(t, "0.2.0", version)
+
+	version, err = service.GetAgentVersion(controlId)
+	require.Nil(t, err)
+	require.Equal(t, serverVersion, version)
+
+	setState(t, workspaceStore, canaryId, &project.ProjectState{Uptime: 10, UpdatedAt: time.Now().Format(time.RFC1123), AgentVersion: "0.2.0"})
+	setState(t, workspaceStore, r.WorkspaceIds[1], &project.ProjectState{Uptime: 10, UpdatedAt: time.Now().Add(-time.Hour).Format(time.RFC1123)})
+	setState(t, workspaceStore, controlId, &project.ProjectState{Uptime: 10, UpdatedAt: time.Now().Format(time.RFC1123), AgentVersion: serverVersion})
+
+	status, err := service.Find(r.Id)
+	require.Nil(t, err)
+	require.Equal(t, rollout.RolloutHealth{Upgraded: 1, Unhealthy: 1, ErrorRate: 0.5}, status.Health)
+
+	_, err = service.Promote(r.Id)
+	require.Nil(t, err)
+
+	_, err = service.Rollback(r.Id)
+	require.Equal(t, rollouts.ErrRolloutNotActive, err)
+
+	version, err = service.GetAgentVersion(controlId)
+	require.Nil(t, err)
+	require.Equal(t, "0.2.0", version)
+}
+
+func setState(t *testing.T, workspaceStore workspace.Store, workspaceId string, state *project.ProjectState) {
+	w, err := workspaceStore.Find(workspaceId)
+	require.Nil(t, err)
+
+	w.Projects[0].State = state
+	require.Nil(t, workspaceStore.Save(w))
+}
+
+func TestRolloutForServerUpgrade(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	for i := 0; i < 4; i++ {
+		require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+			Id:       fmt.Sprintf("ws%d", i),
+			Projects: []*project.Project{{Name: "p"}},
+		}))
+	}
+
+	rolloutStore := t_rollouts.NewInMemoryRolloutStore()
+
+	previousService := rollouts.NewRolloutService(rollouts.RolloutServiceConfig{
+		RolloutStore:   rolloutStore,
+		WorkspaceStore: workspaceStore,
+		ServerVersion:  serverVersion,
+	})
+
+	r, err := previousService.Create("0.1.1", 100, 0)
+	require.Nil(t, err)
+	_, err = previousService.Promote(r.Id)
+	require.Nil(t, err)
+
+	service := rollouts.NewRolloutService(rollouts.RolloutServiceConfig{
+		RolloutStore:   rolloutStore,
+		WorkspaceStore: workspaceStore,
+		ServerVersion:  "0.2.0",
+	})
+
+	r, err = service.CreateForServerUpgrade(serverVersion, 25, 0)
+	require.Nil(t, err)
+	require.Equal(t, "0.2.0", r.AgentVersion)
+	require.Equal(t, "0.1.1", r.PreviousVersion)
+	require.Len(t, r.WorkspaceIds, 1)
+
+	controlId := ""
+	for i := 0; i < 4; i++ {
+		if !r.HasWorkspace(fmt.Sprintf("ws%d", i)) {
+			controlId = fmt.Sprintf("ws%d", i)
+			break
+		}
+	}
+
+	version, err := service.GetAgentVersion(r.WorkspaceIds[0])
+	require.Nil(t, err)
+	require.Equal(t, "0.2.0", version)
+
+	version, err = service.GetAgentVersion(controlId)
+	require.Nil(t, err)
+	require.Equal(t, "0.1.1", version)
+
+	_, err = service.Rollback(r.Id)
+	require.Nil(t, err)
+
+	version, err = service.GetAgentVersion(r.WorkspaceIds[0])
+	require.Nil(t, err)
+	require.Equal(t, "0.1.1", version)
+}
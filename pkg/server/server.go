@@ -6,15 +6,32 @@ package server
 import (
 	"os"
 	"os/signal"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/server/announcements"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/artifacts"
+	"github.com/daytonaio/daytona/pkg/server/audit"
 	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/buildsecrets"
+	"github.com/daytonaio/daytona/pkg/server/commandruns"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/impersonation"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/daytonaio/daytona/pkg/server/organizations"
+	"github.com/daytonaio/daytona/pkg/server/previewdns"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
+	"github.com/daytonaio/daytona/pkg/server/regions"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
+	"github.com/daytonaio/daytona/pkg/server/statehistory"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/daytonaio/daytona/pkg/server/tunnels"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/hashicorp/go-plugin"
@@ -28,15 +45,34 @@ type ServerInstanceConfig struct {
 	TailscaleServer          TailscaleServer
 	ProviderTargetService    providertargets.IProviderTargetService
 	ContainerRegistryService containerregistries.IContainerRegistryService
+	BuildSecretService       buildsecrets.IBuildSecretService
 	BuildService             builds.IBuildService
 	ProjectConfigService     projectconfig.IProjectConfigService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
 	ApiKeyService            apikeys.IApiKeyService
+	OrganizationService      organizations.IOrganizationService
+	RolloutService           rollouts.IRolloutService
+	AnnouncementService      announcements.IAnnouncementService
+	SharedServiceService     sharedservices.ISharedServiceService
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
-	TelemetryService         telemetry.TelemetryService
+	ArtifactService          artifacts.IArtifactService
+	CommandRunService        commandruns.ICommandRunService
+	CreationTimingService    creationtimings.ICreationTimingService
+	NetworkKeyService        networkkeys.INetworkKeyService
+	// PreviewDnsService is nil if the DNS records of public preview URLs are not managed by the server
+	PreviewDnsService previewdns.IPreviewDnsService
+	// TailnetCa signs the certificates of the TLS ports of projects. Clients trust the ports with its certificate
+	TailnetCa           *tailnetca.CertificateAuthority
+	RegionService       regions.IRegionService
+	StateHistoryService statehistory.IStateHistoryService
+	AuditService        audit.IAuditService
+	// ImpersonationService lets the administrators of the impersonation config act on behalf of users
+	ImpersonationService impersonation.IImpersonationService
+	TunnelService        tunnels.ITunnelService
+	TelemetryService     telemetry.TelemetryService
 }
 
 var server *Server
@@ -57,14 +93,30 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			TailscaleServer:          serverConfig.TailscaleServer,
 			ProviderTargetService:    serverConfig.ProviderTargetService,
 			ContainerRegistryService: serverConfig.ContainerRegistryService,
+			BuildSecretService:       serverConfig.BuildSecretService,
 			BuildService:             serverConfig.BuildService,
 			ProjectConfigService:     serverConfig.ProjectConfigService,
 			LocalContainerRegistry:   serverConfig.LocalContainerRegistry,
 			WorkspaceService:         serverConfig.WorkspaceService,
 			ApiKeyService:            serverConfig.ApiKeyService,
+			OrganizationService:      serverConfig.OrganizationService,
+			RolloutService:           serverConfig.RolloutService,
+			AnnouncementService:      serverConfig.AnnouncementService,
+			SharedServiceService:     serverConfig.SharedServiceService,
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
+			ArtifactService:          serverConfig.ArtifactService,
+			CommandRunService:        serverConfig.CommandRunService,
+			CreationTimingService:    serverConfig.CreationTimingService,
+			NetworkKeyService:        serverConfig.NetworkKeyService,
+			PreviewDnsService:        serverConfig.PreviewDnsService,
+			TailnetCa:                serverConfig.TailnetCa,
+			RegionService:            serverConfig.RegionService,
+			StateHistoryService:      serverConfig.StateHistoryService,
+			AuditService:             serverConfig.AuditService,
+			ImpersonationService:     serverConfig.ImpersonationService,
+			TunnelService:            serverConfig.TunnelService,
 			TelemetryService:         serverConfig.TelemetryService,
 		}
 	}
@@ -79,15 +131,37 @@ type Server struct {
 	TailscaleServer          TailscaleServer
 	ProviderTargetService    providertargets.IProviderTargetService
 	ContainerRegistryService containerregistries.IContainerRegistryService
+	BuildSecretService       buildsecrets.IBuildSecretService
 	BuildService             builds.IBuildService
 	ProjectConfigService     projectconfig.IProjectConfigService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
 	ApiKeyService            apikeys.IApiKeyService
+	OrganizationService      organizations.IOrganizationService
+	RolloutService           rollouts.IRolloutService
+	AnnouncementService      announcements.IAnnouncementService
+	SharedServiceService     sharedservices.ISharedServiceService
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
-	TelemetryService         telemetry.TelemetryService
+	ArtifactService          artifacts.IArtifactService
+	CommandRunService        commandruns.ICommandRunService
+	CreationTimingService    creationtimings.ICreationTimingService
+	NetworkKeyService        networkkeys.INetworkKeyService
+	// PreviewDnsService is nil if the DNS records of public preview URLs are not managed by the server
+	PreviewDnsService previewdns.IPreviewDnsService
+	// TailnetCa signs the certificates of the TLS ports of projects. Clients trust the ports with its certificate
+	TailnetCa           *tailnetca.CertificateAuthority
+	RegionService       regions.IRegionService
+	StateHistoryService statehistory.IStateHistoryService
+	AuditService        audit.IAuditService
+	// ImpersonationService lets the administrators of the impersonation config act on behalf of users
+	ImpersonationService impersonation.IImpersonationService
+	TunnelService        tunnels.ITunnelService
+	TelemetryService     telemetry.TelemetryService
+
+	// SHA-256 checksums of served binaries, keyed by path. Binaries of a version do not change once downloaded
+	binaryChecksums sync.Map
 }
 
 func (s *Server) Initialize() error {
@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/sharedservice"
+
+type SharedServiceDTO struct {
+	sharedservice.SharedService
+	Info *sharedservice.SharedServiceInfo `json:"info" validate:"optional"`
+	// IDs of the attached workspaces
+	Workspaces []string `json:"workspaces" validate:"required"`
+} //	@name	SharedServiceDTO
+
+type CreateSharedServiceDTO struct {
+	Name   string `json:"name" validate:"required"`
+	Target string `json:"target" validate:"required"`
+	// Preset for a common service, one of postgres, mysql or redis. Other fields override the values of the template
+	Template          *string           `json:"template,omitempty" validate:"optional"`
+	Image             *string           `json:"image,omitempty" validate:"optional"`
+	Port              *uint16           `json:"port,omitempty" validate:"optional"`
+	EnvVars           map[string]string `json:"envVars,omitempty" validate:"optional"`
+	ConnectionEnvVars map[string]string `json:"connectionEnvVars,omitempty" validate:"optional"`
+	DataPath          *string           `json:"dataPath,omitempty" validate:"optional"`
+} //	@name	CreateSharedServiceDTO
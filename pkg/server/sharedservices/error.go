@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservices
+
+import "errors"
+
+var (
+	ErrInvalidName         = errors.New("name must consist of lowercase letters, digits and hyphens")
+	ErrInvalidTemplate     = errors.New("template must be one of postgres, mysql or redis")
+	ErrImageRequired       = errors.New("image is required")
+	ErrPortRequired        = errors.New("port is required")
+	ErrSharedServiceExists = errors.New("a shared service with the same name already exists")
+	ErrSharedServiceInUse  = errors.New("shared service is attached to workspaces, detach it first or force the deletion")
+	ErrTargetMismatch      = errors.New("only workspaces on the target of the shared service can attach to it")
+)
+
+func IsInvalidSharedServiceRequest(err error) bool {
+	return err.Error() == ErrInvalidName.Error() || err.Error() == ErrInvalidTemplate.Error() || err.Error() == ErrImageRequired.Error() || err.Error() == ErrPortRequired.Error()
+}
+
+func IsSharedServiceExists(err error) bool {
+	return err.Error() == ErrSharedServiceExists.Error()
+}
+
+func IsSharedServiceInUse(err error) bool {
+	return err.Error() == ErrSharedServiceInUse.Error()
+}
+
+func IsTargetMismatch(err error) bool {
+	return err.Error() == ErrTargetMismatch.Error()
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservices
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices/dto"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+type ISharedServiceService interface {
+	Create(ctx context.Context, req dto.CreateSharedServiceDTO) (*sharedservice.SharedService, error)
+	List(ctx context.Context, filter *sharedservice.Filter, verbose bool) ([]dto.SharedServiceDTO, error)
+	Find(name string) (*sharedservice.SharedService, error)
+	// Delete destroys the service with its data. Services attached to workspaces are only deleted if force is set
+	Delete(name string, force bool) error
+	Attach(workspaceId string, name string) error
+	Detach(workspaceId string, name string) error
+	// GetConnectionEnvVars returns the environment variables that point projects on the target to the given services
+	GetConnectionEnvVars(ctx context.Context, target *provider.ProviderTarget, names []string) (map[string]string, error)
+}
+
+type targetStore interface {
+	Find(filter *provider.TargetFilter) (*provider.ProviderTarget, error)
+}
+
+type SharedServiceServiceConfig struct {
+	SharedServiceStore       sharedservice.Store
+	WorkspaceStore           workspace.Store
+	TargetStore              targetStore
+	ContainerRegistryService containerregistries.IContainerRegistryService
+	Provisioner              provisioner.IProvisioner
+}
+
+func NewSharedServiceService(config SharedServiceServiceConfig) ISharedServiceService {
+	return &SharedServiceService{
+		sharedServiceStore:       config.SharedServiceStore,
+		workspaceStore:           config.WorkspaceStore,
+		targetStore:              config.TargetStore,
+		containerRegistryService: config.ContainerRegistryService,
+		provisioner:              config.Provisioner,
+	}
+}
+
+type SharedServiceService struct {
+	sharedServiceStore       sharedservice.Store
+	workspaceStore           workspace.Store
+	targetStore              targetStore
+	containerRegistryService containerregistries.IContainerRegistryService
+	provisioner              provisioner.IProvisioner
+}
@@ -0,0 +1,294 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservices
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices/dto"
+	"github.com/daytonaio/daytona/pkg/sharedservice"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const infoTimeout = 10 * time.Second
+
+func (s *SharedServiceService) Create(ctx context.Context, req dto.CreateSharedServiceDTO) (*sharedservice.SharedService, error) {
+	if !sharedservice.IsValidName(req.Name) {
+		return nil, ErrInvalidName
+	}
+
+	_, err := s.sharedServiceStore.Find(req.Name)
+	if err == nil {
+		return nil, ErrSharedServiceExists
+	}
+	if !sharedservice.IsSharedServiceNotFound(err) {
+		return nil, err
+	}
+
+	service, err := toSharedService(req)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := s.containerRegistryService.FindByImageName(service.Image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return nil, err
+	}
+
+	err = s.provisioner.CreateSharedService(service, target, cr)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, s.sharedServiceStore.Save(service)
+}
+
+func toSharedService(req dto.CreateSharedServiceDTO) (*sharedservice.SharedService, error) {
+	service := &sharedservice.SharedService{
+		Name:              req.Name,
+		Target:            req.Target,
+		EnvVars:           map[string]string{},
+		ConnectionEnvVars: map[string]string{},
+		CreatedAt:         time.Now(),
+	}
+
+	if req.Template != nil {
+		template, ok := sharedservice.Templates[*req.Template]
+		if !ok {
+			return nil, ErrInvalidTemplate
+		}
+
+		service.Image = template.Image
+		service.Port = template.Port
+		service.DataPath = template.DataPath
+
+		// The generated password is only known to the service and the connection env vars of attached projects
+		replacer := strings.NewReplacer("{{password}}", strings.ReplaceAll(uuid.NewString(), "-", ""))
+		for key, value := range template.EnvVars {
+			service.EnvVars[key] = replacer.Replace(value)
+		}
+		for key, value := range template.ConnectionEnvVars {
+			service.ConnectionEnvVars[key] = replacer.Replace(value)
+		}
+	}
+
+	if req.Image != nil {
+		service.Image = *req.Image
+	}
+	if req.Port != nil {
+		service.Port = *req.Port
+	}
+	if req.DataPath != nil {
+		service.DataPath = *req.DataPath
+	}
+	maps.Copy(service.EnvVars, req.EnvVars)
+	maps.Copy(service.ConnectionEnvVars, req.ConnectionEnvVars)
+
+	if service.Image == "" {
+		return nil, ErrImageRequired
+	}
+	if service.Port == 0 {
+		return nil, ErrPortRequired
+	}
+
+	return service, nil
+}
+
+func (s *SharedServiceService) List(ctx context.Context, filter *sharedservice.Filter, verbose bool) ([]dto.SharedServiceDTO, error) {
+	services, err := s.sharedServiceStore.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	attached, err := s.getAttachedWorkspaces()
+	if err != nil {
+		return nil, err
+	}
+
+	var wg sync.WaitGroup
+	response := []dto.SharedServiceDTO{}
+
+	for i, service := range services {
+		response = append(response, dto.SharedServiceDTO{SharedService: *service, Workspaces: attached[service.Name]})
+		if response[i].Workspaces == nil {
+			response[i].Workspaces = []string{}
+		}
+		if !verbose {
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			info, err := s.getInfo(ctx, service)
+			if err != nil {
+				log.Error(fmt.Errorf("failed to get shared service info for %s: %v", service.Name, err))
+				return
+			}
+
+			response[i].Info = info
+		}(i)
+	}
+
+	wg.Wait()
+	return response, nil
+}
+
+func (s *SharedServiceService) Find(name string) (*sharedservice.SharedService, error) {
+	return s.sharedServiceStore.Find(name)
+}
+
+func (s *SharedServiceService) Delete(name string, force bool) error {
+	service, err := s.sharedServiceStore.Find(name)
+	if err != nil {
+		return err
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if !slices.Contains(w.SharedServices, name) {
+			continue
+		}
+
+		if !force {
+			return ErrSharedServiceInUse
+		}
+
+		w.SharedServices = slices.DeleteFunc(w.SharedServices, func(n string) bool { return n == name })
+		err = s.workspaceStore.Save(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &service.Target})
+	if err != nil {
+		if !force {
+			return err
+		}
+		log.Warnf("failed to find target %s, the container of shared service %s has to be removed manually: %v", service.Target, name, err)
+	} else {
+		err = s.provisioner.DestroySharedService(service, target)
+		if err != nil && !force {
+			return err
+		}
+	}
+
+	return s.sharedServiceStore.Delete(service)
+}
+
+func (s *SharedServiceService) Attach(workspaceId string, name string) error {
+	service, err := s.sharedServiceStore.Find(name)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return err
+	}
+
+	if w.Target != service.Target {
+		return ErrTargetMismatch
+	}
+
+	if slices.Contains(w.SharedServices, name) {
+		return nil
+	}
+
+	w.SharedServices = append(w.SharedServices, name)
+	return s.workspaceStore.Save(w)
+}
+
+func (s *SharedServiceService) Detach(workspaceId string, name string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(w.SharedServices, name) {
+		return sharedservice.ErrSharedServiceNotFound
+	}
+
+	w.SharedServices = slices.DeleteFunc(w.SharedServices, func(n string) bool { return n == name })
+	return s.workspaceStore.Save(w)
+}
+
+func (s *SharedServiceService) GetConnectionEnvVars(ctx context.Context, target *provider.ProviderTarget, names []string) (map[string]string, error) {
+	envVars := map[string]string{}
+
+	for _, name := range names {
+		service, err := s.sharedServiceStore.Find(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find shared service %s: %w", name, err)
+		}
+
+		if service.Target != target.Name {
+			return nil, ErrTargetMismatch
+		}
+
+		infoCtx, cancel := context.WithTimeout(ctx, infoTimeout)
+		info, err := s.provisioner.GetSharedServiceInfo(infoCtx, service, target)
+		cancel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shared service info for %s: %w", name, err)
+		}
+
+		if !info.IsRunning || info.Host == "" {
+			return nil, fmt.Errorf("shared service %s is not running", name)
+		}
+
+		maps.Copy(envVars, service.GetConnectionEnvVars(info))
+	}
+
+	return envVars, nil
+}
+
+func (s *SharedServiceService) getInfo(ctx context.Context, service *sharedservice.SharedService) (*sharedservice.SharedServiceInfo, error) {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &service.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, infoTimeout)
+	defer cancel()
+
+	return s.provisioner.GetSharedServiceInfo(ctx, service, target)
+}
+
+// getAttachedWorkspaces returns the IDs of the workspaces attached to each shared service
+func (s *SharedServiceService) getAttachedWorkspaces() (map[string][]string, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	attached := map[string][]string{}
+	for _, w := range workspaces {
+		for _, name := range w.SharedServices {
+			attached[name] = append(attached[name], w.Id)
+		}
+	}
+
+	return attached, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *StateHistoryService) StartPoller() error {
+	// Record right away so the history does not start one interval after a server restart
+	err := s.Record()
+	if err != nil {
+		log.Errorf("Failed to record workspace state snapshots: %s", err)
+	}
+
+	scheduler := build.NewCronScheduler()
+
+	err = scheduler.AddFunc(fmt.Sprintf("@every %s", s.interval), func() {
+		err := s.Record()
+		if err != nil {
+			log.Errorf("Failed to record workspace state snapshots: %s", err)
+		}
+
+		err = s.EnforceRetention()
+		if err != nil {
+			log.Errorf("Failed to remove expired workspace state snapshots: %s", err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
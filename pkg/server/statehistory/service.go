@@ -0,0 +1,104 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/statehistory"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultInterval  = 5 * time.Minute
+	DefaultRetention = 7 * 24 * time.Hour
+)
+
+type IStateHistoryService interface {
+	Record() error
+	List(filter *statehistory.Filter) ([]*statehistory.Snapshot, error)
+	// GetStateAt returns the last known state of each project of the workspace at the given time
+	GetStateAt(workspaceIdOrName string, at time.Time) ([]*statehistory.Snapshot, error)
+	EnforceRetention() error
+	StartPoller() error
+}
+
+type StateHistoryServiceConfig struct {
+	StateSnapshotStore statehistory.Store
+	WorkspaceStore     workspace.Store
+	// Interval between snapshots. Defaults to DefaultInterval
+	Interval time.Duration
+	// Snapshots older than this are removed. Defaults to DefaultRetention
+	Retention time.Duration
+}
+
+func NewStateHistoryService(config StateHistoryServiceConfig) IStateHistoryService {
+	interval := config.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	retention := config.Retention
+	if retention <= 0 {
+		retention = DefaultRetention
+	}
+
+	return &StateHistoryService{
+		stateSnapshotStore: config.StateSnapshotStore,
+		workspaceStore:     config.WorkspaceStore,
+		interval:           interval,
+		retention:          retention,
+	}
+}
+
+type StateHistoryService struct {
+	stateSnapshotStore statehistory.Store
+	workspaceStore     workspace.Store
+	interval           time.Duration
+	retention          time.Duration
+}
+
+func (s *StateHistoryService) Record() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range statehistory.Take(workspaces, time.Now()) {
+		snapshot.Id = uuid.NewString()
+
+		err = s.stateSnapshotStore.Save(snapshot)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *StateHistoryService) List(filter *statehistory.Filter) ([]*statehistory.Snapshot, error) {
+	return s.stateSnapshotStore.List(filter)
+}
+
+func (s *StateHistoryService) GetStateAt(workspaceIdOrName string, at time.Time) ([]*statehistory.Snapshot, error) {
+	// Only the snapshots of the last interval can hold the state at the given time,
+	// with some slack for snapshots that took longer to record
+	since := at.Add(-2 * s.interval)
+
+	snapshots, err := s.stateSnapshotStore.List(&statehistory.Filter{
+		Workspace: &workspaceIdOrName,
+		Since:     &since,
+		Until:     &at,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statehistory.GetStateAt(snapshots, at), nil
+}
+
+func (s *StateHistoryService) EnforceRetention() error {
+	return s.stateSnapshotStore.DeleteBefore(time.Now().Add(-s.retention))
+}
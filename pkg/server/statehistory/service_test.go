@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory_test
+
+import (
+	"testing"
+	"time"
+
+	t_statehistory "github.com/daytonaio/daytona/internal/testing/server/statehistory"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/statehistory"
+	statehistory_model "github.com/daytonaio/daytona/pkg/statehistory"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndGetStateAt(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	snapshotStore := t_statehistory.NewInMemoryStateSnapshotStore()
+
+	ws := &workspace.Workspace{
+		Id:       "ws1",
+		Name:     "ws",
+		Projects: []*project.Project{{Name: "p", State: &project.ProjectState{Uptime: 10, UpdatedAt: time.Now().Format(time.RFC1123)}}},
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	service := statehistory.NewStateHistoryService(statehistory.StateHistoryServiceConfig{
+		StateSnapshotStore: snapshotStore,
+		WorkspaceStore:     workspaceStore,
+	})
+
+	require.Nil(t, service.Record())
+	runningAt := time.Now()
+
+	ws.Projects[0].State.Uptime = 0
+	require.Nil(t, workspaceStore.Save(ws))
+	require.Nil(t, service.Record())
+
+	snapshots, err := service.List(nil)
+	require.Nil(t, err)
+	require.Len(t, snapshots, 2)
+
+	state, err := service.GetStateAt("ws", runningAt)
+	require.Nil(t, err)
+	require.Len(t, state, 1)
+	require.True(t, state[0].Running)
+
+	state, err = service.GetStateAt("ws1", time.Now())
+	require.Nil(t, err)
+	require.Len(t, state, 1)
+	require.False(t, state[0].Running)
+}
+
+func TestEnforceRetention(t *testing.T) {
+	snapshotStore := t_statehistory.NewInMemoryStateSnapshotStore()
+
+	require.Nil(t, snapshotStore.Save(&statehistory_model.Snapshot{Id: "old", RecordedAt: time.Now().Add(-2 * time.Hour)}))
+	require.Nil(t, snapshotStore.Save(&statehistory_model.Snapshot{Id: "new", RecordedAt: time.Now()}))
+
+	service := statehistory.NewStateHistoryService(statehistory.StateHistoryServiceConfig{
+		StateSnapshotStore: snapshotStore,
+		WorkspaceStore:     t_workspaces.NewInMemoryWorkspaceStore(),
+		Retention:          time.Hour,
+	})
+
+	require.Nil(t, service.EnforceRetention())
+
+	snapshots, err := service.List(nil)
+	require.Nil(t, err)
+	require.Len(t, snapshots, 1)
+	require.Equal(t, "new", snapshots[0].Id)
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailnetca
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	certificateFileName = "ca.pem"
+	keyFileName         = "ca.key"
+)
+
+const (
+	caValidity = 10 * 365 * 24 * time.Hour
+	// CertificateValidity is the validity of the certificates issued to projects. Agents renew them before they expire
+	CertificateValidity = 30 * 24 * time.Hour
+	// Tolerates clocks of clients that are behind the clock of the server
+	clockSkew = 5 * time.Minute
+)
+
+var ErrInvalidCertificateRequest = errors.New("invalid certificate request")
+
+func IsInvalidCertificateRequest(err error) bool {
+	return errors.Is(err, ErrInvalidCertificateRequest)
+}
+
+// CertificateAuthority signs the certificates that projects serve their TLS ports with. The authority is constrained
+// to the tailnet domain, so clients that trust it only trust it for the tailnet names of projects
+type CertificateAuthority struct {
+	certificate    *x509.Certificate
+	certificatePem []byte
+	key            crypto.Signer
+}
+
+type Certificate struct {
+	// PEM encoded certificate
+	CertPem  string
+	NotAfter time.Time
+}
+
+// LoadOrCreate loads the authority from the directory and creates it on first use
+func LoadOrCreate(dir string, domain string) (*CertificateAuthority, error) {
+	certificatePath := filepath.Join(dir, certificateFileName)
+	keyPath := filepath.Join(dir, keyFileName)
+
+	certificatePem, err := os.ReadFile(certificatePath)
+	if err == nil {
+		keyPem, err := os.ReadFile(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return parse(certificatePem, keyPem)
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serialNumber, err := getSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:                serialNumber,
+		Subject:                     pkix.Name{CommonName: "Daytona Tailnet CA"},
+		NotBefore:                   time.Now().Add(-clockSkew),
+		NotAfter:                    time.Now().Add(caValidity),
+		KeyUsage:                    x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid:       true,
+		IsCA:                        true,
+		MaxPathLenZero:              true,
+		PermittedDNSDomainsCritical: true,
+		PermittedDNSDomains:         []string{domain},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	certificatePem = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	// The key is written first so that the certificate never pairs with the key of another authority
+	err = os.WriteFile(keyPath, keyPem, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(certificatePath, certificatePem, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse(certificatePem, keyPem)
+}
+
+// GetCertificatePem returns the PEM encoded certificate of the authority that clients trust the projects with
+func (ca *CertificateAuthority) GetCertificatePem() string {
+	return string(ca.certificatePem)
+}
+
+// Sign issues a certificate for the DNS names to the public key of the PEM encoded certificate request. The names
+// requested by the CSR are ignored, so that projects only get certificates for their own names
+func (ca *CertificateAuthority) Sign(csrPem string, dnsNames []string) (*Certificate, error) {
+	block, _ := pem.Decode([]byte(csrPem))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, fmt.Errorf("%w: expected a PEM encoded CSR", ErrInvalidCertificateRequest)
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificateRequest, err)
+	}
+
+	err = csr.CheckSignature()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCertificateRequest, err)
+	}
+
+	serialNumber, err := getSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-clockSkew),
+		NotAfter:     time.Now().Add(CertificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Certificate{
+		CertPem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		NotAfter: template.NotAfter,
+	}, nil
+}
+
+func parse(certificatePem, keyPem []byte) (*CertificateAuthority, error) {
+	block, _ := pem.Decode(certificatePem)
+	if block == nil {
+		return nil, errors.New("invalid tailnet CA certificate")
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ = pem.Decode(keyPem)
+	if block == nil {
+		return nil, errors.New("invalid tailnet CA key")
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateAuthority{
+		certificate:    certificate,
+		certificatePem: certificatePem,
+		key:            key,
+	}, nil
+}
+
+func getSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
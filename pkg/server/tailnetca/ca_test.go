@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailnetca_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/stretchr/testify/require"
+)
+
+func createCsr(t *testing.T, dnsNames ...string) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "project"},
+		DNSNames: dnsNames,
+	}, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func verify(t *testing.T, ca *tailnetca.CertificateAuthority, certificate *tailnetca.Certificate, dnsName string) error {
+	t.Helper()
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM([]byte(ca.GetCertificatePem())))
+
+	block, _ := pem.Decode([]byte(certificate.CertPem))
+	require.NotNil(t, block)
+
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	require.Equal(t, certificate.NotAfter.Unix(), leaf.NotAfter.Unix())
+
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: dnsName, Roots: roots})
+	return err
+}
+
+func TestCertificateAuthority(t *testing.T) {
+	dir := t.TempDir()
+
+	ca, err := tailnetca.LoadOrCreate(dir, "daytona.local")
+	require.NoError(t, err)
+
+	t.Run("LoadOrCreate loads the created authority", func(t *testing.T) {
+		loaded, err := tailnetca.LoadOrCreate(dir, "daytona.local")
+		require.NoError(t, err)
+		require.Equal(t, ca.GetCertificatePem(), loaded.GetCertificatePem())
+	})
+
+	t.Run("Sign issues certificates for the given names only", func(t *testing.T) {
+		certificate, err := ca.Sign(createCsr(t, "other.example.com"), []string{"ws1-p1.daytona.local"})
+		require.NoError(t, err)
+
+		require.NoError(t, verify(t, ca, certificate, "ws1-p1.daytona.local"))
+		require.Error(t, verify(t, ca, certificate, "other.example.com"))
+	})
+
+	t.Run("Certificates outside the tailnet domain are not trusted", func(t *testing.T) {
+		certificate, err := ca.Sign(createCsr(t), []string{"example.com"})
+		require.NoError(t, err)
+
+		require.Error(t, verify(t, ca, certificate, "example.com"))
+	})
+
+	t.Run("Sign rejects invalid requests", func(t *testing.T) {
+		_, err := ca.Sign("not a csr", []string{"ws1-p1.daytona.local"})
+		require.True(t, tailnetca.IsInvalidCertificateRequest(err))
+
+		csr := createCsr(t)
+		block, _ := pem.Decode([]byte(csr))
+		block.Bytes[len(block.Bytes)-1] ^= 0xff
+
+		_, err = ca.Sign(string(pem.EncodeToMemory(block)), []string{"ws1-p1.daytona.local"})
+		require.True(t, tailnetca.IsInvalidCertificateRequest(err))
+	})
+}
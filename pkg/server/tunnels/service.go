@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tunnels
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/tunnel"
+	"github.com/hashicorp/yamux"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrTunnelNotConnected = errors.New("project is not connected through a tunnel")
+)
+
+func IsTunnelNotConnected(err error) bool {
+	return err.Error() == ErrTunnelNotConnected.Error()
+}
+
+type ITunnelService interface {
+	// Serve multiplexes connections to ports of the project over the tunnel its agent opened, for agents that can't
+	// reach the tailnet. Blocks until the tunnel is closed
+	Serve(workspaceId string, projectName string, conn net.Conn) error
+	// Dial opens a connection to a port of the project through the tunnel of its agent
+	Dial(ctx context.Context, workspaceId string, projectName string, port uint16) (net.Conn, error)
+	// IsConnected checks whether the agent of the project is connected through a tunnel
+	IsConnected(workspaceId string, projectName string) bool
+}
+
+func NewTunnelService() ITunnelService {
+	return &TunnelService{
+		sessions: map[string]*yamux.Session{},
+	}
+}
+
+type TunnelService struct {
+	mutex    sync.Mutex
+	sessions map[string]*yamux.Session
+}
+
+func (s *TunnelService) Serve(workspaceId, projectName string, conn net.Conn) error {
+	session, err := tunnel.Open(conn)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	key := getKey(workspaceId, projectName)
+
+	s.mutex.Lock()
+	// A restarted agent replaces the tunnel it opened before
+	if previous, ok := s.sessions[key]; ok {
+		previous.Close()
+	}
+	s.sessions[key] = session
+	s.mutex.Unlock()
+
+	log.Infof("Project %s of workspace %s connected through a tunnel", projectName, workspaceId)
+
+	<-session.CloseChan()
+
+	s.mutex.Lock()
+	if s.sessions[key] == session {
+		delete(s.sessions, key)
+	}
+	s.mutex.Unlock()
+
+	log.Infof("Tunnel of project %s of workspace %s closed", projectName, workspaceId)
+
+	return nil
+}
+
+func (s *TunnelService) Dial(ctx context.Context, workspaceId, projectName string, port uint16) (net.Conn, error) {
+	session := s.getSession(workspaceId, projectName)
+	if session == nil {
+		return nil, ErrTunnelNotConnected
+	}
+
+	return tunnel.Dial(ctx, session, port)
+}
+
+func (s *TunnelService) IsConnected(workspaceId, projectName string) bool {
+	return s.getSession(workspaceId, projectName) != nil
+}
+
+func (s *TunnelService) getSession(workspaceId, projectName string) *yamux.Session {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	session, ok := s.sessions[getKey(workspaceId, projectName)]
+	if !ok || session.IsClosed() {
+		return nil
+	}
+
+	return session
+}
+
+func getKey(workspaceId, projectName string) string {
+	return workspaceId + "/" + projectName
+}
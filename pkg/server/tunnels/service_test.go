@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tunnels_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/tunnels"
+	"github.com/daytonaio/daytona/pkg/tunnel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTunnelService(t *testing.T) {
+	service := tunnels.NewTunnelService()
+	ctx := context.Background()
+
+	_, err := service.Dial(ctx, "ws1", "p1", 2222)
+	require.True(t, tunnels.IsTunnelNotConnected(err))
+
+	serverConn, agentConn := net.Pipe()
+
+	agentSession, err := tunnel.Accept(agentConn)
+	require.NoError(t, err)
+
+	go tunnel.Serve(agentSession, func(port uint16) (net.Conn, error) {
+		projectConn, clientConn := net.Pipe()
+		go func() {
+			defer projectConn.Close()
+			fmt.Fprintf(projectConn, "port %d", port)
+		}()
+		return clientConn, nil
+	})
+
+	served := make(chan error)
+	go func() {
+		served <- service.Serve("ws1", "p1", serverConn)
+	}()
+
+	require.Eventually(t, func() bool {
+		return service.IsConnected("ws1", "p1")
+	}, 5*time.Second, 10*time.Millisecond)
+	require.False(t, service.IsConnected("ws1", "p2"))
+
+	conn, err := service.Dial(ctx, "ws1", "p1", 2222)
+	require.NoError(t, err)
+	body, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	require.Equal(t, "port 2222", string(body))
+	conn.Close()
+
+	agentSession.Close()
+	require.NoError(t, <-served)
+	require.False(t, service.IsConnected("ws1", "p1"))
+}
@@ -4,14 +4,23 @@
 package server
 
 import (
+	"context"
+	"net"
 	"net/http"
+	"time"
 )
 
 type TailscaleServer interface {
 	Connect() error
-	CreateAuthKey() (string, error)
+	CreateAuthKey(tags []string, expiresAt time.Time, ephemeral bool) (string, error)
+	ExpireAuthKey(key string) error
+	DeleteNodes(authKeys []string, tags []string) error
+	RenameNode(hostname, newHostname string) error
+	TakeOverHostname(hostname, authKey string) (bool, error)
+	IsHostnameRegistered(hostname string) (bool, error)
 	CreateUser() error
 	HTTPClient() *http.Client
+	Dial(ctx context.Context, network, address string) (net.Conn, error)
 	Start(errChan chan error) error
 	Stop() error
 	Purge() error
@@ -31,28 +40,317 @@ type FRPSConfig struct {
 
 type NetworkKey struct {
 	Key string `json:"key" validate:"required"`
+	// Time after which the key can no longer be used to join the network, so that clients can renew cached keys in time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty" validate:"optional"`
 } // @name NetworkKey
 
 type Config struct {
-	ProvidersDir              string         `json:"providersDir" validate:"required"`
-	RegistryUrl               string         `json:"registryUrl" validate:"required"`
-	Id                        string         `json:"id" validate:"required"`
-	ServerDownloadUrl         string         `json:"serverDownloadUrl" validate:"required"`
-	Frps                      *FRPSConfig    `json:"frps,omitempty" validate:"optional"`
-	ApiPort                   uint32         `json:"apiPort" validate:"required"`
-	HeadscalePort             uint32         `json:"headscalePort" validate:"required"`
-	BinariesPath              string         `json:"binariesPath" validate:"required"`
-	LogFile                   *LogFileConfig `json:"logFile" validate:"required"`
-	DefaultProjectImage       string         `json:"defaultProjectImage" validate:"required"`
-	DefaultProjectUser        string         `json:"defaultProjectUser" validate:"required"`
-	BuilderImage              string         `json:"builderImage" validate:"required"`
-	LocalBuilderRegistryPort  uint32         `json:"localBuilderRegistryPort" validate:"required"`
-	LocalBuilderRegistryImage string         `json:"localBuilderRegistryImage" validate:"required"`
-	BuilderRegistryServer     string         `json:"builderRegistryServer" validate:"required"`
-	BuildImageNamespace       string         `json:"buildImageNamespace" validate:"optional"`
-	SamplesIndexUrl           string         `json:"samplesIndexUrl" validate:"optional"`
+	ProvidersDir              string                  `json:"providersDir" validate:"required"`
+	RegistryUrl               string                  `json:"registryUrl" validate:"required"`
+	Id                        string                  `json:"id" validate:"required"`
+	ServerDownloadUrl         string                  `json:"serverDownloadUrl" validate:"required"`
+	Frps                      *FRPSConfig             `json:"frps,omitempty" validate:"optional"`
+	ApiPort                   uint32                  `json:"apiPort" validate:"required"`
+	HeadscalePort             uint32                  `json:"headscalePort" validate:"required"`
+	BinariesPath              string                  `json:"binariesPath" validate:"required"`
+	LogFile                   *LogFileConfig          `json:"logFile" validate:"required"`
+	LogLevels                 *LogLevelsConfig        `json:"logLevels,omitempty" validate:"optional"`
+	DefaultProjectImage       string                  `json:"defaultProjectImage" validate:"required"`
+	DefaultProjectUser        string                  `json:"defaultProjectUser" validate:"required"`
+	BuilderImage              string                  `json:"builderImage" validate:"required"`
+	LocalBuilderRegistryPort  uint32                  `json:"localBuilderRegistryPort" validate:"required"`
+	LocalBuilderRegistryImage string                  `json:"localBuilderRegistryImage" validate:"required"`
+	BuilderRegistryServer     string                  `json:"builderRegistryServer" validate:"required"`
+	BuildImageNamespace       string                  `json:"buildImageNamespace" validate:"optional"`
+	SamplesIndexUrl           string                  `json:"samplesIndexUrl" validate:"optional"`
+	ImagePolicy               *ImagePolicyConfig      `json:"imagePolicy,omitempty" validate:"optional"`
+	EmbeddedRegistry          *EmbeddedRegistryConfig `json:"embeddedRegistry,omitempty" validate:"optional"`
+	Metering                  *MeteringConfig         `json:"metering,omitempty" validate:"optional"`
+	Auth                      *AuthConfig             `json:"auth,omitempty" validate:"optional"`
+	CleanupPolicies           []CleanupPolicyConfig   `json:"cleanupPolicies,omitempty" validate:"optional"`
+	BrowserBridge             *BrowserBridgeConfig    `json:"browserBridge,omitempty" validate:"optional"`
+	Derp                      *DerpConfig             `json:"derp,omitempty" validate:"optional"`
+	Federation                *FederationConfig       `json:"federation,omitempty" validate:"optional"`
+	StateHistory              *StateHistoryConfig     `json:"stateHistory,omitempty" validate:"optional"`
+	// Template of the tailnet hostnames of new projects with the {user}, {workspace}, {workspaceId} and {project}
+	// placeholders, e.g. "{user}-{workspace}-{project}". Hostnames are derived from the workspace ID and project name if empty
+	HostnameTemplate string `json:"hostnameTemplate,omitempty" validate:"optional"`
+	// Multiple of the CPU and memory of a target host that projects can reserve, e.g. 1.5. New projects that would
+	// reserve more are refused. Defaults to 1, targets whose provider does not report their capacity are not checked
+	OvercommitRatio float64 `json:"overcommitRatio,omitempty" validate:"optional"`
+	// Autoscaling policies of targets whose provider places projects on a pool of hosts or VMs
+	Autoscaling []AutoscalingConfig `json:"autoscaling,omitempty" validate:"optional"`
+	// Manages the DNS records and the certificate of public preview URLs under an organization owned frps domain
+	PreviewDns *PreviewDnsConfig `json:"previewDns,omitempty" validate:"optional"`
+	// Stores artifacts and the logs of finished operations in a bucket instead of the server config directory
+	Storage *StorageConfig `json:"storage,omitempty" validate:"optional"`
+	// Lets support administrators act on behalf of users. Impersonation is disabled if unset
+	Impersonation *ImpersonationConfig `json:"impersonation,omitempty" validate:"optional"`
+	// Workspaces are stopped after a project saw no SSH, port, terminal, IDE or file activity for this many minutes.
+	// Projects override it with the DAYTONA_AGENT_IDLE_TIMEOUT env var, e.g. 2h. Workspaces are never stopped if 0
+	IdleTimeoutMinutes uint32 `json:"idleTimeoutMinutes,omitempty" validate:"optional"`
+	// Host paths that projects can bind mount, along with the paths below them. Bind mounts are refused if empty
+	AllowedBindMountPaths []string `json:"allowedBindMountPaths,omitempty" validate:"optional"`
+	// Provider jobs that run on a target at the same time, e.g. workspace creations and starts and the image pre-pulls
+	// of prebuilds. Waiting jobs of a higher priority run first, creations and starts before pre-pulls. Unlimited if 0
+	MaxConcurrentProvisioningJobs uint32 `json:"maxConcurrentProvisioningJobs,omitempty" validate:"optional"`
+	// Lets project agents and clients register persistent tailnet nodes that stay registered while they are offline.
+	// Only server administrators can generate persistent network keys if false
+	AllowPersistentNodes bool `json:"allowPersistentNodes,omitempty" validate:"optional"`
 } // @name ServerConfig
 
+// EmbeddedRegistryConfig configures the registry served by the server when the builder registry server is "embedded"
+type EmbeddedRegistryConfig struct {
+	// Storage limit in megabytes. 0 disables the limit
+	QuotaMb uint64 `json:"quotaMb" validate:"optional"`
+	// Interval between garbage collection runs. 0 disables garbage collection
+	GcIntervalMinutes uint32 `json:"gcIntervalMinutes" validate:"optional"`
+} // @name EmbeddedRegistryConfig
+
+type AuthProviderType string
+
+const (
+	AuthProviderApiKey     AuthProviderType = "api-key"
+	AuthProviderOidc       AuthProviderType = "oidc"
+	AuthProviderMtls       AuthProviderType = "mtls"
+	AuthProviderAdminToken AuthProviderType = "admin-token"
+)
+
+// AuthConfig configures how API requests are authenticated. Without it, every route accepts API keys only.
+type AuthConfig struct {
+	// Providers tried in order for each route group, keyed by the group path (e.g. "/server").
+	// The "default" entry applies to groups that are not listed
+	RouteProviders map[string][]AuthProviderType `json:"routeProviders,omitempty" validate:"optional"`
+	Oidc           *OidcAuthConfig               `json:"oidc,omitempty" validate:"optional"`
+	Mtls           *MtlsAuthConfig               `json:"mtls,omitempty" validate:"optional"`
+	// SHA-256 hex hash of the static admin token
+	AdminTokenHash string `json:"adminTokenHash,omitempty" validate:"optional"`
+} // @name AuthConfig
+
+type OidcAuthConfig struct {
+	IssuerUrl string `json:"issuerUrl" validate:"required"`
+	ClientId  string `json:"clientId" validate:"required"`
+	// ID token claim used as the identity name. Defaults to "email"
+	UsernameClaim string `json:"usernameClaim,omitempty" validate:"optional"`
+	// If set, only these users are allowed
+	AllowedUsers []string `json:"allowedUsers,omitempty" validate:"optional"`
+} // @name OidcAuthConfig
+
+// MtlsAuthConfig configures an additional TLS listener that authenticates clients with certificates signed by the CA
+type MtlsAuthConfig struct {
+	Port     uint32 `json:"port" validate:"required"`
+	CaFile   string `json:"caFile" validate:"required"`
+	CertFile string `json:"certFile" validate:"required"`
+	KeyFile  string `json:"keyFile" validate:"required"`
+	// If set, only certificates with these common names are allowed
+	AllowedCommonNames []string `json:"allowedCommonNames,omitempty" validate:"optional"`
+} // @name MtlsAuthConfig
+
+type MeteringExporter string
+
+const (
+	MeteringExporterFile  MeteringExporter = "file"
+	MeteringExporterHttp  MeteringExporter = "http"
+	MeteringExporterKafka MeteringExporter = "kafka"
+)
+
+// MeteringConfig configures where usage events are exported to
+type MeteringConfig struct {
+	Exporter MeteringExporter `json:"exporter" validate:"required"`
+	// File path for the file exporter
+	Path string `json:"path,omitempty" validate:"optional"`
+	// Endpoint for the http exporter or Kafka REST Proxy URL for the kafka exporter
+	Url   string `json:"url,omitempty" validate:"optional"`
+	Topic string `json:"topic,omitempty" validate:"optional"`
+	// Headers sent with every http and kafka export request, e.g. for authentication
+	Headers map[string]string `json:"headers,omitempty" validate:"optional"`
+} // @name MeteringConfig
+
+// StateHistoryConfig configures the periodic snapshots of workspace and project states. Without it, a snapshot
+// is taken every 5 minutes and kept for 7 days
+type StateHistoryConfig struct {
+	// Interval between snapshots. 0 uses the default
+	IntervalMinutes uint32 `json:"intervalMinutes" validate:"optional"`
+	// How long snapshots are kept. 0 uses the default
+	RetentionDays uint32 `json:"retentionDays" validate:"optional"`
+} // @name StateHistoryConfig
+
+// AutoscalingConfig adds hosts to the pool of a target when workspaces are refused for capacity or its utilization
+// is high, and drains hosts while its utilization is low. Scale events are recorded in the audit log
+type AutoscalingConfig struct {
+	Target   string `json:"target" validate:"required"`
+	MinHosts uint32 `json:"minHosts" validate:"required"`
+	MaxHosts uint32 `json:"maxHosts" validate:"required"`
+	// Utilization of the pool, between 0 and 1, above which a host is added. Defaults to 0.8
+	ScaleUpUtilization float64 `json:"scaleUpUtilization,omitempty" validate:"optional"`
+	// Utilization of the pool, between 0 and 1, below which a host is drained. Defaults to 0.3
+	ScaleDownUtilization float64 `json:"scaleDownUtilization,omitempty" validate:"optional"`
+	// Minimum time between two scale events of the target. Defaults to 10 minutes
+	CooldownMinutes uint32 `json:"cooldownMinutes,omitempty" validate:"optional"`
+} // @name AutoscalingConfig
+
+type PreviewDnsProvider string
+
+const (
+	PreviewDnsProviderCloudflare PreviewDnsProvider = "cloudflare"
+	PreviewDnsProviderRoute53    PreviewDnsProvider = "route53"
+)
+
+type PreviewDnsRecords string
+
+const (
+	PreviewDnsRecordsWildcard  PreviewDnsRecords = "wildcard"
+	PreviewDnsRecordsWorkspace PreviewDnsRecords = "workspace"
+)
+
+// PreviewDnsConfig points the public preview URLs under the frps domain to the frps server through a DNS provider.
+// The frps domain must be in the zone managed with the provider credentials
+type PreviewDnsConfig struct {
+	Provider PreviewDnsProvider `json:"provider" validate:"required"`
+	// IP address or hostname of the frps server. A or AAAA records are created for IP addresses, CNAME records for hostnames
+	Target string `json:"target" validate:"required"`
+	// "wildcard" creates a *.<frps domain> record when the server starts and deletes it when the server is purged.
+	// "workspace" creates a record for each public port of a workspace and deletes them with the workspace. Defaults to wildcard
+	Records             PreviewDnsRecords `json:"records,omitempty" validate:"optional"`
+	CloudflareApiToken  string            `json:"cloudflareApiToken,omitempty" validate:"optional"`
+	CloudflareZoneId    string            `json:"cloudflareZoneId,omitempty" validate:"optional"`
+	Route53HostedZoneId string            `json:"route53HostedZoneId,omitempty" validate:"optional"`
+	// Credentials of the default AWS credential chain are used if empty
+	Route53AccessKeyId     string `json:"route53AccessKeyId,omitempty" validate:"optional"`
+	Route53SecretAccessKey string `json:"route53SecretAccessKey,omitempty" validate:"optional"`
+	// Issues a *.<frps domain> certificate through the ACME DNS challenge and renews it 30 days before it expires. The
+	// server terminates TLS of the public URLs of forwarded ports with it, which requires frps to serve HTTPS on its
+	// vhost HTTPS port and the frps protocol to be https
+	IssueCertificate bool   `json:"issueCertificate,omitempty" validate:"optional"`
+	AcmeEmail        string `json:"acmeEmail,omitempty" validate:"optional"`
+	// Defaults to Let's Encrypt
+	AcmeDirectoryUrl string `json:"acmeDirectoryUrl,omitempty" validate:"optional"`
+} // @name PreviewDnsConfig
+
+type StorageProvider string
+
+const (
+	StorageProviderS3  StorageProvider = "s3"
+	StorageProviderGcs StorageProvider = "gcs"
+)
+
+// StorageConfig configures the bucket that artifacts and logs are stored in. Log files are moved to the bucket
+// whenever the operation writing them ends. Retention is enforced by the server, so that lifecycle rules of the bucket
+// are left untouched
+type StorageConfig struct {
+	Provider StorageProvider `json:"provider" validate:"required"`
+	Bucket   string          `json:"bucket" validate:"required"`
+	// Prepended to the keys of the objects, so that several servers can share a bucket
+	Prefix string `json:"prefix,omitempty" validate:"optional"`
+	// Region of the S3 bucket. Defaults to us-east-1
+	Region string `json:"region,omitempty" validate:"optional"`
+	// Endpoint of an S3 compatible service, e.g. MinIO
+	Endpoint string `json:"endpoint,omitempty" validate:"optional"`
+	// Access key of S3 or HMAC key of a Cloud Storage service account. Credentials of the default AWS credential chain
+	// are used for S3 if empty
+	AccessKeyId     string `json:"accessKeyId,omitempty" validate:"optional"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty" validate:"optional"`
+	// Days after which artifacts are deleted. 0 keeps them until they are deleted with their workspace
+	ArtifactRetentionDays uint32 `json:"artifactRetentionDays,omitempty" validate:"optional"`
+	// Days after which archived logs are deleted. 0 keeps them until they are deleted with their workspace or build
+	LogRetentionDays uint32 `json:"logRetentionDays,omitempty" validate:"optional"`
+} // @name StorageConfig
+
+// ImpersonationConfig restricts impersonation to a list of administrators and limits the duration of their sessions.
+// Impersonated requests can only create and inspect workspaces
+type ImpersonationConfig struct {
+	// Names of the administrators that can impersonate users. Only callers authenticated with an admin API key or the
+	// admin token can impersonate, the admin token identity is named "admin"
+	Admins []string `json:"admins" validate:"required"`
+	// Longest session an administrator can start. Defaults to 240 minutes
+	MaxDurationMinutes uint32 `json:"maxDurationMinutes,omitempty" validate:"optional"`
+} // @name ImpersonationConfig
+
+type ImagePolicyConfig struct {
+	AllowedRegistries []string `json:"allowedRegistries" validate:"optional"`
+	VerifySignatures  bool     `json:"verifySignatures" validate:"optional"`
+	CosignPublicKey   string   `json:"cosignPublicKey" validate:"optional"`
+} // @name ImagePolicyConfig
+
+// CleanupPolicyConfig deletes or stops workspaces whose annotations match the selector
+type CleanupPolicyConfig struct {
+	Name string `json:"name" validate:"required"`
+	// Comma-separated annotation requirements, e.g. "ci.example.com/purpose=pr-preview". Supports key=value, key!=value, key and !key.
+	// An empty selector matches every workspace
+	Selector string `json:"selector" validate:"optional"`
+	// Matching workspaces older than this duration (e.g. "48h") are deleted
+	MaxAge string `json:"maxAge,omitempty" validate:"optional"`
+	// The oldest matching running workspaces above this count are stopped
+	MaxRunning uint32 `json:"maxRunning,omitempty" validate:"optional"`
+	// Matching running workspaces without terminal, IDE or file activity and with almost no CPU usage for this
+	// duration (e.g. "2h") are stopped. Requires agents that report their resource usage
+	MaxIdle string `json:"maxIdle,omitempty" validate:"optional"`
+	// Only log the actions the policy would take
+	DryRun bool `json:"dryRun,omitempty" validate:"optional"`
+} // @name CleanupPolicyConfig
+
+type BridgeDirection string
+
+const (
+	BridgeDirectionBoth          BridgeDirection = "both"
+	BridgeDirectionToWorkspace   BridgeDirection = "to-workspace"
+	BridgeDirectionFromWorkspace BridgeDirection = "from-workspace"
+	BridgeDirectionNone          BridgeDirection = "none"
+)
+
+// BrowserBridgeConfig controls the clipboard and file drop bridge of browser IDE and terminal sessions
+type BrowserBridgeConfig struct {
+	// Direction clipboard content may flow in. Defaults to both
+	Clipboard BridgeDirection `json:"clipboard,omitempty" validate:"optional"`
+	// Maximum clipboard content size in kilobytes. Defaults to 1024
+	MaxClipboardKb uint32 `json:"maxClipboardKb,omitempty" validate:"optional"`
+	// Rejects files dropped in the browser
+	DisableFileDrops bool `json:"disableFileDrops,omitempty" validate:"optional"`
+	// Maximum dropped file size in megabytes. Defaults to 100
+	MaxFileDropMb uint32 `json:"maxFileDropMb,omitempty" validate:"optional"`
+} // @name BrowserBridgeConfig
+
+// DerpConfig configures the DERP relays advertised by the embedded control plane
+type DerpConfig struct {
+	// Stops advertising the relay embedded in the server. At least one region is required if set
+	DisableEmbedded bool `json:"disableEmbedded,omitempty" validate:"optional"`
+	// Self-hosted relay regions
+	Regions []DerpRegion `json:"regions,omitempty" validate:"optional"`
+} // @name DerpConfig
+
+type DerpRegion struct {
+	// Unique region ID, 999 is reserved for the embedded relay
+	Id    uint32     `json:"id" validate:"required"`
+	Code  string     `json:"code" validate:"required"`
+	Name  string     `json:"name" validate:"required"`
+	Nodes []DerpNode `json:"nodes" validate:"required"`
+} // @name DerpRegion
+
+type DerpNode struct {
+	Name     string `json:"name" validate:"required"`
+	HostName string `json:"hostName" validate:"required"`
+	// Skips DNS resolution of the host name if set
+	IPv4 string `json:"ipv4,omitempty" validate:"optional"`
+	IPv6 string `json:"ipv6,omitempty" validate:"optional"`
+	// Defaults to 443
+	DerpPort uint32 `json:"derpPort,omitempty" validate:"optional"`
+	// Defaults to 3478
+	StunPort uint32 `json:"stunPort,omitempty" validate:"optional"`
+} // @name DerpNode
+
+// FederationConfig makes the server a region of a federation. Regional servers register with the primary server,
+// which lists the workspaces of all regions and routes workspace creation to them
+type FederationConfig struct {
+	// Name of the region of this server
+	Region string `json:"region" validate:"required"`
+	// API URL of the primary server. Empty on the primary server
+	PrimaryUrl string `json:"primaryUrl,omitempty" validate:"optional"`
+	// Admin API key of the primary server. Required if primaryUrl is set
+	PrimaryApiKey string `json:"primaryApiKey,omitempty" validate:"optional"`
+	// API URL the primary server and clients use to reach this server. Defaults to the public API URL of the server
+	ApiUrl string `json:"apiUrl,omitempty" validate:"optional"`
+} // @name FederationConfig
+
 type LogFileConfig struct {
 	Path       string `json:"path" validate:"required"`
 	MaxSize    int    `json:"maxSize" validate:"required"`
@@ -61,3 +359,19 @@ type LogFileConfig struct {
 	LocalTime  bool   `json:"localTime" validate:"optional"`
 	Compress   bool   `json:"compress" validate:"optional"`
 } // @name LogFileConfig
+
+// LogLevelsConfig sets the log level of each server component to one of trace, debug, info, warn or error. The
+// LOG_LEVEL, FRP_LOG_LEVEL and AGENT_LOG_LEVEL env vars take precedence
+type LogLevelsConfig struct {
+	// Level of the server logs. Defaults to info
+	Server string `json:"server,omitempty" validate:"optional"`
+	// Level of the API request logs. Successful requests are logged at info, failed requests at error. Defaults to the
+	// level of the server logs
+	Api string `json:"api,omitempty" validate:"optional"`
+	// Level of the headscale coordination server logs. Defaults to error
+	Headscale string `json:"headscale,omitempty" validate:"optional"`
+	// Level of the frps tunnel logs. Defaults to error
+	Frp string `json:"frp,omitempty" validate:"optional"`
+	// Level of the agent logs of projects created or started after the change. Defaults to info
+	Agent string `json:"agent,omitempty" validate:"optional"`
+} // @name LogLevelsConfig
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// GetProjectAccessPolicy returns the access policy of the project. Projects without a policy get an empty one,
+// which allows every peer
+func (s *WorkspaceService) GetProjectAccessPolicy(workspaceId, projectName string) (*project.PortAccessPolicy, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if p.AccessPolicy == nil {
+		return &project.PortAccessPolicy{Rules: []project.PortAccessRule{}}, nil
+	}
+
+	return p.AccessPolicy, nil
+}
+
+// SetProjectAccessPolicy replaces the access policy of the project. Running agents pick up the policy on their next refresh
+func (s *WorkspaceService) SetProjectAccessPolicy(workspaceId, projectName string, policy project.PortAccessPolicy) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	p.AccessPolicy = &policy
+	if policy.IsEmpty() {
+		p.AccessPolicy = nil
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
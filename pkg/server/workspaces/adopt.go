@@ -0,0 +1,228 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// AdoptWorkspace registers an existing container or VM as a workspace and installs the agent on it.
+// The agent joins the tailnet and reports the project state like in any other workspace.
+func (s *WorkspaceService) AdoptWorkspace(ctx context.Context, req dto.AdoptWorkspaceDTO) (*workspace.Workspace, error) {
+	_, err := s.workspaceStore.Find(req.Name)
+	if err == nil {
+		return nil, ErrWorkspaceAlreadyExists
+	}
+
+	if !isValidWorkspaceName(req.Name) {
+		return nil, ErrInvalidWorkspaceName
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString(req.ProjectName) {
+		return nil, ErrInvalidProjectName
+	}
+
+	adoption := &workspace.Adoption{
+		Type:        req.Type,
+		ContainerId: req.ContainerId,
+		Host:        req.Host,
+		Port:        req.Port,
+		User:        req.User,
+		AdoptedAt:   time.Now(),
+	}
+	if req.ProjectDir != nil {
+		adoption.ProjectDir = *req.ProjectDir
+	}
+
+	switch req.Type {
+	case workspace.AdoptionTypeDocker:
+		if req.ContainerId == "" {
+			return nil, ErrInvalidAdoption
+		}
+		if adoption.User == "" {
+			adoption.User = "root"
+		}
+	case workspace.AdoptionTypeSsh:
+		if req.Host == "" || req.User == "" {
+			return nil, ErrInvalidAdoption
+		}
+		if adoption.Port == 0 {
+			adoption.Port = 22
+		}
+	default:
+		return nil, ErrInvalidAdoption
+	}
+
+	w := &workspace.Workspace{
+		Id:             req.Id,
+		Name:           req.Name,
+		OrganizationId: organization.GetOrganizationId(ctx),
+		Adoption:       adoption,
+		CreatedAt:      &adoption.AdoptedAt,
+	}
+
+	err = s.checkOrganizationQuota(w.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := &gitprovider.GitRepository{}
+	if req.Repository != nil {
+		repository = req.Repository
+		repository.Url = util.CleanUpRepositoryUrl(repository.Url)
+	}
+
+	w.ApiKey, err = s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &project.Project{
+		Name:        req.ProjectName,
+		User:        adoption.User,
+		Repository:  repository,
+		WorkspaceId: w.Id,
+	}
+
+	err = setSshHostKey(p)
+	if err != nil {
+		return nil, err
+	}
+
+	p.ApiKey, err = s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	w.Projects = []*project.Project{p}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.installAgent(ctx, w, AdoptionCredentials{
+		Password:   req.Password,
+		PrivateKey: req.PrivateKey,
+	})
+	if err != nil {
+		// The machine is left as it was, so only the registration has to be rolled back
+		removeErr := s.ForceRemoveWorkspace(ctx, w.Id)
+		if removeErr != nil {
+			log.Error(removeErr)
+		}
+		return nil, fmt.Errorf("failed to install the agent: %w", err)
+	}
+
+	if telemetry.TelemetryEnabled(ctx) {
+		telemetryError := s.telemetryService.TrackServerEvent(telemetry.ServerEventWorkspaceCreated, telemetry.ClientId(ctx), telemetry.NewWorkspaceEventProps(ctx, w, nil))
+		if telemetryError != nil {
+			log.Trace(telemetryError)
+		}
+	}
+
+	return w, nil
+}
+
+// startAdoptedWorkspace restarts the agent in an adopted container, e.g. after the container was restarted.
+// Agents on adopted VMs run as a system service and are started by the VM itself.
+func (s *WorkspaceService) startAdoptedWorkspace(ctx context.Context, w *workspace.Workspace) error {
+	if w.Adoption.Type != workspace.AdoptionTypeDocker {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
+	return s.installAgent(ctx, w, AdoptionCredentials{})
+}
+
+func (s *WorkspaceService) installAgent(ctx context.Context, w *workspace.Workspace, credentials AdoptionCredentials) error {
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	logWriter := io.MultiWriter(&util.InfoLogWriter{}, wsLogger)
+
+	agentVersion, err := s.getAgentVersion(w.Id)
+	if err != nil {
+		return err
+	}
+
+	p := w.Projects[0]
+	envVars := project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+		ApiUrl:        s.serverApiUrl,
+		ServerUrl:     s.serverUrl,
+		ServerVersion: agentVersion,
+		ClientId:      telemetry.ClientId(ctx),
+		AgentLogLevel: s.agentLogLevel,
+	}, telemetry.TelemetryEnabled(ctx))
+
+	if w.Adoption.ProjectDir != "" {
+		envVars["DAYTONA_PROJECT_DIR"] = w.Adoption.ProjectDir
+	}
+
+	if w.Adoption.Type == workspace.AdoptionTypeSsh {
+		envVars["DAYTONA_PROJECT_USER"] = w.Adoption.User
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Installing the agent on adopted %s workspace %s\n", w.Adoption.Type, w.Name)))
+
+	err = s.agentInstaller.InstallAgent(w.Adoption, credentials, getAgentInstallScript(w.Adoption.Type, envVars, s.daytonaDownloadUrl), logWriter)
+	if err != nil {
+		return err
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Agent started on workspace %s\n", w.Name)))
+	return nil
+}
+
+// getAgentInstallScript downloads the Daytona binary with the server download script and starts the agent.
+// In containers the agent runs in the background, on VMs it is installed as a system service so it survives reboots.
+// The script holds the API keys of the project and is passed to bash through stdin, so that neither the script nor
+// the keys show up in the process list of the machine.
+func getAgentInstallScript(adoptionType workspace.AdoptionType, envVars map[string]string, daytonaDownloadUrl string) string {
+	keys := []string{}
+	for k := range envVars {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	script := "set -e\n"
+	for _, k := range keys {
+		value := shellQuote(envVars[k])
+		// (HOME) is replaced with the home directory of the user running the agent
+		value = strings.ReplaceAll(value, "(HOME)", `'"$HOME"'`)
+		script += fmt.Sprintf("export %s=%s\n", k, value)
+	}
+
+	script += `if [ "$(id -u)" = "0" ]; then SUDO=""; else SUDO="sudo -E"; fi` + "\n"
+	script += fmt.Sprintf(`printf 'Authorization: Bearer %%s\n' "$DAYTONA_SERVER_API_KEY" | curl -sfL -H @- %s | $SUDO bash`, shellQuote(daytonaDownloadUrl)) + "\n"
+
+	// Commands must not read from stdin, the rest of the script is read from it
+	if adoptionType == workspace.AdoptionTypeSsh {
+		script += "$SUDO daytona agent install < /dev/null\n"
+	} else {
+		script += "nohup daytona agent < /dev/null > /dev/null 2>&1 &\n"
+	}
+
+	return script
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/ssh"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	log "github.com/sirupsen/logrus"
+)
+
+type AdoptionCredentials struct {
+	Password   *string
+	PrivateKey *string
+}
+
+// AgentInstaller runs the agent install script on a machine that was not created by a provider
+type AgentInstaller interface {
+	InstallAgent(adoption *workspace.Adoption, credentials AdoptionCredentials, script string, logWriter io.Writer) error
+}
+
+func NewAgentInstaller() AgentInstaller {
+	return &agentInstaller{}
+}
+
+type agentInstaller struct{}
+
+func (i *agentInstaller) InstallAgent(adoption *workspace.Adoption, credentials AdoptionCredentials, script string, logWriter io.Writer) error {
+	switch adoption.Type {
+	case workspace.AdoptionTypeDocker:
+		return i.installInContainer(adoption, script, logWriter)
+	case workspace.AdoptionTypeSsh:
+		return i.installOverSsh(adoption, credentials, script, logWriter)
+	}
+
+	return ErrInvalidAdoption
+}
+
+// installInContainer runs the script with bash in the container. The script is written to the stdin of bash
+func (i *agentInstaller) installInContainer(adoption *workspace.Adoption, script string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return err
+	}
+	defer cli.Close()
+
+	execResp, err := cli.ContainerExecCreate(ctx, adoption.ContainerId, container.ExecOptions{
+		Cmd:          []string{"bash", "-s"},
+		User:         adoption.User,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := cli.ContainerExecAttach(ctx, execResp.ID, container.ExecStartOptions{})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	go func() {
+		_, err := io.Copy(resp.Conn, strings.NewReader(script))
+		if err != nil {
+			log.Error(err)
+		}
+		resp.CloseWrite()
+	}()
+
+	stdErr := bytes.Buffer{}
+	_, err = stdcopy.StdCopy(logWriter, io.MultiWriter(logWriter, &stdErr), resp.Reader)
+	if err != nil {
+		return err
+	}
+
+	inspect, err := cli.ContainerExecInspect(ctx, execResp.ID)
+	if err != nil {
+		return err
+	}
+
+	if inspect.ExitCode != 0 {
+		return errors.New(stdErr.String())
+	}
+
+	return nil
+}
+
+// installOverSsh runs the script with bash on the VM. The script is written to the stdin of bash
+func (i *agentInstaller) installOverSsh(adoption *workspace.Adoption, credentials AdoptionCredentials, script string, logWriter io.Writer) error {
+	if credentials.Password == nil && credentials.PrivateKey == nil {
+		return errors.New("a password or private key is required to connect to the VM")
+	}
+
+	sshClient, err := ssh.NewClient(&ssh.SessionConfig{
+		Hostname:   adoption.Host,
+		Port:       adoption.Port,
+		Username:   adoption.User,
+		Password:   credentials.Password,
+		PrivateKey: credentials.PrivateKey,
+	})
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	err = sshClient.ExecWithInput("bash -s", strings.NewReader(script), logWriter)
+	if err != nil {
+		return fmt.Errorf("failed to run the install script on %s: %w", adoption.Host, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gliderlabs/ssh"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallAgentOverSsh(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	type execution struct {
+		user    string
+		command string
+		stdin   string
+	}
+	executions := make(chan execution, 1)
+
+	server := &ssh.Server{
+		Handler: func(s ssh.Session) {
+			stdin, _ := io.ReadAll(s)
+			executions <- execution{user: s.User(), command: s.RawCommand(), stdin: string(stdin)}
+			io.WriteString(s, "installed\n")
+			s.Exit(0)
+		},
+		PasswordHandler: func(ctx ssh.Context, password string) bool {
+			return password == "secret"
+		},
+	}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+
+	adoption := &workspace.Adoption{
+		Type: workspace.AdoptionTypeSsh,
+		Host: "127.0.0.1",
+		Port: listener.Addr().(*net.TCPAddr).Port,
+		User: "dev",
+	}
+	script := "export DAYTONA_SERVER_API_KEY='project-key'\n$SUDO daytona agent install < /dev/null\n"
+
+	installer := workspaces.NewAgentInstaller()
+
+	err = installer.InstallAgent(adoption, workspaces.AdoptionCredentials{Password: util.Pointer("wrong")}, script, io.Discard)
+	require.NotNil(t, err)
+
+	err = installer.InstallAgent(adoption, workspaces.AdoptionCredentials{}, script, io.Discard)
+	require.NotNil(t, err)
+
+	output := bytes.Buffer{}
+	err = installer.InstallAgent(adoption, workspaces.AdoptionCredentials{Password: util.Pointer("secret")}, script, &output)
+	require.Nil(t, err)
+	require.Equal(t, "installed\n", output.String())
+
+	// The script, and with it the API key, is passed through stdin instead of the command line
+	e := <-executions
+	require.Equal(t, "dev", e.user)
+	require.Equal(t, "bash -s", e.command)
+	require.Equal(t, script, e.stdin)
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Multiple of the capacity of a target host that projects can reserve if the server does not configure it
+const defaultOvercommitRatio = 1.0
+
+// Workspaces refused for capacity are reported as demand for this long
+const refusedCreationRetention = time.Hour
+
+func (s *WorkspaceService) GetTargetAllocation(targetName string) (*provider.TargetAllocation, error) {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &targetName})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	allocation := &provider.TargetAllocation{
+		Target:          target.Name,
+		Capacity:        s.getTargetCapacity(target),
+		OvercommitRatio: s.overcommitRatio,
+		Projects:        []provider.ProjectAllocation{},
+	}
+
+	for _, w := range workspaces {
+		if w.Target != target.Name {
+			continue
+		}
+
+		for _, p := range w.Projects {
+			projectAllocation := provider.ProjectAllocation{
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				Project:       p.Name,
+				Reserved:      p.Resources,
+			}
+
+			if p.Resources != nil {
+				allocation.Reserved = allocation.Reserved.Add(*p.Resources)
+			}
+
+			// Stopped projects keep their last reported state
+			if p.State != nil && p.State.Uptime > 0 && p.State.Usage != nil {
+				projectAllocation.Used = p.State.Usage
+				allocation.Used = allocation.Used.Add(*p.State.Usage)
+			}
+
+			allocation.Projects = append(allocation.Projects, projectAllocation)
+		}
+	}
+
+	return allocation, nil
+}
+
+// getTargetCapacity returns the capacity of the target host, or nil if its provider does not report it
+func (s *WorkspaceService) getTargetCapacity(target *provider.ProviderTarget) *project.Resources {
+	capacity, err := s.provisioner.GetTargetCapacity(target)
+	if err != nil || capacity == nil || *capacity == (project.Resources{}) {
+		// Providers built before resource reservations do not implement the call
+		log.Debugf("failed to get capacity of target %s from provider %s: %v", target.Name, target.ProviderInfo.Name, err)
+		return nil
+	}
+
+	return capacity
+}
+
+// checkTargetCapacity refuses new projects whose resources, added to the resources reserved on the target,
+// exceed the capacity of the target host multiplied by the overcommit ratio
+func (s *WorkspaceService) checkTargetCapacity(targetName string, projects []*project.Project) error {
+	requested := project.Resources{}
+	for _, p := range projects {
+		if p.Resources != nil {
+			requested = requested.Add(*p.Resources)
+		}
+	}
+
+	// Projects without resource limits are not accounted for
+	if requested == (project.Resources{}) {
+		return nil
+	}
+
+	allocation, err := s.GetTargetAllocation(targetName)
+	if err != nil {
+		return err
+	}
+
+	if allocation.Capacity == nil {
+		return nil
+	}
+
+	reserved := allocation.Reserved.Add(requested)
+	if reserved.Exceeds(*allocation.Capacity, allocation.OvercommitRatio) {
+		return fmt.Errorf("%w. %s would be reserved on a host with %s and an overcommit ratio of %g", ErrTargetOvercommitted, reserved, *allocation.Capacity, allocation.OvercommitRatio)
+	}
+
+	return nil
+}
+
+// saveWithinTargetCapacity saves the new workspace if the resources of its projects fit on the target. Concurrent
+// creations are checked one at a time so the workspaces can not reserve the same capacity
+func (s *WorkspaceService) saveWithinTargetCapacity(w *workspace.Workspace) error {
+	s.capacityMutex.Lock()
+	defer s.capacityMutex.Unlock()
+
+	err := s.checkTargetCapacity(w.Target, w.Projects)
+	if err != nil {
+		return err
+	}
+
+	return s.workspaceStore.Save(w)
+}
+
+func (s *WorkspaceService) GetCreationDemand(targetName string, since time.Time) provider.CreationDemand {
+	s.creationDemandMutex.Lock()
+	defer s.creationDemandMutex.Unlock()
+
+	demand := provider.CreationDemand{
+		InFlight: s.creationsInFlight[targetName],
+	}
+
+	for _, refusedAt := range s.refusedCreations[targetName] {
+		if refusedAt.After(since) {
+			demand.Refused++
+		}
+	}
+
+	return demand
+}
+
+// startCreation counts the workspace as being created on the target until the returned function is called
+func (s *WorkspaceService) startCreation(targetName string) func() {
+	s.creationDemandMutex.Lock()
+	s.creationsInFlight[targetName]++
+	s.creationDemandMutex.Unlock()
+
+	return func() {
+		s.creationDemandMutex.Lock()
+		defer s.creationDemandMutex.Unlock()
+
+		s.creationsInFlight[targetName]--
+		if s.creationsInFlight[targetName] <= 0 {
+			delete(s.creationsInFlight, targetName)
+		}
+	}
+}
+
+func (s *WorkspaceService) recordRefusedCreation(targetName string) {
+	s.creationDemandMutex.Lock()
+	defer s.creationDemandMutex.Unlock()
+
+	now := time.Now()
+
+	refused := []time.Time{}
+	for _, refusedAt := range s.refusedCreations[targetName] {
+		if now.Sub(refusedAt) < refusedCreationRetention {
+			refused = append(refused, refusedAt)
+		}
+	}
+
+	s.refusedCreations[targetName] = append(refused, now)
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (s *WorkspaceService) UpdateWorkspaceAnnotations(workspaceId string, set map[string]string, remove []string) (*workspace.Workspace, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	annotations, err := workspace.UpdateAnnotations(ws.Annotations, set, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	ws.Annotations = annotations
+
+	return ws, s.workspaceStore.Save(ws)
+}
+
+func (s *WorkspaceService) UpdateProjectAnnotations(workspaceId, projectName string, set map[string]string, remove []string) (*workspace.Workspace, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := ws.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	annotations, err := workspace.UpdateAnnotations(project.Annotations, set, remove)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Annotations = annotations
+
+	return ws, s.workspaceStore.Save(ws)
+}
@@ -0,0 +1,55 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// GetProjectBandwidthLimit returns the limit the project agent enforces on the traffic it proxies, merged from the
+// limits of the workspace and its target. Directions without a limit are unlimited
+func (s *WorkspaceService) GetProjectBandwidthLimit(workspaceId, projectName string) (*project.BandwidthLimit, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	var targetLimit *project.BandwidthLimit
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err == nil {
+		targetLimit = target.BandwidthLimit
+	}
+
+	limit := project.GetEffectiveBandwidthLimit(targetLimit, w.BandwidthLimit)
+
+	return &limit, nil
+}
+
+// SetWorkspaceBandwidthLimit replaces the bandwidth limit of the workspace. Running agents pick up the limit on their
+// next refresh
+func (s *WorkspaceService) SetWorkspaceBandwidthLimit(workspaceId string, limit project.BandwidthLimit) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	w.BandwidthLimit = &limit
+	if limit.IsEmpty() {
+		w.BandwidthLimit = nil
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	toolbox_config "github.com/daytonaio/daytona/pkg/agent/toolbox/config"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+const branchStatusPollInterval = "0 */10 * * * *"
+
+// Time given to a project agent to fetch its upstream branch
+const fetchUpstreamTimeout = time.Minute
+
+var errNoUpstreamBranch = errors.New("no upstream branch")
+
+func (s *WorkspaceService) RefreshBranchStatuses(ctx context.Context) error {
+	if s.getTailnetHttpClient == nil {
+		return nil
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			if p.State == nil || p.State.Uptime == 0 {
+				continue
+			}
+
+			status, err := s.fetchUpstream(ctx, p)
+			if err != nil {
+				if !errors.Is(err, errNoUpstreamBranch) {
+					errs = append(errs, fmt.Errorf("failed to refresh branch status of project %s in workspace %s: %w", p.Name, w.Name, err))
+				}
+				continue
+			}
+
+			err = s.setProjectGitStatus(w.Id, p.Name, status)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *WorkspaceService) StartBranchStatusPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(branchStatusPollInterval, func() {
+		err := s.RefreshBranchStatuses(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
+
+// fetchUpstream asks the project agent to fetch the upstream branch and returns the updated git status
+func (s *WorkspaceService) fetchUpstream(ctx context.Context, p *project.Project) (*project.GitStatus, error) {
+	address, err := p.GetTailnetAddress(toolbox_config.TOOLBOX_PORT)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchUpstreamTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("http://%s/git/fetch", address), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.getAgentHttpClient(p).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach the project agent: %w", err)
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusConflict:
+		return nil, errNoUpstreamBranch
+	default:
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("project agent responded with status %d: %s", res.StatusCode, message)
+	}
+
+	var status project.GitStatus
+	err = json.NewDecoder(res.Body).Decode(&status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// setProjectGitStatus stores the git status without waiting for the agent to report it with the project state
+func (s *WorkspaceService) setProjectGitStatus(workspaceId, projectName string, status *project.GitStatus) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return err
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if p.State == nil {
+		return nil
+	}
+
+	p.State.GitStatus = status
+
+	return s.workspaceStore.Save(w)
+}
+
+// getAgentHttpClient returns a client that reaches the agent of the project over the tailnet or through its provider
+func (s *WorkspaceService) getAgentHttpClient(p *project.Project) *http.Client {
+	if p.Networking != project.NetworkingAgentless {
+		return s.getTailnetHttpClient()
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return s.ForwardProjectPort(ctx, p.WorkspaceId, p.Name, toolbox_config.TOOLBOX_PORT)
+			},
+		},
+	}
+}
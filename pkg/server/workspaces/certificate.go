@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+)
+
+// IssueProjectCertificate signs a certificate for the tailnet name of the project, which the agent of the project
+// serves its TLS ports with. Routed projects share the node of their gateway, so they have no name of their own
+func (s *WorkspaceService) IssueProjectCertificate(workspaceId string, projectName string, csr string) (*tailnetca.Certificate, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := ws.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if p.Route != nil {
+		return nil, ErrProjectRouted
+	}
+
+	if s.tailnetCa == nil {
+		return nil, ErrCertificatesUnavailable
+	}
+
+	return s.tailnetCa.Sign(csr, []string{p.GetTailnetDomainName()})
+}
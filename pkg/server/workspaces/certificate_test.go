@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueProjectCertificate(t *testing.T) {
+	ca, err := tailnetca.LoadOrCreate(t.TempDir(), project.TailnetDomain)
+	require.Nil(t, err)
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:   "ws1",
+		Name: "ws1",
+		Projects: []*project.Project{
+			{Name: "p1", WorkspaceId: "ws1", Hostname: "dev-api"},
+			{Name: "p2", WorkspaceId: "ws1", Route: &project.ProjectRoute{Gateway: "p1"}},
+		},
+	}))
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		TailnetCa:      ca,
+	})
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	require.Nil(t, err)
+	csr := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+
+	t.Run("IssueProjectCertificate issues a certificate for the tailnet name of the project", func(t *testing.T) {
+		certificate, err := service.IssueProjectCertificate("ws1", "p1", csr)
+		require.Nil(t, err)
+
+		block, _ := pem.Decode([]byte(certificate.CertPem))
+		require.NotNil(t, block)
+		leaf, err := x509.ParseCertificate(block.Bytes)
+		require.Nil(t, err)
+		require.Equal(t, []string{"dev-api.daytona.local"}, leaf.DNSNames)
+	})
+
+	t.Run("IssueProjectCertificate fails for routed projects", func(t *testing.T) {
+		_, err := service.IssueProjectCertificate("ws1", "p2", csr)
+		require.Equal(t, workspaces.ErrProjectRouted, err)
+	})
+
+	t.Run("IssueProjectCertificate fails for unknown projects", func(t *testing.T) {
+		_, err := service.IssueProjectCertificate("ws1", "unknown", csr)
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	log "github.com/sirupsen/logrus"
+)
+
+const cleanupPollInterval = "0 */5 * * * *"
+
+// PreviewCleanup returns the actions the cleanup policies would take without applying them
+func (s *WorkspaceService) PreviewCleanup() ([]workspace.CleanupCandidate, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	usage, err := s.getIdleUsage(now)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace.EvaluateCleanupPolicies(s.cleanupPolicies, workspaces, usage, now), nil
+}
+
+// getIdleUsage returns the resource usage samples of each workspace within the longest idle time of the policies
+func (s *WorkspaceService) getIdleUsage(now time.Time) (map[string][]*resourceusage.Sample, error) {
+	usage := map[string][]*resourceusage.Sample{}
+
+	var maxIdle time.Duration
+	for _, policy := range s.cleanupPolicies {
+		maxIdle = max(maxIdle, policy.MaxIdle)
+	}
+
+	if maxIdle == 0 || s.resourceUsageService == nil {
+		return usage, nil
+	}
+
+	since := now.Add(-maxIdle)
+	samples, err := s.resourceUsageService.List(&resourceusage.Filter{Since: &since})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sample := range samples {
+		usage[sample.WorkspaceId] = append(usage[sample.WorkspaceId], sample)
+	}
+
+	return usage, nil
+}
+
+// ApplyCleanupPolicies deletes or stops every workspace selected by a cleanup policy that is not in dry-run mode
+func (s *WorkspaceService) ApplyCleanupPolicies(ctx context.Context) error {
+	candidates, err := s.PreviewCleanup()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, c := range candidates {
+		if c.DryRun {
+			log.Infof("Cleanup policy %s would %s workspace %s: %s", c.Policy, c.Action, c.WorkspaceName, c.Reason)
+			continue
+		}
+
+		log.Infof("Cleanup policy %s: %s workspace %s: %s", c.Policy, c.Action, c.WorkspaceName, c.Reason)
+
+		switch c.Action {
+		case workspace.CleanupActionDelete:
+			err = s.RemoveWorkspace(ctx, c.WorkspaceId)
+		case workspace.CleanupActionStop:
+			err = s.StopWorkspace(ctx, c.WorkspaceId)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cleanup policy %s failed to %s workspace %s: %w", c.Policy, c.Action, c.WorkspaceName, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *WorkspaceService) StartCleanupPoller() error {
+	if len(s.cleanupPolicies) == 0 {
+		return nil
+	}
+
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(cleanupPollInterval, func() {
+		err := s.ApplyCleanupPolicies(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
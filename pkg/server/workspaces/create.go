@@ -9,21 +9,28 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/build"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/imagepolicy"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/daytonaio/daytona/pkg/workspace/project/config"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -45,6 +52,55 @@ func isValidWorkspaceName(name string) bool {
 	return true
 }
 
+// checkTargetOrganization returns ErrTargetNotFound if the target does not belong to the organization. Workspaces
+// can only be created on the targets of their organization
+func (s *WorkspaceService) checkTargetOrganization(targetName, organizationId string) error {
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &targetName})
+	if err != nil {
+		return err
+	}
+
+	if target.OrganizationId != organizationId {
+		return provider.ErrTargetNotFound
+	}
+
+	return nil
+}
+
+// checkOrganizationQuota returns an error if the organization has reached its workspace quota
+func (s *WorkspaceService) checkOrganizationQuota(organizationId string) error {
+	if organizationId == "" || s.organizationService == nil {
+		return nil
+	}
+
+	o, err := s.organizationService.Find(organizationId)
+	if err != nil {
+		return err
+	}
+
+	if o.Quota.MaxWorkspaces <= 0 {
+		return nil
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	count := 0
+	for _, w := range workspaces {
+		if w.OrganizationId == organizationId {
+			count++
+		}
+	}
+
+	if count >= o.Quota.MaxWorkspaces {
+		return ErrOrganizationQuotaExceeded
+	}
+
+	return nil
+}
+
 func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error) {
 	_, err := s.workspaceStore.Find(req.Name)
 	if err == nil {
@@ -57,11 +113,43 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	}
 
 	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+		Id:             req.Id,
+		Name:           req.Name,
+		Target:         req.Target,
+		OrganizationId: organization.GetOrganizationId(ctx),
+		CreatedAt:      util.Pointer(time.Now()),
 	}
 
+	err = s.checkTargetOrganization(w.Target, w.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.checkOrganizationQuota(w.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Ttl != nil && *req.Ttl != "" {
+		ttl, err := time.ParseDuration(*req.Ttl)
+		if err != nil || ttl <= 0 {
+			return nil, ErrInvalidWorkspaceTtl
+		}
+		expiresAt := time.Now().Add(ttl)
+		w.ExpiresAt = &expiresAt
+	}
+
+	for _, name := range req.SharedServices {
+		service, err := s.sharedServiceService.Find(name)
+		if err != nil {
+			return nil, err
+		}
+		if service.Target != w.Target {
+			return nil, sharedservices.ErrTargetMismatch
+		}
+	}
+	w.SharedServices = req.SharedServices
+
 	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
 	if err != nil {
 		return nil, err
@@ -69,6 +157,7 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	w.ApiKey = apiKey
 
 	w.Projects = []*project.Project{}
+	prebuildUsages := []*config.PrebuildUsage{}
 
 	for _, projectDto := range req.Projects {
 		p := conversion.CreateDtoToProject(projectDto)
@@ -104,9 +193,16 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 
 		if p.BuildConfig != nil {
 			cachedBuild, err := s.getCachedBuildForProject(p)
-			if err == nil {
-				p.BuildConfig.CachedBuild = cachedBuild
+			if err != nil && !build.IsBuildNotFound(err) {
+				return nil, err
+			}
+			if cachedBuild != nil {
+				p.BuildConfig.CachedBuild = &buildconfig.CachedBuild{
+					User:  *cachedBuild.User,
+					Image: *cachedBuild.Image,
+				}
 			}
+			prebuildUsages = append(prebuildUsages, getPrebuildUsage(w.Id, p, cachedBuild))
 		}
 
 		if p.Image == "" {
@@ -117,6 +213,38 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 			p.User = s.defaultProjectUser
 		}
 
+		err = s.validateProjectImage(p)
+		if err != nil {
+			return nil, err
+		}
+
+		err = project.ValidateMounts(p.Mounts)
+		if err != nil {
+			return nil, err
+		}
+
+		err = project.ValidateBindMountSources(p.Mounts, s.allowedBindMountPaths)
+		if err != nil {
+			return nil, err
+		}
+
+		err = project.ValidateCommands(p.Commands)
+		if err != nil {
+			return nil, err
+		}
+
+		err = project.ValidatePorts(p.Ports)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Resources != nil {
+			err = p.Resources.Validate()
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", w.Id, p.Name))
 		if err != nil {
 			return nil, err
@@ -125,20 +253,68 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 		p.WorkspaceId = w.Id
 		p.ApiKey = apiKey
 		p.Target = w.Target
+
+		err = setSshHostKey(p)
+		if err != nil {
+			return nil, err
+		}
+
 		w.Projects = append(w.Projects, p)
 	}
 
-	err = s.workspaceStore.Save(w)
+	err = project.ValidateProjectPorts(w.Projects)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SharedNode {
+		err = project.AssignRoutes(w.Projects)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.assignProjectHostnames(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+	setRoutedProjectHostnames(w)
+
+	unlock, err := s.operations.lock(w.Id, OperationCreate, "")
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	err = s.saveWithinTargetCapacity(w)
 	if err != nil {
+		if errors.Is(err, ErrTargetOvercommitted) {
+			s.recordRefusedCreation(w.Target)
+		}
 		return nil, err
 	}
 
+	for _, usage := range prebuildUsages {
+		err = s.projectConfigService.RecordPrebuildUsage(usage)
+		if err != nil {
+			log.Errorf("failed to record prebuild usage for project %s: %v", usage.ProjectName, err)
+		}
+	}
+
 	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
 	if err != nil {
 		return w, err
 	}
 
+	creationDone := s.startCreation(w.Target)
+	release, err := s.provisioning.acquire(ctx, w.Target, provisioningPriorityInteractive)
+	if err != nil {
+		creationDone()
+		return w, err
+	}
 	w, err = s.createWorkspace(ctx, w, target)
+	release()
+	creationDone()
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return w, err
@@ -160,7 +336,7 @@ func (s *WorkspaceService) CreateWorkspace(ctx context.Context, req dto.CreateWo
 	return w, err
 }
 
-func (s *WorkspaceService) createProject(p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
+func (s *WorkspaceService) createProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", p.Name)))
 
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
@@ -189,6 +365,7 @@ func (s *WorkspaceService) createProject(p *project.Project, target *provider.Pr
 		GitProviderConfig:             gc,
 		BuilderImage:                  s.builderImage,
 		BuilderImageContainerRegistry: builderCr,
+		CorrelationId:                 correlation.GetId(ctx),
 	})
 	if err != nil {
 		return err
@@ -204,15 +381,37 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 	defer wsLogger.Close()
 
 	wsLogger.Write([]byte(fmt.Sprintf("Creating workspace %s (%s)\n", ws.Name, ws.Id)))
+	if correlationId := correlation.GetId(ctx); correlationId != "" {
+		wsLogger.Write([]byte(fmt.Sprintf("Correlation ID: %s\n", correlationId)))
+	}
+
+	agentVersion, err := s.getAgentVersion(ws.Id)
+	if err != nil {
+		return nil, err
+	}
 
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
 		ServerUrl:     s.serverUrl,
-		ServerVersion: s.serverVersion,
+		ServerVersion: agentVersion,
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.CreateWorkspace(ws, target)
+	// Time spent validating the request and waiting for the git provider before provisioning starts
+	for _, p := range ws.Projects {
+		s.recordCreationPhase(ws, p.Name, target, creationtiming.PhaseQueueWait, *ws.CreatedAt)
+	}
+
+	provisionStart := time.Now()
+	err = s.provisioner.CreateWorkspace(ws, target)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range ws.Projects {
+		s.recordCreationPhase(ws, p.Name, target, creationtiming.PhaseProvision, provisionStart)
+	}
+
+	sharedServiceEnvVars, err := s.getSharedServiceEnvVars(ctx, ws.SharedServices, target)
 	if err != nil {
 		return nil, err
 	}
@@ -222,13 +421,30 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 		defer projectLogger.Close()
 
 		projectWithEnv := *p
-		projectWithEnv.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+		if p.Route != nil {
+			if isGatewayOnTailnet(ws, p.Route.Gateway) {
+				projectWithEnv.Networking = project.NetworkingRouted
+			} else {
+				projectLogger.Write([]byte(fmt.Sprintf("Project %s is not on the tailnet, project %s gets its own node\n", p.Route.Gateway, p.Name)))
+				projectWithEnv.Route = nil
+				projectWithEnv.Hostname = ""
+			}
+		}
+		if projectWithEnv.Networking == "" {
+			projectWithEnv.Networking = s.getProjectNetworking(p, target)
+		}
+		projectWithEnv.EnvVars = project.GetProjectEnvVars(&projectWithEnv, project.ProjectEnvVarParams{
 			ApiUrl:        s.serverApiUrl,
 			ServerUrl:     s.serverUrl,
-			ServerVersion: s.serverVersion,
+			ServerVersion: agentVersion,
 			ClientId:      telemetry.ClientId(ctx),
+			Gateway:       projectWithEnv.Networking == project.NetworkingTailnet && isGateway(ws, p.Name),
+			IdleTimeout:   s.idleTimeout,
+			AgentLogLevel: s.agentLogLevel,
 		}, telemetry.TelemetryEnabled(ctx))
 
+		addWorkspaceEnvVars(projectWithEnv.EnvVars, ws, p.Name, sharedServiceEnvVars)
+
 		for k, v := range p.EnvVars {
 			projectWithEnv.EnvVars[k] = v
 		}
@@ -243,14 +459,21 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 			return nil, err
 		}
 
-		err = s.createProject(p, target, projectLogger)
+		projectCreateStart := time.Now()
+		err = s.createProject(ctx, p, target, projectLogger)
 		if err != nil {
 			return nil, err
 		}
+		s.recordCreationPhase(ws, p.Name, target, creationtiming.PhaseProjectCreate, projectCreateStart)
+		s.recordProviderCreationTimings(ws, p, target)
 	}
 
+	s.createPreviewRecords(ctx, ws)
+
 	wsLogger.Write([]byte("Workspace creation complete. Pending start...\n"))
 
+	s.startAgentBootTimer(ws)
+
 	err = s.startWorkspace(ctx, ws, target, wsLogger)
 	if err != nil {
 		return nil, err
@@ -259,29 +482,99 @@ func (s *WorkspaceService) createWorkspace(ctx context.Context, ws *workspace.Wo
 	return ws, nil
 }
 
-func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*buildconfig.CachedBuild, error) {
+// validateProjectImage checks the image the project runs against the image policy and pins it to the verified digest
+func (s *WorkspaceService) validateProjectImage(p *project.Project) error {
+	if s.imagePolicy == nil {
+		return nil
+	}
+
+	if p.BuildConfig == nil {
+		image, err := s.imagePolicy.Validate(p.Image)
+		if err != nil {
+			return err
+		}
+		p.Image = image
+		return nil
+	}
+
+	if p.BuildConfig.CachedBuild != nil {
+		image, err := s.imagePolicy.Validate(p.BuildConfig.CachedBuild.Image)
+		if err != nil {
+			return err
+		}
+		p.BuildConfig.CachedBuild.Image = image
+		return nil
+	}
+
+	// The image is built from the devcontainer on the target and is only known once it is running
+	return fmt.Errorf("%w: project %s is built from a devcontainer without a published prebuild", imagepolicy.ErrImageNotAllowed, p.Name)
+}
+
+func (s *WorkspaceService) getCachedBuildForProject(p *project.Project) (*build.Build, error) {
 	validStates := &[]build.BuildState{
 		build.BuildState(build.BuildStatePublished),
 	}
 
-	build, err := s.buildService.Find(&build.Filter{
+	image := p.Image
+	if image == "" {
+		image = s.defaultProjectImage
+	}
+
+	// Only the prebuild matrix variant of the image and architecture of the project is used. Builds of other variants
+	// would run the project on a different base image or architecture than requested
+	b, err := s.buildService.Find(&build.Filter{
 		States:        validStates,
 		RepositoryUrl: &p.Repository.Url,
 		Branch:        &p.Repository.Branch,
 		EnvVars:       &p.EnvVars,
 		BuildConfig:   p.BuildConfig,
+		Image:         &image,
+		Architecture:  &p.Architecture,
 		GetNewest:     util.Pointer(true),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if build.Image == nil || build.User == nil {
+	if b.Image == nil || b.User == nil {
 		return nil, errors.New("cached build is missing image or user")
 	}
 
-	return &buildconfig.CachedBuild{
-		User:  *build.User,
-		Image: *build.Image,
-	}, nil
+	return b, nil
+}
+
+// getPrebuildUsage records a hit if the project uses a cached build.
+// The build's duration is the time a from-scratch build would have taken.
+func getPrebuildUsage(workspaceId string, p *project.Project, cachedBuild *build.Build) *config.PrebuildUsage {
+	usage := &config.PrebuildUsage{
+		WorkspaceId:   workspaceId,
+		ProjectName:   p.Name,
+		RepositoryUrl: p.Repository.Url,
+		Branch:        p.Repository.Branch,
+	}
+
+	if cachedBuild == nil {
+		return usage
+	}
+
+	usage.Hit = true
+	usage.BuildId = cachedBuild.Id
+	usage.PrebuildId = cachedBuild.PrebuildId
+	usage.CommitSha = cachedBuild.Repository.Sha
+	usage.TimeSaved = cachedBuild.UpdatedAt.Sub(cachedBuild.CreatedAt)
+
+	return usage
+}
+
+// createPreviewRecords creates the DNS records of the public ports of the workspace. Public URLs of the ports
+// don't resolve until they are created, which should not fail the creation
+func (s *WorkspaceService) createPreviewRecords(ctx context.Context, ws *workspace.Workspace) {
+	if s.previewDnsService == nil {
+		return
+	}
+
+	err := s.previewDnsService.CreateWorkspaceRecords(ctx, ws)
+	if err != nil {
+		log.Errorf("failed to create preview DNS records of workspace %s: %v", ws.Id, err)
+	}
 }
@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// recordCreationPhase records the time since start as the duration of the phase. Failing to record a timing
+// never fails the workspace creation.
+func (s *WorkspaceService) recordCreationPhase(ws *workspace.Workspace, projectName string, target *provider.ProviderTarget, phase creationtiming.Phase, start time.Time) {
+	s.recordCreationPhaseDuration(ws, projectName, target, phase, time.Since(start).Milliseconds())
+}
+
+func (s *WorkspaceService) recordCreationPhaseDuration(ws *workspace.Workspace, projectName string, target *provider.ProviderTarget, phase creationtiming.Phase, durationMs int64) {
+	if s.creationTimingService == nil {
+		return
+	}
+
+	err := s.creationTimingService.Record(&creationtiming.PhaseTiming{
+		WorkspaceId: ws.Id,
+		ProjectName: projectName,
+		Target:      target.Name,
+		Provider:    target.ProviderInfo.Name,
+		Phase:       phase,
+		DurationMs:  durationMs,
+	})
+	if err != nil {
+		log.Errorf("failed to record %s timing of workspace %s: %v", phase, ws.Id, err)
+	}
+}
+
+// recordProviderCreationTimings records the phases measured by the provider while creating the project
+func (s *WorkspaceService) recordProviderCreationTimings(ws *workspace.Workspace, p *project.Project, target *provider.ProviderTarget) {
+	if s.creationTimingService == nil {
+		return
+	}
+
+	durations, err := s.provisioner.GetProjectCreationTimings(p, target)
+	if err != nil {
+		// Providers that do not measure their phases only report the total project creation time
+		log.Debugf("failed to get creation timings of project %s from provider %s: %v", p.Name, target.ProviderInfo.Name, err)
+		return
+	}
+
+	for _, d := range durations {
+		s.recordCreationPhaseDuration(ws, p.Name, target, d.Phase, d.DurationMs)
+	}
+}
+
+func getAgentBootKey(workspaceId, projectName string) string {
+	return fmt.Sprintf("%s/%s", workspaceId, projectName)
+}
+
+func (s *WorkspaceService) startAgentBootTimer(ws *workspace.Workspace) {
+	if s.creationTimingService == nil {
+		return
+	}
+
+	s.agentBootStartsMutex.Lock()
+	defer s.agentBootStartsMutex.Unlock()
+
+	for _, p := range ws.Projects {
+		s.agentBootStarts[getAgentBootKey(ws.Id, p.Name)] = time.Now()
+	}
+}
+
+// recordAgentBoot records the agent boot phase when the agent of a newly created project reports its state for the first time
+func (s *WorkspaceService) recordAgentBoot(ws *workspace.Workspace, projectName string) {
+	if s.creationTimingService == nil {
+		return
+	}
+
+	key := getAgentBootKey(ws.Id, projectName)
+
+	s.agentBootStartsMutex.Lock()
+	start, ok := s.agentBootStarts[key]
+	delete(s.agentBootStarts, key)
+	s.agentBootStartsMutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &ws.Target})
+	if err != nil {
+		log.Errorf("failed to record agent boot timing of workspace %s: %v", ws.Id, err)
+		return
+	}
+
+	s.recordCreationPhase(ws, projectName, target, creationtiming.PhaseAgentBoot, start)
+}
+
+// RecordProjectCreationTimings records the phases measured by the agent of a project, e.g. cloning the repository
+// and running the lifecycle commands
+func (s *WorkspaceService) RecordProjectCreationTimings(workspaceId string, projectName string, durations []creationtiming.PhaseDuration) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	_, err = ws.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	for _, d := range durations {
+		if !d.Phase.IsValid() {
+			return creationtimings.ErrInvalidPhase
+		}
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &ws.Target})
+	if err != nil {
+		return err
+	}
+
+	for _, d := range durations {
+		s.recordCreationPhaseDuration(ws, projectName, target, d.Phase, d.DurationMs)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// mergeDetectedPorts keeps the time at which the ports of the new state were first detected and logs the ports
+// the project started listening on since the previous state
+func (s *WorkspaceService) mergeDetectedPorts(ws *workspace.Workspace, p *project.Project, state *project.ProjectState) {
+	var previous []project.DetectedPort
+	if p.State != nil {
+		previous = p.State.DetectedPorts
+	}
+
+	if len(state.DetectedPorts) == 0 {
+		return
+	}
+
+	merged, newPorts := project.MergeDetectedPorts(previous, state.DetectedPorts)
+	for _, port := range newPorts {
+		log.Infof("Project %s of workspace %s is listening on port %d", p.Name, ws.Name, port)
+	}
+
+	state.DetectedPorts = merged
+}
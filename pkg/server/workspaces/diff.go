@@ -0,0 +1,22 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (s *WorkspaceService) DiffWorkspaces(workspaceIdA string, workspaceIdB string) (*workspace.WorkspaceDiff, error) {
+	a, err := s.workspaceStore.Find(workspaceIdA)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	b, err := s.workspaceStore.Find(workspaceIdB)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	return workspace.Diff(a, b), nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// Services of a project whose agent has not reported its state for this long are not resolved
+const serviceHeartbeatTimeout = time.Minute
+
+// ListServiceEndpoints returns the services registered by the agents of running projects in the workspaces of the
+// organization of the request. Only the services of the workspace are returned if workspaceId is set
+func (s *WorkspaceService) ListServiceEndpoints(ctx context.Context, workspaceId string) ([]project.ServiceEndpoint, error) {
+	organizationId := organization.GetOrganizationId(ctx)
+
+	var workspaces []*workspace.Workspace
+
+	if workspaceId != "" {
+		w, err := s.workspaceStore.Find(workspaceId)
+		if err != nil || w.OrganizationId != organizationId {
+			return nil, ErrWorkspaceNotFound
+		}
+		workspaces = []*workspace.Workspace{w}
+	} else {
+		var err error
+		workspaces, err = s.workspaceStore.List()
+		if err != nil {
+			return nil, err
+		}
+		workspaces = slices.DeleteFunc(workspaces, func(w *workspace.Workspace) bool {
+			return w.OrganizationId != organizationId
+		})
+	}
+
+	endpoints := []project.ServiceEndpoint{}
+
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			if p.Networking == project.NetworkingAgentless || !isReportingState(p.State) {
+				continue
+			}
+
+			for _, service := range p.State.Services {
+				address, err := p.GetTailnetAddress(service.Port)
+				if err != nil {
+					log.Debugf("service %s of project %s is not reachable: %s", service.Name, p.Name, err)
+					continue
+				}
+
+				endpoints = append(endpoints, project.ServiceEndpoint{
+					Name:          project.GetQualifiedServiceName(service.Name, p.Name, w.Name),
+					Service:       service.Name,
+					WorkspaceId:   w.Id,
+					WorkspaceName: w.Name,
+					ProjectName:   p.Name,
+					Port:          service.Port,
+					Address:       address,
+					Protocol:      service.Protocol,
+				})
+			}
+		}
+	}
+
+	slices.SortFunc(endpoints, func(a, b project.ServiceEndpoint) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return endpoints, nil
+}
+
+// ResolveService resolves the name of a service to its tailnet address. Names that are not qualified with the
+// workspace, e.g. api-proj.daytona.internal, are resolved in the workspace of the caller first. Only the services of
+// the organization of the request are resolved
+func (s *WorkspaceService) ResolveService(ctx context.Context, name string, callerWorkspaceId string) (*project.ServiceEndpoint, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	endpoints, err := s.ListServiceEndpoints(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var callerWorkspace *workspace.Workspace
+	if callerWorkspaceId != "" {
+		callerWorkspace, err = s.workspaceStore.Find(callerWorkspaceId)
+		if err != nil || callerWorkspace.OrganizationId != organization.GetOrganizationId(ctx) {
+			return nil, ErrWorkspaceNotFound
+		}
+	}
+
+	matches := []project.ServiceEndpoint{}
+
+	for _, e := range endpoints {
+		if e.Name == name {
+			return &e, nil
+		}
+
+		if project.GetServiceName(e.Service, e.ProjectName) == name {
+			if callerWorkspace != nil && e.WorkspaceId == callerWorkspace.Id {
+				return &e, nil
+			}
+			matches = append(matches, e)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", project.ErrServiceNotFound, name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	names := []string{}
+	for _, m := range matches {
+		names = append(names, m.Name)
+	}
+
+	return nil, fmt.Errorf("%w: %s is registered by several workspaces, use one of %s", project.ErrAmbiguousService, name, strings.Join(names, ", "))
+}
+
+func isReportingState(state *project.ProjectState) bool {
+	if state == nil || state.Uptime == 0 {
+		return false
+	}
+
+	updatedAt, err := time.Parse(time.RFC1123, state.UpdatedAt)
+	if err != nil {
+		return false
+	}
+
+	return time.Since(updatedAt) < serviceHeartbeatTimeout
+}
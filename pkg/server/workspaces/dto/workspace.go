@@ -4,6 +4,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/daytonaio/daytona/pkg/workspace/project"
@@ -13,6 +15,8 @@ import (
 type WorkspaceDTO struct {
 	workspace.Workspace
 	Info *workspace.WorkspaceInfo `json:"info" validate:"optional"`
+	// Federated region of the server that manages the workspace. Empty if federation is disabled
+	Region string `json:"region,omitempty" validate:"optional"`
 } //	@name	WorkspaceDTO
 
 type ProjectDTO struct {
@@ -25,6 +29,15 @@ type CreateWorkspaceDTO struct {
 	Name     string             `json:"name" validate:"required"`
 	Target   string             `json:"target" validate:"required"`
 	Projects []CreateProjectDTO `json:"projects" validate:"required,gt=0,dive"`
+	// Duration after which the workspace is removed, e.g. 48h
+	Ttl *string `json:"ttl,omitempty" validate:"optional"`
+	// Names of shared services of the target to attach the workspace to
+	SharedServices []string `json:"sharedServices,omitempty" validate:"optional"`
+	// Federated region to create the workspace in. Defaults to the region of the server
+	Region *string `json:"region,omitempty" validate:"optional"`
+	// Route the ports of all projects through the tailnet node of the first project instead of
+	// connecting each project to the tailnet
+	SharedNode bool `json:"sharedNode,omitempty" validate:"optional"`
 } //	@name	CreateWorkspaceDTO
 
 type CreateProjectDTO struct {
@@ -35,8 +48,40 @@ type CreateProjectDTO struct {
 	Source              CreateProjectSourceDTO   `json:"source" validate:"required"`
 	EnvVars             map[string]string        `json:"envVars" validate:"required"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	Mounts              []project.Mount          `json:"mounts,omitempty" validate:"optional"`
+	Commands            []project.Command        `json:"commands,omitempty" validate:"optional"`
+	Ports               []project.Port           `json:"ports,omitempty" validate:"optional"`
+	// CPU and memory reserved for the project on the target host
+	Resources *project.Resources `json:"resources,omitempty" validate:"optional"`
+	// Architecture of the target host, e.g. arm64. Selects the matching variant of prebuild matrices
+	Architecture *string `json:"architecture,omitempty" validate:"optional"`
 } //	@name	CreateProjectDTO
 
 type CreateProjectSourceDTO struct {
 	Repository *gitprovider.GitRepository `json:"repository" validate:"required"`
 } // @name CreateProjectSourceDTO
+
+type AdoptWorkspaceDTO struct {
+	Id          string                 `json:"id" validate:"required"`
+	Name        string                 `json:"name" validate:"required"`
+	ProjectName string                 `json:"projectName" validate:"required"`
+	Type        workspace.AdoptionType `json:"type" validate:"required"`
+	// Repository checked out in the project directory of the adopted machine
+	Repository *gitprovider.GitRepository `json:"repository,omitempty" validate:"optional"`
+	// Defaults to $HOME/<projectName> of the user running the agent
+	ProjectDir  *string `json:"projectDir,omitempty" validate:"optional"`
+	ContainerId string  `json:"containerId,omitempty" validate:"optional"`
+	Host        string  `json:"host,omitempty" validate:"optional"`
+	Port        int     `json:"port,omitempty" validate:"optional"`
+	// User that runs the agent. Defaults to root for docker adoptions
+	User string `json:"user,omitempty" validate:"optional"`
+	// SSH credentials are only used to install the agent and are not stored
+	Password   *string `json:"password,omitempty" validate:"optional"`
+	PrivateKey *string `json:"privateKey,omitempty" validate:"optional"`
+} //	@name	AdoptWorkspaceDTO
+
+type SshTokenDTO struct {
+	// Password the SSH client authenticates with on the tailnet node of the project
+	Token     string    `json:"token" validate:"required"`
+	ExpiresAt time.Time `json:"expiresAt" validate:"required"`
+} //	@name	SshTokenDTO
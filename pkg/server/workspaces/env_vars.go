@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// addWorkspaceEnvVars adds the connection env vars of the shared services and the env vars that point the project to
+// the named ports of the other projects of the workspace, in that order. Env vars that are already set are kept, so
+// that neither overrides the env vars of the agent or the ones added before them
+func addWorkspaceEnvVars(envVars map[string]string, w *workspace.Workspace, projectName string, sharedServiceEnvVars map[string]string) {
+	for _, added := range []map[string]string{sharedServiceEnvVars, workspace.GetPortEnvVars(w, projectName)} {
+		for name, value := range added {
+			if _, ok := envVars[name]; !ok {
+				envVars[name] = value
+			}
+		}
+	}
+}
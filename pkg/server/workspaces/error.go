@@ -5,15 +5,34 @@ package workspaces
 
 import (
 	"errors"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 var (
-	ErrWorkspaceAlreadyExists = errors.New("workspace already exists")
-	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
-	ErrWorkspaceNotFound      = errors.New("workspace not found")
-	ErrProjectNotFound        = errors.New("project not found")
-	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
-	ErrInvalidProjectConfig   = errors.New("project config is invalid")
+	ErrWorkspaceAlreadyExists     = errors.New("workspace already exists")
+	ErrInvalidWorkspaceName       = errors.New("name is not a valid alphanumeric string")
+	ErrWorkspaceNotFound          = errors.New("workspace not found")
+	ErrProjectNotFound            = errors.New("project not found")
+	ErrInvalidProjectName         = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidProjectConfig       = errors.New("project config is invalid")
+	ErrInvalidWorkspaceTtl        = errors.New("ttl must be a positive duration, e.g. 48h")
+	ErrOrganizationQuotaExceeded  = common.WithErrorCode(errors.New("organization workspace quota exceeded"), common.ErrorCodeQuotaExceeded)
+	ErrInvalidAdoption            = errors.New("docker adoptions require a container and ssh adoptions require a host and user")
+	ErrAdoptedWorkspaceNotManaged = errors.New("the container or VM of an adopted workspace is not managed by Daytona")
+	ErrProjectNotAgentless        = errors.New("project does not use agentless networking")
+	ErrRecoveryNotSupported       = errors.New("recovery is not supported for projects of adopted workspaces or with agentless networking")
+	ErrInvalidHostname            = errors.New("hostname must be a DNS label of at most 63 lowercase letters, digits and hyphens")
+	ErrHostnameTaken              = errors.New("hostname is used by another project or node of the network")
+	ErrHostnameReserved           = errors.New("hostname is reserved for the nodes of the Daytona Server and CLI clients")
+	ErrProjectRouted              = errors.New("project shares the tailnet node of another project")
+	ErrTargetOvercommitted        = common.WithErrorCode(errors.New("the target host does not have enough free CPU or memory for the project"), common.ErrorCodeCapacityExceeded)
+	ErrTooManyReadyHooks          = errors.New("the workspace has too many pending ready hooks")
+	ErrWorkspaceNotIdle           = errors.New("a project of the workspace was used within the idle timeout")
+	ErrNetworkKeysUnavailable     = errors.New("network keys are not issued by the server")
+	ErrCertificatesUnavailable    = errors.New("certificates are not issued by the server")
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -31,3 +50,71 @@ func IsProjectNotFound(err error) bool {
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsInvalidWorkspaceTtl(err error) bool {
+	return err.Error() == ErrInvalidWorkspaceTtl.Error()
+}
+
+func IsOrganizationQuotaExceeded(err error) bool {
+	return err.Error() == ErrOrganizationQuotaExceeded.Error()
+}
+
+func IsInvalidAdoption(err error) bool {
+	return err.Error() == ErrInvalidAdoption.Error()
+}
+
+func IsAdoptedWorkspaceNotManaged(err error) bool {
+	return err.Error() == ErrAdoptedWorkspaceNotManaged.Error()
+}
+
+func IsProjectNotAgentless(err error) bool {
+	return err.Error() == ErrProjectNotAgentless.Error()
+}
+
+func IsRecoveryNotSupported(err error) bool {
+	return err.Error() == ErrRecoveryNotSupported.Error()
+}
+
+func IsInvalidHostname(err error) bool {
+	return err.Error() == ErrInvalidHostname.Error()
+}
+
+func IsHostnameTaken(err error) bool {
+	return err.Error() == ErrHostnameTaken.Error()
+}
+
+func IsHostnameReserved(err error) bool {
+	return err.Error() == ErrHostnameReserved.Error()
+}
+
+func IsProjectRouted(err error) bool {
+	return err.Error() == ErrProjectRouted.Error()
+}
+
+func IsTargetOvercommitted(err error) bool {
+	return errors.Is(err, ErrTargetOvercommitted)
+}
+
+func IsWorkspaceNotIdle(err error) bool {
+	return err.Error() == ErrWorkspaceNotIdle.Error()
+}
+
+func IsInvalidAccessPolicy(err error) bool {
+	return err.Error() == project.ErrInvalidPortAccessAction.Error() || err.Error() == project.ErrInvalidPortRange.Error() || err.Error() == project.ErrInvalidPeerPattern.Error()
+}
+
+func IsInvalidReadyHookUrl(err error) bool {
+	return err.Error() == workspace.ErrInvalidReadyHookUrl.Error()
+}
+
+func IsTooManyReadyHooks(err error) bool {
+	return err.Error() == ErrTooManyReadyHooks.Error()
+}
+
+func IsNetworkKeysUnavailable(err error) bool {
+	return err.Error() == ErrNetworkKeysUnavailable.Error()
+}
+
+func IsCertificatesUnavailable(err error) bool {
+	return err.Error() == ErrCertificatesUnavailable.Error()
+}
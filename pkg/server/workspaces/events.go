@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/server/agentevents"
+	log "github.com/sirupsen/logrus"
+)
+
+// RecordProjectEvents records the lifecycle and network events published by the agent of a project
+func (s *WorkspaceService) RecordProjectEvents(workspaceId string, projectName string, reports []agentevent.Report) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	_, err = ws.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if len(reports) > agentevent.MaxBatchSize {
+		return agentevents.ErrTooManyEvents
+	}
+
+	for _, r := range reports {
+		if !r.Type.IsValid() {
+			return agentevents.ErrInvalidEventType
+		}
+	}
+
+	if s.agentEventService == nil {
+		return nil
+	}
+
+	events := []*agentevent.Event{}
+	for _, r := range reports {
+		events = append(events, &agentevent.Event{
+			WorkspaceId: ws.Id,
+			ProjectName: projectName,
+			Type:        r.Type,
+			Message:     r.Message,
+			Port:        r.Port,
+			OccurredAt:  r.OccurredAt,
+		})
+	}
+
+	return s.agentEventService.Record(events)
+}
+
+// ListWorkspaceEvents returns the events of the agents of the workspace projects in the order they occurred
+func (s *WorkspaceService) ListWorkspaceEvents(workspaceId string, filter agentevent.Filter) ([]*agentevent.Event, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if s.agentEventService == nil {
+		return []*agentevent.Event{}, nil
+	}
+
+	filter.WorkspaceId = &ws.Id
+
+	return s.agentEventService.List(&filter)
+}
+
+// deleteEvents deletes the agent events of a removed workspace
+func (s *WorkspaceService) deleteEvents(workspaceId string) {
+	if s.agentEventService == nil {
+		return
+	}
+
+	err := s.agentEventService.Delete(&agentevent.Filter{WorkspaceId: &workspaceId})
+	if err != nil {
+		log.Errorf("failed to delete agent events of workspace %s: %v", workspaceId, err)
+	}
+}
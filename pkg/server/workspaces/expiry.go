@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	log "github.com/sirupsen/logrus"
+)
+
+const expiryPollInterval = "0 * * * * *"
+
+// RemoveExpiredWorkspaces removes every workspace whose TTL has passed
+func (s *WorkspaceService) RemoveExpiredWorkspaces(ctx context.Context) error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, w := range workspaces {
+		if w.ExpiresAt == nil || w.ExpiresAt.After(time.Now()) {
+			continue
+		}
+
+		log.Infof("Workspace %s expired at %s. Removing...", w.Name, w.ExpiresAt.Format(time.RFC3339))
+
+		err := s.RemoveWorkspace(ctx, w.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove expired workspace %s: %w", w.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *WorkspaceService) StartExpiryPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(expiryPollInterval, func() {
+		err := s.RemoveExpiredWorkspaces(context.Background())
+		if err != nil {
+			log.Error(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
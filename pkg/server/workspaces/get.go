@@ -25,7 +25,8 @@ func (s *WorkspaceService) GetWorkspace(ctx context.Context, workspaceId string,
 		Workspace: *ws,
 	}
 
-	if !verbose {
+	// Adopted workspaces have no provider to report their info
+	if !verbose || ws.IsAdopted() {
 		return &response, nil
 	}
 
@@ -0,0 +1,156 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// controlServer manages the nodes of the Daytona network
+type controlServer interface {
+	// RenameNode renames the nodes registered with the hostname. Nodes that are not connected are skipped
+	RenameNode(hostname, newHostname string) error
+	// TakeOverHostname gives the hostname to the node that registered with the auth key once it registered and removes
+	// the other nodes registered with the hostname. Returns false if no node registered with the auth key yet
+	TakeOverHostname(hostname, authKey string) (bool, error)
+	// IsHostnameRegistered checks if a node of the network is registered with or named after the hostname
+	IsHostnameRegistered(hostname string) (bool, error)
+}
+
+type creatorNameContextKey struct{}
+
+// WithCreatorName sets the name of the identity creating a workspace, used for the {user} hostname placeholder
+func WithCreatorName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, creatorNameContextKey{}, name)
+}
+
+func getCreatorName(ctx context.Context) string {
+	name, _ := ctx.Value(creatorNameContextKey{}).(string)
+	return name
+}
+
+// assignProjectHostnames renders the hostname template for each project of the new workspace,
+// adding a numeric suffix to hostnames already used by other projects
+func (s *WorkspaceService) assignProjectHostnames(ctx context.Context, w *workspace.Workspace) error {
+	if s.hostnameTemplate == "" {
+		return nil
+	}
+
+	taken, err := s.getTakenHostnames()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range w.Projects {
+		// Routed projects are reached through the node of their gateway
+		if p.Route != nil {
+			continue
+		}
+
+		hostname, err := project.RenderHostname(s.hostnameTemplate, project.HostnameVars{
+			User:        getCreatorName(ctx),
+			Workspace:   w.Name,
+			WorkspaceId: w.Id,
+			Project:     p.Name,
+		})
+		if err != nil {
+			return err
+		}
+
+		p.Hostname = project.UniqueHostname(hostname, func(hostname string) bool {
+			return taken[hostname] || project.IsReservedHostname(hostname)
+		})
+		taken[p.Hostname] = true
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) SetProjectHostname(workspaceId, projectName, hostname string) (*workspace.Workspace, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := ws.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if hostname == "" || project.NormalizeHostname(hostname) != hostname {
+		return nil, ErrInvalidHostname
+	}
+
+	if project.IsReservedHostname(hostname) {
+		return nil, ErrHostnameReserved
+	}
+
+	if p.Route != nil {
+		return nil, ErrProjectRouted
+	}
+
+	oldHostname := p.GetHostname()
+	if hostname == oldHostname {
+		return ws, nil
+	}
+
+	taken, err := s.getTakenHostnames()
+	if err != nil {
+		return nil, err
+	}
+	if taken[hostname] {
+		return nil, ErrHostnameTaken
+	}
+
+	// Nodes of deleted projects, providers and other nodes of the network are not listed by the workspace store
+	if s.controlServer != nil {
+		registered, err := s.controlServer.IsHostnameRegistered(hostname)
+		if err != nil {
+			return nil, err
+		}
+		if registered {
+			return nil, ErrHostnameTaken
+		}
+	}
+
+	p.Hostname = hostname
+	setRoutedProjectHostnames(ws)
+
+	err = s.workspaceStore.Save(ws)
+	if err != nil {
+		return nil, err
+	}
+
+	// The agent registers with the new hostname when the project restarts. Until then, the node is renamed
+	// in the control plane so the new hostname resolves right away
+	if s.controlServer != nil && p.Networking != project.NetworkingAgentless {
+		err = s.controlServer.RenameNode(oldHostname, hostname)
+		if err != nil {
+			log.Errorf("failed to rename node %s to %s: %v", oldHostname, hostname, err)
+		}
+	}
+
+	return ws, nil
+}
+
+func (s *WorkspaceService) getTakenHostnames() (map[string]bool, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	taken := map[string]bool{}
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			taken[p.GetHostname()] = true
+		}
+	}
+
+	return taken, nil
+}
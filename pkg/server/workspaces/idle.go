@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+// StopIdleWorkspace stops the workspace on behalf of the agent of a project. Idleness is decided by the server from the
+// last activity the agents of the projects reported: the workspace is only stopped if the project reported activity
+// and no project of the workspace was used within the idle timeout of the server. The workspace is stopped in the
+// background since stopping it also stops the agent that asked for it
+func (s *WorkspaceService) StopIdleWorkspace(ctx context.Context, workspaceId string, projectName string) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := ws.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if ws.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
+	if s.idleTimeout <= 0 || p.State == nil || p.State.LastActivityAt == "" {
+		return ErrWorkspaceNotIdle
+	}
+
+	now := time.Now()
+	idle, err := getIdleTime(p, now)
+	if err != nil || idle < s.idleTimeout {
+		return ErrWorkspaceNotIdle
+	}
+
+	for _, p := range ws.Projects {
+		if p.Name == projectName || p.State == nil || p.State.Uptime == 0 || p.State.LastActivityAt == "" {
+			continue
+		}
+
+		projectIdle, err := getIdleTime(p, now)
+		if err == nil && projectIdle < s.idleTimeout {
+			return ErrWorkspaceNotIdle
+		}
+	}
+
+	if s.agentEventService != nil {
+		err = s.agentEventService.Record([]*agentevent.Event{{
+			WorkspaceId: ws.Id,
+			ProjectName: projectName,
+			Type:        agentevent.TypeIdleStop,
+			Message:     fmt.Sprintf("Stopping the workspace after the project was idle for %s", idle.Round(time.Second)),
+			OccurredAt:  now,
+		}})
+		if err != nil {
+			log.Errorf("failed to record idle stop of workspace %s: %v", ws.Id, err)
+		}
+	}
+
+	log.Infof("Stopping idle workspace %s", ws.Name)
+
+	go func() {
+		err := s.StopWorkspace(context.WithoutCancel(ctx), ws.Id)
+		if err != nil {
+			log.Errorf("failed to stop idle workspace %s: %v", ws.Name, err)
+		}
+	}()
+
+	return nil
+}
+
+func getIdleTime(p *project.Project, now time.Time) (time.Duration, error) {
+	lastActivityAt, err := time.Parse(time.RFC3339, p.State.LastActivityAt)
+	if err != nil {
+		return 0, err
+	}
+
+	return now.Sub(lastActivityAt), nil
+}
@@ -27,7 +27,7 @@ func (s *WorkspaceService) ListWorkspaces(ctx context.Context, verbose bool) ([]
 
 	for i, w := range workspaces {
 		response = append(response, dto.WorkspaceDTO{Workspace: *w})
-		if !verbose {
+		if !verbose || w.IsAdopted() {
 			continue
 		}
 
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/networkkey"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Time within which the node of a renewed network key must register to take over the hostname of the project.
+	// The current node of the project keeps its hostname otherwise
+	networkKeyHandoverTimeout = 10 * time.Minute
+	// Interval at which the server checks whether the node of a renewed network key registered
+	networkKeyHandoverInterval = 5 * time.Second
+)
+
+// RenewProjectNetworkKey issues a network key for the agent of the project to register its node, initially or again
+// before the node key expires. The current node of the project is only removed once the node registered with the key
+// joined, which then takes over its hostname instead of keeping a name with a random suffix
+func (s *WorkspaceService) RenewProjectNetworkKey(workspaceId string, projectName string, persistent bool) (*networkkey.NetworkKey, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := ws.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if p.Route != nil {
+		return nil, ErrProjectRouted
+	}
+
+	if s.networkKeyService == nil {
+		return nil, ErrNetworkKeysUnavailable
+	}
+
+	key, err := s.networkKeyService.Generate(networkkey.ScopeWorkspace, ws.Id, persistent)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.controlServer != nil {
+		go s.handOverHostname(p.GetHostname(), key.Key)
+	}
+
+	return key, nil
+}
+
+// handOverHostname waits for the node of the auth key to register and gives it the hostname of the project
+func (s *WorkspaceService) handOverHostname(hostname, authKey string) {
+	deadline := time.Now().Add(networkKeyHandoverTimeout)
+
+	for time.Now().Before(deadline) {
+		registered, err := s.controlServer.TakeOverHostname(hostname, authKey)
+		if err != nil {
+			log.Errorf("failed to hand over hostname %s to the renewed node: %v", hostname, err)
+		}
+		if registered {
+			return
+		}
+
+		time.Sleep(networkKeyHandoverInterval)
+	}
+
+	log.Debugf("node of the renewed network key did not register within %s, hostname %s is kept by the current node", networkKeyHandoverTimeout, hostname)
+}
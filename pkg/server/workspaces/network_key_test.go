@@ -0,0 +1,136 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces_test
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	t_networkkeys "github.com/daytonaio/daytona/internal/testing/server/networkkeys"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeControlServer issues sequential auth keys and hands hostnames over to the nodes of registered keys
+type fakeControlServer struct {
+	mutex        sync.Mutex
+	createdCount int
+	registered   []string
+	checked      []string
+	takenOver    map[string]string
+}
+
+func (s *fakeControlServer) CreateAuthKey(tags []string, expiresAt time.Time, ephemeral bool) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.createdCount++
+	return fmt.Sprintf("key-%d", s.createdCount), nil
+}
+
+func (s *fakeControlServer) ExpireAuthKey(key string) error {
+	return nil
+}
+
+func (s *fakeControlServer) DeleteNodes(authKeys []string, tags []string) error {
+	return nil
+}
+
+func (s *fakeControlServer) RenameNode(hostname, newHostname string) error {
+	return nil
+}
+
+func (s *fakeControlServer) TakeOverHostname(hostname, authKey string) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.checked = append(s.checked, authKey)
+	if !slices.Contains(s.registered, authKey) {
+		return false, nil
+	}
+
+	s.takenOver[hostname] = authKey
+	return true, nil
+}
+
+func (s *fakeControlServer) IsHostnameRegistered(hostname string) (bool, error) {
+	return false, nil
+}
+
+func (s *fakeControlServer) register(authKey string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.registered = append(s.registered, authKey)
+}
+
+func (s *fakeControlServer) wasChecked(authKey string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return slices.Contains(s.checked, authKey)
+}
+
+func (s *fakeControlServer) getTakenOver(hostname string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.takenOver[hostname]
+}
+
+func TestRenewProjectNetworkKey(t *testing.T) {
+	controlServer := &fakeControlServer{takenOver: map[string]string{}}
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	ws := &workspace.Workspace{
+		Id:   "ws1",
+		Name: "ws1",
+		Projects: []*project.Project{
+			{Name: "p1", WorkspaceId: "ws1"},
+		},
+	}
+	require.Nil(t, workspaceStore.Save(ws))
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		ControlServer:  controlServer,
+		NetworkKeyService: networkkeys.NewNetworkKeyService(networkkeys.NetworkKeyServiceConfig{
+			NetworkKeyStore: t_networkkeys.NewInMemoryNetworkKeyStore(),
+			ControlServer:   controlServer,
+		}),
+	})
+
+	hostname := ws.Projects[0].GetHostname()
+
+	t.Run("RenewProjectNetworkKey keeps the current node until the new node registered", func(t *testing.T) {
+		key, err := service.RenewProjectNetworkKey(ws.Id, "p1", false)
+		require.Nil(t, err)
+
+		require.Eventually(t, func() bool {
+			return controlServer.wasChecked(key.Key)
+		}, time.Second, 10*time.Millisecond)
+
+		require.Empty(t, controlServer.getTakenOver(hostname))
+	})
+
+	t.Run("RenewProjectNetworkKey hands the hostname over to the registered node", func(t *testing.T) {
+		// The fake issues sequential keys, so the node of the next key can register right away
+		controlServer.register("key-2")
+
+		key, err := service.RenewProjectNetworkKey(ws.Id, "p1", false)
+		require.Nil(t, err)
+		require.Equal(t, "key-2", key.Key)
+
+		require.Eventually(t, func() bool {
+			return controlServer.getTakenOver(hostname) == key.Key
+		}, time.Second, 10*time.Millisecond)
+	})
+}
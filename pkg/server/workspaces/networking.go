@@ -0,0 +1,50 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"net"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ForwardProjectPort opens a connection to a port of a project with agentless networking through its provider
+func (s *WorkspaceService) ForwardProjectPort(ctx context.Context, workspaceId, projectName string, port uint16) (net.Conn, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if p.Networking != project.NetworkingAgentless {
+		return nil, ErrProjectNotAgentless
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.provisioner.ForwardProjectPort(p, target, port)
+}
+
+// getProjectNetworking asks the provider how the project agent is reached, falling back to the tailnet
+func (s *WorkspaceService) getProjectNetworking(p *project.Project, target *provider.ProviderTarget) project.Networking {
+	networking, err := s.provisioner.GetProjectNetworking(p, target)
+	if err != nil || networking == "" {
+		// Providers built before agentless networking do not implement the call
+		log.Debugf("failed to get networking of project %s from provider %s, using the tailnet: %v", p.Name, target.ProviderInfo.Name, err)
+		return project.NetworkingTailnet
+	}
+
+	return networking
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectNetworking(t *testing.T) {
+	target := &provider.ProviderTarget{Name: "local", ProviderInfo: provider.ProviderInfo{Name: "test-provider"}}
+
+	tests := []struct {
+		name       string
+		networking project.Networking
+		err        error
+		expected   project.Networking
+	}{
+		{name: "provider networking is used", networking: project.NetworkingAgentless, expected: project.NetworkingAgentless},
+		{name: "empty networking falls back to the tailnet", expected: project.NetworkingTailnet},
+		{name: "providers without the call fall back to the tailnet", err: errors.New("method GetProjectNetworking not found"), expected: project.NetworkingTailnet},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &project.Project{Name: "p1"}
+
+			provisioner := mocks.NewMockProvisioner()
+			provisioner.On("GetProjectNetworking", p, target).Return(test.networking, test.err)
+
+			s := &WorkspaceService{provisioner: provisioner}
+
+			require.Equal(t, test.expected, s.getProjectNetworking(p, target))
+		})
+	}
+}
+
+func TestForwardProjectPort(t *testing.T) {
+	target := &provider.ProviderTarget{Name: "local"}
+	targetStore := t_targets.NewInMemoryTargetStore()
+	require.Nil(t, targetStore.Save(target))
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:     "ws1",
+		Name:   "ws1",
+		Target: "local",
+		Projects: []*project.Project{
+			{Name: "agentless", WorkspaceId: "ws1", Networking: project.NetworkingAgentless},
+			{Name: "tailnet", WorkspaceId: "ws1", Networking: project.NetworkingTailnet},
+		},
+	}))
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{
+		Id:     "ws2",
+		Name:   "ws2",
+		Target: "removed",
+		Projects: []*project.Project{
+			{Name: "agentless", WorkspaceId: "ws2", Networking: project.NetworkingAgentless},
+		},
+	}))
+
+	conn, _ := net.Pipe()
+	defer conn.Close()
+
+	provisioner := mocks.NewMockProvisioner()
+	provisioner.On("ForwardProjectPort", mock.Anything, target, uint16(2280)).Return(conn, nil)
+	provisioner.On("ForwardProjectPort", mock.Anything, target, uint16(22)).Return(conn, errors.New("port is not exposed"))
+
+	s := &WorkspaceService{
+		workspaceStore: workspaceStore,
+		targetStore:    targetStore,
+		provisioner:    provisioner,
+	}
+	ctx := context.Background()
+
+	t.Run("ForwardProjectPort returns the connection of the provider", func(t *testing.T) {
+		forwarded, err := s.ForwardProjectPort(ctx, "ws1", "agentless", 2280)
+		require.Nil(t, err)
+		require.Equal(t, conn, forwarded)
+	})
+
+	t.Run("ForwardProjectPort returns provider errors", func(t *testing.T) {
+		_, err := s.ForwardProjectPort(ctx, "ws1", "agentless", 22)
+		require.EqualError(t, err, "port is not exposed")
+	})
+
+	t.Run("ForwardProjectPort fails for tailnet projects", func(t *testing.T) {
+		_, err := s.ForwardProjectPort(ctx, "ws1", "tailnet", 2280)
+		require.Equal(t, ErrProjectNotAgentless, err)
+	})
+
+	t.Run("ForwardProjectPort fails for unknown workspaces and projects", func(t *testing.T) {
+		_, err := s.ForwardProjectPort(ctx, "unknown", "agentless", 2280)
+		require.Equal(t, ErrWorkspaceNotFound, err)
+
+		_, err = s.ForwardProjectPort(ctx, "ws1", "unknown", 2280)
+		require.Equal(t, ErrProjectNotFound, err)
+	})
+
+	t.Run("ForwardProjectPort fails without the target of the workspace", func(t *testing.T) {
+		_, err := s.ForwardProjectPort(ctx, "ws2", "agentless", 2280)
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// OperationType is an operation that changes the provider resources of a workspace
+type OperationType string
+
+const (
+	OperationCreate  OperationType = "create"
+	OperationStart   OperationType = "start"
+	OperationStop    OperationType = "stop"
+	OperationPause   OperationType = "pause"
+	OperationRebuild OperationType = "rebuild"
+	OperationDelete  OperationType = "delete"
+)
+
+// Operation is the operation a workspace is locked for. Only one operation runs on a workspace at a time
+type Operation struct {
+	Type OperationType
+	// ProjectName is empty for operations on the whole workspace
+	ProjectName string
+	StartedAt   time.Time
+}
+
+func (o Operation) String() string {
+	if o.ProjectName == "" {
+		return fmt.Sprintf("%s of the workspace, started at %s", o.Type, o.StartedAt.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("%s of project %s, started at %s", o.Type, o.ProjectName, o.StartedAt.Format(time.RFC3339))
+}
+
+// ErrOperationInProgress is returned, wrapped in an OperationInProgressError, if the workspace is locked by another operation
+var ErrOperationInProgress = common.WithErrorCode(errors.New("another operation is in progress on the workspace"), common.ErrorCodeOperationInProgress)
+
+// OperationInProgressError reports the operation that holds the lock of the workspace
+type OperationInProgressError struct {
+	Operation Operation
+}
+
+func (e *OperationInProgressError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrOperationInProgress, e.Operation)
+}
+
+func (e *OperationInProgressError) Unwrap() error {
+	return ErrOperationInProgress
+}
+
+func IsOperationInProgress(err error) bool {
+	return errors.Is(err, ErrOperationInProgress)
+}
+
+// operationLocks holds the operations that are running, keyed by workspace ID
+type operationLocks struct {
+	mutex      sync.Mutex
+	operations map[string]Operation
+}
+
+// lock locks the workspace for the operation. The returned function releases the lock
+func (l *operationLocks) lock(workspaceId string, operationType OperationType, projectName string) (func(), error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if current, ok := l.operations[workspaceId]; ok {
+		return nil, &OperationInProgressError{Operation: current}
+	}
+
+	if l.operations == nil {
+		l.operations = map[string]Operation{}
+	}
+
+	l.operations[workspaceId] = Operation{
+		Type:        operationType,
+		ProjectName: projectName,
+		StartedAt:   time.Now(),
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mutex.Lock()
+			defer l.mutex.Unlock()
+			delete(l.operations, workspaceId)
+		})
+	}, nil
+}
+
+// lockWorkspace finds the workspace by ID or name and locks it for the operation. The workspace is read again once
+// it is locked so that the operation does not act on the state from before the previous operation finished
+func (s *WorkspaceService) lockWorkspace(workspaceId string, operationType OperationType, projectName string) (*workspace.Workspace, func(), error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, nil, ErrWorkspaceNotFound
+	}
+
+	unlock, err := s.operations.lock(w.Id, operationType, projectName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w, err = s.workspaceStore.Find(w.Id)
+	if err != nil {
+		unlock()
+		return nil, nil, ErrWorkspaceNotFound
+	}
+
+	return w, unlock, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLocks(t *testing.T) {
+	var locks operationLocks
+
+	unlock, err := locks.lock("ws1", OperationCreate, "")
+	require.NoError(t, err)
+
+	_, err = locks.lock("ws1", OperationStop, "proj")
+	require.True(t, IsOperationInProgress(err))
+	require.ErrorContains(t, err, "create of the workspace")
+
+	var inProgressErr *OperationInProgressError
+	require.ErrorAs(t, err, &inProgressErr)
+	require.Equal(t, OperationCreate, inProgressErr.Operation.Type)
+
+	codedErr, ok := common.GetCodedError(fmt.Errorf("failed to stop workspace: %w", err))
+	require.True(t, ok)
+	require.Equal(t, common.ErrorCodeOperationInProgress, codedErr.Code)
+
+	// Other workspaces are not locked
+	unlockOther, err := locks.lock("ws2", OperationDelete, "")
+	require.NoError(t, err)
+	unlockOther()
+
+	unlock()
+	unlockDelete, err := locks.lock("ws1", OperationDelete, "")
+	require.NoError(t, err)
+
+	// Releasing twice does not release the lock of the next operation
+	unlock()
+
+	_, err = locks.lock("ws1", OperationStart, "")
+	require.True(t, IsOperationInProgress(err))
+	unlockDelete()
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) PauseWorkspace(ctx context.Context, workspaceId string) error {
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationPause, "")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if w.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	paused := false
+	for _, project := range w.Projects {
+		err := s.pauseProject(project, target)
+		if err != nil {
+			return err
+		}
+		paused = paused || project.Paused
+
+		// Saved after each project so that the projects paused before a failure are still resumed from their checkpoints
+		err = s.workspaceStore.Save(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The workspace resources are kept while a project is paused because its checkpoint is stored on them
+	if paused {
+		return nil
+	}
+
+	return s.provisioner.StopWorkspace(w, target)
+}
+
+func (s *WorkspaceService) PauseProject(ctx context.Context, workspaceId, projectName string) error {
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationPause, projectName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if w.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	err = s.pauseProject(project, target)
+	if err != nil {
+		return err
+	}
+
+	return s.workspaceStore.Save(w)
+}
+
+// pauseProject pauses the project or stops it if the provider can't pause projects or fails to checkpoint it
+func (s *WorkspaceService) pauseProject(p *project.Project, target *provider.ProviderTarget) error {
+	err := s.provisioner.PauseProject(p, target)
+	if err == nil {
+		p.Paused = true
+	} else {
+		if provider.IsPauseNotSupported(err) {
+			log.Infof("Provider %s can't pause project %s, stopping it", target.ProviderInfo.Name, p.Name)
+		} else {
+			log.Warnf("Failed to checkpoint project %s, stopping it: %v", p.Name, err)
+		}
+
+		err = s.provisioner.StopProject(p, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.State != nil {
+		p.State.Uptime = 0
+		p.State.UpdatedAt = time.Now().Format(time.RFC1123)
+	}
+
+	return nil
+}
+
+// resumeProject starts a paused project from its checkpoint. Returns false if the project has to be started anew,
+// e.g. because the checkpoint is gone. The project is no longer paused either way
+func (s *WorkspaceService) resumeProject(w *workspace.Workspace, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) bool {
+	err := s.provisioner.ResumeProject(p, target)
+
+	p.Paused = false
+	stored, findErr := w.GetProject(p.Name)
+	if findErr == nil {
+		stored.Paused = false
+	}
+	saveErr := s.workspaceStore.Save(w)
+	if saveErr != nil {
+		log.Errorf("failed to save project %s after resuming it: %v", p.Name, saveErr)
+	}
+
+	if err != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to resume project %s, starting it: %v\n", p.Name, err)))
+		return false
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Project %s resumed\n", p.Name)))
+	return true
+}
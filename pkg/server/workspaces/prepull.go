@@ -0,0 +1,172 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
+	log "github.com/sirupsen/logrus"
+)
+
+const imagePrePullPollInterval = "*/10 * * * * *"
+
+// Prebuilds published longer ago are not pre-pulled, e.g. the prebuilds published before pre-pulls were added
+const imagePrePullMaxAge = 24 * time.Hour
+
+// PrePullPrebuildImages pulls the images of prebuilds published after the given time onto the targets
+// of workspaces with projects from the same repository, or onto the default target if there are none.
+// Each image is pulled once so that the first project created from the prebuild does not wait for the pull.
+// Pulled builds are marked so that their images are not pulled again after a restart
+func (s *WorkspaceService) PrePullPrebuildImages(since time.Time) error {
+	builds, err := s.buildService.List(&build.Filter{
+		States: &[]build.BuildState{build.BuildStatePublished},
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, b := range builds {
+		if b.PrebuildId == "" || b.Image == nil || b.ImagePrePulledAt != nil || b.UpdatedAt.Before(since) {
+			continue
+		}
+
+		// Pulls of the previous poll may still be running
+		s.prePullingBuildsMutex.Lock()
+		prePulling := s.prePullingBuilds[b.Id]
+		s.prePullingBuilds[b.Id] = true
+		s.prePullingBuildsMutex.Unlock()
+
+		if prePulling {
+			continue
+		}
+
+		err := s.prePullImage(b)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to pre-pull image of build %s: %w", b.Id, err))
+		}
+
+		// Failed pulls are not retried, the image is pulled when the first project is created from it
+		err = s.buildService.MarkImagePrePulled(b.Id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to mark image of build %s as pre-pulled: %w", b.Id, err))
+		}
+
+		s.prePullingBuildsMutex.Lock()
+		delete(s.prePullingBuilds, b.Id)
+		s.prePullingBuildsMutex.Unlock()
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *WorkspaceService) prePullImage(b *build.Build) error {
+	targets, err := s.getPrebuildTargets(b)
+	if err != nil {
+		return err
+	}
+
+	cr, err := s.containerRegistryService.FindByImageName(*b.Image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	var errs []error
+
+	for _, target := range targets {
+		release, err := s.provisioning.acquire(context.Background(), target.Name, provisioningPriorityBackground)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+			continue
+		}
+
+		log.Debugf("Pre-pulling image %s onto target %s", *b.Image, target.Name)
+
+		err = s.provisioner.PullImage(*b.Image, target, cr)
+		release()
+		if provider.IsPullImageNotSupported(err) {
+			log.Debugf("Skipped pre-pulling image %s onto target %s: %v", *b.Image, target.Name, err)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("target %s: %w", target.Name, err))
+			continue
+		}
+
+		log.Infof("Pre-pulled image %s onto target %s", *b.Image, target.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+// getPrebuildTargets returns the targets of workspaces with projects from the repository of the build
+func (s *WorkspaceService) getPrebuildTargets(b *build.Build) ([]*provider.ProviderTarget, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	targetNames := map[string]bool{}
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			if p.Repository != nil && b.Repository != nil && p.Repository.Url == b.Repository.Url {
+				targetNames[w.Target] = true
+			}
+		}
+	}
+
+	targets := []*provider.ProviderTarget{}
+
+	if len(targetNames) == 0 {
+		target, err := s.targetStore.Find(&provider.TargetFilter{Default: util.Pointer(true)})
+		if err != nil {
+			if provider.IsTargetNotFound(err) {
+				return targets, nil
+			}
+			return nil, err
+		}
+
+		return append(targets, target), nil
+	}
+
+	for name := range targetNames {
+		target, err := s.targetStore.Find(&provider.TargetFilter{Name: &name})
+		if err != nil {
+			if provider.IsTargetNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+// StartImagePrePullPoller periodically pre-pulls the images of newly published prebuilds
+func (s *WorkspaceService) StartImagePrePullPoller() error {
+	scheduler := build.NewCronScheduler()
+
+	err := scheduler.AddFunc(imagePrePullPollInterval, func() {
+		err := s.PrePullPrebuildImages(time.Now().Add(-imagePrePullMaxAge))
+		if err != nil {
+			log.Warn(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scheduler.Start()
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces_test
+
+import (
+	"testing"
+	"time"
+
+	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrePullPrebuildImages(t *testing.T) {
+	defaultTarget := target
+	defaultTarget.IsDefault = true
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&defaultTarget)
+	require.Nil(t, err)
+
+	buildService := mocks.NewMockBuildService()
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	mockProvisioner := mocks.NewMockProvisioner()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           t_workspaces.NewInMemoryWorkspaceStore(),
+		TargetStore:              targetStore,
+		ContainerRegistryService: containerRegistryService,
+		BuildService:             buildService,
+		Provisioner:              mockProvisioner,
+	})
+
+	var containerRegistry *containerregistry.ContainerRegistry
+	containerRegistryService.On("FindByImageName", mock.Anything).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+
+	since := time.Now().Add(-time.Hour)
+
+	newBuild := func(id string) *build.Build {
+		return &build.Build{
+			Id:         id,
+			State:      build.BuildStatePublished,
+			Image:      util.Pointer("registry.example.com/prebuilds/" + id),
+			PrebuildId: "prebuild-" + id,
+			UpdatedAt:  time.Now(),
+		}
+	}
+
+	t.Run("Pulls the images of new prebuilds once", func(t *testing.T) {
+		published := newBuild("new")
+
+		prePulled := newBuild("pre-pulled")
+		prePulled.ImagePrePulledAt = util.Pointer(time.Now())
+
+		old := newBuild("old")
+		old.UpdatedAt = since.Add(-time.Minute)
+
+		buildService.On("List", mock.Anything).Return([]*build.Build{published, prePulled, old}, nil).Once()
+		mockProvisioner.On("PullImage", *published.Image, &defaultTarget, containerRegistry).Return(nil).Once()
+		buildService.On("MarkImagePrePulled", published.Id).Return(nil).Once()
+
+		err := service.PrePullPrebuildImages(since)
+		require.Nil(t, err)
+
+		mockProvisioner.AssertNumberOfCalls(t, "PullImage", 1)
+		buildService.AssertCalled(t, "MarkImagePrePulled", published.Id)
+	})
+
+	t.Run("Skips providers that can't pull images", func(t *testing.T) {
+		published := newBuild("unsupported")
+
+		buildService.On("List", mock.Anything).Return([]*build.Build{published}, nil).Once()
+		mockProvisioner.On("PullImage", *published.Image, &defaultTarget, containerRegistry).Return(provider.ErrPullImageNotSupported).Once()
+		buildService.On("MarkImagePrePulled", published.Id).Return(nil).Once()
+
+		err := service.PrePullPrebuildImages(since)
+		require.Nil(t, err)
+
+		buildService.AssertCalled(t, "MarkImagePrePulled", published.Id)
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/build"
+)
+
+// Provisioning jobs share the priority classes of builds. Creations and starts requested by users run before
+// background jobs such as the image pre-pulls of prebuilds
+const (
+	provisioningPriorityInteractive = build.BuildPriorityNormal
+	provisioningPriorityBackground  = build.BuildPriorityLow
+)
+
+// provisioningQueue limits the provider jobs that run on a target at the same time. Waiting jobs of a higher priority
+// are admitted first, jobs of the same priority in the order they arrived
+type provisioningQueue struct {
+	// Jobs that run on a target at the same time, unlimited if 0
+	limit   int
+	mutex   sync.Mutex
+	running map[string]int
+	waiting map[string][]*provisioningJob
+}
+
+type provisioningJob struct {
+	priority build.BuildPriority
+	admitted chan struct{}
+}
+
+// acquire waits until the job can run on the target. The returned function frees the slot of the job
+func (q *provisioningQueue) acquire(ctx context.Context, targetName string, priority build.BuildPriority) (func(), error) {
+	if q.limit <= 0 {
+		return func() {}, nil
+	}
+
+	q.mutex.Lock()
+
+	if q.running == nil {
+		q.running = map[string]int{}
+		q.waiting = map[string][]*provisioningJob{}
+	}
+
+	if q.running[targetName] < q.limit && len(q.waiting[targetName]) == 0 {
+		q.running[targetName]++
+		q.mutex.Unlock()
+		return q.releaseFunc(targetName), nil
+	}
+
+	job := &provisioningJob{
+		priority: priority,
+		admitted: make(chan struct{}),
+	}
+	q.waiting[targetName] = append(q.waiting[targetName], job)
+	q.mutex.Unlock()
+
+	select {
+	case <-job.admitted:
+		return q.releaseFunc(targetName), nil
+	case <-ctx.Done():
+		q.mutex.Lock()
+		defer q.mutex.Unlock()
+
+		select {
+		case <-job.admitted:
+			// Admitted while the context was canceled
+			q.release(targetName)
+		default:
+			q.waiting[targetName] = slices.DeleteFunc(q.waiting[targetName], func(j *provisioningJob) bool {
+				return j == job
+			})
+		}
+
+		return nil, ctx.Err()
+	}
+}
+
+func (q *provisioningQueue) releaseFunc(targetName string) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			q.mutex.Lock()
+			defer q.mutex.Unlock()
+			q.release(targetName)
+		})
+	}
+}
+
+// release frees a slot on the target and admits the waiting job of the highest priority. The mutex must be held
+func (q *provisioningQueue) release(targetName string) {
+	q.running[targetName]--
+
+	waiting := q.waiting[targetName]
+	if len(waiting) == 0 {
+		if q.running[targetName] <= 0 {
+			delete(q.running, targetName)
+			delete(q.waiting, targetName)
+		}
+		return
+	}
+
+	next := 0
+	for i, job := range waiting {
+		if job.priority.Rank() > waiting[next].priority.Rank() {
+			next = i
+		}
+	}
+
+	job := waiting[next]
+	q.waiting[targetName] = slices.Delete(waiting, next, next+1)
+	q.running[targetName]++
+	close(job.admitted)
+}
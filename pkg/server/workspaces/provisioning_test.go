@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvisioningQueue(t *testing.T) {
+	queue := provisioningQueue{limit: 1}
+	ctx := context.Background()
+
+	release, err := queue.acquire(ctx, "local", provisioningPriorityInteractive)
+	require.NoError(t, err)
+
+	// Other targets are not limited
+	releaseOther, err := queue.acquire(ctx, "remote", provisioningPriorityBackground)
+	require.NoError(t, err)
+	releaseOther()
+
+	admitted := make(chan string, 2)
+	wait := func(name string, priority build.BuildPriority) {
+		go func() {
+			release, err := queue.acquire(ctx, "local", priority)
+			if err != nil {
+				return
+			}
+			admitted <- name
+			release()
+		}()
+	}
+
+	wait("prepull", provisioningPriorityBackground)
+	require.Eventually(t, func() bool { return waitingJobs(&queue, "local") == 1 }, time.Second, time.Millisecond)
+	wait("start", provisioningPriorityInteractive)
+	require.Eventually(t, func() bool { return waitingJobs(&queue, "local") == 2 }, time.Second, time.Millisecond)
+
+	select {
+	case name := <-admitted:
+		t.Fatalf("%s was admitted over the limit", name)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Interactive jobs run before background jobs that waited longer
+	release()
+	require.Equal(t, "start", <-admitted)
+	require.Equal(t, "prepull", <-admitted)
+}
+
+func TestProvisioningQueueCanceled(t *testing.T) {
+	queue := provisioningQueue{limit: 1}
+
+	release, err := queue.acquire(context.Background(), "local", provisioningPriorityInteractive)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = queue.acquire(ctx, "local", provisioningPriorityInteractive)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 0, waitingJobs(&queue, "local"))
+
+	release()
+
+	release, err = queue.acquire(context.Background(), "local", provisioningPriorityBackground)
+	require.NoError(t, err)
+	release()
+}
+
+func waitingJobs(queue *provisioningQueue, targetName string) int {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	return len(queue.waiting[targetName])
+}
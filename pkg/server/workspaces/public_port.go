@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// RegisterPublicPort publishes a port of the project that a client forwards to its public URL. Ports that aren't
+// declared as public are remembered so that their preview DNS records are deleted with the workspace
+func (s *WorkspaceService) RegisterPublicPort(ctx context.Context, workspaceId, projectName string, port uint16) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if s.previewDnsService == nil {
+		return nil
+	}
+
+	declared := slices.ContainsFunc(p.Ports, func(declaredPort project.Port) bool {
+		return declaredPort.Port == port && declaredPort.Visibility == project.PortVisibilityPublic
+	})
+
+	if !declared && !slices.Contains(p.PublicPorts, port) {
+		p.PublicPorts = append(p.PublicPorts, port)
+
+		err = s.workspaceStore.Save(w)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.previewDnsService.CreatePortRecord(ctx, w, projectName, port)
+}
@@ -0,0 +1,263 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Time after which ready hooks are dropped if they do not set a timeout
+	defaultReadyHookTimeout = 10 * time.Minute
+	maxReadyHookTimeout     = time.Hour
+	// Most ready hooks a workspace can have pending at once
+	maxPendingReadyHooks = 10
+)
+
+// readyHookClient only connects to public addresses and does not follow redirects, so that ready hooks can not reach
+// the server host or its internal network. Addresses are checked once hostnames are resolved
+var readyHookClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: checkReadyHookAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func checkReadyHookAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !workspace.IsPublicReadyHookAddress(ip) {
+		return fmt.Errorf("ready hook address %s is not a public address", host)
+	}
+
+	return nil
+}
+
+// pendingReadyHooks counts the ready hooks of each workspace that wait for its projects to become ready
+type pendingReadyHooks struct {
+	mutex  sync.Mutex
+	counts map[string]int
+}
+
+// add returns false if the workspace has the maximum number of pending ready hooks
+func (h *pendingReadyHooks) add(workspaceId string) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if h.counts == nil {
+		h.counts = map[string]int{}
+	}
+
+	if h.counts[workspaceId] >= maxPendingReadyHooks {
+		return false
+	}
+	h.counts[workspaceId]++
+
+	return true
+}
+
+func (h *pendingReadyHooks) done(workspaceId string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.counts[workspaceId]--
+	if h.counts[workspaceId] <= 0 {
+		delete(h.counts, workspaceId)
+	}
+}
+
+// readinessWatchers wakes up the callers waiting for the projects of a workspace to become ready
+type readinessWatchers struct {
+	mutex sync.Mutex
+	// Channels closed on the next readiness change, keyed by workspace id
+	waiters map[string][]chan struct{}
+}
+
+func (r *readinessWatchers) subscribe(workspaceId string) chan struct{} {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.waiters == nil {
+		r.waiters = map[string][]chan struct{}{}
+	}
+
+	ch := make(chan struct{})
+	r.waiters[workspaceId] = append(r.waiters[workspaceId], ch)
+
+	return ch
+}
+
+func (r *readinessWatchers) unsubscribe(workspaceId string, ch chan struct{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	waiters := r.waiters[workspaceId]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			r.waiters[workspaceId] = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(r.waiters[workspaceId]) == 0 {
+		delete(r.waiters, workspaceId)
+	}
+}
+
+func (r *readinessWatchers) notify(workspaceId string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, ch := range r.waiters[workspaceId] {
+		close(ch)
+	}
+	delete(r.waiters, workspaceId)
+}
+
+// WaitForWorkspaceReady returns once the project, or every project of the workspace if projectName is empty, accepts
+// SSH connections or the context is done. The readiness is returned in both cases
+func (s *WorkspaceService) WaitForWorkspaceReady(ctx context.Context, workspaceId string, projectName string) (*workspace.Readiness, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+	id := ws.Id
+
+	for {
+		// Subscribed before the state is read so that a change in between is not missed
+		changed := s.readiness.subscribe(id)
+
+		ws, err = s.workspaceStore.Find(id)
+		if err != nil {
+			s.readiness.unsubscribe(id, changed)
+			return nil, ErrWorkspaceNotFound
+		}
+
+		if projectName != "" {
+			_, err = ws.GetProject(projectName)
+			if err != nil {
+				s.readiness.unsubscribe(id, changed)
+				return nil, ErrProjectNotFound
+			}
+		}
+
+		readiness := workspace.GetReadiness(ws, projectName)
+		if readiness.Ready {
+			s.readiness.unsubscribe(id, changed)
+			return &readiness, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			s.readiness.unsubscribe(id, changed)
+			return &readiness, nil
+		case <-changed:
+		}
+	}
+}
+
+// RegisterReadyHook posts the readiness of the workspace to the url of the hook once its projects are ready. The hook
+// is posted right away if they are ready already and dropped if they do not become ready within its timeout. Hooks
+// are only posted to public addresses and a workspace can only have a few hooks pending at once
+func (s *WorkspaceService) RegisterReadyHook(workspaceId string, hook workspace.ReadyHook) error {
+	err := hook.Validate()
+	if err != nil {
+		return err
+	}
+
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	if hook.ProjectName != "" {
+		_, err = ws.GetProject(hook.ProjectName)
+		if err != nil {
+			return ErrProjectNotFound
+		}
+	}
+
+	timeout := defaultReadyHookTimeout
+	if hook.TimeoutSeconds > 0 {
+		timeout = min(time.Duration(hook.TimeoutSeconds)*time.Second, maxReadyHookTimeout)
+	}
+
+	if !s.readyHooks.add(ws.Id) {
+		return ErrTooManyReadyHooks
+	}
+
+	go func() {
+		defer s.readyHooks.done(ws.Id)
+
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		readiness, err := s.WaitForWorkspaceReady(ctx, ws.Id, hook.ProjectName)
+		if err != nil {
+			log.Debugf("dropped ready hook of workspace %s: %v", ws.Id, err)
+			return
+		}
+
+		if !readiness.Ready {
+			log.Debugf("dropped ready hook of workspace %s: projects not ready within %s", ws.Id, timeout)
+			return
+		}
+
+		err = s.postReadyHook(hook.Url, readiness)
+		if err != nil {
+			log.Errorf("failed to post ready hook of workspace %s: %v", ws.Id, err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *WorkspaceService) postReadyHook(url string, readiness *workspace.Readiness) error {
+	body, err := json.Marshal(readiness)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := readyHookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("ready hook responded with %s", res.Status)
+	}
+
+	return nil
+}
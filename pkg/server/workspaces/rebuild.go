@@ -0,0 +1,126 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+)
+
+// RebuildProject recreates the project container from the current devcontainer configuration in the project
+// directory. The existing project volume is reattached, so uncommitted changes are kept.
+func (s *WorkspaceService) RebuildProject(ctx context.Context, workspaceId, projectName string) error {
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationRebuild, projectName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if w.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return err
+	}
+
+	err = s.validateProjectImage(p)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Rebuilding project %s\n", p.Name)))
+
+	err = s.provisioner.StopProject(p, target)
+	if err != nil {
+		return err
+	}
+
+	cr, err := s.containerRegistryService.FindByImageName(p.Image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	var gc *gitprovider.GitProviderConfig
+
+	if p.GitProviderConfigId != nil {
+		gc, err = s.gitProviderService.GetConfig(*p.GitProviderConfigId)
+		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
+			return err
+		}
+	}
+
+	sharedServiceEnvVars, err := s.getSharedServiceEnvVars(ctx, w.SharedServices, target)
+	if err != nil {
+		return err
+	}
+
+	err = s.ensureSshHostKey(w, p)
+	if err != nil {
+		return err
+	}
+
+	// Connection env vars of shared services are resolved again since attachments and service addresses can change
+	projectToRebuild := *p
+	projectToRebuild.EnvVars = maps.Clone(p.EnvVars)
+	if projectToRebuild.EnvVars == nil {
+		projectToRebuild.EnvVars = map[string]string{}
+	}
+	maps.Copy(projectToRebuild.EnvVars, sharedServiceEnvVars)
+
+	err = s.provisioner.RebuildProject(provisioner.ProjectParams{
+		Project:                       &projectToRebuild,
+		Target:                        target,
+		ContainerRegistry:             cr,
+		GitProviderConfig:             gc,
+		BuilderImage:                  s.builderImage,
+		BuilderImageContainerRegistry: builderCr,
+		CorrelationId:                 correlation.GetId(ctx),
+	})
+	if err != nil {
+		// Providers keep or restore the previous container if the rebuild fails, so the project is started again
+		projectLogger.Write([]byte(fmt.Sprintf("Failed to rebuild project %s: %s. Starting the previous container\n", p.Name, err)))
+
+		startErr := s.startProject(ctx, p, target, projectLogger)
+		if startErr != nil {
+			return errors.Join(err, startErr)
+		}
+		return err
+	}
+
+	// The state is reported again by the agent of the new container
+	p.State = nil
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Project %s rebuilt\n", p.Name)))
+
+	return s.startProject(ctx, p, target, projectLogger)
+}
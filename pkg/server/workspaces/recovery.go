@@ -0,0 +1,109 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/correlation"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// StartProjectRecovery starts a recovery container that shares the project directory so that
+// users can fix a project whose container or SSH server is broken without losing work
+func (s *WorkspaceService) StartProjectRecovery(ctx context.Context, workspaceId, projectName string) error {
+	w, p, target, err := s.getRecoveryProject(workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Starting recovery of project %s\n", p.Name)))
+
+	agentVersion, err := s.getAgentVersion(p.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	projectToRecover := *p
+	projectToRecover.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
+		ApiUrl:        s.serverApiUrl,
+		ServerUrl:     s.serverUrl,
+		ServerVersion: agentVersion,
+		ClientId:      telemetry.ClientId(ctx),
+		AgentLogLevel: s.agentLogLevel,
+	}, telemetry.TelemetryEnabled(ctx))
+
+	builderCr, err := s.containerRegistryService.FindByImageName(s.builderImage)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return err
+	}
+
+	err = s.provisioner.StartProjectRecovery(provisioner.ProjectParams{
+		Project:                       &projectToRecover,
+		Target:                        target,
+		BuilderImage:                  s.builderImage,
+		BuilderImageContainerRegistry: builderCr,
+		CorrelationId:                 correlation.GetId(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Recovery of project %s started\n", p.Name)))
+
+	return nil
+}
+
+// StopProjectRecovery removes the recovery container of the project
+func (s *WorkspaceService) StopProjectRecovery(ctx context.Context, workspaceId, projectName string) error {
+	w, p, target, err := s.getRecoveryProject(workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, p.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	err = s.provisioner.StopProjectRecovery(p, target)
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Recovery of project %s stopped\n", p.Name)))
+
+	return nil
+}
+
+func (s *WorkspaceService) getRecoveryProject(workspaceId, projectName string) (*workspace.Workspace, *project.Project, *provider.ProviderTarget, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, nil, nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, nil, nil, ErrProjectNotFound
+	}
+
+	if w.IsAdopted() || p.Networking == project.NetworkingAgentless {
+		return nil, nil, nil, ErrRecoveryNotSupported
+	}
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return w, p, target, nil
+}
@@ -8,35 +8,43 @@ import (
 	"fmt"
 
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/networkkey"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/telemetry"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	log "github.com/sirupsen/logrus"
 )
 
 func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	workspace, unlock, err := s.lockWorkspace(workspaceId, OperationDelete, "")
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
 	}
+	defer unlock()
 
 	log.Infof("Destroying workspace %s", workspace.Id)
 
-	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
-	if err != nil {
-		return err
-	}
+	var target *provider.ProviderTarget
 
-	for _, project := range workspace.Projects {
-		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+	// Adopted containers and VMs are left in place, only their registration is removed
+	if !workspace.IsAdopted() {
+		target, err = s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
 		if err != nil {
 			return err
 		}
-	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
-	if err != nil {
-		return err
+		for _, project := range workspace.Projects {
+			//	todo: go routines
+			err := s.provisioner.DestroyProject(project, target)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.provisioner.DestroyWorkspace(workspace, target)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Should not fail the whole operation if the API key cannot be revoked
@@ -45,6 +53,11 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 		log.Error(err)
 	}
 
+	s.revokeNetworkKeys(workspace.Id)
+	s.deleteEvents(workspace.Id)
+	s.deleteUsage(workspace.Id)
+	s.deletePreviewRecords(ctx, workspace)
+
 	for _, project := range workspace.Projects {
 		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
 		if err != nil {
@@ -90,33 +103,43 @@ func (s *WorkspaceService) RemoveWorkspace(ctx context.Context, workspaceId stri
 
 // ForceRemoveWorkspace ignores provider errors and makes sure the workspace is removed from storage.
 func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	workspace, unlock, err := s.lockWorkspace(workspaceId, OperationDelete, "")
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
 	}
+	defer unlock()
 
 	log.Infof("Destroying workspace %s", workspace.Id)
 
-	target, _ := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+	var target *provider.ProviderTarget
 
-	for _, project := range workspace.Projects {
-		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+	if !workspace.IsAdopted() {
+		target, _ = s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
+
+		for _, project := range workspace.Projects {
+			//	todo: go routines
+			err := s.provisioner.DestroyProject(project, target)
+			if err != nil {
+				log.Error(err)
+			}
+		}
+
+		err = s.provisioner.DestroyWorkspace(workspace, target)
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
-	if err != nil {
-		log.Error(err)
-	}
-
 	err = s.apiKeyService.Revoke(workspace.Id)
 	if err != nil {
 		log.Error(err)
 	}
 
+	s.revokeNetworkKeys(workspace.Id)
+	s.deleteEvents(workspace.Id)
+	s.deleteUsage(workspace.Id)
+	s.deletePreviewRecords(ctx, workspace)
+
 	for _, project := range workspace.Projects {
 		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
 		if err != nil {
@@ -151,3 +174,29 @@ func (s *WorkspaceService) ForceRemoveWorkspace(ctx context.Context, workspaceId
 
 	return err
 }
+
+// revokeNetworkKeys removes the nodes of the workspace from the Daytona network so leaked keys cannot be reused.
+// Persistent nodes are only removed here since the control server keeps them while they are offline
+func (s *WorkspaceService) revokeNetworkKeys(workspaceId string) {
+	if s.networkKeyService == nil {
+		return
+	}
+
+	err := s.networkKeyService.RevokeScope(networkkey.ScopeWorkspace, workspaceId)
+	if err != nil {
+		log.Errorf("failed to revoke network keys of workspace %s: %v", workspaceId, err)
+	}
+}
+
+// deletePreviewRecords deletes the DNS records of the public ports of the workspace. Records that can't be deleted
+// are left behind rather than failing the removal
+func (s *WorkspaceService) deletePreviewRecords(ctx context.Context, ws *workspace.Workspace) {
+	if s.previewDnsService == nil {
+		return
+	}
+
+	err := s.previewDnsService.DeleteWorkspaceRecords(ctx, ws)
+	if err != nil {
+		log.Errorf("failed to delete preview DNS records of workspace %s: %v", ws.Id, err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// GetProjectRoutes returns the routing table of the tailnet node of the project
+func (s *WorkspaceService) GetProjectRoutes(workspaceId, projectName string) ([]project.RoutingEntry, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	return project.GetRoutingTable(p.Name, w.Projects), nil
+}
+
+// setRoutedProjectHostnames points the hostnames of routed projects at the tailnet node of their gateway
+func setRoutedProjectHostnames(w *workspace.Workspace) {
+	for _, p := range w.Projects {
+		if p.Route == nil {
+			continue
+		}
+
+		gateway, err := w.GetProject(p.Route.Gateway)
+		if err == nil {
+			p.Hostname = gateway.GetHostname()
+		}
+	}
+}
+
+// isGateway returns true if other projects of the workspace are routed through the tailnet node of the project
+func isGateway(w *workspace.Workspace, projectName string) bool {
+	for _, p := range w.Projects {
+		if p.Route != nil && p.Route.Gateway == projectName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isGatewayOnTailnet returns false if the gateway uses other networking than the tailnet, e.g. because its
+// provider does not allow a mesh network. Projects are not routed through such gateways
+func isGatewayOnTailnet(w *workspace.Workspace, gatewayName string) bool {
+	gateway, err := w.GetProject(gatewayName)
+	return err == nil && gateway.Networking == project.NetworkingTailnet
+}
+
+// validateRouteAddress checks the container address reported by the agent of a project. Only routed projects report
+// an address, and it may not be the address of another project of the workspace
+func validateRouteAddress(w *workspace.Workspace, p *project.Project, address string) error {
+	if address == "" {
+		return nil
+	}
+
+	if p.Route == nil {
+		return fmt.Errorf("%w: project %s is not routed through another project", project.ErrInvalidRouteAddress, p.Name)
+	}
+
+	err := project.ValidateRouteAddress(address)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range w.Projects {
+		if other.Name != p.Name && other.State != nil && other.State.Address == address {
+			return fmt.Errorf("%w: %s is the address of project %s", project.ErrInvalidRouteAddress, address, other.Name)
+		}
+	}
+
+	return nil
+}
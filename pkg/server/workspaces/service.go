@@ -7,15 +7,33 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
 
+	"github.com/daytonaio/daytona/pkg/agentevent"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/imagepolicy"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/networkkey"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/daytonaio/daytona/pkg/server/agentevents"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/creationtimings"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/networkkeys"
+	"github.com/daytonaio/daytona/pkg/server/organizations"
+	"github.com/daytonaio/daytona/pkg/server/previewdns"
 	"github.com/daytonaio/daytona/pkg/server/projectconfig"
+	resourceusage_service "github.com/daytonaio/daytona/pkg/server/resourceusage"
+	"github.com/daytonaio/daytona/pkg/server/rollouts"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
+	"github.com/daytonaio/daytona/pkg/server/tailnetca"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/telemetry"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -24,17 +42,88 @@ import (
 
 type IWorkspaceService interface {
 	CreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.Workspace, error)
+	// ValidateCreateWorkspace checks the creation request without provisioning any resources
+	ValidateCreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.CreationValidation, error)
+	AdoptWorkspace(ctx context.Context, req dto.AdoptWorkspaceDTO) (*workspace.Workspace, error)
 	GetWorkspace(ctx context.Context, workspaceId string, verbose bool) (*dto.WorkspaceDTO, error)
+	DiffWorkspaces(workspaceIdA string, workspaceIdB string) (*workspace.WorkspaceDiff, error)
 	GetWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
 	ListWorkspaces(ctx context.Context, verbose bool) ([]dto.WorkspaceDTO, error)
 	RemoveWorkspace(ctx context.Context, workspaceId string) error
 	ForceRemoveWorkspace(ctx context.Context, workspaceId string) error
 	SetProjectState(workspaceId string, projectName string, state *project.ProjectState) (*workspace.Workspace, error)
+	UpdateWorkspaceAnnotations(workspaceId string, set map[string]string, remove []string) (*workspace.Workspace, error)
+	UpdateProjectAnnotations(workspaceId string, projectName string, set map[string]string, remove []string) (*workspace.Workspace, error)
+	// SetProjectHostname renames the project agent on the tailnet
+	SetProjectHostname(workspaceId string, projectName string, hostname string) (*workspace.Workspace, error)
+	// GetProjectRoutes returns the routing table of the tailnet node of the project
+	GetProjectRoutes(workspaceId string, projectName string) ([]project.RoutingEntry, error)
+	// ListServiceEndpoints returns the services registered by the agents of running projects
+	ListServiceEndpoints(ctx context.Context, workspaceId string) ([]project.ServiceEndpoint, error)
+	// ResolveService resolves the name of a service to its tailnet address, preferring the workspace of the caller
+	ResolveService(ctx context.Context, name string, callerWorkspaceId string) (*project.ServiceEndpoint, error)
+	// GetProjectAccessPolicy returns the policy the project agent enforces on connections from the tailnet
+	GetProjectAccessPolicy(workspaceId string, projectName string) (*project.PortAccessPolicy, error)
+	SetProjectAccessPolicy(workspaceId string, projectName string, policy project.PortAccessPolicy) (*workspace.Workspace, error)
+	// GetProjectBandwidthLimit returns the limit the project agent enforces on the traffic it proxies from and to the tailnet
+	GetProjectBandwidthLimit(workspaceId string, projectName string) (*project.BandwidthLimit, error)
+	SetWorkspaceBandwidthLimit(workspaceId string, limit project.BandwidthLimit) (*workspace.Workspace, error)
+	// RegisterPublicPort publishes a port of the project that a client forwards to its public URL
+	RegisterPublicPort(ctx context.Context, workspaceId string, projectName string, port uint16) error
+	// CreateSshToken issues a short-lived token the client authenticates with on the tailnet node of the project
+	CreateSshToken(ctx context.Context, workspaceId string, projectName string, apiKeyName string, serverAdmin bool) (*dto.SshTokenDTO, error)
+	// VerifySshAccess checks the token an SSH client presents to the project agent on the tailnet
+	VerifySshAccess(workspaceId string, projectName string, token string) error
+	// GetProjectSshHostKey returns the private SSH host key the agent of the project serves SSH with
+	GetProjectSshHostKey(workspaceId string, projectName string) (string, error)
+	// GetProjectAgentVersion returns the version the running agent of the project should update itself to
+	GetProjectAgentVersion(workspaceId string, projectName string) (string, error)
+	// RenewProjectNetworkKey issues a network key for the agent of the project to register its node, initially or again
+	// before the node key expires. The current node is removed once the new node registered
+	RenewProjectNetworkKey(workspaceId string, projectName string, persistent bool) (*networkkey.NetworkKey, error)
+	// IssueProjectCertificate signs a certificate for the tailnet name of the project, which the agent of the project
+	// serves its TLS ports with
+	IssueProjectCertificate(workspaceId string, projectName string, csr string) (*tailnetca.Certificate, error)
+	// PauseProject checkpoints the processes of the project and stops it. Falls back to stopping the project if the
+	// provider can't pause it or checkpointing it fails
+	PauseProject(ctx context.Context, workspaceId string, projectName string) error
+	PauseWorkspace(ctx context.Context, workspaceId string) error
+	RebuildProject(ctx context.Context, workspaceId string, projectName string) error
 	StartProject(ctx context.Context, workspaceId string, projectName string) error
+	StartProjectRecovery(ctx context.Context, workspaceId string, projectName string) error
 	StartWorkspace(ctx context.Context, workspaceId string) error
 	StopProject(ctx context.Context, workspaceId string, projectName string) error
+	StopProjectRecovery(ctx context.Context, workspaceId string, projectName string) error
 	StopWorkspace(ctx context.Context, workspaceId string) error
+	// WaitForWorkspaceReady returns once the projects accept SSH connections or the context is done
+	WaitForWorkspaceReady(ctx context.Context, workspaceId string, projectName string) (*workspace.Readiness, error)
+	RegisterReadyHook(workspaceId string, hook workspace.ReadyHook) error
+	// StopIdleWorkspace stops the workspace at the request of the agent of a project if no project of the workspace
+	// reported activity within the idle timeout
+	StopIdleWorkspace(ctx context.Context, workspaceId string, projectName string) error
+	StartExpiryPoller() error
+	PreviewCleanup() ([]workspace.CleanupCandidate, error)
+	ApplyCleanupPolicies(ctx context.Context) error
+	StartCleanupPoller() error
+	// PrePullPrebuildImages pulls the images of prebuilds published after the given time onto the targets that use them
+	PrePullPrebuildImages(since time.Time) error
+	StartImagePrePullPoller() error
+	// RefreshBranchStatuses fetches the upstream branches of the running projects so that their git status reports
+	// how many commits they are behind
+	RefreshBranchStatuses(ctx context.Context) error
+	StartBranchStatusPoller() error
+	RecordProjectCreationTimings(workspaceId string, projectName string, durations []creationtiming.PhaseDuration) error
+	RecordProjectEvents(workspaceId string, projectName string, reports []agentevent.Report) error
+	ListWorkspaceEvents(workspaceId string, filter agentevent.Filter) ([]*agentevent.Event, error)
+	RecordProjectUsage(workspaceId string, projectName string, reports []resourceusage.Report) error
+	ListWorkspaceUsage(workspaceId string, filter resourceusage.Filter) ([]*resourceusage.Sample, error)
+	ForwardProjectPort(ctx context.Context, workspaceId string, projectName string, port uint16) (net.Conn, error)
+	// GetTargetAllocation compares the resources reserved and used by the projects of the target with its capacity
+	GetTargetAllocation(targetName string) (*provider.TargetAllocation, error)
+	// GetCreationDemand returns the workspaces being created on the target and the workspaces refused since
+	// the given time because the target was at capacity
+	GetCreationDemand(targetName string, since time.Time) provider.CreationDemand
 }
 
 type targetStore interface {
@@ -50,17 +139,66 @@ type WorkspaceServiceConfig struct {
 	ServerApiUrl             string
 	ServerUrl                string
 	ServerVersion            string
+	DaytonaDownloadUrl       string
 	Provisioner              provisioner.IProvisioner
 	DefaultProjectImage      string
 	DefaultProjectUser       string
 	BuilderImage             string
-	ApiKeyService            apikeys.IApiKeyService
-	LoggerFactory            logs.LoggerFactory
-	GitProviderService       gitproviders.IGitProviderService
-	TelemetryService         telemetry.TelemetryService
+	ImagePolicy              imagepolicy.IImagePolicy
+	CleanupPolicies          []workspace.CleanupPolicy
+	// Template of the tailnet hostnames of new projects, e.g. "{user}-{workspace}-{project}".
+	// Hostnames are derived from the workspace ID and project name if empty
+	HostnameTemplate string
+	// Multiple of the capacity of a target host that projects can reserve. Defaults to 1
+	OvercommitRatio float64
+	// Time without activity after which project agents stop their workspace. Never stopped if 0
+	IdleTimeout time.Duration
+	// Host paths that projects can bind mount. Bind mounts are refused if empty
+	AllowedBindMountPaths []string
+	// Provider jobs, e.g. creations and image pre-pulls, that run on a target at the same time. Unlimited if 0
+	MaxConcurrentProvisioningJobs int
+	// Log level of project agents, passed as AGENT_LOG_LEVEL. Agents log at info if empty
+	AgentLogLevel string
+	// ControlServer renames the nodes of projects whose hostname changes. Nodes are renamed when they reconnect if nil
+	ControlServer controlServer
+	// Returns a client that reaches project agents over the tailnet. Branch statuses are not refreshed if nil
+	GetTailnetHttpClient func() *http.Client
+	ApiKeyService        apikeys.IApiKeyService
+	OrganizationService  organizations.IOrganizationService
+	RolloutService       rollouts.IRolloutService
+	// SharedServiceService resolves the connection env vars of the shared services workspaces are attached to
+	SharedServiceService sharedservices.ISharedServiceService
+	// CreationTimingService records the duration of each workspace creation phase. Timings are not recorded if nil
+	CreationTimingService creationtimings.ICreationTimingService
+	// AgentEventService records the lifecycle and network events of project agents. Events are dropped if nil
+	AgentEventService agentevents.IAgentEventService
+	// ResourceUsageService records the resources used by projects. Samples are dropped and cleanup policies can't
+	// stop idle workspaces if nil
+	ResourceUsageService resourceusage_service.IResourceUsageService
+	// NetworkKeyService revokes the network keys of removed workspaces. Keys are not revoked if nil
+	NetworkKeyService networkkeys.INetworkKeyService
+	// PreviewDnsService manages the DNS records of the public ports of workspaces. Records are not managed if nil
+	PreviewDnsService previewdns.IPreviewDnsService
+	// TailnetCa signs the certificates of the TLS ports of projects. Certificates are not issued if nil
+	TailnetCa *tailnetca.CertificateAuthority
+	// AgentInstaller installs the agent on adopted workspaces. Defaults to installing over docker exec or SSH
+	AgentInstaller     AgentInstaller
+	LoggerFactory      logs.LoggerFactory
+	GitProviderService gitproviders.IGitProviderService
+	TelemetryService   telemetry.TelemetryService
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
+	agentInstaller := config.AgentInstaller
+	if agentInstaller == nil {
+		agentInstaller = NewAgentInstaller()
+	}
+
+	overcommitRatio := config.OvercommitRatio
+	if overcommitRatio <= 0 {
+		overcommitRatio = defaultOvercommitRatio
+	}
+
 	return &WorkspaceService{
 		workspaceStore:           config.WorkspaceStore,
 		targetStore:              config.TargetStore,
@@ -70,14 +208,39 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		serverApiUrl:             config.ServerApiUrl,
 		serverUrl:                config.ServerUrl,
 		serverVersion:            config.ServerVersion,
+		daytonaDownloadUrl:       config.DaytonaDownloadUrl,
+		agentInstaller:           agentInstaller,
 		defaultProjectImage:      config.DefaultProjectImage,
 		defaultProjectUser:       config.DefaultProjectUser,
 		provisioner:              config.Provisioner,
 		loggerFactory:            config.LoggerFactory,
 		apiKeyService:            config.ApiKeyService,
+		organizationService:      config.OrganizationService,
+		rolloutService:           config.RolloutService,
+		sharedServiceService:     config.SharedServiceService,
+		creationTimingService:    config.CreationTimingService,
+		agentEventService:        config.AgentEventService,
+		resourceUsageService:     config.ResourceUsageService,
+		networkKeyService:        config.NetworkKeyService,
+		previewDnsService:        config.PreviewDnsService,
+		agentBootStarts:          map[string]time.Time{},
+		prePullingBuilds:         map[string]bool{},
+		creationsInFlight:        map[string]int{},
+		refusedCreations:         map[string][]time.Time{},
 		gitProviderService:       config.GitProviderService,
 		telemetryService:         config.TelemetryService,
 		builderImage:             config.BuilderImage,
+		imagePolicy:              config.ImagePolicy,
+		cleanupPolicies:          config.CleanupPolicies,
+		hostnameTemplate:         config.HostnameTemplate,
+		overcommitRatio:          overcommitRatio,
+		idleTimeout:              config.IdleTimeout,
+		allowedBindMountPaths:    config.AllowedBindMountPaths,
+		provisioning:             provisioningQueue{limit: config.MaxConcurrentProvisioningJobs},
+		agentLogLevel:            config.AgentLogLevel,
+		controlServer:            config.ControlServer,
+		getTailnetHttpClient:     config.GetTailnetHttpClient,
+		tailnetCa:                config.TailnetCa,
 	}
 }
 
@@ -89,15 +252,91 @@ type WorkspaceService struct {
 	projectConfigService     projectconfig.IProjectConfigService
 	provisioner              provisioner.IProvisioner
 	apiKeyService            apikeys.IApiKeyService
+	organizationService      organizations.IOrganizationService
+	rolloutService           rollouts.IRolloutService
+	sharedServiceService     sharedservices.ISharedServiceService
+	creationTimingService    creationtimings.ICreationTimingService
+	agentEventService        agentevents.IAgentEventService
+	resourceUsageService     resourceusage_service.IResourceUsageService
+	networkKeyService        networkkeys.INetworkKeyService
+	previewDnsService        previewdns.IPreviewDnsService
 	serverApiUrl             string
 	serverUrl                string
 	serverVersion            string
+	daytonaDownloadUrl       string
+	agentInstaller           AgentInstaller
 	defaultProjectImage      string
 	defaultProjectUser       string
 	builderImage             string
+	imagePolicy              imagepolicy.IImagePolicy
+	cleanupPolicies          []workspace.CleanupPolicy
+	hostnameTemplate         string
+	overcommitRatio          float64
+	idleTimeout              time.Duration
+	allowedBindMountPaths    []string
+	agentLogLevel            string
+	controlServer            controlServer
+	getTailnetHttpClient     func() *http.Client
+	tailnetCa                *tailnetca.CertificateAuthority
 	loggerFactory            logs.LoggerFactory
 	gitProviderService       gitproviders.IGitProviderService
 	telemetryService         telemetry.TelemetryService
+
+	// Start of the agent boot phase of newly created projects, keyed by workspace id and project name
+	agentBootStarts      map[string]time.Time
+	agentBootStartsMutex sync.Mutex
+
+	// Builds whose image has been pre-pulled onto the targets, keyed by build id
+	prePullingBuilds      map[string]bool
+	prePullingBuildsMutex sync.Mutex
+
+	// Workspaces being created and times workspaces were refused for capacity, keyed by target name
+	creationsInFlight   map[string]int
+	refusedCreations    map[string][]time.Time
+	creationDemandMutex sync.Mutex
+
+	// Held from checking the capacity of a target until the workspace reserving it is saved
+	capacityMutex sync.Mutex
+
+	// Operations that change the provider resources of workspaces, one at a time per workspace
+	operations operationLocks
+
+	// Provider jobs waiting for or running on each target
+	provisioning provisioningQueue
+
+	// Callers waiting for the projects of workspaces to become ready
+	readiness readinessWatchers
+
+	// Ready hooks waiting for the projects of workspaces to become ready
+	readyHooks pendingReadyHooks
+
+	// SSH tokens issued to clients, accepted by the agents of the projects on the tailnet
+	sshTokens sshTokens
+}
+
+// getAgentVersion returns the agent version that the workspace's projects should download when they start
+func (s *WorkspaceService) getAgentVersion(workspaceId string) (string, error) {
+	if s.rolloutService == nil {
+		return s.serverVersion, nil
+	}
+
+	return s.rolloutService.GetAgentVersion(workspaceId)
+}
+
+// GetProjectAgentVersion returns the agent version of the workspace, which running agents of older versions update
+// themselves to
+func (s *WorkspaceService) GetProjectAgentVersion(workspaceId, projectName string) (string, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return "", ErrWorkspaceNotFound
+	}
+
+	_, err = ws.GetProject(projectName)
+	if err != nil {
+		return "", ErrProjectNotFound
+	}
+
+	return s.getAgentVersion(ws.Id)
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *project.ProjectState) (*workspace.Workspace, error) {
@@ -108,8 +347,23 @@ func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, stat
 
 	for _, project := range ws.Projects {
 		if project.Name == projectName {
+			err = validateRouteAddress(ws, project, state.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			wasReady := workspace.GetReadiness(ws, projectName).Ready
+
+			s.mergeDetectedPorts(ws, project, state)
 			project.State = state
-			return ws, s.workspaceStore.Save(ws)
+			s.recordAgentBoot(ws, projectName)
+
+			err = s.workspaceStore.Save(ws)
+			if err == nil && !wasReady && workspace.GetReadiness(ws, projectName).Ready {
+				s.readiness.notify(ws.Id)
+			}
+
+			return ws, err
 		}
 	}
 
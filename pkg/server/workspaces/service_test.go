@@ -5,7 +5,12 @@ package workspaces_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -17,6 +22,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/organization"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
@@ -113,6 +119,8 @@ func TestWorkspaceService(t *testing.T) {
 	wsLogsDir := t.TempDir()
 	buildLogsDir := t.TempDir()
 
+	agentInstaller := &testAgentInstaller{}
+
 	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 		WorkspaceStore:           workspaceStore,
 		TargetStore:              targetStore,
@@ -128,6 +136,7 @@ func TestWorkspaceService(t *testing.T) {
 		Provisioner:              mockProvisioner,
 		LoggerFactory:            logs.NewLoggerFactory(&wsLogsDir, &buildLogsDir),
 		GitProviderService:       gitProviderService,
+		AgentInstaller:           agentInstaller,
 	})
 
 	t.Run("CreateWorkspace", func(t *testing.T) {
@@ -137,6 +146,7 @@ func TestWorkspaceService(t *testing.T) {
 
 		mockProvisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
 		mockProvisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("GetProjectNetworking", mock.Anything, &target).Return(project.NetworkingTailnet, nil)
 
 		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceDto.Id).Return(createWorkspaceDto.Id, nil)
 		gitProviderService.On("GetLastCommitSha", createWorkspaceDto.Projects[0].Source.Repository).Return("123", nil)
@@ -155,6 +165,7 @@ func TestWorkspaceService(t *testing.T) {
 			GitProviderConfigId: createWorkspaceDto.Projects[0].GitProviderConfigId,
 			WorkspaceId:         createWorkspaceDto.Id,
 			Target:              createWorkspaceDto.Target,
+			Networking:          project.NetworkingTailnet,
 		}
 
 		proj.EnvVars = project.GetProjectEnvVars(proj, project.ProjectEnvVarParams{
@@ -164,22 +175,22 @@ func TestWorkspaceService(t *testing.T) {
 			ClientId:      "test",
 		}, false)
 
-		mockProvisioner.On("CreateProject", provisioner.ProjectParams{
+		mockProvisioner.On("CreateProject", withSshHostKey(provisioner.ProjectParams{
 			Project:                       proj,
 			Target:                        &target,
 			ContainerRegistry:             containerRegistry,
 			GitProviderConfig:             &gitProviderConfig,
 			BuilderImage:                  defaultProjectImage,
 			BuilderImageContainerRegistry: containerRegistry,
-		}).Return(nil)
-		mockProvisioner.On("StartProject", provisioner.ProjectParams{
+		})).Return(nil)
+		mockProvisioner.On("StartProject", withSshHostKey(provisioner.ProjectParams{
 			Project:                       proj,
 			Target:                        &target,
 			ContainerRegistry:             containerRegistry,
 			GitProviderConfig:             &gitProviderConfig,
 			BuilderImage:                  defaultProjectImage,
 			BuilderImageContainerRegistry: containerRegistry,
-		}).Return(nil)
+		})).Return(nil)
 
 		gitProviderService.On("GetConfig", "github").Return(&gitProviderConfig, nil)
 
@@ -189,6 +200,12 @@ func TestWorkspaceService(t *testing.T) {
 		require.NotNil(t, workspace)
 
 		workspaceEquals(t, createWorkspaceDto, workspace, defaultProjectImage)
+		require.NotEmpty(t, workspace.Projects[0].SshHostKey)
+		require.NotEmpty(t, workspace.Projects[0].SshHostPublicKey)
+
+		hostKey, err := service.GetProjectSshHostKey(workspace.Id, workspace.Projects[0].Name)
+		require.Nil(t, err)
+		require.Equal(t, workspace.Projects[0].SshHostKey, hostKey)
 	})
 
 	t.Run("CreateWorkspace fails when workspace already exists", func(t *testing.T) {
@@ -206,6 +223,83 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails ttl validation", func(t *testing.T) {
+		invalidWorkspaceRequest := createWorkspaceDto
+		invalidWorkspaceRequest.Name = "ttl-test"
+		invalidWorkspaceRequest.Ttl = util.Pointer("-1h")
+
+		_, err := service.CreateWorkspace(ctx, invalidWorkspaceRequest)
+		require.NotNil(t, err)
+		require.Equal(t, workspaces.ErrInvalidWorkspaceTtl, err)
+	})
+
+	t.Run("CreateWorkspace fails on bind mounts of host paths that are not allowed", func(t *testing.T) {
+		mountWorkspaceRequest := createWorkspaceDto
+		mountWorkspaceRequest.Id = "mount-test"
+		mountWorkspaceRequest.Name = "mount-test"
+		mountWorkspaceRequest.Projects = slices.Clone(createWorkspaceDto.Projects)
+		mountWorkspaceRequest.Projects[0].Mounts = []project.Mount{{Type: project.MountTypeBind, Source: "/var/run/docker.sock", Target: "/var/run/docker.sock"}}
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, "mount-test").Return("mount-test", nil).Once()
+
+		_, err := service.CreateWorkspace(ctx, mountWorkspaceRequest)
+		require.ErrorIs(t, err, project.ErrBindMountNotAllowed)
+
+		_, err = service.GetWorkspace(ctx, "mount-test", false)
+		require.True(t, workspaces.IsWorkspaceNotFound(err))
+	})
+
+	t.Run("CreateWorkspace fails on the target of another organization", func(t *testing.T) {
+		organizationWorkspaceRequest := createWorkspaceDto
+		organizationWorkspaceRequest.Id = "organization-test"
+		organizationWorkspaceRequest.Name = "organization-test"
+
+		_, err := service.CreateWorkspace(organization.WithOrganizationId(ctx, "other-organization"), organizationWorkspaceRequest)
+		require.True(t, provider.IsTargetNotFound(err))
+
+		_, err = service.GetWorkspace(ctx, "organization-test", false)
+		require.True(t, workspaces.IsWorkspaceNotFound(err))
+	})
+
+	t.Run("CreateWorkspace fails when target is overcommitted", func(t *testing.T) {
+		overcommittedWorkspaceRequest := createWorkspaceDto
+		overcommittedWorkspaceRequest.Id = "overcommit-test"
+		overcommittedWorkspaceRequest.Name = "overcommit-test"
+		overcommittedWorkspaceRequest.Projects = []dto.CreateProjectDTO{createWorkspaceDto.Projects[0]}
+		overcommittedWorkspaceRequest.Projects[0].Resources = &project.Resources{Cpus: 4, Memory: 2048}
+
+		mockProvisioner.On("GetTargetCapacity", &target).Return(&project.Resources{Cpus: 2, Memory: 8192}, nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, "overcommit-test").Return("overcommit-test", nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, "overcommit-test/project1").Return("project1", nil)
+
+		_, err := service.CreateWorkspace(ctx, overcommittedWorkspaceRequest)
+		require.True(t, workspaces.IsTargetOvercommitted(err))
+	})
+
+	t.Run("ValidateCreateWorkspace", func(t *testing.T) {
+		validateRequest := createWorkspaceDto
+		validateRequest.Ttl = util.Pointer("-1h")
+		validateRequest.Projects = []dto.CreateProjectDTO{createWorkspaceDto.Projects[0]}
+		validateRequest.Projects[0].Source.Repository = &gitprovider.GitRepository{
+			Url:    "file:///nonexistent/repository",
+			Branch: "main",
+		}
+
+		validation, err := service.ValidateCreateWorkspace(ctx, validateRequest)
+		require.Nil(t, err)
+		require.False(t, validation.Valid)
+
+		checks := []workspace.ValidationCheck{}
+		for _, issue := range validation.Issues {
+			require.Equal(t, workspace.ValidationSeverityError, issue.Severity)
+			checks = append(checks, issue.Check)
+		}
+		require.Equal(t, []workspace.ValidationCheck{workspace.ValidationCheckWorkspace, workspace.ValidationCheckWorkspace, workspace.ValidationCheckRepository}, checks)
+
+		require.Len(t, validation.Estimates, 1)
+		require.Nil(t, validation.Estimates[0].CreationMs)
+	})
+
 	t.Run("GetWorkspace", func(t *testing.T) {
 		mockProvisioner.On("GetWorkspaceInfo", mock.Anything, mock.Anything, &target).Return(&workspaceInfo, nil)
 
@@ -287,6 +381,107 @@ func TestWorkspaceService(t *testing.T) {
 		require.Nil(t, err)
 	})
 
+	t.Run("PauseProject resumes the project on start", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+		mockProvisioner.On("PauseProject", mock.Anything, &target).Return(nil).Once()
+		mockProvisioner.On("ResumeProject", mock.Anything, &target).Return(nil).Once()
+
+		err := service.PauseProject(ctx, createWorkspaceDto.Id, projectName)
+		require.Nil(t, err)
+
+		ws, err := service.GetWorkspace(ctx, createWorkspaceDto.Id, false)
+		require.Nil(t, err)
+		require.True(t, ws.Projects[0].Paused)
+
+		err = service.StartProject(ctx, createWorkspaceDto.Id, projectName)
+		require.Nil(t, err)
+
+		ws, err = service.GetWorkspace(ctx, createWorkspaceDto.Id, false)
+		require.Nil(t, err)
+		require.False(t, ws.Projects[0].Paused)
+		mockProvisioner.AssertCalled(t, "ResumeProject", mock.Anything, &target)
+	})
+
+	t.Run("PauseWorkspace stops projects if the provider can't pause them", func(t *testing.T) {
+		mockProvisioner.On("PauseProject", mock.Anything, &target).Return(provider.ErrPauseNotSupported).Once()
+		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopWorkspace", mock.Anything, &target).Return(nil)
+
+		err := service.PauseWorkspace(ctx, createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		ws, err := service.GetWorkspace(ctx, createWorkspaceDto.Id, false)
+		require.Nil(t, err)
+		require.False(t, ws.Projects[0].Paused)
+	})
+
+	t.Run("PauseWorkspace stops projects that fail to checkpoint", func(t *testing.T) {
+		mockProvisioner.On("PauseProject", mock.Anything, &target).Return(errors.New("checkpoint failed")).Once()
+		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("StopWorkspace", mock.Anything, &target).Return(nil)
+
+		err := service.PauseWorkspace(ctx, createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		ws, err := service.GetWorkspace(ctx, createWorkspaceDto.Id, false)
+		require.Nil(t, err)
+		require.False(t, ws.Projects[0].Paused)
+	})
+
+	t.Run("RebuildProject", func(t *testing.T) {
+		mockProvisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		mockProvisioner.On("RebuildProject", mock.Anything).Return(nil)
+		mockProvisioner.On("StartProject", mock.Anything).Return(nil)
+
+		err := service.RebuildProject(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name)
+
+		require.Nil(t, err)
+	})
+
+	t.Run("RebuildProject fails when project not found", func(t *testing.T) {
+		err := service.RebuildProject(ctx, createWorkspaceDto.Id, "invalid-project")
+
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("VerifySshAccess", func(t *testing.T) {
+		projectName := createWorkspaceDto.Projects[0].Name
+
+		_, err := service.CreateSshToken(ctx, createWorkspaceDto.Id, projectName, "client", false)
+		require.True(t, workspaces.IsSshAccessDenied(err))
+
+		_, err = service.CreateSshToken(ctx, createWorkspaceDto.Id, "unknown", "admin", true)
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+
+		token, err := service.CreateSshToken(ctx, createWorkspaceDto.Id, projectName, "admin", true)
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(workspaces.SshTokenTtl), token.ExpiresAt, time.Minute)
+
+		require.Nil(t, service.VerifySshAccess(createWorkspaceDto.Id, projectName, token.Token))
+		require.True(t, workspaces.IsSshAccessDenied(service.VerifySshAccess(createWorkspaceDto.Id, projectName, "api-key")))
+		require.True(t, workspaces.IsSshAccessDenied(service.VerifySshAccess(createWorkspaceDto.Id, projectName, "")))
+		require.Equal(t, workspaces.ErrProjectNotFound, service.VerifySshAccess(createWorkspaceDto.Id, "unknown", token.Token))
+	})
+
+	t.Run("ForwardProjectPort fails for tailnet projects", func(t *testing.T) {
+		_, err := service.ForwardProjectPort(ctx, createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, 2222)
+
+		require.Equal(t, workspaces.ErrProjectNotAgentless, err)
+	})
+
+	t.Run("RenewProjectNetworkKey fails without network keys", func(t *testing.T) {
+		_, err := service.RenewProjectNetworkKey(createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, false)
+		require.Equal(t, workspaces.ErrNetworkKeysUnavailable, err)
+
+		_, err = service.RenewProjectNetworkKey(createWorkspaceDto.Id, "unknown", false)
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+	})
+
+	t.Run("IssueProjectCertificate fails without a tailnet CA", func(t *testing.T) {
+		_, err := service.IssueProjectCertificate(createWorkspaceDto.Id, createWorkspaceDto.Projects[0].Name, "csr")
+		require.Equal(t, workspaces.ErrCertificatesUnavailable, err)
+	})
+
 	t.Run("RemoveWorkspace", func(t *testing.T) {
 		mockProvisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
 		mockProvisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
@@ -336,12 +531,356 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
 	})
 
+	t.Run("UpdateProjectAnnotations", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+		_, err = service.UpdateProjectAnnotations(ws.Id, projectName, map[string]string{
+			"ci.company.com/run-id":   "123",
+			"ci.company.com/pipeline": "main",
+		}, nil)
+		require.Nil(t, err)
+
+		res, err := service.UpdateProjectAnnotations(ws.Id, projectName, nil, []string{"ci.company.com/pipeline"})
+		require.Nil(t, err)
+
+		project, err := res.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, map[string]string{"ci.company.com/run-id": "123"}, project.Annotations)
+
+		_, err = service.UpdateProjectAnnotations(ws.Id, projectName, map[string]string{"run-id": "123"}, nil)
+		require.ErrorIs(t, err, workspace.ErrInvalidAnnotationKey)
+
+		_, err = service.UpdateWorkspaceAnnotations(ws.Id, map[string]string{"daytona.io/owner": "ci"}, nil)
+		require.ErrorIs(t, err, workspace.ErrReservedAnnotationKey)
+	})
+
+	t.Run("SetProjectHostname", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		_, err = service.SetProjectHostname(ws.Id, projectName, "Dev API")
+		require.Equal(t, workspaces.ErrInvalidHostname, err)
+
+		res, err := service.SetProjectHostname(ws.Id, projectName, "dev-api")
+		require.Nil(t, err)
+
+		project, err := res.GetProject(projectName)
+		require.Nil(t, err)
+		require.Equal(t, "dev-api", project.GetHostname())
+	})
+
+	t.Run("StopIdleWorkspace fails when a project is in use", func(t *testing.T) {
+		idleService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+			WorkspaceStore: workspaceStore,
+			TargetStore:    targetStore,
+			IdleTimeout:    time.Hour,
+		})
+
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		err = idleService.StopIdleWorkspace(ctx, ws.Id, "unknown")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+
+		// The project has not reported any activity
+		err = idleService.StopIdleWorkspace(ctx, ws.Id, projectName)
+		require.Equal(t, workspaces.ErrWorkspaceNotIdle, err)
+
+		projects := ws.Projects
+		idleProject := *ws.Projects[0]
+		idleProject.State = &project.ProjectState{
+			Uptime:         10,
+			LastActivityAt: time.Now().Add(-10 * time.Minute).Format(time.RFC3339),
+		}
+		ws.Projects = []*project.Project{&idleProject}
+		require.Nil(t, workspaceStore.Save(ws))
+
+		err = idleService.StopIdleWorkspace(ctx, ws.Id, projectName)
+		require.Equal(t, workspaces.ErrWorkspaceNotIdle, err)
+
+		idleProject.State.LastActivityAt = time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+		ws.Projects = append(ws.Projects, &project.Project{
+			Name:        "project2",
+			WorkspaceId: ws.Id,
+			State: &project.ProjectState{
+				Uptime:         10,
+				LastActivityAt: time.Now().Add(-10 * time.Minute).Format(time.RFC3339),
+			},
+		})
+		require.Nil(t, workspaceStore.Save(ws))
+
+		err = idleService.StopIdleWorkspace(ctx, ws.Id, projectName)
+		require.Equal(t, workspaces.ErrWorkspaceNotIdle, err)
+
+		ws.Projects = projects
+		require.Nil(t, workspaceStore.Save(ws))
+	})
+
+	t.Run("RegisterReadyHook", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		err = service.RegisterReadyHook(ws.Id, workspace.ReadyHook{Url: "http://169.254.169.254/latest/meta-data"})
+		require.ErrorIs(t, err, workspace.ErrInvalidReadyHookUrl)
+
+		for i := 0; i < 10; i++ {
+			require.Nil(t, service.RegisterReadyHook(ws.Id, workspace.ReadyHook{Url: "https://ide.example.com/ready", TimeoutSeconds: 1}))
+		}
+
+		err = service.RegisterReadyHook(ws.Id, workspace.ReadyHook{Url: "https://ide.example.com/ready", TimeoutSeconds: 1})
+		require.Equal(t, workspaces.ErrTooManyReadyHooks, err)
+	})
+
+	t.Run("WaitForWorkspaceReady", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		_, err = service.WaitForWorkspaceReady(ctx, ws.Id, "unknown")
+		require.Equal(t, workspaces.ErrProjectNotFound, err)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		readiness, err := service.WaitForWorkspaceReady(timeoutCtx, ws.Id, projectName)
+		require.Nil(t, err)
+		require.False(t, readiness.Ready)
+		require.Equal(t, []string{projectName}, readiness.PendingProjects)
+
+		_, err = service.SetProjectState(ws.Id, projectName, &project.ProjectState{Uptime: 10, Ready: true})
+		require.Nil(t, err)
+
+		readiness, err = service.WaitForWorkspaceReady(ctx, ws.Id, projectName)
+		require.Nil(t, err)
+		require.True(t, readiness.Ready)
+		require.Equal(t, []string{projectName}, readiness.ReadyProjects)
+	})
+
+	t.Run("SetProjectAccessPolicy", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		_, err = service.SetProjectAccessPolicy(ws.Id, projectName, project.PortAccessPolicy{
+			Rules: []project.PortAccessRule{{Action: "block"}},
+		})
+		require.True(t, workspaces.IsInvalidAccessPolicy(err))
+
+		policy := project.PortAccessPolicy{
+			Rules: []project.PortAccessRule{
+				{Action: project.PortAccessActionAllow, PortRangeStart: 3000, Peers: []string{"cli-*"}},
+			},
+			DefaultAction: project.PortAccessActionDeny,
+		}
+
+		_, err = service.SetProjectAccessPolicy(ws.Id, projectName, policy)
+		require.Nil(t, err)
+
+		res, err := service.GetProjectAccessPolicy(ws.Id, projectName)
+		require.Nil(t, err)
+		require.Equal(t, &policy, res)
+
+		_, err = service.SetProjectAccessPolicy(ws.Id, projectName, project.PortAccessPolicy{})
+		require.Nil(t, err)
+
+		res, err = service.GetProjectAccessPolicy(ws.Id, projectName)
+		require.Nil(t, err)
+		require.Empty(t, res.Rules)
+
+		action, _ := res.Check(3000, "server")
+		require.Equal(t, project.PortAccessActionAllow, action)
+	})
+
+	t.Run("SetWorkspaceBandwidthLimit", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		target.BandwidthLimit = &project.BandwidthLimit{IngressBytesPerSecond: 1000, EgressBytesPerSecond: 2000}
+		defer func() {
+			target.BandwidthLimit = nil
+		}()
+
+		res, err := service.GetProjectBandwidthLimit(ws.Id, projectName)
+		require.Nil(t, err)
+		require.Equal(t, target.BandwidthLimit, res)
+
+		_, err = service.SetWorkspaceBandwidthLimit(ws.Id, project.BandwidthLimit{EgressBytesPerSecond: 500})
+		require.Nil(t, err)
+
+		res, err = service.GetProjectBandwidthLimit(ws.Id, projectName)
+		require.Nil(t, err)
+		require.Equal(t, &project.BandwidthLimit{IngressBytesPerSecond: 1000, EgressBytesPerSecond: 500}, res)
+
+		updated, err := service.SetWorkspaceBandwidthLimit(ws.Id, project.BandwidthLimit{})
+		require.Nil(t, err)
+		require.Nil(t, updated.BandwidthLimit)
+
+		_, err = service.GetProjectBandwidthLimit(ws.Id, "unknown")
+		require.True(t, workspaces.IsProjectNotFound(err))
+	})
+
+	t.Run("ResolveService", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceDto.Id)
+		require.Nil(t, err)
+
+		projectName := ws.Projects[0].Name
+
+		_, err = service.SetProjectState(ws.Id, projectName, &project.ProjectState{
+			UpdatedAt: time.Now().Format(time.RFC1123),
+			Uptime:    10,
+			Services:  []project.Service{{Name: "api", Port: 8080, Protocol: project.ServiceProtocolTcp}},
+		})
+		require.Nil(t, err)
+
+		endpoints, err := service.ListServiceEndpoints(ctx, ws.Id)
+		require.Nil(t, err)
+		require.Len(t, endpoints, 1)
+		require.Equal(t, "api-project1.test.daytona.internal", endpoints[0].Name)
+
+		endpoint, err := service.ResolveService(ctx, "api-project1.daytona.internal", ws.Id)
+		require.Nil(t, err)
+		require.Equal(t, "dev-api:8080", endpoint.Address)
+		require.Equal(t, uint16(8080), endpoint.Port)
+
+		endpoint, err = service.ResolveService(ctx, "API-project1.test.daytona.internal.", "")
+		require.Nil(t, err)
+		require.Equal(t, "api", endpoint.Service)
+
+		_, err = service.ResolveService(ctx, "web-project1.daytona.internal", ws.Id)
+		require.ErrorIs(t, err, project.ErrServiceNotFound)
+
+		// Services of other organizations are not listed or resolved
+		otherOrganizationCtx := organization.WithOrganizationId(ctx, "other-organization")
+
+		endpoints, err = service.ListServiceEndpoints(otherOrganizationCtx, "")
+		require.Nil(t, err)
+		require.Empty(t, endpoints)
+
+		_, err = service.ListServiceEndpoints(otherOrganizationCtx, ws.Id)
+		require.True(t, workspaces.IsWorkspaceNotFound(err))
+
+		_, err = service.ResolveService(otherOrganizationCtx, "api-project1.test.daytona.internal", "")
+		require.ErrorIs(t, err, project.ErrServiceNotFound)
+
+		// Services of projects whose agent stopped reporting are not resolved
+		_, err = service.SetProjectState(ws.Id, projectName, &project.ProjectState{
+			UpdatedAt: time.Now().Add(-time.Hour).Format(time.RFC1123),
+			Uptime:    10,
+			Services:  []project.Service{{Name: "api", Port: 8080, Protocol: project.ServiceProtocolTcp}},
+		})
+		require.Nil(t, err)
+
+		_, err = service.ResolveService(ctx, "api-project1.daytona.internal", ws.Id)
+		require.ErrorIs(t, err, project.ErrServiceNotFound)
+	})
+
+	t.Run("AdoptWorkspace", func(t *testing.T) {
+		_, err := service.AdoptWorkspace(ctx, dto.AdoptWorkspaceDTO{Id: "adopted", Name: "adopted", ProjectName: "project", Type: workspace.AdoptionTypeDocker})
+		require.Equal(t, workspaces.ErrInvalidAdoption, err)
+
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, "adopted").Return("adopted", nil)
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, "adopted/project").Return("adopted-project", nil)
+
+		ws, err := service.AdoptWorkspace(ctx, dto.AdoptWorkspaceDTO{
+			Id:          "adopted",
+			Name:        "adopted",
+			ProjectName: "project",
+			Type:        workspace.AdoptionTypeDocker,
+			ContainerId: "dev-server",
+		})
+		require.Nil(t, err)
+		require.True(t, ws.IsAdopted())
+		require.Equal(t, "root", ws.Adoption.User)
+		require.Equal(t, "dev-server", agentInstaller.adoption.ContainerId)
+		require.Contains(t, agentInstaller.script, "export DAYTONA_SERVER_API_KEY='adopted-project'")
+		require.Contains(t, agentInstaller.script, "nohup daytona agent")
+
+		err = service.StopWorkspace(ctx, ws.Id)
+		require.Equal(t, workspaces.ErrAdoptedWorkspaceNotManaged, err)
+
+		err = service.RemoveWorkspace(ctx, ws.Id)
+		require.Nil(t, err)
+	})
+
+	t.Run("AdoptWorkspace over SSH", func(t *testing.T) {
+		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, "adopted-ssh").Return("adopted-ssh", nil).Once()
+		apiKeyService.On("Generate", apikey.ApiKeyTypeProject, "adopted-ssh/project").Return("adopted-ssh-project", nil).Once()
+
+		ws, err := service.AdoptWorkspace(ctx, dto.AdoptWorkspaceDTO{
+			Id:          "adopted-ssh",
+			Name:        "adopted-ssh",
+			ProjectName: "project",
+			Type:        workspace.AdoptionTypeSsh,
+			Host:        "dev.example.com",
+			User:        "dev",
+			Password:    util.Pointer("secret"),
+		})
+		require.Nil(t, err)
+		require.Equal(t, "dev.example.com", agentInstaller.adoption.Host)
+		require.Contains(t, agentInstaller.script, "export DAYTONA_SERVER_API_KEY='adopted-ssh-project'")
+		require.Contains(t, agentInstaller.script, "export DAYTONA_PROJECT_USER='dev'")
+		require.Contains(t, agentInstaller.script, "$SUDO daytona agent install")
+		require.NotContains(t, agentInstaller.script, "agent service install")
+		// The API key is read from the environment and never becomes part of a command line
+		require.Contains(t, agentInstaller.script, "-H @-")
+		require.NotContains(t, agentInstaller.script, "Bearer adopted-ssh-project")
+
+		err = service.StartWorkspace(ctx, ws.Id)
+		require.Equal(t, workspaces.ErrAdoptedWorkspaceNotManaged, err)
+
+		err = service.RemoveWorkspace(ctx, ws.Id)
+		require.Nil(t, err)
+	})
+
 	t.Cleanup(func() {
 		apiKeyService.AssertExpectations(t)
 		mockProvisioner.AssertExpectations(t)
 	})
 }
 
+type testAgentInstaller struct {
+	adoption *workspace.Adoption
+	script   string
+}
+
+func (i *testAgentInstaller) InstallAgent(adoption *workspace.Adoption, credentials workspaces.AdoptionCredentials, script string, logWriter io.Writer) error {
+	i.adoption = adoption
+	i.script = script
+	return nil
+}
+
+// withSshHostKey matches the project params expected by the mock provisioner regardless of the generated SSH host key
+// of the project, which must not be passed to the agent in its env vars
+func withSshHostKey(expected provisioner.ProjectParams) interface{} {
+	return mock.MatchedBy(func(params provisioner.ProjectParams) bool {
+		if params.Project == nil || params.Project.SshHostKey == "" {
+			return false
+		}
+		if slices.ContainsFunc(slices.Collect(maps.Values(params.Project.EnvVars)), func(value string) bool {
+			return value == params.Project.SshHostKey
+		}) {
+			return false
+		}
+
+		p := *params.Project
+		p.SshHostKey = ""
+		p.SshHostPublicKey = ""
+		params.Project = &p
+
+		return reflect.DeepEqual(expected, params)
+	})
+}
+
 func workspaceEquals(t *testing.T, req dto.CreateWorkspaceDTO, workspace *workspace.Workspace, projectImage string) {
 	t.Helper()
 
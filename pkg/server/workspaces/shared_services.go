@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+func (s *WorkspaceService) getSharedServiceEnvVars(ctx context.Context, names []string, target *provider.ProviderTarget) (map[string]string, error) {
+	if len(names) == 0 || s.sharedServiceService == nil {
+		return map[string]string{}, nil
+	}
+
+	return s.sharedServiceService.GetConnectionEnvVars(ctx, target, names)
+}
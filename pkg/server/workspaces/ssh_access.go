@@ -0,0 +1,141 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/daytonaio/daytona/pkg/common"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// SshTokenTtl is how long an SSH token is accepted by the agent of the project after it is issued
+const SshTokenTtl = 5 * time.Minute
+
+var ErrSshAccessDenied = common.WithErrorCode(errors.New("the API key does not grant SSH access to the project"), common.ErrorCodeForbidden)
+
+func IsSshAccessDenied(err error) bool {
+	return errors.Is(err, ErrSshAccessDenied)
+}
+
+// sshTokenGrant is the project an SSH token was issued for. Tokens are only kept in memory, keyed by their hash
+type sshTokenGrant struct {
+	workspaceId string
+	projectName string
+	apiKeyName  string
+	expiresAt   time.Time
+}
+
+type sshTokens struct {
+	mutex  sync.Mutex
+	grants map[string]sshTokenGrant
+}
+
+func (t *sshTokens) add(token string, grant sshTokenGrant, now time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.grants == nil {
+		t.grants = map[string]sshTokenGrant{}
+	}
+
+	for hash, g := range t.grants {
+		if !now.Before(g.expiresAt) {
+			delete(t.grants, hash)
+		}
+	}
+
+	t.grants[apikeys.HashKey(token)] = grant
+}
+
+func (t *sshTokens) get(token string, now time.Time) (sshTokenGrant, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	grant, ok := t.grants[apikeys.HashKey(token)]
+	return grant, ok && now.Before(grant.expiresAt)
+}
+
+// CreateSshToken issues a short-lived token that grants the client SSH access to the project through its agent on
+// the tailnet. The workspace must belong to the organization the request is scoped to. Workspaces outside of
+// organizations are only accessible to server admins
+func (s *WorkspaceService) CreateSshToken(ctx context.Context, workspaceId, projectName, apiKeyName string, serverAdmin bool) (*dto.SshTokenDTO, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	if !s.canAccessSsh(w, apiKeyName, serverAdmin) || w.OrganizationId != organization.GetOrganizationId(ctx) {
+		return nil, ErrSshAccessDenied
+	}
+
+	now := time.Now()
+	token := &dto.SshTokenDTO{
+		Token:     apikeys.GenerateRandomKey(),
+		ExpiresAt: now.Add(SshTokenTtl),
+	}
+
+	s.sshTokens.add(token.Token, sshTokenGrant{
+		workspaceId: w.Id,
+		projectName: projectName,
+		apiKeyName:  apiKeyName,
+		expiresAt:   token.ExpiresAt,
+	}, now)
+
+	return token, nil
+}
+
+// VerifySshAccess checks the token an SSH client presents to the agent of the project on the tailnet. Only unexpired
+// tokens issued for the project are accepted, and only while the client that requested them can still access the
+// workspace
+func (s *WorkspaceService) VerifySshAccess(workspaceId, projectName, token string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	_, err = w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	grant, ok := s.sshTokens.get(token, time.Now())
+	if token == "" || !ok || grant.workspaceId != w.Id || grant.projectName != projectName {
+		return ErrSshAccessDenied
+	}
+
+	if w.OrganizationId == "" {
+		return nil
+	}
+
+	if !s.canAccessSsh(w, grant.apiKeyName, false) {
+		return ErrSshAccessDenied
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) canAccessSsh(w *workspace.Workspace, apiKeyName string, serverAdmin bool) bool {
+	if w.OrganizationId == "" || s.organizationService == nil {
+		return serverAdmin
+	}
+
+	o, err := s.organizationService.Find(w.OrganizationId)
+	if err != nil {
+		return false
+	}
+
+	return o.HasMember(apiKeyName)
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+var ErrSshHostKeyNotFound = errors.New("the project has no ssh host key")
+
+func IsSshHostKeyNotFound(err error) bool {
+	return errors.Is(err, ErrSshHostKeyNotFound)
+}
+
+// setSshHostKey generates the SSH host key of the project if it has none. The key is kept with the project so that
+// its agent presents the same host key across restarts and rebuilds
+func setSshHostKey(p *project.Project) error {
+	if p.SshHostKey != "" {
+		return nil
+	}
+
+	privateKey, publicKey, err := workspace.GenerateSshHostKey()
+	if err != nil {
+		return err
+	}
+
+	p.SshHostKey = privateKey
+	p.SshHostPublicKey = publicKey
+
+	return nil
+}
+
+// ensureSshHostKey generates and saves the SSH host key of projects created before the server managed host keys
+func (s *WorkspaceService) ensureSshHostKey(w *workspace.Workspace, p *project.Project) error {
+	if p.SshHostKey != "" {
+		return nil
+	}
+
+	err := setSshHostKey(p)
+	if err != nil {
+		return err
+	}
+
+	return s.workspaceStore.Save(w)
+}
+
+// GetProjectSshHostKey returns the private SSH host key the agent of the project serves SSH with
+func (s *WorkspaceService) GetProjectSshHostKey(workspaceId, projectName string) (string, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return "", ErrWorkspaceNotFound
+	}
+
+	p, err := w.GetProject(projectName)
+	if err != nil {
+		return "", ErrProjectNotFound
+	}
+
+	if p.SshHostKey == "" {
+		return "", ErrSshHostKeyNotFound
+	}
+
+	return p.SshHostKey, nil
+}
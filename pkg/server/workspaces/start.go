@@ -9,6 +9,7 @@ import (
 	"io"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/correlation"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
@@ -22,9 +23,14 @@ import (
 )
 
 func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId string) error {
-	w, err := s.workspaceStore.Find(workspaceId)
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationStart, "")
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
+	}
+	defer unlock()
+
+	if w.IsAdopted() {
+		return s.startAdoptedWorkspace(ctx, w)
 	}
 
 	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
@@ -37,7 +43,12 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 
 	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
 
+	release, err := s.provisioning.acquire(ctx, target.Name, provisioningPriorityInteractive)
+	if err != nil {
+		return err
+	}
 	err = s.startWorkspace(ctx, w, target, wsLogWriter)
+	release()
 
 	if !telemetry.TelemetryEnabled(ctx) {
 		return err
@@ -60,16 +71,21 @@ func (s *WorkspaceService) StartWorkspace(ctx context.Context, workspaceId strin
 }
 
 func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projectName string) error {
-	w, err := s.workspaceStore.Find(workspaceId)
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationStart, projectName)
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
 	}
+	defer unlock()
 
 	project, err := w.GetProject(projectName)
 	if err != nil {
 		return ErrProjectNotFound
 	}
 
+	if w.IsAdopted() {
+		return s.startAdoptedWorkspace(ctx, w)
+	}
+
 	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
 	if err != nil {
 		return err
@@ -78,20 +94,31 @@ func (s *WorkspaceService) StartProject(ctx context.Context, workspaceId, projec
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
+	release, err := s.provisioning.acquire(ctx, target.Name, provisioningPriorityInteractive)
+	if err != nil {
+		return err
+	}
+	defer release()
+
 	return s.startProject(ctx, project, target, projectLogger)
 }
 
 func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
 	wsLogWriter.Write([]byte("Starting workspace\n"))
 
+	agentVersion, err := s.getAgentVersion(ws.Id)
+	if err != nil {
+		return err
+	}
+
 	ws.EnvVars = workspace.GetWorkspaceEnvVars(ws, workspace.WorkspaceEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
 		ServerUrl:     s.serverUrl,
-		ServerVersion: s.serverVersion,
+		ServerVersion: agentVersion,
 		ClientId:      telemetry.ClientId(ctx),
 	}, telemetry.TelemetryEnabled(ctx))
 
-	err := s.provisioner.StartWorkspace(ws, target)
+	err = s.provisioner.StartWorkspace(ws, target)
 	if err != nil {
 		return err
 	}
@@ -114,14 +141,48 @@ func (s *WorkspaceService) startWorkspace(ctx context.Context, ws *workspace.Wor
 func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", p.Name)))
 
+	agentVersion, err := s.getAgentVersion(p.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	w, err := s.workspaceStore.Find(p.WorkspaceId)
+	if err != nil {
+		return err
+	}
+
+	if p.Paused && s.resumeProject(w, p, target, logWriter) {
+		return nil
+	}
+
+	stored, err := w.GetProject(p.Name)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	err = s.ensureSshHostKey(w, stored)
+	if err != nil {
+		return err
+	}
+
 	projectToStart := *p
 	projectToStart.EnvVars = project.GetProjectEnvVars(p, project.ProjectEnvVarParams{
 		ApiUrl:        s.serverApiUrl,
 		ServerUrl:     s.serverUrl,
-		ServerVersion: s.serverVersion,
+		ServerVersion: agentVersion,
 		ClientId:      telemetry.ClientId(ctx),
+		Gateway:       p.Networking == project.NetworkingTailnet && isGateway(w, p.Name),
+		IdleTimeout:   s.idleTimeout,
+		AgentLogLevel: s.agentLogLevel,
 	}, telemetry.TelemetryEnabled(ctx))
 
+	sharedServiceEnvVars, err := s.getSharedServiceEnvVars(ctx, w.SharedServices, target)
+	if err != nil {
+		return err
+	}
+	// Addresses of the other projects are resolved again since their hostnames and routes can change
+	addWorkspaceEnvVars(projectToStart.EnvVars, w, p.Name, sharedServiceEnvVars)
+
 	cr, err := s.containerRegistryService.FindByImageName(p.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
 		return err
@@ -148,6 +209,7 @@ func (s *WorkspaceService) startProject(ctx context.Context, p *project.Project,
 		GitProviderConfig:             gc,
 		BuilderImage:                  s.builderImage,
 		BuilderImageContainerRegistry: builderCr,
+		CorrelationId:                 correlation.GetId(ctx),
 	})
 	if err != nil {
 		return err
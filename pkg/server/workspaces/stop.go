@@ -13,9 +13,14 @@ import (
 )
 
 func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	workspace, unlock, err := s.lockWorkspace(workspaceId, OperationStop, "")
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
+	}
+	defer unlock()
+
+	if workspace.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
 	}
 
 	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &workspace.Target})
@@ -61,16 +66,21 @@ func (s *WorkspaceService) StopWorkspace(ctx context.Context, workspaceId string
 }
 
 func (s *WorkspaceService) StopProject(ctx context.Context, workspaceId, projectName string) error {
-	w, err := s.workspaceStore.Find(workspaceId)
+	w, unlock, err := s.lockWorkspace(workspaceId, OperationStop, projectName)
 	if err != nil {
-		return ErrWorkspaceNotFound
+		return err
 	}
+	defer unlock()
 
 	project, err := w.GetProject(projectName)
 	if err != nil {
 		return ErrProjectNotFound
 	}
 
+	if w.IsAdopted() {
+		return ErrAdoptedWorkspaceNotManaged
+	}
+
 	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &w.Target})
 	if err != nil {
 		return err
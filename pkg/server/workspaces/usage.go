@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	log "github.com/sirupsen/logrus"
+)
+
+// RecordProjectUsage records the resources used by a project as sampled by its agent
+func (s *WorkspaceService) RecordProjectUsage(workspaceId string, projectName string, reports []resourceusage.Report) error {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	_, err = ws.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	if s.resourceUsageService == nil {
+		return nil
+	}
+
+	samples := []*resourceusage.Sample{}
+	for _, r := range reports {
+		samples = append(samples, &resourceusage.Sample{
+			WorkspaceId: ws.Id,
+			ProjectName: projectName,
+			Cpus:        r.Cpus,
+			MemoryUsed:  r.MemoryUsed,
+			MemoryLimit: r.MemoryLimit,
+			DiskUsed:    r.DiskUsed,
+			DiskTotal:   r.DiskTotal,
+			InodesUsed:  r.InodesUsed,
+			InodesTotal: r.InodesTotal,
+			SampledAt:   r.SampledAt,
+		})
+	}
+
+	return s.resourceUsageService.Record(samples)
+}
+
+// ListWorkspaceUsage returns the resource usage samples of the workspace projects in the order they were taken
+func (s *WorkspaceService) ListWorkspaceUsage(workspaceId string, filter resourceusage.Filter) ([]*resourceusage.Sample, error) {
+	ws, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if s.resourceUsageService == nil {
+		return []*resourceusage.Sample{}, nil
+	}
+
+	filter.WorkspaceId = &ws.Id
+
+	return s.resourceUsageService.List(&filter)
+}
+
+// deleteUsage deletes the resource usage samples of a removed workspace
+func (s *WorkspaceService) deleteUsage(workspaceId string) {
+	if s.resourceUsageService == nil {
+		return
+	}
+
+	err := s.resourceUsageService.Delete(&resourceusage.Filter{WorkspaceId: &workspaceId})
+	if err != nil {
+		log.Errorf("failed to delete resource usage of workspace %s: %v", workspaceId, err)
+	}
+}
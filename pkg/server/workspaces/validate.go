@@ -0,0 +1,341 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
+	"github.com/daytonaio/daytona/pkg/build"
+	"github.com/daytonaio/daytona/pkg/build/devcontainer"
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/creationtiming"
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/organization"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/sharedservices"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// Time given to each remote check of a project, e.g. listing the branches of the repository
+const validationCheckTimeout = 30 * time.Second
+
+// Phases that do not overlap and add up to the time it takes to create a project
+var creationEstimatePhases = []creationtiming.Phase{
+	creationtiming.PhaseProvision,
+	creationtiming.PhaseProjectCreate,
+	creationtiming.PhaseAgentBoot,
+	creationtiming.PhaseLifecycleCommands,
+}
+
+// ValidateCreateWorkspace runs the checks of the workspace creation and additionally checks that the repositories
+// are reachable with the configured credentials, that the branches exist, that the devcontainer configurations parse
+// and that the images can be pulled on the target. Images are looked up in their registry without being pulled.
+// Nothing is provisioned or stored.
+func (s *WorkspaceService) ValidateCreateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO) (*workspace.CreationValidation, error) {
+	validation := &workspace.CreationValidation{
+		Valid:     true,
+		Issues:    []workspace.ValidationIssue{},
+		Estimates: []workspace.ProjectCreationEstimate{},
+	}
+
+	s.validateWorkspace(ctx, req, validation)
+
+	target, err := s.targetStore.Find(&provider.TargetFilter{Name: &req.Target})
+	if err == nil && target.OrganizationId != organization.GetOrganizationId(ctx) {
+		target, err = nil, provider.ErrTargetNotFound
+	}
+	if err != nil {
+		if !provider.IsTargetNotFound(err) {
+			return nil, err
+		}
+		validation.AddError("", workspace.ValidationCheckTarget, fmt.Sprintf("target %s not found", req.Target))
+	}
+
+	var phaseReports []creationtiming.PhaseReport
+	if target != nil && s.creationTimingService != nil {
+		phaseReports, err = s.creationTimingService.GetReport(&creationtiming.Filter{Target: &target.Name})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projects := []*project.Project{}
+
+	for _, projectDto := range req.Projects {
+		p := conversion.CreateDtoToProject(projectDto)
+		p.Repository.Url = util.CleanUpRepositoryUrl(p.Repository.Url)
+		p.Target = req.Target
+
+		built := s.validateProject(ctx, p, target, validation)
+
+		validation.Estimates = append(validation.Estimates, getCreationEstimate(p.Name, phaseReports, built))
+		projects = append(projects, p)
+	}
+
+	err = project.ValidateProjectPorts(projects)
+	if err != nil {
+		validation.AddError("", workspace.ValidationCheckProject, err.Error())
+	}
+
+	if target != nil {
+		err = s.checkTargetCapacity(target.Name, projects)
+		if err != nil {
+			if !IsTargetOvercommitted(err) {
+				return nil, err
+			}
+			validation.AddError("", workspace.ValidationCheckCapacity, err.Error())
+		}
+	}
+
+	if req.SharedNode {
+		err = project.AssignRoutes(projects)
+		if err != nil {
+			validation.AddError("", workspace.ValidationCheckProject, err.Error())
+		}
+	}
+
+	return validation, nil
+}
+
+func (s *WorkspaceService) validateWorkspace(ctx context.Context, req dto.CreateWorkspaceDTO, validation *workspace.CreationValidation) {
+	_, err := s.workspaceStore.Find(req.Name)
+	if err == nil {
+		validation.AddError("", workspace.ValidationCheckWorkspace, ErrWorkspaceAlreadyExists.Error())
+	}
+
+	if !isValidWorkspaceName(req.Name) {
+		validation.AddError("", workspace.ValidationCheckWorkspace, ErrInvalidWorkspaceName.Error())
+	}
+
+	err = s.checkOrganizationQuota(organization.GetOrganizationId(ctx))
+	if err != nil {
+		validation.AddError("", workspace.ValidationCheckWorkspace, err.Error())
+	}
+
+	if req.Ttl != nil && *req.Ttl != "" {
+		ttl, err := time.ParseDuration(*req.Ttl)
+		if err != nil || ttl <= 0 {
+			validation.AddError("", workspace.ValidationCheckWorkspace, ErrInvalidWorkspaceTtl.Error())
+		}
+	}
+
+	for _, name := range req.SharedServices {
+		service, err := s.sharedServiceService.Find(name)
+		if err != nil {
+			validation.AddError("", workspace.ValidationCheckWorkspace, err.Error())
+			continue
+		}
+		if service.Target != req.Target {
+			validation.AddError("", workspace.ValidationCheckWorkspace, fmt.Sprintf("shared service %s: %v", name, sharedservices.ErrTargetMismatch))
+		}
+	}
+}
+
+// validateProject adds the issues of the project to the validation and returns true if the project image
+// is built during the creation
+func (s *WorkspaceService) validateProject(ctx context.Context, p *project.Project, target *provider.ProviderTarget, validation *workspace.CreationValidation) bool {
+	if !regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString(p.Name) {
+		validation.AddError(p.Name, workspace.ValidationCheckProject, ErrInvalidProjectName.Error())
+	}
+
+	for _, validate := range []func() error{
+		func() error { return project.ValidateMounts(p.Mounts) },
+		func() error { return project.ValidateBindMountSources(p.Mounts, s.allowedBindMountPaths) },
+		func() error { return project.ValidateCommands(p.Commands) },
+		func() error { return project.ValidatePorts(p.Ports) },
+		func() error {
+			if p.Resources == nil {
+				return nil
+			}
+			return p.Resources.Validate()
+		},
+	} {
+		err := validate()
+		if err != nil {
+			validation.AddError(p.Name, workspace.ValidationCheckProject, err.Error())
+		}
+	}
+
+	auth, err := s.getRepositoryAuth(p)
+	if err != nil {
+		validation.AddError(p.Name, workspace.ValidationCheckRepository, err.Error())
+		return false
+	}
+
+	if !s.validateRepository(ctx, p, auth, validation) {
+		return false
+	}
+
+	if p.Image == "" {
+		p.Image = s.defaultProjectImage
+	}
+
+	built := false
+	image := p.Image
+
+	if p.BuildConfig != nil {
+		cachedBuild, err := s.getCachedBuildForProject(p)
+		if err == nil {
+			image = *cachedBuild.Image
+		} else if !build.IsBuildNotFound(err) {
+			validation.AddError(p.Name, workspace.ValidationCheckImage, fmt.Sprintf("Failed to find a prebuild for the project: %s", err))
+			return false
+		} else {
+			built = p.BuildConfig.Devcontainer != nil
+			if built {
+				if s.imagePolicy != nil {
+					validation.AddError(p.Name, workspace.ValidationCheckImage, "The server image policy requires a published prebuild for projects built from a devcontainer")
+				} else {
+					validation.AddWarning(p.Name, workspace.ValidationCheckImage, "No prebuild matches the project, the image is built while the workspace is created")
+				}
+				s.validateDevcontainer(ctx, p, auth, validation)
+			}
+		}
+	}
+
+	// The image of built projects is only known once the devcontainer is built
+	if !built {
+		s.validateImage(p.Name, image, target, validation)
+	}
+
+	return built
+}
+
+// getRepositoryAuth returns the credentials of the git provider config of the repository, or nil if none is configured
+func (s *WorkspaceService) getRepositoryAuth(p *project.Project) (*http.BasicAuth, error) {
+	if p.GitProviderConfigId != nil && *p.GitProviderConfigId != "" {
+		gc, err := s.gitProviderService.GetConfig(*p.GitProviderConfigId)
+		if err != nil {
+			return nil, err
+		}
+		return &http.BasicAuth{Username: gc.Username, Password: gc.Token}, nil
+	}
+
+	configs, err := s.gitProviderService.ListConfigsForUrl(p.Repository.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(configs) > 1 {
+		return nil, errors.New("multiple git provider configs found for the repository url")
+	}
+
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &http.BasicAuth{Username: configs[0].Username, Password: configs[0].Token}, nil
+}
+
+// validateRepository returns true if the repository is reachable and the branch exists
+func (s *WorkspaceService) validateRepository(ctx context.Context, p *project.Project, auth *http.BasicAuth, validation *workspace.CreationValidation) bool {
+	ctx, cancel := context.WithTimeout(ctx, validationCheckTimeout)
+	defer cancel()
+
+	branches, err := git.ListRemoteBranches(ctx, p.Repository.Url, auth)
+	if err != nil {
+		message := fmt.Sprintf("Repository %s is not reachable: %v", p.Repository.Url, err)
+		if auth == nil {
+			message += ". No git provider is configured for the repository"
+		}
+		validation.AddError(p.Name, workspace.ValidationCheckRepository, message)
+		return false
+	}
+
+	if p.Repository.Branch != "" && !slices.Contains(branches, p.Repository.Branch) {
+		validation.AddError(p.Name, workspace.ValidationCheckBranch, fmt.Sprintf("Branch %s does not exist in repository %s", p.Repository.Branch, p.Repository.Url))
+		return false
+	}
+
+	return true
+}
+
+func (s *WorkspaceService) validateDevcontainer(ctx context.Context, p *project.Project, auth *http.BasicAuth, validation *workspace.CreationValidation) {
+	ctx, cancel := context.WithTimeout(ctx, validationCheckTimeout)
+	defer cancel()
+
+	filePath := p.BuildConfig.Devcontainer.FilePath
+
+	content, err := git.ReadRemoteFile(ctx, p.Repository, filePath, auth)
+	if err != nil {
+		validation.AddError(p.Name, workspace.ValidationCheckDevcontainer, fmt.Sprintf("Failed to read %s: %v", filePath, err))
+		return
+	}
+
+	_, err = devcontainer.ParseConfiguration(content)
+	if err != nil {
+		validation.AddError(p.Name, workspace.ValidationCheckDevcontainer, fmt.Sprintf("Failed to parse %s: %v", filePath, err))
+	}
+}
+
+// validateImage checks the image against the image policy and looks it up from the target without pulling it
+func (s *WorkspaceService) validateImage(projectName, image string, target *provider.ProviderTarget, validation *workspace.CreationValidation) {
+	if s.imagePolicy != nil {
+		pinnedImage, err := s.imagePolicy.Validate(image)
+		if err != nil {
+			validation.AddError(projectName, workspace.ValidationCheckImage, err.Error())
+			return
+		}
+		image = pinnedImage
+	}
+
+	if target == nil {
+		return
+	}
+
+	cr, err := s.containerRegistryService.FindByImageName(image)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		validation.AddError(projectName, workspace.ValidationCheckImage, err.Error())
+		return
+	}
+
+	err = s.provisioner.InspectImage(image, target, cr)
+	if provider.IsInspectImageNotSupported(err) {
+		// Pulling the image instead would make a dry run as slow as the creation itself
+		validation.AddWarning(projectName, workspace.ValidationCheckImage, fmt.Sprintf("Image %s was not checked since the provider of target %s can't inspect images", image, target.Name))
+		return
+	}
+	if err != nil {
+		validation.AddError(projectName, workspace.ValidationCheckImage, fmt.Sprintf("Image %s can not be pulled on target %s: %v", image, target.Name, err))
+	}
+}
+
+// getCreationEstimate adds up the median durations of the creation phases on the target. There is no estimate
+// if no project was created on the target yet
+func getCreationEstimate(projectName string, phaseReports []creationtiming.PhaseReport, built bool) workspace.ProjectCreationEstimate {
+	estimate := workspace.ProjectCreationEstimate{
+		ProjectName: projectName,
+	}
+
+	var creationMs int64
+	projectCreated := false
+
+	for _, report := range phaseReports {
+		if slices.Contains(creationEstimatePhases, report.Phase) {
+			creationMs += report.P50Ms
+		}
+		if report.Phase == creationtiming.PhaseProjectCreate {
+			projectCreated = true
+		}
+		if built && report.Phase == creationtiming.PhaseBuild {
+			estimate.BuildMs = util.Pointer(report.P50Ms)
+		}
+	}
+
+	if projectCreated {
+		estimate.CreationMs = &creationMs
+	}
+
+	return estimate
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package serverupgrade
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DownloadBinary downloads the server binary of the version next to the binary at binaryPath and verifies its
+// checksum and version. Returns the path of the downloaded binary, which replaces the running one with InstallBinary
+func DownloadBinary(ctx context.Context, registryUrl string, c *Compatibility, binaryName, binaryPath string) (string, error) {
+	checksum, ok := c.Checksums[binaryName]
+	if !ok {
+		return "", fmt.Errorf("version %s has no checksum for %s", c.Version, binaryName)
+	}
+
+	downloadUrl, err := url.JoinPath(registryUrl, c.Version, binaryName)
+	if err != nil {
+		return "", err
+	}
+
+	newPath := binaryPath + ".new"
+
+	err = download(ctx, downloadUrl, newPath, checksum)
+	if err == nil {
+		err = verifyBinaryVersion(ctx, newPath, c.Version)
+	}
+	if err != nil {
+		os.Remove(newPath)
+		return "", err
+	}
+
+	return newPath, nil
+}
+
+// InstallBinary swaps the downloaded binary in for the binary at binaryPath
+func InstallBinary(newPath, binaryPath string) error {
+	return os.Rename(newPath, binaryPath)
+}
+
+func download(ctx context.Context, downloadUrl, path, checksum string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: status %d", downloadUrl, resp.StatusCode)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, hash), resp.Body)
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	downloadedChecksum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(downloadedChecksum, checksum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", downloadUrl, checksum, downloadedChecksum)
+	}
+
+	return nil
+}
+
+// verifyBinaryVersion runs the binary to make sure it starts on this machine and is the expected version
+func verifyBinaryVersion(ctx context.Context, path string, version string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	output, err := exec.CommandContext(ctx, path, "version").Output()
+	if err != nil {
+		return fmt.Errorf("failed to run daytona %s: %w", version, err)
+	}
+
+	if !strings.Contains(string(output), version) {
+		return fmt.Errorf("binary reports %q instead of version %s", strings.TrimSpace(string(output)), version)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package serverupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// Compatibility is published to the registry next to the binaries of each server version. It lists what the version
+// requires from the installation it replaces
+type Compatibility struct {
+	// Version the file was published for. Resolves aliases such as latest
+	Version string `json:"version"`
+	// MinimumServerVersion is the oldest server version the migrations of the version can upgrade the state of
+	MinimumServerVersion string `json:"minimumServerVersion"`
+	// MinimumAgentVersion is the oldest agent version the server can still talk to. Older agents do not update
+	// themselves and have to be restarted with their project
+	MinimumAgentVersion string `json:"minimumAgentVersion"`
+	// Providers maps provider names to the oldest version of the provider that works with the server
+	Providers map[string]string `json:"providers"`
+	// Checksums maps binary names, e.g. daytona-linux-amd64, to their hex encoded SHA-256 checksum
+	Checksums map[string]string `json:"checksums"`
+}
+
+// Installation is the state of the running installation that is checked against the compatibility of a version
+type Installation struct {
+	ServerVersion string
+	// Providers maps the installed providers to their version
+	Providers map[string]string
+	Agents    []Agent
+}
+
+// Agent is the agent of a project as last reported by the project
+type Agent struct {
+	WorkspaceName string
+	ProjectName   string
+	Version       string
+}
+
+// Incompatibility is a part of the installation that does not work with the version to upgrade to
+type Incompatibility struct {
+	Component string
+	Reason    string
+}
+
+func (i Incompatibility) String() string {
+	return fmt.Sprintf("%s: %s", i.Component, i.Reason)
+}
+
+// GetCompatibility fetches the compatibility file of the version from the registry
+func GetCompatibility(ctx context.Context, registryUrl, version string) (*Compatibility, error) {
+	compatibilityUrl, err := url.JoinPath(registryUrl, version, "compatibility.json")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, compatibilityUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("version %s does not support in-place upgrades or does not exist", version)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the compatibility of version %s: status %d", version, resp.StatusCode)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Compatibility
+	err = json.Unmarshal(content, &c)
+	if err != nil {
+		return nil, fmt.Errorf("invalid compatibility of version %s: %w", version, err)
+	}
+
+	if !semver.IsValid(c.Version) {
+		return nil, fmt.Errorf("invalid compatibility of version %s: invalid version %q", version, c.Version)
+	}
+
+	return &c, nil
+}
+
+// Check returns the parts of the installation that do not work with the version. Versions that are not valid semantic
+// versions, e.g. of development builds, are always reported
+func (c *Compatibility) Check(installation Installation) []Incompatibility {
+	incompatibilities := []Incompatibility{}
+
+	if !semver.IsValid(installation.ServerVersion) {
+		incompatibilities = append(incompatibilities, Incompatibility{
+			Component: "server",
+			Reason:    fmt.Sprintf("version %s can not be compared to the versions the upgrade supports", installation.ServerVersion),
+		})
+	} else if semver.Compare(installation.ServerVersion, c.Version) > 0 {
+		incompatibilities = append(incompatibilities, Incompatibility{
+			Component: "server",
+			Reason:    fmt.Sprintf("version %s is newer than %s and its state can not be downgraded", installation.ServerVersion, c.Version),
+		})
+	} else if isOlder(installation.ServerVersion, c.MinimumServerVersion) {
+		incompatibilities = append(incompatibilities, Incompatibility{
+			Component: "server",
+			Reason:    fmt.Sprintf("version %s can not be upgraded to %s directly, upgrade to %s first", installation.ServerVersion, c.Version, c.MinimumServerVersion),
+		})
+	}
+
+	providerNames := []string{}
+	for name := range installation.Providers {
+		providerNames = append(providerNames, name)
+	}
+	slices.Sort(providerNames)
+
+	for _, name := range providerNames {
+		minimum, ok := c.Providers[name]
+		if !ok {
+			continue
+		}
+
+		version := installation.Providers[name]
+		if isOlder(version, minimum) {
+			incompatibilities = append(incompatibilities, Incompatibility{
+				Component: "provider " + name,
+				Reason:    fmt.Sprintf("version %s is older than %s, update it with 'daytona provider update'", version, minimum),
+			})
+		}
+	}
+
+	for _, agent := range installation.Agents {
+		if isOlder(agent.Version, c.MinimumAgentVersion) {
+			incompatibilities = append(incompatibilities, Incompatibility{
+				Component: fmt.Sprintf("agent of %s/%s", agent.WorkspaceName, agent.ProjectName),
+				Reason:    fmt.Sprintf("version %s is older than %s, restart the project after the upgrade", agent.Version, c.MinimumAgentVersion),
+			})
+		}
+	}
+
+	return incompatibilities
+}
+
+// GetBinaryName returns the name of the server binary of the operating system and architecture in the registry
+func GetBinaryName(goos, goarch string) string {
+	name := fmt.Sprintf("daytona-%s-%s", goos, goarch)
+	if goos == "windows" {
+		name += ".exe"
+	}
+
+	return name
+}
+
+// isOlder reports whether the version is older than the minimum. Unknown versions are treated as older, a missing
+// minimum is always met
+func isOlder(version, minimum string) bool {
+	if minimum == "" {
+		return false
+	}
+
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+
+	if !semver.IsValid(version) {
+		return true
+	}
+
+	return semver.Compare(version, minimum) < 0
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package serverupgrade
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCompatibility(t *testing.T) {
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/latest/compatibility.json" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"version":"v0.50.0","minimumServerVersion":"v0.45.0","checksums":{"daytona-linux-amd64":"abc"}}`))
+	}))
+	defer registry.Close()
+
+	c, err := GetCompatibility(context.Background(), registry.URL, "latest")
+	require.NoError(t, err)
+	require.Equal(t, "v0.50.0", c.Version)
+	require.Equal(t, "v0.45.0", c.MinimumServerVersion)
+	require.Equal(t, "abc", c.Checksums["daytona-linux-amd64"])
+
+	_, err = GetCompatibility(context.Background(), registry.URL, "v0.30.0")
+	require.ErrorContains(t, err, "does not support in-place upgrades")
+}
+
+func TestCheck(t *testing.T) {
+	c := &Compatibility{
+		Version:              "v0.50.0",
+		MinimumServerVersion: "v0.45.0",
+		MinimumAgentVersion:  "v0.48.0",
+		Providers: map[string]string{
+			"docker-provider": "v0.12.0",
+		},
+	}
+
+	require.Empty(t, c.Check(Installation{
+		ServerVersion: "v0.49.1",
+		Providers:     map[string]string{"docker-provider": "v0.12.3", "custom-provider": "v0.1.0"},
+		Agents:        []Agent{{WorkspaceName: "ws", ProjectName: "api", Version: "v0.49.1"}},
+	}))
+
+	incompatibilities := c.Check(Installation{
+		ServerVersion: "v0.40.0",
+		Providers:     map[string]string{"docker-provider": "0.11.0"},
+		Agents: []Agent{
+			{WorkspaceName: "ws", ProjectName: "api", Version: "v0.47.0"},
+			{WorkspaceName: "ws", ProjectName: "web"},
+		},
+	})
+	require.Len(t, incompatibilities, 4)
+	require.Equal(t, "server", incompatibilities[0].Component)
+	require.Contains(t, incompatibilities[0].Reason, "upgrade to v0.45.0 first")
+	require.Equal(t, "provider docker-provider", incompatibilities[1].Component)
+	require.Equal(t, "agent of ws/api", incompatibilities[2].Component)
+	require.Equal(t, "agent of ws/web", incompatibilities[3].Component)
+
+	// State can not be migrated back to older versions
+	incompatibilities = c.Check(Installation{ServerVersion: "v0.51.0"})
+	require.Len(t, incompatibilities, 1)
+	require.Contains(t, incompatibilities[0].Reason, "can not be downgraded")
+
+	// Development builds are not upgraded without --force
+	incompatibilities = c.Check(Installation{ServerVersion: "v0.0.0-dev"})
+	require.Len(t, incompatibilities, 1)
+
+	incompatibilities = c.Check(Installation{ServerVersion: "dev"})
+	require.Len(t, incompatibilities, 1)
+	require.Contains(t, incompatibilities[0].Reason, "can not be compared")
+}
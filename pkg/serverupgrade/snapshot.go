@@ -0,0 +1,230 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package serverupgrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+const snapshotFileName = "snapshot.json"
+
+// Snapshot is a copy of the server binary and state taken before an upgrade. Restoring it rolls the server back to
+// the version it was taken of
+type Snapshot struct {
+	// Dir holds the copies of the files
+	Dir       string    `json:"-"`
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Files maps the paths of the snapshotted files and directories to the names of their copies in Dir
+	Files map[string]string `json:"files"`
+	// Missing are the paths that did not exist when the snapshot was taken. They are removed on restore
+	Missing []string `json:"missing,omitempty"`
+}
+
+// CreateSnapshot copies the files and directories at the paths to a new directory in baseDir. The server must be
+// stopped so that its state is not written while it is copied
+func CreateSnapshot(baseDir, version string, paths []string) (*Snapshot, error) {
+	now := time.Now()
+
+	s := &Snapshot{
+		Dir:       filepath.Join(baseDir, fmt.Sprintf("%s-%s", now.UTC().Format("20060102150405"), version)),
+		Version:   version,
+		CreatedAt: now,
+		Files:     map[string]string{},
+	}
+
+	err := os.MkdirAll(s.Dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, path := range paths {
+		name := fmt.Sprintf("%d-%s", i, filepath.Base(path))
+
+		err := copyPath(path, filepath.Join(s.Dir, name))
+		if os.IsNotExist(err) {
+			s.Missing = append(s.Missing, path)
+			continue
+		}
+		if err != nil {
+			os.RemoveAll(s.Dir)
+			return nil, fmt.Errorf("failed to snapshot %s: %w", path, err)
+		}
+
+		s.Files[path] = name
+	}
+
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err == nil {
+		err = os.WriteFile(filepath.Join(s.Dir, snapshotFileName), content, 0600)
+	}
+	if err != nil {
+		os.RemoveAll(s.Dir)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Restore puts the snapshotted files and directories back in place. Every copy is swapped in with a rename so that a
+// failed restore never leaves a partially written file or directory behind
+func (s *Snapshot) Restore() error {
+	for path, name := range s.Files {
+		os.RemoveAll(path + ".restore")
+
+		err := copyPath(filepath.Join(s.Dir, name), path+".restore")
+		if err == nil {
+			err = swapPath(path+".restore", path)
+		}
+		if err != nil {
+			os.RemoveAll(path + ".restore")
+			return fmt.Errorf("failed to restore %s: %w", path, err)
+		}
+	}
+
+	for _, path := range s.Missing {
+		err := os.RemoveAll(path)
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to restore %s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+// PruneSnapshots removes all but the newest snapshots in baseDir
+func PruneSnapshots(baseDir string, keep int) error {
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	dirs := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry.Name())
+		}
+	}
+
+	// Snapshot directories are prefixed with their creation time
+	slices.Sort(dirs)
+
+	for len(dirs) > keep {
+		err := os.RemoveAll(filepath.Join(baseDir, dirs[0]))
+		if err != nil {
+			return err
+		}
+		dirs = dirs[1:]
+	}
+
+	return nil
+}
+
+// swapPath replaces dst with src. Directories can not be renamed over each other, so an existing directory is moved
+// aside first and only removed once src is in place
+func swapPath(src, dst string) error {
+	info, err := os.Lstat(dst)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err != nil || !info.IsDir() {
+		return os.Rename(src, dst)
+	}
+
+	os.RemoveAll(dst + ".old")
+
+	err = os.Rename(dst, dst+".old")
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(src, dst)
+	if err != nil {
+		return errors.Join(err, os.Rename(dst+".old", dst))
+	}
+
+	return os.RemoveAll(dst + ".old")
+}
+
+// copyPath copies a file or a directory with its contents. Symlinks are copied as links, other special files such as
+// sockets are skipped since they are recreated by the processes that serve them
+func copyPath(src, dst string) error {
+	info, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case info.IsDir():
+		return copyDir(src, dst, info.Mode().Perm())
+	case info.Mode()&os.ModeSymlink != 0:
+		target, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(target, dst)
+	case info.Mode().IsRegular():
+		return copyFile(src, dst)
+	}
+
+	return nil
+}
+
+func copyDir(src, dst string, perm os.FileMode) error {
+	entries, err := os.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	err = os.Mkdir(dst, perm)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err := copyPath(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(out, in)
+	closeErr := out.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package serverupgrade
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	binaryPath := filepath.Join(dir, "daytona")
+	dbPath := filepath.Join(dir, "db")
+	configPath := filepath.Join(dir, "server", "config.json")
+	require.NoError(t, os.WriteFile(binaryPath, []byte("v0.49.0"), 0755))
+	require.NoError(t, os.WriteFile(dbPath, []byte("state"), 0600))
+
+	s, err := CreateSnapshot(filepath.Join(dir, "upgrades"), "v0.49.0", []string{binaryPath, dbPath, configPath})
+	require.NoError(t, err)
+	require.Equal(t, []string{configPath}, s.Missing)
+
+	// The upgrade replaces the binary, migrates the state and writes files that did not exist before
+	require.NoError(t, os.WriteFile(binaryPath, []byte("v0.50.0"), 0755))
+	require.NoError(t, os.WriteFile(dbPath, []byte("migrated state"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0700))
+	require.NoError(t, os.WriteFile(configPath, []byte("{}"), 0600))
+
+	require.NoError(t, s.Restore())
+
+	content, err := os.ReadFile(binaryPath)
+	require.NoError(t, err)
+	require.Equal(t, "v0.49.0", string(content))
+
+	info, err := os.Stat(binaryPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	content, err = os.ReadFile(dbPath)
+	require.NoError(t, err)
+	require.Equal(t, "state", string(content))
+
+	require.NoFileExists(t, configPath)
+}
+
+func TestSnapshotDirectories(t *testing.T) {
+	dir := t.TempDir()
+
+	headscaleDir := filepath.Join(dir, "headscale")
+	providersDir := filepath.Join(dir, "providers")
+	require.NoError(t, os.MkdirAll(filepath.Join(providersDir, "docker-provider"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(providersDir, "docker-provider", "docker-provider"), []byte("v0.12.0"), 0755))
+	require.NoError(t, os.Symlink("docker-provider", filepath.Join(providersDir, "docker")))
+
+	s, err := CreateSnapshot(filepath.Join(dir, "upgrades"), "v0.49.0", []string{providersDir, headscaleDir})
+	require.NoError(t, err)
+	require.Equal(t, []string{headscaleDir}, s.Missing)
+
+	// The upgrade updates the providers and creates the state of the control server
+	require.NoError(t, os.WriteFile(filepath.Join(providersDir, "docker-provider", "docker-provider"), []byte("v0.13.0"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(providersDir, "new-provider"), []byte("v0.1.0"), 0755))
+	require.NoError(t, os.MkdirAll(headscaleDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(headscaleDir, "headscale.db"), []byte("nodes"), 0600))
+
+	require.NoError(t, s.Restore())
+
+	content, err := os.ReadFile(filepath.Join(providersDir, "docker-provider", "docker-provider"))
+	require.NoError(t, err)
+	require.Equal(t, "v0.12.0", string(content))
+
+	target, err := os.Readlink(filepath.Join(providersDir, "docker"))
+	require.NoError(t, err)
+	require.Equal(t, "docker-provider", target)
+
+	require.NoFileExists(t, filepath.Join(providersDir, "new-provider"))
+	require.NoDirExists(t, headscaleDir)
+	require.NoDirExists(t, providersDir+".old")
+}
+
+func TestPruneSnapshots(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"20240102000000-v0.48.0", "20240101000000-v0.47.0", "20240103000000-v0.49.0"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, name), 0700))
+	}
+
+	require.NoError(t, PruneSnapshots(dir, 2))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "20240102000000-v0.48.0", entries[0].Name())
+	require.Equal(t, "20240103000000-v0.49.0", entries[1].Name())
+
+	require.NoError(t, PruneSnapshots(filepath.Join(dir, "missing"), 2))
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SharedService is a long-running service, like a database or a cache, that the provider of a target
+// runs once and that the projects of all attached workspaces can connect to
+type SharedService struct {
+	Name   string `json:"name" validate:"required"`
+	Target string `json:"target" validate:"required"`
+	Image  string `json:"image" validate:"required"`
+	// Port the service listens on
+	Port uint16 `json:"port" validate:"required"`
+	// Environment variables of the service container
+	EnvVars map[string]string `json:"envVars" validate:"required"`
+	// Environment variables injected in the projects of attached workspaces.
+	// Values can reference the address of the service with {{host}} and {{port}}
+	ConnectionEnvVars map[string]string `json:"connectionEnvVars" validate:"required"`
+	// Path in the service container that is persisted in a volume
+	DataPath  string    `json:"dataPath,omitempty" validate:"optional"`
+	CreatedAt time.Time `json:"createdAt" validate:"required"`
+} // @name SharedService
+
+type SharedServiceInfo struct {
+	Name      string `json:"name" validate:"required"`
+	IsRunning bool   `json:"isRunning" validate:"required"`
+	// Address of the service as seen from project containers on the same target
+	Host             string `json:"host" validate:"required"`
+	Port             uint16 `json:"port" validate:"required"`
+	ProviderMetadata string `json:"providerMetadata,omitempty" validate:"optional"`
+} // @name SharedServiceInfo
+
+var (
+	nameRegex       = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	envVarNameRegex = regexp.MustCompile(`[^A-Z0-9]+`)
+)
+
+func IsValidName(name string) bool {
+	return len(name) <= 63 && nameRegex.MatchString(name)
+}
+
+// GetConnectionEnvVars returns the environment variables that point the projects of an attached workspace to the service.
+// DAYTONA_SERVICE_<NAME>_HOST and DAYTONA_SERVICE_<NAME>_PORT are always set.
+func (s *SharedService) GetConnectionEnvVars(info *SharedServiceInfo) map[string]string {
+	port := strconv.Itoa(int(info.Port))
+	replacer := strings.NewReplacer("{{host}}", info.Host, "{{port}}", port)
+
+	prefix := fmt.Sprintf("DAYTONA_SERVICE_%s_", strings.Trim(envVarNameRegex.ReplaceAllString(strings.ToUpper(s.Name), "_"), "_"))
+
+	envVars := map[string]string{
+		prefix + "HOST": info.Host,
+		prefix + "PORT": port,
+	}
+
+	for key, value := range s.ConnectionEnvVars {
+		envVars[key] = replacer.Replace(value)
+	}
+
+	return envVars
+}
@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import "errors"
+
+type Filter struct {
+	Target *string
+}
+
+type Store interface {
+	List(filter *Filter) ([]*SharedService, error)
+	Find(name string) (*SharedService, error)
+	Save(service *SharedService) error
+	Delete(service *SharedService) error
+}
+
+var (
+	ErrSharedServiceNotFound = errors.New("shared service not found")
+)
+
+func IsSharedServiceNotFound(err error) bool {
+	return err.Error() == ErrSharedServiceNotFound.Error()
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+// Template is a preset for a commonly used service. {{password}} in the environment variables of a template
+// is replaced with a generated password when the service is created.
+type Template struct {
+	Image             string
+	Port              uint16
+	EnvVars           map[string]string
+	ConnectionEnvVars map[string]string
+	DataPath          string
+}
+
+var Templates = map[string]Template{
+	"postgres": {
+		Image: "postgres:16",
+		Port:  5432,
+		EnvVars: map[string]string{
+			"POSTGRES_PASSWORD": "{{password}}",
+		},
+		ConnectionEnvVars: map[string]string{
+			"DATABASE_URL": "postgresql://postgres:{{password}}@{{host}}:{{port}}/postgres",
+		},
+		DataPath: "/var/lib/postgresql/data",
+	},
+	"mysql": {
+		Image: "mysql:8",
+		Port:  3306,
+		EnvVars: map[string]string{
+			"MYSQL_ROOT_PASSWORD": "{{password}}",
+		},
+		ConnectionEnvVars: map[string]string{
+			"DATABASE_URL": "mysql://root:{{password}}@{{host}}:{{port}}/mysql",
+		},
+		DataPath: "/var/lib/mysql",
+	},
+	"redis": {
+		Image:   "redis:7",
+		Port:    6379,
+		EnvVars: map[string]string{},
+		ConnectionEnvVars: map[string]string{
+			"REDIS_URL": "redis://{{host}}:{{port}}",
+		},
+		DataPath: "/data",
+	},
+}
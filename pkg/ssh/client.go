@@ -18,6 +18,8 @@ type SessionConfig struct {
 	Username       string
 	Password       *string
 	PrivateKeyPath *string
+	// PEM encoded private key, used when the key is not available on disk
+	PrivateKey *string
 }
 
 type Client struct {
@@ -58,6 +60,15 @@ func NewClient(config *SessionConfig) (*Client, error) {
 		}...)
 	}
 
+	if config.PrivateKey != nil {
+		privateKey, err := ssh.ParsePrivateKey([]byte(*config.PrivateKey))
+		if err != nil {
+			return nil, err
+		}
+
+		auth = append(auth, ssh.PublicKeys(privateKey))
+	}
+
 	sshConfig.Auth = auth
 
 	client, err := ssh.Dial("tcp", server, sshConfig)
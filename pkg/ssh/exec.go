@@ -19,3 +19,18 @@ func (c *Client) Exec(command string, logWriter io.Writer) error {
 
 	return session.Run(command)
 }
+
+// ExecWithInput runs the command with the input as its stdin
+func (c *Client) ExecWithInput(command string, input io.Reader, logWriter io.Writer) error {
+	session, err := c.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	session.Stdin = input
+	session.Stdout = logWriter
+	session.Stderr = logWriter
+
+	return session.Run(command)
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import (
+	"sort"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// Agents report the state of their project every few seconds. Projects whose agent stopped reporting for longer
+// are not considered running, e.g. after their host crashed
+const stateReportTimeout = time.Minute
+
+// Snapshot is the state of a project as known to the server at a point in time
+type Snapshot struct {
+	Id            string `json:"id" validate:"required"`
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	ProjectName   string `json:"projectName" validate:"required"`
+	Target        string `json:"target" validate:"required"`
+	// Running is set while the project agent reports a non-zero uptime and the last report is recent
+	Running bool `json:"running" validate:"required"`
+	// Uptime of the project in seconds, as last reported by its agent
+	Uptime uint64 `json:"uptime" validate:"required"`
+	// StateUpdatedAt is when the agent last reported the state of the project. Empty if it never did
+	StateUpdatedAt string    `json:"stateUpdatedAt,omitempty" validate:"optional"`
+	AgentVersion   string    `json:"agentVersion,omitempty" validate:"optional"`
+	LastActivityAt string    `json:"lastActivityAt,omitempty" validate:"optional"`
+	GitBranch      string    `json:"gitBranch,omitempty" validate:"optional"`
+	RecordedAt     time.Time `json:"recordedAt" validate:"required"`
+} // @name StateSnapshot
+
+// Take captures the state of every project of the workspaces. Snapshot ids are left to the caller
+func Take(workspaces []*workspace.Workspace, recordedAt time.Time) []*Snapshot {
+	snapshots := []*Snapshot{}
+
+	for _, w := range workspaces {
+		for _, p := range w.Projects {
+			snapshot := &Snapshot{
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				ProjectName:   p.Name,
+				Target:        w.Target,
+				RecordedAt:    recordedAt,
+			}
+
+			if p.State != nil {
+				snapshot.Running = p.State.Uptime > 0 && isReportedSince(p.State, recordedAt.Add(-stateReportTimeout))
+				snapshot.Uptime = p.State.Uptime
+				snapshot.StateUpdatedAt = p.State.UpdatedAt
+				snapshot.AgentVersion = p.State.AgentVersion
+				snapshot.LastActivityAt = p.State.LastActivityAt
+				if p.State.GitStatus != nil {
+					snapshot.GitBranch = p.State.GitStatus.CurrentBranch
+				}
+			}
+
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+
+	return snapshots
+}
+
+// isReportedSince checks if the agent reported the state at or after the given time
+func isReportedSince(state *project.ProjectState, since time.Time) bool {
+	updatedAt, err := time.Parse(time.RFC1123, state.UpdatedAt)
+	if err != nil {
+		return false
+	}
+
+	return !updatedAt.Before(since)
+}
+
+// GetStateAt returns the latest snapshot of each project recorded at or before the given time, sorted by project name
+func GetStateAt(snapshots []*Snapshot, at time.Time) []*Snapshot {
+	latest := map[string]*Snapshot{}
+
+	for _, s := range snapshots {
+		if s.RecordedAt.After(at) {
+			continue
+		}
+
+		if current, ok := latest[s.ProjectName]; !ok || s.RecordedAt.After(current.RecordedAt) {
+			latest[s.ProjectName] = s
+		}
+	}
+
+	result := []*Snapshot{}
+	for _, s := range latest {
+		result = append(result, s)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ProjectName < result[j].ProjectName
+	})
+
+	return result
+}
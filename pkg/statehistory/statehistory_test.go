@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/statehistory"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTake(t *testing.T) {
+	now := time.Now()
+
+	snapshots := statehistory.Take([]*workspace.Workspace{
+		{
+			Id:     "ws1",
+			Name:   "ws",
+			Target: "local",
+			Projects: []*project.Project{
+				{Name: "running", State: &project.ProjectState{Uptime: 10, UpdatedAt: now.Format(time.RFC1123), AgentVersion: "v1", GitStatus: &project.GitStatus{CurrentBranch: "main"}}},
+				{Name: "unknown"},
+				{Name: "stale", State: &project.ProjectState{Uptime: 10, UpdatedAt: now.Add(-time.Hour).Format(time.RFC1123)}},
+			},
+		},
+	}, now)
+
+	require.Len(t, snapshots, 3)
+	require.True(t, snapshots[0].Running)
+	require.Equal(t, "main", snapshots[0].GitBranch)
+	require.Equal(t, "v1", snapshots[0].AgentVersion)
+	require.False(t, snapshots[1].Running)
+	require.Equal(t, now, snapshots[1].RecordedAt)
+	// The agent of the project stopped reporting its uptime
+	require.False(t, snapshots[2].Running)
+	require.Equal(t, uint64(10), snapshots[2].Uptime)
+}
+
+func TestGetStateAt(t *testing.T) {
+	base := time.Date(2024, 5, 1, 14, 0, 0, 0, time.UTC)
+
+	snapshots := []*statehistory.Snapshot{
+		{ProjectName: "b", Running: true, RecordedAt: base.Add(-10 * time.Minute)},
+		{ProjectName: "b", Running: false, RecordedAt: base.Add(-5 * time.Minute)},
+		{ProjectName: "a", Running: true, RecordedAt: base},
+		{ProjectName: "a", Running: false, RecordedAt: base.Add(5 * time.Minute)},
+	}
+
+	state := statehistory.GetStateAt(snapshots, base)
+
+	require.Len(t, state, 2)
+	require.Equal(t, "a", state[0].ProjectName)
+	require.True(t, state[0].Running)
+	require.Equal(t, "b", state[1].ProjectName)
+	require.False(t, state[1].Running)
+
+	require.Empty(t, statehistory.GetStateAt(snapshots, base.Add(-time.Hour)))
+}
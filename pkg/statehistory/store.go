@@ -0,0 +1,20 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import "time"
+
+type Filter struct {
+	// Workspace matches the workspace ID or name, so the history of removed workspaces can be queried by name
+	Workspace *string
+	Since     *time.Time
+	Until     *time.Time
+}
+
+type Store interface {
+	List(filter *Filter) ([]*Snapshot, error)
+	Save(snapshot *Snapshot) error
+	// DeleteBefore removes the snapshots recorded before the time
+	DeleteBefore(before time.Time) error
+}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// Backend stores objects keyed by slash separated paths, e.g. the artifacts and the archived logs of the server
+type Backend interface {
+	// Put stores the content under the key, replacing the object stored before. Returns the size of the object
+	Put(ctx context.Context, key string, content io.Reader) (int64, error)
+	// Get returns the content of the object. Returns ErrObjectNotFound if there is no object with the key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// List returns the objects whose keys start with the prefix, sorted by key
+	List(ctx context.Context, prefix string) ([]Object, error)
+	// Delete deletes the object. Deleting an object that does not exist is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+type Object struct {
+	Key        string
+	Size       int64
+	ModifiedAt time.Time
+}
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+func IsObjectNotFound(err error) bool {
+	return errors.Is(err, ErrObjectNotFound)
+}
+
+// DeletePrefix deletes the objects whose keys start with the prefix
+func DeletePrefix(ctx context.Context, backend Backend, prefix string) error {
+	objects, err := backend.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		err = backend.Delete(ctx, object.Key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteModifiedBefore deletes the objects whose keys start with the prefix and that were last modified before the
+// time. Returns the number of deleted objects
+func DeleteModifiedBefore(ctx context.Context, backend Backend, prefix string, before time.Time) (int, error) {
+	objects, err := backend.List(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, object := range objects {
+		if !object.ModifiedAt.Before(before) {
+			continue
+		}
+
+		err = backend.Delete(ctx, object.Key)
+		if err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// LocalBackend stores objects as files in a directory of the server, with a file per key
+type LocalBackend struct {
+	dir string
+}
+
+func NewLocalBackend(dir string) *LocalBackend {
+	return &LocalBackend{dir: dir}
+}
+
+func (b *LocalBackend) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	filePath, err := b.getFilePath(key)
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(filePath), 0755)
+	if err != nil {
+		return 0, err
+	}
+
+	// Readers of the object must not see a partially written file
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	size, err := io.Copy(tmpFile, content)
+	if err != nil {
+		tmpFile.Close()
+		return 0, err
+	}
+
+	err = tmpFile.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return size, os.Rename(tmpFile.Name(), filePath)
+}
+
+func (b *LocalBackend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := b.getFilePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+
+	return file, err
+}
+
+func (b *LocalBackend) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := []Object{}
+
+	err := filepath.WalkDir(b.dir, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(b.dir, filePath)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(relPath)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		objects = append(objects, Object{
+			Key:        key,
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(objects, func(a, b Object) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return objects, nil
+}
+
+func (b *LocalBackend) Delete(ctx context.Context, key string) error {
+	filePath, err := b.getFilePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// getFilePath returns the path of the file of the object. Keys can not point outside of the directory of the backend
+func (b *LocalBackend) getFilePath(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if key == "" || cleanKey == "/" || cleanKey[1:] != key {
+		return "", errors.New("invalid object key: " + key)
+	}
+
+	return filepath.Join(b.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBackend(t *testing.T) {
+	dir := t.TempDir()
+	backend := NewLocalBackend(dir)
+	ctx := context.Background()
+
+	objects, err := backend.List(ctx, "")
+	require.NoError(t, err)
+	require.Empty(t, objects)
+
+	size, err := backend.Put(ctx, "logs/b/log.1", strings.NewReader("second"))
+	require.NoError(t, err)
+	require.Equal(t, int64(6), size)
+
+	_, err = backend.Put(ctx, "logs/a/log.1", strings.NewReader("first"))
+	require.NoError(t, err)
+	_, err = backend.Put(ctx, "artifact", strings.NewReader("artifact"))
+	require.NoError(t, err)
+
+	content, err := backend.Get(ctx, "logs/a/log.1")
+	require.NoError(t, err)
+	b, err := io.ReadAll(content)
+	content.Close()
+	require.NoError(t, err)
+	require.Equal(t, "first", string(b))
+
+	_, err = backend.Get(ctx, "logs/c/log.1")
+	require.True(t, IsObjectNotFound(err))
+
+	objects, err = backend.List(ctx, "logs/")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	require.Equal(t, "logs/a/log.1", objects[0].Key)
+	require.Equal(t, "logs/b/log.1", objects[1].Key)
+	require.Equal(t, int64(6), objects[1].Size)
+
+	_, err = backend.Put(ctx, "../outside", strings.NewReader(""))
+	require.ErrorContains(t, err, "invalid object key")
+
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "logs", "a", "log.1"), old, old))
+
+	deleted, err := DeleteModifiedBefore(ctx, backend, "logs/", time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	require.NoError(t, DeletePrefix(ctx, backend, "logs/"))
+	objects, err = backend.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	require.Equal(t, "artifact", objects[0].Key)
+
+	require.NoError(t, backend.Delete(ctx, "artifact"))
+	require.NoError(t, backend.Delete(ctx, "artifact"))
+}
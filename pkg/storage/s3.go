@@ -0,0 +1,179 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// Endpoint of the XML API of Cloud Storage, which is compatible with the S3 API
+const gcsEndpoint = "https://storage.googleapis.com"
+
+type S3BackendConfig struct {
+	Bucket string
+	// Prepended to the keys of the objects, so that several servers or object types can share a bucket
+	Prefix string
+	// Defaults to us-east-1
+	Region string
+	// Endpoint of an S3 compatible service, e.g. MinIO. Requests are sent in the path style if set
+	Endpoint string
+	// Credentials of the default AWS credential chain are used if empty
+	AccessKeyId     string
+	SecretAccessKey string
+}
+
+// S3Backend stores objects in an S3 bucket or in the bucket of an S3 compatible service
+type S3Backend struct {
+	bucket   string
+	prefix   string
+	client   *s3.Client
+	uploader *manager.Uploader
+}
+
+func NewS3Backend(config S3BackendConfig) (*S3Backend, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+
+	region := config.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	loadOptions := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if config.AccessKeyId != "" {
+		loadOptions = append(loadOptions, awsconfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(config.AccessKeyId, config.SecretAccessKey, "")))
+	}
+
+	awsConfig, err := awsconfig.LoadDefaultConfig(context.Background(), loadOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS config: %w", err)
+	}
+
+	client := s3.NewFromConfig(awsConfig, func(o *s3.Options) {
+		if config.Endpoint != "" {
+			o.BaseEndpoint = aws.String(config.Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	prefix := strings.Trim(config.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	return &S3Backend{
+		bucket:   config.Bucket,
+		prefix:   prefix,
+		client:   client,
+		uploader: manager.NewUploader(client),
+	}, nil
+}
+
+// NewGcsBackend returns a backend that stores objects in a Cloud Storage bucket through its S3 compatible XML API.
+// Requests are authenticated with an HMAC key of a service account
+func NewGcsBackend(bucket, prefix, accessId, secret string) (*S3Backend, error) {
+	if accessId == "" || secret == "" {
+		return nil, errors.New("an HMAC key is required to access Cloud Storage")
+	}
+
+	return NewS3Backend(S3BackendConfig{
+		Bucket:          bucket,
+		Prefix:          prefix,
+		Region:          "auto",
+		Endpoint:        gcsEndpoint,
+		AccessKeyId:     accessId,
+		SecretAccessKey: secret,
+	})
+}
+
+func (b *S3Backend) Put(ctx context.Context, key string, content io.Reader) (int64, error) {
+	counter := &countingReader{Reader: content}
+
+	// Content of unknown length is uploaded in parts
+	_, err := b.uploader.Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.prefix + key),
+		Body:   counter,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.n, nil
+}
+
+func (b *S3Backend) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := b.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.prefix + key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (b *S3Backend) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := []Object{}
+
+	paginator := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(b.prefix + prefix),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range page.Contents {
+			objects = append(objects, Object{
+				Key:        strings.TrimPrefix(aws.ToString(object.Key), b.prefix),
+				Size:       aws.ToInt64(object.Size),
+				ModifiedAt: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+
+	// Keys are listed in the order of their UTF-8 bytes
+	return objects, nil
+}
+
+func (b *S3Backend) Delete(ctx context.Context, key string) error {
+	_, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.prefix + key),
+	})
+
+	return err
+}
+
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeS3Object struct {
+	Key          string
+	Size         int64
+	LastModified string
+}
+
+type fakeS3ListResult struct {
+	XMLName  xml.Name       `xml:"ListBucketResult"`
+	Name     string         `xml:"Name"`
+	Prefix   string         `xml:"Prefix"`
+	KeyCount int            `xml:"KeyCount"`
+	Contents []fakeS3Object `xml:"Contents"`
+}
+
+// newFakeS3Server serves the subset of the S3 API used by the backend for path style requests to the bucket
+func newFakeS3Server(t *testing.T, bucket string) *httptest.Server {
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		require.True(t, strings.HasPrefix(r.URL.Path, "/"+bucket))
+		require.Contains(t, r.Header.Get("Authorization"), "Credential=key/")
+		key := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/"+bucket), "/")
+
+		switch {
+		case r.Method == http.MethodGet && key == "":
+			prefix := r.URL.Query().Get("prefix")
+			result := fakeS3ListResult{Name: bucket, Prefix: prefix}
+			for k, content := range objects {
+				if strings.HasPrefix(k, prefix) {
+					result.Contents = append(result.Contents, fakeS3Object{Key: k, Size: int64(len(content)), LastModified: time.Now().UTC().Format(time.RFC3339)})
+				}
+			}
+			slices.SortFunc(result.Contents, func(a, b fakeS3Object) int { return strings.Compare(a.Key, b.Key) })
+			result.KeyCount = len(result.Contents)
+			require.NoError(t, xml.NewEncoder(w).Encode(result))
+		case r.Method == http.MethodPut:
+			content, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			objects[key] = content
+		case r.Method == http.MethodGet:
+			content, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+				return
+			}
+			w.Write(content)
+		case r.Method == http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+}
+
+func TestS3Backend(t *testing.T) {
+	server := newFakeS3Server(t, "bucket")
+	defer server.Close()
+
+	backend, err := NewS3Backend(S3BackendConfig{
+		Bucket:          "bucket",
+		Prefix:          "/daytona/",
+		Endpoint:        server.URL,
+		AccessKeyId:     "key",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	size, err := backend.Put(ctx, "artifacts/a", strings.NewReader("content"))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), size)
+
+	_, err = backend.Put(ctx, "logs/b/log.1", strings.NewReader("log"))
+	require.NoError(t, err)
+
+	content, err := backend.Get(ctx, "artifacts/a")
+	require.NoError(t, err)
+	b, err := io.ReadAll(content)
+	content.Close()
+	require.NoError(t, err)
+	require.Equal(t, "content", string(b))
+
+	_, err = backend.Get(ctx, "artifacts/b")
+	require.True(t, IsObjectNotFound(err))
+
+	objects, err := backend.List(ctx, "logs/")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	require.Equal(t, "logs/b/log.1", objects[0].Key)
+	require.Equal(t, int64(3), objects[0].Size)
+
+	require.NoError(t, backend.Delete(ctx, "artifacts/a"))
+
+	objects, err = backend.List(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+
+	_, err = NewGcsBackend("bucket", "", "", "")
+	require.ErrorContains(t, err, "HMAC key is required")
+}
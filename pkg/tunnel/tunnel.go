@@ -0,0 +1,136 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tunnel
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/hashicorp/yamux"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var ErrPortUnreachable = errors.New("port is unreachable through the tunnel")
+
+// The agent answers every stream with a status byte once it dialed the requested port
+const (
+	statusOk byte = iota
+	statusUnreachable
+)
+
+// Time in which the agent must answer a stream if the context of the dial has no deadline
+const handshakeTimeout = 30 * time.Second
+
+// Open starts the multiplexed session on the server end of the tunnel connection. Streams are opened by the server
+func Open(conn net.Conn) (*yamux.Session, error) {
+	return yamux.Client(conn, sessionConfig())
+}
+
+// Accept starts the multiplexed session on the agent end of the tunnel connection. Streams are accepted by the agent
+func Accept(conn net.Conn) (*yamux.Session, error) {
+	return yamux.Server(conn, sessionConfig())
+}
+
+// Dial opens a stream to a port of the project through the session
+func Dial(ctx context.Context, session *yamux.Session, port uint16) (net.Conn, error) {
+	stream, err := session.OpenStream()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(handshakeTimeout)
+	}
+	stream.SetDeadline(deadline)
+
+	err = binary.Write(stream, binary.BigEndian, port)
+	if err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	status := make([]byte, 1)
+	_, err = io.ReadFull(stream, status)
+	if err != nil {
+		stream.Close()
+		return nil, err
+	}
+
+	if status[0] != statusOk {
+		stream.Close()
+		return nil, fmt.Errorf("%w: %d", ErrPortUnreachable, port)
+	}
+
+	stream.SetDeadline(time.Time{})
+
+	return stream, nil
+}
+
+// Serve accepts the streams opened through the session and proxies each of them to the port the server requested.
+// Blocks until the session is closed
+func Serve(session *yamux.Session, dial func(port uint16) (net.Conn, error)) error {
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			if session.IsClosed() {
+				return nil
+			}
+			return err
+		}
+
+		go serveStream(stream, dial)
+	}
+}
+
+func serveStream(stream *yamux.Stream, dial func(port uint16) (net.Conn, error)) {
+	defer stream.Close()
+
+	var port uint16
+	err := binary.Read(stream, binary.BigEndian, &port)
+	if err != nil {
+		log.Debugf("Failed to read the port of a tunnel stream: %v", err)
+		return
+	}
+
+	conn, err := dial(port)
+	if err != nil {
+		log.Debugf("Failed to reach port %d through the tunnel: %v", port, err)
+		stream.Write([]byte{statusUnreachable})
+		return
+	}
+	defer conn.Close()
+
+	_, err = stream.Write([]byte{statusOk})
+	if err != nil {
+		return
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		io.Copy(conn, stream)
+		conn.Close()
+		close(done)
+	}()
+
+	io.Copy(stream, conn)
+	stream.Close()
+
+	<-done
+}
+
+func sessionConfig() *yamux.Config {
+	config := yamux.DefaultConfig()
+	// Keepalives detect tunnels dropped by proxies between the agent and the server
+	config.EnableKeepAlive = true
+	config.LogOutput = io.Discard
+	return config
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tunnel
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTunnel(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	echoPort := uint16(ln.Addr().(*net.TCPAddr).Port)
+
+	serverConn, agentConn := net.Pipe()
+
+	agentSession, err := Accept(agentConn)
+	require.NoError(t, err)
+	defer agentSession.Close()
+
+	go Serve(agentSession, func(port uint16) (net.Conn, error) {
+		if port != echoPort {
+			return nil, fmt.Errorf("port %d is not allowed", port)
+		}
+		return net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	})
+
+	serverSession, err := Open(serverConn)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	conn, err := Dial(context.Background(), serverSession, echoPort)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	require.Equal(t, "ping", string(buf))
+
+	_, err = Dial(context.Background(), serverSession, echoPort+1)
+	require.ErrorIs(t, err, ErrPortUnreachable)
+}
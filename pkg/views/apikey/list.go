@@ -12,8 +12,9 @@ import (
 )
 
 type RowData struct {
-	Name string
-	Type string
+	Name  string
+	Type  string
+	Admin string
 }
 
 func ListApiKeys(apiKeyList []apiclient.ApiKey) {
@@ -24,7 +25,7 @@ func ListApiKeys(apiKeyList []apiclient.ApiKey) {
 	}
 
 	table := util.GetTableView(data, []string{
-		"Name", "Type",
+		"Name", "Type", "Admin",
 	}, nil, func() {
 		renderUnstyledList(apiKeyList)
 	})
@@ -33,14 +34,18 @@ func ListApiKeys(apiKeyList []apiclient.ApiKey) {
 }
 
 func getRowFromRowData(apiKey apiclient.ApiKey) []string {
-	rowData := RowData{"", ""}
+	rowData := RowData{"", "", ""}
 
 	rowData.Name = apiKey.Name
 	rowData.Type = string(apiKey.Type)
+	if apiKey.GetAdmin() {
+		rowData.Admin = "Yes"
+	}
 
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Type),
+		views.DefaultRowDataStyle.Render(rowData.Admin),
 	}
 
 	return row
@@ -54,6 +59,10 @@ func renderUnstyledList(apiKeyList []apiclient.ApiKey) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("API Key Type: "), apiKey.Type) + "\n\n"
 
+		if apiKey.GetAdmin() {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Admin: "), "Yes") + "\n\n"
+		}
+
 		if apiKey.Name != apiKeyList[len(apiKeyList)-1].Name {
 			output += views.SeparatorString + "\n\n"
 		}
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package artifact
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListArtifacts(artifactList []apiclient.Artifact) {
+	if len(artifactList) == 0 {
+		views_util.NotifyEmptyArtifactList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, a := range artifactList {
+		data = append(data, getRowFromArtifact(a))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Workspace", "Project", "Path", "Size", "Created",
+	}, nil, func() {
+		renderUnstyledList(artifactList)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowFromArtifact(a apiclient.Artifact) []string {
+	return []string{
+		views.NameStyle.Render(a.Id),
+		views.DefaultRowDataStyle.Render(a.WorkspaceId),
+		views.DefaultRowDataStyle.Render(a.ProjectName),
+		views.DefaultRowDataStyle.Render(a.Path),
+		views.DefaultRowDataStyle.Render(formatSize(a.Size)),
+		views.DefaultRowDataStyle.Render(util.FormatTimestamp(a.CreatedAt)),
+	}
+}
+
+func renderUnstyledList(artifactList []apiclient.Artifact) {
+	output := "\n"
+
+	for _, a := range artifactList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), a.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspace: "), a.WorkspaceId) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), a.ProjectName) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Path: "), a.Path) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Size: "), formatSize(a.Size)) + "\n\n"
+
+		if a.Id != artifactList[len(artifactList)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func formatSize(size int32) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := int64(size) / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
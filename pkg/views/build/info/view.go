@@ -35,6 +35,8 @@ func Render(b *apiclient.Build, apiServerConfig *apiclient.ServerConfig, forceUn
 
 	output += getInfoLine("State", string(b.State)) + "\n"
 
+	output += getInfoLine("Priority", string(b.Priority)) + "\n"
+
 	output += getInfoLine("Repository", b.Repository.Url) + "\n"
 
 	if b.Image != nil {
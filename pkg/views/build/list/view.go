@@ -17,6 +17,7 @@ import (
 type rowData struct {
 	Id         string
 	State      string
+	Priority   string
 	PrebuildId string
 	CreatedAt  string
 	UpdatedAt  string
@@ -37,7 +38,7 @@ func ListBuilds(buildList []apiclient.Build, apiServerConfig *apiclient.ServerCo
 	}
 
 	table := views_util.GetTableView(data, []string{
-		"ID", "State", "Prebuild ID", "Created", "Updated",
+		"ID", "State", "Priority", "Prebuild ID", "Created", "Updated",
 	}, nil, func() {
 		renderUnstyledList(buildList, apiServerConfig)
 	})
@@ -68,6 +69,7 @@ func getRowFromRowData(build apiclient.Build) []string {
 
 	data.Id = build.Id + views_util.AdditionalPropertyPadding
 	data.State = string(build.State)
+	data.Priority = string(build.Priority)
 	data.PrebuildId = build.PrebuildId
 	if data.PrebuildId == "" {
 		data.PrebuildId = "/"
@@ -78,6 +80,7 @@ func getRowFromRowData(build apiclient.Build) []string {
 	return []string{
 		views.NameStyle.Render(data.Id),
 		views.DefaultRowDataStyle.Render(data.State),
+		views.DefaultRowDataStyle.Render(data.Priority),
 		views.DefaultRowDataStyle.Render(data.PrebuildId),
 		views.DefaultRowDataStyle.Render(data.CreatedAt),
 		views.DefaultRowDataStyle.Render(data.UpdatedAt),
@@ -0,0 +1,39 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildsecret
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListBuildSecrets(buildSecrets []apiclient.BuildSecret) {
+	if len(buildSecrets) == 0 {
+		views.RenderInfoMessage("No build secrets set. Set one with 'daytona build-secret set NAME'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range buildSecrets {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Name),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(s.UpdatedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Updated",
+	}, nil, func() {
+		for _, s := range buildSecrets {
+			fmt.Printf("%s (updated %s)\n", s.Name, util.FormatTimestamp(s.UpdatedAt))
+		}
+	})
+
+	fmt.Println(table)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/common"
 	"golang.org/x/term"
 )
 
@@ -80,6 +81,23 @@ func RenderBorderedMessage(message string) {
 	fmt.Println(GetBorderedMessage(message))
 }
 
+// RenderError prints the error to stderr along with its code and remediation hint, if the error has one
+func RenderError(err error) {
+	renderer := lipgloss.NewRenderer(os.Stderr)
+	message := "Error: " + err.Error()
+
+	codedErr, ok := common.GetCodedError(err)
+	if ok {
+		message = fmt.Sprintf("Error [%s]: %s", codedErr.Code, err.Error())
+	}
+
+	fmt.Fprintln(os.Stderr, renderer.NewStyle().Foreground(Red).Render(message))
+
+	if ok && codedErr.Hint != "" {
+		fmt.Fprintln(os.Stderr, renderer.NewStyle().Foreground(LightGray).Render("Hint: "+codedErr.Hint))
+	}
+}
+
 func GetListFooter(profileName string, padding *Padding) string {
 	style := lipgloss.NewStyle().Bold(true)
 	style = style.Padding(padding.Top, padding.Right, padding.Bottom, padding.Left)
@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+const propertyNameWidth = 16
+
+var propertyNameStyle = lipgloss.NewStyle().
+	Foreground(views.LightGray)
+
+var propertyValueStyle = lipgloss.NewStyle().
+	Foreground(views.Light).
+	Bold(true)
+
+// RenderInstructions prints how to attach an IDE to the debugger forwarded to the local port
+func RenderInstructions(projectName string, preset project.DebugPreset, localPort uint16) {
+	output := views.GetStyledMainTitle(fmt.Sprintf("Debugging %s with %s", projectName, preset.Debugger)) + "\n\n"
+
+	output += getInfoLine("Address", fmt.Sprintf("localhost:%d", localPort))
+	output += getInfoLine("Project port", fmt.Sprint(preset.Port))
+
+	if preset.LaunchCommand != "" {
+		output += "\n" + views.GetInfoMessage("Start the program under the debugger in the project:") + "\n"
+		output += views.GetListLine(preset.LaunchCommand) + "\n"
+	}
+
+	launchConfig, err := json.MarshalIndent(getVSCodeLaunchConfig(preset, localPort), "", "  ")
+	if err == nil {
+		output += "\n" + views.GetInfoMessage("VS Code: add the configuration to .vscode/launch.json and start it:") + "\n"
+		output += string(launchConfig) + "\n"
+	}
+
+	if jetBrains := getJetBrainsInstructions(preset, localPort); jetBrains != "" {
+		output += "\n" + views.GetInfoMessage("JetBrains IDEs: "+jetBrains) + "\n"
+	}
+
+	views.RenderContainerLayout(output)
+}
+
+func getVSCodeLaunchConfig(preset project.DebugPreset, localPort uint16) map[string]interface{} {
+	name := fmt.Sprintf("Attach to Daytona (%s)", preset.Debugger)
+
+	switch preset.Name {
+	case "go":
+		return map[string]interface{}{
+			"name":    name,
+			"type":    "go",
+			"request": "attach",
+			"mode":    "remote",
+			"host":    "127.0.0.1",
+			"port":    localPort,
+		}
+	case "node":
+		return map[string]interface{}{
+			"name":    name,
+			"type":    "node",
+			"request": "attach",
+			"address": "127.0.0.1",
+			"port":    localPort,
+		}
+	case "jvm":
+		return map[string]interface{}{
+			"name":     name,
+			"type":     "java",
+			"request":  "attach",
+			"hostName": "127.0.0.1",
+			"port":     localPort,
+		}
+	case "python":
+		return map[string]interface{}{
+			"name":    name,
+			"type":    "debugpy",
+			"request": "attach",
+			"connect": map[string]interface{}{
+				"host": "127.0.0.1",
+				"port": localPort,
+			},
+		}
+	}
+
+	return nil
+}
+
+func getJetBrainsInstructions(preset project.DebugPreset, localPort uint16) string {
+	switch preset.Name {
+	case "go":
+		return fmt.Sprintf("create a \"Go Remote\" run configuration with host localhost and port %d", localPort)
+	case "node":
+		return fmt.Sprintf("create an \"Attach to Node.js/Chrome\" run configuration with host localhost and port %d", localPort)
+	case "jvm":
+		return fmt.Sprintf("create a \"Remote JVM Debug\" run configuration in attach mode with host localhost and port %d", localPort)
+	}
+
+	return ""
+}
+
+func getInfoLine(key, value string) string {
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
+}
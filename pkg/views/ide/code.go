@@ -20,3 +20,10 @@ func RenderIdeOpeningMessage(workspaceId, projectName, ideId string, ideList []c
 	}
 	views.RenderInfoMessage(fmt.Sprintf("Opening the project '%s' from workspace '%s' in %s", projectName, workspaceId, ideName))
 }
+
+func RenderDeepLink(link, shareableUrl string) {
+	output := fmt.Sprintf("%s %s", views.GetPropertyKey("Link: "), link) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Shareable URL: "), shareableUrl)
+
+	views.RenderInfoMessage(output)
+}
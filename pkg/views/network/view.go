@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/docker/go-units"
+)
+
+const propertyNameWidth = 16
+
+var propertyNameStyle = lipgloss.NewStyle().
+	Foreground(views.LightGray)
+
+var propertyValueStyle = lipgloss.NewStyle().
+	Foreground(views.Light).
+	Bold(true)
+
+func RenderTestResult(projectName string, result *tailscale.NetworkTestResult) {
+	output := views.GetStyledMainTitle(fmt.Sprintf("Network test of %s", projectName)) + "\n\n"
+
+	connection := "-"
+	if result.Direct() {
+		connection = fmt.Sprintf("Direct (%s)", result.Endpoint)
+	} else if result.DerpRegion != "" {
+		connection = fmt.Sprintf("Relayed through DERP (%s)", result.DerpRegion)
+	}
+	output += getInfoLine("Connection", connection)
+
+	latency := "-"
+	if result.LostPings < result.Pings {
+		latency = fmt.Sprintf("%s avg, %s min, %s max", roundLatency(result.AvgLatency), roundLatency(result.MinLatency), roundLatency(result.MaxLatency))
+	}
+	output += getInfoLine("Latency", latency)
+	output += getInfoLine("Packet loss", fmt.Sprintf("%d/%d", result.LostPings, result.Pings))
+
+	if result.Download > 0 {
+		output += getInfoLine("Download", units.BytesSize(result.Download)+"/s")
+	}
+	if result.Upload > 0 {
+		output += getInfoLine("Upload", units.BytesSize(result.Upload)+"/s")
+	}
+
+	for _, hint := range result.Hints {
+		output += "\n" + views.GetInfoMessage(hint)
+	}
+
+	views.RenderContainerLayout(output)
+}
+
+func getInfoLine(key, value string) string {
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
+}
+
+func roundLatency(latency time.Duration) time.Duration {
+	return latency.Round(100 * time.Microsecond)
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListNotifications(announcements []apiclient.Announcement, acked []string) {
+	if len(announcements) == 0 {
+		views_util.NotifyEmptyNotificationList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, a := range announcements {
+		data = append(data, getRowFromAnnouncement(a, slices.Contains(acked, a.Id)))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Type", "Title", "Window", "Created", "Acknowledged",
+	}, nil, func() {
+		renderUnstyledList(announcements, acked)
+	})
+
+	fmt.Println(table)
+}
+
+// RenderNotification prints an announcement the first time it is shown by the CLI
+func RenderNotification(a apiclient.Announcement) {
+	output := views.GetPropertyKey(fmt.Sprintf("[%s] %s", strings.ToUpper(string(a.Type)), a.Title))
+	if a.Message != nil && *a.Message != "" {
+		output += "\n" + *a.Message
+	}
+	if window := getWindow(a); window != "" {
+		output += "\n" + window
+	}
+	output += fmt.Sprintf("\n\nRun `daytona notifications ack %s` to dismiss", a.Id)
+
+	views.RenderBorderedMessage(output)
+}
+
+// RenderImpersonationBanner reminds administrators that their commands act on behalf of another user
+func RenderImpersonationBanner(user string, expiresAt time.Time) {
+	views.RenderBorderedMessage(views.InactiveStyle.Render(fmt.Sprintf("Impersonating %s until %s. Requests are recorded in the audit log.", user, expiresAt.Local().Format("15:04"))) +
+		"\nRun `daytona server impersonate end` to stop impersonating")
+}
+
+func getRowFromAnnouncement(a apiclient.Announcement, acked bool) []string {
+	ackedLabel := "No"
+	if acked {
+		ackedLabel = "Yes"
+	}
+
+	return []string{
+		views.NameStyle.Render(a.Id),
+		views.DefaultRowDataStyle.Render(string(a.Type)),
+		views.DefaultRowDataStyle.Render(a.Title),
+		views.DefaultRowDataStyle.Render(getWindow(a)),
+		views.DefaultRowDataStyle.Render(util.FormatTimestamp(a.CreatedAt)),
+		views.DefaultRowDataStyle.Render(ackedLabel),
+	}
+}
+
+func renderUnstyledList(announcements []apiclient.Announcement, acked []string) {
+	output := "\n"
+
+	for _, a := range announcements {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("ID: "), a.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Type: "), a.Type) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Title: "), a.Title) + "\n\n"
+		if a.Message != nil && *a.Message != "" {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Message: "), *a.Message) + "\n\n"
+		}
+		if window := getWindow(a); window != "" {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Window: "), window) + "\n\n"
+		}
+		output += fmt.Sprintf("%s %t", views.GetPropertyKey("Acknowledged: "), slices.Contains(acked, a.Id)) + "\n\n"
+
+		if a.Id != announcements[len(announcements)-1].Id {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getWindow(a apiclient.Announcement) string {
+	switch {
+	case a.StartsAt != nil && a.EndsAt != nil:
+		return fmt.Sprintf("%s - %s", *a.StartsAt, *a.EndsAt)
+	case a.StartsAt != nil:
+		return "from " + *a.StartsAt
+	case a.EndsAt != nil:
+		return "until " + *a.EndsAt
+	}
+
+	return ""
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package organization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListOrganizations(organizationList []apiclient.Organization, activeOrganization string) {
+	if len(organizationList) == 0 {
+		views.RenderInfoMessage("You are not a member of any organization.\nCreate one with 'daytona organization create'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, o := range organizationList {
+		data = append(data, getRowFromOrganization(o, activeOrganization))
+	}
+
+	table := util.GetTableView(data, []string{
+		"Name", "Members", "Max Workspaces",
+	}, nil, func() {
+		renderUnstyledList(organizationList)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowFromOrganization(o apiclient.Organization, activeOrganization string) []string {
+	name := o.Name
+	if activeOrganization != "" && (activeOrganization == o.Name || activeOrganization == o.Id) {
+		name = fmt.Sprintf("%s (active)", name)
+	}
+
+	return []string{
+		views.NameStyle.Render(name),
+		views.DefaultRowDataStyle.Render(strings.Join(o.Members, ", ")),
+		views.DefaultRowDataStyle.Render(getMaxWorkspaces(o)),
+	}
+}
+
+func getMaxWorkspaces(o apiclient.Organization) string {
+	if o.Quota.MaxWorkspaces <= 0 {
+		return "unlimited"
+	}
+
+	return fmt.Sprint(o.Quota.MaxWorkspaces)
+}
+
+func renderUnstyledList(organizationList []apiclient.Organization) {
+	output := "\n"
+
+	for i, o := range organizationList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Organization Name: "), o.Name) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Organization ID: "), o.Id) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Members: "), strings.Join(o.Members, ", ")) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Admins: "), strings.Join(o.Admins, ", ")) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Max Workspaces: "), getMaxWorkspaces(o)) + "\n\n"
+
+		if i < len(organizationList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/portforward"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// Interval at which the forwards are listed again while the view is open
+const forwardRefreshInterval = time.Second
+
+// PortForwarder is the set of ports forwarded by daytona forward
+type PortForwarder interface {
+	List() []portforward.Status
+	// Add starts forwarding a port given in the REMOTE_PORT[:LOCAL_PORT] format
+	Add(spec string) error
+	Remove(remotePort uint16) error
+}
+
+type forwardRefreshMsg struct{}
+
+type forwardModel struct {
+	title     string
+	forwarder PortForwarder
+	forwards  []portforward.Status
+	cursor    int
+	input     textinput.Model
+	adding    bool
+	message   string
+	err       error
+}
+
+// RunPortForwardView shows the forwarded ports until the user quits. Ports are added and removed while the view is open
+func RunPortForwardView(title string, forwarder PortForwarder) error {
+	input := textinput.New()
+	input.Placeholder = "REMOTE_PORT[:LOCAL_PORT]"
+	input.Prompt = "Forward port: "
+	input.PromptStyle = lipgloss.NewStyle().Foreground(views.Green)
+	input.CharLimit = 11
+
+	m := forwardModel{
+		title:     title,
+		forwarder: forwarder,
+		forwards:  forwarder.List(),
+		input:     input,
+	}
+
+	_, err := tea.NewProgram(m).Run()
+	return err
+}
+
+func (m forwardModel) Init() tea.Cmd {
+	return refreshForwards()
+}
+
+func refreshForwards() tea.Cmd {
+	return tea.Tick(forwardRefreshInterval, func(time.Time) tea.Msg {
+		return forwardRefreshMsg{}
+	})
+}
+
+func (m forwardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case forwardRefreshMsg:
+		m.forwards = m.forwarder.List()
+		m.cursor = min(m.cursor, max(len(m.forwards)-1, 0))
+		return m, refreshForwards()
+	case tea.KeyMsg:
+		if m.adding {
+			return m.updateInput(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		case "up", "k":
+			m.cursor = max(m.cursor-1, 0)
+		case "down", "j":
+			m.cursor = min(m.cursor+1, max(len(m.forwards)-1, 0))
+		case "a", "+":
+			m.adding = true
+			m.message, m.err = "", nil
+			m.input.Reset()
+			return m, m.input.Focus()
+		case "d", "x", "-", "delete", "backspace":
+			if len(m.forwards) == 0 {
+				return m, nil
+			}
+			remotePort := m.forwards[m.cursor].RemotePort
+			m.err = m.forwarder.Remove(remotePort)
+			if m.err == nil {
+				m.message = fmt.Sprintf("Stopped forwarding port %d", remotePort)
+			}
+			m.forwards = m.forwarder.List()
+			m.cursor = min(m.cursor, max(len(m.forwards)-1, 0))
+		}
+	}
+
+	return m, nil
+}
+
+func (m forwardModel) updateInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		m.adding = false
+		m.input.Blur()
+		return m, nil
+	case "enter":
+		spec := strings.TrimSpace(m.input.Value())
+		m.adding = false
+		m.input.Blur()
+		if spec == "" {
+			return m, nil
+		}
+		m.err = m.forwarder.Add(spec)
+		if m.err == nil {
+			m.message = fmt.Sprintf("Forwarding port %s", spec)
+		}
+		m.forwards = m.forwarder.List()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m forwardModel) View() string {
+	output := views.GetStyledMainTitle(m.title) + "\n\n"
+
+	if len(m.forwards) == 0 {
+		output += lipgloss.NewStyle().Foreground(views.Gray).Render("No ports forwarded") + "\n"
+	}
+
+	for i, f := range m.forwards {
+		line := fmt.Sprintf("%-24s → %-6d %s", fmt.Sprintf("http://localhost:%d", f.LocalPort), f.RemotePort, getForwardState(f))
+		if i == m.cursor {
+			output += lipgloss.NewStyle().Foreground(views.Green).Bold(true).Render("> "+line) + "\n"
+		} else {
+			output += "  " + line + "\n"
+		}
+	}
+
+	output += "\n"
+
+	if m.adding {
+		output += m.input.View() + "\n"
+	} else if m.err != nil {
+		output += lipgloss.NewStyle().Foreground(views.Red).Render(m.err.Error()) + "\n"
+	} else if m.message != "" {
+		output += lipgloss.NewStyle().Foreground(views.Gray).Render(m.message) + "\n"
+	}
+
+	help := "a: add • d: remove • ↑/↓: select • q: quit"
+	if m.adding {
+		help = "enter: forward • esc: cancel"
+	}
+
+	return output + "\n" + lipgloss.NewStyle().Foreground(views.Gray).Render(help) + "\n"
+}
+
+func getForwardState(f portforward.Status) string {
+	if f.Error != "" {
+		return lipgloss.NewStyle().Foreground(views.Orange).Render("unreachable: " + f.Error)
+	}
+
+	switch f.Connections {
+	case 0:
+		return lipgloss.NewStyle().Foreground(views.Gray).Render("idle")
+	case 1:
+		return "1 connection"
+	default:
+		return fmt.Sprintf("%d connections", f.Connections)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListServiceEndpoints(endpoints []apiclient.ServiceEndpoint) {
+	if len(endpoints) == 0 {
+		views.RenderInfoMessage("No services registered.\nRegister services of a project with the DAYTONA_AGENT_SERVICES environment variable, e.g. 'api:8080'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, e := range endpoints {
+		data = append(data, []string{
+			views.NameStyle.Render(e.Name),
+			views.DefaultRowDataStyle.Render(e.WorkspaceName),
+			views.DefaultRowDataStyle.Render(e.ProjectName),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(e.Port)),
+			views.DefaultRowDataStyle.Render(string(e.Protocol)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Workspace", "Project", "Port", "Protocol",
+	}, nil, func() {
+		for _, e := range endpoints {
+			fmt.Printf("%s:%d (%s)\n", e.Name, e.Port, e.Protocol)
+		}
+	})
+
+	fmt.Println(table)
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+// PortMapping is a port declared by a project, or detected listening in it, and the local port it is forwarded to
+type PortMapping struct {
+	Project    string `json:"project"`
+	Name       string `json:"name"`
+	Port       uint16 `json:"port"`
+	Protocol   string `json:"protocol"`
+	Visibility string `json:"visibility"`
+	LocalPort  uint16 `json:"localPort"`
+	// Detected is set for ports a process of the project listens on that the project does not declare
+	Detected bool `json:"detected"`
+}
+
+func ListPortMappings(mappings []PortMapping) {
+	if len(mappings) == 0 {
+		views.RenderInfoMessage("No ports declared or detected.\nDeclare ports in the project config with --port 'name=port'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, m := range mappings {
+		data = append(data, []string{
+			views.NameStyle.Render(m.Name),
+			views.DefaultRowDataStyle.Render(m.Project),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(m.Port)),
+			views.DefaultRowDataStyle.Render(m.Protocol),
+			views.DefaultRowDataStyle.Render(m.Visibility),
+			views.DefaultRowDataStyle.Render(getLocalAddress(m)),
+			views.DefaultRowDataStyle.Render(getSource(m)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Project", "Port", "Protocol", "Visibility", "Local", "Source",
+	}, nil, func() {
+		output := "\n"
+		for _, m := range mappings {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), m.Name) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), m.Project) + "\n\n"
+			output += fmt.Sprintf("%s %d", views.GetPropertyKey("Port: "), m.Port) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Local: "), getLocalAddress(m)) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Source: "), getSource(m)) + "\n\n"
+		}
+		fmt.Println(output)
+	})
+
+	fmt.Println(table)
+}
+
+func getLocalAddress(m PortMapping) string {
+	if m.Protocol == "tcp" {
+		return fmt.Sprintf("localhost:%d", m.LocalPort)
+	}
+
+	return fmt.Sprintf("%s://localhost:%d", m.Protocol, m.LocalPort)
+}
+
+func getSource(m PortMapping) string {
+	if m.Detected {
+		return "detected"
+	}
+
+	return "declared"
+}
+
+// ListLocalPorts renders the local ports allocated in the profile. Workspaces missing from workspaceNames, e.g. because
+// they were deleted, are listed by their id
+func ListLocalPorts(allocations []config.LocalPortAllocation, workspaceNames map[string]string) {
+	if len(allocations) == 0 {
+		views.RenderInfoMessage("No local ports allocated.\nPorts are allocated local ports when they are first forwarded or listed with daytona ports")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, a := range allocations {
+		data = append(data, []string{
+			views.NameStyle.Render(getWorkspaceName(a, workspaceNames)),
+			views.DefaultRowDataStyle.Render(a.ProjectName),
+			views.DefaultRowDataStyle.Render(a.PortName),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("localhost:%d", a.LocalPort)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Workspace", "Project", "Port", "Local",
+	}, nil, func() {
+		output := "\n"
+		for _, a := range allocations {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspace: "), getWorkspaceName(a, workspaceNames)) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), a.ProjectName) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Port: "), a.PortName) + "\n\n"
+			output += fmt.Sprintf("%s localhost:%d", views.GetPropertyKey("Local: "), a.LocalPort) + "\n\n"
+		}
+		fmt.Println(output)
+	})
+
+	fmt.Println(table)
+}
+
+func getWorkspaceName(a config.LocalPortAllocation, workspaceNames map[string]string) string {
+	if name, ok := workspaceNames[a.WorkspaceId]; ok {
+		return name
+	}
+
+	return a.WorkspaceId
+}
@@ -55,6 +55,18 @@ func Render(prebuild *apiclient.PrebuildDTO, forceUnstyled bool) {
 		}
 	}
 
+	if prebuild.Matrix != nil {
+		if len(prebuild.Matrix.DevcontainerPaths) > 0 {
+			output += getInfoLine("Devcontainer paths", strings.Join(prebuild.Matrix.DevcontainerPaths, ", ")) + "\n"
+		}
+		if len(prebuild.Matrix.Images) > 0 {
+			output += getInfoLine("Images", strings.Join(prebuild.Matrix.Images, ", ")) + "\n"
+		}
+		if len(prebuild.Matrix.Architectures) > 0 {
+			output += getInfoLine("Architectures", strings.Join(prebuild.Matrix.Architectures, ", ")) + "\n"
+		}
+	}
+
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(output)
@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package stats
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func RenderStats(stats *apiclient.PrebuildStatsDTO) {
+	if stats.Total == 0 {
+		views.RenderInfoMessage("No workspace creations with a build configuration recorded yet.")
+		return
+	}
+
+	output := fmt.Sprintf("%s %d", views.GetPropertyKey("Project creations: "), stats.Total) + "\n\n"
+	output += fmt.Sprintf("%s %d (%.1f%%)", views.GetPropertyKey("Prebuild hits: "), stats.Hits, stats.HitRate*100) + "\n\n"
+	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Prebuild misses: "), stats.Misses) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Time saved: "), formatTimeSaved(stats.TimeSavedSeconds))
+
+	fmt.Println(output)
+
+	data := [][]string{}
+	for _, r := range stats.Repositories {
+		data = append(data, getRowFromData(r))
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Repository", "Branch", "Hits", "Misses", "Time Saved", "Last Commit",
+	}, nil, func() {
+		renderUnstyledList(stats.Repositories)
+	})
+
+	fmt.Println(table)
+}
+
+func getRowFromData(r apiclient.PrebuildRepositoryStatsDTO) []string {
+	lastCommit := views.InactiveStyle.Render("None")
+	if r.LastCommitSha != nil {
+		lastCommit = views.DefaultRowDataStyle.Render(shortSha(*r.LastCommitSha))
+	}
+
+	return []string{
+		views.NameStyle.Render(r.RepositoryUrl + views_util.AdditionalPropertyPadding),
+		views.DefaultRowDataStyle.Render(views.GetBranchNameLabel(r.Branch)),
+		views.ActiveStyle.Render(strconv.Itoa(int(r.Hits))),
+		views.DefaultRowDataStyle.Render(strconv.Itoa(int(r.Misses))),
+		views.DefaultRowDataStyle.Render(formatTimeSaved(r.TimeSavedSeconds)),
+		lastCommit,
+	}
+}
+
+func renderUnstyledList(repositories []apiclient.PrebuildRepositoryStatsDTO) {
+	output := "\n"
+
+	for i, r := range repositories {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Repository: "), r.RepositoryUrl) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Branch: "), r.Branch) + "\n\n"
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Hits: "), r.Hits) + "\n\n"
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Misses: "), r.Misses) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Time saved: "), formatTimeSaved(r.TimeSavedSeconds)) + "\n\n"
+		if r.LastCommitSha != nil {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Last commit: "), *r.LastCommitSha) + "\n\n"
+		}
+
+		if i < len(repositories)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func formatTimeSaved(seconds int32) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
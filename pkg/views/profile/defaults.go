@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// RenderDefaults prints the defaults that apply while the profile is active
+func RenderDefaults(p config.Profile) {
+	if p.DefaultTarget == "" && p.DefaultIdeId == "" && p.DefaultWorkspaceTtl == "" && len(p.EnvVars) == 0 {
+		return
+	}
+
+	lines := []string{}
+
+	if p.DefaultTarget != "" {
+		lines = append(lines, fmt.Sprintf("%s %s", views.GetPropertyKey("Default Target: "), p.DefaultTarget))
+	}
+
+	if p.DefaultIdeId != "" {
+		lines = append(lines, fmt.Sprintf("%s %s", views.GetPropertyKey("Default IDE: "), p.DefaultIdeId))
+	}
+
+	if p.DefaultWorkspaceTtl != "" {
+		lines = append(lines, fmt.Sprintf("%s %s", views.GetPropertyKey("Default Workspace TTL: "), p.DefaultWorkspaceTtl))
+	}
+
+	if len(p.EnvVars) > 0 {
+		keys := make([]string, 0, len(p.EnvVars))
+		for key := range p.EnvVars {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		lines = append(lines, fmt.Sprintf("%s %s", views.GetPropertyKey("Environment Variables: "), strings.Join(keys, ", ")))
+	}
+
+	views.RenderContainerLayout(views.GetInfoMessage(strings.Join(lines, "\n")))
+}
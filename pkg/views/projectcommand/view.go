@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package projectcommand
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListCommands(projectName string, commands []apiclient.ProjectCommand) {
+	if len(commands) == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("Project %s does not declare any commands.\nAdd commands to its project config with --command 'name=command'", projectName))
+		return
+	}
+
+	data := [][]string{}
+
+	for _, c := range commands {
+		data = append(data, []string{
+			views.NameStyle.Render(c.Name),
+			views.DefaultRowDataStyle.Render(c.Command),
+			views.DefaultRowDataStyle.Render(c.GetDescription()),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Command", "Description",
+	}, nil, func() {
+		output := "\n"
+		for _, c := range commands {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), c.Name) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Command: "), c.Command) + "\n\n"
+		}
+		fmt.Println(output)
+	})
+
+	fmt.Println(table)
+}
+
+func ListCommandRuns(runs []apiclient.CommandRun) {
+	if len(runs) == 0 {
+		views.RenderInfoMessage("No command runs found.\nRun a project command with 'daytona cmd run'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, r := range runs {
+		data = append(data, []string{
+			views.NameStyle.Render(r.Id),
+			views.DefaultRowDataStyle.Render(r.WorkspaceId),
+			views.DefaultRowDataStyle.Render(r.ProjectName),
+			views.DefaultRowDataStyle.Render(r.CommandName),
+			views.DefaultRowDataStyle.Render(getResult(r)),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(r.StartedAt)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Workspace", "Project", "Command", "Result", "Started",
+	}, nil, func() {
+		for _, r := range runs {
+			RenderCommandRun(r, false)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+// RenderCommandRun prints the result of a run and, if withOutput is set, the output of the command
+func RenderCommandRun(r apiclient.CommandRun, withOutput bool) {
+	output := "\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Run ID: "), r.Id) + "\n\n"
+	output += fmt.Sprintf("%s %s (%s)", views.GetPropertyKey("Command: "), r.CommandName, r.Command) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Result: "), getResult(r)) + "\n\n"
+
+	if r.Error != nil && *r.Error != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Error: "), *r.Error) + "\n\n"
+	}
+
+	if withOutput && r.Output != "" {
+		if r.Truncated {
+			output += "... output truncated ...\n"
+		}
+		output += strings.TrimRight(r.Output, "\n") + "\n"
+	}
+
+	fmt.Println(output)
+}
+
+func getResult(r apiclient.CommandRun) string {
+	switch {
+	case r.TimedOut:
+		return "timed out"
+	case r.ExitCode != nil:
+		return fmt.Sprintf("%s (exit code %d)", r.State, *r.ExitCode)
+	}
+
+	return string(r.State)
+}
@@ -73,6 +73,10 @@ func Render(projectConfig *apiclient.ProjectConfig, apiServerConfig *apiclient.S
 		output += getInfoLine("Devcontainer path", projectConfig.BuildConfig.Devcontainer.FilePath) + "\n"
 	}
 
+	if len(projectConfig.BuildSecrets) > 0 {
+		output += getInfoLine("Build secrets", strings.Join(projectConfig.BuildSecrets, ", ")) + "\n"
+	}
+
 	prebuildCount := len(projectConfig.Prebuilds)
 
 	if prebuildCount > 0 {
@@ -0,0 +1,63 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListRollouts(rolloutList []apiclient.AgentRolloutStatus) {
+	if len(rolloutList) == 0 {
+		views.RenderInfoMessage("No agent rollouts found.\nStart one with 'daytona server rollout start'")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, r := range rolloutList {
+		data = append(data, []string{
+			views.NameStyle.Render(r.Id),
+			views.DefaultRowDataStyle.Render(r.AgentVersion),
+			views.DefaultRowDataStyle.Render(string(r.State)),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%d%%", r.Percentage)),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%d/%d", r.Health.Upgraded, r.Health.Upgraded+r.Health.Pending+r.Health.Unhealthy)),
+			views.DefaultRowDataStyle.Render(formatRate(r.Health.ErrorRate)),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"ID", "Agent Version", "State", "Percentage", "Upgraded", "Error Rate",
+	}, nil, func() {
+		for _, r := range rolloutList {
+			RenderStatus(&r)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func RenderStatus(r *apiclient.AgentRolloutStatus) {
+	output := "\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Rollout ID: "), r.Id) + "\n\n"
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("State: "), r.State) + "\n\n"
+	output += fmt.Sprintf("%s %s -> %s", views.GetPropertyKey("Agent Version: "), r.PreviousVersion, r.AgentVersion) + "\n\n"
+	output += fmt.Sprintf("%s %d%% (%d workspaces)", views.GetPropertyKey("Canary: "), r.Percentage, len(r.WorkspaceIds)) + "\n\n"
+	output += fmt.Sprintf("%s %d upgraded, %d pending, %d unhealthy", views.GetPropertyKey("Projects: "), r.Health.Upgraded, r.Health.Pending, r.Health.Unhealthy) + "\n\n"
+	output += fmt.Sprintf("%s %s (baseline %s)", views.GetPropertyKey("Error Rate: "), formatRate(r.Health.ErrorRate), formatRate(r.Health.BaselineErrorRate)) + "\n\n"
+
+	if r.MaxErrorRate > 0 {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Automatic Rollback Above: "), formatRate(r.MaxErrorRate)) + "\n\n"
+	}
+
+	fmt.Println(output)
+}
+
+func formatRate(rate float32) string {
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListAuditEvents(events []apiclient.AuditEvent) {
+	if len(events) == 0 {
+		views.RenderInfoMessage("No audit events found")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, e := range events {
+		// Requests made during impersonation are highlighted so they stand out from the actions of the user
+		actor := views.DefaultRowDataStyle.Render(e.Actor)
+		if e.ImpersonatedBy != nil && *e.ImpersonatedBy != "" {
+			actor = views.InactiveStyle.Render(fmt.Sprintf("%s (impersonated by %s)", e.Actor, *e.ImpersonatedBy))
+		}
+
+		data = append(data, []string{
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(e.CreatedAt)),
+			views.NameStyle.Render(string(e.Action)),
+			views.DefaultRowDataStyle.Render(e.Resource),
+			actor,
+			views.DefaultRowDataStyle.Render(e.Message),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Time", "Action", "Resource", "Actor", "Message",
+	}, nil, func() {
+		for _, e := range events {
+			actor := e.Actor
+			if e.ImpersonatedBy != nil && *e.ImpersonatedBy != "" {
+				actor = fmt.Sprintf("%s (impersonated by %s)", e.Actor, *e.ImpersonatedBy)
+			}
+			fmt.Printf("%s %s %s by %s: %s\n", e.CreatedAt, e.Action, e.Resource, actor, e.Message)
+		}
+	})
+
+	fmt.Println(table)
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListCleanupCandidates(candidates []apiclient.CleanupCandidate) {
+	if len(candidates) == 0 {
+		views.RenderInfoMessage("No workspaces would be cleaned up")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, c := range candidates {
+		action := string(c.Action)
+		if c.GetDryRun() {
+			action += " (dry run)"
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(c.WorkspaceName),
+			views.DefaultRowDataStyle.Render(action),
+			views.DefaultRowDataStyle.Render(c.Policy),
+			views.DefaultRowDataStyle.Render(c.Reason),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Workspace", "Action", "Policy", "Reason",
+	}, nil, func() {
+		for _, c := range candidates {
+			fmt.Printf("%s %s: %s (policy %s)\n", c.Action, c.WorkspaceName, c.Reason, c.Policy)
+		}
+	})
+
+	fmt.Println(table)
+}
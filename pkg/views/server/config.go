@@ -5,6 +5,7 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/internal/util"
@@ -55,12 +56,54 @@ func RenderConfig(config *server.Config) {
 		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Local Builder Registry Port: "), config.LocalBuilderRegistryPort) + "\n\n"
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Local Builder Registry Image: "), config.LocalBuilderRegistryImage) + "\n\n"
+	} else if config.BuilderRegistryServer == "embedded" {
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Embedded Registry Port: "), config.LocalBuilderRegistryPort) + "\n\n"
+
+		if config.EmbeddedRegistry != nil {
+			output += fmt.Sprintf("%s %d MB", views.GetPropertyKey("Embedded Registry Quota: "), config.EmbeddedRegistry.QuotaMb) + "\n\n"
+
+			output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("Embedded Registry GC Interval: "), config.EmbeddedRegistry.GcIntervalMinutes) + "\n\n"
+		}
 	} else {
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Builder Registry: "), config.BuilderRegistryServer) + "\n\n"
 	}
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Build Image Namespace: "), config.BuildImageNamespace) + "\n\n"
 
+	if config.ImagePolicy != nil {
+		if len(config.ImagePolicy.AllowedRegistries) > 0 {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Allowed Image Registries: "), strings.Join(config.ImagePolicy.AllowedRegistries, ", ")) + "\n\n"
+		}
+
+		output += fmt.Sprintf("%s %t", views.GetPropertyKey("Verify Image Signatures: "), config.ImagePolicy.VerifySignatures) + "\n\n"
+	}
+
+	if config.Impersonation != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Impersonation Admins: "), strings.Join(config.Impersonation.Admins, ", ")) + "\n\n"
+	}
+
+	if config.Metering != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Metering Exporter: "), config.Metering.Exporter) + "\n\n"
+	}
+
+	if config.PreviewDns != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Preview DNS Provider: "), config.PreviewDns.Provider) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Preview DNS Target: "), config.PreviewDns.Target) + "\n\n"
+	}
+
+	if config.Storage != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Storage Provider: "), config.Storage.Provider) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Storage Bucket: "), config.Storage.Bucket) + "\n\n"
+	}
+
+	if config.StateHistory != nil {
+		output += fmt.Sprintf("%s %d minutes", views.GetPropertyKey("State History Interval: "), config.StateHistory.IntervalMinutes) + "\n\n"
+
+		output += fmt.Sprintf("%s %d days", views.GetPropertyKey("State History Retention: "), config.StateHistory.RetentionDays) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Providers Dir: "), config.ProvidersDir) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Registry URL: "), config.RegistryUrl) + "\n\n"
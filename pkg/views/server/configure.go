@@ -7,6 +7,7 @@ import (
 	"errors"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
@@ -49,6 +50,9 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	builderContainerRegistryOptions := []huh.Option[string]{{
 		Key:   "Local registry managed by Daytona",
 		Value: "local",
+	}, {
+		Key:   "Embedded registry served by Daytona",
+		Value: "embedded",
 	}}
 	for _, cr := range containerRegistries {
 		builderContainerRegistryOptions = append(builderContainerRegistryOptions, huh.Option[string]{Key: cr.Server, Value: cr.Server})
@@ -58,6 +62,29 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 	logFileMaxBackups := strconv.Itoa(int(m.config.LogFile.MaxBackups))
 	logFileMaxAge := strconv.Itoa(int(m.config.LogFile.MaxAge))
 
+	if m.config.ImagePolicy == nil {
+		m.config.ImagePolicy = &apiclient.ImagePolicyConfig{}
+	}
+	if m.config.ImagePolicy.VerifySignatures == nil {
+		m.config.ImagePolicy.VerifySignatures = new(bool)
+	}
+	if m.config.ImagePolicy.CosignPublicKey == nil {
+		m.config.ImagePolicy.CosignPublicKey = new(string)
+	}
+	allowedRegistriesView := strings.Join(m.config.ImagePolicy.AllowedRegistries, ",")
+
+	if m.config.EmbeddedRegistry == nil {
+		m.config.EmbeddedRegistry = &apiclient.EmbeddedRegistryConfig{}
+	}
+	if m.config.EmbeddedRegistry.QuotaMb == nil {
+		m.config.EmbeddedRegistry.QuotaMb = new(int32)
+	}
+	if m.config.EmbeddedRegistry.GcIntervalMinutes == nil {
+		m.config.EmbeddedRegistry.GcIntervalMinutes = new(int32)
+	}
+	embeddedRegistryQuotaView := strconv.Itoa(int(*m.config.EmbeddedRegistry.QuotaMb))
+	embeddedRegistryGcIntervalView := strconv.Itoa(int(*m.config.EmbeddedRegistry.GcIntervalMinutes))
+
 	return huh.NewForm(
 		huh.NewGroup(
 			huh.NewInput().
@@ -100,6 +127,29 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 				Description("Namespace to be used when tagging and pushing build images").
 				Value(m.config.BuildImageNamespace),
 		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Allowed Image Registries").
+				Description("Comma separated list of registries project images can be pulled from. Leave empty to allow all").
+				Value(&allowedRegistriesView).
+				Validate(func(s string) error {
+					m.config.ImagePolicy.AllowedRegistries = []string{}
+					for _, registry := range strings.Split(s, ",") {
+						if registry = strings.TrimSpace(registry); registry != "" {
+							m.config.ImagePolicy.AllowedRegistries = append(m.config.ImagePolicy.AllowedRegistries, registry)
+						}
+					}
+					return nil
+				}),
+			huh.NewConfirm().
+				Title("Verify Image Signatures").
+				Description("Requires cosign to be installed on the server host").
+				Value(m.config.ImagePolicy.VerifySignatures),
+			huh.NewInput().
+				Title("Cosign Public Key").
+				Description("Path, URL or KMS URI of the key used to verify image signatures").
+				Value(m.config.ImagePolicy.CosignPublicKey),
+		),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Local Builder Registry Port").
@@ -111,6 +161,24 @@ func (m *Model) createForm(containerRegistries []apiclient.ContainerRegistry) *h
 		).WithHideFunc(func() bool {
 			return m.config.BuilderRegistryServer != "local"
 		}),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Embedded Registry Port").
+				Value(&localBuilderRegistryPort).
+				Validate(createPortValidator(m.config, &localBuilderRegistryPort, &m.config.LocalBuilderRegistryPort)),
+			huh.NewInput().
+				Title("Embedded Registry Quota").
+				Description("In megabytes. 0 disables the limit").
+				Value(&embeddedRegistryQuotaView).
+				Validate(createNonNegativeIntValidator(&embeddedRegistryQuotaView, m.config.EmbeddedRegistry.QuotaMb)),
+			huh.NewInput().
+				Title("Embedded Registry Garbage Collection Interval").
+				Description("In minutes. 0 disables garbage collection").
+				Value(&embeddedRegistryGcIntervalView).
+				Validate(createNonNegativeIntValidator(&embeddedRegistryGcIntervalView, m.config.EmbeddedRegistry.GcIntervalMinutes)),
+		).WithHideFunc(func() bool {
+			return m.config.BuilderRegistryServer != "embedded"
+		}),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("API Port").
@@ -283,3 +351,20 @@ func createIntValidator(viewValue *string, value *int32) func(string) error {
 		return nil
 	}
 }
+
+func createNonNegativeIntValidator(viewValue *string, value *int32) func(string) error {
+	return func(string) error {
+		validateInt, err := strconv.Atoi(*viewValue)
+		if err != nil {
+			return errors.New("failed to parse int")
+		}
+
+		if validateInt < 0 {
+			return errors.New("int out of range")
+		}
+
+		*value = int32(validateInt)
+
+		return nil
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListCreationTimings(report []apiclient.CreationPhaseReport) {
+	if len(report) == 0 {
+		views.RenderInfoMessage("No creation timings recorded")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, r := range report {
+		data = append(data, []string{
+			views.NameStyle.Render(string(r.Phase)),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%s (%s)", r.Target, r.Provider)),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(r.Count)),
+			views.DefaultRowDataStyle.Render(formatMs(r.P50Ms)),
+			views.DefaultRowDataStyle.Render(formatMs(r.P90Ms)),
+			views.DefaultRowDataStyle.Render(formatMs(r.P99Ms)),
+			views.DefaultRowDataStyle.Render(formatMs(r.MaxMs)),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Phase", "Target", "Count", "P50", "P90", "P99", "Max",
+	}, nil, func() {
+		for _, r := range report {
+			fmt.Printf("%s on %s (%s): p50 %s, p90 %s, p99 %s, max %s over %d projects\n", r.Phase, r.Target, r.Provider, formatMs(r.P50Ms), formatMs(r.P90Ms), formatMs(r.P99Ms), formatMs(r.MaxMs), r.Count)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func formatMs(ms int32) string {
+	return (time.Duration(ms) * time.Millisecond).Round(10 * time.Millisecond).String()
+}
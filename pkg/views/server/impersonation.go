@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListImpersonationSessions(sessions []apiclient.ImpersonationSession) {
+	if len(sessions) == 0 {
+		views.RenderInfoMessage("No impersonation sessions found")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range sessions {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Id),
+			views.DefaultRowDataStyle.Render(s.Admin),
+			views.DefaultRowDataStyle.Render(s.User),
+			views.DefaultRowDataStyle.Render(s.Reason),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(s.CreatedAt)),
+			getImpersonationStatus(s),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Admin", "User", "Reason", "Started", "Status",
+	}, nil, func() {
+		for _, s := range sessions {
+			fmt.Printf("%s %s impersonating %s: %s (expires %s)\n", s.Id, s.Admin, s.User, s.Reason, s.ExpiresAt)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func getImpersonationStatus(s apiclient.ImpersonationSession) string {
+	if s.EndedAt != nil {
+		return views.DefaultRowDataStyle.Render("Ended")
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, s.ExpiresAt)
+	if err == nil && time.Now().After(expiresAt) {
+		return views.DefaultRowDataStyle.Render("Expired")
+	}
+
+	return views.ActiveStyle.Render(fmt.Sprintf("Active until %s", formatExpiresAt(s.ExpiresAt)))
+}
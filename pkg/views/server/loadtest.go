@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/loadtest"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/docker/go-units"
+)
+
+func RenderLoadtestReport(report *loadtest.Report) {
+	data := [][]string{}
+
+	for _, o := range report.Operations {
+		data = append(data, []string{
+			views.NameStyle.Render(string(o.Operation)),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(o.Count)),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(o.Errors)),
+			views.DefaultRowDataStyle.Render(fmt.Sprintf("%.1f/s", o.Throughput)),
+			views.DefaultRowDataStyle.Render(formatLatency(o.P50)),
+			views.DefaultRowDataStyle.Render(formatLatency(o.P90)),
+			views.DefaultRowDataStyle.Render(formatLatency(o.P99)),
+			views.DefaultRowDataStyle.Render(formatLatency(o.Max)),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Operation", "Count", "Errors", "Throughput", "P50", "P90", "P99", "Max",
+	}, nil, func() {
+		for _, o := range report.Operations {
+			fmt.Printf("%s: %d completed, %d failed, %.1f/s, p50 %s, p90 %s, p99 %s, max %s\n", o.Operation, o.Count, o.Errors, o.Throughput, formatLatency(o.P50), formatLatency(o.P90), formatLatency(o.P99), formatLatency(o.Max))
+		}
+	})
+
+	fmt.Println(table)
+
+	for _, o := range report.Operations {
+		if o.LastError != "" {
+			views.RenderInfoMessage(fmt.Sprintf("Last %s error: %s", o.Operation, o.LastError))
+		}
+	}
+
+	r := report.Resources
+	views.RenderContainerLayout(views.GetInfoMessage(fmt.Sprintf(
+		"%d workspaces in %s: %.1fs CPU, %s peak heap, %d peak goroutines, %d GC cycles",
+		report.Config.Workspaces, report.Duration.Round(time.Millisecond), r.CpuSeconds, units.BytesSize(float64(r.PeakHeapBytes)), r.PeakGoroutines, r.GcCycles,
+	)))
+}
+
+func formatLatency(d time.Duration) string {
+	if d == 0 {
+		return "-"
+	}
+
+	return d.Round(100 * time.Microsecond).String()
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListNetworkKeys(keys []apiclient.ScopedNetworkKey) {
+	if len(keys) == 0 {
+		views.RenderInfoMessage("No network keys found")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, k := range keys {
+		revoked := "-"
+		if k.RevokedAt != nil {
+			revoked = util.FormatTimestamp(*k.RevokedAt)
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(k.Id),
+			views.DefaultRowDataStyle.Render(string(k.Scope)),
+			views.DefaultRowDataStyle.Render(k.ScopeName),
+			views.DefaultRowDataStyle.Render(util.FormatTimestamp(k.CreatedAt)),
+			views.DefaultRowDataStyle.Render(formatExpiresAt(k.ExpiresAt)),
+			views.DefaultRowDataStyle.Render(revoked),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"ID", "Scope", "Scope Name", "Created", "Expires", "Revoked",
+	}, nil, func() {
+		for _, k := range keys {
+			fmt.Printf("%s %s %s (expires %s)\n", k.Id, k.Scope, k.ScopeName, k.ExpiresAt)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func formatExpiresAt(expiresAt string) string {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return expiresAt
+	}
+
+	return t.Local().Format("2006-01-02 15:04")
+}
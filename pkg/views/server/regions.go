@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListRegions(regions []apiclient.FederatedRegion) {
+	if len(regions) == 0 {
+		views.RenderInfoMessage("Federation is not enabled on the server")
+		return
+	}
+
+	data := [][]string{}
+
+	for _, r := range regions {
+		name := r.Name
+		lastSeen := util.FormatTimestamp(r.LastSeenAt)
+		if r.Local {
+			name += " (this server)"
+			lastSeen = "-"
+		}
+
+		data = append(data, []string{
+			views.NameStyle.Render(name),
+			views.DefaultRowDataStyle.Render(r.ApiUrl),
+			views.DefaultRowDataStyle.Render(strings.Join(r.Targets, ", ")),
+			views.DefaultRowDataStyle.Render(lastSeen),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Region", "API URL", "Targets", "Last Seen",
+	}, nil, func() {
+		for _, r := range regions {
+			fmt.Printf("%s %s (targets: %s)\n", r.Name, r.ApiUrl, strings.Join(r.Targets, ", "))
+		}
+	})
+
+	fmt.Println(table)
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type SelftestStep struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+	Skipped  bool          `json:"skipped,omitempty"`
+}
+
+var failedSymbol = lipgloss.NewStyle().Foreground(views.Red).SetString("✗")
+var skippedSymbol = lipgloss.NewStyle().Foreground(views.LightGray).SetString("-")
+
+func RenderSelftestStep(step SelftestStep) {
+	if step.Skipped {
+		fmt.Printf(" %s %s\n", skippedSymbol, lipgloss.NewStyle().Foreground(views.LightGray).Render(step.Name+" (skipped)"))
+		return
+	}
+
+	duration := lipgloss.NewStyle().Foreground(views.LightGray).Render(step.Duration.Round(time.Millisecond).String())
+
+	if step.Error != "" {
+		fmt.Printf(" %s %s %s\n", failedSymbol, step.Name, duration)
+		fmt.Println(lipgloss.NewStyle().Foreground(views.Red).PaddingLeft(3).Render(step.Error))
+		return
+	}
+
+	fmt.Printf(" %s %s %s\n", views.CheckmarkSymbol, step.Name, duration)
+}
+
+func RenderSelftestSummary(steps []SelftestStep) {
+	var total time.Duration
+	failed := 0
+	for _, step := range steps {
+		total += step.Duration
+		if step.Error != "" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		views.RenderContainerLayout(views.GetInfoMessage(fmt.Sprintf("Selftest failed: %d of %d steps failed in %s", failed, len(steps), total.Round(time.Millisecond))))
+		return
+	}
+
+	views.RenderContainerLayout(views.GetBoldedInfoMessage(fmt.Sprintf("Selftest passed: %d steps completed in %s", len(steps), total.Round(time.Millisecond))))
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/cmd/server/daemon"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type ServerStatus struct {
+	Daemon  *daemon.DaemonStatus `json:"daemon"`
+	Healthy bool                 `json:"healthy"`
+	Version string               `json:"version,omitempty"`
+	ApiUrl  string               `json:"apiUrl"`
+	LogFile string               `json:"logFile"`
+}
+
+func RenderStatus(status ServerStatus) {
+	output := views.GetStyledMainTitle("Daytona Server Status") + "\n\n"
+
+	state := "Not installed"
+	if status.Daemon.Installed {
+		state = "Stopped"
+		if status.Daemon.Running {
+			state = "Running"
+		}
+	}
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Daemon: "), state) + "\n\n"
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Service Manager: "), status.Daemon.ServiceManager) + "\n\n"
+
+	if status.Daemon.Installed {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Service File: "), status.Daemon.ServiceFilePath) + "\n\n"
+	}
+
+	health := lipgloss.NewStyle().Foreground(views.Red).Render("Unreachable")
+	if status.Healthy {
+		health = lipgloss.NewStyle().Foreground(views.Green).Render("Healthy")
+	}
+	output += fmt.Sprintf("%s %s (%s)", views.GetPropertyKey("API: "), health, status.ApiUrl) + "\n\n"
+
+	if status.Version != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Version: "), status.Version) + "\n\n"
+	}
+
+	if status.Daemon.Pid != 0 {
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("PID: "), status.Daemon.Pid) + "\n\n"
+	}
+
+	if status.Daemon.StartedAt != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Uptime: "), util.FormatUptime(int32(time.Since(*status.Daemon.StartedAt).Seconds()))) + "\n\n"
+	}
+
+	if status.Daemon.Running {
+		output += fmt.Sprintf("%s %.1f%%", views.GetPropertyKey("CPU (average): "), status.Daemon.CpuPercent) + "\n\n"
+
+		output += fmt.Sprintf("%s %.1f MB", views.GetPropertyKey("Memory: "), float64(status.Daemon.MemoryBytes)/1024/1024) + "\n\n"
+
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Threads: "), status.Daemon.Threads) + "\n\n"
+	}
+
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Log File: "), status.LogFile) + "\n\n"
+
+	if status.Daemon.LogCommand != "" {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Service Logs: "), lipgloss.NewStyle().Foreground(views.Green).Render(status.Daemon.LogCommand)) + "\n\n"
+	}
+
+	if status.Daemon.Outdated {
+		output += views.SeparatorString + "\n\n"
+		output += fmt.Sprintf("The installed service file is outdated. Run %s to reinstall it", lipgloss.NewStyle().Foreground(views.Green).Render("daytona server restart")) + "\n\n"
+	}
+
+	views.RenderContainerLayout(views.GetInfoMessage(output))
+}
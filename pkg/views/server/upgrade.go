@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/serverupgrade"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderIncompatibilities(version string, incompatibilities []serverupgrade.Incompatibility) {
+	views.RenderInfoMessageBold(fmt.Sprintf("The installation is not compatible with version %s:", version))
+
+	for _, incompatibility := range incompatibilities {
+		views.RenderListLine(incompatibility.String())
+	}
+}
+
+func ConfirmUpgrade(currentVersion, version string, confirmCheck *bool) error {
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(fmt.Sprintf("Upgrade the Daytona Server from %s to %s?", currentVersion, version)).
+				Description("The server is stopped during the upgrade and rolled back if it does not start healthy.").
+				Value(confirmCheck),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	return form.Run()
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedservice
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+)
+
+func ListSharedServices(services []apiclient.SharedServiceDTO) {
+	if len(services) == 0 {
+		views_util.NotifyEmptySharedServiceList(true)
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range services {
+		data = append(data, []string{
+			views.NameStyle.Render(s.Name),
+			views.DefaultRowDataStyle.Render(s.Target),
+			views.DefaultRowDataStyle.Render(s.Image),
+			views.DefaultRowDataStyle.Render(getState(s)),
+			views.DefaultRowDataStyle.Render(getWorkspaces(s)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Name", "Target", "Image", "State", "Workspaces",
+	}, nil, func() {
+		output := "\n"
+		for _, s := range services {
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), s.Name) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target: "), s.Target) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Image: "), s.Image) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("State: "), getState(s)) + "\n\n"
+			output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspaces: "), getWorkspaces(s)) + "\n\n"
+		}
+		fmt.Println(output)
+	})
+
+	fmt.Println(table)
+}
+
+func getState(s apiclient.SharedServiceDTO) string {
+	if s.Info == nil {
+		return "/"
+	}
+
+	if !s.Info.IsRunning {
+		return "Stopped"
+	}
+
+	return fmt.Sprintf("Running on %s:%d", s.Info.Host, s.Info.Port)
+}
+
+func getWorkspaces(s apiclient.SharedServiceDTO) string {
+	if len(s.Workspaces) == 0 {
+		return "/"
+	}
+
+	return strings.Join(s.Workspaces, ", ")
+}
@@ -0,0 +1,151 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package info
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"golang.org/x/term"
+)
+
+const propertyNameWidth = 20
+
+var propertyNameStyle = lipgloss.NewStyle().
+	Foreground(views.LightGray)
+
+var propertyValueStyle = lipgloss.NewStyle().
+	Foreground(views.Light).
+	Bold(true)
+
+// Render shows the target and, if set, how the resources of its host are allocated to projects
+func Render(target *apiclient.ProviderTarget, allocation *apiclient.TargetAllocation, forceUnstyled bool) {
+	var output string
+	output += "\n\n"
+
+	output += views.GetStyledMainTitle("Target Info") + "\n\n"
+
+	output += getInfoLine("Name", target.Name) + "\n"
+
+	output += getInfoLine("Provider", fmt.Sprintf("%s %s", target.ProviderInfo.Name, target.ProviderInfo.Version)) + "\n"
+
+	if target.IsDefault {
+		output += getInfoLine("Default", "Yes") + "\n"
+	}
+
+	output += getInfoLine("Options", target.Options) + "\n"
+
+	if allocation != nil {
+		output += getAllocationInfo(allocation)
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(output)
+	} else if terminalWidth < views.TUITableMinimumWidth || forceUnstyled {
+		renderUnstyledInfo(output)
+	} else {
+		renderTUIView(output, views.GetContainerBreakpointWidth(terminalWidth))
+	}
+
+	if allocation != nil && len(allocation.Projects) > 0 {
+		renderProjectAllocations(allocation.Projects)
+	}
+}
+
+func getAllocationInfo(allocation *apiclient.TargetAllocation) string {
+	output := "\n" + views.GetStyledMainTitle("Allocation") + "\n\n"
+
+	if allocation.Capacity != nil {
+		output += getInfoLine("Capacity", formatResources(allocation.Capacity)) + "\n"
+	} else {
+		output += getInfoLine("Capacity", "Not reported by the provider") + "\n"
+	}
+
+	output += getInfoLine("Reserved", formatResources(&allocation.Reserved)) + "\n"
+
+	output += getInfoLine("Used", formatResources(&allocation.Used)) + "\n"
+
+	output += getInfoLine("Overcommit ratio", fmt.Sprintf("%g", allocation.OvercommitRatio)) + "\n"
+
+	return output
+}
+
+func renderProjectAllocations(projects []apiclient.ProjectAllocation) {
+	data := [][]string{}
+
+	for _, p := range projects {
+		data = append(data, []string{
+			views.NameStyle.Render(p.WorkspaceName),
+			views.DefaultRowDataStyle.Render(p.Project),
+			views.DefaultRowDataStyle.Render(formatResources(p.Reserved)),
+			views.DefaultRowDataStyle.Render(formatResources(p.Used)),
+		})
+	}
+
+	table := views_util.GetTableView(data, []string{
+		"Workspace", "Project", "Reserved", "Used",
+	}, nil, func() {
+		renderUnstyledProjectAllocations(projects)
+	})
+
+	fmt.Println(table)
+}
+
+func renderUnstyledProjectAllocations(projects []apiclient.ProjectAllocation) {
+	output := ""
+
+	for i, p := range projects {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspace: "), p.WorkspaceName) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), p.Project) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Reserved: "), formatResources(p.Reserved)) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Used: "), formatResources(p.Used)) + "\n\n"
+
+		if i < len(projects)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func formatResources(resources *apiclient.Resources) string {
+	if resources == nil {
+		return "/"
+	}
+
+	cpus := "/"
+	if resources.Cpus != nil {
+		cpus = fmt.Sprintf("%g", *resources.Cpus)
+	}
+
+	memory := "/"
+	if resources.Memory != nil {
+		memory = fmt.Sprintf("%d MiB", *resources.Memory)
+	}
+
+	return fmt.Sprintf("%s CPUs, %s memory", cpus, memory)
+}
+
+func renderUnstyledInfo(output string) {
+	fmt.Println(output)
+}
+
+func renderTUIView(output string, width int) {
+	output = lipgloss.NewStyle().PaddingLeft(3).Render(output)
+
+	content := lipgloss.
+		NewStyle().Width(width).
+		Render(output)
+
+	fmt.Println(content)
+}
+
+func getInfoLine(key, value string) string {
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
+}
@@ -75,6 +75,13 @@ func NotifyEmptyBuildList(tip bool) {
 	}
 }
 
+func NotifyEmptyArtifactList(tip bool) {
+	views.RenderInfoMessageBold("No artifacts found")
+	if tip {
+		views.RenderTip("Use 'daytona artifacts register' inside a project to collect output files when it stops")
+	}
+}
+
 func NotifyEmptyEnvVarList(tip bool) {
 	views.RenderInfoMessageBold("No environment variables found")
 	if tip {
@@ -88,3 +95,17 @@ func NotifyEmptyServerLogList(tip bool) {
 		views.RenderTip("Use 'daytona serve' in order to create server log files")
 	}
 }
+
+func NotifyEmptyNotificationList(tip bool) {
+	views.RenderInfoMessageBold("No notifications found")
+	if tip {
+		views.RenderTip("Use 'daytona notifications list --all' to include expired notifications")
+	}
+}
+
+func NotifyEmptySharedServiceList(tip bool) {
+	views.RenderInfoMessageBold("No shared services found")
+	if tip {
+		views.RenderTip("Use 'daytona shared-service create' to add a database or cache that workspaces on a target can share")
+	}
+}
@@ -5,8 +5,10 @@ package create
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
@@ -147,6 +149,15 @@ func validateDevcontainerFilename(filename string) error {
 	return nil
 }
 
+func validateRequired(name string) func(string) error {
+	return func(str string) error {
+		if strings.TrimSpace(str) == "" {
+			return fmt.Errorf("%s can not be empty", name)
+		}
+		return nil
+	}
+}
+
 func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData) *huh.Form {
 	buildOptions := []huh.Option[string]{
 		{Key: "Automatic", Value: string(views_util.AUTOMATIC)},
@@ -167,10 +178,12 @@ func GetProjectConfigurationForm(projectConfiguration *ProjectConfigurationData)
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Custom container image").
-				Value(&projectConfiguration.Image),
+				Value(&projectConfiguration.Image).
+				Validate(validateRequired("image")),
 			huh.NewInput().
 				Title("Container user").
-				Value(&projectConfiguration.User),
+				Value(&projectConfiguration.User).
+				Validate(validateRequired("user")),
 		).WithHeight(5).WithHideFunc(func() bool {
 			return projectConfiguration.BuildChoice != string(views_util.CUSTOMIMAGE)
 		}),
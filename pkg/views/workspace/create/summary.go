@@ -13,6 +13,7 @@ import (
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	util "github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient/conversion"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
@@ -26,6 +27,10 @@ const (
 	Image              ProjectDetail = "Image"
 	User               ProjectDetail = "User"
 	EnvVars            ProjectDetail = "Env Vars"
+	Mounts             ProjectDetail = "Mounts"
+	Commands           ProjectDetail = "Commands"
+	Ports              ProjectDetail = "Ports"
+	Overrides          ProjectDetail = "Overrides"
 	EMPTY_STRING                     = ""
 	DEFAULT_PADDING                  = 21
 )
@@ -40,6 +45,7 @@ type SummaryModel struct {
 	projectList []apiclient.CreateProjectDTO
 	defaults    *views_util.ProjectConfigDefaults
 	nameLabel   string
+	overrides   map[string][]string
 }
 
 type SubmissionFormConfig struct {
@@ -49,6 +55,10 @@ type SubmissionFormConfig struct {
 	ProjectList   *[]apiclient.CreateProjectDTO
 	NameLabel     string
 	Defaults      *views_util.ProjectConfigDefaults
+	// Override files applied to each project, keyed by project name
+	Overrides map[string][]string
+	// Validate checks the projects before the form can be submitted. The error is shown below the name input
+	Validate func() error
 }
 
 var configureCheck bool
@@ -85,7 +95,7 @@ func RunSubmissionForm(config SubmissionFormConfig) error {
 	return RunSubmissionForm(config)
 }
 
-func RenderSummary(name string, projectList []apiclient.CreateProjectDTO, defaults *views_util.ProjectConfigDefaults, nameLabel string) (string, error) {
+func RenderSummary(name string, projectList []apiclient.CreateProjectDTO, defaults *views_util.ProjectConfigDefaults, nameLabel string, overrides map[string][]string) (string, error) {
 	var output string
 	if name == "" {
 		output = views.GetStyledMainTitle("SUMMARY")
@@ -104,6 +114,9 @@ func RenderSummary(name string, projectList []apiclient.CreateProjectDTO, defaul
 
 		projectBuildChoice, choiceName := views_util.GetProjectBuildChoice(projectList[i], defaults)
 		output += renderProjectDetails(projectList[i], projectBuildChoice, choiceName)
+		if len(overrides[projectList[i].Name]) > 0 {
+			output += "\n" + projectDetailOutput(Overrides, strings.Join(overrides[projectList[i].Name], ", "))
+		}
 		if i < len(projectList)-1 {
 			output += "\n\n"
 		}
@@ -150,6 +163,42 @@ func renderProjectDetails(project apiclient.CreateProjectDTO, buildChoice views_
 		output += projectDetailOutput(EnvVars, strings.TrimSuffix(envVars, "; "))
 	}
 
+	if len(project.Mounts) > 0 {
+		if output != "" {
+			output += "\n"
+		}
+
+		mounts := []string{}
+		for _, m := range project.Mounts {
+			mounts = append(mounts, conversion.ToMount(m).String())
+		}
+		output += projectDetailOutput(Mounts, strings.Join(mounts, "; "))
+	}
+
+	if len(project.Commands) > 0 {
+		if output != "" {
+			output += "\n"
+		}
+
+		commands := []string{}
+		for _, c := range project.Commands {
+			commands = append(commands, c.Name)
+		}
+		output += projectDetailOutput(Commands, strings.Join(commands, ", "))
+	}
+
+	if len(project.Ports) > 0 {
+		if output != "" {
+			output += "\n"
+		}
+
+		ports := []string{}
+		for _, p := range project.Ports {
+			ports = append(ports, fmt.Sprintf("%s (%d)", p.Name, p.Port))
+		}
+		output += projectDetailOutput(Ports, strings.Join(ports, ", "))
+	}
+
 	return output
 }
 
@@ -165,6 +214,7 @@ func NewSummaryModel(config SubmissionFormConfig) SummaryModel {
 	m.projectList = *config.ProjectList
 	m.defaults = config.Defaults
 	m.nameLabel = config.NameLabel
+	m.overrides = config.Overrides
 
 	if *config.ChosenName == "" {
 		*config.ChosenName = config.SuggestedName
@@ -187,6 +237,9 @@ func NewSummaryModel(config SubmissionFormConfig) SummaryModel {
 						}
 					}
 					*config.ChosenName = result
+					if config.Validate != nil {
+						return config.Validate()
+					}
 					return nil
 				}),
 		),
@@ -240,8 +293,8 @@ func (m SummaryModel) View() string {
 
 	view := m.form.WithHeight(5).View() + "\n" + configurationHelpLine
 
-	if len(m.projectList) > 1 || len(m.projectList) == 1 && ProjectsConfigurationChanged {
-		summary, err := RenderSummary(m.name, m.projectList, m.defaults, m.nameLabel)
+	if len(m.projectList) > 1 || len(m.projectList) == 1 && (ProjectsConfigurationChanged || len(m.overrides) > 0) {
+		summary, err := RenderSummary(m.name, m.projectList, m.defaults, m.nameLabel, m.overrides)
 		if err != nil {
 			log.Fatal(err)
 		}
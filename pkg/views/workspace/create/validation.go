@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// RenderValidation lists the issues found while validating the creation request and the estimated creation times
+func RenderValidation(validation *apiclient.CreationValidation) {
+	output := ""
+
+	if len(validation.Issues) == 0 {
+		output += lipgloss.NewStyle().Foreground(views.Green).Render("No issues found") + "\n"
+	}
+
+	for _, issue := range validation.Issues {
+		severityStyle := lipgloss.NewStyle().Foreground(views.Orange)
+		if issue.Severity == apiclient.ValidationSeverityError {
+			severityStyle = lipgloss.NewStyle().Foreground(views.Red)
+		}
+
+		subject := string(issue.Check)
+		if issue.ProjectName != nil && *issue.ProjectName != "" {
+			subject = fmt.Sprintf("%s/%s", *issue.ProjectName, issue.Check)
+		}
+
+		output += fmt.Sprintf("%s %s: %s\n", severityStyle.Render(strings.ToUpper(string(issue.Severity))), views.GetPropertyKey(subject), issue.Message)
+	}
+
+	estimates := []string{}
+	for _, estimate := range validation.Estimates {
+		if estimate.CreationMs == nil {
+			continue
+		}
+
+		line := fmt.Sprintf("%s ~%s", estimate.ProjectName, formatEstimate(*estimate.CreationMs))
+		if estimate.BuildMs != nil {
+			line += fmt.Sprintf(" (build ~%s)", formatEstimate(*estimate.BuildMs))
+		}
+		estimates = append(estimates, line)
+	}
+
+	if len(estimates) > 0 {
+		output += "\n" + views.GetPropertyKey("Estimated creation time") + "\n" + strings.Join(estimates, "\n") + "\n"
+	}
+
+	views.RenderInfoMessage(strings.TrimSuffix(output, "\n"))
+}
+
+func formatEstimate(ms int32) string {
+	return (time.Duration(ms) * time.Millisecond).Round(time.Second).String()
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -153,6 +155,38 @@ func RunAddMoreProjectsForm() (bool, error) {
 	return addMore, nil
 }
 
+// RunResumeDraftForm asks whether to resume a workspace creation that did not complete
+func RunResumeDraftForm(workspaceName string, projectNames []string, updatedAt time.Time) (bool, error) {
+	m := Model{width: maxWidth}
+	m.lg = lipgloss.DefaultRenderer()
+	m.styles = NewStyles(m.lg)
+
+	resume := true
+
+	confirmInput :=
+		huh.NewConfirm().
+			Title(fmt.Sprintf("Resume creating workspace %s?", workspaceName)).
+			Description(fmt.Sprintf("Projects %s were set up %s", strings.Join(projectNames, ", "), util.FormatTimestamp(updatedAt.Format(time.RFC3339Nano)))).
+			Affirmative("Resume").
+			Negative("Start over").
+			Value(&resume)
+
+	m.form = huh.NewForm(
+		huh.NewGroup(confirmInput),
+	).
+		WithWidth(maxWidth).
+		WithShowHelp(false).
+		WithShowErrors(true).
+		WithTheme(views.GetCustomTheme())
+
+	err := m.form.WithProgramOptions(tea.WithAltScreen()).Run()
+	if err != nil {
+		return false, err
+	}
+
+	return resume, nil
+}
+
 func getOrderNumberString(number int) string {
 	if number >= 1 && number <= 10 {
 		// Handle numbers 1 to 10
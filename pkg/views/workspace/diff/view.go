@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+const missingValue = "-"
+
+func Render(diff *apiclient.WorkspaceDiff) {
+	if len(diff.Differences) == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("Workspaces %s and %s have the same configuration", diff.WorkspaceA, diff.WorkspaceB))
+		return
+	}
+
+	data := [][]string{}
+
+	for _, d := range diff.Differences {
+		data = append(data, []string{
+			views.NameStyle.Render(d.Path),
+			renderValue(d.A),
+			renderValue(d.B),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Setting", diff.WorkspaceA, diff.WorkspaceB,
+	}, nil, func() {
+		for _, d := range diff.Differences {
+			fmt.Printf("%s\n  %s: %s\n  %s: %s\n", d.Path, diff.WorkspaceA, getValue(d.A), diff.WorkspaceB, getValue(d.B))
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func renderValue(value *string) string {
+	if value == nil {
+		return views.InactiveStyle.Render(missingValue)
+	}
+
+	return views.DefaultRowDataStyle.Render(*value)
+}
+
+func getValue(value *string) string {
+	if value == nil {
+		return missingValue
+	}
+
+	return *value
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+func ListEvents(workspaceName string, events []apiclient.AgentEvent) {
+	if len(events) == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("No events recorded for workspace %s", workspaceName))
+		return
+	}
+
+	data := [][]string{}
+
+	for _, e := range events {
+		data = append(data, []string{
+			views.DefaultRowDataStyle.Render(formatTime(e.OccurredAt)),
+			views.NameStyle.Render(e.ProjectName),
+			renderType(e.Type),
+			views.DefaultRowDataStyle.Render(renderPort(e)),
+			views.DefaultRowDataStyle.Render(e.Message),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Occurred At", "Project", "Type", "Port", "Message",
+	}, nil, func() {
+		for _, e := range events {
+			RenderEvent(e)
+		}
+	})
+
+	fmt.Println(table)
+}
+
+// RenderEvent prints the event on a line of its own, e.g. while events are followed
+func RenderEvent(e apiclient.AgentEvent) {
+	port := ""
+	if e.Port != nil {
+		port = fmt.Sprintf(" (port %d)", *e.Port)
+	}
+
+	fmt.Printf("%s %s: %s%s %s\n", formatTime(e.OccurredAt), e.ProjectName, renderType(e.Type), port, e.Message)
+}
+
+func renderType(t apiclient.AgenteventType) string {
+	switch t {
+	case apiclient.TypeProxyError, apiclient.TypeOom, apiclient.TypeDiskPressure:
+		return views.InactiveStyle.Render(string(t))
+	}
+
+	return views.ActiveStyle.Render(string(t))
+}
+
+func renderPort(e apiclient.AgentEvent) string {
+	if e.Port == nil {
+		return "/"
+	}
+
+	return fmt.Sprintf("%d", *e.Port)
+}
+
+// formatTime renders RFC3339 timestamps in local time, since they are usually compared with the time a problem was noticed
+func formatTime(value string) string {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return value
+	}
+
+	return t.Local().Format(timeFormat)
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package info
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+// RenderEnvironmentChangesNotice tells the user that the environment definition of the project changed and how to
+// apply the changes. Nothing is rendered if the definition did not change
+func RenderEnvironmentChangesNotice(workspace *apiclient.WorkspaceDTO, projectName string) {
+	for _, project := range workspace.Projects {
+		if project.Name != projectName || project.State == nil || project.State.EnvironmentChanges == nil {
+			continue
+		}
+
+		changes := project.State.EnvironmentChanges
+		views.RenderInfoMessage(fmt.Sprintf("The environment definition of project '%s' changed since it was built: %s\nRun `%s` to apply the changes", projectName, strings.Join(getChangedEnvironmentFiles(changes), ", "), getRebuildCommand(changes, workspace.Name, "-p", projectName)))
+	}
+}
+
+// getChangedEnvironmentFiles returns the changed files of the environment definition. Files changed on the upstream
+// branch are marked
+func getChangedEnvironmentFiles(changes *apiclient.EnvironmentChanges) []string {
+	files := []string{}
+	files = append(files, changes.Local...)
+	for _, file := range changes.Upstream {
+		files = append(files, file+" (upstream)")
+	}
+
+	return files
+}
+
+// getRebuildCommand returns the command that applies the changes of the environment definition. Changes on the
+// upstream branch are pulled first
+func getRebuildCommand(changes *apiclient.EnvironmentChanges, args ...string) string {
+	command := strings.Join(append([]string{"daytona rebuild"}, args...), " ")
+	if len(changes.Upstream) > 0 {
+		command += " --pull"
+	}
+
+	return command
+}
@@ -7,8 +7,11 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/agent/activity"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	"golang.org/x/term"
@@ -16,6 +19,9 @@ import (
 
 const propertyNameWidth = 16
 
+// Node keys of running projects that expire within this time are shown, in case the agent fails to renew them
+const nodeKeyExpiryWarning = 7 * 24 * time.Hour
+
 var propertyNameStyle = lipgloss.NewStyle().
 	Foreground(views.LightGray)
 
@@ -23,7 +29,9 @@ var propertyValueStyle = lipgloss.NewStyle().
 	Foreground(views.Light).
 	Bold(true)
 
-func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
+// Render prints the workspace info. Projects with an entry in drift show their configuration drift and projects
+// with an entry in usage show their latest resource utilization.
+func Render(workspace *apiclient.WorkspaceDTO, ide string, drift map[string]apiclient.ProjectDrift, usage map[string]apiclient.ResourceUsageSample, forceUnstyled bool) {
 	var isCreationView bool
 	var output string
 	nameLabel := "Name"
@@ -41,14 +49,18 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 
 	output += getInfoLine("ID", workspace.Id) + "\n"
 
+	if workspace.ExpiresAt != nil {
+		output += getInfoLineExpiresAt(*workspace.ExpiresAt) + "\n"
+	}
+
 	if isCreationView {
 		output += getInfoLine("Editor", ide) + "\n"
 	}
 
 	if len(workspace.Projects) == 1 {
-		output += getSingleProjectOutput(&workspace.Projects[0], isCreationView)
+		output += getSingleProjectOutput(&workspace.Projects[0], drift, usage, isCreationView)
 	} else {
-		output += getProjectsOutputs(workspace.Projects, isCreationView)
+		output += getProjectsOutputs(workspace.Projects, drift, usage, isCreationView)
 	}
 
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
@@ -86,7 +98,7 @@ func renderTUIView(output string, width int, isCreationView bool) {
 	fmt.Println(content)
 }
 
-func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) string {
+func getSingleProjectOutput(project *apiclient.Project, drift map[string]apiclient.ProjectDrift, usage map[string]apiclient.ResourceUsageSample, isCreationView bool) string {
 	var output string
 	var repositoryUrl string
 
@@ -97,6 +109,24 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	if project.State != nil {
 		output += getInfoLineState("State", project.State) + "\n"
 		output += getInfoLineGitStatus("Branch", &project.State.GitStatus) + "\n"
+		if project.State.Uptime > 0 && project.State.LastActivityAt != nil {
+			output += getInfoLineLastActivity(project.State) + "\n"
+		}
+		if nodeKeyExpiry := getInfoLineNodeKeyExpiry(project.State, time.Now()); nodeKeyExpiry != "" {
+			output += nodeKeyExpiry + "\n"
+		}
+	}
+
+	if projectUsage, ok := usage[project.Name]; ok {
+		output += getInfoLine("Usage", formatUsage(projectUsage)) + "\n"
+	}
+
+	if projectDrift, ok := drift[project.Name]; ok {
+		output += getInfoLineDrift("Config", projectDrift) + "\n"
+	}
+
+	if project.State != nil && project.State.EnvironmentChanges != nil {
+		output += getInfoLineEnvironmentChanges("Environment", project.State.EnvironmentChanges) + "\n"
 	}
 
 	output += getInfoLinePrNumber(project.Repository.PrNumber, project.Repository, project.State)
@@ -109,18 +139,31 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 	if !isCreationView {
 		output += "\n"
 		output += getInfoLine("Project", project.Name)
+		if project.GetHostname() != "" {
+			output += getInfoLine("Hostname", project.GetHostname())
+		}
 	}
 
 	return output
 }
 
-func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) string {
+func getProjectsOutputs(projects []apiclient.Project, drift map[string]apiclient.ProjectDrift, usage map[string]apiclient.ResourceUsageSample, isCreationView bool) string {
 	var output string
 	for i, project := range projects {
 		output += getInfoLine(fmt.Sprintf("Project #%d", i+1), project.Name)
 		output += getInfoLineState("State", project.State)
 		if project.State != nil {
 			output += getInfoLineGitStatus("Branch", &project.State.GitStatus)
+			output += getInfoLineNodeKeyExpiry(project.State, time.Now())
+		}
+		if projectUsage, ok := usage[project.Name]; ok {
+			output += getInfoLine("Usage", formatUsage(projectUsage))
+		}
+		if projectDrift, ok := drift[project.Name]; ok {
+			output += getInfoLineDrift("Config", projectDrift)
+		}
+		if project.State != nil && project.State.EnvironmentChanges != nil {
+			output += getInfoLineEnvironmentChanges("Environment", project.State.EnvironmentChanges)
 		}
 		output += getInfoLinePrNumber(project.Repository.PrNumber, project.Repository, project.State)
 
@@ -128,6 +171,9 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 			output += getInfoLine("Target", project.Target)
 		}
 		output += getInfoLine("Repository", project.Repository.Url)
+		if !isCreationView && project.GetHostname() != "" {
+			output += getInfoLine("Hostname", project.GetHostname())
+		}
 		if project.Name != projects[len(projects)-1].Name {
 			output += "\n"
 		}
@@ -200,9 +246,120 @@ func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 	return output
 }
 
+func getInfoLineDrift(key string, drift apiclient.ProjectDrift) string {
+	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key))
+
+	if !drift.Drifted {
+		return output + propertyValueStyle.Foreground(views.Green).Render("IN SYNC") + propertyValueStyle.Foreground(views.Light).Render("\n")
+	}
+
+	changes := []string{}
+	for _, difference := range drift.Differences {
+		switch difference.Kind {
+		case apiclient.DriftKindFeature:
+			changes = append(changes, fmt.Sprintf("feature %s", difference.Key))
+		case apiclient.DriftKindEnv:
+			changes = append(changes, fmt.Sprintf("env %s", difference.Key))
+		default:
+			changes = append(changes, string(difference.Kind))
+		}
+	}
+
+	output += propertyValueStyle.Foreground(views.Orange).Render("DRIFTED")
+	output += propertyNameStyle.Foreground(views.Gray).Render(fmt.Sprintf(" (%s) - run `daytona rebuild` to apply", strings.Join(changes, ", ")))
+
+	return output + propertyValueStyle.Foreground(views.Light).Render("\n")
+}
+
+func getInfoLineEnvironmentChanges(key string, changes *apiclient.EnvironmentChanges) string {
+	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key))
+
+	output += propertyValueStyle.Foreground(views.Orange).Render("CHANGED")
+	output += propertyNameStyle.Foreground(views.Gray).Render(fmt.Sprintf(" (%s) - run `%s` to apply", strings.Join(getChangedEnvironmentFiles(changes), ", "), getRebuildCommand(changes)))
+
+	return output + propertyValueStyle.Foreground(views.Light).Render("\n")
+}
+
 func getInfoLinePrNumber(PrNumber *int32, repo apiclient.GitRepository, state *apiclient.ProjectState) string {
 	if PrNumber != nil && (state == nil || state.GitStatus.CurrentBranch == repo.Branch) {
 		return getInfoLine("PR Number", fmt.Sprintf("#%d", *PrNumber)) + "\n"
 	}
 	return ""
 }
+
+func getInfoLineLastActivity(state *apiclient.ProjectState) string {
+	lastActivity := util.FormatTimestamp(*state.LastActivityAt)
+	if state.LastActivitySource != nil && *state.LastActivitySource != string(activity.SourceStart) {
+		lastActivity += fmt.Sprintf(" (%s)", *state.LastActivitySource)
+	}
+
+	return getInfoLine("Last activity", lastActivity)
+}
+
+// getInfoLineNodeKeyExpiry returns the line of the tailnet node key if the project is running and the key expires soon.
+// Empty otherwise
+func getInfoLineNodeKeyExpiry(state *apiclient.ProjectState, now time.Time) string {
+	if state.Uptime == 0 || state.NodeKeyExpiry == nil {
+		return ""
+	}
+
+	expiry, err := time.Parse(time.RFC3339, *state.NodeKeyExpiry)
+	if err != nil || expiry.Sub(now) > nodeKeyExpiryWarning {
+		return ""
+	}
+
+	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, "Node key"))
+
+	if !expiry.After(now) {
+		output += propertyValueStyle.Foreground(views.Red).Render("EXPIRED")
+		output += propertyNameStyle.Foreground(views.Gray).Render(" - the project drops off the network until the agent registers it again")
+		return output + propertyValueStyle.Foreground(views.Light).Render("\n")
+	}
+
+	output += propertyValueStyle.Foreground(views.Orange).Render(fmt.Sprintf("EXPIRES %s", expiry.Local().Format("2006-01-02 15:04")))
+	output += propertyNameStyle.Foreground(views.Gray).Render(fmt.Sprintf(" (in %s) - renewed by the agent before it expires", formatRemaining(expiry.Sub(now))))
+
+	return output + propertyValueStyle.Foreground(views.Light).Render("\n")
+}
+
+func formatRemaining(d time.Duration) string {
+	switch {
+	case d >= 48*time.Hour:
+		return fmt.Sprintf("%d days", int(d.Hours()/24))
+	case d >= 2*time.Hour:
+		return fmt.Sprintf("%d hours", int(d.Hours()))
+	case d >= 2*time.Minute:
+		return fmt.Sprintf("%d minutes", int(d.Minutes()))
+	default:
+		return "less than 2 minutes"
+	}
+}
+
+func formatUsage(sample apiclient.ResourceUsageSample) string {
+	memory := fmt.Sprintf("%d MiB memory", sample.MemoryUsed)
+	if sample.MemoryLimit != nil && *sample.MemoryLimit > 0 {
+		memory = fmt.Sprintf("%d / %d MiB memory (%s)", sample.MemoryUsed, *sample.MemoryLimit, formatShare(sample.MemoryUsed, *sample.MemoryLimit))
+	}
+
+	disk := fmt.Sprintf("%.1f / %.1f GiB disk (%s)", float64(sample.DiskUsed)/1024, float64(sample.DiskTotal)/1024, formatShare(sample.DiskUsed, sample.DiskTotal))
+	inodes := fmt.Sprintf("%s inodes", formatShare(sample.InodesUsed, sample.InodesTotal))
+
+	return fmt.Sprintf("%.2f CPUs, %s, %s, %s", sample.Cpus, memory, disk, inodes)
+}
+
+func formatShare(used, total int32) string {
+	if total <= 0 {
+		return "/"
+	}
+
+	return fmt.Sprintf("%.0f%%", float64(used)/float64(total)*100)
+}
+
+func getInfoLineExpiresAt(expiresAt string) string {
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return getInfoLine("Expires", expiresAt)
+	}
+
+	return getInfoLine("Expires", t.Local().Format("2006-01-02 15:04"))
+}
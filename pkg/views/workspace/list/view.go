@@ -82,7 +82,7 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 
 func renderUnstyledList(workspaceList []apiclient.WorkspaceDTO) {
 	for _, workspace := range workspaceList {
-		info_view.Render(&workspace, "", true)
+		info_view.Render(&workspace, "", nil, nil, true)
 
 		if workspace.Id != workspaceList[len(workspaceList)-1].Id {
 			fmt.Printf("\n%s\n\n", views.SeparatorString)
@@ -119,6 +119,15 @@ func getRowFromRowData(rowData RowData, isMultiProjectAccordion bool) []string {
 	return row
 }
 
+// getBranchLabel returns the branch of the project and how many commits it is behind its upstream branch
+func getBranchLabel(project apiclient.Project) string {
+	if project.State == nil || project.State.GitStatus.Behind == nil || *project.State.GitStatus.Behind == 0 {
+		return project.Repository.Branch
+	}
+
+	return fmt.Sprintf("%s (%d behind)", views.GetBranchNameLabel(project.Repository.Branch), *project.State.GitStatus.Behind)
+}
+
 func SortWorkspaces(workspaceList *[]apiclient.WorkspaceDTO, verbose bool) {
 	if verbose {
 		sort.Slice(*workspaceList, func(i, j int) bool {
@@ -151,10 +160,16 @@ func getWorkspaceTableRowData(workspace apiclient.WorkspaceDTO, specifyGitProvid
 	rowData.Name = workspace.Name + views_util.AdditionalPropertyPadding
 	if len(workspace.Projects) > 0 {
 		rowData.Repository = util.GetRepositorySlugFromUrl(workspace.Projects[0].Repository.Url, specifyGitProviders)
-		rowData.Branch = workspace.Projects[0].Repository.Branch
+		rowData.Branch = getBranchLabel(workspace.Projects[0])
 	}
 
 	rowData.Target = workspace.Target + views_util.AdditionalPropertyPadding
+	if workspace.Adoption != nil {
+		rowData.Target = fmt.Sprintf("adopted (%s)", workspace.Adoption.Type) + views_util.AdditionalPropertyPadding
+	}
+	if workspace.Region != nil && *workspace.Region != "" {
+		rowData.Target = fmt.Sprintf("%s (%s)", workspace.Target, *workspace.Region) + views_util.AdditionalPropertyPadding
+	}
 
 	if workspace.Info != nil && workspace.Info.Projects != nil && len(workspace.Info.Projects) > 0 {
 		rowData.Created = util.FormatTimestamp(workspace.Info.Projects[0].Created)
@@ -170,9 +185,12 @@ func getProjectTableRowData(workspaceDTO apiclient.WorkspaceDTO, project apiclie
 	rowData.Name = " └ " + project.Name
 
 	rowData.Repository = util.GetRepositorySlugFromUrl(project.Repository.Url, specifyGitProviders)
-	rowData.Branch = project.Repository.Branch
+	rowData.Branch = getBranchLabel(project)
 
 	rowData.Target = project.Target + views_util.AdditionalPropertyPadding
+	if workspaceDTO.Region != nil && *workspaceDTO.Region != "" {
+		rowData.Target = fmt.Sprintf("%s (%s)", project.Target, *workspaceDTO.Region) + views_util.AdditionalPropertyPadding
+	}
 
 	if project.State != nil && project.State.Uptime > 0 {
 		rowData.Status = util.FormatUptime(project.State.Uptime)
@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package statehistory
+
+import (
+	"fmt"
+	"time"
+
+	internal_util "github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/util"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+func ListSnapshots(workspaceName string, snapshots []apiclient.StateSnapshot) {
+	if len(snapshots) == 0 {
+		views.RenderInfoMessage(fmt.Sprintf("No state recorded for workspace %s", workspaceName))
+		return
+	}
+
+	data := [][]string{}
+
+	for _, s := range snapshots {
+		data = append(data, []string{
+			views.DefaultRowDataStyle.Render(formatTime(s.RecordedAt)),
+			views.NameStyle.Render(s.ProjectName),
+			renderState(s),
+			views.DefaultRowDataStyle.Render(renderUptime(s)),
+			views.DefaultRowDataStyle.Render(formatTime(s.GetStateUpdatedAt())),
+			views.DefaultRowDataStyle.Render(s.GetGitBranch()),
+		})
+	}
+
+	table := util.GetTableView(data, []string{
+		"Recorded At", "Project", "State", "Uptime", "Agent Reported At", "Branch",
+	}, nil, func() {
+		for _, s := range snapshots {
+			state := "STOPPED"
+			if s.Running {
+				state = "RUNNING"
+			}
+			fmt.Printf("%s %s: %s, uptime %s, agent reported at %s\n", formatTime(s.RecordedAt), s.ProjectName, state, renderUptime(s), formatTime(s.GetStateUpdatedAt()))
+		}
+	})
+
+	fmt.Println(table)
+}
+
+func renderState(s apiclient.StateSnapshot) string {
+	if s.Running {
+		return views.ActiveStyle.Render("RUNNING")
+	}
+
+	return views.InactiveStyle.Render("STOPPED")
+}
+
+func renderUptime(s apiclient.StateSnapshot) string {
+	if !s.Running {
+		return "/"
+	}
+
+	return internal_util.FormatUptime(s.Uptime)
+}
+
+// formatTime renders RFC3339 timestamps in local time, since they are usually compared with the time a problem was noticed
+func formatTime(value string) string {
+	for _, layout := range []string{time.RFC3339Nano, time.RFC1123} {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t.Local().Format(timeFormat)
+		}
+	}
+
+	if value == "" {
+		return "/"
+	}
+
+	return value
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// MaxAnnotationsSize limits the combined size of all annotation keys and values on a single workspace or project
+	MaxAnnotationsSize        = 256 * 1024
+	maxAnnotationPrefix       = 253
+	maxAnnotationPrefixLabel  = 63
+	maxAnnotationName         = 63
+	reservedAnnotationsPrefix = "daytona.io"
+)
+
+var (
+	ErrInvalidAnnotationKey    = errors.New("invalid annotation key")
+	ErrReservedAnnotationKey   = errors.New("annotation key uses a reserved prefix")
+	ErrAnnotationsSizeExceeded = fmt.Errorf("annotations exceed the maximum size of %d bytes", MaxAnnotationsSize)
+)
+
+var (
+	annotationNameRegex        = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9])?$`)
+	annotationPrefixLabelRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+)
+
+// ValidateAnnotations checks that every key is namespaced as <prefix>/<name>, where prefix is a
+// DNS subdomain owned by the integration (e.g. ci.company.com/run-id), and that the annotations fit the size limit
+func ValidateAnnotations(annotations map[string]string) error {
+	size := 0
+
+	for key, value := range annotations {
+		err := validateAnnotationKey(key)
+		if err != nil {
+			return err
+		}
+
+		size += len(key) + len(value)
+	}
+
+	if size > MaxAnnotationsSize {
+		return ErrAnnotationsSizeExceeded
+	}
+
+	return nil
+}
+
+// UpdateAnnotations applies set and remove to a copy of current and validates the result
+func UpdateAnnotations(current map[string]string, set map[string]string, remove []string) (map[string]string, error) {
+	annotations := map[string]string{}
+	for key, value := range current {
+		annotations[key] = value
+	}
+
+	for _, key := range remove {
+		delete(annotations, key)
+	}
+
+	for key, value := range set {
+		annotations[key] = value
+	}
+
+	err := ValidateAnnotations(annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(annotations) == 0 {
+		return nil, nil
+	}
+
+	return annotations, nil
+}
+
+func IsInvalidAnnotations(err error) bool {
+	return errors.Is(err, ErrInvalidAnnotationKey) || errors.Is(err, ErrReservedAnnotationKey) || errors.Is(err, ErrAnnotationsSizeExceeded)
+}
+
+func validateAnnotationKey(key string) error {
+	prefix, name, found := strings.Cut(key, "/")
+	if !found || prefix == "" {
+		return fmt.Errorf("%w %s: key must be namespaced as <prefix>/<name>", ErrInvalidAnnotationKey, key)
+	}
+
+	if len(name) > maxAnnotationName || !annotationNameRegex.MatchString(name) {
+		return fmt.Errorf("%w %s: name must be at most %d alphanumeric characters, '-', '_' or '.'", ErrInvalidAnnotationKey, key, maxAnnotationName)
+	}
+
+	if len(prefix) > maxAnnotationPrefix {
+		return fmt.Errorf("%w %s: prefix must be at most %d characters", ErrInvalidAnnotationKey, key, maxAnnotationPrefix)
+	}
+
+	for _, label := range strings.Split(prefix, ".") {
+		if len(label) > maxAnnotationPrefixLabel || !annotationPrefixLabelRegex.MatchString(label) {
+			return fmt.Errorf("%w %s: prefix must be a lowercase DNS subdomain", ErrInvalidAnnotationKey, key)
+		}
+	}
+
+	if prefix == reservedAnnotationsPrefix || strings.HasSuffix(prefix, "."+reservedAnnotationsPrefix) {
+		return fmt.Errorf("%w: %s", ErrReservedAnnotationKey, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+)
+
+type CleanupAction string
+
+const (
+	CleanupActionDelete CleanupAction = "delete"
+	CleanupActionStop   CleanupAction = "stop"
+)
+
+// CleanupPolicy limits the age, the idle time and the number of running workspaces whose annotations match the selector
+type CleanupPolicy struct {
+	Name     string
+	Selector Selector
+	// Matching workspaces created longer than MaxAge ago are deleted. 0 disables the limit
+	MaxAge time.Duration
+	// The oldest matching running workspaces above this count are stopped. 0 disables the limit
+	MaxRunning int
+	// Matching running workspaces without activity and with almost no CPU usage for longer than MaxIdle are stopped.
+	// 0 disables the limit
+	MaxIdle time.Duration
+	// Dry-run policies are evaluated and logged but never enforced
+	DryRun bool
+}
+
+type CleanupCandidate struct {
+	Policy        string        `json:"policy" validate:"required"`
+	WorkspaceId   string        `json:"workspaceId" validate:"required"`
+	WorkspaceName string        `json:"workspaceName" validate:"required"`
+	Action        CleanupAction `json:"action" validate:"required"`
+	Reason        string        `json:"reason" validate:"required"`
+	DryRun        bool          `json:"dryRun" validate:"optional"`
+} // @name CleanupCandidate
+
+// IsRunning reports whether any of the workspace projects has a running agent
+func (w *Workspace) IsRunning() bool {
+	for _, p := range w.Projects {
+		if p.State != nil && p.State.Uptime > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EvaluateCleanupPolicies returns the actions required to bring the workspaces in line with the policies.
+// Policies are applied in order and every workspace gets at most one action. Deleting takes precedence
+// over stopping, so a workspace deleted by any policy no longer counts towards running limits. Idle workspaces are
+// stopped before running limits are applied. Usage holds the recent resource usage samples of each workspace by ID.
+func EvaluateCleanupPolicies(policies []CleanupPolicy, workspaces []*Workspace, usage map[string][]*resourceusage.Sample, now time.Time) []CleanupCandidate {
+	candidates := []CleanupCandidate{}
+	deleted := map[string]bool{}
+	stopped := map[string]bool{}
+
+	for _, policy := range policies {
+		if policy.MaxAge <= 0 {
+			continue
+		}
+
+		for _, w := range workspaces {
+			if deleted[w.Id] || w.CreatedAt == nil || !policy.Selector.Matches(w.Annotations) {
+				continue
+			}
+
+			age := now.Sub(*w.CreatedAt)
+			if age <= policy.MaxAge {
+				continue
+			}
+
+			deleted[w.Id] = true
+			candidates = append(candidates, CleanupCandidate{
+				Policy:        policy.Name,
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				Action:        CleanupActionDelete,
+				Reason:        fmt.Sprintf("created %s ago, the maximum age is %s", age.Round(time.Minute), policy.MaxAge),
+				DryRun:        policy.DryRun,
+			})
+		}
+	}
+
+	for _, policy := range policies {
+		if policy.MaxIdle <= 0 {
+			continue
+		}
+
+		for _, w := range workspaces {
+			if deleted[w.Id] || stopped[w.Id] || !w.IsRunning() || !policy.Selector.Matches(w.Annotations) {
+				continue
+			}
+
+			if !isIdle(w, usage[w.Id], policy.MaxIdle, now) {
+				continue
+			}
+
+			stopped[w.Id] = true
+			candidates = append(candidates, CleanupCandidate{
+				Policy:        policy.Name,
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				Action:        CleanupActionStop,
+				Reason:        fmt.Sprintf("no activity and less than %.2f CPU cores used for %s", resourceusage.IdleCpus, policy.MaxIdle),
+				DryRun:        policy.DryRun,
+			})
+		}
+	}
+
+	for _, policy := range policies {
+		if policy.MaxRunning <= 0 {
+			continue
+		}
+
+		running := []*Workspace{}
+		for _, w := range workspaces {
+			if !deleted[w.Id] && !stopped[w.Id] && w.IsRunning() && policy.Selector.Matches(w.Annotations) {
+				running = append(running, w)
+			}
+		}
+
+		if len(running) <= policy.MaxRunning {
+			continue
+		}
+
+		// Keep the most recently created workspaces running
+		sort.SliceStable(running, func(i, j int) bool {
+			return createdAt(running[i]).After(createdAt(running[j]))
+		})
+
+		for _, w := range running[policy.MaxRunning:] {
+			stopped[w.Id] = true
+			candidates = append(candidates, CleanupCandidate{
+				Policy:        policy.Name,
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				Action:        CleanupActionStop,
+				Reason:        fmt.Sprintf("%d matching workspaces are running, the maximum is %d", len(running), policy.MaxRunning),
+				DryRun:        policy.DryRun,
+			})
+		}
+	}
+
+	return candidates
+}
+
+// isIdle reports whether no running project of the workspace had terminal, IDE or file activity for longer than
+// maxIdle and whether the samples taken since then show almost no CPU usage. Workspaces whose agents do not report
+// their activity or usage are never idle
+func isIdle(w *Workspace, samples []*resourceusage.Sample, maxIdle time.Duration, now time.Time) bool {
+	for _, p := range w.Projects {
+		if p.State == nil || p.State.Uptime == 0 {
+			continue
+		}
+
+		if p.State.LastActivityAt == "" {
+			return false
+		}
+
+		lastActivityAt, err := time.Parse(time.RFC3339, p.State.LastActivityAt)
+		if err != nil || now.Sub(lastActivityAt) <= maxIdle {
+			return false
+		}
+	}
+
+	since := now.Add(-maxIdle)
+	recent := []*resourceusage.Sample{}
+	for _, s := range samples {
+		if !s.SampledAt.Before(since) {
+			recent = append(recent, s)
+		}
+	}
+
+	return resourceusage.IsIdle(recent)
+}
+
+// createdAt sorts workspaces without a creation time as the oldest
+func createdAt(w *Workspace) time.Time {
+	if w.CreatedAt == nil {
+		return time.Time{}
+	}
+
+	return *w.CreatedAt
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/resourceusage"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+const purposeKey = "ci.example.com/purpose"
+const teamKey = "ci.example.com/team"
+
+func TestParseSelector(t *testing.T) {
+	selector, err := workspace.ParseSelector(purposeKey + "=pr-preview, " + teamKey + "!=core,ci.example.com/run,!ci.example.com/keep")
+	require.NoError(t, err)
+	require.Equal(t, purposeKey+"=pr-preview,"+teamKey+"!=core,ci.example.com/run,!ci.example.com/keep", selector.String())
+
+	require.True(t, selector.Matches(map[string]string{purposeKey: "pr-preview", "ci.example.com/run": "1"}))
+	require.False(t, selector.Matches(map[string]string{purposeKey: "pr-preview", teamKey: "core", "ci.example.com/run": "1"}))
+	require.False(t, selector.Matches(map[string]string{purposeKey: "pr-preview", "ci.example.com/run": "1", "ci.example.com/keep": "true"}))
+	require.False(t, selector.Matches(nil))
+
+	empty, err := workspace.ParseSelector("")
+	require.NoError(t, err)
+	require.True(t, empty.Matches(nil))
+
+	_, err = workspace.ParseSelector("=value")
+	require.ErrorIs(t, err, workspace.ErrInvalidSelector)
+}
+
+func TestEvaluateCleanupPolicies(t *testing.T) {
+	now := time.Now()
+
+	previewSelector, err := workspace.ParseSelector(purposeKey + "=pr-preview")
+	require.NoError(t, err)
+	internsSelector, err := workspace.ParseSelector(teamKey + "=interns")
+	require.NoError(t, err)
+
+	policies := []workspace.CleanupPolicy{
+		{Name: "pr-previews", Selector: previewSelector, MaxAge: 48 * time.Hour},
+		{Name: "interns", Selector: internsSelector, MaxRunning: 2, DryRun: true},
+	}
+
+	workspaces := []*workspace.Workspace{
+		newWorkspace("old-preview", now.Add(-72*time.Hour), true, map[string]string{purposeKey: "pr-preview", teamKey: "interns"}),
+		newWorkspace("new-preview", now.Add(-time.Hour), true, map[string]string{purposeKey: "pr-preview"}),
+		newWorkspace("intern-1", now.Add(-3*time.Hour), true, map[string]string{teamKey: "interns"}),
+		newWorkspace("intern-2", now.Add(-2*time.Hour), true, map[string]string{teamKey: "interns"}),
+		newWorkspace("intern-3", now.Add(-time.Hour), true, map[string]string{teamKey: "interns"}),
+		newWorkspace("intern-stopped", now.Add(-time.Hour), false, map[string]string{teamKey: "interns"}),
+	}
+
+	candidates := workspace.EvaluateCleanupPolicies(policies, workspaces, nil, now)
+	require.Len(t, candidates, 2)
+
+	require.Equal(t, "old-preview", candidates[0].WorkspaceName)
+	require.Equal(t, workspace.CleanupActionDelete, candidates[0].Action)
+	require.Equal(t, "pr-previews", candidates[0].Policy)
+	require.False(t, candidates[0].DryRun)
+
+	// The deleted workspace no longer counts towards the running limit, the oldest remaining one is stopped
+	require.Equal(t, "intern-1", candidates[1].WorkspaceName)
+	require.Equal(t, workspace.CleanupActionStop, candidates[1].Action)
+	require.True(t, candidates[1].DryRun)
+}
+
+func TestEvaluateCleanupPoliciesStopsIdleWorkspaces(t *testing.T) {
+	now := time.Now()
+
+	policies := []workspace.CleanupPolicy{
+		{Name: "idle", MaxIdle: time.Hour},
+	}
+
+	idle := newWorkspace("idle", now.Add(-3*time.Hour), true, nil)
+	busy := newWorkspace("busy", now.Add(-3*time.Hour), true, nil)
+	active := newWorkspace("active", now.Add(-3*time.Hour), true, nil)
+	unreported := newWorkspace("unreported", now.Add(-3*time.Hour), true, nil)
+
+	for _, w := range []*workspace.Workspace{idle, busy, unreported} {
+		w.Projects[0].State.LastActivityAt = now.Add(-2 * time.Hour).Format(time.RFC3339)
+	}
+	active.Projects[0].State.LastActivityAt = now.Add(-10 * time.Minute).Format(time.RFC3339)
+
+	usage := map[string][]*resourceusage.Sample{
+		// Usage before the idle window does not matter
+		"idle":   {{Cpus: 2, SampledAt: now.Add(-90 * time.Minute)}, {Cpus: 0.01, SampledAt: now.Add(-time.Minute)}},
+		"busy":   {{Cpus: 0.01, SampledAt: now.Add(-30 * time.Minute)}, {Cpus: 1.2, SampledAt: now.Add(-time.Minute)}},
+		"active": {{Cpus: 0.01, SampledAt: now.Add(-time.Minute)}},
+	}
+
+	candidates := workspace.EvaluateCleanupPolicies(policies, []*workspace.Workspace{idle, busy, active, unreported}, usage, now)
+	require.Len(t, candidates, 1)
+	require.Equal(t, "idle", candidates[0].WorkspaceName)
+	require.Equal(t, workspace.CleanupActionStop, candidates[0].Action)
+}
+
+func newWorkspace(name string, createdAt time.Time, running bool, annotations map[string]string) *workspace.Workspace {
+	state := &project.ProjectState{}
+	if running {
+		state.Uptime = 10
+	}
+
+	return &workspace.Workspace{
+		Id:          name,
+		Name:        name,
+		CreatedAt:   &createdAt,
+		Annotations: annotations,
+		Projects:    []*project.Project{{Name: "project", State: state}},
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+// WorkspaceDiff lists the configuration settings that differ between two workspaces
+type WorkspaceDiff struct {
+	WorkspaceA  string       `json:"workspaceA" validate:"required"`
+	WorkspaceB  string       `json:"workspaceB" validate:"required"`
+	Differences []Difference `json:"differences" validate:"required"`
+} // @name WorkspaceDiff
+
+// Difference is a setting with a different value in each workspace.
+// A missing value means the setting is not present in that workspace.
+type Difference struct {
+	// Path of the setting, e.g. projects.api.envVars.NODE_ENV
+	Path string  `json:"path" validate:"required"`
+	A    *string `json:"a,omitempty" validate:"optional"`
+	B    *string `json:"b,omitempty" validate:"optional"`
+} // @name WorkspaceDifference
+
+// Diff compares the configuration of two workspaces. Projects are matched by name.
+// Differences are sorted by path.
+func Diff(a, b *Workspace) *WorkspaceDiff {
+	settingsA := a.settings()
+	settingsB := b.settings()
+
+	paths := []string{}
+	for path := range settingsA {
+		paths = append(paths, path)
+	}
+	for path := range settingsB {
+		if _, ok := settingsA[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	diff := &WorkspaceDiff{
+		WorkspaceA:  a.Name,
+		WorkspaceB:  b.Name,
+		Differences: []Difference{},
+	}
+
+	for _, path := range paths {
+		valueA, okA := settingsA[path]
+		valueB, okB := settingsB[path]
+		if okA && okB && valueA == valueB {
+			continue
+		}
+
+		difference := Difference{Path: path}
+		if okA {
+			difference.A = &valueA
+		}
+		if okB {
+			difference.B = &valueB
+		}
+
+		diff.Differences = append(diff.Differences, difference)
+	}
+
+	return diff
+}
+
+// settings flattens the comparable configuration of the workspace into setting paths and values
+func (w *Workspace) settings() map[string]string {
+	settings := map[string]string{
+		"target": w.Target,
+	}
+
+	if len(w.SharedServices) > 0 {
+		sharedServices := append([]string{}, w.SharedServices...)
+		sort.Strings(sharedServices)
+		settings["sharedServices"] = strings.Join(sharedServices, ", ")
+	}
+
+	for _, p := range w.Projects {
+		prefix := "projects." + p.Name + "."
+
+		settings[prefix+"image"] = p.Image
+		settings[prefix+"user"] = p.User
+		settings[prefix+"build"] = getBuildSetting(p)
+
+		if p.Networking != "" {
+			settings[prefix+"networking"] = string(p.Networking)
+		}
+
+		if p.Repository != nil {
+			settings[prefix+"repository.url"] = p.Repository.Url
+			settings[prefix+"repository.branch"] = p.Repository.Branch
+			settings[prefix+"repository.sha"] = p.Repository.Sha
+		}
+
+		if p.State != nil && p.State.AgentVersion != "" {
+			settings[prefix+"agentVersion"] = p.State.AgentVersion
+		}
+
+		for key, value := range p.EnvVars {
+			settings[prefix+"envVars."+key] = value
+		}
+
+		for _, mount := range p.Mounts {
+			settings[prefix+"mounts."+mount.Target] = getMountSetting(mount)
+		}
+
+		for _, command := range p.Commands {
+			value := command.Command
+			if command.Workdir != "" {
+				value = fmt.Sprintf("%s (in %s)", command.Command, command.Workdir)
+			}
+			settings[prefix+"commands."+command.Name] = value
+		}
+
+		for _, port := range p.Ports {
+			settings[prefix+"ports."+port.Name] = fmt.Sprintf("%d/%s (%s)", port.Port, port.GetProtocol(), port.GetVisibility())
+		}
+	}
+
+	return settings
+}
+
+func getBuildSetting(p *project.Project) string {
+	if p.BuildConfig == nil {
+		return "none"
+	}
+
+	if p.BuildConfig.Devcontainer != nil {
+		return "devcontainer " + p.BuildConfig.Devcontainer.FilePath
+	}
+
+	return "automatic"
+}
+
+// getMountSetting formats the mount like ParseMount input, without the target
+func getMountSetting(m project.Mount) string {
+	value := "type=" + string(m.Type)
+	if m.Source != "" {
+		value += ",source=" + m.Source
+	}
+	if m.ReadOnly {
+		value += ",readonly"
+	}
+	if m.Size != "" {
+		value += ",size=" + m.Size
+	}
+
+	return value
+}
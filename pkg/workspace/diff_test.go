@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	newWorkspace := func(name string) *workspace.Workspace {
+		return &workspace.Workspace{
+			Id:     name,
+			Name:   name,
+			Target: "local",
+			Projects: []*project.Project{
+				{
+					Name:        "api",
+					Image:       "daytonaio/workspace-project:latest",
+					User:        "daytona",
+					BuildConfig: &buildconfig.BuildConfig{},
+					Repository: &gitprovider.GitRepository{
+						Url:    "https://github.com/daytonaio/daytona",
+						Branch: "main",
+					},
+					EnvVars:  map[string]string{"NODE_ENV": "development"},
+					Commands: []project.Command{{Name: "test", Command: "go test ./..."}},
+				},
+			},
+		}
+	}
+
+	a := newWorkspace("a")
+	b := newWorkspace("b")
+
+	require.Empty(t, workspace.Diff(a, b).Differences)
+
+	b.Projects[0].Image = "golang:1.22"
+	b.Projects[0].EnvVars["DEBUG"] = "true"
+	b.Projects[0].BuildConfig.Devcontainer = &buildconfig.DevcontainerConfig{FilePath: ".devcontainer/devcontainer.json"}
+	a.Projects[0].Commands[0].Workdir = "api"
+	a.Projects = append(a.Projects, &project.Project{Name: "web", Image: "node:20", User: "node"})
+
+	diff := workspace.Diff(a, b)
+	require.Equal(t, "a", diff.WorkspaceA)
+	require.Equal(t, "b", diff.WorkspaceB)
+
+	paths := []string{}
+	for _, d := range diff.Differences {
+		paths = append(paths, d.Path)
+	}
+
+	require.Equal(t, []string{
+		"projects.api.build",
+		"projects.api.commands.test",
+		"projects.api.envVars.DEBUG",
+		"projects.api.image",
+		"projects.web.build",
+		"projects.web.image",
+		"projects.web.user",
+	}, paths)
+
+	require.Equal(t, "automatic", *diff.Differences[0].A)
+	require.Equal(t, "devcontainer .devcontainer/devcontainer.json", *diff.Differences[0].B)
+	require.Equal(t, "go test ./... (in api)", *diff.Differences[1].A)
+	require.Nil(t, diff.Differences[2].A)
+	require.Equal(t, "true", *diff.Differences[2].B)
+	require.Equal(t, "node:20", *diff.Differences[5].A)
+	require.Nil(t, diff.Differences[5].B)
+}
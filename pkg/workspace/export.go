@@ -0,0 +1,280 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"sigs.k8s.io/yaml"
+)
+
+type ExportFormat string
+
+const (
+	// One devcontainer.json per project in .devcontainer/<project>
+	ExportFormatDevcontainer ExportFormat = "devcontainer"
+	// A Docker Compose file with a service per project
+	ExportFormatCompose ExportFormat = "compose"
+)
+
+var ErrInvalidExportFormat = errors.New("invalid export format")
+
+const ExportReadmeFileName = "README.md"
+
+// Export generates the files needed to run the projects of the workspace outside Daytona, keyed by their path
+// relative to the export directory. The repository of each project is expected to be cloned into a directory
+// named after the project next to the generated files, as described by the generated README.
+// Environment variables set by Daytona are not exported.
+func Export(w *Workspace, format ExportFormat) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	switch format {
+	case ExportFormatDevcontainer:
+		for _, p := range w.Projects {
+			content, err := json.MarshalIndent(toDevcontainerConfig(p), "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			files[path.Join(".devcontainer", p.Name, "devcontainer.json")] = append(content, '\n')
+		}
+	case ExportFormatCompose:
+		content, err := yaml.Marshal(toComposeFile(w))
+		if err != nil {
+			return nil, err
+		}
+		files["compose.yaml"] = content
+	default:
+		return nil, fmt.Errorf("%w: %q, must be one of %s, %s", ErrInvalidExportFormat, format, ExportFormatDevcontainer, ExportFormatCompose)
+	}
+
+	files[ExportReadmeFileName] = []byte(getExportReadme(w, format))
+
+	return files, nil
+}
+
+type devcontainerConfig struct {
+	Name            string                            `json:"name"`
+	Image           string                            `json:"image"`
+	RemoteUser      string                            `json:"remoteUser,omitempty"`
+	WorkspaceMount  string                            `json:"workspaceMount"`
+	WorkspaceFolder string                            `json:"workspaceFolder"`
+	ContainerEnv    map[string]string                 `json:"containerEnv,omitempty"`
+	Mounts          []string                          `json:"mounts,omitempty"`
+	ForwardPorts    []uint16                          `json:"forwardPorts,omitempty"`
+	PortsAttributes map[string]devcontainerPortConfig `json:"portsAttributes,omitempty"`
+}
+
+type devcontainerPortConfig struct {
+	Label    string `json:"label"`
+	Protocol string `json:"protocol,omitempty"`
+}
+
+func toDevcontainerConfig(p *project.Project) devcontainerConfig {
+	image, user := getExportImage(p)
+	projectDir := getExportProjectDir(p, user)
+
+	config := devcontainerConfig{
+		Name:  p.Name,
+		Image: image,
+		// The export directory contains the repository of each project in a directory named after the project
+		WorkspaceMount:  fmt.Sprintf("source=${localWorkspaceFolder}/%s,target=%s,type=bind", p.Name, projectDir),
+		WorkspaceFolder: projectDir,
+		RemoteUser:      user,
+		ContainerEnv:    getExportEnvVars(p),
+	}
+
+	for _, m := range p.Mounts {
+		config.Mounts = append(config.Mounts, m.MountFlag())
+	}
+
+	for _, port := range p.Ports {
+		if config.PortsAttributes == nil {
+			config.PortsAttributes = map[string]devcontainerPortConfig{}
+		}
+
+		portConfig := devcontainerPortConfig{Label: port.Name}
+		// The devcontainer spec only knows application protocols
+		if port.GetProtocol() != project.PortProtocolTcp {
+			portConfig.Protocol = string(port.GetProtocol())
+		}
+
+		config.ForwardPorts = append(config.ForwardPorts, port.Port)
+		config.PortsAttributes[strconv.Itoa(int(port.Port))] = portConfig
+	}
+
+	return config
+}
+
+type composeFile struct {
+	Name     string                    `json:"name"`
+	Services map[string]composeService `json:"services"`
+	Volumes  map[string]struct{}       `json:"volumes,omitempty"`
+}
+
+type composeService struct {
+	Image       string            `json:"image"`
+	User        string            `json:"user,omitempty"`
+	WorkingDir  string            `json:"working_dir"`
+	Command     []string          `json:"command"`
+	Environment map[string]string `json:"environment,omitempty"`
+	Volumes     []composeVolume   `json:"volumes"`
+	Ports       []string          `json:"ports,omitempty"`
+}
+
+type composeVolume struct {
+	Type     string               `json:"type"`
+	Source   string               `json:"source,omitempty"`
+	Target   string               `json:"target"`
+	ReadOnly bool                 `json:"read_only,omitempty"`
+	Tmpfs    *composeTmpfsOptions `json:"tmpfs,omitempty"`
+}
+
+type composeTmpfsOptions struct {
+	Size int64 `json:"size"`
+}
+
+var composeNameInvalidChars = regexp.MustCompile(`[^a-z0-9_-]`)
+
+// toComposeName converts a name to a valid Compose project or service name
+func toComposeName(name string) string {
+	return strings.Trim(composeNameInvalidChars.ReplaceAllString(strings.ToLower(name), "-"), "-_")
+}
+
+func toComposeFile(w *Workspace) composeFile {
+	file := composeFile{
+		Name:     toComposeName(w.Name),
+		Services: map[string]composeService{},
+	}
+
+	for _, p := range w.Projects {
+		image, user := getExportImage(p)
+		projectDir := getExportProjectDir(p, user)
+
+		service := composeService{
+			Image:      image,
+			User:       user,
+			WorkingDir: projectDir,
+			// Projects run their processes through the IDE or SSH, so the container only has to stay up
+			Command:     []string{"sleep", "infinity"},
+			Environment: getExportEnvVars(p),
+			Volumes: []composeVolume{
+				{Type: "bind", Source: "./" + p.Name, Target: projectDir},
+			},
+		}
+
+		for _, m := range p.Mounts {
+			volume := composeVolume{
+				Type:     string(m.Type),
+				Source:   m.Source,
+				Target:   m.Target,
+				ReadOnly: m.ReadOnly,
+			}
+
+			if size, _ := m.SizeBytes(); m.Type == project.MountTypeTmpfs && size > 0 {
+				volume.Tmpfs = &composeTmpfsOptions{Size: size}
+			}
+
+			if m.Type == project.MountTypeVolume {
+				if file.Volumes == nil {
+					file.Volumes = map[string]struct{}{}
+				}
+				file.Volumes[m.Source] = struct{}{}
+			}
+
+			service.Volumes = append(service.Volumes, volume)
+		}
+
+		for _, port := range p.Ports {
+			service.Ports = append(service.Ports, fmt.Sprintf("%d:%d", port.Port, port.Port))
+		}
+
+		file.Services[toComposeName(p.Name)] = service
+	}
+
+	return file
+}
+
+// getExportImage returns the image and user of the project, preferring the image of the build it was created from
+func getExportImage(p *project.Project) (string, string) {
+	if p.BuildConfig != nil && p.BuildConfig.CachedBuild != nil {
+		return p.BuildConfig.CachedBuild.Image, p.BuildConfig.CachedBuild.User
+	}
+
+	return p.Image, p.User
+}
+
+func getExportProjectDir(p *project.Project, user string) string {
+	if user == "" || user == "root" {
+		return "/" + path.Join("root", p.Name)
+	}
+
+	return path.Join("/home", user, p.Name)
+}
+
+func getExportEnvVars(p *project.Project) map[string]string {
+	envVars := map[string]string{}
+
+	for key, value := range p.EnvVars {
+		if strings.HasPrefix(key, "DAYTONA_") {
+			continue
+		}
+		envVars[key] = value
+	}
+
+	if len(envVars) == 0 {
+		return nil
+	}
+
+	return envVars
+}
+
+func getExportReadme(w *Workspace, format ExportFormat) string {
+	var readme strings.Builder
+
+	fmt.Fprintf(&readme, "# %s\n\n", w.Name)
+	readme.WriteString("Exported from Daytona. Clone the repository of each project next to this file:\n\n```sh\n")
+
+	projects := append([]*project.Project{}, w.Projects...)
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Name < projects[j].Name
+	})
+
+	for _, p := range projects {
+		if p.Repository == nil {
+			continue
+		}
+
+		branch := ""
+		if p.Repository.Branch != "" {
+			branch = fmt.Sprintf(" --branch %s", p.Repository.Branch)
+		}
+		fmt.Fprintf(&readme, "git clone%s %s %s\n", branch, p.Repository.Url, p.Name)
+	}
+	readme.WriteString("```\n\n")
+
+	switch format {
+	case ExportFormatDevcontainer:
+		readme.WriteString("Open this directory in an editor with devcontainer support and select the configuration of a project, " +
+			"or run `devcontainer up --workspace-folder . --config .devcontainer/<project>/devcontainer.json`.\n")
+	case ExportFormatCompose:
+		readme.WriteString("Start the projects with `docker compose up -d` and open a shell with `docker compose exec <project> bash`.\n")
+	}
+
+	for _, p := range projects {
+		if p.BuildConfig != nil && p.BuildConfig.Devcontainer != nil && p.BuildConfig.CachedBuild == nil {
+			fmt.Fprintf(&readme, "\nProject %s was built from %s in its repository. The exported configuration uses the base image %s, "+
+				"use the devcontainer configuration of the repository to get the same environment.\n", p.Name, p.BuildConfig.Devcontainer.FilePath, p.Image)
+		}
+	}
+
+	return readme.String()
+}
@@ -0,0 +1,96 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+var exportWorkspace = &workspace.Workspace{
+	Name: "My Workspace",
+	Projects: []*project.Project{
+		{
+			Name:  "api",
+			Image: "daytonaio/workspace-project:latest",
+			User:  "daytona",
+			BuildConfig: &buildconfig.BuildConfig{
+				CachedBuild: &buildconfig.CachedBuild{Image: "registry.example.com/api:abc", User: "dev"},
+			},
+			Repository: &gitprovider.GitRepository{
+				Url:    "https://github.com/daytonaio/api",
+				Branch: "main",
+			},
+			EnvVars: map[string]string{"NODE_ENV": "development", "DAYTONA_WS_ID": "123"},
+			Mounts: []project.Mount{
+				{Type: project.MountTypeVolume, Source: "cache", Target: "/cache", ReadOnly: true},
+				{Type: project.MountTypeTmpfs, Target: "/tmp/scratch", Size: "1m"},
+			},
+			Ports: []project.Port{
+				{Name: "web", Port: 3000},
+				{Name: "db", Port: 5432, Protocol: project.PortProtocolTcp},
+			},
+		},
+	},
+}
+
+func TestExportDevcontainer(t *testing.T) {
+	files, err := workspace.Export(exportWorkspace, workspace.ExportFormatDevcontainer)
+	require.NoError(t, err)
+	require.Contains(t, files, workspace.ExportReadmeFileName)
+	require.Contains(t, string(files[workspace.ExportReadmeFileName]), "git clone --branch main https://github.com/daytonaio/api api")
+
+	config := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(files[".devcontainer/api/devcontainer.json"], &config))
+
+	require.Equal(t, "registry.example.com/api:abc", config["image"])
+	require.Equal(t, "dev", config["remoteUser"])
+	require.Equal(t, "/home/dev/api", config["workspaceFolder"])
+	require.Equal(t, map[string]interface{}{"NODE_ENV": "development"}, config["containerEnv"])
+	require.Equal(t, []interface{}{
+		"type=volume,source=cache,target=/cache,readonly",
+		"type=tmpfs,target=/tmp/scratch,tmpfs-size=1048576",
+	}, config["mounts"])
+	require.Equal(t, []interface{}{float64(3000), float64(5432)}, config["forwardPorts"])
+	require.Equal(t, map[string]interface{}{
+		"3000": map[string]interface{}{"label": "web", "protocol": "http"},
+		"5432": map[string]interface{}{"label": "db"},
+	}, config["portsAttributes"])
+}
+
+func TestExportCompose(t *testing.T) {
+	files, err := workspace.Export(exportWorkspace, workspace.ExportFormatCompose)
+	require.NoError(t, err)
+	require.Contains(t, files, workspace.ExportReadmeFileName)
+
+	compose := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal(files["compose.yaml"], &compose))
+
+	require.Equal(t, "my-workspace", compose["name"])
+	require.Equal(t, map[string]interface{}{"cache": map[string]interface{}{}}, compose["volumes"])
+
+	service := compose["services"].(map[string]interface{})["api"].(map[string]interface{})
+	require.Equal(t, "registry.example.com/api:abc", service["image"])
+	require.Equal(t, "dev", service["user"])
+	require.Equal(t, "/home/dev/api", service["working_dir"])
+	require.Equal(t, map[string]interface{}{"NODE_ENV": "development"}, service["environment"])
+	require.Equal(t, []interface{}{"3000:3000", "5432:5432"}, service["ports"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"type": "bind", "source": "./api", "target": "/home/dev/api"},
+		map[string]interface{}{"type": "volume", "source": "cache", "target": "/cache", "read_only": true},
+		map[string]interface{}{"type": "tmpfs", "target": "/tmp/scratch", "tmpfs": map[string]interface{}{"size": float64(1048576)}},
+	}, service["volumes"])
+}
+
+func TestExportInvalidFormat(t *testing.T) {
+	_, err := workspace.Export(exportWorkspace, "helm")
+	require.ErrorIs(t, err, workspace.ErrInvalidExportFormat)
+}
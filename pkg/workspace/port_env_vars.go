@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+var envVarNameRegex = regexp.MustCompile(`[^A-Z0-9]+`)
+
+// GetPortEnvVars returns the env vars that point a project to the named ports of the other projects of the workspace,
+// so that multi-service repositories wire themselves up without hardcoded addresses. For a port named api of project
+// backend, BACKEND_API_URL and BACKEND_API_ADDR are set, e.g. to http://<hostname>:8080 and <hostname>:8080.
+// API_URL and API_ADDR are also set if no other project declares a port with the same name.
+// Names that collide keep the first value, names with the project prefix taking precedence, and DAYTONA_ names of the
+// agent are never set. Projects that are not on the tailnet are skipped
+func GetPortEnvVars(w *Workspace, projectName string) map[string]string {
+	envVars := map[string]string{}
+
+	declaredBy := map[string]int{}
+	for _, p := range w.Projects {
+		if p.Name == projectName {
+			continue
+		}
+		for _, port := range p.Ports {
+			declaredBy[toEnvVarName(port.Name)]++
+		}
+	}
+
+	type portEnvVar struct {
+		prefix  string
+		url     string
+		address string
+	}
+
+	prefixed := []portEnvVar{}
+	unprefixed := []portEnvVar{}
+
+	for _, p := range w.Projects {
+		if p.Name == projectName || p.Networking == project.NetworkingAgentless {
+			continue
+		}
+
+		for _, port := range p.Ports {
+			address, err := p.GetTailnetAddress(port.Port)
+			if err != nil {
+				continue
+			}
+
+			url := fmt.Sprintf("%s://%s", port.GetProtocol(), address)
+			portName := toEnvVarName(port.Name)
+
+			prefixed = append(prefixed, portEnvVar{toEnvVarName(p.Name) + "_" + portName, url, address})
+			if declaredBy[portName] == 1 {
+				unprefixed = append(unprefixed, portEnvVar{portName, url, address})
+			}
+		}
+	}
+
+	for _, envVar := range append(prefixed, unprefixed...) {
+		if strings.HasPrefix(envVar.prefix+"_", "DAYTONA_") {
+			continue
+		}
+
+		_, urlSet := envVars[envVar.prefix+"_URL"]
+		_, addressSet := envVars[envVar.prefix+"_ADDR"]
+		if urlSet || addressSet {
+			continue
+		}
+
+		envVars[envVar.prefix+"_URL"] = envVar.url
+		envVars[envVar.prefix+"_ADDR"] = envVar.address
+	}
+
+	return envVars
+}
+
+func toEnvVarName(name string) string {
+	return strings.Trim(envVarNameRegex.ReplaceAllString(strings.ToUpper(name), "_"), "_")
+}
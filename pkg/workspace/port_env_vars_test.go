@@ -0,0 +1,110 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPortEnvVars(t *testing.T) {
+	w := &workspace.Workspace{
+		Id: "ws1",
+		Projects: []*project.Project{
+			{
+				Name:        "frontend",
+				WorkspaceId: "ws1",
+				Ports:       []project.Port{{Name: "web", Port: 3000}},
+			},
+			{
+				Name:        "backend",
+				WorkspaceId: "ws1",
+				Hostname:    "backend-dev",
+				Ports: []project.Port{
+					{Name: "api", Port: 8080},
+					{Name: "web", Port: 8000},
+					{Name: "db-admin", Port: 5432, Protocol: project.PortProtocolTcp},
+				},
+			},
+			{
+				Name:        "worker",
+				WorkspaceId: "ws1",
+				Ports:       []project.Port{{Name: "metrics", Port: 9090}},
+				Route:       &project.ProjectRoute{Gateway: "frontend", PortRangeStart: 10000, PortRangeEnd: 19999},
+			},
+			{
+				Name:        "docs",
+				WorkspaceId: "ws1",
+				Networking:  project.NetworkingAgentless,
+				Ports:       []project.Port{{Name: "docs", Port: 4000}},
+			},
+		},
+	}
+
+	require.Equal(t, map[string]string{
+		"BACKEND_API_URL":       "http://backend-dev:8080",
+		"BACKEND_API_ADDR":      "backend-dev:8080",
+		"API_URL":               "http://backend-dev:8080",
+		"API_ADDR":              "backend-dev:8080",
+		"BACKEND_WEB_URL":       "http://backend-dev:8000",
+		"BACKEND_WEB_ADDR":      "backend-dev:8000",
+		"WEB_URL":               "http://backend-dev:8000",
+		"WEB_ADDR":              "backend-dev:8000",
+		"BACKEND_DB_ADMIN_URL":  "tcp://backend-dev:5432",
+		"BACKEND_DB_ADMIN_ADDR": "backend-dev:5432",
+		"DB_ADMIN_URL":          "tcp://backend-dev:5432",
+		"DB_ADMIN_ADDR":         "backend-dev:5432",
+		"WORKER_METRICS_URL":    "http://ws1-worker:19090",
+		"WORKER_METRICS_ADDR":   "ws1-worker:19090",
+		"METRICS_URL":           "http://ws1-worker:19090",
+		"METRICS_ADDR":          "ws1-worker:19090",
+	}, workspace.GetPortEnvVars(w, "frontend"))
+
+	// Port names declared by more than one other project are only set with the project prefix
+	envVars := workspace.GetPortEnvVars(w, "worker")
+	require.Equal(t, "http://ws1-frontend:3000", envVars["FRONTEND_WEB_URL"])
+	require.Equal(t, "http://backend-dev:8000", envVars["BACKEND_WEB_URL"])
+	require.NotContains(t, envVars, "WEB_URL")
+	require.NotContains(t, envVars, "METRICS_URL")
+	require.NotContains(t, envVars, "DOCS_DOCS_URL")
+}
+
+func TestGetPortEnvVarsCollisions(t *testing.T) {
+	w := &workspace.Workspace{
+		Id: "ws1",
+		Projects: []*project.Project{
+			{Name: "app", WorkspaceId: "ws1"},
+			{
+				Name:        "backend",
+				WorkspaceId: "ws1",
+				Ports:       []project.Port{{Name: "api", Port: 8080}},
+			},
+			{
+				Name:        "gateway",
+				WorkspaceId: "ws1",
+				Ports: []project.Port{
+					{Name: "backend-api", Port: 9000},
+					{Name: "daytona-server-api", Port: 3986},
+				},
+			},
+			{
+				Name:        "daytona",
+				WorkspaceId: "ws1",
+				Ports:       []project.Port{{Name: "server", Port: 3000}},
+			},
+		},
+	}
+
+	envVars := workspace.GetPortEnvVars(w, "app")
+
+	// The port of the backend project keeps its prefixed name over the unprefixed name of the gateway port
+	require.Equal(t, "http://ws1-backend:8080", envVars["BACKEND_API_URL"])
+	require.Equal(t, "http://ws1-gateway:9000", envVars["GATEWAY_BACKEND_API_URL"])
+	require.NotContains(t, envVars, "DAYTONA_SERVER_URL")
+	require.NotContains(t, envVars, "DAYTONA_SERVER_API_URL")
+	require.Equal(t, "http://ws1-gateway:3986", envVars["GATEWAY_DAYTONA_SERVER_API_URL"])
+}
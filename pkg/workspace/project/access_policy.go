@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"path"
+)
+
+type PortAccessAction string // @name PortAccessAction
+
+const (
+	PortAccessActionAllow PortAccessAction = "allow"
+	PortAccessActionDeny  PortAccessAction = "deny"
+)
+
+var (
+	ErrInvalidPortAccessAction = errors.New("access action must be allow or deny")
+	ErrInvalidPortRange        = errors.New("port range end must not be lower than its start")
+	ErrInvalidPeerPattern      = errors.New("peer patterns must be valid hostname globs, e.g. cli-*")
+)
+
+// PortAccessRule matches connections from tailnet peers to a range of project ports
+type PortAccessRule struct {
+	Action PortAccessAction `json:"action" validate:"required"`
+	// First port of the range. The rule matches every port if both ends of the range are 0
+	PortRangeStart uint16 `json:"portRangeStart,omitempty" validate:"optional"`
+	// Last port of the range. Defaults to the first port
+	PortRangeEnd uint16 `json:"portRangeEnd,omitempty" validate:"optional"`
+	// Globs of the names the control server gave the tailnet peers, e.g. server or cli-*. The rule matches every peer if empty
+	Peers []string `json:"peers,omitempty" validate:"optional"`
+} // @name PortAccessRule
+
+// PortAccessPolicy restricts the tailnet peers that can connect to ports of the project.
+// Rules are evaluated in order and the first matching rule applies
+type PortAccessPolicy struct {
+	Rules []PortAccessRule `json:"rules" validate:"required"`
+	// Action for connections that match no rule. Connections are allowed if empty
+	DefaultAction PortAccessAction `json:"defaultAction,omitempty" validate:"optional"`
+} // @name PortAccessPolicy
+
+func (p *PortAccessPolicy) Validate() error {
+	if p.DefaultAction != "" && p.DefaultAction != PortAccessActionAllow && p.DefaultAction != PortAccessActionDeny {
+		return ErrInvalidPortAccessAction
+	}
+
+	for _, rule := range p.Rules {
+		if rule.Action != PortAccessActionAllow && rule.Action != PortAccessActionDeny {
+			return ErrInvalidPortAccessAction
+		}
+
+		if rule.PortRangeEnd != 0 && rule.PortRangeEnd < rule.PortRangeStart {
+			return ErrInvalidPortRange
+		}
+
+		for _, peer := range rule.Peers {
+			_, err := path.Match(peer, "")
+			if peer == "" || err != nil {
+				return ErrInvalidPeerPattern
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check returns the action for a connection from the peer with the hostname to the port, along with
+// the index of the rule that matched. The index is -1 if the default action applies
+func (p *PortAccessPolicy) Check(port uint16, peer string) (PortAccessAction, int) {
+	for i, rule := range p.Rules {
+		if rule.matchesPort(port) && rule.matchesPeer(peer) {
+			return rule.Action, i
+		}
+	}
+
+	if p.DefaultAction == "" {
+		return PortAccessActionAllow, -1
+	}
+
+	return p.DefaultAction, -1
+}
+
+// IsEmpty returns true if the policy allows every connection
+func (p *PortAccessPolicy) IsEmpty() bool {
+	return len(p.Rules) == 0 && p.DefaultAction != PortAccessActionDeny
+}
+
+func (r *PortAccessRule) matchesPort(port uint16) bool {
+	if r.PortRangeStart == 0 && r.PortRangeEnd == 0 {
+		return true
+	}
+
+	end := r.PortRangeEnd
+	if end == 0 {
+		end = r.PortRangeStart
+	}
+
+	return port >= r.PortRangeStart && port <= end
+}
+
+func (r *PortAccessRule) matchesPeer(peer string) bool {
+	if len(r.Peers) == 0 {
+		return true
+	}
+
+	for _, pattern := range r.Peers {
+		if matched, _ := path.Match(pattern, peer); matched {
+			return true
+		}
+	}
+
+	return false
+}
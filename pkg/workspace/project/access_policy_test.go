@@ -0,0 +1,64 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortAccessPolicyValidate(t *testing.T) {
+	require.NoError(t, (&project.PortAccessPolicy{}).Validate())
+	require.NoError(t, (&project.PortAccessPolicy{
+		Rules:         []project.PortAccessRule{{Action: project.PortAccessActionAllow, PortRangeStart: 3000, PortRangeEnd: 3999, Peers: []string{"cli-*"}}},
+		DefaultAction: project.PortAccessActionDeny,
+	}).Validate())
+
+	require.ErrorIs(t, (&project.PortAccessPolicy{DefaultAction: "reject"}).Validate(), project.ErrInvalidPortAccessAction)
+	require.ErrorIs(t, (&project.PortAccessPolicy{Rules: []project.PortAccessRule{{}}}).Validate(), project.ErrInvalidPortAccessAction)
+	require.ErrorIs(t, (&project.PortAccessPolicy{Rules: []project.PortAccessRule{{Action: project.PortAccessActionDeny, PortRangeStart: 8080, PortRangeEnd: 80}}}).Validate(), project.ErrInvalidPortRange)
+	require.ErrorIs(t, (&project.PortAccessPolicy{Rules: []project.PortAccessRule{{Action: project.PortAccessActionDeny, Peers: []string{"cli-["}}}}).Validate(), project.ErrInvalidPeerPattern)
+	require.ErrorIs(t, (&project.PortAccessPolicy{Rules: []project.PortAccessRule{{Action: project.PortAccessActionDeny, Peers: []string{""}}}}).Validate(), project.ErrInvalidPeerPattern)
+}
+
+func TestPortAccessPolicyCheck(t *testing.T) {
+	policy := &project.PortAccessPolicy{
+		Rules: []project.PortAccessRule{
+			{Action: project.PortAccessActionDeny, PortRangeStart: 5432},
+			{Action: project.PortAccessActionAllow, PortRangeStart: 3000, PortRangeEnd: 3999, Peers: []string{"cli-*"}},
+			{Action: project.PortAccessActionAllow, Peers: []string{"server"}},
+		},
+		DefaultAction: project.PortAccessActionDeny,
+	}
+
+	tests := []struct {
+		port   uint16
+		peer   string
+		action project.PortAccessAction
+		rule   int
+	}{
+		{5432, "server", project.PortAccessActionDeny, 0},
+		{3000, "cli-laptop", project.PortAccessActionAllow, 1},
+		{3999, "cli-laptop", project.PortAccessActionAllow, 1},
+		{4000, "cli-laptop", project.PortAccessActionDeny, -1},
+		{3000, "server", project.PortAccessActionAllow, 2},
+		{3000, "", project.PortAccessActionDeny, -1},
+	}
+
+	for _, test := range tests {
+		action, rule := policy.Check(test.port, test.peer)
+		require.Equal(t, test.action, action, "port %d from %q", test.port, test.peer)
+		require.Equal(t, test.rule, rule, "port %d from %q", test.port, test.peer)
+	}
+
+	action, rule := (&project.PortAccessPolicy{}).Check(22, "cli-laptop")
+	require.Equal(t, project.PortAccessActionAllow, action)
+	require.Equal(t, -1, rule)
+
+	require.True(t, (&project.PortAccessPolicy{DefaultAction: project.PortAccessActionAllow}).IsEmpty())
+	require.False(t, (&project.PortAccessPolicy{DefaultAction: project.PortAccessActionDeny}).IsEmpty())
+	require.False(t, policy.IsEmpty())
+}
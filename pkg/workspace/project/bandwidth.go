@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+// BandwidthLimit caps the rate of the traffic the project agent proxies between the tailnet and the project
+type BandwidthLimit struct {
+	// Maximum rate in bytes per second of the traffic from tailnet peers to the project. Unlimited if 0
+	IngressBytesPerSecond uint64 `json:"ingressBytesPerSecond,omitempty" validate:"optional"`
+	// Maximum rate in bytes per second of the traffic from the project to tailnet peers. Unlimited if 0
+	EgressBytesPerSecond uint64 `json:"egressBytesPerSecond,omitempty" validate:"optional"`
+} // @name BandwidthLimit
+
+func (l *BandwidthLimit) IsEmpty() bool {
+	return l == nil || (l.IngressBytesPerSecond == 0 && l.EgressBytesPerSecond == 0)
+}
+
+// GetEffectiveBandwidthLimit merges the limit of the target with the limit of the workspace. The stricter limit of
+// each direction applies, so a workspace can only lower the limit of its target
+func GetEffectiveBandwidthLimit(targetLimit, workspaceLimit *BandwidthLimit) BandwidthLimit {
+	limit := BandwidthLimit{}
+	if targetLimit != nil {
+		limit = *targetLimit
+	}
+
+	if workspaceLimit != nil {
+		limit.IngressBytesPerSecond = minRate(limit.IngressBytesPerSecond, workspaceLimit.IngressBytesPerSecond)
+		limit.EgressBytesPerSecond = minRate(limit.EgressBytesPerSecond, workspaceLimit.EgressBytesPerSecond)
+	}
+
+	return limit
+}
+
+// minRate returns the lower of two rates where 0 is unlimited
+func minRate(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	return min(a, b)
+}
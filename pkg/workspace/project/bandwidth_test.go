@@ -0,0 +1,27 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEffectiveBandwidthLimit(t *testing.T) {
+	targetLimit := &project.BandwidthLimit{IngressBytesPerSecond: 1000, EgressBytesPerSecond: 2000}
+
+	require.Equal(t, project.BandwidthLimit{}, project.GetEffectiveBandwidthLimit(nil, nil))
+	require.Equal(t, *targetLimit, project.GetEffectiveBandwidthLimit(targetLimit, nil))
+	require.Equal(t, *targetLimit, project.GetEffectiveBandwidthLimit(targetLimit, &project.BandwidthLimit{}))
+
+	// The stricter limit of each direction applies
+	require.Equal(t, project.BandwidthLimit{IngressBytesPerSecond: 500, EgressBytesPerSecond: 2000},
+		project.GetEffectiveBandwidthLimit(targetLimit, &project.BandwidthLimit{IngressBytesPerSecond: 500}))
+	require.Equal(t, *targetLimit,
+		project.GetEffectiveBandwidthLimit(targetLimit, &project.BandwidthLimit{IngressBytesPerSecond: 5000, EgressBytesPerSecond: 4000}))
+	require.Equal(t, project.BandwidthLimit{EgressBytesPerSecond: 3000},
+		project.GetEffectiveBandwidthLimit(nil, &project.BandwidthLimit{EgressBytesPerSecond: 3000}))
+}
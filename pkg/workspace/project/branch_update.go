@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import "errors"
+
+type BranchUpdateStrategy string // @name BranchUpdateStrategy
+
+const (
+	BranchUpdateStrategyFastForward BranchUpdateStrategy = "fast-forward"
+	BranchUpdateStrategyRebase      BranchUpdateStrategy = "rebase"
+)
+
+var (
+	ErrInvalidBranchUpdateStrategy = errors.New("update strategy must be fast-forward or rebase")
+	ErrNoUpstreamBranch            = errors.New("the checked out branch has no upstream branch")
+	ErrBranchDiverged              = errors.New("the branch has diverged from its upstream branch and can not be fast-forwarded. Update it with the rebase strategy")
+)
+
+func (s BranchUpdateStrategy) Validate() error {
+	if s != BranchUpdateStrategyFastForward && s != BranchUpdateStrategyRebase {
+		return ErrInvalidBranchUpdateStrategy
+	}
+
+	return nil
+}
+
+// BranchUpdate is the result of updating the checked out branch of a project to its upstream branch
+type BranchUpdate struct {
+	Branch      string               `json:"branch" validate:"required"`
+	Upstream    string               `json:"upstream" validate:"required"`
+	Strategy    BranchUpdateStrategy `json:"strategy" validate:"required"`
+	PreviousSha string               `json:"previousSha" validate:"required"`
+	Sha         string               `json:"sha" validate:"required"`
+	// Files that conflicted while rebasing. The rebase is aborted and the branch is left as it was if set
+	Conflicts []string `json:"conflicts,omitempty" validate:"optional"`
+} // @name BranchUpdate
+
+// Updated returns true if the branch moved to another commit
+func (u *BranchUpdate) Updated() bool {
+	return u.Sha != u.PreviousSha
+}
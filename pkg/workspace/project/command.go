@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidCommand = errors.New("invalid command")
+
+var ErrCommandNotFound = errors.New("command not found")
+
+// Command is a named shell command declared by the project, e.g. test or migrate-db
+type Command struct {
+	Name    string `json:"name" validate:"required"`
+	Command string `json:"command" validate:"required"`
+	// Working directory relative to the project directory. Defaults to the project directory
+	Workdir     string `json:"workdir,omitempty" validate:"optional"`
+	Description string `json:"description,omitempty" validate:"optional"`
+} // @name ProjectCommand
+
+var commandNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.:-]*$`)
+
+func (c Command) Validate() error {
+	if !commandNameRegex.MatchString(c.Name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidCommand, c.Name)
+	}
+
+	if strings.TrimSpace(c.Command) == "" {
+		return fmt.Errorf("%w: %s has no command", ErrInvalidCommand, c.Name)
+	}
+
+	if strings.HasPrefix(c.Workdir, "/") || strings.Contains("/"+c.Workdir+"/", "/../") {
+		return fmt.Errorf("%w: workdir of %s must be relative to the project directory", ErrInvalidCommand, c.Name)
+	}
+
+	return nil
+}
+
+// ValidateCommands validates each command and rejects duplicate names
+func ValidateCommands(commands []Command) error {
+	names := map[string]bool{}
+
+	for _, c := range commands {
+		err := c.Validate()
+		if err != nil {
+			return err
+		}
+
+		if names[c.Name] {
+			return fmt.Errorf("%w: multiple commands named %s", ErrInvalidCommand, c.Name)
+		}
+		names[c.Name] = true
+	}
+
+	return nil
+}
+
+// ParseCommand parses a command in the form <name>=<command>, e.g. test=go test ./...
+func ParseCommand(value string) (Command, error) {
+	name, command, ok := strings.Cut(value, "=")
+	if !ok {
+		return Command{}, fmt.Errorf("%w: %q must be in the form name=command", ErrInvalidCommand, value)
+	}
+
+	c := Command{
+		Name:    strings.TrimSpace(name),
+		Command: strings.TrimSpace(command),
+	}
+
+	return c, c.Validate()
+}
+
+func (p *Project) GetCommand(name string) (*Command, error) {
+	for _, c := range p.Commands {
+		if c.Name == name {
+			return &c, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrCommandNotFound, name)
+}
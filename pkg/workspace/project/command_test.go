@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	c, err := project.ParseCommand("migrate-db = npm run migrate -- --env=dev")
+	require.NoError(t, err)
+	require.Equal(t, project.Command{Name: "migrate-db", Command: "npm run migrate -- --env=dev"}, c)
+
+	for _, invalid := range []string{"test", "=go test", "test=", "-test=go test"} {
+		_, err := project.ParseCommand(invalid)
+		require.True(t, errors.Is(err, project.ErrInvalidCommand), invalid)
+	}
+
+	require.Error(t, project.Command{Name: "seed", Command: "make seed", Workdir: "../other"}.Validate())
+	require.NoError(t, project.Command{Name: "seed", Command: "make seed", Workdir: "db"}.Validate())
+
+	err = project.ValidateCommands([]project.Command{{Name: "test", Command: "go test"}, {Name: "test", Command: "make test"}})
+	require.ErrorIs(t, err, project.ErrInvalidCommand)
+}
@@ -5,7 +5,9 @@ package config
 
 import (
 	"errors"
+	"slices"
 
+	"github.com/daytonaio/daytona/pkg/workspace/project"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
 )
 
@@ -19,6 +21,12 @@ type ProjectConfig struct {
 	IsDefault           bool                     `json:"default" validate:"required"`
 	Prebuilds           []*PrebuildConfig        `json:"prebuilds" validate:"optional"`
 	GitProviderConfigId *string                  `json:"gitProviderConfigId" validate:"optional"`
+	OrganizationId      string                   `json:"organizationId,omitempty" validate:"optional"`
+	Mounts              []project.Mount          `json:"mounts,omitempty" validate:"optional"`
+	Commands            []project.Command        `json:"commands,omitempty" validate:"optional"`
+	Ports               []project.Port           `json:"ports,omitempty" validate:"optional"`
+	// Names of the build secrets that builds of the project config can read through secret mounts
+	BuildSecrets []string `json:"buildSecrets,omitempty" validate:"optional"`
 } // @name ProjectConfig
 
 func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
@@ -28,6 +36,8 @@ func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
 		CommitInterval: p.CommitInterval,
 		TriggerFiles:   p.TriggerFiles,
 		Retention:      p.Retention,
+		Matrix:         p.Matrix,
+		BuildSecrets:   p.BuildSecrets,
 	}
 
 	for _, pb := range pc.Prebuilds {
@@ -41,6 +51,22 @@ func (pc *ProjectConfig) SetPrebuild(p *PrebuildConfig) error {
 	return nil
 }
 
+// GetBuildSecrets returns the names of the build secrets of the project config and of the prebuild, each name once.
+// The prebuild can be nil
+func (pc *ProjectConfig) GetBuildSecrets(prebuild *PrebuildConfig) []string {
+	names := slices.Clone(pc.BuildSecrets)
+
+	if prebuild != nil {
+		for _, name := range prebuild.BuildSecrets {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
 func (pc *ProjectConfig) FindPrebuild(filter *PrebuildFilter) (*PrebuildConfig, error) {
 	for _, pb := range pc.Prebuilds {
 		if pb.Match(filter) {
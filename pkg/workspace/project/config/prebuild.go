@@ -12,13 +12,33 @@ import (
 
 // PrebuildConfig holds configuration for the prebuild process
 type PrebuildConfig struct {
-	Id             string   `json:"id" validate:"required"`
-	Branch         string   `json:"branch" validate:"required"`
-	CommitInterval *int     `json:"commitInterval" validate:"required"`
-	TriggerFiles   []string `json:"triggerFiles" validate:"required"`
-	Retention      int      `json:"retention" validate:"required"`
+	Id             string          `json:"id" validate:"required"`
+	Branch         string          `json:"branch" validate:"required"`
+	CommitInterval *int            `json:"commitInterval" validate:"required"`
+	TriggerFiles   []string        `json:"triggerFiles" validate:"required"`
+	Retention      int             `json:"retention" validate:"required"`
+	Matrix         *PrebuildMatrix `json:"matrix,omitempty" validate:"optional"`
+	// Names of the build secrets that builds of the prebuild can read in addition to those of the project config
+	BuildSecrets []string `json:"buildSecrets,omitempty" validate:"optional"`
 } // @name PrebuildConfig
 
+// PrebuildMatrix fans a single prebuild out into multiple builds - one for
+// every combination of the listed devcontainer paths, images and architectures.
+// An empty dimension falls back to the project config value.
+type PrebuildMatrix struct {
+	DevcontainerPaths []string `json:"devcontainerPaths,omitempty" validate:"optional"`
+	Images            []string `json:"images,omitempty" validate:"optional"`
+	Architectures     []string `json:"architectures,omitempty" validate:"optional"`
+} // @name PrebuildMatrix
+
+// PrebuildVariant is a single combination of a prebuild matrix.
+// Empty fields mean that the project config value should be used.
+type PrebuildVariant struct {
+	DevcontainerPath string
+	Image            string
+	Architecture     string
+}
+
 func (p *PrebuildConfig) GenerateId() error {
 	id := stringid.GenerateRandomID()
 	id = stringid.TruncateID(id)
@@ -27,6 +47,37 @@ func (p *PrebuildConfig) GenerateId() error {
 	return nil
 }
 
+// Variants returns every combination of the prebuild matrix.
+// A prebuild without a matrix has a single, empty variant.
+func (p *PrebuildConfig) Variants() []PrebuildVariant {
+	variants := []PrebuildVariant{{}}
+	if p.Matrix == nil {
+		return variants
+	}
+
+	expand := func(values []string, set func(*PrebuildVariant, string)) {
+		if len(values) == 0 {
+			return
+		}
+
+		expanded := []PrebuildVariant{}
+		for _, variant := range variants {
+			for _, value := range values {
+				v := variant
+				set(&v, value)
+				expanded = append(expanded, v)
+			}
+		}
+		variants = expanded
+	}
+
+	expand(p.Matrix.DevcontainerPaths, func(v *PrebuildVariant, value string) { v.DevcontainerPath = value })
+	expand(p.Matrix.Images, func(v *PrebuildVariant, value string) { v.Image = value })
+	expand(p.Matrix.Architectures, func(v *PrebuildVariant, value string) { v.Architecture = value })
+
+	return variants
+}
+
 func (p *PrebuildConfig) Match(filter *PrebuildFilter) bool {
 	if filter.Id != nil && *filter.Id != p.Id {
 		return false
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import "time"
+
+// PrebuildUsage records whether a project was created from a prebuilt image.
+// Misses have no build, prebuild or commit information.
+type PrebuildUsage struct {
+	Id            string
+	WorkspaceId   string
+	ProjectName   string
+	RepositoryUrl string
+	Branch        string
+	Hit           bool
+	BuildId       string
+	PrebuildId    string
+	CommitSha     string
+	// TimeSaved is the duration of the prebuild's build which the project creation skipped
+	TimeSaved time.Duration
+	CreatedAt time.Time
+}
+
+type PrebuildUsageFilter struct {
+	RepositoryUrl *string
+	Branch        *string
+	PrebuildId    *string
+}
+
+type PrebuildUsageStore interface {
+	List(filter *PrebuildUsageFilter) ([]*PrebuildUsage, error)
+	Save(usage *PrebuildUsage) error
+}
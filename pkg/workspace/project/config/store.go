@@ -11,6 +11,7 @@ type ProjectConfigFilter struct {
 	Default             *bool
 	PrebuildId          *string
 	GitProviderConfigId *string
+	OrganizationId      *string
 }
 
 type PrebuildFilter struct {
@@ -0,0 +1,113 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrUnknownDebugPreset = errors.New("unknown debug preset")
+
+// DebugPortName is the name of the port a debug preset declares on the project
+const DebugPortName = "debug"
+
+// DebugPreset configures a project for remote debugging with the debugger of a language
+type DebugPreset struct {
+	Name     string
+	Debugger string
+	Port     uint16
+	// LaunchCommand starts a program under the debugger. The debugger only listens on the loopback interface of the
+	// project and is reached through the port forward of the agent
+	LaunchCommand string
+}
+
+var DebugPresets = []DebugPreset{
+	{
+		Name:          "go",
+		Debugger:      "Delve",
+		Port:          2345,
+		LaunchCommand: "dlv debug --headless --listen=127.0.0.1:2345 --api-version=2 --accept-multiclient",
+	},
+	{
+		Name:          "node",
+		Debugger:      "Node.js inspector",
+		Port:          9229,
+		LaunchCommand: "node --inspect=127.0.0.1:9229 <script>",
+	},
+	{
+		Name:          "jvm",
+		Debugger:      "JDWP",
+		Port:          5005,
+		LaunchCommand: "java -agentlib:jdwp=transport=dt_socket,server=y,suspend=n,address=127.0.0.1:5005 -jar <jar>",
+	},
+	{
+		Name:          "python",
+		Debugger:      "debugpy",
+		Port:          5678,
+		LaunchCommand: "python -m debugpy --listen 127.0.0.1:5678 <script>",
+	},
+}
+
+// GetDebugPreset returns the debug preset with the given name
+func GetDebugPreset(name string) (*DebugPreset, error) {
+	for _, preset := range DebugPresets {
+		if preset.Name == strings.ToLower(name) {
+			return &preset, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q, available presets: %s", ErrUnknownDebugPreset, name, strings.Join(GetDebugPresetNames(), ", "))
+}
+
+func GetDebugPresetNames() []string {
+	names := []string{}
+	for _, preset := range DebugPresets {
+		names = append(names, preset.Name)
+	}
+	return names
+}
+
+// FindDebugPreset returns the debug preset applied to a project with the given ports, or nil if none was applied
+func FindDebugPreset(ports []Port) *DebugPreset {
+	for _, port := range ports {
+		if port.Name != DebugPortName {
+			continue
+		}
+
+		for _, preset := range DebugPresets {
+			if preset.Port == port.Port {
+				return &preset
+			}
+		}
+	}
+
+	return nil
+}
+
+// Apply declares the debug port of the preset on the project
+func (p DebugPreset) Apply(ports []Port) ([]Port, error) {
+	declared := false
+	for _, port := range ports {
+		if port.Name == DebugPortName && port.Port != p.Port {
+			return nil, fmt.Errorf("%w: %s is already declared as port %d", ErrInvalidPort, DebugPortName, port.Port)
+		}
+		if port.Port == p.Port && port.Name != DebugPortName {
+			return nil, fmt.Errorf("%w: port %d of the %s debugger is already declared as %s", ErrInvalidPort, p.Port, p.Debugger, port.Name)
+		}
+		declared = declared || port.Name == DebugPortName
+	}
+
+	if declared {
+		return ports, nil
+	}
+
+	return append(slices.Clone(ports), Port{
+		Name:     DebugPortName,
+		Port:     p.Port,
+		Protocol: PortProtocolTcp,
+	}), nil
+}
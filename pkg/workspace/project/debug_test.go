@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebugPresetApply(t *testing.T) {
+	preset, err := project.GetDebugPreset("Node")
+	require.NoError(t, err)
+
+	require.Contains(t, preset.LaunchCommand, "127.0.0.1:9229")
+
+	ports, err := preset.Apply([]project.Port{{Name: "web", Port: 3000}})
+	require.NoError(t, err)
+	require.Equal(t, []project.Port{
+		{Name: "web", Port: 3000},
+		{Name: project.DebugPortName, Port: 9229, Protocol: project.PortProtocolTcp},
+	}, ports)
+	require.Equal(t, preset, project.FindDebugPreset(ports))
+
+	again, err := preset.Apply(ports)
+	require.NoError(t, err)
+	require.Equal(t, ports, again)
+
+	_, err = preset.Apply([]project.Port{{Name: "inspector", Port: 9229}})
+	require.ErrorIs(t, err, project.ErrInvalidPort)
+
+	_, err = preset.Apply([]project.Port{{Name: project.DebugPortName, Port: 5005}})
+	require.ErrorIs(t, err, project.ErrInvalidPort)
+
+	_, err = project.GetDebugPreset("ruby")
+	require.ErrorIs(t, err, project.ErrUnknownDebugPreset)
+
+	require.Nil(t, project.FindDebugPreset([]project.Port{{Name: "web", Port: 3000}}))
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"slices"
+	"time"
+)
+
+// DetectedPort is a port that a process of the project listens on, detected by the agent
+type DetectedPort struct {
+	Port uint16 `json:"port" validate:"required"`
+	// When the port was first detected, in RFC3339 format
+	DetectedAt string `json:"detectedAt" validate:"required"`
+} // @name DetectedPort
+
+// NewDetectedPorts returns the ports reported by the agent as detected at the given time, sorted by port number
+func NewDetectedPorts(ports []uint16, detectedAt time.Time) []DetectedPort {
+	detected := []DetectedPort{}
+
+	for _, port := range ports {
+		if slices.ContainsFunc(detected, func(p DetectedPort) bool { return p.Port == port }) {
+			continue
+		}
+
+		detected = append(detected, DetectedPort{
+			Port:       port,
+			DetectedAt: detectedAt.Format(time.RFC3339),
+		})
+	}
+
+	slices.SortFunc(detected, func(a, b DetectedPort) int {
+		return int(a.Port) - int(b.Port)
+	})
+
+	return detected
+}
+
+// MergeDetectedPorts returns the reported ports. Ports that were already detected keep the time they were first
+// detected at. The ports of reported that are not in previous are returned as new
+func MergeDetectedPorts(previous, reported []DetectedPort) (merged []DetectedPort, newPorts []uint16) {
+	merged = []DetectedPort{}
+	newPorts = []uint16{}
+
+	for _, p := range reported {
+		i := slices.IndexFunc(previous, func(prev DetectedPort) bool { return prev.Port == p.Port })
+		if i >= 0 {
+			p.DetectedAt = previous[i].DetectedAt
+		} else {
+			newPorts = append(newPorts, p.Port)
+		}
+		merged = append(merged, p)
+	}
+
+	return merged, newPorts
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeDetectedPorts(t *testing.T) {
+	first := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	previous := project.NewDetectedPorts([]uint16{8080, 3000, 3000}, first)
+	require.Equal(t, []project.DetectedPort{
+		{Port: 3000, DetectedAt: "2024-01-01T10:00:00Z"},
+		{Port: 8080, DetectedAt: "2024-01-01T10:00:00Z"},
+	}, previous)
+
+	reported := project.NewDetectedPorts([]uint16{3000, 5173}, first.Add(time.Minute))
+	merged, newPorts := project.MergeDetectedPorts(previous, reported)
+	require.Equal(t, []project.DetectedPort{
+		{Port: 3000, DetectedAt: "2024-01-01T10:00:00Z"},
+		{Port: 5173, DetectedAt: "2024-01-01T10:01:00Z"},
+	}, merged)
+	require.Equal(t, []uint16{5173}, newPorts)
+
+	merged, newPorts = project.MergeDetectedPorts(nil, reported)
+	require.Equal(t, reported, merged)
+	require.Equal(t, []uint16{3000, 5173}, newPorts)
+}
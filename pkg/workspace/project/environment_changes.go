@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+// EnvironmentChanges lists the files of the environment definition of a project, i.e. the files in .devcontainer and
+// the devcontainer configuration, that changed since the project container was built. They only take effect after
+// the project is rebuilt
+type EnvironmentChanges struct {
+	// Files changed in the project directory
+	Local []string `json:"local,omitempty" validate:"optional"`
+	// Files changed on the upstream branch of the project that are not pulled yet
+	Upstream []string `json:"upstream,omitempty" validate:"optional"`
+} // @name EnvironmentChanges
+
+func (c *EnvironmentChanges) IsEmpty() bool {
+	return c == nil || (len(c.Local) == 0 && len(c.Upstream) == 0)
+}
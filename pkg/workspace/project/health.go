@@ -0,0 +1,69 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+type AgentHealthStatus string // @name AgentHealthStatus
+
+const (
+	AgentHealthStatusHealthy AgentHealthStatus = "healthy"
+	// The agent is reachable but disconnected from the control plane or one of its processes is failing
+	AgentHealthStatusDegraded AgentHealthStatus = "degraded"
+)
+
+// AgentHealth is reported by the /health endpoint the project agent serves on the tailnet
+type AgentHealth struct {
+	Status  AgentHealthStatus `json:"status" validate:"required"`
+	Tailnet TailnetHealth     `json:"tailnet" validate:"required"`
+	// LastControlContactAt is when the agent was last seen online by the control plane. Empty if it never was
+	LastControlContactAt string `json:"lastControlContactAt,omitempty" validate:"optional"`
+	// Uptime of the agent in seconds
+	Uptime uint64 `json:"uptime" validate:"required"`
+	// Connections currently forwarded from the tailnet to ports and sockets of the project
+	ActiveConnections uint32          `json:"activeConnections" validate:"required"`
+	Processes         []ProcessHealth `json:"processes" validate:"required"`
+} // @name AgentHealth
+
+type TailnetHealth struct {
+	// Tailscale backend state, e.g. Running or NeedsLogin
+	State  string `json:"state" validate:"required"`
+	Online bool   `json:"online" validate:"required"`
+	// DERP relay region the agent is homed in
+	Relay string `json:"relay,omitempty" validate:"optional"`
+	// Warnings reported by the tailscale client
+	Warnings []string `json:"warnings,omitempty" validate:"optional"`
+	// Relay regions of the tailnet as probed from the project, sorted by region code
+	Relays []RelayHealth `json:"relays,omitempty" validate:"optional"`
+} // @name TailnetHealth
+
+// RelayHealth is the result of probing a DERP relay region of the tailnet from the project
+type RelayHealth struct {
+	Region    string `json:"region" validate:"required"`
+	Reachable bool   `json:"reachable" validate:"required"`
+	// Lowest round trip time of the probes in milliseconds. Zero if the region is unreachable
+	LatencyMs int64  `json:"latencyMs" validate:"required"`
+	Error     string `json:"error,omitempty" validate:"optional"`
+	CheckedAt string `json:"checkedAt" validate:"required"`
+} // @name RelayHealth
+
+// ProcessHealth is the state of a process the agent runs in the project, e.g. the SSH server
+type ProcessHealth struct {
+	Name    string `json:"name" validate:"required"`
+	Healthy bool   `json:"healthy" validate:"required"`
+	Error   string `json:"error,omitempty" validate:"optional"`
+} // @name ProcessHealth
+
+// GetStatus returns degraded if the agent is disconnected from the control plane or any process is unhealthy
+func (h *AgentHealth) GetStatus() AgentHealthStatus {
+	if !h.Tailnet.Online {
+		return AgentHealthStatusDegraded
+	}
+
+	for _, p := range h.Processes {
+		if !p.Healthy {
+			return AgentHealthStatusDegraded
+		}
+	}
+
+	return AgentHealthStatusHealthy
+}
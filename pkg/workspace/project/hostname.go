@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const maxHostnameLength = 63
+
+// TailnetDomain is the MagicDNS domain of the tailnet, so projects are reachable at <hostname>.<domain>
+const TailnetDomain = "daytona.local"
+
+var ErrInvalidHostnameTemplate = errors.New("invalid hostname template")
+
+var hostnamePlaceholderRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+var invalidHostnameCharsRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// HostnameVars are the values of the placeholders of a hostname template
+type HostnameVars struct {
+	// User is the name of the identity that created the workspace
+	User        string
+	Workspace   string
+	WorkspaceId string
+	Project     string
+}
+
+func (v HostnameVars) get(placeholder string) (string, bool) {
+	switch placeholder {
+	case "user":
+		return v.User, true
+	case "workspace":
+		return v.Workspace, true
+	case "workspaceId":
+		return v.WorkspaceId, true
+	case "project":
+		return v.Project, true
+	}
+
+	return "", false
+}
+
+// ValidateHostnameTemplate checks that the template only uses known placeholders, e.g. "{user}-{workspace}-{project}"
+func ValidateHostnameTemplate(template string) error {
+	if strings.TrimSpace(template) == "" {
+		return fmt.Errorf("%w: template is empty", ErrInvalidHostnameTemplate)
+	}
+
+	for _, match := range hostnamePlaceholderRegex.FindAllStringSubmatch(template, -1) {
+		if _, ok := (HostnameVars{}).get(match[1]); !ok {
+			return fmt.Errorf("%w: unknown placeholder {%s}", ErrInvalidHostnameTemplate, match[1])
+		}
+	}
+
+	return nil
+}
+
+// RenderHostname fills the placeholders of the template and normalizes the result to a DNS label
+func RenderHostname(template string, vars HostnameVars) (string, error) {
+	err := ValidateHostnameTemplate(template)
+	if err != nil {
+		return "", err
+	}
+
+	rendered := hostnamePlaceholderRegex.ReplaceAllStringFunc(template, func(placeholder string) string {
+		value, _ := vars.get(strings.Trim(placeholder, "{}"))
+		return value
+	})
+
+	hostname := NormalizeHostname(rendered)
+	if hostname == "" {
+		return "", fmt.Errorf("%w: %s renders an empty hostname", ErrInvalidHostnameTemplate, template)
+	}
+
+	return hostname, nil
+}
+
+// NormalizeHostname lowercases the value, replaces characters that are not allowed in a DNS label with
+// hyphens and trims it to 63 characters
+func NormalizeHostname(value string) string {
+	hostname := invalidHostnameCharsRegex.ReplaceAllString(strings.ToLower(value), "-")
+	hostname = strings.Trim(hostname, "-")
+
+	if len(hostname) > maxHostnameLength {
+		hostname = strings.TrimRight(hostname[:maxHostnameLength], "-")
+	}
+
+	return hostname
+}
+
+// Hostnames of the Daytona Server node and the prefix of the hostnames of CLI client nodes
+const (
+	serverHostname    = "server"
+	cliHostnamePrefix = "cli-"
+)
+
+// IsReservedHostname checks if the hostname is used by the nodes of the Daytona Server or CLI clients
+func IsReservedHostname(hostname string) bool {
+	return hostname == serverHostname || strings.HasPrefix(hostname, cliHostnamePrefix)
+}
+
+// UniqueHostname appends the lowest numeric suffix that makes the hostname unique, e.g. "dev-api-2"
+func UniqueHostname(hostname string, taken func(hostname string) bool) string {
+	if !taken(hostname) {
+		return hostname
+	}
+
+	for i := 2; ; i++ {
+		suffix := fmt.Sprintf("-%d", i)
+
+		base := hostname
+		if len(base)+len(suffix) > maxHostnameLength {
+			base = strings.TrimRight(base[:maxHostnameLength-len(suffix)], "-")
+		}
+
+		if candidate := base + suffix; !taken(candidate) {
+			return candidate
+		}
+	}
+}
+
+// GetHostname returns the tailnet hostname of the project agent
+func (p *Project) GetHostname() string {
+	if p.Hostname != "" {
+		return p.Hostname
+	}
+
+	return GetProjectHostname(p.WorkspaceId, p.Name)
+}
+
+// GetTailnetDomainName returns the MagicDNS name of the project agent
+func (p *Project) GetTailnetDomainName() string {
+	return fmt.Sprintf("%s.%s", p.GetHostname(), TailnetDomain)
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderHostname(t *testing.T) {
+	hostname, err := project.RenderHostname("{user}-{workspace}-{project}", project.HostnameVars{
+		User:      "Jane.Doe",
+		Workspace: "My Workspace",
+		Project:   "api_server",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "jane-doe-my-workspace-api-server", hostname)
+
+	_, err = project.RenderHostname("{owner}-{project}", project.HostnameVars{Project: "api"})
+	require.ErrorIs(t, err, project.ErrInvalidHostnameTemplate)
+
+	_, err = project.RenderHostname("{user}", project.HostnameVars{})
+	require.ErrorIs(t, err, project.ErrInvalidHostnameTemplate)
+}
+
+func TestNormalizeHostname(t *testing.T) {
+	require.Equal(t, "dev-api", project.NormalizeHostname("-Dev API!-"))
+	require.Len(t, project.NormalizeHostname(strings.Repeat("a", 70)), 63)
+}
+
+func TestUniqueHostname(t *testing.T) {
+	taken := map[string]bool{"dev-api": true, "dev-api-2": true}
+	isTaken := func(hostname string) bool { return taken[hostname] }
+
+	require.Equal(t, "dev-web", project.UniqueHostname("dev-web", isTaken))
+	require.Equal(t, "dev-api-3", project.UniqueHostname("dev-api", isTaken))
+
+	long := strings.Repeat("a", 63)
+	taken[long] = true
+	unique := project.UniqueHostname(long, isTaken)
+	require.Len(t, unique, 63)
+	require.True(t, strings.HasSuffix(unique, "-2"))
+}
+
+func TestIsReservedHostname(t *testing.T) {
+	require.True(t, project.IsReservedHostname("server"))
+	require.True(t, project.IsReservedHostname("cli-laptop"))
+	require.False(t, project.IsReservedHostname("server-api"))
+	require.False(t, project.IsReservedHostname("client"))
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/docker/go-units"
+)
+
+var ErrInvalidMount = errors.New("invalid mount")
+var ErrBindMountNotAllowed = errors.New("bind mount source is not allowed")
+
+type MountType string // @name MountType
+
+const (
+	// Path on the host running the project container, e.g. a shared dataset on a remote Docker target
+	MountTypeBind MountType = "bind"
+	// Named volume, created by the provider if it does not exist
+	MountTypeVolume MountType = "volume"
+	// In-memory filesystem discarded when the project stops
+	MountTypeTmpfs MountType = "tmpfs"
+)
+
+// Mount is an additional mount of the project container
+type Mount struct {
+	Type MountType `json:"type" validate:"required"`
+	// Host path for bind mounts and volume name for volume mounts. Unused for tmpfs mounts
+	Source   string `json:"source,omitempty" validate:"optional"`
+	Target   string `json:"target" validate:"required"`
+	ReadOnly bool   `json:"readOnly,omitempty" validate:"optional"`
+	// Size limit of tmpfs mounts, e.g. 512m. Unlimited if empty
+	Size string `json:"size,omitempty" validate:"optional"`
+} // @name Mount
+
+var volumeNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+func (m Mount) Validate() error {
+	if m.Target == "" || !path.IsAbs(m.Target) {
+		return fmt.Errorf("%w: target %q must be an absolute path", ErrInvalidMount, m.Target)
+	}
+
+	if path.Clean(m.Target) == "/" {
+		return fmt.Errorf("%w: target can not be the root directory", ErrInvalidMount)
+	}
+
+	if m.Size != "" {
+		if m.Type != MountTypeTmpfs {
+			return fmt.Errorf("%w: size is only supported for tmpfs mounts", ErrInvalidMount)
+		}
+
+		_, err := m.SizeBytes()
+		if err != nil {
+			return err
+		}
+	}
+
+	switch m.Type {
+	case MountTypeBind:
+		if !path.IsAbs(m.Source) {
+			return fmt.Errorf("%w: bind source %q must be an absolute path", ErrInvalidMount, m.Source)
+		}
+	case MountTypeVolume:
+		if !volumeNameRegex.MatchString(m.Source) {
+			return fmt.Errorf("%w: invalid volume name %q", ErrInvalidMount, m.Source)
+		}
+	case MountTypeTmpfs:
+		if m.Source != "" {
+			return fmt.Errorf("%w: tmpfs mounts do not have a source", ErrInvalidMount)
+		}
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidMount, m.Type)
+	}
+
+	return nil
+}
+
+// ValidateMounts validates each mount and rejects mounts sharing a target
+func ValidateMounts(mounts []Mount) error {
+	targets := map[string]bool{}
+
+	for _, m := range mounts {
+		err := m.Validate()
+		if err != nil {
+			return err
+		}
+
+		target := path.Clean(m.Target)
+		if targets[target] {
+			return fmt.Errorf("%w: multiple mounts target %s", ErrInvalidMount, target)
+		}
+		targets[target] = true
+	}
+
+	return nil
+}
+
+// ValidateBindMountSources rejects bind mounts whose source is not one of the allowed host paths or a path below one.
+// Bind mounts are refused if no paths are allowed
+func ValidateBindMountSources(mounts []Mount, allowedPaths []string) error {
+	for _, m := range mounts {
+		if m.Type != MountTypeBind {
+			continue
+		}
+
+		if !isPathAllowed(m.Source, allowedPaths) {
+			return fmt.Errorf("%w: %s is not below a host path allowed by the server administrator", ErrBindMountNotAllowed, m.Source)
+		}
+	}
+
+	return nil
+}
+
+func isPathAllowed(source string, allowedPaths []string) bool {
+	source = path.Clean(source)
+
+	for _, allowed := range allowedPaths {
+		if !path.IsAbs(allowed) {
+			continue
+		}
+
+		allowed = path.Clean(allowed)
+		if source == allowed || strings.HasPrefix(source, strings.TrimSuffix(allowed, "/")+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseMount parses a mount in the form type=<type>,source=<source>,target=<target>[,readonly][,size=<size>],
+// e.g. type=tmpfs,target=/cache,size=512m. The type defaults to volume.
+func ParseMount(value string) (Mount, error) {
+	m := Mount{Type: MountTypeVolume}
+
+	for _, field := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(field), "=")
+
+		switch strings.ToLower(key) {
+		case "type":
+			m.Type = MountType(val)
+		case "source", "src":
+			m.Source = val
+		case "target", "dst", "destination":
+			m.Target = val
+		case "readonly", "ro":
+			m.ReadOnly = val == "" || val == "true" || val == "1"
+		case "size":
+			m.Size = val
+		default:
+			return Mount{}, fmt.Errorf("%w: unknown option %q", ErrInvalidMount, key)
+		}
+	}
+
+	return m, m.Validate()
+}
+
+func (m Mount) String() string {
+	fields := []string{"type=" + string(m.Type)}
+	if m.Source != "" {
+		fields = append(fields, "source="+m.Source)
+	}
+	fields = append(fields, "target="+m.Target)
+	if m.ReadOnly {
+		fields = append(fields, "readonly")
+	}
+	if m.Size != "" {
+		fields = append(fields, "size="+m.Size)
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// SizeBytes returns the size limit in bytes, 0 if the mount is unlimited
+func (m Mount) SizeBytes() (int64, error) {
+	if m.Size == "" {
+		return 0, nil
+	}
+
+	size, err := units.RAMInBytes(m.Size)
+	if err != nil || size <= 0 {
+		return 0, fmt.Errorf("%w: invalid size %q", ErrInvalidMount, m.Size)
+	}
+
+	return size, nil
+}
+
+// MountFlag formats the mount in the docker --mount syntax, e.g. type=tmpfs,target=/cache,tmpfs-size=536870912
+func (m Mount) MountFlag() string {
+	fields := []string{"type=" + string(m.Type)}
+	if m.Source != "" {
+		fields = append(fields, "source="+m.Source)
+	}
+	fields = append(fields, "target="+m.Target)
+	if m.ReadOnly {
+		fields = append(fields, "readonly")
+	}
+	if size, _ := m.SizeBytes(); m.Type == MountTypeTmpfs && size > 0 {
+		fields = append(fields, fmt.Sprintf("tmpfs-size=%d", size))
+	}
+
+	return strings.Join(fields, ",")
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMount(t *testing.T) {
+	m, err := project.ParseMount("type=bind,source=/mnt/datasets,target=/data,readonly")
+	require.NoError(t, err)
+	require.Equal(t, project.Mount{Type: project.MountTypeBind, Source: "/mnt/datasets", Target: "/data", ReadOnly: true}, m)
+	require.Equal(t, "type=bind,source=/mnt/datasets,target=/data,readonly", m.String())
+
+	m, err = project.ParseMount("type=tmpfs,target=/cache,size=512m")
+	require.NoError(t, err)
+	size, err := m.SizeBytes()
+	require.NoError(t, err)
+	require.Equal(t, int64(512*1024*1024), size)
+
+	m, err = project.ParseMount("source=node-modules,target=/workspaces/app/node_modules")
+	require.NoError(t, err)
+	require.Equal(t, project.MountTypeVolume, m.Type)
+
+	for _, invalid := range []string{
+		"type=bind,source=data,target=/data",
+		"type=volume,source=cache,target=relative",
+		"type=volume,source=cache,target=/cache,size=1g",
+		"type=tmpfs,source=x,target=/tmp/x",
+		"type=tmpfs,target=/cache,size=lots",
+		"type=nfs,source=a,target=/a",
+		"type=volume,source=cache,target=/cache,mode=0755",
+	} {
+		_, err := project.ParseMount(invalid)
+		require.ErrorIs(t, err, project.ErrInvalidMount, invalid)
+	}
+}
+
+func TestValidateMountsRejectsDuplicateTargets(t *testing.T) {
+	err := project.ValidateMounts([]project.Mount{
+		{Type: project.MountTypeVolume, Source: "cache", Target: "/cache"},
+		{Type: project.MountTypeTmpfs, Target: "/cache/"},
+	})
+	require.ErrorIs(t, err, project.ErrInvalidMount)
+}
+
+func TestValidateBindMountSources(t *testing.T) {
+	allowed := []string{"/mnt/datasets", "/srv/shared/"}
+
+	for _, source := range []string{"/mnt/datasets", "/mnt/datasets/images", "/srv/shared/models"} {
+		err := project.ValidateBindMountSources([]project.Mount{{Type: project.MountTypeBind, Source: source, Target: "/data"}}, allowed)
+		require.NoError(t, err, source)
+	}
+
+	for _, source := range []string{"/", "/etc", "/var/run/docker.sock", "/mnt/datasets-private", "/mnt/datasets/../../etc"} {
+		err := project.ValidateBindMountSources([]project.Mount{{Type: project.MountTypeBind, Source: source, Target: "/data"}}, allowed)
+		require.ErrorIs(t, err, project.ErrBindMountNotAllowed, source)
+	}
+
+	err := project.ValidateBindMountSources([]project.Mount{{Type: project.MountTypeBind, Source: "/mnt/datasets", Target: "/data"}}, nil)
+	require.ErrorIs(t, err, project.ErrBindMountNotAllowed)
+
+	err = project.ValidateBindMountSources([]project.Mount{
+		{Type: project.MountTypeVolume, Source: "cache", Target: "/cache"},
+		{Type: project.MountTypeTmpfs, Target: "/tmp/cache"},
+	}, nil)
+	require.NoError(t, err)
+}
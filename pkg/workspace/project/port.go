@@ -0,0 +1,168 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPort = errors.New("invalid port")
+
+var ErrPortConflict = errors.New("port conflict")
+
+type PortProtocol string // @name PortProtocol
+
+const (
+	PortProtocolHttp  PortProtocol = "http"
+	PortProtocolHttps PortProtocol = "https"
+	PortProtocolTcp   PortProtocol = "tcp"
+)
+
+type PortVisibility string // @name PortVisibility
+
+const (
+	// Only reachable by forwarding the port to the local machine
+	PortVisibilityPrivate PortVisibility = "private"
+	// Also exposed through a public preview URL when forwarded
+	PortVisibilityPublic PortVisibility = "public"
+)
+
+// Port is a named port the project exposes, e.g. web or debugger
+type Port struct {
+	Name string `json:"name" validate:"required"`
+	Port uint16 `json:"port" validate:"required"`
+	// Defaults to http
+	Protocol PortProtocol `json:"protocol,omitempty" validate:"optional"`
+	// Defaults to private
+	Visibility PortVisibility `json:"visibility,omitempty" validate:"optional"`
+} // @name ProjectPort
+
+var portNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+func (p Port) Validate() error {
+	if !portNameRegex.MatchString(p.Name) {
+		return fmt.Errorf("%w: invalid name %q", ErrInvalidPort, p.Name)
+	}
+
+	if p.Port == 0 {
+		return fmt.Errorf("%w: %s has no port number", ErrInvalidPort, p.Name)
+	}
+
+	if !slices.Contains([]PortProtocol{"", PortProtocolHttp, PortProtocolHttps, PortProtocolTcp}, p.Protocol) {
+		return fmt.Errorf("%w: unknown protocol %q of %s", ErrInvalidPort, p.Protocol, p.Name)
+	}
+
+	if !slices.Contains([]PortVisibility{"", PortVisibilityPrivate, PortVisibilityPublic}, p.Visibility) {
+		return fmt.Errorf("%w: unknown visibility %q of %s", ErrInvalidPort, p.Visibility, p.Name)
+	}
+
+	return nil
+}
+
+func (p Port) GetProtocol() PortProtocol {
+	if p.Protocol == "" {
+		return PortProtocolHttp
+	}
+	return p.Protocol
+}
+
+func (p Port) GetVisibility() PortVisibility {
+	if p.Visibility == "" {
+		return PortVisibilityPrivate
+	}
+	return p.Visibility
+}
+
+// ValidatePorts validates each port and rejects duplicate names and port numbers
+func ValidatePorts(ports []Port) error {
+	names := map[string]bool{}
+	numbers := map[uint16]string{}
+
+	for _, p := range ports {
+		err := p.Validate()
+		if err != nil {
+			return err
+		}
+
+		if names[p.Name] {
+			return fmt.Errorf("%w: multiple ports named %s", ErrInvalidPort, p.Name)
+		}
+		names[p.Name] = true
+
+		if other, ok := numbers[p.Port]; ok {
+			return fmt.Errorf("%w: %s and %s both declare port %d", ErrInvalidPort, other, p.Name, p.Port)
+		}
+		numbers[p.Port] = p.Name
+	}
+
+	return nil
+}
+
+// ValidateProjectPorts rejects port numbers declared by more than one project of a workspace.
+// Forwarding the ports of all projects to the local machine would otherwise map them to different local ports
+// depending on the order they are forwarded in
+func ValidateProjectPorts(projects []*Project) error {
+	declaredBy := map[uint16]string{}
+
+	for _, p := range projects {
+		for _, port := range p.Ports {
+			if other, ok := declaredBy[port.Port]; ok && other != p.Name {
+				return fmt.Errorf("%w: projects %s and %s both declare port %d", ErrPortConflict, other, p.Name, port.Port)
+			}
+			declaredBy[port.Port] = p.Name
+		}
+	}
+
+	return nil
+}
+
+// ParsePort parses a port in the form name=<name>,port=<port>[,protocol=<protocol>][,visibility=<visibility>],
+// e.g. name=web,port=3000,visibility=public. The shorthand <name>=<port> is also accepted
+func ParsePort(value string) (Port, error) {
+	p := Port{}
+
+	if name, number, ok := strings.Cut(value, "="); ok && !strings.Contains(value, ",") && name != "name" && name != "port" {
+		value = fmt.Sprintf("name=%s,port=%s", name, number)
+	}
+
+	for _, field := range strings.Split(value, ",") {
+		key, val, _ := strings.Cut(strings.TrimSpace(field), "=")
+
+		switch strings.ToLower(key) {
+		case "name":
+			p.Name = val
+		case "port":
+			number, err := strconv.ParseUint(val, 10, 16)
+			if err != nil {
+				return Port{}, fmt.Errorf("%w: invalid port number %q", ErrInvalidPort, val)
+			}
+			p.Port = uint16(number)
+		case "protocol":
+			p.Protocol = PortProtocol(strings.ToLower(val))
+		case "visibility":
+			p.Visibility = PortVisibility(strings.ToLower(val))
+		default:
+			return Port{}, fmt.Errorf("%w: unknown option %q", ErrInvalidPort, key)
+		}
+	}
+
+	return p, p.Validate()
+}
+
+func (p Port) String() string {
+	fields := []string{"name=" + p.Name, fmt.Sprintf("port=%d", p.Port)}
+	if p.Protocol != "" {
+		fields = append(fields, "protocol="+string(p.Protocol))
+	}
+	if p.Visibility != "" {
+		fields = append(fields, "visibility="+string(p.Visibility))
+	}
+
+	return strings.Join(fields, ",")
+}
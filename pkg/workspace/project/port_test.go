@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePort(t *testing.T) {
+	p, err := project.ParsePort("web=3000")
+	require.NoError(t, err)
+	require.Equal(t, project.Port{Name: "web", Port: 3000}, p)
+	require.Equal(t, project.PortProtocolHttp, p.GetProtocol())
+	require.Equal(t, project.PortVisibilityPrivate, p.GetVisibility())
+
+	p, err = project.ParsePort("name=db,port=5432,protocol=TCP,visibility=private")
+	require.NoError(t, err)
+	require.Equal(t, project.Port{Name: "db", Port: 5432, Protocol: project.PortProtocolTcp, Visibility: project.PortVisibilityPrivate}, p)
+
+	for _, invalid := range []string{"web", "web=0", "web=70000", "name=web,port=3000,protocol=udp", "name=web,port=3000,visibility=team", "-web=3000"} {
+		_, err := project.ParsePort(invalid)
+		require.ErrorIs(t, err, project.ErrInvalidPort, invalid)
+	}
+
+	err = project.ValidatePorts([]project.Port{{Name: "web", Port: 3000}, {Name: "api", Port: 3000}})
+	require.ErrorIs(t, err, project.ErrInvalidPort)
+}
+
+func TestValidateProjectPorts(t *testing.T) {
+	api := &project.Project{Name: "api", Ports: []project.Port{{Name: "http", Port: 8080}}}
+	web := &project.Project{Name: "web", Ports: []project.Port{{Name: "http", Port: 3000}}}
+	require.NoError(t, project.ValidateProjectPorts([]*project.Project{api, web}))
+
+	web.Ports = append(web.Ports, project.Port{Name: "proxy", Port: 8080})
+	require.ErrorIs(t, project.ValidateProjectPorts([]*project.Project{api, web}), project.ErrPortConflict)
+}
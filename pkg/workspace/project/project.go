@@ -6,6 +6,7 @@ package project
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/workspace/project/buildconfig"
@@ -23,8 +24,47 @@ type Project struct {
 	Target              string                     `json:"target" validate:"required"`
 	State               *ProjectState              `json:"state,omitempty" validate:"optional"`
 	GitProviderConfigId *string                    `json:"gitProviderConfigId,omitempty" validate:"optional"`
+	Annotations         map[string]string          `json:"annotations,omitempty" validate:"optional"`
+	Mounts              []Mount                    `json:"mounts,omitempty" validate:"optional"`
+	Commands            []Command                  `json:"commands,omitempty" validate:"optional"`
+	Ports               []Port                     `json:"ports,omitempty" validate:"optional"`
+	// Ports other than the declared public ports that were forwarded to their public URLs. Their preview DNS records
+	// are deleted with the workspace
+	PublicPorts []uint16   `json:"publicPorts,omitempty" validate:"optional"`
+	Networking  Networking `json:"networking,omitempty" validate:"optional"`
+	// Hostname of the project agent on the tailnet. Derived from the workspace ID and project name if empty
+	Hostname string `json:"hostname,omitempty" validate:"optional"`
+	// Route is set if the project shares the tailnet node of another project of the workspace
+	Route *ProjectRoute `json:"route,omitempty" validate:"optional"`
+	// AccessPolicy restricts the tailnet peers that can connect to ports of the project. Every peer can connect if not set
+	AccessPolicy *PortAccessPolicy `json:"accessPolicy,omitempty" validate:"optional"`
+	// Resources reserved for the project on the target host. The provider limits the project container to them
+	Resources *Resources `json:"resources,omitempty" validate:"optional"`
+	// Paused is set if the project was stopped with a checkpoint of its processes that it is resumed from on start
+	Paused bool `json:"paused,omitempty" validate:"optional"`
+	// Architecture of the target host, e.g. arm64. Only builds of the prebuild matrix variant of the architecture are
+	// used for the project
+	Architecture string `json:"architecture,omitempty" validate:"optional"`
+	// Public SSH host key of the project agent in the authorized_keys format
+	SshHostPublicKey string `json:"sshHostPublicKey,omitempty" validate:"optional"`
+	// PEM encoded private SSH host key. Only handed out to the agent of the project, which fetches it on start
+	SshHostKey string `json:"-"`
 } // @name Project
 
+// Networking is how the server and clients reach the project agent
+type Networking string // @name ProjectNetworking
+
+const (
+	// NetworkingTailnet connects the agent to the tailnet of the server
+	NetworkingTailnet Networking = "tailnet"
+	// NetworkingAgentless leaves the agent off the tailnet. Project ports are reached through the provider API,
+	// e.g. Kubernetes exec and port-forward, for targets where a mesh network is not allowed
+	NetworkingAgentless Networking = "agentless"
+	// NetworkingRouted leaves the agent off the tailnet. Project ports are reached through the tailnet node
+	// of another project of the workspace
+	NetworkingRouted Networking = "routed"
+)
+
 type ProjectInfo struct {
 	Name             string `json:"name" validate:"required"`
 	Created          string `json:"created" validate:"required"`
@@ -37,6 +77,28 @@ type ProjectState struct {
 	UpdatedAt string     `json:"updatedAt" validate:"required"`
 	Uptime    uint64     `json:"uptime" validate:"required"`
 	GitStatus *GitStatus `json:"gitStatus" validate:"required"`
+	// AgentVersion is the version of the agent that reported the state
+	AgentVersion string `json:"agentVersion,omitempty" validate:"optional"`
+	// LastActivityAt is when the agent last observed terminal, IDE or file activity. Not set by older agents
+	LastActivityAt     string `json:"lastActivityAt,omitempty" validate:"optional"`
+	LastActivitySource string `json:"lastActivitySource,omitempty" validate:"optional"`
+	// Address of the project container. Only reported by agents of routed projects
+	Address string `json:"address,omitempty" validate:"optional"`
+	// Resources used by the project container. Not reported by older agents or outside of a container
+	Usage *Resources `json:"usage,omitempty" validate:"optional"`
+	// Services the agent registered for other workspaces to resolve by name
+	Services []Service `json:"services,omitempty" validate:"optional"`
+	// Ports processes of the project listen on, for clients to offer forwarding them. Not reported by older agents
+	DetectedPorts []DetectedPort `json:"detectedPorts,omitempty" validate:"optional"`
+	// Tunneled is set if the agent can't reach the tailnet and the project is reached through its tunnel to the server
+	Tunneled bool `json:"tunneled,omitempty" validate:"optional"`
+	// Ready is set once the agent accepts SSH connections and can be reached over the tailnet or its tunnel
+	Ready bool `json:"ready,omitempty" validate:"optional"`
+	// Files of the environment definition that changed since the project container was built. Not reported by older agents
+	EnvironmentChanges *EnvironmentChanges `json:"environmentChanges,omitempty" validate:"optional"`
+	// NodeKeyExpiry is when the key of the tailnet node of the agent expires, in RFC3339. The agent renews the key
+	// before then. Not set if the key does not expire or by older agents
+	NodeKeyExpiry string `json:"nodeKeyExpiry,omitempty" validate:"optional"`
 } // @name ProjectState
 
 type GitStatus struct {
@@ -73,6 +135,12 @@ type ProjectEnvVarParams struct {
 	ServerUrl     string
 	ServerVersion string
 	ClientId      string
+	// Gateway is set if other projects of the workspace are routed through the tailnet node of the project
+	Gateway bool
+	// Time without activity after which the agent stops the workspace. Never stopped if 0
+	IdleTimeout time.Duration
+	// Log level of the agent. The agent logs at info if empty
+	AgentLogLevel string
 }
 
 func GetProjectEnvVars(project *Project, params ProjectEnvVarParams, telemetryEnabled bool) map[string]string {
@@ -93,6 +161,29 @@ func GetProjectEnvVars(project *Project, params ProjectEnvVarParams, telemetryEn
 		envVars["DAYTONA_TELEMETRY_ENABLED"] = "true"
 	}
 
+	if project.Networking == NetworkingAgentless || project.Networking == NetworkingRouted {
+		envVars["DAYTONA_AGENT_NETWORKING"] = string(project.Networking)
+	}
+
+	if params.Gateway {
+		envVars["DAYTONA_AGENT_GATEWAY"] = "true"
+	}
+
+	if params.AgentLogLevel != "" {
+		envVars["AGENT_LOG_LEVEL"] = params.AgentLogLevel
+	}
+
+	if project.Hostname != "" {
+		envVars["DAYTONA_PROJECT_HOSTNAME"] = project.Hostname
+	}
+
+	// The idle timeout set in the env vars of the project overrides the default of the server
+	if _, ok := project.EnvVars["DAYTONA_AGENT_IDLE_TIMEOUT"]; ok {
+		envVars["DAYTONA_AGENT_IDLE_TIMEOUT"] = project.EnvVars["DAYTONA_AGENT_IDLE_TIMEOUT"]
+	} else if params.IdleTimeout > 0 {
+		envVars["DAYTONA_AGENT_IDLE_TIMEOUT"] = params.IdleTimeout.String()
+	}
+
 	return envVars
 }
 
@@ -111,3 +202,13 @@ func GetProjectHostname(workspaceId string, projectName string) string {
 
 	return hostname
 }
+
+// GetProjectRecoveryHostname returns the tailnet hostname of the recovery container of the project
+func GetProjectRecoveryHostname(workspaceId string, projectName string) string {
+	hostname := GetProjectHostname(workspaceId, projectName)
+	if len(hostname) > 54 {
+		hostname = hostname[:54]
+	}
+
+	return hostname + "-recovery"
+}
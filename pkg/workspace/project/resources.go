@@ -0,0 +1,51 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var ErrInvalidResources = errors.New("cpus must be a positive number of cores")
+
+// Resources is an amount of CPU and memory. It is used for the limits of projects, the usage reported
+// by their agents and the capacity of target hosts
+type Resources struct {
+	// Number of CPU cores, e.g. 0.5
+	Cpus float64 `json:"cpus,omitempty" validate:"optional"`
+	// Memory in MiB
+	Memory uint64 `json:"memory,omitempty" validate:"optional"`
+} // @name Resources
+
+func (r Resources) Validate() error {
+	if r.Cpus < 0 || math.IsNaN(r.Cpus) || math.IsInf(r.Cpus, 0) {
+		return ErrInvalidResources
+	}
+
+	return nil
+}
+
+func (r Resources) Add(other Resources) Resources {
+	return Resources{
+		Cpus:   r.Cpus + other.Cpus,
+		Memory: r.Memory + other.Memory,
+	}
+}
+
+// Exceeds returns true if the resources are above the capacity multiplied by the overcommit ratio.
+// Dimensions the capacity is unknown for, i.e. 0, are not checked
+func (r Resources) Exceeds(capacity Resources, overcommitRatio float64) bool {
+	if capacity.Cpus > 0 && r.Cpus > capacity.Cpus*overcommitRatio {
+		return true
+	}
+
+	return capacity.Memory > 0 && float64(r.Memory) > float64(capacity.Memory)*overcommitRatio
+}
+
+func (r Resources) String() string {
+	return fmt.Sprintf("%s CPUs and %d MiB of memory", strconv.FormatFloat(r.Cpus, 'f', -1, 64), r.Memory)
+}
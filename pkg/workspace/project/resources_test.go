@@ -0,0 +1,32 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateResources(t *testing.T) {
+	require.NoError(t, project.Resources{Cpus: 0.5, Memory: 512}.Validate())
+	require.NoError(t, project.Resources{Memory: 512}.Validate())
+	require.ErrorIs(t, project.Resources{Cpus: -1}.Validate(), project.ErrInvalidResources)
+	require.ErrorIs(t, project.Resources{Cpus: math.NaN()}.Validate(), project.ErrInvalidResources)
+}
+
+func TestResourcesExceeds(t *testing.T) {
+	capacity := project.Resources{Cpus: 4, Memory: 8192}
+	reserved := project.Resources{Cpus: 2, Memory: 4096}.Add(project.Resources{Cpus: 3, Memory: 2048})
+
+	require.Equal(t, project.Resources{Cpus: 5, Memory: 6144}, reserved)
+	require.True(t, reserved.Exceeds(capacity, 1))
+	require.False(t, reserved.Exceeds(capacity, 1.5))
+	require.True(t, project.Resources{Memory: 16384}.Exceeds(capacity, 1.5))
+
+	// Dimensions with an unknown capacity are not checked
+	require.False(t, reserved.Exceeds(project.Resources{Memory: 8192}, 1))
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+const (
+	// Tailnet ports of the gateway from this port on are routed to the other projects of the workspace
+	routedPortRangeStart = 10000
+	// Number of tailnet ports routed to each project. Ports of a routed project from this number on are not reachable
+	RoutedPortRangeSize = 10000
+)
+
+var ErrTooManyRoutedProjects = errors.New("too many projects to share a tailnet node")
+var ErrPortNotRouted = errors.New("port is not routed")
+var ErrInvalidRouteAddress = errors.New("invalid route address")
+
+// ProjectRoute makes the ports of a project reachable through the tailnet node of another project of the workspace.
+// Port N of the project is reached at tailnet port PortRangeStart+N of the gateway
+type ProjectRoute struct {
+	// Gateway is the name of the project whose agent runs the tailnet node
+	Gateway        string `json:"gateway" validate:"required"`
+	PortRangeStart uint16 `json:"portRangeStart" validate:"required"`
+	PortRangeEnd   uint16 `json:"portRangeEnd" validate:"required"`
+} // @name ProjectRoute
+
+// RoutingEntry forwards a range of tailnet ports of the gateway to the container of a routed project
+type RoutingEntry struct {
+	Project string `json:"project" validate:"required"`
+	// Address of the project container reported by its agent
+	Address        string `json:"address" validate:"required"`
+	PortRangeStart uint16 `json:"portRangeStart" validate:"required"`
+	PortRangeEnd   uint16 `json:"portRangeEnd" validate:"required"`
+} // @name RoutingEntry
+
+// AssignRoutes routes the ports of all projects but the first through the tailnet node of the first project
+func AssignRoutes(projects []*Project) error {
+	if len(projects) < 2 {
+		return nil
+	}
+
+	gateway := projects[0]
+
+	for i, p := range projects[1:] {
+		start := routedPortRangeStart + i*RoutedPortRangeSize
+		end := start + RoutedPortRangeSize - 1
+		if end > 65535 {
+			return fmt.Errorf("%w: at most %d projects can be routed through one node", ErrTooManyRoutedProjects, i)
+		}
+
+		for _, port := range p.Ports {
+			if port.Port >= RoutedPortRangeSize {
+				return fmt.Errorf("%w: port %d of project %s is not below %d", ErrPortNotRouted, port.Port, p.Name, RoutedPortRangeSize)
+			}
+		}
+
+		p.Route = &ProjectRoute{
+			Gateway:        gateway.Name,
+			PortRangeStart: uint16(start),
+			PortRangeEnd:   uint16(end),
+		}
+	}
+
+	return nil
+}
+
+// ValidateRouteAddress checks that the address reported by the agent of a routed project is a private IPv4
+// address of a container network. Gateways only forward ports to such addresses, so a project can not
+// make them reach the host, the tailnet or the internet
+func ValidateRouteAddress(address string) error {
+	ip := net.ParseIP(address)
+	if ip == nil || ip.To4() == nil {
+		return fmt.Errorf("%w: %s is not an IPv4 address", ErrInvalidRouteAddress, address)
+	}
+
+	if !ip.IsPrivate() {
+		return fmt.Errorf("%w: %s is not a private address", ErrInvalidRouteAddress, address)
+	}
+
+	return nil
+}
+
+// GetRoutingTable returns the routes of the projects that use the gateway. Projects whose agent
+// did not report a valid address yet are left out
+func GetRoutingTable(gateway string, projects []*Project) []RoutingEntry {
+	table := []RoutingEntry{}
+
+	for _, p := range projects {
+		if p.Route == nil || p.Route.Gateway != gateway || p.State == nil || ValidateRouteAddress(p.State.Address) != nil {
+			continue
+		}
+
+		table = append(table, RoutingEntry{
+			Project:        p.Name,
+			Address:        p.State.Address,
+			PortRangeStart: p.Route.PortRangeStart,
+			PortRangeEnd:   p.Route.PortRangeEnd,
+		})
+	}
+
+	return table
+}
+
+// GetTailnetAddress returns the address a port of the project is reached at on the tailnet
+func (p *Project) GetTailnetAddress(port uint16) (string, error) {
+	if p.Route == nil {
+		return fmt.Sprintf("%s:%d", p.GetHostname(), port), nil
+	}
+
+	if int(port) > int(p.Route.PortRangeEnd-p.Route.PortRangeStart) {
+		return "", fmt.Errorf("%w: project %s shares the tailnet node of project %s and only ports below %d are reachable", ErrPortNotRouted, p.Name, p.Route.Gateway, RoutedPortRangeSize)
+	}
+
+	return fmt.Sprintf("%s:%d", p.GetHostname(), p.Route.PortRangeStart+port), nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssignRoutes(t *testing.T) {
+	api := &project.Project{Name: "api", WorkspaceId: "ws"}
+	web := &project.Project{Name: "web", WorkspaceId: "ws", Ports: []project.Port{{Name: "http", Port: 3000}}}
+	db := &project.Project{Name: "db", WorkspaceId: "ws"}
+
+	require.NoError(t, project.AssignRoutes([]*project.Project{api, web, db}))
+	require.Nil(t, api.Route)
+	require.Equal(t, &project.ProjectRoute{Gateway: "api", PortRangeStart: 10000, PortRangeEnd: 19999}, web.Route)
+	require.Equal(t, &project.ProjectRoute{Gateway: "api", PortRangeStart: 20000, PortRangeEnd: 29999}, db.Route)
+
+	web.Hostname = api.GetHostname()
+	address, err := web.GetTailnetAddress(3000)
+	require.NoError(t, err)
+	require.Equal(t, "ws-api:13000", address)
+
+	_, err = web.GetTailnetAddress(10000)
+	require.ErrorIs(t, err, project.ErrPortNotRouted)
+
+	web.Ports = []project.Port{{Name: "http", Port: 10080}}
+	require.ErrorIs(t, project.AssignRoutes([]*project.Project{api, web}), project.ErrPortNotRouted)
+
+	projects := []*project.Project{api}
+	for i := 0; i < 6; i++ {
+		projects = append(projects, &project.Project{Name: "p"})
+	}
+	require.ErrorIs(t, project.AssignRoutes(projects), project.ErrTooManyRoutedProjects)
+}
+
+func TestGetRoutingTable(t *testing.T) {
+	projects := []*project.Project{
+		{Name: "api"},
+		{Name: "web", Route: &project.ProjectRoute{Gateway: "api", PortRangeStart: 10000, PortRangeEnd: 19999}, State: &project.ProjectState{Address: "172.17.0.3"}},
+		{Name: "db", Route: &project.ProjectRoute{Gateway: "api", PortRangeStart: 20000, PortRangeEnd: 29999}},
+		{Name: "cache", Route: &project.ProjectRoute{Gateway: "api", PortRangeStart: 30000, PortRangeEnd: 39999}, State: &project.ProjectState{Address: "127.0.0.1"}},
+	}
+
+	require.Equal(t, []project.RoutingEntry{
+		{Project: "web", Address: "172.17.0.3", PortRangeStart: 10000, PortRangeEnd: 19999},
+	}, project.GetRoutingTable("api", projects))
+	require.Empty(t, project.GetRoutingTable("web", projects))
+}
+
+func TestValidateRouteAddress(t *testing.T) {
+	require.NoError(t, project.ValidateRouteAddress("172.17.0.3"))
+	require.NoError(t, project.ValidateRouteAddress("10.1.2.3"))
+
+	for _, address := range []string{"127.0.0.1", "0.0.0.0", "169.254.169.254", "100.64.0.1", "8.8.8.8", "fd00::1", "api"} {
+		require.ErrorIs(t, project.ValidateRouteAddress(address), project.ErrInvalidRouteAddress, address)
+	}
+}
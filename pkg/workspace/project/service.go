@@ -0,0 +1,130 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ServiceDomain is the domain under which other workspaces resolve the services of a project
+const ServiceDomain = "daytona.internal"
+
+var (
+	ErrInvalidService   = errors.New("invalid service")
+	ErrServiceNotFound  = errors.New("service not found")
+	ErrAmbiguousService = errors.New("service name is ambiguous")
+)
+
+type ServiceProtocol string // @name ServiceProtocol
+
+const (
+	ServiceProtocolTcp ServiceProtocol = "tcp"
+	ServiceProtocolUdp ServiceProtocol = "udp"
+)
+
+// Service is a port of the project that other workspaces reach by name over the tailnet
+type Service struct {
+	Name     string          `json:"name" validate:"required"`
+	Port     uint16          `json:"port" validate:"required"`
+	Protocol ServiceProtocol `json:"protocol" validate:"required"`
+} // @name ProjectService
+
+// ServiceEndpoint is a service resolved to the tailnet address of the project that registered it
+type ServiceEndpoint struct {
+	// Fully qualified name of the service, e.g. api-proj.my-workspace.daytona.internal
+	Name          string `json:"name" validate:"required"`
+	Service       string `json:"service" validate:"required"`
+	WorkspaceId   string `json:"workspaceId" validate:"required"`
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	ProjectName   string `json:"projectName" validate:"required"`
+	// Port the service was registered with
+	Port uint16 `json:"port" validate:"required"`
+	// Tailnet address of the service in the host:port format. The port differs from the registered port
+	// if the project shares the tailnet node of another project
+	Address  string          `json:"address" validate:"required"`
+	Protocol ServiceProtocol `json:"protocol" validate:"required"`
+} // @name ServiceEndpoint
+
+var serviceNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+func (s Service) Validate() error {
+	if !serviceNameRegex.MatchString(s.Name) {
+		return fmt.Errorf("%w: name %q must be a lowercase DNS label", ErrInvalidService, s.Name)
+	}
+
+	if s.Port == 0 {
+		return fmt.Errorf("%w: %s has no port number", ErrInvalidService, s.Name)
+	}
+
+	if s.Protocol != ServiceProtocolTcp && s.Protocol != ServiceProtocolUdp {
+		return fmt.Errorf("%w: unknown protocol %q of %s", ErrInvalidService, s.Protocol, s.Name)
+	}
+
+	return nil
+}
+
+// ParseServices parses services in the <name>:<port>[/<protocol>] format, e.g. "api:8080" or "dns:53/udp".
+// The protocol defaults to tcp
+func ParseServices(specs []string) ([]Service, error) {
+	services := []Service{}
+	names := map[string]bool{}
+
+	for _, spec := range specs {
+		name, portSpec, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not in the <name>:<port>[/<protocol>] format", ErrInvalidService, spec)
+		}
+
+		portSpec, protocol, ok := strings.Cut(portSpec, "/")
+		if !ok {
+			protocol = string(ServiceProtocolTcp)
+		}
+
+		port, err := strconv.ParseUint(portSpec, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid port %q of %s", ErrInvalidService, portSpec, name)
+		}
+
+		service := Service{
+			Name:     name,
+			Port:     uint16(port),
+			Protocol: ServiceProtocol(protocol),
+		}
+
+		err = service.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		if names[service.Name] {
+			return nil, fmt.Errorf("%w: %s is registered more than once", ErrInvalidService, service.Name)
+		}
+		names[service.Name] = true
+
+		services = append(services, service)
+	}
+
+	return services, nil
+}
+
+// GetServiceName returns the name of the service of the project, e.g. api-proj.daytona.internal.
+// The name is resolved in the workspace of the caller first
+func GetServiceName(service, projectName string) string {
+	return fmt.Sprintf("%s.%s", NormalizeHostname(service+"-"+projectName), ServiceDomain)
+}
+
+// GetQualifiedServiceName returns the name of the service that resolves from any workspace,
+// e.g. api-proj.my-workspace.daytona.internal
+func GetQualifiedServiceName(service, projectName, workspaceName string) string {
+	return fmt.Sprintf("%s.%s.%s", NormalizeHostname(service+"-"+projectName), NormalizeHostname(workspaceName), ServiceDomain)
+}
+
+// IsServiceName returns true if the name is in the service domain
+func IsServiceName(name string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(strings.ToLower(name), "."), "."+ServiceDomain)
+}
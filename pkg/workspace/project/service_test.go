@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package project_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServices(t *testing.T) {
+	services, err := project.ParseServices([]string{"api:8080", " dns:53/udp"})
+	require.NoError(t, err)
+	require.Equal(t, []project.Service{
+		{Name: "api", Port: 8080, Protocol: project.ServiceProtocolTcp},
+		{Name: "dns", Port: 53, Protocol: project.ServiceProtocolUdp},
+	}, services)
+
+	for _, invalid := range []string{"api", "api:0", "api:70000", "api:8080/http", "API:8080", "-api:8080"} {
+		_, err := project.ParseServices([]string{invalid})
+		require.ErrorIs(t, err, project.ErrInvalidService, invalid)
+	}
+
+	_, err = project.ParseServices([]string{"api:8080", "api:9090"})
+	require.ErrorIs(t, err, project.ErrInvalidService)
+}
+
+func TestServiceNames(t *testing.T) {
+	require.Equal(t, "api-proj.daytona.internal", project.GetServiceName("api", "proj"))
+	require.Equal(t, "api-proj.my-workspace.daytona.internal", project.GetQualifiedServiceName("api", "proj", "My Workspace"))
+
+	require.True(t, project.IsServiceName("api-proj.daytona.internal."))
+	require.False(t, project.IsServiceName("daytona.internal"))
+	require.False(t, project.IsServiceName("example.com"))
+}
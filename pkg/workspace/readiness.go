@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+)
+
+var ErrInvalidReadyHookUrl = errors.New("ready hook url must be an absolute http or https url of a public host")
+
+// Shared address space of carrier-grade NATs, also used for the addresses of the nodes of the tailnet
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// Readiness tells whether clients can connect to the projects of a workspace
+type Readiness struct {
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	// Ready is set once every project in question accepts SSH connections
+	Ready bool `json:"ready" validate:"required"`
+	// Projects that accept SSH connections
+	ReadyProjects []string `json:"readyProjects" validate:"required"`
+	// Projects whose agent is still starting or that are stopped
+	PendingProjects []string `json:"pendingProjects" validate:"required"`
+} // @name WorkspaceReadiness
+
+// ReadyHook is a callback the server posts the readiness of the workspace to once its projects are ready
+type ReadyHook struct {
+	// Url the readiness of the workspace is posted to as JSON
+	Url string `json:"url" validate:"required"`
+	// Only wait for the project. Waits for every project of the workspace if empty
+	ProjectName string `json:"projectName,omitempty" validate:"optional"`
+	// Seconds after which the hook is dropped if the projects did not become ready. Defaults to 10 minutes
+	TimeoutSeconds uint32 `json:"timeoutSeconds,omitempty" validate:"optional"`
+} // @name ReadyHook
+
+func (h *ReadyHook) Validate() error {
+	u, err := url.Parse(h.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrInvalidReadyHookUrl
+	}
+
+	host := u.Hostname()
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s is a loopback host", ErrInvalidReadyHookUrl, host)
+	}
+
+	// Hostnames are checked again once they are resolved, when the hook is posted
+	ip := net.ParseIP(host)
+	if ip != nil && !IsPublicReadyHookAddress(ip) {
+		return fmt.Errorf("%w: %s is not a public address", ErrInvalidReadyHookUrl, host)
+	}
+
+	return nil
+}
+
+// IsPublicReadyHookAddress returns false for the addresses ready hooks must not be posted to, i.e. loopback, private,
+// link-local and tailnet addresses that could reach the server host or its internal network
+func IsPublicReadyHookAddress(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified() && !sharedAddressSpace.Contains(ip)
+}
+
+// GetReadiness returns the readiness of the project, or of every project of the workspace if projectName is empty
+func GetReadiness(w *Workspace, projectName string) Readiness {
+	readiness := Readiness{
+		WorkspaceId:     w.Id,
+		ReadyProjects:   []string{},
+		PendingProjects: []string{},
+	}
+
+	for _, p := range w.Projects {
+		if projectName != "" && p.Name != projectName {
+			continue
+		}
+
+		if isReady(p) {
+			readiness.ReadyProjects = append(readiness.ReadyProjects, p.Name)
+		} else {
+			readiness.PendingProjects = append(readiness.PendingProjects, p.Name)
+		}
+	}
+
+	readiness.Ready = len(readiness.ReadyProjects) > 0 && len(readiness.PendingProjects) == 0
+
+	return readiness
+}
+
+// isReady reports whether the agent of the running project accepts connections
+func isReady(p *project.Project) bool {
+	return p.State != nil && p.State.Uptime > 0 && p.State.Ready
+}
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/daytonaio/daytona/pkg/workspace/project"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReadiness(t *testing.T) {
+	w := &workspace.Workspace{
+		Id: "ws",
+		Projects: []*project.Project{
+			{Name: "api", State: &project.ProjectState{Uptime: 10, Ready: true}},
+			// Reported its state before the SSH server started
+			{Name: "web", State: &project.ProjectState{Uptime: 2}},
+			// Stopped after it was ready
+			{Name: "docs", State: &project.ProjectState{Ready: true}},
+			{Name: "db"},
+		},
+	}
+
+	readiness := workspace.GetReadiness(w, "")
+	require.False(t, readiness.Ready)
+	require.Equal(t, []string{"api"}, readiness.ReadyProjects)
+	require.Equal(t, []string{"web", "docs", "db"}, readiness.PendingProjects)
+
+	readiness = workspace.GetReadiness(w, "api")
+	require.True(t, readiness.Ready)
+	require.Empty(t, readiness.PendingProjects)
+
+	// Unknown projects are never ready
+	readiness = workspace.GetReadiness(w, "unknown")
+	require.False(t, readiness.Ready)
+}
+
+func TestReadyHookValidate(t *testing.T) {
+	require.NoError(t, (&workspace.ReadyHook{Url: "https://ide.example.com/ready?session=1"}).Validate())
+	require.NoError(t, (&workspace.ReadyHook{Url: "https://203.0.113.10/ready"}).Validate())
+
+	for _, u := range []string{
+		"", "/ready", "ftp://example.com/ready", "https://",
+		"http://localhost:8080/ready", "http://127.0.0.1:8080/ready", "http://[::1]/ready", "http://10.0.0.5/ready",
+		"http://169.254.169.254/latest/meta-data", "http://100.100.100.100/ready", "http://[fd7a:115c:a1e0::1]/ready",
+		"http://0.0.0.0/ready",
+	} {
+		require.ErrorIs(t, (&workspace.ReadyHook{Url: u}).Validate(), workspace.ErrInvalidReadyHookUrl, u)
+	}
+}
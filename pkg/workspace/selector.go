@@ -0,0 +1,109 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidSelector = errors.New("invalid selector")
+
+type selectorOperator string
+
+const (
+	selectorEquals    selectorOperator = "="
+	selectorNotEquals selectorOperator = "!="
+	selectorExists    selectorOperator = "exists"
+	selectorNotExists selectorOperator = "!exists"
+)
+
+type selectorRequirement struct {
+	key      string
+	operator selectorOperator
+	value    string
+}
+
+// Selector matches annotations against a list of requirements that must all hold
+type Selector []selectorRequirement
+
+// ParseSelector parses a comma-separated list of requirements in the form
+// key=value, key!=value, key (the key is set) or !key (the key is not set).
+// An empty selector matches everything.
+func ParseSelector(selector string) (Selector, error) {
+	result := Selector{}
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+
+		var requirement selectorRequirement
+		if key, value, found := strings.Cut(term, "!="); found {
+			requirement = selectorRequirement{key: key, operator: selectorNotEquals, value: value}
+		} else if key, value, found := strings.Cut(term, "="); found {
+			requirement = selectorRequirement{key: key, operator: selectorEquals, value: strings.TrimPrefix(value, "=")}
+		} else if key, found := strings.CutPrefix(term, "!"); found {
+			requirement = selectorRequirement{key: key, operator: selectorNotExists}
+		} else {
+			requirement = selectorRequirement{key: term, operator: selectorExists}
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+
+		if requirement.key == "" || strings.ContainsAny(requirement.key, "!= ") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSelector, term)
+		}
+
+		result = append(result, requirement)
+	}
+
+	return result, nil
+}
+
+func (s Selector) Matches(annotations map[string]string) bool {
+	for _, r := range s {
+		value, ok := annotations[r.key]
+
+		switch r.operator {
+		case selectorEquals:
+			if !ok || value != r.value {
+				return false
+			}
+		case selectorNotEquals:
+			if ok && value == r.value {
+				return false
+			}
+		case selectorExists:
+			if !ok {
+				return false
+			}
+		case selectorNotExists:
+			if ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (s Selector) String() string {
+	terms := []string{}
+	for _, r := range s {
+		switch r.operator {
+		case selectorEquals, selectorNotEquals:
+			terms = append(terms, r.key+string(r.operator)+r.value)
+		case selectorExists:
+			terms = append(terms, r.key)
+		case selectorNotExists:
+			terms = append(terms, "!"+r.key)
+		}
+	}
+
+	return strings.Join(terms, ",")
+}
@@ -0,0 +1,30 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestGenerateSshHostKey(t *testing.T) {
+	privateKey, publicKey, err := workspace.GenerateSshHostKey()
+	require.Nil(t, err)
+
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	require.Nil(t, err)
+
+	parsedPublicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	require.Nil(t, err)
+	require.Equal(t, signer.PublicKey().Marshal(), parsedPublicKey.Marshal())
+	require.True(t, strings.HasPrefix(publicKey, "ssh-ed25519 "))
+
+	otherPrivateKey, _, err := workspace.GenerateSshHostKey()
+	require.Nil(t, err)
+	require.NotEqual(t, privateKey, otherPrivateKey)
+}
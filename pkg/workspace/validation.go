@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+type ValidationCheck string // @name ValidationCheck
+
+const (
+	ValidationCheckWorkspace    ValidationCheck = "workspace"
+	ValidationCheckTarget       ValidationCheck = "target"
+	ValidationCheckProject      ValidationCheck = "project"
+	ValidationCheckRepository   ValidationCheck = "repository"
+	ValidationCheckBranch       ValidationCheck = "branch"
+	ValidationCheckDevcontainer ValidationCheck = "devcontainer"
+	ValidationCheckImage        ValidationCheck = "image"
+	ValidationCheckCapacity     ValidationCheck = "capacity"
+)
+
+type ValidationSeverity string // @name ValidationSeverity
+
+const (
+	// The workspace creation would fail
+	ValidationSeverityError ValidationSeverity = "error"
+	// The workspace can be created but might not behave as expected or take longer to create
+	ValidationSeverityWarning ValidationSeverity = "warning"
+)
+
+type ValidationIssue struct {
+	// Empty for issues of the workspace
+	ProjectName string             `json:"projectName,omitempty" validate:"optional"`
+	Check       ValidationCheck    `json:"check" validate:"required"`
+	Severity    ValidationSeverity `json:"severity" validate:"required"`
+	Message     string             `json:"message" validate:"required"`
+} // @name ValidationIssue
+
+// ProjectCreationEstimate is based on the median durations of the creation phases of past projects on the target
+type ProjectCreationEstimate struct {
+	ProjectName string `json:"projectName" validate:"required"`
+	// Not set if the project image is not built or no project was built on the target yet
+	BuildMs *int64 `json:"buildMs,omitempty" validate:"optional"`
+	// Not set if no project was created on the target yet
+	CreationMs *int64 `json:"creationMs,omitempty" validate:"optional"`
+} // @name ProjectCreationEstimate
+
+// CreationValidation is the result of validating a workspace creation request without provisioning any resources
+type CreationValidation struct {
+	// False if an issue would make the workspace creation fail
+	Valid     bool                      `json:"valid" validate:"required"`
+	Issues    []ValidationIssue         `json:"issues" validate:"required"`
+	Estimates []ProjectCreationEstimate `json:"estimates" validate:"required"`
+} // @name CreationValidation
+
+func (v *CreationValidation) AddError(projectName string, check ValidationCheck, message string) {
+	v.Issues = append(v.Issues, ValidationIssue{ProjectName: projectName, Check: check, Severity: ValidationSeverityError, Message: message})
+	v.Valid = false
+}
+
+func (v *CreationValidation) AddWarning(projectName string, check ValidationCheck, message string) {
+	v.Issues = append(v.Issues, ValidationIssue{ProjectName: projectName, Check: check, Severity: ValidationSeverityWarning, Message: message})
+}
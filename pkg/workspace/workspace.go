@@ -5,25 +5,60 @@ package workspace
 
 import (
 	"errors"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/workspace/project"
 )
 
 type Workspace struct {
-	Id       string             `json:"id" validate:"required"`
-	Name     string             `json:"name" validate:"required"`
-	Projects []*project.Project `json:"projects" validate:"required"`
-	Target   string             `json:"target" validate:"required"`
-	ApiKey   string             `json:"-"`
-	EnvVars  map[string]string  `json:"-"`
+	Id             string             `json:"id" validate:"required"`
+	Name           string             `json:"name" validate:"required"`
+	Projects       []*project.Project `json:"projects" validate:"required"`
+	Target         string             `json:"target" validate:"required"`
+	OrganizationId string             `json:"organizationId,omitempty" validate:"optional"`
+	Annotations    map[string]string  `json:"annotations,omitempty" validate:"optional"`
+	ExpiresAt      *time.Time         `json:"expiresAt,omitempty" validate:"optional"`
+	CreatedAt      *time.Time         `json:"createdAt,omitempty" validate:"optional"`
+	// Adoption is set for workspaces registered from an existing container or VM instead of being created by a provider
+	Adoption *Adoption `json:"adoption,omitempty" validate:"optional"`
+	// Names of the shared services of the target the projects of the workspace connect to
+	SharedServices []string `json:"sharedServices,omitempty" validate:"optional"`
+	// Limit of the traffic the agent of each project proxies from and to the tailnet. Only lowers the limit of the
+	// target in the directions it sets
+	BandwidthLimit *project.BandwidthLimit `json:"bandwidthLimit,omitempty" validate:"optional"`
+	ApiKey         string                  `json:"-"`
+	EnvVars        map[string]string       `json:"-"`
 } // @name Workspace
 
+type AdoptionType string
+
+const (
+	AdoptionTypeDocker AdoptionType = "docker"
+	AdoptionTypeSsh    AdoptionType = "ssh"
+)
+
+type Adoption struct {
+	Type AdoptionType `json:"type" validate:"required"`
+	// ID or name of the adopted container on the server host
+	ContainerId string `json:"containerId,omitempty" validate:"optional"`
+	Host        string `json:"host,omitempty" validate:"optional"`
+	Port        int    `json:"port,omitempty" validate:"optional"`
+	// User that runs the agent
+	User       string    `json:"user" validate:"required"`
+	ProjectDir string    `json:"projectDir,omitempty" validate:"optional"`
+	AdoptedAt  time.Time `json:"adoptedAt" validate:"required"`
+} // @name WorkspaceAdoption
+
 type WorkspaceInfo struct {
 	Name             string                 `json:"name" validate:"required"`
 	Projects         []*project.ProjectInfo `json:"projects" validate:"required"`
 	ProviderMetadata string                 `json:"providerMetadata,omitempty" validate:"optional"`
 } // @name WorkspaceInfo
 
+func (w *Workspace) IsAdopted() bool {
+	return w.Adoption != nil
+}
+
 func (w *Workspace) GetProject(projectName string) (*project.Project, error) {
 	for _, project := range w.Projects {
 		if project.Name == projectName {